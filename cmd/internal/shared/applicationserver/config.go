@@ -21,9 +21,11 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqttbridge"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
 )
 
 // DefaultWebhookTemplatesConfig is the default configuration for the Webhook templates.
@@ -40,6 +42,13 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		PublicAddress:    fmt.Sprintf("%s:1883", shared.DefaultPublicHost),
 		PublicTLSAddress: fmt.Sprintf("%s:8883", shared.DefaultPublicHost),
 	},
+	MQTTBridge: mqttbridge.Config{
+		QoS:           1,
+		SharedGroup:   "ttn-lw-stack",
+		SessionExpiry: time.Hour,
+		KeepAlive:     30 * time.Second,
+		MaxInflight:   16,
+	},
 	Webhooks: applicationserver.WebhooksConfig{
 		Templates: DefaultWebhookTemplatesConfig,
 		Target:    "direct",
@@ -51,6 +60,10 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 	UplinkStorage: applicationserver.UplinkStorageConfig{
 		Limit: 16,
 	},
+	Downlinks: applicationserver.DownlinksConfig{
+		Limit:            64,
+		DispatchInterval: time.Second,
+	},
 	EndDeviceMetadataStorage: applicationserver.EndDeviceMetadataStorageConfig{
 		Location: applicationserver.EndDeviceLocationStorageConfig{
 			Timeout: 5 * time.Second,
@@ -80,6 +93,10 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 				SubscriptionQueueSize: io.DefaultBufferSize,
 			},
 		},
+		Durable: applicationserver.DurableDistributorConfig{
+			Retention: 24 * time.Hour,
+			MaxLength: 10000,
+		},
 	},
 	PubSub: applicationserver.PubSubConfig{
 		Providers: map[string]string{
@@ -94,7 +111,9 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		},
 	},
 	Formatters: applicationserver.FormattersConfig{
-		MaxParameterLength: 40960,
+		MaxParameterLength:     40960,
+		MaxWASMParameterLength: 1 << 20,
+		GRPCService:            grpcservice.DefaultConfig,
 	},
 	DeviceLastSeen: applicationserver.LastSeenConfig{
 		BatchSize:     1000,
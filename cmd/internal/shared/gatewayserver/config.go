@@ -36,7 +36,25 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 	UpdateConnectionStatsDebounceTime: 30 * time.Second,
 	ConnectionStatsTTL:                12 * time.Hour,
 	ConnectionStatsDisconnectTTL:      48 * time.Hour,
-	UpdateVersionInfoDelay:            5 * time.Second,
+	ConnectionStatsHistory: gatewayserver.ConnectionStatsHistoryConfig{
+		Backend:  "none",
+		Interval: 5 * time.Minute,
+		Redis: gatewayserver.ConnectionStatsHistoryRedisConfig{
+			RawRetention:       24 * time.Hour,
+			DownsampleInterval: time.Hour,
+			Retention:          30 * 24 * time.Hour,
+		},
+		SQL: gatewayserver.ConnectionStatsHistorySQLConfig{
+			Retention: 30 * 24 * time.Hour,
+		},
+	},
+	TrafficCapture: gatewayserver.TrafficCaptureConfig{
+		MaxDuration:  time.Hour,
+		MaxSize:      16 << 20,
+		MaxTotalSize: 256 << 20,
+		Retention:    time.Hour,
+	},
+	UpdateVersionInfoDelay: 5 * time.Second,
 	Forward: map[string][]string{
 		"": {"00000000/0"},
 	},
@@ -0,0 +1,320 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+var (
+	errFormatterTestVectors  = errors.DefineFailedPrecondition("formatter_test_vectors", "test vectors `{names}` failed")
+	errNoEndDeviceFormatters = errors.DefineFailedPrecondition(
+		"no_end_device_formatters",
+		"end device `{device_id}` has no payload formatters, import the test vectors in the application link instead",
+	)
+	errNoVersionIDs       = errors.DefineInvalidArgument("no_version_ids", "no end device version identifiers set")
+	errTooManyTestVectors = errors.DefineInvalidArgument(
+		"too_many_test_vectors", "`{count}` test vectors exceed the maximum of `{max}` test vectors",
+	)
+)
+
+// maxTestVectors is the maximum number of test vectors of payload formatters.
+const maxTestVectors = 20
+
+func applicationFormattersFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("application-id", "", "")
+	flagSet.String("device-id", "", "end device ID (optional)")
+	return flagSet
+}
+
+// getApplicationFormattersID returns the application identifiers and the optional end device ID.
+func getApplicationFormattersID(flagSet *pflag.FlagSet, args []string) (*ttnpb.ApplicationIdentifiers, string, error) {
+	applicationID, _ := flagSet.GetString("application-id")
+	deviceID, _ := flagSet.GetString("device-id")
+	switch len(args) {
+	case 0:
+	case 1:
+		applicationID = args[0]
+	default:
+		if len(args) > 2 {
+			logger.Warn("Multiple IDs found in arguments, considering the first")
+		}
+		applicationID, deviceID = args[0], args[1]
+	}
+	if applicationID == "" {
+		return nil, "", errNoApplicationID.New()
+	}
+	return &ttnpb.ApplicationIdentifiers{ApplicationId: applicationID}, deviceID, nil
+}
+
+// getStoredFormatters returns the payload formatters of the end device, or the default formatters of the application
+// if deviceID is empty or the end device has no payload formatters. The returned boolean is true if the formatters
+// belong to the end device.
+func getStoredFormatters(
+	ctx context.Context, cc *grpc.ClientConn, appIDs *ttnpb.ApplicationIdentifiers, deviceID string,
+) (*ttnpb.MessagePayloadFormatters, bool, error) {
+	if deviceID != "" {
+		dev, err := ttnpb.NewAsEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: appIDs,
+				DeviceId:       deviceID,
+			},
+			FieldMask: ttnpb.FieldMask("formatters"),
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if dev.Formatters != nil {
+			return dev.Formatters, true, nil
+		}
+	}
+	link, err := ttnpb.NewAsClient(cc).GetLink(ctx, &ttnpb.GetApplicationLinkRequest{
+		ApplicationIds: appIDs,
+		FieldMask:      ttnpb.FieldMask("default_formatters"),
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, false, err
+	}
+	return link.GetDefaultFormatters(), false, nil
+}
+
+// mergeTestVectors returns the test vectors with the imported test vectors. Test vectors with the same name are
+// replaced.
+func mergeTestVectors(vectors, imported []*ttnpb.MessagePayloadFormatterTestVector) []*ttnpb.MessagePayloadFormatterTestVector {
+	res := make([]*ttnpb.MessagePayloadFormatterTestVector, 0, len(vectors)+len(imported))
+	names := make(map[string]int, len(vectors))
+	for _, vector := range vectors {
+		names[vector.Name] = len(res)
+		res = append(res, vector)
+	}
+	for _, vector := range imported {
+		if i, ok := names[vector.Name]; ok {
+			res[i] = vector
+			continue
+		}
+		res = append(res, vector)
+	}
+	return res
+}
+
+var (
+	applicationsFormattersCommand = &cobra.Command{
+		Use:     "formatters",
+		Aliases: []string{"formatter"},
+		Short:   "Application payload formatter commands",
+	}
+	applicationsFormattersTestCommand = &cobra.Command{
+		Use:   "test [application-id] [device-id]",
+		Short: "Run the test vectors of the payload formatters",
+		Long: `Run the test vectors of the payload formatters
+
+The test vectors of the end device, or of the application link if no end
+device is given or if the end device has no payload formatters, are run
+against the stored payload formatters. Use the formatters flags to run the
+stored test vectors against other payload formatters.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appIDs, deviceID, err := getApplicationFormattersID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			formatters := &ttnpb.MessagePayloadFormatters{}
+			paths, err := formatters.SetFromFlags(cmd.Flags(), "formatters")
+			if err != nil {
+				return err
+			}
+			newPaths, err := parsePayloadFormatterParameterFlags("formatters", formatters, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			paths = append(paths, newPaths...)
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.TestFormattersRequest{
+				ApplicationIds: appIDs,
+				DeviceId:       deviceID,
+			}
+			if len(paths) > 0 {
+				stored, _, err := getStoredFormatters(ctx, as, appIDs, deviceID)
+				if err != nil {
+					return err
+				}
+				formatters.TestVectors = stored.GetTestVectors()
+				req.Formatters = formatters
+			}
+			res, err := ttnpb.NewAsClient(as).TestFormatters(ctx, req)
+			if err != nil {
+				return err
+			}
+			if err := io.Write(os.Stdout, config.OutputFormat, res); err != nil {
+				return err
+			}
+			var failed []string
+			for _, result := range res.Results {
+				if !result.Passed {
+					failed = append(failed, result.Name)
+				}
+			}
+			if len(failed) > 0 {
+				return errFormatterTestVectors.WithAttributes("names", strings.Join(failed, ", "))
+			}
+			return nil
+		},
+	}
+	applicationsFormattersImportCommand = &cobra.Command{
+		Use:   "import-test-vectors [application-id] [device-id]",
+		Short: "Import the payload formatter examples of the Device Repository as test vectors",
+		Long: `Import the payload formatter examples of the Device Repository as test vectors
+
+The examples of the uplink decoder, downlink encoder and downlink decoder of
+the end device version are stored as test vectors of the payload formatters
+of the end device, or of the application link if no end device is given.
+Stored test vectors with the same name are replaced. The end device version
+defaults to the version of the end device.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appIDs, deviceID, err := getApplicationFormattersID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			versionIDs := &ttnpb.EndDeviceVersionIdentifiers{}
+			if _, err := versionIDs.SetFromFlags(cmd.Flags(), "version-ids"); err != nil {
+				return err
+			}
+			skip, _ := cmd.Flags().GetBool("skip-formatter-test-vectors")
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			formatters, isDevice, err := getStoredFormatters(ctx, as, appIDs, deviceID)
+			if err != nil {
+				return err
+			}
+			if deviceID != "" && !isDevice {
+				return errNoEndDeviceFormatters.WithAttributes("device_id", deviceID)
+			}
+			if versionIDs.BrandId == "" && deviceID != "" {
+				dev, err := ttnpb.NewAsEndDeviceRegistryClient(as).Get(ctx, &ttnpb.GetEndDeviceRequest{
+					EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+						ApplicationIds: appIDs,
+						DeviceId:       deviceID,
+					},
+					FieldMask: ttnpb.FieldMask("version_ids"),
+				})
+				if err != nil {
+					return err
+				}
+				versionIDs = dev.VersionIds
+			}
+			if versionIDs.GetBrandId() == "" || versionIDs.GetModelId() == "" {
+				return errNoVersionIDs.New()
+			}
+
+			dr, err := api.Dial(ctx, config.DeviceRepositoryGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewDeviceRepositoryClient(dr)
+			drReq := &ttnpb.GetPayloadFormatterRequest{
+				ApplicationIds: appIDs,
+				VersionIds:     versionIDs,
+			}
+			var imported []*ttnpb.MessagePayloadFormatterTestVector
+			uplinkDecoder, err := client.GetUplinkDecoder(ctx, drReq)
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			imported = append(imported,
+				uplinkDecoder.TestVectors(ttnpb.MessagePayloadFormatterTestVector_UPLINK_DECODE)...,
+			)
+			downlinkEncoder, err := client.GetDownlinkEncoder(ctx, drReq)
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			imported = append(imported, downlinkEncoder.TestVectors()...)
+			downlinkDecoder, err := client.GetDownlinkDecoder(ctx, drReq)
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			imported = append(imported,
+				downlinkDecoder.TestVectors(ttnpb.MessagePayloadFormatterTestVector_DOWNLINK_DECODE)...,
+			)
+			logger.WithField("count", len(imported)).Info("Import test vectors")
+
+			vectors := mergeTestVectors(formatters.GetTestVectors(), imported)
+			if len(vectors) > maxTestVectors {
+				return errTooManyTestVectors.WithAttributes("count", len(vectors), "max", maxTestVectors)
+			}
+			if deviceID != "" {
+				res, err := ttnpb.NewAsEndDeviceRegistryClient(as).Set(ctx, &ttnpb.SetEndDeviceRequest{
+					EndDevice: &ttnpb.EndDevice{
+						Ids: &ttnpb.EndDeviceIdentifiers{
+							ApplicationIds: appIDs,
+							DeviceId:       deviceID,
+						},
+						Formatters: &ttnpb.MessagePayloadFormatters{
+							TestVectors: vectors,
+						},
+					},
+					FieldMask:                ttnpb.FieldMask("formatters.test_vectors"),
+					SkipFormatterTestVectors: skip,
+				})
+				if err != nil {
+					return err
+				}
+				return io.Write(os.Stdout, config.OutputFormat, res)
+			}
+			res, err := ttnpb.NewAsClient(as).SetLink(ctx, &ttnpb.SetApplicationLinkRequest{
+				ApplicationIds: appIDs,
+				Link: &ttnpb.ApplicationLink{
+					DefaultFormatters: &ttnpb.MessagePayloadFormatters{
+						TestVectors: vectors,
+					},
+				},
+				FieldMask:                ttnpb.FieldMask("default_formatters.test_vectors"),
+				SkipFormatterTestVectors: skip,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	applicationsFormattersTestCommand.Flags().AddFlagSet(applicationFormattersFlags())
+	ttnpb.AddSetFlagsForMessagePayloadFormatters(applicationsFormattersTestCommand.Flags(), "formatters", false)
+	applicationsFormattersTestCommand.Flags().AddFlagSet(payloadFormatterParameterFlags("formatters"))
+	applicationsFormattersCommand.AddCommand(applicationsFormattersTestCommand)
+	applicationsFormattersImportCommand.Flags().AddFlagSet(applicationFormattersFlags())
+	ttnpb.AddSetFlagsForEndDeviceVersionIdentifiers(applicationsFormattersImportCommand.Flags(), "version-ids", false)
+	applicationsFormattersImportCommand.Flags().Bool("skip-formatter-test-vectors", false, "store the test vectors even if they fail")
+	applicationsFormattersCommand.AddCommand(applicationsFormattersImportCommand)
+	applicationsCommand.AddCommand(applicationsFormattersCommand)
+}
@@ -101,14 +101,16 @@ var (
 				return err
 			}
 			paths = append(paths, newPaths...)
+			skip, _ := cmd.Flags().GetBool("skip-formatter-test-vectors")
 			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
 			if err != nil {
 				return err
 			}
 			res, err := ttnpb.NewAsClient(as).SetLink(ctx, &ttnpb.SetApplicationLinkRequest{
-				ApplicationIds: appID,
-				Link:           link,
-				FieldMask:      ttnpb.FieldMask(paths...),
+				ApplicationIds:           appID,
+				Link:                     link,
+				FieldMask:                ttnpb.FieldMask(paths...),
+				SkipFormatterTestVectors: skip,
 			})
 			if err != nil {
 				return err
@@ -150,6 +152,7 @@ func init() {
 	applicationsLinkSetCommand.Flags().AddFlagSet(applicationIDFlags())
 	ttnpb.AddSetFlagsForApplicationLink(applicationsLinkSetCommand.Flags(), "", false)
 	applicationsLinkSetCommand.Flags().AddFlagSet(payloadFormatterParameterFlags("default-formatters"))
+	applicationsLinkSetCommand.Flags().Bool("skip-formatter-test-vectors", false, "update the default formatters even if the test vectors fail")
 	applicationsLinkSetCommand.Flags().AddFlagSet(deprecatedApplicationLinkFlags())
 	applicationsLinkCommand.AddCommand(applicationsLinkSetCommand)
 	applicationsLinkDeleteCommand.Flags().AddFlagSet(applicationIDFlags())
@@ -0,0 +1,206 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func applicationWebhookTemplateIDFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("organization-id", "", "")
+	flagSet.String("template-id", "", "")
+	return flagSet
+}
+
+var (
+	errNoWebhookTemplateID    = errors.DefineInvalidArgument("no_webhook_template_id", "no webhook template ID set")
+	errNoWebhookTemplateInput = errors.DefineInvalidArgument("no_webhook_template_input", "no webhook template in input")
+)
+
+// getApplicationWebhookTemplateID returns the webhook template identifiers from the flags and arguments.
+// If requireOrganization is set, the organization ID must be set.
+func getApplicationWebhookTemplateID(
+	flagSet *pflag.FlagSet, args []string, requireOrganization bool,
+) (*ttnpb.ApplicationWebhookTemplateIdentifiers, error) {
+	organizationID, _ := flagSet.GetString("organization-id")
+	templateID, _ := flagSet.GetString("template-id")
+	switch len(args) {
+	case 0:
+	case 1:
+		templateID = args[0]
+	case 2:
+		organizationID = args[0]
+		templateID = args[1]
+	default:
+		logger.Warn("Multiple IDs found in arguments, considering the first")
+		organizationID = args[0]
+		templateID = args[1]
+	}
+	if templateID == "" {
+		return nil, errNoWebhookTemplateID.New()
+	}
+	ids := &ttnpb.ApplicationWebhookTemplateIdentifiers{
+		TemplateId: templateID,
+	}
+	if organizationID != "" {
+		ids.OrganizationIds = &ttnpb.OrganizationIdentifiers{OrganizationId: organizationID}
+	} else if requireOrganization {
+		return nil, errNoOrganizationID.New()
+	}
+	return ids, nil
+}
+
+var (
+	applicationsWebhookTemplatesCommand = &cobra.Command{
+		Use:     "templates",
+		Aliases: []string{"template"},
+		Short:   "Application webhook templates commands",
+	}
+	applicationsWebhookTemplatesGetCommand = &cobra.Command{
+		Use:     "get [[organization-id] template-id]",
+		Aliases: []string{"info"},
+		Short:   "Get a webhook template",
+		Long: `Get a webhook template
+
+Templates of the public catalog are retrieved when no organization ID is set.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			templateID, err := getApplicationWebhookTemplateID(cmd.Flags(), args, false)
+			if err != nil {
+				return err
+			}
+			templateID.Version, _ = cmd.Flags().GetUint32("version")
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).GetTemplate(ctx, &ttnpb.GetApplicationWebhookTemplateRequest{
+				Ids:       templateID,
+				FieldMask: ttnpb.FieldMask(ttnpb.ApplicationWebhookTemplateFieldPathsTopLevel...),
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsWebhookTemplatesListCommand = &cobra.Command{
+		Use:     "list [organization-id]",
+		Aliases: []string{"ls"},
+		Short:   "List webhook templates",
+		Long: `List webhook templates
+
+The templates of the public catalog are always listed. The templates of the
+organization are listed in addition when an organization ID is set.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			includeDeprecated, _ := cmd.Flags().GetBool("include-deprecated")
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ListTemplates(ctx, &ttnpb.ListApplicationWebhookTemplatesRequest{
+				OrganizationIds:   getOrganizationID(cmd.Flags(), args),
+				IncludeDeprecated: includeDeprecated,
+				FieldMask:         ttnpb.FieldMask(ttnpb.ApplicationWebhookTemplateFieldPathsTopLevel...),
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsWebhookTemplatesPublishCommand = &cobra.Command{
+		Use:   "publish [organization-id] [template-id]",
+		Short: "Publish a new version of a webhook template of an organization",
+		Long: `Publish a new version of a webhook template of an organization
+
+This command takes the webhook template from stdin. The identifiers of the
+template are taken from the arguments or flags. The template is validated by
+the Application Server before it is published.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if inputDecoder == nil {
+				return errNoWebhookTemplateInput.New()
+			}
+			var template ttnpb.ApplicationWebhookTemplate
+			if _, err := inputDecoder.Decode(&template); err != nil {
+				return err
+			}
+			templateID, err := getApplicationWebhookTemplateID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			template.Ids = templateID
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).PublishTemplate(ctx, &ttnpb.PublishApplicationWebhookTemplateRequest{
+				Template: &template,
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsWebhookTemplatesDeprecateCommand = &cobra.Command{
+		Use:   "deprecate [organization-id] [template-id]",
+		Short: "Deprecate all versions of a webhook template of an organization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			templateID, err := getApplicationWebhookTemplateID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).DeprecateTemplate(ctx, templateID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+)
+
+func init() {
+	applicationsWebhookTemplatesGetCommand.Flags().AddFlagSet(applicationWebhookTemplateIDFlags())
+	applicationsWebhookTemplatesGetCommand.Flags().Uint32("version", 0, "version of the template of the organization (latest if zero)")
+	applicationsWebhookTemplatesCommand.AddCommand(applicationsWebhookTemplatesGetCommand)
+	applicationsWebhookTemplatesListCommand.Flags().AddFlagSet(organizationIDFlags())
+	applicationsWebhookTemplatesListCommand.Flags().Bool("include-deprecated", false, "")
+	applicationsWebhookTemplatesCommand.AddCommand(applicationsWebhookTemplatesListCommand)
+	applicationsWebhookTemplatesPublishCommand.Flags().AddFlagSet(applicationWebhookTemplateIDFlags())
+	applicationsWebhookTemplatesCommand.AddCommand(applicationsWebhookTemplatesPublishCommand)
+	applicationsWebhookTemplatesDeprecateCommand.Flags().AddFlagSet(applicationWebhookTemplateIDFlags())
+	applicationsWebhookTemplatesCommand.AddCommand(applicationsWebhookTemplatesDeprecateCommand)
+	applicationsWebhooksCommand.AddCommand(applicationsWebhookTemplatesCommand)
+}
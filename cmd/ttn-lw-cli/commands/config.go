@@ -54,6 +54,7 @@ type Config struct {
 	JoinServerGRPCAddress              string      `name:"join-server-grpc-address" yaml:"join-server-grpc-address" description:"Join Server address"`
 	DeviceTemplateConverterGRPCAddress string      `name:"device-template-converter-grpc-address" yaml:"device-template-converter-grpc-address" description:"Device Template Converter address"`
 	DeviceClaimingServerGRPCAddress    string      `name:"device-claiming-server-grpc-address" yaml:"device-claiming-server-grpc-address" description:"Device Claiming Server address"`
+	DeviceRepositoryGRPCAddress        string      `name:"device-repository-grpc-address" yaml:"device-repository-grpc-address" description:"Device Repository address"`
 	QRCodeGeneratorGRPCAddress         string      `name:"qr-code-generator-grpc-address" yaml:"qr-code-generator-grpc-address" description:"QR Code Generator address"`
 	PacketBrokerAgentGRPCAddress       string      `name:"packet-broker-agent-grpc-address" yaml:"packet-broker-agent-grpc-address" description:"Packet Broker Agent address"`
 	Insecure                           bool        `name:"insecure" yaml:"insecure" description:"Connect without TLS"`
@@ -89,6 +90,7 @@ func (c Config) getHosts() []string {
 	}
 	hosts = append(hosts, c.DeviceTemplateConverterGRPCAddress)
 	hosts = append(hosts, c.DeviceClaimingServerGRPCAddress)
+	hosts = append(hosts, c.DeviceRepositoryGRPCAddress)
 	hosts = append(hosts, c.QRCodeGeneratorGRPCAddress)
 	hosts = append(hosts, c.PacketBrokerAgentGRPCAddress)
 	return getHosts(hosts...)
@@ -117,6 +119,7 @@ func MakeDefaultConfig(clusterGRPCAddress string, oauthServerAddress string, ins
 		JoinServerGRPCAddress:              clusterGRPCAddress,
 		DeviceTemplateConverterGRPCAddress: clusterGRPCAddress,
 		DeviceClaimingServerGRPCAddress:    clusterGRPCAddress,
+		DeviceRepositoryGRPCAddress:        clusterGRPCAddress,
 		QRCodeGeneratorGRPCAddress:         clusterGRPCAddress,
 		PacketBrokerAgentGRPCAddress:       clusterGRPCAddress,
 		Insecure:                           insecure,
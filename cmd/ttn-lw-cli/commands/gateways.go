@@ -513,6 +513,44 @@ If both the parameter and the flag are provided, the flag is ignored.`,
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	gatewaysConnectionStatsHistory = &cobra.Command{
+		Use:     "get-connection-stats-history [gateway-id]",
+		Aliases: []string{"connection-stats-history", "stats-history"},
+		Short:   "Get the history of the connection stats of a gateway",
+		Long: `Get the history of the connection stats of a gateway
+The history contains snapshots of the connection stats in a time range.
+If no time range is given, the snapshots of the last 24 hours are returned.
+The snapshots can be aggregated in intervals with --aggregation-interval.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			before, after, last, err := timeRangeFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.GetGatewayConnectionStatsHistoryRequest{
+				GatewayIds: gtwID,
+				After:      after,
+				Before:     before,
+				Last:       last,
+			}
+			if interval, _ := cmd.Flags().GetDuration("aggregation-interval"); interval > 0 {
+				req.AggregationInterval = types.DurationProto(interval)
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).GetGatewayConnectionStatsHistory(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 	gatewaysContactInfoCommand = contactInfoCommands("gateway", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		gtwID, err := getGatewayID(cmd.Flags(), args, true)
 		if err != nil {
@@ -599,6 +637,12 @@ func init() {
 		"gateway-ids",
 	)
 	gatewaysCommand.AddCommand(gatewaysConnectionStats)
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("after", "query snapshots after specified timestamp"))
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("before", "query snapshots before specified timestamp"))
+	gatewaysConnectionStatsHistory.Flags().Duration("last", 0, "query snapshots in the last hours or minutes")
+	gatewaysConnectionStatsHistory.Flags().Duration("aggregation-interval", 0, "aggregate snapshots in intervals of this duration")
+	gatewaysCommand.AddCommand(gatewaysConnectionStatsHistory)
 	gatewaysContactInfoCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysContactInfoCommand)
 	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
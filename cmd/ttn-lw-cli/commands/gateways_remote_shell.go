@@ -0,0 +1,162 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	stdio "io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/term"
+)
+
+// remoteShellInputSize is the maximum size of remote shell input messages.
+const remoteShellInputSize = 4096
+
+var errNoRemoteCommand = errors.DefineInvalidArgument("no_remote_command", "no remote command set")
+
+var (
+	gatewaysRemoteCommandCommand = &cobra.Command{
+		Use:   "run-command [gateway-id] [command] [arguments...]",
+		Short: "Run a remote command on a connected gateway",
+		Long: `Run a remote command on a connected gateway
+The gateway must be connected to the Gateway Server with a protocol that
+supports remote commands, such as LoRa Basic Station LNS.
+
+This command does not return the output of the remote command.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args[:1], true)
+			if err != nil {
+				return err
+			}
+			if args[1] == "" {
+				return errNoRemoteCommand.New()
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewGsClient(gs).RunGatewayRemoteCommand(ctx, &ttnpb.GatewayRemoteCommandRequest{
+				GatewayIds: gtwID,
+				Command:    args[1],
+				Arguments:  args[2:],
+			})
+			return err
+		},
+	}
+	gatewaysRemoteShellCommand = &cobra.Command{
+		Use:   "shell [gateway-id]",
+		Short: "Open a remote shell session on a connected gateway",
+		Long: `Open a remote shell session on a connected gateway
+The gateway must be connected to the Gateway Server with a protocol that
+supports remote shells, such as LoRa Basic Station LNS.
+
+Standard input is sent to the remote shell and the output of the remote
+shell is written to standard output. If standard input is a terminal, it
+is put in raw mode for the duration of the session.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			user, _ := cmd.Flags().GetString("user")
+			termType, _ := cmd.Flags().GetString("term")
+			if termType == "" {
+				termType = os.Getenv("TERM")
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			stream, err := ttnpb.NewGsClient(gs).GatewayRemoteShell(ctx)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&ttnpb.GatewayRemoteShellRequest{
+				Message: &ttnpb.GatewayRemoteShellRequest_Start_{
+					Start: &ttnpb.GatewayRemoteShellRequest_Start{
+						GatewayIds: gtwID,
+						User:       user,
+						Term:       termType,
+					},
+				},
+			}); err != nil {
+				return err
+			}
+
+			if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+				state, err := term.MakeRaw(fd)
+				if err != nil {
+					return err
+				}
+				defer term.Restore(fd, state)
+			}
+
+			go func() {
+				buf := make([]byte, remoteShellInputSize)
+				for {
+					n, err := os.Stdin.Read(buf)
+					if n > 0 {
+						input := make([]byte, n)
+						copy(input, buf[:n])
+						if err := stream.Send(&ttnpb.GatewayRemoteShellRequest{
+							Message: &ttnpb.GatewayRemoteShellRequest_Input{Input: input},
+						}); err != nil {
+							return
+						}
+					}
+					if err != nil {
+						if err == stdio.EOF {
+							stream.CloseSend()
+						} else {
+							cancel()
+						}
+						return
+					}
+				}
+			}()
+
+			for {
+				res, err := stream.Recv()
+				if err != nil {
+					if err == stdio.EOF {
+						return nil
+					}
+					return err
+				}
+				if _, err := os.Stdout.Write(res.Output); err != nil {
+					return err
+				}
+			}
+		},
+	}
+)
+
+func init() {
+	gatewaysRemoteCommandCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCommand.AddCommand(gatewaysRemoteCommandCommand)
+	gatewaysRemoteShellCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysRemoteShellCommand.Flags().String("user", "", "user of the remote shell session")
+	gatewaysRemoteShellCommand.Flags().String("term", "", "terminal type of the remote shell session (default $TERM)")
+	gatewaysCommand.AddCommand(gatewaysRemoteShellCommand)
+}
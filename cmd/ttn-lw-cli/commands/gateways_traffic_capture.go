@@ -0,0 +1,323 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	ttntypes "go.thethings.network/lorawan-stack/v3/pkg/types"
+	"golang.org/x/exp/slices"
+)
+
+var (
+	errNoTrafficCapture             = errors.DefineInvalidArgument("no_traffic_capture", "no traffic capture set")
+	errTrafficCaptureReplayProtocol = errors.DefineInvalidArgument(
+		"traffic_capture_replay_protocol", "traffic captures of protocol `{protocol}` cannot be replayed",
+	)
+)
+
+func trafficCaptureOutputFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("output-file", "", "write the capture as JSON to this file")
+	return flagSet
+}
+
+func writeTrafficCapture(flagSet *pflag.FlagSet, capture *ttnpb.GatewayTrafficCapture) error {
+	outputFile, _ := flagSet.GetString("output-file")
+	if outputFile == "" {
+		return io.Write(os.Stdout, config.OutputFormat, capture)
+	}
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := io.Write(f, "json", capture); err != nil {
+		return err
+	}
+	logger.WithField("file", outputFile).Info("Wrote traffic capture")
+	return nil
+}
+
+// replayTrafficCapture sends the raw uplink frames of the capture with send.
+// The time between the frames is divided by speed. If speed is zero, the frames are sent without delay.
+func replayTrafficCapture(
+	ctx context.Context, capture *ttnpb.GatewayTrafficCapture, speed float64, send func([]byte) error,
+) error {
+	var last time.Time
+	count := 0
+	for _, frame := range capture.Frames {
+		if frame.Direction != ttnpb.GatewayTrafficCaptureFrame_UPLINK || len(frame.Raw) == 0 {
+			continue
+		}
+		t := *ttnpb.StdTime(frame.Time)
+		if !last.IsZero() && speed > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(float64(t.Sub(last)) / speed)):
+			}
+		}
+		last = t
+		if err := send(frame.Raw); err != nil {
+			return err
+		}
+		count++
+	}
+	logger.WithField("count", count).Info("Replayed uplink frames")
+	return nil
+}
+
+func replayTrafficCaptureUDP(
+	ctx context.Context, capture *ttnpb.GatewayTrafficCapture, address string, eui *ttntypes.EUI64, speed float64,
+) error {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	go func() {
+		buf := make([]byte, 65507)
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				return
+			}
+			logger.WithField("size", n).Debug("Received UDP packet")
+		}
+	}()
+	return replayTrafficCapture(ctx, capture, speed, func(raw []byte) error {
+		// The gateway EUI is in bytes 4 to 12 of the packets that the gateway sends.
+		if eui != nil && len(raw) >= 12 {
+			raw = slices.Clone(raw)
+			copy(raw[4:12], eui[:])
+		}
+		_, err := conn.Write(raw)
+		return err
+	})
+}
+
+func replayTrafficCaptureWS(
+	ctx context.Context, capture *ttnpb.GatewayTrafficCapture, address string, eui ttntypes.EUI64, apiKey string,
+	speed float64,
+) error {
+	header := http.Header{}
+	if apiKey != "" {
+		header.Set("Authorization", fmt.Sprintf("Bearer %s", apiKey))
+	}
+	url := fmt.Sprintf("%s/traffic/eui-%s", strings.TrimSuffix(address, "/"), eui)
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	go func() {
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			logger.WithField("message", string(data)).Debug("Received websocket message")
+		}
+	}()
+	if err := replayTrafficCapture(ctx, capture, speed, func(raw []byte) error {
+		return conn.WriteMessage(websocket.TextMessage, raw)
+	}); err != nil {
+		return err
+	}
+	return conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+}
+
+var (
+	gatewaysTrafficCaptureCommand = &cobra.Command{
+		Use:     "traffic-capture",
+		Aliases: []string{"capture"},
+		Short:   "Capture and replay gateway traffic",
+	}
+	gatewaysTrafficCaptureStartCommand = &cobra.Command{
+		Use:   "start [gateway-id]",
+		Short: "Start capturing the traffic of a connected gateway",
+		Long: `Start capturing the traffic of a connected gateway
+The capture stops when the duration or the size limit is reached, or when it
+is stopped. If the limits are not set, the maximum limits configured in the
+Gateway Server are used. A previous capture of the gateway is discarded.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.StartGatewayTrafficCaptureRequest{
+				GatewayIds: gtwID,
+			}
+			if duration, _ := cmd.Flags().GetDuration("duration"); duration > 0 {
+				req.Duration = types.DurationProto(duration)
+			}
+			req.MaxSize, _ = cmd.Flags().GetUint64("max-size")
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).StartGatewayTrafficCapture(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	gatewaysTrafficCaptureStopCommand = &cobra.Command{
+		Use:   "stop [gateway-id]",
+		Short: "Stop capturing the traffic of a gateway and get the capture",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).StopGatewayTrafficCapture(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+			return writeTrafficCapture(cmd.Flags(), res)
+		},
+	}
+	gatewaysTrafficCaptureGetCommand = &cobra.Command{
+		Use:     "get [gateway-id]",
+		Aliases: []string{"export"},
+		Short:   "Get the active or the last traffic capture of a gateway",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).GetGatewayTrafficCapture(ctx, gtwID)
+			if err != nil {
+				return err
+			}
+			return writeTrafficCapture(cmd.Flags(), res)
+		},
+	}
+	gatewaysTrafficCaptureReplayCommand = &cobra.Command{
+		Use:   "replay [file]",
+		Short: "Replay a gateway traffic capture",
+		Long: `Replay a gateway traffic capture
+The raw uplink frames of the capture are sent to the UDP or the LoRa Basics
+Station frontend of a Gateway Server, with the time between the frames of
+the capture. The capture is read from the file, or from standard input if
+no file is given.
+
+The frontend defaults to the protocol of the capture. Use --gateway-eui to
+replay the capture as a different gateway.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			capture := &ttnpb.GatewayTrafficCapture{}
+			if len(args) == 1 {
+				f, err := os.Open(args[0])
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				if err := jsonpb.TTN().NewDecoder(f).Decode(capture); err != nil {
+					return err
+				}
+			} else if rd, ok := io.BufferedPipe(os.Stdin); ok {
+				if err := jsonpb.TTN().NewDecoder(rd).Decode(capture); err != nil {
+					return err
+				}
+			} else {
+				return errNoTrafficCapture.New()
+			}
+
+			var eui *ttntypes.EUI64
+			if euiHex, _ := cmd.Flags().GetString("gateway-eui"); euiHex != "" {
+				eui = &ttntypes.EUI64{}
+				if err := eui.UnmarshalText([]byte(euiHex)); err != nil {
+					return errInvalidGatewayEUI.WithCause(err)
+				}
+			}
+			frontend, _ := cmd.Flags().GetString("frontend")
+			if frontend == "" {
+				frontend = capture.Protocol
+			}
+			address, _ := cmd.Flags().GetString("address")
+			speed, _ := cmd.Flags().GetFloat64("speed")
+
+			switch frontend {
+			case "udp":
+				if address == "" {
+					address = "localhost:1700"
+				}
+				return replayTrafficCaptureUDP(ctx, capture, address, eui, speed)
+			case "ws":
+				if address == "" {
+					address = "ws://localhost:1887"
+				}
+				if eui == nil {
+					if len(capture.GetGatewayIds().GetEui()) == 0 {
+						return errNoGatewayEUI.New()
+					}
+					eui = &ttntypes.EUI64{}
+					copy(eui[:], capture.GatewayIds.Eui)
+				}
+				apiKey, _ := cmd.Flags().GetString("api-key")
+				return replayTrafficCaptureWS(ctx, capture, address, *eui, apiKey, speed)
+			default:
+				return errTrafficCaptureReplayProtocol.WithAttributes("protocol", frontend)
+			}
+		},
+	}
+)
+
+func init() {
+	gatewaysTrafficCaptureStartCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysTrafficCaptureStartCommand.Flags().Duration("duration", 0, "stop the capture after this duration")
+	gatewaysTrafficCaptureStartCommand.Flags().Uint64("max-size", 0, "stop the capture when the frames exceed this size in bytes")
+	gatewaysTrafficCaptureCommand.AddCommand(gatewaysTrafficCaptureStartCommand)
+	gatewaysTrafficCaptureStopCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysTrafficCaptureStopCommand.Flags().AddFlagSet(trafficCaptureOutputFlags())
+	gatewaysTrafficCaptureCommand.AddCommand(gatewaysTrafficCaptureStopCommand)
+	gatewaysTrafficCaptureGetCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysTrafficCaptureGetCommand.Flags().AddFlagSet(trafficCaptureOutputFlags())
+	gatewaysTrafficCaptureCommand.AddCommand(gatewaysTrafficCaptureGetCommand)
+	gatewaysTrafficCaptureReplayCommand.Flags().String("frontend", "", "frontend to replay the capture with (udp, ws)")
+	gatewaysTrafficCaptureReplayCommand.Flags().String("address", "", "address of the frontend (default localhost:1700 for udp, ws://localhost:1887 for ws)")
+	gatewaysTrafficCaptureReplayCommand.Flags().String("gateway-eui", "", "replay the capture as the gateway with this EUI")
+	gatewaysTrafficCaptureReplayCommand.Flags().String("api-key", "", "API key of the gateway for the ws frontend")
+	gatewaysTrafficCaptureReplayCommand.Flags().Float64("speed", 1, "replay speed factor (0 to replay without delay)")
+	gatewaysTrafficCaptureCommand.AddCommand(gatewaysTrafficCaptureReplayCommand)
+	gatewaysCommand.AddCommand(gatewaysTrafficCaptureCommand)
+}
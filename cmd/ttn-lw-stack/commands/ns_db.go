@@ -126,6 +126,7 @@ var (
 			uidRegexp3_10_Fields := regexp.MustCompile(cl.Key("uid", uidRegexpStr, "fields$"))
 
 			euiRegexp := regexp.MustCompile(cl.Key("eui", euiRegexpStr, euiRegexpStr+"$"))
+			devEUIRegexp := regexp.MustCompile(cl.Key("dev_eui", euiRegexpStr+"$"))
 
 			addrRegexpLegacy := regexp.MustCompile(cl.Key("addr", devAddrRegexpStr+"$"))
 			addrRegexp3_10_16Bit := regexp.MustCompile(cl.Key("addr", devAddrRegexpStr, "16bit$"))
@@ -435,8 +436,29 @@ var (
 						return true, nil
 					}
 
+				case euiRegexp.MatchString(k):
+					var devEUI types.EUI64
+					if err := devEUI.UnmarshalText([]byte(k[len(k)-16:])); err != nil {
+						logger.WithError(err).Error("Failed to parse DevEUI from EUI key")
+						return true, nil
+					}
+					uid, err := cl.Get(ctx, k).Result()
+					if err != nil {
+						logger.WithError(err).Error("Failed to get UID of EUI key")
+						return true, nil
+					}
+					added, err := cl.SAdd(ctx, nsredis.DevEUIKey(cl, devEUI), uid).Result()
+					if err != nil {
+						logger.WithError(err).Error("Failed to add UID to DevEUI key")
+						return true, nil
+					}
+					if added == 0 {
+						logger.Debug("Skip valid key")
+						return true, nil
+					}
+
 				case uidRegexp.MatchString(k),
-					euiRegexp.MatchString(k),
+					devEUIRegexp.MatchString(k),
 					addrRegexp3_11_Current.MatchString(k):
 					logger.Debug("Skip valid key")
 					return true, nil
@@ -454,7 +476,7 @@ var (
 					logger.Debug("Skip unmatched key with a TTL")
 					return true, nil
 				}
-				logger.Debug("Migrated key to 3.11 format")
+				logger.Debug("Migrated key")
 				migrated++
 				return true, nil
 			})
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
@@ -32,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
+	dcsredis "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -39,6 +42,7 @@ import (
 	events_grpc "go.thethings.network/lorawan-stack/v3/pkg/events/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	gsbunstore "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/bunstore"
 	gsredis "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
@@ -49,6 +53,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/web"
 )
 
@@ -95,7 +100,12 @@ func NewJoinServerSessionKeyRegistryRedis(conf *Config) *redis.Client {
 	return redis.New(conf.Redis.WithNamespace("js", "keys"))
 }
 
-var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+var (
+	errUnknownComponent                     = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
+	errUnknownConnectionStatsHistoryBackend = errors.DefineInvalidArgument(
+		"unknown_connection_stats_history_backend", "unknown gateway connection stats history backend `{backend}`",
+	)
+)
 
 var startCommand = &cobra.Command{
 	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
@@ -243,6 +253,39 @@ var startCommand = &cobra.Command{
 				}
 				config.GS.Stats = gatewayConnectionStatsRegistry
 			}
+			switch history := config.GS.ConnectionStatsHistory; history.Backend {
+			case "redis":
+				gatewayConnectionStatsHistory := &gsredis.GatewayConnectionStatsHistory{
+					Redis:              redis.New(config.Redis.WithNamespace("gs", "connstats", "history")),
+					LockTTL:            defaultLockTTL,
+					RawRetention:       history.Redis.RawRetention,
+					DownsampleInterval: history.Redis.DownsampleInterval,
+					Retention:          history.Redis.Retention,
+				}
+				if err := gatewayConnectionStatsHistory.Init(ctx); err != nil {
+					return shared.ErrInitializeGatewayServer.WithCause(err)
+				}
+				config.GS.ConnectionStatsHistory.Store = gatewayConnectionStatsHistory
+			case "sql":
+				db, err := storeutil.OpenDB(ctx, history.SQL.DatabaseURI)
+				if err != nil {
+					return shared.ErrInitializeGatewayServer.WithCause(err)
+				}
+				// The SQL backend only supports PostgreSQL, like the database of the Identity Server.
+				gatewayConnectionStatsHistory := &gsbunstore.GatewayConnectionStatsHistory{
+					DB:        bun.NewDB(db, pgdialect.New()),
+					Retention: history.SQL.Retention,
+				}
+				if err := gatewayConnectionStatsHistory.Init(ctx); err != nil {
+					return shared.ErrInitializeGatewayServer.WithCause(err)
+				}
+				config.GS.ConnectionStatsHistory.Store = gatewayConnectionStatsHistory
+			case "", "none":
+			default:
+				return shared.ErrInitializeGatewayServer.WithCause(
+					errUnknownConnectionStatsHistoryBackend.WithAttributes("backend", history.Backend),
+				)
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -320,9 +363,23 @@ var startCommand = &cobra.Command{
 				Redis: redis.New(config.Redis.WithNamespace("as", "applicationups")),
 				Limit: config.AS.UplinkStorage.Limit,
 			}
+			heldDownlinkRegistry := &asredis.HeldDownlinkRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("as", "downlinks", "held")),
+				LockTTL: defaultLockTTL,
+				Limit:   config.AS.Downlinks.Limit,
+			}
+			if err := heldDownlinkRegistry.Init(ctx); err != nil {
+				return shared.ErrInitializeApplicationServer.WithCause(err)
+			}
+			config.AS.Downlinks.Held = heldDownlinkRegistry
 			config.AS.Distribution.Global.PubSub = &asdistribredis.PubSub{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("as", "traffic")),
 			}
+			config.AS.Distribution.Durable.Stream = &asdistribredis.Stream{
+				Redis:     redis.New(config.Redis.WithNamespace("as", "traffic", "durable")),
+				Retention: config.AS.Distribution.Durable.Retention,
+				MaxLen:    config.AS.Distribution.Durable.MaxLength,
+			}
 			pubsubRegistry := &asiopsredis.PubSubRegistry{
 				Redis:   redis.New(config.Redis.WithNamespace("as", "io", "pubsub")),
 				LockTTL: defaultLockTTL,
@@ -349,6 +406,14 @@ var startCommand = &cobra.Command{
 				}
 				config.AS.Webhooks.Registry = webhookRegistry
 			}
+			webhookTemplateRegistry := &asiowebredis.TemplateRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("as", "io", "webhooktemplates")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := webhookTemplateRegistry.Init(ctx); err != nil {
+				return shared.ErrInitializeApplicationServer.WithCause(err)
+			}
+			config.AS.Webhooks.Templates.Registry = webhookTemplateRegistry
 			if cache := &config.AS.EndDeviceMetadataStorage.Location.Cache; cache.Enable {
 				switch config.Cache.Service {
 				case "redis":
@@ -478,6 +543,23 @@ var startCommand = &cobra.Command{
 
 			var opts []deviceclaimingserver.Option
 
+			localJSConfig := &config.DCS.EndDeviceClaimingServerConfig.LocalJoinServer
+			localJSConfig.Authorizations = &dcsredis.ApplicationAuthorizationRegistry{
+				Redis:           redis.New(config.Redis.WithNamespace("dcs", "applications", "authorizations")),
+				KeyVault:        c.KeyVault,
+				EncryptionKeyID: localJSConfig.EncryptionKeyID,
+			}
+			localJSConfig.Transfers = &dcsredis.EndDeviceTransferRegistry{
+				Redis:           redis.New(config.Redis.WithNamespace("dcs", "devices", "transfers")),
+				KeyVault:        c.KeyVault,
+				EncryptionKeyID: localJSConfig.EncryptionKeyID,
+			}
+			config.DCS.GatewayClaimingServerConfig.Authorizations = &dcsredis.GatewayAuthorizationRegistry{
+				Redis:           redis.New(config.Redis.WithNamespace("dcs", "gateways", "authorizations")),
+				KeyVault:        c.KeyVault,
+				EncryptionKeyID: config.DCS.GatewayClaimingServerConfig.EncryptionKeyID,
+			}
+
 			dcs, err := deviceclaimingserver.New(c, &config.DCS, opts...)
 			if err != nil {
 				return shared.ErrInitializeDeviceClaimingServer.WithCause(err)
@@ -61,6 +61,7 @@ require (
 	github.com/disintegration/imaging v1.6.2
 	github.com/dop251/goja v0.0.0-20220815083517-0c74f9139fd6
 	github.com/dustin/go-humanize v1.0.0
+	github.com/eclipse/paho.golang v0.11.0
 	github.com/eclipse/paho.mqtt.golang v1.3.5
 	github.com/emersion/go-smtp v0.15.0
 	github.com/envoyproxy/protoc-gen-validate v0.6.3
@@ -116,6 +117,7 @@ require (
 	github.com/spf13/pflag v1.0.5
 	// NOTE: github.com/spf13/viper is actually a different version (see above).
 	github.com/spf13/viper v1.10.1
+	github.com/tetratelabs/wazero v1.0.0
 	github.com/throttled/throttled v2.2.5+incompatible
 	github.com/throttled/throttled/v2 v2.7.1
 	github.com/uptrace/bun v1.1.8
@@ -142,6 +144,7 @@ require (
 	golang.org/x/net v0.0.0-20220127200216-cd36cc0744dd
 	golang.org/x/oauth2 v0.0.0-20220411215720-9780585627b5
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
+	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211
 	// NOTE: google.golang.org/genproto is actually a different version (see above).
 	google.golang.org/genproto v0.0.0-20220519153652-3a47de7e79bd
 	// NOTE: google.golang.org/grpc is actually a different version (see above).
@@ -260,7 +263,6 @@ require (
 	golang.org/x/lint v0.0.0-20210508222113-6edffad5e616 // indirect
 	golang.org/x/mod v0.6.0-dev.0.20220106191415-9b9b3d81d5e3 // indirect
 	golang.org/x/sys v0.0.0-20220825204002-c680a09ffe64 // indirect
-	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211 // indirect
 	golang.org/x/text v0.3.7 // indirect
 	golang.org/x/time v0.0.0-20220210224613-90d013bbcef8 // indirect
 	golang.org/x/tools v0.1.10 // indirect
@@ -28,6 +28,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	iogrpc "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqttbridge"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	_ "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
@@ -46,7 +47,9 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/cayennelpp"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/wasm"
 	"go.thethings.network/lorawan-stack/v3/pkg/task"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -68,6 +71,8 @@ type ApplicationServer struct {
 	linkRegistry           LinkRegistry
 	deviceRegistry         DeviceRegistry
 	appUpsRegistry         ApplicationUplinkRegistry
+	heldDownlinks          HeldDownlinkRegistry
+	heldDownlinksDue       chan struct{}
 	locationRegistry       metadata.EndDeviceLocationRegistry
 	formatters             messageprocessors.MapPayloadProcessor
 	webhooks               ioweb.Webhooks
@@ -123,6 +128,8 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		linkRegistry:     conf.Links,
 		deviceRegistry:   wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
 		appUpsRegistry:   conf.UplinkStorage.Registry,
+		heldDownlinks:    conf.Downlinks.Held,
+		heldDownlinksDue: make(chan struct{}, 1),
 		locationRegistry: conf.EndDeviceMetadataStorage.Location.Registry,
 		formatters:       make(messageprocessors.MapPayloadProcessor),
 		clusterDistributor: distribution.NewPubSubDistributor(
@@ -145,6 +152,8 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT] = javascript.New()
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP] = cayennelpp.New()
+	as.formatters[ttnpb.PayloadFormatter_FORMATTER_WASM] = wasm.New()
+	as.formatters[ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE] = grpcservice.New(ctx, c, conf.Formatters.GRPCService)
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_REPOSITORY] = devicerepository.New(as.formatters, as)
 
 	as.activationPool = workerpool.NewWorkerPool(workerpool.Config[*ttnpb.EndDeviceIdentifiers]{
@@ -180,6 +189,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			return dev.Ids, nil
 		}),
 		iogrpc.WithPayloadProcessor(as.formatters),
+		iogrpc.WithDurableStream(conf.Distribution.Durable.Stream),
 		iogrpc.WithSkipPayloadCrypto(func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (bool, error) {
 			link, err := as.getLink(ctx, ids.ApplicationIds, []string{"skip_payload_crypto"})
 			if err != nil {
@@ -242,6 +252,16 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}
 
+	if as.heldDownlinks != nil {
+		as.RegisterTask(&task.Config{
+			Context: as.Context(),
+			ID:      "dispatch_held_downlinks",
+			Func:    as.dispatchHeldDownlinks,
+			Restart: task.RestartOnFailure,
+			Backoff: task.DefaultBackoffConfig,
+		})
+	}
+
 	if as.webhooks, err = conf.Webhooks.NewWebhooks(ctx, as); err != nil {
 		return nil, err
 	}
@@ -258,6 +278,10 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		return nil, err
 	}
 
+	if _, err = mqttbridge.New(ctx, as, conf.MQTTBridge); err != nil {
+		return nil, err
+	}
+
 	if as.deviceLastSeenProvider, err = conf.DeviceLastSeen.NewLastSeen(ctx, c); err != nil {
 		return nil, err
 	}
@@ -398,7 +422,13 @@ func (as *ApplicationServer) publishUp(ctx context.Context, up *ttnpb.Applicatio
 	if err := as.localDistributor.Publish(ctx, up); err != nil {
 		return err
 	}
-	return as.clusterDistributor.Publish(ctx, up)
+	if err := as.clusterDistributor.Publish(ctx, up); err != nil {
+		return err
+	}
+	if stream := as.config.Distribution.Durable.Stream; stream != nil {
+		return stream.Publish(ctx, up)
+	}
+	return nil
 }
 
 // skipPayloadCrypto indicates whether LoRaWAN FRMPayload encryption and decryption should be skipped.
@@ -612,8 +642,19 @@ func (as *ApplicationServer) attemptDownlinkQueueOp(ctx context.Context, dev *tt
 	}
 }
 
-func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink, op func(ttnpb.AsNsClient, context.Context, *ttnpb.DownlinkQueueRequest, ...grpc.CallOption) (*pbtypes.Empty, error)) error {
+// downlinkQueueOp performs the downlink queue operation in the Network Server.
+// If reportErrors is true, errors that occur before the operation is attempted are reported as failed downlink
+// messages as well.
+func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink, op func(ttnpb.AsNsClient, context.Context, *ttnpb.DownlinkQueueRequest, ...grpc.CallOption) (*pbtypes.Empty, error), reportErrors bool) (err error) {
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("as:downlink:%s", events.NewCorrelationID()))
+	var attempted bool
+	if reportErrors {
+		defer func() {
+			if err != nil && !attempted {
+				as.registerDropDownlinks(ctx, ids, items, err)
+			}
+		}()
+	}
 	link, err := as.getLink(ctx, ids.ApplicationIds, []string{
 		"default_formatters",
 		"skip_payload_crypto",
@@ -658,6 +699,7 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids *ttnpb.End
 				Items:     items,
 				Operation: op,
 				ResultFunc: func(decrypted, encrypted []*ttnpb.ApplicationDownlink, err error) {
+					attempted = true
 					if err != nil {
 						as.registerDropDownlinks(ctx, ids, decrypted, err)
 					} else {
@@ -675,20 +717,53 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids *ttnpb.End
 }
 
 // DownlinkQueuePush pushes the given downlink messages to the end device's application downlink queue.
+// Downlink messages with scheduling are held by the Application Server until they are due.
 // This operation changes FRMPayload in the given items.
 func (as *ApplicationServer) DownlinkQueuePush(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
-	return as.downlinkQueueOp(ctx, ids, io.CleanDownlinks(items), ttnpb.AsNsClient.DownlinkQueuePush)
+	held, items := partitionHeldDownlinks(io.CleanDownlinks(items))
+	if len(held) > 0 {
+		if err := as.holdDownlinks(ctx, ids, held); err != nil {
+			return err
+		}
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	return as.downlinkQueueOp(ctx, ids, items, ttnpb.AsNsClient.DownlinkQueuePush, false)
 }
 
 // DownlinkQueueReplace replaces the end device's application downlink queue with the given downlink messages.
+// The downlink messages that are held by the Application Server are discarded and reported as failed once the queue
+// in the Network Server has been replaced.
+// Downlink messages with scheduling are held by the Application Server until they are due.
 // This operation changes FRMPayload in the given items.
 func (as *ApplicationServer) DownlinkQueueReplace(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
-	return as.downlinkQueueOp(ctx, ids, io.CleanDownlinks(items), ttnpb.AsNsClient.DownlinkQueueReplace)
+	held, items := partitionHeldDownlinks(io.CleanDownlinks(items))
+	if len(held) > 0 {
+		if err := as.validateHeldDownlinks(held); err != nil {
+			return err
+		}
+	}
+	if err := as.downlinkQueueOp(ctx, ids, items, ttnpb.AsNsClient.DownlinkQueueReplace, false); err != nil {
+		return err
+	}
+	if as.heldDownlinks != nil {
+		cleared, err := as.heldDownlinks.Clear(ctx, ids)
+		if err != nil {
+			return err
+		}
+		as.registerDropDownlinks(ctx, ids, cleared, errDownlinkReplaced.New())
+	}
+	if len(held) == 0 {
+		return nil
+	}
+	return as.holdDownlinks(ctx, ids, held)
 }
 
 var errNoAppSKey = errors.DefineCorruption("no_app_s_key", "no AppSKey")
 
 // DownlinkQueueList lists the application downlink queue of the given end device.
+// The downlink messages that are held by the Application Server are listed after the downlink messages in the queue.
 func (as *ApplicationServer) DownlinkQueueList(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error) {
 	dev, err := as.deviceRegistry.Get(ctx, ids, []string{
 		"formatters",
@@ -716,7 +791,7 @@ func (as *ApplicationServer) DownlinkQueueList(ctx context.Context, ids *ttnpb.E
 		return nil, err
 	}
 	if len(res.Downlinks) == 0 {
-		return nil, nil
+		return as.appendHeldDownlinks(ctx, ids, nil)
 	}
 	var queue []*ttnpb.ApplicationDownlink
 	var session *ttnpb.Session
@@ -733,14 +808,14 @@ func (as *ApplicationServer) DownlinkQueueList(ctx context.Context, ids *ttnpb.E
 	}
 	queue, _ = ttnpb.PartitionDownlinksBySessionKeyIDEquality(session.Keys.SessionKeyId, res.Downlinks...)
 	if as.skipPayloadCrypto(ctx, link, dev, session) {
-		return queue, nil
+		return as.appendHeldDownlinks(ctx, ids, queue)
 	}
 	for _, item := range queue {
 		if err := as.decryptAndDecodeDownlink(ctx, dev, item, link.DefaultFormatters); err != nil {
 			return nil, err
 		}
 	}
-	return queue, nil
+	return as.appendHeldDownlinks(ctx, ids, queue)
 }
 
 var (
@@ -813,6 +888,8 @@ func (as *ApplicationServer) handleUp(ctx context.Context, up *ttnpb.Application
 		return true, as.handleLocationSolved(ctx, up.EndDeviceIds, p.LocationSolved, link)
 	case *ttnpb.ApplicationUp_ServiceData:
 		return true, nil
+	case *ttnpb.ApplicationUp_SessionRecovered:
+		return true, as.handleSessionRecovered(ctx, up.EndDeviceIds, p.SessionRecovered, link)
 	default:
 		return false, nil
 	}
@@ -1341,6 +1418,97 @@ func (as *ApplicationServer) handleDownlinkNack(ctx context.Context, ids *ttnpb.
 	return err
 }
 
+// handleSessionRecovered reconciles the stored session with the session recovered by the Network Server.
+// Downlinks in the Network Server queue that are encrypted with the discarded session are re-encrypted with the
+// recovered session.
+func (as *ApplicationServer) handleSessionRecovered(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationSessionRecovered, link *ttnpb.ApplicationLink) error {
+	defer trace.StartRegion(ctx, "handle session recovered").End()
+
+	peer, err := as.GetPeer(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	if err != nil {
+		return err
+	}
+	_, err = as.deviceRegistry.Set(ctx, ids,
+		[]string{
+			"formatters",
+			"pending_session",
+			"session",
+			"skip_payload_crypto_override",
+			"version_ids",
+		},
+		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
+			}
+
+			if as.skipPayloadCrypto(ctx, link, dev, dev.Session) {
+				// When skipping application payload crypto, the upstream application is responsible for recalculating the
+				// downlink queue. No error is returned here to pass the session recovered message upstream.
+				return dev, nil, nil
+			}
+
+			var discarded *ttnpb.Session
+			if len(msg.DiscardedSessionKeyId) > 0 &&
+				dev.PendingSession != nil &&
+				bytes.Equal(dev.PendingSession.Keys.SessionKeyId, msg.DiscardedSessionKeyId) {
+				discarded = dev.PendingSession
+			}
+
+			matchMask, err := as.matchSession(ctx, ids, dev, link, msg.SessionKeyId)
+			if err != nil {
+				return nil, nil, err
+			}
+			if dev.PendingSession != nil && discarded != nil {
+				dev.PendingSession = nil
+				matchMask = ttnpb.AddFields(matchMask, "pending_session")
+			}
+			mask := ttnpb.AddFields(matchMask, "session.last_a_f_cnt_down")
+			dev.Session.LastAFCntDown = msg.LastAFCntDown
+			if discarded == nil {
+				return dev, mask, nil
+			}
+
+			pc, err := peer.Conn()
+			if err != nil {
+				return nil, nil, err
+			}
+			res, err := ttnpb.NewAsNsClient(pc).DownlinkQueueList(ctx, ids, as.WithClusterAuth())
+			if err != nil {
+				return nil, nil, err
+			}
+			if stale, _ := ttnpb.PartitionDownlinksBySessionKeyIDEquality(
+				discarded.Keys.SessionKeyId, res.Downlinks...,
+			); len(stale) == 0 {
+				return dev, mask, nil
+			}
+
+			items := make([]*ttnpb.ApplicationDownlink, 0, len(res.Downlinks))
+			for _, down := range res.Downlinks {
+				if err := as.decryptDownlink(ctx, dev, down, discarded); err != nil {
+					log.FromContext(ctx).WithError(err).Warn("Failed to decrypt downlink message; drop item")
+					registerDropDownlink(ctx, ids, down, err)
+					continue
+				}
+				items = append(items, down)
+			}
+			replaceMask, err := as.attemptDownlinkQueueOp(ctx, dev, link, peer, downlinkQueueOperation{
+				Items:     items,
+				Operation: ttnpb.AsNsClient.DownlinkQueueReplace,
+				ResultFunc: func(decrypted, _ []*ttnpb.ApplicationDownlink, err error) {
+					if err != nil {
+						as.registerDropDownlinks(ctx, ids, decrypted, err)
+					}
+				},
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			return dev, ttnpb.AddFields(mask, replaceMask...), nil
+		},
+	)
+	return err
+}
+
 // handleLocationSolved saves the provided *ttnpb.ApplicationLocation in the Entity Registry as part of the device locations.
 // Locations provided by other services will be maintained.
 func (as *ApplicationServer) handleLocationSolved(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationLocation, link *ttnpb.ApplicationLink) error {
@@ -47,6 +47,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
@@ -548,6 +549,7 @@ func TestApplicationServer(t *testing.T) {
 						"location_solved",
 						"provider",
 						"service_data",
+						"session_recovered",
 						"uplink_message",
 						"uplink_normalized",
 					),
@@ -694,6 +696,7 @@ func TestApplicationServer(t *testing.T) {
 						"location_solved",
 						"provider",
 						"service_data",
+						"session_recovered",
 						"uplink_message",
 						"uplink_normalized",
 					),
@@ -814,6 +817,7 @@ func TestApplicationServer(t *testing.T) {
 						"join_accept",
 						"location_solved",
 						"service_data",
+						"session_recovered",
 						"uplink_message",
 						"uplink_normalized",
 					),
@@ -3161,6 +3165,544 @@ func TestUplinkNormalized(t *testing.T) {
 	})
 }
 
+func TestHeldDownlinks(t *testing.T) {
+	a, ctx := test.New(t)
+
+	registeredApplicationID := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+
+	// This device gets registered in the device registry of the Application Server.
+	registeredDevice := &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: registeredApplicationID,
+			DeviceId:       "foo-device",
+			JoinEui:        types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}.Bytes(),
+			DevEui:         types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}.Bytes(),
+		},
+		Session: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x11, 0x11, 0x11, 0x11}.Bytes(),
+			Keys: &ttnpb.SessionKeys{
+				SessionKeyId: []byte{0x11},
+				AppSKey: &ttnpb.KeyEnvelope{
+					EncryptedKey: bytes.Repeat([]byte{0x11}, 24),
+					KekLabel:     "unknown",
+				},
+			},
+		},
+	}
+
+	_, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+	nsConnChan := make(chan *mockNSASConn)
+	ns, nsAddr := startMockNS(ctx, nsConnChan)
+
+	devsRedisClient, devsFlush := test.NewRedis(ctx, "applicationserver_test", "devices")
+	defer devsFlush()
+	defer devsRedisClient.Close()
+	deviceRegistry := &redis.DeviceRegistry{Redis: devsRedisClient, LockTTL: test.Delay << 10}
+	if err := deviceRegistry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err := deviceRegistry.Set(ctx, registeredDevice.Ids, nil, func(ed *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		return registeredDevice, []string{"ids", "session"}, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set device in registry: %s", err)
+	}
+
+	linksRedisClient, linksFlush := test.NewRedis(ctx, "applicationserver_test", "links")
+	defer linksFlush()
+	defer linksRedisClient.Close()
+	linkRegistry := &redis.LinkRegistry{Redis: linksRedisClient, LockTTL: test.Delay << 10}
+	if err := linkRegistry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = linkRegistry.Set(ctx, registeredApplicationID, nil, func(_ *ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error) {
+		return &ttnpb.ApplicationLink{
+			SkipPayloadCrypto: &pbtypes.BoolValue{Value: true},
+			DownlinkPolicy: &ttnpb.ApplicationDownlinkPolicy{
+				DefaultTtl:      ttnpb.ProtoDurationPtr(time.Hour),
+				OrderByPriority: true,
+			},
+		}, []string{"skip_payload_crypto", "downlink_policy"}, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set link in registry: %s", err)
+	}
+
+	applicationUpsRedisClient, applicationUpsFlush := test.NewRedis(ctx, "applicationserver_test", "applicationups")
+	defer applicationUpsFlush()
+	defer applicationUpsRedisClient.Close()
+	applicationUpsRegistry := &redis.ApplicationUplinkRegistry{
+		Redis: applicationUpsRedisClient,
+		Limit: 16,
+	}
+
+	distribRedisClient, distribFlush := test.NewRedis(ctx, "applicationserver_test", "traffic")
+	defer distribFlush()
+	defer distribRedisClient.Close()
+	distribPubSub := distribredis.PubSub{Redis: distribRedisClient}
+
+	heldDownlinksRedisClient, heldDownlinksFlush := test.NewRedis(ctx, "applicationserver_test", "downlinks", "held")
+	defer heldDownlinksFlush()
+	defer heldDownlinksRedisClient.Close()
+	heldDownlinkRegistry := &redis.HeldDownlinkRegistry{Redis: heldDownlinksRedisClient, LockTTL: test.Delay << 10}
+	if err := heldDownlinkRegistry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				NetworkServer:  nsAddr,
+			},
+		},
+	})
+	config := &applicationserver.Config{
+		Devices: deviceRegistry,
+		Links:   linkRegistry,
+		UplinkStorage: applicationserver.UplinkStorageConfig{
+			Registry: applicationUpsRegistry,
+			Limit:    16,
+		},
+		Distribution: applicationserver.DistributionConfig{
+			Global: applicationserver.GlobalDistributorConfig{
+				PubSub: distribPubSub,
+			},
+		},
+		Downlinks: applicationserver.DownlinksConfig{
+			Held:             heldDownlinkRegistry,
+			DispatchInterval: Timeout / 4,
+		},
+		EndDeviceMetadataStorage: applicationserver.EndDeviceMetadataStorageConfig{
+			Location: applicationserver.EndDeviceLocationStorageConfig{
+				Registry: metadata.NewNoopEndDeviceLocationRegistry(),
+			},
+		},
+	}
+	as, err := applicationserver.New(c, config)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
+
+	sub, err := as.Subscribe(ctx, "test", nil, false)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	assertQueue := func(fCnts ...uint32) {
+		t.Helper()
+		var queue []uint32
+		ns.downlinkQueueMu.RLock()
+		for _, down := range ns.downlinkQueue[unique.ID(ctx, registeredDevice.Ids)] {
+			queue = append(queue, down.FCnt)
+		}
+		ns.downlinkQueueMu.RUnlock()
+		a.So(queue, should.Resemble, fCnts)
+	}
+	// expectUp returns the next upstream downlink message event for each of the given downlink frame counters.
+	expectUp := func(fCnts ...uint32) map[uint32]*ttnpb.ApplicationUp {
+		t.Helper()
+		ups := make(map[uint32]*ttnpb.ApplicationUp, len(fCnts))
+		for range fCnts {
+			select {
+			case msg := <-sub.Up():
+				var down *ttnpb.ApplicationDownlink
+				switch up := msg.ApplicationUp.Up.(type) {
+				case *ttnpb.ApplicationUp_DownlinkQueued:
+					down = up.DownlinkQueued
+				case *ttnpb.ApplicationUp_DownlinkFailed:
+					down = up.DownlinkFailed.Downlink
+				default:
+					t.Fatalf("Unexpected upstream message %T", up)
+				}
+				ups[down.FCnt] = msg.ApplicationUp
+			case <-time.After(4 * Timeout):
+				t.Fatal("Expected upstream message timed out")
+			}
+		}
+		a.So(ups, should.HaveLength, len(fCnts))
+		for _, fCnt := range fCnts {
+			a.So(ups, should.ContainKey, fCnt)
+		}
+		return ups
+	}
+	assertFailed := func(up *ttnpb.ApplicationUp, name string) {
+		t.Helper()
+		if a.So(up.GetDownlinkFailed(), should.NotBeNil) {
+			a.So(up.GetDownlinkFailed().Error.Namespace, should.Equal, "pkg/applicationserver")
+			a.So(up.GetDownlinkFailed().Error.Name, should.Equal, name)
+		}
+	}
+
+	notBefore := time.Now().Add(2 * Timeout)
+	err = as.DownlinkQueuePush(ctx, registeredDevice.Ids, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      1,
+			FCnt:       1,
+			FrmPayload: []byte{0x01},
+			Priority:   ttnpb.TxSchedulePriority_LOW,
+			Scheduling: &ttnpb.ApplicationDownlink_Scheduling{
+				NotBefore:        ttnpb.ProtoTimePtr(notBefore),
+				DeduplicationKey: "status",
+			},
+		},
+		{
+			FPort:      1,
+			FCnt:       2,
+			FrmPayload: []byte{0x02},
+			Priority:   ttnpb.TxSchedulePriority_HIGH,
+			Scheduling: &ttnpb.ApplicationDownlink_Scheduling{
+				NotBefore: ttnpb.ProtoTimePtr(notBefore.Add(time.Millisecond)),
+			},
+		},
+		{
+			FPort:      1,
+			FCnt:       3,
+			FrmPayload: []byte{0x03},
+		},
+		{
+			FPort:      1,
+			FCnt:       4,
+			FrmPayload: []byte{0x04},
+			Scheduling: &ttnpb.ApplicationDownlink_Scheduling{
+				ExpiresAt: ttnpb.ProtoTimePtr(time.Now().Add(-time.Second)),
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	ups := expectUp(3, 4)
+	a.So(ups[3].GetDownlinkQueued(), should.NotBeNil)
+	assertFailed(ups[4], "downlink_expired")
+	assertQueue(3)
+
+	err = as.DownlinkQueuePush(ctx, registeredDevice.Ids, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      1,
+			FCnt:       5,
+			FrmPayload: []byte{0x05},
+			Scheduling: &ttnpb.ApplicationDownlink_Scheduling{
+				NotBefore:        ttnpb.ProtoTimePtr(notBefore),
+				DeduplicationKey: "status",
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	ups = expectUp(1)
+	assertFailed(ups[1], "downlink_superseded")
+
+	queue, err := as.DownlinkQueueList(ctx, registeredDevice.Ids)
+	if a.So(err, should.BeNil) && a.So(queue, should.HaveLength, 3) {
+		a.So(queue[0].FCnt, should.Equal, 3)
+		a.So(queue[0].Scheduling, should.BeNil)
+		for _, down := range queue[1:] {
+			a.So(down.FCnt, should.BeIn, []uint32{2, 5})
+			// The default time to live of the downlink policy applies from the not before time.
+			a.So(*ttnpb.StdTime(down.Scheduling.ExpiresAt), should.Equal, ttnpb.StdTime(down.Scheduling.NotBefore).Add(time.Hour))
+		}
+	}
+
+	ups = expectUp(2, 5)
+	a.So(ups[2].GetDownlinkQueued(), should.NotBeNil)
+	a.So(ups[5].GetDownlinkQueued(), should.NotBeNil)
+	// The held downlink messages are queued in the order of their priority.
+	assertQueue(3, 2, 5)
+
+	err = as.DownlinkQueuePush(ctx, registeredDevice.Ids, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      1,
+			FCnt:       6,
+			FrmPayload: []byte{0x06},
+			Scheduling: &ttnpb.ApplicationDownlink_Scheduling{
+				NotBefore: ttnpb.ProtoTimePtr(time.Now().Add(time.Hour)),
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	err = as.DownlinkQueueReplace(ctx, registeredDevice.Ids, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      1,
+			FCnt:       7,
+			FrmPayload: []byte{0x07},
+			Scheduling: &ttnpb.ApplicationDownlink_Scheduling{
+				NotBefore: ttnpb.ProtoTimePtr(time.Now().Add(time.Hour)),
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	// The held downlink messages that are discarded by the replacement are reported as failed.
+	ups = expectUp(6)
+	assertFailed(ups[6], "downlink_replaced")
+	assertQueue()
+	queue, err = as.DownlinkQueueList(ctx, registeredDevice.Ids)
+	if a.So(err, should.BeNil) && a.So(queue, should.HaveLength, 1) {
+		a.So(queue[0].FCnt, should.Equal, 7)
+	}
+}
+
+func TestSessionRecovered(t *testing.T) {
+	a, ctx := test.New(t)
+
+	registeredApplicationID := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+
+	kek := []byte{0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f}
+	var (
+		currentDevAddr = types.DevAddr{0x11, 0x11, 0x11, 0x11}
+		currentAppSKey = types.AES128Key{0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11}
+		pendingDevAddr = types.DevAddr{0x22, 0x22, 0x22, 0x22}
+		pendingAppSKey = types.AES128Key{0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22, 0x22}
+		currentSession = []byte{0x11}
+		pendingSession = []byte{0x22}
+		wrapAppSKey    = func(key types.AES128Key) *ttnpb.KeyEnvelope {
+			return &ttnpb.KeyEnvelope{
+				EncryptedKey: test.Must(crypto.WrapKey(key[:], kek)).([]byte),
+				KekLabel:     "test",
+			}
+		}
+	)
+
+	// This device gets registered in the device registry of the Application Server.
+	// It has a pending session which the Network Server discards after the device keeps using the current session.
+	registeredDevice := &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: registeredApplicationID,
+			DeviceId:       "foo-device",
+			JoinEui:        types.EUI64{0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}.Bytes(),
+			DevEui:         types.EUI64{0x42, 0x42, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}.Bytes(),
+		},
+		Session: &ttnpb.Session{
+			DevAddr: currentDevAddr.Bytes(),
+			Keys: &ttnpb.SessionKeys{
+				SessionKeyId: currentSession,
+				AppSKey:      wrapAppSKey(currentAppSKey),
+			},
+			LastAFCntDown: 4,
+		},
+		PendingSession: &ttnpb.Session{
+			DevAddr: pendingDevAddr.Bytes(),
+			Keys: &ttnpb.SessionKeys{
+				SessionKeyId: pendingSession,
+				AppSKey:      wrapAppSKey(pendingAppSKey),
+			},
+			LastAFCntDown: 1,
+		},
+	}
+
+	_, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+	nsConnChan := make(chan *mockNSASConn)
+	ns, nsAddr := startMockNS(ctx, nsConnChan)
+
+	devsRedisClient, devsFlush := test.NewRedis(ctx, "applicationserver_test", "devices")
+	defer devsFlush()
+	defer devsRedisClient.Close()
+	deviceRegistry := &redis.DeviceRegistry{Redis: devsRedisClient, LockTTL: test.Delay << 10}
+	if err := deviceRegistry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err := deviceRegistry.Set(ctx, registeredDevice.Ids, nil, func(ed *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		return registeredDevice, []string{"ids", "session", "pending_session"}, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set device in registry: %s", err)
+	}
+
+	linksRedisClient, linksFlush := test.NewRedis(ctx, "applicationserver_test", "links")
+	defer linksFlush()
+	defer linksRedisClient.Close()
+	linkRegistry := &redis.LinkRegistry{Redis: linksRedisClient, LockTTL: test.Delay << 10}
+	if err := linkRegistry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	applicationUpsRedisClient, applicationUpsFlush := test.NewRedis(ctx, "applicationserver_test", "applicationups")
+	defer applicationUpsFlush()
+	defer applicationUpsRedisClient.Close()
+	applicationUpsRegistry := &redis.ApplicationUplinkRegistry{
+		Redis: applicationUpsRedisClient,
+		Limit: 16,
+	}
+
+	distribRedisClient, distribFlush := test.NewRedis(ctx, "applicationserver_test", "traffic")
+	defer distribFlush()
+	defer distribRedisClient.Close()
+	distribPubSub := distribredis.PubSub{Redis: distribRedisClient}
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+				NetworkServer:  nsAddr,
+			},
+			KeyVault: config.KeyVault{
+				Provider: "static",
+				Static: map[string][]byte{
+					"test": kek,
+				},
+			},
+		},
+	})
+	config := &applicationserver.Config{
+		Devices: deviceRegistry,
+		Links:   linkRegistry,
+		UplinkStorage: applicationserver.UplinkStorageConfig{
+			Registry: applicationUpsRegistry,
+			Limit:    16,
+		},
+		Distribution: applicationserver.DistributionConfig{
+			Global: applicationserver.GlobalDistributorConfig{
+				PubSub: distribPubSub,
+			},
+		},
+		EndDeviceMetadataStorage: applicationserver.EndDeviceMetadataStorageConfig{
+			Location: applicationserver.EndDeviceLocationStorageConfig{
+				Registry: metadata.NewNoopEndDeviceLocationRegistry(),
+			},
+		},
+	}
+	as, err := applicationserver.New(c, config)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	select {
+	case <-ctx.Done():
+		return
+	case nsConnChan <- &mockNSASConn{
+		cc:   as.LoopbackConn(),
+		auth: as.WithClusterAuth(),
+	}:
+	}
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
+
+	sub, err := as.Subscribe(ctx, "test", nil, false)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The downlink queue in the Network Server is encrypted with the pending session.
+	ns.downlinkQueueMu.Lock()
+	ns.downlinkQueue[unique.ID(ctx, registeredDevice.Ids)] = []*ttnpb.ApplicationDownlink{
+		{
+			SessionKeyId: pendingSession,
+			FPort:        1,
+			FCnt:         2,
+			FrmPayload:   test.Must(crypto.EncryptDownlink(pendingAppSKey, pendingDevAddr, 2, []byte{0x01, 0x02})).([]byte),
+		},
+	}
+	ns.downlinkQueueMu.Unlock()
+
+	for _, tc := range []struct {
+		Name         string
+		Message      *ttnpb.ApplicationSessionRecovered
+		AssertDevice func(t *testing.T, dev *ttnpb.EndDevice, nsQueue, queue []*ttnpb.ApplicationDownlink)
+	}{
+		{
+			Name: "FCntReset",
+			Message: &ttnpb.ApplicationSessionRecovered{
+				SessionKeyId:  currentSession,
+				FCntReset:     true,
+				LastFCntUp:    1,
+				LastAFCntDown: 6,
+			},
+			AssertDevice: func(t *testing.T, dev *ttnpb.EndDevice, nsQueue, queue []*ttnpb.ApplicationDownlink) {
+				a := assertions.New(t)
+				a.So(dev.Session.Keys.SessionKeyId, should.Resemble, currentSession)
+				a.So(dev.Session.LastAFCntDown, should.Equal, 6)
+				// The pending session is not discarded, so the queue is left untouched.
+				a.So(dev.PendingSession, should.NotBeNil)
+				if a.So(nsQueue, should.HaveLength, 1) {
+					a.So(nsQueue[0].SessionKeyId, should.Resemble, pendingSession)
+					a.So(nsQueue[0].FCnt, should.Equal, 2)
+				}
+			},
+		},
+		{
+			Name: "DiscardedPendingSession",
+			Message: &ttnpb.ApplicationSessionRecovered{
+				SessionKeyId:          currentSession,
+				DiscardedSessionKeyId: pendingSession,
+				LastFCntUp:            2,
+				LastAFCntDown:         8,
+			},
+			AssertDevice: func(t *testing.T, dev *ttnpb.EndDevice, nsQueue, queue []*ttnpb.ApplicationDownlink) {
+				a := assertions.New(t)
+				a.So(dev.Session.Keys.SessionKeyId, should.Resemble, currentSession)
+				a.So(dev.PendingSession, should.BeNil)
+				// The downlink queue is encrypted with the current session, continuing from the recovered frame counter.
+				a.So(dev.Session.LastAFCntDown, should.Equal, 9)
+				if a.So(nsQueue, should.HaveLength, 1) {
+					a.So(nsQueue[0].SessionKeyId, should.Resemble, currentSession)
+					a.So(nsQueue[0].FCnt, should.Equal, 9)
+					a.So(nsQueue[0].FrmPayload, should.Resemble,
+						test.Must(crypto.EncryptDownlink(currentAppSKey, currentDevAddr, 9, []byte{0x01, 0x02})).([]byte),
+					)
+				}
+				if a.So(queue, should.HaveLength, 1) {
+					a.So(queue[0].FPort, should.Equal, 1)
+					a.So(queue[0].FrmPayload, should.Resemble, []byte{0x01, 0x02})
+				}
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			up := &ttnpb.ApplicationUp{
+				EndDeviceIds: withDevAddr(registeredDevice.Ids, currentDevAddr),
+				Up: &ttnpb.ApplicationUp_SessionRecovered{
+					SessionRecovered: tc.Message,
+				},
+			}
+			ns.upCh <- up
+			select {
+			case msg := <-sub.Up():
+				a.So(msg.ApplicationUp.GetSessionRecovered(), should.Resemble, tc.Message)
+				a.So(msg.ApplicationUp.EndDeviceIds, should.Resemble, up.EndDeviceIds)
+			case <-time.After(Timeout):
+				t.Fatal("Expected upstream session recovered message timed out")
+			}
+			dev, err := deviceRegistry.Get(ctx, registeredDevice.Ids, []string{"session", "pending_session"})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			ns.downlinkQueueMu.RLock()
+			nsQueue := ns.downlinkQueue[unique.ID(ctx, registeredDevice.Ids)]
+			ns.downlinkQueueMu.RUnlock()
+			queue, err := as.DownlinkQueueList(ctx, registeredDevice.Ids)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			tc.AssertDevice(t, dev, nsQueue, queue)
+		})
+	}
+}
+
 func TestApplicationServerCleanup(t *testing.T) {
 	a, ctx := test.New(t)
 
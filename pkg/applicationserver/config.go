@@ -20,7 +20,9 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqttbridge"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	localgeolocationv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/localgls/v1"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
 	loracloudgeolocationv3 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loragls/v3"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
@@ -30,6 +32,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -88,7 +91,18 @@ type EndDeviceLocationStorageCacheConfig struct {
 
 // FormattersConfig represents the configuration for payload formatters.
 type FormattersConfig struct {
-	MaxParameterLength int `name:"max-parameter-length" description:"Maximum allowed size for length of formatter parameters (payload formatter scripts)"`
+	MaxParameterLength     int                `name:"max-parameter-length" description:"Maximum allowed size for length of formatter parameters (payload formatter scripts)"`
+	MaxWASMParameterLength int                `name:"max-wasm-parameter-length" description:"Maximum allowed size for length of WebAssembly formatter parameters (base64 encoded modules)"`
+	GRPCService            grpcservice.Config `name:"grpc-service" description:"gRPC service payload formatter configuration"`
+}
+
+// maxParameterLength returns the maximum allowed size for length of the parameter of the given formatter.
+// WebAssembly modules are binaries that are typically larger than scripts, and have a separate limit.
+func (c FormattersConfig) maxParameterLength(formatter ttnpb.PayloadFormatter) int {
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_WASM {
+		return c.MaxWASMParameterLength
+	}
+	return c.MaxParameterLength
 }
 
 // Config represents the ApplicationServer configuration.
@@ -97,11 +111,13 @@ type Config struct {
 	Devices                  DeviceRegistry                 `name:"-"`
 	Links                    LinkRegistry                   `name:"-"`
 	UplinkStorage            UplinkStorageConfig            `name:"uplink-storage" description:"Application uplinks storage configuration"`
+	Downlinks                DownlinksConfig                `name:"downlinks" description:"Downlink messages configuration"`
 	Formatters               FormattersConfig               `name:"formatters" description:"Payload formatters configuration"`
 	Distribution             DistributionConfig             `name:"distribution" description:"Distribution configuration"`
 	EndDeviceFetcher         EndDeviceFetcherConfig         `name:"fetcher" description:"Deprecated - End Device fetcher configuration"`
 	EndDeviceMetadataStorage EndDeviceMetadataStorageConfig `name:"end-device-metadata-storage" description:"End device metadata storage configuration"`
 	MQTT                     config.MQTT                    `name:"mqtt" description:"MQTT configuration"`
+	MQTTBridge               mqttbridge.Config              `name:"mqtt-bridge" description:"MQTT v5 bridge configuration"`
 	Webhooks                 WebhooksConfig                 `name:"webhooks" description:"Webhooks configuration"`
 	PubSub                   PubSubConfig                   `name:"pubsub" description:"Pub/sub messaging configuration"`
 	Packages                 ApplicationPackagesConfig      `name:"packages" description:"Application packages configuration"`
@@ -128,6 +144,13 @@ type UplinkStorageConfig struct {
 	Limit    int64                     `name:"limit" description:"Number of application uplinks to be stored"`
 }
 
+// DownlinksConfig defines the configuration of the downlink messages that are held by the Application Server.
+type DownlinksConfig struct {
+	Held             HeldDownlinkRegistry `name:"-"`
+	Limit            int64                `name:"limit" description:"Number of downlink messages that can be held per end device"`
+	DispatchInterval time.Duration        `name:"dispatch-interval" description:"Interval at which held downlink messages are dispatched"`
+}
+
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
 	Registry                   web.WebhookRegistry `name:"-"`
@@ -150,9 +173,10 @@ func (c WebhooksConfig) toProto() *ttnpb.AsConfiguration_Webhooks {
 
 // DistributionConfig contains the upstream traffic distribution configuration of the Application Server.
 type DistributionConfig struct {
-	Timeout time.Duration           `name:"timeout" description:"Wait timeout of an empty subscription set"`
-	Local   LocalDistributorConfig  `name:"local" description:"Local distributor configuration"`
-	Global  GlobalDistributorConfig `name:"global" description:"Global distributor configuration"`
+	Timeout time.Duration            `name:"timeout" description:"Wait timeout of an empty subscription set"`
+	Local   LocalDistributorConfig   `name:"local" description:"Local distributor configuration"`
+	Global  GlobalDistributorConfig  `name:"global" description:"Global distributor configuration"`
+	Durable DurableDistributorConfig `name:"durable" description:"Durable distributor configuration"`
 }
 
 // DistributorConfig contains the configuration of a traffic distributor of the Application Server.
@@ -187,6 +211,13 @@ type GlobalDistributorConfig struct {
 	Individual DistributorConfig   `name:"individual" description:"Individual distributor configuration"`
 }
 
+// DurableDistributorConfig contains the configuration of the durable traffic distributor of the Application Server.
+type DurableDistributorConfig struct {
+	Stream    distribution.Stream `name:"-"`
+	Retention time.Duration       `name:"retention" description:"Duration for which upstream messages are retained for durable consumers"`
+	MaxLength int64               `name:"max-length" description:"Approximate number of upstream messages retained per application for durable consumers"`
+}
+
 // PubSubConfig contains go-cloud pub/sub configuration of the Application Server.
 type PubSubConfig struct {
 	Registry pubsub.Registry `name:"-"`
@@ -285,6 +316,9 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	// Initialize LoRa Cloud Geolocation v3 package handler
 	handlers[loracloudgeolocationv3.PackageName] = loracloudgeolocationv3.New(server, c.Registry)
 
+	// Initialize local geolocation v1 package handler
+	handlers[localgeolocationv1.PackageName] = localgeolocationv1.New(server, c.Registry)
+
 	return packages.New(ctx, server, c.Registry, handlers, c.Workers, c.Timeout)
 }
 
@@ -37,6 +37,26 @@ type Distributor interface {
 	Subscribe(context.Context, string, *ttnpb.ApplicationIdentifiers) (*io.Subscription, error)
 }
 
+// Stream buffers upstream traffic for durable consumers.
+// Messages are delivered at least once: a message is delivered again when the consumer subscribes again,
+// until the consumer acknowledges the message.
+type Stream interface {
+	// Publish appends the traffic to the stream of the application.
+	Publish(context.Context, *ttnpb.ApplicationUp) error
+	// Subscribe to the traffic of a specific application as the given consumer.
+	// Delivery resumes after the cursor, if not empty. The handler is called with the message identifier.
+	Subscribe(
+		ctx context.Context,
+		ids *ttnpb.ApplicationIdentifiers,
+		consumer, cursor string,
+		handler func(context.Context, string, *ttnpb.ApplicationUp) error,
+	) error
+	// Ack acknowledges the messages delivered to the consumer.
+	Ack(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, consumer string, messageIDs ...string) error
+	// DeleteConsumer deletes the consumer. Messages are no longer buffered for the consumer.
+	DeleteConsumer(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, consumer string) error
+}
+
 // RequestDecoupler decouples the security information found in a context
 // from the lifetime of the context.
 type RequestDecoupler interface {
@@ -0,0 +1,278 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	payloadKey = "payload"
+
+	streamReadCount = 64
+	streamBlock     = time.Second
+)
+
+var (
+	errInvalidPayload   = errors.DefineCorruption("invalid_payload", "invalid payload")
+	errMissingPayload   = errors.DefineDataLoss("missing_payload", "missing payload")
+	errConsumerNotFound = errors.DefineNotFound("consumer_not_found", "durable consumer `{consumer}` not found")
+)
+
+// Stream is a Redis-based durable upstream traffic stream.
+// Traffic is only buffered for applications which have at least one durable consumer.
+type Stream struct {
+	Redis *ttnredis.Client
+	// Retention is the duration for which messages are retained. Zero means that messages do not expire.
+	Retention time.Duration
+	// MaxLen is the approximate maximum number of messages retained per application. Zero means no limit.
+	MaxLen int64
+}
+
+func (s Stream) uidStreamKey(uid string) string {
+	return s.Redis.Key("uid", uid, "stream")
+}
+
+// publishScript appends the message to the stream and trims the stream, only if the stream has consumer groups.
+// KEYS[1] is the stream key. ARGV[1] is the approximate maximum length of the stream, or 0 for no limit.
+// ARGV[2] is the approximate minimum message identifier, or empty for no limit. ARGV[3] is the payload.
+// It returns 1 if the message is appended and 0 otherwise.
+var publishScript = redis.NewScript(`local groups = redis.pcall('xinfo', 'groups', KEYS[1])
+if groups['err'] or #groups == 0 then
+	return 0
+end
+if ARGV[1] == '0' then
+	redis.call('xadd', KEYS[1], '*', '` + payloadKey + `', ARGV[3])
+else
+	redis.call('xadd', KEYS[1], 'maxlen', '~', ARGV[1], '*', '` + payloadKey + `', ARGV[3])
+end
+if ARGV[2] ~= '' then
+	redis.call('xtrim', KEYS[1], 'minid', '~', ARGV[2])
+end
+return 1`)
+
+// Publish appends the uplink to the stream of the application, if the application has durable consumers.
+func (s Stream) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	msg, err := ttnredis.MarshalProto(up)
+	if err != nil {
+		return err
+	}
+	var minMessageID string
+	if s.Retention > 0 {
+		minMessageID = minID(time.Now().Add(-s.Retention))
+	}
+	k := s.uidStreamKey(unique.ID(ctx, up.EndDeviceIds.ApplicationIds))
+	if err := publishScript.Run(ctx, s.Redis, []string{k}, s.MaxLen, minMessageID, msg).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// minID returns the smallest stream message identifier which may be generated at t.
+func minID(t time.Time) string {
+	return fmt.Sprintf("%d-0", t.UnixMilli())
+}
+
+// initGroup creates the consumer group of the consumer, or moves it to the cursor if it already exists.
+func (s Stream) initGroup(ctx context.Context, k, consumer, cursor string) error {
+	start := cursor
+	if start == "" {
+		start = "$"
+	}
+	err := s.Redis.XGroupCreateMkStream(ctx, k, consumer, start).Err()
+	switch {
+	case err == nil:
+		return nil
+	case ttnredis.IsConsumerGroupExistsErr(err):
+		if cursor == "" {
+			return nil
+		}
+		return ttnredis.ConvertError(s.Redis.XGroupSetID(ctx, k, consumer, cursor).Err())
+	default:
+		return ttnredis.ConvertError(err)
+	}
+}
+
+func (s Stream) read(ctx context.Context, k, consumer, start string, block time.Duration) ([]redis.XMessage, error) {
+	rets, err := s.Redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    consumer,
+		Consumer: consumer,
+		Streams:  []string{k, start},
+		Count:    streamReadCount,
+		Block:    block,
+	}).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(rets) == 0 {
+		return nil, nil
+	}
+	return rets[0].Messages, nil
+}
+
+// handle calls the handler for the message. Messages which have been trimmed from the stream while pending
+// are acknowledged without calling the handler.
+func (s Stream) handle(
+	ctx context.Context,
+	k, consumer string,
+	msg redis.XMessage,
+	handler func(context.Context, string, *ttnpb.ApplicationUp) error,
+) error {
+	if msg.Values == nil {
+		return ttnredis.ConvertError(s.Redis.XAck(ctx, k, consumer, msg.ID).Err())
+	}
+	v, ok := msg.Values[payloadKey]
+	if !ok {
+		return errMissingPayload.New()
+	}
+	str, ok := v.(string)
+	if !ok {
+		return errInvalidPayload.New()
+	}
+	up := &ttnpb.ApplicationUp{}
+	if err := ttnredis.UnmarshalProto(str, up); err != nil {
+		return err
+	}
+	return handler(ctx, msg.ID, up)
+}
+
+// ackPending acknowledges all messages that have been delivered to the consumer, but that have not been acknowledged.
+func (s Stream) ackPending(ctx context.Context, k, consumer string) error {
+	for {
+		pending, err := s.Redis.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: k,
+			Group:  consumer,
+			Start:  "-",
+			End:    "+",
+			Count:  streamReadCount,
+		}).Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		ids := make([]string, 0, len(pending))
+		for _, p := range pending {
+			ids = append(ids, p.ID)
+		}
+		if err := s.Redis.XAck(ctx, k, consumer, ids...).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+	}
+}
+
+// Subscribe subscribes to the traffic of the provided application as the durable consumer and processes it using
+// the handler. Messages that have been delivered to the consumer before, but that have not been acknowledged,
+// are delivered first. If the cursor is set, delivery resumes after the cursor instead: the messages up to the
+// cursor are acknowledged, and the messages after the cursor are delivered again.
+func (s Stream) Subscribe(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	consumer, cursor string,
+	handler func(context.Context, string, *ttnpb.ApplicationUp) error,
+) error {
+	k := s.uidStreamKey(unique.ID(ctx, ids))
+	if err := s.initGroup(ctx, k, consumer, cursor); err != nil {
+		return err
+	}
+
+	// The consumer group is moved to the cursor, so the pending messages after the cursor are delivered as new
+	// messages. Replaying them from the pending entries would deliver them twice.
+	if cursor != "" {
+		if err := s.ackPending(ctx, k, consumer); err != nil {
+			return err
+		}
+	}
+	for start := "0"; cursor == ""; {
+		msgs, err := s.read(ctx, k, consumer, start, -1) // do not block
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			break
+		}
+		for _, msg := range msgs {
+			if err := s.handle(ctx, k, consumer, msg, handler); err != nil {
+				return err
+			}
+			start = msg.ID
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		// XREADGROUP does not respect the asynchronous context cancelation, so the call
+		// blocks for a limited time only, after which the context is checked again.
+		msgs, err := s.read(ctx, k, consumer, ">", streamBlock)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		for _, msg := range msgs {
+			if err := s.handle(ctx, k, consumer, msg, handler); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Ack acknowledges the messages delivered to the durable consumer.
+func (s Stream) Ack(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, consumer string, messageIDs ...string) error {
+	k := s.uidStreamKey(unique.ID(ctx, ids))
+	return ttnredis.ConvertError(s.Redis.XAck(ctx, k, consumer, messageIDs...).Err())
+}
+
+// deleteConsumerScript destroys the consumer group, and deletes the stream if it has no consumer groups left.
+// KEYS[1] is the stream key. ARGV[1] is the consumer group.
+// It returns the number of destroyed consumer groups.
+var deleteConsumerScript = redis.NewScript(`if redis.call('exists', KEYS[1]) == 0 then
+	return 0
+end
+local n = redis.call('xgroup', 'destroy', KEYS[1], ARGV[1])
+if #redis.call('xinfo', 'groups', KEYS[1]) == 0 then
+	redis.call('del', KEYS[1])
+end
+return n`)
+
+// DeleteConsumer deletes the durable consumer. Messages are no longer buffered for the consumer.
+func (s Stream) DeleteConsumer(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, consumer string) error {
+	k := s.uidStreamKey(unique.ID(ctx, ids))
+	n, err := deleteConsumerScript.Run(ctx, s.Redis, []string{k}, consumer).Int64()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if n == 0 {
+		return errConsumerNotFound.WithAttributes("consumer", consumer)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 10) * test.Delay
+
+type streamMessage struct {
+	id string
+	up *ttnpb.ApplicationUp
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "distribution_redis_test")
+	defer flush()
+	stream := &Stream{
+		Redis:     cl,
+		Retention: time.Hour,
+		MaxLen:    100,
+	}
+
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	newUp := func(fCnt uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: ids,
+				DeviceId:       "test-dev",
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FCnt: fCnt,
+				},
+			},
+		}
+	}
+
+	msgCh := make(chan streamMessage, 10)
+	subscribe := func(cursor string) (context.CancelFunc, <-chan error) {
+		ctx, cancel := context.WithCancel(ctx)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- stream.Subscribe(ctx, ids, "test-consumer", cursor,
+				func(_ context.Context, id string, up *ttnpb.ApplicationUp) error {
+					msgCh <- streamMessage{id, up}
+					return nil
+				},
+			)
+		}()
+		// Wait for the consumer group to be created.
+		k := cl.Key("uid", unique.ID(ctx, ids), "stream")
+		for start := time.Now(); ; time.Sleep(test.Delay) {
+			if groups, err := cl.XInfoGroups(ctx, k).Result(); err == nil && len(groups) > 0 {
+				break
+			}
+			if time.Since(start) > timeout {
+				t.Fatal("Timed out waiting for consumer group")
+			}
+		}
+		return cancel, errCh
+	}
+	unsubscribe := func(cancel context.CancelFunc, errCh <-chan error) {
+		cancel()
+		select {
+		case err := <-errCh:
+			a.So(err, should.Equal, context.Canceled)
+		case <-time.After(timeout):
+			t.Fatal("Timed out waiting for subscription to end")
+		}
+	}
+	expectMessage := func(fCnt uint32) string {
+		select {
+		case msg := <-msgCh:
+			a.So(msg.up, should.Resemble, newUp(fCnt))
+			return msg.id
+		case <-time.After(timeout):
+			t.Fatalf("Timed out waiting for message with FCnt %d", fCnt)
+			return ""
+		}
+	}
+	expectNoMessage := func() {
+		select {
+		case msg := <-msgCh:
+			t.Fatalf("Received unexpected message with FCnt %d", msg.up.GetUplinkMessage().GetFCnt())
+		case <-time.After(test.Delay):
+		}
+	}
+
+	// Traffic is not buffered without durable consumers.
+	a.So(stream.Publish(ctx, newUp(1)), should.BeNil)
+
+	cancel, errCh := subscribe("")
+	a.So(stream.Publish(ctx, newUp(2)), should.BeNil)
+	id2 := expectMessage(2)
+	expectNoMessage()
+	unsubscribe(cancel, errCh)
+
+	// Traffic is buffered while the consumer is not subscribed.
+	a.So(stream.Publish(ctx, newUp(3)), should.BeNil)
+
+	// Messages which are not acknowledged are delivered again.
+	cancel, errCh = subscribe("")
+	a.So(expectMessage(2), should.Equal, id2)
+	id3 := expectMessage(3)
+	expectNoMessage()
+	a.So(stream.Ack(ctx, ids, "test-consumer", id2, id3), should.BeNil)
+	unsubscribe(cancel, errCh)
+
+	// Acknowledged messages are not delivered again.
+	cancel, errCh = subscribe("")
+	expectNoMessage()
+	a.So(stream.Publish(ctx, newUp(4)), should.BeNil)
+	id4 := expectMessage(4)
+	a.So(stream.Ack(ctx, ids, "test-consumer", id4), should.BeNil)
+	unsubscribe(cancel, errCh)
+
+	// Delivery resumes after the cursor.
+	cancel, errCh = subscribe(id2)
+	a.So(expectMessage(3), should.Equal, id3)
+	a.So(expectMessage(4), should.Equal, id4)
+	expectNoMessage()
+	unsubscribe(cancel, errCh)
+
+	// Pending messages are not delivered again when resuming after the cursor. The pending messages up to the cursor
+	// are acknowledged, and the pending messages after the cursor are delivered once.
+	cancel, errCh = subscribe(id3)
+	a.So(expectMessage(4), should.Equal, id4)
+	expectNoMessage()
+	unsubscribe(cancel, errCh)
+	pending, err := cl.XPending(ctx, cl.Key("uid", unique.ID(ctx, ids), "stream"), "test-consumer").Result()
+	if a.So(err, should.BeNil) {
+		a.So(pending.Count, should.Equal, 1)
+		a.So(pending.Lower, should.Equal, id4)
+	}
+	a.So(stream.Ack(ctx, ids, "test-consumer", id4), should.BeNil)
+
+	// Traffic is no longer buffered after the consumer is deleted.
+	a.So(stream.DeleteConsumer(ctx, ids, "test-consumer"), should.BeNil)
+	a.So(errors.IsNotFound(stream.DeleteConsumer(ctx, ids, "test-consumer")), should.BeTrue)
+	a.So(stream.Publish(ctx, newUp(5)), should.BeNil)
+	n, err := cl.Exists(ctx, cl.Key("uid", unique.ID(ctx, ids), "stream")).Result()
+	if a.So(err, should.BeNil) {
+		a.So(n, should.Equal, 0)
+	}
+}
+
+func TestStreamWithoutConsumerGroups(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "distribution_redis_test")
+	defer flush()
+	stream := &Stream{
+		Redis:     cl,
+		Retention: time.Hour,
+		MaxLen:    100,
+	}
+
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	k := cl.Key("uid", unique.ID(ctx, ids), "stream")
+
+	// The stream exists, but it has no consumer groups.
+	if !a.So(cl.XGroupCreateMkStream(ctx, k, "test-consumer", "$").Err(), should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(cl.XGroupDestroy(ctx, k, "test-consumer").Err(), should.BeNil) {
+		t.FailNow()
+	}
+
+	a.So(stream.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: ids,
+			DeviceId:       "test-dev",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{},
+		},
+	}), should.BeNil)
+	n, err := cl.XLen(ctx, k).Result()
+	if a.So(err, should.BeNil) {
+		a.So(n, should.Equal, 0)
+	}
+}
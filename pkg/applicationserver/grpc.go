@@ -17,12 +17,17 @@ package applicationserver
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	pbtypes "github.com/gogo/protobuf/types"
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/warning"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -69,26 +74,52 @@ func (as *ApplicationServer) GetLink(ctx context.Context, req *ttnpb.GetApplicat
 
 // SetLink implements ttnpb.AsServer.
 func (as *ApplicationServer) SetLink(ctx context.Context, req *ttnpb.SetApplicationLinkRequest) (*ttnpb.ApplicationLink, error) {
-	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "default_formatters.up_formatter_parameter") {
-		if size := len(req.Link.GetDefaultFormatters().GetUpFormatterParameter()); size > as.config.Formatters.MaxParameterLength {
-			return nil, errInvalidFieldValue.WithAttributes("field", "default_formatters.up_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", as.config.Formatters.MaxParameterLength),
-			)
-		}
-	}
-	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "default_formatters.down_formatter_parameter") {
-		if size := len(req.Link.GetDefaultFormatters().GetDownFormatterParameter()); size > as.config.Formatters.MaxParameterLength {
-			return nil, errInvalidFieldValue.WithAttributes("field", "default_formatters.down_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", as.config.Formatters.MaxParameterLength),
-			)
-		}
-	}
 	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
 		return nil, err
 	}
 	req.FieldMask = removeDeprecatedPaths(ctx, req.FieldMask)
+
+	// The test vectors are run before the transaction, as running the formatters may take long. The transaction fails
+	// if the stored formatters are modified in the meantime.
+	checkFormatters := !req.SkipFormatterTestVectors &&
+		updatesTestVectors(req.FieldMask.GetPaths(), "default_formatters", req.Link.GetDefaultFormatters())
+	var checked *ttnpb.ApplicationLink
+	if checkFormatters {
+		link, err := as.linkRegistry.Get(ctx, req.ApplicationIds, []string{"default_formatters"})
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		checked = link
+		if err := as.config.Formatters.checkParameterLengths(
+			"default_formatters", req.FieldMask.GetPaths(),
+			req.Link.GetDefaultFormatters(), link.GetDefaultFormatters(),
+		); err != nil {
+			return nil, err
+		}
+		updated := &ttnpb.ApplicationLink{}
+		if link != nil {
+			updated = ttnpb.Clone(link)
+		}
+		if err := updated.SetFields(req.Link, req.FieldMask.GetPaths()...); err != nil {
+			return nil, err
+		}
+		if err := as.checkFormatterTestVectors(
+			ctx, &ttnpb.EndDeviceIdentifiers{ApplicationIds: req.ApplicationIds}, nil, updated.DefaultFormatters,
+		); err != nil {
+			return nil, err
+		}
+	}
 	return as.linkRegistry.Set(ctx, req.ApplicationIds, ttnpb.ApplicationLinkFieldPathsTopLevel,
-		func(*ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error) {
+		func(link *ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error) {
+			if checkFormatters && !proto.Equal(link.GetDefaultFormatters(), checked.GetDefaultFormatters()) {
+				return nil, nil, errFormattersModified.New()
+			}
+			if err := as.config.Formatters.checkParameterLengths(
+				"default_formatters", req.FieldMask.GetPaths(),
+				req.Link.GetDefaultFormatters(), link.GetDefaultFormatters(),
+			); err != nil {
+				return nil, nil, err
+			}
 			return req.Link, req.FieldMask.GetPaths(), nil
 		},
 	)
@@ -124,6 +155,20 @@ func (as *ApplicationServer) GetConfiguration(ctx context.Context, _ *ttnpb.GetA
 	}, nil
 }
 
+// GetNormalizedPayloadSchema implements ttnpb.AsServer.
+func (*ApplicationServer) GetNormalizedPayloadSchema(
+	context.Context, *ttnpb.GetNormalizedPayloadSchemaRequest,
+) (*ttnpb.GetNormalizedPayloadSchemaResponse, error) {
+	schema, err := gogoproto.Struct(normalizedpayload.JSONSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GetNormalizedPayloadSchemaResponse{
+		Version: normalizedpayload.SchemaVersion,
+		Schema:  schema,
+	}, nil
+}
+
 // HandleUplink implements ttnpb.NsAsServer.
 func (as *ApplicationServer) HandleUplink(ctx context.Context, req *ttnpb.NsAsHandleUplinkRequest) (*pbtypes.Empty, error) {
 	now := time.Now()
@@ -145,3 +190,78 @@ func (as *ApplicationServer) HandleUplink(ctx context.Context, req *ttnpb.NsAsHa
 	}
 	return ttnpb.Empty, nil
 }
+
+// updatesTestVectors returns whether the paths update the payload formatters with the given prefix, in a way that
+// requires running the test vectors. This is not the case if the test vectors are cleared.
+func updatesTestVectors(paths []string, prefix string, formatters *ttnpb.MessagePayloadFormatters) bool {
+	if !ttnpb.HasAnyField(paths, ttnpb.FieldsWithPrefix(prefix, ttnpb.MessagePayloadFormattersFieldPathsTopLevel...)...) {
+		return false
+	}
+	return !ttnpb.HasAnyField(paths, prefix+".test_vectors") || len(formatters.GetTestVectors()) > 0
+}
+
+var (
+	errFormatterTestVectors = errors.DefineFailedPrecondition(
+		"formatter_test_vectors", "payload formatter test vectors `{names}` failed",
+	)
+	errFormattersModified = errors.DefineAborted(
+		"formatters_modified", "payload formatters modified while running test vectors",
+	)
+)
+
+// checkFormatterTestVectors runs the test vectors of the formatters and returns an error if any of them failed.
+func (as *ApplicationServer) checkFormatterTestVectors(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	formatters *ttnpb.MessagePayloadFormatters,
+) error {
+	results := messageprocessors.RunTestVectors(ctx, as.formatters, ids, version, formatters)
+	if names := messageprocessors.FailedTestVectors(results); len(names) > 0 {
+		return errFormatterTestVectors.WithAttributes("names", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// TestFormatters implements ttnpb.AsServer.
+func (as *ApplicationServer) TestFormatters(
+	ctx context.Context, req *ttnpb.TestFormattersRequest,
+) (*ttnpb.TestFormattersResponse, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	var (
+		ids = &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: req.ApplicationIds,
+			DeviceId:       req.DeviceId,
+		}
+		version    *ttnpb.EndDeviceVersionIdentifiers
+		formatters = req.Formatters
+	)
+	if req.DeviceId != "" {
+		if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+			return nil, err
+		}
+		dev, err := as.deviceRegistry.Get(ctx, ids, []string{"formatters", "ids", "version_ids"})
+		if err != nil {
+			return nil, err
+		}
+		ids, version = dev.Ids, dev.VersionIds
+		if formatters == nil {
+			formatters = dev.Formatters
+		}
+	}
+	if formatters == nil {
+		if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+			return nil, err
+		}
+		link, err := as.getLink(ctx, req.ApplicationIds, []string{"default_formatters"})
+		if err != nil {
+			return nil, err
+		}
+		formatters = link.DefaultFormatters
+	}
+	return &ttnpb.TestFormattersResponse{
+		Results: messageprocessors.RunTestVectors(ctx, as.formatters, ids, version, formatters),
+	}, nil
+}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
@@ -171,6 +172,38 @@ var (
 	errFormatterScriptTooLarge = errors.DefineInvalidArgument("formatter_script_too_large", "formatter script size exceeds maximum allowed size", "size", "max_size")
 )
 
+// checkParameterLengths checks the size of the formatter parameters in the field mask against the maximum size for
+// their formatter. The formatter is the requested formatter if it is in the field mask, or the stored formatter
+// otherwise. The formatters are in the field with the given name.
+func (c FormattersConfig) checkParameterLengths(
+	name string, paths []string, requested, stored *ttnpb.MessagePayloadFormatters,
+) error {
+	for _, p := range []struct {
+		field     string
+		formatter func(*ttnpb.MessagePayloadFormatters) ttnpb.PayloadFormatter
+		parameter string
+	}{
+		{"up_formatter", (*ttnpb.MessagePayloadFormatters).GetUpFormatter, requested.GetUpFormatterParameter()},
+		{"down_formatter", (*ttnpb.MessagePayloadFormatters).GetDownFormatter, requested.GetDownFormatterParameter()},
+	} {
+		parameterPath := name + "." + p.field + "_parameter"
+		if !ttnpb.HasAnyField(paths, parameterPath) {
+			continue
+		}
+		formatter := p.formatter(stored)
+		if ttnpb.HasAnyField(paths, name+"."+p.field) {
+			formatter = p.formatter(requested)
+		}
+		maxSize := c.maxParameterLength(formatter)
+		if size := len(p.parameter); size > maxSize {
+			return errInvalidFieldValue.WithAttributes("field", parameterPath).WithCause(
+				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", maxSize),
+			)
+		}
+	}
+	return nil
+}
+
 // Set implements ttnpb.AsEndDeviceRegistryServer.
 func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest) (dev *ttnpb.EndDevice, err error) {
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "session.dev_addr") &&
@@ -181,20 +214,6 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 		types.MustAES128Key(req.EndDevice.GetSession().GetKeys().GetAppSKey().GetKey()).OrZero().IsZero() {
 		return nil, errInvalidFieldValue.WithAttributes("field", "session.keys.app_s_key.key")
 	}
-	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "formatters.up_formatter_parameter") {
-		if size := len(req.EndDevice.GetFormatters().GetUpFormatterParameter()); size > r.AS.config.Formatters.MaxParameterLength {
-			return nil, errInvalidFieldValue.WithAttributes("field", "formatters.up_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", r.AS.config.Formatters.MaxParameterLength),
-			)
-		}
-	}
-	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "formatters.down_formatter_parameter") {
-		if size := len(req.EndDevice.GetFormatters().GetDownFormatterParameter()); size > r.AS.config.Formatters.MaxParameterLength {
-			return nil, errInvalidFieldValue.WithAttributes("field", "formatters.down_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", r.AS.config.Formatters.MaxParameterLength),
-			)
-		}
-	}
 	if err := rights.RequireApplication(ctx, req.EndDevice.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
 		return nil, err
 	}
@@ -227,8 +246,61 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 		)
 	}
 
+	// The test vectors are run before the transaction, as running the formatters may take long. The transaction fails
+	// if the stored formatters or version identifiers are modified in the meantime.
+	gets := req.FieldMask.GetPaths()
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(),
+		"formatters.up_formatter_parameter",
+		"formatters.down_formatter_parameter",
+	) {
+		// The maximum size of the formatter parameters depends on the stored formatters if they are not updated.
+		gets = ttnpb.AddFields(gets,
+			"formatters.up_formatter",
+			"formatters.down_formatter",
+		)
+	}
+	checkFormatters := !req.SkipFormatterTestVectors &&
+		updatesTestVectors(req.FieldMask.GetPaths(), "formatters", req.EndDevice.GetFormatters())
+	var checked *ttnpb.EndDevice
+	if checkFormatters {
+		checkPaths := []string{
+			"formatters",
+			"version_ids",
+		}
+		gets = ttnpb.AddFields(gets, checkPaths...)
+		stored, err := r.AS.deviceRegistry.Get(ctx, req.EndDevice.Ids, checkPaths)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+		checked = stored
+		if err := r.AS.config.Formatters.checkParameterLengths(
+			"formatters", req.FieldMask.GetPaths(), req.EndDevice.GetFormatters(), stored.GetFormatters(),
+		); err != nil {
+			return nil, err
+		}
+		updated := &ttnpb.EndDevice{}
+		if stored != nil {
+			updated = ttnpb.Clone(stored)
+		}
+		if err := updated.SetFields(req.EndDevice, req.FieldMask.GetPaths()...); err != nil {
+			return nil, err
+		}
+		if err := r.AS.checkFormatterTestVectors(ctx, req.EndDevice.Ids, updated.VersionIds, updated.Formatters); err != nil {
+			return nil, err
+		}
+	}
+
 	var evt events.Event
-	dev, err = r.AS.deviceRegistry.Set(ctx, req.EndDevice.Ids, req.FieldMask.GetPaths(), func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+	dev, err = r.AS.deviceRegistry.Set(ctx, req.EndDevice.Ids, gets, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if checkFormatters && (!proto.Equal(dev.GetFormatters(), checked.GetFormatters()) ||
+			!proto.Equal(dev.GetVersionIds(), checked.GetVersionIds())) {
+			return nil, nil, errFormattersModified.New()
+		}
+		if err := r.AS.config.Formatters.checkParameterLengths(
+			"formatters", req.FieldMask.GetPaths(), req.EndDevice.GetFormatters(), dev.GetFormatters(),
+		); err != nil {
+			return nil, nil, err
+		}
 		if dev != nil {
 			evt = evtUpdateEndDevice.NewWithIdentifiersAndData(ctx, req.EndDevice.Ids, req.FieldMask.GetPaths())
 			if err := ttnpb.ProhibitFields(sets,
@@ -303,5 +375,10 @@ func (r asEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndDev
 	if err := r.AS.appUpsRegistry.Clear(ctx, ids); err != nil {
 		return nil, err
 	}
+	if held := r.AS.heldDownlinks; held != nil {
+		if _, err := held.Clear(ctx, ids); err != nil {
+			return nil, err
+		}
+	}
 	return ttnpb.Empty, nil
 }
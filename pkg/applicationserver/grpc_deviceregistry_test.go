@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
@@ -329,6 +330,27 @@ func TestDeviceRegistryGet(t *testing.T) {
 	}
 }
 
+// testVectorFormatters returns JavaScript payload formatters with a test vector that expects the given temperature.
+func testVectorFormatters(temperature float64) *ttnpb.MessagePayloadFormatters {
+	return &ttnpb.MessagePayloadFormatters{
+		UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+		UpFormatterParameter: "function decodeUplink(input) { return { data: { temperature: input.bytes[0] } }; }",
+		TestVectors: []*ttnpb.MessagePayloadFormatterTestVector{
+			{
+				Name:       "temperature",
+				Type:       ttnpb.MessagePayloadFormatterTestVector_UPLINK_DECODE,
+				FPort:      1,
+				FrmPayload: []byte{21},
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: temperature}},
+					},
+				},
+			},
+		},
+	}
+}
+
 func TestDeviceRegistrySet(t *testing.T) {
 	registeredApplicationID := "foo-application"
 	registeredDeviceID := "foo-device"
@@ -345,9 +367,11 @@ func TestDeviceRegistrySet(t *testing.T) {
 		},
 	}
 	maxParameterLength := 1024
+	maxWASMParameterLength := 4096
 	for _, tc := range []struct {
 		Name            string
 		ContextFunc     func(context.Context) context.Context
+		GetFunc         func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error)
 		SetFunc         func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 		DeviceRequest   *ttnpb.SetEndDeviceRequest
 		ErrorAssertion  func(*testing.T, error) bool
@@ -510,6 +534,147 @@ func TestDeviceRegistrySet(t *testing.T) {
 			SetCalls: 1,
 		},
 
+		{
+			Name: "Formatter test vectors fail",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids:        registeredDevice.Ids,
+					Formatters: testVectorFormatters(22),
+				},
+				FieldMask: ttnpb.FieldMask("formatters.up_formatter", "formatters.up_formatter_parameter", "formatters.test_vectors"),
+			},
+			GetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(paths, should.HaveSameElementsDeep, []string{
+					"formatters",
+					"version_ids",
+				})
+				return ttnpb.Clone(registeredDevice), nil
+			},
+			SetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetFunc must not be called")
+				return nil, errors.New("SetFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name: "Formatter test vectors pass",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids:        registeredDevice.Ids,
+					Formatters: testVectorFormatters(21),
+				},
+				FieldMask: ttnpb.FieldMask("formatters"),
+			},
+			GetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+				return ttnpb.Clone(registeredDevice), nil
+			},
+			SetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, gets []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(gets, should.HaveSameElementsDeep, []string{
+					"formatters",
+					"version_ids",
+				})
+				dev, _, err := cb(ttnpb.Clone(registeredDevice))
+				return dev, err
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				a := assertions.New(t)
+				return a.So(dev.Formatters, should.Resemble, testVectorFormatters(21))
+			},
+			SetCalls: 1,
+		},
+
+		{
+			Name: "Formatters modified while running test vectors",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids:        registeredDevice.Ids,
+					Formatters: testVectorFormatters(21),
+				},
+				FieldMask: ttnpb.FieldMask("formatters.up_formatter", "formatters.up_formatter_parameter"),
+			},
+			GetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+				return ttnpb.Clone(registeredDevice), nil
+			},
+			SetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, gets []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				stored := ttnpb.Clone(registeredDevice)
+				stored.Formatters = testVectorFormatters(22)
+				dev, _, err := cb(stored)
+				return dev, err
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsAborted(err), should.BeTrue)
+			},
+			SetCalls: 1,
+		},
+
+		{
+			Name: "Skip formatter test vectors",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids:        registeredDevice.Ids,
+					Formatters: testVectorFormatters(22),
+				},
+				FieldMask:                ttnpb.FieldMask("formatters"),
+				SkipFormatterTestVectors: true,
+			},
+			SetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, gets []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(gets, should.HaveSameElementsDeep, []string{
+					"formatters",
+				})
+				dev, _, err := cb(ttnpb.Clone(registeredDevice))
+				return dev, err
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				a := assertions.New(t)
+				return a.So(dev.Formatters, should.Resemble, testVectorFormatters(22))
+			},
+			SetCalls: 1,
+		},
+
 		{
 			Name: "Uplink formatter script size exceeds maximum allowed",
 			ContextFunc: func(ctx context.Context) context.Context {
@@ -529,6 +694,9 @@ func TestDeviceRegistrySet(t *testing.T) {
 				}(),
 				FieldMask: ttnpb.FieldMask("formatters.up_formatter_parameter"),
 			},
+			GetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+				return ttnpb.Clone(registeredDevice), nil
+			},
 			SetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
 				test.MustTFromContext(ctx).Errorf("SetFunc must not be called")
 				return nil, errors.New("SetFunc must not be called")
@@ -557,6 +725,150 @@ func TestDeviceRegistrySet(t *testing.T) {
 				}(),
 				FieldMask: ttnpb.FieldMask("formatters.down_formatter_parameter"),
 			},
+			GetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+				return ttnpb.Clone(registeredDevice), nil
+			},
+			SetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetFunc must not be called")
+				return nil, errors.New("SetFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+		{
+			Name: "WebAssembly uplink formatter module size within maximum allowed",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids: registeredDevice.Ids,
+					Formatters: &ttnpb.MessagePayloadFormatters{
+						UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_WASM,
+						UpFormatterParameter: strings.Repeat("-", maxParameterLength+1),
+					},
+				},
+				FieldMask:                ttnpb.FieldMask("formatters.up_formatter", "formatters.up_formatter_parameter"),
+				SkipFormatterTestVectors: true,
+			},
+			SetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, gets []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				dev, _, err := cb(ttnpb.Clone(registeredDevice))
+				return dev, err
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				a := assertions.New(t)
+				return test.AllTrue(
+					a.So(dev.Formatters.UpFormatter, should.Equal, ttnpb.PayloadFormatter_FORMATTER_WASM),
+					a.So(dev.Formatters.UpFormatterParameter, should.HaveLength, maxParameterLength+1),
+				)
+			},
+			SetCalls: 1,
+		},
+		{
+			Name: "WebAssembly uplink formatter module size without formatter in field mask",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids: registeredDevice.Ids,
+					Formatters: &ttnpb.MessagePayloadFormatters{
+						UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_WASM,
+						UpFormatterParameter: strings.Repeat("-", maxParameterLength+1),
+					},
+				},
+				FieldMask:                ttnpb.FieldMask("formatters.up_formatter_parameter"),
+				SkipFormatterTestVectors: true,
+			},
+			SetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, gets []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(gets, should.Contain, "formatters.up_formatter")
+				dev, _, err := cb(ttnpb.Clone(registeredDevice))
+				return dev, err
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+			SetCalls: 1,
+		},
+		{
+			Name: "WebAssembly uplink formatter module size with stored formatter",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids: registeredDevice.Ids,
+					Formatters: &ttnpb.MessagePayloadFormatters{
+						UpFormatterParameter: strings.Repeat("-", maxParameterLength+1),
+					},
+				},
+				FieldMask:                ttnpb.FieldMask("formatters.up_formatter_parameter"),
+				SkipFormatterTestVectors: true,
+			},
+			SetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, gets []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				stored := ttnpb.Clone(registeredDevice)
+				stored.Formatters.UpFormatter = ttnpb.PayloadFormatter_FORMATTER_WASM
+				dev, sets, err := cb(stored)
+				if err != nil {
+					return nil, err
+				}
+				if err := stored.SetFields(dev, sets...); err != nil {
+					return nil, err
+				}
+				return stored, nil
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				a := assertions.New(t)
+				return a.So(dev.Formatters.UpFormatterParameter, should.HaveLength, maxParameterLength+1)
+			},
+			SetCalls: 1,
+		},
+		{
+			Name: "WebAssembly uplink formatter module size exceeds maximum allowed",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids: registeredDevice.Ids,
+					Formatters: &ttnpb.MessagePayloadFormatters{
+						UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_WASM,
+						UpFormatterParameter: strings.Repeat("-", maxWASMParameterLength+1),
+					},
+				},
+				FieldMask: ttnpb.FieldMask("formatters.up_formatter", "formatters.up_formatter_parameter"),
+			},
+			GetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+				return ttnpb.Clone(registeredDevice), nil
+			},
 			SetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
 				test.MustTFromContext(ctx).Errorf("SetFunc must not be called")
 				return nil, errors.New("SetFunc must not be called")
@@ -575,13 +887,15 @@ func TestDeviceRegistrySet(t *testing.T) {
 			as := test.Must(applicationserver.New(componenttest.NewComponent(t, &component.Config{}),
 				&applicationserver.Config{
 					Devices: &MockDeviceRegistry{
+						GetFunc: tc.GetFunc,
 						SetFunc: func(ctx context.Context, deviceIds *ttnpb.EndDeviceIdentifiers, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
 							atomic.AddUint64(&setCalls, 1)
 							return tc.SetFunc(ctx, deviceIds, paths, cb)
 						},
 					},
 					Formatters: applicationserver.FormattersConfig{
-						MaxParameterLength: maxParameterLength,
+						MaxParameterLength:     maxParameterLength,
+						MaxWASMParameterLength: maxWASMParameterLength,
 					},
 				})).(*applicationserver.ApplicationServer)
 
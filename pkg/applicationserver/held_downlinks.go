@@ -0,0 +1,201 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errHeldDownlinksDisabled = errors.DefineFailedPrecondition(
+		"held_downlinks_disabled", "downlink messages cannot be held by the Application Server",
+	)
+	errDownlinkExpired = errors.DefineDeadlineExceeded(
+		"downlink_expired", "downlink message expired before it was queued",
+	)
+	errDownlinkSuperseded = errors.DefineAborted(
+		"downlink_superseded", "downlink message superseded by downlink message with deduplication key `{deduplication_key}`",
+	)
+	errDownlinkReplaced = errors.DefineAborted(
+		"downlink_replaced", "downlink message replaced by downlink queue replacement",
+	)
+)
+
+const defaultHeldDownlinksDispatchInterval = time.Second
+
+// partitionHeldDownlinks partitions the downlink messages into the downlink messages that are held by the Application
+// Server until they are due, and the downlink messages that are queued in the Network Server directly.
+func partitionHeldDownlinks(items []*ttnpb.ApplicationDownlink) (held, direct []*ttnpb.ApplicationDownlink) {
+	return ttnpb.PartitionDownlinks(func(down *ttnpb.ApplicationDownlink) bool {
+		return down.Scheduling != nil
+	}, items...)
+}
+
+// sortHeldDownlinks sorts the due downlink messages by their not before time.
+// If orderByPriority is true, the downlink messages are sorted by their priority first.
+func sortHeldDownlinks(items []*ttnpb.ApplicationDownlink, orderByPriority bool) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if orderByPriority && a.Priority != b.Priority {
+			return a.Priority > b.Priority
+		}
+		aNotBefore := ttnpb.StdTimeOrZero(a.Scheduling.GetNotBefore())
+		bNotBefore := ttnpb.StdTimeOrZero(b.Scheduling.GetNotBefore())
+		return aNotBefore.Before(bNotBefore)
+	})
+}
+
+// downlinkPolicy returns the policy for downlink messages of the end device that are held by the Application Server.
+// The policy of the end device overrides the policy of the application.
+func (as *ApplicationServer) downlinkPolicy(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.ApplicationDownlinkPolicy, error) {
+	dev, err := as.deviceRegistry.Get(ctx, ids, []string{"application_downlink_policy"})
+	if err != nil {
+		return nil, err
+	}
+	if dev.ApplicationDownlinkPolicy != nil {
+		return dev.ApplicationDownlinkPolicy, nil
+	}
+	link, err := as.getLink(ctx, ids.ApplicationIds, []string{"downlink_policy"})
+	if err != nil {
+		return nil, err
+	}
+	return link.DownlinkPolicy, nil
+}
+
+// validateHeldDownlinks checks that the downlink messages can be held by the Application Server.
+func (as *ApplicationServer) validateHeldDownlinks(items []*ttnpb.ApplicationDownlink) error {
+	if as.heldDownlinks == nil {
+		return errHeldDownlinksDisabled.New()
+	}
+	for _, item := range items {
+		if item.FPort == 0 {
+			return errNoFPort.New()
+		}
+	}
+	return nil
+}
+
+// holdDownlinks holds the downlink messages in the Application Server until they are due.
+// Held downlink messages that are superseded by the given downlink messages are reported as failed.
+func (as *ApplicationServer) holdDownlinks(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	if err := as.validateHeldDownlinks(items); err != nil {
+		return err
+	}
+	policy, err := as.downlinkPolicy(ctx, ids)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	ttl := ttnpb.StdDurationOrZero(policy.GetDefaultTtl())
+	for _, item := range items {
+		ctx := events.ContextWithCorrelationID(ctx, item.CorrelationIds...)
+		item.CorrelationIds = events.CorrelationIDsFromContext(ctx)
+		if item.Scheduling.ExpiresAt != nil || ttl <= 0 {
+			continue
+		}
+		start := now
+		if notBefore := ttnpb.StdTime(item.Scheduling.NotBefore); notBefore != nil && notBefore.After(now) {
+			start = *notBefore
+		}
+		item.Scheduling.ExpiresAt = ttnpb.ProtoTimePtr(start.Add(ttl))
+	}
+	superseded, err := as.heldDownlinks.Add(ctx, ids, items)
+	if err != nil {
+		return err
+	}
+	registerHoldDownlinks(ctx, ids, items)
+	for _, item := range superseded {
+		as.registerDropDownlinks(ctx, ids, []*ttnpb.ApplicationDownlink{item}, errDownlinkSuperseded.WithAttributes(
+			"deduplication_key", item.Scheduling.GetDeduplicationKey(),
+		))
+	}
+	select {
+	case as.heldDownlinksDue <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// appendHeldDownlinks appends the downlink messages of the end device that are held by the Application Server to the
+// given downlink queue.
+func (as *ApplicationServer) appendHeldDownlinks(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, queue []*ttnpb.ApplicationDownlink) ([]*ttnpb.ApplicationDownlink, error) {
+	if as.heldDownlinks == nil {
+		return queue, nil
+	}
+	held, err := as.heldDownlinks.List(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return append(queue, held...), nil
+}
+
+// dispatchHeldDownlinks periodically queues the held downlink messages that are due in the Network Server, and reports
+// the held downlink messages that expired.
+func (as *ApplicationServer) dispatchHeldDownlinks(ctx context.Context) error {
+	interval := as.config.Downlinks.DispatchInterval
+	if interval <= 0 {
+		interval = defaultHeldDownlinksDispatchInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case <-as.heldDownlinksDue:
+		}
+		if err := as.heldDownlinks.Pop(ctx, time.Now(), as.handleDueDownlinks); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to dispatch held downlink messages")
+		}
+	}
+}
+
+func (as *ApplicationServer) handleDueDownlinks(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ids))
+	now := time.Now()
+	expired, due := ttnpb.PartitionDownlinks(func(down *ttnpb.ApplicationDownlink) bool {
+		expiresAt := ttnpb.StdTime(down.Scheduling.GetExpiresAt())
+		return expiresAt != nil && !expiresAt.After(now)
+	}, items...)
+	if len(expired) > 0 {
+		as.registerDropDownlinks(ctx, ids, expired, errDownlinkExpired.New())
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	policy, err := as.downlinkPolicy(ctx, ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get downlink policy")
+		as.registerDropDownlinks(ctx, ids, due, err)
+		return nil
+	}
+	sortHeldDownlinks(due, policy.GetOrderByPriority())
+	for _, item := range due {
+		item.Scheduling = nil
+	}
+	if err := as.downlinkQueueOp(ctx, ids, due, ttnpb.AsNsClient.DownlinkQueuePush, true); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to queue held downlink messages")
+	}
+	return nil
+}
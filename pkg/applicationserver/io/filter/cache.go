@@ -0,0 +1,45 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import "github.com/bluele/gcache"
+
+// DefaultCacheSize is the default number of compiled filters in a Cache.
+const DefaultCacheSize = 1024
+
+// Cache is a cache of compiled filters.
+type Cache struct {
+	cache gcache.Cache
+}
+
+// NewCache returns a new Cache which holds at most size compiled filters.
+func NewCache(size int) *Cache {
+	return &Cache{
+		cache: gcache.New(size).LRU().Build(),
+	}
+}
+
+// Compile returns the compiled filter expression from the cache, or compiles it.
+func (c *Cache) Compile(expr string) (*Filter, error) {
+	if v, err := c.cache.Get(expr); err == nil {
+		return v.(*Filter), nil
+	}
+	f, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Set(expr, f) //nolint:errcheck
+	return f, nil
+}
@@ -0,0 +1,172 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"strconv"
+	"strings"
+)
+
+func or(left, right predicate) predicate {
+	return func(env *Environment) bool {
+		return left(env) || right(env)
+	}
+}
+
+func and(left, right predicate) predicate {
+	return func(env *Environment) bool {
+		return left(env) && right(env)
+	}
+}
+
+func not(inner predicate) predicate {
+	return func(env *Environment) bool {
+		return !inner(env)
+	}
+}
+
+func comparison(kind tokenKind, left, right operand) predicate {
+	var f func(a, b interface{}) bool
+	switch kind {
+	case tokenEqual:
+		f = equal
+	case tokenNotEqual:
+		return func(env *Environment) bool {
+			return !anyPair(left(env), right(env), equal)
+		}
+	case tokenLess:
+		f = ordered(func(c int) bool { return c < 0 })
+	case tokenLessEqual:
+		f = ordered(func(c int) bool { return c <= 0 })
+	case tokenGreater:
+		f = ordered(func(c int) bool { return c > 0 })
+	case tokenGreaterEqual:
+		f = ordered(func(c int) bool { return c >= 0 })
+	default:
+		panic("unreachable")
+	}
+	return func(env *Environment) bool {
+		return anyPair(left(env), right(env), f)
+	}
+}
+
+// lookup returns the values at the path in v. The values of repeated fields are flattened.
+func lookup(v interface{}, path []string) []interface{} {
+	if len(path) == 0 {
+		if vs, ok := v.([]interface{}); ok {
+			return vs
+		}
+		return []interface{}{v}
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		child, ok := v[path[0]]
+		if !ok {
+			return nil
+		}
+		return lookup(child, path[1:])
+	case []interface{}:
+		var res []interface{}
+		for _, child := range v {
+			res = append(res, lookup(child, path)...)
+		}
+		return res
+	default:
+		return nil
+	}
+}
+
+var nullValues = []interface{}{nil}
+
+// anyPair returns whether f is true for any pair of values. Missing values are null.
+func anyPair(left, right []interface{}, f func(a, b interface{}) bool) bool {
+	if len(left) == 0 {
+		left = nullValues
+	}
+	if len(right) == 0 {
+		right = nullValues
+	}
+	for _, a := range left {
+		for _, b := range right {
+			if f(a, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compare compares the numbers or strings a and b. Strings are compared as numbers with numbers, as 64-bit integers
+// are strings in JSON. The second return value is false if a and b cannot be compared.
+func compare(a, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case float64:
+		switch b := b.(type) {
+		case float64:
+			return compareFloats(a, b), true
+		case string:
+			f, err := strconv.ParseFloat(b, 64)
+			if err != nil {
+				return 0, false
+			}
+			return compareFloats(a, f), true
+		}
+	case string:
+		switch b := b.(type) {
+		case string:
+			return strings.Compare(a, b), true
+		case float64:
+			f, err := strconv.ParseFloat(a, 64)
+			if err != nil {
+				return 0, false
+			}
+			return compareFloats(f, b), true
+		}
+	}
+	return 0, false
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func equal(a, b interface{}) bool {
+	if c, ok := compare(a, b); ok {
+		return c == 0
+	}
+	switch a := a.(type) {
+	case nil:
+		return b == nil
+	case bool:
+		b, ok := b.(bool)
+		return ok && a == b
+	default:
+		return false
+	}
+}
+
+func ordered(f func(int) bool) func(a, b interface{}) bool {
+	return func(a, b interface{}) bool {
+		c, ok := compare(a, b)
+		return ok && f(c)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filter implements the message filter expressions of the Application Server integrations.
+//
+// A filter expression is a boolean expression that is evaluated against an upstream message and the attributes of
+// the end device. Fields of the upstream message are referenced by their JSON path prefixed with `up`, and the
+// attributes of the end device are referenced by their key prefixed with `attributes`. For example:
+//
+//	up.uplink_message.f_port == 2 && up.uplink_message.decoded_payload.temperature > 20.5
+//	attributes.building in ["a", "b"] || up.end_device_ids.device_id =~ "^sensor-"
+//	!(up.uplink_message.rx_metadata.gateway_ids.gateway_id == "gtw-1")
+//
+// The supported operators are `==`, `!=`, `<`, `<=`, `>`, `>=`, `=~` (regular expression match), `in`, `!`, `&&`
+// and `||`. A field without operator matches if it is `true`. Missing fields are `null`.
+// Fields within repeated fields, such as the gateway identifiers in the metadata of an uplink message, have
+// multiple values. A comparison matches if it matches for any of the values, and `!=` matches if none of the values
+// are equal.
+package filter
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// MaxLength is the maximum length of a filter expression.
+const MaxLength = 1024
+
+// maxDepth is the maximum nesting depth of a filter expression.
+const maxDepth = 32
+
+// Filter is a compiled filter expression.
+type Filter struct {
+	expr       string
+	match      predicate
+	attributes bool
+}
+
+// Compile compiles the filter expression.
+func Compile(expr string) (*Filter, error) {
+	if len(expr) > MaxLength {
+		return nil, errTooLong.WithAttributes("max_length", MaxLength)
+	}
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	match, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, errUnexpectedToken.WithAttributes("token", tok.text, "position", tok.pos)
+	}
+	return &Filter{
+		expr:       expr,
+		match:      match,
+		attributes: p.attributes,
+	}, nil
+}
+
+// String returns the filter expression.
+func (f *Filter) String() string {
+	return f.expr
+}
+
+// UsesAttributes returns whether the filter references the attributes of the end device.
+func (f *Filter) UsesAttributes() bool {
+	return f.attributes
+}
+
+// Match returns whether the message and end device attributes of the environment match the filter.
+func (f *Filter) Match(env *Environment) (bool, error) {
+	match := f.match(env)
+	if err := env.error(); err != nil {
+		return false, err
+	}
+	return match, nil
+}
+
+var (
+	errMarshalUp     = errors.DefineCorruption("marshal_up", "marshal upstream message")
+	errGetAttributes = errors.Define("get_attributes", "get end device attributes")
+)
+
+// Environment is the message and end device in which filters are evaluated.
+// The message is converted and the attributes are retrieved when a filter references them first.
+// An Environment is created per message, and it can be shared by the filters of multiple integrations.
+type Environment struct {
+	up            *ttnpb.ApplicationUp
+	getAttributes func() (map[string]string, error)
+
+	upOnce  sync.Once
+	upValue interface{}
+	upErr   error
+
+	attributesOnce sync.Once
+	attributes     map[string]string
+	attributesErr  error
+}
+
+// NewEnvironment returns a new Environment for the message. The getAttributes function returns the attributes of
+// the end device of the message. If getAttributes is nil, the end device has no attributes.
+func NewEnvironment(up *ttnpb.ApplicationUp, getAttributes func() (map[string]string, error)) *Environment {
+	return &Environment{
+		up:            up,
+		getAttributes: getAttributes,
+	}
+}
+
+// EndDeviceAttributes returns a function which retrieves the attributes of the end device from the registry.
+func EndDeviceAttributes(
+	ctx context.Context, registry io.EndDeviceRegistry, ids *ttnpb.EndDeviceIdentifiers,
+) func() (map[string]string, error) {
+	return func() (map[string]string, error) {
+		dev, err := registry.GetEndDevice(ctx, ids, []string{"attributes"})
+		if err != nil {
+			return nil, err
+		}
+		return dev.Attributes, nil
+	}
+}
+
+// Up returns the message of the environment.
+func (env *Environment) Up() *ttnpb.ApplicationUp {
+	return env.up
+}
+
+func (env *Environment) upFields() interface{} {
+	env.upOnce.Do(func() {
+		b, err := jsonpb.TTN().Marshal(env.up)
+		if err != nil {
+			env.upErr = errMarshalUp.WithCause(err)
+			return
+		}
+		if err := json.Unmarshal(b, &env.upValue); err != nil {
+			env.upErr = errMarshalUp.WithCause(err)
+		}
+	})
+	return env.upValue
+}
+
+func (env *Environment) attribute(key string) (string, bool) {
+	env.attributesOnce.Do(func() {
+		if env.getAttributes == nil {
+			return
+		}
+		attributes, err := env.getAttributes()
+		if err != nil {
+			env.attributesErr = errGetAttributes.WithCause(err)
+			return
+		}
+		env.attributes = attributes
+	})
+	value, ok := env.attributes[key]
+	return value, ok
+}
+
+func (env *Environment) error() error {
+	if env.upErr != nil {
+		return env.upErr
+	}
+	return env.attributesErr
+}
+
+var errInvalidFilter = errors.DefineInvalidArgument("invalid_filter", "invalid filter expression")
+
+// Validate returns an error if the filter expression is not empty and does not compile.
+func Validate(expr string) error {
+	if expr == "" {
+		return nil
+	}
+	if _, err := Compile(expr); err != nil {
+		return errInvalidFilter.WithCause(err)
+	}
+	return nil
+}
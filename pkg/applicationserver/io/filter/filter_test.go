@@ -0,0 +1,170 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/filter"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Expr           string
+		Attributes     bool
+		ErrorAssertion func(error) bool
+	}{
+		{Expr: `up.uplink_message.f_port == 2`},
+		{Expr: `!(up.simulated) && (attributes.building == "a" || attributes.floor >= 2)`, Attributes: true},
+		{Expr: `up.uplink_message.f_port in [1, 2, -3.5e2]`},
+		{Expr: `up.end_device_ids.device_id =~ "^dev-[0-9]+$"`},
+		{Expr: `up.uplink_message.f_port ==`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.uplink_message.f_port == 2 2`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `(up.simulated`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.uplink_message.f_port in [1, 2`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.uplink_message.f_port in [up.simulated]`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up == 1`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `attributes.building.floor == 1`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `device.attributes.building == 1`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up..simulated`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.end_device_ids.device_id =~ "("`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.end_device_ids.device_id =~ 1`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.end_device_ids.device_id == "dev`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.uplink_message.f_port == 1.2.3`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: `up.simulated $ true`, ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: fmt.Sprintf("%sup.simulated%s", strings.Repeat("(", 33), strings.Repeat(")", 33)), ErrorAssertion: errors.IsInvalidArgument},
+		{Expr: fmt.Sprintf("up.simulated%s", strings.Repeat(" ", MaxLength)), ErrorAssertion: errors.IsInvalidArgument},
+	} {
+		tc := tc
+		t.Run(tc.Expr, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			f, err := Compile(tc.Expr)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(f.String(), should.Equal, tc.Expr)
+			a.So(f.UsesAttributes(), should.Equal, tc.Attributes)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			DeviceId:       "dev-1",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort: 2,
+				FCnt:  42,
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: 21.5}},
+						"alarm":       {Kind: &pbtypes.Value_BoolValue{BoolValue: true}},
+					},
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}},
+					{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}},
+				},
+			},
+		},
+	}
+	attributes := map[string]string{
+		"building": "a",
+		"floor":    "3",
+	}
+
+	for _, tc := range []struct {
+		Expr  string
+		Match bool
+	}{
+		{Expr: `up.uplink_message.f_port == 2`, Match: true},
+		{Expr: `up.uplink_message.f_port != 2`, Match: false},
+		{Expr: `up.uplink_message.f_port > 1 && up.uplink_message.f_port <= 2`, Match: true},
+		{Expr: `up.uplink_message.f_cnt < 42`, Match: false},
+		{Expr: `up.uplink_message.f_port in [1, 3]`, Match: false},
+		{Expr: `up.uplink_message.decoded_payload.temperature >= 21.5`, Match: true},
+		{Expr: `up.uplink_message.decoded_payload.alarm`, Match: true},
+		{Expr: `!up.uplink_message.decoded_payload.alarm`, Match: false},
+		{Expr: `up.uplink_message.decoded_payload.humidity == null`, Match: true},
+		{Expr: `up.uplink_message.decoded_payload.humidity > 10`, Match: false},
+		{Expr: `up.join_accept != null`, Match: false},
+		{Expr: `up.uplink_message.rx_metadata.gateway_ids.gateway_id == "gtw-2"`, Match: true},
+		{Expr: `up.uplink_message.rx_metadata.gateway_ids.gateway_id != "gtw-2"`, Match: false},
+		{Expr: `up.uplink_message.rx_metadata.gateway_ids.gateway_id in ["gtw-3", "gtw-4"]`, Match: false},
+		{Expr: `up.end_device_ids.device_id =~ "^dev-[0-9]+$"`, Match: true},
+		{Expr: `attributes.building == "a" && attributes.floor > 2`, Match: true},
+		{Expr: `attributes.room == null || attributes.building == "b"`, Match: true},
+		{Expr: `(attributes.building == "b" || up.uplink_message.f_port == 3) && true`, Match: false},
+	} {
+		tc := tc
+		t.Run(tc.Expr, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			f, err := Compile(tc.Expr)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			match, err := f.Match(NewEnvironment(up, func() (map[string]string, error) {
+				return attributes, nil
+			}))
+			a.So(err, should.BeNil)
+			a.So(match, should.Equal, tc.Match)
+		})
+	}
+
+	t.Run("AttributesError", func(t *testing.T) {
+		t.Parallel()
+		a, _ := test.New(t)
+		f, err := Compile(`attributes.building == "a"`)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = f.Match(NewEnvironment(up, func() (map[string]string, error) {
+			return nil, errors.New("unavailable")
+		}))
+		a.So(err, should.NotBeNil)
+	})
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+	cache := NewCache(2)
+	f1, err := cache.Compile(`up.simulated`)
+	a.So(err, should.BeNil)
+	f2, err := cache.Compile(`up.simulated`)
+	a.So(err, should.BeNil)
+	a.So(f1, should.Equal, f2)
+	_, err = cache.Compile(`up.simulated ==`)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
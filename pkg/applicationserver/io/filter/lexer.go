@@ -0,0 +1,185 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"strconv"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errTooLong = errors.DefineInvalidArgument(
+		"too_long", "filter expression longer than {max_length} characters",
+	)
+	errInvalidCharacter = errors.DefineInvalidArgument(
+		"invalid_character", "invalid character `{character}` at position {position}",
+	)
+	errInvalidNumber = errors.DefineInvalidArgument(
+		"invalid_number", "invalid number `{number}` at position {position}",
+	)
+	errInvalidString = errors.DefineInvalidArgument(
+		"invalid_string", "invalid string at position {position}",
+	)
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenField
+	tokenNumber
+	tokenString
+	tokenTrue
+	tokenFalse
+	tokenNull
+	tokenIn
+	tokenLeftParen
+	tokenRightParen
+	tokenLeftBracket
+	tokenRightBracket
+	tokenComma
+	tokenEqual
+	tokenNotEqual
+	tokenLess
+	tokenLessEqual
+	tokenGreater
+	tokenGreaterEqual
+	tokenMatch
+	tokenAnd
+	tokenOr
+	tokenNot
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	pos   int
+	value interface{}
+}
+
+var operators = []struct {
+	text string
+	kind tokenKind
+}{
+	// Operators that are a prefix of another operator follow the longer operator.
+	{"==", tokenEqual},
+	{"!=", tokenNotEqual},
+	{"<=", tokenLessEqual},
+	{">=", tokenGreaterEqual},
+	{"=~", tokenMatch},
+	{"&&", tokenAnd},
+	{"||", tokenOr},
+	{"<", tokenLess},
+	{">", tokenGreater},
+	{"!", tokenNot},
+	{"(", tokenLeftParen},
+	{")", tokenRightParen},
+	{"[", tokenLeftBracket},
+	{"]", tokenRightBracket},
+	{",", tokenComma},
+}
+
+var keywords = map[string]tokenKind{
+	"true":  tokenTrue,
+	"false": tokenFalse,
+	"null":  tokenNull,
+	"in":    tokenIn,
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isFieldStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isField(c byte) bool {
+	return isFieldStart(c) || isDigit(c) || c == '-' || c == '.'
+}
+
+// tokenize splits the expression in tokens. The last token is always tokenEOF.
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+outer:
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+
+		case isFieldStart(c):
+			j := i + 1
+			for j < len(expr) && isField(expr[j]) {
+				j++
+			}
+			text := expr[i:j]
+			kind, ok := keywords[text]
+			if !ok {
+				kind = tokenField
+			}
+			tokens = append(tokens, token{kind: kind, text: text, pos: i})
+			i = j
+			continue
+
+		case isDigit(c) || c == '-' && i+1 < len(expr) && isDigit(expr[i+1]):
+			j := i + 1
+			for j < len(expr) && (isDigit(expr[j]) || strings.IndexByte(".eE", expr[j]) >= 0 ||
+				(expr[j] == '-' || expr[j] == '+') && (expr[j-1] == 'e' || expr[j-1] == 'E')) {
+				j++
+			}
+			text := expr[i:j]
+			f, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, errInvalidNumber.WithAttributes("number", text, "position", i)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, pos: i, value: f})
+			i = j
+			continue
+
+		case c == '"':
+			j := i + 1
+			for ; j < len(expr) && expr[j] != '"'; j++ {
+				if expr[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(expr) {
+				return nil, errInvalidString.WithAttributes("position", i)
+			}
+			text := expr[i : j+1]
+			s, err := strconv.Unquote(text)
+			if err != nil {
+				return nil, errInvalidString.WithAttributes("position", i)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: i, value: s})
+			i = j + 1
+			continue
+		}
+
+		for _, op := range operators {
+			if strings.HasPrefix(expr[i:], op.text) {
+				tokens = append(tokens, token{kind: op.kind, text: op.text, pos: i})
+				i += len(op.text)
+				continue outer
+			}
+		}
+		return nil, errInvalidCharacter.WithAttributes("character", string(c), "position", i)
+	}
+	return append(tokens, token{kind: tokenEOF, text: "end of expression", pos: len(expr)}), nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const (
+	subsystem          = "as_filter"
+	integrationLabel   = "integration"
+	applicationIDLabel = "application_id"
+	integrationIDLabel = "integration_id"
+)
+
+var filterMetrics = &messageMetrics{
+	matched: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "matched_total",
+			Help:      "Total number of messages that matched the filter of an integration",
+		},
+		[]string{integrationLabel, applicationIDLabel, integrationIDLabel},
+	),
+	dropped: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "dropped_total",
+			Help:      "Total number of messages that were dropped by the filter of an integration",
+		},
+		[]string{integrationLabel, applicationIDLabel, integrationIDLabel},
+	),
+}
+
+func init() {
+	metrics.MustRegister(filterMetrics)
+}
+
+type messageMetrics struct {
+	matched *metrics.ContextualCounterVec
+	dropped *metrics.ContextualCounterVec
+}
+
+func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.matched.Describe(ch)
+	m.dropped.Describe(ch)
+}
+
+func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.matched.Collect(ch)
+	m.dropped.Collect(ch)
+}
+
+// RegisterMatch registers whether a message matched the filter of an integration.
+func RegisterMatch(ctx context.Context, integration, applicationID, integrationID string, match bool) {
+	if match {
+		filterMetrics.matched.WithLabelValues(ctx, integration, applicationID, integrationID).Inc()
+	} else {
+		filterMetrics.dropped.WithLabelValues(ctx, integration, applicationID, integrationID).Inc()
+	}
+}
@@ -0,0 +1,294 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"regexp"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errUnexpectedToken = errors.DefineInvalidArgument(
+		"unexpected_token", "unexpected `{token}` at position {position}",
+	)
+	errUnknownField = errors.DefineInvalidArgument(
+		"unknown_field", "unknown field `{field}` at position {position}; use `up.<path>` or `attributes.<key>`",
+	)
+	errInvalidRegexp = errors.DefineInvalidArgument(
+		"invalid_regexp", "invalid regular expression `{regexp}` at position {position}",
+	)
+	errTooDeep = errors.DefineInvalidArgument(
+		"too_deep", "filter expression nested deeper than {max_depth} levels",
+	)
+)
+
+// predicate is a compiled boolean expression.
+type predicate func(*Environment) bool
+
+// operand is a compiled field or literal. It returns the values of the operand.
+type operand func(*Environment) []interface{}
+
+// parser is a recursive descent parser which compiles the tokens of an expression to a predicate.
+type parser struct {
+	tokens     []token
+	i          int
+	depth      int
+	attributes bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.i]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.i]
+	if tok.kind != tokenEOF {
+		p.i++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, errUnexpectedToken.WithAttributes("token", tok.text, "position", tok.pos)
+	}
+	return tok, nil
+}
+
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxDepth {
+		return errTooDeep.WithAttributes("max_depth", maxDepth)
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+// parseOr parses `and ('||' and)*`.
+func (p *parser) parseOr() (predicate, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = or(left, right)
+	}
+	return left, nil
+}
+
+// parseAnd parses `unary ('&&' unary)*`.
+func (p *parser) parseAnd() (predicate, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = and(left, right)
+	}
+	return left, nil
+}
+
+// parseUnary parses `'!' unary | '(' or ')' | comparison`.
+func (p *parser) parseUnary() (predicate, error) {
+	switch p.peek().kind {
+	case tokenNot:
+		p.next()
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+		inner, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return not(inner), nil
+
+	case tokenLeftParen:
+		p.next()
+		if err := p.enter(); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRightParen); err != nil {
+			return nil, err
+		}
+		return inner, nil
+
+	default:
+		return p.parseComparison()
+	}
+}
+
+// parseComparison parses `operand (op operand | 'in' list | '=~' string)?`.
+func (p *parser) parseComparison() (predicate, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	switch op := p.peek(); op.kind {
+	case tokenEqual, tokenNotEqual, tokenLess, tokenLessEqual, tokenGreater, tokenGreaterEqual:
+		p.next()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return comparison(op.kind, left, right), nil
+
+	case tokenIn:
+		p.next()
+		values, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return func(env *Environment) bool {
+			return anyPair(left(env), values, equal)
+		}, nil
+
+	case tokenMatch:
+		p.next()
+		tok, err := p.expect(tokenString)
+		if err != nil {
+			return nil, err
+		}
+		re, err := regexp.Compile(tok.value.(string))
+		if err != nil {
+			return nil, errInvalidRegexp.WithCause(err).WithAttributes("regexp", tok.value, "position", tok.pos)
+		}
+		return func(env *Environment) bool {
+			for _, v := range left(env) {
+				if s, ok := v.(string); ok && re.MatchString(s) {
+					return true
+				}
+			}
+			return false
+		}, nil
+
+	default:
+		return func(env *Environment) bool {
+			for _, v := range left(env) {
+				if v == true {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+}
+
+// parseList parses `'[' (literal (',' literal)*)? ']'`.
+func (p *parser) parseList() ([]interface{}, error) {
+	if _, err := p.expect(tokenLeftBracket); err != nil {
+		return nil, err
+	}
+	var values []interface{}
+	if p.peek().kind == tokenRightBracket {
+		p.next()
+		return values, nil
+	}
+	for {
+		value, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		switch tok := p.next(); tok.kind {
+		case tokenComma:
+		case tokenRightBracket:
+			return values, nil
+		default:
+			return nil, errUnexpectedToken.WithAttributes("token", tok.text, "position", tok.pos)
+		}
+	}
+}
+
+func (p *parser) parseLiteral() (interface{}, error) {
+	switch tok := p.next(); tok.kind {
+	case tokenNumber, tokenString:
+		return tok.value, nil
+	case tokenTrue:
+		return true, nil
+	case tokenFalse:
+		return false, nil
+	case tokenNull:
+		return nil, nil
+	default:
+		return nil, errUnexpectedToken.WithAttributes("token", tok.text, "position", tok.pos)
+	}
+}
+
+// parseOperand parses `field | literal`.
+func (p *parser) parseOperand() (operand, error) {
+	if tok := p.peek(); tok.kind == tokenField {
+		p.next()
+		return p.field(tok)
+	}
+	value, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+	values := []interface{}{value}
+	return func(*Environment) []interface{} {
+		return values
+	}, nil
+}
+
+func (p *parser) field(tok token) (operand, error) {
+	path := strings.Split(tok.text, ".")
+	for _, element := range path {
+		if element == "" {
+			return nil, errUnknownField.WithAttributes("field", tok.text, "position", tok.pos)
+		}
+	}
+	switch {
+	case path[0] == "up" && len(path) > 1:
+		path := path[1:]
+		return func(env *Environment) []interface{} {
+			return lookup(env.upFields(), path)
+		}, nil
+
+	case path[0] == "attributes" && len(path) == 2:
+		p.attributes = true
+		key := path[1]
+		return func(env *Environment) []interface{} {
+			if value, ok := env.attribute(key); ok {
+				return []interface{}{value}
+			}
+			return nil
+		}, nil
+
+	default:
+		return nil, errUnknownField.WithAttributes("field", tok.text, "position", tok.pos)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -65,6 +66,7 @@ type impl struct {
 	mqttConfigProvider config.MQTTConfigProvider
 	processor          messageprocessors.PayloadProcessor
 	skipPayloadCrypto  SkipPayloadCryptoFunc
+	durableStream      distribution.Stream
 }
 
 // WithMQTTConfigProvider sets the MQTT configuration provider for the gRPC frontend.
@@ -95,6 +97,13 @@ func WithSkipPayloadCrypto(f SkipPayloadCryptoFunc) Option {
 	})
 }
 
+// WithDurableStream sets the stream that will be used by the gRPC frontend for durable subscriptions.
+func WithDurableStream(stream distribution.Stream) Option {
+	return optionFunc(func(i *impl) {
+		i.durableStream = stream
+	})
+}
+
 // New returns a new gRPC frontend.
 func New(server io.Server, opts ...Option) ttnpb.AppAsServer {
 	i := &impl{
@@ -155,6 +164,81 @@ func (s *impl) Subscribe(ids *ttnpb.ApplicationIdentifiers, stream ttnpb.AppAs_S
 	}
 }
 
+var errDurableSubscriptionsUnavailable = errors.DefineUnimplemented(
+	"durable_subscriptions_unavailable", "durable subscriptions unavailable",
+)
+
+func (s *impl) SubscribeDurable(req *ttnpb.SubscribeDurableRequest, stream ttnpb.AppAs_SubscribeDurableServer) error {
+	ctx := log.NewContextWithField(stream.Context(), "namespace", "applicationserver/io/grpc")
+
+	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return err
+	}
+	if s.durableStream == nil {
+		return errDurableSubscriptionsUnavailable.New()
+	}
+
+	if peer, ok := peer.FromContext(ctx); ok {
+		ctx = log.NewContextWithField(ctx, "remote_addr", peer.Addr.String())
+	}
+	uid := unique.ID(ctx, req.ApplicationIds)
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"application_uid", uid,
+		"consumer", req.Consumer,
+	))
+	logger := log.FromContext(ctx)
+
+	logger.Info("Subscribed")
+	defer logger.Info("Unsubscribed")
+	err := s.durableStream.Subscribe(
+		ctx, req.ApplicationIds, req.Consumer, req.Cursor,
+		func(ctx context.Context, id string, up *ttnpb.ApplicationUp) error {
+			if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+				return err
+			}
+			if err := stream.Send(&ttnpb.DurableApplicationUp{
+				Id: id,
+				Up: up,
+			}); err != nil {
+				logger.WithError(err).Warn("Failed to send message")
+				return err
+			}
+			return nil
+		},
+	)
+	if err != nil && ctx.Err() == nil {
+		logger.WithError(err).Warn("Failed to read durable subscription")
+		return errConnect.WithCause(err).WithAttributes("application_uid", uid)
+	}
+	return err
+}
+
+func (s *impl) AcknowledgeDurable(ctx context.Context, req *ttnpb.AcknowledgeDurableRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.durableStream == nil {
+		return nil, errDurableSubscriptionsUnavailable.New()
+	}
+	if err := s.durableStream.Ack(ctx, req.ApplicationIds, req.Consumer, req.Ids...); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (s *impl) DeleteDurableConsumer(ctx context.Context, req *ttnpb.DeleteDurableConsumerRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.durableStream == nil {
+		return nil, errDurableSubscriptionsUnavailable.New()
+	}
+	if err := s.durableStream.DeleteConsumer(ctx, req.ApplicationIds, req.Consumer); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
 func (s *impl) DownlinkQueuePush(ctx context.Context, req *ttnpb.DownlinkQueueRequest) (*pbtypes.Empty, error) {
 	if err := rights.RequireApplication(ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
 		return nil, err
@@ -361,6 +361,175 @@ func TestTraffic(t *testing.T) {
 	})
 }
 
+func TestSubscribeDurable(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	is, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+	is.ApplicationRegistry().Add(ctx, registeredApplicationID, registeredApplicationKey, testRights...)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	as := mock.NewServer(c)
+	durableStream := newMockStream()
+	srv := New(as, WithDurableStream(durableStream))
+	c.RegisterGRPC(&mockRegisterer{ctx, srv})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	client := ttnpb.NewAppAsClient(c.LoopbackConn())
+
+	creds := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     registeredApplicationKey,
+		AllowInsecure: true,
+	})
+	badCreds := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     "barfoo",
+		AllowInsecure: true,
+	})
+
+	// Subscribe: unauthorized.
+	{
+		stream, err := client.SubscribeDurable(ctx, &ttnpb.SubscribeDurableRequest{
+			ApplicationIds: registeredApplicationID,
+			Consumer:       "test-consumer",
+		}, badCreds)
+		if a.So(err, should.BeNil) {
+			_, err = stream.Recv()
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+	}
+
+	// Subscribe: invalid cursor.
+	{
+		stream, err := client.SubscribeDurable(ctx, &ttnpb.SubscribeDurableRequest{
+			ApplicationIds: registeredApplicationID,
+			Consumer:       "test-consumer",
+			Cursor:         "foo",
+		}, creds)
+		if a.So(err, should.BeNil) {
+			_, err = stream.Recv()
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+	}
+
+	upCh := make(chan *ttnpb.DurableApplicationUp, 1)
+	stream, err := client.SubscribeDurable(ctx, &ttnpb.SubscribeDurableRequest{
+		ApplicationIds: registeredApplicationID,
+		Consumer:       "test-consumer",
+		Cursor:         "1-0",
+	}, creds)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go func() {
+		for ctx.Err() == nil {
+			up, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			upCh <- up
+		}
+	}()
+
+	select {
+	case sub := <-durableStream.subscriptions:
+		a.So(sub.ids, should.Resemble, registeredApplicationID)
+		a.So(sub.consumer, should.Equal, "test-consumer")
+		a.So(sub.cursor, should.Equal, "1-0")
+	case <-time.After(timeout):
+		t.Fatal("Subscription timeout")
+	}
+
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: registeredApplicationID,
+			DeviceId:       "foo-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FrmPayload: []byte{0x01, 0x02, 0x03},
+			},
+		},
+	}
+	durableStream.messages <- mockStreamMessage{id: "2-0", up: up}
+	select {
+	case actual := <-upCh:
+		a.So(actual.Id, should.Equal, "2-0")
+		a.So(actual.Up, should.Resemble, up)
+	case <-time.After(timeout):
+		t.Fatal("Receive expected upstream message timeout")
+	}
+
+	// Acknowledge: unauthorized.
+	{
+		_, err := client.AcknowledgeDurable(ctx, &ttnpb.AcknowledgeDurableRequest{
+			ApplicationIds: registeredApplicationID,
+			Consumer:       "test-consumer",
+			Ids:            []string{"2-0"},
+		}, badCreds)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	}
+
+	// Acknowledge: happy flow.
+	{
+		_, err := client.AcknowledgeDurable(ctx, &ttnpb.AcknowledgeDurableRequest{
+			ApplicationIds: registeredApplicationID,
+			Consumer:       "test-consumer",
+			Ids:            []string{"2-0"},
+		}, creds)
+		a.So(err, should.BeNil)
+		select {
+		case ack := <-durableStream.acks:
+			a.So(ack.consumer, should.Equal, "test-consumer")
+			a.So(ack.messageIDs, should.Resemble, []string{"2-0"})
+		case <-time.After(timeout):
+			t.Fatal("Acknowledgement timeout")
+		}
+	}
+
+	// Delete consumer: unauthorized.
+	{
+		_, err := client.DeleteDurableConsumer(ctx, &ttnpb.DeleteDurableConsumerRequest{
+			ApplicationIds: registeredApplicationID,
+			Consumer:       "test-consumer",
+		}, badCreds)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	}
+
+	// Delete consumer: happy flow.
+	{
+		_, err := client.DeleteDurableConsumer(ctx, &ttnpb.DeleteDurableConsumerRequest{
+			ApplicationIds: registeredApplicationID,
+			Consumer:       "test-consumer",
+		}, creds)
+		a.So(err, should.BeNil)
+		select {
+		case consumer := <-durableStream.deletions:
+			a.So(consumer, should.Equal, "test-consumer")
+		case <-time.After(timeout):
+			t.Fatal("Consumer deletion timeout")
+		}
+	}
+}
+
 type mockMQTTConfigProvider struct {
 	config.MQTT
 }
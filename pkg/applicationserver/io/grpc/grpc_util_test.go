@@ -71,3 +71,67 @@ func (f *mockFetcher) Get(_ context.Context, ids *ttnpb.EndDeviceIdentifiers) (*
 	f.calledWithIdentifiers = ids
 	return f.ids, f.err
 }
+
+type mockStreamMessage struct {
+	id string
+	up *ttnpb.ApplicationUp
+}
+
+type mockStreamSubscription struct {
+	ids              *ttnpb.ApplicationIdentifiers
+	consumer, cursor string
+}
+
+type mockStreamAck struct {
+	consumer   string
+	messageIDs []string
+}
+
+type mockStream struct {
+	subscriptions chan mockStreamSubscription
+	messages      chan mockStreamMessage
+	acks          chan mockStreamAck
+	deletions     chan string
+}
+
+func newMockStream() *mockStream {
+	return &mockStream{
+		subscriptions: make(chan mockStreamSubscription, 1),
+		messages:      make(chan mockStreamMessage, 1),
+		acks:          make(chan mockStreamAck, 1),
+		deletions:     make(chan string, 1),
+	}
+}
+
+func (*mockStream) Publish(context.Context, *ttnpb.ApplicationUp) error {
+	return nil
+}
+
+func (s *mockStream) Subscribe(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	consumer, cursor string,
+	handler func(context.Context, string, *ttnpb.ApplicationUp) error,
+) error {
+	s.subscriptions <- mockStreamSubscription{ids, consumer, cursor}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg := <-s.messages:
+			if err := handler(ctx, msg.id, msg.up); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *mockStream) Ack(_ context.Context, _ *ttnpb.ApplicationIdentifiers, consumer string, messageIDs ...string) error {
+	s.acks <- mockStreamAck{consumer, messageIDs}
+	return nil
+}
+
+func (s *mockStream) DeleteConsumer(_ context.Context, _ *ttnpb.ApplicationIdentifiers, consumer string) error {
+	s.deletions <- consumer
+	return nil
+}
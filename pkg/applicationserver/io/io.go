@@ -262,6 +262,7 @@ func CleanDownlinks(items []*ttnpb.ApplicationDownlink) []*ttnpb.ApplicationDown
 			Priority:       item.Priority,
 			Confirmed:      item.Confirmed,
 			CorrelationIds: item.CorrelationIds,
+			Scheduling:     item.Scheduling,
 		})
 	}
 	return res
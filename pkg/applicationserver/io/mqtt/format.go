@@ -54,6 +54,8 @@ func TopicParts(up *io.ContextualApplicationUp, layout topics.Layout) []string {
 		f = layout.LocationSolvedTopic
 	case *ttnpb.ApplicationUp_ServiceData:
 		f = layout.ServiceDataTopic
+	case *ttnpb.ApplicationUp_SessionRecovered:
+		f = layout.SessionRecoveredTopic
 	default:
 		panic("unreachable")
 	}
@@ -24,7 +24,6 @@ import (
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
 	"github.com/TheThingsIndustries/mystique/pkg/packet"
-	"github.com/TheThingsIndustries/mystique/pkg/session"
 	"github.com/TheThingsIndustries/mystique/pkg/topic"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
 	ttsauth "go.thethings.network/lorawan-stack/v3/pkg/auth"
@@ -61,18 +60,20 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 type connection struct {
 	format   Format
 	server   io.Server
+	mqttConn mqttnet.Conn
 	io       *io.Subscription
 	resource ratelimit.Resource
 }
 
 func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server) error {
 	c := &connection{
-		format: format,
-		server: server,
+		format:   format,
+		server:   server,
+		mqttConn: mqttConn,
 	}
 
 	ctx = auth.NewContextWithInterface(ctx, c)
-	session := session.New(ctx, mqttConn, c.deliver)
+	session := mqtt.NewSession(ctx, mqttConn, c.deliver)
 	if err := session.ReadConnect(); err != nil {
 		if c.io != nil {
 			c.io.Disconnect(err)
@@ -101,12 +102,16 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 				}
 				topicName := topic.Join(topicParts)
 				logger.WithField("topic", topicName).Debug("Publish upstream message")
-				session.Publish(&packet.PublishPacket{
+				pkt := &packet.PublishPacket{
 					TopicName:  topicName,
 					TopicParts: topicParts,
 					QoS:        qosUpstream,
 					Message:    buf,
+				}
+				mqtt.SetPublishProperties(mqttConn, pkt, mqtt.PublishProperties{
+					CorrelationIDs: up.CorrelationIds,
 				})
+				session.Publish(pkt)
 			}
 		}
 	}
@@ -155,12 +160,7 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (_ context.Co
 		if err != nil {
 			registerConnectFail(ctx, ids, err)
 		}
-		switch {
-		case errors.IsPermissionDenied(err):
-			err = packet.ConnectNotAuthorized
-		case errors.IsResourceExhausted(err):
-			err = packet.ConnectServerUnavailable
-		}
+		err = mqtt.ConnectError(err)
 	}()
 
 	if err := rights.RequireApplication(ctx, ids); err != nil {
@@ -195,6 +195,7 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (_ context.Co
 			c.format.DownlinkQueueInvalidatedTopic(uid, topic.PartWildcard),
 			c.format.LocationSolvedTopic(uid, topic.PartWildcard),
 			c.format.ServiceDataTopic(uid, topic.PartWildcard),
+			c.format.SessionRecoveredTopic(uid, topic.PartWildcard),
 		)
 	}
 	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err == nil {
@@ -266,6 +267,11 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		logger.WithError(err).Warn("Failed to decode downlink messages")
 		return
 	}
+	if correlationIDs := mqtt.ReceivedPublishProperties(c.mqttConn, pkt).CorrelationIDs; len(correlationIDs) > 0 {
+		for _, item := range items.Downlinks {
+			item.CorrelationIds = append(item.CorrelationIds, correlationIDs...)
+		}
+	}
 	if err := items.ValidateFields(); err != nil {
 		logger.WithError(err).Warn("Failed to validate downlink messages")
 		return
@@ -30,6 +30,7 @@ type Layout interface {
 	DownlinkQueueInvalidatedTopic(applicationUID, deviceID string) []string
 	LocationSolvedTopic(applicationUID, deviceID string) []string
 	ServiceDataTopic(applicationUID, deviceID string) []string
+	SessionRecoveredTopic(applicationUID, deviceID string) []string
 
 	DownlinkPushTopic(applicationUID, deviceID string) []string
 	IsDownlinkPushTopic(parts []string) bool
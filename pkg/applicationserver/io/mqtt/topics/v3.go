@@ -88,6 +88,10 @@ func (v3) ServiceDataTopic(applicationUID, deviceID string) []string {
 	return []string{topicV3, applicationUID, "devices", deviceID, "service", "data"}
 }
 
+func (v3) SessionRecoveredTopic(applicationUID, deviceID string) []string {
+	return []string{topicV3, applicationUID, "devices", deviceID, "session", "recovered"}
+}
+
 func (v3) DownlinkPushTopic(applicationUID, deviceID string) []string {
 	return []string{topicV3, applicationUID, "devices", deviceID, "down", "push"}
 }
@@ -130,6 +130,10 @@ func TestV3Topics(t *testing.T) {
 			Fn:       topics.Default.ServiceDataTopic,
 			Expected: fmt.Sprintf("v3/%s/devices/%s/service/data", appUID, devID),
 		},
+		{
+			Fn:       topics.Default.SessionRecoveredTopic,
+			Expected: fmt.Sprintf("v3/%s/devices/%s/session/recovered", appUID, devID),
+		},
 		{
 			Fn:       topics.Default.DownlinkPushTopic,
 			Expected: fmt.Sprintf("v3/%s/devices/%s/down/push", appUID, devID),
@@ -0,0 +1,405 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mqttbridge implements a bridge that publishes the upstream traffic of all applications to an external
+// MQTT v5 server, and that handles the downlink messages published on that server.
+//
+// Upstream messages are published using the topic layout of the MQTT frontend, prefixed with the configured topic
+// prefix. They carry the application_id, device_id, dev_eui and correlation_id user properties.
+// Downlink messages are consumed from the push and replace topics, using a shared subscription if a shared group is
+// configured. The correlation_id user properties of downlink messages are added to their correlation IDs.
+//
+// The bridge uses a persistent session, so that the MQTT server retains the downlink subscription and undelivered
+// downlink messages while the bridge is disconnected. Downlink messages are acknowledged only after they have been
+// handled. Upstream messages are consumed from the subscription only while the bridge is connected and has fewer than
+// the configured number of messages in flight, so that the buffering of the subscription applies backpressure.
+package mqttbridge
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/eclipse/paho.golang/packets"
+	"github.com/eclipse/paho.golang/paho"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	applicationIDProperty = "application_id"
+	deviceIDProperty      = "device_id"
+	devEUIProperty        = "dev_eui"
+	correlationIDProperty = "correlation_id"
+
+	sharedSubscriptionPrefix = "$share"
+
+	defaultMaxInflight = 16
+)
+
+var (
+	errInvalidServer      = errors.DefineInvalidArgument("invalid_server", "invalid server `{server}`")
+	errInvalidScheme      = errors.DefineInvalidArgument("invalid_scheme", "invalid scheme `{scheme}`")
+	errInvalidQoS         = errors.DefineInvalidArgument("invalid_qos", "invalid QoS `{qos}`")
+	errInvalidTopicPrefix = errors.DefineInvalidArgument("invalid_topic_prefix", "invalid topic prefix `{prefix}`")
+	errConnect            = errors.DefineUnavailable("connect", "connect to MQTT server `{server}`")
+	errSubscribe          = errors.DefineUnavailable("subscribe", "subscribe to topic `{topic}`")
+	errDisconnected       = errors.DefineUnavailable("disconnected", "disconnected by MQTT server with reason code `{reason_code}`") //nolint:lll
+	errConnectionLost     = errors.DefineUnavailable("connection_lost", "connection to MQTT server lost")
+	errPublish            = errors.DefineUnavailable("publish", "publish to topic `{topic}`")
+	errInvalidTopic       = errors.DefineInvalidArgument("invalid_topic", "invalid topic `{topic}`")
+)
+
+// Bridge publishes upstream traffic to an MQTT v5 server and handles the downlink messages published on it.
+type Bridge struct {
+	server    io.Server
+	format    mqtt.Format
+	config    Config
+	address   string
+	tlsConfig *tls.Config
+	clientID  string
+	prefix    []string
+}
+
+// New returns a new Bridge which subscribes to the upstream traffic of all applications and starts forwarding it to
+// the MQTT server. It returns nil if the bridge is not enabled in the configuration.
+func New(ctx context.Context, server io.Server, conf Config) (*Bridge, error) {
+	if !conf.Enabled() {
+		return nil, nil
+	}
+	u, err := url.Parse(conf.Server)
+	if err != nil || u.Host == "" {
+		return nil, errInvalidServer.WithAttributes("server", conf.Server)
+	}
+	b := &Bridge{
+		server:   server,
+		format:   mqtt.JSON,
+		config:   conf,
+		clientID: conf.ClientID,
+	}
+	var defaultPort string
+	switch u.Scheme {
+	case "mqtt", "tcp":
+		defaultPort = "1883"
+	case "mqtts", "ssl", "tls":
+		defaultPort = "8883"
+		b.tlsConfig = &tls.Config{
+			ServerName: u.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		}
+		if err := conf.TLS.ApplyTo(b.tlsConfig); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errInvalidScheme.WithAttributes("scheme", u.Scheme)
+	}
+	b.address = u.Host
+	if u.Port() == "" {
+		b.address = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+	if conf.QoS < 0 || conf.QoS > 2 {
+		return nil, errInvalidQoS.WithAttributes("qos", conf.QoS)
+	}
+	if conf.TopicPrefix != "" {
+		if topic.ValidateTopic(conf.TopicPrefix) != nil {
+			return nil, errInvalidTopicPrefix.WithAttributes("prefix", conf.TopicPrefix)
+		}
+		b.prefix = topic.Split(conf.TopicPrefix)
+	}
+	if b.config.MaxInflight <= 0 {
+		b.config.MaxInflight = defaultMaxInflight
+	}
+	if b.clientID == "" {
+		if b.clientID, err = os.Hostname(); err != nil {
+			return nil, err
+		}
+	}
+
+	sub, err := server.Subscribe(ctx, "mqtt-bridge", nil, false)
+	if err != nil {
+		return nil, err
+	}
+	server.StartTask(&task.Config{
+		Context: sub.Context(),
+		ID:      "mqtt_bridge",
+		Func: func(ctx context.Context) error {
+			return b.run(ctx, sub)
+		},
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	return b, nil
+}
+
+func (b *Bridge) dial(ctx context.Context) (net.Conn, error) {
+	if b.tlsConfig != nil {
+		dialer := &tls.Dialer{
+			Config: b.tlsConfig,
+		}
+		return dialer.DialContext(ctx, "tcp", b.address)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", b.address)
+}
+
+// downlinkTopics returns the topics of the downlink queue operations.
+// The operations are subscribed to individually, since a wildcard would also match the downlink events that the
+// bridge publishes itself, and the no local option cannot be used with shared subscriptions.
+func (b *Bridge) downlinkTopics() []string {
+	topics := make([]string, 0, 2)
+	for _, f := range []func(string, string) []string{
+		b.format.DownlinkPushTopic,
+		b.format.DownlinkReplaceTopic,
+	} {
+		parts := make([]string, 0, len(b.prefix)+6)
+		parts = append(parts, b.prefix...)
+		// The topic layout is not aware of the topic prefix. The topic is parsed after stripping the prefix.
+		parts = append(parts, f(topic.PartWildcard, topic.PartWildcard)...)
+		if b.config.SharedGroup != "" {
+			parts = append([]string{sharedSubscriptionPrefix, b.config.SharedGroup}, parts...)
+		}
+		topics = append(topics, topic.Join(parts))
+	}
+	return topics
+}
+
+// run connects to the MQTT server and forwards traffic until the connection is lost or the context is done.
+func (b *Bridge) run(ctx context.Context, sub *io.Subscription) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	logger := log.FromContext(ctx).WithField("server", b.address)
+
+	conn, err := b.dial(ctx)
+	if err != nil {
+		return errConnect.WithAttributes("server", b.address).WithCause(err)
+	}
+	errCh := make(chan error, 1)
+	reportErr := func(err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	}
+	client := paho.NewClient(paho.ClientConfig{
+		ClientID:                   b.clientID,
+		Conn:                       packets.NewThreadSafeConn(conn),
+		EnableManualAcknowledgment: true,
+		OnClientError: func(err error) {
+			reportErr(errConnectionLost.WithCause(err))
+		},
+		OnServerDisconnect: func(d *paho.Disconnect) {
+			reportErr(errDisconnected.WithAttributes("reason_code", d.ReasonCode))
+		},
+	})
+	client.Router = paho.NewSingleHandlerRouter(func(msg *paho.Publish) {
+		b.handleDownlink(ctx, msg)
+		if err := client.Ack(msg); err != nil {
+			logger.WithError(err).Warn("Failed to acknowledge downlink message")
+		}
+	})
+
+	sessionExpiry := uint32(b.config.SessionExpiry / time.Second)
+	connect := &paho.Connect{
+		ClientID:   b.clientID,
+		KeepAlive:  uint16(b.config.KeepAlive / time.Second),
+		CleanStart: false,
+		Properties: &paho.ConnectProperties{
+			SessionExpiryInterval: &sessionExpiry,
+		},
+	}
+	if b.config.Username != "" {
+		connect.UsernameFlag, connect.Username = true, b.config.Username
+	}
+	if b.config.Password != "" {
+		connect.PasswordFlag, connect.Password = true, []byte(b.config.Password)
+	}
+	connack, err := client.Connect(ctx, connect)
+	if err != nil {
+		return errConnect.WithAttributes("server", b.address).WithCause(err)
+	}
+	defer client.Disconnect(&paho.Disconnect{ReasonCode: 0}) //nolint:errcheck
+	logger.WithField("session_present", connack.SessionPresent).Info("Connected to MQTT server")
+
+	for _, downlinkTopic := range b.downlinkTopics() {
+		if _, err := client.Subscribe(ctx, &paho.Subscribe{
+			Subscriptions: map[string]paho.SubscribeOptions{
+				downlinkTopic: {QoS: 1},
+			},
+		}); err != nil {
+			return errSubscribe.WithAttributes("topic", downlinkTopic).WithCause(err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	inflight := make(chan struct{}, b.config.MaxInflight)
+	for {
+		// Acquire an in-flight slot before taking a message from the subscription, so that the subscription buffers
+		// (or blocks) while the MQTT server is slow to acknowledge messages.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-errCh:
+			return err
+		case inflight <- struct{}{}:
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-sub.Context().Done():
+			return sub.Context().Err()
+		case err := <-errCh:
+			return err
+		case up := <-sub.Up():
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-inflight
+					wg.Done()
+				}()
+				if err := b.publishUp(ctx, client, up); err != nil {
+					log.FromContext(up.Context).WithError(err).Warn("Failed to publish upstream message")
+					registerUplinkFailed(up.Context, up.EndDeviceIds.ApplicationIds.ApplicationId)
+					return
+				}
+				registerUplinkPublished(up.Context, up.EndDeviceIds.ApplicationIds.ApplicationId)
+			}()
+		}
+	}
+}
+
+func (b *Bridge) publishUp(ctx context.Context, client *paho.Client, up *io.ContextualApplicationUp) error {
+	buf, err := b.format.FromUp(up.ApplicationUp)
+	if err != nil {
+		return err
+	}
+	parts := make([]string, 0, len(b.prefix)+6)
+	parts = append(parts, b.prefix...)
+	parts = append(parts, mqtt.TopicParts(up, b.format)...)
+	topicName := topic.Join(parts)
+
+	properties := &paho.PublishProperties{
+		ContentType: "application/json",
+	}
+	properties.User.Add(applicationIDProperty, up.EndDeviceIds.ApplicationIds.ApplicationId)
+	properties.User.Add(deviceIDProperty, up.EndDeviceIds.DeviceId)
+	if devEUI := types.MustEUI64(up.EndDeviceIds.DevEui).OrZero(); !devEUI.IsZero() {
+		properties.User.Add(devEUIProperty, devEUI.String())
+	}
+	for _, id := range up.CorrelationIds {
+		properties.User.Add(correlationIDProperty, id)
+	}
+	if _, err := client.Publish(ctx, &paho.Publish{
+		QoS:        byte(b.config.QoS),
+		Topic:      topicName,
+		Payload:    buf,
+		Properties: properties,
+	}); err != nil {
+		return errPublish.WithAttributes("topic", topicName).WithCause(err)
+	}
+	return nil
+}
+
+func (b *Bridge) handleDownlink(ctx context.Context, msg *paho.Publish) {
+	logger := log.FromContext(ctx).WithField("topic", msg.Topic)
+	ids, op, err := b.parseDownlinkTopic(ctx, msg.Topic)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to parse downlink topic")
+		return
+	}
+	appID := ids.ApplicationIds.ApplicationId
+	registerDownlinkReceived(ctx, appID)
+	items, err := b.format.ToDownlinks(msg.Payload)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to decode downlink messages")
+		registerDownlinkFailed(ctx, appID)
+		return
+	}
+	if err := items.ValidateFields(); err != nil {
+		logger.WithError(err).Warn("Failed to validate downlink messages")
+		registerDownlinkFailed(ctx, appID)
+		return
+	}
+	var correlationIDs []string
+	if msg.Properties != nil {
+		correlationIDs = msg.Properties.User.GetAll(correlationIDProperty)
+	}
+	if len(correlationIDs) > 0 {
+		ctx = events.ContextWithCorrelationID(ctx, correlationIDs...)
+		for _, item := range items.Downlinks {
+			item.CorrelationIds = append(item.CorrelationIds, correlationIDs...)
+		}
+	}
+	logger.WithFields(log.Fields(
+		"device_uid", unique.ID(ctx, ids),
+		"count", len(items.Downlinks),
+	)).Debug("Handle downlink messages")
+	if err := op(b.server, ctx, ids, items.Downlinks); err != nil {
+		logger.WithError(err).Warn("Failed to handle downlink messages")
+		registerDownlinkFailed(ctx, appID)
+	}
+}
+
+type downlinkQueueOperation func(io.Server, context.Context, *ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
+
+func (b *Bridge) parseDownlinkTopic(
+	ctx context.Context, topicName string,
+) (*ttnpb.EndDeviceIdentifiers, downlinkQueueOperation, error) {
+	parts := topic.Split(topicName)
+	if len(parts) < len(b.prefix) {
+		return nil, nil, errInvalidTopic.WithAttributes("topic", topicName)
+	}
+	for i, part := range b.prefix {
+		if parts[i] != part {
+			return nil, nil, errInvalidTopic.WithAttributes("topic", topicName)
+		}
+	}
+	parts = parts[len(b.prefix):]
+	var deviceID string
+	var op downlinkQueueOperation
+	switch {
+	case b.format.IsDownlinkPushTopic(parts):
+		deviceID = b.format.ParseDownlinkPushTopic(parts)
+		op = io.Server.DownlinkQueuePush
+	case b.format.IsDownlinkReplaceTopic(parts):
+		deviceID = b.format.ParseDownlinkReplaceTopic(parts)
+		op = io.Server.DownlinkQueueReplace
+	default:
+		return nil, nil, errInvalidTopic.WithAttributes("topic", topicName)
+	}
+	appIDs, err := unique.ToApplicationID(parts[1])
+	if err != nil {
+		return nil, nil, errInvalidTopic.WithAttributes("topic", topicName).WithCause(err)
+	}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appIDs,
+		DeviceId:       deviceID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, nil, err
+	}
+	return ids, op, nil
+}
@@ -0,0 +1,267 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqttbridge_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/eclipse/paho.golang/packets"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqttbridge"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+// serveBroker accepts a single connection on the listener and acts as an MQTT v5 server.
+// Received CONNECT, SUBSCRIBE, PUBLISH and PUBACK packets are sent on the returned channel.
+func serveBroker(t *testing.T, lis net.Listener) (<-chan packets.Packet, <-chan net.Conn) {
+	t.Helper()
+	packetCh := make(chan packets.Packet, 16)
+	connCh := make(chan net.Conn, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		conn = packets.NewThreadSafeConn(conn)
+		connCh <- conn
+		for {
+			pkt, err := packets.ReadPacket(conn)
+			if err != nil {
+				return
+			}
+			var res packets.Packet
+			switch p := pkt.Content.(type) {
+			case *packets.Connect:
+				res = &packets.Connack{
+					Properties: &packets.Properties{},
+				}
+			case *packets.Subscribe:
+				res = &packets.Suback{
+					Properties: &packets.Properties{},
+					PacketID:   p.PacketID,
+					Reasons:    []byte{1},
+				}
+			case *packets.Publish:
+				res = &packets.Puback{
+					Properties: &packets.Properties{},
+					PacketID:   p.PacketID,
+				}
+			case *packets.Pingreq:
+				res = &packets.Pingresp{}
+			}
+			packetCh <- pkt.Content
+			if res != nil {
+				if _, err := res.WriteTo(conn); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return packetCh, connCh
+}
+
+func expectPacket(t *testing.T, ch <-chan packets.Packet) packets.Packet {
+	t.Helper()
+	select {
+	case pkt := <-ch:
+		return pkt
+	case <-time.After(timeout):
+		t.Fatal("Timeout waiting for packet")
+		return nil
+	}
+}
+
+func TestBridge(t *testing.T) {
+	a, ctx := test.New(t)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lis.Close()
+	packetCh, connCh := serveBroker(t, lis)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	as := mock.NewServer(c)
+	_, err = New(ctx, as, Config{
+		Server:        fmt.Sprintf("mqtt://%s", lis.Addr()),
+		ClientID:      "test-bridge",
+		TopicPrefix:   "lorawan/as",
+		QoS:           1,
+		SharedGroup:   "test-group",
+		SessionExpiry: time.Hour,
+		KeepAlive:     time.Minute,
+		MaxInflight:   1,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The bridge connects with a persistent session.
+	connect, ok := expectPacket(t, packetCh).(*packets.Connect)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(connect.ClientID, should.Equal, "test-bridge")
+	a.So(connect.CleanStart, should.BeFalse)
+	if a.So(connect.Properties.SessionExpiryInterval, should.NotBeNil) {
+		a.So(*connect.Properties.SessionExpiryInterval, should.Equal, 3600)
+	}
+
+	// The bridge subscribes to the downlink topics using a shared subscription.
+	// The bridge does not subscribe to the downlink events that it publishes itself.
+	for _, topic := range []string{
+		"$share/test-group/lorawan/as/v3/+/devices/+/down/push",
+		"$share/test-group/lorawan/as/v3/+/devices/+/down/replace",
+	} {
+		subscribe, ok := expectPacket(t, packetCh).(*packets.Subscribe)
+		if !a.So(ok, should.BeTrue) {
+			t.FailNow()
+		}
+		a.So(subscribe.Subscriptions, should.HaveLength, 1)
+		a.So(subscribe.Subscriptions, should.ContainKey, topic)
+	}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+		DevEui:   []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+	}
+
+	// Upstream messages are published with user properties.
+	err = as.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: []string{"test:up:1", "test:up:2"},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      42,
+				FrmPayload: []byte{0x01, 0x02},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	publish, ok := expectPacket(t, packetCh).(*packets.Publish)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(publish.Topic, should.Equal, "lorawan/as/v3/foo-app/devices/foo-device/up")
+	a.So(publish.QoS, should.Equal, 1)
+	a.So(publish.Properties.User, should.Resemble, []packets.User{
+		{Key: "application_id", Value: "foo-app"},
+		{Key: "device_id", Value: "foo-device"},
+		{Key: "dev_eui", Value: "0102030405060708"},
+		{Key: "correlation_id", Value: "test:up:1"},
+		{Key: "correlation_id", Value: "test:up:2"},
+	})
+
+	// Downlink messages are pushed to the queue and acknowledged.
+	var conn net.Conn
+	select {
+	case conn = <-connCh:
+	case <-time.After(timeout):
+		t.Fatal("Timeout waiting for connection")
+	}
+	down := &packets.Publish{
+		Topic:    "lorawan/as/v3/foo-app/devices/foo-device/down/push",
+		QoS:      1,
+		PacketID: 42,
+		Payload:  []byte(`{"downlinks":[{"f_port":42,"frm_payload":"AQI=","priority":"NORMAL"}]}`),
+		Properties: &packets.Properties{
+			User: []packets.User{
+				{Key: "correlation_id", Value: "test:down:1"},
+			},
+		},
+	}
+	if _, err := down.WriteTo(conn); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	puback, ok := expectPacket(t, packetCh).(*packets.Puback)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(puback.PacketID, should.Equal, 42)
+
+	queue, err := as.DownlinkQueueList(ctx, ids)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(queue, should.Resemble, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:          42,
+			FrmPayload:     []byte{0x01, 0x02},
+			Priority:       ttnpb.TxSchedulePriority_NORMAL,
+			CorrelationIds: []string{"test:down:1"},
+		},
+	})
+}
+
+func TestBridgeConfig(t *testing.T) {
+	a, ctx := test.New(t)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	as := mock.NewServer(c)
+	for _, tc := range []struct {
+		Name   string
+		Config Config
+		OK     bool
+	}{
+		{
+			Name: "Disabled",
+			OK:   true,
+		},
+		{
+			Name:   "InvalidScheme",
+			Config: Config{Server: "http://localhost:1883"},
+		},
+		{
+			Name:   "InvalidServer",
+			Config: Config{Server: "localhost"},
+		},
+		{
+			Name:   "InvalidQoS",
+			Config: Config{Server: "mqtt://localhost", QoS: 3},
+		},
+		{
+			Name:   "InvalidTopicPrefix",
+			Config: Config{Server: "mqtt://localhost", TopicPrefix: "foo/#"},
+		},
+	} {
+		_, err := New(ctx, as, tc.Config)
+		if tc.OK {
+			a.So(err, should.BeNil)
+		} else {
+			a.So(err, should.NotBeNil)
+		}
+	}
+}
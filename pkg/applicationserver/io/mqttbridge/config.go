@@ -0,0 +1,41 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqttbridge
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+)
+
+// Config represents the configuration of the MQTT bridge.
+type Config struct {
+	Server        string           `name:"server" description:"Address of the MQTT v5 server (mqtt://host:port or mqtts://host:port). Leave empty to disable the bridge"` //nolint:lll
+	ClientID      string           `name:"client-id" description:"Client identifier of the bridge. The host name is used if empty"`
+	Username      string           `name:"username" description:"Username used to authenticate with the MQTT server"`
+	Password      string           `name:"password" description:"Password used to authenticate with the MQTT server"`
+	TopicPrefix   string           `name:"topic-prefix" description:"Prefix of the topics of upstream and downlink messages"`
+	QoS           int              `name:"qos" description:"QoS of the upstream messages (0, 1 or 2)"`
+	SharedGroup   string           `name:"shared-group" description:"Shared subscription group used for downlink messages. Leave empty to use a regular subscription"` //nolint:lll
+	SessionExpiry time.Duration    `name:"session-expiry" description:"Time for which the MQTT server retains the session of the bridge after a disconnect"`           //nolint:lll
+	KeepAlive     time.Duration    `name:"keep-alive" description:"Keep alive interval of the connection"`
+	MaxInflight   int              `name:"max-inflight" description:"Maximum number of upstream messages that are published concurrently"`
+	TLS           tlsconfig.Client `name:"tls"`
+}
+
+// Enabled returns whether the bridge is enabled.
+func (c Config) Enabled() bool {
+	return c.Server != ""
+}
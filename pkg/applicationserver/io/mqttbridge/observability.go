@@ -0,0 +1,103 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqttbridge
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const (
+	subsystem          = "as_mqtt_bridge"
+	applicationIDLabel = "application_id"
+)
+
+var bridgeMetrics = &messageMetrics{
+	uplinkPublished: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_published_total",
+			Help:      "Total number of upstream messages published to the MQTT server",
+		},
+		[]string{applicationIDLabel},
+	),
+	uplinkFailed: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_failed_total",
+			Help:      "Total number of upstream messages that failed to be published to the MQTT server",
+		},
+		[]string{applicationIDLabel},
+	),
+	downlinkReceived: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "downlink_received_total",
+			Help:      "Total number of downlink messages received from the MQTT server",
+		},
+		[]string{applicationIDLabel},
+	),
+	downlinkFailed: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "downlink_failed_total",
+			Help:      "Total number of downlink messages received from the MQTT server that failed to be handled",
+		},
+		[]string{applicationIDLabel},
+	),
+}
+
+func init() {
+	metrics.MustRegister(bridgeMetrics)
+}
+
+type messageMetrics struct {
+	uplinkPublished  *metrics.ContextualCounterVec
+	uplinkFailed     *metrics.ContextualCounterVec
+	downlinkReceived *metrics.ContextualCounterVec
+	downlinkFailed   *metrics.ContextualCounterVec
+}
+
+func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.uplinkPublished.Describe(ch)
+	m.uplinkFailed.Describe(ch)
+	m.downlinkReceived.Describe(ch)
+	m.downlinkFailed.Describe(ch)
+}
+
+func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.uplinkPublished.Collect(ch)
+	m.uplinkFailed.Collect(ch)
+	m.downlinkReceived.Collect(ch)
+	m.downlinkFailed.Collect(ch)
+}
+
+func registerUplinkPublished(ctx context.Context, applicationID string) {
+	bridgeMetrics.uplinkPublished.WithLabelValues(ctx, applicationID).Inc()
+}
+
+func registerUplinkFailed(ctx context.Context, applicationID string) {
+	bridgeMetrics.uplinkFailed.WithLabelValues(ctx, applicationID).Inc()
+}
+
+func registerDownlinkReceived(ctx context.Context, applicationID string) {
+	bridgeMetrics.downlinkReceived.WithLabelValues(ctx, applicationID).Inc()
+}
+
+func registerDownlinkFailed(ctx context.Context, applicationID string) {
+	bridgeMetrics.downlinkFailed.WithLabelValues(ctx, applicationID).Inc()
+}
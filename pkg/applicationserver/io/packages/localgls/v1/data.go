@@ -0,0 +1,185 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errInvalidType  = errors.DefineCorruption("invalid_type", "wrong type `{type}`")
+	errInvalidValue = errors.DefineCorruption("invalid_value", "wrong value `{value}`")
+)
+
+// QueryType enum defines the location query types of the package.
+type QueryType uint8
+
+// Value returns the protobuf value for the query type.
+func (t QueryType) Value() *types.Value {
+	var s string
+	switch t {
+	case QUERY_TDOA:
+		s = "TDOA"
+	case QUERY_RSSI:
+		s = "RSSI"
+	case QUERY_TDOARSSI:
+		s = "TDOARSSI"
+	default:
+		panic("invalid query type")
+	}
+	return toString(s)
+}
+
+// FromValue sets the query type from a protobuf value.
+func (t *QueryType) FromValue(v *types.Value) error {
+	s, err := stringFromValue(v)
+	if err != nil {
+		return err
+	}
+	switch s {
+	case "TDOA":
+		*t = QUERY_TDOA
+	case "RSSI":
+		*t = QUERY_RSSI
+	case "TDOARSSI":
+		*t = QUERY_TDOARSSI
+	default:
+		return errInvalidValue.WithAttributes("value", s)
+	}
+	return nil
+}
+
+const (
+	// QUERY_TDOA uses the fine timestamps of at least three gateways to compute the location of the end device.
+	QUERY_TDOA QueryType = iota + 1
+	// QUERY_RSSI uses the RSSI of the gateways to compute the location of the end device.
+	QUERY_RSSI
+	// QUERY_TDOARSSI uses the fine timestamps if available from at least three gateways, and falls back to the RSSI.
+	QUERY_TDOARSSI
+)
+
+const (
+	// DefaultReferenceRSSI is the default RSSI at one meter distance from the end device in dBm.
+	DefaultReferenceRSSI = -20.0
+	// DefaultPathLossExponent is the default path loss exponent of the log-distance path loss model.
+	DefaultPathLossExponent = 2.7
+)
+
+// Data contains the package configuration.
+type Data struct {
+	// Query is the query type used by the package. The zero value means QUERY_TDOARSSI.
+	Query QueryType
+	// ReferenceRSSI is the RSSI at one meter distance from the end device in dBm, used for RSSI queries.
+	// The zero value means DefaultReferenceRSSI.
+	ReferenceRSSI float64
+	// PathLossExponent is the path loss exponent of the log-distance path loss model, used for RSSI queries.
+	// The zero value means DefaultPathLossExponent.
+	PathLossExponent float64
+}
+
+const (
+	queryField            = "query"
+	referenceRSSIField    = "reference_rssi"
+	pathLossExponentField = "path_loss_exponent"
+)
+
+func toString(s string) *types.Value {
+	return &types.Value{
+		Kind: &types.Value_StringValue{
+			StringValue: s,
+		},
+	}
+}
+
+func toFloat64(f float64) *types.Value {
+	return &types.Value{
+		Kind: &types.Value_NumberValue{
+			NumberValue: f,
+		},
+	}
+}
+
+// Struct serializes the configuration to *types.Struct.
+func (d *Data) Struct() *types.Struct {
+	st := &types.Struct{
+		Fields: map[string]*types.Value{},
+	}
+	if d.Query != 0 {
+		st.Fields[queryField] = d.Query.Value()
+	}
+	if d.ReferenceRSSI != 0 {
+		st.Fields[referenceRSSIField] = toFloat64(d.ReferenceRSSI)
+	}
+	if d.PathLossExponent != 0 {
+		st.Fields[pathLossExponentField] = toFloat64(d.PathLossExponent)
+	}
+	return st
+}
+
+func stringFromValue(v *types.Value) (string, error) {
+	sv, ok := v.Kind.(*types.Value_StringValue)
+	if !ok {
+		return "", errInvalidType.WithAttributes("type", fmt.Sprintf("%T", v.Kind))
+	}
+	return sv.StringValue, nil
+}
+
+func float64FromValue(v *types.Value) (float64, error) {
+	fv, ok := v.Kind.(*types.Value_NumberValue)
+	if !ok {
+		return 0.0, errInvalidType.WithAttributes("type", fmt.Sprintf("%T", v.Kind))
+	}
+	return fv.NumberValue, nil
+}
+
+// FromStruct deserializes the configuration from *types.Struct.
+func (d *Data) FromStruct(st *types.Struct) error {
+	fields := st.GetFields()
+	{
+		value, ok := fields[queryField]
+		if ok {
+			if err := d.Query.FromValue(value); err != nil {
+				return err
+			}
+		}
+	}
+	{
+		value, ok := fields[referenceRSSIField]
+		if ok {
+			referenceRSSI, err := float64FromValue(value)
+			if err != nil {
+				return err
+			}
+			d.ReferenceRSSI = referenceRSSI
+		}
+	}
+	{
+		value, ok := fields[pathLossExponentField]
+		if ok {
+			pathLossExponent, err := float64FromValue(value)
+			if err != nil {
+				return err
+			}
+			if pathLossExponent <= 0 {
+				return errInvalidValue.WithAttributes("value", pathLossExponent)
+			}
+			d.PathLossExponent = pathLossExponent
+		}
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localgeolocationv1 implements an application package that solves the location of end devices locally,
+// using the fine timestamps and the RSSI of the gateways that received the uplink message.
+package localgeolocationv1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// PackageName defines the package name.
+const PackageName = "local-geolocation-v1"
+
+// GeolocationPackage is the local geolocation application package.
+type GeolocationPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+var errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) HandleUp(ctx context.Context, def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/localgls/v1")
+	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIds, fmt.Sprintf("as:packages:localglsv1:%s", events.NewCorrelationID()))...)
+
+	if def == nil && assoc == nil {
+		return errNoAssociation.New()
+	}
+
+	defer func() {
+		if err != nil {
+			registerPackageFail(ctx, up.EndDeviceIds, err)
+		}
+	}()
+
+	data, err := p.mergePackageData(def, assoc)
+	if err != nil {
+		return err
+	}
+
+	switch m := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		loc, algorithm, ok := solve(m.UplinkMessage.RxMetadata, data)
+		if !ok {
+			log.FromContext(ctx).Debug("Not enough gateway metadata to solve location")
+			return nil
+		}
+		return p.sendLocationSolved(ctx, up.EndDeviceIds, loc, algorithm)
+	default:
+		return nil
+	}
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (p *GeolocationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 198,
+	}
+}
+
+// New instantiates the local geolocation package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &GeolocationPackage{
+		server:   server,
+		registry: registry,
+	}
+}
+
+// solve solves the location of the end device from the gateway metadata according to the query type.
+// It returns the location and the algorithm that was used to solve it.
+func solve(mds []*ttnpb.RxMetadata, data *Data) (*ttnpb.Location, string, bool) {
+	receivers, proj := receiversFromMetadata(mds)
+	if len(receivers) == 0 {
+		return nil, "", false
+	}
+	var (
+		sol       solution
+		ok        bool
+		algorithm string
+		source    ttnpb.LocationSource
+	)
+	if data.Query == QUERY_TDOA || data.Query == QUERY_TDOARSSI {
+		sol, ok = solveTDOA(receivers)
+		algorithm, source = "tdoa", ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION
+	}
+	if !ok && (data.Query == QUERY_RSSI || data.Query == QUERY_TDOARSSI) {
+		sol, ok = solveRSSI(receivers, data.ReferenceRSSI, data.PathLossExponent)
+		algorithm, source = "rssi", ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION
+	}
+	if !ok {
+		return nil, "", false
+	}
+	lat, lon := proj.toGlobal(sol.x, sol.y)
+	return &ttnpb.Location{
+		Latitude:  lat,
+		Longitude: lon,
+		Accuracy:  int32(math.Min(math.Ceil(sol.accuracy), math.MaxInt32)),
+		Source:    source,
+	}, algorithm, true
+}
+
+func (p *GeolocationPackage) sendLocationSolved(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, loc *ttnpb.Location, algorithm string) error {
+	return p.server.Publish(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		ReceivedAt:     ttnpb.ProtoTimePtr(time.Now()),
+		Up: &ttnpb.ApplicationUp_LocationSolved{
+			LocationSolved: &ttnpb.ApplicationLocation{
+				Service:  fmt.Sprintf("%v-%s", PackageName, algorithm),
+				Location: loc,
+			},
+		},
+	})
+}
+
+func (p *GeolocationPackage) mergePackageData(def *ttnpb.ApplicationPackageDefaultAssociation, assoc *ttnpb.ApplicationPackageAssociation) (*Data, error) {
+	var defaultData, associationData Data
+	if def != nil {
+		if err := defaultData.FromStruct(def.Data); err != nil {
+			return nil, err
+		}
+	}
+	if assoc != nil {
+		if err := associationData.FromStruct(assoc.Data); err != nil {
+			return nil, err
+		}
+	}
+	merged := Data{
+		Query:            QUERY_TDOARSSI,
+		ReferenceRSSI:    DefaultReferenceRSSI,
+		PathLossExponent: DefaultPathLossExponent,
+	}
+	for _, data := range []*Data{
+		&defaultData,
+		&associationData,
+	} {
+		if data.Query != 0 {
+			merged.Query = data.Query
+		}
+		if data.ReferenceRSSI != 0 {
+			merged.ReferenceRSSI = data.ReferenceRSSI
+		}
+		if data.PathLossExponent != 0 {
+			merged.PathLossExponent = data.PathLossExponent
+		}
+	}
+	return &merged, nil
+}
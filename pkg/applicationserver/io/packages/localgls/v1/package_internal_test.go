@@ -0,0 +1,291 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// distance returns the great-circle distance between two coordinates in meters.
+func distance(lat1, lon1, lat2, lon2 float64) float64 {
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat, dLon := rad(lat2-lat1), rad(lon2-lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(rad(lat1))*math.Cos(rad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+type syntheticGateway struct {
+	lat, lon    float64
+	noTimestamp bool
+}
+
+// syntheticMetadata returns the metadata of an uplink message transmitted at the given location, which is received
+// by the gateways at the given nanosecond within the GPS second. The RSSI follows the default path loss model.
+func syntheticMetadata(lat, lon float64, transmitAt uint64, gateways ...syntheticGateway) []*ttnpb.RxMetadata {
+	mds := make([]*ttnpb.RxMetadata, 0, len(gateways))
+	for i, gtw := range gateways {
+		d := distance(lat, lon, gtw.lat, gtw.lon)
+		md := &ttnpb.RxMetadata{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-" + string(rune('a'+i))},
+			Rssi:       float32(DefaultReferenceRSSI - 10*DefaultPathLossExponent*math.Log10(d)),
+			Location: &ttnpb.Location{
+				Latitude:  gtw.lat,
+				Longitude: gtw.lon,
+				Source:    ttnpb.LocationSource_SOURCE_REGISTRY,
+			},
+		}
+		if !gtw.noTimestamp {
+			md.FineTimestamp = (transmitAt + uint64(math.Round(d/speedOfLight*1e9))) % 1e9
+		}
+		mds = append(mds, md)
+	}
+	return mds
+}
+
+var (
+	deviceLat, deviceLon = 52.3676, 4.9041
+	syntheticGateways    = []syntheticGateway{
+		{lat: 52.3900, lon: 4.8800},
+		{lat: 52.3500, lon: 4.8600},
+		{lat: 52.3550, lon: 4.9500},
+		{lat: 52.3950, lon: 4.9400},
+		{lat: 52.3700, lon: 4.9900},
+	}
+)
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name              string
+		Metadata          []*ttnpb.RxMetadata
+		Query             QueryType
+		ExpectedAlgorithm string
+		MaxError          float64
+		NoSolution        bool
+	}{
+		{
+			Name:              "TDOA/FiveGateways",
+			Metadata:          syntheticMetadata(deviceLat, deviceLon, 123456789, syntheticGateways...),
+			Query:             QUERY_TDOA,
+			ExpectedAlgorithm: "tdoa",
+			MaxError:          10,
+		},
+		{
+			Name:              "TDOA/ThreeGateways",
+			Metadata:          syntheticMetadata(deviceLat, deviceLon, 123456789, syntheticGateways[:3]...),
+			Query:             QUERY_TDOA,
+			ExpectedAlgorithm: "tdoa",
+			MaxError:          10,
+		},
+		{
+			Name:              "TDOA/SecondWraparound",
+			Metadata:          syntheticMetadata(deviceLat, deviceLon, 999990000, syntheticGateways...),
+			Query:             QUERY_TDOA,
+			ExpectedAlgorithm: "tdoa",
+			MaxError:          10,
+		},
+		{
+			Name:       "TDOA/TwoGateways",
+			Metadata:   syntheticMetadata(deviceLat, deviceLon, 123456789, syntheticGateways[:2]...),
+			Query:      QUERY_TDOA,
+			NoSolution: true,
+		},
+		{
+			Name: "TDOA/NoLocation",
+			Metadata: []*ttnpb.RxMetadata{
+				{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-a"}, FineTimestamp: 1},
+			},
+			Query:      QUERY_TDOARSSI,
+			NoSolution: true,
+		},
+		{
+			Name:              "RSSI/FiveGateways",
+			Metadata:          syntheticMetadata(deviceLat, deviceLon, 123456789, syntheticGateways...),
+			Query:             QUERY_RSSI,
+			ExpectedAlgorithm: "rssi",
+			MaxError:          50,
+		},
+		{
+			Name:              "RSSI/OneGateway",
+			Metadata:          syntheticMetadata(deviceLat, deviceLon, 123456789, syntheticGateways[0]),
+			Query:             QUERY_RSSI,
+			ExpectedAlgorithm: "rssi",
+			MaxError:          distance(deviceLat, deviceLon, syntheticGateways[0].lat, syntheticGateways[0].lon),
+		},
+		{
+			Name: "TDOARSSI/Fallback",
+			Metadata: syntheticMetadata(deviceLat, deviceLon, 123456789,
+				syntheticGateways[0],
+				syntheticGateways[1],
+				syntheticGateway{lat: syntheticGateways[2].lat, lon: syntheticGateways[2].lon, noTimestamp: true},
+				syntheticGateway{lat: syntheticGateways[3].lat, lon: syntheticGateways[3].lon, noTimestamp: true},
+			),
+			Query:             QUERY_TDOARSSI,
+			ExpectedAlgorithm: "rssi",
+			MaxError:          50,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			loc, algorithm, ok := solve(tc.Metadata, &Data{
+				Query:            tc.Query,
+				ReferenceRSSI:    DefaultReferenceRSSI,
+				PathLossExponent: DefaultPathLossExponent,
+			})
+			if tc.NoSolution {
+				a.So(ok, should.BeFalse)
+				return
+			}
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			a.So(algorithm, should.Equal, tc.ExpectedAlgorithm)
+			a.So(distance(deviceLat, deviceLon, loc.Latitude, loc.Longitude), should.BeLessThanOrEqualTo, tc.MaxError)
+			a.So(loc.Accuracy, should.BeGreaterThan, 0)
+			switch algorithm {
+			case "tdoa":
+				a.So(loc.Source, should.Equal, ttnpb.LocationSource_SOURCE_LORA_TDOA_GEOLOCATION)
+			case "rssi":
+				a.So(loc.Source, should.Equal, ttnpb.LocationSource_SOURCE_LORA_RSSI_GEOLOCATION)
+			}
+		})
+	}
+}
+
+func TestSolveTDOAAccuracy(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	// Deviation of the fine timestamps increases the accuracy estimate.
+	mds := syntheticMetadata(deviceLat, deviceLon, 123456789, syntheticGateways...)
+	exact, _, ok := solve(mds, &Data{Query: QUERY_TDOA})
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	for i, md := range mds {
+		md.FineTimestamp += uint64(i%2) * 500
+	}
+	noisy, _, ok := solve(mds, &Data{Query: QUERY_TDOA})
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(noisy.Accuracy, should.BeGreaterThan, exact.Accuracy)
+}
+
+func TestData(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	data := &Data{
+		Query:            QUERY_RSSI,
+		ReferenceRSSI:    -25,
+		PathLossExponent: 3,
+	}
+	var decoded Data
+	if a.So(decoded.FromStruct(data.Struct()), should.BeNil) {
+		a.So(decoded, should.Resemble, *data)
+	}
+
+	p := &GeolocationPackage{}
+	merged, err := p.mergePackageData(&ttnpb.ApplicationPackageDefaultAssociation{
+		Data: (&Data{PathLossExponent: 3}).Struct(),
+	}, &ttnpb.ApplicationPackageAssociation{
+		Data: (&Data{Query: QUERY_TDOA}).Struct(),
+	})
+	if a.So(err, should.BeNil) {
+		a.So(merged, should.Resemble, &Data{
+			Query:            QUERY_TDOA,
+			ReferenceRSSI:    DefaultReferenceRSSI,
+			PathLossExponent: 3,
+		})
+	}
+
+	a.So(decoded.FromStruct((&Data{PathLossExponent: -1}).Struct()), should.HaveSameErrorDefinitionAs, errInvalidValue)
+}
+
+type mockServer struct {
+	io.Server
+	publishCh chan *ttnpb.ApplicationUp
+}
+
+func (s *mockServer) Publish(_ context.Context, up *ttnpb.ApplicationUp) error {
+	s.publishCh <- up
+	return nil
+}
+
+func TestHandleUp(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	server := &mockServer{publishCh: make(chan *ttnpb.ApplicationUp, 1)}
+	p := New(server, nil)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIds: ids,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				RxMetadata: syntheticMetadata(deviceLat, deviceLon, 123456789, syntheticGateways...),
+			},
+		},
+	}
+
+	a.So(p.HandleUp(ctx, nil, nil, up), should.HaveSameErrorDefinitionAs, errNoAssociation)
+
+	if !a.So(p.HandleUp(ctx, nil, &ttnpb.ApplicationPackageAssociation{
+		Data: (&Data{}).Struct(),
+	}, up), should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case up := <-server.publishCh:
+		a.So(up.EndDeviceIds, should.Resemble, ids)
+		if a.So(up.GetLocationSolved(), should.NotBeNil) {
+			a.So(up.GetLocationSolved().Service, should.Equal, "local-geolocation-v1-tdoa")
+			loc := up.GetLocationSolved().Location
+			a.So(distance(deviceLat, deviceLon, loc.Latitude, loc.Longitude), should.BeLessThanOrEqualTo, 10)
+		}
+	default:
+		t.Fatal("Expected location to be published")
+	}
+
+	// Uplink messages without gateway locations do not publish a location.
+	a.So(p.HandleUp(ctx, nil, &ttnpb.ApplicationPackageAssociation{
+		Data: (&Data{}).Struct(),
+	}, &ttnpb.ApplicationUp{
+		EndDeviceIds: ids,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				RxMetadata: []*ttnpb.RxMetadata{{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-a"}}},
+			},
+		},
+	}), should.BeNil)
+	a.So(server.publishCh, should.BeEmpty)
+}
@@ -0,0 +1,385 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localgeolocationv1
+
+import (
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// speedOfLight is the propagation speed of the radio signal in meters per second.
+	speedOfLight = 299792458.0
+	// earthRadius is the mean radius of the Earth in meters.
+	earthRadius = 6371008.8
+
+	// timestampAccuracy is the assumed accuracy of gateway fine timestamps in seconds.
+	timestampAccuracy = 50e-9
+	// rssiShadowing is the assumed standard deviation of the RSSI around the path loss model in dB.
+	rssiShadowing = 6.0
+
+	maxIterations = 100
+	// convergence is the step size in meters below which the solver is considered converged.
+	convergence = 1e-3
+)
+
+// receiver is a gateway antenna that received the uplink message.
+// The position is in meters on a local tangent plane, see projection.
+type receiver struct {
+	x, y float64
+	rssi float64
+	// toa is the time of arrival in seconds, relative to the first receiver with a fine timestamp.
+	toa    float64
+	hasTOA bool
+}
+
+// projection is an equirectangular projection of coordinates onto a local tangent plane.
+// It is accurate for the distances that are covered by LoRaWAN gateways that receive the same uplink message.
+type projection struct {
+	lat0, lon0 float64
+	kx, ky     float64
+}
+
+func newProjection(lat0, lon0 float64) projection {
+	ky := earthRadius * math.Pi / 180
+	return projection{
+		lat0: lat0,
+		lon0: lon0,
+		kx:   ky * math.Cos(lat0*math.Pi/180),
+		ky:   ky,
+	}
+}
+
+func (p projection) toLocal(lat, lon float64) (x, y float64) {
+	dLon := math.Remainder(lon-p.lon0, 360)
+	return dLon * p.kx, (lat - p.lat0) * p.ky
+}
+
+func (p projection) toGlobal(x, y float64) (lat, lon float64) {
+	lat, lon = p.lat0+y/p.ky, p.lon0+x/p.kx
+	return math.Max(-90, math.Min(90, lat)), math.Remainder(lon, 360)
+}
+
+// receiversFromMetadata returns the receivers with known locations, projected around their centroid.
+// Fine timestamps are nanoseconds within the GPS second, so the time of arrival of each receiver is made relative to
+// the first receiver with a fine timestamp, and wrapped to the nearest second.
+func receiversFromMetadata(mds []*ttnpb.RxMetadata) ([]receiver, projection) {
+	var lat0, lon0 float64
+	var n int
+	for _, md := range mds {
+		if md.GetLocation() == nil {
+			continue
+		}
+		lat0 += md.Location.Latitude
+		lon0 += md.Location.Longitude
+		n++
+	}
+	if n == 0 {
+		return nil, projection{}
+	}
+	proj := newProjection(lat0/float64(n), lon0/float64(n))
+
+	receivers := make([]receiver, 0, n)
+	var (
+		refTimestamp int64
+		hasRef       bool
+	)
+	for _, md := range mds {
+		if md.GetLocation() == nil {
+			continue
+		}
+		x, y := proj.toLocal(md.Location.Latitude, md.Location.Longitude)
+		r := receiver{
+			x:    x,
+			y:    y,
+			rssi: float64(md.Rssi),
+		}
+		if md.FineTimestamp != 0 {
+			ts := int64(md.FineTimestamp)
+			if !hasRef {
+				refTimestamp, hasRef = ts, true
+			}
+			d := ts - refTimestamp
+			switch {
+			case d > 5e8:
+				d -= 1e9
+			case d <= -5e8:
+				d += 1e9
+			}
+			r.toa, r.hasTOA = float64(d)*1e-9, true
+		}
+		receivers = append(receivers, r)
+	}
+	return receivers, proj
+}
+
+// solution is a position on the local tangent plane with its estimated horizontal accuracy in meters.
+type solution struct {
+	x, y     float64
+	accuracy float64
+}
+
+// residualFunc returns the residuals and their Jacobian for the parameters p.
+type residualFunc func(p []float64) (r []float64, jac [][]float64)
+
+func cost(r []float64) float64 {
+	var c float64
+	for _, v := range r {
+		c += v * v
+	}
+	return c
+}
+
+// normalEquations returns J^T J and J^T r.
+func normalEquations(r []float64, jac [][]float64, n int) ([][]float64, []float64) {
+	jtj := make([][]float64, n)
+	jtr := make([]float64, n)
+	for i := range jtj {
+		jtj[i] = make([]float64, n)
+	}
+	for k, row := range jac {
+		for i := 0; i < n; i++ {
+			jtr[i] += row[i] * r[k]
+			for j := 0; j < n; j++ {
+				jtj[i][j] += row[i] * row[j]
+			}
+		}
+	}
+	return jtj, jtr
+}
+
+// solveLinear solves a x = b by Gaussian elimination with partial pivoting. a and b are modified in place.
+func solveLinear(a [][]float64, b []float64) ([]float64, bool) {
+	n := len(b)
+	for i := 0; i < n; i++ {
+		pivot := i
+		for k := i + 1; k < n; k++ {
+			if math.Abs(a[k][i]) > math.Abs(a[pivot][i]) {
+				pivot = k
+			}
+		}
+		if math.Abs(a[pivot][i]) < 1e-12 {
+			return nil, false
+		}
+		a[i], a[pivot] = a[pivot], a[i]
+		b[i], b[pivot] = b[pivot], b[i]
+		for k := i + 1; k < n; k++ {
+			f := a[k][i] / a[i][i]
+			for j := i; j < n; j++ {
+				a[k][j] -= f * a[i][j]
+			}
+			b[k] -= f * b[i]
+		}
+	}
+	x := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		v := b[i]
+		for j := i + 1; j < n; j++ {
+			v -= a[i][j] * x[j]
+		}
+		x[i] = v / a[i][i]
+	}
+	return x, true
+}
+
+// leastSquares minimizes the sum of squared residuals of f with the Levenberg-Marquardt algorithm, starting at p0.
+func leastSquares(f residualFunc, p0 []float64) ([]float64, float64) {
+	n := len(p0)
+	p := append([]float64(nil), p0...)
+	r, jac := f(p)
+	c := cost(r)
+	lambda := 1e-3
+	for i := 0; i < maxIterations; i++ {
+		jtj, jtr := normalEquations(r, jac, n)
+		for j := 0; j < n; j++ {
+			jtj[j][j] *= 1 + lambda
+			jtr[j] = -jtr[j]
+		}
+		step, ok := solveLinear(jtj, jtr)
+		if !ok {
+			lambda *= 10
+			continue
+		}
+		next := make([]float64, n)
+		for j := range next {
+			next[j] = p[j] + step[j]
+		}
+		nextR, nextJac := f(next)
+		if nextC := cost(nextR); nextC < c {
+			p, r, jac, c = next, nextR, nextJac, nextC
+			lambda = math.Max(lambda/10, 1e-9)
+		} else {
+			lambda *= 10
+		}
+		if math.Hypot(step[0], step[1]) < convergence {
+			break
+		}
+	}
+	return p, c
+}
+
+// horizontalAccuracy returns the distance root mean square error of the position parameters, which are the first two
+// parameters, given the residual standard deviation sigma.
+func horizontalAccuracy(jac [][]float64, n int, sigma float64) float64 {
+	jtj, _ := normalEquations(make([]float64, len(jac)), jac, n)
+	var variance float64
+	for i := 0; i < 2; i++ {
+		a := make([][]float64, n)
+		for j := range a {
+			a[j] = append([]float64(nil), jtj[j]...)
+		}
+		e := make([]float64, n)
+		e[i] = 1
+		col, ok := solveLinear(a, e)
+		if !ok {
+			return math.Inf(1)
+		}
+		variance += col[i]
+	}
+	return sigma * math.Sqrt(variance)
+}
+
+// initialGuesses returns the starting points for the solver: the centroid of the receivers, and a point near each
+// receiver, so that the solver can find the global minimum when the problem has multiple local minima.
+func initialGuesses(receivers []receiver) [][2]float64 {
+	var cx, cy float64
+	for _, r := range receivers {
+		cx += r.x
+		cy += r.y
+	}
+	n := float64(len(receivers))
+	guesses := [][2]float64{{cx / n, cy / n}}
+	for _, r := range receivers {
+		guesses = append(guesses, [2]float64{
+			r.x + (cx/n-r.x)/10,
+			r.y + (cy/n-r.y)/10,
+		})
+	}
+	return guesses
+}
+
+// solveTDOA solves the position of the end device from the time difference of arrival at the receivers.
+// The unknowns are the position and the emission time, expressed as distance, so at least three receivers with fine
+// timestamps are required.
+func solveTDOA(receivers []receiver) (solution, bool) {
+	var rs []receiver
+	for _, r := range receivers {
+		if r.hasTOA {
+			rs = append(rs, r)
+		}
+	}
+	if len(rs) < 3 {
+		return solution{}, false
+	}
+	f := func(p []float64) ([]float64, [][]float64) {
+		res := make([]float64, len(rs))
+		jac := make([][]float64, len(rs))
+		for i, r := range rs {
+			dx, dy := p[0]-r.x, p[1]-r.y
+			d := math.Max(math.Hypot(dx, dy), 1e-6)
+			res[i] = d + p[2] - speedOfLight*r.toa
+			jac[i] = []float64{dx / d, dy / d, 1}
+		}
+		return res, jac
+	}
+	var (
+		best     []float64
+		bestCost = math.Inf(1)
+	)
+	for _, g := range initialGuesses(rs) {
+		var b float64
+		for _, r := range rs {
+			b += speedOfLight*r.toa - math.Hypot(g[0]-r.x, g[1]-r.y)
+		}
+		p, c := leastSquares(f, []float64{g[0], g[1], b / float64(len(rs))})
+		if c < bestCost {
+			best, bestCost = p, c
+		}
+	}
+	sigma := speedOfLight * timestampAccuracy
+	if dof := len(rs) - 3; dof > 0 {
+		sigma = math.Max(sigma, math.Sqrt(bestCost/float64(dof)))
+	}
+	_, jac := f(best)
+	accuracy := horizontalAccuracy(jac, 3, sigma)
+	if math.IsInf(accuracy, 0) || math.IsNaN(accuracy) {
+		return solution{}, false
+	}
+	return solution{x: best[0], y: best[1], accuracy: accuracy}, true
+}
+
+// rssiDistance returns the distance in meters at which the RSSI is expected according to the log-distance path loss
+// model.
+func rssiDistance(rssi, referenceRSSI, pathLossExponent float64) float64 {
+	return math.Pow(10, (referenceRSSI-rssi)/(10*pathLossExponent))
+}
+
+// solveRSSI solves the position of the end device from the RSSI at the receivers, using the log-distance path loss
+// model to estimate the distance to each receiver. With fewer than three receivers, the position is the centroid of
+// the receivers weighted by the inverse square of the estimated distance.
+func solveRSSI(receivers []receiver, referenceRSSI, pathLossExponent float64) (solution, bool) {
+	if len(receivers) == 0 {
+		return solution{}, false
+	}
+	ds := make([]float64, len(receivers))
+	for i, r := range receivers {
+		ds[i] = rssiDistance(r.rssi, referenceRSSI, pathLossExponent)
+	}
+	if len(receivers) < 3 {
+		var x, y, w, accuracy float64
+		for i, r := range receivers {
+			wi := 1 / (ds[i] * ds[i])
+			x += wi * r.x
+			y += wi * r.y
+			w += wi
+			accuracy = math.Max(accuracy, ds[i])
+		}
+		return solution{x: x / w, y: y / w, accuracy: accuracy}, true
+	}
+	// The residuals are relative to the estimated distance, as the error of the path loss model is proportional to the
+	// distance.
+	f := func(p []float64) ([]float64, [][]float64) {
+		res := make([]float64, len(receivers))
+		jac := make([][]float64, len(receivers))
+		for i, r := range receivers {
+			dx, dy := p[0]-r.x, p[1]-r.y
+			d := math.Max(math.Hypot(dx, dy), 1e-6)
+			res[i] = (d - ds[i]) / ds[i]
+			jac[i] = []float64{dx / d / ds[i], dy / d / ds[i]}
+		}
+		return res, jac
+	}
+	var (
+		best     []float64
+		bestCost = math.Inf(1)
+	)
+	for _, g := range initialGuesses(receivers) {
+		p, c := leastSquares(f, []float64{g[0], g[1]})
+		if c < bestCost {
+			best, bestCost = p, c
+		}
+	}
+	sigma := math.Pow(10, rssiShadowing/(10*pathLossExponent)) - 1
+	if dof := len(receivers) - 2; dof > 0 {
+		sigma = math.Max(sigma, math.Sqrt(bestCost/float64(dof)))
+	}
+	_, jac := f(best)
+	accuracy := horizontalAccuracy(jac, 2, sigma)
+	if math.IsInf(accuracy, 0) || math.IsNaN(accuracy) {
+		return solution{}, false
+	}
+	return solution{x: best[0], y: best[1], accuracy: accuracy}, true
+}
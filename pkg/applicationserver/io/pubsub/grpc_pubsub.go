@@ -18,6 +18,7 @@ import (
 	"context"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/filter"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -90,6 +91,11 @@ func (ps *PubSub) Set(ctx context.Context, req *ttnpb.SetApplicationPubSubReques
 	if err := ps.providerStatuses.Enabled(ctx, req.Pubsub.Provider); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "filter") {
+		if err := filter.Validate(req.Pubsub.Filter); err != nil {
+			return nil, err
+		}
+	}
 	// Get all the fields here for starting the integration task.
 	pubsub, err := ps.registry.Set(ctx, req.Pubsub.Ids, appendImplicitPubSubGetPaths(req.FieldMask.GetPaths()...),
 		func(pubsub *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
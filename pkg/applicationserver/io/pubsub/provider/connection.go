@@ -52,6 +52,7 @@ type UplinkTopics struct {
 	DownlinkQueueInvalidated *pubsub.Topic
 	LocationSolved           *pubsub.Topic
 	ServiceData              *pubsub.Topic
+	SessionRecovered         *pubsub.Topic
 }
 
 // Shutdown shutdowns the active topics.
@@ -68,6 +69,7 @@ func (ut *UplinkTopics) Shutdown(ctx context.Context) error {
 		ut.DownlinkQueueInvalidated,
 		ut.LocationSolved,
 		ut.ServiceData,
+		ut.SessionRecovered,
 	)
 }
 
@@ -58,6 +58,7 @@ type Connection struct {
 	DownlinkQueueInvalidated *pubsub.Subscription
 	LocationSolved           *pubsub.Subscription
 	ServiceData              *pubsub.Subscription
+	SessionRecovered         *pubsub.Subscription
 }
 
 // ApplicationPubSubIdentifiers returns the identifiers of the connection.
@@ -91,6 +92,7 @@ func (c *Connection) Shutdown(ctx context.Context) (err error) {
 		c.DownlinkQueueInvalidated,
 		c.LocationSolved,
 		c.ServiceData,
+		c.SessionRecovered,
 	} {
 		if topic != nil {
 			if err = topic.Shutdown(ctx); err != nil && !errors.IsCanceled(err) {
@@ -174,6 +176,10 @@ func (i *Impl) OpenConnection(ctx context.Context, target provider.Target, enabl
 			topic:        &pc.Topics.ServiceData,
 			subscription: &conn.ServiceData,
 		},
+		{
+			topic:        &pc.Topics.SessionRecovered,
+			subscription: &conn.SessionRecovered,
+		},
 	} {
 		*t.topic = mempubsub.NewTopic()
 		*t.subscription = mempubsub.NewSubscription(*t.topic, 5*time.Minute)
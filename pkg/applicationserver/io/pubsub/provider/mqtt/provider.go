@@ -212,6 +212,10 @@ func OpenConnection(ctx context.Context, settings Settings, topics provider.Topi
 			topic:   &pc.Topics.ServiceData,
 			message: topics.GetServiceData(),
 		},
+		{
+			topic:   &pc.Topics.SessionRecovered,
+			message: topics.GetSessionRecovered(),
+		},
 	} {
 		if t.message == nil {
 			continue
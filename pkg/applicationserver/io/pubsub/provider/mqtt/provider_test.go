@@ -112,6 +112,9 @@ func TestOpenConnection(t *testing.T) {
 		ServiceData: &ttnpb.ApplicationPubSub_Message{
 			Topic: "service/data",
 		},
+		SessionRecovered: &ttnpb.ApplicationPubSub_Message{
+			Topic: "session/recovered",
+		},
 	}
 
 	impl, err := provider.GetProvider(&ttnpb.ApplicationPubSub{
@@ -324,6 +327,11 @@ func TestOpenConnection(t *testing.T) {
 						topicName: "app1/ps1/service/data",
 						topic:     conn.Topics.ServiceData,
 					},
+					{
+						name:      "ValidSessionRecovered",
+						topicName: "app1/ps1/session/recovered",
+						topic:     conn.Topics.SessionRecovered,
+					},
 				} {
 					t.Run(tc.name, func(t *testing.T) {
 						a := assertions.New(t)
@@ -104,6 +104,10 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler
 			topic:   &pc.Topics.ServiceData,
 			message: target.GetServiceData(),
 		},
+		{
+			topic:   &pc.Topics.SessionRecovered,
+			message: target.GetSessionRecovered(),
+		},
 	} {
 		if t.message == nil {
 			continue
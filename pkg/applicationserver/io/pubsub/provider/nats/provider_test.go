@@ -107,6 +107,9 @@ func TestOpenConnection(t *testing.T) {
 		ServiceData: &ttnpb.ApplicationPubSub_Message{
 			Topic: "service.data",
 		},
+		SessionRecovered: &ttnpb.ApplicationPubSub_Message{
+			Topic: "session.recovered",
+		},
 	}
 
 	impl, err := provider.GetProvider(&ttnpb.ApplicationPubSub{
@@ -252,6 +255,11 @@ func TestOpenConnection(t *testing.T) {
 					subject: "app1.ps1.service.data",
 					topic:   conn.Topics.ServiceData,
 				},
+				{
+					name:    "ValidSessionRecovered",
+					subject: "app1.ps1.session.recovered",
+					topic:   conn.Topics.SessionRecovered,
+				},
 			} {
 				t.Run(tc.name, func(t *testing.T) {
 					upCh := make(chan *nats_client.Msg, 10)
@@ -38,6 +38,7 @@ type Topics interface {
 	GetDownlinkQueueInvalidated() *ttnpb.ApplicationPubSub_Message
 	GetLocationSolved() *ttnpb.ApplicationPubSub_Message
 	GetServiceData() *ttnpb.ApplicationPubSub_Message
+	GetSessionRecovered() *ttnpb.ApplicationPubSub_Message
 	GetDownlinkPush() *ttnpb.ApplicationPubSub_Message
 	GetDownlinkReplace() *ttnpb.ApplicationPubSub_Message
 }
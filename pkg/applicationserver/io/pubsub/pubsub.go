@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/filter"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
@@ -121,6 +122,7 @@ type integration struct {
 	server io.Server
 	sub    *io.Subscription
 	format Format
+	filter *filter.Filter
 }
 
 func (i *integration) handleUp(ctx context.Context) {
@@ -155,8 +157,10 @@ func (i *integration) handleUp(ctx context.Context) {
 				topic = i.conn.Topics.LocationSolved
 			case *ttnpb.ApplicationUp_ServiceData:
 				topic = i.conn.Topics.ServiceData
+			case *ttnpb.ApplicationUp_SessionRecovered:
+				topic = i.conn.Topics.SessionRecovered
 			}
-			if topic == nil {
+			if topic == nil || !i.matchFilter(ctx, up.ApplicationUp) {
 				continue
 			}
 			buf, err := i.format.FromUp(up.ApplicationUp)
@@ -177,6 +181,21 @@ func (i *integration) handleUp(ctx context.Context) {
 	}
 }
 
+// matchFilter returns whether the message matches the filter of the integration.
+// Messages do not match if the filter cannot be evaluated.
+func (i *integration) matchFilter(ctx context.Context, up *ttnpb.ApplicationUp) bool {
+	if i.filter == nil {
+		return true
+	}
+	match, err := i.filter.Match(filter.NewEnvironment(up, filter.EndDeviceAttributes(ctx, i.server, up.EndDeviceIds)))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to evaluate filter")
+		return false
+	}
+	filter.RegisterMatch(ctx, "pubsub", i.Ids.ApplicationIds.ApplicationId, i.Ids.PubSubId, match)
+	return match
+}
+
 func (i *integration) handleDown(
 	ctx context.Context,
 	op func(io.Server, context.Context, *ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error,
@@ -314,6 +333,11 @@ func (ps *PubSub) start(ctx context.Context, pb *ttnpb.ApplicationPubSub) (err e
 	if i.format, ok = formats[pb.Format]; !ok {
 		return errFormatNotFound.WithAttributes("format", pb.Format)
 	}
+	if pb.Filter != "" {
+		if i.filter, err = filter.Compile(pb.Filter); err != nil {
+			return err
+		}
+	}
 
 	go i.handleUp(ctx)
 	i.startHandleDown(ctx)
@@ -110,6 +110,9 @@ func TestPubSub(t *testing.T) {
 		ServiceData: &ttnpb.ApplicationPubSub_Message{
 			Topic: "service.data",
 		},
+		SessionRecovered: &ttnpb.ApplicationPubSub_Message{
+			Topic: "session.recovered",
+		},
 	}
 	paths := []string{
 		"base_topic",
@@ -129,6 +132,7 @@ func TestPubSub(t *testing.T) {
 		"uplink_normalized",
 		"uplink_message",
 		"service_data",
+		"session_recovered",
 	}
 
 	_, err := registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
@@ -371,6 +375,21 @@ func TestPubSub(t *testing.T) {
 				},
 				Subscription: conn.ServiceData,
 			},
+			{
+				Name: "SessionRecovered",
+				Message: &ttnpb.ApplicationUp{
+					EndDeviceIds: registeredDeviceID,
+					Up: &ttnpb.ApplicationUp_SessionRecovered{
+						SessionRecovered: &ttnpb.ApplicationSessionRecovered{
+							SessionKeyId:  []byte{0x11, 0x22, 0x33, 0x44},
+							FCntReset:     true,
+							LastFCntUp:    1,
+							LastAFCntDown: 42,
+						},
+					},
+				},
+				Subscription: conn.SessionRecovered,
+			},
 		} {
 			tcok := t.Run(tc.Name, func(t *testing.T) {
 				ctx, cancel := context.WithCancel(ctx)
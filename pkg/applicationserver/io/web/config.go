@@ -26,8 +26,9 @@ import (
 
 // TemplatesConfig defines the configuration for the webhook templates registry.
 type TemplatesConfig struct {
+	Registry    TemplateRegistry  `name:"-"`
 	Static      map[string][]byte `name:"-"`
-	Directory   string            `name:"directory" description:"Retrieve the webhook templates from the filesystem"`
+	Directory   string            `name:"directory" description:"Retrieve the webhook templates from the filesystem, such as a Git checkout"`
 	URL         string            `name:"url" description:"Retrieve the webhook templates from a web server"`
 	LogoBaseURL string            `name:"logo-base-url" description:"The base URL for the logo storage"`
 }
@@ -41,7 +42,21 @@ type TemplateStore interface {
 }
 
 // NewTemplateStore returns a TemplateStore based on the configuration.
+// If a registry is configured, the templates of organizations are served in addition to the public templates.
 func (c TemplatesConfig) NewTemplateStore(ctx context.Context, httpClientProvider httpclient.Provider) (TemplateStore, error) {
+	public, err := c.newPublicTemplateStore(ctx, httpClientProvider)
+	if err != nil {
+		return nil, err
+	}
+	if c.Registry == nil {
+		return public, nil
+	}
+	return NewLayeredTemplateStore(public, c.Registry), nil
+}
+
+func (c TemplatesConfig) newPublicTemplateStore(
+	ctx context.Context, httpClientProvider httpclient.Provider,
+) (TemplateStore, error) {
 	var fetcher fetch.Interface
 	switch {
 	case c.Static != nil:
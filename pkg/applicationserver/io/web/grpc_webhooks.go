@@ -19,6 +19,7 @@ import (
 	"strconv"
 
 	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/filter"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -61,13 +62,53 @@ func (s webhookRegistryRPC) GetFormats(ctx context.Context, _ *pbtypes.Empty) (*
 }
 
 func (s webhookRegistryRPC) GetTemplate(ctx context.Context, req *ttnpb.GetApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if ids := req.Ids.GetOrganizationIds(); ids != nil {
+		if err := rights.RequireOrganization(ctx, ids, ttnpb.Right_RIGHT_ORGANIZATION_INFO); err != nil {
+			return nil, err
+		}
+	}
 	return s.templates.GetTemplate(ctx, req)
 }
 
 func (s webhookRegistryRPC) ListTemplates(ctx context.Context, req *ttnpb.ListApplicationWebhookTemplatesRequest) (*ttnpb.ApplicationWebhookTemplates, error) {
+	if ids := req.GetOrganizationIds(); ids != nil {
+		if err := rights.RequireOrganization(ctx, ids, ttnpb.Right_RIGHT_ORGANIZATION_INFO); err != nil {
+			return nil, err
+		}
+	}
 	return s.templates.ListTemplates(ctx, req)
 }
 
+func (s webhookRegistryRPC) PublishTemplate(ctx context.Context, req *ttnpb.PublishApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error) {
+	ids := req.Template.Ids.GetOrganizationIds()
+	if ids == nil {
+		return nil, errTemplateOrganization.WithAttributes("template_id", req.Template.Ids.TemplateId)
+	}
+	if err := rights.RequireOrganization(ctx, ids, ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	publisher, ok := s.templates.(TemplatePublisher)
+	if !ok {
+		return nil, errPrivateTemplatesDisabled.New()
+	}
+	return publisher.PublishTemplate(ctx, req)
+}
+
+func (s webhookRegistryRPC) DeprecateTemplate(ctx context.Context, req *ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error) {
+	ids := req.GetOrganizationIds()
+	if ids == nil {
+		return nil, errTemplateOrganization.WithAttributes("template_id", req.TemplateId)
+	}
+	if err := rights.RequireOrganization(ctx, ids, ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	publisher, ok := s.templates.(TemplatePublisher)
+	if !ok {
+		return nil, errPrivateTemplatesDisabled.New()
+	}
+	return publisher.DeprecateTemplate(ctx, req)
+}
+
 func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWebhookRequest) (*ttnpb.ApplicationWebhook, error) {
 	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
@@ -101,6 +142,11 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "filter") {
+		if err := filter.Validate(req.Webhook.Filter); err != nil {
+			return nil, err
+		}
+	}
 	return s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
 			if webhook != nil {
@@ -21,13 +21,16 @@ import (
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
@@ -113,6 +116,21 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(err, should.BeNil)
 	}
 
+	// Set invalid filter.
+	{
+		_, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+			Webhook: &ttnpb.ApplicationWebhook{
+				Ids: &ttnpb.ApplicationWebhookIdentifiers{
+					ApplicationIds: registeredApplicationID,
+					WebhookId:      registeredWebhookID,
+				},
+				Filter: "up.uplink_message.f_port ==",
+			},
+			FieldMask: ttnpb.FieldMask("filter"),
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
 	// List; assert one.
 	{
 		res, err := client.List(ctx, &ttnpb.ListApplicationWebhooksRequest{
@@ -254,3 +272,203 @@ description: Bar`),
 		})
 	}
 }
+
+func TestPrivateTemplatesRPC(t *testing.T) {
+	a, ctx := test.New(t)
+
+	redisClient, flush := test.NewRedis(ctx, "applicationserver_test")
+	defer flush()
+	defer redisClient.Close()
+	templateReg := &redis.TemplateRegistry{Redis: redisClient, LockTTL: test.Delay << 10}
+	if err := templateReg.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	store, err := web.TemplatesConfig{
+		Registry: templateReg,
+		Static: map[string][]byte{
+			"templates.yml": []byte(`---
+- foo`),
+			"foo.yml": []byte(`---
+template-id: foo
+name: Foo
+format: json
+base-url: https://example.com`),
+		},
+	}.NewTemplateStore(ctx, c)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	srv := web.NewWebhookRegistryRPC(nil, store)
+
+	orgIDs := &ttnpb.OrganizationIdentifiers{OrganizationId: "foo-org"}
+	noRightsCtx := rights.NewContext(ctx, &rights.Rights{})
+	readCtx := rights.NewContext(ctx, &rights.Rights{
+		OrganizationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, orgIDs): ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_INFO),
+		}),
+	})
+	writeCtx := rights.NewContext(ctx, &rights.Rights{
+		OrganizationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, orgIDs): ttnpb.RightsFrom(
+				ttnpb.Right_RIGHT_ORGANIZATION_INFO,
+				ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_BASIC,
+			),
+		}),
+	})
+	templateIDs := &ttnpb.ApplicationWebhookTemplateIdentifiers{
+		OrganizationIds: orgIDs,
+		TemplateId:      "bar",
+	}
+	template := func(baseURL string) *ttnpb.ApplicationWebhookTemplate {
+		return &ttnpb.ApplicationWebhookTemplate{
+			Ids:     templateIDs,
+			Name:    "Bar",
+			BaseUrl: baseURL,
+			Format:  "json",
+			Headers: map[string]string{
+				"Authorization": "Bearer {api-key}",
+			},
+			Fields: []*ttnpb.ApplicationWebhookTemplateField{
+				{Id: "api-key", Name: "API key", Secret: true},
+			},
+			UplinkMessage: &ttnpb.ApplicationWebhookTemplate_Message{
+				Path: "/up/{devID}",
+			},
+		}
+	}
+
+	// Insufficient rights.
+	{
+		_, err := srv.PublishTemplate(readCtx, &ttnpb.PublishApplicationWebhookTemplateRequest{
+			Template: template("https://example.com/v1"),
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	}
+
+	// Invalid templates.
+	for _, invalid := range []func(*ttnpb.ApplicationWebhookTemplate){
+		func(t *ttnpb.ApplicationWebhookTemplate) { t.Format = "xml" },
+		func(t *ttnpb.ApplicationWebhookTemplate) { t.BaseUrl = "https://{region}.example.com" },
+		func(t *ttnpb.ApplicationWebhookTemplate) { t.Fields = append(t.Fields, t.Fields[0]) },
+		func(t *ttnpb.ApplicationWebhookTemplate) { t.FieldMask = ttnpb.FieldMask("up.unknown") },
+	} {
+		tmpl := template("https://example.com/v1")
+		invalid(tmpl)
+		_, err := srv.PublishTemplate(writeCtx, &ttnpb.PublishApplicationWebhookTemplateRequest{
+			Template: tmpl,
+		})
+		a.So(err, should.NotBeNil)
+	}
+
+	// Publish two versions.
+	for i, baseURL := range []string{"https://example.com/v1", "https://example.com/v2"} {
+		res, err := srv.PublishTemplate(writeCtx, &ttnpb.PublishApplicationWebhookTemplateRequest{
+			Template: template(baseURL),
+		})
+		if a.So(err, should.BeNil) {
+			a.So(res.Ids.Version, should.Equal, i+1)
+			a.So(res.CreatedAt, should.NotBeNil)
+		}
+	}
+
+	// Get latest and specific version.
+	{
+		_, err := srv.GetTemplate(noRightsCtx, &ttnpb.GetApplicationWebhookTemplateRequest{
+			Ids: templateIDs,
+		})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		res, err := srv.GetTemplate(readCtx, &ttnpb.GetApplicationWebhookTemplateRequest{
+			Ids:       templateIDs,
+			FieldMask: ttnpb.FieldMask("base_url"),
+		})
+		if a.So(err, should.BeNil) {
+			a.So(res.Ids.Version, should.Equal, 2)
+			a.So(res.BaseUrl, should.Equal, "https://example.com/v2")
+		}
+
+		res, err = srv.GetTemplate(readCtx, &ttnpb.GetApplicationWebhookTemplateRequest{
+			Ids: &ttnpb.ApplicationWebhookTemplateIdentifiers{
+				OrganizationIds: orgIDs,
+				TemplateId:      "bar",
+				Version:         1,
+			},
+			FieldMask: ttnpb.FieldMask("base_url"),
+		})
+		if a.So(err, should.BeNil) {
+			a.So(res.BaseUrl, should.Equal, "https://example.com/v1")
+		}
+
+		_, err = srv.GetTemplate(readCtx, &ttnpb.GetApplicationWebhookTemplateRequest{
+			Ids: &ttnpb.ApplicationWebhookTemplateIdentifiers{
+				OrganizationIds: orgIDs,
+				TemplateId:      "bar",
+				Version:         3,
+			},
+		})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	}
+
+	listTemplateIDs := func(ctx context.Context, req *ttnpb.ListApplicationWebhookTemplatesRequest) []string {
+		res, err := srv.ListTemplates(ctx, req)
+		if !a.So(err, should.BeNil) {
+			return nil
+		}
+		var ids []string
+		for _, template := range res.Templates {
+			ids = append(ids, template.Ids.TemplateId)
+		}
+		return ids
+	}
+
+	// List the public and the private templates.
+	a.So(listTemplateIDs(ctx, &ttnpb.ListApplicationWebhookTemplatesRequest{}), should.Resemble, []string{"foo"})
+	a.So(listTemplateIDs(readCtx, &ttnpb.ListApplicationWebhookTemplatesRequest{
+		OrganizationIds: orgIDs,
+	}), should.Resemble, []string{"foo", "bar"})
+
+	// Deprecate.
+	{
+		_, err := srv.DeprecateTemplate(readCtx, templateIDs)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		res, err := srv.DeprecateTemplate(writeCtx, templateIDs)
+		if a.So(err, should.BeNil) {
+			a.So(res.Deprecated, should.BeTrue)
+			a.So(res.Ids.Version, should.Equal, 2)
+		}
+
+		a.So(listTemplateIDs(readCtx, &ttnpb.ListApplicationWebhookTemplatesRequest{
+			OrganizationIds: orgIDs,
+		}), should.Resemble, []string{"foo"})
+		a.So(listTemplateIDs(readCtx, &ttnpb.ListApplicationWebhookTemplatesRequest{
+			OrganizationIds:   orgIDs,
+			IncludeDeprecated: true,
+		}), should.Resemble, []string{"foo", "bar"})
+
+		getRes, err := srv.GetTemplate(readCtx, &ttnpb.GetApplicationWebhookTemplateRequest{
+			Ids: &ttnpb.ApplicationWebhookTemplateIdentifiers{
+				OrganizationIds: orgIDs,
+				TemplateId:      "bar",
+				Version:         1,
+			},
+		})
+		if a.So(err, should.BeNil) {
+			a.So(getRes.Deprecated, should.BeTrue)
+		}
+	}
+
+	// Private templates disabled.
+	{
+		publicStore, err := web.TemplatesConfig{}.NewTemplateStore(ctx, c)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		_, err = web.NewWebhookRegistryRPC(nil, publicStore).PublishTemplate(writeCtx, &ttnpb.PublishApplicationWebhookTemplateRequest{
+			Template: template("https://example.com/v3"),
+		})
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	}
+}
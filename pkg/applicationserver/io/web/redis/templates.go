@@ -0,0 +1,239 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errTemplateNotFound        = errors.DefineNotFound("template_not_found", "template `{template_id}` not found")
+	errTemplateVersionNotFound = errors.DefineNotFound(
+		"template_version_not_found", "version `{version}` of template `{template_id}` not found",
+	)
+	errNoOrganizationIdentifiers = errors.DefineInvalidArgument(
+		"no_organization_identifiers", "no organization identifiers in template `{template_id}`",
+	)
+)
+
+// appendImplicitTemplateGetPaths appends implicit ttnpb.ApplicationWebhookTemplate get paths to paths.
+func appendImplicitTemplateGetPaths(paths ...string) []string {
+	return append(append(make([]string, 0, 3+len(paths)),
+		"created_at",
+		"deprecated",
+		"ids",
+	), paths...)
+}
+
+func applyTemplateFieldMask(src *ttnpb.ApplicationWebhookTemplate, paths ...string) (*ttnpb.ApplicationWebhookTemplate, error) {
+	dst := &ttnpb.ApplicationWebhookTemplate{}
+	return dst, dst.SetFields(src, paths...)
+}
+
+// TemplateRegistry is a Redis registry of the webhook templates of organizations.
+// All versions of a template are stored in a list, of which the last element is the latest version.
+type TemplateRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+// Init initializes the TemplateRegistry.
+func (r *TemplateRegistry) Init(ctx context.Context) error {
+	if err := ttnredis.InitMutex(ctx, r.Redis); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *TemplateRegistry) orgKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func (r *TemplateRegistry) templateKey(orgUID, id string) string {
+	return r.Redis.Key("uid", orgUID, id)
+}
+
+// Get implements web.TemplateRegistry.
+func (r TemplateRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers, paths []string,
+) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if ids.GetOrganizationIds() == nil {
+		return nil, errNoOrganizationIdentifiers.WithAttributes("template_id", ids.GetTemplateId())
+	}
+	index := int64(-1)
+	if ids.Version > 0 {
+		index = int64(ids.Version) - 1
+	}
+	s, err := r.Redis.LIndex(ctx, r.templateKey(unique.ID(ctx, ids.OrganizationIds), ids.TemplateId), index).Result()
+	if err != nil {
+		if err == redis.Nil {
+			if ids.Version > 0 {
+				return nil, errTemplateVersionNotFound.WithAttributes(
+					"template_id", ids.TemplateId,
+					"version", ids.Version,
+				)
+			}
+			return nil, errTemplateNotFound.WithAttributes("template_id", ids.TemplateId)
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	pb := &ttnpb.ApplicationWebhookTemplate{}
+	if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+		return nil, err
+	}
+	return applyTemplateFieldMask(pb, appendImplicitTemplateGetPaths(paths...)...)
+}
+
+// List implements web.TemplateRegistry.
+func (r TemplateRegistry) List(
+	ctx context.Context, ids *ttnpb.OrganizationIdentifiers, paths []string,
+) ([]*ttnpb.ApplicationWebhookTemplate, error) {
+	orgUID := unique.ID(ctx, ids)
+	templateIDs, err := r.Redis.SMembers(ctx, r.orgKey(orgUID)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(templateIDs) == 0 {
+		return nil, nil
+	}
+	sort.Strings(templateIDs)
+	cmds := make([]*redis.StringCmd, 0, len(templateIDs))
+	if _, err := r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, id := range templateIDs {
+			cmds = append(cmds, p.LIndex(ctx, r.templateKey(orgUID, id), -1))
+		}
+		return nil
+	}); err != nil && err != redis.Nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.ApplicationWebhookTemplate, 0, len(cmds))
+	for _, cmd := range cmds {
+		s, err := cmd.Result()
+		if err == redis.Nil {
+			continue
+		} else if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		pb := &ttnpb.ApplicationWebhookTemplate{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		pb, err = applyTemplateFieldMask(pb, appendImplicitTemplateGetPaths(paths...)...)
+		if err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+// Publish implements web.TemplateRegistry.
+func (r TemplateRegistry) Publish(
+	ctx context.Context, template *ttnpb.ApplicationWebhookTemplate,
+) (*ttnpb.ApplicationWebhookTemplate, error) {
+	ids := template.GetIds()
+	if ids.GetOrganizationIds() == nil {
+		return nil, errNoOrganizationIdentifiers.WithAttributes("template_id", ids.GetTemplateId())
+	}
+	orgUID := unique.ID(ctx, ids.OrganizationIds)
+	tk := r.templateKey(orgUID, ids.TemplateId)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.ApplicationWebhookTemplate
+	err = ttnredis.LockedWatch(ctx, r.Redis, tk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		n, err := tx.LLen(ctx, tk).Result()
+		if err != nil {
+			return err
+		}
+		pb = proto.Clone(template).(*ttnpb.ApplicationWebhookTemplate)
+		pb.Ids.Version = uint32(n) + 1
+		pb.Deprecated = false
+		pb.CreatedAt = ttnpb.ProtoTimePtr(time.Now())
+		s, err := ttnredis.MarshalProto(pb)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.RPush(ctx, tk, s)
+			p.SAdd(ctx, r.orgKey(orgUID), ids.TemplateId)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
+
+// Deprecate implements web.TemplateRegistry.
+func (r TemplateRegistry) Deprecate(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers,
+) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if ids.GetOrganizationIds() == nil {
+		return nil, errNoOrganizationIdentifiers.WithAttributes("template_id", ids.GetTemplateId())
+	}
+	tk := r.templateKey(unique.ID(ctx, ids.OrganizationIds), ids.TemplateId)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var pb *ttnpb.ApplicationWebhookTemplate
+	err = ttnredis.LockedWatch(ctx, r.Redis, tk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		ss, err := tx.LRange(ctx, tk, 0, -1).Result()
+		if err != nil {
+			return err
+		}
+		if len(ss) == 0 {
+			return errTemplateNotFound.WithAttributes("template_id", ids.TemplateId)
+		}
+		for i, s := range ss {
+			pb = &ttnpb.ApplicationWebhookTemplate{}
+			if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+				return err
+			}
+			pb.Deprecated = true
+			if ss[i], err = ttnredis.MarshalProto(pb); err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			for i, s := range ss {
+				p.LSet(ctx, tk, int64(i), s)
+			}
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
@@ -31,3 +31,16 @@ type WebhookRegistry interface {
 	// Range ranges over the webhooks and calls the callback function, until false is returned.
 	Range(ctx context.Context, paths []string, f func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationWebhook) bool) error
 }
+
+// TemplateRegistry is a store for the webhook templates of organizations.
+type TemplateRegistry interface {
+	// Get returns the template by its identifiers.
+	// If the version is zero, the latest version of the template is returned.
+	Get(ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers, paths []string) (*ttnpb.ApplicationWebhookTemplate, error)
+	// List returns the latest version of all templates of the organization.
+	List(ctx context.Context, ids *ttnpb.OrganizationIdentifiers, paths []string) ([]*ttnpb.ApplicationWebhookTemplate, error)
+	// Publish stores the template as the next version of the template and returns it.
+	Publish(ctx context.Context, template *ttnpb.ApplicationWebhookTemplate) (*ttnpb.ApplicationWebhookTemplate, error)
+	// Deprecate marks all versions of the template as deprecated and returns the latest version.
+	Deprecate(ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error)
+}
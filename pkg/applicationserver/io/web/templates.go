@@ -103,6 +103,9 @@ func (ts *templateStore) ListTemplates(ctx context.Context, req *ttnpb.ListAppli
 		if err != nil {
 			return nil, err
 		}
+		if template.Deprecated && !req.IncludeDeprecated {
+			continue
+		}
 
 		template, err = applyWebhookTemplateFieldMask(nil, template, appendImplicitWebhookTemplatePaths(req.FieldMask.GetPaths()...)...)
 		if err != nil {
@@ -234,6 +237,7 @@ type webhookTemplatePaths struct {
 	DownlinkQueueInvalidated *string `yaml:"downlink-queue-invalidated,omitempty"`
 	LocationSolved           *string `yaml:"location-solved,omitempty"`
 	ServiceData              *string `yaml:"service-data,omitempty"`
+	SessionRecovered         *string `yaml:"session-recovered,omitempty"`
 }
 
 type webhookTemplate struct {
@@ -250,6 +254,7 @@ type webhookTemplate struct {
 	CreateDownlinkAPIKey bool                   `yaml:"create-downlink-api-key"`
 	Paths                webhookTemplatePaths   `yaml:"paths,omitempty"`
 	FieldMask            []string               `yaml:"field-mask,omitempty"`
+	Deprecated           bool                   `yaml:"deprecated,omitempty"`
 }
 
 func (webhookTemplate) pathToMessage(s *string) *ttnpb.ApplicationWebhookTemplate_Message {
@@ -299,6 +304,8 @@ func (t webhookTemplate) toPB() *ttnpb.ApplicationWebhookTemplate {
 		DownlinkQueueInvalidated: t.pathToMessage(t.Paths.DownlinkQueueInvalidated),
 		LocationSolved:           t.pathToMessage(t.Paths.LocationSolved),
 		ServiceData:              t.pathToMessage(t.Paths.ServiceData),
+		SessionRecovered:         t.pathToMessage(t.Paths.SessionRecovered),
 		FieldMask:                t.pbFieldMask(),
+		Deprecated:               t.Deprecated,
 	}
 }
@@ -0,0 +1,231 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errTemplateOrganization = errors.DefineInvalidArgument(
+		"template_organization", "template `{template_id}` is not owned by an organization",
+	)
+	errTemplateFieldDuplicate = errors.DefineInvalidArgument(
+		"template_field_duplicate", "duplicate field `{field_id}` in template `{template_id}`",
+	)
+	errTemplateUnknownVariable = errors.DefineInvalidArgument(
+		"template_unknown_variable", "unknown variable `{variable}` in template `{template_id}`",
+	)
+	errTemplateFieldMask = errors.DefineInvalidArgument(
+		"template_field_mask", "invalid field mask path `{path}` in template `{template_id}`",
+	)
+	errPrivateTemplatesDisabled = errors.DefineFailedPrecondition(
+		"private_templates_disabled", "private webhook templates are disabled",
+	)
+)
+
+// webhookRuntimeVariables are the variables which are expanded for every message.
+// See expandVariables.
+var webhookRuntimeVariables = map[string]struct{}{
+	"appID":         {},
+	"applicationID": {},
+	"appEUI":        {},
+	"joinEUI":       {},
+	"devID":         {},
+	"deviceID":      {},
+	"devEUI":        {},
+	"devAddr":       {},
+}
+
+var templateExpressionRegex = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// templateVariables returns the names of the variables in the expressions of the URI template.
+func templateVariables(value string) []string {
+	var names []string
+	for _, match := range templateExpressionRegex.FindAllStringSubmatch(value, -1) {
+		expression := strings.TrimLeft(match[1], "+#./;?&")
+		for _, name := range strings.Split(expression, ",") {
+			if i := strings.IndexByte(name, ':'); i >= 0 {
+				name = name[:i]
+			}
+			names = append(names, strings.TrimSuffix(name, "*"))
+		}
+	}
+	return names
+}
+
+// TemplatePublisher publishes and deprecates the webhook templates of organizations.
+type TemplatePublisher interface {
+	// PublishTemplate validates the template and publishes it as the next version of the template.
+	PublishTemplate(ctx context.Context, req *ttnpb.PublishApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error)
+	// DeprecateTemplate deprecates all versions of the template.
+	DeprecateTemplate(ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error)
+}
+
+// layeredTemplateStore merges the public templates with the templates of organizations.
+type layeredTemplateStore struct {
+	public   TemplateStore
+	registry TemplateRegistry
+}
+
+// NewLayeredTemplateStore returns a TemplateStore which serves the templates of the public store, and the
+// templates of organizations stored in the registry. The returned store implements TemplatePublisher.
+func NewLayeredTemplateStore(public TemplateStore, registry TemplateRegistry) TemplateStore {
+	return &layeredTemplateStore{
+		public:   public,
+		registry: registry,
+	}
+}
+
+// GetTemplate implements TemplateStore.
+func (ts *layeredTemplateStore) GetTemplate(
+	ctx context.Context, req *ttnpb.GetApplicationWebhookTemplateRequest,
+) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if req.Ids.GetOrganizationIds() == nil {
+		return ts.public.GetTemplate(ctx, req)
+	}
+	return ts.registry.Get(ctx, req.Ids, appendImplicitWebhookTemplatePaths(req.FieldMask.GetPaths()...))
+}
+
+// ListTemplates implements TemplateStore.
+func (ts *layeredTemplateStore) ListTemplates(
+	ctx context.Context, req *ttnpb.ListApplicationWebhookTemplatesRequest,
+) (*ttnpb.ApplicationWebhookTemplates, error) {
+	res, err := ts.public.ListTemplates(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.OrganizationIds == nil {
+		return res, nil
+	}
+	templates, err := ts.registry.List(ctx, req.OrganizationIds, appendImplicitWebhookTemplatePaths(req.FieldMask.GetPaths()...))
+	if err != nil {
+		return nil, err
+	}
+	for _, template := range templates {
+		if template.Deprecated && !req.IncludeDeprecated {
+			continue
+		}
+		res.Templates = append(res.Templates, template)
+	}
+	return res, nil
+}
+
+// PublishTemplate implements TemplatePublisher.
+func (ts *layeredTemplateStore) PublishTemplate(
+	ctx context.Context, req *ttnpb.PublishApplicationWebhookTemplateRequest,
+) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if err := validateWebhookTemplate(req.Template); err != nil {
+		return nil, err
+	}
+	return ts.registry.Publish(ctx, req.Template)
+}
+
+// DeprecateTemplate implements TemplatePublisher.
+func (ts *layeredTemplateStore) DeprecateTemplate(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers,
+) (*ttnpb.ApplicationWebhookTemplate, error) {
+	if ids.GetOrganizationIds() == nil {
+		return nil, errTemplateOrganization.WithAttributes("template_id", ids.GetTemplateId())
+	}
+	return ts.registry.Deprecate(ctx, ids)
+}
+
+// validateWebhookTemplate validates that the template is owned by an organization, that its format exists,
+// that its URI templates only refer to declared fields or runtime variables, and that its field mask
+// only contains application uplink paths.
+func validateWebhookTemplate(template *ttnpb.ApplicationWebhookTemplate) error {
+	paths := make([]string, 0, len(ttnpb.ApplicationWebhookTemplateFieldPathsTopLevel))
+	for _, path := range ttnpb.ApplicationWebhookTemplateFieldPathsTopLevel {
+		switch {
+		case path == "logo_url" && template.LogoUrl == "",
+			path == "info_url" && template.InfoUrl == "",
+			path == "documentation_url" && template.DocumentationUrl == "":
+			// The URLs are optional, but must be absolute when set.
+			continue
+		}
+		paths = append(paths, path)
+	}
+	if err := template.ValidateFields(paths...); err != nil {
+		return err
+	}
+	templateID := template.Ids.TemplateId
+	if template.Ids.OrganizationIds == nil {
+		return errTemplateOrganization.WithAttributes("template_id", templateID)
+	}
+	if _, ok := formats[template.Format]; !ok {
+		return errFormatNotFound.WithAttributes("format", template.Format)
+	}
+	fields := make(map[string]struct{}, len(template.Fields))
+	for _, field := range template.Fields {
+		if _, ok := fields[field.Id]; ok {
+			return errTemplateFieldDuplicate.WithAttributes(
+				"template_id", templateID,
+				"field_id", field.Id,
+			)
+		}
+		fields[field.Id] = struct{}{}
+	}
+	values := []string{template.BaseUrl}
+	for key, value := range template.Headers {
+		values = append(values, key, value)
+	}
+	for _, msg := range []*ttnpb.ApplicationWebhookTemplate_Message{
+		template.UplinkMessage,
+		template.UplinkNormalized,
+		template.JoinAccept,
+		template.DownlinkAck,
+		template.DownlinkNack,
+		template.DownlinkSent,
+		template.DownlinkFailed,
+		template.DownlinkQueued,
+		template.DownlinkQueueInvalidated,
+		template.LocationSolved,
+		template.ServiceData,
+		template.SessionRecovered,
+	} {
+		if msg != nil {
+			values = append(values, msg.Path)
+		}
+	}
+	for _, value := range values {
+		for _, name := range templateVariables(value) {
+			if _, ok := fields[name]; ok {
+				continue
+			}
+			if _, ok := webhookRuntimeVariables[name]; ok {
+				continue
+			}
+			return errTemplateUnknownVariable.WithAttributes(
+				"template_id", templateID,
+				"variable", name,
+			)
+		}
+	}
+	for _, path := range template.FieldMask.GetPaths() {
+		if !ttnpb.ContainsField(path, ttnpb.ApplicationUpFieldPathsNested) {
+			return errTemplateFieldMask.WithAttributes(
+				"template_id", templateID,
+				"path", path,
+			)
+		}
+	}
+	return nil
+}
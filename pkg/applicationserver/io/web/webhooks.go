@@ -28,6 +28,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/jtacoma/uritemplates"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/filter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
@@ -142,6 +143,7 @@ type webhooks struct {
 	registry  WebhookRegistry
 	target    Sink
 	downlinks DownlinksConfig
+	filters   *filter.Cache
 }
 
 // NewWebhooks returns a new Webhooks.
@@ -159,6 +161,7 @@ func NewWebhooks(
 		registry:  registry,
 		target:    target,
 		downlinks: downlinks,
+		filters:   filter.NewCache(filter.DefaultCacheSize),
 	}
 	sub, err := server.Subscribe(ctx, "webhooks", nil, false)
 	if err != nil {
@@ -249,12 +252,14 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"downlink_queued",
 			"downlink_sent",
 			"field_mask",
+			"filter",
 			"format",
 			"headers",
 			"health_status",
 			"join_accept",
 			"location_solved",
 			"service_data",
+			"session_recovered",
 			"uplink_message",
 			"uplink_normalized",
 		},
@@ -263,12 +268,16 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 		return err
 	}
 	ctx = withDeviceID(ctx, msg.EndDeviceIds)
+	env := filter.NewEnvironment(msg, filter.EndDeviceAttributes(ctx, w.server, msg.EndDeviceIds))
 	wg := sync.WaitGroup{}
 	for i := range hooks {
 		hook := hooks[i]
 		ctx := withWebhookID(ctx, hook.Ids)
 		ctx = WithCachedHealthStatus(ctx, hook.HealthStatus)
 		logger := log.FromContext(ctx).WithField("hook", hook.Ids.WebhookId)
+		if !w.matchFilter(ctx, hook, env) {
+			continue
+		}
 		f := func(ctx context.Context) error {
 			req, err := w.newRequest(ctx, msg, hook)
 			if err != nil {
@@ -300,6 +309,26 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	return nil
 }
 
+// matchFilter returns whether the message of the environment matches the filter of the hook.
+// Messages do not match if the filter cannot be evaluated.
+func (w *webhooks) matchFilter(ctx context.Context, hook *ttnpb.ApplicationWebhook, env *filter.Environment) bool {
+	if hook.Filter == "" || webhookMessage(env.Up(), hook) == nil {
+		return true
+	}
+	f, err := w.filters.Compile(hook.Filter)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to compile filter")
+		return false
+	}
+	match, err := f.Match(env)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to evaluate filter")
+		return false
+	}
+	filter.RegisterMatch(ctx, "webhook", hook.Ids.ApplicationIds.ApplicationId, hook.Ids.WebhookId, match)
+	return match
+}
+
 func webhookMessage(
 	msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook,
 ) *ttnpb.ApplicationWebhook_Message {
@@ -326,6 +355,8 @@ func webhookMessage(
 		return hook.LocationSolved
 	case *ttnpb.ApplicationUp_ServiceData:
 		return hook.ServiceData
+	case *ttnpb.ApplicationUp_SessionRecovered:
+		return hook.SessionRecovered
 	}
 	return nil
 }
@@ -354,6 +385,8 @@ func webhookUplinkMessageMask(msg *ttnpb.ApplicationUp) string {
 		return "up.location_solved"
 	case *ttnpb.ApplicationUp_ServiceData:
 		return "up.service_data"
+	case *ttnpb.ApplicationUp_SessionRecovered:
+		return "up.session_recovered"
 	}
 	return ""
 }
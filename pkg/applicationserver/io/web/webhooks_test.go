@@ -146,6 +146,9 @@ func TestWebhooks(t *testing.T) {
 						ServiceData: &ttnpb.ApplicationWebhook_Message{
 							Path: tc.prefix + "service/data",
 						},
+						SessionRecovered: &ttnpb.ApplicationWebhook_Message{
+							Path: tc.prefix + "session/recovered",
+						},
 						FieldMask: ttnpb.FieldMask(
 							"correlation_ids",
 							"end_device_ids",
@@ -180,6 +183,7 @@ func TestWebhooks(t *testing.T) {
 						"join_accept",
 						"location_solved",
 						"service_data",
+						"session_recovered",
 						"uplink_message",
 						"uplink_normalized",
 					}, nil
@@ -441,6 +445,22 @@ func TestWebhooks(t *testing.T) {
 								OK:  true,
 								URL: fmt.Sprintf("%s/service/data", baseURL),
 							},
+							{
+								Name: "SessionRecovered",
+								Message: &ttnpb.ApplicationUp{
+									EndDeviceIds: registeredDeviceID,
+									Up: &ttnpb.ApplicationUp_SessionRecovered{
+										SessionRecovered: &ttnpb.ApplicationSessionRecovered{
+											SessionKeyId:  []byte{0x11, 0x22, 0x33, 0x44},
+											FCntReset:     true,
+											LastFCntUp:    1,
+											LastAFCntDown: 42,
+										},
+									},
+								},
+								OK:  true,
+								URL: fmt.Sprintf("%s/session/recovered", baseURL),
+							},
 						} {
 							t.Run(tc.Name, func(t *testing.T) {
 								a := assertions.New(t)
@@ -594,3 +614,89 @@ func (s *mockSink) Process(req *http.Request) error {
 		return s.err
 	}
 }
+
+func TestWebhooksFilter(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	redisClient, flush := test.NewRedis(ctx, "web_test")
+	defer flush()
+	defer redisClient.Close()
+	registry := &redis.WebhookRegistry{
+		Redis:   redisClient,
+		LockTTL: test.Delay << 10,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	ids := &ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIds: registeredApplicationID,
+		WebhookId:      registeredWebhookID,
+	}
+	_, err := registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+			Ids:     ids,
+			BaseUrl: "https://myapp.com/api/ttn/v3",
+			Format:  "json",
+			UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+				Path: "up",
+			},
+			Filter: "up.uplink_message.f_port == 42",
+		}, []string{"base_url", "filter", "format", "ids", "uplink_message"}, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	sink := &mockSink{
+		ch: make(chan *http.Request, 1),
+	}
+	c := componenttest.NewComponent(t, &component.Config{})
+	as := mock.NewServer(c)
+	if _, err := web.NewWebhooks(ctx, as, registry, sink, web.DownlinksConfig{}); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	for _, tc := range []struct {
+		Name  string
+		FPort uint32
+		OK    bool
+	}{
+		{
+			Name:  "Match",
+			FPort: 42,
+			OK:    true,
+		},
+		{
+			Name:  "NoMatch",
+			FPort: 1,
+			OK:    false,
+		},
+	} {
+		err := as.Publish(ctx, &ttnpb.ApplicationUp{
+			EndDeviceIds: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort:      tc.FPort,
+					FrmPayload: []byte{0x1, 0x2, 0x3},
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case req := <-sink.ch:
+			if !tc.OK {
+				t.Fatalf("%s: did not expect message but received: %v", tc.Name, req)
+			}
+			a.So(req.URL.String(), should.Equal, "https://myapp.com/api/ttn/v3/up")
+		case <-time.After(Timeout):
+			if tc.OK {
+				t.Fatalf("%s: expected message but nothing received", tc.Name)
+			}
+		}
+	}
+}
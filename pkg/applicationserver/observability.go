@@ -96,6 +96,12 @@ var (
 		events.WithDataType(&ttnpb.ApplicationUp{}),
 		events.WithPropagateToParent(),
 	)
+	evtForwardSessionRecovered = events.Define(
+		"as.up.session.forward", "forward session recovered message",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationUp{}),
+		events.WithPropagateToParent(),
+	)
 	evtReceiveDataDown = events.Define(
 		"as.down.data.receive", "receive downlink data message",
 		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
@@ -104,6 +110,14 @@ var (
 		events.WithClientInfoFromContext(),
 		events.WithPropagateToParent(),
 	)
+	evtHoldDataDown = events.Define(
+		"as.down.data.hold", "hold downlink data message",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationDownlink{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+		events.WithPropagateToParent(),
+	)
 	evtDropDataDown = events.Define(
 		"as.down.data.drop", "drop downlink data message",
 		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
@@ -284,6 +298,8 @@ func registerForwardUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
 		events.Publish(evtForwardLocationSolved.NewWithIdentifiersAndData(ctx, msg.EndDeviceIds, msg))
 	case *ttnpb.ApplicationUp_ServiceData:
 		events.Publish(evtForwardServiceData.NewWithIdentifiersAndData(ctx, msg.EndDeviceIds, msg))
+	case *ttnpb.ApplicationUp_SessionRecovered:
+		events.Publish(evtForwardSessionRecovered.NewWithIdentifiersAndData(ctx, msg.EndDeviceIds, msg))
 	default:
 		return
 	}
@@ -326,6 +342,12 @@ func registerReceiveDownlinks(ctx context.Context, ids *ttnpb.EndDeviceIdentifie
 	}
 }
 
+func registerHoldDownlinks(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) {
+	for _, item := range items {
+		events.Publish(evtHoldDataDown.NewWithIdentifiersAndData(ctx, ids, item))
+	}
+}
+
 func registerForwardDownlink(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, ns string) {
 	events.Publish(evtForwardDataDown.NewWithIdentifiersAndData(ctx, ids, msg))
 	asMetrics.downlinkForwarded.WithLabelValues(ctx, ns).Inc()
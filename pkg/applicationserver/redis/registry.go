@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"runtime/trace"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -518,3 +519,236 @@ func (r *ApplicationUplinkRegistry) Clear(ctx context.Context, ids *ttnpb.EndDev
 
 	return nil
 }
+
+var errTooManyHeldDownlinks = errors.DefineResourceExhausted(
+	"too_many_held_downlinks", "too many held downlink messages", "limit",
+)
+
+// HeldDownlinkRegistry is a store for downlink messages that are held by the Application Server.
+type HeldDownlinkRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+	Limit   int64
+}
+
+// Init initializes the HeldDownlinkRegistry.
+func (r *HeldDownlinkRegistry) Init(ctx context.Context) error {
+	if err := ttnredis.InitMutex(ctx, r.Redis); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *HeldDownlinkRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func (r *HeldDownlinkRegistry) dueKey() string {
+	return r.Redis.Key("due")
+}
+
+const heldDownlinkPopCount = 128
+
+// heldDownlinkDue returns whether the held downlink message is due or expired at the given time.
+func heldDownlinkDue(item *ttnpb.ApplicationDownlink, now time.Time) bool {
+	scheduling := item.GetScheduling()
+	if expiresAt := ttnpb.StdTime(scheduling.GetExpiresAt()); expiresAt != nil && !expiresAt.After(now) {
+		return true
+	}
+	notBefore := ttnpb.StdTime(scheduling.GetNotBefore())
+	return notBefore == nil || !notBefore.After(now)
+}
+
+// heldDownlinksDueAt returns the earliest time at which one of the held downlink messages is due or expired.
+func heldDownlinksDueAt(items []*ttnpb.ApplicationDownlink) time.Time {
+	var dueAt time.Time
+	for _, item := range items {
+		scheduling := item.GetScheduling()
+		var t time.Time
+		if notBefore := ttnpb.StdTime(scheduling.GetNotBefore()); notBefore != nil {
+			t = *notBefore
+		}
+		if expiresAt := ttnpb.StdTime(scheduling.GetExpiresAt()); expiresAt != nil && expiresAt.Before(t) {
+			t = *expiresAt
+		}
+		if dueAt.IsZero() || t.Before(dueAt) {
+			dueAt = t
+		}
+	}
+	return dueAt
+}
+
+// set stores the held downlink messages of the end device in the transaction, and schedules the end device in the
+// due set at the earliest time at which one of the held downlink messages is due or expired.
+func (r *HeldDownlinkRegistry) set(ctx context.Context, tx *redis.Tx, uid string, items []*ttnpb.ApplicationDownlink) error {
+	uk := r.uidKey(uid)
+	_, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		if len(items) == 0 {
+			p.Del(ctx, uk)
+			p.ZRem(ctx, r.dueKey(), uid)
+			return nil
+		}
+		if _, err := ttnredis.SetProto(ctx, p, uk, &ttnpb.ApplicationDownlinks{Downlinks: items}, 0); err != nil {
+			return err
+		}
+		p.ZAdd(ctx, r.dueKey(), &redis.Z{
+			Score:  float64(heldDownlinksDueAt(items).UnixMilli()),
+			Member: uid,
+		})
+		return nil
+	})
+	return err
+}
+
+func getHeldDownlinks(ctx context.Context, r redis.Cmdable, k string) ([]*ttnpb.ApplicationDownlink, error) {
+	pb := &ttnpb.ApplicationDownlinks{}
+	if err := ttnredis.GetProto(ctx, r, k).ScanProto(pb); err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return pb.Downlinks, nil
+}
+
+// Add adds the downlink messages to the held downlink messages of the end device.
+// Held downlink messages with the same deduplication key as one of the added downlink messages are removed and returned.
+func (r *HeldDownlinkRegistry) Add(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) ([]*ttnpb.ApplicationDownlink, error) {
+	defer trace.StartRegion(ctx, "add held downlinks").End()
+
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var superseded []*ttnpb.ApplicationDownlink
+	err = ttnredis.LockedWatch(ctx, r.Redis, uk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored, err := getHeldDownlinks(ctx, tx, uk)
+		if err != nil {
+			return err
+		}
+		superseded = nil
+		held := append(make([]*ttnpb.ApplicationDownlink, 0, len(stored)+len(items)), stored...)
+		for _, item := range items {
+			if key := item.GetScheduling().GetDeduplicationKey(); key != "" {
+				n := 0
+				for _, other := range held {
+					if other.GetScheduling().GetDeduplicationKey() == key {
+						superseded = append(superseded, other)
+						continue
+					}
+					held[n] = other
+					n++
+				}
+				held = held[:n]
+			}
+			held = append(held, item)
+		}
+		if r.Limit > 0 && int64(len(held)) > r.Limit {
+			return errTooManyHeldDownlinks.WithAttributes("limit", r.Limit)
+		}
+		return r.set(ctx, tx, uid, held)
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return superseded, nil
+}
+
+// List returns the held downlink messages of the end device.
+func (r *HeldDownlinkRegistry) List(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error) {
+	defer trace.StartRegion(ctx, "list held downlinks").End()
+
+	items, err := getHeldDownlinks(ctx, r.Redis, r.uidKey(unique.ID(ctx, ids)))
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return items, nil
+}
+
+// Clear removes and returns the held downlink messages of the end device.
+func (r *HeldDownlinkRegistry) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error) {
+	defer trace.StartRegion(ctx, "clear held downlinks").End()
+
+	uid := unique.ID(ctx, ids)
+	uk := r.uidKey(uid)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*ttnpb.ApplicationDownlink
+	err = ttnredis.LockedWatch(ctx, r.Redis, uk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		var err error
+		items, err = getHeldDownlinks(ctx, tx, uk)
+		if err != nil || len(items) == 0 {
+			return err
+		}
+		return r.set(ctx, tx, uid, nil)
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return items, nil
+}
+
+// Pop removes the held downlink messages that are due or expired at the given time, and calls the callback function
+// for each end device that has such downlink messages.
+// The downlink messages are removed before the callback function is called.
+func (r *HeldDownlinkRegistry) Pop(ctx context.Context, now time.Time, f func(context.Context, *ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error) error {
+	defer trace.StartRegion(ctx, "pop held downlinks").End()
+
+	uids, err := r.Redis.ZRangeByScore(ctx, r.dueKey(), &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.UnixMilli(), 10),
+		Count: heldDownlinkPopCount,
+	}).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, uid := range uids {
+		ids, err := unique.ToDeviceID(uid)
+		if err != nil {
+			return err
+		}
+		ctx, err := unique.WithContext(ctx, uid)
+		if err != nil {
+			return err
+		}
+		uk := r.uidKey(uid)
+
+		lockerID, err := ttnredis.GenerateLockerID()
+		if err != nil {
+			return err
+		}
+
+		var due []*ttnpb.ApplicationDownlink
+		err = ttnredis.LockedWatch(ctx, r.Redis, uk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+			stored, err := getHeldDownlinks(ctx, tx, uk)
+			if err != nil {
+				return err
+			}
+			due = nil
+			held := make([]*ttnpb.ApplicationDownlink, 0, len(stored))
+			for _, item := range stored {
+				if heldDownlinkDue(item, now) {
+					due = append(due, item)
+				} else {
+					held = append(held, item)
+				}
+			}
+			return r.set(ctx, tx, uid, held)
+		})
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		if len(due) == 0 {
+			continue
+		}
+		if err := f(ctx, ids, due); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -16,6 +16,7 @@ package applicationserver
 
 import (
 	"context"
+	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -146,3 +147,17 @@ type ApplicationUplinkRegistry interface {
 	// Clear empties the uplink messages storage by the end device identifiers.
 	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
 }
+
+// HeldDownlinkRegistry is a store for downlink messages that are held by the Application Server.
+type HeldDownlinkRegistry interface {
+	// Add adds the downlink messages to the held downlink messages of the end device.
+	// Held downlink messages with the same deduplication key as one of the added downlink messages are removed and returned.
+	Add(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) (superseded []*ttnpb.ApplicationDownlink, err error)
+	// List returns the held downlink messages of the end device.
+	List(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error)
+	// Clear removes and returns the held downlink messages of the end device.
+	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error)
+	// Pop removes the held downlink messages that are due or expired at the given time, and calls the callback function
+	// for each end device that has such downlink messages.
+	Pop(ctx context.Context, now time.Time, f func(context.Context, *ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error) error
+}
@@ -422,3 +422,110 @@ func TestApplicationUplinksRegistry(t *testing.T) {
 		},
 	})
 }
+
+func TestHeldDownlinkRegistry(t *testing.T) {
+	namespace := [...]string{
+		"applicationserver_test",
+		"held_downlinks",
+	}
+	test.RunTest(t, test.TestConfig{
+		Func: func(ctx context.Context, a *assertions.Assertion) {
+			cl, flush := test.NewRedis(ctx, namespace[:]...)
+			defer flush()
+			defer cl.Close()
+			registry := &redis.HeldDownlinkRegistry{
+				Redis:   cl,
+				LockTTL: test.Delay << 10,
+				Limit:   4,
+			}
+			if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+
+			ids := &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:       "test-dev",
+			}
+			now := time.Now().Truncate(time.Millisecond)
+			down := func(fCnt uint32, notBefore, expiresAt time.Duration, key string) *ttnpb.ApplicationDownlink {
+				scheduling := &ttnpb.ApplicationDownlink_Scheduling{
+					NotBefore:        ttnpb.ProtoTimePtr(now.Add(notBefore)),
+					DeduplicationKey: key,
+				}
+				if expiresAt > 0 {
+					scheduling.ExpiresAt = ttnpb.ProtoTimePtr(now.Add(expiresAt))
+				}
+				return &ttnpb.ApplicationDownlink{
+					FPort:      1,
+					FCnt:       fCnt,
+					Scheduling: scheduling,
+				}
+			}
+			pop := func(at time.Duration) []*ttnpb.ApplicationDownlink {
+				var popped []*ttnpb.ApplicationDownlink
+				err := registry.Pop(ctx, now.Add(at), func(ctx context.Context, popIDs *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+					a.So(popIDs, should.Resemble, ids)
+					popped = append(popped, items...)
+					return nil
+				})
+				a.So(err, should.BeNil)
+				return popped
+			}
+
+			items, err := registry.List(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(items, should.BeEmpty)
+
+			superseded, err := registry.Add(ctx, ids, []*ttnpb.ApplicationDownlink{
+				down(1, time.Minute, 0, "a"),
+				down(2, 2*time.Minute, 0, "b"),
+				down(3, 3*time.Minute, 90*time.Second, ""),
+			})
+			a.So(err, should.BeNil)
+			a.So(superseded, should.BeEmpty)
+
+			superseded, err = registry.Add(ctx, ids, []*ttnpb.ApplicationDownlink{
+				down(4, 2*time.Minute, 0, "a"),
+			})
+			a.So(err, should.BeNil)
+			a.So(superseded, should.Resemble, []*ttnpb.ApplicationDownlink{
+				down(1, time.Minute, 0, "a"),
+			})
+
+			items, err = registry.List(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(items, should.Resemble, []*ttnpb.ApplicationDownlink{
+				down(2, 2*time.Minute, 0, "b"),
+				down(3, 3*time.Minute, 90*time.Second, ""),
+				down(4, 2*time.Minute, 0, "a"),
+			})
+
+			_, err = registry.Add(ctx, ids, []*ttnpb.ApplicationDownlink{
+				down(5, time.Minute, 0, ""),
+				down(6, time.Minute, 0, ""),
+			})
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+			a.So(pop(time.Minute), should.BeEmpty)
+			a.So(pop(90*time.Second), should.Resemble, []*ttnpb.ApplicationDownlink{
+				down(3, 3*time.Minute, 90*time.Second, ""),
+			})
+			a.So(pop(2*time.Minute), should.Resemble, []*ttnpb.ApplicationDownlink{
+				down(2, 2*time.Minute, 0, "b"),
+				down(4, 2*time.Minute, 0, "a"),
+			})
+			a.So(pop(time.Hour), should.BeEmpty)
+
+			_, err = registry.Add(ctx, ids, []*ttnpb.ApplicationDownlink{
+				down(7, time.Minute, 0, ""),
+			})
+			a.So(err, should.BeNil)
+			items, err = registry.Clear(ctx, ids)
+			a.So(err, should.BeNil)
+			a.So(items, should.Resemble, []*ttnpb.ApplicationDownlink{
+				down(7, time.Minute, 0, ""),
+			})
+			a.So(pop(time.Hour), should.BeEmpty)
+		},
+	})
+}
@@ -676,6 +676,38 @@ func TestBeacon(t *testing.T) {
 				a.So(beaconDR.Rate.GetLora(), should.NotBeNil)
 				a.So(beaconDR.Rate.GetLora().GetSpreadingFactor(), should.BeBetweenOrEqual, 8, 12)
 			}
+			_, _, err := b.BeaconFrameLayout()
+			a.So(err, should.BeNil)
+		})
+	}
+}
+
+func TestBeaconFrameLayout(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		BandID     string
+		RFU1, RFU2 int
+	}{
+		{BandID: EU_863_870, RFU1: 2, RFU2: 0},
+		{BandID: US_902_928, RFU1: 5, RFU2: 3},
+		{BandID: CN_470_510, RFU1: 3, RFU2: 1},
+		{BandID: IN_865_867, RFU1: 1, RFU2: 3},
+	} {
+		tc := tc
+		t.Run(tc.BandID, func(t *testing.T) {
+			t.Parallel()
+
+			a := assertions.New(t)
+			b, err := GetLatest(tc.BandID)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			rfu1, rfu2, err := b.BeaconFrameLayout()
+			if a.So(err, should.BeNil) {
+				a.So(rfu1, should.Equal, tc.RFU1)
+				a.So(rfu2, should.Equal, tc.RFU2)
+			}
 		})
 	}
 }
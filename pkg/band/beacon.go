@@ -17,9 +17,19 @@ package band
 import (
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
+const (
+	// BeaconPeriod is the period of Class B beacons.
+	BeaconPeriod = 128 * time.Second
+	// BeaconDelay is the delay of the beacon transmission relative to the start of the beacon period.
+	BeaconDelay = 1500 * time.Microsecond
+	// BeaconPreambleLength is the length of the preamble of Class B beacons (symbols).
+	BeaconPreambleLength = 10
+)
+
 // ComputePeriodicFrequency computes the frequency at time t given the period p and offset offset.
 // It panics if no frequencies are provided.
 func ComputePeriodicFrequency(t time.Duration, p time.Duration, offset uint32, frequencies ...uint64) uint64 {
@@ -40,6 +50,32 @@ type Beacon struct {
 	Frequencies   []uint64
 }
 
+var errBeaconDataRate = errors.DefineInvalidArgument(
+	"beacon_data_rate", "beacon data rate `{data_rate_index}` not supported",
+)
+
+// BeaconFrameLayout returns the lengths of the RFU fields of the beacon frame of the band.
+// The lengths depend on the spreading factor of the beacon data rate, which keeps the beacon airtime similar
+// across bands.
+func (b Band) BeaconFrameLayout() (rfu1, rfu2 int, err error) {
+	dr, ok := b.DataRates[b.Beacon.DataRateIndex]
+	if !ok {
+		return 0, 0, errBeaconDataRate.WithAttributes("data_rate_index", b.Beacon.DataRateIndex)
+	}
+	switch dr.Rate.GetLora().GetSpreadingFactor() {
+	case 8:
+		return 1, 3, nil
+	case 9:
+		return 2, 0, nil
+	case 10:
+		return 3, 1, nil
+	case 12:
+		return 5, 3, nil
+	default:
+		return 0, 0, errBeaconDataRate.WithAttributes("data_rate_index", b.Beacon.DataRateIndex)
+	}
+}
+
 var usAuBeaconFrequencies = func() []uint64 {
 	freqs := make([]uint64, 8)
 	for i := 0; i < 8; i++ {
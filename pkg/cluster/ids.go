@@ -18,3 +18,6 @@ import "go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 
 // PacketBrokerGatewayID is the proxy gateway identifier of gateways connected through Packet Broker.
 var PacketBrokerGatewayID = &ttnpb.GatewayIdentifiers{GatewayId: "packetbroker"}
+
+// InteropGatewayID is the proxy gateway identifier of gateways connected through a forwarding Network Server.
+var InteropGatewayID = &ttnpb.GatewayIdentifiers{GatewayId: "interop"}
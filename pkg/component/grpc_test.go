@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/smartystreets/assertions"
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
@@ -80,6 +81,55 @@ func (*gsImplementation) BatchGetGatewayConnectionStats(ctx context.Context,
 	return &ttnpb.BatchGetGatewayConnectionStatsResponse{}, nil
 }
 
+func (*gsImplementation) GetGatewayConnectionStatsHistory(ctx context.Context,
+	_ *ttnpb.GetGatewayConnectionStatsHistoryRequest,
+) (*ttnpb.GatewayConnectionStatsHistory, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayConnectionStatsHistory{}, nil
+}
+
+func (*gsImplementation) StartGatewayTrafficCapture(ctx context.Context,
+	_ *ttnpb.StartGatewayTrafficCaptureRequest,
+) (*ttnpb.GatewayTrafficCapture, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayTrafficCapture{}, nil
+}
+
+func (*gsImplementation) StopGatewayTrafficCapture(ctx context.Context,
+	_ *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayTrafficCapture, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayTrafficCapture{}, nil
+}
+
+func (*gsImplementation) GetGatewayTrafficCapture(ctx context.Context,
+	_ *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayTrafficCapture, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayTrafficCapture{}, nil
+}
+
+func (*gsImplementation) RunGatewayRemoteCommand(ctx context.Context,
+	_ *ttnpb.GatewayRemoteCommandRequest,
+) (*pbtypes.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (*gsImplementation) GatewayRemoteShell(stream ttnpb.Gs_GatewayRemoteShellServer) error {
+	return clusterauth.Authorized(stream.Context())
+}
+
 func TestHooks(t *testing.T) {
 	a := assertions.New(t)
 
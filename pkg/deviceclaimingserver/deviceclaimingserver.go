@@ -20,6 +20,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/gateways"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/web"
@@ -61,7 +62,11 @@ func New(c *component.Component, conf *Config, opts ...Option) (*DeviceClaimingS
 		opt(dcs)
 	}
 
-	dcs.gatewayClaimingServerUpstream = noopGCLS{}
+	gatewayUpstream, err := gateways.NewUpstream(ctx, conf.GatewayClaimingServerConfig, c)
+	if err != nil {
+		return nil, err
+	}
+	dcs.gatewayClaimingServerUpstream = gatewayUpstream
 
 	upstream, err := enddevices.NewUpstream(ctx, conf.EndDeviceClaimingServerConfig, c)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/localjs"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -32,6 +33,17 @@ type NetworkServer struct {
 	HomeNSID *types.EUI64 `name:"home-ns-id" description:"HomeNSID of the Network Server (EUI)"`
 }
 
+// LocalJoinServer contains options for claiming end devices on the Join Server of the cluster.
+//
+//nolint:lll
+type LocalJoinServer struct {
+	JoinEUIPrefixes []types.EUI64Prefix `name:"join-eui-prefixes" description:"JoinEUI prefixes of end devices that are claimed on the Join Server of the cluster"`
+
+	EncryptionKeyID string                           `name:"encryption-key-id" description:"ID of the key used to encrypt the API keys of application authorizations and the end devices that are being transferred at rest"`
+	Authorizations  ApplicationAuthorizationRegistry `name:"-"`
+	Transfers       localjs.TransferRegistry         `name:"-"`
+}
+
 // Config contains options for end device claiming clients.
 //
 //nolint:lll
@@ -39,6 +51,8 @@ type Config struct {
 	NetID         types.NetID   `name:"net-id" description:"NetID of this network to configure as home NetID when claiming"`
 	NetworkServer NetworkServer `name:"network-server" description:"Network Server of the cluster that handles claimed device traffic"`
 
+	LocalJoinServer LocalJoinServer `name:"local-join-server" description:"Claiming of end devices on the Join Server of the cluster"`
+
 	Source    string                `name:"source" description:"Source of the file containing Join Server settings (directory, url, blob)"`
 	Directory string                `name:"directory" description:"OS filesystem directory, which contains the config.yml and the client-specific files"`
 	URL       string                `name:"url" description:"URL, which contains Join Server client configuration"`
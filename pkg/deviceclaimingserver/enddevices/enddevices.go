@@ -24,6 +24,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/localjs"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/ttjsv2"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
@@ -32,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/grpc"
 	"gopkg.in/yaml.v2"
 )
@@ -48,6 +50,27 @@ type EndDeviceClaimer interface {
 	Unclaim(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (err error)
 }
 
+// EndDeviceTransferer is an EndDeviceClaimer that moves claimed end devices into the target application.
+type EndDeviceTransferer interface {
+	EndDeviceClaimer
+	// Transfer claims an End Device and moves it into the application of the target identifiers.
+	// It returns the identifiers of the moved End Device.
+	Transfer(
+		ctx context.Context, target *ttnpb.EndDeviceIdentifiers, claimAuthenticationCode string,
+	) (*ttnpb.EndDeviceIdentifiers, error)
+}
+
+// ApplicationAuthorizationRegistry stores the API keys with which applications authorize the Device Claiming Server to
+// move their end devices.
+type ApplicationAuthorizationRegistry interface {
+	// Get returns the authorization of the application.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error)
+	// Set sets the authorization of the application.
+	Set(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error
+	// Delete deletes the authorization of the application.
+	Delete(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) error
+}
+
 // Component abstracts the underlying *component.Component.
 type Component interface {
 	httpclient.Provider
@@ -58,20 +81,43 @@ type Component interface {
 
 const (
 	ttjsV2Type = "ttjsv2"
+
+	localJSName = "local"
 )
 
 // Upstream abstracts EndDeviceClaimingServer.
 type Upstream struct {
 	Component
-	deviceRegistry ttnpb.EndDeviceRegistryClient
-	servers        map[string]EndDeviceClaimer
+	deviceRegistry    ttnpb.EndDeviceRegistryClient
+	applicationAccess ttnpb.ApplicationAccessClient
+	localJSOpts       []localjs.Option
+	authorizations    ApplicationAuthorizationRegistry
+	servers           map[string]EndDeviceClaimer
 }
 
 // NewUpstream returns a new Upstream.
 func NewUpstream(ctx context.Context, conf Config, c Component, opts ...Option) (*Upstream, error) {
 	upstream := &Upstream{
-		Component: c,
-		servers:   make(map[string]EndDeviceClaimer),
+		Component:      c,
+		authorizations: conf.LocalJoinServer.Authorizations,
+		servers:        make(map[string]EndDeviceClaimer),
+	}
+	for _, opt := range opts {
+		opt(upstream)
+	}
+	if len(conf.LocalJoinServer.JoinEUIPrefixes) > 0 {
+		cfg := &localjs.Config{
+			NetID:           conf.NetID,
+			HomeNSID:        conf.NetworkServer.HomeNSID,
+			JoinEUIPrefixes: conf.LocalJoinServer.JoinEUIPrefixes,
+			Authorizations:  conf.LocalJoinServer.Authorizations,
+			Transfers:       conf.LocalJoinServer.Transfers,
+		}
+		claimer, err := cfg.NewClient(ctx, c, upstream.localJSOpts...)
+		if err != nil {
+			return nil, err
+		}
+		upstream.servers[localJSName] = claimer
 	}
 	fetcher, err := conf.Fetcher(ctx, c.GetBaseConfig(ctx).Blob, c)
 	if err != nil {
@@ -125,10 +171,6 @@ func NewUpstream(ctx context.Context, conf Config, c Component, opts ...Option)
 		upstream.servers[clientName] = claimer
 	}
 
-	for _, opt := range opts {
-		opt(upstream)
-	}
-
 	return upstream, nil
 }
 
@@ -142,11 +184,39 @@ func WithDeviceRegistry(reg ttnpb.EndDeviceRegistryClient) Option {
 	}
 }
 
+// WithApplicationAccess overrides the application access client of the Upstream.
+func WithApplicationAccess(access ttnpb.ApplicationAccessClient) Option {
+	return func(upstream *Upstream) {
+		upstream.applicationAccess = access
+	}
+}
+
+// WithLocalJoinServerOptions configures the claiming client of the Join Server of the cluster.
+func WithLocalJoinServerOptions(opts ...localjs.Option) Option {
+	return func(upstream *Upstream) {
+		upstream.localJSOpts = append(upstream.localJSOpts, opts...)
+	}
+}
+
 var (
-	errNoEUI                = errors.DefineInvalidArgument("no_eui", "DevEUI/JoinEUI not found in request")
-	errClaimingNotSupported = errors.DefineAborted("claiming_not_supported", "claiming not supported for JoinEUI `{eui}`")
+	errNoEUI                  = errors.DefineInvalidArgument("no_eui", "DevEUI/JoinEUI not found in request")
+	errClaimingNotSupported   = errors.DefineAborted("claiming_not_supported", "claiming not supported for JoinEUI `{eui}`")
+	errAuthorizationsDisabled = errors.DefineUnimplemented(
+		"authorizations_disabled", "application claiming authorizations are not configured",
+	)
+	errAPIKeyRights = errors.DefinePermissionDenied(
+		"api_key_rights", "API key does not have the rights to move end devices of application `{application_uid}`",
+	)
 )
 
+// authorizationKeyRights are the rights that the API key of an application authorization must have.
+var authorizationKeyRights = []ttnpb.Right{
+	ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+}
+
 func (upstream *Upstream) joinEUIClaimer(ctx context.Context, joinEUI types.EUI64) EndDeviceClaimer {
 	for _, srv := range upstream.servers {
 		if srv.SupportsJoinEUI(joinEUI) {
@@ -157,14 +227,75 @@ func (upstream *Upstream) joinEUIClaimer(ctx context.Context, joinEUI types.EUI6
 }
 
 // Claim implements EndDeviceClaimingServer.
+// The End Device is claimed with the JoinEUI and DevEUI of the target identifiers. If the claimer moves the End Device
+// into the target application, the identifiers of the moved End Device are returned. Otherwise, the target identifiers
+// are returned.
 func (upstream *Upstream) Claim(
-	ctx context.Context, joinEUI, devEUI types.EUI64, claimAuthenticationCode string,
-) error {
+	ctx context.Context, target *ttnpb.EndDeviceIdentifiers, claimAuthenticationCode string,
+) (*ttnpb.EndDeviceIdentifiers, error) {
+	joinEUI, devEUI := types.MustEUI64(target.JoinEui).OrZero(), types.MustEUI64(target.DevEui).OrZero()
 	claimer := upstream.joinEUIClaimer(ctx, joinEUI)
 	if claimer == nil {
-		return errClaimingNotSupported.WithAttributes("eui", joinEUI)
+		return nil, errClaimingNotSupported.WithAttributes("eui", joinEUI)
+	}
+	if transferer, ok := claimer.(EndDeviceTransferer); ok {
+		return transferer.Transfer(ctx, target, claimAuthenticationCode)
+	}
+	if err := claimer.Claim(ctx, joinEUI, devEUI, claimAuthenticationCode); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// AuthorizeApplication implements EndDeviceClaimingServer.
+func (upstream *Upstream) AuthorizeApplication(
+	ctx context.Context, req *ttnpb.AuthorizeApplicationRequest,
+) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_API_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	if upstream.authorizations == nil {
+		return nil, errAuthorizationsDisabled.New()
+	}
+	access, err := upstream.getApplicationAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyRights, err := access.ListRights(ctx, req.ApplicationIds, grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     req.ApiKey,
+		AllowInsecure: upstream.Component.AllowInsecureForCredentials(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if !keyRights.Implied().IncludesAll(authorizationKeyRights...) {
+		return nil, errAPIKeyRights.WithAttributes("application_uid", unique.ID(ctx, req.ApplicationIds))
+	}
+	if err := upstream.authorizations.Set(ctx, req); err != nil {
+		return nil, err
 	}
-	return claimer.Claim(ctx, joinEUI, devEUI, claimAuthenticationCode)
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeApplication implements EndDeviceClaimingServer.
+func (upstream *Upstream) UnauthorizeApplication(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids,
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_API_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	if upstream.authorizations == nil {
+		return nil, errAuthorizationsDisabled.New()
+	}
+	if err := upstream.authorizations.Delete(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
 }
 
 // Unclaim implements EndDeviceClaimingServer.
@@ -260,3 +391,14 @@ func (upstream *Upstream) getDeviceRegistry(ctx context.Context) (ttnpb.EndDevic
 	}
 	return ttnpb.NewEndDeviceRegistryClient(conn), nil
 }
+
+func (upstream *Upstream) getApplicationAccess(ctx context.Context) (ttnpb.ApplicationAccessClient, error) {
+	if upstream.applicationAccess != nil {
+		return upstream.applicationAccess, nil
+	}
+	conn, err := upstream.Component.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewApplicationAccessClient(conn), nil
+}
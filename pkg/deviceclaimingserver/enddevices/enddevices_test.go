@@ -59,9 +59,10 @@ func TestUpstream(t *testing.T) {
 	ctx = rights.NewContextWithFetcher(ctx, mock)
 
 	// Invalid JoinEUI.
-	err = upstream.Claim(ctx, *unsupportedJoinEUI,
-		types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0x30},
-		"secret")
+	_, err = upstream.Claim(ctx, &ttnpb.EndDeviceIdentifiers{
+		JoinEui: unsupportedJoinEUI.Bytes(),
+		DevEui:  types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0x30}.Bytes(),
+	}, "secret")
 	a.So(errors.IsAborted(err), should.BeTrue)
 
 	_, err = upstream.Unclaim(ctx, &ttnpb.EndDeviceIdentifiers{
@@ -88,9 +89,10 @@ func TestUpstream(t *testing.T) {
 	a.So(inf.JoinEui, should.Resemble, supportedJoinEUI.Bytes())
 	a.So(inf.SupportsClaiming, should.BeTrue)
 
-	err = upstream.Claim(ctx, *supportedJoinEUI,
-		types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0x30},
-		"secret")
+	_, err = upstream.Claim(ctx, &ttnpb.EndDeviceIdentifiers{
+		JoinEui: supportedJoinEUI.Bytes(),
+		DevEui:  types.EUI64{0x00, 0x04, 0xA3, 0x0B, 0x00, 0x1C, 0x05, 0x30}.Bytes(),
+	}, "secret")
 	a.So(!errors.IsUnimplemented(err), should.BeTrue)
 
 	_, err = upstream.Unclaim(ctx, &ttnpb.EndDeviceIdentifiers{
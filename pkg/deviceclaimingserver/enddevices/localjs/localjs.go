@@ -0,0 +1,718 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package localjs provides the claiming client implementation for the Join Server of the cluster.
+//
+// End devices that are activated on the Join Server of the cluster are claimed with the claim authentication code
+// that is stored for the end device. Claiming moves the end device from its current application into the target
+// application: the end device is deleted from the Identity Server, Join Server, Network Server and Application Server
+// registries of the current application, and registered in the target application. The session and the claim
+// authentication code of the end device are not moved, so the end device rejoins in the target application.
+//
+// The EUIs of end devices are unique in the registries, so the end device can not be registered in the target
+// application before it is deleted from the current application. Therefore, the end device, including its root keys
+// and session, is persisted in a transfer registry before it is deleted, until it is registered in the target
+// application or restored in the current application. If both fail, the end device is only registered in the transfer
+// registry, and the transfer is resumed by transferring the end device again.
+//
+// The current application must have authorized the Device Claiming Server to move its end devices with an API key.
+package localjs
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+// AuthorizationRegistry provides the API keys with which applications authorize the Device Claiming Server to move
+// their end devices.
+type AuthorizationRegistry interface {
+	// Get returns the authorization of the application.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.AuthorizeApplicationRequest, error)
+}
+
+// TransferRegistry persists the registrations of end devices while they are transferred.
+type TransferRegistry interface {
+	// Get returns the registrations of the end device that is being transferred.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevices, error)
+	// Set sets the registrations of the end device that is being transferred.
+	Set(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, devs *ttnpb.EndDevices) error
+	// Delete deletes the registrations of the end device that is being transferred.
+	Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
+}
+
+// Component abstracts the underlying *component.Component.
+type Component interface {
+	GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (*grpc.ClientConn, error)
+	AllowInsecureForCredentials() bool
+}
+
+// Config is the configuration of the local Join Server claiming client.
+type Config struct {
+	NetID           types.NetID
+	HomeNSID        *types.EUI64
+	JoinEUIPrefixes []types.EUI64Prefix
+	Authorizations  AuthorizationRegistry
+	Transfers       TransferRegistry
+}
+
+// Registries contains the end device registries of the cluster.
+// Registries that are nil are dialed through the cluster.
+type Registries struct {
+	EntityRegistry    ttnpb.EndDeviceRegistryClient
+	JoinServer        ttnpb.JsEndDeviceRegistryClient
+	NetworkServer     ttnpb.NsEndDeviceRegistryClient
+	ApplicationServer ttnpb.AsEndDeviceRegistryClient
+}
+
+// LocalJS is a client that claims end devices on the Join Server of the cluster.
+type LocalJS struct {
+	Component
+
+	config     Config
+	registries Registries
+}
+
+// Option configures LocalJS.
+type Option func(*LocalJS)
+
+// WithRegistries overrides the end device registries of LocalJS.
+func WithRegistries(registries Registries) Option {
+	return func(js *LocalJS) {
+		js.registries = registries
+	}
+}
+
+// NewClient applies the config and returns a new LocalJS client.
+func (cfg *Config) NewClient(_ context.Context, c Component, opts ...Option) (*LocalJS, error) {
+	js := &LocalJS{
+		Component: c,
+		config:    *cfg,
+	}
+	for _, opt := range opts {
+		opt(js)
+	}
+	return js, nil
+}
+
+// SupportsJoinEUI implements EndDeviceClaimer.
+func (js *LocalJS) SupportsJoinEUI(eui types.EUI64) bool {
+	for _, prefix := range js.config.JoinEUIPrefixes {
+		if eui.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	errDeviceNotFound = errors.DefineNotFound(
+		"device_not_found", "end device with JoinEUI `{join_eui}` and DevEUI `{dev_eui}` not found",
+	)
+	errApplicationNotAuthorized = errors.DefinePermissionDenied(
+		"application_not_authorized", "application `{application_uid}` is not authorized for claiming",
+	)
+	errClaimAuthenticationCode = errors.DefinePermissionDenied(
+		"claim_authentication_code", "invalid claim authentication code for end device with DevEUI `{dev_eui}`",
+	)
+	errAlreadyInApplication = errors.DefineAlreadyExists(
+		"already_in_application", "end device with DevEUI `{dev_eui}` is already in application `{application_uid}`",
+	)
+	errTransfersDisabled = errors.DefineUnimplemented(
+		"transfers_disabled", "end device transfers are not configured",
+	)
+	errTransferCorrupted = errors.DefineCorruption(
+		"transfer_corrupted", "end device transfer with DevEUI `{dev_eui}` is corrupted",
+	)
+)
+
+var (
+	isPaths = []string{
+		"application_server_address",
+		"attributes",
+		"claim_authentication_code",
+		"description",
+		"join_server_address",
+		"locations",
+		"name",
+		"network_server_address",
+		"picture",
+		"service_profile_id",
+		"version_ids",
+	}
+	jsGetPaths = []string{
+		"application_server_address",
+		"application_server_id",
+		"application_server_kek_label",
+		"claim_authentication_code",
+		"last_dev_nonce",
+		"last_join_nonce",
+		"last_rj_count_0",
+		"last_rj_count_1",
+		"net_id",
+		"network_server_address",
+		"network_server_kek_label",
+		"provisioner_id",
+		"provisioning_data",
+		"resets_join_nonces",
+		"root_keys.app_key.key",
+		"root_keys.nwk_key.key",
+		"root_keys.root_key_id",
+		"used_dev_nonces",
+	}
+	// jsSetPaths excludes the claim authentication code, which is not moved.
+	// The nonces are moved, so that join-requests can not be replayed in the target application.
+	jsSetPaths = []string{
+		"application_server_address",
+		"application_server_id",
+		"application_server_kek_label",
+		"last_dev_nonce",
+		"last_join_nonce",
+		"last_rj_count_0",
+		"last_rj_count_1",
+		"net_id",
+		"network_server_address",
+		"network_server_kek_label",
+		"provisioner_id",
+		"provisioning_data",
+		"resets_join_nonces",
+		"root_keys.root_key_id",
+		"used_dev_nonces",
+	}
+	// nsPaths excludes the session and MAC state, which are not moved.
+	nsPaths = []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings",
+		"multicast",
+		"supports_class_b",
+		"supports_class_c",
+		"supports_join",
+		"version_ids",
+	}
+	// asPaths excludes the session, which is not moved.
+	asPaths = []string{
+		"formatters",
+		"skip_payload_crypto_override",
+		"version_ids",
+	}
+	// nsSessionPaths and asSessionPaths are only used to restore the end device in the source application.
+	nsSessionPaths = []string{
+		"mac_state",
+		"pending_mac_state",
+		"pending_session",
+		"session",
+	}
+	asSessionPaths = []string{
+		"pending_session",
+		"session",
+	}
+)
+
+// withEUIs returns a copy of the paths with the EUIs of the end device.
+func withEUIs(paths []string) []string {
+	return append(append(paths[:0:0], paths...), "ids.dev_eui", "ids.join_eui")
+}
+
+// endDevice contains the registrations of an end device in the cluster.
+// The Network Server and Application Server registrations are nil if the end device is not registered there.
+type endDevice struct {
+	is, js, ns, as *ttnpb.EndDevice
+}
+
+// toPB returns the registrations of the end device in the order of the Identity Server, Join Server, Network Server
+// and Application Server. Registrations that do not exist are empty.
+func (dev *endDevice) toPB() *ttnpb.EndDevices {
+	pb := &ttnpb.EndDevices{}
+	for _, d := range []*ttnpb.EndDevice{dev.is, dev.js, dev.ns, dev.as} {
+		if d == nil {
+			d = &ttnpb.EndDevice{}
+		}
+		pb.EndDevices = append(pb.EndDevices, d)
+	}
+	return pb
+}
+
+// endDeviceFromPB returns the registrations of the end device from the output of toPB.
+func endDeviceFromPB(pb *ttnpb.EndDevices) (*endDevice, bool) {
+	if len(pb.GetEndDevices()) != 4 {
+		return nil, false
+	}
+	dev := &endDevice{
+		is: pb.EndDevices[0],
+		js: pb.EndDevices[1],
+		ns: pb.EndDevices[2],
+		as: pb.EndDevices[3],
+	}
+	if dev.is.GetIds() == nil {
+		return nil, false
+	}
+	if dev.ns.GetIds() == nil {
+		dev.ns = nil
+	}
+	if dev.as.GetIds() == nil {
+		dev.as = nil
+	}
+	return dev, true
+}
+
+func (js *LocalJS) getRegistries(ctx context.Context) (Registries, error) {
+	registries := js.registries
+	if registries.EntityRegistry == nil {
+		conn, err := js.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+		if err != nil {
+			return Registries{}, err
+		}
+		registries.EntityRegistry = ttnpb.NewEndDeviceRegistryClient(conn)
+	}
+	if registries.JoinServer == nil {
+		conn, err := js.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, nil)
+		if err != nil {
+			return Registries{}, err
+		}
+		registries.JoinServer = ttnpb.NewJsEndDeviceRegistryClient(conn)
+	}
+	if registries.NetworkServer == nil {
+		conn, err := js.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return Registries{}, err
+		}
+		registries.NetworkServer = ttnpb.NewNsEndDeviceRegistryClient(conn)
+	}
+	if registries.ApplicationServer == nil {
+		conn, err := js.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return Registries{}, err
+		}
+		registries.ApplicationServer = ttnpb.NewAsEndDeviceRegistryClient(conn)
+	}
+	return registries, nil
+}
+
+func (js *LocalJS) apiKeyCallOpt(apiKey string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     apiKey,
+		AllowInsecure: js.AllowInsecureForCredentials(),
+	})
+}
+
+// source returns the identifiers of the end device and the call option that authorizes calls for its application.
+func (js *LocalJS) source(
+	ctx context.Context, registries Registries, joinEUI, devEUI types.EUI64,
+) (*ttnpb.EndDeviceIdentifiers, grpc.CallOption, error) {
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, js.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, nil, err
+	}
+	ids, err := registries.EntityRegistry.GetIdentifiersForEUIs(ctx, &ttnpb.GetEndDeviceIdentifiersForEUIsRequest{
+		JoinEui: joinEUI.Bytes(),
+		DevEui:  devEUI.Bytes(),
+	}, callOpt)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, errDeviceNotFound.WithAttributes("join_eui", joinEUI, "dev_eui", devEUI)
+		}
+		return nil, nil, err
+	}
+	callOpt, err = js.authorization(ctx, ids.ApplicationIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ids, callOpt, nil
+}
+
+// authorization returns the call option that authorizes calls for the application.
+func (js *LocalJS) authorization(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (grpc.CallOption, error) {
+	if js.config.Authorizations == nil {
+		return nil, errApplicationNotAuthorized.WithAttributes("application_uid", unique.ID(ctx, ids))
+	}
+	authorization, err := js.config.Authorizations.Get(ctx, ids)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errApplicationNotAuthorized.WithAttributes("application_uid", unique.ID(ctx, ids))
+		}
+		return nil, err
+	}
+	return js.apiKeyCallOpt(authorization.ApiKey), nil
+}
+
+func (js *LocalJS) get(
+	ctx context.Context, registries Registries, ids *ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption,
+) (*endDevice, error) {
+	var (
+		dev endDevice
+		err error
+	)
+	dev.is, err = registries.EntityRegistry.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask(isPaths...),
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	dev.js, err = registries.JoinServer.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask(jsGetPaths...),
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	dev.ns, err = registries.NetworkServer.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask(ttnpb.AddFields(nsPaths, nsSessionPaths...)...),
+	}, callOpt)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	dev.as, err = registries.ApplicationServer.Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask(ttnpb.AddFields(asPaths, asSessionPaths...)...),
+	}, callOpt)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return &dev, nil
+}
+
+// claimAuthenticationCode returns the claim authentication code of the end device.
+// The claim authentication code in the Join Server registry takes precedence over the one in the Identity Server.
+func (dev *endDevice) claimAuthenticationCode() *ttnpb.EndDeviceAuthenticationCode {
+	if code := dev.js.GetClaimAuthenticationCode(); code.GetValue() != "" {
+		return code
+	}
+	return dev.is.GetClaimAuthenticationCode()
+}
+
+func validClaimAuthenticationCode(code *ttnpb.EndDeviceAuthenticationCode, value string, now time.Time) bool {
+	if code.GetValue() == "" {
+		return false
+	}
+	if validFrom := ttnpb.StdTime(code.ValidFrom); validFrom != nil && now.Before(*validFrom) {
+		return false
+	}
+	if validTo := ttnpb.StdTime(code.ValidTo); validTo != nil && now.After(*validTo) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(code.Value), []byte(value)) == 1
+}
+
+// delete deletes the end device from the Application Server, Network Server, Join Server and Identity Server,
+// in that order.
+func (js *LocalJS) delete(
+	ctx context.Context, registries Registries, ids *ttnpb.EndDeviceIdentifiers, callOpt grpc.CallOption,
+) error {
+	if _, err := registries.ApplicationServer.Delete(ctx, ids, callOpt); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if _, err := registries.NetworkServer.Delete(ctx, ids, callOpt); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if _, err := registries.JoinServer.Delete(ctx, ids, callOpt); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if _, err := registries.EntityRegistry.Delete(ctx, ids, callOpt); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// create registers the end device with the given identifiers in the Identity Server, Join Server, Network Server and
+// Application Server, in that order. The claim authentication code and the session are only kept if restore is set.
+// The Join Server does not store claim authentication codes, so a claim authentication code from the Join Server is
+// restored in the Identity Server. If registration fails after the end device has been created in the Identity Server,
+// the end device is deleted again.
+func (js *LocalJS) create(
+	ctx context.Context,
+	registries Registries,
+	ids *ttnpb.EndDeviceIdentifiers,
+	dev *endDevice,
+	restore bool,
+	callOpt grpc.CallOption,
+) (err error) {
+	isDev := ttnpb.Clone(dev.is)
+	isDev.Ids = ids
+	isDev.ClaimAuthenticationCode = nil
+	if restore {
+		isDev.ClaimAuthenticationCode = dev.claimAuthenticationCode()
+	}
+	if _, err := registries.EntityRegistry.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: isDev,
+	}, callOpt); err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if err := js.delete(ctx, registries, ids, callOpt); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to delete partially registered end device")
+		}
+	}()
+
+	jsDev := ttnpb.Clone(dev.js)
+	jsDev.Ids = ids
+	jsDev.ClaimAuthenticationCode = nil
+	jsPaths := withEUIs(jsSetPaths)
+	if !types.MustAES128Key(jsDev.GetRootKeys().GetAppKey().GetKey()).OrZero().IsZero() {
+		jsPaths = ttnpb.AddFields(jsPaths, "root_keys.app_key.key")
+	}
+	if !types.MustAES128Key(jsDev.GetRootKeys().GetNwkKey().GetKey()).OrZero().IsZero() {
+		jsPaths = ttnpb.AddFields(jsPaths, "root_keys.nwk_key.key")
+	}
+	if _, err := registries.JoinServer.Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: jsDev,
+		FieldMask: ttnpb.FieldMask(jsPaths...),
+	}, callOpt); err != nil {
+		return err
+	}
+
+	if dev.ns != nil {
+		nsDev := ttnpb.Clone(dev.ns)
+		nsDev.Ids = ids
+		paths := withEUIs(nsPaths)
+		if restore {
+			paths = ttnpb.AddFields(paths, nsSessionPaths...)
+		}
+		if _, err := registries.NetworkServer.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: nsDev,
+			FieldMask: ttnpb.FieldMask(paths...),
+		}, callOpt); err != nil {
+			return err
+		}
+	}
+
+	if dev.as != nil {
+		asDev := ttnpb.Clone(dev.as)
+		asDev.Ids = ids
+		paths := withEUIs(asPaths)
+		if restore {
+			paths = ttnpb.AddFields(paths, asSessionPaths...)
+		}
+		if _, err := registries.ApplicationServer.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: asDev,
+			FieldMask: ttnpb.FieldMask(paths...),
+		}, callOpt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Claim implements EndDeviceClaimer.
+// The end device is not moved; this only verifies the claim authentication code.
+func (js *LocalJS) Claim(ctx context.Context, joinEUI, devEUI types.EUI64, claimAuthenticationCode string) error {
+	registries, err := js.getRegistries(ctx)
+	if err != nil {
+		return err
+	}
+	ids, callOpt, err := js.source(ctx, registries, joinEUI, devEUI)
+	if err != nil {
+		return err
+	}
+	dev, err := js.get(ctx, registries, ids, callOpt)
+	if err != nil {
+		return err
+	}
+	if !validClaimAuthenticationCode(dev.claimAuthenticationCode(), claimAuthenticationCode, time.Now()) {
+		return errClaimAuthenticationCode.WithAttributes("dev_eui", devEUI)
+	}
+	return nil
+}
+
+// Transfer implements EndDeviceTransferer.
+// The end device is moved to the application of the target identifiers. If the target identifiers do not contain a
+// device ID, the device ID is kept.
+func (js *LocalJS) Transfer(
+	ctx context.Context, target *ttnpb.EndDeviceIdentifiers, claimAuthenticationCode string,
+) (*ttnpb.EndDeviceIdentifiers, error) {
+	joinEUI, devEUI := types.MustEUI64(target.JoinEui).OrZero(), types.MustEUI64(target.DevEui).OrZero()
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
+	))
+	if js.config.Transfers == nil {
+		return nil, errTransfersDisabled.New()
+	}
+
+	// The root keys are moved, so the caller needs to be able to write keys in the target application.
+	if err := rights.RequireApplication(ctx, target.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	targetCallOpt, err := rpcmetadata.WithForwardedAuth(ctx, js.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	registries, err := js.getRegistries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sourceIDs, sourceCallOpt, err := js.source(ctx, registries, joinEUI, devEUI)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return js.resumeTransfer(ctx, registries, target, claimAuthenticationCode, targetCallOpt)
+		}
+		return nil, err
+	}
+	if unique.ID(ctx, sourceIDs.ApplicationIds) == unique.ID(ctx, target.ApplicationIds) {
+		return nil, errAlreadyInApplication.WithAttributes(
+			"dev_eui", devEUI,
+			"application_uid", unique.ID(ctx, target.ApplicationIds),
+		)
+	}
+	dev, err := js.get(ctx, registries, sourceIDs, sourceCallOpt)
+	if err != nil {
+		return nil, err
+	}
+	if !validClaimAuthenticationCode(dev.claimAuthenticationCode(), claimAuthenticationCode, time.Now()) {
+		return nil, errClaimAuthenticationCode.WithAttributes("dev_eui", devEUI)
+	}
+
+	targetIDs := transferTarget(target, sourceIDs)
+
+	// The EUIs are unique in the registries, so the end device is deleted from the source application before it is
+	// registered in the target application. The end device is persisted in the transfer registry first, so that its
+	// root keys and session are stored until it is registered in the target application. If that fails, the end
+	// device is registered in the source application again, including its session. The end device is only deleted
+	// from the transfer registry when it is registered in either application.
+	if err := js.config.Transfers.Set(ctx, sourceIDs, dev.toPB()); err != nil {
+		return nil, err
+	}
+	restore := func() {
+		if err := js.delete(ctx, registries, sourceIDs, sourceCallOpt); err != nil {
+			logger.WithError(err).Warn("Failed to delete partially deleted end device")
+		}
+		if err := js.create(ctx, registries, sourceIDs, dev, true, sourceCallOpt); err != nil {
+			logger.WithError(err).Error("Failed to restore end device after failed claim, keep it in the transfer registry")
+			return
+		}
+		if err := js.config.Transfers.Delete(ctx, sourceIDs); err != nil {
+			logger.WithError(err).Warn("Failed to delete restored end device from transfer registry")
+		}
+	}
+	if err := js.delete(ctx, registries, sourceIDs, sourceCallOpt); err != nil {
+		restore()
+		return nil, err
+	}
+	if err := js.create(ctx, registries, targetIDs, dev, false, targetCallOpt); err != nil {
+		restore()
+		return nil, err
+	}
+	if err := js.config.Transfers.Delete(ctx, sourceIDs); err != nil {
+		logger.WithError(err).Warn("Failed to delete claimed end device from transfer registry")
+	}
+	logger.WithFields(log.Fields(
+		"source_device_uid", unique.ID(ctx, sourceIDs),
+		"target_device_uid", unique.ID(ctx, targetIDs),
+	)).Info("Claimed end device")
+	return targetIDs, nil
+}
+
+// transferTarget returns the identifiers of the end device in the target application.
+// If the target identifiers do not contain a device ID, the device ID of the source identifiers is used.
+func transferTarget(target, source *ttnpb.EndDeviceIdentifiers) *ttnpb.EndDeviceIdentifiers {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: target.ApplicationIds,
+		DeviceId:       target.DeviceId,
+		JoinEui:        source.JoinEui,
+		DevEui:         source.DevEui,
+	}
+	if ids.DeviceId == "" {
+		ids.DeviceId = source.DeviceId
+	}
+	return ids
+}
+
+// resumeTransfer resumes the transfer of an end device that is only registered in the transfer registry, because it
+// could neither be registered in the target application nor be restored in the source application.
+// The end device is registered in the target application. If the target application is the source application, the
+// end device is restored, including its session. The end device is kept in the transfer registry if this fails.
+func (js *LocalJS) resumeTransfer(
+	ctx context.Context,
+	registries Registries,
+	target *ttnpb.EndDeviceIdentifiers,
+	claimAuthenticationCode string,
+	targetCallOpt grpc.CallOption,
+) (*ttnpb.EndDeviceIdentifiers, error) {
+	joinEUI, devEUI := types.MustEUI64(target.JoinEui).OrZero(), types.MustEUI64(target.DevEui).OrZero()
+	pb, err := js.config.Transfers.Get(ctx, target)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errDeviceNotFound.WithAttributes("join_eui", joinEUI, "dev_eui", devEUI)
+		}
+		return nil, err
+	}
+	dev, ok := endDeviceFromPB(pb)
+	if !ok {
+		return nil, errTransferCorrupted.WithAttributes("dev_eui", devEUI)
+	}
+	sourceIDs := dev.is.Ids
+	if _, err := js.authorization(ctx, sourceIDs.ApplicationIds); err != nil {
+		return nil, err
+	}
+	if !validClaimAuthenticationCode(dev.claimAuthenticationCode(), claimAuthenticationCode, time.Now()) {
+		return nil, errClaimAuthenticationCode.WithAttributes("dev_eui", devEUI)
+	}
+
+	targetIDs := transferTarget(target, sourceIDs)
+	restore := unique.ID(ctx, sourceIDs.ApplicationIds) == unique.ID(ctx, targetIDs.ApplicationIds)
+	if err := js.create(ctx, registries, targetIDs, dev, restore, targetCallOpt); err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
+		"source_device_uid", unique.ID(ctx, sourceIDs),
+		"target_device_uid", unique.ID(ctx, targetIDs),
+	))
+	if err := js.config.Transfers.Delete(ctx, sourceIDs); err != nil {
+		logger.WithError(err).Warn("Failed to delete claimed end device from transfer registry")
+	}
+	logger.Info("Resumed end device transfer")
+	return targetIDs, nil
+}
+
+// Unclaim implements EndDeviceClaimer.
+// End devices on the Join Server of the cluster are not locked to the network, so there is no claim to release.
+func (*LocalJS) Unclaim(context.Context, *ttnpb.EndDeviceIdentifiers) error {
+	return nil
+}
+
+// GetClaimStatus implements EndDeviceClaimer.
+func (js *LocalJS) GetClaimStatus(
+	_ context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.GetClaimStatusResponse, error) {
+	res := &ttnpb.GetClaimStatusResponse{
+		EndDeviceIds: ids,
+		HomeNetId:    js.config.NetID.Bytes(),
+	}
+	if js.config.HomeNSID != nil {
+		res.HomeNsId = js.config.HomeNSID.Bytes()
+	}
+	return res, nil
+}
@@ -0,0 +1,527 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package localjs_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	. "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/localjs"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	errNotFound      = errors.DefineNotFound("not_found", "not found")
+	errAlreadyExists = errors.DefineAlreadyExists("already_exists", "already exists")
+	errUnavailable   = errors.DefineUnavailable("unavailable", "unavailable")
+)
+
+type mockComponent struct{}
+
+func (mockComponent) GetPeerConn(context.Context, ttnpb.ClusterRole, cluster.EntityIdentifiers) (*grpc.ClientConn, error) {
+	return nil, nil
+}
+
+func (mockComponent) AllowInsecureForCredentials() bool {
+	return true
+}
+
+// mockRegistry is an in-memory end device registry.
+type mockRegistry struct {
+	ttnpb.EndDeviceRegistryClient
+	ttnpb.JsEndDeviceRegistryClient
+	ttnpb.NsEndDeviceRegistryClient
+
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevice
+	// failSet contains the applications in which setting end devices fails.
+	failSet map[string]bool
+}
+
+func newMockRegistry(devs ...*ttnpb.EndDevice) *mockRegistry {
+	r := &mockRegistry{
+		devices: make(map[string]*ttnpb.EndDevice),
+	}
+	for _, dev := range devs {
+		r.devices[unique.ID(test.Context(), dev.Ids)] = ttnpb.Clone(dev)
+	}
+	return r
+}
+
+func (r *mockRegistry) get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) *ttnpb.EndDevice {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.devices[unique.ID(ctx, ids)]
+}
+
+func (r *mockRegistry) GetIdentifiersForEUIs(
+	ctx context.Context, req *ttnpb.GetEndDeviceIdentifiersForEUIsRequest, _ ...grpc.CallOption,
+) (*ttnpb.EndDeviceIdentifiers, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, dev := range r.devices {
+		if types.MustEUI64(dev.Ids.JoinEui).OrZero() == types.MustEUI64(req.JoinEui).OrZero() &&
+			types.MustEUI64(dev.Ids.DevEui).OrZero() == types.MustEUI64(req.DevEui).OrZero() {
+			return dev.Ids, nil
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (r *mockRegistry) Create(
+	ctx context.Context, req *ttnpb.CreateEndDeviceRequest, _ ...grpc.CallOption,
+) (*ttnpb.EndDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, dev := range r.devices {
+		if types.MustEUI64(dev.Ids.DevEui).OrZero() == types.MustEUI64(req.EndDevice.Ids.DevEui).OrZero() {
+			return nil, errAlreadyExists.New()
+		}
+	}
+	r.devices[unique.ID(ctx, req.EndDevice.Ids)] = ttnpb.Clone(req.EndDevice)
+	return req.EndDevice, nil
+}
+
+func (r *mockRegistry) Get(
+	ctx context.Context, req *ttnpb.GetEndDeviceRequest, _ ...grpc.CallOption,
+) (*ttnpb.EndDevice, error) {
+	dev := r.get(ctx, req.EndDeviceIds)
+	if dev == nil {
+		return nil, errNotFound.New()
+	}
+	return ttnpb.Clone(dev), nil
+}
+
+func (r *mockRegistry) Set(
+	ctx context.Context, req *ttnpb.SetEndDeviceRequest, _ ...grpc.CallOption,
+) (*ttnpb.EndDevice, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failSet[req.EndDevice.Ids.ApplicationIds.ApplicationId] {
+		return nil, errUnavailable.New()
+	}
+	uid := unique.ID(ctx, req.EndDevice.Ids)
+	dev, ok := r.devices[uid]
+	if !ok {
+		dev = &ttnpb.EndDevice{
+			Ids: req.EndDevice.Ids,
+		}
+	}
+	if err := dev.SetFields(req.EndDevice, req.FieldMask.GetPaths()...); err != nil {
+		return nil, err
+	}
+	r.devices[uid] = dev
+	return dev, nil
+}
+
+func (r *mockRegistry) Delete(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, _ ...grpc.CallOption,
+) (*pbtypes.Empty, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	if _, ok := r.devices[uid]; !ok {
+		return nil, errNotFound.New()
+	}
+	delete(r.devices, uid)
+	return ttnpb.Empty, nil
+}
+
+type mockAuthorizationRegistry map[string]*ttnpb.AuthorizeApplicationRequest
+
+func (r mockAuthorizationRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.AuthorizeApplicationRequest, error) {
+	req, ok := r[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return req, nil
+}
+
+// mockTransferRegistry is an in-memory end device transfer registry.
+type mockTransferRegistry struct {
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevices
+	// err is returned when setting end devices.
+	err error
+}
+
+func newMockTransferRegistry() *mockTransferRegistry {
+	return &mockTransferRegistry{
+		devices: make(map[string]*ttnpb.EndDevices),
+	}
+}
+
+func (*mockTransferRegistry) key(ids *ttnpb.EndDeviceIdentifiers) string {
+	return types.MustEUI64(ids.JoinEui).OrZero().String() + types.MustEUI64(ids.DevEui).OrZero().String()
+}
+
+func (r *mockTransferRegistry) get(ids *ttnpb.EndDeviceIdentifiers) *ttnpb.EndDevices {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.devices[r.key(ids)]
+}
+
+func (r *mockTransferRegistry) Get(_ context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDevices, error) {
+	devs := r.get(ids)
+	if devs == nil {
+		return nil, errNotFound.New()
+	}
+	return ttnpb.Clone(devs), nil
+}
+
+func (r *mockTransferRegistry) Set(_ context.Context, ids *ttnpb.EndDeviceIdentifiers, devs *ttnpb.EndDevices) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.devices[r.key(ids)] = ttnpb.Clone(devs)
+	return nil
+}
+
+func (r *mockTransferRegistry) Delete(_ context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.devices, r.key(ids))
+	return nil
+}
+
+func newContext(ctx context.Context) context.Context {
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer token"))
+	return rights.NewContextWithFetcher(ctx, struct {
+		rights.EntityFetcherFunc
+		rights.AuthInfoFetcherFunc
+	}{
+		EntityFetcherFunc: func(context.Context, *ttnpb.EntityIdentifiers) (*ttnpb.Rights, error) {
+			return ttnpb.RightsFrom(
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE_KEYS,
+			), nil
+		},
+	})
+}
+
+func TestLocalJS(t *testing.T) {
+	t.Parallel()
+
+	var (
+		joinEUI   = types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}
+		devEUI    = types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30}
+		appKey    = types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+		sourceApp = &ttnpb.ApplicationIdentifiers{ApplicationId: "source-app"}
+		targetApp = &ttnpb.ApplicationIdentifiers{ApplicationId: "target-app"}
+		sourceIDs = &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: sourceApp,
+			DeviceId:       "dev1",
+			JoinEui:        joinEUI.Bytes(),
+			DevEui:         devEUI.Bytes(),
+		}
+		targetIDs = &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: targetApp,
+			DeviceId:       "dev1",
+			JoinEui:        joinEUI.Bytes(),
+			DevEui:         devEUI.Bytes(),
+		}
+	)
+
+	type registries struct {
+		is, js, ns, as *mockRegistry
+		transfers      *mockTransferRegistry
+	}
+	newRegistries := func() registries {
+		return registries{
+			is: newMockRegistry(&ttnpb.EndDevice{
+				Ids:  sourceIDs,
+				Name: "Device",
+			}),
+			js: newMockRegistry(&ttnpb.EndDevice{
+				Ids: sourceIDs,
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: appKey.Bytes()},
+				},
+				LastDevNonce: 42,
+				ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+					Value:   "secret",
+					ValidTo: ttnpb.ProtoTimePtr(time.Now().Add(time.Hour)),
+				},
+			}),
+			ns: newMockRegistry(&ttnpb.EndDevice{
+				Ids:             sourceIDs,
+				FrequencyPlanId: "EU_863_870",
+				SupportsJoin:    true,
+				Session: &ttnpb.Session{
+					DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04}.Bytes(),
+				},
+			}),
+			as: newMockRegistry(&ttnpb.EndDevice{
+				Ids: sourceIDs,
+			}),
+			transfers: newMockTransferRegistry(),
+		}
+	}
+	newClient := func(ctx context.Context, regs registries, authorizations mockAuthorizationRegistry) *LocalJS {
+		cfg := &Config{
+			NetID:           types.NetID{0x00, 0x00, 0x13},
+			JoinEUIPrefixes: []types.EUI64Prefix{{EUI64: joinEUI, Length: 64}},
+			Authorizations:  authorizations,
+			Transfers:       regs.transfers,
+		}
+		js, err := cfg.NewClient(ctx, mockComponent{}, WithRegistries(Registries{
+			EntityRegistry:    regs.is,
+			JoinServer:        regs.js,
+			NetworkServer:     regs.ns,
+			ApplicationServer: regs.as,
+		}))
+		if err != nil {
+			panic(err)
+		}
+		return js
+	}
+	authorized := mockAuthorizationRegistry{
+		"source-app": {
+			ApplicationIds: sourceApp,
+			ApiKey:         "NNSXS.KEY.SECRET",
+		},
+	}
+
+	t.Run("NotAuthorized", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		js := newClient(ctx, regs, mockAuthorizationRegistry{})
+
+		a.So(js.SupportsJoinEUI(joinEUI), should.BeTrue)
+		_, err := js.Transfer(ctx, targetIDs, "secret")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		a.So(regs.is.get(ctx, sourceIDs), should.NotBeNil)
+	})
+
+	t.Run("InvalidClaimAuthenticationCode", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		js := newClient(ctx, regs, authorized)
+
+		a.So(errors.IsPermissionDenied(js.Claim(ctx, joinEUI, devEUI, "invalid")), should.BeTrue)
+		_, err := js.Transfer(ctx, targetIDs, "invalid")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		a.So(regs.js.get(ctx, sourceIDs), should.NotBeNil)
+	})
+
+	t.Run("SameApplication", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		js := newClient(ctx, regs, authorized)
+
+		_, err := js.Transfer(ctx, sourceIDs, "secret")
+		a.So(errors.IsAlreadyExists(err), should.BeTrue)
+	})
+
+	t.Run("Transfer", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		js := newClient(ctx, regs, authorized)
+
+		a.So(js.Claim(ctx, joinEUI, devEUI, "secret"), should.BeNil)
+		ids, err := js.Transfer(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: targetApp,
+			JoinEui:        joinEUI.Bytes(),
+			DevEui:         devEUI.Bytes(),
+		}, "secret")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ids, should.Resemble, targetIDs)
+
+		for _, reg := range []*mockRegistry{regs.is, regs.js, regs.ns, regs.as} {
+			a.So(reg.get(ctx, sourceIDs), should.BeNil)
+			a.So(reg.get(ctx, targetIDs), should.NotBeNil)
+		}
+		a.So(regs.is.get(ctx, targetIDs).Name, should.Equal, "Device")
+
+		jsDev := regs.js.get(ctx, targetIDs)
+		a.So(jsDev.RootKeys.GetAppKey().GetKey(), should.Resemble, appKey.Bytes())
+		a.So(jsDev.LastDevNonce, should.Equal, 42)
+		a.So(jsDev.ClaimAuthenticationCode, should.BeNil)
+
+		nsDev := regs.ns.get(ctx, targetIDs)
+		a.So(nsDev.FrequencyPlanId, should.Equal, "EU_863_870")
+		a.So(nsDev.SupportsJoin, should.BeTrue)
+		a.So(nsDev.Session, should.BeNil)
+		a.So(regs.transfers.get(sourceIDs), should.BeNil)
+
+		// The claim authentication code is reset, so the end device can not be claimed again.
+		_, err = js.Transfer(ctx, &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "other-app"},
+			JoinEui:        joinEUI.Bytes(),
+			DevEui:         devEUI.Bytes(),
+		}, "secret")
+		a.So(err, should.NotBeNil)
+	})
+
+	t.Run("Restore", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		js := newClient(ctx, regs, authorized)
+
+		regs.ns.failSet = map[string]bool{targetApp.ApplicationId: true}
+		_, err := js.Transfer(ctx, targetIDs, "secret")
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+
+		// The end device is registered in the source application again, with session.
+		for _, reg := range []*mockRegistry{regs.is, regs.js, regs.ns, regs.as} {
+			a.So(reg.get(ctx, sourceIDs), should.NotBeNil)
+			a.So(reg.get(ctx, targetIDs), should.BeNil)
+		}
+		a.So(regs.js.get(ctx, sourceIDs).GetRootKeys().GetAppKey().GetKey(), should.Resemble, appKey.Bytes())
+		a.So(regs.ns.get(ctx, sourceIDs).GetSession().GetDevAddr(), should.Resemble,
+			types.DevAddr{0x01, 0x02, 0x03, 0x04}.Bytes(),
+		)
+		a.So(regs.transfers.get(sourceIDs), should.BeNil)
+
+		// The claim authentication code of the Join Server is restored in the Identity Server.
+		a.So(regs.is.get(ctx, sourceIDs).GetClaimAuthenticationCode().GetValue(), should.Equal, "secret")
+		a.So(js.Claim(ctx, joinEUI, devEUI, "secret"), should.BeNil)
+	})
+
+	t.Run("RestoreFailure", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		js := newClient(ctx, regs, authorized)
+
+		regs.js.failSet = map[string]bool{
+			sourceApp.ApplicationId: true,
+			targetApp.ApplicationId: true,
+		}
+		_, err := js.Transfer(ctx, targetIDs, "secret")
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+
+		// The end device, including its root keys and session, is kept in the transfer registry.
+		devs := regs.transfers.get(sourceIDs)
+		if !a.So(devs.GetEndDevices(), should.HaveLength, 4) {
+			t.FailNow()
+		}
+		a.So(devs.EndDevices[0].Ids, should.Resemble, sourceIDs)
+		a.So(devs.EndDevices[1].GetRootKeys().GetAppKey().GetKey(), should.Resemble, appKey.Bytes())
+		a.So(devs.EndDevices[2].GetSession().GetDevAddr(), should.Resemble,
+			types.DevAddr{0x01, 0x02, 0x03, 0x04}.Bytes(),
+		)
+		for _, reg := range []*mockRegistry{regs.is, regs.js, regs.ns, regs.as} {
+			a.So(reg.get(ctx, sourceIDs), should.BeNil)
+			a.So(reg.get(ctx, targetIDs), should.BeNil)
+		}
+
+		// The transfer is resumed from the transfer registry, which requires the claim authentication code.
+		regs.js.failSet = nil
+		_, err = js.Transfer(ctx, targetIDs, "invalid")
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		ids, err := js.Transfer(ctx, targetIDs, "secret")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ids, should.Resemble, targetIDs)
+		for _, reg := range []*mockRegistry{regs.is, regs.js, regs.ns, regs.as} {
+			a.So(reg.get(ctx, targetIDs), should.NotBeNil)
+		}
+		a.So(regs.js.get(ctx, targetIDs).GetRootKeys().GetAppKey().GetKey(), should.Resemble, appKey.Bytes())
+		a.So(regs.ns.get(ctx, targetIDs).GetSession(), should.BeNil)
+		a.So(regs.transfers.get(sourceIDs), should.BeNil)
+	})
+
+	t.Run("ResumeRestore", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		js := newClient(ctx, regs, authorized)
+
+		regs.js.failSet = map[string]bool{
+			sourceApp.ApplicationId: true,
+			targetApp.ApplicationId: true,
+		}
+		_, err := js.Transfer(ctx, targetIDs, "secret")
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+
+		// Resuming the transfer to the source application restores the end device, including its session.
+		regs.js.failSet = nil
+		ids, err := js.Transfer(ctx, sourceIDs, "secret")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(ids, should.Resemble, sourceIDs)
+		for _, reg := range []*mockRegistry{regs.is, regs.js, regs.ns, regs.as} {
+			a.So(reg.get(ctx, sourceIDs), should.NotBeNil)
+		}
+		a.So(regs.ns.get(ctx, sourceIDs).GetSession().GetDevAddr(), should.Resemble,
+			types.DevAddr{0x01, 0x02, 0x03, 0x04}.Bytes(),
+		)
+		a.So(regs.transfers.get(sourceIDs), should.BeNil)
+
+		// The claim authentication code of the Join Server is restored in the Identity Server.
+		a.So(regs.is.get(ctx, sourceIDs).GetClaimAuthenticationCode().GetValue(), should.Equal, "secret")
+		a.So(js.Claim(ctx, joinEUI, devEUI, "secret"), should.BeNil)
+	})
+
+	t.Run("TransferRegistryUnavailable", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx = newContext(ctx)
+		regs := newRegistries()
+		regs.transfers.err = errUnavailable.New()
+		js := newClient(ctx, regs, authorized)
+
+		// The end device is not deleted if it can not be persisted in the transfer registry.
+		_, err := js.Transfer(ctx, targetIDs, "secret")
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+		for _, reg := range []*mockRegistry{regs.is, regs.js, regs.ns, regs.as} {
+			a.So(reg.get(ctx, sourceIDs), should.NotBeNil)
+		}
+	})
+
+	t.Run("GetClaimStatus", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		js := newClient(ctx, newRegistries(), authorized)
+
+		res, err := js.GetClaimStatus(ctx, sourceIDs)
+		if a.So(err, should.BeNil) {
+			a.So(res.HomeNetId, should.Resemble, types.NetID{0x00, 0x00, 0x13}.Bytes())
+		}
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateways
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// ClaimerConfigurationName is the filename of the gateway claimer configuration.
+const ClaimerConfigurationName = "config.yml"
+
+// Config contains options for gateway claiming.
+//
+//nolint:lll
+type Config struct {
+	DefaultCUPSURI string `name:"default-cups-uri" description:"CUPS URI that claimed gateways are redirected to if the claim request does not specify one"`
+
+	Source    string                `name:"source" description:"Source of the file containing gateway claimer settings (directory, url, blob)"`
+	Directory string                `name:"directory" description:"OS filesystem directory, which contains the config.yml and the claimer-specific files"`
+	URL       string                `name:"url" description:"URL, which contains gateway claimer configuration"`
+	Blob      config.BlobPathConfig `name:"blob"`
+
+	EncryptionKeyID string                `name:"encryption-key-id" description:"ID of the key used to encrypt the API keys of gateway authorizations at rest"`
+	Authorizations  AuthorizationRegistry `name:"-"`
+}
+
+// Fetcher returns a fetch.Interface based on the configuration.
+// If no configuration source is set, this method returns nil, nil.
+func (c Config) Fetcher(
+	ctx context.Context, blobConf config.BlobConfig, httpClientProvider httpclient.Provider,
+) (fetch.Interface, error) {
+	switch c.Source {
+	case "directory":
+		return fetch.FromFilesystem(c.Directory), nil
+	case "url":
+		httpClient, err := httpClientProvider.HTTPClient(ctx, httpclient.WithCache(true))
+		if err != nil {
+			return nil, err
+		}
+		return fetch.FromHTTP(httpClient, c.URL)
+	case "blob":
+		b, err := blobConf.Bucket(ctx, c.Blob.Bucket, httpClientProvider)
+		if err != nil {
+			return nil, err
+		}
+		return fetch.FromBucket(ctx, b, c.Blob.Path), nil
+	default:
+		return nil, nil
+	}
+}
+
+type baseConfig struct {
+	Claimers []struct {
+		File        string              `yaml:"file"`
+		GatewayEUIs []types.EUI64Prefix `yaml:"gateway-euis"`
+		Type        string              `yaml:"type"`
+	} `yaml:"claimers"`
+}
@@ -0,0 +1,187 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cups provides the gateway claiming client implementation for vendors that manage LoRa Basics Station
+// gateways with owner tokens, such as The Things Indoor Gateway, through a CUPS management API.
+//
+// The management API claims gateways with PUT /api/v1/gateways/{eui}/claim, releases claims with
+// DELETE /api/v1/gateways/{eui}/claim and redirects the CUPS client of claimed gateways with
+// PUT /api/v1/gateways/{eui}/cups. Requests are authenticated with HTTP basic auth.
+package cups
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// BasicAuth contains HTTP basic auth settings.
+type BasicAuth struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// Config is the configuration to communicate with the CUPS management API.
+type Config struct {
+	BasicAuth `yaml:"basic-auth"`
+	URL       string `yaml:"url"`
+}
+
+// Client is a client that claims gateways through a CUPS management API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    *url.URL
+	config     Config
+}
+
+// NewClient applies the config and returns a new client.
+func (cfg *Config) NewClient(ctx context.Context, c httpclient.Provider) (*Client, error) {
+	httpClient, err := c.HTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	baseURL, err := url.Parse(cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    baseURL,
+		config:     *cfg,
+	}, nil
+}
+
+var (
+	errGatewayNotProvisioned = errors.DefineNotFound(
+		"gateway_not_provisioned", "gateway with EUI `{gateway_eui}` not provisioned",
+	)
+	errGatewayNotClaimed   = errors.DefineNotFound("gateway_not_claimed", "gateway with EUI `{gateway_eui}` not claimed")
+	errGatewayAccessDenied = errors.DefinePermissionDenied(
+		"gateway_access_denied",
+		"access to gateway with EUI `{gateway_eui}` denied. Either the gateway is already claimed or the owner token is invalid", //nolint:lll
+	)
+	errUnauthorized = errors.DefineUnauthenticated("unauthorized", "client credentials missing or invalid")
+)
+
+type claimRequest struct {
+	OwnerToken string `json:"ownerToken"`
+}
+
+type redirectRequest struct {
+	CUPSURI   string `json:"cupsURI"`
+	CUPSTrust []byte `json:"cupsTrust,omitempty"`
+	AuthToken string `json:"authToken,omitempty"`
+}
+
+// errorResponse is a message that may be returned by the CUPS management API in case of an error.
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+func (client *Client) do(
+	ctx context.Context, method string, eui types.EUI64, path string, body interface{}, notFound *errors.Definition,
+) error {
+	reqURL := fmt.Sprintf("%s/api/v1/gateways/%s/%s", client.baseURL.String(), eui.String(), path)
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_eui", eui,
+		"method", method,
+		"url", reqURL,
+	))
+
+	var reqBody io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+	request, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+	request.SetBasicAuth(client.config.BasicAuth.Username, client.config.BasicAuth.Password)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if isSuccess(resp.StatusCode) {
+		return nil
+	}
+
+	var errResp errorResponse
+	if err := json.Unmarshal(respBody, &errResp); err != nil {
+		logger.WithError(err).Warn("Failed to decode error message")
+	} else {
+		logger.WithField("error", errResp.Message).Warn("CUPS management request failed")
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return notFound.WithAttributes("gateway_eui", eui)
+	case http.StatusForbidden:
+		return errGatewayAccessDenied.WithAttributes("gateway_eui", eui)
+	case http.StatusUnauthorized:
+		return errUnauthorized.New()
+	default:
+		return errors.FromHTTPStatusCode(resp.StatusCode)
+	}
+}
+
+// Claim implements gateways.Claimer.
+func (client *Client) Claim(ctx context.Context, eui types.EUI64, ownerToken string) error {
+	return client.do(ctx, http.MethodPut, eui, "claim", &claimRequest{
+		OwnerToken: ownerToken,
+	}, errGatewayNotProvisioned)
+}
+
+// Redirect implements gateways.Claimer.
+func (client *Client) Redirect(ctx context.Context, eui types.EUI64, redirection *ttnpb.CUPSRedirection) error {
+	return client.do(ctx, http.MethodPut, eui, "cups", &redirectRequest{
+		CUPSURI:   redirection.GetTargetCupsUri(),
+		CUPSTrust: redirection.GetTargetCupsTrust(),
+		AuthToken: redirection.GetAuthToken(),
+	}, errGatewayNotClaimed)
+}
+
+// Unclaim implements gateways.Claimer.
+func (client *Client) Unclaim(ctx context.Context, eui types.EUI64) error {
+	return client.do(ctx, http.MethodDelete, eui, "claim", nil, errGatewayNotClaimed)
+}
+
+// isSuccess returns true if the HTTP status code is 2xx.
+func isSuccess(statusCode int) bool {
+	return statusCode >= 200 && statusCode <= 299
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/gateways/cups"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	eui := types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01}
+	claimed := make(map[string]bool)
+	var redirect map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/gateways/58A0CBFFFE800001/claim", func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			var req map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if req["ownerToken"] != "secret" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"message":"invalid owner token"}`)) //nolint:errcheck
+				return
+			}
+			claimed["58A0CBFFFE800001"] = true
+		case http.MethodDelete:
+			if !claimed["58A0CBFFFE800001"] {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(claimed, "58A0CBFFFE800001")
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/api/v1/gateways/58A0CBFFFE800001/cups", func(w http.ResponseWriter, r *http.Request) {
+		if !claimed["58A0CBFFFE800001"] {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&redirect); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// Invalid credentials.
+	cfg := &Config{
+		BasicAuth: BasicAuth{
+			Username: "user",
+			Password: "invalid",
+		},
+		URL: srv.URL,
+	}
+	client, err := cfg.NewClient(ctx, test.HTTPClientProvider)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	err = client.Claim(ctx, eui, "secret")
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+
+	cfg.BasicAuth.Password = "pass"
+	client, err = cfg.NewClient(ctx, test.HTTPClientProvider)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Not provisioned.
+	err = client.Claim(ctx, types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x02}, "secret")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Not claimed.
+	err = client.Redirect(ctx, eui, &ttnpb.CUPSRedirection{TargetCupsUri: "https://cups.example.com"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Invalid owner token.
+	err = client.Claim(ctx, eui, "invalid")
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	a.So(client.Claim(ctx, eui, "secret"), should.BeNil)
+	a.So(client.Redirect(ctx, eui, &ttnpb.CUPSRedirection{
+		TargetCupsUri: "https://cups.example.com",
+		GatewayCredentials: &ttnpb.CUPSRedirection_AuthToken{
+			AuthToken: "token",
+		},
+	}), should.BeNil)
+	a.So(redirect, should.Resemble, map[string]interface{}{
+		"cupsURI":   "https://cups.example.com",
+		"authToken": "token",
+	})
+	a.So(client.Unclaim(ctx, eui), should.BeNil)
+	a.So(errors.IsNotFound(client.Unclaim(ctx, eui)), should.BeTrue)
+}
@@ -0,0 +1,722 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gateways provides functions to claim gateways.
+//
+// Gateways that are not registered in the Identity Server are claimed with an owner token on the claimer that is
+// configured for the EUI range of the gateway, and registered for the target collaborator. Gateways that are
+// registered in the Identity Server are transferred to the target collaborator, if their owner authorized the
+// Device Claiming Server to do so. The owner token of these gateways is verified by the claimer, or if no claimer is
+// configured, by the claim authentication code of the gateway.
+//
+// On claim, the API keys of the gateway are rotated: a new LNS API key is set as LoRa Basics Station LNS secret, and if
+// the gateway is redirected to a CUPS server, a new CUPS API key is passed to the claimer.
+package gateways
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/gateways/cups"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v2"
+)
+
+// Claimer provides methods for claiming gateways on (external) gateway management servers.
+type Claimer interface {
+	// Claim claims the gateway with the owner token.
+	Claim(ctx context.Context, eui types.EUI64, ownerToken string) error
+	// Redirect redirects the CUPS client of the claimed gateway.
+	Redirect(ctx context.Context, eui types.EUI64, redirection *ttnpb.CUPSRedirection) error
+	// Unclaim releases the claim on the gateway.
+	Unclaim(ctx context.Context, eui types.EUI64) error
+}
+
+// AuthorizationRegistry stores the API keys with which gateway owners authorize the Device Claiming Server to
+// transfer their gateways.
+type AuthorizationRegistry interface {
+	// Get returns the authorization of the gateway.
+	Get(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.AuthorizeGatewayRequest, error)
+	// Set sets the authorization of the gateway.
+	Set(ctx context.Context, req *ttnpb.AuthorizeGatewayRequest) error
+	// Delete deletes the authorization of the gateway.
+	Delete(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error
+}
+
+// Component abstracts the underlying *component.Component.
+type Component interface {
+	httpclient.Provider
+	GetBaseConfig(ctx context.Context) config.ServiceBase
+	GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers) (*grpc.ClientConn, error)
+	AllowInsecureForCredentials() bool
+}
+
+const (
+	cupsType = "cups"
+
+	lnsKeyName  = "LNS Key (claimed)"
+	cupsKeyName = "CUPS Key (claimed)"
+)
+
+var (
+	lnsKeyRights  = []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_LINK}
+	cupsKeyRights = []ttnpb.Right{
+		ttnpb.Right_RIGHT_GATEWAY_INFO,
+		ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC,
+		ttnpb.Right_RIGHT_GATEWAY_READ_SECRETS,
+	}
+)
+
+type claimerRange struct {
+	prefixes []types.EUI64Prefix
+	claimer  Claimer
+}
+
+// Upstream abstracts GatewayClaimingServer.
+type Upstream struct {
+	Component
+	config   Config
+	claimers []claimerRange
+	registry ttnpb.GatewayRegistryClient
+	access   ttnpb.GatewayAccessClient
+}
+
+// NewUpstream returns a new Upstream.
+func NewUpstream(ctx context.Context, conf Config, c Component, opts ...Option) (*Upstream, error) {
+	upstream := &Upstream{
+		Component: c,
+		config:    conf,
+	}
+	for _, opt := range opts {
+		opt(upstream)
+	}
+	fetcher, err := conf.Fetcher(ctx, c.GetBaseConfig(ctx).Blob, c)
+	if err != nil {
+		return nil, err
+	}
+	if fetcher == nil {
+		return upstream, nil
+	}
+	baseConfigBytes, err := fetcher.File(ClaimerConfigurationName)
+	if err != nil {
+		return nil, err
+	}
+	var baseConfig baseConfig
+	if err := yaml.UnmarshalStrict(baseConfigBytes, &baseConfig); err != nil {
+		return nil, err
+	}
+
+	// Setup claimers.
+	for _, cl := range baseConfig.Claimers {
+		// Fetch and parse configuration.
+		fileParts := strings.Split(filepath.ToSlash(cl.File), "/")
+		fetcher := fetch.WithBasePath(fetcher, fileParts[:len(fileParts)-1]...)
+		fileName := fileParts[len(fileParts)-1]
+		configBytes, err := fetcher.File(fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		var claimer Claimer
+		switch cl.Type {
+		case cupsType:
+			var cfg cups.Config
+			if err := yaml.UnmarshalStrict(configBytes, &cfg); err != nil {
+				return nil, err
+			}
+			claimer, err = cfg.NewClient(ctx, c)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			log.FromContext(ctx).WithField("type", cl.Type).Warn("Unknown gateway claimer type")
+			continue
+		}
+		upstream.claimers = append(upstream.claimers, claimerRange{
+			prefixes: cl.GatewayEUIs,
+			claimer:  claimer,
+		})
+	}
+	return upstream, nil
+}
+
+// Option configures Upstream.
+type Option func(*Upstream)
+
+// WithClaimer adds a claimer for the given gateway EUI prefixes to the Upstream.
+func WithClaimer(claimer Claimer, prefixes ...types.EUI64Prefix) Option {
+	return func(upstream *Upstream) {
+		upstream.claimers = append(upstream.claimers, claimerRange{
+			prefixes: prefixes,
+			claimer:  claimer,
+		})
+	}
+}
+
+// WithGatewayRegistry overrides the gateway registry of the Upstream.
+func WithGatewayRegistry(reg ttnpb.GatewayRegistryClient) Option {
+	return func(upstream *Upstream) {
+		upstream.registry = reg
+	}
+}
+
+// WithGatewayAccess overrides the gateway access client of the Upstream.
+func WithGatewayAccess(access ttnpb.GatewayAccessClient) Option {
+	return func(upstream *Upstream) {
+		upstream.access = access
+	}
+}
+
+var (
+	errNoGatewayEUI           = errors.DefineInvalidArgument("no_gateway_eui", "gateway EUI not found in request")
+	errQRCodeNotSupported     = errors.DefineUnimplemented("qr_code_not_supported", "claiming gateways by QR code is not supported") //nolint:lll
+	errClaimingNotSupported   = errors.DefineAborted("claiming_not_supported", "claiming not supported for gateway EUI `{eui}`")     //nolint:lll
+	errAuthorizationsDisabled = errors.DefineFailedPrecondition(
+		"authorizations_disabled", "gateway claiming authorizations are not configured",
+	)
+	errGatewayNotAuthorized = errors.DefinePermissionDenied(
+		"gateway_not_authorized", "gateway `{gateway_uid}` is not authorized for claiming",
+	)
+	errAPIKeyRights = errors.DefinePermissionDenied(
+		"api_key_rights", "API key does not have all rights on gateway `{gateway_uid}`",
+	)
+	errClaimAuthenticationCode = errors.DefinePermissionDenied(
+		"claim_authentication_code", "invalid claim authentication code for gateway `{gateway_uid}`",
+	)
+)
+
+func (upstream *Upstream) gatewayEUIClaimer(eui types.EUI64) Claimer {
+	for _, r := range upstream.claimers {
+		for _, prefix := range r.prefixes {
+			if eui.HasPrefix(prefix) {
+				return r.claimer
+			}
+		}
+	}
+	return nil
+}
+
+func (upstream *Upstream) getRegistry(ctx context.Context) (ttnpb.GatewayRegistryClient, error) {
+	if upstream.registry != nil {
+		return upstream.registry, nil
+	}
+	conn, err := upstream.Component.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewGatewayRegistryClient(conn), nil
+}
+
+func (upstream *Upstream) getAccess(ctx context.Context) (ttnpb.GatewayAccessClient, error) {
+	if upstream.access != nil {
+		return upstream.access, nil
+	}
+	conn, err := upstream.Component.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewGatewayAccessClient(conn), nil
+}
+
+func (upstream *Upstream) apiKeyCallOpt(apiKey string) grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      "Bearer",
+		AuthValue:     apiKey,
+		AllowInsecure: upstream.Component.AllowInsecureForCredentials(),
+	})
+}
+
+func requireCollaboratorRights(ctx context.Context, collaborator *ttnpb.OrganizationOrUserIdentifiers) error {
+	if ids := collaborator.GetOrganizationIds(); ids != nil {
+		return rights.RequireOrganization(ctx, ids, ttnpb.Right_RIGHT_ORGANIZATION_GATEWAYS_CREATE)
+	}
+	return rights.RequireUser(ctx, collaborator.GetUserIds(), ttnpb.Right_RIGHT_USER_GATEWAYS_CREATE)
+}
+
+// Claim implements GatewayClaimingServer.
+func (upstream *Upstream) Claim(ctx context.Context, req *ttnpb.ClaimGatewayRequest) (*ttnpb.GatewayIdentifiers, error) {
+	// Check that the caller can create gateways for the collaborator before attempting to claim.
+	if err := requireCollaboratorRights(ctx, req.Collaborator); err != nil {
+		return nil, err
+	}
+
+	var (
+		eui        types.EUI64
+		ownerToken string
+	)
+	switch {
+	case req.GetAuthenticatedIdentifiers() != nil:
+		authenticatedIDs := req.GetAuthenticatedIdentifiers()
+		if len(authenticatedIDs.GatewayEui) == 0 {
+			return nil, errNoGatewayEUI.New()
+		}
+		eui = types.MustEUI64(authenticatedIDs.GatewayEui).OrZero()
+		ownerToken = string(authenticatedIDs.AuthenticationCode)
+	case req.GetQrCode() != nil:
+		return nil, errQRCodeNotSupported.New()
+	default:
+		return nil, errNoGatewayEUI.New()
+	}
+
+	logger := log.FromContext(ctx).WithField("gateway_eui", eui)
+	ctx = log.NewContext(ctx, logger)
+
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, upstream.Component.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	registry, err := upstream.getRegistry(ctx)
+	if err != nil {
+		return nil, err
+	}
+	access, err := upstream.getAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claimer := upstream.gatewayEUIClaimer(eui)
+	ids, err := registry.GetIdentifiersForEUI(ctx, &ttnpb.GetGatewayIdentifiersForEUIRequest{
+		Eui: eui.Bytes(),
+	}, callOpt)
+	var cupsKey string
+	switch {
+	case errors.IsNotFound(err):
+		if claimer == nil {
+			return nil, errClaimingNotSupported.WithAttributes("eui", eui)
+		}
+		ids, cupsKey, err = upstream.create(ctx, registry, access, claimer, eui, ownerToken, req, callOpt)
+	case err != nil:
+		return nil, err
+	default:
+		cupsKey, err = upstream.transfer(ctx, registry, access, claimer, ids, ownerToken, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	redirection := &ttnpb.CUPSRedirection{
+		TargetCupsUri: upstream.config.DefaultCUPSURI,
+	}
+	if req.CupsRedirection != nil {
+		redirection = &ttnpb.CUPSRedirection{
+			TargetCupsUri:   req.CupsRedirection.TargetCupsUri,
+			TargetCupsTrust: req.CupsRedirection.TargetCupsTrust,
+		}
+	}
+	if claimer != nil && redirection.TargetCupsUri != "" {
+		redirection.GatewayCredentials = &ttnpb.CUPSRedirection_AuthToken{
+			AuthToken: cupsKey,
+		}
+		// The gateway is claimed at this point, so failing to redirect it does not fail the claim.
+		// The gateway can still be redirected by updating its CUPS settings.
+		if err := claimer.Redirect(ctx, eui, redirection); err != nil {
+			logger.WithError(err).Warn("Failed to redirect claimed gateway")
+		}
+	}
+	return ids, nil
+}
+
+// create claims the gateway on the claimer and registers it for the collaborator.
+func (upstream *Upstream) create(
+	ctx context.Context,
+	registry ttnpb.GatewayRegistryClient,
+	access ttnpb.GatewayAccessClient,
+	claimer Claimer,
+	eui types.EUI64,
+	ownerToken string,
+	req *ttnpb.ClaimGatewayRequest,
+	callOpt grpc.CallOption,
+) (ids *ttnpb.GatewayIdentifiers, cupsKey string, err error) {
+	if err := claimer.Claim(ctx, eui, ownerToken); err != nil {
+		return nil, "", err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if err := claimer.Unclaim(ctx, eui); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to unclaim gateway")
+		}
+	}()
+
+	gatewayID := req.TargetGatewayId
+	if gatewayID == "" {
+		gatewayID = fmt.Sprintf("eui-%s", strings.ToLower(eui.String()))
+	}
+	gtw := &ttnpb.Gateway{
+		Ids: &ttnpb.GatewayIdentifiers{
+			GatewayId: gatewayID,
+			Eui:       eui.Bytes(),
+		},
+		GatewayServerAddress: req.TargetGatewayServerAddress,
+	}
+	if req.TargetFrequencyPlanId != "" {
+		gtw.FrequencyPlanIds = []string{req.TargetFrequencyPlanId}
+	}
+	gtw, err = registry.Create(ctx, &ttnpb.CreateGatewayRequest{
+		Gateway:      gtw,
+		Collaborator: req.Collaborator,
+	}, callOpt)
+	if err != nil {
+		return nil, "", err
+	}
+	cupsKey, err = upstream.rotateKeys(ctx, registry, access, gtw.Ids, "", callOpt)
+	if err != nil {
+		if _, err := registry.Delete(ctx, gtw.Ids, callOpt); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to delete claimed gateway")
+		}
+		return nil, "", err
+	}
+	return gtw.Ids, cupsKey, nil
+}
+
+// transfer verifies the owner token and transfers the registered gateway to the collaborator.
+// The gateway must be authorized for claiming.
+func (upstream *Upstream) transfer(
+	ctx context.Context,
+	registry ttnpb.GatewayRegistryClient,
+	access ttnpb.GatewayAccessClient,
+	claimer Claimer,
+	ids *ttnpb.GatewayIdentifiers,
+	ownerToken string,
+	req *ttnpb.ClaimGatewayRequest,
+) (cupsKey string, err error) {
+	if upstream.config.Authorizations == nil {
+		return "", errGatewayNotAuthorized.WithAttributes("gateway_uid", unique.ID(ctx, ids))
+	}
+	authorization, err := upstream.config.Authorizations.Get(ctx, ids)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", errGatewayNotAuthorized.WithAttributes("gateway_uid", unique.ID(ctx, ids))
+		}
+		return "", err
+	}
+	callOpt := upstream.apiKeyCallOpt(authorization.ApiKey)
+
+	if claimer != nil {
+		eui := types.MustEUI64(ids.Eui).OrZero()
+		if err := claimer.Claim(ctx, eui, ownerToken); err != nil {
+			return "", err
+		}
+		defer func() {
+			if err == nil {
+				return
+			}
+			if err := claimer.Unclaim(ctx, eui); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to unclaim gateway")
+			}
+		}()
+	} else {
+		gtw, err := registry.Get(ctx, &ttnpb.GetGatewayRequest{
+			GatewayIds: ids,
+			FieldMask:  ttnpb.FieldMask("claim_authentication_code"),
+		}, callOpt)
+		if err != nil {
+			return "", err
+		}
+		if !validClaimAuthenticationCode(gtw.ClaimAuthenticationCode, ownerToken, time.Now()) {
+			return "", errClaimAuthenticationCode.WithAttributes("gateway_uid", unique.ID(ctx, ids))
+		}
+	}
+
+	collaborators, err := access.ListCollaborators(ctx, &ttnpb.ListGatewayCollaboratorsRequest{
+		GatewayIds: ids,
+		Limit:      1000,
+	}, callOpt)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		upstream.restoreCollaborators(ctx, access, ids, req.Collaborator, collaborators.Collaborators, callOpt)
+	}()
+
+	// Add the collaborator before removing the others, as a gateway must always have an owner.
+	if _, err := access.SetCollaborator(ctx, &ttnpb.SetGatewayCollaboratorRequest{
+		GatewayIds: ids,
+		Collaborator: &ttnpb.Collaborator{
+			Ids:    req.Collaborator,
+			Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_ALL},
+		},
+	}, callOpt); err != nil {
+		return "", err
+	}
+	for _, collaborator := range collaborators.Collaborators {
+		if unique.ID(ctx, collaborator.Ids) == unique.ID(ctx, req.Collaborator) {
+			continue
+		}
+		if _, err := access.SetCollaborator(ctx, &ttnpb.SetGatewayCollaboratorRequest{
+			GatewayIds: ids,
+			Collaborator: &ttnpb.Collaborator{
+				Ids: collaborator.Ids,
+			},
+		}, callOpt); err != nil {
+			return "", err
+		}
+	}
+
+	// The claim authentication code can only be used once.
+	gtw := &ttnpb.Gateway{
+		Ids:                  ids,
+		GatewayServerAddress: req.TargetGatewayServerAddress,
+	}
+	paths := []string{"claim_authentication_code"}
+	if req.TargetGatewayServerAddress != "" {
+		paths = append(paths, "gateway_server_address")
+	}
+	if req.TargetFrequencyPlanId != "" {
+		gtw.FrequencyPlanIds = []string{req.TargetFrequencyPlanId}
+		paths = append(paths, "frequency_plan_ids")
+	}
+	original, err := registry.Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIds: ids,
+		FieldMask:  ttnpb.FieldMask(paths...),
+	}, callOpt)
+	if err != nil {
+		return "", err
+	}
+	if _, err := registry.Update(ctx, &ttnpb.UpdateGatewayRequest{
+		Gateway:   gtw,
+		FieldMask: ttnpb.FieldMask(paths...),
+	}, callOpt); err != nil {
+		return "", err
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		upstream.restoreGateway(ctx, registry, original, paths, callOpt)
+	}()
+
+	_, authKeyID, _, err := auth.SplitToken(authorization.ApiKey)
+	if err != nil {
+		return "", err
+	}
+	cupsKey, err = upstream.rotateKeys(ctx, registry, access, ids, authKeyID, callOpt)
+	if err != nil {
+		return "", err
+	}
+	if err := upstream.config.Authorizations.Delete(ctx, ids); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to delete gateway claiming authorization")
+	}
+	return cupsKey, nil
+}
+
+// restoreCollaborators restores the original collaborators of the gateway after a failed transfer.
+// The original collaborators are set with their original rights, and the collaborator is removed if it was
+// not one of the original collaborators.
+func (*Upstream) restoreCollaborators(
+	ctx context.Context,
+	access ttnpb.GatewayAccessClient,
+	ids *ttnpb.GatewayIdentifiers,
+	collaborator *ttnpb.OrganizationOrUserIdentifiers,
+	original []*ttnpb.Collaborator,
+	callOpt grpc.CallOption,
+) {
+	logger := log.FromContext(ctx)
+	wasCollaborator := false
+	for _, c := range original {
+		if unique.ID(ctx, c.Ids) == unique.ID(ctx, collaborator) {
+			wasCollaborator = true
+		}
+		if _, err := access.SetCollaborator(ctx, &ttnpb.SetGatewayCollaboratorRequest{
+			GatewayIds:   ids,
+			Collaborator: c,
+		}, callOpt); err != nil {
+			logger.WithError(err).WithField("collaborator_uid", unique.ID(ctx, c.Ids)).Warn(
+				"Failed to restore gateway collaborator",
+			)
+		}
+	}
+	if wasCollaborator {
+		return
+	}
+	if _, err := access.SetCollaborator(ctx, &ttnpb.SetGatewayCollaboratorRequest{
+		GatewayIds: ids,
+		Collaborator: &ttnpb.Collaborator{
+			Ids: collaborator,
+		},
+	}, callOpt); err != nil {
+		logger.WithError(err).Warn("Failed to remove gateway collaborator")
+	}
+}
+
+// restoreGateway restores the original fields of the gateway, including the claim authentication code, after a
+// failed transfer.
+func (*Upstream) restoreGateway(
+	ctx context.Context,
+	registry ttnpb.GatewayRegistryClient,
+	original *ttnpb.Gateway,
+	paths []string,
+	callOpt grpc.CallOption,
+) {
+	if _, err := registry.Update(ctx, &ttnpb.UpdateGatewayRequest{
+		Gateway:   original,
+		FieldMask: ttnpb.FieldMask(paths...),
+	}, callOpt); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to restore gateway")
+	}
+}
+
+func validClaimAuthenticationCode(code *ttnpb.GatewayClaimAuthenticationCode, value string, now time.Time) bool {
+	if code.GetSecret() == nil || len(code.Secret.Value) == 0 {
+		return false
+	}
+	if validFrom := ttnpb.StdTime(code.ValidFrom); validFrom != nil && now.Before(*validFrom) {
+		return false
+	}
+	if validTo := ttnpb.StdTime(code.ValidTo); validTo != nil && now.After(*validTo) {
+		return false
+	}
+	return subtle.ConstantTimeCompare(code.Secret.Value, []byte(value)) == 1
+}
+
+// rotateKeys creates new LNS and CUPS API keys for the gateway and sets the LNS key as LNS secret.
+// If authKeyID is set, all other API keys of the gateway are revoked, and the API key with authKeyID last.
+// It returns the CUPS API key.
+func (upstream *Upstream) rotateKeys(
+	ctx context.Context,
+	registry ttnpb.GatewayRegistryClient,
+	access ttnpb.GatewayAccessClient,
+	ids *ttnpb.GatewayIdentifiers,
+	authKeyID string,
+	callOpt grpc.CallOption,
+) (string, error) {
+	lnsKey, err := access.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
+		GatewayIds: ids,
+		Name:       lnsKeyName,
+		Rights:     lnsKeyRights,
+	}, callOpt)
+	if err != nil {
+		return "", err
+	}
+	cupsKey, err := access.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
+		GatewayIds: ids,
+		Name:       cupsKeyName,
+		Rights:     cupsKeyRights,
+	}, callOpt)
+	if err != nil {
+		return "", err
+	}
+	if _, err := registry.Update(ctx, &ttnpb.UpdateGatewayRequest{
+		Gateway: &ttnpb.Gateway{
+			Ids: ids,
+			LbsLnsSecret: &ttnpb.Secret{
+				Value: []byte(lnsKey.Key),
+			},
+		},
+		FieldMask: ttnpb.FieldMask("lbs_lns_secret"),
+	}, callOpt); err != nil {
+		return "", err
+	}
+	if authKeyID == "" {
+		return cupsKey.Key, nil
+	}
+
+	keys, err := access.ListAPIKeys(ctx, &ttnpb.ListGatewayAPIKeysRequest{
+		GatewayIds: ids,
+		Limit:      1000,
+	}, callOpt)
+	if err != nil {
+		return "", err
+	}
+	revoke := func(id string) error {
+		_, err := access.UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
+			GatewayIds: ids,
+			ApiKey: &ttnpb.APIKey{
+				Id: id,
+			},
+			FieldMask: ttnpb.FieldMask("rights"),
+		}, callOpt)
+		return err
+	}
+	for _, key := range keys.ApiKeys {
+		switch key.Id {
+		case lnsKey.Id, cupsKey.Id, authKeyID:
+			continue
+		}
+		if err := revoke(key.Id); err != nil {
+			return "", err
+		}
+	}
+	// Revoking the authorization API key revokes the rights of the Device Claiming Server, so it is revoked last.
+	if err := revoke(authKeyID); err != nil {
+		return "", err
+	}
+	return cupsKey.Key, nil
+}
+
+// AuthorizeGateway implements GatewayClaimingServer.
+func (upstream *Upstream) AuthorizeGateway(
+	ctx context.Context, req *ttnpb.AuthorizeGatewayRequest,
+) (*pbtypes.Empty, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_ALL); err != nil {
+		return nil, err
+	}
+	if upstream.config.Authorizations == nil {
+		return nil, errAuthorizationsDisabled.New()
+	}
+	// The API key must have all rights, so that the Device Claiming Server can transfer the gateway and rotate its
+	// API keys.
+	access, err := upstream.getAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyRights, err := access.ListRights(ctx, req.GatewayIds, upstream.apiKeyCallOpt(req.ApiKey))
+	if err != nil {
+		return nil, err
+	}
+	if !keyRights.Implied().IncludesAll(ttnpb.Right_RIGHT_GATEWAY_ALL) {
+		return nil, errAPIKeyRights.WithAttributes("gateway_uid", unique.ID(ctx, req.GatewayIds))
+	}
+	if err := upstream.config.Authorizations.Set(ctx, req); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// UnauthorizeGateway implements GatewayClaimingServer.
+func (upstream *Upstream) UnauthorizeGateway(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*pbtypes.Empty, error) {
+	if err := rights.RequireGateway(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_ALL); err != nil {
+		return nil, err
+	}
+	if upstream.config.Authorizations == nil {
+		return nil, errAuthorizationsDisabled.New()
+	}
+	if err := upstream.config.Authorizations.Delete(ctx, ids); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
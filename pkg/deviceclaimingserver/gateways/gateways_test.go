@@ -0,0 +1,392 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateways_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	. "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/gateways"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	claimableEUI   = types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01}
+	unclaimableEUI = types.EUI64{0x00, 0x80, 0x00, 0x00, 0xa0, 0x00, 0x00, 0x01}
+	registeredEUI  = types.EUI64{0x00, 0x80, 0x00, 0x00, 0xa0, 0x00, 0x00, 0x02}
+
+	oldOwner = (&ttnpb.UserIdentifiers{UserId: "old-owner"}).GetOrganizationOrUserIdentifiers()
+	newOwner = (&ttnpb.UserIdentifiers{UserId: "new-owner"}).GetOrganizationOrUserIdentifiers()
+)
+
+func newContext(ctx context.Context) context.Context {
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer token"))
+	return rights.NewContextWithFetcher(ctx, struct {
+		rights.EntityFetcherFunc
+		rights.AuthInfoFetcherFunc
+	}{
+		EntityFetcherFunc: func(context.Context, *ttnpb.EntityIdentifiers) (*ttnpb.Rights, error) {
+			return ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_GATEWAYS_CREATE, ttnpb.Right_RIGHT_GATEWAY_ALL), nil
+		},
+	})
+}
+
+func TestClaimUnregistered(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx = newContext(ctx)
+
+	server := newMockGatewayServer()
+	claimer := &mockClaimer{ownerToken: "secret"}
+	upstream, err := NewUpstream(ctx, Config{
+		DefaultCUPSURI: "https://cups.example.com:443",
+	}, mockComponent{},
+		WithClaimer(claimer, types.EUI64Prefix{EUI64: claimableEUI, Length: 48}),
+		WithGatewayRegistry(server),
+		WithGatewayAccess(server),
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// QR codes are not supported.
+	_, err = upstream.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+		Collaborator: newOwner,
+		SourceGateway: &ttnpb.ClaimGatewayRequest_QrCode{
+			QrCode: []byte("qr"),
+		},
+	})
+	a.So(errors.IsUnimplemented(err), should.BeTrue)
+
+	// No claimer for the EUI.
+	_, err = upstream.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+		Collaborator: newOwner,
+		SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+				GatewayEui:         unclaimableEUI.Bytes(),
+				AuthenticationCode: []byte("secret"),
+			},
+		},
+	})
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	// Invalid owner token.
+	_, err = upstream.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+		Collaborator: newOwner,
+		SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+				GatewayEui:         claimableEUI.Bytes(),
+				AuthenticationCode: []byte("invalid"),
+			},
+		},
+	})
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	a.So(server.gateways, should.BeEmpty)
+
+	// Valid owner token.
+	ids, err := upstream.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+		Collaborator: newOwner,
+		SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+				GatewayEui:         claimableEUI.Bytes(),
+				AuthenticationCode: []byte("secret"),
+			},
+		},
+		TargetGatewayServerAddress: "gs.example.com",
+		TargetFrequencyPlanId:      "EU_863_870",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(ids.GatewayId, should.Equal, "eui-58a0cbfffe800001")
+
+	gtw := server.gateways[ids.GatewayId]
+	a.So(gtw.GatewayServerAddress, should.Equal, "gs.example.com")
+	a.So(gtw.FrequencyPlanIds, should.Resemble, []string{"EU_863_870"})
+	a.So(server.collaborators, should.ContainKey, "new-owner")
+	if !a.So(server.apiKeys, should.HaveLength, 2) {
+		t.FailNow()
+	}
+
+	var lnsKey, cupsKey *ttnpb.APIKey
+	for _, apiKey := range server.apiKeys {
+		if ttnpb.RightsFrom(apiKey.Rights...).IncludesAll(ttnpb.Right_RIGHT_GATEWAY_LINK) {
+			lnsKey = apiKey
+		} else {
+			cupsKey = apiKey
+		}
+	}
+	if !a.So(lnsKey, should.NotBeNil) || !a.So(cupsKey, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(string(gtw.LbsLnsSecret.GetValue()), should.Equal, lnsKey.Key)
+	a.So(claimer.redirection, should.Resemble, &ttnpb.CUPSRedirection{
+		TargetCupsUri: "https://cups.example.com:443",
+		GatewayCredentials: &ttnpb.CUPSRedirection_AuthToken{
+			AuthToken: cupsKey.Key,
+		},
+	})
+}
+
+func TestClaimRegistered(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx = newContext(ctx)
+
+	server := newMockGatewayServer()
+	gtwIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: "registered",
+		Eui:       registeredEUI.Bytes(),
+	}
+	server.gateways[gtwIDs.GatewayId] = &ttnpb.Gateway{
+		Ids: gtwIDs,
+		ClaimAuthenticationCode: &ttnpb.GatewayClaimAuthenticationCode{
+			Secret: &ttnpb.Secret{
+				Value: []byte("secret"),
+			},
+			ValidTo: ttnpb.ProtoTimePtr(time.Now().Add(time.Hour)),
+		},
+	}
+	server.collaborators["old-owner"] = &ttnpb.Collaborator{
+		Ids:    oldOwner,
+		Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_ALL},
+	}
+	oldKey := server.addAPIKey(ctx, ttnpb.Right_RIGHT_GATEWAY_LINK)
+	limitedKey := server.addAPIKey(ctx, ttnpb.Right_RIGHT_GATEWAY_INFO)
+	authKey := server.addAPIKey(ctx, ttnpb.Right_RIGHT_GATEWAY_ALL)
+
+	authorizations := &mockAuthorizationRegistry{}
+	upstream, err := NewUpstream(ctx, Config{
+		Authorizations: authorizations,
+	}, mockComponent{},
+		WithGatewayRegistry(server),
+		WithGatewayAccess(server),
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	claimReq := func(code string) *ttnpb.ClaimGatewayRequest {
+		return &ttnpb.ClaimGatewayRequest{
+			Collaborator: newOwner,
+			SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+				AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+					GatewayEui:         registeredEUI.Bytes(),
+					AuthenticationCode: []byte(code),
+				},
+			},
+			TargetGatewayServerAddress: "gs.example.com",
+		}
+	}
+
+	// The gateway is not authorized for claiming.
+	_, err = upstream.Claim(ctx, claimReq("secret"))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	// The API key must have all rights.
+	_, err = upstream.AuthorizeGateway(ctx, &ttnpb.AuthorizeGatewayRequest{
+		GatewayIds: gtwIDs,
+		ApiKey:     limitedKey.Key,
+	})
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	_, err = upstream.AuthorizeGateway(ctx, &ttnpb.AuthorizeGatewayRequest{
+		GatewayIds: gtwIDs,
+		ApiKey:     authKey.Key,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Invalid claim authentication code.
+	_, err = upstream.Claim(ctx, claimReq("invalid"))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	a.So(server.collaborators, should.ContainKey, "old-owner")
+
+	ids, err := upstream.Claim(ctx, claimReq("secret"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(ids, should.Resemble, gtwIDs)
+
+	// The gateway is transferred to the new owner.
+	a.So(server.collaborators, should.HaveLength, 1)
+	a.So(server.collaborators, should.ContainKey, "new-owner")
+
+	// The claim authentication code can only be used once.
+	gtw := server.gateways[gtwIDs.GatewayId]
+	a.So(gtw.ClaimAuthenticationCode, should.BeNil)
+	a.So(gtw.GatewayServerAddress, should.Equal, "gs.example.com")
+
+	// The API keys of the previous owner are revoked.
+	a.So(server.apiKeys, should.HaveLength, 2)
+	a.So(server.apiKeys, should.NotContainKey, oldKey.Id)
+	a.So(server.apiKeys, should.NotContainKey, limitedKey.Id)
+	a.So(server.apiKeys, should.NotContainKey, authKey.Id)
+	a.So(authorizations.authorizations, should.BeEmpty)
+
+	// The authorization is consumed.
+	_, err = upstream.Claim(ctx, claimReq("secret"))
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	// Unauthorize.
+	_, err = upstream.UnauthorizeGateway(ctx, gtwIDs)
+	a.So(err, should.BeNil)
+}
+
+func TestClaimRegisteredFailure(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx = newContext(ctx)
+
+	server := newMockGatewayServer()
+	gtwIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: "registered",
+		Eui:       claimableEUI.Bytes(),
+	}
+	server.gateways[gtwIDs.GatewayId] = &ttnpb.Gateway{
+		Ids: gtwIDs,
+	}
+	server.collaborators["old-owner"] = &ttnpb.Collaborator{
+		Ids:    oldOwner,
+		Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_ALL},
+	}
+	authKey := server.addAPIKey(ctx, ttnpb.Right_RIGHT_GATEWAY_ALL)
+
+	claimer := &mockClaimer{ownerToken: "secret"}
+	authorizations := &mockAuthorizationRegistry{}
+	failingServer := failingUpdateGatewayServer{server}
+	upstream, err := NewUpstream(ctx, Config{
+		Authorizations: authorizations,
+	}, mockComponent{},
+		WithClaimer(claimer, types.EUI64Prefix{EUI64: claimableEUI, Length: 48}),
+		WithGatewayRegistry(failingServer),
+		WithGatewayAccess(failingServer),
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	_, err = upstream.AuthorizeGateway(ctx, &ttnpb.AuthorizeGatewayRequest{
+		GatewayIds: gtwIDs,
+		ApiKey:     authKey.Key,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The gateway update fails after the collaborators are changed.
+	_, err = upstream.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+		Collaborator: newOwner,
+		SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+				GatewayEui:         claimableEUI.Bytes(),
+				AuthenticationCode: []byte("secret"),
+			},
+		},
+	})
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	// The original collaborators are restored.
+	a.So(server.collaborators, should.HaveLength, 1)
+	a.So(server.collaborators, should.ContainKey, "old-owner")
+	a.So(server.collaborators["old-owner"].Rights, should.Resemble, []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_ALL})
+
+	// The gateway is unclaimed and the authorization is retained.
+	a.So(claimer.claimed, should.BeEmpty)
+	a.So(server.apiKeys, should.ContainKey, authKey.Id)
+	a.So(authorizations.authorizations, should.HaveLength, 1)
+}
+
+func TestClaimRegisteredRotateKeysFailure(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx = newContext(ctx)
+
+	server := newMockGatewayServer()
+	gtwIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: "registered",
+		Eui:       registeredEUI.Bytes(),
+	}
+	cac := &ttnpb.GatewayClaimAuthenticationCode{
+		Secret: &ttnpb.Secret{
+			Value: []byte("secret"),
+		},
+		ValidTo: ttnpb.ProtoTimePtr(time.Now().Add(time.Hour)),
+	}
+	server.gateways[gtwIDs.GatewayId] = &ttnpb.Gateway{
+		Ids:                     gtwIDs,
+		ClaimAuthenticationCode: ttnpb.Clone(cac),
+		GatewayServerAddress:    "old-gs.example.com",
+	}
+	server.collaborators["old-owner"] = &ttnpb.Collaborator{
+		Ids:    oldOwner,
+		Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_ALL},
+	}
+	authKey := server.addAPIKey(ctx, ttnpb.Right_RIGHT_GATEWAY_ALL)
+
+	authorizations := &mockAuthorizationRegistry{}
+	failingServer := failingCreateAPIKeyGatewayServer{server}
+	upstream, err := NewUpstream(ctx, Config{
+		Authorizations: authorizations,
+	}, mockComponent{},
+		WithGatewayRegistry(failingServer),
+		WithGatewayAccess(failingServer),
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	_, err = upstream.AuthorizeGateway(ctx, &ttnpb.AuthorizeGatewayRequest{
+		GatewayIds: gtwIDs,
+		ApiKey:     authKey.Key,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The API key creation fails after the gateway is updated.
+	_, err = upstream.Claim(ctx, &ttnpb.ClaimGatewayRequest{
+		Collaborator: newOwner,
+		SourceGateway: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers_{
+			AuthenticatedIdentifiers: &ttnpb.ClaimGatewayRequest_AuthenticatedIdentifiers{
+				GatewayEui:         registeredEUI.Bytes(),
+				AuthenticationCode: []byte("secret"),
+			},
+		},
+		TargetGatewayServerAddress: "gs.example.com",
+	})
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+
+	// The claim authentication code and the Gateway Server address are restored.
+	gtw := server.gateways[gtwIDs.GatewayId]
+	a.So(gtw.ClaimAuthenticationCode, should.Resemble, cac)
+	a.So(gtw.GatewayServerAddress, should.Equal, "old-gs.example.com")
+
+	// The original collaborators are restored.
+	a.So(server.collaborators, should.HaveLength, 1)
+	a.So(server.collaborators, should.ContainKey, "old-owner")
+
+	// The authorization is retained.
+	a.So(server.apiKeys, should.ContainKey, authKey.Id)
+	a.So(authorizations.authorizations, should.HaveLength, 1)
+}
@@ -0,0 +1,337 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateways_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"google.golang.org/grpc"
+)
+
+type mockComponent struct{}
+
+func (mockComponent) HTTPClient(ctx context.Context, opts ...httpclient.Option) (*http.Client, error) {
+	return test.HTTPClientProvider.HTTPClient(ctx, opts...)
+}
+
+func (mockComponent) GetBaseConfig(ctx context.Context) config.ServiceBase {
+	return config.ServiceBase{}
+}
+
+func (mockComponent) GetPeerConn(
+	ctx context.Context, role ttnpb.ClusterRole, ids cluster.EntityIdentifiers,
+) (*grpc.ClientConn, error) {
+	return nil, nil
+}
+
+func (mockComponent) AllowInsecureForCredentials() bool {
+	return true
+}
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+func apiKeyFromCallOptions(ctx context.Context, opts []grpc.CallOption) string {
+	for _, opt := range opts {
+		if creds, ok := opt.(grpc.PerRPCCredsCallOption); ok {
+			md, err := creds.Creds.GetRequestMetadata(ctx)
+			if err != nil {
+				return ""
+			}
+			var tokenType string
+			var key string
+			fmt.Sscanf(md["authorization"], "%s %s", &tokenType, &key) //nolint:errcheck
+			return key
+		}
+	}
+	return ""
+}
+
+// mockGatewayServer is an in-memory gateway registry and access server.
+type mockGatewayServer struct {
+	ttnpb.GatewayRegistryClient
+	ttnpb.GatewayAccessClient
+
+	mu            sync.Mutex
+	gateways      map[string]*ttnpb.Gateway
+	collaborators map[string]*ttnpb.Collaborator
+	apiKeys       map[string]*ttnpb.APIKey
+}
+
+func newMockGatewayServer() *mockGatewayServer {
+	return &mockGatewayServer{
+		gateways:      make(map[string]*ttnpb.Gateway),
+		collaborators: make(map[string]*ttnpb.Collaborator),
+		apiKeys:       make(map[string]*ttnpb.APIKey),
+	}
+}
+
+func (s *mockGatewayServer) addAPIKey(ctx context.Context, rights ...ttnpb.Right) *ttnpb.APIKey {
+	id, err := auth.GenerateID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	key, err := auth.GenerateKey(ctx)
+	if err != nil {
+		panic(err)
+	}
+	apiKey := &ttnpb.APIKey{
+		Id:     id,
+		Key:    auth.JoinToken(auth.APIKey, id, key),
+		Rights: rights,
+	}
+	s.apiKeys[id] = apiKey
+	return apiKey
+}
+
+func (s *mockGatewayServer) GetIdentifiersForEUI(
+	ctx context.Context, req *ttnpb.GetGatewayIdentifiersForEUIRequest, opts ...grpc.CallOption,
+) (*ttnpb.GatewayIdentifiers, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, gtw := range s.gateways {
+		if types.MustEUI64(gtw.Ids.Eui).OrZero() == types.MustEUI64(req.Eui).OrZero() {
+			return gtw.Ids, nil
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (s *mockGatewayServer) Create(
+	ctx context.Context, req *ttnpb.CreateGatewayRequest, opts ...grpc.CallOption,
+) (*ttnpb.Gateway, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.gateways[req.Gateway.Ids.GatewayId] = req.Gateway
+	s.collaborators[unique.ID(ctx, req.Collaborator)] = &ttnpb.Collaborator{
+		Ids:    req.Collaborator,
+		Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_ALL},
+	}
+	return req.Gateway, nil
+}
+
+func (s *mockGatewayServer) Get(
+	ctx context.Context, req *ttnpb.GetGatewayRequest, opts ...grpc.CallOption,
+) (*ttnpb.Gateway, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	gtw, ok := s.gateways[req.GatewayIds.GatewayId]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return ttnpb.Clone(gtw), nil
+}
+
+func (s *mockGatewayServer) Update(
+	ctx context.Context, req *ttnpb.UpdateGatewayRequest, opts ...grpc.CallOption,
+) (*ttnpb.Gateway, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	gtw, ok := s.gateways[req.Gateway.Ids.GatewayId]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	if err := gtw.SetFields(req.Gateway, req.FieldMask.GetPaths()...); err != nil {
+		return nil, err
+	}
+	return gtw, nil
+}
+
+func (s *mockGatewayServer) ListRights(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, opts ...grpc.CallOption,
+) (*ttnpb.Rights, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, id, _, err := auth.SplitToken(apiKeyFromCallOptions(ctx, opts))
+	if err != nil {
+		return nil, err
+	}
+	apiKey, ok := s.apiKeys[id]
+	if !ok {
+		return &ttnpb.Rights{}, nil
+	}
+	return &ttnpb.Rights{Rights: apiKey.Rights}, nil
+}
+
+func (s *mockGatewayServer) CreateAPIKey(
+	ctx context.Context, req *ttnpb.CreateGatewayAPIKeyRequest, opts ...grpc.CallOption,
+) (*ttnpb.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	apiKey := s.addAPIKey(ctx, req.Rights...)
+	apiKey.Name = req.Name
+	return apiKey, nil
+}
+
+func (s *mockGatewayServer) ListAPIKeys(
+	ctx context.Context, req *ttnpb.ListGatewayAPIKeysRequest, opts ...grpc.CallOption,
+) (*ttnpb.APIKeys, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &ttnpb.APIKeys{}
+	for _, apiKey := range s.apiKeys {
+		res.ApiKeys = append(res.ApiKeys, apiKey)
+	}
+	return res, nil
+}
+
+func (s *mockGatewayServer) UpdateAPIKey(
+	ctx context.Context, req *ttnpb.UpdateGatewayAPIKeyRequest, opts ...grpc.CallOption,
+) (*ttnpb.APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(req.ApiKey.Rights) == 0 {
+		delete(s.apiKeys, req.ApiKey.Id)
+		return &ttnpb.APIKey{}, nil
+	}
+	s.apiKeys[req.ApiKey.Id].Rights = req.ApiKey.Rights
+	return s.apiKeys[req.ApiKey.Id], nil
+}
+
+func (s *mockGatewayServer) SetCollaborator(
+	ctx context.Context, req *ttnpb.SetGatewayCollaboratorRequest, opts ...grpc.CallOption,
+) (*pbtypes.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	uid := unique.ID(ctx, req.Collaborator.Ids)
+	if len(req.Collaborator.Rights) == 0 {
+		delete(s.collaborators, uid)
+	} else {
+		s.collaborators[uid] = req.Collaborator
+	}
+	return ttnpb.Empty, nil
+}
+
+func (s *mockGatewayServer) ListCollaborators(
+	ctx context.Context, req *ttnpb.ListGatewayCollaboratorsRequest, opts ...grpc.CallOption,
+) (*ttnpb.Collaborators, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &ttnpb.Collaborators{}
+	for _, collaborator := range s.collaborators {
+		res.Collaborators = append(res.Collaborators, collaborator)
+	}
+	return res, nil
+}
+
+var errUnavailable = errors.DefineUnavailable("unavailable", "unavailable")
+
+// failingUpdateGatewayServer is a mockGatewayServer that fails to update gateways.
+type failingUpdateGatewayServer struct {
+	*mockGatewayServer
+}
+
+func (failingUpdateGatewayServer) Update(
+	context.Context, *ttnpb.UpdateGatewayRequest, ...grpc.CallOption,
+) (*ttnpb.Gateway, error) {
+	return nil, errUnavailable.New()
+}
+
+// failingCreateAPIKeyGatewayServer is a mockGatewayServer that fails to create API keys.
+type failingCreateAPIKeyGatewayServer struct {
+	*mockGatewayServer
+}
+
+func (failingCreateAPIKeyGatewayServer) CreateAPIKey(
+	context.Context, *ttnpb.CreateGatewayAPIKeyRequest, ...grpc.CallOption,
+) (*ttnpb.APIKey, error) {
+	return nil, errUnavailable.New()
+}
+
+var errAccessDenied = errors.DefinePermissionDenied("access_denied", "access denied")
+
+type mockClaimer struct {
+	ownerToken string
+
+	mu          sync.Mutex
+	claimed     map[types.EUI64]bool
+	redirection *ttnpb.CUPSRedirection
+}
+
+func (c *mockClaimer) Claim(ctx context.Context, eui types.EUI64, ownerToken string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ownerToken != c.ownerToken {
+		return errAccessDenied.New()
+	}
+	if c.claimed == nil {
+		c.claimed = make(map[types.EUI64]bool)
+	}
+	c.claimed[eui] = true
+	return nil
+}
+
+func (c *mockClaimer) Redirect(ctx context.Context, eui types.EUI64, redirection *ttnpb.CUPSRedirection) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.claimed[eui] {
+		return errNotFound.New()
+	}
+	c.redirection = redirection
+	return nil
+}
+
+func (c *mockClaimer) Unclaim(ctx context.Context, eui types.EUI64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.claimed, eui)
+	return nil
+}
+
+type mockAuthorizationRegistry struct {
+	mu             sync.Mutex
+	authorizations map[string]*ttnpb.AuthorizeGatewayRequest
+}
+
+func (r *mockAuthorizationRegistry) Get(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.AuthorizeGatewayRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	req, ok := r.authorizations[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return req, nil
+}
+
+func (r *mockAuthorizationRegistry) Set(ctx context.Context, req *ttnpb.AuthorizeGatewayRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.authorizations == nil {
+		r.authorizations = make(map[string]*ttnpb.AuthorizeGatewayRequest)
+	}
+	r.authorizations[unique.ID(ctx, req.GatewayIds)] = req
+	return nil
+}
+
+func (r *mockAuthorizationRegistry) Delete(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.authorizations, unique.ID(ctx, ids))
+	return nil
+}
@@ -133,7 +133,12 @@ func (edcs *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.Claim
 		return nil, errNoJoinEUI.New()
 	}
 
-	err := edcs.DCS.endDeviceClaimingUpstream.Claim(ctx, joinEUI, devEUI, claimAuthenticationCode)
+	ids, err := edcs.DCS.endDeviceClaimingUpstream.Claim(ctx, &ttnpb.EndDeviceIdentifiers{
+		DeviceId:       req.TargetDeviceId,
+		ApplicationIds: req.TargetApplicationIds,
+		DevEui:         devEUI.Bytes(),
+		JoinEui:        joinEUI.Bytes(),
+	}, claimAuthenticationCode)
 	if err != nil {
 		if errors.IsAborted(err) {
 			log.FromContext(ctx).Warn("No upstream supports JoinEUI, use fallback")
@@ -141,14 +146,7 @@ func (edcs *endDeviceClaimingServer) Claim(ctx context.Context, req *ttnpb.Claim
 		}
 		return nil, err
 	}
-
-	// Echo identifiers from the request.
-	return &ttnpb.EndDeviceIdentifiers{
-		DeviceId:       req.TargetDeviceId,
-		ApplicationIds: req.TargetApplicationIds,
-		DevEui:         devEUI.Bytes(),
-		JoinEui:        joinEUI.Bytes(),
-	}, nil
+	return ids, nil
 }
 
 // Unclaim implements EndDeviceClaimingServer.
@@ -168,10 +166,18 @@ func (edcs *endDeviceClaimingServer) GetClaimStatus(ctx context.Context, in *ttn
 
 // AuthorizeApplication implements EndDeviceClaimingServer.
 func (edcs *endDeviceClaimingServer) AuthorizeApplication(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) (*pbtypes.Empty, error) {
-	return edcs.DCS.endDeviceClaimingFallback.AuthorizeApplication(ctx, req)
+	res, err := edcs.DCS.endDeviceClaimingUpstream.AuthorizeApplication(ctx, req)
+	if errors.IsUnimplemented(err) {
+		return edcs.DCS.endDeviceClaimingFallback.AuthorizeApplication(ctx, req)
+	}
+	return res, err
 }
 
 // UnauthorizeApplication implements EndDeviceClaimingServer.
 func (edcs *endDeviceClaimingServer) UnauthorizeApplication(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*pbtypes.Empty, error) {
-	return edcs.DCS.endDeviceClaimingFallback.UnauthorizeApplication(ctx, ids)
+	res, err := edcs.DCS.endDeviceClaimingUpstream.UnauthorizeApplication(ctx, ids)
+	if errors.IsUnimplemented(err) {
+		return edcs.DCS.endDeviceClaimingFallback.UnauthorizeApplication(ctx, ids)
+	}
+	return res, err
 }
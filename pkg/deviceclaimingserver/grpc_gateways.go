@@ -21,24 +21,6 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
-// noopGCLS is a no-op GCLS.
-type noopGCLS struct{}
-
-// Claim implements GatewayClaimingServer.
-func (noopGCLS) Claim(ctx context.Context, req *ttnpb.ClaimGatewayRequest) (ids *ttnpb.GatewayIdentifiers, retErr error) {
-	return nil, errMethodUnavailable.New()
-}
-
-// AuthorizeGateway implements GatewayClaimingServer.
-func (noopGCLS) AuthorizeGateway(ctx context.Context, req *ttnpb.AuthorizeGatewayRequest) (*pbtypes.Empty, error) {
-	return nil, errMethodUnavailable.New()
-}
-
-// UnauthorizeGateway implements GatewayClaimingServer.
-func (noopGCLS) UnauthorizeGateway(ctx context.Context, gtwIDs *ttnpb.GatewayIdentifiers) (*pbtypes.Empty, error) {
-	return nil, errMethodUnavailable.New()
-}
-
 // gatewayClaimingServer is the front facing entity for gRPC requests.
 type gatewayClaimingServer struct {
 	DCS *DeviceClaimingServer
@@ -0,0 +1,211 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis provides Redis implementations of the Device Claiming Server registries.
+package redis
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// encrypt encrypts the value with the key vault using the given key ID.
+// If the key ID is empty, the value is stored in plaintext.
+func encrypt(ctx context.Context, keyVault crypto.KeyVault, keyID string, value []byte) (*ttnpb.Secret, error) {
+	if keyID == "" {
+		return &ttnpb.Secret{Value: value}, nil
+	}
+	value, err := keyVault.Encrypt(ctx, value, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.Secret{
+		KeyId: keyID,
+		Value: value,
+	}, nil
+}
+
+// decrypt decrypts the secret with the key vault using the key ID of the secret.
+func decrypt(ctx context.Context, keyVault crypto.KeyVault, secret *ttnpb.Secret) ([]byte, error) {
+	if secret.KeyId == "" {
+		return secret.Value, nil
+	}
+	return keyVault.Decrypt(ctx, secret.Value, secret.KeyId)
+}
+
+// GatewayAuthorizationRegistry implements the gateways.AuthorizationRegistry interface.
+// The API keys are encrypted at rest with the key vault using EncryptionKeyID.
+type GatewayAuthorizationRegistry struct {
+	Redis           *ttnredis.Client
+	KeyVault        crypto.KeyVault
+	EncryptionKeyID string
+}
+
+func (r *GatewayAuthorizationRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get returns the authorization of the gateway.
+func (r *GatewayAuthorizationRegistry) Get(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.AuthorizeGatewayRequest, error) {
+	secret := &ttnpb.Secret{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.key(unique.ID(ctx, ids))).ScanProto(secret); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	apiKey, err := decrypt(ctx, r.KeyVault, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.AuthorizeGatewayRequest{
+		GatewayIds: ids,
+		ApiKey:     string(apiKey),
+	}, nil
+}
+
+// Set sets the authorization of the gateway.
+func (r *GatewayAuthorizationRegistry) Set(ctx context.Context, req *ttnpb.AuthorizeGatewayRequest) error {
+	secret, err := encrypt(ctx, r.KeyVault, r.EncryptionKeyID, []byte(req.ApiKey))
+	if err != nil {
+		return err
+	}
+	if _, err := ttnredis.SetProto(ctx, r.Redis, r.key(unique.ID(ctx, req.GatewayIds)), secret, 0); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete deletes the authorization of the gateway.
+func (r *GatewayAuthorizationRegistry) Delete(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error {
+	if err := r.Redis.Del(ctx, r.key(unique.ID(ctx, ids))).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// ApplicationAuthorizationRegistry implements the enddevices.ApplicationAuthorizationRegistry interface.
+// The API keys are encrypted at rest with the key vault using EncryptionKeyID.
+type ApplicationAuthorizationRegistry struct {
+	Redis           *ttnredis.Client
+	KeyVault        crypto.KeyVault
+	EncryptionKeyID string
+}
+
+func (r *ApplicationAuthorizationRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get returns the authorization of the application.
+func (r *ApplicationAuthorizationRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.AuthorizeApplicationRequest, error) {
+	secret := &ttnpb.Secret{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.key(unique.ID(ctx, ids))).ScanProto(secret); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	apiKey, err := decrypt(ctx, r.KeyVault, secret)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIds: ids,
+		ApiKey:         string(apiKey),
+	}, nil
+}
+
+// Set sets the authorization of the application.
+func (r *ApplicationAuthorizationRegistry) Set(ctx context.Context, req *ttnpb.AuthorizeApplicationRequest) error {
+	secret, err := encrypt(ctx, r.KeyVault, r.EncryptionKeyID, []byte(req.ApiKey))
+	if err != nil {
+		return err
+	}
+	if _, err := ttnredis.SetProto(ctx, r.Redis, r.key(unique.ID(ctx, req.ApplicationIds)), secret, 0); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete deletes the authorization of the application.
+func (r *ApplicationAuthorizationRegistry) Delete(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) error {
+	if err := r.Redis.Del(ctx, r.key(unique.ID(ctx, ids))).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// EndDeviceTransferRegistry implements the localjs.TransferRegistry interface.
+// The end devices are encrypted at rest with the key vault using EncryptionKeyID.
+type EndDeviceTransferRegistry struct {
+	Redis           *ttnredis.Client
+	KeyVault        crypto.KeyVault
+	EncryptionKeyID string
+}
+
+func (r *EndDeviceTransferRegistry) key(ids *ttnpb.EndDeviceIdentifiers) string {
+	return r.Redis.Key("eui",
+		types.MustEUI64(ids.JoinEui).OrZero().String(),
+		types.MustEUI64(ids.DevEui).OrZero().String(),
+	)
+}
+
+// Get returns the registrations of the end device that is being transferred.
+func (r *EndDeviceTransferRegistry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDevices, error) {
+	secret := &ttnpb.Secret{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.key(ids)).ScanProto(secret); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	b, err := decrypt(ctx, r.KeyVault, secret)
+	if err != nil {
+		return nil, err
+	}
+	pb := &ttnpb.EndDevices{}
+	if err := proto.Unmarshal(b, pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set sets the registrations of the end device that is being transferred.
+func (r *EndDeviceTransferRegistry) Set(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, devs *ttnpb.EndDevices,
+) error {
+	b, err := proto.Marshal(devs)
+	if err != nil {
+		return err
+	}
+	secret, err := encrypt(ctx, r.KeyVault, r.EncryptionKeyID, b)
+	if err != nil {
+		return err
+	}
+	if _, err := ttnredis.SetProto(ctx, r.Redis, r.key(ids), secret, 0); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete deletes the registrations of the end device that is being transferred.
+func (r *EndDeviceTransferRegistry) Delete(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	if err := r.Redis.Del(ctx, r.key(ids)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,223 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func newKeyVault() *cryptoutil.MemKeyVault {
+	return cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	})
+}
+
+func TestGatewayAuthorizationRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &GatewayAuthorizationRegistry{
+		Redis: cl,
+	}
+	ids := &ttnpb.GatewayIdentifiers{
+		GatewayId: "gtw1",
+	}
+
+	_, err := registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	req := &ttnpb.AuthorizeGatewayRequest{
+		GatewayIds: ids,
+		ApiKey:     "NNSXS.TEST",
+	}
+	a.So(registry.Set(ctx, req), should.BeNil)
+
+	res, err := registry.Get(ctx, ids)
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, req)
+	}
+
+	a.So(registry.Delete(ctx, ids), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestGatewayAuthorizationRegistryEncryption(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &GatewayAuthorizationRegistry{
+		Redis:           cl,
+		KeyVault:        newKeyVault(),
+		EncryptionKeyID: "test",
+	}
+	ids := &ttnpb.GatewayIdentifiers{
+		GatewayId: "gtw1",
+	}
+
+	req := &ttnpb.AuthorizeGatewayRequest{
+		GatewayIds: ids,
+		ApiKey:     "NNSXS.TEST",
+	}
+	a.So(registry.Set(ctx, req), should.BeNil)
+
+	// The API key is not stored in plaintext.
+	secret := &ttnpb.Secret{}
+	err := ttnredis.GetProto(ctx, cl, registry.key(unique.ID(ctx, ids))).ScanProto(secret)
+	if a.So(err, should.BeNil) {
+		a.So(secret.KeyId, should.Equal, "test")
+		a.So(string(secret.Value), should.NotContainSubstring, req.ApiKey)
+	}
+
+	res, err := registry.Get(ctx, ids)
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, req)
+	}
+}
+
+func TestApplicationAuthorizationRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &ApplicationAuthorizationRegistry{
+		Redis: cl,
+	}
+	ids := &ttnpb.ApplicationIdentifiers{
+		ApplicationId: "app1",
+	}
+
+	_, err := registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	req := &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIds: ids,
+		ApiKey:         "NNSXS.TEST",
+	}
+	a.So(registry.Set(ctx, req), should.BeNil)
+
+	res, err := registry.Get(ctx, ids)
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, req)
+	}
+
+	a.So(registry.Delete(ctx, ids), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestApplicationAuthorizationRegistryEncryption(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &ApplicationAuthorizationRegistry{
+		Redis:           cl,
+		KeyVault:        newKeyVault(),
+		EncryptionKeyID: "test",
+	}
+	ids := &ttnpb.ApplicationIdentifiers{
+		ApplicationId: "app1",
+	}
+
+	req := &ttnpb.AuthorizeApplicationRequest{
+		ApplicationIds: ids,
+		ApiKey:         "NNSXS.TEST",
+	}
+	a.So(registry.Set(ctx, req), should.BeNil)
+
+	// The API key is not stored in plaintext.
+	secret := &ttnpb.Secret{}
+	err := ttnredis.GetProto(ctx, cl, registry.key(unique.ID(ctx, ids))).ScanProto(secret)
+	if a.So(err, should.BeNil) {
+		a.So(secret.KeyId, should.Equal, "test")
+		a.So(string(secret.Value), should.NotContainSubstring, req.ApiKey)
+	}
+
+	res, err := registry.Get(ctx, ids)
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, req)
+	}
+}
+
+func TestEndDeviceTransferRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &EndDeviceTransferRegistry{
+		Redis:           cl,
+		KeyVault:        newKeyVault(),
+		EncryptionKeyID: "test",
+	}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"},
+		DeviceId:       "dev1",
+		JoinEui:        types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}.Bytes(),
+		DevEui:         types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30}.Bytes(),
+	}
+	appKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+
+	_, err := registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	devs := &ttnpb.EndDevices{
+		EndDevices: []*ttnpb.EndDevice{
+			{
+				Ids: ids,
+			},
+			{
+				Ids: ids,
+				RootKeys: &ttnpb.RootKeys{
+					AppKey: &ttnpb.KeyEnvelope{Key: appKey.Bytes()},
+				},
+			},
+		},
+	}
+	a.So(registry.Set(ctx, ids, devs), should.BeNil)
+
+	// The root keys are not stored in plaintext.
+	secret := &ttnpb.Secret{}
+	err = ttnredis.GetProto(ctx, cl, registry.key(ids)).ScanProto(secret)
+	if a.So(err, should.BeNil) {
+		a.So(secret.KeyId, should.Equal, "test")
+		a.So(string(secret.Value), should.NotContainSubstring, string(appKey.Bytes()))
+	}
+
+	res, err := registry.Get(ctx, ids)
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Resemble, devs)
+	}
+
+	a.So(registry.Delete(ctx, ids), should.BeNil)
+	_, err = registry.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
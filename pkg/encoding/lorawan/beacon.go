@@ -0,0 +1,113 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan
+
+import (
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/byteutil"
+)
+
+// Beacon is a Class B beacon frame.
+type Beacon struct {
+	// Time is the number of seconds since the GPS epoch modulo 2^32.
+	Time uint32
+	// InfoDesc describes the content of the gateway specific field.
+	// The values 0, 1 and 2 indicate the GPS coordinates of the first, second and third antenna of the gateway.
+	InfoDesc uint8
+	// Latitude is the latitude of the gateway antenna in degrees.
+	Latitude float64
+	// Longitude is the longitude of the gateway antenna in degrees.
+	Longitude float64
+}
+
+var errBeaconCRC = errors.DefineInvalidArgument("beacon_crc", "`{lorawan_field}` mismatch")
+
+// beaconCRC computes the CRC-16 of the beacon frame fields.
+// The polynomial is 0x1021 with initial value 0x0000, as in CRC-16/XMODEM.
+func beaconCRC(b []byte) uint16 {
+	var crc uint16
+	for _, v := range b {
+		crc ^= uint16(v) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func appendBeaconCoordinate(dst []byte, v, max float64) []byte {
+	i := int64(math.Round(v / max * (1 << 23)))
+	if i > 1<<23-1 {
+		i = 1<<23 - 1
+	}
+	return byteutil.AppendUint32(dst, uint32(i), 3)
+}
+
+func parseBeaconCoordinate(b []byte, max float64) float64 {
+	i := int32(byteutil.ParseUint32(b)<<8) >> 8
+	return float64(i) * max / (1 << 23)
+}
+
+// AppendBeacon appends the encoded beacon frame to dst.
+// The lengths of the RFU fields depend on the band, see band.Band.BeaconFrameLayout.
+func AppendBeacon(dst []byte, rfu1, rfu2 int, msg *Beacon) ([]byte, error) {
+	if msg.Latitude < -90 || msg.Latitude > 90 {
+		return nil, errExpectedBetween("Lat", -90, 90)(msg.Latitude)
+	}
+	if msg.Longitude < -180 || msg.Longitude > 180 {
+		return nil, errExpectedBetween("Lng", -180, 180)(msg.Longitude)
+	}
+	start := len(dst)
+	dst = append(dst, make([]byte, rfu1)...)
+	dst = byteutil.AppendUint32(dst, msg.Time, 4)
+	dst = byteutil.AppendUint16(dst, beaconCRC(dst[start:]), 2)
+	start = len(dst)
+	dst = append(dst, msg.InfoDesc)
+	dst = appendBeaconCoordinate(dst, msg.Latitude, 90)
+	dst = appendBeaconCoordinate(dst, msg.Longitude, 180)
+	dst = append(dst, make([]byte, rfu2)...)
+	dst = byteutil.AppendUint16(dst, beaconCRC(dst[start:]), 2)
+	return dst, nil
+}
+
+// MarshalBeacon returns the encoded beacon frame.
+func MarshalBeacon(rfu1, rfu2 int, msg *Beacon) ([]byte, error) {
+	return AppendBeacon(make([]byte, 0, rfu1+rfu2+15), rfu1, rfu2, msg)
+}
+
+// UnmarshalBeacon decodes the beacon frame from b into msg.
+func UnmarshalBeacon(b []byte, rfu1, rfu2 int, msg *Beacon) error {
+	if n := len(b); n != rfu1+rfu2+15 {
+		return errExpectedLengthEqual("Beacon", rfu1+rfu2+15)(n)
+	}
+	common, gwSpecific := b[:rfu1+6], b[rfu1+6:]
+	if byteutil.ParseUint32(common[rfu1+4:]) != uint32(beaconCRC(common[:rfu1+4])) {
+		return errBeaconCRC.WithAttributes("lorawan_field", "CRC1")
+	}
+	if byteutil.ParseUint32(gwSpecific[rfu2+7:]) != uint32(beaconCRC(gwSpecific[:rfu2+7])) {
+		return errBeaconCRC.WithAttributes("lorawan_field", "CRC2")
+	}
+	msg.Time = byteutil.ParseUint32(common[rfu1 : rfu1+4])
+	msg.InfoDesc = gwSpecific[0]
+	msg.Latitude = parseBeaconCoordinate(gwSpecific[1:4], 90)
+	msg.Longitude = parseBeaconCoordinate(gwSpecific[4:7], 180)
+	return nil
+}
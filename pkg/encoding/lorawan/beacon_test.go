@@ -0,0 +1,66 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lorawan_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBeacon(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	msg := &Beacon{
+		Time:      1234567890,
+		InfoDesc:  0,
+		Latitude:  52.37,
+		Longitude: -4.89,
+	}
+	b, err := MarshalBeacon(2, 0, msg)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(b, should.Resemble, []byte{
+		0x00, 0x00, 0xd2, 0x02, 0x96, 0x49, 0xab, 0x40,
+		0x00, 0x56, 0x7b, 0x4a, 0xcd, 0x85, 0xfc, 0x63, 0xa4,
+	})
+
+	var decoded Beacon
+	if a.So(UnmarshalBeacon(b, 2, 0, &decoded), should.BeNil) {
+		a.So(decoded.Time, should.Equal, msg.Time)
+		a.So(decoded.InfoDesc, should.Equal, msg.InfoDesc)
+		a.So(decoded.Latitude, should.AlmostEqual, msg.Latitude, 1e-5)
+		a.So(decoded.Longitude, should.AlmostEqual, msg.Longitude, 1e-4)
+	}
+
+	b, err = MarshalBeacon(5, 3, msg)
+	if a.So(err, should.BeNil) {
+		a.So(b, should.HaveLength, 23)
+		a.So(UnmarshalBeacon(b, 5, 3, &decoded), should.BeNil)
+	}
+
+	b[len(b)-1] ^= 0xff
+	a.So(UnmarshalBeacon(b, 5, 3, &decoded), should.NotBeNil)
+	a.So(UnmarshalBeacon(b, 2, 0, &decoded), should.NotBeNil)
+
+	_, err = MarshalBeacon(2, 0, &Beacon{Latitude: 91})
+	a.So(err, should.NotBeNil)
+	_, err = MarshalBeacon(2, 0, &Beacon{Longitude: -181})
+	a.So(err, should.NotBeNil)
+}
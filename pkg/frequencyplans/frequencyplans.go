@@ -83,6 +83,18 @@ func (lbt *LBT) ToConcentratorConfig() *ttnpb.ConcentratorConfig_LBTConfiguratio
 	}
 }
 
+// ToTxSettings returns the LBT settings for a downlink transmission in the protobuf format.
+func (lbt *LBT) ToTxSettings() *ttnpb.TxSettings_Downlink_ListenBeforeTalk {
+	if lbt == nil {
+		return nil
+	}
+	return &ttnpb.TxSettings_Downlink_ListenBeforeTalk{
+		RssiOffset: lbt.RSSIOffset,
+		RssiTarget: lbt.RSSITarget,
+		ScanTime:   ttnpb.ProtoDurationPtr(lbt.ScanTime),
+	}
+}
+
 // DwellTime contains dwell time settings.
 type DwellTime struct {
 	Uplinks   *bool          `yaml:"uplinks,omitempty"`
@@ -0,0 +1,133 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bunstore implements the Gateway Server stores on PostgreSQL databases.
+package bunstore
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/migrate"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/bunstore/migrations"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errDatabase = errors.DefineUnavailable("database", "database error")
+
+// connectionStatsSnapshot is the model of a gateway connection stats snapshot.
+type connectionStatsSnapshot struct {
+	bun.BaseModel `bun:"table:gateway_connection_stats_snapshots,alias:snapshot"`
+
+	GatewayUID string    `bun:"gateway_uid,pk"`
+	Time       time.Time `bun:"time,pk"`
+	Snapshot   []byte    `bun:"snapshot,notnull"`
+}
+
+// GatewayConnectionStatsHistory implements the GatewayConnectionStatsHistoryStore interface.
+// Snapshots are kept for Retention. The snapshots are not downsampled.
+type GatewayConnectionStatsHistory struct {
+	DB        *bun.DB
+	Retention time.Duration
+}
+
+// Init migrates the database schema of the Gateway Server stores.
+// The applied migrations are tracked separately from the migrations of the Identity Server,
+// so that the stores can share the database.
+func (s *GatewayConnectionStatsHistory) Init(ctx context.Context) error {
+	migrator := migrate.NewMigrator(s.DB, migrations.Migrations,
+		migrate.WithTableName("gs_bun_migrations"),
+		migrate.WithLocksTableName("gs_bun_migration_locks"),
+		migrate.WithMarkAppliedOnSuccess(true),
+	)
+	if err := migrator.Init(ctx); err != nil {
+		return errDatabase.WithCause(err)
+	}
+	if _, err := migrator.Migrate(ctx); err != nil {
+		return errDatabase.WithCause(err)
+	}
+	return nil
+}
+
+// Record stores the snapshot and deletes the snapshots of the gateway that are older than the retention.
+// The end of the interval of the snapshot is used as the current time for retention.
+func (s *GatewayConnectionStatsHistory) Record(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, snapshot *ttnpb.GatewayConnectionStatsSnapshot,
+) error {
+	defer trace.StartRegion(ctx, "record gateway connection stats snapshot").End()
+
+	uid := unique.ID(ctx, ids)
+	b, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	start := *ttnpb.StdTime(snapshot.Time)
+	now := start.Add(ttnpb.StdDurationOrZero(snapshot.Interval))
+	return s.DB.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.NewInsert().
+			Model(&connectionStatsSnapshot{
+				GatewayUID: uid,
+				Time:       start.UTC(),
+				Snapshot:   b,
+			}).
+			On("CONFLICT (gateway_uid, time) DO UPDATE").
+			Set("snapshot = EXCLUDED.snapshot").
+			Exec(ctx); err != nil {
+			return errDatabase.WithCause(err)
+		}
+		if s.Retention > 0 {
+			if _, err := tx.NewDelete().
+				Model((*connectionStatsSnapshot)(nil)).
+				Where("gateway_uid = ?", uid).
+				Where("time < ?", now.Add(-s.Retention).UTC()).
+				Exec(ctx); err != nil {
+				return errDatabase.WithCause(err)
+			}
+		}
+		return nil
+	})
+}
+
+// Range returns the snapshots that start in the time range.
+func (s *GatewayConnectionStatsHistory) Range(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, from, to time.Time,
+) ([]*ttnpb.GatewayConnectionStatsSnapshot, error) {
+	uid := unique.ID(ctx, ids)
+	var models []*connectionStatsSnapshot
+	if err := s.DB.NewSelect().
+		Model(&models).
+		Where("gateway_uid = ?", uid).
+		Where("time >= ?", from.UTC()).
+		Where("time < ?", to.UTC()).
+		Order("time ASC").
+		Scan(ctx); err != nil {
+		return nil, errDatabase.WithCause(err)
+	}
+	snapshots := make([]*ttnpb.GatewayConnectionStatsSnapshot, 0, len(models))
+	for _, model := range models {
+		snapshot := &ttnpb.GatewayConnectionStatsSnapshot{}
+		if err := proto.Unmarshal(model.Snapshot, snapshot); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to decode connection stats snapshot")
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bunstore
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "github.com/lib/pq" // PostgreSQL driver.
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewayConnectionStatsHistory(t *testing.T) {
+	a, ctx := test.New(t)
+
+	const schemaName = "gateway_connection_stats_history_test"
+	dsn := storetest.GetDSN("ttn_lorawan_is_store_test")
+	schemaDB, err := sql.Open("postgres", dsn.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer schemaDB.Close()
+	if err := storetest.CreateSchema(schemaDB, schemaName); err != nil {
+		t.Fatal(err)
+	}
+	defer storetest.DropSchema(schemaDB, schemaName)
+
+	sqlDB, err := storeutil.OpenDB(ctx, storetest.GetSchemaDSN(dsn, schemaName).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := bun.NewDB(sqlDB, pgdialect.New())
+	defer db.Close()
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	history := &GatewayConnectionStatsHistory{
+		DB:        db,
+		Retention: time.Hour,
+	}
+	if err := history.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	// The migrations are only applied once.
+	if err := history.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	start := time.Now().UTC().Truncate(time.Hour).Add(-3 * time.Hour)
+	for i := 0; i < 24; i++ {
+		err := history.Record(ctx, ids, &ttnpb.GatewayConnectionStatsSnapshot{
+			Time:        ttnpb.ProtoTimePtr(start.Add(time.Duration(i) * 5 * time.Minute)),
+			Interval:    ttnpb.ProtoDurationPtr(5 * time.Minute),
+			UplinkCount: 1,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// The snapshots that are older than the retention, relative to the end of the last snapshot, are deleted.
+	snapshots, err := history.Range(ctx, ids, start, start.Add(3*time.Hour))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(snapshots, should.HaveLength, 12) {
+		t.FailNow()
+	}
+	for i, snapshot := range snapshots {
+		a.So(*ttnpb.StdTime(snapshot.Time), should.Equal, start.Add(time.Hour+time.Duration(i)*5*time.Minute))
+		a.So(ttnpb.StdDurationOrZero(snapshot.Interval), should.Equal, 5*time.Minute)
+		a.So(snapshot.UplinkCount, should.Equal, 1)
+	}
+
+	// Recording a snapshot with the same time replaces the snapshot.
+	err = history.Record(ctx, ids, &ttnpb.GatewayConnectionStatsSnapshot{
+		Time:        ttnpb.ProtoTimePtr(start.Add(time.Hour)),
+		Interval:    ttnpb.ProtoDurationPtr(5 * time.Minute),
+		UplinkCount: 3,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The time range is inclusive of the start and exclusive of the end.
+	snapshots, err = history.Range(ctx, ids, start.Add(time.Hour), start.Add(70*time.Minute))
+	if a.So(err, should.BeNil) && a.So(snapshots, should.HaveLength, 2) {
+		a.So(*ttnpb.StdTime(snapshots[0].Time), should.Equal, start.Add(time.Hour))
+		a.So(snapshots[0].UplinkCount, should.Equal, 3)
+		a.So(*ttnpb.StdTime(snapshots[1].Time), should.Equal, start.Add(65*time.Minute))
+	}
+
+	// Other gateways have no history.
+	snapshots, err = history.Range(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}, start, start.Add(3*time.Hour))
+	a.So(err, should.BeNil)
+	a.So(snapshots, should.BeEmpty)
+}
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS gateway_connection_stats_snapshots;
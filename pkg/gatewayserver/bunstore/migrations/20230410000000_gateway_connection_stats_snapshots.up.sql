@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS gateway_connection_stats_snapshots (
+  gateway_uid varchar NOT NULL,
+  time timestamptz NOT NULL,
+  snapshot bytea NOT NULL,
+  PRIMARY KEY (gateway_uid, time)
+);
@@ -45,6 +45,47 @@ type PacketBrokerConfig struct {
 	OnlineTTLMargin       time.Duration `name:"online-ttl-margin" description:"Time to extend the online status before it expires"`
 }
 
+// RoamingConfig configures the passive roaming upstream.
+type RoamingConfig struct {
+	NetID   types.NetID          `name:"net-id" description:"NetID of this network, used as forwarding network in passive roaming"`
+	Interop config.InteropClient `name:"interop" description:"Interop client configuration with roaming partner Network Servers"`
+}
+
+// ConnectionStatsHistoryRedisConfig configures the Redis backend of the gateway connection stats history.
+type ConnectionStatsHistoryRedisConfig struct {
+	RawRetention       time.Duration `name:"raw-retention" description:"Time to keep the snapshots before they are downsampled"`
+	DownsampleInterval time.Duration `name:"downsample-interval" description:"Interval of downsampled snapshots"`
+	Retention          time.Duration `name:"retention" description:"Time to keep the downsampled snapshots"`
+}
+
+// ConnectionStatsHistorySQLConfig configures the SQL backend of the gateway connection stats history.
+// The SQL backend only supports PostgreSQL.
+type ConnectionStatsHistorySQLConfig struct {
+	DatabaseURI string        `name:"database-uri" description:"PostgreSQL database connection URI"`
+	Retention   time.Duration `name:"retention" description:"Time to keep the snapshots"`
+}
+
+// ConnectionStatsHistoryConfig configures the history of gateway connection stats.
+type ConnectionStatsHistoryConfig struct {
+	Store GatewayConnectionStatsHistoryStore `name:"-"`
+
+	Backend  string                            `name:"backend" description:"Backend of the gateway connection stats history (none, redis, sql)"`
+	Interval time.Duration                     `name:"interval" description:"Interval of gateway connection stats snapshots"`
+	Redis    ConnectionStatsHistoryRedisConfig `name:"redis"`
+	SQL      ConnectionStatsHistorySQLConfig   `name:"sql"`
+}
+
+// TrafficCaptureConfig configures captures of gateway traffic.
+// Captures are kept in the memory of the Gateway Server instance that the gateway is connected to.
+// The memory is bounded by MaxTotalSize: active captures count with their maximum size,
+// and stopped captures with their captured size.
+type TrafficCaptureConfig struct {
+	MaxDuration  time.Duration `name:"max-duration" description:"Maximum duration of gateway traffic captures"`
+	MaxSize      uint64        `name:"max-size" description:"Maximum size of gateway traffic captures in bytes"`
+	MaxTotalSize uint64        `name:"max-total-size" description:"Maximum total size of the active and stopped gateway traffic captures in bytes"`
+	Retention    time.Duration `name:"retention" description:"Time to keep stopped gateway traffic captures"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
@@ -60,10 +101,15 @@ type Config struct {
 	ConnectionStatsTTL                time.Duration `name:"connection-stats-ttl" description:"Time to live of the gateway connection stats that are periodically updated by Gateway Server"`
 	ConnectionStatsDisconnectTTL      time.Duration `name:"connection-stats-disconnect-ttl" description:"Time to live of the gateway connection stats after disconnecting"`
 
+	ConnectionStatsHistory ConnectionStatsHistoryConfig `name:"connection-stats-history" description:"History of gateway connection stats"`
+
+	TrafficCapture TrafficCaptureConfig `name:"traffic-capture" description:"Captures of gateway traffic"`
+
 	UpdateVersionInfoDelay time.Duration `name:"update-version-info-delay" description:"Maximum time to wait to update version information. A Jitter of 25% is applied for randomization"`
 
 	Forward      map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 	PacketBroker PacketBrokerConfig  `name:"packetbroker" description:"Packet Broker upstream configuration"`
+	Roaming      RoamingConfig       `name:"roaming" description:"Passive roaming upstream configuration"`
 
 	MQTT         config.MQTT        `name:"mqtt"`
 	MQTTV2       config.MQTT        `name:"mqtt-v2"`
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -42,9 +43,13 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/ns"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/packetbroker"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/roaming"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
@@ -73,10 +78,15 @@ type GatewayServer struct {
 	entityRegistry EntityRegistry
 
 	upstreamHandlers map[string]upstream.Handler
+	roaming          *roaming.Handler
 
 	connections sync.Map // string to connectionEntry
 
 	statsRegistry GatewayConnectionStatsRegistry
+	statsHistory  GatewayConnectionStatsHistoryStore
+
+	trafficCapturesMu sync.RWMutex
+	trafficCaptures   map[string]*io.TrafficCapture
 }
 
 // Option configures GatewayServer.
@@ -102,6 +112,9 @@ var (
 	errNotConnected        = errors.DefineNotFound("not_connected", "gateway `{gateway_uid}` not connected")
 	errSetupUpstream       = errors.DefineFailedPrecondition("upstream", "failed to setup upstream `{name}`")
 	errInvalidUpstreamName = errors.DefineInvalidArgument("invalid_upstream_name", "upstream `{name}` is invalid")
+	errNoRoamingInterop    = errors.DefineFailedPrecondition(
+		"no_roaming_interop", "no interop client configured for passive roaming",
+	)
 
 	modelAttribute    = "model"
 	firmwareAttribute = "firmware"
@@ -127,6 +140,8 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		forward:                   forward,
 		upstreamHandlers:          make(map[string]upstream.Handler),
 		statsRegistry:             conf.Stats,
+		statsHistory:              conf.ConnectionStatsHistory.Store,
+		trafficCaptures:           make(map[string]*io.TrafficCapture),
 		entityRegistry:            NewIS(c),
 	}
 	for _, opt := range opts {
@@ -154,6 +169,24 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 				UpdateJitter:    conf.PacketBroker.UpdateGatewayJitter,
 				OnlineTTLMargin: conf.PacketBroker.OnlineTTLMargin,
 			})
+		case "interop":
+			if conf.Roaming.Interop.IsZero() {
+				return nil, errNoRoamingInterop.New()
+			}
+			interopConf := conf.Roaming.Interop
+			interopConf.BlobConfig = c.GetBaseConfig(ctx).Blob
+			interopCl, err := interop.NewClient(ctx, interopConf, c, interop.SelectorNetworkServer)
+			if err != nil {
+				return nil, err
+			}
+			roamingHandler := roaming.NewHandler(gs.Context(), roaming.Config{
+				NetID:           conf.Roaming.NetID,
+				DevAddrPrefixes: prefix,
+				Client:          interopCl,
+				Scheduler:       roamingDownlinkScheduler{gs: gs},
+			})
+			gs.roaming = roamingHandler
+			handler = roamingHandler
 		default:
 			return nil, errInvalidUpstreamName.WithAttributes("name", name)
 		}
@@ -163,6 +196,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		gs.upstreamHandlers[name] = handler
 	}
 
+	if gs.roaming != nil {
+		c.RegisterInterop(gs)
+	}
+
 	// Register gRPC services.
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayserver"))
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", cluster.HookName, c.ClusterAuthUnaryHook())
@@ -325,6 +362,11 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	return gs, nil
 }
 
+// RegisterInterop registers the passive roaming sNS-fNS interop services.
+func (gs *GatewayServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterForwardingNS(gs.roaming)
+}
+
 // RegisterServices registers services provided by gs at s.
 func (gs *GatewayServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterGsServer(s, gs)
@@ -461,7 +503,9 @@ func (gs *GatewayServer) Connect(
 			"schedule_anytime_delay",
 			"schedule_downlink_late",
 			"status_public",
+			"transmit_beacons",
 			"update_location_from_status",
+			"uplink_filters",
 		),
 	})
 	if errors.IsNotFound(err) {
@@ -501,6 +545,9 @@ func (gs *GatewayServer) Connect(
 	if err != nil {
 		return nil, err
 	}
+	if tc, ok := gs.trafficCapture(uid); ok {
+		conn.SetTrafficCapture(tc)
+	}
 	wg := &sync.WaitGroup{}
 	// The tasks will always start once the entry is stored.
 	// As such, we must ensure any new connection waits for
@@ -527,6 +574,7 @@ func (gs *GatewayServer) Connect(
 	gs.startDisconnectOnChangeTask(connEntry)
 	gs.startHandleUpstreamTask(connEntry)
 	gs.startUpdateConnStatsTask(connEntry)
+	gs.startHandleBeaconsTask(connEntry)
 	// Unauthenticated connections cannot update the gateway entity.
 	// As such, there is no reason to start these tasks, since they
 	// will perpetually fail.
@@ -578,6 +626,7 @@ func requireDisconnect(connected, current *ttnpb.Gateway) bool {
 		ttnpb.StdDurationOrZero(connected.ScheduleAnytimeDelay) != ttnpb.StdDurationOrZero(current.ScheduleAnytimeDelay) ||
 		connected.ScheduleDownlinkLate != current.ScheduleDownlinkLate ||
 		connected.StatusPublic != current.StatusPublic ||
+		connected.TransmitBeacons != current.TransmitBeacons ||
 		connected.UpdateLocationFromStatus != current.UpdateLocationFromStatus ||
 		connected.FrequencyPlanId != current.FrequencyPlanId ||
 		len(connected.FrequencyPlanIds) != len(current.FrequencyPlanIds) {
@@ -588,7 +637,7 @@ func requireDisconnect(connected, current *ttnpb.Gateway) bool {
 			return true
 		}
 	}
-	return false
+	return !sameUplinkFilters(connected.UplinkFilters, current.UplinkFilters)
 }
 
 var errGatewayChanged = errors.Define("gateway_changed", "gateway changed in registry")
@@ -620,7 +669,9 @@ func (gs *GatewayServer) startDisconnectOnChangeTask(conn connectionEntry) {
 					"schedule_anytime_delay",
 					"schedule_downlink_late",
 					"status_public",
+					"transmit_beacons",
 					"update_location_from_status",
+					"uplink_filters",
 				),
 			})
 			if err != nil {
@@ -712,6 +763,24 @@ func (gs *GatewayServer) startHandleVersionUpdatesTask(conn connectionEntry) {
 	})
 }
 
+func (gs *GatewayServer) startHandleBeaconsTask(conn connectionEntry) {
+	if !conn.Gateway().GetTransmitBeacons() {
+		return
+	}
+	conn.tasksDone.Add(1)
+	gs.StartTask(&task.Config{
+		Context: conn.Context(),
+		ID:      fmt.Sprintf("handle_beacons_%s", unique.ID(conn.Context(), conn.Gateway().GetIds())),
+		Func: func(ctx context.Context) error {
+			gs.handleBeacons(ctx, conn)
+			return nil
+		},
+		Done:    conn.tasksDone.Done,
+		Restart: task.RestartNever,
+		Backoff: task.DialBackoffConfig,
+	})
+}
+
 var errHostHandle = errors.Define("host_handle", "host `{host}` failed to handle message")
 
 type upstreamHost struct {
@@ -819,11 +888,16 @@ func (host *upstreamHost) handlePacket(ctx context.Context, item any) {
 	}
 }
 
+var errUplinkFiltered = errors.DefineFailedPrecondition(
+	"uplink_filtered", "uplink message filtered for upstream `{name}`",
+)
+
 func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntry) {
 	var (
 		gtw      = conn.Gateway()
 		protocol = conn.Frontend().Protocol()
 		logger   = log.FromContext(ctx)
+		filters  = newUplinkFilters(gtw.UplinkFilters)
 	)
 	defer func() {
 		gs.connections.Delete(unique.ID(ctx, gtw.GetIds()))
@@ -906,6 +980,11 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 			registerReceiveTxAck(ctx, gtw, msg, protocol)
 		}
 		for _, host := range hosts {
+			if msg, ok := val.(*ttnpb.GatewayUplinkMessage); ok && !filters.allow(host.name, msg.Message.Payload) {
+				conn.RecordFilteredUplink(host.name)
+				registerDropUplink(ctx, gtw, msg, host.name, errUplinkFiltered.WithAttributes("name", host.name))
+				continue
+			}
 			err := host.pool.Publish(ctx, val)
 			if err == nil {
 				continue
@@ -964,6 +1043,27 @@ func (gs *GatewayServer) updateConnStats(ctx context.Context, conn connectionEnt
 		}
 	}()
 
+	var (
+		historyTicker  <-chan time.Time
+		prevStats      *ttnpb.GatewayConnectionStats
+		prevSnapshotAt = connectTime
+	)
+	recordSnapshot := func(now time.Time) {
+		stats, _ := conn.Stats()
+		snapshot := statshistory.NewSnapshot(prevStats, stats, prevSnapshotAt, now)
+		prevStats, prevSnapshotAt = stats, now
+		if err := gs.statsHistory.Record(decoupledCtx, ids, snapshot); err != nil {
+			logger.WithError(err).Warn("Failed to record connection stats snapshot")
+		}
+	}
+	if interval := gs.config.ConnectionStatsHistory.Interval; gs.statsHistory != nil && interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		historyTicker = ticker.C
+		// Record the last snapshot of the connection when the gateway disconnects.
+		defer func() { recordSnapshot(time.Now()) }()
+	}
+
 	var (
 		nextStats  = time.NewTimer(gs.config.UpdateConnectionStatsInterval)
 		lastUpdate = time.Now() // Start with a debounce, the initial update has already been sent.
@@ -972,6 +1072,9 @@ func (gs *GatewayServer) updateConnStats(ctx context.Context, conn connectionEnt
 		select {
 		case <-ctx.Done():
 			return
+		case now := <-historyTicker:
+			recordSnapshot(now)
+			continue
 		case <-conn.StatsChanged():
 			if !nextStats.Stop() {
 				<-nextStats.C
@@ -1134,6 +1237,32 @@ func (gs *GatewayServer) handleVersionInfoUpdates(ctx context.Context, conn conn
 	}
 }
 
+// beaconScheduleAhead is the time before the start of the beacon period at which the beacon is scheduled.
+const beaconScheduleAhead = 4 * time.Second
+
+// nextBeaconPeriodStart returns the start of the first beacon period after t.
+func nextBeaconPeriodStart(t time.Time) time.Time {
+	return gpstime.Parse((gpstime.ToGPS(t)/band.BeaconPeriod + 1) * band.BeaconPeriod)
+}
+
+// handleBeacons schedules the Class B beacon of each beacon period on the gateway.
+// Beacons that cannot be scheduled, for instance because the gateway is not synchronized with GPS time or because
+// the duty-cycle is exhausted, are skipped.
+func (gs *GatewayServer) handleBeacons(ctx context.Context, conn connectionEntry) {
+	logger := log.FromContext(ctx)
+	for {
+		periodStart := nextBeaconPeriodStart(time.Now().Add(beaconScheduleAhead))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Until(periodStart.Add(-beaconScheduleAhead))):
+		}
+		if err := conn.ScheduleBeacon(periodStart); err != nil {
+			logger.WithError(err).WithField("period_start", periodStart).Warn("Failed to schedule beacon")
+		}
+	}
+}
+
 // GetFrequencyPlans gets the frequency plans by the gateway identifiers.
 func (gs *GatewayServer) GetFrequencyPlans(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (map[string]*frequencyplans.FrequencyPlan, error) {
 	gtw, err := gs.entityRegistry.Get(ctx, &ttnpb.GetGatewayRequest{
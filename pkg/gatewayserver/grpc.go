@@ -16,8 +16,10 @@ package gatewayserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"golang.org/x/sync/errgroup"
@@ -50,6 +52,64 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 	return stats, nil
 }
 
+// defaultConnectionStatsHistoryRange is the time range of the connection stats history
+// if the request does not specify a time range.
+const defaultConnectionStatsHistoryRange = 24 * time.Hour
+
+var (
+	errConnectionStatsHistoryDisabled = errors.DefineFailedPrecondition(
+		"connection_stats_history_disabled", "gateway connection stats history is disabled",
+	)
+	errLastWithTimeRange = errors.DefineInvalidArgument(
+		"last_with_time_range", "last cannot be used in conjunction with after or before",
+	)
+	errInvalidTimeRange = errors.DefineInvalidArgument(
+		"invalid_time_range", "time range from `{from}` to `{to}` is invalid",
+	)
+)
+
+// GetGatewayConnectionStatsHistory returns the history of the statistics of gateway connections.
+func (gs *GatewayServer) GetGatewayConnectionStatsHistory(
+	ctx context.Context,
+	req *ttnpb.GetGatewayConnectionStatsHistoryRequest,
+) (*ttnpb.GatewayConnectionStatsHistory, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_STATUS_READ,
+	); err != nil {
+		return nil, err
+	}
+	if gs.statsHistory == nil {
+		return nil, errConnectionStatsHistoryDisabled.New()
+	}
+	if req.Last != nil && (req.After != nil || req.Before != nil) {
+		return nil, errLastWithTimeRange.New()
+	}
+	to := time.Now()
+	if req.Before != nil {
+		to = *ttnpb.StdTime(req.Before)
+	}
+	from := to.Add(-defaultConnectionStatsHistoryRange)
+	switch {
+	case req.After != nil:
+		from = *ttnpb.StdTime(req.After)
+	case req.Last != nil:
+		from = to.Add(-ttnpb.StdDurationOrZero(req.Last))
+	}
+	if !from.Before(to) {
+		return nil, errInvalidTimeRange.WithAttributes("from", from, "to", to)
+	}
+	snapshots, err := gs.statsHistory.Range(ctx, req.GatewayIds, from, to)
+	if err != nil {
+		return nil, err
+	}
+	if interval := ttnpb.StdDurationOrZero(req.AggregationInterval); interval > 0 {
+		snapshots = statshistory.Aggregate(snapshots, interval)
+	}
+	return &ttnpb.GatewayConnectionStatsHistory{
+		Snapshots: snapshots,
+	}, nil
+}
+
 func applyGatewayConnectionStatsFieldMask(
 	dst, src *ttnpb.GatewayConnectionStats,
 	paths ...string,
@@ -42,6 +42,11 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	return gs.scheduleDownlink(ctx, down)
+}
+
+// scheduleDownlink schedules the downlink message request on the first downlink path that succeeds.
+func (gs *GatewayServer) scheduleDownlink(ctx context.Context, down *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
 	request := down.GetRequest()
 	if request == nil {
 		return nil, errNotTxRequest.New()
@@ -112,3 +117,16 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 		PathErrors: protoErrs,
 	})
 }
+
+// roamingDownlinkScheduler schedules downlink messages of serving Network Servers in passive roaming.
+// The serving Network Servers are authenticated by the interop server, not by cluster authentication.
+type roamingDownlinkScheduler struct {
+	gs *GatewayServer
+}
+
+// ScheduleDownlink implements roaming.DownlinkScheduler.
+func (s roamingDownlinkScheduler) ScheduleDownlink(
+	ctx context.Context, down *ttnpb.DownlinkMessage,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
+	return s.gs.scheduleDownlink(ctx, down)
+}
@@ -0,0 +1,155 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	stdio "io"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errRemoteShellStart = errors.DefineInvalidArgument(
+		"remote_shell_start", "first remote shell message must start the session",
+	)
+	errRemoteShellInput = errors.DefineInvalidArgument(
+		"remote_shell_input", "remote shell messages after the first message must contain input",
+	)
+)
+
+func (gs *GatewayServer) remoteShell(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (io.RemoteShell, error) {
+	uid := unique.ID(ctx, ids)
+	val, ok := gs.connections.Load(uid)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	return val.(connectionEntry).RemoteShell()
+}
+
+// RunGatewayRemoteCommand runs a command on a connected gateway.
+func (gs *GatewayServer) RunGatewayRemoteCommand(
+	ctx context.Context, req *ttnpb.GatewayRemoteCommandRequest,
+) (*pbtypes.Empty, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL,
+	); err != nil {
+		return nil, err
+	}
+	rs, err := gs.remoteShell(ctx, req.GatewayIds)
+	if err != nil {
+		return nil, err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", unique.ID(ctx, req.GatewayIds),
+		"command", req.Command,
+		"arguments", req.Arguments,
+	))
+	logger.Info("Run remote command on gateway")
+	events.Publish(evtRunRemoteCommand.NewWithIdentifiersAndData(ctx, req.GatewayIds, req))
+	if err := rs.RunCommand(ctx, req.Command, req.Arguments); err != nil {
+		logger.WithError(err).Warn("Failed to run remote command on gateway")
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// GatewayRemoteShell opens an interactive remote shell session on a connected gateway.
+// The first message of the stream must start the session.
+func (gs *GatewayServer) GatewayRemoteShell(stream ttnpb.Gs_GatewayRemoteShellServer) (err error) {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return errRemoteShellStart.New()
+	}
+	ids := start.GatewayIds
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL); err != nil {
+		return err
+	}
+	rs, err := gs.remoteShell(ctx, ids)
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", unique.ID(ctx, ids),
+		"user", start.User,
+		"term", start.Term,
+	))
+	session, err := rs.OpenShell(ctx, start.User, start.Term)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to open remote shell session on gateway")
+		return err
+	}
+	logger.Info("Opened remote shell session on gateway")
+	events.Publish(evtOpenRemoteShell.NewWithIdentifiersAndData(ctx, ids, start))
+	defer func() {
+		if closeErr := session.Close(); closeErr != nil {
+			logger.WithError(closeErr).Debug("Failed to close remote shell session on gateway")
+		}
+		logger.WithError(err).Info("Closed remote shell session on gateway")
+		events.Publish(evtCloseRemoteShell.NewWithIdentifiersAndData(ctx, ids, err))
+	}()
+
+	recvErrCh := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErrCh <- err
+				return
+			}
+			msg, ok := req.Message.(*ttnpb.GatewayRemoteShellRequest_Input)
+			if !ok {
+				recvErrCh <- errRemoteShellInput.New()
+				return
+			}
+			events.Publish(evtRemoteShellInput.NewWithIdentifiersAndData(ctx, ids, req))
+			if err := session.Write(msg.Input); err != nil {
+				recvErrCh <- err
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-session.Done():
+			return session.Err()
+		case err := <-recvErrCh:
+			if err == stdio.EOF {
+				return nil
+			}
+			return err
+		case output := <-session.Output():
+			res := &ttnpb.GatewayRemoteShellResponse{Output: output}
+			events.Publish(evtRemoteShellOutput.NewWithIdentifiersAndData(ctx, ids, res))
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errTrafficCaptureDuration = errors.DefineInvalidArgument(
+		"traffic_capture_duration", "traffic capture duration `{duration}` exceeds the maximum of `{max}`",
+	)
+	errTrafficCaptureSize = errors.DefineInvalidArgument(
+		"traffic_capture_size", "traffic capture size `{size}` exceeds the maximum of `{max}` bytes",
+	)
+	errTrafficCapturesTotalSize = errors.DefineResourceExhausted(
+		"traffic_captures_total_size", "traffic captures exceed the maximum total size of `{max}` bytes",
+	)
+	errTrafficCaptureNotFound = errors.DefineNotFound(
+		"traffic_capture_not_found", "traffic capture of gateway `{gateway_uid}` not found on this Gateway Server",
+	)
+)
+
+func (gs *GatewayServer) trafficCapture(uid string) (*io.TrafficCapture, bool) {
+	gs.trafficCapturesMu.RLock()
+	defer gs.trafficCapturesMu.RUnlock()
+	tc, ok := gs.trafficCaptures[uid]
+	return tc, ok
+}
+
+// trafficCapturesSize returns the total size of the traffic captures, except the capture of the given gateway.
+// Active captures count with their maximum size, and stopped captures with their captured size.
+// The caller must hold trafficCapturesMu.
+func (gs *GatewayServer) trafficCapturesSize(exceptUID string) uint64 {
+	var size uint64
+	for uid, tc := range gs.trafficCaptures {
+		if uid == exceptUID {
+			continue
+		}
+		capture := tc.Capture(false)
+		if capture.StoppedAt != nil {
+			size += capture.CapturedSize
+		} else {
+			size += capture.MaxSize
+		}
+	}
+	return size
+}
+
+// StartGatewayTrafficCapture starts capturing the traffic of a connected gateway.
+// The capture is kept in memory of this Gateway Server, so the gateway must be connected to this Gateway Server.
+func (gs *GatewayServer) StartGatewayTrafficCapture(
+	ctx context.Context, req *ttnpb.StartGatewayTrafficCaptureRequest,
+) (*ttnpb.GatewayTrafficCapture, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ,
+	); err != nil {
+		return nil, err
+	}
+	conf := gs.config.TrafficCapture
+	duration := conf.MaxDuration
+	if d := ttnpb.StdDuration(req.Duration); d != nil {
+		if *d > conf.MaxDuration {
+			return nil, errTrafficCaptureDuration.WithAttributes("duration", *d, "max", conf.MaxDuration)
+		}
+		duration = *d
+	}
+	maxSize := conf.MaxSize
+	if req.MaxSize > 0 {
+		if req.MaxSize > conf.MaxSize {
+			return nil, errTrafficCaptureSize.WithAttributes("size", req.MaxSize, "max", conf.MaxSize)
+		}
+		maxSize = req.MaxSize
+	}
+
+	uid := unique.ID(ctx, req.GatewayIds)
+	val, ok := gs.connections.Load(uid)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", uid)
+	}
+	conn := val.(connectionEntry).Connection
+	gs.trafficCapturesMu.Lock()
+	// The previous capture of the gateway is discarded, so it does not count towards the total size.
+	if gs.trafficCapturesSize(uid)+maxSize > conf.MaxTotalSize {
+		gs.trafficCapturesMu.Unlock()
+		return nil, errTrafficCapturesTotalSize.WithAttributes("max", conf.MaxTotalSize)
+	}
+	tc := io.NewTrafficCapture(conn.Gateway().GetIds(), conn.Frontend().Protocol(), duration, maxSize)
+	if prev, ok := gs.trafficCaptures[uid]; ok {
+		prev.Stop()
+	}
+	gs.trafficCaptures[uid] = tc
+	gs.trafficCapturesMu.Unlock()
+	conn.SetTrafficCapture(tc)
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"gateway_uid", uid,
+		"duration", duration,
+		"max_size", maxSize,
+	))
+	logger.Info("Started gateway traffic capture")
+	res := tc.Capture(false)
+	events.Publish(evtStartTrafficCapture.NewWithIdentifiersAndData(ctx, req.GatewayIds, res))
+
+	ctx = gs.FromRequestContext(ctx)
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tc.Done():
+		}
+		res := tc.Capture(false)
+		logger.WithField("size", res.CapturedSize).Info("Stopped gateway traffic capture")
+		events.Publish(evtStopTrafficCapture.NewWithIdentifiersAndData(ctx, req.GatewayIds, res))
+		time.AfterFunc(conf.Retention, func() {
+			gs.trafficCapturesMu.Lock()
+			if gs.trafficCaptures[uid] == tc {
+				delete(gs.trafficCaptures, uid)
+			}
+			gs.trafficCapturesMu.Unlock()
+		})
+	}()
+	return res, nil
+}
+
+// StopGatewayTrafficCapture stops capturing the traffic of a gateway and returns the capture.
+// Only captures that are started on this Gateway Server are found.
+func (gs *GatewayServer) StopGatewayTrafficCapture(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayTrafficCapture, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+	tc, ok := gs.trafficCapture(uid)
+	if !ok {
+		return nil, errTrafficCaptureNotFound.WithAttributes("gateway_uid", uid)
+	}
+	tc.Stop()
+	return tc.Capture(true), nil
+}
+
+// GetGatewayTrafficCapture returns the active or the last traffic capture of a gateway.
+// Only captures that are started on this Gateway Server are found.
+func (gs *GatewayServer) GetGatewayTrafficCapture(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayTrafficCapture, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	uid := unique.ID(ctx, ids)
+	tc, ok := gs.trafficCapture(uid)
+	if !ok {
+		return nil, errTrafficCaptureNotFound.WithAttributes("gateway_uid", uid)
+	}
+	return tc.Capture(true), nil
+}
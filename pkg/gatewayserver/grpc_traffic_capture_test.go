@@ -0,0 +1,189 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// trafficCaptureRegistry is an entity registry that authorizes with the rights in the context.
+type trafficCaptureRegistry struct {
+	gatewayserver.EntityRegistry
+}
+
+func (trafficCaptureRegistry) AssertGatewayRights(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, required ...ttnpb.Right,
+) error {
+	return rights.RequireGateway(ctx, ids, required...)
+}
+
+func (trafficCaptureRegistry) Get(_ context.Context, req *ttnpb.GetGatewayRequest) (*ttnpb.Gateway, error) {
+	return &ttnpb.Gateway{
+		Ids:             req.GatewayIds,
+		FrequencyPlanId: test.EUFrequencyPlanID,
+	}, nil
+}
+
+func (trafficCaptureRegistry) UpdateAttributes(context.Context, *ttnpb.GatewayIdentifiers, map[string]string, map[string]string) error {
+	return nil
+}
+
+func TestTrafficCapture(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+	defer c.Close()
+
+	gs, err := gatewayserver.New(c, &gatewayserver.Config{
+		TrafficCapture: gatewayserver.TrafficCaptureConfig{
+			MaxDuration:  time.Hour,
+			MaxSize:      1 << 10,
+			MaxTotalSize: 3 << 9,
+			Retention:    time.Hour,
+		},
+	}, gatewayserver.WithRegistry(trafficCaptureRegistry{}))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	componenttest.StartComponent(t, c)
+
+	gtw1IDs := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	gtw2IDs := &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}
+	gtw3IDs := &ttnpb.GatewayIdentifiers{GatewayId: "gtw3"}
+	withRights := func(ctx context.Context, required ...ttnpb.Right) context.Context {
+		return rights.NewContext(ctx, &rights.Rights{
+			GatewayRights: *rights.NewMap(map[string]*ttnpb.Rights{
+				unique.ID(ctx, gtw1IDs): ttnpb.RightsFrom(required...),
+				unique.ID(ctx, gtw2IDs): ttnpb.RightsFrom(required...),
+				unique.ID(ctx, gtw3IDs): ttnpb.RightsFrom(required...),
+			}),
+		})
+	}
+	for _, ids := range []*ttnpb.GatewayIdentifiers{gtw1IDs, gtw2IDs} {
+		if _, err := mock.ConnectFrontend(withRights(ctx, ttnpb.Right_RIGHT_GATEWAY_LINK), ids, gs); err != nil {
+			t.Fatalf("Failed to connect gateway: %v", err)
+		}
+	}
+	ctx = withRights(ctx, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ)
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		a := assertions.New(t)
+		ctx := withRights(ctx, ttnpb.Right_RIGHT_GATEWAY_INFO)
+		_, err := gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{GatewayIds: gtw1IDs})
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		_, err = gs.StopGatewayTrafficCapture(ctx, gtw1IDs)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		_, err = gs.GetGatewayTrafficCapture(ctx, gtw1IDs)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("NotConnected", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{GatewayIds: gtw3IDs})
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := gs.StopGatewayTrafficCapture(ctx, gtw1IDs)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+		_, err = gs.GetGatewayTrafficCapture(ctx, gtw1IDs)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("Limits", func(t *testing.T) {
+		a := assertions.New(t)
+		_, err := gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{
+			GatewayIds: gtw1IDs,
+			Duration:   ttnpb.ProtoDurationPtr(2 * time.Hour),
+		})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		_, err = gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{
+			GatewayIds: gtw1IDs,
+			MaxSize:    2 << 10,
+		})
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("TotalSize", func(t *testing.T) {
+		a := assertions.New(t)
+
+		// The capture uses the maximum size by default.
+		capture, err := gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{GatewayIds: gtw1IDs})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(capture.MaxSize, should.Equal, 1<<10)
+		a.So(capture.StoppedAt, should.BeNil)
+
+		// The active capture of the first gateway reserves its maximum size.
+		_, err = gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{GatewayIds: gtw2IDs})
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		_, err = gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{
+			GatewayIds: gtw2IDs,
+			MaxSize:    1 << 9,
+		})
+		a.So(err, should.BeNil)
+
+		// Restarting the capture of a gateway discards the previous capture.
+		_, err = gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{GatewayIds: gtw1IDs})
+		a.So(err, should.BeNil)
+
+		// Stopped captures count with their captured size.
+		capture, err = gs.StopGatewayTrafficCapture(ctx, gtw1IDs)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(capture.StoppedAt, should.NotBeNil)
+		a.So(capture.CapturedSize, should.Equal, 0)
+		_, err = gs.StartGatewayTrafficCapture(ctx, &ttnpb.StartGatewayTrafficCaptureRequest{GatewayIds: gtw2IDs})
+		a.So(err, should.BeNil)
+
+		// Stopped captures are retained.
+		capture, err = gs.GetGatewayTrafficCapture(ctx, gtw1IDs)
+		if a.So(err, should.BeNil) {
+			a.So(capture.StoppedAt, should.NotBeNil)
+		}
+		capture, err = gs.GetGatewayTrafficCapture(ctx, gtw2IDs)
+		if a.So(err, should.BeNil) {
+			a.So(capture.StoppedAt, should.BeNil)
+			a.So(capture.MaxSize, should.Equal, 1<<10)
+		}
+	})
+}
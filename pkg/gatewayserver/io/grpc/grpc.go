@@ -77,6 +77,7 @@ func New(server io.Server, opts ...Option) ttnpb.GtwGsServer {
 
 func (*impl) Protocol() string                          { return "grpc" }
 func (*impl) SupportsDownlinkClaim() bool               { return false }
+func (*impl) SupportsListenBeforeTalk() bool            { return true }
 func (*impl) DutyCycleStyle() scheduling.DutyCycleStyle { return scheduling.DefaultDutyCycleStyle }
 
 var errConnect = errors.Define("connect", "failed to connect gateway `{gateway_uid}`")
@@ -145,6 +146,8 @@ func (s *impl) LinkGateway(link ttnpb.GtwGs_LinkGatewayServer) error {
 				"has_status", msg.GatewayStatus != nil,
 				"uplink_count", len(msg.UplinkMessages),
 			)).Debug("Received message")
+			// The messages are exchanged as Protocol Buffers, so the raw frames are not captured.
+			conn.CaptureUp(nil, msg)
 
 			for _, up := range io.UniqueUplinkMessagesByRSSI(msg.UplinkMessages) {
 				up.ReceivedAt = ttnpb.ProtoTimePtr(now)
@@ -189,6 +192,7 @@ func (s *impl) LinkGateway(link ttnpb.GtwGs_LinkGatewayServer) error {
 				conn.Disconnect(err)
 				return err
 			}
+			conn.CaptureDown(nil, msg)
 		}
 	}
 }
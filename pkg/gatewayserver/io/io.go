@@ -19,16 +19,19 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/task"
@@ -48,6 +51,8 @@ type Frontend interface {
 	Protocol() string
 	// SupportsDownlinkClaim returns true if the frontend can itself claim downlinks.
 	SupportsDownlinkClaim() bool
+	// SupportsListenBeforeTalk returns true if the frontend sends listen-before-talk settings in downlink messages.
+	SupportsListenBeforeTalk() bool
 	// DutyCycleStyle returns the duty cycle style used by the frontend.
 	DutyCycleStyle() scheduling.DutyCycleStyle
 }
@@ -117,6 +122,14 @@ type Connection struct {
 	lastRepeatUpEventTime time.Time
 
 	addr *ttnpb.GatewayRemoteAddress
+
+	remoteShell   RemoteShell
+	remoteShellMu sync.RWMutex
+
+	trafficCapture atomic.Pointer[TrafficCapture]
+
+	filteredUplinks   map[string]uint64
+	filteredUplinksMu sync.RWMutex
 }
 
 type uplinkMessage struct {
@@ -385,7 +398,7 @@ func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) (err error) {
 	return nil
 }
 
-// HandleTxAck sends the acknowledgment to the status channel.
+// HandleTxAck records the acknowledgment in the scheduler and sends the acknowledgment to the status channel.
 func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) (err error) {
 	defer func() {
 		if err != nil {
@@ -395,6 +408,9 @@ func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) (err error) {
 	if err := ack.ValidateFields(); err != nil {
 		return err
 	}
+	if scheduled := ack.GetDownlinkMessage().GetScheduled(); scheduled != nil && c.scheduler != nil {
+		c.scheduler.HandleTxAck(scheduled, ack.Result)
+	}
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
@@ -573,27 +589,15 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 				"data_rate", rx.dataRate,
 			)
 		}
-		eirp := phy.DefaultMaxEIRP
-		if sb, ok := phy.FindSubBand(rx.frequency); ok {
-			eirp = sb.MaxEIRP
-		}
-		if fp.MaxEIRP != nil {
-			eirp = *fp.MaxEIRP
-		}
-		if sb, ok := fp.FindSubBand(rx.frequency); ok && sb.MaxEIRP != nil {
-			eirp = *sb.MaxEIRP
-		}
 		settings := &ttnpb.TxSettings{
 			DataRate:  rx.dataRate,
 			Frequency: rx.frequency,
 			Downlink: &ttnpb.TxSettings_Downlink{
-				TxPower:      eirp,
-				AntennaIndex: ids.AntennaIndex,
+				TxPower:          c.txPower(phy, fp, rx.frequency, ids.AntennaIndex),
+				AntennaIndex:     ids.AntennaIndex,
+				ListenBeforeTalk: c.listenBeforeTalk(rx.frequency),
 			},
 		}
-		if int(ids.AntennaIndex) < len(c.gateway.Antennas) {
-			settings.Downlink.TxPower -= c.gateway.Antennas[ids.AntennaIndex].Gain
-		}
 		switch rx.dataRate.Modulation.(type) {
 		case *ttnpb.DataRate_Lora:
 			settings.Downlink.InvertPolarization = true
@@ -678,6 +682,110 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 	return
 }
 
+// txPower returns the transmit power on the given frequency and antenna. The transmit power is the maximum EIRP
+// of the band, overridden by the frequency plan, minus the antenna gain.
+func (c *Connection) txPower(
+	phy band.Band, fp *frequencyplans.FrequencyPlan, frequency uint64, antennaIndex uint32,
+) float32 {
+	eirp := phy.DefaultMaxEIRP
+	if sb, ok := phy.FindSubBand(frequency); ok {
+		eirp = sb.MaxEIRP
+	}
+	if fp.MaxEIRP != nil {
+		eirp = *fp.MaxEIRP
+	}
+	if sb, ok := fp.FindSubBand(frequency); ok && sb.MaxEIRP != nil {
+		eirp = *sb.MaxEIRP
+	}
+	if int(antennaIndex) < len(c.gateway.Antennas) {
+		eirp -= c.gateway.Antennas[antennaIndex].Gain
+	}
+	return eirp
+}
+
+// listenBeforeTalk returns the listen-before-talk settings for a transmission on the given frequency.
+// The settings are only returned if the frequency requires listen-before-talk and if the frontend supports sending
+// listen-before-talk settings in downlink messages. Other gateways are configured with the listen-before-talk
+// requirements of the frequency plan.
+func (c *Connection) listenBeforeTalk(frequency uint64) *ttnpb.TxSettings_Downlink_ListenBeforeTalk {
+	if !c.frontend.SupportsListenBeforeTalk() {
+		return nil
+	}
+	lbt, ok := c.scheduler.ListenBeforeTalk(frequency)
+	if !ok {
+		return nil
+	}
+	return lbt.ToTxSettings()
+}
+
+// ScheduleBeacon schedules and sends the Class B beacon of the beacon period that starts at the given time.
+// Beacons are scheduled with the highest priority, and only on gateways that are synchronized with GPS time.
+// The gateway specific field of the beacon contains the location of the first antenna, if known.
+func (c *Connection) ScheduleBeacon(periodStart time.Time) error {
+	if !c.scheduler.IsGatewayTimeSynced() {
+		return errNoGPSSync.New()
+	}
+	fp := c.gatewayPrimaryFP
+	phy, err := band.GetLatest(fp.BandID)
+	if err != nil {
+		return err
+	}
+	rfu1, rfu2, err := phy.BeaconFrameLayout()
+	if err != nil {
+		return err
+	}
+	gpsTime := gpstime.ToGPS(periodStart)
+	beacon := &lorawan.Beacon{
+		Time: uint32(gpsTime / time.Second),
+	}
+	if len(c.gateway.Antennas) > 0 {
+		if loc := c.gateway.Antennas[0].Location; loc != nil {
+			beacon.Latitude, beacon.Longitude = loc.Latitude, loc.Longitude
+		}
+	}
+	payload, err := lorawan.MarshalBeacon(rfu1, rfu2, beacon)
+	if err != nil {
+		return err
+	}
+
+	dataRate := ttnpb.Clone(phy.DataRates[phy.Beacon.DataRateIndex].Rate)
+	dataRate.GetLora().CodingRate = phy.Beacon.CodingRate
+	frequency := band.ComputePeriodicFrequency(gpsTime, band.BeaconPeriod, 0, phy.Beacon.Frequencies...)
+	settings := &ttnpb.TxSettings{
+		DataRate:  dataRate,
+		Frequency: frequency,
+		Downlink: &ttnpb.TxSettings_Downlink{
+			TxPower:          c.txPower(phy, fp, frequency, 0),
+			ListenBeforeTalk: c.listenBeforeTalk(frequency),
+			// Beacons use the implicit header mode without CRC and have a longer preamble than data frames.
+			ImplicitHeader: true,
+			PreambleLength: band.BeaconPreambleLength,
+		},
+		Time: ttnpb.ProtoTimePtr(periodStart.Add(band.BeaconDelay)),
+	}
+	em, _, err := c.scheduler.ScheduleAt(c.ctx, scheduling.Options{
+		PayloadSize: len(payload),
+		TxSettings:  settings,
+		RTTs:        c.rtts,
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	if err != nil {
+		return err
+	}
+	settings.ConcentratorTimestamp = int64(em.Starts())
+	log.FromContext(c.ctx).WithFields(log.Fields(
+		"frequency", frequency,
+		"starts", em.Starts(),
+		"period_start", periodStart,
+	)).Debug("Scheduled beacon")
+	return c.SendDown(&ttnpb.DownlinkMessage{
+		RawPayload: payload,
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: settings,
+		},
+	})
+}
+
 // Status returns the status channel.
 func (c *Connection) Status() <-chan *ttnpb.GatewayStatus {
 	return c.statusCh
@@ -745,6 +853,32 @@ func (c *Connection) DownStats() (total uint64, t time.Time, ok bool) {
 	return
 }
 
+// RecordFilteredUplink records that an uplink message was not forwarded to the given upstream
+// because of the uplink filters of the gateway.
+func (c *Connection) RecordFilteredUplink(upstream string) {
+	c.filteredUplinksMu.Lock()
+	if c.filteredUplinks == nil {
+		c.filteredUplinks = make(map[string]uint64)
+	}
+	c.filteredUplinks[upstream]++
+	c.filteredUplinksMu.Unlock()
+	c.notifyStatsChanged()
+}
+
+// FilteredUplinkStats returns the number of filtered uplink messages by upstream.
+func (c *Connection) FilteredUplinkStats() (counts map[string]uint64, ok bool) {
+	c.filteredUplinksMu.RLock()
+	defer c.filteredUplinksMu.RUnlock()
+	if len(c.filteredUplinks) == 0 {
+		return nil, false
+	}
+	counts = make(map[string]uint64, len(c.filteredUplinks))
+	for upstream, count := range c.filteredUplinks {
+		counts[upstream] = count
+	}
+	return counts, true
+}
+
 // RTTStats returns the recorded round-trip time statistics.
 func (c *Connection) RTTStats(percentile int, t time.Time) (min, max, median, np time.Duration, count int) {
 	return c.rtts.Stats(percentile, t)
@@ -790,6 +924,10 @@ func (c *Connection) Stats() (*ttnpb.GatewayConnectionStats, []string) {
 		}
 		paths = append(paths, "round_trip_times")
 	}
+	if counts, ok := c.FilteredUplinkStats(); ok {
+		stats.FilteredUplinkCounts = counts
+		paths = append(paths, "filtered_uplink_counts")
+	}
 	return stats, paths
 }
 
@@ -25,9 +25,12 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
 	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -681,6 +684,89 @@ func TestSubBandEIRPOverride(t *testing.T) {
 	}
 }
 
+func TestScheduleBeacon(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	is, _, closeIS := mockis.New(ctx)
+	defer closeIS()
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+
+	gs := mock.NewServer(c, is)
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "beacon-gateway"}
+	gtw := &ttnpb.Gateway{
+		Ids:             ids,
+		FrequencyPlanId: test.EUFrequencyPlanID,
+		Antennas: []*ttnpb.GatewayAntenna{
+			{
+				Location: &ttnpb.Location{
+					Latitude:  52.37,
+					Longitude: 4.89,
+				},
+			},
+		},
+		TransmitBeacons: true,
+	}
+	gs.RegisterGateway(ctx, ids, gtw)
+
+	gtwCtx := rights.NewContext(ctx, &rights.Rights{
+		GatewayRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_LINK),
+		}),
+	})
+	frontend, err := mock.ConnectFrontend(gtwCtx, ids, gs)
+	if err != nil {
+		panic(err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	periodStart := time.Now().Add(10 * time.Second).Truncate(time.Second)
+
+	// Beacons require GPS synchronization.
+	a.So(conn.ScheduleBeacon(periodStart), should.NotBeNil)
+
+	now := time.Now()
+	conn.SyncWithGatewayConcentrator(100, now, &now, scheduling.ConcentratorTime(100*time.Microsecond))
+
+	if !a.So(conn.ScheduleBeacon(periodStart), should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case msg := <-frontend.Down:
+		scheduled := msg.GetScheduled()
+		if !a.So(scheduled, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(scheduled.Frequency, should.Equal, 869525000)
+		a.So(scheduled.DataRate.GetLora().GetSpreadingFactor(), should.Equal, 9)
+		a.So(scheduled.EnableCrc, should.BeFalse)
+		a.So(scheduled.Downlink.InvertPolarization, should.BeFalse)
+		a.So(scheduled.Downlink.ImplicitHeader, should.BeTrue)
+		a.So(scheduled.Downlink.PreambleLength, should.Equal, band.BeaconPreambleLength)
+		a.So(*ttnpb.StdTime(scheduled.Time), should.Equal, periodStart.Add(band.BeaconDelay))
+
+		var beacon lorawan.Beacon
+		if a.So(lorawan.UnmarshalBeacon(msg.RawPayload, 2, 0, &beacon), should.BeNil) {
+			a.So(beacon.Time, should.Equal, uint32(gpstime.ToGPS(periodStart)/time.Second))
+			a.So(beacon.Latitude, should.AlmostEqual, 52.37, 1e-4)
+			a.So(beacon.Longitude, should.AlmostEqual, 4.89, 1e-4)
+		}
+	case <-time.After(timeout):
+		t.Fatalf("Expected beacon timeout")
+	}
+
+	// The beacon of the same period conflicts with the scheduled beacon.
+	a.So(conn.ScheduleBeacon(periodStart), should.NotBeNil)
+}
+
 func TestUniqueUplinkMessagesByRSSI(t *testing.T) {
 	for _, tc := range []struct {
 		name string
@@ -754,3 +840,64 @@ func TestUniqueUplinkMessagesByRSSI(t *testing.T) {
 		})
 	}
 }
+
+func TestFilteredUplinkStats(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	is, _, closeIS := mockis.New(ctx)
+	defer closeIS()
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+
+	gs := mock.NewServer(c, is)
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "filter-gateway"}
+	gs.RegisterGateway(ctx, ids, &ttnpb.Gateway{
+		Ids:             ids,
+		FrequencyPlanId: test.EUFrequencyPlanID,
+	})
+
+	gtwCtx := rights.NewContext(ctx, &rights.Rights{
+		GatewayRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_LINK),
+		}),
+	})
+	if _, err := mock.ConnectFrontend(gtwCtx, ids, gs); err != nil {
+		panic(err)
+	}
+	conn := gs.GetConnection(ctx, ids)
+
+	_, ok := conn.FilteredUplinkStats()
+	a.So(ok, should.BeFalse)
+	stats, paths := conn.Stats()
+	a.So(stats.FilteredUplinkCounts, should.BeEmpty)
+	a.So(paths, should.NotContain, "filtered_uplink_counts")
+
+	conn.RecordFilteredUplink("cluster")
+	conn.RecordFilteredUplink("cluster")
+	conn.RecordFilteredUplink("packetbroker")
+
+	select {
+	case <-conn.StatsChanged():
+	case <-time.After(timeout):
+		t.Fatal("Expected stats changed notification")
+	}
+
+	counts, ok := conn.FilteredUplinkStats()
+	if a.So(ok, should.BeTrue) {
+		a.So(counts, should.Resemble, map[string]uint64{
+			"cluster":      2,
+			"packetbroker": 1,
+		})
+	}
+	stats, paths = conn.Stats()
+	a.So(stats.FilteredUplinkCounts, should.Resemble, counts)
+	a.So(paths, should.Contain, "filtered_uplink_counts")
+}
@@ -33,6 +33,7 @@ type Frontend struct {
 
 func (*Frontend) Protocol() string                          { return "mock" }
 func (*Frontend) SupportsDownlinkClaim() bool               { return true }
+func (*Frontend) SupportsListenBeforeTalk() bool            { return true }
 func (*Frontend) DutyCycleStyle() scheduling.DutyCycleStyle { return scheduling.DefaultDutyCycleStyle }
 
 // ConnectFrontend connects a new mock front-end to the given server.
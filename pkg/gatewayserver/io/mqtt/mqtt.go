@@ -24,7 +24,6 @@ import (
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
 	"github.com/TheThingsIndustries/mystique/pkg/packet"
-	"github.com/TheThingsIndustries/mystique/pkg/session"
 	"github.com/TheThingsIndustries/mystique/pkg/topic"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -41,6 +40,10 @@ import (
 
 const qosDownlink byte = 0
 
+// downlinkMessageExpiry is the lifetime of downlink messages that are sent to MQTT v5 gateways,
+// if the downlink message is not scheduled at an absolute time.
+const downlinkMessageExpiry = 10 * time.Second
+
 // Serve serves the MQTT frontend.
 func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/mqtt")
@@ -61,6 +64,7 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 type connection struct {
 	format   Format
 	server   io.Server
+	mqttConn mqttnet.Conn
 	io       *io.Connection
 	tokens   io.DownlinkTokens
 	resource ratelimit.Resource
@@ -68,18 +72,26 @@ type connection struct {
 
 func (*connection) Protocol() string            { return "mqtt" }
 func (*connection) SupportsDownlinkClaim() bool { return false }
+
+// SupportsListenBeforeTalk implements io.Frontend.
+// Only the Protocol Buffers format sends the listen-before-talk settings in downlink messages.
+func (c *connection) SupportsListenBeforeTalk() bool {
+	_, ok := c.format.(*protobuf)
+	return ok
+}
 func (*connection) DutyCycleStyle() scheduling.DutyCycleStyle {
 	return scheduling.DefaultDutyCycleStyle
 }
 
 func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server) error {
 	c := &connection{
-		format: format,
-		server: server,
+		format:   format,
+		server:   server,
+		mqttConn: mqttConn,
 	}
 
 	ctx = auth.NewContextWithInterface(ctx, c)
-	session := session.New(ctx, mqttConn, c.deliver)
+	session := mqtt.NewSession(ctx, mqttConn, c.deliver)
 	if err := session.ReadConnect(); err != nil {
 		if c.io != nil {
 			c.io.Disconnect(err)
@@ -110,12 +122,18 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 				}
 				logger.Info("Publish downlink message")
 				topicParts := format.DownlinkTopic(unique.ID(c.io.Context(), c.io.Gateway().GetIds()))
-				session.Publish(&packet.PublishPacket{
+				pkt := &packet.PublishPacket{
 					TopicName:  topic.Join(topicParts),
 					TopicParts: topicParts,
 					QoS:        qosDownlink,
 					Message:    buf,
+				}
+				mqtt.SetPublishProperties(mqttConn, pkt, mqtt.PublishProperties{
+					MessageExpiry:  downlinkExpiry(down, time.Now()),
+					CorrelationIDs: down.CorrelationIds,
 				})
+				session.Publish(pkt)
+				c.io.CaptureDown(buf, &ttnpb.GatewayDown{DownlinkMessage: down})
 			}
 		}
 	}
@@ -133,13 +151,29 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 	return nil
 }
 
+// downlinkExpiry returns the lifetime of the downlink message.
+// Downlink messages that are scheduled at an absolute time expire at that time.
+func downlinkExpiry(down *ttnpb.DownlinkMessage, now time.Time) time.Duration {
+	if t := ttnpb.StdTime(down.GetScheduled().GetTime()); t != nil {
+		if d := t.Sub(now); d > time.Second {
+			return d
+		}
+		return time.Second
+	}
+	return downlinkMessageExpiry
+}
+
 type topicAccess struct {
 	gtwUID string
 	reads  [][]string
 	writes [][]string
 }
 
-func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
+func (c *connection) Connect(ctx context.Context, info *auth.Info) (_ context.Context, err error) {
+	defer func() {
+		err = mqtt.ConnectError(err)
+	}()
+
 	ids := &ttnpb.GatewayIdentifiers{
 		GatewayId: info.Username,
 	}
@@ -156,7 +190,7 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	}
 	ctx = metadata.NewIncomingContext(ctx, md)
 
-	ctx, ids, err := c.server.FillGatewayContext(ctx, ids)
+	ctx, ids, err = c.server.FillGatewayContext(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
@@ -244,9 +278,12 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		up, err := c.format.ToUplink(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal uplink message")
+			c.io.CaptureUp(pkt.Message, nil)
 			return
 		}
 		up.ReceivedAt = ttnpb.ProtoTimePtr(pkt.Received)
+		up.CorrelationIds = append(up.CorrelationIds, mqtt.ReceivedPublishProperties(c.mqttConn, pkt).CorrelationIDs...)
+		c.io.CaptureUp(pkt.Message, &ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{up}})
 		if err := c.io.HandleUp(up, nil); err != nil {
 			logger.WithError(err).Warn("Failed to handle uplink message")
 		}
@@ -254,8 +291,10 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		status, err := c.format.ToStatus(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal status message")
+			c.io.CaptureUp(pkt.Message, nil)
 			return
 		}
+		c.io.CaptureUp(pkt.Message, &ttnpb.GatewayUp{GatewayStatus: status})
 		if err := c.io.HandleStatus(status); err != nil {
 			logger.WithError(err).Warn("Failed to handle status message")
 		}
@@ -263,13 +302,16 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		ack, err := c.format.ToTxAck(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal Tx acknowledgment message")
+			c.io.CaptureUp(pkt.Message, nil)
 			return
 		}
+		ack.CorrelationIds = append(ack.CorrelationIds, mqtt.ReceivedPublishProperties(c.mqttConn, pkt).CorrelationIDs...)
 		if token, ok := c.tokens.ParseTokenFromCorrelationIDs(ack.GetCorrelationIds()); ok {
 			if down, _, ok := c.tokens.Get(token, time.Now()); ok {
 				ack.DownlinkMessage = down
 			}
 		}
+		c.io.CaptureUp(pkt.Message, &ttnpb.GatewayUp{TxAcknowledgment: ack})
 		if err := c.io.HandleTxAck(ack); err != nil {
 			logger.WithError(err).Warn("Failed to handle Tx acknowledgment message")
 		}
@@ -0,0 +1,68 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var errRemoteShellNotSupported = errors.DefineFailedPrecondition(
+	"remote_shell_not_supported", "remote commands and remote shells are not supported by the gateway connection",
+)
+
+// RemoteShell runs remote commands and opens remote shell sessions on a gateway.
+type RemoteShell interface {
+	// RunCommand runs the command with the arguments on the gateway.
+	RunCommand(ctx context.Context, command string, arguments []string) error
+	// OpenShell opens an interactive shell session on the gateway.
+	OpenShell(ctx context.Context, user, term string) (RemoteShellSession, error)
+}
+
+// RemoteShellSession is an interactive shell session on a gateway.
+type RemoteShellSession interface {
+	// Write writes the input to the shell.
+	Write(input []byte) error
+	// Output returns the channel with the output of the shell.
+	// The channel is not closed when the session ends.
+	Output() <-chan []byte
+	// Done returns a channel that is closed when the session ends.
+	Done() <-chan struct{}
+	// Err returns the error that ended the session.
+	// It returns nil if the session has not ended, or if the session ended normally.
+	Err() error
+	// Close ends the session.
+	Close() error
+}
+
+// SetRemoteShell sets the remote shell of the connection.
+// Frontends that support remote commands and remote shells set the remote shell when the gateway connects.
+func (c *Connection) SetRemoteShell(rs RemoteShell) {
+	c.remoteShellMu.Lock()
+	c.remoteShell = rs
+	c.remoteShellMu.Unlock()
+}
+
+// RemoteShell returns the remote shell of the connection.
+// An error is returned if the frontend does not support remote commands and remote shells.
+func (c *Connection) RemoteShell() (RemoteShell, error) {
+	c.remoteShellMu.RLock()
+	defer c.remoteShellMu.RUnlock()
+	if c.remoteShell == nil {
+		return nil, errRemoteShellNotSupported.New()
+	}
+	return c.remoteShell, nil
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/exp/slices"
+)
+
+// TrafficCapture captures the frames exchanged with a gateway.
+// The capture stops when the duration or the size limit is reached, or when it is stopped explicitly.
+type TrafficCapture struct {
+	mu      sync.RWMutex
+	capture *ttnpb.GatewayTrafficCapture
+	timer   *time.Timer
+	done    chan struct{}
+}
+
+// NewTrafficCapture starts a traffic capture for the gateway.
+// The capture stops after the duration, or before the captured frames exceed maxSize bytes.
+func NewTrafficCapture(
+	ids *ttnpb.GatewayIdentifiers, protocol string, duration time.Duration, maxSize uint64,
+) *TrafficCapture {
+	tc := &TrafficCapture{
+		capture: &ttnpb.GatewayTrafficCapture{
+			GatewayIds: ids,
+			Protocol:   protocol,
+			StartedAt:  ttnpb.ProtoTimePtr(time.Now()),
+			Duration:   ttnpb.ProtoDurationPtr(duration),
+			MaxSize:    maxSize,
+		},
+		done: make(chan struct{}),
+	}
+	// The timer may fire before it is assigned, so assign it while holding the lock that Stop acquires.
+	tc.mu.Lock()
+	tc.timer = time.AfterFunc(duration, tc.Stop)
+	tc.mu.Unlock()
+	return tc
+}
+
+// Stop stops the capture. Stopping a stopped capture has no effect.
+func (tc *TrafficCapture) Stop() {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	tc.stop()
+}
+
+func (tc *TrafficCapture) stop() {
+	if tc.capture.StoppedAt != nil {
+		return
+	}
+	tc.timer.Stop()
+	tc.capture.StoppedAt = ttnpb.ProtoTimePtr(time.Now())
+	close(tc.done)
+}
+
+// Done returns a channel that is closed when the capture stops.
+func (tc *TrafficCapture) Done() <-chan struct{} { return tc.done }
+
+// Capture returns a copy of the capture.
+// The captured frames are only included if withFrames is true.
+func (tc *TrafficCapture) Capture(withFrames bool) *ttnpb.GatewayTrafficCapture {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+	res := &ttnpb.GatewayTrafficCapture{
+		GatewayIds:   tc.capture.GatewayIds,
+		Protocol:     tc.capture.Protocol,
+		StartedAt:    tc.capture.StartedAt,
+		StoppedAt:    tc.capture.StoppedAt,
+		Duration:     tc.capture.Duration,
+		MaxSize:      tc.capture.MaxSize,
+		CapturedSize: tc.capture.CapturedSize,
+	}
+	if withFrames {
+		// Frames are not modified after they are captured, so the slice can be shared.
+		res.Frames = tc.capture.Frames[:len(tc.capture.Frames):len(tc.capture.Frames)]
+	}
+	return res
+}
+
+func (tc *TrafficCapture) record(
+	direction ttnpb.GatewayTrafficCaptureFrame_Direction, raw []byte, up *ttnpb.GatewayUp, down *ttnpb.GatewayDown,
+) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if tc.capture.StoppedAt != nil {
+		return
+	}
+	frame := &ttnpb.GatewayTrafficCaptureFrame{
+		Time:      ttnpb.ProtoTimePtr(time.Now()),
+		Direction: direction,
+		Raw:       slices.Clone(raw),
+	}
+	if up != nil {
+		frame.Up = ttnpb.Clone(up)
+	}
+	if down != nil {
+		frame.Down = ttnpb.Clone(down)
+	}
+	size := uint64(proto.Size(frame))
+	if tc.capture.CapturedSize+size > tc.capture.MaxSize {
+		tc.stop()
+		return
+	}
+	tc.capture.CapturedSize += size
+	tc.capture.Frames = append(tc.capture.Frames, frame)
+}
+
+// SetTrafficCapture sets the traffic capture of the connection.
+// The frontend records the frames exchanged with the gateway while the capture is active.
+func (c *Connection) SetTrafficCapture(tc *TrafficCapture) {
+	c.trafficCapture.Store(tc)
+}
+
+// CaptureUp records the raw upstream frame and the decoded message if a traffic capture is active.
+// The decoded message may be nil if the frame does not carry messages.
+func (c *Connection) CaptureUp(raw []byte, up *ttnpb.GatewayUp) {
+	if tc := c.trafficCapture.Load(); tc != nil {
+		tc.record(ttnpb.GatewayTrafficCaptureFrame_UPLINK, raw, up, nil)
+	}
+}
+
+// CaptureDown records the raw downstream frame and the decoded message if a traffic capture is active.
+func (c *Connection) CaptureDown(raw []byte, down *ttnpb.GatewayDown) {
+	if tc := c.trafficCapture.Load(); tc != nil {
+		tc.record(ttnpb.GatewayTrafficCaptureFrame_DOWNLINK, raw, nil, down)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestTrafficCapture(t *testing.T) {
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"}
+	up := &ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{{RawPayload: []byte{0x01, 0x02, 0x03}}},
+	}
+	down := &ttnpb.GatewayDown{
+		DownlinkMessage: &ttnpb.DownlinkMessage{RawPayload: []byte{0x04, 0x05}},
+	}
+
+	t.Run("Frames", func(t *testing.T) {
+		a := assertions.New(t)
+		conn := &Connection{}
+
+		// Frames are not recorded without a capture.
+		conn.CaptureUp([]byte("up"), up)
+
+		tc := NewTrafficCapture(ids, "udp", time.Hour, 1<<20)
+		conn.SetTrafficCapture(tc)
+		conn.CaptureUp([]byte("pull"), nil)
+		conn.CaptureUp([]byte("up"), up)
+		conn.CaptureDown([]byte("down"), down)
+
+		// The captured messages are copies.
+		up.UplinkMessages[0].RawPayload[0] = 0xff
+
+		capture := tc.Capture(false)
+		a.So(capture.GatewayIds, should.Resemble, ids)
+		a.So(capture.Protocol, should.Equal, "udp")
+		a.So(capture.StoppedAt, should.BeNil)
+		a.So(capture.Frames, should.BeEmpty)
+		a.So(capture.CapturedSize, should.BeGreaterThan, 0)
+
+		tc.Stop()
+		select {
+		case <-tc.Done():
+		default:
+			t.Fatal("Capture not done after stop")
+		}
+		conn.CaptureUp([]byte("up"), up)
+
+		capture = tc.Capture(true)
+		a.So(capture.StoppedAt, should.NotBeNil)
+		if !a.So(capture.Frames, should.HaveLength, 3) {
+			t.FailNow()
+		}
+		a.So(capture.Frames[0].Direction, should.Equal, ttnpb.GatewayTrafficCaptureFrame_UPLINK)
+		a.So(capture.Frames[0].Raw, should.Resemble, []byte("pull"))
+		a.So(capture.Frames[0].Up, should.BeNil)
+		a.So(capture.Frames[1].Direction, should.Equal, ttnpb.GatewayTrafficCaptureFrame_UPLINK)
+		a.So(capture.Frames[1].Raw, should.Resemble, []byte("up"))
+		a.So(capture.Frames[1].Up.UplinkMessages[0].RawPayload, should.Resemble, []byte{0x01, 0x02, 0x03})
+		a.So(capture.Frames[2].Direction, should.Equal, ttnpb.GatewayTrafficCaptureFrame_DOWNLINK)
+		a.So(capture.Frames[2].Raw, should.Resemble, []byte("down"))
+		a.So(capture.Frames[2].Down, should.Resemble, down)
+
+		var size uint64
+		for _, frame := range capture.Frames {
+			size += uint64(proto.Size(frame))
+		}
+		a.So(capture.CapturedSize, should.Equal, size)
+	})
+
+	t.Run("SizeLimit", func(t *testing.T) {
+		a := assertions.New(t)
+		conn := &Connection{}
+
+		tc := NewTrafficCapture(ids, "ws", time.Hour, 64)
+		conn.SetTrafficCapture(tc)
+		conn.CaptureDown([]byte("first"), nil)
+		conn.CaptureDown(make([]byte, 64), nil)
+		conn.CaptureDown([]byte("last"), nil)
+
+		select {
+		case <-tc.Done():
+		default:
+			t.Fatal("Capture not done after exceeding size limit")
+		}
+		capture := tc.Capture(true)
+		a.So(capture.CapturedSize, should.BeLessThanOrEqualTo, 64)
+		if a.So(capture.Frames, should.HaveLength, 1) {
+			a.So(capture.Frames[0].Raw, should.Resemble, []byte("first"))
+		}
+	})
+
+	t.Run("DurationLimit", func(t *testing.T) {
+		a := assertions.New(t)
+
+		tc := NewTrafficCapture(ids, "mqtt", test.Delay, 1<<20)
+		select {
+		case <-tc.Done():
+		case <-time.After(10 * test.Delay):
+			t.Fatal("Capture not done after duration")
+		}
+		a.So(tc.Capture(false).StoppedAt, should.NotBeNil)
+	})
+}
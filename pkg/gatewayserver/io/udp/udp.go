@@ -50,6 +50,7 @@ type srv struct {
 
 func (*srv) Protocol() string                          { return "udp" }
 func (*srv) SupportsDownlinkClaim() bool               { return true }
+func (*srv) SupportsListenBeforeTalk() bool            { return false }
 func (*srv) DutyCycleStyle() scheduling.DutyCycleStyle { return scheduling.DefaultDutyCycleStyle }
 
 var (
@@ -82,7 +83,7 @@ func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, config Conf
 
 		limitLogs: limitLogs,
 	}
-	wp := workerpool.NewWorkerPool(workerpool.Config[encoding.Packet]{
+	wp := workerpool.NewWorkerPool(workerpool.Config[receivedPacket]{
 		Component:  server,
 		Context:    ctx,
 		Name:       "udp",
@@ -100,7 +101,13 @@ func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, config Conf
 
 var errPacketType = errors.DefineInvalidArgument("packet_type", "invalid packet type")
 
-func (s *srv) read(wp workerpool.WorkerPool[encoding.Packet]) error {
+// receivedPacket is a packet received from a gateway with the datagram it was unmarshaled from.
+type receivedPacket struct {
+	packet encoding.Packet
+	raw    []byte
+}
+
+func (s *srv) read(wp workerpool.WorkerPool[receivedPacket]) error {
 	var buf [65507]byte
 	for {
 		n, addr, err := s.conn.ReadFromUDP(buf[:])
@@ -147,7 +154,7 @@ func (s *srv) read(wp workerpool.WorkerPool[encoding.Packet]) error {
 			continue
 		}
 
-		if err := wp.Publish(ctx, packet); err != nil {
+		if err := wp.Publish(ctx, receivedPacket{packet: packet, raw: packetBuf}); err != nil {
 			logger.WithError(err).Warn("UDP packet publishing failed")
 			registerMessageDropped(ctx, err)
 			continue
@@ -156,7 +163,8 @@ func (s *srv) read(wp workerpool.WorkerPool[encoding.Packet]) error {
 	}
 }
 
-func (s *srv) handlePacket(ctx context.Context, packet encoding.Packet) {
+func (s *srv) handlePacket(ctx context.Context, received receivedPacket) {
+	packet := received.packet
 	eui := *packet.GatewayEUI
 	ctx = log.NewContextWithField(ctx, "gateway_eui", eui)
 	logger := log.FromContext(ctx)
@@ -183,7 +191,7 @@ func (s *srv) handlePacket(ctx context.Context, packet encoding.Packet) {
 		return
 	}
 
-	if err := s.handleUp(cs.io.Context(), cs, packet); err != nil {
+	if err := s.handleUp(cs.io.Context(), cs, packet, received.raw); err != nil {
 		logger.WithError(err).Warn("Failed to handle upstream packet")
 	}
 }
@@ -255,7 +263,7 @@ func (s *srv) connect(ctx context.Context, eui types.EUI64, addr *net.UDPAddr) (
 	return cs, nil
 }
 
-func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet) error {
+func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet, raw []byte) error {
 	logger := log.FromContext(ctx)
 	md := encoding.UpstreamMetadata{
 		ID: state.io.Gateway().GetIds(),
@@ -266,6 +274,7 @@ func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet
 	switch packet.PacketType {
 	case encoding.PullData:
 		atomic.StoreInt64(&state.lastSeenPull, now.UnixNano())
+		state.io.CaptureUp(raw, nil)
 		state.lastDownlinkPath.Store(downlinkPath{
 			addr:    *packet.GatewayAddr,
 			version: packet.ProtocolVersion,
@@ -298,10 +307,14 @@ func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet
 		msg, err := encoding.ToGatewayUp(*packet.Data, md)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal packet")
+			state.io.CaptureUp(raw, nil)
 			return err
 		}
-		for _, up := range io.UniqueUplinkMessagesByRSSI(msg.UplinkMessages) {
+		for _, up := range msg.UplinkMessages {
 			up.ReceivedAt = ttnpb.ProtoTimePtr(packet.ReceivedAt)
+		}
+		state.io.CaptureUp(raw, msg)
+		for _, up := range io.UniqueUplinkMessagesByRSSI(msg.UplinkMessages) {
 			if err := state.io.HandleUp(up, nil); err != nil {
 				logger.WithError(err).Warn("Failed to handle uplink message")
 			}
@@ -323,6 +336,7 @@ func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet
 			msg, err = encoding.ToGatewayUp(*packet.Data, md)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to unmarshal packet")
+				state.io.CaptureUp(raw, nil)
 				return err
 			}
 		} else {
@@ -338,6 +352,7 @@ func (s *srv) handleUp(ctx context.Context, state *state, packet encoding.Packet
 			msg.TxAcknowledgment.CorrelationIds = downlink.CorrelationIds
 			rtt = &delta
 		}
+		state.io.CaptureUp(raw, msg)
 		if err := state.io.HandleTxAck(msg.TxAcknowledgment); err != nil {
 			logger.WithError(err).Warn("Failed to handle Tx acknowledgment")
 		}
@@ -405,10 +420,13 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 				logger.Debug("Write downlink message")
 				token := state.tokens.Next(down, time.Now())
 				packet.Token = [2]byte{byte(token >> 8), byte(token)}
-				if err := s.write(packet); err != nil {
+				raw, err := s.write(packet)
+				if err != nil {
 					logger.WithError(err).Warn("Failed to write downlink message")
 					// TODO: Report to Network Server: https://github.com/TheThingsNetwork/lorawan-stack/issues/76
+					return
 				}
+				state.io.CaptureDown(raw, &ttnpb.GatewayDown{DownlinkMessage: down})
 			}
 			canImmediate := atomic.LoadUint32(&state.receivedTxAck) == 1
 			forceLate := state.io.Gateway().ScheduleDownlinkLate
@@ -438,13 +456,15 @@ func (s *srv) handleDown(ctx context.Context, state *state) error {
 	}
 }
 
-func (s *srv) write(packet encoding.Packet) error {
+func (s *srv) write(packet encoding.Packet) ([]byte, error) {
 	buf, err := packet.MarshalBinary()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	_, err = s.conn.WriteToUDP(buf, packet.GatewayAddr)
-	return err
+	if _, err := s.conn.WriteToUDP(buf, packet.GatewayAddr); err != nil {
+		return nil, err
+	}
+	return buf, nil
 }
 
 func (s *srv) writeAckFor(packet encoding.Packet) error {
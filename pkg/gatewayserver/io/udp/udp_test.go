@@ -639,3 +639,91 @@ func TestTraffic(t *testing.T) {
 
 	cancelCtx()
 }
+
+func TestBeacon(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, _, closeIS := mockis.New(ctx)
+	defer closeIS()
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	gs := mock.NewServer(c, is)
+	addr, _ := net.ResolveUDPAddr("udp", ":0")
+	lis, err := net.ListenUDP("udp", addr)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	go Serve(ctx, gs, lis, testConfig)
+
+	udpConn, err := net.Dial("udp", lis.LocalAddr().String())
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	eui := types.EUI64{0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04, 0x04}
+	write := func(packet encoding.Packet, token [2]byte) {
+		buf, err := packet.MarshalBinary()
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		copy(buf[1:], token[:])
+		if _, err := udpConn.Write(buf); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	write(generatePullData(eui), [2]byte{0x00, 0x01})
+	expectAck(t, udpConn, true, encoding.PullAck, [2]byte{0x00, 0x01})
+	conn := expectConnection(t, gs, &sync.Map{}, eui, true)
+
+	// Acknowledge a transmission so that the beacon is written immediately.
+	write(generateTxAck(eui, encoding.TxErrNone), [2]byte{0x00, 0x02})
+	select {
+	case <-conn.TxAck():
+	case <-time.After(timeout):
+		t.Fatal("Receive expected TxAck timeout")
+	}
+
+	now := time.Now()
+	conn.SyncWithGatewayConcentrator(100, now, &now, scheduling.ConcentratorTime(100*time.Microsecond))
+	periodStart := now.Add(10 * time.Second).Truncate(time.Second)
+	if err := conn.ScheduleBeacon(periodStart); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	var buf [65507]byte
+	udpConn.SetReadDeadline(time.Now().Add(timeout))
+	n, err := udpConn.Read(buf[:])
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var response encoding.Packet
+	if err := response.UnmarshalBinary(buf[:n]); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(response.PacketType, should.Equal, encoding.PullResp)
+	// Beacons are transmitted with implicit header, without CRC and with a 10 symbol preamble.
+	for _, field := range []string{`"nhdr":true`, `"ncrc":true`, `"prea":10`, `"ipol":false`} {
+		a.So(string(buf[4:n]), should.ContainSubstring, field)
+	}
+	tx := response.Data.TxPacket
+	if a.So(tx, should.NotBeNil) {
+		a.So(tx.Tmms, should.NotBeNil)
+		a.So(tx.Freq, should.Equal, 869.525)
+		a.So(tx.Size, should.Equal, 17)
+	}
+}
@@ -50,3 +50,17 @@ type Formatter interface {
 	// TransferTime generates a spurious time transfer message for a particular server time.
 	TransferTime(ctx context.Context, serverTime time.Time, gpsTime *time.Time, concentratorTime *scheduling.ConcentratorTime) ([]byte, error)
 }
+
+// RemoteShellFormatter is implemented by formatters of protocols that support remote commands and remote shells.
+type RemoteShellFormatter interface {
+	// FromRemoteCommand generates a message that runs the command on the gateway.
+	FromRemoteCommand(ctx context.Context, command string, arguments []string) ([]byte, error)
+	// FromRemoteShellStart generates a message that starts the remote shell session with the given index.
+	FromRemoteShellStart(ctx context.Context, index int, user, term string) ([]byte, error)
+	// FromRemoteShellStop generates a message that stops the remote shell session with the given index.
+	FromRemoteShellStop(ctx context.Context, index int) ([]byte, error)
+	// FromRemoteShellInput generates a binary message with the input of the remote shell session.
+	FromRemoteShellInput(ctx context.Context, index int, input []byte) ([]byte, error)
+	// ToRemoteShellOutput parses a binary message with the output of a remote shell session.
+	ToRemoteShellOutput(ctx context.Context, raw []byte) (index int, output []byte, err error)
+}
@@ -79,6 +79,10 @@ func (f *lbsLNS) FromDownlink(
 	ctx context.Context, down *ttnpb.DownlinkMessage, bandID string, dlTime time.Time,
 ) ([]byte, error) {
 	settings := down.GetScheduled()
+	// The dnmsg message has no fields for the PHY header, so frames like Class B beacons cannot be encoded.
+	if settings.GetDownlink().GetImplicitHeader() || settings.GetDownlink().GetPreambleLength() != 0 {
+		return nil, errPHYHeader.New()
+	}
 	dnmsg := DownlinkMessage{
 		DevEUI:   "00-00-00-00-00-00-00-01", // The DevEUI is required for transmission acknowledgements.
 		Diid:     int64(f.tokens.Next(down, dlTime)),
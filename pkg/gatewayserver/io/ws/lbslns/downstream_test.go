@@ -21,6 +21,7 @@ import (
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -145,6 +146,35 @@ func TestFromDownlinkMessage(t *testing.T) {
 	}
 }
 
+func TestFromDownlinkMessageImplicitHeader(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx = ws.NewContextWithSession(ctx, &ws.Session{})
+	var lbsLNS lbsLNS
+	_, err := lbsLNS.FromDownlink(ctx, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x00, 0x00, 0x01, 0x02, 0x03, 0x04},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{
+			Scheduled: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 9,
+							Bandwidth:       125000,
+							CodingRate:      band.Cr4_5,
+						},
+					},
+				},
+				Frequency: 869525000,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					ImplicitHeader: true,
+					PreambleLength: band.BeaconPreambleLength,
+				},
+				Time: ttnpb.ProtoTimePtr(time.Unix(0x42424242, 0)),
+			},
+		},
+	}, band.EU_863_870, time.Unix(1554300787, 123456000))
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+}
+
 func TestToDownlinkMessage(t *testing.T) {
 	for _, tc := range []struct {
 		BandID,
@@ -25,6 +25,7 @@ import (
 
 var (
 	errSessionStateNotFound = errors.DefineUnavailable("session_state_not_found", "session state not found")
+	errPHYHeader            = errors.DefineFailedPrecondition("phy_header", "implicit header and preamble length not supported")
 	trafficEndPointPrefix   = "/traffic"
 )
 
@@ -0,0 +1,146 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// featureRemoteShell is the feature advertised by gateways that support remote shells.
+const featureRemoteShell = "rmtsh"
+
+var (
+	errRemoteShellIndex  = errors.DefineInvalidArgument("remote_shell_index", "invalid remote shell session index `{index}`")
+	errRemoteShellOutput = errors.DefineInvalidArgument("remote_shell_output", "invalid remote shell output")
+)
+
+// RemoteCommand is a command that the gateway runs.
+// This message is sent by the Gateway Server.
+type RemoteCommand struct {
+	Command   string   `json:"command"`
+	Arguments []string `json:"arguments,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c RemoteCommand) MarshalJSON() ([]byte, error) {
+	type Alias RemoteCommand
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteCommand,
+		Alias: Alias(c),
+	})
+}
+
+// RemoteShellControl starts or stops a remote shell session.
+// This message is sent by the Gateway Server.
+type RemoteShellControl struct {
+	User  string `json:"user,omitempty"`
+	Term  string `json:"term,omitempty"`
+	Start *int   `json:"start,omitempty"`
+	Stop  *int   `json:"stop,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c RemoteShellControl) MarshalJSON() ([]byte, error) {
+	type Alias RemoteShellControl
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteShell,
+		Alias: Alias(c),
+	})
+}
+
+// RemoteShellSessionStatus is the status of a remote shell session.
+type RemoteShellSessionStatus struct {
+	User    string `json:"user"`
+	Started bool   `json:"started"`
+	Age     int    `json:"age"`
+	PID     int    `json:"pid"`
+}
+
+// RemoteShellStatus contains the status of the remote shell sessions, by session index.
+// This message is sent by the gateway.
+type RemoteShellStatus struct {
+	Sessions []RemoteShellSessionStatus `json:"rmtsh"`
+}
+
+// Running returns whether the remote shell sessions are running, by session index.
+func (s RemoteShellStatus) Running() []bool {
+	running := make([]bool, len(s.Sessions))
+	for i, session := range s.Sessions {
+		running[i] = session.Started
+	}
+	return running
+}
+
+// FromRemoteCommand implements ws.RemoteShellFormatter.
+func (*lbsLNS) FromRemoteCommand(_ context.Context, command string, arguments []string) ([]byte, error) {
+	return RemoteCommand{
+		Command:   command,
+		Arguments: arguments,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellStart implements ws.RemoteShellFormatter.
+func (*lbsLNS) FromRemoteShellStart(_ context.Context, index int, user, term string) ([]byte, error) {
+	if index < 0 || index > 0xff {
+		return nil, errRemoteShellIndex.WithAttributes("index", index)
+	}
+	return RemoteShellControl{
+		User:  user,
+		Term:  term,
+		Start: &index,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellStop implements ws.RemoteShellFormatter.
+func (*lbsLNS) FromRemoteShellStop(_ context.Context, index int) ([]byte, error) {
+	if index < 0 || index > 0xff {
+		return nil, errRemoteShellIndex.WithAttributes("index", index)
+	}
+	return RemoteShellControl{
+		Stop: &index,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellInput implements ws.RemoteShellFormatter.
+// The binary message starts with the session index, followed by the input.
+func (*lbsLNS) FromRemoteShellInput(_ context.Context, index int, input []byte) ([]byte, error) {
+	if index < 0 || index > 0xff {
+		return nil, errRemoteShellIndex.WithAttributes("index", index)
+	}
+	b := make([]byte, 1+len(input))
+	b[0] = byte(index)
+	copy(b[1:], input)
+	return b, nil
+}
+
+// ToRemoteShellOutput implements ws.RemoteShellFormatter.
+// The binary message starts with the session index, followed by the output.
+func (*lbsLNS) ToRemoteShellOutput(_ context.Context, raw []byte) (int, []byte, error) {
+	if len(raw) < 1 {
+		return 0, nil, errRemoteShellOutput.New()
+	}
+	output := make([]byte, len(raw)-1)
+	copy(output, raw[1:])
+	return int(raw[0]), output, nil
+}
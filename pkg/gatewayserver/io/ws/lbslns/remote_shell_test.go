@@ -0,0 +1,56 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRemoteShellFraming(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	f := &lbsLNS{}
+
+	b, err := f.FromRemoteShellInput(ctx, 3, []byte("uptime\n"))
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, append([]byte{0x03}, "uptime\n"...))
+
+	_, err = f.FromRemoteShellInput(ctx, 256, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	index, output, err := f.ToRemoteShellOutput(ctx, b)
+	a.So(err, should.BeNil)
+	a.So(index, should.Equal, 3)
+	a.So(output, should.Resemble, []byte("uptime\n"))
+
+	_, _, err = f.ToRemoteShellOutput(ctx, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestRemoteShellStatus(t *testing.T) {
+	a := assertions.New(t)
+	var status RemoteShellStatus
+	err := json.Unmarshal([]byte(`{"msgtype":"rmtsh","rmtsh":[{"user":"root","started":true,"age":10,"pid":42},{"user":"","started":false,"age":0,"pid":0}]}`), &status)
+	a.So(err, should.BeNil)
+	a.So(status.Sessions, should.HaveLength, 2)
+	a.So(status.Sessions[0].PID, should.Equal, 42)
+	a.So(status.Running(), should.Resemble, []bool{true, false})
+}
@@ -484,6 +484,17 @@ func (conf TxConfirmation) ToTxAck(ctx context.Context, tokens io.DownlinkTokens
 // HandleUp implements Formatter.
 func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIdentifiers, conn *io.Connection, receivedAt time.Time) ([]byte, error) {
 	logger := log.FromContext(ctx)
+	// Capture the message before it is handled, as the handled messages are owned by the connection.
+	captured := false
+	capture := func(msg *ttnpb.GatewayUp) {
+		captured = true
+		conn.CaptureUp(raw, msg)
+	}
+	defer func() {
+		if !captured {
+			conn.CaptureUp(raw, nil)
+		}
+	}()
 	typ, err := Type(raw)
 	if err != nil {
 		logger.WithError(err).Debug("Failed to parse message type")
@@ -544,6 +555,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIde
 			return nil, err
 		}
 		logger = log.FromContext(ctx)
+		capture(&ttnpb.GatewayUp{GatewayStatus: stat})
 		if err := conn.HandleStatus(stat); err != nil {
 			logger.WithError(err).Warn("Failed to handle status message")
 			return nil, err
@@ -567,6 +579,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIde
 		}
 		ws.UpdateSessionID(ctx, ws.SessionIDFromXTime(jreq.UpInfo.XTime))
 		ct := recordTime(jreq.RefTime, jreq.UpInfo.XTime, jreq.UpInfo.GPSTime, jreq.UpInfo.RxTime)
+		capture(&ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{up}})
 		if err := conn.HandleUp(up, ct); err != nil {
 			logger.WithError(err).Warn("Failed to handle upstream message")
 		}
@@ -588,6 +601,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIde
 		}
 		ws.UpdateSessionID(ctx, ws.SessionIDFromXTime(updf.UpInfo.XTime))
 		ct := recordTime(updf.RefTime, updf.UpInfo.XTime, updf.UpInfo.GPSTime, updf.UpInfo.RxTime)
+		capture(&ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{up}})
 		if err := conn.HandleUp(up, ct); err != nil {
 			logger.WithError(err).Warn("Failed to handle upstream message")
 		}
@@ -601,6 +615,7 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIde
 		if txAck == nil {
 			break
 		}
+		capture(&ttnpb.GatewayUp{TxAcknowledgment: txAck})
 		if err := conn.HandleTxAck(txAck); err != nil {
 			logger.WithError(err).Warn("Failed to handle tx ack message")
 			return nil, err
@@ -623,7 +638,15 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIde
 		}
 		return req.Response(receivedAt).MarshalJSON()
 
-	case TypeUpstreamProprietaryDataFrame, TypeUpstreamRemoteShell:
+	case TypeUpstreamRemoteShell:
+		var status RemoteShellStatus
+		if err := json.Unmarshal(raw, &status); err != nil {
+			logger.WithError(err).Debug("Failed to unmarshal remote shell status")
+			return nil, err
+		}
+		ws.HandleRemoteShellStatus(ctx, status.Running())
+
+	case TypeUpstreamProprietaryDataFrame:
 		logger.WithField("message_type", typ).Debug("Message type not implemented")
 
 	default:
@@ -58,6 +58,16 @@ func (v Version) IsProduction() bool {
 	return strings.Contains(v.Features, "prod")
 }
 
+// HasFeature checks whether the features field contains the given feature.
+func (v Version) HasFeature(feature string) bool {
+	for _, f := range strings.Fields(v.Features) {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
 // GetRouterConfig gets router config for the particular version message.
 func (*lbsLNS) GetRouterConfig(
 	ctx context.Context,
@@ -76,6 +86,7 @@ func (*lbsLNS) GetRouterConfig(
 	// to gateways that signal the presence of a PPS.
 	// References https://github.com/lorabasics/basicstation/issues/135.
 	ws.UpdateSessionTimeSync(ctx, true)
+	ws.UpdateSessionRemoteShell(ctx, version.HasFeature(featureRemoteShell))
 	cfg, err := pfconfig.GetRouterConfig(bandID, fps, version, time.Now(), antennaGain)
 	if err != nil {
 		return ctx, nil, nil, err
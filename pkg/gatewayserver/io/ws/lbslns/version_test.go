@@ -79,3 +79,12 @@ func TestIsProduction(t *testing.T) {
 		})
 	}
 }
+
+func TestHasFeature(t *testing.T) {
+	a := assertions.New(t)
+	v := Version{Features: "prod gps rmtsh"}
+	a.So(v.HasFeature("rmtsh"), should.BeTrue)
+	a.So(v.HasFeature("gps"), should.BeTrue)
+	a.So(v.HasFeature("rmt"), should.BeFalse)
+	a.So(Version{}.HasFeature("rmtsh"), should.BeFalse)
+}
@@ -0,0 +1,303 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+// maxRemoteShellSessions is the maximum number of concurrent remote shell sessions per gateway.
+const maxRemoteShellSessions = 2
+
+// remoteShellOutputBuffer is the number of output messages buffered per remote shell session.
+const remoteShellOutputBuffer = 64
+
+var (
+	errRemoteShellUnavailable = errors.DefineFailedPrecondition(
+		"remote_shell_unavailable", "remote shell is not available on the gateway",
+	)
+	errRemoteShellSessionsExhausted = errors.DefineResourceExhausted(
+		"remote_shell_sessions_exhausted", "no more than `{max}` remote shell sessions can be open at the same time",
+	)
+	errRemoteShellSessionClosed  = errors.DefineAborted("remote_shell_session_closed", "remote shell session closed")
+	errRemoteShellOutputOverflow = errors.DefineResourceExhausted(
+		"remote_shell_output_overflow", "remote shell output exceeds buffer of `{max}` messages",
+	)
+	errConnectionClosed = errors.DefineUnavailable("connection_closed", "gateway connection closed")
+)
+
+// wsMessage is a message that is written to the websocket.
+type wsMessage struct {
+	typ  int
+	data []byte
+}
+
+// remoteShell implements io.RemoteShell by multiplexing remote commands and remote shell sessions
+// over the websocket connection of the gateway.
+type remoteShell struct {
+	ctx       context.Context
+	formatter RemoteShellFormatter
+	writeCh   chan wsMessage
+
+	mu       sync.Mutex
+	sessions [maxRemoteShellSessions]*remoteShellSession
+}
+
+var _ io.RemoteShell = (*remoteShell)(nil)
+
+func newRemoteShell(ctx context.Context, formatter RemoteShellFormatter) *remoteShell {
+	rs := &remoteShell{
+		ctx:       ctx,
+		formatter: formatter,
+		writeCh:   make(chan wsMessage),
+	}
+	updateState(ctx, func(st *state) {
+		st.remoteShell = rs
+	})
+	return rs
+}
+
+func (rs *remoteShell) available() error {
+	if enabled, ok := GetSessionRemoteShell(rs.ctx); !ok || !enabled {
+		return errRemoteShellUnavailable.New()
+	}
+	return nil
+}
+
+func (rs *remoteShell) write(ctx context.Context, msg wsMessage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-rs.ctx.Done():
+		return errConnectionClosed.New()
+	case rs.writeCh <- msg:
+		return nil
+	}
+}
+
+// RunCommand implements io.RemoteShell.
+func (rs *remoteShell) RunCommand(ctx context.Context, command string, arguments []string) error {
+	if err := rs.available(); err != nil {
+		return err
+	}
+	b, err := rs.formatter.FromRemoteCommand(rs.ctx, command, arguments)
+	if err != nil {
+		return err
+	}
+	return rs.write(ctx, wsMessage{typ: websocket.TextMessage, data: b})
+}
+
+// OpenShell implements io.RemoteShell.
+func (rs *remoteShell) OpenShell(ctx context.Context, user, term string) (io.RemoteShellSession, error) {
+	if err := rs.available(); err != nil {
+		return nil, err
+	}
+	rs.mu.Lock()
+	index := -1
+	for i, s := range rs.sessions {
+		if s == nil {
+			index = i
+			break
+		}
+	}
+	if index == -1 {
+		rs.mu.Unlock()
+		return nil, errRemoteShellSessionsExhausted.WithAttributes("max", maxRemoteShellSessions)
+	}
+	s := &remoteShellSession{
+		rs:     rs,
+		index:  index,
+		output: make(chan []byte, remoteShellOutputBuffer),
+		done:   make(chan struct{}),
+	}
+	rs.sessions[index] = s
+	rs.mu.Unlock()
+
+	b, err := rs.formatter.FromRemoteShellStart(rs.ctx, index, user, term)
+	if err == nil {
+		err = rs.write(ctx, wsMessage{typ: websocket.TextMessage, data: b})
+	}
+	if err != nil {
+		s.end(nil)
+		return nil, err
+	}
+	return s, nil
+}
+
+// session returns the session with the given index, or nil if there is no such session.
+func (rs *remoteShell) session(index int) *remoteShellSession {
+	if index < 0 || index >= maxRemoteShellSessions {
+		return nil
+	}
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.sessions[index]
+}
+
+// release removes the session from the session table.
+func (rs *remoteShell) release(s *remoteShellSession) {
+	rs.mu.Lock()
+	if rs.sessions[s.index] == s {
+		rs.sessions[s.index] = nil
+	}
+	rs.mu.Unlock()
+}
+
+// handleOutput handles a binary message with remote shell output from the gateway.
+// The output is not awaited, since that would block the traffic of the gateway. Instead, the session fails if the
+// consumer of the output does not keep up with the gateway.
+func (rs *remoteShell) handleOutput(raw []byte) {
+	logger := log.FromContext(rs.ctx)
+	index, output, err := rs.formatter.ToRemoteShellOutput(rs.ctx, raw)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to parse remote shell output")
+		return
+	}
+	s := rs.session(index)
+	if s == nil {
+		logger.WithField("index", index).Debug("Drop remote shell output of unknown session")
+		return
+	}
+	select {
+	case <-s.done:
+	case s.output <- output:
+	default:
+		logger.WithField("index", index).Warn("Remote shell output buffer full, end session")
+		s.fail(errRemoteShellOutputOverflow.WithAttributes("max", remoteShellOutputBuffer))
+	}
+}
+
+// handleStatus handles the status of the remote shell sessions reported by the gateway.
+// Sessions that have been running before and that are no longer running are ended.
+func (rs *remoteShell) handleStatus(running []bool) {
+	for i := 0; i < maxRemoteShellSessions; i++ {
+		s := rs.session(i)
+		if s == nil {
+			continue
+		}
+		if i < len(running) && running[i] {
+			s.mu.Lock()
+			s.started = true
+			s.mu.Unlock()
+			continue
+		}
+		s.mu.Lock()
+		started := s.started
+		s.mu.Unlock()
+		if started {
+			s.end(nil)
+		}
+	}
+}
+
+// closeAll ends all remote shell sessions. This is called when the gateway disconnects.
+func (rs *remoteShell) closeAll() {
+	for i := 0; i < maxRemoteShellSessions; i++ {
+		if s := rs.session(i); s != nil {
+			s.end(errConnectionClosed.New())
+		}
+	}
+}
+
+// remoteShellSession implements io.RemoteShellSession.
+type remoteShellSession struct {
+	rs     *remoteShell
+	index  int
+	output chan []byte
+	done   chan struct{}
+
+	mu      sync.Mutex
+	started bool
+	err     error
+	endOnce sync.Once
+}
+
+var _ io.RemoteShellSession = (*remoteShellSession)(nil)
+
+// end ends the session with the given error without notifying the gateway.
+// It returns whether the session was ended by this call.
+func (s *remoteShellSession) end(err error) (ended bool) {
+	s.endOnce.Do(func() {
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+		close(s.done)
+		s.rs.release(s)
+		ended = true
+	})
+	return ended
+}
+
+// fail ends the session with the given error and stops the shell on the gateway.
+func (s *remoteShellSession) fail(err error) {
+	if !s.end(err) {
+		return
+	}
+	go func() {
+		if err := s.stop(); err != nil {
+			log.FromContext(s.rs.ctx).WithError(err).Debug("Failed to stop remote shell session")
+		}
+	}()
+}
+
+// stop stops the shell on the gateway.
+func (s *remoteShellSession) stop() error {
+	b, err := s.rs.formatter.FromRemoteShellStop(s.rs.ctx, s.index)
+	if err != nil {
+		return err
+	}
+	return s.rs.write(s.rs.ctx, wsMessage{typ: websocket.TextMessage, data: b})
+}
+
+// Write implements io.RemoteShellSession.
+func (s *remoteShellSession) Write(input []byte) error {
+	select {
+	case <-s.done:
+		return errRemoteShellSessionClosed.New()
+	default:
+	}
+	b, err := s.rs.formatter.FromRemoteShellInput(s.rs.ctx, s.index, input)
+	if err != nil {
+		return err
+	}
+	return s.rs.write(s.rs.ctx, wsMessage{typ: websocket.BinaryMessage, data: b})
+}
+
+// Output implements io.RemoteShellSession.
+func (s *remoteShellSession) Output() <-chan []byte { return s.output }
+
+// Done implements io.RemoteShellSession.
+func (s *remoteShellSession) Done() <-chan struct{} { return s.done }
+
+// Err implements io.RemoteShellSession.
+func (s *remoteShellSession) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Close implements io.RemoteShellSession.
+func (s *remoteShellSession) Close() error {
+	if !s.end(nil) {
+		return nil
+	}
+	return s.stop()
+}
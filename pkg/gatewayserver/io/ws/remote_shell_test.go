@@ -0,0 +1,267 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRemoteShell(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, _, cancelIS := mockis.New(ctx)
+	defer cancelIS()
+	testGtw := mockis.DefaultGateway(registeredGatewayID, false, false)
+	is.GatewayRegistry().Add(ctx, registeredGatewayID, registeredGatewayToken, testGtw, testRights...)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	gs := mock.NewServer(c, is)
+	gs.RegisterGateway(ctx, registeredGatewayID, &ttnpb.Gateway{
+		Ids:             registeredGatewayID,
+		FrequencyPlanId: test.EUFrequencyPlanID,
+	})
+
+	web, err := New(ctx, gs, lbslns.NewFormatter(maxValidRoundTripDelay), defaultConfig)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	lis, err := net.Listen("tcp", serverAddress)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lis.Close()
+	go func() error {
+		return http.Serve(lis, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			web.ServeHTTP(w, r)
+		}))
+	}()
+	servAddr := fmt.Sprintf("ws://%s", lis.Addr().String())
+
+	conn, _, err := websocket.DefaultDialer.Dial(servAddr+testTrafficEndPoint, nil)
+	if !a.So(err, should.BeNil) {
+		t.Fatalf("Connection failed: %v", err)
+	}
+	defer conn.Close()
+
+	var gsConn *io.Connection
+	select {
+	case gsConn = <-gs.Connections():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+	go func() {
+		for {
+			select {
+			case <-gsConn.Context().Done():
+				return
+			case <-gsConn.Status():
+			}
+		}
+	}()
+
+	readMessage := func(t *testing.T) (int, []byte) {
+		t.Helper()
+		conn.SetReadDeadline(time.Now().Add(timeout))
+		typ, data, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("Failed to read message: %v", err)
+		}
+		return typ, data
+	}
+	writeMessage := func(t *testing.T, typ int, data []byte) {
+		t.Helper()
+		if err := conn.WriteMessage(typ, data); err != nil {
+			t.Fatalf("Failed to write message: %v", err)
+		}
+	}
+
+	rs, err := gsConn.RemoteShell()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The gateway did not advertise the remote shell feature yet.
+	err = rs.RunCommand(ctx, "reboot", nil)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	version, err := json.Marshal(lbslns.Version{
+		Station:  "test-station",
+		Firmware: "1.0.0",
+		Package:  "test-package",
+		Model:    "test-model",
+		Protocol: 2,
+		Features: "rmtsh gps",
+	})
+	if err != nil {
+		panic(err)
+	}
+	writeMessage(t, websocket.TextMessage, version)
+	readMessage(t) // router_config
+
+	var sessions [2]io.RemoteShellSession
+	t.Run("RunCommand", func(t *testing.T) {
+		a := assertions.New(t)
+		if !a.So(rs.RunCommand(ctx, "reboot", []string{"now"}), should.BeNil) {
+			t.FailNow()
+		}
+		typ, data := readMessage(t)
+		a.So(typ, should.Equal, websocket.TextMessage)
+		a.So(string(data), should.Equal, `{"msgtype":"runcmd","command":"reboot","arguments":["now"]}`)
+	})
+
+	t.Run("OpenShell", func(t *testing.T) {
+		a := assertions.New(t)
+		for i := range sessions {
+			session, err := rs.OpenShell(ctx, "root", "xterm")
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			sessions[i] = session
+			typ, data := readMessage(t)
+			a.So(typ, should.Equal, websocket.TextMessage)
+			a.So(string(data), should.Equal, fmt.Sprintf(`{"msgtype":"rmtsh","user":"root","term":"xterm","start":%d}`, i))
+		}
+		_, err := rs.OpenShell(ctx, "root", "xterm")
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	})
+
+	t.Run("Input", func(t *testing.T) {
+		a := assertions.New(t)
+		if !a.So(sessions[1].Write([]byte("ls\n")), should.BeNil) {
+			t.FailNow()
+		}
+		typ, data := readMessage(t)
+		a.So(typ, should.Equal, websocket.BinaryMessage)
+		a.So(data, should.Resemble, []byte{0x01, 'l', 's', '\n'})
+	})
+
+	t.Run("Output", func(t *testing.T) {
+		a := assertions.New(t)
+		writeMessage(t, websocket.BinaryMessage, []byte{0x01, 'o', 'k'})
+		select {
+		case output := <-sessions[1].Output():
+			a.So(output, should.Resemble, []byte("ok"))
+		case <-time.After(timeout):
+			t.Fatal("Output timeout")
+		}
+	})
+
+	t.Run("Status", func(t *testing.T) {
+		a := assertions.New(t)
+		writeMessage(t, websocket.TextMessage, []byte(`{"msgtype":"rmtsh","rmtsh":[{"user":"root","started":true,"age":1,"pid":42},{"user":"root","started":false,"age":0,"pid":0}]}`))
+		writeMessage(t, websocket.TextMessage, []byte(`{"msgtype":"rmtsh","rmtsh":[{"user":"","started":false,"age":0,"pid":0},{"user":"root","started":false,"age":0,"pid":0}]}`))
+		select {
+		case <-sessions[0].Done():
+		case <-time.After(timeout):
+			t.Fatal("Session end timeout")
+		}
+		select {
+		case <-sessions[1].Done():
+			t.Fatal("Session that did not start ended")
+		default:
+		}
+		a.So(sessions[0].Write([]byte("ls\n")), should.NotBeNil)
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		a := assertions.New(t)
+		if !a.So(sessions[1].Close(), should.BeNil) {
+			t.FailNow()
+		}
+		typ, data := readMessage(t)
+		a.So(typ, should.Equal, websocket.TextMessage)
+		a.So(string(data), should.Equal, `{"msgtype":"rmtsh","stop":1}`)
+		select {
+		case <-sessions[1].Done():
+		default:
+			t.Fatal("Closed session not done")
+		}
+	})
+
+	t.Run("OutputOverflow", func(t *testing.T) {
+		a := assertions.New(t)
+		session, err := rs.OpenShell(ctx, "root", "xterm")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		readMessage(t)
+		// The output is not consumed, so the session fails once the output buffer is full.
+		for i := 0; i <= 64; i++ {
+			writeMessage(t, websocket.BinaryMessage, []byte{0x00, 'o', 'k'})
+		}
+		typ, data := readMessage(t)
+		a.So(typ, should.Equal, websocket.TextMessage)
+		a.So(string(data), should.Equal, `{"msgtype":"rmtsh","stop":0}`)
+		select {
+		case <-session.Done():
+		case <-time.After(timeout):
+			t.Fatal("Session end timeout")
+		}
+		a.So(errors.IsResourceExhausted(session.Err()), should.BeTrue)
+	})
+
+	t.Run("Disconnect", func(t *testing.T) {
+		a := assertions.New(t)
+		session, err := rs.OpenShell(ctx, "root", "xterm")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		readMessage(t)
+		conn.Close()
+		select {
+		case <-session.Done():
+		case <-time.After(timeout):
+			t.Fatal("Session end timeout")
+		}
+	})
+}
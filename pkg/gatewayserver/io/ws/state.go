@@ -20,8 +20,11 @@ import (
 
 // state represents the LBS session state.
 type state struct {
-	ID       *int32
-	TimeSync *bool
+	ID          *int32
+	TimeSync    *bool
+	RemoteShell *bool
+
+	remoteShell *remoteShell
 }
 
 // updateState updates the session state.
@@ -84,3 +87,36 @@ func GetSessionTimeSync(ctx context.Context) (enabled bool, ok bool) {
 	}).(bool)
 	return d, ok
 }
+
+// UpdateSessionRemoteShell updates whether the gateway supports remote shell sessions.
+func UpdateSessionRemoteShell(ctx context.Context, b bool) {
+	updateState(ctx, func(st *state) {
+		st.RemoteShell = &b
+	})
+}
+
+// GetSessionRemoteShell returns whether the gateway supports remote shell sessions.
+func GetSessionRemoteShell(ctx context.Context) (enabled bool, ok bool) {
+	d, ok := getState(ctx, func(st *state) interface{} {
+		if st.RemoteShell != nil {
+			return *st.RemoteShell
+		}
+		return nil
+	}).(bool)
+	return d, ok
+}
+
+// HandleRemoteShellStatus handles the status of the remote shell sessions reported by the gateway.
+// The status contains whether the session with the index is running.
+func HandleRemoteShellStatus(ctx context.Context, running []bool) {
+	rs, ok := getState(ctx, func(st *state) interface{} {
+		if st.remoteShell != nil {
+			return st.remoteShell
+		}
+		return nil
+	}).(*remoteShell)
+	if !ok {
+		return
+	}
+	rs.handleStatus(running)
+}
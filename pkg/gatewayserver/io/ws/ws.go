@@ -59,8 +59,9 @@ type srv struct {
 	formatter Formatter
 }
 
-func (*srv) Protocol() string            { return "ws" }
-func (*srv) SupportsDownlinkClaim() bool { return false }
+func (*srv) Protocol() string               { return "ws" }
+func (*srv) SupportsDownlinkClaim() bool    { return false }
+func (*srv) SupportsListenBeforeTalk() bool { return false }
 func (*srv) DutyCycleStyle() scheduling.DutyCycleStyle {
 	return scheduling.DutyCycleStyleBlockingWindow
 }
@@ -302,6 +303,17 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 		return nil
 	})
 
+	var (
+		rs            *remoteShell
+		remoteShellCh <-chan wsMessage
+	)
+	if f, ok := s.formatter.(RemoteShellFormatter); ok {
+		rs = newRemoteShell(conn.Context(), f)
+		remoteShellCh = rs.writeCh
+		conn.SetRemoteShell(rs)
+		defer rs.closeAll()
+	}
+
 	var timeSyncTickerC <-chan time.Time
 	if s.cfg.TimeSyncInterval > 0 {
 		ticker := time.NewTicker(random.Jitter(s.cfg.TimeSyncInterval, 0.1))
@@ -353,6 +365,7 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 					logger.WithError(err).Warn("Failed to transfer time")
 					return err
 				}
+				conn.CaptureDown(b, nil)
 			case down := <-conn.Down():
 				dnmsg, err := s.formatter.FromDownlink(ctx, down, conn.BandID(), time.Now())
 				if err != nil {
@@ -363,11 +376,18 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 					logger.WithError(err).Warn("Failed to send downlink message")
 					return err
 				}
+				conn.CaptureDown(dnmsg, &ttnpb.GatewayDown{DownlinkMessage: down})
 			case downstream := <-downstreamCh:
 				if err := ws.WriteMessage(websocket.TextMessage, downstream); err != nil {
 					logger.WithError(err).Warn("Failed to send message downstream")
 					return err
 				}
+				conn.CaptureDown(downstream, nil)
+			case msg := <-remoteShellCh:
+				if err := ws.WriteMessage(msg.typ, msg.data); err != nil {
+					logger.WithError(err).Warn("Failed to send remote shell message")
+					return err
+				}
 			}
 		}
 	}()
@@ -378,11 +398,17 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 			logger.WithError(err).Warn("Terminate connection")
 			return err
 		}
-		_, data, err := ws.ReadMessage()
+		typ, data, err := ws.ReadMessage()
 		if err != nil {
 			logger.WithError(err).Debug("Failed to read message")
 			return err
 		}
+		if typ == websocket.BinaryMessage {
+			if rs != nil {
+				rs.handleOutput(data)
+			}
+			continue
+		}
 		downstream, err := s.formatter.HandleUp(ctx, data, ids, conn, time.Now())
 		if err != nil {
 			return err
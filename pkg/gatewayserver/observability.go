@@ -113,6 +113,53 @@ var (
 		"gs.txack.forward", "forward transmission acknowledgement",
 		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ),
 	)
+	evtRunRemoteCommand = events.Define(
+		"gs.gateway.remote_command.run", "run remote command on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+		events.WithDataType(&ttnpb.GatewayRemoteCommandRequest{}),
+	)
+	evtOpenRemoteShell = events.Define(
+		"gs.gateway.remote_shell.open", "open remote shell session on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+		events.WithDataType(&ttnpb.GatewayRemoteShellRequest_Start{}),
+	)
+	evtRemoteShellInput = events.Define(
+		"gs.gateway.remote_shell.input", "send input to remote shell session on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+		events.WithDataType(&ttnpb.GatewayRemoteShellRequest{}),
+	)
+	evtRemoteShellOutput = events.Define(
+		"gs.gateway.remote_shell.output", "receive output of remote shell session on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+		events.WithDataType(&ttnpb.GatewayRemoteShellResponse{}),
+	)
+	evtCloseRemoteShell = events.Define(
+		"gs.gateway.remote_shell.close", "close remote shell session on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+		events.WithErrorDataType(),
+	)
+	evtStartTrafficCapture = events.Define(
+		"gs.gateway.traffic_capture.start", "start gateway traffic capture",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+		events.WithDataType(&ttnpb.GatewayTrafficCapture{}),
+	)
+	evtStopTrafficCapture = events.Define(
+		"gs.gateway.traffic_capture.stop", "stop gateway traffic capture",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.GatewayTrafficCapture{}),
+	)
 )
 
 const (
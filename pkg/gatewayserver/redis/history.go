@@ -0,0 +1,175 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayConnectionStatsHistory implements the GatewayConnectionStatsHistoryStore interface.
+// Snapshots are stored in a sorted set per gateway, scored by their start time in milliseconds.
+// Snapshots older than RawRetention are downsampled to snapshots of DownsampleInterval,
+// which are stored in a separate sorted set per gateway and kept for Retention.
+// If DownsampleInterval is zero, snapshots older than RawRetention are removed.
+// Downsampling happens when a snapshot is recorded. Since a gateway that disconnects records no more snapshots, its
+// raw snapshots are kept for Retention, so that they are downsampled when the gateway reconnects and are not lost
+// otherwise.
+type GatewayConnectionStatsHistory struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+
+	RawRetention       time.Duration
+	DownsampleInterval time.Duration
+	Retention          time.Duration
+}
+
+// Init initializes the GatewayConnectionStatsHistory.
+func (r *GatewayConnectionStatsHistory) Init(ctx context.Context) error {
+	if err := ttnredis.InitMutex(ctx, r.Redis); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *GatewayConnectionStatsHistory) rawKey(uid string) string {
+	return r.Redis.Key("uid", uid, "raw")
+}
+
+func (r *GatewayConnectionStatsHistory) downsampledKey(uid string) string {
+	return r.Redis.Key("uid", uid, "downsampled")
+}
+
+func score(t time.Time) float64 {
+	return float64(t.UnixNano() / int64(time.Millisecond))
+}
+
+func scoreString(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano()/int64(time.Millisecond), 10)
+}
+
+func unmarshalSnapshots(ctx context.Context, ss []string) []*ttnpb.GatewayConnectionStatsSnapshot {
+	snapshots := make([]*ttnpb.GatewayConnectionStatsSnapshot, 0, len(ss))
+	for _, s := range ss {
+		snapshot := &ttnpb.GatewayConnectionStatsSnapshot{}
+		if err := ttnredis.UnmarshalProto(s, snapshot); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to decode connection stats snapshot")
+			continue
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// Record stores the snapshot and downsamples the snapshots that are older than the raw retention.
+// The end of the interval of the snapshot is used as the current time for downsampling and retention.
+func (r *GatewayConnectionStatsHistory) Record(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, snapshot *ttnpb.GatewayConnectionStatsSnapshot,
+) error {
+	uid := unique.ID(ctx, ids)
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "record gateway connection stats snapshot").End()
+
+	s, err := ttnredis.MarshalProto(snapshot)
+	if err != nil {
+		return err
+	}
+	start := *ttnpb.StdTime(snapshot.Time)
+	now := start.Add(ttnpb.StdDurationOrZero(snapshot.Interval))
+
+	rk, dk := r.rawKey(uid), r.downsampledKey(uid)
+	rawTTL := r.RawRetention
+	if r.DownsampleInterval > 0 && r.Retention > rawTTL {
+		rawTTL = r.Retention
+	}
+	err = ttnredis.LockedWatch(ctx, r.Redis, rk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		// Downsample whole intervals only, so that an interval is never downsampled twice.
+		var (
+			cutoff      = now.Add(-r.RawRetention).Truncate(r.DownsampleInterval)
+			downsampled []*ttnpb.GatewayConnectionStatsSnapshot
+		)
+		if r.DownsampleInterval > 0 {
+			ss, err := tx.ZRangeByScore(ctx, rk, &redis.ZRangeBy{
+				Min: "-inf",
+				Max: "(" + scoreString(cutoff),
+			}).Result()
+			if err != nil {
+				return err
+			}
+			downsampled = statshistory.Aggregate(unmarshalSnapshots(ctx, ss), r.DownsampleInterval)
+		}
+		_, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.ZAdd(ctx, rk, &redis.Z{Score: score(start), Member: s})
+			if r.DownsampleInterval > 0 {
+				for _, snapshot := range downsampled {
+					s, err := ttnredis.MarshalProto(snapshot)
+					if err != nil {
+						return err
+					}
+					p.ZAdd(ctx, dk, &redis.Z{Score: score(*ttnpb.StdTime(snapshot.Time)), Member: s})
+				}
+			}
+			p.ZRemRangeByScore(ctx, rk, "-inf", "("+scoreString(cutoff))
+			p.ZRemRangeByScore(ctx, dk, "-inf", "("+scoreString(now.Add(-r.Retention)))
+			p.PExpire(ctx, rk, rawTTL)
+			p.PExpire(ctx, dk, r.Retention)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range returns the downsampled and the raw snapshots that start in the time range.
+func (r *GatewayConnectionStatsHistory) Range(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, from, to time.Time,
+) ([]*ttnpb.GatewayConnectionStatsSnapshot, error) {
+	uid := unique.ID(ctx, ids)
+	by := &redis.ZRangeBy{
+		Min: scoreString(from),
+		Max: "(" + scoreString(to),
+	}
+	var downsampledCmd, rawCmd *redis.StringSliceCmd
+	if _, err := r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		downsampledCmd = p.ZRangeByScore(ctx, r.downsampledKey(uid), by)
+		rawCmd = p.ZRangeByScore(ctx, r.rawKey(uid), by)
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	snapshots := append(
+		unmarshalSnapshots(ctx, downsampledCmd.Val()),
+		unmarshalSnapshots(ctx, rawCmd.Val())...,
+	)
+	statshistory.Sort(snapshots)
+	return snapshots, nil
+}
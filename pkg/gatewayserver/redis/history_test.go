@@ -0,0 +1,97 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewayConnectionStatsHistory(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	history := &GatewayConnectionStatsHistory{
+		Redis:              cl,
+		LockTTL:            test.Delay << 10,
+		RawRetention:       time.Hour,
+		DownsampleInterval: 10 * time.Minute,
+		Retention:          24 * time.Hour,
+	}
+	if err := history.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	start := time.Now().UTC().Truncate(time.Hour).Add(-3 * time.Hour)
+	for i := 0; i < 24; i++ {
+		err := history.Record(ctx, ids, &ttnpb.GatewayConnectionStatsSnapshot{
+			Time:        ttnpb.ProtoTimePtr(start.Add(time.Duration(i) * 5 * time.Minute)),
+			Interval:    ttnpb.ProtoDurationPtr(5 * time.Minute),
+			UplinkCount: 1,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// The snapshots of the first hour are downsampled to intervals of 10 minutes.
+	snapshots, err := history.Range(ctx, ids, start, start.Add(3*time.Hour))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(snapshots, should.HaveLength, 18) {
+		t.FailNow()
+	}
+	var uplinks uint64
+	for i, snapshot := range snapshots {
+		uplinks += snapshot.UplinkCount
+		if i < 6 {
+			a.So(*ttnpb.StdTime(snapshot.Time), should.Equal, start.Add(time.Duration(i)*10*time.Minute))
+			a.So(ttnpb.StdDurationOrZero(snapshot.Interval), should.Equal, 10*time.Minute)
+			a.So(snapshot.UplinkCount, should.Equal, 2)
+		} else {
+			a.So(*ttnpb.StdTime(snapshot.Time), should.Equal, start.Add(time.Hour+time.Duration(i-6)*5*time.Minute))
+			a.So(ttnpb.StdDurationOrZero(snapshot.Interval), should.Equal, 5*time.Minute)
+			a.So(snapshot.UplinkCount, should.Equal, 1)
+		}
+	}
+	a.So(uplinks, should.Equal, 24)
+
+	// The raw snapshots are kept until they are downsampled, even if no more snapshots are recorded.
+	ttl, err := cl.PTTL(ctx, history.rawKey(unique.ID(ctx, ids))).Result()
+	if a.So(err, should.BeNil) {
+		a.So(ttl, should.BeGreaterThan, history.RawRetention+history.DownsampleInterval)
+	}
+
+	// The time range is inclusive of the start and exclusive of the end.
+	snapshots, err = history.Range(ctx, ids, start.Add(50*time.Minute), start.Add(70*time.Minute))
+	if a.So(err, should.BeNil) && a.So(snapshots, should.HaveLength, 3) {
+		a.So(*ttnpb.StdTime(snapshots[0].Time), should.Equal, start.Add(50*time.Minute))
+		a.So(*ttnpb.StdTime(snapshots[2].Time), should.Equal, start.Add(65*time.Minute))
+	}
+
+	// Other gateways have no history.
+	snapshots, err = history.Range(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}, start, start.Add(3*time.Hour))
+	a.So(err, should.BeNil)
+	a.So(snapshots, should.BeEmpty)
+}
@@ -35,6 +35,15 @@ type GatewayConnectionStatsRegistry interface {
 	Set(ctx context.Context, ids *ttnpb.GatewayIdentifiers, stats *ttnpb.GatewayConnectionStats, paths []string, ttl time.Duration) error
 }
 
+// GatewayConnectionStatsHistoryStore stores and retrieves snapshots of gateway connection stats.
+type GatewayConnectionStatsHistoryStore interface {
+	// Record stores the snapshot of the connection stats of a gateway.
+	Record(ctx context.Context, ids *ttnpb.GatewayIdentifiers, snapshot *ttnpb.GatewayConnectionStatsSnapshot) error
+	// Range returns the snapshots of the connection stats of a gateway that start in the time range [from, to),
+	// in chronological order.
+	Range(ctx context.Context, ids *ttnpb.GatewayIdentifiers, from, to time.Time) ([]*ttnpb.GatewayConnectionStatsSnapshot, error)
+}
+
 // EntityRegistry abstracts the Identity server gateway functions.
 type EntityRegistry interface {
 	// AssertGatewayRights checks whether the gateway authentication (provied in the context) contains the required rights.
@@ -99,6 +99,17 @@ func (ems Emissions) Insert(em Emission) Emissions {
 	return append(ems, em)
 }
 
+// Remove returns a new list of emissions without the emissions that start at the given time.
+func (ems Emissions) Remove(starts ConcentratorTime) Emissions {
+	res := ems[:0:0]
+	for _, em := range ems {
+		if em.t != starts {
+			res = append(res, em)
+		}
+	}
+	return res
+}
+
 // GreaterThan returns a new list of emissions that have not ended relative to the provided time.
 func (ems Emissions) GreaterThan(to ConcentratorTime) Emissions {
 	expired := 0
@@ -129,6 +129,7 @@ func NewScheduler(
 						MinFrequency: subBand.MinFrequency,
 						MaxFrequency: subBand.MaxFrequency,
 						DutyCycle:    subBand.DutyCycle,
+						LBT:          fp.LBT.Clone(),
 					}
 					sb := NewSubBand(params, s.clock, nil, dutyCycleStyle)
 					var isIdentical bool
@@ -155,6 +156,7 @@ func NewScheduler(
 						MinFrequency: subBand.MinFrequency,
 						MaxFrequency: subBand.MaxFrequency,
 						DutyCycle:    subBand.DutyCycle,
+						LBT:          fp.LBT.Clone(),
 					}
 					sb := NewSubBand(params, s.clock, nil, dutyCycleStyle)
 					var isIdentical bool
@@ -178,6 +180,12 @@ func NewScheduler(
 			MinFrequency: 0,
 			MaxFrequency: math.MaxUint64,
 		}
+		for _, fp := range fps {
+			// Listen-before-talk is not a duty-cycle limitation; use the longest scan time of the frequency plans.
+			if fp.LBT != nil && (noDutyCycleParams.LBT == nil || fp.LBT.ScanTime > noDutyCycleParams.LBT.ScanTime) {
+				noDutyCycleParams.LBT = fp.LBT.Clone()
+			}
+		}
 		sb := NewSubBand(noDutyCycleParams, s.clock, nil, dutyCycleStyle)
 		s.subBands = append(s.subBands, sb)
 	}
@@ -350,15 +358,17 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (res Emission,
 	if err != nil {
 		return Emission{}, 0, err
 	}
+	// The listen-before-talk scan time conflicts with other emissions, but it does not count towards the duty-cycle.
+	occupied := withLBTScan(em, sb.LBTScanTime())
 	for _, other := range s.emissions {
-		if em.OverlapsWithOffAir(other, s.timeOffAir) {
+		if occupied.OverlapsWithOffAir(other, s.timeOffAir) {
 			return Emission{}, 0, errConflict.New()
 		}
 	}
 	if err := sb.Schedule(em, opts.Priority); err != nil {
 		return Emission{}, 0, err
 	}
-	s.emissions = s.emissions.Insert(em)
+	s.emissions = s.emissions.Insert(occupied)
 	return em, now, nil
 }
 
@@ -410,44 +420,92 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (res Emis
 	if err != nil {
 		return Emission{}, 0, err
 	}
+	// Conflicts are resolved including the listen-before-talk scan time, while the sub-band only accounts for the
+	// emission itself.
+	scanTime := sb.LBTScanTime()
+	occupied := withLBTScan(em, scanTime)
 	i := 0
-	next := func() ConcentratorTime {
+	nextOccupied := func() ConcentratorTime {
 		if len(s.emissions) == 0 {
 			// No emissions; schedule at the requested time.
-			return em.t
+			return occupied.t
 		}
 		for i < len(s.emissions)-1 {
 			// Find a window between two emissions that does not conflict with either side.
-			if em.OverlapsWithOffAir(s.emissions[i], s.timeOffAir) {
+			if occupied.OverlapsWithOffAir(s.emissions[i], s.timeOffAir) {
 				// Schedule right after previous to resolve conflict.
-				em.t = s.emissions[i].EndsWithOffAir(s.timeOffAir)
+				occupied.t = s.emissions[i].EndsWithOffAir(s.timeOffAir)
 			}
-			if em.OverlapsWithOffAir(s.emissions[i+1], s.timeOffAir) {
+			if occupied.OverlapsWithOffAir(s.emissions[i+1], s.timeOffAir) {
 				// Schedule right after next to resolve conflict.
-				em.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir)
+				occupied.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir)
 				i++
 				continue
 			}
 			// No conflicts, but advance counter for potential next iteration.
 			// A next iteration can be necessary when this emission and priority exceeds a duty-cycle limitation.
 			i++
-			return em.t
+			return occupied.t
 		}
 		// No emissions to schedule in between; schedule at timestamp or last transmission, whichever comes first.
 		afterLast := s.emissions[len(s.emissions)-1].EndsWithOffAir(s.timeOffAir)
-		if afterLast > em.t {
+		if afterLast > occupied.t {
 			return afterLast
 		}
-		return em.t
+		return occupied.t
+	}
+	next := func() ConcentratorTime {
+		return nextOccupied() + ConcentratorTime(scanTime)
 	}
 	em, err = sb.ScheduleAnytime(em.d, next, opts.Priority)
 	if err != nil {
 		return Emission{}, 0, err
 	}
-	s.emissions = s.emissions.Insert(em)
+	s.emissions = s.emissions.Insert(withLBTScan(em, scanTime))
 	return em, now, nil
 }
 
+// withLBTScan returns the emission extended with the listen-before-talk scan time that precedes the emission.
+// The gateway listens for channel activity during the scan time, so the scan time cannot overlap with other emissions
+// of the gateway, including emissions on other antennas.
+func withLBTScan(em Emission, scanTime time.Duration) Emission {
+	return NewEmission(em.t-ConcentratorTime(scanTime), em.d+scanTime)
+}
+
+// ListenBeforeTalk returns the listen-before-talk requirements for the given frequency.
+// This method returns false if the frequency does not require listen-before-talk.
+func (s *Scheduler) ListenBeforeTalk(frequency uint64) (*frequencyplans.LBT, bool) {
+	sb, err := s.findSubBand(frequency)
+	if err != nil || sb.LBT == nil {
+		return nil, false
+	}
+	return sb.LBT, true
+}
+
+// HandleTxAck handles the acknowledgment of the transmission with the given scheduled settings.
+// If the sub-band requires listen-before-talk, the result is recorded in the sub-band statistics. If the gateway did
+// not transmit because listen-before-talk detected channel activity, the emission is released so that it does not
+// count towards the duty-cycle and does not conflict with other emissions.
+func (s *Scheduler) HandleTxAck(settings *ttnpb.TxSettings, result ttnpb.TxAcknowledgment_Result) {
+	sb, err := s.findSubBand(settings.GetFrequency())
+	if err != nil || sb.LBT == nil {
+		return
+	}
+	switch result {
+	case ttnpb.TxAcknowledgment_SUCCESS:
+		sb.recordLBT(false)
+	case ttnpb.TxAcknowledgment_LBT_FAILED:
+		sb.recordLBT(true)
+		starts := ConcentratorTime(settings.GetConcentratorTimestamp())
+		sb.release(starts)
+		s.mu.Lock()
+		s.emissions = s.emissions.Remove(starts - ConcentratorTime(sb.LBTScanTime()))
+		s.mu.Unlock()
+	default:
+		// The gateway did not attempt to transmit.
+	}
+}
+
 // Sync synchronizes the clock with the given concentrator time v and the server time.
 func (s *Scheduler) Sync(v uint32, server time.Time) ConcentratorTime {
 	s.mu.Lock()
@@ -516,11 +574,15 @@ func (s *Scheduler) SubBandStats() []*ttnpb.GatewayConnectionStats_SubBand {
 	var res []*ttnpb.GatewayConnectionStats_SubBand
 
 	for _, sb := range s.subBands {
+		lbtFailures, lbtFailureRate := sb.LBTFailureStats()
 		res = append(res, &ttnpb.GatewayConnectionStats_SubBand{
 			MaxFrequency:             sb.MaxFrequency,
 			MinFrequency:             sb.MinFrequency,
 			DownlinkUtilizationLimit: sb.DutyCycle,
 			DownlinkUtilization:      sb.DutyCycleUtilization(),
+			ListenBeforeTalk:         sb.LBT != nil,
+			LbtFailureCount:          lbtFailures,
+			LbtFailureRate:           lbtFailureRate,
 		})
 	}
 
@@ -1248,3 +1248,195 @@ func TestScheduleSyncViaUplinkToken(t *testing.T) {
 		a.So(err, should.BeNil)
 	}
 }
+
+func TestScheduleWithListenBeforeTalk(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	lbt := &frequencyplans.LBT{
+		RSSITarget: -80,
+		ScanTime:   5 * time.Millisecond,
+	}
+	fps := map[string]*frequencyplans.FrequencyPlan{test.KRFrequencyPlanID: {
+		BandID: band.KR_920_923,
+		LBT:    lbt,
+	}}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, false, scheduling.DefaultDutyCycleStyle, nil, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	scheduler.SyncWithGatewayAbsolute(0, time.Now(), time.Unix(0, 0))
+
+	settingsAt := func(t uint32) *ttnpb.TxSettings {
+		return &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodingRate:      band.Cr4_5,
+					},
+				},
+			},
+			Frequency: 922100000,
+			Timestamp: t,
+		}
+	}
+
+	res, ok := scheduler.ListenBeforeTalk(922100000)
+	if a.So(ok, should.BeTrue) {
+		a.So(res, should.Resemble, lbt)
+	}
+
+	// Time-on-air is 41216 us, time-off-air is 30000 us and the scan time is 5000 us.
+	// 1: [995000, 1041216]
+	em1, _, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(1000000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em1.Starts(), should.Equal, scheduling.ConcentratorTime(1000000*time.Microsecond))
+	a.So(em1.Duration(), should.Equal, 41216*time.Microsecond)
+
+	// The emission would start right after the time-off-air of 1, but the scan time overlaps with 1.
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(1071216),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrConflict)
+
+	// 1: [995000, 1041216]
+	// 2: [1071216, 1117432]
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(1076216),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// The emission is scheduled after 2, with the scan time after the time-off-air of 2.
+	// 1: [995000, 1041216]
+	// 2: [1071216, 1117432]
+	// 3: [1147432, 1193648]
+	em3, _, err := scheduler.ScheduleAnytime(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(1000000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em3.Starts(), should.Equal, scheduling.ConcentratorTime(1152432*time.Microsecond))
+	a.So(em3.Duration(), should.Equal, 41216*time.Microsecond)
+
+	stats := scheduler.SubBandStats()
+	if a.So(stats, should.HaveLength, 1) {
+		a.So(stats[0].ListenBeforeTalk, should.BeTrue)
+		a.So(stats[0].LbtFailureCount, should.BeZeroValue)
+		a.So(stats[0].LbtFailureRate, should.BeZeroValue)
+	}
+
+	// The gateway reports that 1 failed listen-before-talk, so the emission is released.
+	scheduled := settingsAt(0)
+	scheduled.ConcentratorTimestamp = int64(em1.Starts())
+	scheduler.HandleTxAck(scheduled, ttnpb.TxAcknowledgment_LBT_FAILED)
+	scheduled = settingsAt(0)
+	scheduled.ConcentratorTimestamp = int64(em3.Starts())
+	scheduler.HandleTxAck(scheduled, ttnpb.TxAcknowledgment_SUCCESS)
+
+	stats = scheduler.SubBandStats()
+	if a.So(stats, should.HaveLength, 1) {
+		a.So(stats[0].LbtFailureCount, should.Equal, 1)
+		a.So(stats[0].LbtFailureRate, should.Equal, 0.5)
+	}
+
+	// 1: [995000, 1041216]
+	// 2: [1071216, 1117432]
+	// 3: [1147432, 1193648]
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(1000000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.BeNil)
+}
+
+func TestScheduleWithListenBeforeTalkDutyCycle(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	// With the highest priority, the duty-cycle allows one emission of 41216 us in the window of 10 s,
+	// but not with the scan time of 5000 us.
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+		LBT: &frequencyplans.LBT{
+			RSSITarget: -80,
+			ScanTime:   5 * time.Millisecond,
+		},
+		SubBands: []frequencyplans.SubBandParameters{
+			{
+				MinFrequency: 868000000,
+				MaxFrequency: 868600000,
+				DutyCycle:    0.0044,
+			},
+		},
+	}}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, scheduling.DefaultDutyCycleStyle, nil, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	scheduler.SyncWithGatewayAbsolute(0, time.Now(), time.Unix(0, 0))
+
+	settingsAt := func(t uint32) *ttnpb.TxSettings {
+		return &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodingRate:      band.Cr4_5,
+					},
+				},
+			},
+			Frequency: 868100000,
+			Timestamp: t,
+		}
+	}
+
+	em1, _, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(1000000),
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em1.Duration(), should.Equal, 41216*time.Microsecond)
+
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(2000000),
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrDutyCycle)
+
+	// The gateway reports that 1 failed listen-before-talk, so the emission does not count towards the duty-cycle.
+	scheduled := settingsAt(0)
+	scheduled.ConcentratorTimestamp = int64(em1.Starts())
+	scheduler.HandleTxAck(scheduled, ttnpb.TxAcknowledgment_LBT_FAILED)
+
+	em2, _, err := scheduler.ScheduleAnytime(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(1000000),
+		Priority:    ttnpb.TxSchedulePriority_HIGHEST,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em2.Starts(), should.Equal, scheduling.ConcentratorTime(1000000*time.Microsecond))
+	a.So(em2.Duration(), should.Equal, 41216*time.Microsecond)
+}
@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -56,11 +57,12 @@ var DefaultDutyCycleCeilings DutyCycleCeilings = map[ttnpb.TxSchedulePriority]fl
 	ttnpb.TxSchedulePriority_HIGHEST:      1.00,
 }
 
-// SubBandParameters defines the sub-band frequency bounds and duty-cycle value.
+// SubBandParameters defines the sub-band frequency bounds, duty-cycle value and listen-before-talk requirements.
 type SubBandParameters struct {
 	MinFrequency,
 	MaxFrequency uint64
 	DutyCycle float32
+	LBT       *frequencyplans.LBT
 }
 
 // SubBand tracks the utilization and controls the duty-cycle of a sub-band.
@@ -71,6 +73,9 @@ type SubBand struct {
 	ceilings  DutyCycleCeilings
 	style     DutyCycleStyle
 	emissions Emissions
+
+	lbtAttempts,
+	lbtFailures uint64
 }
 
 // NewSubBand returns a new SubBand considering the given duty-cycle, clock and optionally duty-cycle ceilings.
@@ -103,6 +108,15 @@ func (sb SubBandParameters) Comprises(frequency uint64) bool {
 	return frequency >= sb.MinFrequency && frequency <= sb.MaxFrequency
 }
 
+// LBTScanTime returns the time the gateway listens before talking in the sub-band.
+// This method returns zero if the sub-band does not require listen-before-talk.
+func (sb SubBandParameters) LBTScanTime() time.Duration {
+	if sb.LBT == nil {
+		return 0
+	}
+	return sb.LBT.ScanTime
+}
+
 // sum returns the total emission durations in the given window.
 // This method requires the read lock to be held.
 func (sb *SubBand) sum(from, to ConcentratorTime) time.Duration {
@@ -251,6 +265,34 @@ func (sb *SubBand) ScheduleAnytime(d time.Duration, next func() ConcentratorTime
 	return em, nil
 }
 
+// release removes the emission that starts at the given time, i.e. because it has not been transmitted.
+func (sb *SubBand) release(starts ConcentratorTime) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.emissions = sb.emissions.Remove(starts)
+}
+
+// recordLBT records the result of an acknowledged transmission that required listen-before-talk.
+func (sb *SubBand) recordLBT(failed bool) {
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+	sb.lbtAttempts++
+	if failed {
+		sb.lbtFailures++
+	}
+}
+
+// LBTFailureStats returns the number of transmissions that failed because listen-before-talk detected channel
+// activity, and the failure rate as a fraction of the acknowledged transmissions in the sub-band.
+func (sb *SubBand) LBTFailureStats() (failures uint64, rate float32) {
+	sb.mu.RLock()
+	defer sb.mu.RUnlock()
+	if sb.lbtAttempts == 0 {
+		return 0, 0
+	}
+	return sb.lbtFailures, float32(sb.lbtFailures) / float32(sb.lbtAttempts)
+}
+
 // HasOverlap checks if the two sub bands have an overlap.
 func (sb *SubBand) HasOverlap(subBand *SubBand) bool {
 	return subBand.MaxFrequency > sb.MinFrequency && subBand.MinFrequency < sb.MaxFrequency ||
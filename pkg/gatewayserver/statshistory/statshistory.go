@@ -0,0 +1,161 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package statshistory provides functions to create and aggregate snapshots of gateway connection stats.
+package statshistory
+
+import (
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// NewSnapshot returns the snapshot of the connection stats in the interval from start to end.
+// The counters of the snapshot are the difference between the counters of the current and the previous stats.
+// If the previous stats are nil, or if the counters have been reset, the counters of the current stats are used.
+func NewSnapshot(prev, cur *ttnpb.GatewayConnectionStats, start, end time.Time) *ttnpb.GatewayConnectionStatsSnapshot {
+	return &ttnpb.GatewayConnectionStatsSnapshot{
+		Time:           ttnpb.ProtoTimePtr(start),
+		Interval:       ttnpb.ProtoDurationPtr(end.Sub(start)),
+		UplinkCount:    delta(prev.GetUplinkCount(), cur.GetUplinkCount()),
+		DownlinkCount:  delta(prev.GetDownlinkCount(), cur.GetDownlinkCount()),
+		RoundTripTimes: cur.GetRoundTripTimes(),
+		SubBands:       cur.GetSubBands(),
+	}
+}
+
+func delta(prev, cur uint64) uint64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+// Sort sorts the snapshots chronologically.
+func Sort(snapshots []*ttnpb.GatewayConnectionStatsSnapshot) {
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return ttnpb.StdTime(snapshots[i].Time).Before(*ttnpb.StdTime(snapshots[j].Time))
+	})
+}
+
+type subBandKey struct {
+	minFrequency, maxFrequency uint64
+}
+
+type subBandAggregate struct {
+	*ttnpb.GatewayConnectionStats_SubBand
+	n int
+}
+
+type aggregate struct {
+	snapshot   *ttnpb.GatewayConnectionStatsSnapshot
+	rttWeights float64
+	rttMedian  float64
+	rttN       int
+	subBands   []*subBandAggregate
+	subBandIdx map[subBandKey]*subBandAggregate
+}
+
+func (a *aggregate) add(s *ttnpb.GatewayConnectionStatsSnapshot) {
+	a.snapshot.UplinkCount += s.UplinkCount
+	a.snapshot.DownlinkCount += s.DownlinkCount
+	if rtt := s.RoundTripTimes; rtt != nil {
+		min, max, median := ttnpb.StdDurationOrZero(rtt.Min), ttnpb.StdDurationOrZero(rtt.Max), ttnpb.StdDurationOrZero(rtt.Median)
+		if a.snapshot.RoundTripTimes == nil {
+			a.snapshot.RoundTripTimes = &ttnpb.GatewayConnectionStats_RoundTripTimes{
+				Min: ttnpb.ProtoDurationPtr(min),
+				Max: ttnpb.ProtoDurationPtr(max),
+			}
+		} else {
+			if min < ttnpb.StdDurationOrZero(a.snapshot.RoundTripTimes.Min) {
+				a.snapshot.RoundTripTimes.Min = ttnpb.ProtoDurationPtr(min)
+			}
+			if max > ttnpb.StdDurationOrZero(a.snapshot.RoundTripTimes.Max) {
+				a.snapshot.RoundTripTimes.Max = ttnpb.ProtoDurationPtr(max)
+			}
+		}
+		weight := float64(rtt.Count)
+		if weight == 0 {
+			weight = 1
+		}
+		a.rttMedian += weight * float64(median)
+		a.rttWeights += weight
+		a.rttN++
+		a.snapshot.RoundTripTimes.Count += rtt.Count
+	}
+	for _, sb := range s.SubBands {
+		key := subBandKey{sb.MinFrequency, sb.MaxFrequency}
+		agg, ok := a.subBandIdx[key]
+		if !ok {
+			agg = &subBandAggregate{
+				GatewayConnectionStats_SubBand: &ttnpb.GatewayConnectionStats_SubBand{
+					MinFrequency: sb.MinFrequency,
+					MaxFrequency: sb.MaxFrequency,
+				},
+			}
+			a.subBandIdx[key] = agg
+			a.subBands = append(a.subBands, agg)
+		}
+		agg.DownlinkUtilizationLimit = sb.DownlinkUtilizationLimit
+		agg.DownlinkUtilization += sb.DownlinkUtilization
+		agg.n++
+	}
+}
+
+func (a *aggregate) result() *ttnpb.GatewayConnectionStatsSnapshot {
+	if a.rttN > 0 {
+		a.snapshot.RoundTripTimes.Median = ttnpb.ProtoDurationPtr(time.Duration(a.rttMedian / a.rttWeights))
+	}
+	for _, sb := range a.subBands {
+		sb.DownlinkUtilization /= float32(sb.n)
+		a.snapshot.SubBands = append(a.snapshot.SubBands, sb.GatewayConnectionStats_SubBand)
+	}
+	return a.snapshot
+}
+
+// Aggregate aggregates the snapshots in intervals of the given duration.
+// The intervals are aligned to the zero time. The snapshots are assigned to intervals by their start time.
+// The resulting snapshots are sorted chronologically.
+func Aggregate(snapshots []*ttnpb.GatewayConnectionStatsSnapshot, interval time.Duration) []*ttnpb.GatewayConnectionStatsSnapshot {
+	if interval <= 0 {
+		return snapshots
+	}
+	var (
+		aggregates []*aggregate
+		index      = make(map[time.Time]*aggregate)
+	)
+	for _, s := range snapshots {
+		start := ttnpb.StdTime(s.Time).UTC().Truncate(interval)
+		agg, ok := index[start]
+		if !ok {
+			agg = &aggregate{
+				snapshot: &ttnpb.GatewayConnectionStatsSnapshot{
+					Time:     ttnpb.ProtoTimePtr(start),
+					Interval: ttnpb.ProtoDurationPtr(interval),
+				},
+				subBandIdx: make(map[subBandKey]*subBandAggregate),
+			}
+			index[start] = agg
+			aggregates = append(aggregates, agg)
+		}
+		agg.add(s)
+	}
+	res := make([]*ttnpb.GatewayConnectionStatsSnapshot, 0, len(aggregates))
+	for _, agg := range aggregates {
+		res = append(res, agg.result())
+	}
+	Sort(res)
+	return res
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statshistory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/statshistory"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestNewSnapshot(t *testing.T) {
+	a := assertions.New(t)
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	end := start.Add(time.Minute)
+	rtt := &ttnpb.GatewayConnectionStats_RoundTripTimes{
+		Min:    ttnpb.ProtoDurationPtr(10 * time.Millisecond),
+		Max:    ttnpb.ProtoDurationPtr(30 * time.Millisecond),
+		Median: ttnpb.ProtoDurationPtr(20 * time.Millisecond),
+		Count:  5,
+	}
+
+	snapshot := statshistory.NewSnapshot(nil, &ttnpb.GatewayConnectionStats{
+		UplinkCount:    10,
+		DownlinkCount:  2,
+		RoundTripTimes: rtt,
+	}, start, end)
+	a.So(snapshot, should.Resemble, &ttnpb.GatewayConnectionStatsSnapshot{
+		Time:           ttnpb.ProtoTimePtr(start),
+		Interval:       ttnpb.ProtoDurationPtr(time.Minute),
+		UplinkCount:    10,
+		DownlinkCount:  2,
+		RoundTripTimes: rtt,
+	})
+
+	snapshot = statshistory.NewSnapshot(
+		&ttnpb.GatewayConnectionStats{UplinkCount: 10, DownlinkCount: 2},
+		&ttnpb.GatewayConnectionStats{UplinkCount: 15, DownlinkCount: 1},
+		start, end,
+	)
+	a.So(snapshot.UplinkCount, should.Equal, 5)
+	a.So(snapshot.DownlinkCount, should.Equal, 1) // Reset.
+}
+
+func TestAggregate(t *testing.T) {
+	a := assertions.New(t)
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	newSnapshot := func(offset time.Duration, uplinks uint64, median time.Duration, count uint32, utilization float32) *ttnpb.GatewayConnectionStatsSnapshot {
+		return &ttnpb.GatewayConnectionStatsSnapshot{
+			Time:        ttnpb.ProtoTimePtr(start.Add(offset)),
+			Interval:    ttnpb.ProtoDurationPtr(time.Minute),
+			UplinkCount: uplinks,
+			RoundTripTimes: &ttnpb.GatewayConnectionStats_RoundTripTimes{
+				Min:    ttnpb.ProtoDurationPtr(median / 2),
+				Max:    ttnpb.ProtoDurationPtr(median * 2),
+				Median: ttnpb.ProtoDurationPtr(median),
+				Count:  count,
+			},
+			SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+				{
+					MinFrequency:             863000000,
+					MaxFrequency:             865000000,
+					DownlinkUtilizationLimit: 0.001,
+					DownlinkUtilization:      utilization,
+				},
+			},
+		}
+	}
+
+	snapshots := []*ttnpb.GatewayConnectionStatsSnapshot{
+		newSnapshot(0, 1, 20*time.Millisecond, 1, 0.1),
+		newSnapshot(time.Minute, 2, 40*time.Millisecond, 3, 0.3),
+		newSnapshot(5*time.Minute, 4, 10*time.Millisecond, 1, 0.2),
+	}
+	a.So(statshistory.Aggregate(snapshots, 0), should.Resemble, snapshots)
+
+	res := statshistory.Aggregate(snapshots, 5*time.Minute)
+	if !a.So(res, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(res[0], should.Resemble, &ttnpb.GatewayConnectionStatsSnapshot{
+		Time:        ttnpb.ProtoTimePtr(start),
+		Interval:    ttnpb.ProtoDurationPtr(5 * time.Minute),
+		UplinkCount: 3,
+		RoundTripTimes: &ttnpb.GatewayConnectionStats_RoundTripTimes{
+			Min:    ttnpb.ProtoDurationPtr(10 * time.Millisecond),
+			Max:    ttnpb.ProtoDurationPtr(80 * time.Millisecond),
+			Median: ttnpb.ProtoDurationPtr(35 * time.Millisecond),
+			Count:  4,
+		},
+		SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+			{
+				MinFrequency:             863000000,
+				MaxFrequency:             865000000,
+				DownlinkUtilizationLimit: 0.001,
+				DownlinkUtilization:      0.2,
+			},
+		},
+	})
+	a.So(*ttnpb.StdTime(res[1].Time), should.Equal, start.Add(5*time.Minute))
+	a.So(res[1].UplinkCount, should.Equal, 4)
+}
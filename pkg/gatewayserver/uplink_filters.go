@@ -0,0 +1,202 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/proto"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"golang.org/x/exp/slices"
+)
+
+type joinEUIRange struct {
+	start, end types.EUI64
+}
+
+// uplinkFilter is a parsed ttnpb.GatewayUplinkFilter.
+type uplinkFilter struct {
+	action          ttnpb.GatewayUplinkFilter_Action
+	upstreams       []string
+	mTypes          []ttnpb.MType
+	devAddrPrefixes []types.DevAddrPrefix
+	netIDPrefixes   []types.DevAddrPrefix
+	netIDs          []types.NetID
+	joinEUIRanges   []joinEUIRange
+}
+
+// uplinkFilters are the uplink filters of a gateway, in order of evaluation.
+type uplinkFilters []uplinkFilter
+
+// newUplinkFilters parses the given uplink filters. Invalid conditions are skipped.
+func newUplinkFilters(pbs []*ttnpb.GatewayUplinkFilter) uplinkFilters {
+	if len(pbs) == 0 {
+		return nil
+	}
+	filters := make(uplinkFilters, 0, len(pbs))
+	for _, pb := range pbs {
+		f := uplinkFilter{
+			action:    pb.Action,
+			upstreams: pb.Upstreams,
+			mTypes:    pb.MTypes,
+		}
+		for _, b := range pb.DevAddrPrefixes {
+			var prefix types.DevAddrPrefix
+			if err := prefix.UnmarshalBinary(b); err != nil {
+				continue
+			}
+			f.devAddrPrefixes = append(f.devAddrPrefixes, prefix)
+		}
+		for _, b := range pb.NetIds {
+			var netID types.NetID
+			if err := netID.UnmarshalBinary(b); err != nil {
+				continue
+			}
+			devAddr, err := types.NewDevAddr(netID, nil)
+			if err != nil {
+				continue
+			}
+			f.netIDs = append(f.netIDs, netID)
+			f.netIDPrefixes = append(f.netIDPrefixes, types.DevAddrPrefix{
+				DevAddr: devAddr,
+				Length:  uint8(32 - types.NwkAddrBits(netID)),
+			})
+		}
+		for _, r := range pb.JoinEuiRanges {
+			var start, end types.EUI64
+			if err := start.UnmarshalBinary(r.GetStart()); err != nil {
+				continue
+			}
+			if err := end.UnmarshalBinary(r.GetEnd()); err != nil {
+				continue
+			}
+			f.joinEUIRanges = append(f.joinEUIRanges, joinEUIRange{start: start, end: end})
+		}
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// allow returns whether the given uplink message should be forwarded to the given upstream.
+// The action of the first filter that matches determines the result. If no filter matches, the message is forwarded.
+func (fs uplinkFilters) allow(upstream string, msg *ttnpb.Message) bool {
+	for _, f := range fs {
+		if f.matches(upstream, msg) {
+			return f.action == ttnpb.GatewayUplinkFilter_ALLOW
+		}
+	}
+	return true
+}
+
+func (f uplinkFilter) matches(upstream string, msg *ttnpb.Message) bool {
+	if len(f.upstreams) > 0 && !slices.Contains(f.upstreams, upstream) {
+		return false
+	}
+	mType := msg.GetMHdr().GetMType()
+	if len(f.mTypes) > 0 && !slices.Contains(f.mTypes, mType) {
+		return false
+	}
+	if len(f.devAddrPrefixes) > 0 {
+		devAddr, ok := uplinkDevAddr(msg)
+		if !ok || !matchesAnyPrefix(f.devAddrPrefixes, devAddr) {
+			return false
+		}
+	}
+	if len(f.netIDs) > 0 && !f.matchesNetID(msg) {
+		return false
+	}
+	if len(f.joinEUIRanges) > 0 && !f.matchesJoinEUI(msg) {
+		return false
+	}
+	return true
+}
+
+func (f uplinkFilter) matchesNetID(msg *ttnpb.Message) bool {
+	if devAddr, ok := uplinkDevAddr(msg); ok {
+		return matchesAnyPrefix(f.netIDPrefixes, devAddr)
+	}
+	pld := msg.GetRejoinRequestPayload()
+	if pld == nil || pld.RejoinType == ttnpb.RejoinRequestType_SESSION {
+		return false
+	}
+	for _, netID := range f.netIDs {
+		if bytes.Equal(netID[:], pld.NetId) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f uplinkFilter) matchesJoinEUI(msg *ttnpb.Message) bool {
+	var b []byte
+	switch pld := msg.GetPayload().(type) {
+	case *ttnpb.Message_JoinRequestPayload:
+		b = pld.JoinRequestPayload.GetJoinEui()
+	case *ttnpb.Message_RejoinRequestPayload:
+		if pld.RejoinRequestPayload.GetRejoinType() != ttnpb.RejoinRequestType_SESSION {
+			return false
+		}
+		b = pld.RejoinRequestPayload.GetJoinEui()
+	default:
+		return false
+	}
+	var joinEUI types.EUI64
+	if err := joinEUI.UnmarshalBinary(b); err != nil {
+		return false
+	}
+	n := joinEUI.MarshalNumber()
+	for _, r := range f.joinEUIRanges {
+		if n >= r.start.MarshalNumber() && n <= r.end.MarshalNumber() {
+			return true
+		}
+	}
+	return false
+}
+
+func sameUplinkFilters(a, b []*ttnpb.GatewayUplinkFilter) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// uplinkDevAddr returns the DevAddr of the data uplink message.
+func uplinkDevAddr(msg *ttnpb.Message) (types.DevAddr, bool) {
+	switch msg.GetMHdr().GetMType() {
+	case ttnpb.MType_UNCONFIRMED_UP, ttnpb.MType_CONFIRMED_UP:
+	default:
+		return types.DevAddr{}, false
+	}
+	var devAddr types.DevAddr
+	if err := devAddr.UnmarshalBinary(msg.GetMacPayload().GetFHdr().GetDevAddr()); err != nil {
+		return types.DevAddr{}, false
+	}
+	return devAddr, true
+}
+
+func matchesAnyPrefix(prefixes []types.DevAddrPrefix, devAddr types.DevAddr) bool {
+	for _, prefix := range prefixes {
+		if prefix.Matches(devAddr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestUplinkFilters(t *testing.T) {
+	dataUp := func(devAddr types.DevAddr) *ttnpb.Message {
+		return &ttnpb.Message{
+			MHdr: &ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_UP, Major: ttnpb.Major_LORAWAN_R1},
+			Payload: &ttnpb.Message_MacPayload{
+				MacPayload: &ttnpb.MACPayload{
+					FHdr: &ttnpb.FHDR{DevAddr: devAddr.Bytes()},
+				},
+			},
+		}
+	}
+	joinRequest := func(joinEUI types.EUI64) *ttnpb.Message {
+		return &ttnpb.Message{
+			MHdr: &ttnpb.MHDR{MType: ttnpb.MType_JOIN_REQUEST, Major: ttnpb.Major_LORAWAN_R1},
+			Payload: &ttnpb.Message_JoinRequestPayload{
+				JoinRequestPayload: &ttnpb.JoinRequestPayload{
+					JoinEui: joinEUI.Bytes(),
+					DevEui:  types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+				},
+			},
+		}
+	}
+	rejoinRequest := func(rejoinType ttnpb.RejoinRequestType, netID types.NetID, joinEUI types.EUI64) *ttnpb.Message {
+		return &ttnpb.Message{
+			MHdr: &ttnpb.MHDR{MType: ttnpb.MType_REJOIN_REQUEST, Major: ttnpb.Major_LORAWAN_R1},
+			Payload: &ttnpb.Message_RejoinRequestPayload{
+				RejoinRequestPayload: &ttnpb.RejoinRequestPayload{
+					RejoinType: rejoinType,
+					NetId:      netID.Bytes(),
+					JoinEui:    joinEUI.Bytes(),
+					DevEui:     types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+				},
+			},
+		}
+	}
+
+	var (
+		ttnNetID       = types.NetID{0x00, 0x00, 0x13}
+		ttnDevAddr     = types.DevAddr{0x26, 0x01, 0x12, 0x34}
+		otherDevAddr   = types.DevAddr{0x01, 0x02, 0x03, 0x04}
+		inRangeEUI     = types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x12, 0x34}
+		outOfRangeEUI  = types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x01, 0x00, 0x00}
+		joinEUIRangePB = &ttnpb.GatewayUplinkFilter_JoinEUIRange{
+			Start: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}.Bytes(),
+			End:   types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0xff, 0xff}.Bytes(),
+		}
+	)
+
+	type check struct {
+		upstream string
+		msg      *ttnpb.Message
+		allow    bool
+	}
+	for _, tc := range []struct {
+		name    string
+		filters []*ttnpb.GatewayUplinkFilter
+		checks  []check
+	}{
+		{
+			name: "NoFilters",
+			checks: []check{
+				{"cluster", dataUp(ttnDevAddr), true},
+				{"packetbroker", joinRequest(inRangeEUI), true},
+			},
+		},
+		{
+			name: "DenyMType",
+			filters: []*ttnpb.GatewayUplinkFilter{
+				{Action: ttnpb.GatewayUplinkFilter_DENY, MTypes: []ttnpb.MType{ttnpb.MType_JOIN_REQUEST}},
+			},
+			checks: []check{
+				{"cluster", dataUp(ttnDevAddr), true},
+				{"cluster", joinRequest(inRangeEUI), false},
+				{"packetbroker", joinRequest(outOfRangeEUI), false},
+			},
+		},
+		{
+			name: "AllowDevAddrPrefixPerUpstream",
+			filters: []*ttnpb.GatewayUplinkFilter{
+				{
+					Action:          ttnpb.GatewayUplinkFilter_ALLOW,
+					Upstreams:       []string{"cluster"},
+					DevAddrPrefixes: [][]byte{{0x26, 0x01, 0x00, 0x00, 16}},
+				},
+				{Action: ttnpb.GatewayUplinkFilter_DENY},
+			},
+			checks: []check{
+				{"cluster", dataUp(ttnDevAddr), true},
+				{"cluster", dataUp(otherDevAddr), false},
+				{"cluster", joinRequest(inRangeEUI), false},
+				{"packetbroker", dataUp(ttnDevAddr), false},
+			},
+		},
+		{
+			name: "DenyNetID",
+			filters: []*ttnpb.GatewayUplinkFilter{
+				{Action: ttnpb.GatewayUplinkFilter_DENY, NetIds: [][]byte{ttnNetID.Bytes()}},
+			},
+			checks: []check{
+				{"packetbroker", dataUp(ttnDevAddr), false},
+				{"packetbroker", dataUp(otherDevAddr), true},
+				{"packetbroker", rejoinRequest(ttnpb.RejoinRequestType_CONTEXT, ttnNetID, inRangeEUI), false},
+				{"packetbroker", rejoinRequest(ttnpb.RejoinRequestType_KEYS, types.NetID{0x00, 0x00, 0x01}, inRangeEUI), true},
+				{"packetbroker", rejoinRequest(ttnpb.RejoinRequestType_SESSION, ttnNetID, inRangeEUI), true},
+				{"packetbroker", joinRequest(inRangeEUI), true},
+			},
+		},
+		{
+			name: "DenyJoinEUIRange",
+			filters: []*ttnpb.GatewayUplinkFilter{
+				{
+					Action:        ttnpb.GatewayUplinkFilter_DENY,
+					JoinEuiRanges: []*ttnpb.GatewayUplinkFilter_JoinEUIRange{joinEUIRangePB},
+				},
+			},
+			checks: []check{
+				{"cluster", joinRequest(inRangeEUI), false},
+				{"cluster", joinRequest(outOfRangeEUI), true},
+				{"cluster", rejoinRequest(ttnpb.RejoinRequestType_SESSION, ttnNetID, inRangeEUI), false},
+				{"cluster", rejoinRequest(ttnpb.RejoinRequestType_CONTEXT, ttnNetID, inRangeEUI), true},
+				{"cluster", dataUp(ttnDevAddr), true},
+			},
+		},
+		{
+			name: "ConditionsCombined",
+			filters: []*ttnpb.GatewayUplinkFilter{
+				{
+					Action:          ttnpb.GatewayUplinkFilter_DENY,
+					MTypes:          []ttnpb.MType{ttnpb.MType_CONFIRMED_UP},
+					DevAddrPrefixes: [][]byte{{0x26, 0x00, 0x00, 0x00, 7}},
+				},
+			},
+			checks: []check{
+				{"cluster", dataUp(ttnDevAddr), true},
+				{"cluster", func() *ttnpb.Message {
+					msg := dataUp(ttnDevAddr)
+					msg.MHdr.MType = ttnpb.MType_CONFIRMED_UP
+					return msg
+				}(), false},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			filters := newUplinkFilters(tc.filters)
+			for i, c := range tc.checks {
+				if allow := filters.allow(c.upstream, c.msg); allow != c.allow {
+					t.Errorf("Check %d: expected allow to be %v for upstream %q, got %v", i, c.allow, c.upstream, allow)
+				}
+			}
+		})
+	}
+}
+
+func TestSameUplinkFilters(t *testing.T) {
+	a := assertions.New(t)
+	filter := &ttnpb.GatewayUplinkFilter{
+		Action: ttnpb.GatewayUplinkFilter_DENY,
+		MTypes: []ttnpb.MType{ttnpb.MType_JOIN_REQUEST},
+	}
+	a.So(sameUplinkFilters(nil, nil), should.BeTrue)
+	a.So(sameUplinkFilters(
+		[]*ttnpb.GatewayUplinkFilter{filter},
+		[]*ttnpb.GatewayUplinkFilter{{Action: ttnpb.GatewayUplinkFilter_DENY, MTypes: []ttnpb.MType{ttnpb.MType_JOIN_REQUEST}}},
+	), should.BeTrue)
+	a.So(sameUplinkFilters([]*ttnpb.GatewayUplinkFilter{filter}, nil), should.BeFalse)
+	a.So(sameUplinkFilters(
+		[]*ttnpb.GatewayUplinkFilter{filter},
+		[]*ttnpb.GatewayUplinkFilter{{Action: ttnpb.GatewayUplinkFilter_ALLOW, MTypes: []ttnpb.MType{ttnpb.MType_JOIN_REQUEST}}},
+	), should.BeFalse)
+}
@@ -0,0 +1,583 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package roaming abstracts LoRaWAN Backend Interfaces passive roaming to the upstream.Handler interface.
+// The Gateway Server acts as forwarding Network Server (fNS) and forwards uplink messages of devices of partner
+// networks to their serving Network Server (sNS). Downlink messages of the serving Network Server are scheduled on
+// the gateways that received the uplink message.
+package roaming
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Client is the interop client used to contact serving Network Servers.
+type Client interface {
+	RoamingAgreements() []interop.RoamingAgreement
+	PRStartRequest(context.Context, *interop.PRStartReq) (*interop.PRStartAns, error)
+	XmitDataRequest(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+}
+
+// DownlinkScheduler schedules downlink messages on gateways.
+type DownlinkScheduler interface {
+	ScheduleDownlink(context.Context, *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error)
+}
+
+// Config configures the Handler.
+type Config struct {
+	// NetID is the NetID of the forwarding network.
+	NetID types.NetID
+	// DevAddrPrefixes limits the DevAddr prefixes of partner networks that are forwarded.
+	DevAddrPrefixes []types.DevAddrPrefix
+	// Client is the interop client.
+	Client Client
+	// Scheduler schedules downlink messages of serving Network Servers. If nil, downlink is not allowed.
+	Scheduler DownlinkScheduler
+}
+
+type agreement struct {
+	interop.RoamingAgreement
+	prefix types.DevAddrPrefix
+}
+
+type sessionKey struct {
+	netID   types.NetID
+	devAddr types.DevAddr
+}
+
+type session struct {
+	devEUI    *types.EUI64
+	expiresAt time.Time
+}
+
+// Handler is the upstream handler.
+type Handler struct {
+	Config
+
+	agreements     []agreement
+	joinAgreements []interop.RoamingAgreement
+
+	sessionsMu sync.RWMutex
+	sessions   map[sessionKey]session
+}
+
+// NewHandler returns a new upstream handler.
+// Only partner networks with passive roaming enabled whose DevAddr prefix is within the configured DevAddr prefixes
+// are handled. Join-request messages are forwarded to the partner networks by their JoinEUI prefixes.
+func NewHandler(ctx context.Context, config Config) *Handler {
+	var (
+		agreements     []agreement
+		joinAgreements []interop.RoamingAgreement
+	)
+	for _, a := range config.Client.RoamingAgreements() {
+		if a.PassiveRoamingProfile == interop.PassiveRoamingDisabled || a.NetID.Equal(config.NetID) {
+			continue
+		}
+		if len(a.PassiveRoamingJoinEUIs) > 0 {
+			joinAgreements = append(joinAgreements, a)
+		}
+		devAddr, err := types.NewDevAddr(a.NetID, nil)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("net_id", a.NetID).Warn("Invalid roaming partner NetID")
+			continue
+		}
+		prefix := types.DevAddrPrefix{
+			DevAddr: devAddr,
+			Length:  uint8(32 - types.NwkAddrBits(a.NetID)),
+		}
+		for _, p := range config.DevAddrPrefixes {
+			if p.Length <= prefix.Length && prefix.DevAddr.HasPrefix(p) {
+				agreements = append(agreements, agreement{
+					RoamingAgreement: a,
+					prefix:           prefix,
+				})
+				break
+			}
+		}
+	}
+	return &Handler{
+		Config:         config,
+		agreements:     agreements,
+		joinAgreements: joinAgreements,
+		sessions:       make(map[sessionKey]session),
+	}
+}
+
+// DevAddrPrefixes implements upstream.Handler.
+func (h *Handler) DevAddrPrefixes() []types.DevAddrPrefix {
+	res := make([]types.DevAddrPrefix, len(h.agreements))
+	for i, a := range h.agreements {
+		res[i] = a.prefix
+	}
+	return res
+}
+
+// Setup implements upstream.Handler.
+func (h *Handler) Setup(context.Context) error {
+	return nil
+}
+
+// ConnectGateway implements upstream.Handler.
+func (h *Handler) ConnectGateway(context.Context, *ttnpb.GatewayIdentifiers, *io.Connection) error {
+	return nil
+}
+
+func (h *Handler) agreement(devAddr types.DevAddr) (agreement, bool) {
+	for _, a := range h.agreements {
+		if devAddr.HasPrefix(a.prefix) {
+			return a, true
+		}
+	}
+	return agreement{}, false
+}
+
+// joinAgreement returns the roaming agreement with the most specific JoinEUI prefix matching the JoinEUI.
+func (h *Handler) joinAgreement(joinEUI types.EUI64) (interop.RoamingAgreement, bool) {
+	var (
+		res    interop.RoamingAgreement
+		length = -1
+	)
+	for _, a := range h.joinAgreements {
+		for _, prefix := range a.PassiveRoamingJoinEUIs {
+			if int(prefix.Length) > length && prefix.Matches(joinEUI) {
+				res, length = a, int(prefix.Length)
+			}
+		}
+	}
+	return res, length >= 0
+}
+
+func (h *Handler) agreementByNetID(netID types.NetID) (interop.RoamingAgreement, bool) {
+	for _, a := range h.agreements {
+		if a.NetID.Equal(netID) {
+			return a.RoamingAgreement, true
+		}
+	}
+	for _, a := range h.joinAgreements {
+		if a.NetID.Equal(netID) {
+			return a, true
+		}
+	}
+	return interop.RoamingAgreement{}, false
+}
+
+var (
+	errNoRoamingAgreement = errors.DefineNotFound(
+		"no_roaming_agreement", "no roaming agreement for DevAddr `{dev_addr}`",
+	)
+	errUnknownBand     = errors.DefineInvalidArgument("unknown_band", "unknown band `{band_id}`")
+	errUnknownDataRate = errors.DefineInvalidArgument("unknown_data_rate", "unknown data rate")
+	errUplinkToken     = errors.DefineInvalidArgument("uplink_token", "invalid uplink token")
+	errUnknownClass    = errors.DefineInvalidArgument("unknown_class", "unknown class `{class}`")
+	errNoDownlinkPath  = errors.DefineInvalidArgument("no_downlink_path", "no downlink path")
+)
+
+// uplinkToken is the FNSULToken of uplink messages forwarded to serving Network Servers.
+// It contains the band of the uplink message, which is needed to interpret the downlink data rates.
+type uplinkToken struct {
+	BandID string `json:"b"`
+}
+
+func parseUplinkToken(buf []byte) (*uplinkToken, error) {
+	token := &uplinkToken{}
+	if err := json.Unmarshal(buf, token); err != nil {
+		return nil, errUplinkToken.WithCause(err)
+	}
+	if token.BandID == "" {
+		return nil, errUplinkToken.New()
+	}
+	return token, nil
+}
+
+func (h *Handler) uplinkMetadata(
+	gtwIDs *ttnpb.GatewayIdentifiers, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.GatewayUplinkMessage,
+) (*interop.ULMetaData, error) {
+	b, err := band.GetLatest(msg.BandId)
+	if err != nil {
+		return nil, errUnknownBand.WithCause(err).WithAttributes("band_id", msg.BandId)
+	}
+	rfRegion, err := interop.RFRegionFromBandID(msg.BandId)
+	if err != nil {
+		return nil, err
+	}
+	up := msg.Message
+	drIdx, _, ok := b.FindUplinkDataRate(up.GetSettings().GetDataRate())
+	if !ok {
+		return nil, errUnknownDataRate.New()
+	}
+	fnsULToken, err := json.Marshal(uplinkToken{BandID: msg.BandId})
+	if err != nil {
+		return nil, err
+	}
+	var (
+		dataRate = int(drIdx)
+		ulFreq   = float64(up.GetSettings().GetFrequency()) / 1e6
+		gwCnt    = len(up.RxMetadata)
+	)
+	var gtwID interop.Buffer
+	if eui := types.MustEUI64(gtwIDs.GetEui()); eui != nil {
+		gtwID = interop.Buffer(eui[:])
+	}
+	gwInfo := make([]interop.GWInfoElement, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		var (
+			rssi = int32(math.Round(float64(md.ChannelRssi)))
+			snr  = md.Snr
+		)
+		info := interop.GWInfoElement{
+			ID:       gtwID,
+			RFRegion: rfRegion,
+			RSSI:     &rssi,
+			SNR:      &snr,
+			ULToken:  interop.Buffer(md.UplinkToken),
+			DLAllowed: h.Scheduler != nil && len(md.UplinkToken) > 0 &&
+				md.DownlinkPathConstraint != ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if loc := md.GetLocation(); loc != nil {
+			lat, lon := loc.Latitude, loc.Longitude
+			info.Lat, info.Lon = &lat, &lon
+		}
+		gwInfo = append(gwInfo, info)
+	}
+	recvTime := time.Now()
+	if up.ReceivedAt != nil {
+		recvTime = *ttnpb.StdTime(up.ReceivedAt)
+	}
+	res := &interop.ULMetaData{
+		DataRate:   &dataRate,
+		ULFreq:     &ulFreq,
+		FNSULToken: interop.Buffer(fnsULToken),
+		RecvTime:   interop.ISO8601Time(recvTime),
+		RFRegion:   rfRegion,
+		GWCnt:      &gwCnt,
+		GWInfo:     gwInfo,
+	}
+	if devAddr := types.MustDevAddr(ids.DevAddr); devAddr != nil {
+		res.DevAddr = (*interop.DevAddr)(devAddr)
+	}
+	if devEUI := types.MustEUI64(ids.DevEui); devEUI != nil {
+		res.DevEUI = (*interop.EUI64)(devEUI)
+	}
+	return res, nil
+}
+
+func (h *Handler) header(netID types.NetID) interop.NsNsMessageHeader {
+	return interop.NsNsMessageHeader{
+		SenderID:   interop.NetID(h.NetID),
+		ReceiverID: interop.NetID(netID),
+	}
+}
+
+func (h *Handler) startSession(
+	ctx context.Context, a interop.RoamingAgreement, msg *ttnpb.GatewayUplinkMessage, md *interop.ULMetaData,
+) error {
+	ans, err := h.Client.PRStartRequest(ctx, &interop.PRStartReq{
+		NsNsMessageHeader: h.header(a.NetID),
+		PHYPayload:        interop.Buffer(msg.Message.RawPayload),
+		ULMetaData:        *md,
+	})
+	if err != nil {
+		return err
+	}
+	if a.PassiveRoamingProfile != interop.PassiveRoamingStateful || md.DevAddr == nil ||
+		ans.Lifetime == nil || *ans.Lifetime == 0 {
+		return nil
+	}
+	s := session{
+		devEUI:    (*types.EUI64)(ans.DevEUI),
+		expiresAt: time.Now().Add(time.Duration(*ans.Lifetime) * time.Second),
+	}
+	h.sessionsMu.Lock()
+	// Prune expired sessions of end devices that are no longer roaming in this network.
+	for key, s := range h.sessions {
+		if s.expiresAt.Before(time.Now()) {
+			delete(h.sessions, key)
+		}
+	}
+	h.sessions[sessionKey{netID: a.NetID, devAddr: types.DevAddr(*md.DevAddr)}] = s
+	h.sessionsMu.Unlock()
+	return nil
+}
+
+func (h *Handler) session(key sessionKey) bool {
+	h.sessionsMu.RLock()
+	s, ok := h.sessions[key]
+	h.sessionsMu.RUnlock()
+	if !ok {
+		return false
+	}
+	if time.Now().After(s.expiresAt) {
+		h.deleteSession(key)
+		return false
+	}
+	return true
+}
+
+func (h *Handler) deleteSession(key sessionKey) {
+	h.sessionsMu.Lock()
+	delete(h.sessions, key)
+	h.sessionsMu.Unlock()
+}
+
+// handleJoinRequest forwards the join-request message to the partner network of the JoinEUI.
+// Join-request messages are always forwarded with PRStartReq; the serving Network Server does not start a stateful
+// session for join-request messages.
+func (h *Handler) handleJoinRequest(
+	ctx context.Context, gtwIDs *ttnpb.GatewayIdentifiers, ids *ttnpb.EndDeviceIdentifiers,
+	msg *ttnpb.GatewayUplinkMessage,
+) error {
+	joinEUI := types.MustEUI64(ids.GetJoinEui())
+	if joinEUI == nil {
+		return nil
+	}
+	a, ok := h.joinAgreement(*joinEUI)
+	if !ok {
+		// The join-request message is not of an end device of a partner network.
+		return nil
+	}
+	md, err := h.uplinkMetadata(gtwIDs, ids, msg)
+	if err != nil {
+		return err
+	}
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"net_id", a.NetID,
+		"join_eui", *joinEUI,
+	))
+	return h.startSession(ctx, a, msg, md)
+}
+
+// HandleUplink implements upstream.Handler.
+func (h *Handler) HandleUplink(
+	ctx context.Context, gtwIDs *ttnpb.GatewayIdentifiers, ids *ttnpb.EndDeviceIdentifiers,
+	msg *ttnpb.GatewayUplinkMessage,
+) error {
+	devAddr := types.MustDevAddr(ids.GetDevAddr())
+	if devAddr == nil {
+		return h.handleJoinRequest(ctx, gtwIDs, ids, msg)
+	}
+	a, ok := h.agreement(*devAddr)
+	if !ok {
+		return errNoRoamingAgreement.WithAttributes("dev_addr", *devAddr)
+	}
+	md, err := h.uplinkMetadata(gtwIDs, ids, msg)
+	if err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"net_id", a.NetID,
+		"dev_addr", *devAddr,
+		"profile", a.PassiveRoamingProfile,
+	))
+	ctx = log.NewContext(ctx, logger)
+
+	key := sessionKey{netID: a.NetID, devAddr: *devAddr}
+	if a.PassiveRoamingProfile != interop.PassiveRoamingStateful || !h.session(key) {
+		return h.startSession(ctx, a.RoamingAgreement, msg, md)
+	}
+	_, err = h.Client.XmitDataRequest(ctx, &interop.XmitDataReq{
+		NsNsMessageHeader: h.header(a.NetID),
+		PHYPayload:        interop.Buffer(msg.Message.RawPayload),
+		ULMetaData:        md,
+	})
+	if errors.Resemble(err, interop.ErrUnknownDevAddr) {
+		// The serving Network Server no longer has the session; start a new one.
+		logger.Debug("Passive roaming session unknown to serving Network Server, start new session")
+		h.deleteSession(key)
+		return h.startSession(ctx, a.RoamingAgreement, msg, md)
+	}
+	return err
+}
+
+// HandleStatus implements upstream.Handler.
+func (h *Handler) HandleStatus(context.Context, *ttnpb.GatewayIdentifiers, *ttnpb.GatewayStatus) error {
+	return nil
+}
+
+// HandleTxAck implements upstream.Handler.
+func (h *Handler) HandleTxAck(context.Context, *ttnpb.GatewayIdentifiers, *ttnpb.TxAcknowledgment) error {
+	return nil
+}
+
+// PRStopRequest implements interop.ForwardingNetworkServer.
+// The passive roaming sessions of the end device are terminated.
+func (h *Handler) PRStopRequest(ctx context.Context, req *interop.PRStopReq) (*interop.PRStopAns, error) {
+	netID := types.NetID(req.SenderID)
+	if err := (interop.Authorizer{}).RequireNetID(ctx, netID); err != nil {
+		return nil, interop.ErrUnknownSender.WithCause(err)
+	}
+	if req.DevAddr == nil && req.DevEUI == nil {
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	var found bool
+	h.sessionsMu.Lock()
+	for key, s := range h.sessions {
+		if !key.netID.Equal(netID) {
+			continue
+		}
+		if req.DevAddr != nil && key.devAddr.Equal(types.DevAddr(*req.DevAddr)) ||
+			req.DevEUI != nil && s.devEUI != nil && s.devEUI.Equal(types.EUI64(*req.DevEUI)) {
+			delete(h.sessions, key)
+			found = true
+		}
+	}
+	h.sessionsMu.Unlock()
+	if !found {
+		if req.DevAddr != nil {
+			return nil, interop.ErrUnknownDevAddr.New()
+		}
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+
+	header, err := req.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.PRStopAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+var classModes = map[string]ttnpb.Class{
+	"":  ttnpb.Class_CLASS_A,
+	"A": ttnpb.Class_CLASS_A,
+	"B": ttnpb.Class_CLASS_B,
+	"C": ttnpb.Class_CLASS_C,
+}
+
+// txRequest returns the Tx request of the downlink metadata.
+func txRequest(md *interop.DLMetaData) (*ttnpb.TxRequest, error) {
+	token, err := parseUplinkToken(md.FNSULToken)
+	if err != nil {
+		return nil, err
+	}
+	phy, err := band.GetLatest(token.BandID)
+	if err != nil {
+		return nil, errUnknownBand.WithCause(err).WithAttributes("band_id", token.BandID)
+	}
+	class, ok := classModes[md.ClassMode]
+	if !ok {
+		return nil, errUnknownClass.WithAttributes("class", md.ClassMode)
+	}
+	// NOTE: The frequency plan ID cannot be inferred from the downlink metadata. It is intentionally left blank, which
+	// makes the Gateway Server fallback to the single frequency plan configured for the gateway.
+	req := &ttnpb.TxRequest{
+		Class:    class,
+		Priority: ttnpb.TxSchedulePriority_NORMAL,
+	}
+	if md.HiPriorityFlag {
+		req.Priority = ttnpb.TxSchedulePriority_HIGHEST
+	}
+	if md.RXDelay1 != nil {
+		req.Rx1Delay = ttnpb.RxDelay(*md.RXDelay1)
+	}
+	for _, rx := range []struct {
+		dataRateIndex *int
+		frequencyMHz  *float64
+		dataRate      **ttnpb.DataRate
+		frequency     *uint64
+	}{
+		{md.DataRate1, md.DLFreq1, &req.Rx1DataRate, &req.Rx1Frequency},
+		{md.DataRate2, md.DLFreq2, &req.Rx2DataRate, &req.Rx2Frequency},
+	} {
+		if rx.dataRateIndex == nil || rx.frequencyMHz == nil {
+			continue
+		}
+		dr, ok := phy.DataRates[ttnpb.DataRateIndex(*rx.dataRateIndex)]
+		if !ok {
+			return nil, errUnknownDataRate.New()
+		}
+		*rx.dataRate = dr.Rate
+		*rx.frequency = uint64(math.Round(*rx.frequencyMHz * 1e6))
+	}
+	for _, gwInfo := range md.GWInfo {
+		if len(gwInfo.ULToken) == 0 {
+			continue
+		}
+		req.DownlinkPaths = append(req.DownlinkPaths, &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_UplinkToken{
+				UplinkToken: gwInfo.ULToken,
+			},
+		})
+	}
+	if len(req.DownlinkPaths) == 0 {
+		return nil, errNoDownlinkPath.New()
+	}
+	return req, nil
+}
+
+// XmitDataRequest implements interop.ForwardingNetworkServer.
+// The downlink message is scheduled on the gateways of the downlink metadata, identified by the uplink tokens of the
+// uplink message that the serving Network Server responds to.
+func (h *Handler) XmitDataRequest(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	netID := types.NetID(req.SenderID)
+	if err := (interop.Authorizer{}).RequireNetID(ctx, netID); err != nil {
+		return nil, interop.ErrUnknownSender.WithCause(err)
+	}
+	if _, ok := h.agreementByNetID(netID); !ok {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	if req.DLMetaData == nil || len(req.PHYPayload) == 0 {
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	if h.Scheduler == nil {
+		return nil, interop.ErrTransmitFailed.New()
+	}
+	txReq, err := txRequest(req.DLMetaData)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"net_id", netID,
+		"class", txReq.Class,
+		"path_count", len(txReq.DownlinkPaths),
+	))
+	ctx = log.NewContext(ctx, logger)
+	if _, err := h.Scheduler.ScheduleDownlink(ctx, &ttnpb.DownlinkMessage{
+		RawPayload: req.PHYPayload,
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: txReq,
+		},
+	}); err != nil {
+		logger.WithError(err).Debug("Failed to schedule passive roaming downlink")
+		return nil, interop.ErrTransmitFailed.WithCause(err)
+	}
+
+	header, err := req.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DLFreq1: req.DLMetaData.DLFreq1,
+		DLFreq2: req.DLMetaData.DLFreq2,
+	}, nil
+}
@@ -0,0 +1,467 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package roaming_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/roaming"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	localNetID     = types.NetID{0x00, 0x00, 0x01}
+	statefulNetID  = types.NetID{0x00, 0x00, 0x13}
+	statelessNetID = types.NetID{0x00, 0x00, 0x42}
+	disabledNetID  = types.NetID{0x00, 0x00, 0x43}
+
+	statelessJoinEUIPrefix = types.EUI64Prefix{
+		EUI64:  types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00},
+		Length: 40,
+	}
+)
+
+type mockClient struct {
+	mu        sync.Mutex
+	prStarts  []*interop.PRStartReq
+	xmitDatas []*interop.XmitDataReq
+	xmitErr   error
+}
+
+func (*mockClient) RoamingAgreements() []interop.RoamingAgreement {
+	return []interop.RoamingAgreement{
+		{
+			NetID:                 localNetID,
+			PassiveRoamingProfile: interop.PassiveRoamingStateless,
+		},
+		{
+			NetID:                  statefulNetID,
+			PassiveRoamingProfile:  interop.PassiveRoamingStateful,
+			PassiveRoamingLifetime: time.Hour,
+		},
+		{
+			NetID:                  statelessNetID,
+			PassiveRoamingProfile:  interop.PassiveRoamingStateless,
+			PassiveRoamingJoinEUIs: []types.EUI64Prefix{statelessJoinEUIPrefix},
+		},
+		{
+			NetID: disabledNetID,
+		},
+	}
+}
+
+func (c *mockClient) PRStartRequest(_ context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prStarts = append(c.prStarts, req)
+	ans := &interop.PRStartAns{
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}
+	if types.NetID(req.ReceiverID).Equal(statefulNetID) {
+		lifetime := uint32(3600)
+		ans.Lifetime = &lifetime
+	}
+	return ans, nil
+}
+
+func (c *mockClient) XmitDataRequest(_ context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.xmitDatas = append(c.xmitDatas, req)
+	if c.xmitErr != nil {
+		return nil, c.xmitErr
+	}
+	return &interop.XmitDataAns{
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+func (c *mockClient) counts() (int, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.prStarts), len(c.xmitDatas)
+}
+
+type mockScheduler struct {
+	mu    sync.Mutex
+	downs []*ttnpb.DownlinkMessage
+	err   error
+}
+
+func (s *mockScheduler) ScheduleDownlink(
+	_ context.Context, down *ttnpb.DownlinkMessage,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.downs = append(s.downs, down)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &ttnpb.ScheduleDownlinkResponse{}, nil
+}
+
+func devAddrPrefix(netID types.NetID) types.DevAddrPrefix {
+	return types.DevAddrPrefix{
+		DevAddr: test.Must(types.NewDevAddr(netID, nil)).(types.DevAddr),
+		Length:  uint8(32 - types.NwkAddrBits(netID)),
+	}
+}
+
+func TestDevAddrPrefixes(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	h := NewHandler(ctx, Config{
+		NetID:           localNetID,
+		DevAddrPrefixes: []types.DevAddrPrefix{{}},
+		Client:          &mockClient{},
+	})
+	a.So(h.DevAddrPrefixes(), should.Resemble, []types.DevAddrPrefix{
+		devAddrPrefix(statefulNetID),
+		devAddrPrefix(statelessNetID),
+	})
+
+	h = NewHandler(ctx, Config{
+		NetID:           localNetID,
+		DevAddrPrefixes: []types.DevAddrPrefix{devAddrPrefix(statelessNetID)},
+		Client:          &mockClient{},
+	})
+	a.So(h.DevAddrPrefixes(), should.Resemble, []types.DevAddrPrefix{
+		devAddrPrefix(statelessNetID),
+	})
+}
+
+func TestHandleUplink(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl := &mockClient{}
+	h := NewHandler(ctx, Config{
+		NetID:           localNetID,
+		DevAddrPrefixes: []types.DevAddrPrefix{{}},
+		Client:          cl,
+	})
+
+	gtwIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: "test-gateway",
+		Eui:       types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01}.Bytes(),
+	}
+	newUplink := func(devAddr types.DevAddr) (*ttnpb.EndDeviceIdentifiers, *ttnpb.GatewayUplinkMessage) {
+		ids := &ttnpb.EndDeviceIdentifiers{
+			DevAddr: devAddr.Bytes(),
+		}
+		msg := &ttnpb.GatewayUplinkMessage{
+			BandId: band.EU_863_870,
+			Message: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0x40, devAddr[3], devAddr[2], devAddr[1], devAddr[0]},
+				Settings: &ttnpb.TxSettings{
+					DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+					Frequency: 868100000,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIds:  gtwIDs,
+						ChannelRssi: -42,
+						Snr:         5.5,
+						UplinkToken: []byte{0x01, 0x02},
+					},
+				},
+				ReceivedAt: ttnpb.ProtoTimePtr(time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC)),
+			},
+		}
+		return ids, msg
+	}
+
+	// Join-request messages of JoinEUIs without roaming agreement are not forwarded.
+	err := h.HandleUplink(ctx, gtwIDs, &ttnpb.EndDeviceIdentifiers{
+		JoinEui: types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+		DevEui:  types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+	}, &ttnpb.GatewayUplinkMessage{})
+	a.So(err, should.BeNil)
+	prStarts, xmitDatas := cl.counts()
+	a.So(prStarts, should.Equal, 0)
+	a.So(xmitDatas, should.Equal, 0)
+
+	// Join-request messages are forwarded to the partner network of the JoinEUI.
+	joinIDs := &ttnpb.EndDeviceIdentifiers{
+		JoinEui: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}.Bytes(),
+		DevEui:  types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x02}.Bytes(),
+	}
+	_, joinMsg := newUplink(types.DevAddr{})
+	joinMsg.Message.RawPayload = make([]byte, 23)
+	a.So(h.HandleUplink(ctx, gtwIDs, joinIDs, joinMsg), should.BeNil)
+	prStarts, xmitDatas = cl.counts()
+	a.So(prStarts, should.Equal, 1)
+	a.So(xmitDatas, should.Equal, 0)
+	joinReq := cl.prStarts[0]
+	a.So(joinReq.ReceiverID, should.Equal, interop.NetID(statelessNetID))
+	a.So(joinReq.ULMetaData.DevAddr, should.BeNil)
+	a.So(*joinReq.ULMetaData.DevEUI, should.Equal, interop.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x02})
+	cl.mu.Lock()
+	cl.prStarts = nil
+	cl.mu.Unlock()
+
+	// Stateless profile sends PRStartReq for every uplink message.
+	statelessDevAddr := devAddrPrefix(statelessNetID).DevAddr
+	statelessDevAddr[3] = 0x01
+	for i := 0; i < 2; i++ {
+		ids, msg := newUplink(statelessDevAddr)
+		a.So(h.HandleUplink(ctx, gtwIDs, ids, msg), should.BeNil)
+	}
+	prStarts, xmitDatas = cl.counts()
+	a.So(prStarts, should.Equal, 2)
+	a.So(xmitDatas, should.Equal, 0)
+
+	req := cl.prStarts[0]
+	a.So(req.SenderID, should.Equal, interop.NetID(localNetID))
+	a.So(req.ReceiverID, should.Equal, interop.NetID(statelessNetID))
+	a.So(req.ULMetaData.RFRegion, should.Equal, interop.RFRegion("EU868"))
+	a.So(*req.ULMetaData.DataRate, should.Equal, 5)
+	a.So(*req.ULMetaData.ULFreq, should.Equal, 868.1)
+	a.So(*req.ULMetaData.DevAddr, should.Equal, interop.DevAddr(statelessDevAddr))
+	if a.So(req.ULMetaData.GWInfo, should.HaveLength, 1) {
+		gwInfo := req.ULMetaData.GWInfo[0]
+		a.So(gwInfo.ID, should.Resemble, interop.Buffer(gtwIDs.Eui))
+		a.So(*gwInfo.RSSI, should.Equal, -42)
+		a.So(*gwInfo.SNR, should.Equal, 5.5)
+		a.So(gwInfo.ULToken, should.Resemble, interop.Buffer{0x01, 0x02})
+		a.So(gwInfo.DLAllowed, should.BeFalse)
+	}
+
+	// Stateful profile sends PRStartReq for the first uplink message, and XmitDataReq for subsequent messages.
+	statefulDevAddr := devAddrPrefix(statefulNetID).DevAddr
+	statefulDevAddr[3] = 0x01
+	for i := 0; i < 3; i++ {
+		ids, msg := newUplink(statefulDevAddr)
+		a.So(h.HandleUplink(ctx, gtwIDs, ids, msg), should.BeNil)
+	}
+	prStarts, xmitDatas = cl.counts()
+	a.So(prStarts, should.Equal, 3)
+	a.So(xmitDatas, should.Equal, 2)
+
+	// If the serving Network Server does not know the session, a new session is started.
+	cl.mu.Lock()
+	cl.xmitErr = interop.ErrUnknownDevAddr.New()
+	cl.mu.Unlock()
+	ids, msg := newUplink(statefulDevAddr)
+	a.So(h.HandleUplink(ctx, gtwIDs, ids, msg), should.BeNil)
+	prStarts, xmitDatas = cl.counts()
+	a.So(prStarts, should.Equal, 4)
+	a.So(xmitDatas, should.Equal, 3)
+
+	// The serving Network Server stops the session.
+	cl.mu.Lock()
+	cl.xmitErr = nil
+	cl.mu.Unlock()
+	_, err = h.PRStopRequest(ctx, &interop.PRStopReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			MessageHeader: interop.MessageHeader{
+				ProtocolVersion: interop.ProtocolV1_1,
+				MessageType:     interop.MessageTypePRStopReq,
+			},
+			SenderID:   interop.NetID(statefulNetID),
+			ReceiverID: interop.NetID(localNetID),
+		},
+		DevAddr: (*interop.DevAddr)(&statefulDevAddr),
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrUnknownSender)
+
+	stopCtx := interop.NewContextWithNetworkServerAuthInfo(ctx, &interop.NetworkServerAuthInfo{
+		NetID: statefulNetID,
+	})
+	ans, err := h.PRStopRequest(stopCtx, &interop.PRStopReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			MessageHeader: interop.MessageHeader{
+				ProtocolVersion: interop.ProtocolV1_1,
+				MessageType:     interop.MessageTypePRStopReq,
+			},
+			SenderID:   interop.NetID(statefulNetID),
+			ReceiverID: interop.NetID(localNetID),
+		},
+		DevAddr: (*interop.DevAddr)(&statefulDevAddr),
+	})
+	if a.So(err, should.BeNil) {
+		a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+		a.So(ans.MessageType, should.Equal, interop.MessageTypePRStopAns)
+		a.So(ans.ReceiverID, should.Equal, interop.NetID(statefulNetID))
+	}
+	ids, msg = newUplink(statefulDevAddr)
+	a.So(h.HandleUplink(ctx, gtwIDs, ids, msg), should.BeNil)
+	prStarts, xmitDatas = cl.counts()
+	a.So(prStarts, should.Equal, 5)
+	a.So(xmitDatas, should.Equal, 3)
+
+	// No roaming agreement.
+	disabledDevAddr := devAddrPrefix(disabledNetID).DevAddr
+	ids, msg = newUplink(disabledDevAddr)
+	a.So(h.HandleUplink(ctx, gtwIDs, ids, msg), should.NotBeNil)
+}
+
+func TestXmitDataRequest(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl := &mockClient{}
+	scheduler := &mockScheduler{}
+	h := NewHandler(ctx, Config{
+		NetID:           localNetID,
+		DevAddrPrefixes: []types.DevAddrPrefix{{}},
+		Client:          cl,
+		Scheduler:       scheduler,
+	})
+
+	gtwIDs := &ttnpb.GatewayIdentifiers{
+		GatewayId: "test-gateway",
+		Eui:       types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01}.Bytes(),
+	}
+	devAddr := devAddrPrefix(statelessNetID).DevAddr
+	devAddr[3] = 0x01
+	a.So(h.HandleUplink(ctx, gtwIDs, &ttnpb.EndDeviceIdentifiers{
+		DevAddr: devAddr.Bytes(),
+	}, &ttnpb.GatewayUplinkMessage{
+		BandId: band.EU_863_870,
+		Message: &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, devAddr[3], devAddr[2], devAddr[1], devAddr[0]},
+			Settings: &ttnpb.TxSettings{
+				DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+				Frequency: 868100000,
+			},
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIds:  gtwIDs,
+					ChannelRssi: -42,
+					Snr:         5.5,
+					UplinkToken: []byte{0x01, 0x02},
+				},
+			},
+		},
+	}), should.BeNil)
+	if !a.So(cl.prStarts, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	ulMD := cl.prStarts[0].ULMetaData
+	if !a.So(ulMD.GWInfo, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(ulMD.GWInfo[0].DLAllowed, should.BeTrue)
+
+	var (
+		rxDelay1  = 1
+		dataRate1 = 5
+		dataRate2 = 0
+		dlFreq1   = 868.1
+		dlFreq2   = 869.525
+	)
+	newRequest := func() *interop.XmitDataReq {
+		return &interop.XmitDataReq{
+			NsNsMessageHeader: interop.NsNsMessageHeader{
+				MessageHeader: interop.MessageHeader{
+					ProtocolVersion: interop.ProtocolV1_1,
+					MessageType:     interop.MessageTypeXmitDataReq,
+				},
+				SenderID:   interop.NetID(statelessNetID),
+				ReceiverID: interop.NetID(localNetID),
+			},
+			PHYPayload: interop.Buffer{0x60, devAddr[3], devAddr[2], devAddr[1], devAddr[0]},
+			DLMetaData: &interop.DLMetaData{
+				DLFreq1:    &dlFreq1,
+				DLFreq2:    &dlFreq2,
+				RXDelay1:   &rxDelay1,
+				ClassMode:  "A",
+				DataRate1:  &dataRate1,
+				DataRate2:  &dataRate2,
+				FNSULToken: ulMD.FNSULToken,
+				GWInfo: []interop.GWInfoElement{
+					{
+						ULToken: ulMD.GWInfo[0].ULToken,
+					},
+				},
+			},
+		}
+	}
+
+	// The sender must be authenticated.
+	_, err := h.XmitDataRequest(ctx, newRequest())
+	a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrUnknownSender)
+
+	xmitCtx := interop.NewContextWithNetworkServerAuthInfo(ctx, &interop.NetworkServerAuthInfo{
+		NetID: statelessNetID,
+	})
+	ans, err := h.XmitDataRequest(xmitCtx, newRequest())
+	if a.So(err, should.BeNil) {
+		a.So(ans.Result.ResultCode, should.Equal, interop.ResultSuccess)
+		a.So(ans.MessageType, should.Equal, interop.MessageTypeXmitDataAns)
+		a.So(*ans.DLFreq1, should.Equal, dlFreq1)
+	}
+	if a.So(scheduler.downs, should.HaveLength, 1) {
+		down := scheduler.downs[0]
+		a.So(down.RawPayload, should.Resemble, []byte{0x60, devAddr[3], devAddr[2], devAddr[1], devAddr[0]})
+		a.So(down.GetRequest(), should.Resemble, &ttnpb.TxRequest{
+			Class: ttnpb.Class_CLASS_A,
+			DownlinkPaths: []*ttnpb.DownlinkPath{
+				{
+					Path: &ttnpb.DownlinkPath_UplinkToken{
+						UplinkToken: []byte{0x01, 0x02},
+					},
+				},
+			},
+			Rx1Delay:     ttnpb.RxDelay_RX_DELAY_1,
+			Rx1DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+			Rx1Frequency: 868100000,
+			Rx2DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+			Rx2Frequency: 869525000,
+			Priority:     ttnpb.TxSchedulePriority_NORMAL,
+		})
+	}
+
+	// Scheduling failures are reported as transmission failures.
+	scheduler.mu.Lock()
+	scheduler.err = errors.New("test")
+	scheduler.mu.Unlock()
+	_, err = h.XmitDataRequest(xmitCtx, newRequest())
+	a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrTransmitFailed)
+
+	// Downlink messages without uplink token are malformed.
+	req := newRequest()
+	req.DLMetaData.FNSULToken = nil
+	_, err = h.XmitDataRequest(xmitCtx, req)
+	a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrMalformedMessage)
+
+	// Partner networks without roaming agreement cannot transmit downlink messages.
+	disabledCtx := interop.NewContextWithNetworkServerAuthInfo(ctx, &interop.NetworkServerAuthInfo{
+		NetID: disabledNetID,
+	})
+	req = newRequest()
+	req.SenderID = interop.NetID(disabledNetID)
+	_, err = h.XmitDataRequest(disabledCtx, req)
+	a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrNoRoamingAgreement)
+}
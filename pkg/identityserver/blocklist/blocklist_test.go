@@ -45,6 +45,8 @@ func TestGlobalBlocklist(t *testing.T) {
 	ctx := blocklist.NewContext(test.Context(), b)
 
 	a.So(blocklist.Check(ctx, "root"), should.NotBeNil)
+	a.So(blocklist.Check(ctx, "packetbroker"), should.NotBeNil)
+	a.So(blocklist.Check(ctx, "interop"), should.NotBeNil)
 	a.So(blocklist.Check(ctx, "foo"), should.NotBeNil)
 	a.So(blocklist.Check(ctx, "foobar"), should.BeNil)
 }
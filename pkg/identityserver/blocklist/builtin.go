@@ -297,6 +297,7 @@ var builtin = New(
 	"information",
 	"inquiry",
 	"instagram",
+	"interop",
 	"intranet",
 	"invitations",
 	"invite",
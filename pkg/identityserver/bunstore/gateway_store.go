@@ -92,6 +92,10 @@ type Gateway struct {
 	SupportsLRFHSS bool `bun:"supports_lrfhss,notnull"`
 
 	DisablePacketBrokerForwarding bool `bun:"disable_packet_broker_forwarding,notnull"`
+
+	TransmitBeacons bool `bun:"transmit_beacons,notnull"`
+
+	UplinkFilters []byte `bun:"uplink_filters,nullzero"`
 }
 
 // BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
@@ -172,8 +176,16 @@ func gatewayToPB(m *Gateway, fieldMask ...string) (*ttnpb.Gateway, error) {
 		}(),
 
 		DisablePacketBrokerForwarding: m.DisablePacketBrokerForwarding,
+
+		TransmitBeacons: m.TransmitBeacons,
 	}
 
+	uplinkFilters, err := uplinkFiltersFromBytes(m.UplinkFilters)
+	if err != nil {
+		return nil, err
+	}
+	pb.UplinkFilters = uplinkFilters
+
 	if len(m.Attributes) > 0 {
 		pb.Attributes = make(map[string]string, len(m.Attributes))
 		for _, a := range m.Attributes {
@@ -239,6 +251,11 @@ func (s *gatewayStore) CreateGateway(
 	))
 	defer span.End()
 
+	uplinkFilters, err := uplinkFiltersToBytes(pb.UplinkFilters)
+	if err != nil {
+		return nil, err
+	}
+
 	gatewayModel := &Gateway{
 		GatewayID:   pb.GetIds().GetGatewayId(),
 		GatewayEUI:  eui64ToString(types.MustEUI64(pb.GetIds().GetEui())),
@@ -282,6 +299,10 @@ func (s *gatewayStore) CreateGateway(
 		SupportsLRFHSS: pb.Lrfhss.GetSupported(),
 
 		DisablePacketBrokerForwarding: pb.DisablePacketBrokerForwarding,
+
+		TransmitBeacons: pb.TransmitBeacons,
+
+		UplinkFilters: uplinkFilters,
 	}
 
 	if contact := pb.AdministrativeContact; contact != nil {
@@ -301,7 +322,7 @@ func (s *gatewayStore) CreateGateway(
 		gatewayModel.TechnicalContactID = &account.ID
 	}
 
-	_, err := s.DB.NewInsert().
+	_, err = s.DB.NewInsert().
 		Model(gatewayModel).
 		Exec(ctx)
 	if err != nil {
@@ -373,7 +394,9 @@ func (*gatewayStore) selectWithFields(q *bun.SelectQuery, fieldMask store.FieldM
 				"lbs_lns_secret",
 				"target_cups_uri", "target_cups_key",
 				"require_authenticated_connection",
-				"disable_packet_broker_forwarding":
+				"disable_packet_broker_forwarding",
+				"transmit_beacons",
+				"uplink_filters":
 				// Proto name equals model name.
 				columns = append(columns, f)
 			case "version_ids":
@@ -750,6 +773,17 @@ func (s *gatewayStore) updateGatewayModel( //nolint:gocyclo
 		case "disable_packet_broker_forwarding":
 			model.DisablePacketBrokerForwarding = pb.DisablePacketBrokerForwarding
 			columns = append(columns, "disable_packet_broker_forwarding")
+
+		case "transmit_beacons":
+			model.TransmitBeacons = pb.TransmitBeacons
+			columns = append(columns, "transmit_beacons")
+
+		case "uplink_filters":
+			model.UplinkFilters, err = uplinkFiltersToBytes(pb.UplinkFilters)
+			if err != nil {
+				return err
+			}
+			columns = append(columns, "uplink_filters")
 		}
 	}
 
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/uptrace/bun"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -146,6 +147,24 @@ func secretToBytes(secret *ttnpb.Secret) []byte {
 	return buf.Bytes()
 }
 
+func uplinkFiltersFromBytes(b []byte) ([]*ttnpb.GatewayUplinkFilter, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	filters := &ttnpb.GatewayUplinkFilters{}
+	if err := proto.Unmarshal(b, filters); err != nil {
+		return nil, err
+	}
+	return filters.Filters, nil
+}
+
+func uplinkFiltersToBytes(filters []*ttnpb.GatewayUplinkFilter) ([]byte, error) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	return proto.Marshal(&ttnpb.GatewayUplinkFilters{Filters: filters})
+}
+
 func eui64FromString(s *string) *types.EUI64 {
 	if s == nil {
 		return nil
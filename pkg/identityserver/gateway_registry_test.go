@@ -137,6 +137,16 @@ func TestGatewaysCRUD(t *testing.T) {
 
 		is.config.UserRights.CreateGateways = true
 
+		_, err = reg.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway: &ttnpb.Gateway{
+				Ids: &ttnpb.GatewayIdentifiers{
+					GatewayId: "interop",
+				},
+			},
+			Collaborator: usr1.GetOrganizationOrUserIdentifiers(),
+		}, creds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
 		created, err := reg.Create(ctx, &ttnpb.CreateGatewayRequest{
 			Gateway: &ttnpb.Gateway{
 				Ids: &ttnpb.GatewayIdentifiers{
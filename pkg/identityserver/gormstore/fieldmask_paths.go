@@ -70,10 +70,12 @@ const (
 	targetCUPSKeyField                  = "target_cups_key"
 	targetCUPSURIField                  = "target_cups_uri"
 	technicalContactField               = "technical_contact"
+	transmitBeaconsField                = "transmit_beacons"
 	temporaryPasswordCreatedAtField     = "temporary_password_created_at"
 	temporaryPasswordExpiresAtField     = "temporary_password_expires_at"
 	temporaryPasswordField              = "temporary_password"
 	updateChannelField                  = "update_channel"
 	updateLocationFromStatusField       = "update_location_from_status"
+	uplinkFiltersField                  = "uplink_filters"
 	versionIDsField                     = "version_ids"
 )
@@ -20,6 +20,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -87,6 +88,10 @@ type Gateway struct {
 	SupportsLRFHSS bool `gorm:"default:false not null"`
 
 	DisablePacketBrokerForwarding bool `gorm:"default:false not null"`
+
+	TransmitBeacons bool `gorm:"default:false not null"`
+
+	UplinkFilters []byte `gorm:"type:BYTEA"`
 }
 
 func init() {
@@ -269,6 +274,19 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 	disablePacketBrokerForwardingField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		pb.DisablePacketBrokerForwarding = gtw.DisablePacketBrokerForwarding
 	},
+	transmitBeaconsField: func(pb *ttnpb.Gateway, gtw *Gateway) {
+		pb.TransmitBeacons = gtw.TransmitBeacons
+	},
+	uplinkFiltersField: func(pb *ttnpb.Gateway, gtw *Gateway) {
+		pb.UplinkFilters = nil
+		if len(gtw.UplinkFilters) == 0 {
+			return
+		}
+		filters := &ttnpb.GatewayUplinkFilters{}
+		if err := proto.Unmarshal(gtw.UplinkFilters, filters); err == nil {
+			pb.UplinkFilters = filters.Filters
+		}
+	},
 }
 
 // functions to set fields from the gateway proto into the gateway model.
@@ -438,6 +456,16 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 	disablePacketBrokerForwardingField: func(gtw *Gateway, pb *ttnpb.Gateway) {
 		gtw.DisablePacketBrokerForwarding = pb.DisablePacketBrokerForwarding
 	},
+	transmitBeaconsField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.TransmitBeacons = pb.TransmitBeacons
+	},
+	uplinkFiltersField: func(gtw *Gateway, pb *ttnpb.Gateway) {
+		gtw.UplinkFilters = nil
+		if len(pb.UplinkFilters) == 0 {
+			return
+		}
+		gtw.UplinkFilters, _ = proto.Marshal(&ttnpb.GatewayUplinkFilters{Filters: pb.UplinkFilters})
+	},
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -491,6 +519,8 @@ var gatewayColumnNames = map[string][]string{
 	disablePacketBrokerForwardingField:  {disablePacketBrokerForwardingField},
 	administrativeContactField:          {administrativeContactField + "_id"},
 	technicalContactField:               {technicalContactField + "_id"},
+	transmitBeaconsField:                {transmitBeaconsField},
+	uplinkFiltersField:                  {uplinkFiltersField},
 }
 
 func (gtw Gateway) toPB(pb *ttnpb.Gateway, fieldMask store.FieldMask) {
@@ -0,0 +1 @@
+ALTER TABLE gateways DROP COLUMN transmit_beacons;
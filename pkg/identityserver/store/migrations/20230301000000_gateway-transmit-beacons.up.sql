@@ -0,0 +1 @@
+ALTER TABLE gateways ADD transmit_beacons boolean DEFAULT false NOT NULL;
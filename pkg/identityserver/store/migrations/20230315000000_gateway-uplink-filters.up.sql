@@ -0,0 +1 @@
+ALTER TABLE gateways ADD uplink_filters bytea;
@@ -59,6 +59,21 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 		ValidFrom: ttnpb.ProtoTimePtr(start),
 		Secret:    secret,
 	}
+	uplinkFilters := []*ttnpb.GatewayUplinkFilter{
+		{
+			Action:          ttnpb.GatewayUplinkFilter_DENY,
+			MTypes:          []ttnpb.MType{ttnpb.MType_UNCONFIRMED_UP, ttnpb.MType_CONFIRMED_UP},
+			DevAddrPrefixes: [][]byte{{0x26, 0x01, 0x00, 0x00, 16}},
+			JoinEuiRanges: []*ttnpb.GatewayUplinkFilter_JoinEUIRange{{
+				Start: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}.Bytes(),
+				End:   types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0xff, 0xff}.Bytes(),
+			}},
+		},
+		{
+			Action:    ttnpb.GatewayUplinkFilter_ALLOW,
+			Upstreams: []string{"cluster"},
+		},
+	}
 	var created *ttnpb.Gateway
 
 	t.Run("CreateGateway", func(t *T) {
@@ -98,6 +113,8 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			RequireAuthenticatedConnection: true,
 			Lrfhss:                         &ttnpb.Gateway_LRFHSS{Supported: true},
 			DisablePacketBrokerForwarding:  true,
+			TransmitBeacons:                true,
+			UplinkFilters:                  uplinkFilters,
 		})
 
 		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
@@ -135,6 +152,8 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			a.So(created.RequireAuthenticatedConnection, should.BeTrue)
 			a.So(created.Lrfhss.Supported, should.BeTrue)
 			a.So(created.DisablePacketBrokerForwarding, should.BeTrue)
+			a.So(created.TransmitBeacons, should.BeTrue)
+			a.So(created.UplinkFilters, should.Resemble, uplinkFilters)
 			a.So(*ttnpb.StdTime(created.CreatedAt), should.HappenWithin, 5*time.Second, start)
 			a.So(*ttnpb.StdTime(created.UpdatedAt), should.HappenWithin, 5*time.Second, start)
 		}
@@ -256,6 +275,7 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			RequireAuthenticatedConnection: false,
 			Lrfhss:                         &ttnpb.Gateway_LRFHSS{Supported: false},
 			DisablePacketBrokerForwarding:  false,
+			TransmitBeacons:                false,
 		}, append(mask, "ids.eui"))
 		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
 			a.So(updated.GetIds().GetGatewayId(), should.Equal, "foo")
@@ -293,6 +313,8 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			a.So(updated.RequireAuthenticatedConnection, should.BeFalse)
 			a.So(updated.Lrfhss.GetSupported(), should.BeFalse)
 			a.So(updated.DisablePacketBrokerForwarding, should.BeFalse)
+			a.So(updated.TransmitBeacons, should.BeFalse)
+			a.So(updated.UplinkFilters, should.BeEmpty)
 			a.So(*ttnpb.StdTime(updated.CreatedAt), should.Equal, *ttnpb.StdTime(created.CreatedAt))
 			a.So(*ttnpb.StdTime(updated.UpdatedAt), should.HappenWithin, 5*time.Second, start)
 		}
@@ -315,7 +315,8 @@ type prefixJoinServerClient struct {
 
 // Client is an interop client.
 type Client struct {
-	joinServers []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	joinServers    []prefixJoinServerClient // Sorted by JoinEUI prefix range length.
+	networkServers map[types.NetID]*networkServerHTTPClient
 }
 
 var (
@@ -354,6 +355,10 @@ func NewClient(
 			Components []ComponentSelector `yaml:"components"`
 			JoinEUIs   []types.EUI64Prefix `yaml:"join-euis"`
 		} `yaml:"join-servers"`
+		NetworkServers []struct {
+			File   string        `yaml:"file"`
+			NetIDs []types.NetID `yaml:"net-ids"`
+		} `yaml:"network-servers"`
 	}
 	if err := yaml.UnmarshalStrict(confFileBytes, &yamlConf); err != nil {
 		return nil, err
@@ -443,6 +448,82 @@ func NewClient(
 			})
 		}
 	}
+
+	nss := make(map[types.NetID]*networkServerHTTPClient, len(yamlConf.NetworkServers))
+	for _, nsEntry := range yamlConf.NetworkServers {
+		fileParts := strings.Split(filepath.ToSlash(nsEntry.File), "/")
+		fetcher := fetch.WithBasePath(fetcher, fileParts[:len(fileParts)-1]...)
+		nsFileBytes, err := fetcher.File(fileParts[len(fileParts)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		var nsConf struct {
+			ComponentConfig `yaml:",inline"`
+			Path            string          `yaml:"path"`
+			Protocol        ProtocolVersion `yaml:"protocol"`
+			SenderNSID      *types.EUI64    `yaml:"sender-ns-id,omitempty"`
+			PassiveRoaming  struct {
+				Profile  PassiveRoamingProfile `yaml:"profile"`
+				Lifetime time.Duration         `yaml:"lifetime"`
+				JoinEUIs []types.EUI64Prefix   `yaml:"join-euis"`
+			} `yaml:"passive-roaming"`
+			HandoverRoaming struct {
+				Enabled  bool          `yaml:"enabled"`
+				Lifetime time.Duration `yaml:"lifetime"`
+				KEKLabel string        `yaml:"kek-label"`
+			} `yaml:"handover-roaming"`
+		}
+		if err := yaml.UnmarshalStrict(nsFileBytes, &nsConf); err != nil {
+			return nil, err
+		}
+		switch nsConf.Protocol {
+		case ProtocolV1_0, ProtocolV1_1:
+		default:
+			return nil, errUnknownProtocol.New()
+		}
+		switch nsConf.PassiveRoaming.Profile {
+		case PassiveRoamingDisabled, PassiveRoamingStateless, PassiveRoamingStateful:
+		default:
+			return nil, errUnknownPassiveRoamingProfile.WithAttributes("profile", nsConf.PassiveRoaming.Profile)
+		}
+		var opts []httpclient.Option
+		if !nsConf.TLS.IsZero() {
+			tlsConf, err := nsConf.TLS.TLSConfig(fetcher)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, httpclient.WithTLSConfig(tlsConf))
+		}
+		if nsConf.DNSSuffix != "" || nsConf.FQDN == "" {
+			return nil, errDNSLookupNotSupported.New()
+		}
+		for _, netID := range nsEntry.NetIDs {
+			nss[netID] = &networkServerHTTPClient{
+				clientProvider: httpClientProvider,
+				clientOpts:     opts,
+				protocol:       nsConf.Protocol,
+				senderNSID:     nsConf.SenderNSID,
+				scheme:         nsConf.Scheme,
+				fqdn:           nsConf.FQDN,
+				port:           nsConf.Port,
+				path:           nsConf.Path,
+				headers:        nsConf.Headers,
+				username:       nsConf.BasicAuth.Username,
+				password:       nsConf.BasicAuth.Password,
+				agreement: RoamingAgreement{
+					NetID:                   netID,
+					PassiveRoamingProfile:   nsConf.PassiveRoaming.Profile,
+					PassiveRoamingLifetime:  nsConf.PassiveRoaming.Lifetime,
+					PassiveRoamingJoinEUIs:  nsConf.PassiveRoaming.JoinEUIs,
+					HandoverRoaming:         nsConf.HandoverRoaming.Enabled,
+					HandoverRoamingLifetime: nsConf.HandoverRoaming.Lifetime,
+					HandoverRoamingKEKLabel: nsConf.HandoverRoaming.KEKLabel,
+				},
+			}
+		}
+	}
+
 	sort.Slice(jss, func(i, j int) bool {
 		pi, pj := jss[i].prefix, jss[j].prefix
 		if pi.Length != pj.Length {
@@ -451,7 +532,8 @@ func NewClient(
 		return pi.EUI64.MarshalNumber() > pj.EUI64.MarshalNumber()
 	})
 	return &Client{
-		joinServers: jss,
+		joinServers:    jss,
+		networkServers: nss,
 	}, nil
 }
 
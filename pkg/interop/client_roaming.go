@@ -0,0 +1,249 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// PassiveRoamingProfile is the session profile of passive roaming.
+type PassiveRoamingProfile string
+
+// Passive roaming profiles.
+const (
+	// PassiveRoamingDisabled disables passive roaming.
+	PassiveRoamingDisabled PassiveRoamingProfile = ""
+	// PassiveRoamingStateless is the stateless passive roaming profile.
+	// Each uplink message is forwarded with a PRStartReq and the serving Network Server does not keep a session.
+	PassiveRoamingStateless PassiveRoamingProfile = "stateless"
+	// PassiveRoamingStateful is the stateful passive roaming profile.
+	// The first uplink message is forwarded with a PRStartReq, which establishes a session with a lifetime.
+	// Subsequent uplink messages are forwarded with XmitDataReq until the session expires or is stopped with PRStopReq.
+	PassiveRoamingStateful PassiveRoamingProfile = "stateful"
+)
+
+var errUnknownPassiveRoamingProfile = errors.DefineInvalidArgument(
+	"unknown_passive_roaming_profile", "unknown passive roaming profile `{profile}`",
+)
+
+// RoamingAgreement is a roaming agreement with a partner network.
+type RoamingAgreement struct {
+	// NetID is the NetID of the partner network.
+	NetID types.NetID
+	// PassiveRoamingProfile is the passive roaming session profile.
+	PassiveRoamingProfile PassiveRoamingProfile
+	// PassiveRoamingLifetime is the lifetime of stateful passive roaming sessions granted to the partner network,
+	// when acting as serving Network Server.
+	PassiveRoamingLifetime time.Duration
+	// PassiveRoamingJoinEUIs are the JoinEUI prefixes of end devices served by the partner network, when acting as
+	// forwarding Network Server. Join-request messages with a matching JoinEUI are forwarded to the partner network.
+	PassiveRoamingJoinEUIs []types.EUI64Prefix
+	// HandoverRoaming indicates whether the partner network may serve end devices of this network,
+	// when acting as home Network Server.
+	HandoverRoaming bool
+	// HandoverRoamingLifetime is the lifetime of handover roaming sessions granted to the partner network.
+	HandoverRoamingLifetime time.Duration
+	// HandoverRoamingKEKLabel is the label of the key encryption key used to wrap the network session keys sent to
+	// the partner network. If empty, the network session keys are sent in the clear.
+	HandoverRoamingKEKLabel string
+}
+
+type networkServerHTTPClient struct {
+	clientProvider httpclient.Provider
+	clientOpts     []httpclient.Option
+	protocol       ProtocolVersion
+	scheme,
+	fqdn string
+	port               uint32
+	path               string
+	headers            map[string]string
+	username, password string
+	senderNSID         *types.EUI64
+	agreement          RoamingAgreement
+}
+
+func (cl networkServerHTTPClient) exchange(ctx context.Context, pld, res interface{}) error {
+	client, err := cl.clientProvider.HTTPClient(ctx, cl.clientOpts...)
+	if err != nil {
+		return err
+	}
+	if cl.scheme != "" && cl.scheme != "https" {
+		log.FromContext(ctx).WithField("scheme", cl.scheme).Warn(
+			"Use non-https scheme for contacting interop Network Server",
+		)
+	}
+	req, err := newHTTPRequest(
+		serverURL(cl.scheme, cl.fqdn, cl.path, cl.port), pld, cl.headers, cl.username, cl.password,
+	)
+	if err != nil {
+		return err
+	}
+	return httpExchange(ctx, req.WithContext(ctx), res, client.Do)
+}
+
+// header fills the protocol fields of the message header.
+func (cl networkServerHTTPClient) header(header *NsNsMessageHeader, messageType MessageType) {
+	header.ProtocolVersion = cl.protocol
+	header.MessageType = messageType
+	if cl.protocol.RequiresNSID() {
+		header.SenderNSID = (*EUI64)(cl.senderNSID)
+	} else {
+		header.SenderNSID = nil
+	}
+}
+
+// RoamingAgreement returns the roaming agreement with the partner network identified by the NetID.
+func (cl Client) RoamingAgreement(netID types.NetID) (RoamingAgreement, bool) {
+	ns, ok := cl.networkServers[netID]
+	if !ok {
+		return RoamingAgreement{}, false
+	}
+	return ns.agreement, true
+}
+
+// RoamingAgreements returns the roaming agreements ordered by NetID.
+func (cl Client) RoamingAgreements() []RoamingAgreement {
+	res := make([]RoamingAgreement, 0, len(cl.networkServers))
+	for _, ns := range cl.networkServers {
+		res = append(res, ns.agreement)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].NetID.MarshalNumber() < res[j].NetID.MarshalNumber()
+	})
+	return res
+}
+
+func (cl Client) networkServer(netID NetID) (*networkServerHTTPClient, error) {
+	ns, ok := cl.networkServers[types.NetID(netID)]
+	if !ok {
+		return nil, errNotRegistered.New()
+	}
+	return ns, nil
+}
+
+// PRStartRequest sends the PRStartReq to the Network Server of the receiver NetID.
+// The protocol version, message type and sender NSID are set according to the configuration of the receiver.
+func (cl Client) PRStartRequest(ctx context.Context, req *PRStartReq) (*PRStartAns, error) {
+	ns, err := cl.networkServer(req.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+	ns.header(&req.NsNsMessageHeader, MessageTypePRStartReq)
+	ans := &PRStartAns{}
+	if err := ns.exchange(ctx, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// PRStopRequest sends the PRStopReq to the Network Server of the receiver NetID.
+// The protocol version, message type and sender NSID are set according to the configuration of the receiver.
+func (cl Client) PRStopRequest(ctx context.Context, req *PRStopReq) (*PRStopAns, error) {
+	ns, err := cl.networkServer(req.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+	ns.header(&req.NsNsMessageHeader, MessageTypePRStopReq)
+	ans := &PRStopAns{}
+	if err := ns.exchange(ctx, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// XmitDataRequest sends the XmitDataReq to the Network Server of the receiver NetID.
+// The protocol version, message type and sender NSID are set according to the configuration of the receiver.
+func (cl Client) XmitDataRequest(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+	ns, err := cl.networkServer(req.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+	ns.header(&req.NsNsMessageHeader, MessageTypeXmitDataReq)
+	ans := &XmitDataAns{}
+	if err := ns.exchange(ctx, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// ProfileRequest sends the ProfileReq to the Network Server of the receiver NetID.
+// The protocol version, message type and sender NSID are set according to the configuration of the receiver.
+func (cl Client) ProfileRequest(ctx context.Context, req *ProfileReq) (*ProfileAns, error) {
+	ns, err := cl.networkServer(req.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+	ns.header(&req.NsNsMessageHeader, MessageTypeProfileReq)
+	ans := &ProfileAns{}
+	if err := ns.exchange(ctx, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// HRStartRequest sends the HRStartReq to the Network Server of the receiver NetID.
+// The protocol version, message type and sender NSID are set according to the configuration of the receiver.
+func (cl Client) HRStartRequest(ctx context.Context, req *HRStartReq) (*HRStartAns, error) {
+	ns, err := cl.networkServer(req.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+	ns.header(&req.NsNsMessageHeader, MessageTypeHRStartReq)
+	ans := &HRStartAns{}
+	if err := ns.exchange(ctx, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
+
+// HRStopRequest sends the HRStopReq to the Network Server of the receiver NetID.
+// The protocol version, message type and sender NSID are set according to the configuration of the receiver.
+func (cl Client) HRStopRequest(ctx context.Context, req *HRStopReq) (*HRStopAns, error) {
+	ns, err := cl.networkServer(req.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+	ns.header(&req.NsNsMessageHeader, MessageTypeHRStopReq)
+	ans := &HRStopAns{}
+	if err := ns.exchange(ctx, req, ans); err != nil {
+		return nil, err
+	}
+	if err := parseResult(ans.Result); err != nil {
+		return nil, err
+	}
+	return ans, nil
+}
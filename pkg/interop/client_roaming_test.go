@@ -0,0 +1,537 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRoamingAgreements(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		ConfigSource: "directory",
+		Directory:    "testdata/client",
+	}, test.HTTPClientProvider, SelectorNetworkServer)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	a.So(cl.RoamingAgreements(), should.Resemble, []RoamingAgreement{
+		{
+			NetID:                  types.NetID{0x00, 0x00, 0x13},
+			PassiveRoamingProfile:  PassiveRoamingStateful,
+			PassiveRoamingLifetime: time.Hour,
+			PassiveRoamingJoinEUIs: []types.EUI64Prefix{
+				{EUI64: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x02, 0x00, 0x00}, Length: 48},
+			},
+			HandoverRoaming:         true,
+			HandoverRoamingLifetime: 24 * time.Hour,
+		},
+		{
+			NetID:                   types.NetID{0x00, 0x00, 0x42},
+			PassiveRoamingProfile:   PassiveRoamingStateless,
+			HandoverRoaming:         true,
+			HandoverRoamingKEKLabel: "test-kek",
+		},
+		{
+			NetID:                   types.NetID{0x60, 0x00, 0x14},
+			PassiveRoamingProfile:   PassiveRoamingStateless,
+			HandoverRoaming:         true,
+			HandoverRoamingKEKLabel: "test-kek",
+		},
+	})
+
+	agreement, ok := cl.RoamingAgreement(types.NetID{0x00, 0x00, 0x42})
+	a.So(ok, should.BeTrue)
+	a.So(agreement.PassiveRoamingProfile, should.Equal, PassiveRoamingStateless)
+
+	_, ok = cl.RoamingAgreement(types.NetID{0x00, 0x00, 0x01})
+	a.So(ok, should.BeFalse)
+}
+
+func TestPRStartRequest(t *testing.T) { //nolint:paralleltest
+	recvTime := time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC)
+
+	for _, tc := range []struct { //nolint:paralleltest
+		Name              string
+		NewServer         func(*assertions.Assertion) *httptest.Server
+		Request           *PRStartReq
+		ResponseAssertion func(*assertions.Assertion, *PRStartAns) bool
+		ErrorAssertion    func(*assertions.Assertion, error) bool
+	}{
+		{
+			Name: "No roaming agreement",
+			Request: &PRStartReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					SenderID:   NetID{0x00, 0x00, 0x01},
+					ReceiverID: NetID{0x00, 0x00, 0x01},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, ans *PRStartAns) bool {
+				return a.So(ans, should.BeNil)
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(errors.IsNotFound(err), should.BeTrue)
+			},
+		},
+		{
+			Name: "UnknownDevAddr",
+			NewServer: func(a *assertions.Assertion) *httptest.Server {
+				return newTLSServer(9184, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					test.Must(nil, json.NewEncoder(w).Encode(map[string]interface{}{
+						"ProtocolVersion": "1.1",
+						"TransactionID":   0,
+						"MessageType":     "PRStartAns",
+						"SenderID":        "000013",
+						"ReceiverID":      "000001",
+						"ReceiverNSID":    "70B3D57ED0000001",
+						"Result": map[string]interface{}{
+							"ResultCode": "UnknownDevAddr",
+						},
+					}))
+				}))
+			},
+			Request: &PRStartReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					SenderID:   NetID{0x00, 0x00, 0x01},
+					ReceiverID: NetID{0x00, 0x00, 0x13},
+				},
+				PHYPayload: Buffer{0x40, 0x04, 0x03, 0x02, 0x26},
+				ULMetaData: ULMetaData{
+					RecvTime: ISO8601Time(recvTime),
+					RFRegion: "EU868",
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, ans *PRStartAns) bool {
+				return a.So(ans, should.BeNil)
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.HaveSameErrorDefinitionAs, ErrUnknownDevAddr)
+			},
+		},
+		{
+			Name: "Success",
+			NewServer: func(a *assertions.Assertion) *httptest.Server {
+				return newTLSServer(9184, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					a.So(r.Method, should.Equal, http.MethodPost)
+					a.So(r.URL.Path, should.Equal, "/test-ns-path")
+					b := test.Must(io.ReadAll(r.Body)).([]byte)
+					var req map[string]interface{}
+					test.Must(nil, json.Unmarshal(b, &req))
+					a.So(req, should.Resemble, map[string]interface{}{
+						"ProtocolVersion": "1.1",
+						"TransactionID":   0.0,
+						"MessageType":     "PRStartReq",
+						"SenderID":        "000001",
+						"SenderNSID":      "70B3D57ED0000001",
+						"ReceiverID":      "000013",
+						"PHYPayload":      "4004030226",
+						"ULMetaData": map[string]interface{}{
+							"DataRate": 5.0,
+							"ULFreq":   868.1,
+							"RecvTime": "2023-01-02T03:04:05Z",
+							"RFRegion": "EU868",
+							"GWCnt":    1.0,
+							"GWInfo": []interface{}{
+								map[string]interface{}{
+									"RSSI":    -42.0,
+									"SNR":     5.5,
+									"ULToken": "01020304",
+								},
+							},
+						},
+					})
+					test.Must(nil, json.NewEncoder(w).Encode(map[string]interface{}{
+						"ProtocolVersion": "1.1",
+						"TransactionID":   0,
+						"MessageType":     "PRStartAns",
+						"SenderID":        "000013",
+						"ReceiverID":      "000001",
+						"ReceiverNSID":    "70B3D57ED0000001",
+						"Result": map[string]interface{}{
+							"ResultCode": "Success",
+						},
+						"DevAddr":  "26020304",
+						"Lifetime": 3600,
+					}))
+				}))
+			},
+			Request: &PRStartReq{
+				NsNsMessageHeader: NsNsMessageHeader{
+					SenderID:   NetID{0x00, 0x00, 0x01},
+					ReceiverID: NetID{0x00, 0x00, 0x13},
+				},
+				PHYPayload: Buffer{0x40, 0x04, 0x03, 0x02, 0x26},
+				ULMetaData: ULMetaData{
+					DataRate: func(v int) *int { return &v }(5),
+					ULFreq:   func(v float64) *float64 { return &v }(868.1),
+					RecvTime: ISO8601Time(recvTime),
+					RFRegion: "EU868",
+					GWCnt:    func(v int) *int { return &v }(1),
+					GWInfo: []GWInfoElement{
+						{
+							RSSI:    func(v int32) *int32 { return &v }(-42),
+							SNR:     func(v float32) *float32 { return &v }(5.5),
+							ULToken: Buffer{0x01, 0x02, 0x03, 0x04},
+						},
+					},
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, ans *PRStartAns) bool {
+				lifetime := uint32(3600)
+				return a.So(ans, should.Resemble, &PRStartAns{
+					NsNsMessageHeader: NsNsMessageHeader{
+						MessageHeader: MessageHeader{
+							ProtocolVersion: ProtocolV1_1,
+							MessageType:     MessageTypePRStartAns,
+						},
+						SenderID:     NetID{0x00, 0x00, 0x13},
+						ReceiverID:   NetID{0x00, 0x00, 0x01},
+						ReceiverNSID: &EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01},
+					},
+					Result: Result{
+						ResultCode: ResultSuccess,
+					},
+					DevAddr:  &DevAddr{0x26, 0x02, 0x03, 0x04},
+					Lifetime: &lifetime,
+				})
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.BeNil)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ctx := test.Context()
+			ctx = log.NewContext(ctx, test.GetLogger(t))
+
+			if tc.NewServer != nil {
+				srv := tc.NewServer(a)
+				defer srv.Close()
+			}
+
+			cl, err := NewClient(ctx, config.InteropClient{
+				ConfigSource: "directory",
+				Directory:    "testdata/client",
+			}, test.HTTPClientProvider, SelectorNetworkServer)
+			if !a.So(err, should.BeNil) {
+				t.Fatalf("Failed to create new client: %s", err)
+			}
+
+			res, err := cl.PRStartRequest(ctx, tc.Request)
+			if a.So(tc.ErrorAssertion(a, err), should.BeTrue) {
+				a.So(tc.ResponseAssertion(a, res), should.BeTrue)
+			} else if err != nil {
+				t.Errorf("Received unexpected error: %v", errors.Stack(err))
+			}
+		})
+	}
+}
+
+// TestHandoverRoaming runs a home Network Server (hNS) and a serving Network Server (sNS) in process.
+// The hNS with NetID 000013 listens on port 9184, and the sNS with NetID 000042 listens on port 9185.
+// Both Network Servers use the same client configuration to reach each other.
+func TestHandoverRoaming(t *testing.T) { //nolint:paralleltest
+	a := assertions.New(t)
+	ctx := test.Context()
+	ctx = log.NewContext(ctx, test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		authorizer = Authorizer{}
+		hNSNetID   = NetID{0x00, 0x00, 0x13}
+		sNSNetID   = NetID{0x00, 0x00, 0x42}
+		devEUI     = EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+		devAddr    = DevAddr{0x00, 0x00, 0x84, 0x01}
+		recvTime   = time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	cl, err := NewClient(ctx, config.InteropClient{
+		ConfigSource: "directory",
+		Directory:    "testdata/client",
+	}, test.HTTPClientProvider, SelectorNetworkServer)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	newServer := func(port int) *Server {
+		s, err := NewServer(&mockComponent{ctx}, nil, config.InteropServer{
+			SenderClientCA: config.SenderClientCA{
+				Source:    "directory",
+				Directory: "testdata/server",
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		srv := newTLSServer(port, s)
+		t.Cleanup(srv.Close)
+		return s
+	}
+
+	uplinkCh := make(chan *XmitDataReq, 1)
+	hNS := newServer(9184)
+	hNS.RegisterHomeNS(mockNetworkServer{
+		ProfileRequestFunc: func(ctx context.Context, req *ProfileReq) (*ProfileAns, error) {
+			if err := authorizer.RequireNetID(ctx, types.NetID(sNSNetID)); err != nil {
+				return nil, err
+			}
+			if req.DevEUI != devEUI {
+				return nil, ErrUnknownDevEUI.New()
+			}
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			return &ProfileAns{
+				NsNsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+				DeviceProfile: &DeviceProfile{
+					MACVersion:   MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+					SupportsJoin: true,
+					RFRegion:     "EU868",
+				},
+				RoamingActivationType: RoamingActivationHandover,
+			}, nil
+		},
+		HRStartRequestFunc: func(ctx context.Context, req *HRStartReq) (*HRStartAns, error) {
+			if err := authorizer.RequireNetID(ctx, types.NetID(sNSNetID)); err != nil {
+				return nil, err
+			}
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			lifetime := uint32(24 * 60 * 60)
+			return &HRStartAns{
+				NsNsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+				PHYPayload: Buffer{0x20, 0x01, 0x02, 0x03},
+				Lifetime:   &lifetime,
+				NwkSKey: &KeyEnvelope{
+					KekLabel:     "test-kek",
+					EncryptedKey: []byte{0x01, 0x02, 0x03, 0x04},
+				},
+				DevEUI: &devEUI,
+			}, nil
+		},
+		XmitDataRequestFunc: func(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+			if err := authorizer.RequireNetID(ctx, types.NetID(sNSNetID)); err != nil {
+				return nil, err
+			}
+			uplinkCh <- req
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			return &XmitDataAns{
+				NsNsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+			}, nil
+		},
+	})
+
+	downlinkCh := make(chan *XmitDataReq, 1)
+	stopCh := make(chan *HRStopReq, 1)
+	sNS := newServer(9185)
+	sNS.RegisterServingNS(mockNetworkServer{
+		HRStopRequestFunc: func(ctx context.Context, req *HRStopReq) (*HRStopAns, error) {
+			if err := authorizer.RequireNetID(ctx, types.NetID(hNSNetID)); err != nil {
+				return nil, err
+			}
+			stopCh <- req
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			return &HRStopAns{
+				NsNsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+			}, nil
+		},
+		XmitDataRequestFunc: func(ctx context.Context, req *XmitDataReq) (*XmitDataAns, error) {
+			if err := authorizer.RequireNetID(ctx, types.NetID(hNSNetID)); err != nil {
+				return nil, err
+			}
+			downlinkCh <- req
+			header, err := req.AnswerHeader()
+			if err != nil {
+				return nil, err
+			}
+			return &XmitDataAns{
+				NsNsMessageHeader: header,
+				Result: Result{
+					ResultCode: ResultSuccess,
+				},
+			}, nil
+		},
+	})
+
+	// The sNS requests the device profile of the end device from the hNS.
+	profileAns, err := cl.ProfileRequest(ctx, &ProfileReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			SenderID:   sNSNetID,
+			ReceiverID: hNSNetID,
+		},
+		DevEUI: devEUI,
+	})
+	if a.So(err, should.BeNil) {
+		a.So(profileAns.RoamingActivationType, should.Equal, RoamingActivationHandover)
+		a.So(profileAns.DeviceProfile, should.Resemble, &DeviceProfile{
+			MACVersion:   MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+			SupportsJoin: true,
+			RFRegion:     "EU868",
+		})
+	}
+	_, err = cl.ProfileRequest(ctx, &ProfileReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			SenderID:   sNSNetID,
+			ReceiverID: hNSNetID,
+		},
+		DevEUI: EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01},
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, ErrUnknownDevEUI)
+
+	// The sNS starts the handover roaming session with the join-request.
+	hrStartAns, err := cl.HRStartRequest(ctx, &HRStartReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			SenderID:   sNSNetID,
+			ReceiverID: hNSNetID,
+		},
+		MACVersion: MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+		PHYPayload: Buffer{0x00, 0x01, 0x02, 0x03},
+		DevAddr:    devAddr,
+		ULMetaData: ULMetaData{
+			RecvTime: ISO8601Time(recvTime),
+			RFRegion: "EU868",
+		},
+		DLSettings: Buffer{0x00},
+		RxDelay:    ttnpb.RxDelay_RX_DELAY_5,
+	})
+	if a.So(err, should.BeNil) {
+		a.So(hrStartAns.PHYPayload, should.Resemble, Buffer{0x20, 0x01, 0x02, 0x03})
+		a.So(*hrStartAns.Lifetime, should.Equal, 24*60*60)
+		a.So(hrStartAns.NwkSKey, should.Resemble, &KeyEnvelope{
+			KekLabel:     "test-kek",
+			EncryptedKey: []byte{0x01, 0x02, 0x03, 0x04},
+		})
+		a.So(*hrStartAns.DevEUI, should.Equal, devEUI)
+	}
+
+	// The sNS transmits the FRMPayload of an uplink message to the hNS.
+	fPort := uint8(42)
+	fCntUp := uint32(1)
+	_, err = cl.XmitDataRequest(ctx, &XmitDataReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			SenderID:   sNSNetID,
+			ReceiverID: hNSNetID,
+		},
+		FRMPayload: Buffer{0x01, 0x02, 0x03},
+		ULMetaData: &ULMetaData{
+			DevEUI:   &devEUI,
+			DevAddr:  &devAddr,
+			FPort:    &fPort,
+			FCntUp:   &fCntUp,
+			RecvTime: ISO8601Time(recvTime),
+			RFRegion: "EU868",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case <-time.After(test.Delay << 8):
+		t.Fatal("Timed out waiting for uplink")
+	case req := <-uplinkCh:
+		a.So(req.FRMPayload, should.Resemble, Buffer{0x01, 0x02, 0x03})
+		a.So(*req.ULMetaData.FPort, should.Equal, fPort)
+		a.So(*req.ULMetaData.FCntUp, should.Equal, fCntUp)
+	}
+
+	// The hNS transmits the FRMPayload of a downlink message to the sNS.
+	fCntDown := uint32(1)
+	_, err = cl.XmitDataRequest(ctx, &XmitDataReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			SenderID:   hNSNetID,
+			ReceiverID: sNSNetID,
+		},
+		FRMPayload: Buffer{0x04, 0x05, 0x06},
+		DLMetaData: &DLMetaData{
+			DevEUI:   &devEUI,
+			FPort:    &fPort,
+			FCntDown: &fCntDown,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case <-time.After(test.Delay << 8):
+		t.Fatal("Timed out waiting for downlink")
+	case req := <-downlinkCh:
+		a.So(req.FRMPayload, should.Resemble, Buffer{0x04, 0x05, 0x06})
+		a.So(*req.DLMetaData.DevEUI, should.Equal, devEUI)
+		a.So(*req.DLMetaData.FCntDown, should.Equal, fCntDown)
+	}
+
+	// The hNS stops the handover roaming session.
+	_, err = cl.HRStopRequest(ctx, &HRStopReq{
+		NsNsMessageHeader: NsNsMessageHeader{
+			SenderID:   hNSNetID,
+			ReceiverID: sNSNetID,
+		},
+		DevEUI: devEUI,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case <-time.After(test.Delay << 8):
+		t.Fatal("Timed out waiting for HRStopReq")
+	case req := <-stopCh:
+		a.So(req.DevEUI, should.Equal, devEUI)
+	}
+}
@@ -169,3 +169,204 @@ type HomeNSAns struct {
 	HNSID  *EUI64 `json:",omitempty"`
 	HNetID NetID
 }
+
+// NsNsMessageHeader contains the message header for NS to NS messages.
+type NsNsMessageHeader struct {
+	MessageHeader
+	SenderID     NetID
+	SenderNSID   *EUI64 `json:",omitempty"`
+	ReceiverID   NetID
+	ReceiverNSID *EUI64 `json:",omitempty"`
+}
+
+// AnswerHeader returns the header of the answer message.
+func (h NsNsMessageHeader) AnswerHeader() (NsNsMessageHeader, error) {
+	header, err := h.MessageHeader.AnswerHeader()
+	if err != nil {
+		return NsNsMessageHeader{}, err
+	}
+	return NsNsMessageHeader{
+		MessageHeader: header,
+		SenderID:      h.ReceiverID,
+		SenderNSID:    h.ReceiverNSID,
+		ReceiverID:    h.SenderID,
+		ReceiverNSID:  h.SenderNSID,
+	}, nil
+}
+
+// GWInfoElement contains the metadata of a gateway that received an uplink message.
+type GWInfoElement struct {
+	ID           Buffer   `json:",omitempty"`
+	FineRecvTime *int64   `json:",omitempty"`
+	RFRegion     RFRegion `json:",omitempty"`
+	RSSI         *int32   `json:",omitempty"`
+	SNR          *float32 `json:",omitempty"`
+	Lat          *float64 `json:",omitempty"`
+	Lon          *float64 `json:",omitempty"`
+	ULToken      Buffer   `json:",omitempty"`
+	DLAllowed    bool     `json:",omitempty"`
+}
+
+// ULMetaData contains the metadata of an uplink message.
+type ULMetaData struct {
+	DevEUI     *EUI64   `json:",omitempty"`
+	DevAddr    *DevAddr `json:",omitempty"`
+	FPort      *uint8   `json:",omitempty"`
+	FCntDown   *uint32  `json:",omitempty"`
+	FCntUp     *uint32  `json:",omitempty"`
+	Confirmed  bool     `json:",omitempty"`
+	DataRate   *int     `json:",omitempty"`
+	ULFreq     *float64 `json:",omitempty"`
+	Margin     *int     `json:",omitempty"`
+	Battery    *int     `json:",omitempty"`
+	FNSULToken Buffer   `json:",omitempty"`
+	RecvTime   ISO8601Time
+	RFRegion   RFRegion
+	GWCnt      *int `json:",omitempty"`
+	GWInfo     []GWInfoElement
+}
+
+// DLMetaData contains the metadata of a downlink message.
+type DLMetaData struct {
+	DevEUI         *EUI64   `json:",omitempty"`
+	FPort          *uint8   `json:",omitempty"`
+	FCntDown       *uint32  `json:",omitempty"`
+	Confirmed      bool     `json:",omitempty"`
+	DLFreq1        *float64 `json:",omitempty"`
+	DLFreq2        *float64 `json:",omitempty"`
+	RXDelay1       *int     `json:",omitempty"`
+	ClassMode      string   `json:",omitempty"`
+	DataRate1      *int     `json:",omitempty"`
+	DataRate2      *int     `json:",omitempty"`
+	FNSULToken     Buffer   `json:",omitempty"`
+	GWInfo         []GWInfoElement
+	HiPriorityFlag bool `json:",omitempty"`
+}
+
+// PRStartReq is a passive roaming start request message.
+type PRStartReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer
+	ULMetaData ULMetaData
+}
+
+// PRStartAns is an answer to a PRStartReq message.
+type PRStartAns struct {
+	NsNsMessageHeader
+	Result      Result
+	PHYPayload  Buffer       `json:",omitempty"`
+	DevEUI      *EUI64       `json:",omitempty"`
+	DevAddr     *DevAddr     `json:",omitempty"`
+	Lifetime    *uint32      `json:",omitempty"`
+	FNwkSIntKey *KeyEnvelope `json:",omitempty"`
+	NwkSKey     *KeyEnvelope `json:",omitempty"`
+	FCntUp      *uint32      `json:",omitempty"`
+	DLMetaData  *DLMetaData  `json:",omitempty"`
+}
+
+// PRStopReq is a passive roaming stop request message.
+// DevAddr is not part of LoRaWAN Backend Interfaces; it is used to stop sessions of devices without DevEUI.
+type PRStopReq struct {
+	NsNsMessageHeader
+	DevEUI   *EUI64   `json:",omitempty"`
+	DevAddr  *DevAddr `json:",omitempty"`
+	Lifetime *uint32  `json:",omitempty"`
+}
+
+// PRStopAns is an answer to a PRStopReq message.
+type PRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
+
+// XmitDataReq is a data transmission request message.
+type XmitDataReq struct {
+	NsNsMessageHeader
+	PHYPayload Buffer      `json:",omitempty"`
+	FRMPayload Buffer      `json:",omitempty"`
+	ULMetaData *ULMetaData `json:",omitempty"`
+	DLMetaData *DLMetaData `json:",omitempty"`
+}
+
+// XmitDataAns is an answer to a XmitDataReq message.
+type XmitDataAns struct {
+	NsNsMessageHeader
+	Result  Result
+	DLFreq1 *float64 `json:",omitempty"`
+	DLFreq2 *float64 `json:",omitempty"`
+}
+
+// RoamingActivationType is the type of roaming that is activated for an end device.
+type RoamingActivationType string
+
+// Roaming activation types.
+const (
+	RoamingActivationPassive  RoamingActivationType = "Passive"
+	RoamingActivationHandover RoamingActivationType = "Handover"
+)
+
+// DeviceProfile contains the capabilities of an end device.
+type DeviceProfile struct {
+	DeviceProfileID   string `json:",omitempty"`
+	SupportsClassB    bool   `json:",omitempty"`
+	SupportsClassC    bool   `json:",omitempty"`
+	MACVersion        MACVersion
+	SupportsJoin      bool     `json:",omitempty"`
+	RFRegion          RFRegion `json:",omitempty"`
+	Supports32bitFCnt bool     `json:",omitempty"`
+}
+
+// ProfileReq is a device profile request message.
+type ProfileReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// ProfileAns is an answer to a ProfileReq message.
+type ProfileAns struct {
+	NsNsMessageHeader
+	Result                 Result
+	DeviceProfile          *DeviceProfile        `json:",omitempty"`
+	DeviceProfileTimestamp *ISO8601Time          `json:",omitempty"`
+	RoamingActivationType  RoamingActivationType `json:",omitempty"`
+}
+
+// HRStartReq is a handover roaming start request message.
+type HRStartReq struct {
+	NsNsMessageHeader
+	MACVersion             MACVersion
+	PHYPayload             Buffer
+	DevAddr                DevAddr
+	DeviceProfileTimestamp *ISO8601Time `json:",omitempty"`
+	ULMetaData             ULMetaData
+	DLSettings             Buffer
+	RxDelay                ttnpb.RxDelay
+	CFList                 Buffer `json:",omitempty"`
+}
+
+// HRStartAns is an answer to a HRStartReq message.
+type HRStartAns struct {
+	NsNsMessageHeader
+	Result                 Result
+	PHYPayload             Buffer         `json:",omitempty"`
+	Lifetime               *uint32        `json:",omitempty"`
+	SNwkSIntKey            *KeyEnvelope   `json:",omitempty"`
+	FNwkSIntKey            *KeyEnvelope   `json:",omitempty"`
+	NwkSEncKey             *KeyEnvelope   `json:",omitempty"`
+	NwkSKey                *KeyEnvelope   `json:",omitempty"`
+	DeviceProfile          *DeviceProfile `json:",omitempty"`
+	DeviceProfileTimestamp *ISO8601Time   `json:",omitempty"`
+	DevEUI                 *EUI64         `json:",omitempty"`
+}
+
+// HRStopReq is a handover roaming stop request message.
+type HRStopReq struct {
+	NsNsMessageHeader
+	DevEUI EUI64
+}
+
+// HRStopAns is an answer to a HRStopReq message.
+type HRStopAns struct {
+	NsNsMessageHeader
+	Result Result
+}
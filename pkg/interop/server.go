@@ -51,6 +51,26 @@ type JoinServer interface {
 	HomeNSRequest(context.Context, *HomeNSReq) (*TTIHomeNSAns, error)
 }
 
+// HomeNetworkServer represents a home Network Server as specified in LoRaWAN Backend Interfaces.
+type HomeNetworkServer interface {
+	ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error)
+	HRStartRequest(context.Context, *HRStartReq) (*HRStartAns, error)
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
+}
+
+// ServingNetworkServer represents a serving Network Server as specified in LoRaWAN Backend Interfaces.
+type ServingNetworkServer interface {
+	PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error)
+	HRStopRequest(context.Context, *HRStopReq) (*HRStopAns, error)
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
+}
+
+// ForwardingNetworkServer represents a forwarding Network Server as specified in LoRaWAN Backend Interfaces.
+type ForwardingNetworkServer interface {
+	PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error)
+	XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error)
+}
+
 type noopServer struct{}
 
 func (noopServer) JoinRequest(context.Context, *JoinReq) (*JoinAns, error) {
@@ -65,6 +85,30 @@ func (noopServer) HomeNSRequest(context.Context, *HomeNSReq) (*TTIHomeNSAns, err
 	return nil, ErrMalformedMessage.New()
 }
 
+func (noopServer) ProfileRequest(context.Context, *ProfileReq) (*ProfileAns, error) {
+	return nil, ErrMalformedMessage.New()
+}
+
+func (noopServer) HRStartRequest(context.Context, *HRStartReq) (*HRStartAns, error) {
+	return nil, ErrMalformedMessage.New()
+}
+
+func (noopServer) HRStopRequest(context.Context, *HRStopReq) (*HRStopAns, error) {
+	return nil, ErrMalformedMessage.New()
+}
+
+func (noopServer) PRStartRequest(context.Context, *PRStartReq) (*PRStartAns, error) {
+	return nil, ErrMalformedMessage.New()
+}
+
+func (noopServer) PRStopRequest(context.Context, *PRStopReq) (*PRStopAns, error) {
+	return nil, ErrMalformedMessage.New()
+}
+
+func (noopServer) XmitDataRequest(context.Context, *XmitDataReq) (*XmitDataAns, error) {
+	return nil, ErrMalformedMessage.New()
+}
+
 // Server is the server.
 type Server struct {
 	config config.InteropServer
@@ -76,8 +120,11 @@ type Server struct {
 
 	tokenVerifiers map[string]tokenVerifier
 
-	is IdentityServer
-	js JoinServer
+	is  IdentityServer
+	js  JoinServer
+	hNS HomeNetworkServer
+	sNS ServingNetworkServer
+	fNS ForwardingNetworkServer
 }
 
 // Component represents the Component to the Interop Server.
@@ -124,6 +171,9 @@ func NewServer(c Component, contextFillers []fillcontext.Filler, conf config.Int
 		senderClientCAPool: senderClientCAPool,
 		tokenVerifiers:     tokenVerifiers,
 		js:                 &noopServer{},
+		hNS:                &noopServer{},
+		sNS:                &noopServer{},
+		fNS:                &noopServer{},
 	}
 
 	s.router = mux.NewRouter()
@@ -162,6 +212,22 @@ func (s *Server) RegisterJS(js JoinServer) {
 	s.js = js
 }
 
+// RegisterHomeNS registers the home Network Server for handover roaming sNS-hNS messages.
+func (s *Server) RegisterHomeNS(ns HomeNetworkServer) {
+	s.hNS = ns
+}
+
+// RegisterServingNS registers the serving Network Server for passive roaming fNS-sNS and handover roaming hNS-sNS
+// messages.
+func (s *Server) RegisterServingNS(ns ServingNetworkServer) {
+	s.sNS = ns
+}
+
+// RegisterForwardingNS registers the forwarding Network Server for passive roaming sNS-fNS messages.
+func (s *Server) RegisterForwardingNS(ns ForwardingNetworkServer) {
+	s.fNS = ns
+}
+
 // ClientCAPool returns a certificate pool of all configured client CAs.
 func (s *Server) ClientCAPool() *x509.CertPool {
 	return s.senderClientCAPool
@@ -177,10 +243,16 @@ func (s *Server) SenderClientCAs(_ context.Context, senderID string) ([]*x509.Ce
 
 func (s *Server) handle() http.Handler {
 	senderAuthenticators := map[MessageType]senderAuthenticator{
-		MessageTypeJoinReq:    senderAuthenticatorFunc(s.authenticateNS),
-		MessageTypeRejoinReq:  senderAuthenticatorFunc(s.authenticateNS),
-		MessageTypeAppSKeyReq: senderAuthenticatorFunc(s.authenticateAS),
-		MessageTypeHomeNSReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeJoinReq:     senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeRejoinReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeAppSKeyReq:  senderAuthenticatorFunc(s.authenticateAS),
+		MessageTypeHomeNSReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypePRStartReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypePRStopReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeHRStartReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeHRStopReq:   senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeProfileReq:  senderAuthenticatorFunc(s.authenticateNS),
+		MessageTypeXmitDataReq: senderAuthenticatorFunc(s.authenticateNS),
 	}
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -236,6 +308,18 @@ func (s *Server) handle() http.Handler {
 			msg = &AppSKeyReq{}
 		case MessageTypeHomeNSReq:
 			msg = &HomeNSReq{}
+		case MessageTypePRStartReq:
+			msg = &PRStartReq{}
+		case MessageTypePRStopReq:
+			msg = &PRStopReq{}
+		case MessageTypeHRStartReq:
+			msg = &HRStartReq{}
+		case MessageTypeHRStopReq:
+			msg = &HRStopReq{}
+		case MessageTypeProfileReq:
+			msg = &ProfileReq{}
+		case MessageTypeXmitDataReq:
+			msg = &XmitDataReq{}
 		default:
 			writeError(w, r, header, ErrMalformedMessage.New())
 			return
@@ -259,6 +343,33 @@ func (s *Server) handle() http.Handler {
 			ans, err = js.HomeNSRequest(ctx, req)
 		case *AppSKeyReq:
 			ans, err = s.js.AppSKeyRequest(ctx, req)
+		case *PRStartReq:
+			ans, err = s.sNS.PRStartRequest(ctx, req)
+		case *PRStopReq:
+			ans, err = s.fNS.PRStopRequest(ctx, req)
+		case *HRStartReq:
+			ans, err = s.hNS.HRStartRequest(ctx, req)
+		case *HRStopReq:
+			ans, err = s.sNS.HRStopRequest(ctx, req)
+		case *ProfileReq:
+			ans, err = s.hNS.ProfileRequest(ctx, req)
+		case *XmitDataReq:
+			// In passive roaming, the PHYPayload of uplink messages is transmitted from the fNS to the sNS, and the
+			// PHYPayload of downlink messages from the sNS to the fNS.
+			// In handover roaming, the FRMPayload of uplink messages is transmitted from the sNS to the hNS, and the
+			// FRMPayload of downlink messages from the hNS to the sNS.
+			switch {
+			case req.ULMetaData != nil && req.DLMetaData == nil && len(req.FRMPayload) == 0:
+				ans, err = s.sNS.XmitDataRequest(ctx, req)
+			case req.ULMetaData != nil && req.DLMetaData == nil && len(req.PHYPayload) == 0:
+				ans, err = s.hNS.XmitDataRequest(ctx, req)
+			case req.DLMetaData != nil && req.ULMetaData == nil && len(req.FRMPayload) == 0:
+				ans, err = s.fNS.XmitDataRequest(ctx, req)
+			case req.DLMetaData != nil && req.ULMetaData == nil && len(req.PHYPayload) == 0:
+				ans, err = s.sNS.XmitDataRequest(ctx, req)
+			default:
+				err = ErrMalformedMessage.New()
+			}
 		default:
 			writeError(w, r, header, ErrMalformedMessage.New())
 			return
@@ -32,6 +32,7 @@ type authInfo interface {
 // NetworkServerAuthInfo contains the authentication information of a Network Server.
 type NetworkServerAuthInfo struct {
 	NetID     types.NetID
+	NSID      *types.EUI64
 	Addresses []string
 }
 
@@ -164,6 +165,9 @@ func (s *Server) authenticateNS(ctx context.Context, r *http.Request, data []byt
 			if err := authInfo.Require(types.NetID(header.SenderID), header.SenderNSID); err != nil {
 				return nil, ErrUnknownSender.WithCause(err)
 			}
+			if header.SenderNSID != nil {
+				authInfo.NSID = (*types.EUI64)(header.SenderNSID)
+			}
 			return NewContextWithNetworkServerAuthInfo(ctx, authInfo), nil
 		}
 	}
@@ -77,6 +77,65 @@ func (m mockTarget) HomeNSRequest(ctx context.Context, req *interop.HomeNSReq) (
 	panic("HomeNSRequest called but not registered")
 }
 
+type mockNetworkServer struct {
+	ProfileRequestFunc  func(context.Context, *interop.ProfileReq) (*interop.ProfileAns, error)
+	PRStartRequestFunc  func(context.Context, *interop.PRStartReq) (*interop.PRStartAns, error)
+	PRStopRequestFunc   func(context.Context, *interop.PRStopReq) (*interop.PRStopAns, error)
+	HRStartRequestFunc  func(context.Context, *interop.HRStartReq) (*interop.HRStartAns, error)
+	HRStopRequestFunc   func(context.Context, *interop.HRStopReq) (*interop.HRStopAns, error)
+	XmitDataRequestFunc func(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
+}
+
+func (m mockNetworkServer) ProfileRequest(
+	ctx context.Context, req *interop.ProfileReq,
+) (*interop.ProfileAns, error) {
+	if m.ProfileRequestFunc != nil {
+		return m.ProfileRequestFunc(ctx, req)
+	}
+	panic("ProfileRequest called but not registered")
+}
+
+func (m mockNetworkServer) PRStartRequest(
+	ctx context.Context, req *interop.PRStartReq,
+) (*interop.PRStartAns, error) {
+	if m.PRStartRequestFunc != nil {
+		return m.PRStartRequestFunc(ctx, req)
+	}
+	panic("PRStartRequest called but not registered")
+}
+
+func (m mockNetworkServer) PRStopRequest(ctx context.Context, req *interop.PRStopReq) (*interop.PRStopAns, error) {
+	if m.PRStopRequestFunc != nil {
+		return m.PRStopRequestFunc(ctx, req)
+	}
+	panic("PRStopRequest called but not registered")
+}
+
+func (m mockNetworkServer) HRStartRequest(
+	ctx context.Context, req *interop.HRStartReq,
+) (*interop.HRStartAns, error) {
+	if m.HRStartRequestFunc != nil {
+		return m.HRStartRequestFunc(ctx, req)
+	}
+	panic("HRStartRequest called but not registered")
+}
+
+func (m mockNetworkServer) HRStopRequest(ctx context.Context, req *interop.HRStopReq) (*interop.HRStopAns, error) {
+	if m.HRStopRequestFunc != nil {
+		return m.HRStopRequestFunc(ctx, req)
+	}
+	panic("HRStopRequest called but not registered")
+}
+
+func (m mockNetworkServer) XmitDataRequest(
+	ctx context.Context, req *interop.XmitDataReq,
+) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc != nil {
+		return m.XmitDataRequestFunc(ctx, req)
+	}
+	panic("XmitDataRequest called but not registered")
+}
+
 func TestServer(t *testing.T) { //nolint:gocyclo
 	t.Parallel()
 
@@ -85,6 +144,9 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 	for _, tc := range []struct {
 		Name              string
 		JS                interop.JoinServer
+		HomeNS            interop.HomeNetworkServer
+		ServingNS         interop.ServingNetworkServer
+		ForwardingNS      interop.ForwardingNetworkServer
 		ClientTLSConfig   *tls.Config
 		PacketBrokerToken bool
 		RequestBody       interface{}
@@ -244,6 +306,221 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 					a.So(msg.ReceiverID, should.Resemble, interop.NetID{0x0, 0x0, 0x01})
 			},
 		},
+		{
+			Name: "ClientTLS/PRStartReq/Success",
+			ServingNS: mockNetworkServer{
+				PRStartRequestFunc: func(ctx context.Context, req *interop.PRStartReq) (*interop.PRStartAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &interop.PRStartAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+						DevAddr: &interop.DevAddr{0x26, 0x01, 0x02, 0x03},
+					}, nil
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.PRStartReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypePRStartReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				PHYPayload: interop.Buffer{0x40, 0x03, 0x02, 0x01, 0x26},
+				ULMetaData: interop.ULMetaData{
+					RFRegion: "EU868",
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.PRStartAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypePRStartAns) &&
+					a.So(msg.SenderID, should.Resemble, interop.NetID{0x0, 0x0, 0x13}) &&
+					a.So(msg.ReceiverID, should.Resemble, interop.NetID{0x0, 0x0, 0x01}) &&
+					a.So(msg.DevAddr, should.Resemble, &interop.DevAddr{0x26, 0x01, 0x02, 0x03})
+			},
+		},
+		{
+			Name: "ClientTLS/XmitDataReq/Uplink",
+			ServingNS: mockNetworkServer{
+				XmitDataRequestFunc: func(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &interop.XmitDataAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+					}, nil
+				},
+			},
+			ForwardingNS:    mockNetworkServer{},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.XmitDataReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeXmitDataReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				PHYPayload: interop.Buffer{0x40, 0x03, 0x02, 0x01, 0x26},
+				ULMetaData: &interop.ULMetaData{
+					RFRegion: "EU868",
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.XmitDataAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypeXmitDataAns)
+			},
+		},
+		{
+			Name:            "ClientTLS/XmitDataReq/NoMetadata",
+			ServingNS:       mockNetworkServer{},
+			ForwardingNS:    mockNetworkServer{},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.XmitDataReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeXmitDataReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				PHYPayload: interop.Buffer{0x40, 0x03, 0x02, 0x01, 0x26},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultMalformedRequest)
+			},
+		},
+		{
+			Name: "ClientTLS/ProfileReq/Success",
+			HomeNS: mockNetworkServer{
+				ProfileRequestFunc: func(ctx context.Context, req *interop.ProfileReq) (*interop.ProfileAns, error) {
+					if err := authorizer.RequireNetID(ctx, types.NetID{0x0, 0x0, 0x1}); err != nil {
+						return nil, err
+					}
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &interop.ProfileAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+						DeviceProfile: &interop.DeviceProfile{
+							MACVersion:   interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+							SupportsJoin: true,
+							RFRegion:     "EU868",
+						},
+						RoamingActivationType: interop.RoamingActivationHandover,
+					}, nil
+				},
+			},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.ProfileReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeProfileReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				DevEUI: interop.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ProfileAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypeProfileAns) &&
+					a.So(msg.RoamingActivationType, should.Equal, interop.RoamingActivationHandover) &&
+					a.So(msg.DeviceProfile, should.Resemble, &interop.DeviceProfile{
+						MACVersion:   interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+						SupportsJoin: true,
+						RFRegion:     "EU868",
+					})
+			},
+		},
+		{
+			Name: "ClientTLS/XmitDataReq/HandoverUplink",
+			HomeNS: mockNetworkServer{
+				XmitDataRequestFunc: func(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+					header, err := req.AnswerHeader()
+					if err != nil {
+						return nil, err
+					}
+					return &interop.XmitDataAns{
+						NsNsMessageHeader: header,
+						Result: interop.Result{
+							ResultCode: interop.ResultSuccess,
+						},
+					}, nil
+				},
+			},
+			ServingNS:       mockNetworkServer{},
+			ClientTLSConfig: makeClientTLSConfig(),
+			RequestBody: &interop.XmitDataReq{
+				NsNsMessageHeader: interop.NsNsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeXmitDataReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.NetID{0x0, 0x0, 0x13},
+				},
+				FRMPayload: interop.Buffer{0x01, 0x02, 0x03},
+				ULMetaData: &interop.ULMetaData{
+					RFRegion: "EU868",
+				},
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.XmitDataAns
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				return a.So(err, should.BeNil) &&
+					a.So(msg.Result.ResultCode, should.Equal, interop.ResultSuccess) &&
+					a.So(msg.MessageType, should.Equal, interop.MessageTypeXmitDataAns)
+			},
+		},
 		{
 			Name: "PacketBroker/HomeNSReq/Success",
 			JS: mockTarget{
@@ -333,6 +610,15 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				if tc.JS != nil {
 					s.RegisterJS(tc.JS)
 				}
+				if tc.HomeNS != nil {
+					s.RegisterHomeNS(tc.HomeNS)
+				}
+				if tc.ServingNS != nil {
+					s.RegisterServingNS(tc.ServingNS)
+				}
+				if tc.ForwardingNS != nil {
+					s.RegisterForwardingNS(tc.ForwardingNS)
+				}
 
 				srv := newTLSServer(0, s)
 				defer srv.Close()
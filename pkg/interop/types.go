@@ -18,7 +18,9 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"strings"
+	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -419,3 +421,58 @@ func (n *DevAddr) UnmarshalText(data []byte) error {
 	copy(n[:], buf)
 	return nil
 }
+
+// ISO8601Time is a time encoded as ISO 8601 string.
+type ISO8601Time time.Time
+
+// MarshalText implements encoding.TextMarshaler.
+func (t ISO8601Time) MarshalText() ([]byte, error) {
+	return []byte(time.Time(t).UTC().Format(time.RFC3339Nano)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *ISO8601Time) UnmarshalText(data []byte) error {
+	parsed, err := time.Parse(time.RFC3339Nano, string(data))
+	if err != nil {
+		return err
+	}
+	*t = ISO8601Time(parsed)
+	return nil
+}
+
+// RFRegion is the name of a LoRaWAN regional parameters channel plan.
+type RFRegion string
+
+var rfRegionBandIDs = map[RFRegion]string{
+	"EU868":         band.EU_863_870,
+	"US902":         band.US_902_928,
+	"China779":      band.CN_779_787,
+	"EU433":         band.EU_433,
+	"Australia915":  band.AU_915_928,
+	"China470":      band.CN_470_510,
+	"AS923":         band.AS_923,
+	"SouthKorea920": band.KR_920_923,
+	"India865":      band.IN_865_867,
+	"RU864":         band.RU_864_870,
+}
+
+var errUnknownRFRegion = errors.DefineInvalidArgument("unknown_rf_region", "unknown RF region `{rf_region}`")
+
+// BandID returns the band ID of the RF region.
+func (r RFRegion) BandID() (string, error) {
+	id, ok := rfRegionBandIDs[r]
+	if !ok {
+		return "", errUnknownRFRegion.WithAttributes("rf_region", r)
+	}
+	return id, nil
+}
+
+// RFRegionFromBandID returns the RF region of the band ID.
+func RFRegionFromBandID(id string) (RFRegion, error) {
+	for r, bandID := range rfRegionBandIDs {
+		if bandID == id {
+			return r, nil
+		}
+	}
+	return "", errUnknownRFRegion.WithAttributes("rf_region", id)
+}
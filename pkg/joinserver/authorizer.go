@@ -47,6 +47,12 @@ type ExternalAuthorizer interface {
 	RequireASID(ctx context.Context, id string) error
 }
 
+// JoinEUIAuthorizer authorizes the request context by JoinEUI.
+type JoinEUIAuthorizer interface {
+	// RequireJoinEUI returns an error if the given JoinEUI is not authorized in the context.
+	RequireJoinEUI(ctx context.Context, joinEUI types.EUI64) error
+}
+
 // ApplicationAccessAuthorizer authorizes the request context for application access.
 type ApplicationAccessAuthorizer interface {
 	Authorizer
@@ -77,6 +83,18 @@ func (a clusterAuthorizer) RequireEntityContext(ctx context.Context) error {
 	return nil
 }
 
+// peerAuthorizer returns the Authorizer for a Network Server or Application Server with the given role.
+// Callers that are not authenticated as cluster peer are authenticated as external peer. If that fails, the returned
+// Authorizer fails with the cluster authentication error.
+func (js *JoinServer) peerAuthorizer(ctx context.Context, role ttnpb.ClusterRole) Authorizer {
+	if clusterauth.Authorized(ctx) != nil && js.externalPeers != nil {
+		if peer, err := js.externalPeers.authenticate(ctx, role); err == nil {
+			return externalPeerAuthorizer{peer: peer}
+		}
+	}
+	return ClusterAuthorizer(ctx)
+}
+
 type applicationRightsAuthorizer struct {
 	reqCtx context.Context
 }
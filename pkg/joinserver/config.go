@@ -14,7 +14,49 @@
 
 package joinserver
 
-import "go.thethings.network/lorawan-stack/v3/pkg/types"
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// ExternalPeersConfig represents the configuration of Network Servers and Application Servers outside the cluster
+// that are authorized to use the Join Server.
+type ExternalPeersConfig struct {
+	ConfigSource string                `name:"config-source" description:"Source of the external peers configuration (directory, url, blob)"` //nolint:lll
+	Directory    string                `name:"directory" description:"OS filesystem directory, which contains external peers configuration"`  //nolint:lll
+	URL          string                `name:"url" description:"URL, which contains external peers configuration"`
+	Blob         config.BlobPathConfig `name:"blob"`
+	ListenTLS    string                `name:"listen-tls" description:"Address for the gRPC TLS listener on which external peers authenticate with client certificates"` //nolint:lll
+
+	BlobConfig config.BlobConfig `name:"-"`
+}
+
+// Fetcher returns fetch.Interface defined by conf.
+// If no configuration source is set, this method returns nil, nil.
+func (c ExternalPeersConfig) Fetcher(ctx context.Context, httpClientProvider httpclient.Provider) (fetch.Interface, error) {
+	switch c.ConfigSource {
+	case "directory":
+		return fetch.FromFilesystem(c.Directory), nil
+	case "url":
+		httpClient, err := httpClientProvider.HTTPClient(ctx, httpclient.WithCache(true))
+		if err != nil {
+			return nil, err
+		}
+		return fetch.FromHTTP(httpClient, c.URL)
+	case "blob":
+		b, err := c.BlobConfig.Bucket(ctx, c.Blob.Bucket, httpClientProvider)
+		if err != nil {
+			return nil, err
+		}
+		return fetch.FromBucket(ctx, b, c.Blob.Path), nil
+	default:
+		return nil, nil
+	}
+}
 
 // Config represents the JoinServer configuration.
 type Config struct {
@@ -26,4 +68,5 @@ type Config struct {
 	DeviceKEKLabel                string                               `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DevNonceLimit                 int                                  `name:"dev-nonce-limit" description:"Amount of DevNonces stored per device"`
 	SessionKeyLimit               int                                  `name:"session-key-limit" description:"Amount of session keys stored per device"`
+	ExternalPeers                 ExternalPeersConfig                  `name:"external-peers" description:"Network Servers and Application Servers outside the cluster"`
 }
@@ -27,8 +27,10 @@ var (
 	errDuplicateIdentifiers           = errors.DefineAlreadyExists("duplicate_identifiers", "a device identified by the identifiers already exists")
 	errEncodePayload                  = errors.DefineInvalidArgument("encode_payload", "failed to encode payload")
 	errEncryptPayload                 = errors.Define("encrypt_payload", "failed to encrypt JoinAccept")
+	errFetchExternalPeers             = errors.Define("fetch_external_peers", "failed to fetch external peers")
 	errGenerateSessionKeyID           = errors.Define("generate_session_key_id", "failed to generate session key ID")
 	errJoinNonceTooHigh               = errors.Define("join_nonce_too_high", "JoinNonce is too high")
+	errListenExternalPeers            = errors.DefineFailedPrecondition("listen_external_peers", "failed to start external peers listener `{protocol}` on address `{address}`")
 	errLookupNetID                    = errors.Define("lookup_net_id", "lookup NetID")
 	errMICMismatch                    = errors.DefineInvalidArgument("mic_mismatch", "MIC mismatch")
 	errNetIDMismatch                  = errors.DefineInvalidArgument("net_id_mismatch", "NetID `{net_id}` does not match")
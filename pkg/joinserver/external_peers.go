@@ -0,0 +1,355 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"sort"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ExternalPeersConfigurationName represents the filename of the external peers configuration.
+const ExternalPeersConfigurationName = "config.yml"
+
+type externalPeerConfig struct {
+	NetID           *types.NetID        `yaml:"net-id"`
+	NSID            *types.EUI64        `yaml:"ns-id"`
+	ASID            string              `yaml:"as-id"`
+	ClientCA        string              `yaml:"client-ca"`
+	Token           string              `yaml:"token"`
+	JoinEUIPrefixes []types.EUI64Prefix `yaml:"join-eui-prefixes"`
+}
+
+type externalTenantConfig struct {
+	NetworkServers     []externalPeerConfig `yaml:"network-servers"`
+	ApplicationServers []externalPeerConfig `yaml:"application-servers"`
+}
+
+var (
+	errExternalPeerNoCredentials = errors.DefineInvalidArgument(
+		"external_peer_no_credentials",
+		"no client CA or token specified for external peer of tenant `{tenant_id}`",
+	)
+	errExternalPeerNoIdentity = errors.DefineInvalidArgument(
+		"external_peer_no_identity",
+		"no NetID or AS-ID specified for external peer of tenant `{tenant_id}`",
+	)
+	errExternalPeerNoJoinEUIPrefixes = errors.DefineInvalidArgument(
+		"external_peer_no_join_eui_prefixes",
+		"no JoinEUI prefixes specified for external peer of tenant `{tenant_id}`",
+	)
+	errExternalPeerClientCA = errors.DefineInvalidArgument(
+		"external_peer_client_ca",
+		"invalid client CA `{name}` of external peer of tenant `{tenant_id}`",
+	)
+	errExternalPeerNotAuthorized = errors.DefinePermissionDenied(
+		"external_peer_not_authorized",
+		"external peer of tenant `{tenant_id}` not authorized for `{target}`",
+	)
+)
+
+// externalPeer is a Network Server or Application Server outside the cluster.
+type externalPeer struct {
+	tenantID        string
+	role            ttnpb.ClusterRole
+	netID           types.NetID
+	nsID            *types.EUI64
+	asID            string
+	clientCAs       *x509.CertPool
+	tokenHash       []byte
+	joinEUIPrefixes []types.EUI64Prefix
+}
+
+func (p *externalPeer) authorizesJoinEUI(joinEUI types.EUI64) bool {
+	for _, prefix := range p.joinEUIPrefixes {
+		if prefix.Matches(joinEUI) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyCertificates returns whether the leaf certificate chains up to one of the client CAs of the peer.
+func (p *externalPeer) verifyCertificates(certs []*x509.Certificate) bool {
+	if p.clientCAs == nil || len(certs) == 0 {
+		return false
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         p.clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err == nil
+}
+
+func (p *externalPeer) verifyToken(tokenHash []byte) bool {
+	return p.tokenHash != nil && subtle.ConstantTimeCompare(p.tokenHash, tokenHash) == 1
+}
+
+func hashExternalPeerToken(token string) []byte {
+	h := sha256.Sum256([]byte(token))
+	return h[:]
+}
+
+// externalPeerRegistry contains the external peers registered per tenant.
+type externalPeerRegistry struct {
+	peers []*externalPeer
+}
+
+func newExternalPeer(
+	fetcher fetch.Interface, tenantID string, role ttnpb.ClusterRole, conf externalPeerConfig,
+) (*externalPeer, error) {
+	p := &externalPeer{
+		tenantID:        tenantID,
+		role:            role,
+		nsID:            conf.NSID,
+		asID:            conf.ASID,
+		joinEUIPrefixes: conf.JoinEUIPrefixes,
+	}
+	switch role {
+	case ttnpb.ClusterRole_NETWORK_SERVER:
+		if conf.NetID == nil {
+			return nil, errExternalPeerNoIdentity.WithAttributes("tenant_id", tenantID)
+		}
+		p.netID = *conf.NetID
+	case ttnpb.ClusterRole_APPLICATION_SERVER:
+		if conf.ASID == "" {
+			return nil, errExternalPeerNoIdentity.WithAttributes("tenant_id", tenantID)
+		}
+	}
+	if len(conf.JoinEUIPrefixes) == 0 {
+		return nil, errExternalPeerNoJoinEUIPrefixes.WithAttributes("tenant_id", tenantID)
+	}
+	if conf.ClientCA == "" && conf.Token == "" {
+		return nil, errExternalPeerNoCredentials.WithAttributes("tenant_id", tenantID)
+	}
+	if conf.ClientCA != "" {
+		b, err := fetcher.File(conf.ClientCA)
+		if err != nil {
+			return nil, err
+		}
+		p.clientCAs = x509.NewCertPool()
+		if !p.clientCAs.AppendCertsFromPEM(b) {
+			return nil, errExternalPeerClientCA.WithAttributes(
+				"name", conf.ClientCA,
+				"tenant_id", tenantID,
+			)
+		}
+	}
+	if conf.Token != "" {
+		p.tokenHash = hashExternalPeerToken(conf.Token)
+	}
+	return p, nil
+}
+
+// fetchExternalPeers fetches the external peers configuration.
+// If fetcher is nil, an empty registry is returned.
+func fetchExternalPeers(fetcher fetch.Interface) (*externalPeerRegistry, error) {
+	r := &externalPeerRegistry{}
+	if fetcher == nil {
+		return r, nil
+	}
+	b, err := fetcher.File(ExternalPeersConfigurationName)
+	if err != nil {
+		return nil, err
+	}
+	var conf struct {
+		Tenants map[string]externalTenantConfig `yaml:"tenants"`
+	}
+	if err := yaml.UnmarshalStrict(b, &conf); err != nil {
+		return nil, err
+	}
+	tenantIDs := make([]string, 0, len(conf.Tenants))
+	for tenantID := range conf.Tenants {
+		tenantIDs = append(tenantIDs, tenantID)
+	}
+	sort.Strings(tenantIDs)
+	for _, tenantID := range tenantIDs {
+		tenantConf := conf.Tenants[tenantID]
+		for _, peerConf := range tenantConf.NetworkServers {
+			p, err := newExternalPeer(fetcher, tenantID, ttnpb.ClusterRole_NETWORK_SERVER, peerConf)
+			if err != nil {
+				return nil, err
+			}
+			r.peers = append(r.peers, p)
+		}
+		for _, peerConf := range tenantConf.ApplicationServers {
+			p, err := newExternalPeer(fetcher, tenantID, ttnpb.ClusterRole_APPLICATION_SERVER, peerConf)
+			if err != nil {
+				return nil, err
+			}
+			r.peers = append(r.peers, p)
+		}
+	}
+	return r, nil
+}
+
+// authenticate returns the external peer with the given role that is authenticated in the gRPC context.
+// The peer is authenticated by bearer token or by TLS client certificate.
+func (r *externalPeerRegistry) authenticate(ctx context.Context, role ttnpb.ClusterRole) (*externalPeer, error) {
+	var tokenHash []byte
+	if md := rpcmetadata.FromIncomingContext(ctx); strings.EqualFold(md.AuthType, "bearer") && md.AuthValue != "" {
+		tokenHash = hashExternalPeerToken(md.AuthValue)
+	}
+	var certs []*x509.Certificate
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			certs = tlsInfo.State.PeerCertificates
+		}
+	}
+	if tokenHash == nil && len(certs) == 0 {
+		return nil, errUnauthenticated.New()
+	}
+	for _, p := range r.peers {
+		if p.role != role {
+			continue
+		}
+		if tokenHash != nil && p.verifyToken(tokenHash) {
+			return p, nil
+		}
+		if len(certs) > 0 && p.verifyCertificates(certs) {
+			return p, nil
+		}
+	}
+	return nil, errUnauthenticated.New()
+}
+
+// networkServers returns the external Network Servers with the given NetID.
+// If nsID is set, Network Servers with a different NSID are not returned.
+func (r *externalPeerRegistry) networkServers(netID types.NetID, nsID *types.EUI64) []*externalPeer {
+	var res []*externalPeer
+	for _, p := range r.peers {
+		if p.role != ttnpb.ClusterRole_NETWORK_SERVER || !p.netID.Equal(netID) {
+			continue
+		}
+		if nsID != nil && p.nsID != nil && !p.nsID.Equal(*nsID) {
+			continue
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// applicationServers returns the external Application Servers with the given AS-ID.
+func (r *externalPeerRegistry) applicationServers(asID string) []*externalPeer {
+	var res []*externalPeer
+	for _, p := range r.peers {
+		if p.role == ttnpb.ClusterRole_APPLICATION_SERVER && p.asID == asID {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// externalPeerAuthorizer authorizes an external peer that is authenticated over gRPC.
+type externalPeerAuthorizer struct {
+	peer *externalPeer
+}
+
+var (
+	_ ExternalAuthorizer = externalPeerAuthorizer{}
+	_ JoinEUIAuthorizer  = externalPeerAuthorizer{}
+)
+
+// RequireAuthorized returns nil, as the peer is authenticated.
+func (externalPeerAuthorizer) RequireAuthorized(context.Context) error {
+	return nil
+}
+
+// RequireAddress returns nil, as external peers are authorized by NetID, AS-ID and JoinEUI.
+func (externalPeerAuthorizer) RequireAddress(context.Context, string) error {
+	return nil
+}
+
+// RequireNetID implements ExternalAuthorizer.
+func (a externalPeerAuthorizer) RequireNetID(_ context.Context, netID types.NetID) error {
+	if a.peer.role != ttnpb.ClusterRole_NETWORK_SERVER || !a.peer.netID.Equal(netID) {
+		return errExternalPeerNotAuthorized.WithAttributes(
+			"tenant_id", a.peer.tenantID,
+			"target", netID.String(),
+		)
+	}
+	return nil
+}
+
+// RequireASID implements ExternalAuthorizer.
+func (a externalPeerAuthorizer) RequireASID(_ context.Context, id string) error {
+	if a.peer.role != ttnpb.ClusterRole_APPLICATION_SERVER || a.peer.asID != id {
+		return errExternalPeerNotAuthorized.WithAttributes(
+			"tenant_id", a.peer.tenantID,
+			"target", id,
+		)
+	}
+	return nil
+}
+
+// RequireJoinEUI implements JoinEUIAuthorizer.
+func (a externalPeerAuthorizer) RequireJoinEUI(_ context.Context, joinEUI types.EUI64) error {
+	if !a.peer.authorizesJoinEUI(joinEUI) {
+		return errExternalPeerNotAuthorized.WithAttributes(
+			"tenant_id", a.peer.tenantID,
+			"target", joinEUI.String(),
+		)
+	}
+	return nil
+}
+
+// interopPeerAuthorizer authorizes callers authenticated by the interop server.
+// Callers that are registered as external peers are only authorized for the JoinEUIs of their registration.
+type interopPeerAuthorizer struct {
+	ExternalAuthorizer
+	peers *externalPeerRegistry
+}
+
+var _ JoinEUIAuthorizer = interopPeerAuthorizer{}
+
+// RequireJoinEUI implements JoinEUIAuthorizer.
+func (a interopPeerAuthorizer) RequireJoinEUI(ctx context.Context, joinEUI types.EUI64) error {
+	var peers []*externalPeer
+	if nsAuthInfo, ok := interop.NetworkServerAuthInfoFromContext(ctx); ok {
+		peers = a.peers.networkServers(nsAuthInfo.NetID, nsAuthInfo.NSID)
+	} else if asAuthInfo, ok := interop.ApplicationServerAuthInfoFromContext(ctx); ok {
+		peers = a.peers.applicationServers(asAuthInfo.ASID)
+	}
+	if len(peers) == 0 {
+		return nil
+	}
+	for _, p := range peers {
+		if p.authorizesJoinEUI(joinEUI) {
+			return nil
+		}
+	}
+	return errExternalPeerNotAuthorized.WithAttributes(
+		"tenant_id", peers[0].tenantID,
+		"target", joinEUI.String(),
+	)
+}
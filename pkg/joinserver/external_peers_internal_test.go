@@ -0,0 +1,233 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func generateTestCertificate(t *testing.T, template, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	return cert, key
+}
+
+func TestExternalPeers(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	notBefore, notAfter := time.Now().Add(-time.Hour), time.Now().Add(time.Hour)
+	ca, caKey := generateTestCertificate(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Partner CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	clientCert, _ := generateTestCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "ns.partner.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+	otherCert, _ := generateTestCertificate(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(3),
+		Subject:               pkix.Name{CommonName: "ns.other.com"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}, nil, nil)
+
+	for _, tc := range []struct {
+		Name   string
+		Config string
+		Error  *errors.Definition
+	}{
+		{
+			Name: "NoNetID",
+			Config: `tenants:
+  partner:
+    network-servers:
+    - token: secret
+      join-eui-prefixes: ["70b3d57ed0000000/40"]
+`,
+			Error: errExternalPeerNoIdentity,
+		},
+		{
+			Name: "NoCredentials",
+			Config: `tenants:
+  partner:
+    application-servers:
+    - as-id: partner-as
+      join-eui-prefixes: ["70b3d57ed0000000/40"]
+`,
+			Error: errExternalPeerNoCredentials,
+		},
+		{
+			Name: "NoJoinEUIPrefixes",
+			Config: `tenants:
+  partner:
+    application-servers:
+    - as-id: partner-as
+      token: secret
+`,
+			Error: errExternalPeerNoJoinEUIPrefixes,
+		},
+		{
+			Name: "InvalidClientCA",
+			Config: `tenants:
+  partner:
+    network-servers:
+    - net-id: "000013"
+      client-ca: partner.pem
+      join-eui-prefixes: ["70b3d57ed0000000/40"]
+`,
+			Error: errExternalPeerClientCA,
+		},
+	} {
+		_, err := fetchExternalPeers(fetch.NewMemFetcher(map[string][]byte{
+			ExternalPeersConfigurationName: []byte(tc.Config),
+			"partner.pem":                  []byte("invalid"),
+		}))
+		if !a.So(err, should.HaveSameErrorDefinitionAs, tc.Error) {
+			t.Errorf("%s: unexpected error: %v", tc.Name, err)
+		}
+	}
+
+	r, err := fetchExternalPeers(fetch.NewMemFetcher(map[string][]byte{
+		ExternalPeersConfigurationName: []byte(`tenants:
+  partner:
+    network-servers:
+    - net-id: "000013"
+      ns-id: "70b3d57ed0000001"
+      client-ca: partner.pem
+      join-eui-prefixes: ["70b3d57ed0000000/40"]
+    application-servers:
+    - as-id: partner-as
+      token: secret
+      join-eui-prefixes: ["70b3d57ed0000000/40", "70b3d57ed1000000/40"]
+`),
+		"partner.pem": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}),
+	}))
+	if !a.So(err, should.BeNil) || !a.So(r.peers, should.HaveLength, 2) {
+		t.FailNow()
+	}
+
+	withTLS := func(ctx context.Context, certs ...*x509.Certificate) context.Context {
+		return peer.NewContext(ctx, &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{PeerCertificates: certs},
+			},
+		})
+	}
+	withToken := func(ctx context.Context, token string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+	}
+
+	joinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}
+	otherJoinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd2, 0x00, 0x00, 0x01}
+
+	// Network Server authenticated by TLS client certificate.
+	p, err := r.authenticate(withTLS(ctx, clientCert), ttnpb.ClusterRole_NETWORK_SERVER)
+	if a.So(err, should.BeNil) {
+		authorizer := externalPeerAuthorizer{peer: p}
+		a.So(authorizer.RequireAuthorized(ctx), should.BeNil)
+		a.So(authorizer.RequireNetID(ctx, types.NetID{0x00, 0x00, 0x13}), should.BeNil)
+		a.So(authorizer.RequireNetID(ctx, types.NetID{0x00, 0x00, 0x42}), should.HaveSameErrorDefinitionAs, errExternalPeerNotAuthorized)
+		a.So(authorizer.RequireASID(ctx, "partner-as"), should.HaveSameErrorDefinitionAs, errExternalPeerNotAuthorized)
+		a.So(authorizer.RequireJoinEUI(ctx, joinEUI), should.BeNil)
+		a.So(authorizer.RequireJoinEUI(ctx, otherJoinEUI), should.HaveSameErrorDefinitionAs, errExternalPeerNotAuthorized)
+	}
+	_, err = r.authenticate(withTLS(ctx, otherCert), ttnpb.ClusterRole_NETWORK_SERVER)
+	a.So(err, should.HaveSameErrorDefinitionAs, errUnauthenticated)
+	_, err = r.authenticate(withTLS(ctx, clientCert), ttnpb.ClusterRole_APPLICATION_SERVER)
+	a.So(err, should.HaveSameErrorDefinitionAs, errUnauthenticated)
+
+	// Application Server authenticated by token.
+	p, err = r.authenticate(withToken(ctx, "secret"), ttnpb.ClusterRole_APPLICATION_SERVER)
+	if a.So(err, should.BeNil) {
+		authorizer := externalPeerAuthorizer{peer: p}
+		a.So(authorizer.RequireASID(ctx, "partner-as"), should.BeNil)
+		a.So(authorizer.RequireASID(ctx, "other-as"), should.HaveSameErrorDefinitionAs, errExternalPeerNotAuthorized)
+		a.So(authorizer.RequireNetID(ctx, types.NetID{0x00, 0x00, 0x13}), should.HaveSameErrorDefinitionAs, errExternalPeerNotAuthorized)
+		a.So(authorizer.RequireJoinEUI(ctx, types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x01}), should.BeNil)
+	}
+	_, err = r.authenticate(withToken(ctx, "invalid"), ttnpb.ClusterRole_APPLICATION_SERVER)
+	a.So(err, should.HaveSameErrorDefinitionAs, errUnauthenticated)
+	_, err = r.authenticate(ctx, ttnpb.ClusterRole_APPLICATION_SERVER)
+	a.So(err, should.HaveSameErrorDefinitionAs, errUnauthenticated)
+
+	// Callers authenticated by the interop server.
+	interopAuthorizer := interopPeerAuthorizer{
+		ExternalAuthorizer: InteropAuthorizer,
+		peers:              r,
+	}
+	nsCtx := interop.NewContextWithNetworkServerAuthInfo(ctx, &interop.NetworkServerAuthInfo{
+		NetID: types.NetID{0x00, 0x00, 0x13},
+	})
+	a.So(interopAuthorizer.RequireJoinEUI(nsCtx, joinEUI), should.BeNil)
+	a.So(interopAuthorizer.RequireJoinEUI(nsCtx, otherJoinEUI), should.HaveSameErrorDefinitionAs, errExternalPeerNotAuthorized)
+	otherNSCtx := interop.NewContextWithNetworkServerAuthInfo(ctx, &interop.NetworkServerAuthInfo{
+		NetID: types.NetID{0x00, 0x00, 0x13},
+		NSID:  &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x02},
+	})
+	a.So(interopAuthorizer.RequireJoinEUI(otherNSCtx, otherJoinEUI), should.BeNil)
+	unregisteredCtx := interop.NewContextWithApplicationServerAuthInfo(ctx, &interop.ApplicationServerAuthInfo{
+		ASID: "other-as",
+	})
+	a.So(interopAuthorizer.RequireJoinEUI(unregisteredCtx, otherJoinEUI), should.BeNil)
+}
@@ -26,5 +26,5 @@ type asJsServer struct {
 
 // GetAppSKey returns the AppSKey associated with session keys identified by the supplied request.
 func (srv asJsServer) GetAppSKey(ctx context.Context, req *ttnpb.SessionKeyRequest) (*ttnpb.AppSKeyResponse, error) {
-	return srv.JS.GetAppSKey(ctx, req, ClusterAuthorizer(ctx))
+	return srv.JS.GetAppSKey(ctx, req, srv.JS.peerAuthorizer(ctx, ttnpb.ClusterRole_APPLICATION_SERVER))
 }
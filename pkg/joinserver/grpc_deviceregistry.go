@@ -95,12 +95,11 @@ func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndD
 		}
 	}
 
-	// TODO: Reject writing Claim Authentication Code (https://github.com/TheThingsNetwork/lorawan-stack/issues/5631).
 	if ttnpb.HasAnyField(
 		req.FieldMask.GetPaths(),
 		"claim_authentication_code.value",
 		"claim_authentication_code.valid_from",
-		"claim_authentication_code.value_to",
+		"claim_authentication_code.valid_to",
 	) {
 		warning.Add(
 			ctx,
@@ -195,6 +194,11 @@ func (srv jsEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndD
 var (
 	errInvalidFieldMask  = errors.DefineInvalidArgument("field_mask", "invalid field mask")
 	errInvalidFieldValue = errors.DefineInvalidArgument("field_value", "invalid value of field `{field}`")
+
+	errClaimAuthenticationCode = errors.DefineInvalidArgument(
+		"claim_authentication_code",
+		"claim authentication code can not be stored in the Join Server registry, use the Identity Server registry instead",
+	)
 )
 
 // Set implements ttnpb.JsEndDeviceRegistryServer.
@@ -206,17 +210,15 @@ func (srv jsEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndD
 		return nil, errNoDevEUI.New()
 	}
 
-	// TODO: Reject writing Claim Authentication Code (https://github.com/TheThingsNetwork/lorawan-stack/issues/5631).
+	// The claim authentication code is stored in the Identity Server registry. Existing claim authentication codes
+	// can still be cleared from the Join Server registry.
 	if ttnpb.HasAnyField(
 		req.FieldMask.GetPaths(),
 		"claim_authentication_code.value",
 		"claim_authentication_code.valid_from",
-		"claim_authentication_code.value_to",
-	) {
-		warning.Add(
-			ctx,
-			"Storage of claim authentication code in the Join Server registry is deprecated. Use the Identity Server registry instead", //nolint:lll
-		)
+		"claim_authentication_code.valid_to",
+	) && req.EndDevice.ClaimAuthenticationCode != nil {
+		return nil, errClaimAuthenticationCode.New()
 	}
 
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "root_keys.app_key.key") &&
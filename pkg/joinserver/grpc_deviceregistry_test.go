@@ -571,6 +571,36 @@ func TestDeviceRegistrySet(t *testing.T) {
 			},
 		},
 
+		{
+			Name: "Claim authentication code",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), ttnpb.Clone(registeredDevice.Ids.ApplicationIds)): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: &ttnpb.EndDevice{
+					Ids: ttnpb.Clone(registeredDevice.Ids),
+					ClaimAuthenticationCode: &ttnpb.EndDeviceAuthenticationCode{
+						Value: "secret",
+					},
+				},
+				FieldMask: ttnpb.FieldMask("claim_authentication_code"),
+			},
+			SetByIDFunc: func(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string, cb func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetByIDFunc must not be called")
+				return nil, errors.New("SetByIDFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
+
 		{
 			Name: "Create",
 			ContextFunc: func(ctx context.Context) context.Context {
@@ -35,6 +35,15 @@ type interopHandler interface {
 
 type interopServer struct {
 	JS interopHandler
+	// Authorizer authorizes the callers. If nil, InteropAuthorizer is used.
+	Authorizer ExternalAuthorizer
+}
+
+func (srv interopServer) authorizer() ExternalAuthorizer {
+	if srv.Authorizer == nil {
+		return InteropAuthorizer
+	}
+	return srv.Authorizer
 }
 
 func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (*interop.JoinAns, error) {
@@ -73,7 +82,7 @@ func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (
 		return nil, interop.ErrMalformedMessage.WithCause(err)
 	}
 
-	res, err := srv.JS.HandleJoin(ctx, req, InteropAuthorizer)
+	res, err := srv.JS.HandleJoin(ctx, req, srv.authorizer())
 	if err != nil {
 		switch {
 		case errors.Resemble(err, errDecodePayload),
@@ -123,7 +132,7 @@ func (srv interopServer) JoinRequest(ctx context.Context, in *interop.JoinReq) (
 func (srv interopServer) HomeNSRequest(ctx context.Context, in *interop.HomeNSReq) (*interop.TTIHomeNSAns, error) {
 	ctx = log.NewContextWithField(ctx, "namespace", "joinserver/interop")
 
-	homeNetwork, err := srv.JS.GetHomeNetwork(ctx, types.EUI64(in.ReceiverID), types.EUI64(in.DevEUI), InteropAuthorizer)
+	homeNetwork, err := srv.JS.GetHomeNetwork(ctx, types.EUI64(in.ReceiverID), types.EUI64(in.DevEUI), srv.authorizer())
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, interop.ErrUnknownDevEUI.WithCause(err)
@@ -178,7 +187,7 @@ func (srv interopServer) AppSKeyRequest(ctx context.Context, in *interop.AppSKey
 		return nil, interop.ErrMalformedMessage.WithCause(err)
 	}
 
-	res, err := srv.JS.GetAppSKey(ctx, req, InteropAuthorizer)
+	res, err := srv.JS.GetAppSKey(ctx, req, srv.authorizer())
 	if err != nil {
 		switch {
 		case errors.IsPermissionDenied(err):
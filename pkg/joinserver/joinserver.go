@@ -19,13 +19,16 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"encoding/binary"
+	"net"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	ulid "github.com/oklog/ulid/v2"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoservices"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
@@ -34,7 +37,9 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/grpc"
@@ -54,6 +59,11 @@ type JoinServer struct {
 	euiPrefixes    []types.EUI64Prefix
 	defaultJoinEUI types.EUI64
 	devNonceLimit  int
+	externalPeers  *externalPeerRegistry
+
+	// externalPeersGRPC serves the NsJs and AsJs services on the external peers TLS listener.
+	// Contrary to the component gRPC server, it exposes the TLS client certificates of the callers.
+	externalPeersGRPC *rpcserver.Server
 
 	grpc struct {
 		nsJs                          nsJsServer
@@ -104,13 +114,28 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 		JS:       js,
 		kekLabel: conf.DeviceKEKLabel,
 	}
+	externalPeersConf := conf.ExternalPeers
+	externalPeersConf.BlobConfig = c.GetBaseConfig(js.ctx).Blob
+	fetcher, err := externalPeersConf.Fetcher(js.ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if js.externalPeers, err = fetchExternalPeers(fetcher); err != nil {
+		return nil, errFetchExternalPeers.WithCause(err)
+	}
+
 	js.grpc.nsJs = nsJsServer{JS: js}
 	js.grpc.asJs = asJsServer{JS: js}
 	js.grpc.appJs = appJsServer{JS: js}
 	js.grpc.js = jsServer{JS: js}
-	js.interop = interopServer{JS: js}
+	js.interop = interopServer{
+		JS: js,
+		Authorizer: interopPeerAuthorizer{
+			ExternalAuthorizer: InteropAuthorizer,
+			peers:              js.externalPeers,
+		},
+	}
 
-	// TODO: Support authentication from non-cluster-local NS and AS (https://github.com/TheThingsNetwork/lorawan-stack/issues/4).
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.AppJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
@@ -120,11 +145,67 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, c.ClusterAuthUnaryHook())
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.Js", cluster.HookName, c.ClusterAuthUnaryHook())
 
+	if conf.ExternalPeers.ListenTLS != "" {
+		js.registerExternalPeersGRPC(conf.ExternalPeers.ListenTLS)
+	}
+
 	c.RegisterGRPC(js)
 	c.RegisterInterop(js)
 	return js, nil
 }
 
+// registerExternalPeersGRPC registers the task that serves the NsJs and AsJs services to external peers on a
+// dedicated TLS listener. TLS client certificates are requested on this listener only; they are verified against the
+// client CAs of the external peers when authenticating the caller.
+func (js *JoinServer) registerExternalPeersGRPC(address string) {
+	srv := rpcserver.New(js.ctx,
+		rpcserver.WithContextFiller(js.FillContext),
+		rpcserver.WithRateLimiter(js.RateLimiter()),
+		rpcserver.WithTLSPeerCredentials(),
+	)
+	srv.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
+	srv.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("joinserver"))
+	srv.RegisterUnaryHook("/ttn.lorawan.v3.NsJs", cluster.HookName, js.ClusterAuthUnaryHook())
+	srv.RegisterUnaryHook("/ttn.lorawan.v3.AsJs", cluster.HookName, js.ClusterAuthUnaryHook())
+	ttnpb.RegisterNsJsServer(srv.Server, js.grpc.nsJs)
+	ttnpb.RegisterAsJsServer(srv.Server, js.grpc.asJs)
+	js.externalPeersGRPC = srv
+
+	endpoint := component.NewTLSEndpoint(address, "gRPC",
+		tlsconfig.WithNextProtos("h2"),
+		tlsconfig.WithTLSClientAuth(tls.RequestClientCert, nil, nil),
+	)
+	js.RegisterTask(&task.Config{
+		Context: js.ctx,
+		ID:      "serve_external_peers_grpc",
+		Func: func(ctx context.Context) error {
+			l, err := js.ListenTCP(endpoint.Address())
+			var lis net.Listener
+			if err == nil {
+				lis, err = endpoint.Listen(l)
+			}
+			if err != nil {
+				return errListenExternalPeers.WithCause(err).WithAttributes(
+					"address", endpoint.Address(),
+					"protocol", endpoint.Protocol(),
+				)
+			}
+			defer lis.Close()
+			go func() {
+				<-ctx.Done()
+				srv.Stop()
+			}()
+			log.FromContext(ctx).WithFields(log.Fields(
+				"address", endpoint.Address(),
+				"protocol", endpoint.Protocol(),
+			)).Info("Listening for external peer connections")
+			return srv.Serve(lis)
+		},
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+}
+
 // Roles of the gRPC service.
 func (js *JoinServer) Roles() []ttnpb.ClusterRole {
 	return []ttnpb.ClusterRole{ttnpb.ClusterRole_JOIN_SERVER}
@@ -261,6 +342,11 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 	if !match {
 		return nil, errUnknownJoinEUI.New()
 	}
+	if joinEUIAuth, ok := authorizer.(JoinEUIAuthorizer); ok {
+		if err := joinEUIAuth.RequireJoinEUI(ctx, joinEUI); err != nil {
+			return nil, err
+		}
+	}
 
 	var handled bool
 	dev, err := js.devices.SetByEUI(ctx, joinEUI, devEUI,
@@ -632,10 +718,16 @@ func (js *JoinServer) GetNwkSKeys(ctx context.Context, req *ttnpb.SessionKeyRequ
 	if err := authorizer.RequireAuthorized(ctx); err != nil {
 		return nil, err
 	}
+	if joinEUIAuth, ok := authorizer.(JoinEUIAuthorizer); ok {
+		if err := joinEUIAuth.RequireJoinEUI(ctx, types.MustEUI64(req.JoinEui).OrZero()); err != nil {
+			return nil, err
+		}
+	}
 
 	if externalAuth, ok := authorizer.(ExternalAuthorizer); ok {
 		dev, err := js.devices.GetByEUI(ctx, types.MustEUI64(req.JoinEui).OrZero(), types.MustEUI64(req.DevEui).OrZero(),
 			[]string{
+				"net_id",
 				"network_server_address",
 			},
 		)
@@ -707,6 +799,11 @@ func (js *JoinServer) GetAppSKey(ctx context.Context, req *ttnpb.SessionKeyReque
 	if err := authorizer.RequireAuthorized(ctx); err != nil {
 		return nil, err
 	}
+	if joinEUIAuth, ok := authorizer.(JoinEUIAuthorizer); ok {
+		if err := joinEUIAuth.RequireJoinEUI(ctx, types.MustEUI64(req.JoinEui).OrZero()); err != nil {
+			return nil, err
+		}
+	}
 
 	if externalAuth, ok := authorizer.(ExternalAuthorizer); ok {
 		dev, err := js.devices.GetByEUI(ctx, types.MustEUI64(req.JoinEui).OrZero(), types.MustEUI64(req.DevEui).OrZero(),
@@ -790,6 +887,11 @@ func (js *JoinServer) GetHomeNetwork(ctx context.Context, joinEUI, devEUI types.
 	if err := authorizer.RequireAuthorized(ctx); err != nil {
 		return nil, err
 	}
+	if joinEUIAuth, ok := authorizer.(JoinEUIAuthorizer); ok {
+		if err := joinEUIAuth.RequireJoinEUI(ctx, joinEUI); err != nil {
+			return nil, err
+		}
+	}
 
 	dev, err := js.devices.GetByEUI(ctx, joinEUI, devEUI,
 		[]string{
@@ -820,9 +922,19 @@ func (js *JoinServer) GetHomeNetwork(ctx context.Context, joinEUI, devEUI types.
 		}
 		netID = sets.HomeNetId
 	}
-	// TODO: Return NSID (https://github.com/TheThingsNetwork/lorawan-stack/issues/4741).
-	return &EndDeviceHomeNetwork{
+	homeNetwork := &EndDeviceHomeNetwork{
 		NetID:                types.MustNetID(netID),
 		NetworkServerAddress: dev.NetworkServerAddress,
-	}, nil
+	}
+	if homeNetwork.NetID != nil && js.externalPeers != nil {
+		for _, p := range js.externalPeers.networkServers(*homeNetwork.NetID, nil) {
+			if p.authorizesJoinEUI(joinEUI) {
+				homeNetwork.TenantID = p.tenantID
+				homeNetwork.NSID = p.nsID
+				break
+			}
+		}
+	}
+	// TODO: Return NSID of cluster-local Network Servers (https://github.com/TheThingsNetwork/lorawan-stack/issues/4741).
+	return homeNetwork, nil
 }
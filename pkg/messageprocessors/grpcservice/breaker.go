@@ -0,0 +1,78 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcservice
+
+import (
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// circuitBreaker rejects calls to a service after a number of consecutive failures.
+// When the cooldown has passed, calls are allowed again. The first failure after the cooldown opens the circuit
+// again, while a success closes it.
+type circuitBreaker struct {
+	config CircuitBreakerConfig
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+// allow returns whether a call is allowed at the given time.
+func (b *circuitBreaker) allow(now time.Time) bool {
+	if b.config.Threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.openUntil)
+}
+
+// report reports the result of a call at the given time.
+func (b *circuitBreaker) report(now time.Time, err error) {
+	if b.config.Threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !isServiceFailure(err) {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.config.Threshold {
+		b.openUntil = now.Add(b.config.Cooldown)
+	}
+}
+
+// isServiceFailure returns whether the error indicates that the service is unhealthy, as opposed to the payload
+// formatter rejecting the message.
+func isServiceFailure(err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.IsUnavailable(err),
+		errors.IsDeadlineExceeded(err),
+		errors.IsResourceExhausted(err),
+		errors.IsUnimplemented(err),
+		errors.IsInternal(err),
+		errors.IsUnknown(err):
+		return true
+	default:
+		return false
+	}
+}
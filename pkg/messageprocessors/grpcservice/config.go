@@ -0,0 +1,43 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcservice
+
+import "time"
+
+// CircuitBreakerConfig is the configuration of the circuit breaker of a payload formatter service.
+type CircuitBreakerConfig struct {
+	Threshold int           `name:"threshold" description:"Number of consecutive failures after which calls to a payload formatter service are rejected (0 is disabled)"` //nolint:lll
+	Cooldown  time.Duration `name:"cooldown" description:"Duration during which calls to a payload formatter service are rejected"`                                       //nolint:lll
+}
+
+// Config is the configuration of the gRPC service payload formatter.
+type Config struct {
+	Timeout        time.Duration        `name:"timeout" description:"Deadline of calls to payload formatter services"`
+	Insecure       bool                 `name:"insecure" description:"Connect to payload formatter services without TLS (insecure)"`                                              //nolint:lll
+	Token          string               `name:"token" description:"Bearer token that is sent to the allowed payload formatter services"`                                          //nolint:lll
+	AllowedTargets []string             `name:"allowed-targets" description:"Addresses (host:port) of payload formatter services that may be used (formatter disabled if empty)"` //nolint:lll
+	MaxConnections int                  `name:"max-connections" description:"Maximum number of connections to payload formatter services that are kept open"`                     //nolint:lll
+	CircuitBreaker CircuitBreakerConfig `name:"circuit-breaker" description:"Circuit breaker configuration of calls to payload formatter services"`                               //nolint:lll
+}
+
+// DefaultConfig is the default configuration of the gRPC service payload formatter.
+var DefaultConfig = Config{
+	Timeout:        5 * time.Second,
+	MaxConnections: 64,
+	CircuitBreaker: CircuitBreakerConfig{
+		Threshold: 5,
+		Cooldown:  30 * time.Second,
+	},
+}
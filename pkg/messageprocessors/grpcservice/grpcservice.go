@@ -0,0 +1,353 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcservice implements a payload formatter that calls an external gRPC service.
+//
+// The service implements ttnpb.PayloadFormatterServiceServer. The formatter parameter is the address (host:port) of
+// the service. Only the services that are allowed in the configuration are called, as the parameter is provided by
+// users. The formatter is disabled if no services are allowed.
+package grpcservice
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"runtime/trace"
+	"time"
+
+	"github.com/bluele/gcache"
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// TLSConfigProvider provides the TLS client configuration.
+type TLSConfigProvider interface {
+	GetTLSClientConfig(context.Context, ...tlsconfig.Option) (*tls.Config, error)
+}
+
+var (
+	errDisabled         = errors.DefineFailedPrecondition("disabled", "gRPC service payload formatter is disabled")
+	errTarget           = errors.DefineInvalidArgument("target", "invalid target `{target}`")
+	errTargetNotAllowed = errors.DefinePermissionDenied("target_not_allowed", "target `{target}` is not allowed")
+	errDial             = errors.DefineUnavailable("dial", "dial payload formatter service `{target}`")
+	errCircuitOpen      = errors.DefineUnavailable(
+		"circuit_open", "payload formatter service `{target}` is temporarily unavailable",
+	)
+	errServiceTimeout = errors.DefineDeadlineExceeded("service_timeout", "payload formatter service `{target}` timeout")
+	errService        = errors.DefineUnavailable("service", "payload formatter service `{target}` failed")
+	errFormatter      = errors.DefineAborted("formatter", "payload formatter service `{target}` returned an error")
+	errNoOutput       = errors.DefineAborted("no_output", "no output from payload formatter service `{target}`")
+)
+
+type host struct {
+	ctx       context.Context
+	config    Config
+	tlsConfig TLSConfigProvider
+	allowed   map[string]struct{}
+	services  gcache.Cache
+}
+
+// New creates and returns a new payload encoder and decoder that calls external gRPC services.
+// The connections to the services are closed when the context is done.
+func New(ctx context.Context, tlsConfig TLSConfigProvider, config Config) messageprocessors.PayloadEncoderDecoder {
+	h := &host{
+		ctx:       ctx,
+		config:    config,
+		tlsConfig: tlsConfig,
+	}
+	h.allowed = make(map[string]struct{}, len(config.AllowedTargets))
+	for _, target := range config.AllowedTargets {
+		h.allowed[target] = struct{}{}
+	}
+	size := config.MaxConnections
+	if size <= 0 {
+		size = DefaultConfig.MaxConnections
+	}
+	h.services = gcache.New(size).LRU().
+		LoaderFunc(func(key interface{}) (interface{}, error) {
+			return h.dial(key.(string))
+		}).
+		EvictedFunc(func(_, value interface{}) {
+			h.closeLater(value.(*service))
+		}).
+		PurgeVisitorFunc(func(_, value interface{}) {
+			value.(*service).cc.Close() //nolint:errcheck
+		}).
+		Build()
+	go func() {
+		<-ctx.Done()
+		h.services.Purge()
+	}()
+	return h
+}
+
+// service is a connection to a payload formatter service.
+type service struct {
+	cc      *grpc.ClientConn
+	client  ttnpb.PayloadFormatterServiceClient
+	breaker *circuitBreaker
+}
+
+func (h *host) dial(target string) (*service, error) {
+	opts := rpcclient.DefaultDialOptions(h.ctx)
+	if h.config.Insecure {
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		tlsConfig, err := h.tlsConfig.GetTLSClientConfig(h.ctx)
+		if err != nil {
+			return nil, errDial.WithAttributes("target", target).WithCause(err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	}
+	// The token is only sent to allowed targets, as other targets are rejected before they are dialed.
+	if _, ok := h.allowed[target]; ok && h.config.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(rpcclient.OAuth2(
+			oauth2.StaticTokenSource(&oauth2.Token{
+				AccessToken: h.config.Token,
+				TokenType:   "Bearer",
+			}),
+			h.config.Insecure,
+		)))
+	}
+	// The connection is established in the background, and reconnects when it fails.
+	cc, err := grpc.DialContext(h.ctx, target, opts...)
+	if err != nil {
+		return nil, errDial.WithAttributes("target", target).WithCause(err)
+	}
+	return &service{
+		cc:      cc,
+		client:  ttnpb.NewPayloadFormatterServiceClient(cc),
+		breaker: &circuitBreaker{config: h.config.CircuitBreaker},
+	}, nil
+}
+
+// closeLater closes the connection of an evicted service after calls in flight are done.
+func (h *host) closeLater(s *service) {
+	delay := h.config.Timeout
+	if delay <= 0 {
+		delay = DefaultConfig.Timeout
+	}
+	time.AfterFunc(delay, func() {
+		s.cc.Close() //nolint:errcheck
+	})
+}
+
+func (h *host) getService(target string) (*service, error) {
+	if len(h.allowed) == 0 {
+		return nil, errDisabled.New()
+	}
+	hostname, port, err := net.SplitHostPort(target)
+	if err != nil || hostname == "" || port == "" {
+		return nil, errTarget.WithAttributes("target", target)
+	}
+	if _, ok := h.allowed[target]; !ok {
+		return nil, errTargetNotAllowed.WithAttributes("target", target)
+	}
+	s, err := h.services.Get(target)
+	if err != nil {
+		return nil, err
+	}
+	return s.(*service), nil
+}
+
+// call calls the service at the target with a deadline and the circuit breaker.
+func (h *host) call(ctx context.Context, target string, f func(context.Context, *service) error) error {
+	s, err := h.getService(target)
+	if err != nil {
+		return err
+	}
+	if !s.breaker.allow(time.Now()) {
+		return errCircuitOpen.WithAttributes("target", target)
+	}
+	if h.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.config.Timeout)
+		defer cancel()
+	}
+	err = f(ctx, s)
+	s.breaker.report(time.Now(), err)
+	switch {
+	case err == nil:
+		return nil
+	case errors.IsDeadlineExceeded(err):
+		return errServiceTimeout.WithAttributes("target", target).WithCause(err)
+	case isServiceFailure(err):
+		return errService.WithAttributes("target", target).WithCause(err)
+	default:
+		return errFormatter.WithAttributes("target", target).WithCause(err)
+	}
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the service at the given address.
+func (h *host) EncodeDownlink(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	if msg.DecodedPayload == nil {
+		return nil
+	}
+	var res *ttnpb.EncodeDownlinkResponse
+	if err := h.call(ctx, parameter, func(ctx context.Context, s *service) (err error) {
+		res, err = s.client.EncodeDownlink(ctx, &ttnpb.EncodeDownlinkRequest{
+			EndDeviceIds: ids,
+			VersionIds:   version,
+			Downlink:     msg,
+			Formatter:    ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+			Parameter:    parameter,
+		})
+		return err
+	}); err != nil {
+		return err
+	}
+	downlink := res.GetDownlink()
+	if downlink == nil {
+		return errNoOutput.WithAttributes("target", parameter)
+	}
+	msg.FrmPayload = downlink.FrmPayload
+	msg.DecodedPayloadWarnings = downlink.DecodedPayloadWarnings
+	if downlink.FPort != 0 {
+		msg.FPort = downlink.FPort
+	} else if msg.FPort == 0 {
+		msg.FPort = 1
+	}
+	return nil
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the service at the given address.
+// The normalized payload returned by the service is validated. Invalid fields are removed and reported as warnings.
+func (h *host) DecodeUplink(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationUplink,
+	parameter string,
+) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	var res *ttnpb.DecodeUplinkResponse
+	if err := h.call(ctx, parameter, func(ctx context.Context, s *service) (err error) {
+		res, err = s.client.DecodeUplink(ctx, &ttnpb.DecodeUplinkRequest{
+			EndDeviceIds: ids,
+			VersionIds:   version,
+			Uplink:       msg,
+			Formatter:    ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+			Parameter:    parameter,
+		})
+		return err
+	}); err != nil {
+		return err
+	}
+	uplink := res.GetUplink()
+	if uplink == nil {
+		return errNoOutput.WithAttributes("target", parameter)
+	}
+	msg.DecodedPayload, msg.DecodedPayloadWarnings = uplink.DecodedPayload, uplink.DecodedPayloadWarnings
+	msg.NormalizedPayload, msg.NormalizedPayloadWarnings = nil, nil
+
+	if len(uplink.NormalizedPayload) > 0 {
+		measurements, err := normalizedpayload.Parse(uplink.NormalizedPayload)
+		if err != nil {
+			return errNoOutput.WithAttributes("target", parameter).WithCause(err)
+		}
+		msg.NormalizedPayload = validNormalizedPayload(measurements)
+		msg.NormalizedPayloadWarnings = make([]string, 0, len(uplink.NormalizedPayloadWarnings))
+		msg.NormalizedPayloadWarnings = append(msg.NormalizedPayloadWarnings, uplink.NormalizedPayloadWarnings...)
+		msg.NormalizedPayloadWarnings = appendValidationErrors(msg.NormalizedPayloadWarnings, measurements)
+	} else if msg.DecodedPayload != nil {
+		// If the service does not normalize the payload, the decoded payload may already be normalized.
+		// This is a best effort attempt to parse the decoded payload as normalized payload.
+		measurements, err := normalizedpayload.Parse([]*pbtypes.Struct{msg.DecodedPayload})
+		if err == nil {
+			msg.NormalizedPayload = validNormalizedPayload(measurements)
+			msg.NormalizedPayloadWarnings = appendValidationErrors(msg.NormalizedPayloadWarnings, measurements)
+		}
+	}
+	return nil
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the service at the given address.
+func (h *host) DecodeDownlink(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	var res *ttnpb.DecodeDownlinkResponse
+	if err := h.call(ctx, parameter, func(ctx context.Context, s *service) (err error) {
+		res, err = s.client.DecodeDownlink(ctx, &ttnpb.DecodeDownlinkRequest{
+			EndDeviceIds: ids,
+			VersionIds:   version,
+			Downlink:     msg,
+			Formatter:    ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+			Parameter:    parameter,
+		})
+		return err
+	}); err != nil {
+		return err
+	}
+	downlink := res.GetDownlink()
+	if downlink == nil {
+		return errNoOutput.WithAttributes("target", parameter)
+	}
+	msg.DecodedPayload = downlink.DecodedPayload
+	msg.DecodedPayloadWarnings = downlink.DecodedPayloadWarnings
+	return nil
+}
+
+func appendValidationErrors(dst []string, measurements []normalizedpayload.ParsedMeasurement) []string {
+	for i, m := range measurements {
+		for _, err := range m.ValidationErrors {
+			var (
+				errString string
+				ttnErr    *errors.Error
+			)
+			if errors.As(err, &ttnErr) {
+				errString = ttnErr.FormatMessage(ttnErr.PublicAttributes())
+			} else {
+				errString = err.Error()
+			}
+			dst = append(dst, fmt.Sprintf("measurement %d: %s", i+1, errString))
+		}
+	}
+	return dst
+}
+
+// validNormalizedPayload returns the measurements that contain valid fields.
+func validNormalizedPayload(measurements []normalizedpayload.ParsedMeasurement) []*pbtypes.Struct {
+	res := make([]*pbtypes.Struct, 0, len(measurements))
+	for _, measurement := range measurements {
+		if len(measurement.Valid.GetFields()) == 0 {
+			continue
+		}
+		res = append(res, measurement.Valid)
+	}
+	return res
+}
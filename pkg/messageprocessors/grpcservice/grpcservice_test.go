@@ -0,0 +1,397 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcservice_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ids = &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	version = &ttnpb.EndDeviceVersionIdentifiers{
+		BrandId: "foo-brand",
+	}
+)
+
+type mockService struct {
+	ttnpb.UnimplementedPayloadFormatterServiceServer
+
+	calls              int32
+	authorization      atomic.Value
+	DecodeUplinkFunc   func(context.Context, *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error)
+	EncodeDownlinkFunc func(context.Context, *ttnpb.EncodeDownlinkRequest) (*ttnpb.EncodeDownlinkResponse, error)
+	DecodeDownlinkFunc func(context.Context, *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error)
+}
+
+func (s *mockService) called(ctx context.Context) {
+	atomic.AddInt32(&s.calls, 1)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("authorization"); len(v) > 0 {
+			s.authorization.Store(v[0])
+		}
+	}
+}
+
+func (s *mockService) DecodeUplink(ctx context.Context, req *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error) {
+	s.called(ctx)
+	return s.DecodeUplinkFunc(ctx, req)
+}
+
+func (s *mockService) EncodeDownlink(ctx context.Context, req *ttnpb.EncodeDownlinkRequest) (*ttnpb.EncodeDownlinkResponse, error) {
+	s.called(ctx)
+	return s.EncodeDownlinkFunc(ctx, req)
+}
+
+func (s *mockService) DecodeDownlink(ctx context.Context, req *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error) {
+	s.called(ctx)
+	return s.DecodeDownlinkFunc(ctx, req)
+}
+
+func startService(t *testing.T, svc ttnpb.PayloadFormatterServiceServer) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	ttnpb.RegisterPayloadFormatterServiceServer(srv, svc)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func newConfig(allowedTargets ...string) grpcservice.Config {
+	config := grpcservice.DefaultConfig
+	config.Insecure = true
+	config.AllowedTargets = allowedTargets
+	return config
+}
+
+func TestDecodeUplink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	svc := &mockService{
+		DecodeUplinkFunc: func(_ context.Context, req *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error) {
+			a.So(req.EndDeviceIds, should.Resemble, ids)
+			a.So(req.VersionIds, should.Resemble, version)
+			a.So(req.Formatter, should.Equal, ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE)
+			a.So(req.Uplink.FrmPayload, should.Resemble, []byte{0x01, 0x02})
+			return &ttnpb.DecodeUplinkResponse{
+				Uplink: &ttnpb.ApplicationUplink{
+					DecodedPayload: &pbtypes.Struct{
+						Fields: map[string]*pbtypes.Value{
+							"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: -300}},
+						},
+					},
+					DecodedPayloadWarnings: []string{"estimated"},
+					NormalizedPayload: []*pbtypes.Struct{
+						{
+							Fields: map[string]*pbtypes.Value{
+								"air": {
+									Kind: &pbtypes.Value_StructValue{
+										StructValue: &pbtypes.Struct{
+											Fields: map[string]*pbtypes.Value{
+												"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: 21.5}},
+											},
+										},
+									},
+								},
+							},
+						},
+						{
+							Fields: map[string]*pbtypes.Value{
+								"air": {
+									Kind: &pbtypes.Value_StructValue{
+										StructValue: &pbtypes.Struct{
+											Fields: map[string]*pbtypes.Value{
+												"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: -300}},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					NormalizedPayloadWarnings: []string{"calibrated"},
+					// The formatter must not change other fields.
+					FPort: 100,
+				},
+			}, nil
+		},
+	}
+	target := startService(t, svc)
+	host := grpcservice.New(ctx, nil, newConfig(target))
+
+	msg := &ttnpb.ApplicationUplink{
+		FPort:      42,
+		FrmPayload: []byte{0x01, 0x02},
+	}
+	if !a.So(host.DecodeUplink(ctx, ids, version, msg, target), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(msg.FPort, should.Equal, 42)
+	a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+		Fields: map[string]*pbtypes.Value{
+			"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: -300}},
+		},
+	})
+	a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"estimated"})
+	a.So(msg.NormalizedPayload, should.Resemble, []*pbtypes.Struct{
+		{
+			Fields: map[string]*pbtypes.Value{
+				"air": {
+					Kind: &pbtypes.Value_StructValue{
+						StructValue: &pbtypes.Struct{
+							Fields: map[string]*pbtypes.Value{
+								"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: 21.5}},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	a.So(msg.NormalizedPayloadWarnings, should.HaveLength, 2)
+	a.So(msg.NormalizedPayloadWarnings[0], should.Equal, "calibrated")
+	a.So(msg.NormalizedPayloadWarnings[1], should.StartWith, "measurement 2:")
+}
+
+func TestEncodeDownlink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	svc := &mockService{
+		EncodeDownlinkFunc: func(_ context.Context, req *ttnpb.EncodeDownlinkRequest) (*ttnpb.EncodeDownlinkResponse, error) {
+			a.So(req.Downlink.DecodedPayload.Fields, should.ContainKey, "state")
+			return &ttnpb.EncodeDownlinkResponse{
+				Downlink: &ttnpb.ApplicationDownlink{
+					FPort:                  2,
+					FrmPayload:             []byte{1, 2, 3},
+					DecodedPayloadWarnings: []string{"truncated"},
+				},
+			}, nil
+		},
+	}
+	target := startService(t, svc)
+	host := grpcservice.New(ctx, nil, newConfig(target))
+
+	msg := &ttnpb.ApplicationDownlink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"state": {Kind: &pbtypes.Value_StringValue{StringValue: "on"}},
+			},
+		},
+	}
+	if a.So(host.EncodeDownlink(ctx, ids, version, msg, target), should.BeNil) {
+		a.So(msg.FrmPayload, should.Resemble, []byte{1, 2, 3})
+		a.So(msg.FPort, should.Equal, 2)
+		a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"truncated"})
+	}
+}
+
+func TestDecodeDownlink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	svc := &mockService{
+		DecodeDownlinkFunc: func(_ context.Context, req *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error) {
+			a.So(req.Downlink.FrmPayload, should.Resemble, []byte{1, 2, 3})
+			return &ttnpb.DecodeDownlinkResponse{
+				Downlink: &ttnpb.ApplicationDownlink{
+					DecodedPayload: &pbtypes.Struct{
+						Fields: map[string]*pbtypes.Value{
+							"state": {Kind: &pbtypes.Value_StringValue{StringValue: "on"}},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	target := startService(t, svc)
+	host := grpcservice.New(ctx, nil, newConfig(target))
+
+	msg := &ttnpb.ApplicationDownlink{
+		FPort:      2,
+		FrmPayload: []byte{1, 2, 3},
+	}
+	if a.So(host.DecodeDownlink(ctx, ids, version, msg, target), should.BeNil) {
+		a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"state": {Kind: &pbtypes.Value_StringValue{StringValue: "on"}},
+			},
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	svc := &mockService{
+		DecodeUplinkFunc: func(context.Context, *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error) {
+			return nil, status.Error(codes.InvalidArgument, "invalid payload")
+		},
+		DecodeDownlinkFunc: func(context.Context, *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error) {
+			return &ttnpb.DecodeDownlinkResponse{}, nil
+		},
+	}
+	target := startService(t, svc)
+	config := newConfig(target)
+	host := grpcservice.New(ctx, nil, config)
+
+	err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FPort: 1}, target)
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	err = host.DecodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{FPort: 1}, target)
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	err = host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FPort: 1}, "no port")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	err = host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FPort: 1}, "localhost:1")
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+}
+
+func TestAuthorization(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	svc := &mockService{
+		DecodeDownlinkFunc: func(context.Context, *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error) {
+			return &ttnpb.DecodeDownlinkResponse{
+				Downlink: &ttnpb.ApplicationDownlink{},
+			}, nil
+		},
+	}
+	target := startService(t, svc)
+	config := newConfig(target)
+	config.Token = "secret"
+	host := grpcservice.New(ctx, nil, config)
+
+	if a.So(host.DecodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{FPort: 1}, target), should.BeNil) {
+		a.So(svc.authorization.Load(), should.Equal, "Bearer secret")
+	}
+
+	// The token is never sent to targets that are not allowed.
+	otherSvc := &mockService{
+		DecodeDownlinkFunc: svc.DecodeDownlinkFunc,
+	}
+	otherTarget := startService(t, otherSvc)
+	err := host.DecodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{FPort: 1}, otherTarget)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	a.So(atomic.LoadInt32(&otherSvc.calls), should.Equal, 0)
+	a.So(otherSvc.authorization.Load(), should.BeNil)
+}
+
+func TestDisabled(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	svc := &mockService{
+		DecodeUplinkFunc: func(context.Context, *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error) {
+			return &ttnpb.DecodeUplinkResponse{
+				Uplink: &ttnpb.ApplicationUplink{},
+			}, nil
+		},
+	}
+	target := startService(t, svc)
+	host := grpcservice.New(ctx, nil, newConfig())
+
+	err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FPort: 1}, target)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	a.So(atomic.LoadInt32(&svc.calls), should.Equal, 0)
+}
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	svc := &mockService{
+		DecodeDownlinkFunc: func(ctx context.Context, _ *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+	target := startService(t, svc)
+	config := newConfig(target)
+	config.Timeout = test.Delay
+	host := grpcservice.New(ctx, nil, config)
+
+	err := host.DecodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{FPort: 1}, target)
+	a.So(errors.IsDeadlineExceeded(err), should.BeTrue)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var unavailable int32 = 1
+	svc := &mockService{
+		DecodeDownlinkFunc: func(context.Context, *ttnpb.DecodeDownlinkRequest) (*ttnpb.DecodeDownlinkResponse, error) {
+			if atomic.LoadInt32(&unavailable) == 1 {
+				return nil, status.Error(codes.Unavailable, "overloaded")
+			}
+			return &ttnpb.DecodeDownlinkResponse{
+				Downlink: &ttnpb.ApplicationDownlink{},
+			}, nil
+		},
+	}
+	target := startService(t, svc)
+	config := newConfig(target)
+	config.CircuitBreaker = grpcservice.CircuitBreakerConfig{
+		Threshold: 2,
+		Cooldown:  (1 << 3) * test.Delay,
+	}
+	host := grpcservice.New(ctx, nil, config)
+	decode := func() error {
+		return host.DecodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{FPort: 1}, target)
+	}
+
+	// The circuit opens after two consecutive failures.
+	for i := 0; i < 2; i++ {
+		a.So(errors.IsUnavailable(decode()), should.BeTrue)
+	}
+	a.So(atomic.LoadInt32(&svc.calls), should.Equal, 2)
+	a.So(errors.IsUnavailable(decode()), should.BeTrue)
+	a.So(atomic.LoadInt32(&svc.calls), should.Equal, 2)
+
+	// The circuit allows calls after the cooldown, and closes on success.
+	atomic.StoreInt32(&unavailable, 0)
+	time.Sleep(config.CircuitBreaker.Cooldown)
+	a.So(decode(), should.BeNil)
+	a.So(decode(), should.BeNil)
+	a.So(atomic.LoadInt32(&svc.calls), should.Equal, 4)
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package normalizedpayload
+
+import (
+	"sort"
+	"strings"
+)
+
+// jsonSchemaDialect is the JSON Schema dialect of the schema returned by JSONSchema.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// fieldSchema describes a field of the normalized payload.
+type fieldSchema struct {
+	// Type is the JSON Schema type of the field.
+	Type string
+	// Format is the JSON Schema format of the field.
+	Format string
+	// Description is the human readable description of the field.
+	Description string
+	// Unit is the unit of the field, if any.
+	Unit string
+	// Minimum, ExclusiveMinimum, Maximum and ExclusiveMaximum are the numeric bounds of the field, if any.
+	Minimum, ExclusiveMinimum, Maximum, ExclusiveMaximum *float64
+	// Enum contains the allowed values of the field, if any.
+	Enum []string
+	// Since is the schema version in which the field was introduced.
+	Since string
+}
+
+func (s fieldSchema) jsonSchema() map[string]interface{} {
+	res := map[string]interface{}{
+		"type": s.Type,
+	}
+	if s.Format != "" {
+		res["format"] = s.Format
+	}
+	if s.Description != "" {
+		res["description"] = s.Description
+	}
+	if s.Unit != "" {
+		res["unit"] = s.Unit
+	}
+	for k, v := range map[string]*float64{
+		"minimum":          s.Minimum,
+		"exclusiveMinimum": s.ExclusiveMinimum,
+		"maximum":          s.Maximum,
+		"exclusiveMaximum": s.ExclusiveMaximum,
+	} {
+		if v != nil {
+			res[k] = *v
+		}
+	}
+	if len(s.Enum) > 0 {
+		enum := make([]interface{}, len(s.Enum))
+		for i, v := range s.Enum {
+			enum[i] = v
+		}
+		res["enum"] = enum
+	}
+	if s.Since != "" {
+		res["since"] = s.Since
+	}
+	if s.Type == "object" {
+		res["properties"] = map[string]interface{}{}
+		res["additionalProperties"] = false
+	}
+	return res
+}
+
+// JSONSchema returns the JSON Schema of a single normalized payload measurement.
+// Besides the standard keywords, the schema is annotated with its `version`, and fields with their `unit` and the
+// schema version they were added in as `since`.
+func JSONSchema() map[string]interface{} {
+	root := map[string]interface{}{
+		"$schema":              jsonSchemaDialect,
+		"title":                "Normalized payload measurement",
+		"version":              SchemaVersion,
+		"type":                 "object",
+		"properties":           map[string]interface{}{},
+		"additionalProperties": false,
+	}
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	// Sorting the paths guarantees that objects are defined before their properties.
+	sort.Strings(paths)
+	for _, path := range paths {
+		parent := root
+		elements := strings.Split(path, ".")
+		for _, element := range elements[:len(elements)-1] {
+			parent = parent["properties"].(map[string]interface{})[element].(map[string]interface{})
+		}
+		parent["properties"].(map[string]interface{})[elements[len(elements)-1]] = fields[path].schema.jsonSchema()
+	}
+	return root
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package normalizedpayload_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestJSONSchema(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	schema := normalizedpayload.JSONSchema()
+	a.So(schema["version"], should.Equal, normalizedpayload.SchemaVersion)
+	a.So(schema["type"], should.Equal, "object")
+	a.So(schema["additionalProperties"], should.BeFalse)
+
+	properties := schema["properties"].(map[string]interface{})
+	a.So(properties, should.ContainKey, "time")
+	a.So(properties["time"], should.Resemble, map[string]interface{}{
+		"type":        "string",
+		"format":      "date-time",
+		"description": "Time of the measurement",
+		"since":       "1.0.0",
+	})
+
+	wind := properties["wind"].(map[string]interface{})
+	a.So(wind["type"], should.Equal, "object")
+	a.So(wind["properties"].(map[string]interface{})["direction"], should.Resemble, map[string]interface{}{
+		"type":             "number",
+		"description":      "Wind direction, clockwise from north",
+		"unit":             "°",
+		"minimum":          0.0,
+		"exclusiveMaximum": 360.0,
+		"since":            "1.0.0",
+	})
+
+	metering := properties["metering"].(map[string]interface{})
+	electricity := metering["properties"].(map[string]interface{})["electricity"].(map[string]interface{})
+	a.So(electricity["additionalProperties"], should.BeFalse)
+	a.So(electricity["properties"], should.ContainKey, "energy")
+
+	action := properties["action"].(map[string]interface{})
+	a.So(action["properties"].(map[string]interface{})["contactState"], should.Resemble, map[string]interface{}{
+		"type":        "string",
+		"description": "State of the contact",
+		"enum":        []interface{}{"open", "closed"},
+		"since":       "1.1.0",
+	})
+
+	// The schema must be convertible to a protobuf struct to be exposed over the API.
+	_, err := gogoproto.Struct(schema)
+	a.So(err, should.BeNil)
+}
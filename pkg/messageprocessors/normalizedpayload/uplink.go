@@ -17,6 +17,7 @@ package normalizedpayload
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
@@ -24,11 +25,17 @@ import (
 	"golang.org/x/exp/constraints"
 )
 
+// SchemaVersion is the version of the normalized payload schema.
+// The minor version is incremented when fields are added, and the major version when fields are changed or removed.
+const SchemaVersion = "1.1.0"
+
 // Air is an air measurement.
 type Air struct {
 	Temperature      *float64
 	RelativeHumidity *float64
 	Pressure         *float64
+	CO2              *float64
+	VOC              *float64
 }
 
 // Wind is a wind measurement.
@@ -37,11 +44,93 @@ type Wind struct {
 	Direction *float64
 }
 
+// Soil is a soil measurement.
+type Soil struct {
+	Depth       *float64
+	Moisture    *float64
+	Temperature *float64
+	EC          *float64
+	PH          *float64
+}
+
+// Water is a water measurement.
+type Water struct {
+	Level       *float64
+	Temperature *float64
+	Leak        *bool
+}
+
+// Battery is a battery measurement.
+type Battery struct {
+	Voltage *float64
+	Level   *float64
+}
+
+// Light is a light measurement.
+type Light struct {
+	Illuminance *float64
+	UVIndex     *float64
+}
+
+// Motion is a motion measurement.
+type Motion struct {
+	Detected *bool
+	Count    *float64
+}
+
+// Occupancy is an occupancy measurement.
+type Occupancy struct {
+	Occupied    *bool
+	PeopleCount *float64
+}
+
+// Position is a position measurement.
+type Position struct {
+	Latitude  *float64
+	Longitude *float64
+	Altitude  *float64
+	Accuracy  *float64
+}
+
+// ElectricityMetering is an electricity metering measurement.
+type ElectricityMetering struct {
+	Energy  *float64
+	Power   *float64
+	Voltage *float64
+	Current *float64
+}
+
+// WaterMetering is a water metering measurement.
+type WaterMetering struct {
+	Volume *float64
+}
+
+// Metering is a metering measurement.
+type Metering struct {
+	Electricity ElectricityMetering
+	Water       WaterMetering
+}
+
+// Action is an action measurement.
+type Action struct {
+	ContactState *string
+	ButtonPress  *string
+}
+
 // Measurement is a measurement.
 type Measurement struct {
-	Time *time.Time
-	Air  Air
-	Wind Wind
+	Time      *time.Time
+	Air       Air
+	Wind      Wind
+	Soil      Soil
+	Water     Water
+	Battery   Battery
+	Light     Light
+	Motion    Motion
+	Occupancy Occupancy
+	Position  Position
+	Metering  Metering
+	Action    Action
 }
 
 var (
@@ -50,7 +139,6 @@ var (
 		"field_minimum",
 		"`{path}` should be equal or greater than `{minimum}`",
 	)
-	//nolint:unused
 	errFieldExclusiveMinimum = errors.DefineDataLoss(
 		"field_exclusive_minimum",
 		"`{path}` should be greater than `{minimum}`",
@@ -63,6 +151,10 @@ var (
 		"field_exclusive_maximum",
 		"`{path}` should be less than `{maximum}`",
 	)
+	errFieldEnum = errors.DefineDataLoss(
+		"field_enum",
+		"`{path}` should be one of `{values}`",
+	)
 	errUnknownField = errors.DefineInvalidArgument("unknown_field", "unknown field `{path}`")
 )
 
@@ -126,6 +218,38 @@ func parseNumber(selector func(dst *Measurement) **float64, vals ...fieldValidat
 	}
 }
 
+// parseBool parses and validates a boolean.
+func parseBool(selector func(dst *Measurement) **bool, vals ...fieldValidator[bool]) fieldParser {
+	return func(dst *Measurement, src *pbtypes.Value, path string) []error {
+		val, ok := src.Kind.(*pbtypes.Value_BoolValue)
+		if !ok {
+			return []error{errFieldType.WithAttributes("path", path)}
+		}
+		b := val.BoolValue
+		if validateErrs := validate(b, vals, path); len(validateErrs) > 0 {
+			return validateErrs
+		}
+		*selector(dst) = &b
+		return nil
+	}
+}
+
+// parseString parses and validates a string.
+func parseString(selector func(dst *Measurement) **string, vals ...fieldValidator[string]) fieldParser {
+	return func(dst *Measurement, src *pbtypes.Value, path string) []error {
+		val, ok := src.Kind.(*pbtypes.Value_StringValue)
+		if !ok {
+			return []error{errFieldType.WithAttributes("path", path)}
+		}
+		str := val.StringValue
+		if validateErrs := validate(str, vals, path); len(validateErrs) > 0 {
+			return validateErrs
+		}
+		*selector(dst) = &str
+		return nil
+	}
+}
+
 // minimum returns a field validator that checks the inclusive minimum.
 func minimum[T constraints.Ordered](min T) fieldValidator[T] {
 	return func(v T, path string) error {
@@ -140,8 +264,6 @@ func minimum[T constraints.Ordered](min T) fieldValidator[T] {
 }
 
 // exclusiveMinimum returns a field validator that checks the exclusive minimum.
-//
-//nolint:unused,deadcode
 func exclusiveMinimum[T constraints.Ordered](min T) fieldValidator[T] {
 	return func(v T, path string) error {
 		if v <= min {
@@ -180,54 +302,591 @@ func exclusiveMaximum[T constraints.Ordered](max T) fieldValidator[T] {
 	}
 }
 
-var fieldParsers = map[string]fieldParser{
-	"time": parseTime(
+// oneOf returns a field validator that checks that the value is one of the given values.
+func oneOf[T comparable](values ...T) fieldValidator[T] {
+	return func(v T, path string) error {
+		for _, value := range values {
+			if v == value {
+				return nil
+			}
+		}
+		strs := make([]string, len(values))
+		for i, value := range values {
+			strs[i] = fmt.Sprint(value)
+		}
+		return errFieldEnum.WithAttributes(
+			"path", path,
+			"values", strings.Join(strs, ", "),
+		)
+	}
+}
+
+// field is a field of the normalized payload.
+type field struct {
+	schema fieldSchema
+	parser fieldParser
+}
+
+// objectField returns an object field.
+func objectField[T any](schema fieldSchema, selector func(*Measurement) *T) field {
+	schema.Type = "object"
+	return field{
+		schema: schema,
+		parser: object(selector),
+	}
+}
+
+// timeField returns a date-time field.
+func timeField(schema fieldSchema, selector func(*Measurement) **time.Time) field {
+	schema.Type, schema.Format = "string", "date-time"
+	return field{
+		schema: schema,
+		parser: parseTime(selector),
+	}
+}
+
+// numberField returns a number field. The validators are derived from the bounds in the schema.
+func numberField(schema fieldSchema, selector func(*Measurement) **float64) field {
+	schema.Type = "number"
+	var vals []fieldValidator[float64]
+	if schema.Minimum != nil {
+		vals = append(vals, minimum(*schema.Minimum))
+	}
+	if schema.ExclusiveMinimum != nil {
+		vals = append(vals, exclusiveMinimum(*schema.ExclusiveMinimum))
+	}
+	if schema.Maximum != nil {
+		vals = append(vals, maximum(*schema.Maximum))
+	}
+	if schema.ExclusiveMaximum != nil {
+		vals = append(vals, exclusiveMaximum(*schema.ExclusiveMaximum))
+	}
+	return field{
+		schema: schema,
+		parser: parseNumber(selector, vals...),
+	}
+}
+
+// booleanField returns a boolean field.
+func booleanField(schema fieldSchema, selector func(*Measurement) **bool) field {
+	schema.Type = "boolean"
+	return field{
+		schema: schema,
+		parser: parseBool(selector),
+	}
+}
+
+// stringField returns a string field. If the schema defines an enum, the value must be one of the enum values.
+func stringField(schema fieldSchema, selector func(*Measurement) **string) field {
+	schema.Type = "string"
+	var vals []fieldValidator[string]
+	if len(schema.Enum) > 0 {
+		vals = append(vals, oneOf(schema.Enum...))
+	}
+	return field{
+		schema: schema,
+		parser: parseString(selector, vals...),
+	}
+}
+
+func bound(f float64) *float64 {
+	return &f
+}
+
+// fields contains the fields of the normalized payload by their path.
+var fields = map[string]field{
+	"time": timeField(
+		fieldSchema{
+			Description: "Time of the measurement",
+			Since:       "1.0.0",
+		},
 		func(dst *Measurement) **time.Time {
 			return &dst.Time
 		},
 	),
-	"air": object(
+	"air": objectField(
+		fieldSchema{
+			Description: "Air measurement",
+			Since:       "1.0.0",
+		},
 		func(dst *Measurement) *Air {
 			return &dst.Air
 		},
 	),
-	"air.temperature": parseNumber(
+	"air.temperature": numberField(
+		fieldSchema{
+			Description: "Temperature",
+			Unit:        "°C",
+			Minimum:     bound(-273.15),
+			Since:       "1.0.0",
+		},
 		func(dst *Measurement) **float64 {
 			return &dst.Air.Temperature
 		},
-		minimum(-273.15),
 	),
-	"air.relativeHumidity": parseNumber(
+	"air.relativeHumidity": numberField(
+		fieldSchema{
+			Description: "Relative humidity",
+			Unit:        "%",
+			Minimum:     bound(0),
+			Maximum:     bound(100),
+			Since:       "1.0.0",
+		},
 		func(dst *Measurement) **float64 {
 			return &dst.Air.RelativeHumidity
 		},
-		minimum(0.0),
-		maximum(100.0),
 	),
-	"air.pressure": parseNumber(
+	"air.pressure": numberField(
+		fieldSchema{
+			Description: "Atmospheric pressure",
+			Unit:        "hPa",
+			Minimum:     bound(900),
+			Maximum:     bound(1100),
+			Since:       "1.0.0",
+		},
 		func(dst *Measurement) **float64 {
 			return &dst.Air.Pressure
 		},
-		minimum(900.0),
-		maximum(1100.0),
 	),
-	"wind": object(
+	"air.co2": numberField(
+		fieldSchema{
+			Description: "Carbon dioxide concentration",
+			Unit:        "ppm",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Air.CO2
+		},
+	),
+	"air.voc": numberField(
+		fieldSchema{
+			Description: "Volatile organic compounds concentration",
+			Unit:        "ppb",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Air.VOC
+		},
+	),
+	"wind": objectField(
+		fieldSchema{
+			Description: "Wind measurement",
+			Since:       "1.0.0",
+		},
 		func(dst *Measurement) *Wind {
 			return &dst.Wind
 		},
 	),
-	"wind.speed": parseNumber(
+	"wind.speed": numberField(
+		fieldSchema{
+			Description: "Wind speed",
+			Unit:        "m/s",
+			Minimum:     bound(0),
+			Since:       "1.0.0",
+		},
 		func(dst *Measurement) **float64 {
 			return &dst.Wind.Speed
 		},
-		minimum(0.0),
 	),
-	"wind.direction": parseNumber(
+	"wind.direction": numberField(
+		fieldSchema{
+			Description:      "Wind direction, clockwise from north",
+			Unit:             "°",
+			Minimum:          bound(0),
+			ExclusiveMaximum: bound(360),
+			Since:            "1.0.0",
+		},
 		func(dst *Measurement) **float64 {
 			return &dst.Wind.Direction
 		},
-		minimum(0.0),
-		exclusiveMaximum(360.0),
+	),
+	"soil": objectField(
+		fieldSchema{
+			Description: "Soil measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Soil {
+			return &dst.Soil
+		},
+	),
+	"soil.depth": numberField(
+		fieldSchema{
+			Description: "Depth of the measurement below the surface",
+			Unit:        "cm",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Soil.Depth
+		},
+	),
+	"soil.moisture": numberField(
+		fieldSchema{
+			Description: "Volumetric water content",
+			Unit:        "%",
+			Minimum:     bound(0),
+			Maximum:     bound(100),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Soil.Moisture
+		},
+	),
+	"soil.temperature": numberField(
+		fieldSchema{
+			Description: "Temperature",
+			Unit:        "°C",
+			Minimum:     bound(-273.15),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Soil.Temperature
+		},
+	),
+	"soil.ec": numberField(
+		fieldSchema{
+			Description: "Electrical conductivity",
+			Unit:        "dS/m",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Soil.EC
+		},
+	),
+	"soil.pH": numberField(
+		fieldSchema{
+			Description: "Acidity",
+			Minimum:     bound(0),
+			Maximum:     bound(14),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Soil.PH
+		},
+	),
+	"water": objectField(
+		fieldSchema{
+			Description: "Water measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Water {
+			return &dst.Water
+		},
+	),
+	"water.level": numberField(
+		fieldSchema{
+			Description: "Water level relative to the reference level of the sensor",
+			Unit:        "cm",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Water.Level
+		},
+	),
+	"water.temperature": numberField(
+		fieldSchema{
+			Description: "Temperature",
+			Unit:        "°C",
+			Minimum:     bound(-273.15),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Water.Temperature
+		},
+	),
+	"water.leak": booleanField(
+		fieldSchema{
+			Description: "Whether a leak is detected",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **bool {
+			return &dst.Water.Leak
+		},
+	),
+	"battery": objectField(
+		fieldSchema{
+			Description: "Battery measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Battery {
+			return &dst.Battery
+		},
+	),
+	"battery.voltage": numberField(
+		fieldSchema{
+			Description: "Battery voltage",
+			Unit:        "V",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Battery.Voltage
+		},
+	),
+	"battery.level": numberField(
+		fieldSchema{
+			Description: "Remaining battery capacity",
+			Unit:        "%",
+			Minimum:     bound(0),
+			Maximum:     bound(100),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Battery.Level
+		},
+	),
+	"light": objectField(
+		fieldSchema{
+			Description: "Light measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Light {
+			return &dst.Light
+		},
+	),
+	"light.illuminance": numberField(
+		fieldSchema{
+			Description: "Illuminance",
+			Unit:        "lx",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Light.Illuminance
+		},
+	),
+	"light.uvIndex": numberField(
+		fieldSchema{
+			Description: "Ultraviolet index",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Light.UVIndex
+		},
+	),
+	"motion": objectField(
+		fieldSchema{
+			Description: "Motion measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Motion {
+			return &dst.Motion
+		},
+	),
+	"motion.detected": booleanField(
+		fieldSchema{
+			Description: "Whether motion is detected",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **bool {
+			return &dst.Motion.Detected
+		},
+	),
+	"motion.count": numberField(
+		fieldSchema{
+			Description: "Number of motion events since the previous measurement",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Motion.Count
+		},
+	),
+	"occupancy": objectField(
+		fieldSchema{
+			Description: "Occupancy measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Occupancy {
+			return &dst.Occupancy
+		},
+	),
+	"occupancy.occupied": booleanField(
+		fieldSchema{
+			Description: "Whether the space is occupied",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **bool {
+			return &dst.Occupancy.Occupied
+		},
+	),
+	"occupancy.peopleCount": numberField(
+		fieldSchema{
+			Description: "Number of people in the space",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Occupancy.PeopleCount
+		},
+	),
+	"position": objectField(
+		fieldSchema{
+			Description: "Position measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Position {
+			return &dst.Position
+		},
+	),
+	"position.latitude": numberField(
+		fieldSchema{
+			Description: "Latitude (WGS84)",
+			Unit:        "°",
+			Minimum:     bound(-90),
+			Maximum:     bound(90),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Latitude
+		},
+	),
+	"position.longitude": numberField(
+		fieldSchema{
+			Description: "Longitude (WGS84)",
+			Unit:        "°",
+			Minimum:     bound(-180),
+			Maximum:     bound(180),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Longitude
+		},
+	),
+	"position.altitude": numberField(
+		fieldSchema{
+			Description: "Altitude above sea level",
+			Unit:        "m",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Altitude
+		},
+	),
+	"position.accuracy": numberField(
+		fieldSchema{
+			Description: "Horizontal accuracy",
+			Unit:        "m",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Accuracy
+		},
+	),
+	"metering": objectField(
+		fieldSchema{
+			Description: "Metering measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Metering {
+			return &dst.Metering
+		},
+	),
+	"metering.electricity": objectField(
+		fieldSchema{
+			Description: "Electricity metering measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *ElectricityMetering {
+			return &dst.Metering.Electricity
+		},
+	),
+	"metering.electricity.energy": numberField(
+		fieldSchema{
+			Description: "Total energy consumption",
+			Unit:        "kWh",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Electricity.Energy
+		},
+	),
+	"metering.electricity.power": numberField(
+		fieldSchema{
+			Description: "Active power",
+			Unit:        "W",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Electricity.Power
+		},
+	),
+	"metering.electricity.voltage": numberField(
+		fieldSchema{
+			Description: "Voltage",
+			Unit:        "V",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Electricity.Voltage
+		},
+	),
+	"metering.electricity.current": numberField(
+		fieldSchema{
+			Description: "Current",
+			Unit:        "A",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Electricity.Current
+		},
+	),
+	"metering.water": objectField(
+		fieldSchema{
+			Description: "Water metering measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *WaterMetering {
+			return &dst.Metering.Water
+		},
+	),
+	"metering.water.volume": numberField(
+		fieldSchema{
+			Description: "Total water consumption",
+			Unit:        "L",
+			Minimum:     bound(0),
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Water.Volume
+		},
+	),
+	"action": objectField(
+		fieldSchema{
+			Description: "Action measurement",
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) *Action {
+			return &dst.Action
+		},
+	),
+	"action.contactState": stringField(
+		fieldSchema{
+			Description: "State of the contact",
+			Enum:        []string{"open", "closed"},
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **string {
+			return &dst.Action.ContactState
+		},
+	),
+	"action.buttonPress": stringField(
+		fieldSchema{
+			Description: "Type of button press",
+			Enum:        []string{"single", "double", "long"},
+			Since:       "1.1.0",
+		},
+		func(dst *Measurement) **string {
+			return &dst.Action.ButtonPress
+		},
 	),
 }
 
@@ -258,11 +917,11 @@ func Parse(measurements []*pbtypes.Struct) ([]ParsedMeasurement, error) {
 func parse(dst *ParsedMeasurement, src *pbtypes.Struct, prefix string) error {
 	for k, v := range src.GetFields() {
 		path := fmt.Sprintf("%s%s", prefix, k)
-		parser, ok := fieldParsers[path]
+		f, ok := fields[path]
 		if !ok {
 			return errUnknownField.WithAttributes("path", path)
 		}
-		if errs := parser(&dst.Measurement, v, path); errs != nil {
+		if errs := f.parser(&dst.Measurement, v, path); errs != nil {
 			for _, err := range errs {
 				if !errors.IsDataLoss(err) {
 					return err
@@ -33,6 +33,14 @@ func float64Ptr(f float64) *float64 {
 	return &f
 }
 
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
 func TestUplink(t *testing.T) {
 	t.Parallel()
 
@@ -188,6 +196,195 @@ func TestUplink(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "extended categories",
+			normalizedPayload: []*pbtypes.Struct{
+				{
+					Fields: map[string]*pbtypes.Value{
+						"soil": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"moisture": {
+											Kind: &pbtypes.Value_NumberValue{
+												NumberValue: 31.5,
+											},
+										},
+										"pH": {
+											Kind: &pbtypes.Value_NumberValue{
+												NumberValue: 6.8,
+											},
+										},
+									},
+								},
+							},
+						},
+						"water": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"leak": {
+											Kind: &pbtypes.Value_BoolValue{
+												BoolValue: true,
+											},
+										},
+									},
+								},
+							},
+						},
+						"battery": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"voltage": {
+											Kind: &pbtypes.Value_NumberValue{
+												NumberValue: 3.6,
+											},
+										},
+									},
+								},
+							},
+						},
+						"metering": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"electricity": {
+											Kind: &pbtypes.Value_StructValue{
+												StructValue: &pbtypes.Struct{
+													Fields: map[string]*pbtypes.Value{
+														"energy": {
+															Kind: &pbtypes.Value_NumberValue{
+																NumberValue: 1234.5,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"action": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"contactState": {
+											Kind: &pbtypes.Value_StringValue{
+												StringValue: "open",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []normalizedpayload.Measurement{
+				{
+					Soil: normalizedpayload.Soil{
+						Moisture: float64Ptr(31.5),
+						PH:       float64Ptr(6.8),
+					},
+					Water: normalizedpayload.Water{
+						Leak: boolPtr(true),
+					},
+					Battery: normalizedpayload.Battery{
+						Voltage: float64Ptr(3.6),
+					},
+					Metering: normalizedpayload.Metering{
+						Electricity: normalizedpayload.ElectricityMetering{
+							Energy: float64Ptr(1234.5),
+						},
+					},
+					Action: normalizedpayload.Action{
+						ContactState: stringPtr("open"),
+					},
+				},
+			},
+		},
+		{
+			name: "invalid latitude and contact state",
+			normalizedPayload: []*pbtypes.Struct{
+				{
+					Fields: map[string]*pbtypes.Value{
+						"position": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"latitude": {
+											Kind: &pbtypes.Value_NumberValue{
+												NumberValue: 91,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Fields: map[string]*pbtypes.Value{
+						"action": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"contactState": {
+											Kind: &pbtypes.Value_StringValue{
+												StringValue: "ajar",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: []normalizedpayload.Measurement{
+				{},
+				{},
+			},
+			expectedValidationErrors: [][]error{
+				{
+					normalizedpayload.ErrFieldMaximum.WithAttributes(
+						"path", "position.latitude",
+						"maximum", 90.0,
+					),
+				},
+				{
+					normalizedpayload.ErrFieldEnum.WithAttributes(
+						"path", "action.contactState",
+						"values", "open, closed",
+					),
+				},
+			},
+		},
+		{
+			name: "invalid boolean type",
+			normalizedPayload: []*pbtypes.Struct{
+				{
+					Fields: map[string]*pbtypes.Value{
+						"motion": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"detected": {
+											Kind: &pbtypes.Value_NumberValue{
+												NumberValue: 1,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
 		{
 			name: "invalid type",
 			normalizedPayload: []*pbtypes.Struct{
@@ -0,0 +1,155 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messageprocessors
+
+import (
+	"bytes"
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errNoFormatter = errors.DefineFailedPrecondition("no_formatter", "no payload formatter for test vector `{name}`")
+
+// RunTestVectors runs the test vectors of the given formatters and returns the results.
+// A test vector passes if the output of the payload formatter matches the expected output. If the test vector
+// expects errors, the test vector passes if the payload formatter fails.
+func RunTestVectors(
+	ctx context.Context,
+	p PayloadProcessor,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	formatters *ttnpb.MessagePayloadFormatters,
+) []*ttnpb.TestFormattersResponse_Result {
+	results := make([]*ttnpb.TestFormattersResponse_Result, 0, len(formatters.GetTestVectors()))
+	for _, vector := range formatters.GetTestVectors() {
+		output := &ttnpb.MessagePayloadFormatterTestVector{
+			Name:        vector.Name,
+			Description: vector.Description,
+			Type:        vector.Type,
+		}
+		err := runTestVector(ctx, p, ids, version, formatters, vector, output)
+		if err != nil {
+			output.Errors = []string{err.Error()}
+		}
+		results = append(results, &ttnpb.TestFormattersResponse_Result{
+			Name:   vector.Name,
+			Passed: testVectorPassed(vector, output, err),
+			Output: output,
+		})
+	}
+	return results
+}
+
+// FailedTestVectors returns the names of the test vectors that did not pass.
+func FailedTestVectors(results []*ttnpb.TestFormattersResponse_Result) []string {
+	var names []string
+	for _, res := range results {
+		if !res.Passed {
+			names = append(names, res.Name)
+		}
+	}
+	return names
+}
+
+func runTestVector(
+	ctx context.Context,
+	p PayloadProcessor,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	formatters *ttnpb.MessagePayloadFormatters,
+	vector *ttnpb.MessagePayloadFormatterTestVector,
+	output *ttnpb.MessagePayloadFormatterTestVector,
+) error {
+	switch vector.Type {
+	case ttnpb.MessagePayloadFormatterTestVector_UPLINK_DECODE:
+		if formatters.UpFormatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+			return errNoFormatter.WithAttributes("name", vector.Name)
+		}
+		msg := &ttnpb.ApplicationUplink{
+			FPort:      vector.FPort,
+			FrmPayload: vector.FrmPayload,
+		}
+		output.FPort, output.FrmPayload = vector.FPort, vector.FrmPayload
+		if err := p.DecodeUplink(ctx, ids, version, msg, formatters.UpFormatter, formatters.UpFormatterParameter); err != nil {
+			return err
+		}
+		output.DecodedPayload, output.Warnings = msg.DecodedPayload, msg.DecodedPayloadWarnings
+
+	case ttnpb.MessagePayloadFormatterTestVector_DOWNLINK_ENCODE:
+		if formatters.DownFormatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+			return errNoFormatter.WithAttributes("name", vector.Name)
+		}
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: vector.DecodedPayload,
+		}
+		output.DecodedPayload = vector.DecodedPayload
+		if err := p.EncodeDownlink(ctx, ids, version, msg, formatters.DownFormatter, formatters.DownFormatterParameter); err != nil {
+			return err
+		}
+		output.FPort, output.FrmPayload, output.Warnings = msg.FPort, msg.FrmPayload, msg.DecodedPayloadWarnings
+
+	case ttnpb.MessagePayloadFormatterTestVector_DOWNLINK_DECODE:
+		if formatters.DownFormatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+			return errNoFormatter.WithAttributes("name", vector.Name)
+		}
+		msg := &ttnpb.ApplicationDownlink{
+			FPort:      vector.FPort,
+			FrmPayload: vector.FrmPayload,
+		}
+		output.FPort, output.FrmPayload = vector.FPort, vector.FrmPayload
+		if err := p.DecodeDownlink(ctx, ids, version, msg, formatters.DownFormatter, formatters.DownFormatterParameter); err != nil {
+			return err
+		}
+		output.DecodedPayload, output.Warnings = msg.DecodedPayload, msg.DecodedPayloadWarnings
+	}
+	return nil
+}
+
+func testVectorPassed(expected, actual *ttnpb.MessagePayloadFormatterTestVector, err error) bool {
+	if len(expected.Errors) > 0 || err != nil {
+		return len(expected.Errors) > 0 && err != nil
+	}
+	if !equalStrings(expected.Warnings, actual.Warnings) {
+		return false
+	}
+	switch expected.Type {
+	case ttnpb.MessagePayloadFormatterTestVector_DOWNLINK_ENCODE:
+		return expected.FPort == actual.FPort && bytes.Equal(expected.FrmPayload, actual.FrmPayload)
+	default:
+		return equalStructs(expected.DecodedPayload, actual.DecodedPayload)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalStructs(a, b *pbtypes.Struct) bool {
+	if len(a.GetFields()) == 0 || len(b.GetFields()) == 0 {
+		return len(a.GetFields()) == len(b.GetFields())
+	}
+	return a.Equal(b)
+}
@@ -0,0 +1,151 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messageprocessors_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	. "go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRunTestVectors(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	processor := MapPayloadProcessor{
+		ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
+	}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		DeviceId:       "foo-device",
+	}
+	script := `
+function decodeUplink(input) {
+  if (input.fPort !== 1) {
+    return { errors: ["unknown FPort"] };
+  }
+  return { data: { temperature: input.bytes[0] } };
+}
+
+function encodeDownlink(input) {
+  return { fPort: 2, bytes: [input.data.led ? 1 : 0] };
+}
+
+function decodeDownlink(input) {
+  return { data: { led: input.bytes[0] === 1 }, warnings: ["led"] };
+}
+`
+	temperature := func(v float64) *pbtypes.Struct {
+		return &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: v}},
+			},
+		}
+	}
+	led := &pbtypes.Struct{
+		Fields: map[string]*pbtypes.Value{
+			"led": {Kind: &pbtypes.Value_BoolValue{BoolValue: true}},
+		},
+	}
+	formatters := &ttnpb.MessagePayloadFormatters{
+		UpFormatter:            ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+		UpFormatterParameter:   script,
+		DownFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+		DownFormatterParameter: script,
+		TestVectors: []*ttnpb.MessagePayloadFormatterTestVector{
+			{
+				Name:           "uplink",
+				Type:           ttnpb.MessagePayloadFormatterTestVector_UPLINK_DECODE,
+				FPort:          1,
+				FrmPayload:     []byte{21},
+				DecodedPayload: temperature(21),
+			},
+			{
+				Name:           "uplink-mismatch",
+				Type:           ttnpb.MessagePayloadFormatterTestVector_UPLINK_DECODE,
+				FPort:          1,
+				FrmPayload:     []byte{21},
+				DecodedPayload: temperature(22),
+			},
+			{
+				Name:       "uplink-error",
+				Type:       ttnpb.MessagePayloadFormatterTestVector_UPLINK_DECODE,
+				FPort:      2,
+				FrmPayload: []byte{21},
+				Errors:     []string{"unknown FPort"},
+			},
+			{
+				Name:           "uplink-unexpected-error",
+				Type:           ttnpb.MessagePayloadFormatterTestVector_UPLINK_DECODE,
+				FPort:          2,
+				FrmPayload:     []byte{21},
+				DecodedPayload: temperature(21),
+			},
+			{
+				Name:           "downlink-encode",
+				Type:           ttnpb.MessagePayloadFormatterTestVector_DOWNLINK_ENCODE,
+				FPort:          2,
+				FrmPayload:     []byte{1},
+				DecodedPayload: led,
+			},
+			{
+				Name:           "downlink-decode",
+				Type:           ttnpb.MessagePayloadFormatterTestVector_DOWNLINK_DECODE,
+				FPort:          2,
+				FrmPayload:     []byte{1},
+				DecodedPayload: led,
+				Warnings:       []string{"led"},
+			},
+			{
+				Name:           "downlink-decode-warnings",
+				Type:           ttnpb.MessagePayloadFormatterTestVector_DOWNLINK_DECODE,
+				FPort:          2,
+				FrmPayload:     []byte{1},
+				DecodedPayload: led,
+			},
+		},
+	}
+
+	results := RunTestVectors(ctx, processor, ids, nil, formatters)
+	passed := make(map[string]bool, len(results))
+	for _, res := range results {
+		passed[res.Name] = res.Passed
+	}
+	a.So(passed, should.Resemble, map[string]bool{
+		"uplink":                   true,
+		"uplink-mismatch":          false,
+		"uplink-error":             true,
+		"uplink-unexpected-error":  false,
+		"downlink-encode":          true,
+		"downlink-decode":          true,
+		"downlink-decode-warnings": false,
+	})
+	a.So(results[1].Output.DecodedPayload, should.Resemble, temperature(21))
+	a.So(results[3].Output.Errors, should.HaveLength, 1)
+	a.So(FailedTestVectors(results), should.Resemble, []string{
+		"uplink-mismatch", "uplink-unexpected-error", "downlink-decode-warnings",
+	})
+
+	// Without downlink formatter, the downlink test vectors fail.
+	formatters.DownFormatter = ttnpb.PayloadFormatter_FORMATTER_NONE
+	a.So(FailedTestVectors(RunTestVectors(ctx, processor, ids, nil, formatters)), should.Resemble, []string{
+		"uplink-mismatch", "uplink-unexpected-error", "downlink-encode", "downlink-decode", "downlink-decode-warnings",
+	})
+}
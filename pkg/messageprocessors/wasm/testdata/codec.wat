@@ -0,0 +1,43 @@
+;; codec.wasm is compiled from this module with `wat2wasm codec.wat`.
+(module
+  (type (func (param i32) (result i32)))
+  (type (func (param i32 i32) (result i64)))
+  (memory (export "memory") 1)
+
+  ;; The input is always written at offset 16384.
+  (func (export "alloc") (type 0)
+    i32.const 16384)
+
+  ;; Returns {"data":<input>}, so the decoded payload contains the bytes and the FPort.
+  (func (export "decodeUplink") (type 1)
+    i32.const 32768
+    i32.const 256
+    i32.const 8
+    memory.copy
+    i32.const 32776
+    local.get 0
+    local.get 1
+    memory.copy
+    i32.const 32776
+    local.get 1
+    i32.add
+    i32.const 125 ;; }
+    i32.store8
+    i64.const 0x8000_0000_0009
+    local.get 1
+    i64.extend_i32_u
+    i64.add)
+
+  (func (export "normalizeUplink") (type 1)
+    i64.const 0x120_0000_003e)
+
+  (func (export "encodeDownlink") (type 1)
+    i64.const 0x180_0000_0034)
+
+  (func (export "decodeDownlink") (type 1)
+    i64.const 0x1c0_0000_0017)
+
+  (data (i32.const 256) "{\"data\":")
+  (data (i32.const 288) "{\"data\":{\"air\":{\"temperature\":21.5}},\"warnings\":[\"estimated\"]}")
+  (data (i32.const 384) "{\"bytes\":[1,2,3],\"fPort\":2,\"warnings\":[\"truncated\"]}")
+  (data (i32.const 448) "{\"data\":{\"state\":\"on\"}}"))
@@ -0,0 +1,19 @@
+;; errors.wasm is compiled from this module with `wat2wasm errors.wat`.
+(module
+  (type (func (param i32) (result i32)))
+  (type (func (param i32 i32) (result i64)))
+  (memory (export "memory") 1)
+
+  (func (export "alloc") (type 0)
+    i32.const 16384)
+
+  (func (export "decodeUplink") (type 1)
+    i64.const 0x100_0000_001e)
+
+  (func (export "encodeDownlink") (type 1)
+    i64.const 0x100_0000_001e)
+
+  (func (export "decodeDownlink") (type 1)
+    i64.const 0x100_0000_001e)
+
+  (data (i32.const 256) "{\"errors\":[\"invalid payload\"]}"))
@@ -0,0 +1,480 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm contains the WebAssembly payload formatter message processors.
+//
+// The formatter parameter is the base64 encoded WebAssembly module. The module implements the ABI of the WebAssembly
+// scripting engine, and exports the following entrypoints, which take and return the same JSON objects as the
+// functions of JavaScript payload formatters:
+//
+//   - decodeUplink: takes {bytes, fPort} and returns {data, warnings, errors}.
+//   - normalizeUplink (optional): takes {data} and returns {data, warnings, errors}. It is called with the output of
+//     decodeUplink if that did not return errors.
+//   - encodeDownlink: takes {data, fPort} and returns {bytes, fPort, warnings, errors}.
+//   - decodeDownlink: takes {bytes, fPort} and returns {data, warnings, errors}.
+//
+// Byte arrays are encoded as JSON arrays of numbers.
+package wasm
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"runtime/trace"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting/wasm"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// stackDepthLimit is the maximum depth of nested function calls of the modules. Compiled modules use more call frames
+// than JavaScript payload formatters, as the runtime of the source language calls functions of the module as well.
+const stackDepthLimit = 1024
+
+type host struct {
+	engine scripting.AheadOfTimeEngine
+}
+
+// New creates and returns a new WebAssembly payload encoder and decoder.
+func New() messageprocessors.CompilablePayloadEncoderDecoder {
+	options := scripting.DefaultOptions
+	options.StackDepthLimit = stackDepthLimit
+	return &host{
+		engine: wasm.New(options),
+	}
+}
+
+var (
+	errModule       = errors.DefineInvalidArgument("module", "invalid module encoding")
+	errInput        = errors.DefineInvalidArgument("input", "invalid input")
+	errOutput       = errors.Define("output", "invalid output")
+	errOutputErrors = errors.DefineAborted("output_errors", "{errors}")
+	errByteValue    = errors.DefineInvalidArgument("byte_value", "invalid byte value `{value}`")
+)
+
+// byteArray is a byte slice that is encoded as a JSON array of numbers.
+type byteArray []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b byteArray) MarshalJSON() ([]byte, error) {
+	ints := make([]uint16, len(b))
+	for i, v := range b {
+		ints[i] = uint16(v)
+	}
+	return json.Marshal(ints)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *byteArray) UnmarshalJSON(data []byte) error {
+	var ints []uint16
+	if err := json.Unmarshal(data, &ints); err != nil {
+		return err
+	}
+	res := make([]byte, len(ints))
+	for i, v := range ints {
+		if v > 0xff {
+			return errByteValue.WithAttributes("value", v)
+		}
+		res[i] = byte(v)
+	}
+	*b = res
+	return nil
+}
+
+func decodeModule(parameter string) (string, error) {
+	module, err := base64.StdEncoding.DecodeString(parameter)
+	if err != nil {
+		return "", errModule.WithCause(err)
+	}
+	return string(module), nil
+}
+
+type runFunc func(context.Context, string, ...interface{}) (func(interface{}) error, error)
+
+func (h *host) compile(ctx context.Context, parameter string) (runFunc, error) {
+	module, err := decodeModule(parameter)
+	if err != nil {
+		return nil, err
+	}
+	return h.engine.Compile(ctx, module)
+}
+
+func (h *host) run(parameter string) runFunc {
+	return func(ctx context.Context, fn string, params ...interface{}) (func(interface{}) error, error) {
+		module, err := decodeModule(parameter)
+		if err != nil {
+			return nil, err
+		}
+		return h.engine.Run(ctx, module, fn, params...)
+	}
+}
+
+type encodeDownlinkInput struct {
+	Data  map[string]interface{} `json:"data"`
+	FPort *uint8                 `json:"fPort"`
+}
+
+type encodeDownlinkOutput struct {
+	Bytes    byteArray `json:"bytes"`
+	FPort    *uint8    `json:"fPort"`
+	Warnings []string  `json:"warnings"`
+	Errors   []string  `json:"errors"`
+}
+
+// CompileDownlinkEncoder generates a downlink encoder from the provided module.
+func (h *host) CompileDownlinkEncoder(
+	ctx context.Context, parameter string,
+) (
+	func(
+		context.Context,
+		*ttnpb.EndDeviceIdentifiers,
+		*ttnpb.EndDeviceVersionIdentifiers,
+		*ttnpb.ApplicationDownlink,
+	) error,
+	error,
+) {
+	defer trace.StartRegion(ctx, "compile downlink encoder").End()
+
+	run, err := h.compile(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		version *ttnpb.EndDeviceVersionIdentifiers,
+		msg *ttnpb.ApplicationDownlink,
+	) error {
+		return h.encodeDownlink(ctx, msg, run)
+	}, nil
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the given module.
+func (h *host) EncodeDownlink(
+	ctx context.Context,
+	_ *ttnpb.EndDeviceIdentifiers,
+	_ *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	return h.encodeDownlink(ctx, msg, h.run(parameter))
+}
+
+func (*host) encodeDownlink(ctx context.Context, msg *ttnpb.ApplicationDownlink, run runFunc) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	decoded := msg.DecodedPayload
+	if decoded == nil {
+		return nil
+	}
+	data, err := gogoproto.Map(decoded)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	fPort := uint8(msg.FPort)
+	input := encodeDownlinkInput{
+		Data:  data,
+		FPort: &fPort,
+	}
+
+	valueAs, err := run(ctx, "encodeDownlink", input)
+	if err != nil {
+		return err
+	}
+
+	var output encodeDownlinkOutput
+	err = valueAs(&output)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	if len(output.Errors) > 0 {
+		return errOutputErrors.WithAttributes("errors", strings.Join(output.Errors, ", "))
+	}
+
+	msg.FrmPayload = output.Bytes
+	msg.DecodedPayloadWarnings = output.Warnings
+	if output.FPort != nil {
+		fPort := *output.FPort
+		msg.FPort = uint32(fPort)
+	} else if msg.FPort == 0 {
+		msg.FPort = 1
+	}
+	return nil
+}
+
+type decodeInput struct {
+	Bytes byteArray `json:"bytes"`
+	FPort uint8     `json:"fPort"`
+}
+
+type decodeOutput struct {
+	Data     map[string]interface{} `json:"data"`
+	Warnings []string               `json:"warnings"`
+	Errors   []string               `json:"errors"`
+}
+
+type normalizeUplinkInput struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+type normalizeUplinkOutput struct {
+	Data     interface{} `json:"data"`
+	Warnings []string    `json:"warnings"`
+	Errors   []string    `json:"errors"`
+}
+
+// CompileUplinkDecoder generates an uplink decoder from the provided module.
+func (h *host) CompileUplinkDecoder(
+	ctx context.Context, parameter string,
+) (
+	func(
+		context.Context,
+		*ttnpb.EndDeviceIdentifiers,
+		*ttnpb.EndDeviceVersionIdentifiers,
+		*ttnpb.ApplicationUplink,
+	) error,
+	error,
+) {
+	defer trace.StartRegion(ctx, "compile uplink decoder").End()
+
+	run, err := h.compile(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		version *ttnpb.EndDeviceVersionIdentifiers,
+		msg *ttnpb.ApplicationUplink,
+	) error {
+		return h.decodeUplink(ctx, msg, run)
+	}, nil
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the given module.
+func (h *host) DecodeUplink(
+	ctx context.Context,
+	_ *ttnpb.EndDeviceIdentifiers,
+	_ *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationUplink,
+	parameter string,
+) error {
+	return h.decodeUplink(ctx, msg, h.run(parameter))
+}
+
+func appendValidationErrors(dst []string, measurements []normalizedpayload.ParsedMeasurement) []string {
+	for i, m := range measurements {
+		for _, err := range m.ValidationErrors {
+			var (
+				errString string
+				ttnErr    *errors.Error
+			)
+			if errors.As(err, &ttnErr) {
+				errString = ttnErr.FormatMessage(ttnErr.PublicAttributes())
+			} else {
+				errString = err.Error()
+			}
+			dst = append(dst, fmt.Sprintf("measurement %d: %s", i+1, errString))
+		}
+	}
+	return dst
+}
+
+// validNormalizedPayload returns the measurements that contain valid fields.
+func validNormalizedPayload(measurements []normalizedpayload.ParsedMeasurement) []*pbtypes.Struct {
+	res := make([]*pbtypes.Struct, 0, len(measurements))
+	for _, measurement := range measurements {
+		if len(measurement.Valid.GetFields()) == 0 {
+			continue
+		}
+		res = append(res, measurement.Valid)
+	}
+	return res
+}
+
+func (*host) decodeUplink(ctx context.Context, msg *ttnpb.ApplicationUplink, run runFunc) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	valueAs, err := run(ctx, "decodeUplink", decodeInput{
+		Bytes: msg.FrmPayload,
+		FPort: uint8(msg.FPort),
+	})
+	if err != nil {
+		return err
+	}
+	var decoded decodeOutput
+	if err := valueAs(&decoded); err != nil {
+		return errOutput.WithCause(err)
+	}
+	if errs := decoded.Errors; len(errs) > 0 {
+		return errOutputErrors.WithAttributes("errors", strings.Join(errs, ", "))
+	}
+	decodedPayload, err := gogoproto.Struct(decoded.Data)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload, msg.DecodedPayloadWarnings = decodedPayload, decoded.Warnings
+	msg.NormalizedPayload, msg.NormalizedPayloadWarnings = nil, nil
+
+	var normalized *normalizeUplinkOutput
+	if decoded.Data != nil {
+		valueAs, err := run(ctx, "normalizeUplink", normalizeUplinkInput{
+			Data: decoded.Data,
+		})
+		switch {
+		case errors.IsNotFound(err):
+			// The module does not export a normalizer.
+		case err != nil:
+			return err
+		default:
+			normalized = &normalizeUplinkOutput{}
+			if err := valueAs(normalized); err != nil {
+				return errOutput.WithCause(err)
+			}
+		}
+	}
+
+	if normalized != nil {
+		if errs := normalized.Errors; len(errs) > 0 {
+			return errOutputErrors.WithAttributes("errors", strings.Join(errs, ", "))
+		}
+		if normalized.Data == nil {
+			return nil
+		}
+		// The returned data can be an array of measurements or a single measurement object.
+		var measurements []map[string]interface{}
+		if val := reflect.ValueOf(normalized.Data); val.Kind() == reflect.Slice {
+			measurements = make([]map[string]interface{}, val.Len())
+			for i := 0; i < val.Len(); i++ {
+				measurement, ok := val.Index(i).Interface().(map[string]interface{})
+				if !ok {
+					return errOutput.New()
+				}
+				measurements[i] = measurement
+			}
+		} else {
+			measurement, ok := normalized.Data.(map[string]interface{})
+			if !ok {
+				return errOutput.New()
+			}
+			measurements = []map[string]interface{}{measurement}
+		}
+		normalizedPayload := make([]*pbtypes.Struct, len(measurements))
+		for i := range measurements {
+			pb, err := gogoproto.Struct(measurements[i])
+			if err != nil {
+				return errOutput.WithCause(err)
+			}
+			normalizedPayload[i] = pb
+		}
+		// Validate the normalized payload.
+		normalizedMeasurements, err := normalizedpayload.Parse(normalizedPayload)
+		if err != nil {
+			return errOutput.WithCause(err)
+		}
+		msg.NormalizedPayload = validNormalizedPayload(normalizedMeasurements)
+		msg.NormalizedPayloadWarnings = make([]string, 0, len(normalized.Warnings))
+		msg.NormalizedPayloadWarnings = append(msg.NormalizedPayloadWarnings, normalized.Warnings...)
+		msg.NormalizedPayloadWarnings = appendValidationErrors(msg.NormalizedPayloadWarnings, normalizedMeasurements)
+	} else {
+		// If the normalizer is not exported, the decoder may return already normalized payload.
+		// This is a best effort attempt to parse the decoded payload as normalized payload.
+		// If that does not return an error, the decoded payload is assumed to be normalized.
+		normalizedMeasurements, err := normalizedpayload.Parse([]*pbtypes.Struct{decodedPayload})
+		if err == nil {
+			msg.NormalizedPayload = validNormalizedPayload(normalizedMeasurements)
+			msg.NormalizedPayloadWarnings = appendValidationErrors(msg.NormalizedPayloadWarnings, normalizedMeasurements)
+		}
+	}
+	return nil
+}
+
+// CompileDownlinkDecoder generates a downlink decoder from the provided module.
+func (h *host) CompileDownlinkDecoder(
+	ctx context.Context, parameter string,
+) (
+	func(
+		context.Context,
+		*ttnpb.EndDeviceIdentifiers,
+		*ttnpb.EndDeviceVersionIdentifiers,
+		*ttnpb.ApplicationDownlink,
+	) error,
+	error,
+) {
+	defer trace.StartRegion(ctx, "compile downlink decoder").End()
+
+	run, err := h.compile(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		version *ttnpb.EndDeviceVersionIdentifiers,
+		msg *ttnpb.ApplicationDownlink,
+	) error {
+		return h.decodeDownlink(ctx, msg, run)
+	}, nil
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the given module.
+func (h *host) DecodeDownlink(
+	ctx context.Context,
+	_ *ttnpb.EndDeviceIdentifiers,
+	_ *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	return h.decodeDownlink(ctx, msg, h.run(parameter))
+}
+
+func (*host) decodeDownlink(ctx context.Context, msg *ttnpb.ApplicationDownlink, run runFunc) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	valueAs, err := run(ctx, "decodeDownlink", decodeInput{
+		Bytes: msg.FrmPayload,
+		FPort: uint8(msg.FPort),
+	})
+	if err != nil {
+		return err
+	}
+
+	var output decodeOutput
+	err = valueAs(&output)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	if len(output.Errors) > 0 {
+		return errOutputErrors.WithAttributes("errors", strings.Join(output.Errors, ", "))
+	}
+
+	s, err := gogoproto.Struct(output.Data)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload = s
+	msg.DecodedPayloadWarnings = output.Warnings
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm_test
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/wasm"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func readModule(t *testing.T, name string) string {
+	t.Helper()
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("Failed to read module: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+var (
+	ids = &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{
+			ApplicationId: "foo-app",
+		},
+		DeviceId: "foo-device",
+	}
+	version = &ttnpb.EndDeviceVersionIdentifiers{}
+)
+
+func TestDecodeUplink(t *testing.T) {
+	t.Parallel()
+
+	host := wasm.New()
+	module := readModule(t, "testdata/codec.wasm")
+	compiled, err := host.CompileUplinkDecoder(test.Context(), module)
+	if err != nil {
+		t.Fatalf("Failed to compile module: %v", err)
+	}
+
+	for _, tc := range []struct {
+		Name   string
+		Decode func(context.Context, *ttnpb.ApplicationUplink) error
+	}{
+		{
+			Name: "Run",
+			Decode: func(ctx context.Context, msg *ttnpb.ApplicationUplink) error {
+				return host.DecodeUplink(ctx, ids, version, msg, module)
+			},
+		},
+		{
+			Name: "Compiled",
+			Decode: func(ctx context.Context, msg *ttnpb.ApplicationUplink) error {
+				return compiled(ctx, ids, version, msg)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+
+			msg := &ttnpb.ApplicationUplink{
+				FPort:      42,
+				FrmPayload: []byte{0x01, 0x02, 0xff},
+			}
+			if !a.So(tc.Decode(ctx, msg), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"bytes": {
+						Kind: &pbtypes.Value_ListValue{
+							ListValue: &pbtypes.ListValue{
+								Values: []*pbtypes.Value{
+									{Kind: &pbtypes.Value_NumberValue{NumberValue: 1}},
+									{Kind: &pbtypes.Value_NumberValue{NumberValue: 2}},
+									{Kind: &pbtypes.Value_NumberValue{NumberValue: 255}},
+								},
+							},
+						},
+					},
+					"fPort": {Kind: &pbtypes.Value_NumberValue{NumberValue: 42}},
+				},
+			})
+			a.So(msg.NormalizedPayload, should.Resemble, []*pbtypes.Struct{
+				{
+					Fields: map[string]*pbtypes.Value{
+						"air": {
+							Kind: &pbtypes.Value_StructValue{
+								StructValue: &pbtypes.Struct{
+									Fields: map[string]*pbtypes.Value{
+										"temperature": {Kind: &pbtypes.Value_NumberValue{NumberValue: 21.5}},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+			a.So(msg.NormalizedPayloadWarnings, should.Resemble, []string{"estimated"})
+		})
+	}
+}
+
+func TestEncodeDownlink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	host := wasm.New()
+	msg := &ttnpb.ApplicationDownlink{
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"state": {Kind: &pbtypes.Value_StringValue{StringValue: "on"}},
+			},
+		},
+	}
+	if a.So(host.EncodeDownlink(ctx, ids, version, msg, readModule(t, "testdata/codec.wasm")), should.BeNil) {
+		a.So(msg.FrmPayload, should.Resemble, []byte{1, 2, 3})
+		a.So(msg.FPort, should.Equal, 2)
+		a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"truncated"})
+	}
+}
+
+func TestDecodeDownlink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	host := wasm.New()
+	msg := &ttnpb.ApplicationDownlink{
+		FPort:      2,
+		FrmPayload: []byte{1, 2, 3},
+	}
+	if a.So(host.DecodeDownlink(ctx, ids, version, msg, readModule(t, "testdata/codec.wasm")), should.BeNil) {
+		a.So(msg.DecodedPayload, should.Resemble, &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"state": {Kind: &pbtypes.Value_StringValue{StringValue: "on"}},
+			},
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	host := wasm.New()
+	module := readModule(t, "testdata/errors.wasm")
+
+	err := host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FPort: 1}, module)
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	err = host.EncodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{
+		DecodedPayload: &pbtypes.Struct{},
+	}, module)
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	err = host.DecodeDownlink(ctx, ids, version, &ttnpb.ApplicationDownlink{FPort: 1}, module)
+	a.So(errors.IsAborted(err), should.BeTrue)
+
+	err = host.DecodeUplink(ctx, ids, version, &ttnpb.ApplicationUplink{FPort: 1}, "not base64")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = host.CompileUplinkDecoder(ctx, base64.StdEncoding.EncodeToString([]byte("not a module")))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
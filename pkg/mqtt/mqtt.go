@@ -29,6 +29,7 @@ import (
 )
 
 // RunListener runs the MQTT accept connection loop.
+// The protocol version is negotiated with the CONNECT packet: MQTT 3.1, 3.1.1 and v5 clients are supported.
 func RunListener(
 	ctx context.Context,
 	lis mqttnet.Listener,
@@ -38,6 +39,7 @@ func RunListener(
 	setupConnection func(context.Context, mqttnet.Conn) error,
 ) error {
 	ctx = mqttlog.NewContext(ctx, Logger(log.FromContext(ctx)))
+	sessions := newSessionStore()
 	for {
 		mqttConn, err := lis.Accept()
 		if err != nil {
@@ -63,6 +65,12 @@ func RunListener(
 					mqttConn.Close()
 				}
 			}()
+			conn, level, err := negotiate(ctx, mqttConn, sessions)
+			if err != nil {
+				return err
+			}
+			mqttConn = conn
+			ctx = log.NewContextWithField(ctx, "protocol_level", level)
 			return setupConnection(ctx, mqttConn)
 		}
 		ts.StartTask(&task.Config{
@@ -125,6 +133,11 @@ func RunSession(
 		<-ctx.Done()
 		log.FromContext(ctx).WithError(ctx.Err()).Info("Disconnected")
 
+		if code, ok := disconnectReasonCode(ctx.Err()); ok {
+			if conn, ok := mqttConn.(*v5Conn); ok {
+				conn.disconnect(code)
+			}
+		}
 		session.Close()
 		mqttConn.Close()
 
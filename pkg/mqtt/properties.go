@@ -0,0 +1,65 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"time"
+
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/TheThingsIndustries/mystique/pkg/session"
+)
+
+// correlationIDProperty is the user property that carries a correlation ID.
+const correlationIDProperty = "correlation_id"
+
+// PublishProperties are the MQTT v5 properties of a PUBLISH packet.
+type PublishProperties struct {
+	// MessageExpiry is the lifetime of the message. A message that is not sent to the client
+	// before it expires is dropped. Zero means that the message does not expire.
+	MessageExpiry time.Duration
+	// CorrelationIDs are the correlation IDs of the message.
+	// They are carried as correlation_id user properties.
+	CorrelationIDs []string
+}
+
+// SetPublishProperties sets the properties of the PUBLISH packet that is published to the session of the connection.
+// The properties are only sent to MQTT v5 clients; this is a no-op for MQTT 3.1.1 clients.
+// This must be called before the packet is published to the session.
+func SetPublishProperties(conn mqttnet.Conn, pkt *packet.PublishPacket, props PublishProperties) {
+	if c, ok := conn.(*v5Conn); ok {
+		c.setOutgoingProperties(pkt, props)
+	}
+}
+
+// ReceivedPublishProperties returns the properties of the PUBLISH packet that has been received from the connection.
+// This returns the zero value for MQTT 3.1.1 clients.
+// This must be called from the deliver function of the session.
+func ReceivedPublishProperties(conn mqttnet.Conn, pkt *packet.PublishPacket) PublishProperties {
+	if c, ok := conn.(*v5Conn); ok {
+		return c.incomingProperties(pkt)
+	}
+	return PublishProperties{}
+}
+
+// NewSession returns a new MQTT session on the given connection.
+// The deliver function is called for each PUBLISH packet that the client is allowed to publish.
+func NewSession(ctx context.Context, conn mqttnet.Conn, deliver func(*packet.PublishPacket)) session.Session {
+	if c, ok := conn.(*v5Conn); ok {
+		deliver = c.wrapDeliver(deliver)
+	}
+	return session.New(ctx, conn, deliver)
+}
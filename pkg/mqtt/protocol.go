@@ -0,0 +1,240 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"time"
+
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// Protocol levels of MQTT 3.1.1 and MQTT v5. MQTT 3.1 uses protocol level 3.
+const (
+	protocolLevel311 byte = 4
+	protocolLevel5   byte = 5
+)
+
+// connectTimeout is the time in which the client must send the CONNECT packet.
+const connectTimeout = 10 * time.Second
+
+// Extended CONNACK return codes. These are sent as-is to MQTT v5 clients.
+// MQTT 3.1.1 clients receive the closest return code that MQTT 3.1.1 defines.
+const (
+	connectBadUsernameOrPassword packet.ConnectReturnCode = 0x86
+	connectNotAuthorized         packet.ConnectReturnCode = 0x87
+	connectQuotaExceeded         packet.ConnectReturnCode = 0x97
+)
+
+// ConnectError converts the given error to the CONNACK return code that is sent to the client.
+// Errors that do not map to a return code are returned as-is, in which case the client is not authorized.
+func ConnectError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.IsPermissionDenied(err):
+		return connectNotAuthorized
+	case errors.IsUnauthenticated(err):
+		return connectBadUsernameOrPassword
+	case errors.IsResourceExhausted(err):
+		return connectQuotaExceeded
+	}
+	return err
+}
+
+var (
+	errNotConnect              = errors.DefineInvalidArgument("not_connect", "first packet is not a CONNECT")
+	errMalformedPacket         = errors.DefineInvalidArgument("malformed_packet", "malformed packet")
+	errPacketTooLarge          = errors.DefineInvalidArgument("packet_too_large", "packet of `{size}` bytes exceeds the maximum of `{max}` bytes")
+	errUnsupportedPacket       = errors.DefineInvalidArgument("unsupported_packet", "unsupported packet type `{type}`")
+	errInvalidTopicAlias       = errors.DefineInvalidArgument("invalid_topic_alias", "invalid topic alias `{alias}`")
+	errAuthenticationMethod    = errors.DefineUnimplemented("authentication_method", "authentication method `{method}` not supported")
+	errSessionExpiryNotAllowed = errors.DefineInvalidArgument("session_expiry_not_allowed", "session expiry can not be set on disconnect")
+)
+
+// maxPacketSize is the maximum size of a control packet that is read from the client.
+const maxPacketSize = 1 << 20
+
+// rawPacket is a control packet that has been read from the connection but not yet decoded.
+type rawPacket struct {
+	header    byte
+	remaining []byte
+}
+
+func (p rawPacket) packetType() byte { return p.header >> 4 }
+
+// bytes returns the packet as it was read from the wire.
+func (p rawPacket) bytes() []byte {
+	buf := make([]byte, 0, 1+binary.MaxVarintLen32+len(p.remaining))
+	buf = append(buf, p.header)
+	n := len(p.remaining)
+	for {
+		b := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			b |= 0x80
+		}
+		buf = append(buf, b)
+		if n == 0 {
+			break
+		}
+	}
+	return append(buf, p.remaining...)
+}
+
+func readRawPacket(r io.Reader) (rawPacket, error) {
+	var (
+		b   [1]byte
+		pkt rawPacket
+	)
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return pkt, err
+	}
+	pkt.header = b[0]
+	var length, multiplier int = 0, 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return pkt, errMalformedPacket.New()
+		}
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return pkt, err
+		}
+		length += int(b[0]&0x7f) * multiplier
+		multiplier *= 128
+		if b[0]&0x80 == 0 {
+			break
+		}
+	}
+	if length > maxPacketSize {
+		return pkt, errPacketTooLarge.WithAttributes("size", length, "max", maxPacketSize)
+	}
+	pkt.remaining = make([]byte, length)
+	if _, err := io.ReadFull(r, pkt.remaining); err != nil {
+		return pkt, err
+	}
+	return pkt, nil
+}
+
+// protocolLevel returns the protocol level of the CONNECT packet.
+func (p rawPacket) protocolLevel() (byte, error) {
+	if p.packetType() != packet.CONNECT {
+		return 0, errNotConnect.New()
+	}
+	if len(p.remaining) < 2 {
+		return 0, errMalformedPacket.New()
+	}
+	nameLength := int(binary.BigEndian.Uint16(p.remaining))
+	if len(p.remaining) < 2+nameLength+1 {
+		return 0, errMalformedPacket.New()
+	}
+	return p.remaining[2+nameLength], nil
+}
+
+// readWriter returns the reader and writer of the connection.
+// The mystique connection implements io.ReadWriter in order to count the bytes that are read and written.
+func readWriter(conn mqttnet.Conn) io.ReadWriter {
+	if rw, ok := conn.(io.ReadWriter); ok {
+		return rw
+	}
+	return conn.NetConn()
+}
+
+// negotiate reads the CONNECT packet from the connection and returns the connection that speaks
+// the protocol version that the client requested.
+func negotiate(ctx context.Context, conn mqttnet.Conn, sessions *sessionStore) (mqttnet.Conn, byte, error) {
+	conn.SetReadTimeout(connectTimeout)
+	connect, err := readRawPacket(readWriter(conn))
+	if err != nil {
+		return nil, 0, err
+	}
+	level, err := connect.protocolLevel()
+	if err != nil {
+		return nil, 0, err
+	}
+	if level == protocolLevel5 {
+		return newV5Conn(ctx, conn, connect, sessions), level, nil
+	}
+	return &v311Conn{Conn: conn, connect: connect.bytes()}, level, nil
+}
+
+// v311Conn is a MQTT 3.1 and 3.1.1 connection.
+// It replays the CONNECT packet that has been read during protocol negotiation.
+type v311Conn struct {
+	mqttnet.Conn
+	connect []byte
+}
+
+// Receive implements mqttnet.Conn.
+func (c *v311Conn) Receive() (packet.ControlPacket, error) {
+	if c.connect != nil {
+		connect := c.connect
+		c.connect = nil
+		return packet.Read(bytes.NewReader(connect))
+	}
+	return c.Conn.Receive()
+}
+
+// Send implements mqttnet.Conn.
+func (c *v311Conn) Send(pkt packet.ControlPacket) error {
+	if connack, ok := pkt.(*packet.ConnackPacket); ok {
+		switch connack.ReturnCode {
+		case connectBadUsernameOrPassword, connectNotAuthorized:
+			connack.ReturnCode = packet.ConnectNotAuthorized
+		case connectQuotaExceeded:
+			connack.ReturnCode = packet.ConnectServerUnavailable
+		}
+	}
+	return c.Conn.Send(pkt)
+}
+
+// Disconnect reason codes that are sent to MQTT v5 clients when the server closes the connection.
+const (
+	disconnectNotAuthorized       byte = 0x87
+	disconnectServerShuttingDown  byte = 0x8b
+	disconnectSessionTakenOver    byte = 0x8e
+	disconnectTopicAliasInvalid   byte = 0x94
+	disconnectMessageRateTooHigh  byte = 0x96
+	disconnectProtocolError       byte = 0x82
+	disconnectImplementationError byte = 0x83
+)
+
+// disconnectReasonCode returns the DISCONNECT reason code for the error that closed the connection.
+// The second return value is false if the client closed the connection.
+func disconnectReasonCode(err error) (byte, bool) {
+	switch {
+	case err == nil, err == io.EOF:
+		return 0, false
+	case errors.IsResourceExhausted(err):
+		return disconnectMessageRateTooHigh, true
+	case errors.IsPermissionDenied(err), errors.IsUnauthenticated(err):
+		return disconnectNotAuthorized, true
+	case errors.IsAborted(err):
+		return disconnectSessionTakenOver, true
+	case errors.IsCanceled(err):
+		return disconnectServerShuttingDown, true
+	case errors.Resemble(err, errInvalidTopicAlias):
+		return disconnectTopicAliasInvalid, true
+	case errors.IsInvalidArgument(err):
+		return disconnectProtocolError, true
+	case errors.IsUnimplemented(err):
+		return disconnectImplementationError, true
+	}
+	return 0, false
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"sync"
+	"time"
+)
+
+// maxSessionExpiry is the maximum session expiry interval that is granted to MQTT v5 clients.
+const maxSessionExpiry = 24 * time.Hour
+
+type sessionKey struct {
+	username string
+	clientID string
+}
+
+type storedSession struct {
+	subscriptions map[string]byte
+	expiresAt     time.Time
+}
+
+// sessionStore stores the state of MQTT v5 sessions that outlive their connection.
+// Only the subscriptions of a session are retained; messages that are published while the
+// client is disconnected are not queued.
+type sessionStore struct {
+	mu       sync.Mutex
+	sessions map[sessionKey]storedSession
+}
+
+func newSessionStore() *sessionStore {
+	return &sessionStore{
+		sessions: make(map[sessionKey]storedSession),
+	}
+}
+
+// put stores the subscriptions of the session until the given expiry interval elapses.
+func (s *sessionStore) put(key sessionKey, subscriptions map[string]byte, expiry time.Duration) {
+	now := time.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for k, v := range s.sessions {
+		if now.After(v.expiresAt) {
+			delete(s.sessions, k)
+		}
+	}
+	s.sessions[key] = storedSession{
+		subscriptions: subscriptions,
+		expiresAt:     now.Add(expiry),
+	}
+}
+
+// take removes the session from the store and returns its subscriptions.
+// The second return value is false if there is no session or if the session expired.
+func (s *sessionStore) take(key sessionKey) (map[string]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[key]
+	if !ok {
+		return nil, false
+	}
+	delete(s.sessions, key)
+	if time.Now().After(session.expiresAt) {
+		return nil, false
+	}
+	return session.subscriptions, true
+}
+
+// remove removes the session from the store.
+func (s *sessionStore) remove(key sessionKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, key)
+}
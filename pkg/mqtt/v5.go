@@ -0,0 +1,708 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/bluele/gcache"
+	"github.com/eclipse/paho.golang/packets"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+const (
+	// serverTopicAliasMaximum is the number of topic aliases that MQTT v5 clients can use when publishing.
+	serverTopicAliasMaximum = 64
+	// clientTopicAliasMaximum is the maximum number of topic aliases that are assigned when publishing to
+	// MQTT v5 clients. The client may accept fewer topic aliases.
+	clientTopicAliasMaximum = 64
+	// outgoingPropertiesSize is the number of outgoing PUBLISH packets for which the properties are retained.
+	outgoingPropertiesSize = 256
+	// disconnectTimeout is the time in which the DISCONNECT packet must be written.
+	disconnectTimeout = time.Second
+)
+
+// Reason codes that are sent to MQTT v5 clients.
+const (
+	reasonSuccess                 byte = 0x00
+	reasonDisconnectWithWill      byte = 0x04
+	reasonNoSubscriptionExisted   byte = 0x11
+	reasonUnsupportedProtocol     byte = 0x84
+	reasonClientIdentifierInvalid byte = 0x85
+	reasonBadUsernameOrPassword   byte = 0x86
+	reasonNotAuthorized           byte = 0x87
+	reasonServerUnavailable       byte = 0x88
+	reasonBadAuthenticationMethod byte = 0x8c
+)
+
+// restoreSubscriptionsIdentifier is the packet identifier of the SUBSCRIBE packet that restores a session.
+const restoreSubscriptionsIdentifier = 1
+
+// v5ConnectReasonCode returns the CONNACK reason code for the given return code.
+func v5ConnectReasonCode(code packet.ConnectReturnCode) byte {
+	switch code {
+	case packet.ConnectAccepted:
+		return reasonSuccess
+	case packet.ConnectUnacceptableProtocolVersion:
+		return reasonUnsupportedProtocol
+	case packet.ConnectIdentifierRejected:
+		return reasonClientIdentifierInvalid
+	case packet.ConnectServerUnavailable:
+		return reasonServerUnavailable
+	case packet.ConnectMalformedUsernameOrPassword:
+		return reasonBadUsernameOrPassword
+	case packet.ConnectNotAuthorized:
+		return reasonNotAuthorized
+	}
+	return byte(code)
+}
+
+type outgoingProperties struct {
+	PublishProperties
+	setAt time.Time
+}
+
+type pendingSubscription struct {
+	packetIdentifier uint16
+	topics           []string
+	restore          bool
+}
+
+// v5Conn is a MQTT v5 connection.
+// It converts the MQTT v5 control packets to and from the MQTT 3.1.1 control packets that the session handles,
+// and implements the MQTT v5 features that have no MQTT 3.1.1 equivalent.
+type v5Conn struct {
+	mqttnet.Conn
+	ctx      context.Context
+	rw       io.ReadWriter
+	sessions *sessionStore
+	timeout  time.Duration
+
+	// connect is the CONNECT packet that has been read during protocol negotiation.
+	connect *rawPacket
+	// disconnected is true when the client sent a DISCONNECT packet.
+	disconnected bool
+	// inTopicAliases are the topic aliases that the client assigned.
+	inTopicAliases map[uint16]string
+	// outgoing contains the properties of the outgoing PUBLISH packets, by the address of their first message byte.
+	outgoing gcache.Cache
+
+	writeMu sync.Mutex
+
+	mu                  sync.Mutex
+	key                 sessionKey
+	cleanStart          bool
+	sessionExpiry       time.Duration
+	assignedClientID    string
+	topicAliasMaximum   uint16
+	connected           bool
+	injected            []packet.ControlPacket
+	inPublish           *packet.PublishPacket
+	inProperties        PublishProperties
+	undelivered         map[uint16]struct{}
+	outTopicAliases     map[string]uint16
+	pendingSubscribes   []pendingSubscription
+	pendingUnsubscribes []pendingSubscription
+	subscriptions       map[string]byte
+}
+
+func newV5Conn(ctx context.Context, conn mqttnet.Conn, connect rawPacket, sessions *sessionStore) *v5Conn {
+	return &v5Conn{
+		Conn:            conn,
+		ctx:             ctx,
+		rw:              readWriter(conn),
+		sessions:        sessions,
+		connect:         &connect,
+		inTopicAliases:  make(map[uint16]string),
+		outgoing:        gcache.New(outgoingPropertiesSize).LRU().Build(),
+		undelivered:     make(map[uint16]struct{}),
+		outTopicAliases: make(map[string]uint16),
+		subscriptions:   make(map[string]byte),
+	}
+}
+
+// SetReadTimeout implements mqttnet.Conn.
+func (c *v5Conn) SetReadTimeout(d time.Duration) {
+	c.timeout = d
+	c.Conn.SetReadTimeout(d)
+}
+
+// Receive implements mqttnet.Conn.
+func (c *v5Conn) Receive() (packet.ControlPacket, error) {
+	c.mu.Lock()
+	if len(c.injected) > 0 {
+		pkt := c.injected[0]
+		c.injected = c.injected[1:]
+		c.mu.Unlock()
+		return pkt, nil
+	}
+	c.mu.Unlock()
+
+	if c.disconnected {
+		// The client must not send packets after the DISCONNECT packet.
+		return nil, io.EOF
+	}
+	var raw rawPacket
+	if c.connect != nil {
+		raw, c.connect = *c.connect, nil
+	} else {
+		var err error
+		if raw, err = readRawPacket(c.rw); err != nil {
+			return nil, err
+		}
+		// Setting the read timeout extends the read deadline.
+		c.Conn.SetReadTimeout(c.timeout)
+	}
+
+	switch raw.packetType() {
+	case packet.CONNECT:
+		return c.receiveConnect(raw)
+	case packet.PUBLISH:
+		return c.receivePublish(raw)
+	case packet.PUBACK, packet.PUBREC, packet.PUBREL, packet.PUBCOMP:
+		if len(raw.remaining) < 2 {
+			return nil, errMalformedPacket.New()
+		}
+		id := binary.BigEndian.Uint16(raw.remaining)
+		switch raw.packetType() {
+		case packet.PUBACK:
+			return &packet.PubackPacket{PacketIdentifier: id}, nil
+		case packet.PUBREC:
+			return &packet.PubrecPacket{PacketIdentifier: id}, nil
+		case packet.PUBREL:
+			return &packet.PubrelPacket{PacketIdentifier: id}, nil
+		default:
+			return &packet.PubcompPacket{PacketIdentifier: id}, nil
+		}
+	case packet.SUBSCRIBE:
+		return c.receiveSubscribe(raw)
+	case packet.UNSUBSCRIBE:
+		return c.receiveUnsubscribe(raw)
+	case packet.PINGREQ:
+		return &packet.PingreqPacket{}, nil
+	case packet.DISCONNECT:
+		return c.receiveDisconnect(raw)
+	}
+	return nil, errUnsupportedPacket.WithAttributes("type", raw.packetType())
+}
+
+func decode(raw rawPacket) (interface{}, error) {
+	cp, err := packets.ReadPacket(bytes.NewReader(raw.bytes()))
+	if err != nil {
+		return nil, errMalformedPacket.WithCause(err)
+	}
+	return cp.Content, nil
+}
+
+func (c *v5Conn) receiveConnect(raw rawPacket) (packet.ControlPacket, error) {
+	content, err := decode(raw)
+	if err != nil {
+		return nil, err
+	}
+	connect := content.(*packets.Connect)
+	if method := connect.Properties.AuthMethod; method != "" {
+		c.write(&packets.Connack{
+			ReasonCode: reasonBadAuthenticationMethod,
+			Properties: &packets.Properties{},
+		})
+		return nil, errAuthenticationMethod.WithAttributes("method", method)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clientID := connect.ClientID
+	if clientID == "" {
+		clientID = fmt.Sprintf("%s-%d", c.RemoteAddr().String(), time.Now().UnixNano())
+		c.assignedClientID = clientID
+	}
+	c.key = sessionKey{username: connect.Username, clientID: clientID}
+	c.cleanStart = connect.CleanStart
+	if expiry := connect.Properties.SessionExpiryInterval; expiry != nil {
+		c.sessionExpiry = capSessionExpiry(*expiry)
+	}
+	if max := connect.Properties.TopicAliasMaximum; max != nil {
+		c.topicAliasMaximum = *max
+		if c.topicAliasMaximum > clientTopicAliasMaximum {
+			c.topicAliasMaximum = clientTopicAliasMaximum
+		}
+	}
+	return &packet.ConnectPacket{
+		ProtocolName:  "MQTT",
+		ProtocolLevel: protocolLevel311,
+		CleanStart:    connect.CleanStart,
+		Will:          connect.WillFlag,
+		WillQoS:       connect.WillQOS,
+		WillRetain:    connect.WillRetain,
+		KeepAlive:     connect.KeepAlive,
+		ClientID:      clientID,
+		WillTopic:     connect.WillTopic,
+		WillMessage:   connect.WillMessage,
+		Username:      connect.Username,
+		Password:      connect.Password,
+	}, nil
+}
+
+func capSessionExpiry(seconds uint32) time.Duration {
+	if expiry := time.Duration(seconds) * time.Second; expiry < maxSessionExpiry {
+		return expiry
+	}
+	return maxSessionExpiry
+}
+
+func (c *v5Conn) receivePublish(raw rawPacket) (packet.ControlPacket, error) {
+	content, err := decode(raw)
+	if err != nil {
+		return nil, err
+	}
+	publish := content.(*packets.Publish)
+	if alias := publish.Properties.TopicAlias; alias != nil {
+		if *alias == 0 || *alias > serverTopicAliasMaximum {
+			return nil, errInvalidTopicAlias.WithAttributes("alias", *alias)
+		}
+		if publish.Topic != "" {
+			c.inTopicAliases[*alias] = publish.Topic
+		} else if topic, ok := c.inTopicAliases[*alias]; ok {
+			publish.Topic = topic
+		} else {
+			return nil, errInvalidTopicAlias.WithAttributes("alias", *alias)
+		}
+	}
+	pkt := &packet.PublishPacket{
+		Retain:           publish.Retain,
+		QoS:              publish.QoS,
+		Duplicate:        publish.Duplicate,
+		PacketIdentifier: publish.PacketID,
+		TopicName:        publish.Topic,
+		Message:          publish.Payload,
+	}
+	var props PublishProperties
+	if expiry := publish.Properties.MessageExpiry; expiry != nil {
+		props.MessageExpiry = time.Duration(*expiry) * time.Second
+	}
+	for _, user := range publish.Properties.User {
+		if user.Key == correlationIDProperty {
+			props.CorrelationIDs = append(props.CorrelationIDs, user.Value)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inPublish, c.inProperties = pkt, props
+	// A retransmitted QoS 2 message has already been delivered.
+	if pkt.QoS > 0 && !(pkt.QoS == 2 && pkt.Duplicate) {
+		c.undelivered[pkt.PacketIdentifier] = struct{}{}
+	}
+	return pkt, nil
+}
+
+func (c *v5Conn) receiveSubscribe(raw rawPacket) (packet.ControlPacket, error) {
+	// The subscriptions are decoded here as the order of the topic filters determines the order of the reason codes.
+	b := raw.remaining
+	if len(b) < 2 {
+		return nil, errMalformedPacket.New()
+	}
+	pkt := &packet.SubscribePacket{
+		PacketIdentifier: binary.BigEndian.Uint16(b),
+	}
+	b = b[2:]
+	propertiesLength, n, err := readVariableByteInteger(b)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < n+propertiesLength {
+		return nil, errMalformedPacket.New()
+	}
+	b = b[n+propertiesLength:]
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return nil, errMalformedPacket.New()
+		}
+		topicLength := int(binary.BigEndian.Uint16(b))
+		if len(b) < 2+topicLength+1 {
+			return nil, errMalformedPacket.New()
+		}
+		pkt.Topics = append(pkt.Topics, string(b[2:2+topicLength]))
+		pkt.QoSs = append(pkt.QoSs, b[2+topicLength]&0x03)
+		b = b[2+topicLength+1:]
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pendingSubscribes = append(c.pendingSubscribes, pendingSubscription{
+		packetIdentifier: pkt.PacketIdentifier,
+		topics:           pkt.Topics,
+	})
+	return pkt, nil
+}
+
+func readVariableByteInteger(b []byte) (value, n int, err error) {
+	multiplier := 1
+	for n < len(b) && n < 4 {
+		value += int(b[n]&0x7f) * multiplier
+		multiplier *= 128
+		n++
+		if b[n-1]&0x80 == 0 {
+			return value, n, nil
+		}
+	}
+	return 0, 0, errMalformedPacket.New()
+}
+
+func (c *v5Conn) receiveUnsubscribe(raw rawPacket) (packet.ControlPacket, error) {
+	content, err := decode(raw)
+	if err != nil {
+		return nil, err
+	}
+	unsubscribe := content.(*packets.Unsubscribe)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pendingUnsubscribes = append(c.pendingUnsubscribes, pendingSubscription{
+		packetIdentifier: unsubscribe.PacketID,
+		topics:           unsubscribe.Topics,
+	})
+	return &packet.UnsubscribePacket{
+		PacketIdentifier: unsubscribe.PacketID,
+		Topics:           unsubscribe.Topics,
+	}, nil
+}
+
+func (c *v5Conn) receiveDisconnect(raw rawPacket) (packet.ControlPacket, error) {
+	c.disconnected = true
+	disconnect := &packets.Disconnect{Properties: &packets.Properties{}}
+	switch len(raw.remaining) {
+	case 0:
+	case 1:
+		disconnect.ReasonCode = raw.remaining[0]
+	default:
+		content, err := decode(raw)
+		if err != nil {
+			return nil, err
+		}
+		disconnect = content.(*packets.Disconnect)
+	}
+	if expiry := disconnect.Properties.SessionExpiryInterval; expiry != nil {
+		c.mu.Lock()
+		if c.sessionExpiry == 0 && *expiry > 0 {
+			c.mu.Unlock()
+			return nil, errSessionExpiryNotAllowed.New()
+		}
+		c.sessionExpiry = capSessionExpiry(*expiry)
+		c.mu.Unlock()
+	}
+	if disconnect.ReasonCode == reasonDisconnectWithWill {
+		// Closing the connection without handling the DISCONNECT packet publishes the will.
+		return nil, io.EOF
+	}
+	return &packet.DisconnectPacket{}, nil
+}
+
+// Send implements mqttnet.Conn.
+func (c *v5Conn) Send(pkt packet.ControlPacket) error {
+	var out interface {
+		WriteTo(io.Writer) (int64, error)
+	}
+	switch pkt := pkt.(type) {
+	case *packet.ConnackPacket:
+		out = c.sendConnack(pkt)
+	case *packet.PublishPacket:
+		publish, ok := c.sendPublish(pkt)
+		if !ok {
+			return nil
+		}
+		out = publish
+	case *packet.PubackPacket:
+		out = &packets.Puback{
+			PacketID:   pkt.PacketIdentifier,
+			ReasonCode: c.publishReasonCode(pkt.PacketIdentifier),
+			Properties: &packets.Properties{},
+		}
+	case *packet.PubrecPacket:
+		out = &packets.Pubrec{
+			PacketID:   pkt.PacketIdentifier,
+			ReasonCode: c.publishReasonCode(pkt.PacketIdentifier),
+			Properties: &packets.Properties{},
+		}
+	case *packet.PubrelPacket:
+		out = &packets.Pubrel{
+			PacketID:   pkt.PacketIdentifier,
+			Properties: &packets.Properties{},
+		}
+	case *packet.PubcompPacket:
+		out = &packets.Pubcomp{
+			PacketID:   pkt.PacketIdentifier,
+			Properties: &packets.Properties{},
+		}
+	case *packet.SubackPacket:
+		suback, ok := c.sendSuback(pkt)
+		if !ok {
+			return nil
+		}
+		out = suback
+	case *packet.UnsubackPacket:
+		out = c.sendUnsuback(pkt)
+	case *packet.PingrespPacket:
+		out = &packets.Pingresp{}
+	default:
+		return errUnsupportedPacket.WithAttributes("type", pkt.PacketType())
+	}
+	return c.write(out)
+}
+
+func (c *v5Conn) write(pkt interface {
+	WriteTo(io.Writer) (int64, error)
+}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_, err := pkt.WriteTo(c.rw)
+	return err
+}
+
+func (c *v5Conn) sendConnack(pkt *packet.ConnackPacket) *packets.Connack {
+	connack := &packets.Connack{
+		ReasonCode: v5ConnectReasonCode(pkt.ReturnCode),
+		Properties: &packets.Properties{},
+	}
+	if pkt.ReturnCode != packet.ConnectAccepted {
+		return connack
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.connected = true
+	if c.cleanStart {
+		c.sessions.remove(c.key)
+	} else if subscriptions, ok := c.sessions.take(c.key); ok {
+		connack.SessionPresent = true
+		c.restoreSubscriptions(subscriptions)
+	}
+
+	sessionExpiry := uint32(c.sessionExpiry / time.Second)
+	topicAliasMaximum := uint16(serverTopicAliasMaximum)
+	var notAvailable, available byte = 0, 1
+	connack.Properties.SessionExpiryInterval = &sessionExpiry
+	connack.Properties.TopicAliasMaximum = &topicAliasMaximum
+	connack.Properties.WildcardSubAvailable = &available
+	connack.Properties.SubIDAvailable = &notAvailable
+	connack.Properties.SharedSubAvailable = &notAvailable
+	connack.Properties.AssignedClientID = c.assignedClientID
+	return connack
+}
+
+// restoreSubscriptions injects a SUBSCRIBE packet that restores the subscriptions of the previous session.
+// The subscriptions are authorized again by the session. The corresponding SUBACK packet is not sent to the client.
+func (c *v5Conn) restoreSubscriptions(subscriptions map[string]byte) {
+	if len(subscriptions) == 0 {
+		return
+	}
+	topics := make([]string, 0, len(subscriptions))
+	for topic := range subscriptions {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	qoss := make([]byte, len(topics))
+	for i, topic := range topics {
+		qoss[i] = subscriptions[topic]
+	}
+	c.injected = append(c.injected, &packet.SubscribePacket{
+		PacketIdentifier: restoreSubscriptionsIdentifier,
+		Topics:           topics,
+		QoSs:             qoss,
+	})
+	c.pendingSubscribes = append(c.pendingSubscribes, pendingSubscription{
+		packetIdentifier: restoreSubscriptionsIdentifier,
+		topics:           topics,
+		restore:          true,
+	})
+}
+
+func (c *v5Conn) sendPublish(pkt *packet.PublishPacket) (*packets.Publish, bool) {
+	publish := &packets.Publish{
+		Payload:    pkt.Message,
+		Topic:      pkt.TopicName,
+		QoS:        pkt.QoS,
+		Duplicate:  pkt.Duplicate,
+		Retain:     pkt.Retain,
+		PacketID:   pkt.PacketIdentifier,
+		Properties: &packets.Properties{},
+	}
+	if len(pkt.Message) > 0 {
+		if v, err := c.outgoing.Get(&pkt.Message[0]); err == nil {
+			c.outgoing.Remove(&pkt.Message[0])
+			props := v.(outgoingProperties)
+			if props.MessageExpiry > 0 {
+				remaining := props.MessageExpiry - time.Since(props.setAt)
+				if remaining <= 0 {
+					log.FromContext(c.ctx).WithField("topic", pkt.TopicName).Debug("Drop expired message")
+					return nil, false
+				}
+				expiry := uint32((remaining + time.Second - 1) / time.Second)
+				publish.Properties.MessageExpiry = &expiry
+			}
+			for _, id := range props.CorrelationIDs {
+				publish.Properties.User = append(publish.Properties.User, packets.User{
+					Key:   correlationIDProperty,
+					Value: id,
+				})
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if alias, ok := c.outTopicAliases[publish.Topic]; ok {
+		publish.Topic = ""
+		publish.Properties.TopicAlias = &alias
+	} else if len(c.outTopicAliases) < int(c.topicAliasMaximum) {
+		alias := uint16(len(c.outTopicAliases) + 1)
+		c.outTopicAliases[publish.Topic] = alias
+		publish.Properties.TopicAlias = &alias
+	}
+	return publish, true
+}
+
+// publishReasonCode returns the reason code of the acknowledgment of the PUBLISH packet with the given identifier.
+func (c *v5Conn) publishReasonCode(id uint16) byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.undelivered[id]; ok {
+		delete(c.undelivered, id)
+		return reasonNotAuthorized
+	}
+	return reasonSuccess
+}
+
+// popPending removes and returns the first pending subscription with the given packet identifier.
+func popPending(pending *[]pendingSubscription, id uint16) (pendingSubscription, bool) {
+	for i, p := range *pending {
+		if p.packetIdentifier == id {
+			*pending = append((*pending)[:i], (*pending)[i+1:]...)
+			return p, true
+		}
+	}
+	return pendingSubscription{}, false
+}
+
+func (c *v5Conn) sendSuback(pkt *packet.SubackPacket) (*packets.Suback, bool) {
+	suback := &packets.Suback{
+		PacketID:   pkt.PacketIdentifier,
+		Reasons:    make([]byte, len(pkt.ReturnCodes)),
+		Properties: &packets.Properties{},
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pending, _ := popPending(&c.pendingSubscribes, pkt.PacketIdentifier)
+	for i, code := range pkt.ReturnCodes {
+		if code == packet.SubscribeRejected {
+			suback.Reasons[i] = reasonNotAuthorized
+			continue
+		}
+		suback.Reasons[i] = code
+		if i < len(pending.topics) {
+			c.subscriptions[pending.topics[i]] = code
+		}
+	}
+	return suback, !pending.restore
+}
+
+func (c *v5Conn) sendUnsuback(pkt *packet.UnsubackPacket) *packets.Unsuback {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pending, _ := popPending(&c.pendingUnsubscribes, pkt.PacketIdentifier)
+	unsuback := &packets.Unsuback{
+		PacketID:   pkt.PacketIdentifier,
+		Reasons:    make([]byte, len(pending.topics)),
+		Properties: &packets.Properties{},
+	}
+	for i, topic := range pending.topics {
+		if _, ok := c.subscriptions[topic]; !ok {
+			unsuback.Reasons[i] = reasonNoSubscriptionExisted
+			continue
+		}
+		delete(c.subscriptions, topic)
+	}
+	return unsuback
+}
+
+func (c *v5Conn) setOutgoingProperties(pkt *packet.PublishPacket, props PublishProperties) {
+	if len(pkt.Message) == 0 {
+		return
+	}
+	c.outgoing.Set(&pkt.Message[0], outgoingProperties{
+		PublishProperties: props,
+		setAt:             time.Now(),
+	})
+}
+
+func (c *v5Conn) incomingProperties(pkt *packet.PublishPacket) PublishProperties {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if pkt != c.inPublish {
+		return PublishProperties{}
+	}
+	return c.inProperties
+}
+
+func (c *v5Conn) wrapDeliver(deliver func(*packet.PublishPacket)) func(*packet.PublishPacket) {
+	return func(pkt *packet.PublishPacket) {
+		if pkt.QoS > 0 {
+			c.mu.Lock()
+			delete(c.undelivered, pkt.PacketIdentifier)
+			c.mu.Unlock()
+		}
+		deliver(pkt)
+	}
+}
+
+// disconnect sends a DISCONNECT packet with the given reason code to the client.
+func (c *v5Conn) disconnect(code byte) {
+	c.mu.Lock()
+	connected := c.connected
+	c.mu.Unlock()
+	if !connected {
+		return
+	}
+	c.NetConn().SetWriteDeadline(time.Now().Add(disconnectTimeout))
+	if err := c.write(&packets.Disconnect{
+		ReasonCode: code,
+		Properties: &packets.Properties{},
+	}); err != nil {
+		log.FromContext(c.ctx).WithError(err).Debug("Failed to send DISCONNECT")
+	}
+}
+
+// Close implements mqttnet.Conn.
+// If the client requested a session expiry interval, the subscriptions of the session are retained.
+func (c *v5Conn) Close() error {
+	c.mu.Lock()
+	if c.connected && c.sessionExpiry > 0 {
+		subscriptions := make(map[string]byte, len(c.subscriptions))
+		for topic, qos := range c.subscriptions {
+			subscriptions[topic] = qos
+		}
+		c.sessions.put(c.key, subscriptions, c.sessionExpiry)
+	}
+	c.connected = false
+	c.mu.Unlock()
+	return c.Conn.Close()
+}
@@ -0,0 +1,508 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/auth"
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/TheThingsIndustries/mystique/pkg/session"
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/eclipse/paho.golang/packets"
+	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+var (
+	errTestNotAuthorized  = errors.DefinePermissionDenied("test_not_authorized", "not authorized")
+	errTestQuotaExceeded  = errors.DefineResourceExhausted("test_quota_exceeded", "quota exceeded")
+	errTestRateLimited    = errors.DefineResourceExhausted("test_rate_limited", "rate limited")
+	errTestServerShutdown = errors.DefineCanceled("test_server_shutdown", "server shutdown")
+)
+
+type delivery struct {
+	pkt   *packet.PublishPacket
+	props PublishProperties
+}
+
+// testConnection is a connection with a client that can publish to up/# and subscribe to down/#.
+type testConnection struct {
+	mqttConn  mqttnet.Conn
+	session   session.Session
+	cancel    errorcontext.CancelFunc
+	delivered chan delivery
+}
+
+func (c *testConnection) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
+	switch {
+	case info.Username == "exhausted":
+		return nil, ConnectError(errTestQuotaExceeded.New())
+	case info.Username != "user" || string(info.Password) != "pass":
+		return nil, ConnectError(errTestNotAuthorized.New())
+	}
+	info.Interface = c
+	return ctx, nil
+}
+
+func (c *testConnection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (string, byte, error) {
+	if parts := topic.Split(requestedTopic); parts[0] != "down" {
+		return "", 0, errTestNotAuthorized.New()
+	}
+	return requestedTopic, requestedQoS, nil
+}
+
+func (c *testConnection) CanRead(info *auth.Info, topicParts ...string) bool {
+	return topicParts[0] == "down"
+}
+
+func (c *testConnection) CanWrite(info *auth.Info, topicParts ...string) bool {
+	return topicParts[0] == "up"
+}
+
+func (c *testConnection) deliver(pkt *packet.PublishPacket) {
+	c.delivered <- delivery{
+		pkt:   pkt,
+		props: ReceivedPublishProperties(c.mqttConn, pkt),
+	}
+}
+
+// publish publishes a message to the session of the connection.
+func (c *testConnection) publish(topicName string, payload []byte, props PublishProperties) {
+	pkt := &packet.PublishPacket{
+		TopicName:  topicName,
+		TopicParts: topic.Split(topicName),
+		QoS:        1,
+		Message:    payload,
+	}
+	SetPublishProperties(c.mqttConn, pkt, props)
+	c.session.Publish(pkt)
+}
+
+func startServer(ctx context.Context, t *testing.T) (string, <-chan *testConnection) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+	connCh := make(chan *testConnection, 4)
+	ts := task.StartTaskFunc(task.DefaultStartTask)
+	go RunListener(
+		ctx, mqttnet.NewListener(lis, "tcp"), ts,
+		ratelimit.GatewayAcceptMQTTConnectionResource, &ratelimit.NoopRateLimiter{},
+		func(ctx context.Context, mqttConn mqttnet.Conn) error {
+			c := &testConnection{
+				mqttConn:  mqttConn,
+				delivered: make(chan delivery, 4),
+			}
+			ctx = auth.NewContextWithInterface(ctx, c)
+			c.session = NewSession(ctx, mqttConn, c.deliver)
+			if err := c.session.ReadConnect(); err != nil {
+				return err
+			}
+			ctx, c.cancel = errorcontext.New(ctx)
+			RunSession(ctx, c.cancel, ts, c.session, mqttConn, &sync.WaitGroup{})
+			connCh <- c
+			return nil
+		},
+	)
+	return lis.Addr().String(), connCh
+}
+
+type v5Client struct {
+	t    *testing.T
+	conn net.Conn
+}
+
+func dialV5(t *testing.T, addr string) *v5Client {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	return &v5Client{t: t, conn: conn}
+}
+
+func (c *v5Client) write(pkt packets.Packet) {
+	c.t.Helper()
+	if _, err := pkt.WriteTo(c.conn); err != nil {
+		c.t.Fatalf("Failed to write packet: %v", err)
+	}
+}
+
+func (c *v5Client) read() interface{} {
+	c.t.Helper()
+	c.conn.SetReadDeadline(time.Now().Add(timeout))
+	pkt, err := packets.ReadPacket(c.conn)
+	if err != nil {
+		c.t.Fatalf("Failed to read packet: %v", err)
+	}
+	return pkt.Content
+}
+
+func (c *v5Client) connect(username string, cleanStart bool, props *packets.Properties) *packets.Connack {
+	c.t.Helper()
+	c.write(&packets.Connect{
+		ProtocolName:    "MQTT",
+		ProtocolVersion: 5,
+		ClientID:        "test-client",
+		CleanStart:      cleanStart,
+		KeepAlive:       30,
+		UsernameFlag:    true,
+		Username:        username,
+		PasswordFlag:    true,
+		Password:        []byte("pass"),
+		Properties:      props,
+	})
+	connack, ok := c.read().(*packets.Connack)
+	if !ok {
+		c.t.Fatal("Expected CONNACK")
+	}
+	return connack
+}
+
+func (c *v5Client) readEOF() {
+	c.t.Helper()
+	c.conn.SetReadDeadline(time.Now().Add(timeout))
+	if pkt, err := packets.ReadPacket(c.conn); err != io.EOF {
+		c.t.Fatalf("Expected connection to be closed, got %v, %v", pkt, err)
+	}
+}
+
+func uint16Ptr(v uint16) *uint16 { return &v }
+func uint32Ptr(v uint32) *uint32 { return &v }
+
+func waitConnection(t *testing.T, connCh <-chan *testConnection) *testConnection {
+	t.Helper()
+	select {
+	case conn := <-connCh:
+		return conn
+	case <-time.After(timeout):
+		t.Fatal("Timeout waiting for connection")
+		return nil
+	}
+}
+
+func waitDelivery(t *testing.T, conn *testConnection) delivery {
+	t.Helper()
+	select {
+	case d := <-conn.delivered:
+		return d
+	case <-time.After(timeout):
+		t.Fatal("Timeout waiting for delivery")
+		return delivery{}
+	}
+}
+
+func TestV311(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	addr, connCh := startServer(ctx, t)
+
+	connect := func(username string) (net.Conn, *packet.ConnackPacket) {
+		conn, err := net.Dial("tcp", addr)
+		if err != nil {
+			t.Fatalf("Failed to dial: %v", err)
+		}
+		if err := packet.Write(conn, &packet.ConnectPacket{
+			ProtocolName:  "MQTT",
+			ProtocolLevel: 4,
+			CleanStart:    true,
+			ClientID:      "test-client",
+			Username:      username,
+			Password:      []byte("pass"),
+		}); err != nil {
+			t.Fatalf("Failed to write CONNECT: %v", err)
+		}
+		conn.SetReadDeadline(time.Now().Add(timeout))
+		pkt, err := packet.Read(conn)
+		if err != nil {
+			t.Fatalf("Failed to read CONNACK: %v", err)
+		}
+		return conn, pkt.(*packet.ConnackPacket)
+	}
+
+	for _, tc := range []struct {
+		username   string
+		returnCode packet.ConnectReturnCode
+	}{
+		{username: "unknown", returnCode: packet.ConnectNotAuthorized},
+		{username: "exhausted", returnCode: packet.ConnectServerUnavailable},
+	} {
+		conn, connack := connect(tc.username)
+		a.So(connack.ReturnCode, should.Equal, tc.returnCode)
+		conn.Close()
+	}
+
+	client, connack := connect("user")
+	defer client.Close()
+	a.So(connack.ReturnCode, should.Equal, packet.ConnectAccepted)
+	conn := waitConnection(t, connCh)
+
+	if err := packet.Write(client, &packet.SubscribePacket{
+		PacketIdentifier: 1,
+		Topics:           []string{"down/#", "forbidden/#"},
+		QoSs:             []byte{1, 1},
+	}); err != nil {
+		t.Fatalf("Failed to write SUBSCRIBE: %v", err)
+	}
+	pkt, err := packet.Read(client)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pkt.(*packet.SubackPacket).ReturnCodes, should.Resemble, []byte{1, packet.SubscribeRejected})
+
+	conn.publish("down/a", []byte("down"), PublishProperties{
+		MessageExpiry:  time.Minute,
+		CorrelationIDs: []string{"a"},
+	})
+	pkt, err = packet.Read(client)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pkt.(*packet.PublishPacket).TopicName, should.Equal, "down/a")
+	a.So(pkt.(*packet.PublishPacket).Message, should.Resemble, []byte("down"))
+
+	if err := packet.Write(client, &packet.PublishPacket{
+		QoS:              1,
+		PacketIdentifier: 2,
+		TopicName:        "up/a",
+		Message:          []byte("up"),
+	}); err != nil {
+		t.Fatalf("Failed to write PUBLISH: %v", err)
+	}
+	d := waitDelivery(t, conn)
+	a.So(d.pkt.TopicName, should.Equal, "up/a")
+	a.So(d.props, should.Resemble, PublishProperties{})
+	pkt, err = packet.Read(client)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pkt.(*packet.PubackPacket).PacketIdentifier, should.Equal, 2)
+}
+
+func TestV5(t *testing.T) {
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	addr, connCh := startServer(ctx, t)
+
+	for _, tc := range []struct {
+		username   string
+		reasonCode byte
+	}{
+		{username: "unknown", reasonCode: 0x87},
+		{username: "exhausted", reasonCode: 0x97},
+	} {
+		client := dialV5(t, addr)
+		connack := client.connect(tc.username, true, &packets.Properties{})
+		a.So(connack.ReasonCode, should.Equal, tc.reasonCode)
+		client.conn.Close()
+	}
+
+	client := dialV5(t, addr)
+	defer client.conn.Close()
+	connack := client.connect("user", true, &packets.Properties{
+		SessionExpiryInterval: uint32Ptr(60),
+		TopicAliasMaximum:     uint16Ptr(8),
+	})
+	a.So(connack.ReasonCode, should.Equal, 0)
+	a.So(connack.SessionPresent, should.BeFalse)
+	if !a.So(connack.Properties.SessionExpiryInterval, should.NotBeNil) ||
+		!a.So(connack.Properties.TopicAliasMaximum, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(*connack.Properties.SessionExpiryInterval, should.Equal, 60)
+	a.So(*connack.Properties.TopicAliasMaximum, should.BeGreaterThan, 0)
+	conn := waitConnection(t, connCh)
+
+	// Subscribe with reason codes.
+	client.write(&packets.Subscribe{
+		PacketID:      1,
+		Subscriptions: map[string]packets.SubOptions{"down/#": {QoS: 1}},
+		Properties:    &packets.Properties{},
+	})
+	a.So(client.read().(*packets.Suback).Reasons, should.Resemble, []byte{1})
+	client.write(&packets.Subscribe{
+		PacketID:      2,
+		Subscriptions: map[string]packets.SubOptions{"forbidden/#": {QoS: 1}},
+		Properties:    &packets.Properties{},
+	})
+	a.So(client.read().(*packets.Suback).Reasons, should.Resemble, []byte{0x87})
+
+	// Outgoing messages have user properties, message expiry and topic aliases.
+	conn.publish("down/a", []byte("1"), PublishProperties{
+		MessageExpiry:  time.Minute,
+		CorrelationIDs: []string{"a", "b"},
+	})
+	publish := client.read().(*packets.Publish)
+	a.So(publish.Topic, should.Equal, "down/a")
+	a.So(publish.Payload, should.Resemble, []byte("1"))
+	if a.So(publish.Properties.TopicAlias, should.NotBeNil) {
+		a.So(*publish.Properties.TopicAlias, should.Equal, 1)
+	}
+	if a.So(publish.Properties.MessageExpiry, should.NotBeNil) {
+		a.So(*publish.Properties.MessageExpiry, should.BeBetweenOrEqual, 1, 60)
+	}
+	a.So(publish.Properties.User, should.Resemble, []packets.User{
+		{Key: "correlation_id", Value: "a"},
+		{Key: "correlation_id", Value: "b"},
+	})
+	client.write(&packets.Puback{PacketID: publish.PacketID, Properties: &packets.Properties{}})
+
+	conn.publish("down/a", []byte("2"), PublishProperties{})
+	publish = client.read().(*packets.Publish)
+	a.So(publish.Topic, should.BeEmpty)
+	a.So(publish.Payload, should.Resemble, []byte("2"))
+	if a.So(publish.Properties.TopicAlias, should.NotBeNil) {
+		a.So(*publish.Properties.TopicAlias, should.Equal, 1)
+	}
+	a.So(publish.Properties.MessageExpiry, should.BeNil)
+	client.write(&packets.Puback{PacketID: publish.PacketID, Properties: &packets.Properties{}})
+
+	// Expired messages are dropped.
+	conn.publish("down/b", []byte("3"), PublishProperties{
+		MessageExpiry: time.Nanosecond,
+	})
+	conn.publish("down/b", []byte("4"), PublishProperties{})
+	publish = client.read().(*packets.Publish)
+	a.So(publish.Payload, should.Resemble, []byte("4"))
+	client.write(&packets.Puback{PacketID: publish.PacketID, Properties: &packets.Properties{}})
+
+	// Incoming messages resolve topic aliases and carry user properties.
+	client.write(&packets.Publish{
+		PacketID: 3,
+		QoS:      1,
+		Topic:    "up/a",
+		Payload:  []byte("5"),
+		Properties: &packets.Properties{
+			TopicAlias: uint16Ptr(1),
+			User:       []packets.User{{Key: "correlation_id", Value: "c"}},
+		},
+	})
+	d := waitDelivery(t, conn)
+	a.So(d.pkt.TopicName, should.Equal, "up/a")
+	a.So(d.props.CorrelationIDs, should.Resemble, []string{"c"})
+	a.So(client.read().(*packets.Puback).ReasonCode, should.Equal, 0)
+
+	client.write(&packets.Publish{
+		PacketID: 4,
+		QoS:      1,
+		Payload:  []byte("6"),
+		Properties: &packets.Properties{
+			TopicAlias: uint16Ptr(1),
+		},
+	})
+	d = waitDelivery(t, conn)
+	a.So(d.pkt.TopicName, should.Equal, "up/a")
+	a.So(d.props.CorrelationIDs, should.BeEmpty)
+	a.So(client.read().(*packets.Puback).ReasonCode, should.Equal, 0)
+
+	// Messages that the client is not allowed to publish are acknowledged with a reason code.
+	client.write(&packets.Publish{
+		PacketID:   5,
+		QoS:        1,
+		Topic:      "forbidden/a",
+		Payload:    []byte("7"),
+		Properties: &packets.Properties{},
+	})
+	a.So(client.read().(*packets.Puback).ReasonCode, should.Equal, 0x87)
+
+	// Unsubscribe with reason codes.
+	client.write(&packets.Unsubscribe{
+		PacketID:   6,
+		Topics:     []string{"down/#", "other/#"},
+		Properties: &packets.Properties{},
+	})
+	a.So(client.read().(*packets.Unsuback).Reasons, should.Resemble, []byte{0, 0x11})
+	client.write(&packets.Subscribe{
+		PacketID:      7,
+		Subscriptions: map[string]packets.SubOptions{"down/#": {QoS: 1}},
+		Properties:    &packets.Properties{},
+	})
+	a.So(client.read().(*packets.Suback).Reasons, should.Resemble, []byte{1})
+
+	// The session is resumed after reconnecting.
+	client.write(&packets.Disconnect{Properties: &packets.Properties{}})
+	client.readEOF()
+
+	client = dialV5(t, addr)
+	defer client.conn.Close()
+	connack = client.connect("user", false, &packets.Properties{
+		SessionExpiryInterval: uint32Ptr(60),
+	})
+	a.So(connack.ReasonCode, should.Equal, 0)
+	a.So(connack.SessionPresent, should.BeTrue)
+	conn = waitConnection(t, connCh)
+	client.write(&packets.Pingreq{})
+	if _, ok := client.read().(*packets.Pingresp); !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	conn.publish("down/c", []byte("8"), PublishProperties{})
+	publish = client.read().(*packets.Publish)
+	a.So(publish.Topic, should.Equal, "down/c")
+	a.So(publish.Payload, should.Resemble, []byte("8"))
+
+	// The server sends the reason when it closes the connection.
+	conn.cancel(errTestRateLimited.New())
+	a.So(client.read().(*packets.Disconnect).ReasonCode, should.Equal, 0x96)
+	client.readEOF()
+
+	// A clean start discards the session.
+	client = dialV5(t, addr)
+	defer client.conn.Close()
+	connack = client.connect("user", true, &packets.Properties{})
+	a.So(connack.ReasonCode, should.Equal, 0)
+	a.So(connack.SessionPresent, should.BeFalse)
+	conn = waitConnection(t, connCh)
+
+	// Invalid topic aliases close the connection.
+	client.write(&packets.Publish{
+		Payload: []byte("9"),
+		Properties: &packets.Properties{
+			TopicAlias: uint16Ptr(2),
+		},
+	})
+	a.So(client.read().(*packets.Disconnect).ReasonCode, should.Equal, 0x94)
+	client.readEOF()
+
+	client = dialV5(t, addr)
+	defer client.conn.Close()
+	client.connect("user", true, &packets.Properties{})
+	conn = waitConnection(t, connCh)
+	conn.cancel(errTestServerShutdown.New())
+	a.So(client.read().(*packets.Disconnect).ReasonCode, should.Equal, 0x8b)
+	client.readEOF()
+}
@@ -81,6 +81,13 @@ func (c MACSettingConfig) Parse() *ttnpb.MACSettings {
 	return p
 }
 
+// DeviceRegistryRetryConfig defines how device registry transactions are retried on conflicts.
+type DeviceRegistryRetryConfig struct {
+	MaxAttempts int           `name:"max-attempts" description:"Maximum number of attempts of a device registry transaction that conflicts with a concurrent modification (1 disables retries)"`
+	Backoff     time.Duration `name:"backoff" description:"Base delay between attempts of a conflicting device registry transaction"`
+	Jitter      float64       `name:"jitter" description:"Fraction of random jitter applied to the delay between attempts"`
+}
+
 // DownlinkPriorityConfig defines priorities for downlink messages.
 type DownlinkPriorityConfig struct {
 	// JoinAccept is the downlink priority for join-accept messages.
@@ -126,6 +133,7 @@ func (c DownlinkPriorityConfig) Parse() (DownlinkPriorities, error) {
 type Config struct {
 	ApplicationUplinkQueue   ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
 	Devices                  DeviceRegistry               `name:"-"`
+	DeviceRegistryRetry      DeviceRegistryRetryConfig    `name:"device-registry-retry" description:"Device registry transaction retry configuration"`
 	DownlinkTaskQueue        DownlinkTaskQueueConfig      `name:"downlink-task-queue"`
 	UplinkDeduplicator       UplinkDeduplicator           `name:"-"`
 	ScheduledDownlinkMatcher ScheduledDownlinkMatcher     `name:"-"`
@@ -153,6 +161,11 @@ var DefaultConfig = Config{
 	DownlinkTaskQueue: DownlinkTaskQueueConfig{
 		NumConsumers: 1,
 	},
+	DeviceRegistryRetry: DeviceRegistryRetryConfig{
+		MaxAttempts: 5,
+		Backoff:     10 * time.Millisecond,
+		Jitter:      0.5,
+	},
 	DeduplicationWindow: 200 * time.Millisecond,
 	CooldownWindow:      time.Second,
 	DownlinkPriorities: DownlinkPriorityConfig{
@@ -29,6 +29,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
@@ -119,6 +120,14 @@ func (ns *NetworkServer) nextDataDownlinkTaskAt(ctx context.Context, dev *ttnpb.
 		earliestAt = t
 	}
 
+	if _, ok := ns.handoverRoamingAgreement(ctx, dev); ok {
+		// Application downlinks are forwarded to the serving Network Server as soon as they are queued.
+		if len(dev.Session.QueuedApplicationDownlinks) == 0 {
+			return time.Time{}, nil
+		}
+		return earliestAt, nil
+	}
+
 	fps, err := ns.FrequencyPlansStore(ctx)
 	if err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to get frequency plans store")
@@ -640,6 +649,8 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 type downlinkPath struct {
 	*ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+	// interop is set if the downlink path is through a forwarding Network Server in passive roaming.
+	interop bool
 }
 
 func buildMetadataComparator(
@@ -690,6 +701,7 @@ func downlinkPathsFromMetadata(
 			tail = append(tail, path)
 		} else {
 			path.GatewayIdentifiers = md.GatewayIds
+			path.interop = isInteropUplinkToken(md.UplinkToken)
 			switch md.DownlinkPathConstraint {
 			case ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NONE:
 				head = append(head, path)
@@ -849,6 +861,101 @@ func (t *packetBrokerDownlinkTarget) Schedule(
 	}, nil
 }
 
+var errInteropDownlinkClass = errors.DefineFailedPrecondition(
+	"interop_downlink_class", "class `{class}` downlink is not supported in passive roaming",
+)
+
+var interopClassModes = map[ttnpb.Class]string{
+	ttnpb.Class_CLASS_A: "A",
+	ttnpb.Class_CLASS_C: "C",
+}
+
+// interopDownlinkTarget transmits downlink messages through the forwarding Network Server in passive roaming.
+type interopDownlinkTarget struct {
+	client InteropClient
+	netID  types.NetID
+	token  *interopUplinkToken
+}
+
+func (t *interopDownlinkTarget) Equal(target downlinkTarget) bool {
+	other, ok := target.(*interopDownlinkTarget)
+	if !ok {
+		return false
+	}
+	return other.token.NetID.Equal(t.token.NetID) && bytes.Equal(other.token.FNSULToken, t.token.FNSULToken)
+}
+
+func (t *interopDownlinkTarget) Schedule(
+	ctx context.Context, msg *ttnpb.DownlinkMessage, _ ...grpc.CallOption,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
+	req := msg.GetRequest()
+	classMode, ok := interopClassModes[req.Class]
+	if !ok {
+		return nil, errInteropDownlinkClass.WithAttributes("class", req.Class)
+	}
+	phy, err := band.GetLatest(t.token.BandID)
+	if err != nil {
+		return nil, err
+	}
+	md := &interop.DLMetaData{
+		ClassMode:      classMode,
+		FNSULToken:     t.token.FNSULToken,
+		HiPriorityFlag: req.Priority >= ttnpb.TxSchedulePriority_HIGH,
+	}
+	if req.Class == ttnpb.Class_CLASS_A {
+		rxDelay1 := int(req.Rx1Delay)
+		md.RXDelay1 = &rxDelay1
+	}
+	for _, rx := range []struct {
+		dataRate      *ttnpb.DataRate
+		frequency     uint64
+		dataRateIndex **int
+		frequencyMHz  **float64
+	}{
+		{req.Rx1DataRate, req.Rx1Frequency, &md.DataRate1, &md.DLFreq1},
+		{req.Rx2DataRate, req.Rx2Frequency, &md.DataRate2, &md.DLFreq2},
+	} {
+		if rx.dataRate == nil || rx.frequency == 0 {
+			continue
+		}
+		idx, _, ok := phy.FindDownlinkDataRate(rx.dataRate)
+		if !ok {
+			return nil, errDataRateNotFound.WithAttributes("data_rate", rx.dataRate)
+		}
+		dataRateIndex, frequencyMHz := int(idx), float64(rx.frequency)/1e6
+		*rx.dataRateIndex, *rx.frequencyMHz = &dataRateIndex, &frequencyMHz
+	}
+	for _, path := range req.DownlinkPaths {
+		token, err := parseInteropUplinkToken(path.GetUplinkToken())
+		if err != nil {
+			return nil, err
+		}
+		md.GWInfo = append(md.GWInfo, interop.GWInfoElement{
+			ULToken: token.ULToken,
+		})
+	}
+	if _, err := t.client.XmitDataRequest(ctx, &interop.XmitDataReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:   interop.NetID(t.netID),
+			ReceiverID: interop.NetID(t.token.NetID),
+		},
+		PHYPayload: msg.RawPayload,
+		DLMetaData: md,
+	}); err != nil {
+		return nil, err
+	}
+	return &ttnpb.ScheduleDownlinkResponse{
+		Delay: ttnpb.ProtoDurationPtr(peeringScheduleDelay),
+		DownlinkPath: &ttnpb.DownlinkPath{
+			Path: &ttnpb.DownlinkPath_Fixed{
+				Fixed: &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIds: cluster.InteropGatewayID,
+				},
+			},
+		},
+	}, nil
+}
+
 // scheduleDownlinkByPaths attempts to schedule payload b using parameters in req using paths.
 // scheduleDownlinkByPaths discards req.TxRequest.DownlinkPaths and mutates it arbitrarily.
 // scheduleDownlinkByPaths returns the scheduled downlink or error.
@@ -876,7 +983,24 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 		attempts := groupedAttempts[groupIdx]
 		for _, path := range paths {
 			var target downlinkTarget
-			if path.GatewayIdentifiers != nil {
+			switch {
+			case path.interop:
+				logger := logger.WithField("target", "interop")
+				if ns.interopClient == nil {
+					logger.Warn("No interop client to schedule passive roaming downlink")
+					continue
+				}
+				token, err := parseInteropUplinkToken(path.GetUplinkToken())
+				if err != nil {
+					logger.WithError(err).Warn("Failed to parse interop uplink token")
+					continue
+				}
+				target = &interopDownlinkTarget{
+					client: ns.interopClient,
+					netID:  ns.netID,
+					token:  token,
+				}
+			case path.GatewayIdentifiers != nil:
 				logger := logger.WithFields(log.Fields(
 					"target", "gateway_server",
 					"gateway_uid", unique.ID(ctx, path.GatewayIdentifiers),
@@ -887,7 +1011,7 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(
 					continue
 				}
 				target = &gatewayServerDownlinkTarget{peer: peer}
-			} else {
+			default:
 				logger := logger.WithField("target", "packet_broker_agent")
 				peer, err := ns.GetPeer(ctx, ttnpb.ClusterRole_PACKET_BROKER_AGENT, nil)
 				if err != nil {
@@ -1841,8 +1965,11 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 		var queuedApplicationUplinks []*ttnpb.ApplicationUp
 		defer func() { ns.submitApplicationUplinks(ctx, queuedApplicationUplinks...) }()
 
+		// Downlink transmissions cannot be undone, so the transaction is only retried
+		// if no downlink transmission was attempted by the callback.
+		var transmitted bool
 		taskUpdateStrategy := noDownlinkTask
-		dev, ctx, err := ns.devices.SetByID(ctx, devID.ApplicationIds, devID.DeviceId,
+		dev, ctx, err := ns.devices.SetByID(withConditionallyRetryableDeviceRegistryTransaction(ctx, func() bool { return !transmitted }), devID.ApplicationIds, devID.DeviceId,
 			[]string{
 				"frequency_plan_id",
 				"last_dev_status_received_at",
@@ -1854,6 +1981,9 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 				"session",
 			},
 			func(ctx context.Context, dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				logger := logger
+				queuedEvents, queuedApplicationUplinks, taskUpdateStrategy = nil, nil, noDownlinkTask
+
 				if dev == nil {
 					logger.Warn("Device not found")
 					return nil, nil, nil
@@ -1864,6 +1994,17 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 					return dev, nil, nil
 				}
 
+				if agreement, ok := ns.handoverRoamingAgreement(ctx, dev); ok {
+					logger = logger.WithField("serving_net_id", agreement.NetID)
+					ctx = log.NewContext(ctx, logger)
+
+					transmitted = true
+					paths, ups := ns.forwardHandoverDataDownlink(ctx, dev, agreement)
+					queuedApplicationUplinks = append(queuedApplicationUplinks, ups...)
+					taskUpdateStrategy = nextDownlinkTask
+					return dev, paths, nil
+				}
+
 				fps, err := ns.FrequencyPlansStore(ctx)
 				if err != nil {
 					logger.WithError(err).Error("Failed to get frequency plan store")
@@ -1967,6 +2108,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 						req.Rx2Frequency = rxParameters.rx2Frequency
 						req.Rx2DataRate = rxParameters.rx2DataRate
 					}
+					transmitted = true
 					down, downEvs, err := ns.scheduleDownlinkByPaths(
 						log.NewContext(ctx, loggerWithTxRequestFields(logger, req, attemptRX1, attemptRX2).WithField("rx1_delay", req.Rx1Delay)),
 						&scheduleRequest{
@@ -2087,6 +2229,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 					}
 					switch slot := v.(type) {
 					case *classADownlinkSlot:
+						transmitted = true
 						a := ns.attemptClassADataDownlink(ctx, dev, phy, fp, slot, maxUpLength)
 						queuedEvents = append(queuedEvents, a.QueuedEvents...)
 						queuedApplicationUplinks = append(queuedApplicationUplinks, a.QueuedApplicationUplinks...)
@@ -2118,6 +2261,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 							earliestAt = time.Now().Add(absoluteTimeSchedulingDelay / 2)
 							continue
 						}
+						transmitted = true
 						a := ns.attemptNetworkInitiatedDataDownlink(ctx, dev, phy, fp, slot, maxUpLength)
 						queuedEvents = append(queuedEvents, a.QueuedEvents...)
 						queuedApplicationUplinks = append(queuedApplicationUplinks, a.QueuedApplicationUplinks...)
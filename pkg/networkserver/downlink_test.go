@@ -20,6 +20,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -44,6 +45,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
 )
 
 func TestProcessDownlinkTask(t *testing.T) {
@@ -2687,3 +2689,140 @@ func rx2DataRateFromIndex(dev *ttnpb.EndDevice, a *assertions.Assertion, t *test
 	}
 	return dr.Rate
 }
+
+var errTestDeviceRegistryConflict = errors.DefineAborted("test_device_registry_conflict", "test device registry conflict")
+
+// conflictingDeviceRegistry fails the SetByID transactions with a conflict after invoking the callback,
+// as long as conflicts is positive.
+type conflictingDeviceRegistry struct {
+	DeviceRegistry
+	conflicts *int32
+}
+
+func (r conflictingDeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	return r.DeviceRegistry.SetByID(ctx, appID, devID, paths, func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		dev, paths, err := f(ctx, stored)
+		if err != nil {
+			return nil, nil, err
+		}
+		if atomic.AddInt32(r.conflicts, -1) >= 0 {
+			return nil, nil, errTestDeviceRegistryConflict.New()
+		}
+		return dev, paths, nil
+	})
+}
+
+func TestProcessDownlinkTaskConflict(t *testing.T) {
+	a, ctx := test.New(t)
+
+	appID := &ttnpb.ApplicationIdentifiers{ApplicationId: "conflict-test-app-id"}
+	const devID = "conflict-test-dev-id"
+
+	devices, closeFn := NewDeviceRegistry(ctx)
+	defer closeFn()
+	var conflicts int32
+
+	conf := DefaultConfig
+	conf.Devices = conflictingDeviceRegistry{
+		DeviceRegistry: devices,
+		conflicts:      &conflicts,
+	}
+	errCh := make(chan error, 1)
+	_, ctx, env, stop := StartTest(ctx, TestConfig{
+		NetworkServer: conf,
+		TaskStarter: task.StartTaskFunc(func(conf *task.Config) {
+			if !strings.HasPrefix(conf.ID, DownlinkProcessTaskName) {
+				task.DefaultStartTask(conf)
+				return
+			}
+			go func() {
+				errCh <- conf.Func(conf.Context)
+			}()
+		}),
+		Component: component.Config{
+			ServiceBase: config.ServiceBase{
+				FrequencyPlans: config.FrequencyPlansConfig{
+					ConfigSource: "static",
+					Static:       test.StaticFrequencyPlans,
+				},
+			},
+		},
+	})
+	defer stop()
+
+	var scheduled int32
+	gs := NewGSPeer(ctx, &MockNsGsServer{
+		ScheduleDownlinkFunc: func(context.Context, *ttnpb.DownlinkMessage) (*ttnpb.ScheduleDownlinkResponse, error) {
+			atomic.AddInt32(&scheduled, 1)
+			return &ttnpb.ScheduleDownlinkResponse{
+				Delay: ttnpb.ProtoDurationPtr(time.Second),
+			}, nil
+		},
+	})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-env.Cluster.GetPeer:
+				req.Response <- test.ClusterGetPeerResponse{Peer: gs}
+			case req := <-env.Cluster.Auth:
+				req.Response <- &grpc.EmptyCallOption{}
+			case req := <-env.Events:
+				close(req.Response)
+			}
+		}
+	}()
+
+	created, ctx := MustCreateDevice(ctx, env.Devices, &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: appID,
+			DeviceId:       devID,
+			DevAddr:        test.DefaultDevAddr.Bytes(),
+		},
+		FrequencyPlanId:   test.EUFrequencyPlanID,
+		LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_1_REV_B,
+		MacState: func() *ttnpb.MACState {
+			macState := MakeDefaultEU868MACState(ttnpb.Class_CLASS_C, ttnpb.MACVersion_MAC_V1_1, ttnpb.PHYVersion_RP001_V1_1_REV_B)
+			macState.RecentUplinks = ToMACStateUplinkMessages(
+				MakeDataUplink(DataUplinkConfig{
+					DecodePayload: true,
+					Matched:       true,
+					MACVersion:    ttnpb.MACVersion_MAC_V1_1,
+					DevAddr:       test.DefaultDevAddr,
+					DataRate:      LoRaWANBands[band.EU_863_870][ttnpb.PHYVersion_RP001_V1_1_REV_B].DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+					Frequency:     868100000,
+					RxMetadata:    DefaultRxMetadata[:],
+					ReceivedAt:    time.Now().Add(-time.Minute),
+				}),
+			)
+			return macState
+		}(),
+		Session: &ttnpb.Session{
+			DevAddr:       test.DefaultDevAddr.Bytes(),
+			LastNFCntDown: 0x24,
+			Keys:          MakeSessionKeys(ttnpb.MACVersion_MAC_V1_1, false, true),
+			QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+				{
+					CorrelationIds: []string{"correlation-app-down-1"},
+					FCnt:           0x42,
+					FPort:          0x1,
+					FrmPayload:     []byte("testPayload"),
+					Priority:       ttnpb.TxSchedulePriority_HIGHEST,
+					SessionKeyId:   test.DefaultSessionKeyID,
+				},
+			},
+		},
+	})
+	atomic.StoreInt32(&conflicts, 1)
+	test.Must(nil, env.DownlinkTaskQueue.Queue.Add(ctx, created.Ids, time.Now(), true))
+
+	select {
+	case <-ctx.Done():
+		t.Fatal("Timed out while waiting for processDownlinkTask to return")
+
+	case err := <-errCh:
+		a.So(err, should.HaveSameErrorDefinitionAs, errTestDeviceRegistryConflict)
+	}
+	a.So(atomic.LoadInt32(&scheduled), should.Equal, 1)
+}
@@ -520,8 +520,20 @@ func (st *setDeviceState) ValidateSetFields(isValid func(map[string]*ttnpb.EndDe
 }
 
 // SetFunc is the function meant to be passed to SetByID.
+// The returned function may be invoked multiple times if the transaction is retried. f may modify the device and
+// add set fields, so these are restored to their state before the first invocation on every subsequent invocation.
+// The device is restored in place, since validators registered via WithFields may reference it.
 func (st *setDeviceState) SetFunc(f func(context.Context, *ttnpb.EndDevice) error) func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+	dev := ttnpb.Clone(st.Device)
+	extraSets := append(st.extraSets[:0:0], st.extraSets...)
+	var invoked bool
 	return func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		if invoked {
+			*st.Device = *ttnpb.Clone(dev)
+			st.extraSets = append(extraSets[:0:0], extraSets...)
+			st.extraSetsCache = make(map[string]bool, len(extraSets))
+		}
+		invoked = true
 		for p, shouldBeZero := range st.zeroPaths {
 			if stored.FieldIsZero(p) != shouldBeZero {
 				return nil, nil, newInvalidFieldValueError(p)
@@ -2659,7 +2671,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	}
 
 	var evt events.Event
-	dev, ctx, err := ns.devices.SetByID(ctx, st.Device.Ids.ApplicationIds, st.Device.Ids.DeviceId, st.GetFields(), st.SetFunc(func(ctx context.Context, stored *ttnpb.EndDevice) error {
+	dev, ctx, err := ns.devices.SetByID(withRetryableDeviceRegistryTransaction(ctx), st.Device.Ids.ApplicationIds, st.Device.Ids.DeviceId, st.GetFields(), st.SetFunc(func(ctx context.Context, stored *ttnpb.EndDevice) error {
 		if hasSession {
 			macVersion := stored.GetMacState().GetLorawanVersion()
 			if stored.GetMacState() == nil && !st.HasSetField("mac_state") {
@@ -636,8 +636,10 @@ macLoop:
 			return nil, false, nil
 		}
 		setPaths = ttnpb.AddFields(setPaths, "ids.dev_addr")
-	} else if dev.PendingSession != nil || dev.PendingMacState != nil {
-		// TODO: Notify AS of session recovery(https://github.com/TheThingsNetwork/lorawan-stack/issues/594)
+	}
+	var discardedSessionKeyID []byte
+	if matchType != pendingMatch && dev.PendingSession != nil {
+		discardedSessionKeyID = dev.PendingSession.GetKeys().GetSessionKeyId()
 	}
 	dev.MacState.PendingJoinRequest = nil
 	dev.PendingMacState = nil
@@ -689,27 +691,38 @@ macLoop:
 	dev.Session.LastFCntUp = cmacFMatchResult.FullFCnt
 
 	var queuedApplicationUplinks []*ttnpb.ApplicationUp
+	if discardedSessionKeyID != nil || matchType == currentResetMatch {
+		queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
+			EndDeviceIds: dev.Ids,
+			Up: &ttnpb.ApplicationUp_SessionRecovered{
+				SessionRecovered: &ttnpb.ApplicationSessionRecovered{
+					SessionKeyId:          dev.Session.Keys.SessionKeyId,
+					DiscardedSessionKeyId: discardedSessionKeyID,
+					FCntReset:             matchType == currentResetMatch,
+					LastFCntUp:            dev.Session.LastFCntUp,
+					LastAFCntDown:         dev.Session.LastAFCntDown,
+				},
+			},
+			CorrelationIds: up.CorrelationIds,
+		})
+	}
 	if pendingAppDown != nil {
 		if pld.FHdr.FCtrl.Ack {
-			queuedApplicationUplinks = []*ttnpb.ApplicationUp{
-				{
-					EndDeviceIds: dev.Ids,
-					Up: &ttnpb.ApplicationUp_DownlinkAck{
-						DownlinkAck: pendingAppDown,
-					},
-					CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
+			queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
+				EndDeviceIds: dev.Ids,
+				Up: &ttnpb.ApplicationUp_DownlinkAck{
+					DownlinkAck: pendingAppDown,
 				},
-			}
+				CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
+			})
 		} else {
-			queuedApplicationUplinks = []*ttnpb.ApplicationUp{
-				{
-					EndDeviceIds: dev.Ids,
-					Up: &ttnpb.ApplicationUp_DownlinkNack{
-						DownlinkNack: pendingAppDown,
-					},
-					CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
+			queuedApplicationUplinks = append(queuedApplicationUplinks, &ttnpb.ApplicationUp{
+				EndDeviceIds: dev.Ids,
+				Up: &ttnpb.ApplicationUp_DownlinkNack{
+					DownlinkNack: pendingAppDown,
 				},
-			}
+				CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
+			})
 		}
 		if dev.MacState != nil {
 			dev.MacState.PendingApplicationDownlink = nil
@@ -998,7 +1011,11 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	var queuedApplicationUplinks []*ttnpb.ApplicationUp
 	defer func() { ns.submitApplicationUplinks(ctx, queuedApplicationUplinks...) }()
 
-	stored, _, err := ns.devices.SetByID(ctx, matched.Device.Ids.ApplicationIds, matched.Device.Ids.DeviceId, handleDataUplinkGetPaths[:],
+	// The callback may be invoked multiple times if the transaction is retried. The matched device is
+	// modified in place below, so any subsequent invocation must match the uplink against the stored device again.
+	// The callback only assigns the queued application uplinks and events, so it is safe to retry.
+	var invoked bool
+	stored, _, err := ns.devices.SetByID(withRetryableDeviceRegistryTransaction(ctx), matched.Device.Ids.ApplicationIds, matched.Device.Ids.DeviceId, handleDataUplinkGetPaths[:],
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			defer trace.StartRegion(ctx, "update stored device").End()
 
@@ -1007,7 +1024,9 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				return nil, nil, errOutdatedData.New()
 			}
 
-			if !matched.Device.CreatedAt.Equal(stored.CreatedAt) || !matched.Device.UpdatedAt.Equal(stored.UpdatedAt) {
+			rematch := invoked || !matched.Device.CreatedAt.Equal(stored.CreatedAt) || !matched.Device.UpdatedAt.Equal(stored.UpdatedAt)
+			invoked = true
+			if rematch {
 				matched, ok, err = ns.matchAndHandleDataUplink(ctx, stored, up, true, matched.cmacFMatchingResult)
 				if err != nil {
 					return nil, nil, err
@@ -1020,8 +1039,8 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				ctx = matched.Context
 			}
 
-			queuedApplicationUplinks = append(queuedApplicationUplinks, matched.QueuedApplicationUplinks...)
-			queuedEvents = append(queuedEvents, matched.QueuedEventBuilders.New(ctx, events.WithIdentifiers(matched.Device.Ids))...)
+			queuedApplicationUplinks = matched.QueuedApplicationUplinks
+			queuedEvents = matched.QueuedEventBuilders.New(ctx, events.WithIdentifiers(matched.Device.Ids))
 
 			stored = matched.Device
 			paths := ttnpb.AddFields(matched.SetPaths,
@@ -1068,7 +1087,6 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			return stored, paths, nil
 		})
 	if err != nil {
-		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
 		logRegistryRPCError(ctx, err, "Failed to update device in registry")
 		return err
 	}
@@ -1349,7 +1367,8 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	macState.RecentUplinks = appendRecentUplink(nil, up, recentUplinkCount)
 
 	logger := log.FromContext(ctx)
-	stored, storedCtx, err := ns.devices.SetByID(ctx, matched.Ids.ApplicationIds, matched.Ids.DeviceId,
+	// The callback only assigns the pending MAC state computed above, so it is safe to retry.
+	stored, storedCtx, err := ns.devices.SetByID(withRetryableDeviceRegistryTransaction(ctx), matched.Ids.ApplicationIds, matched.Ids.DeviceId,
 		[]string{
 			"frequency_plan_id",
 			"lorawan_phy_version",
@@ -1367,7 +1386,6 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 			}, nil
 		})
 	if err != nil {
-		// TODO: Retry transaction. (https://github.com/TheThingsNetwork/lorawan-stack/issues/33)
 		logRegistryRPCError(ctx, err, "Failed to update device in registry")
 		return err
 	}
@@ -1398,11 +1416,18 @@ func (ns *NetworkServer) handleRejoinRequest(ctx context.Context, up *ttnpb.Upli
 }
 
 // HandleUplink is called by the Gateway Server when an uplink message arrives.
-func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (_ *pbtypes.Empty, err error) {
+func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*pbtypes.Empty, error) {
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	if err := ns.handleUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
 
+// handleUplink handles the uplink message received by the Gateway Server or by a forwarding Network Server.
+func (ns *NetworkServer) handleUplink(ctx context.Context, up *ttnpb.UplinkMessage) (err error) {
 	ctx = events.ContextWithCorrelationID(ctx, append(
 		up.CorrelationIds,
 		fmt.Sprintf("ns:uplink:%s", events.NewCorrelationID()),
@@ -1414,10 +1439,10 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	if err := up.Payload.ValidateFields(); err != nil {
-		return nil, errDecodePayload.WithCause(err)
+		return errDecodePayload.WithCause(err)
 	}
 	registerReceiveUplink(ctx, up)
 	defer func() {
@@ -1454,7 +1479,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 			"ocw", dr.Lrfhss.GetOperatingChannelWidth(),
 		))
 	default:
-		return nil, errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
+		return errDataRateNotFound.WithAttributes("data_rate", up.Settings.DataRate)
 	}
 	ctx = log.NewContext(ctx, logger)
 
@@ -1465,14 +1490,14 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	}
 	switch up.Payload.MHdr.MType {
 	case ttnpb.MType_CONFIRMED_UP, ttnpb.MType_UNCONFIRMED_UP:
-		return ttnpb.Empty, ns.handleDataUplink(ctx, up)
+		return ns.handleDataUplink(ctx, up)
 	case ttnpb.MType_JOIN_REQUEST:
-		return ttnpb.Empty, ns.handleJoinRequest(ctx, up)
+		return ns.handleJoinRequest(ctx, up)
 	case ttnpb.MType_REJOIN_REQUEST:
-		return ttnpb.Empty, ns.handleRejoinRequest(ctx, up)
+		return ns.handleRejoinRequest(ctx, up)
 	}
 	logger.Debug("Unmatched MType")
-	return ttnpb.Empty, nil
+	return nil
 }
 
 var errTransmission = errors.Define("transmission", "downlink transmission failed with result `{result}`")
@@ -18,9 +18,17 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -98,3 +106,164 @@ func TestAppendRecentUplink(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchAndHandleDataUplinkSessionRecovered(t *testing.T) {
+	const (
+		macVersion = ttnpb.MACVersion_MAC_V1_0_3
+		phyVersion = ttnpb.PHYVersion_RP001_V1_0_3_REV_A
+	)
+	discardedSessionKeyID := []byte{0x42, 0x42}
+	makeDevice := func(resetsFCnt bool) *ttnpb.EndDevice {
+		dev := MakeABPEndDevice(DefaultConfig.DefaultMACSettings.Parse(), false,
+			[]test.SessionOption{
+				SessionOptions.WithKeys(MakeSessionKeys(macVersion, false, true)),
+				SessionOptions.WithLastFCntUp(42),
+				SessionOptions.WithLastAFCntDown(24),
+			},
+			nil,
+			EndDeviceOptions.WithLorawanVersion(macVersion),
+			EndDeviceOptions.WithLorawanPhyVersion(phyVersion),
+			EndDeviceOptions.WithMacSettings(&ttnpb.MACSettings{
+				ResetsFCnt: &ttnpb.BoolValue{Value: resetsFCnt},
+			}),
+		)
+		dev.PendingSession = MakeSession(macVersion, false, false,
+			SessionOptions.WithDevAddr(types.DevAddr{0x42, 0xff, 0xff, 0xff}.Bytes()),
+			SessionOptions.WithKeys(MakeSessionKeys(macVersion, false, false,
+				SessionKeysOptions.WithSessionKeyId(discardedSessionKeyID),
+			)),
+		)
+		return dev
+	}
+	makeUplink := func(dev *ttnpb.EndDevice, fCnt uint32) *ttnpb.UplinkMessage {
+		conf := WithDeviceDataUplinkConfig(dev, false, ttnpb.DataRateIndex_DATA_RATE_2, 1, 0)(DataUplinkConfig{
+			DecodePayload:  true,
+			FPort:          1,
+			FRMPayload:     []byte{0x01},
+			RxMetadata:     DefaultRxMetadata[:],
+			CorrelationIDs: []string{"test-correlation-id"},
+			ReceivedAt:     time.Now(),
+		})
+		conf.FCnt = fCnt
+		return MakeDataUplink(conf)
+	}
+
+	for _, tc := range []struct {
+		Name                  string
+		Device                *ttnpb.EndDevice
+		FCnt                  uint32
+		DiscardPendingSession bool
+		ExpectedRecovered     *ttnpb.ApplicationSessionRecovered
+	}{
+		{
+			Name:   "current session/pending session present",
+			Device: makeDevice(false),
+			FCnt:   43,
+			ExpectedRecovered: &ttnpb.ApplicationSessionRecovered{
+				SessionKeyId:          test.DefaultSessionKeyID,
+				DiscardedSessionKeyId: discardedSessionKeyID,
+				LastFCntUp:            43,
+				LastAFCntDown:         24,
+			},
+		},
+		{
+			Name: "current session/no pending session",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(false)
+				dev.PendingSession = nil
+				return dev
+			}(),
+			FCnt: 43,
+		},
+		{
+			Name:   "current session/FCnt reset",
+			Device: makeDevice(true),
+			FCnt:   2,
+			ExpectedRecovered: &ttnpb.ApplicationSessionRecovered{
+				SessionKeyId:          test.DefaultSessionKeyID,
+				DiscardedSessionKeyId: discardedSessionKeyID,
+				FCntReset:             true,
+				LastFCntUp:            2,
+				LastAFCntDown:         24,
+			},
+		},
+		{
+			Name: "current session/FCnt reset/no pending session",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(true)
+				dev.PendingSession = nil
+				return dev
+			}(),
+			FCnt: 2,
+			ExpectedRecovered: &ttnpb.ApplicationSessionRecovered{
+				SessionKeyId:  test.DefaultSessionKeyID,
+				FCntReset:     true,
+				LastFCntUp:    2,
+				LastAFCntDown: 24,
+			},
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				c := component.MustNew(
+					log.Noop,
+					&component.Config{
+						ServiceBase: config.ServiceBase{
+							FrequencyPlans: config.FrequencyPlansConfig{
+								ConfigSource: "static",
+								Static:       test.StaticFrequencyPlans,
+							},
+						},
+					},
+					component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+						return &test.MockCluster{
+							JoinFunc: test.ClusterJoinNilFunc,
+						}, nil
+					}),
+				)
+				componenttest.StartComponent(t, c)
+				defer c.Close()
+
+				ns := &NetworkServer{
+					Component:          c,
+					ctx:                ctx,
+					defaultMACSettings: DefaultConfig.DefaultMACSettings.Parse(),
+				}
+
+				dev := ttnpb.Clone(tc.Device)
+				up := makeUplink(dev, tc.FCnt)
+				res, ok, err := ns.matchAndHandleDataUplink(ctx, dev, up, true, cmacFMatchingResult{
+					LastFCnt:       dev.Session.LastFCntUp,
+					FNwkSIntKey:    *types.MustAES128Key(dev.Session.Keys.FNwkSIntKey.Key),
+					LoRaWANVersion: macVersion,
+					FullFCnt:       tc.FCnt,
+				})
+				if !a.So(err, should.BeNil) || !a.So(res, should.NotBeNil) {
+					t.FailNow()
+				}
+				a.So(ok, should.BeTrue)
+				a.So(res.Device.PendingSession, should.BeNil)
+				a.So(res.Device.Session.LastFCntUp, should.Equal, tc.FCnt)
+
+				var recovered []*ttnpb.ApplicationSessionRecovered
+				for _, appUp := range res.QueuedApplicationUplinks {
+					if pld := appUp.GetSessionRecovered(); pld != nil {
+						a.So(appUp.EndDeviceIds, should.Resemble, dev.Ids)
+						a.So(appUp.CorrelationIds, should.Resemble, up.CorrelationIds)
+						recovered = append(recovered, pld)
+					}
+				}
+				if tc.ExpectedRecovered == nil {
+					a.So(recovered, should.BeEmpty)
+					return
+				}
+				if a.So(recovered, should.HaveLength, 1) {
+					a.So(recovered[0], should.Resemble, tc.ExpectedRecovered)
+				}
+			},
+		})
+	}
+}
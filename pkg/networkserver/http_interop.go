@@ -0,0 +1,326 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// interopServer is the serving Network Server (sNS) in passive roaming.
+type interopServer struct {
+	NS *NetworkServer
+}
+
+var (
+	errMissingULMetaData  = errors.DefineInvalidArgument("missing_ul_metadata", "missing uplink metadata")
+	errInteropDataRateIdx = errors.DefineInvalidArgument(
+		"interop_data_rate_index", "data rate index `{index}` not found in band `{band_id}`",
+	)
+	errInteropUplinkToken = errors.DefineCorruption("interop_uplink_token", "invalid interop uplink token")
+)
+
+// interopUplinkToken is the uplink token of a gateway of a forwarding Network Server.
+type interopUplinkToken struct {
+	// NetID is the NetID of the forwarding Network Server.
+	NetID types.NetID `json:"n"`
+	// BandID is the band of the uplink message.
+	BandID string `json:"b"`
+	// FNSULToken is the uplink token of the forwarding Network Server.
+	FNSULToken []byte `json:"f,omitempty"`
+	// ULToken is the uplink token of the gateway.
+	ULToken []byte `json:"u"`
+}
+
+// interopUplinkTokenPrefix marks uplink tokens of forwarding Network Servers, so that downlink paths through them are
+// distinguished from downlink paths through gateways.
+var interopUplinkTokenPrefix = []byte("interop:")
+
+func isInteropUplinkToken(buf []byte) bool {
+	return bytes.HasPrefix(buf, interopUplinkTokenPrefix)
+}
+
+func encodeInteropUplinkToken(token *interopUplinkToken) ([]byte, error) {
+	buf, err := json.Marshal(token)
+	if err != nil {
+		return nil, err
+	}
+	return append(append(make([]byte, 0, len(interopUplinkTokenPrefix)+len(buf)), interopUplinkTokenPrefix...), buf...), nil
+}
+
+func parseInteropUplinkToken(buf []byte) (*interopUplinkToken, error) {
+	if !isInteropUplinkToken(buf) {
+		return nil, errInteropUplinkToken.New()
+	}
+	token := &interopUplinkToken{}
+	if err := json.Unmarshal(buf[len(interopUplinkTokenPrefix):], token); err != nil {
+		return nil, errInteropUplinkToken.WithCause(err)
+	}
+	return token, nil
+}
+
+// uplinkMessageFromInterop converts the passive roaming uplink message to an uplink message.
+// The uplink message is received through the forwarding Network Server identified by dlNetID. Gateways that allow
+// downlink get an uplink token which identifies the forwarding Network Server; other gateways have the downlink path
+// constraint DOWNLINK_PATH_CONSTRAINT_NEVER. If dlNetID is nil, downlink is not allowed on any gateway.
+func uplinkMessageFromInterop(
+	phyPayload interop.Buffer, md *interop.ULMetaData, dlNetID *types.NetID,
+) (*ttnpb.UplinkMessage, error) {
+	if md.DataRate == nil || md.ULFreq == nil {
+		return nil, errMissingULMetaData.New()
+	}
+	bandID, err := md.RFRegion.BandID()
+	if err != nil {
+		return nil, err
+	}
+	phy, err := band.GetLatest(bandID)
+	if err != nil {
+		return nil, err
+	}
+	dr, ok := phy.DataRates[ttnpb.DataRateIndex(*md.DataRate)]
+	if !ok {
+		return nil, errInteropDataRateIdx.WithAttributes(
+			"index", *md.DataRate,
+			"band_id", bandID,
+		)
+	}
+	recvTime := ttnpb.ProtoTimePtr(time.Time(md.RecvTime))
+	rxMetadata := make([]*ttnpb.RxMetadata, 0, len(md.GWInfo))
+	for _, gwInfo := range md.GWInfo {
+		rxMD := &ttnpb.RxMetadata{
+			GatewayIds:             cluster.InteropGatewayID,
+			ReceivedAt:             recvTime,
+			DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+		}
+		if gwInfo.RSSI != nil {
+			rxMD.Rssi = float32(*gwInfo.RSSI)
+			rxMD.ChannelRssi = float32(*gwInfo.RSSI)
+		}
+		if gwInfo.SNR != nil {
+			rxMD.Snr = *gwInfo.SNR
+		}
+		if dlNetID != nil && gwInfo.DLAllowed && len(gwInfo.ULToken) > 0 {
+			token, err := encodeInteropUplinkToken(&interopUplinkToken{
+				NetID:      *dlNetID,
+				BandID:     bandID,
+				FNSULToken: md.FNSULToken,
+				ULToken:    gwInfo.ULToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			rxMD.UplinkToken = token
+			rxMD.DownlinkPathConstraint = ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NONE
+		}
+		if gwInfo.Lat != nil && gwInfo.Lon != nil {
+			rxMD.Location = &ttnpb.Location{
+				Latitude:  *gwInfo.Lat,
+				Longitude: *gwInfo.Lon,
+				Source:    ttnpb.LocationSource_SOURCE_REGISTRY,
+			}
+		}
+		rxMetadata = append(rxMetadata, rxMD)
+	}
+	if len(rxMetadata) == 0 {
+		rxMetadata = append(rxMetadata, &ttnpb.RxMetadata{
+			GatewayIds:             cluster.InteropGatewayID,
+			ReceivedAt:             recvTime,
+			DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+		})
+	}
+	return &ttnpb.UplinkMessage{
+		RawPayload: phyPayload,
+		Settings: &ttnpb.TxSettings{
+			DataRate:  dr.Rate,
+			Frequency: uint64(math.Round(*md.ULFreq * 1e6)),
+		},
+		RxMetadata: rxMetadata,
+	}, nil
+}
+
+// interopUplinkResult is the result of handling an uplink message forwarded by a forwarding Network Server.
+type interopUplinkResult struct {
+	agreement interop.RoamingAgreement
+	devAddr   *types.DevAddr
+	devEUI    *types.EUI64
+}
+
+// handleUplink handles the uplink message forwarded by the forwarding Network Server identified by header.
+// Data uplink messages of end devices with a DevAddr of this network and join-request messages of end devices
+// registered in this network are handled.
+func (srv interopServer) handleUplink(
+	ctx context.Context, header interop.NsNsMessageHeader, phyPayload interop.Buffer, md *interop.ULMetaData,
+) (*interopUplinkResult, error) {
+	ns := srv.NS
+	netID := types.NetID(header.SenderID)
+	if err := (interop.Authorizer{}).RequireNetID(ctx, netID); err != nil {
+		return nil, interop.ErrUnknownSender.WithCause(err)
+	}
+	if !types.NetID(header.ReceiverID).Equal(ns.netID) {
+		return nil, interop.ErrUnknownReceiver.New()
+	}
+	if ns.interopClient == nil {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+	agreement, ok := ns.interopClient.RoamingAgreement(netID)
+	if !ok || agreement.PassiveRoamingProfile == interop.PassiveRoamingDisabled {
+		return nil, interop.ErrNoRoamingAgreement.New()
+	}
+
+	ids, err := lorawan.GetUplinkMessageIdentifiers(phyPayload)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	res := &interopUplinkResult{
+		agreement: agreement,
+		devAddr:   types.MustDevAddr(ids.DevAddr),
+		devEUI:    types.MustEUI64(ids.DevEui),
+	}
+	fields := log.Fields("forwarding_net_id", netID)
+	switch {
+	case res.devAddr != nil:
+		var matches bool
+		for _, prefix := range ns.devAddrPrefixes(ctx) {
+			if res.devAddr.HasPrefix(prefix) {
+				matches = true
+				break
+			}
+		}
+		if !matches {
+			return nil, interop.ErrUnknownDevAddr.New()
+		}
+		fields = fields.WithField("dev_addr", *res.devAddr)
+	case res.devEUI != nil:
+		if phyPayload[0]>>5 != byte(ttnpb.MType_JOIN_REQUEST) {
+			// Rejoin-request messages are handled by the serving Network Server of the current session.
+			return nil, interop.ErrRoamingActivation.New()
+		}
+		fields = fields.WithField("dev_eui", *res.devEUI)
+	default:
+		return nil, interop.ErrMalformedMessage.New()
+	}
+
+	up, err := uplinkMessageFromInterop(phyPayload, md, &netID)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	ctx = log.NewContextWithFields(ctx, fields)
+	if err := ns.handleUplink(ctx, up); err != nil {
+		switch {
+		case errors.Is(err, errDuplicateUplink):
+			// The uplink message has already been received by the local Gateway Server or by another forwarding
+			// Network Server.
+		case errors.Resemble(err, errDeviceNotFound):
+			if res.devAddr == nil {
+				return nil, interop.ErrUnknownDevEUI.WithCause(err)
+			}
+			return nil, interop.ErrUnknownDevAddr.WithCause(err)
+		case errors.Resemble(err, errDecodePayload):
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		default:
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// PRStartRequest implements interop.ServingNetworkServer.
+// If the roaming agreement uses the stateful profile, the answer to a data uplink message contains the lifetime of
+// the passive roaming session.
+func (srv interopServer) PRStartRequest(ctx context.Context, in *interop.PRStartReq) (*interop.PRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	res, err := srv.handleUplink(ctx, in.NsNsMessageHeader, in.PHYPayload, &in.ULMetaData)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	ans := &interop.PRStartAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DevAddr: (*interop.DevAddr)(res.devAddr),
+		DevEUI:  (*interop.EUI64)(res.devEUI),
+	}
+	// The DevAddr of join-request messages is assigned with the join-accept message, so there is no session yet.
+	if res.agreement.PassiveRoamingProfile == interop.PassiveRoamingStateful && res.devAddr != nil {
+		lifetime := uint32(res.agreement.PassiveRoamingLifetime / time.Second)
+		ans.Lifetime = &lifetime
+	}
+	return ans, nil
+}
+
+// XmitDataRequest implements interop.ServingNetworkServer.
+// Uplink messages are only accepted through XmitDataReq with the stateful profile; otherwise the forwarding
+// Network Server is expected to start a new session with PRStartReq.
+func (srv interopServer) XmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+
+	if in.DLMetaData != nil {
+		// The Network Server does not serve end devices of other networks in handover roaming.
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+	if in.ULMetaData == nil || len(in.PHYPayload) == 0 {
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	if ns := srv.NS; ns.interopClient != nil {
+		agreement, ok := ns.interopClient.RoamingAgreement(types.NetID(in.SenderID))
+		if ok && agreement.PassiveRoamingProfile != interop.PassiveRoamingStateful {
+			return nil, interop.ErrUnknownDevAddr.New()
+		}
+	}
+	res, err := srv.handleUplink(ctx, in.NsNsMessageHeader, in.PHYPayload, in.ULMetaData)
+	if err != nil {
+		return nil, err
+	}
+	if res.devAddr == nil {
+		// Join-request messages are forwarded with PRStartReq.
+		return nil, interop.ErrMalformedMessage.New()
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// HRStopRequest implements interop.ServingNetworkServer.
+// The Network Server does not serve end devices of other networks in handover roaming.
+func (interopServer) HRStopRequest(context.Context, *interop.HRStopReq) (*interop.HRStopAns, error) {
+	return nil, interop.ErrUnknownDevEUI.New()
+}
@@ -0,0 +1,588 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// interopHomeServer is the home Network Server (hNS) in handover roaming.
+// The serving Network Server (sNS) handles the MAC layer, while the hNS keeps the relationship with the Join Server
+// and the Application Server.
+type interopHomeServer struct {
+	NS *NetworkServer
+}
+
+var errForwardHandoverDownlink = errors.DefineUnavailable(
+	"forward_handover_downlink", "forward downlink to serving Network Server `{net_id}`",
+)
+
+var handoverDeviceProfilePaths = []string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+	"updated_at",
+}
+
+// deviceProfileFromEndDevice returns the device profile of dev in handover roaming.
+func deviceProfileFromEndDevice(dev *ttnpb.EndDevice, phy string, defaults *ttnpb.MACSettings) (*interop.DeviceProfile, error) {
+	rfRegion, err := interop.RFRegionFromBandID(phy)
+	if err != nil {
+		return nil, err
+	}
+	return &interop.DeviceProfile{
+		SupportsClassB:    dev.SupportsClassB,
+		SupportsClassC:    dev.SupportsClassC,
+		MACVersion:        interop.MACVersion(dev.LorawanVersion),
+		SupportsJoin:      dev.SupportsJoin,
+		RFRegion:          rfRegion,
+		Supports32bitFCnt: mac.DeviceSupports32BitFCnt(dev, defaults),
+	}, nil
+}
+
+// authorize checks that the message identified by header is sent by a partner network with a handover roaming
+// agreement.
+func (srv interopHomeServer) authorize(ctx context.Context, header interop.NsNsMessageHeader) (interop.RoamingAgreement, error) {
+	ns := srv.NS
+	netID := types.NetID(header.SenderID)
+	if err := (interop.Authorizer{}).RequireNetID(ctx, netID); err != nil {
+		return interop.RoamingAgreement{}, interop.ErrUnknownSender.WithCause(err)
+	}
+	if !types.NetID(header.ReceiverID).Equal(ns.netID) {
+		return interop.RoamingAgreement{}, interop.ErrUnknownReceiver.New()
+	}
+	if ns.interopClient == nil {
+		return interop.RoamingAgreement{}, interop.ErrNoRoamingAgreement.New()
+	}
+	agreement, ok := ns.interopClient.RoamingAgreement(netID)
+	if !ok || !agreement.HandoverRoaming {
+		return interop.RoamingAgreement{}, interop.ErrNoRoamingAgreement.New()
+	}
+	return agreement, nil
+}
+
+// deviceProfile returns the device profile of dev and the time it was last updated.
+func (srv interopHomeServer) deviceProfile(ctx context.Context, dev *ttnpb.EndDevice) (*interop.DeviceProfile, *interop.ISO8601Time, error) {
+	ns := srv.NS
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	phy, err := DeviceBand(dev, fps)
+	if err != nil {
+		return nil, nil, err
+	}
+	profile, err := deviceProfileFromEndDevice(dev, phy.ID, ns.defaultMACSettings)
+	if err != nil {
+		return nil, nil, err
+	}
+	var timestamp *interop.ISO8601Time
+	if dev.UpdatedAt != nil {
+		t := interop.ISO8601Time(*ttnpb.StdTime(dev.UpdatedAt))
+		timestamp = &t
+	}
+	return profile, timestamp, nil
+}
+
+// ProfileRequest implements interop.HomeNetworkServer.
+func (srv interopHomeServer) ProfileRequest(ctx context.Context, in *interop.ProfileReq) (*interop.ProfileAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	if _, err := srv.authorize(ctx, in.NsNsMessageHeader); err != nil {
+		return nil, err
+	}
+
+	// ProfileReq only contains the DevEUI, so the JoinEUI of the end device is unknown.
+	dev, ctx, err := ns.devices.GetByDevEUI(ctx, types.EUI64(in.DevEUI), handoverDeviceProfilePaths)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return nil, err
+	}
+	profile, timestamp, err := srv.deviceProfile(ctx, dev)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.ProfileAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		DeviceProfile:          profile,
+		DeviceProfileTimestamp: timestamp,
+		RoamingActivationType:  interop.RoamingActivationHandover,
+	}, nil
+}
+
+// HRStartRequest implements interop.HomeNetworkServer.
+// The join-request is forwarded to the Join Server. The network session keys are stored to identify the session, and
+// they are sent to the serving Network Server, optionally wrapped with the key encryption key of the roaming agreement.
+func (srv interopHomeServer) HRStartRequest(ctx context.Context, in *interop.HRStartReq) (*interop.HRStartAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	agreement, err := srv.authorize(ctx, in.NsNsMessageHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(in.PHYPayload, msg); err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	pld := msg.GetJoinRequestPayload()
+	if pld == nil {
+		return nil, interop.ErrMalformedMessage.New()
+	}
+	dlSettings := &ttnpb.DLSettings{}
+	if err := lorawan.UnmarshalDLSettings(in.DLSettings, dlSettings); err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	var cfList *ttnpb.CFList
+	if len(in.CFList) > 0 {
+		cfList = &ttnpb.CFList{}
+		if err := lorawan.UnmarshalCFList(in.CFList, cfList); err != nil {
+			return nil, interop.ErrMalformedMessage.WithCause(err)
+		}
+	}
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_eui", types.MustEUI64(pld.DevEui).OrZero(),
+		"join_eui", types.MustEUI64(pld.JoinEui).OrZero(),
+		"serving_net_id", agreement.NetID,
+	))
+
+	joinEUI, devEUI := types.MustEUI64(pld.JoinEui).OrZero(), types.MustEUI64(pld.DevEui).OrZero()
+	dev, ctx, err := ns.devices.GetByEUI(ctx, joinEUI, devEUI, handoverDeviceProfilePaths)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, interop.ErrUnknownDevEUI.WithCause(err)
+		}
+		return nil, err
+	}
+	if !dev.SupportsJoin {
+		return nil, interop.ErrActivation.New()
+	}
+	profile, timestamp, err := srv.deviceProfile(ctx, dev)
+	if err != nil {
+		return nil, err
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	macState, err := mac.NewState(dev, fps, ns.defaultMACSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	devAddr := types.DevAddr(in.DevAddr)
+	resp, queuedEvents, err := ns.sendJoinRequest(ctx, dev.Ids, &ttnpb.JoinRequest{
+		Payload:            msg,
+		CfList:             cfList,
+		CorrelationIds:     events.CorrelationIDsFromContext(ctx),
+		DevAddr:            devAddr.Bytes(),
+		NetId:              agreement.NetID.Bytes(),
+		RawPayload:         in.PHYPayload,
+		RxDelay:            in.RxDelay,
+		SelectedMacVersion: dev.LorawanVersion,
+		DownlinkSettings:   dlSettings,
+	})
+	publishEvents(ctx, queuedEvents...)
+	if err != nil {
+		return nil, interop.ErrJoinReq.WithCause(err)
+	}
+	ctx = events.ContextWithCorrelationID(ctx, resp.CorrelationIds...)
+
+	keys := resp.SessionKeys
+	if !macspec.UseNwkKey(dev.LorawanVersion) {
+		keys.NwkSEncKey = keys.FNwkSIntKey
+		keys.SNwkSIntKey = keys.FNwkSIntKey
+	}
+	// The network session keys are wrapped with the device KEK for storage, and with the KEK of the roaming agreement
+	// for the serving Network Server.
+	var (
+		storedKeys = &ttnpb.SessionKeys{
+			SessionKeyId: keys.SessionKeyId,
+		}
+		servingKeys = &ttnpb.SessionKeys{}
+	)
+	for _, k := range []struct {
+		key            *ttnpb.KeyEnvelope
+		stored, served **ttnpb.KeyEnvelope
+	}{
+		{keys.FNwkSIntKey, &storedKeys.FNwkSIntKey, &servingKeys.FNwkSIntKey},
+		{keys.SNwkSIntKey, &storedKeys.SNwkSIntKey, &servingKeys.SNwkSIntKey},
+		{keys.NwkSEncKey, &storedKeys.NwkSEncKey, &servingKeys.NwkSEncKey},
+	} {
+		key, err := cryptoutil.UnwrapAES128Key(ctx, k.key, ns.KeyVault)
+		if err != nil {
+			return nil, err
+		}
+		if *k.stored, err = cryptoutil.WrapAES128Key(ctx, key, ns.deviceKEKLabel, ns.KeyVault); err != nil {
+			return nil, err
+		}
+		if *k.served, err = cryptoutil.WrapAES128Key(ctx, key, agreement.HandoverRoamingKEKLabel, ns.KeyVault); err != nil {
+			return nil, err
+		}
+	}
+
+	macState.LorawanVersion = dev.LorawanVersion
+	macState.CurrentParameters.Rx1Delay = in.RxDelay
+	macState.CurrentParameters.Rx1DataRateOffset = dlSettings.Rx1DrOffset
+	macState.CurrentParameters.Rx2DataRateIndex = dlSettings.Rx2Dr
+	var invalidatedQueue []*ttnpb.ApplicationDownlink
+	dev, ctx, err = ns.devices.SetByID(ctx, dev.Ids.ApplicationIds, dev.Ids.DeviceId,
+		[]string{
+			"pending_session.queued_application_downlinks",
+			"session.queued_application_downlinks",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				return nil, nil, errOutdatedData.New()
+			}
+			if stored.Session != nil {
+				invalidatedQueue = stored.Session.QueuedApplicationDownlinks
+			} else {
+				invalidatedQueue = stored.GetPendingSession().GetQueuedApplicationDownlinks()
+			}
+			stored.Session = &ttnpb.Session{
+				DevAddr:   devAddr.Bytes(),
+				Keys:      storedKeys,
+				StartedAt: ttnpb.ProtoTimePtr(time.Now()),
+			}
+			stored.MacState = macState
+			stored.PendingSession = nil
+			stored.PendingMacState = nil
+			return stored, []string{
+				"mac_state",
+				"pending_mac_state",
+				"pending_session",
+				"session",
+			}, nil
+		})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to update device in registry")
+		return nil, err
+	}
+	ns.submitApplicationUplinks(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: dev.Ids.ApplicationIds,
+			DeviceId:       dev.Ids.DeviceId,
+			DevEui:         dev.Ids.DevEui,
+			JoinEui:        dev.Ids.JoinEui,
+			DevAddr:        devAddr.Bytes(),
+		},
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{
+				AppSKey:              keys.AppSKey,
+				InvalidatedDownlinks: invalidatedQueue,
+				SessionKeyId:         keys.SessionKeyId,
+				ReceivedAt:           ttnpb.ProtoTimePtr(time.Time(in.ULMetaData.RecvTime)),
+			},
+		},
+	})
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	ans := &interop.HRStartAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+		PHYPayload:             resp.RawPayload,
+		DeviceProfile:          profile,
+		DeviceProfileTimestamp: timestamp,
+		DevEUI:                 (*interop.EUI64)(&devEUI),
+	}
+	if macspec.UseNwkKey(macState.LorawanVersion) {
+		ans.SNwkSIntKey = (*interop.KeyEnvelope)(servingKeys.SNwkSIntKey)
+		ans.FNwkSIntKey = (*interop.KeyEnvelope)(servingKeys.FNwkSIntKey)
+		ans.NwkSEncKey = (*interop.KeyEnvelope)(servingKeys.NwkSEncKey)
+	} else {
+		ans.NwkSKey = (*interop.KeyEnvelope)(servingKeys.FNwkSIntKey)
+	}
+	if agreement.HandoverRoamingLifetime > 0 {
+		lifetime := uint32(agreement.HandoverRoamingLifetime / time.Second)
+		ans.Lifetime = &lifetime
+	}
+	return ans, nil
+}
+
+// XmitDataRequest implements interop.HomeNetworkServer.
+// The serving Network Server sends the FRMPayload of uplink messages, which is forwarded to the Application Server.
+func (srv interopHomeServer) XmitDataRequest(ctx context.Context, in *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "networkserver/interop")
+	ns := srv.NS
+
+	if _, err := srv.authorize(ctx, in.NsNsMessageHeader); err != nil {
+		return nil, err
+	}
+	md := in.ULMetaData
+	if md == nil || md.DevEUI == nil || md.DevAddr == nil || md.FCntUp == nil || md.FPort == nil {
+		return nil, interop.ErrMalformedMessage.WithCause(errMissingULMetaData.New())
+	}
+	devEUI, devAddr := types.EUI64(*md.DevEUI), types.DevAddr(*md.DevAddr)
+	ctx = log.NewContextWithFields(ctx, log.Fields(
+		"dev_addr", devAddr,
+		"dev_eui", devEUI,
+		"serving_net_id", types.NetID(in.SenderID),
+	))
+
+	// Only the current session is matched, as the serving Network Server only sends uplink messages after the end
+	// device has activated.
+	var dev *ttnpb.EndDevice
+	if err := ns.devices.RangeByUplinkMatches(ctx, &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHdr: &ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+			},
+			Payload: &ttnpb.Message_MacPayload{
+				MacPayload: &ttnpb.MACPayload{
+					FHdr: &ttnpb.FHDR{
+						DevAddr: devAddr.Bytes(),
+						FCtrl: &ttnpb.FCtrl{
+							Ack: true,
+						},
+						FCnt: *md.FCntUp,
+					},
+				},
+			},
+		},
+	}, func(ctx context.Context, match *UplinkMatch) (bool, error) {
+		stored, _, err := ns.devices.GetByID(ctx, match.ApplicationIdentifiers, match.DeviceID, []string{
+			"session",
+		})
+		if err != nil {
+			return false, err
+		}
+		if !types.MustEUI64(stored.Ids.DevEui).OrZero().Equal(devEUI) {
+			return false, nil
+		}
+		dev = stored
+		return true, nil
+	}); err != nil {
+		return nil, err
+	}
+	if dev == nil || dev.Session == nil {
+		return nil, interop.ErrUnknownDevEUI.New()
+	}
+
+	// The uplink metadata is recorded in the recent uplinks of the device, which are used to detect replayed frames.
+	up, err := uplinkMessageFromInterop(nil, md, nil)
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if md.Confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	fPort := uint32(*md.FPort)
+	up.Payload = &ttnpb.Message{
+		MHdr: &ttnpb.MHDR{
+			MType: mType,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MacPayload{
+			MacPayload: &ttnpb.MACPayload{
+				FHdr: &ttnpb.FHDR{
+					DevAddr: devAddr.Bytes(),
+					FCtrl:   &ttnpb.FCtrl{},
+					FCnt:    *md.FCntUp & 0xffff,
+				},
+				FPort:    fPort,
+				FullFCnt: *md.FCntUp,
+			},
+		},
+	}
+	up.ReceivedAt = ttnpb.ProtoTimePtr(time.Time(md.RecvTime))
+	up.CorrelationIds = events.CorrelationIDsFromContext(ctx)
+
+	dev, ctx, err = ns.devices.SetByID(ctx, dev.Ids.ApplicationIds, dev.Ids.DeviceId,
+		[]string{
+			"mac_state.recent_uplinks",
+			"session",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil || stored.Session == nil || stored.MacState == nil {
+				return nil, nil, errOutdatedData.New()
+			}
+			// The recent uplinks are empty until the first uplink message of the session, which may have FCnt 0.
+			if *md.FCntUp < stored.Session.LastFCntUp ||
+				*md.FCntUp == stored.Session.LastFCntUp && len(stored.MacState.RecentUplinks) > 0 {
+				return nil, nil, interop.ErrFrameReplayed.New()
+			}
+			stored.Session.LastFCntUp = *md.FCntUp
+			stored.MacState.RecentUplinks = appendRecentUplink(stored.MacState.RecentUplinks, up, recentUplinkCount)
+			return stored, []string{
+				"mac_state.recent_uplinks",
+				"session.last_f_cnt_up",
+			}, nil
+		})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to update device in registry")
+		return nil, err
+	}
+
+	appUp := &ttnpb.ApplicationUplink{
+		Confirmed:    md.Confirmed,
+		FCnt:         *md.FCntUp,
+		FPort:        fPort,
+		FrmPayload:   in.FRMPayload,
+		SessionKeyId: dev.Session.Keys.SessionKeyId,
+		ReceivedAt:   up.ReceivedAt,
+		Settings:     up.Settings,
+		RxMetadata:   up.RxMetadata,
+		NetworkIds:   ns.networkIdentifiers(ctx),
+	}
+	ns.submitApplicationUplinks(ctx, &ttnpb.ApplicationUp{
+		EndDeviceIds:   dev.Ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: appUp,
+		},
+	})
+
+	header, err := in.AnswerHeader()
+	if err != nil {
+		return nil, interop.ErrMalformedMessage.WithCause(err)
+	}
+	return &interop.XmitDataAns{
+		NsNsMessageHeader: header,
+		Result: interop.Result{
+			ResultCode: interop.ResultSuccess,
+		},
+	}, nil
+}
+
+// handoverRoamingAgreement returns the roaming agreement with the serving Network Server of dev, if dev is served by
+// a partner network in handover roaming.
+func (ns *NetworkServer) handoverRoamingAgreement(ctx context.Context, dev *ttnpb.EndDevice) (interop.RoamingAgreement, bool) {
+	if ns.interopClient == nil || dev.GetSession() == nil {
+		return interop.RoamingAgreement{}, false
+	}
+	devAddr := types.MustDevAddr(dev.Session.DevAddr).OrZero()
+	for _, prefix := range ns.devAddrPrefixes(ctx) {
+		if devAddr.HasPrefix(prefix) {
+			return interop.RoamingAgreement{}, false
+		}
+	}
+	for _, agreement := range ns.interopClient.RoamingAgreements() {
+		if !agreement.HandoverRoaming {
+			continue
+		}
+		prefixDevAddr, err := types.NewDevAddr(agreement.NetID, nil)
+		if err != nil {
+			continue
+		}
+		if devAddr.HasPrefix(types.DevAddrPrefix{
+			DevAddr: prefixDevAddr,
+			Length:  uint8(32 - types.NwkAddrBits(agreement.NetID)),
+		}) {
+			return agreement, true
+		}
+	}
+	return interop.RoamingAgreement{}, false
+}
+
+// forwardHandoverDataDownlink forwards the first queued application downlink of dev to the serving Network Server.
+// forwardHandoverDataDownlink mutates dev and returns the paths that were updated and the application uplinks
+// associated with the result.
+func (ns *NetworkServer) forwardHandoverDataDownlink(
+	ctx context.Context, dev *ttnpb.EndDevice, agreement interop.RoamingAgreement,
+) ([]string, []*ttnpb.ApplicationUp) {
+	if len(dev.Session.QueuedApplicationDownlinks) == 0 {
+		return nil, nil
+	}
+	down := dev.Session.QueuedApplicationDownlinks[0]
+	dev.Session.QueuedApplicationDownlinks = dev.Session.QueuedApplicationDownlinks[1:]
+	paths := []string{
+		"session.queued_application_downlinks",
+	}
+	ctx = events.ContextWithCorrelationID(ctx, down.CorrelationIds...)
+
+	var (
+		devEUI = interop.EUI64(types.MustEUI64(dev.Ids.DevEui).OrZero())
+		fPort  = uint8(down.FPort)
+		fCnt   = down.FCnt
+	)
+	_, err := ns.interopClient.XmitDataRequest(ctx, &interop.XmitDataReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:   interop.NetID(ns.netID),
+			ReceiverID: interop.NetID(agreement.NetID),
+		},
+		FRMPayload: down.FrmPayload,
+		DLMetaData: &interop.DLMetaData{
+			DevEUI:    &devEUI,
+			FPort:     &fPort,
+			FCntDown:  &fCnt,
+			Confirmed: down.Confirmed,
+		},
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to forward application downlink to serving Network Server")
+		return paths, []*ttnpb.ApplicationUp{{
+			EndDeviceIds:   dev.Ids,
+			CorrelationIds: events.CorrelationIDsFromContext(ctx),
+			Up: &ttnpb.ApplicationUp_DownlinkFailed{
+				DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+					Downlink: down,
+					Error: ttnpb.ErrorDetailsToProto(
+						errForwardHandoverDownlink.WithAttributes("net_id", agreement.NetID).WithCause(err),
+					),
+				},
+			},
+		}}
+	}
+	dev.Session.LastAFCntDown = fCnt
+	paths = ttnpb.AddFields(paths, "session.last_a_f_cnt_down")
+	if macspec.UseSharedFCntDown(dev.MacState.GetLorawanVersion()) {
+		dev.Session.LastNFCntDown = fCnt
+		paths = ttnpb.AddFields(paths, "session.last_n_f_cnt_down")
+	}
+	return paths, []*ttnpb.ApplicationUp{{
+		EndDeviceIds:   dev.Ids,
+		CorrelationIds: events.CorrelationIDsFromContext(ctx),
+		Up: &ttnpb.ApplicationUp_DownlinkSent{
+			DownlinkSent: down,
+		},
+	}}
+}
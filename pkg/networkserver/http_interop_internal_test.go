@@ -0,0 +1,779 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestUplinkMessageFromInterop(t *testing.T) {
+	t.Parallel()
+
+	recvTime := time.Date(2023, time.January, 2, 3, 4, 5, 0, time.UTC)
+	intPtr := func(v int) *int { return &v }
+	float64Ptr := func(v float64) *float64 { return &v }
+	rssi, snr := int32(-42), float32(5.5)
+
+	for _, tc := range []struct {
+		Name           string
+		Metadata       *interop.ULMetaData
+		Expected       *ttnpb.UplinkMessage
+		ErrorAssertion func(*assertions.Assertion, error) bool
+	}{
+		{
+			Name: "MissingDataRate",
+			Metadata: &interop.ULMetaData{
+				ULFreq:   float64Ptr(868.1),
+				RecvTime: interop.ISO8601Time(recvTime),
+				RFRegion: "EU868",
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.HaveSameErrorDefinitionAs, errMissingULMetaData)
+			},
+		},
+		{
+			Name: "UnknownRFRegion",
+			Metadata: &interop.ULMetaData{
+				DataRate: intPtr(5),
+				ULFreq:   float64Ptr(868.1),
+				RecvTime: interop.ISO8601Time(recvTime),
+				RFRegion: "Unknown",
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.NotBeNil)
+			},
+		},
+		{
+			Name: "UnknownDataRateIndex",
+			Metadata: &interop.ULMetaData{
+				DataRate: intPtr(15),
+				ULFreq:   float64Ptr(868.1),
+				RecvTime: interop.ISO8601Time(recvTime),
+				RFRegion: "EU868",
+			},
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.HaveSameErrorDefinitionAs, errInteropDataRateIdx)
+			},
+		},
+		{
+			Name: "EU868/NoGWInfo",
+			Metadata: &interop.ULMetaData{
+				DataRate: intPtr(5),
+				ULFreq:   float64Ptr(868.1),
+				RecvTime: interop.ISO8601Time(recvTime),
+				RFRegion: "EU868",
+			},
+			Expected: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0x40, 0x04, 0x03, 0x02, 0x01},
+				Settings: &ttnpb.TxSettings{
+					DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+					Frequency: 868100000,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIds:             cluster.InteropGatewayID,
+						ReceivedAt:             ttnpb.ProtoTimePtr(recvTime),
+						DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+					},
+				},
+			},
+		},
+		{
+			Name: "EU868/GWInfo",
+			Metadata: &interop.ULMetaData{
+				DataRate: intPtr(5),
+				ULFreq:   float64Ptr(868.1),
+				RecvTime: interop.ISO8601Time(recvTime),
+				RFRegion: "EU868",
+				GWInfo: []interop.GWInfoElement{
+					{
+						RSSI:    &rssi,
+						SNR:     &snr,
+						Lat:     float64Ptr(52.37),
+						Lon:     float64Ptr(4.89),
+						ULToken: interop.Buffer{0x01, 0x02},
+					},
+				},
+			},
+			Expected: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0x40, 0x04, 0x03, 0x02, 0x01},
+				Settings: &ttnpb.TxSettings{
+					DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+					Frequency: 868100000,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIds:             cluster.InteropGatewayID,
+						ReceivedAt:             ttnpb.ProtoTimePtr(recvTime),
+						Rssi:                   -42,
+						ChannelRssi:            -42,
+						Snr:                    5.5,
+						DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NEVER,
+						Location: &ttnpb.Location{
+							Latitude:  52.37,
+							Longitude: 4.89,
+							Source:    ttnpb.LocationSource_SOURCE_REGISTRY,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "EU868/GWInfo/DLAllowed",
+			Metadata: &interop.ULMetaData{
+				DataRate:   intPtr(5),
+				ULFreq:     float64Ptr(868.1),
+				FNSULToken: interop.Buffer{0x03, 0x04},
+				RecvTime:   interop.ISO8601Time(recvTime),
+				RFRegion:   "EU868",
+				GWInfo: []interop.GWInfoElement{
+					{
+						RSSI:      &rssi,
+						SNR:       &snr,
+						ULToken:   interop.Buffer{0x01, 0x02},
+						DLAllowed: true,
+					},
+				},
+			},
+			Expected: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0x40, 0x04, 0x03, 0x02, 0x01},
+				Settings: &ttnpb.TxSettings{
+					DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+					Frequency: 868100000,
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIds:  cluster.InteropGatewayID,
+						ReceivedAt:  ttnpb.ProtoTimePtr(recvTime),
+						Rssi:        -42,
+						ChannelRssi: -42,
+						Snr:         5.5,
+						UplinkToken: test.Must(encodeInteropUplinkToken(&interopUplinkToken{
+							NetID:      types.NetID{0x00, 0x00, 0x42},
+							BandID:     band.EU_863_870,
+							FNSULToken: []byte{0x03, 0x04},
+							ULToken:    []byte{0x01, 0x02},
+						})).([]byte),
+						DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NONE,
+					},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			up, err := uplinkMessageFromInterop(
+				interop.Buffer{0x40, 0x04, 0x03, 0x02, 0x01}, tc.Metadata, &types.NetID{0x00, 0x00, 0x42},
+			)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(a, err), should.BeTrue)
+				a.So(up, should.BeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(up, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestInteropDownlinkTarget(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	var (
+		localNetID      = types.NetID{0x00, 0x00, 0x13}
+		forwardingNetID = types.NetID{0x00, 0x00, 0x42}
+	)
+	token := &interopUplinkToken{
+		NetID:      forwardingNetID,
+		BandID:     band.EU_863_870,
+		FNSULToken: []byte{0x03, 0x04},
+		ULToken:    []byte{0x01, 0x02},
+	}
+	var reqs []*interop.XmitDataReq
+	target := &interopDownlinkTarget{
+		client: MockInteropClient{
+			XmitDataRequestFunc: func(_ context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+				reqs = append(reqs, req)
+				return &interop.XmitDataAns{}, nil
+			},
+		},
+		netID: localNetID,
+		token: token,
+	}
+	a.So(target.Equal(&interopDownlinkTarget{token: token}), should.BeTrue)
+	a.So(target.Equal(&interopDownlinkTarget{token: &interopUplinkToken{NetID: localNetID}}), should.BeFalse)
+
+	newDownlink := func(class ttnpb.Class) *ttnpb.DownlinkMessage {
+		return &ttnpb.DownlinkMessage{
+			RawPayload: []byte{0x60, 0x01, 0x02, 0x03, 0x04},
+			Settings: &ttnpb.DownlinkMessage_Request{
+				Request: &ttnpb.TxRequest{
+					Class: class,
+					DownlinkPaths: []*ttnpb.DownlinkPath{
+						{
+							Path: &ttnpb.DownlinkPath_UplinkToken{
+								UplinkToken: test.Must(encodeInteropUplinkToken(token)).([]byte),
+							},
+						},
+					},
+					Rx1Delay:     ttnpb.RxDelay_RX_DELAY_1,
+					Rx1DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+					Rx1Frequency: 868100000,
+					Rx2DataRate:  band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+					Rx2Frequency: 869525000,
+					Priority:     ttnpb.TxSchedulePriority_HIGH,
+				},
+			},
+		}
+	}
+
+	res, err := target.Schedule(ctx, newDownlink(ttnpb.Class_CLASS_A))
+	if !a.So(err, should.BeNil) || !a.So(reqs, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(res.DownlinkPath.GetFixed().GetGatewayIds(), should.Resemble, cluster.InteropGatewayID)
+	intPtr := func(v int) *int { return &v }
+	float64Ptr := func(v float64) *float64 { return &v }
+	a.So(reqs[0], should.Resemble, &interop.XmitDataReq{
+		NsNsMessageHeader: interop.NsNsMessageHeader{
+			SenderID:   interop.NetID(localNetID),
+			ReceiverID: interop.NetID(forwardingNetID),
+		},
+		PHYPayload: interop.Buffer{0x60, 0x01, 0x02, 0x03, 0x04},
+		DLMetaData: &interop.DLMetaData{
+			DLFreq1:    float64Ptr(868.1),
+			DLFreq2:    float64Ptr(869.525),
+			RXDelay1:   intPtr(1),
+			ClassMode:  "A",
+			DataRate1:  intPtr(5),
+			DataRate2:  intPtr(0),
+			FNSULToken: interop.Buffer{0x03, 0x04},
+			GWInfo: []interop.GWInfoElement{
+				{
+					ULToken: interop.Buffer{0x01, 0x02},
+				},
+			},
+			HiPriorityFlag: true,
+		},
+	})
+
+	_, err = target.Schedule(ctx, newDownlink(ttnpb.Class_CLASS_B))
+	a.So(err, should.HaveSameErrorDefinitionAs, errInteropDownlinkClass)
+	a.So(reqs, should.HaveLength, 1)
+}
+
+func TestInteropDownlinkPaths(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	token := test.Must(encodeInteropUplinkToken(&interopUplinkToken{
+		NetID:   types.NetID{0x00, 0x00, 0x42},
+		BandID:  band.EU_863_870,
+		ULToken: []byte{0x01, 0x02},
+	})).([]byte)
+	parsed, err := parseInteropUplinkToken(token)
+	if a.So(err, should.BeNil) {
+		a.So(parsed.ULToken, should.Resemble, []byte{0x01, 0x02})
+	}
+	_, err = parseInteropUplinkToken([]byte(`{"n":"000042","b":"EU_863_870","u":"AQI="}`))
+	a.So(err, should.HaveSameErrorDefinitionAs, errInteropUplinkToken)
+
+	paths := downlinkPathsFromMetadata(&ttnpb.MACState_UplinkMessage_TxSettings{
+		DataRate: band.EU_863_870_RP2_V1_0_3.DataRates[ttnpb.DataRateIndex_DATA_RATE_5].Rate,
+	}, []*ttnpb.MACState_UplinkMessage_RxMetadata{
+		{
+			GatewayIds:             cluster.InteropGatewayID,
+			UplinkToken:            token,
+			DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NONE,
+		},
+		{
+			// A gateway that is named like the interop pseudo gateway is not a passive roaming downlink path.
+			GatewayIds:             &ttnpb.GatewayIdentifiers{GatewayId: cluster.InteropGatewayID.GatewayId},
+			UplinkToken:            []byte{0x03, 0x04},
+			DownlinkPathConstraint: ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NONE,
+		},
+	})
+	if a.So(paths, should.HaveLength, 2) {
+		for _, path := range paths {
+			a.So(path.interop, should.Equal, bytes.Equal(path.GetUplinkToken(), token))
+		}
+	}
+}
+
+func TestDeviceProfileFromEndDevice(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	profile, err := deviceProfileFromEndDevice(&ttnpb.EndDevice{
+		LorawanVersion: ttnpb.MACVersion_MAC_V1_0_3,
+		SupportsJoin:   true,
+		SupportsClassC: true,
+	}, band.EU_863_870, &ttnpb.MACSettings{})
+	if a.So(err, should.BeNil) {
+		a.So(profile, should.Resemble, &interop.DeviceProfile{
+			SupportsClassC:    true,
+			MACVersion:        interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+			SupportsJoin:      true,
+			RFRegion:          "EU868",
+			Supports32bitFCnt: true,
+		})
+	}
+
+	_, err = deviceProfileFromEndDevice(&ttnpb.EndDevice{}, "Unknown", &ttnpb.MACSettings{})
+	a.So(err, should.NotBeNil)
+}
+
+func TestHandoverRoamingAgreement(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	var (
+		localNetID    = types.NetID{0x00, 0x00, 0x13}
+		handoverNetID = types.NetID{0x00, 0x00, 0x42}
+		passiveNetID  = types.NetID{0x00, 0x00, 0x43}
+	)
+	prefix := func(netID types.NetID) types.DevAddrPrefix {
+		return types.DevAddrPrefix{
+			DevAddr: test.Must(types.NewDevAddr(netID, nil)).(types.DevAddr),
+			Length:  uint8(32 - types.NwkAddrBits(netID)),
+		}
+	}
+	devAddr := func(netID types.NetID) []byte {
+		devAddr := prefix(netID).DevAddr
+		devAddr[3] = 0x01
+		return devAddr.Bytes()
+	}
+	ns := &NetworkServer{
+		netID:           localNetID,
+		devAddrPrefixes: makeDevAddrPrefixesFunc(prefix(localNetID)),
+		interopClient: MockInteropClient{
+			RoamingAgreementsFunc: func() []interop.RoamingAgreement {
+				return []interop.RoamingAgreement{
+					{
+						NetID:           handoverNetID,
+						HandoverRoaming: true,
+					},
+					{
+						NetID:                 passiveNetID,
+						PassiveRoamingProfile: interop.PassiveRoamingStateless,
+					},
+				}
+			},
+		},
+	}
+
+	_, ok := ns.handoverRoamingAgreement(ctx, &ttnpb.EndDevice{})
+	a.So(ok, should.BeFalse)
+
+	_, ok = ns.handoverRoamingAgreement(ctx, &ttnpb.EndDevice{
+		Session: &ttnpb.Session{DevAddr: devAddr(localNetID)},
+	})
+	a.So(ok, should.BeFalse)
+
+	agreement, ok := ns.handoverRoamingAgreement(ctx, &ttnpb.EndDevice{
+		Session: &ttnpb.Session{DevAddr: devAddr(handoverNetID)},
+	})
+	if a.So(ok, should.BeTrue) {
+		a.So(agreement.NetID, should.Equal, handoverNetID)
+	}
+
+	_, ok = ns.handoverRoamingAgreement(ctx, &ttnpb.EndDevice{
+		Session: &ttnpb.Session{DevAddr: devAddr(passiveNetID)},
+	})
+	a.So(ok, should.BeFalse)
+}
+
+// interopLoopbackClient routes the interop requests of a Network Server to the interop handlers of its roaming
+// partners, as the interop server of the partners would.
+type interopLoopbackClient struct {
+	netID      types.NetID
+	agreements []interop.RoamingAgreement
+	peers      map[types.NetID]*NetworkServer
+}
+
+var _ InteropClient = (*interopLoopbackClient)(nil)
+
+func (c *interopLoopbackClient) header(receiverID types.NetID, typ interop.MessageType) interop.NsNsMessageHeader {
+	return interop.NsNsMessageHeader{
+		MessageHeader: interop.MessageHeader{
+			ProtocolVersion: interop.ProtocolV1_1,
+			MessageType:     typ,
+		},
+		SenderID:   interop.NetID(c.netID),
+		ReceiverID: interop.NetID(receiverID),
+	}
+}
+
+func (c *interopLoopbackClient) peer(ctx context.Context, header interop.NsNsMessageHeader) (context.Context, *NetworkServer, error) {
+	peer, ok := c.peers[types.NetID(header.ReceiverID)]
+	if !ok {
+		return nil, nil, interop.ErrUnknownReceiver.New()
+	}
+	return interop.NewContextWithNetworkServerAuthInfo(ctx, &interop.NetworkServerAuthInfo{
+		NetID: c.netID,
+	}), peer, nil
+}
+
+// HandleJoinRequest panics.
+func (*interopLoopbackClient) HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	panic("HandleJoinRequest must not be called")
+}
+
+// RoamingAgreement implements InteropClient.
+func (c *interopLoopbackClient) RoamingAgreement(netID types.NetID) (interop.RoamingAgreement, bool) {
+	for _, agreement := range c.agreements {
+		if agreement.NetID.Equal(netID) {
+			return agreement, true
+		}
+	}
+	return interop.RoamingAgreement{}, false
+}
+
+// RoamingAgreements implements InteropClient.
+func (c *interopLoopbackClient) RoamingAgreements() []interop.RoamingAgreement {
+	return c.agreements
+}
+
+// XmitDataRequest sends uplink messages to the home Network Server and downlink messages to the serving Network
+// Server.
+func (c *interopLoopbackClient) XmitDataRequest(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	ctx, peer, err := c.peer(ctx, req.NsNsMessageHeader)
+	if err != nil {
+		return nil, err
+	}
+	if req.DLMetaData != nil {
+		return interopServer{NS: peer}.XmitDataRequest(ctx, req)
+	}
+	return interopHomeServer{NS: peer}.XmitDataRequest(ctx, req)
+}
+
+// ProfileRequest sends the ProfileReq to the home Network Server.
+func (c *interopLoopbackClient) ProfileRequest(ctx context.Context, req *interop.ProfileReq) (*interop.ProfileAns, error) {
+	ctx, peer, err := c.peer(ctx, req.NsNsMessageHeader)
+	if err != nil {
+		return nil, err
+	}
+	return interopHomeServer{NS: peer}.ProfileRequest(ctx, req)
+}
+
+// HRStartRequest sends the HRStartReq to the home Network Server.
+func (c *interopLoopbackClient) HRStartRequest(ctx context.Context, req *interop.HRStartReq) (*interop.HRStartAns, error) {
+	ctx, peer, err := c.peer(ctx, req.NsNsMessageHeader)
+	if err != nil {
+		return nil, err
+	}
+	return interopHomeServer{NS: peer}.HRStartRequest(ctx, req)
+}
+
+func TestHandoverRoamingLoopback(t *testing.T) {
+	var (
+		homeNetID    = types.NetID{0x00, 0x00, 0x13}
+		servingNetID = types.NetID{0x00, 0x00, 0x42}
+	)
+	homeClient := &interopLoopbackClient{
+		netID: homeNetID,
+		agreements: []interop.RoamingAgreement{
+			{
+				NetID:           servingNetID,
+				HandoverRoaming: true,
+			},
+		},
+	}
+	servingClient := &interopLoopbackClient{
+		netID: servingNetID,
+		agreements: []interop.RoamingAgreement{
+			{
+				NetID:           homeNetID,
+				HandoverRoaming: true,
+			},
+		},
+	}
+
+	test.RunTest(t, test.TestConfig{
+		Func: func(ctx context.Context, a *assertions.Assertion) {
+			t := test.MustTFromContext(ctx)
+
+			componentConf := component.Config{
+				ServiceBase: config.ServiceBase{
+					FrequencyPlans: config.FrequencyPlansConfig{
+						ConfigSource: "static",
+						Static:       test.StaticFrequencyPlans,
+					},
+				},
+			}
+			homeConf := DefaultConfig
+			homeConf.NetID = homeNetID
+			home, ctx, env, stop := StartTest(ctx, TestConfig{
+				NetworkServer: homeConf,
+				TaskStarter: StartTaskExclude(
+					DownlinkProcessTaskName,
+					DownlinkDispatchTaskName,
+				),
+				Component: componentConf,
+			})
+			defer stop()
+			go LogEvents(t, env.Events)
+
+			// StartTest holds the default events pubsub until it is stopped, so the serving Network Server is started
+			// directly. Its events are published to the events channel of the home Network Server.
+			servingConf := DefaultConfig
+			servingConf.NetID = servingNetID
+			for _, newFn := range []func(context.Context) func(){
+				func(ctx context.Context) (closeFn func()) {
+					servingConf.Devices, closeFn = NewDeviceRegistry(ctx)
+					return closeFn
+				},
+				func(ctx context.Context) (closeFn func()) {
+					servingConf.ApplicationUplinkQueue.Queue, closeFn = NewApplicationUplinkQueue(ctx)
+					return closeFn
+				},
+				func(ctx context.Context) (closeFn func()) {
+					servingConf.DownlinkTaskQueue.Queue, closeFn = NewDownlinkTaskQueue(ctx)
+					return closeFn
+				},
+				func(ctx context.Context) (closeFn func()) {
+					servingConf.UplinkDeduplicator, closeFn = NewUplinkDeduplicator(ctx)
+					return closeFn
+				},
+				func(ctx context.Context) (closeFn func()) {
+					servingConf.ScheduledDownlinkMatcher, closeFn = NewScheduledDownlinkMatcher(ctx)
+					return closeFn
+				},
+			} {
+				if closeFn := newFn(ctx); closeFn != nil {
+					defer closeFn()
+				}
+			}
+			serving := test.Must(New(
+				componenttest.NewComponent(t, &componentConf,
+					component.WithTaskStarter(StartTaskExclude(
+						DownlinkProcessTaskName,
+						DownlinkDispatchTaskName,
+					)),
+				),
+				&servingConf,
+			)).(*NetworkServer)
+			componenttest.StartComponent(t, serving.Component)
+			defer serving.Close()
+
+			home.interopClient = homeClient
+			serving.interopClient = servingClient
+			homeClient.peers = map[types.NetID]*NetworkServer{servingNetID: serving}
+			servingClient.peers = map[types.NetID]*NetworkServer{homeNetID: home}
+
+			dev, ctx := MustCreateDevice(ctx, env.Devices, MakeOTAAEndDevice())
+
+			_, err := servingClient.ProfileRequest(ctx, &interop.ProfileReq{
+				NsNsMessageHeader: servingClient.header(homeNetID, interop.MessageTypeProfileReq),
+				DevEUI:            interop.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+			})
+			a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrUnknownDevEUI)
+
+			profileAns, err := servingClient.ProfileRequest(ctx, &interop.ProfileReq{
+				NsNsMessageHeader: servingClient.header(homeNetID, interop.MessageTypeProfileReq),
+				DevEUI:            interop.EUI64(test.DefaultDevEUI),
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(profileAns.RoamingActivationType, should.Equal, interop.RoamingActivationHandover)
+			if a.So(profileAns.DeviceProfile, should.NotBeNil) {
+				a.So(profileAns.DeviceProfile.SupportsJoin, should.BeTrue)
+				a.So(profileAns.DeviceProfile.RFRegion, should.Equal, interop.RFRegion("EU868"))
+			}
+
+			devAddr := test.Must(types.NewDevAddr(servingNetID, nil)).(types.DevAddr)
+			devAddr[3] = 0x01
+			joinReq := test.Must(lorawan.MarshalMessage(&ttnpb.Message{
+				MHdr: &ttnpb.MHDR{
+					MType: ttnpb.MType_JOIN_REQUEST,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_JoinRequestPayload{
+					JoinRequestPayload: &ttnpb.JoinRequestPayload{
+						JoinEui:  test.DefaultJoinEUI.Bytes(),
+						DevEui:   test.DefaultDevEUI.Bytes(),
+						DevNonce: test.DefaultDevNonce.Bytes(),
+					},
+				},
+				Mic: []byte{0x42, 0x42, 0x42, 0x42},
+			})).([]byte)
+			joinResp := &ttnpb.JoinResponse{
+				RawPayload: bytes.Repeat([]byte{0x42}, 33),
+				SessionKeys: test.MakeSessionKeys(
+					test.SessionKeysOptions.WithDefaultSessionKeyID(),
+					test.SessionKeysOptions.WithDefaultAppSKey(),
+					test.SessionKeysOptions.WithDefaultNwkKeys(dev.LorawanVersion),
+				),
+				Lifetime:       ttnpb.ProtoDurationPtr(time.Hour),
+				CorrelationIds: []string{"NsJs-1", "NsJs-2"},
+			}
+			type hrStartResult struct {
+				ans *interop.HRStartAns
+				err error
+			}
+			hrStartCh := make(chan hrStartResult, 1)
+			go func() {
+				ans, err := servingClient.HRStartRequest(ctx, &interop.HRStartReq{
+					NsNsMessageHeader: servingClient.header(homeNetID, interop.MessageTypeHRStartReq),
+					MACVersion:        interop.MACVersion(dev.LorawanVersion),
+					PHYPayload:        joinReq,
+					DevAddr:           interop.DevAddr(devAddr),
+					ULMetaData: interop.ULMetaData{
+						RecvTime: interop.ISO8601Time(time.Now()),
+						RFRegion: interop.RFRegion("EU868"),
+					},
+					DLSettings: interop.Buffer{0x00},
+					RxDelay:    ttnpb.RxDelay_RX_DELAY_5,
+				})
+				hrStartCh <- hrStartResult{ans: ans, err: err}
+			}()
+			if !a.So(env.AssertNsJsJoin(ctx,
+				func(ctx, reqCtx context.Context, ids cluster.EntityIdentifiers) bool {
+					return a.So(ids, should.BeNil)
+				},
+				func(ctx, reqCtx context.Context, req *ttnpb.JoinRequest) bool {
+					return test.AllTrue(
+						a.So(req.DevAddr, should.Resemble, devAddr.Bytes()),
+						a.So(req.NetId, should.Resemble, servingNetID.Bytes()),
+						a.So(req.RawPayload, should.Resemble, joinReq),
+					)
+				},
+				joinResp, nil,
+			), should.BeTrue) {
+				t.FailNow()
+			}
+			select {
+			case <-ctx.Done():
+				t.Fatal("Timed out while waiting for HRStartAns")
+			case res := <-hrStartCh:
+				if !a.So(res.err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(res.ans.PHYPayload, should.Resemble, interop.Buffer(joinResp.RawPayload))
+				a.So(res.ans.DevEUI, should.Resemble, (*interop.EUI64)(&test.DefaultDevEUI))
+				for _, k := range []struct {
+					envelope *interop.KeyEnvelope
+					key      types.AES128Key
+				}{
+					{res.ans.FNwkSIntKey, test.DefaultFNwkSIntKey},
+					{res.ans.SNwkSIntKey, test.DefaultSNwkSIntKey},
+					{res.ans.NwkSEncKey, test.DefaultNwkSEncKey},
+				} {
+					key, err := cryptoutil.UnwrapAES128Key(ctx, (*ttnpb.KeyEnvelope)(k.envelope), serving.KeyVault)
+					if a.So(err, should.BeNil) {
+						a.So(key, should.Resemble, k.key)
+					}
+				}
+			}
+			if !a.So(env.AssertNsAsHandleUplink(ctx, dev.Ids.ApplicationIds, func(ctx context.Context, ups ...*ttnpb.ApplicationUp) bool {
+				_, a := test.MustNewTFromContext(ctx)
+				return a.So(ups, should.HaveLength, 1) && test.AllTrue(
+					a.So(ups[0].EndDeviceIds.DevAddr, should.Resemble, devAddr.Bytes()),
+					a.So(ups[0].GetJoinAccept().GetAppSKey(), should.Resemble, test.DefaultAppSKeyEnvelope),
+					a.So(ups[0].GetJoinAccept().GetSessionKeyId(), should.Resemble, test.DefaultSessionKeyID),
+				)
+			}, nil), should.BeTrue) {
+				t.FailNow()
+			}
+
+			xmitUplink := func(fCnt uint32) (*interop.XmitDataAns, error) {
+				var (
+					devEUI   = interop.EUI64(test.DefaultDevEUI)
+					devAddr  = interop.DevAddr(devAddr)
+					fPort    = uint8(1)
+					dataRate = 5
+					ulFreq   = 868.1
+				)
+				return servingClient.XmitDataRequest(ctx, &interop.XmitDataReq{
+					NsNsMessageHeader: servingClient.header(homeNetID, interop.MessageTypeXmitDataReq),
+					FRMPayload:        interop.Buffer{0x01, 0x02, 0x03},
+					ULMetaData: &interop.ULMetaData{
+						DevEUI:   &devEUI,
+						DevAddr:  &devAddr,
+						FPort:    &fPort,
+						FCntUp:   &fCnt,
+						DataRate: &dataRate,
+						ULFreq:   &ulFreq,
+						RecvTime: interop.ISO8601Time(time.Now()),
+						RFRegion: interop.RFRegion("EU868"),
+					},
+				})
+			}
+			assertApplicationUplink := func(fCnt uint32) bool {
+				return env.AssertNsAsHandleUplink(ctx, dev.Ids.ApplicationIds, func(ctx context.Context, ups ...*ttnpb.ApplicationUp) bool {
+					_, a := test.MustNewTFromContext(ctx)
+					return a.So(ups, should.HaveLength, 1) && test.AllTrue(
+						a.So(ups[0].GetUplinkMessage().GetFCnt(), should.Equal, fCnt),
+						a.So(ups[0].GetUplinkMessage().GetFPort(), should.Equal, 1),
+						a.So(ups[0].GetUplinkMessage().GetFrmPayload(), should.Resemble, []byte{0x01, 0x02, 0x03}),
+						a.So(ups[0].GetUplinkMessage().GetSessionKeyId(), should.Resemble, test.DefaultSessionKeyID),
+						a.So(ups[0].GetUplinkMessage().GetSettings().GetFrequency(), should.Equal, 868100000),
+					)
+				}, nil)
+			}
+
+			_, err = xmitUplink(0)
+			if !a.So(err, should.BeNil) || !a.So(assertApplicationUplink(0), should.BeTrue) {
+				t.FailNow()
+			}
+			_, err = xmitUplink(0)
+			a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrFrameReplayed)
+
+			_, err = xmitUplink(1)
+			if !a.So(err, should.BeNil) || !a.So(assertApplicationUplink(1), should.BeTrue) {
+				t.FailNow()
+			}
+			_, err = xmitUplink(1)
+			a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrFrameReplayed)
+			_, err = xmitUplink(0)
+			a.So(err, should.HaveSameErrorDefinitionAs, interop.ErrFrameReplayed)
+
+			stored, _, err := env.Devices.GetByID(ctx, dev.Ids.ApplicationIds, dev.Ids.DeviceId, []string{
+				"mac_state.recent_uplinks",
+				"session.last_f_cnt_up",
+			})
+			if a.So(err, should.BeNil) {
+				a.So(stored.Session.LastFCntUp, should.Equal, 1)
+				a.So(stored.MacState.RecentUplinks, should.HaveLength, 2)
+			}
+		},
+	})
+}
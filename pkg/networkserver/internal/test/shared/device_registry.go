@@ -179,6 +179,17 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 			return false
 		}
 
+		stored, storedCtx, err = reg.GetByDevEUI(ctx, types.MustEUI64(pb.Ids.DevEui).OrZero(), ttnpb.EndDeviceFieldPathsTopLevel)
+		if !test.AllTrue(
+			a.So(err, should.NotBeNil),
+			a.So(errors.IsNotFound(err), should.BeTrue),
+			a.So(storedCtx, should.HaveParentContextOrEqual, ctx),
+			a.So(stored, should.BeNil),
+		) {
+			t.Error("GetByDevEUI assertion failed with empty registry")
+			return false
+		}
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.Ids.ApplicationIds, pb.Ids.DeviceId, ttnpb.EndDeviceFieldPathsTopLevel,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
@@ -251,6 +262,17 @@ func handleDeviceRegistryTest(ctx context.Context, reg DeviceRegistry) {
 		}
 		ctx = storedCtx
 
+		stored, storedCtx, err = reg.GetByDevEUI(ctx, types.MustEUI64(pb.Ids.DevEui).OrZero(), ttnpb.EndDeviceFieldPathsTopLevel)
+		if !test.AllTrue(
+			a.So(err, should.BeNil) || a.So(errors.Stack(err), should.BeEmpty),
+			a.So(storedCtx, should.HaveParentContextOrEqual, ctx),
+			a.So(stored, should.Resemble, pb),
+		) {
+			t.Error("GetByDevEUI assertion failed with non-empty registry")
+			return false
+		}
+		ctx = storedCtx
+
 		stored, storedCtx, err = reg.SetByID(ctx, pb.Ids.ApplicationIds, pb.Ids.DeviceId, fields,
 			func(storedCtx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				a.So(storedCtx, should.HaveParentContextOrEqual, ctx)
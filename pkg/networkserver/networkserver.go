@@ -107,6 +107,9 @@ type DownlinkPriorities struct {
 // InteropClient is a client, which Network Server can use for interoperability.
 type InteropClient interface {
 	HandleJoinRequest(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	RoamingAgreement(types.NetID) (interop.RoamingAgreement, bool)
+	RoamingAgreements() []interop.RoamingAgreement
+	XmitDataRequest(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // NetworkServer implements the Network Server component.
@@ -234,7 +237,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		applicationUplinks:       conf.ApplicationUplinkQueue.Queue,
 		deduplicationWindow:      makeWindowDurationFunc(conf.DeduplicationWindow),
 		collectionWindow:         makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
-		devices:                  wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
+		devices:                  wrapEndDeviceRegistryWithRetries(wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...), conf.DeviceRegistryRetry),
 		downlinkTasks:            conf.DownlinkTaskQueue.Queue,
 		downlinkPriorities:       downlinkPriorities,
 		defaultMACSettings:       conf.DefaultMACSettings.Parse(),
@@ -313,6 +316,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		})
 	}
 	c.RegisterGRPC(ns)
+	c.RegisterInterop(ns)
 	return ns, nil
 }
 
@@ -321,6 +325,12 @@ func (ns *NetworkServer) Context() context.Context {
 	return ns.ctx
 }
 
+// RegisterInterop registers the passive roaming fNS-sNS and the handover roaming hNS-sNS interop services.
+func (ns *NetworkServer) RegisterInterop(srv *interop.Server) {
+	srv.RegisterServingNS(&interopServer{NS: ns})
+	srv.RegisterHomeNS(&interopHomeServer{NS: ns})
+}
+
 // RegisterServices registers services provided by ns at s.
 func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterGsNsServer(s, ns)
@@ -34,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
@@ -71,6 +72,9 @@ var (
 	ApplyCFList                         = applyCFList
 	DownlinkPathsFromMetadata           = downlinkPathsFromMetadata
 	JoinResponseWithoutKeys             = joinResponseWithoutKeys
+	WrapEndDeviceRegistryWithRetries    = wrapEndDeviceRegistryWithRetries
+
+	WithRetryableDeviceRegistryTransaction = withRetryableDeviceRegistryTransaction
 
 	ErrABPJoinRequest             = errABPJoinRequest
 	ErrApplicationDownlinkTooLong = errApplicationDownlinkTooLong
@@ -299,6 +303,9 @@ var _ InteropClient = MockInteropClient{}
 // MockInteropClient is a mock InteropClient used for testing.
 type MockInteropClient struct {
 	HandleJoinRequestFunc func(context.Context, types.NetID, *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error)
+	RoamingAgreementFunc  func(types.NetID) (interop.RoamingAgreement, bool)
+	RoamingAgreementsFunc func() []interop.RoamingAgreement
+	XmitDataRequestFunc   func(context.Context, *interop.XmitDataReq) (*interop.XmitDataAns, error)
 }
 
 // HandleJoinRequest calls HandleJoinRequestFunc if set and panics otherwise.
@@ -309,6 +316,30 @@ func (m MockInteropClient) HandleJoinRequest(ctx context.Context, netID types.Ne
 	return m.HandleJoinRequestFunc(ctx, netID, req)
 }
 
+// RoamingAgreement calls RoamingAgreementFunc if set and returns no agreement otherwise.
+func (m MockInteropClient) RoamingAgreement(netID types.NetID) (interop.RoamingAgreement, bool) {
+	if m.RoamingAgreementFunc == nil {
+		return interop.RoamingAgreement{}, false
+	}
+	return m.RoamingAgreementFunc(netID)
+}
+
+// RoamingAgreements calls RoamingAgreementsFunc if set and returns no agreements otherwise.
+func (m MockInteropClient) RoamingAgreements() []interop.RoamingAgreement {
+	if m.RoamingAgreementsFunc == nil {
+		return nil
+	}
+	return m.RoamingAgreementsFunc()
+}
+
+// XmitDataRequest calls XmitDataRequestFunc if set and panics otherwise.
+func (m MockInteropClient) XmitDataRequest(ctx context.Context, req *interop.XmitDataReq) (*interop.XmitDataAns, error) {
+	if m.XmitDataRequestFunc == nil {
+		panic("XmitDataRequest called, but not set")
+	}
+	return m.XmitDataRequestFunc(ctx, req)
+}
+
 type InteropClientHandleJoinRequestResponse struct {
 	Response *ttnpb.JoinResponse
 	Error    error
@@ -2514,6 +2545,11 @@ func (m MockDeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.
 	panic("GetByEUI must not be called")
 }
 
+// GetByDevEUI panics.
+func (m MockDeviceRegistry) GetByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	panic("GetByDevEUI must not be called")
+}
+
 // GetByID calls GetByIDFunc if set and panics otherwise.
 func (m MockDeviceRegistry) GetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
 	if m.GetByIDFunc == nil {
@@ -257,6 +257,15 @@ var nsMetrics = &messageMetrics{
 		},
 		[]string{messageType},
 	),
+
+	deviceRegistryConflicts: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "device_registry_conflicts_total",
+			Help:      "Total number of device registry transactions that conflicted with a concurrent modification",
+		},
+		[]string{"result"},
+	),
 }
 
 func init() {
@@ -277,6 +286,8 @@ type messageMetrics struct {
 
 	downlinkAttempted *metrics.ContextualCounterVec
 	downlinkForwarded *metrics.ContextualCounterVec
+
+	deviceRegistryConflicts *metrics.ContextualCounterVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
@@ -293,6 +304,8 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 
 	m.downlinkAttempted.Describe(ch)
 	m.downlinkForwarded.Describe(ch)
+
+	m.deviceRegistryConflicts.Describe(ch)
 }
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
@@ -309,6 +322,8 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 
 	m.downlinkAttempted.Collect(ch)
 	m.downlinkForwarded.Collect(ch)
+
+	m.deviceRegistryConflicts.Collect(ch)
 }
 
 func mTypeLabel(mType ttnpb.MType) string {
@@ -422,3 +437,12 @@ func registerForwardConfirmedDataDownlink(ctx context.Context) {
 func registerForwardJoinAcceptDownlink(ctx context.Context) {
 	nsMetrics.downlinkForwarded.WithLabelValues(ctx, joinAcceptDownlinkMTypeLabel).Inc()
 }
+
+const (
+	deviceRegistryConflictRetried   = "retried"
+	deviceRegistryConflictExhausted = "exhausted"
+)
+
+func registerDeviceRegistryConflict(ctx context.Context, result string) {
+	nsMetrics.deviceRegistryConflicts.WithLabelValues(ctx, result).Inc()
+}
@@ -37,10 +37,14 @@ var (
 	errInvalidIdentifiers   = errors.DefineInvalidArgument("invalid_identifiers", "invalid identifiers")
 	errDuplicateIdentifiers = errors.DefineAlreadyExists("duplicate_identifiers", "duplicate identifiers")
 	errReadOnlyField        = errors.DefineInvalidArgument("read_only_field", "read-only field `{field}`")
+	errDevEUINotFound       = errors.DefineNotFound("dev_eui_not_found", "device with DevEUI `{dev_eui}` not found")
+	errAmbiguousDevEUI      = errors.DefineFailedPrecondition(
+		"ambiguous_dev_eui", "multiple devices with DevEUI `{dev_eui}` found",
+	)
 )
 
 // SchemaVersion is the Network Server database schema version. Bump when a migration is required.
-const SchemaVersion = 1
+const SchemaVersion = 2
 
 // DeviceRegistry is an implementation of networkserver.DeviceRegistry.
 type DeviceRegistry struct {
@@ -67,6 +71,15 @@ func (r *DeviceRegistry) euiKey(joinEUI, devEUI types.EUI64) string {
 	return r.Redis.Key("eui", joinEUI.String(), devEUI.String())
 }
 
+// DevEUIKey returns the key of the set of UIDs of the devices with devEUI.
+func DevEUIKey(r *ttnredis.Client, devEUI types.EUI64) string {
+	return r.Key("dev_eui", devEUI.String())
+}
+
+func (r *DeviceRegistry) devEUIKey(devEUI types.EUI64) string {
+	return DevEUIKey(r.Redis, devEUI)
+}
+
 // GetByID gets device by appID, devID.
 func (r *DeviceRegistry) GetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
 	defer trace.StartRegion(ctx, "get end device by id").End()
@@ -107,6 +120,33 @@ func (r *DeviceRegistry) GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI
 	return pb, ctx, nil
 }
 
+// GetByDevEUI gets device by devEUI.
+// An error is returned if multiple devices, with different JoinEUIs, have the same DevEUI.
+func (r *DeviceRegistry) GetByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	defer trace.StartRegion(ctx, "get end device by dev_eui").End()
+
+	uids, err := r.Redis.SMembers(ctx, r.devEUIKey(devEUI)).Result()
+	if err != nil {
+		return nil, ctx, ttnredis.ConvertError(err)
+	}
+	switch len(uids) {
+	case 0:
+		return nil, ctx, errDevEUINotFound.WithAttributes("dev_eui", devEUI)
+	case 1:
+	default:
+		return nil, ctx, errAmbiguousDevEUI.WithAttributes("dev_eui", devEUI)
+	}
+	pb := &ttnpb.EndDevice{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.uidKey(uids[0])).ScanProto(pb); err != nil {
+		return nil, ctx, err
+	}
+	pb, err = ttnpb.FilterGetEndDevice(pb, paths...)
+	if err != nil {
+		return nil, ctx, err
+	}
+	return pb, ctx, nil
+}
+
 type UplinkMatchSession struct {
 	FNwkSIntKey       *ttnpb.KeyEnvelope
 	ResetsFCnt        *ttnpb.BoolValue
@@ -746,6 +786,9 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationId
 						),
 					)
 				}
+				if stored.Ids.DevEui != nil {
+					p.SRem(ctx, r.devEUIKey(types.MustEUI64(stored.Ids.DevEui).OrZero()), uid)
+				}
 				if stored.PendingSession != nil {
 					removeAddrMapping(ctx, p, PendingAddrKey(r.addrKey(types.MustDevAddr(stored.PendingSession.DevAddr).OrZero())), uid)
 				}
@@ -780,6 +823,9 @@ func (r *DeviceRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationId
 					}
 					p.Set(ctx, ek, uid, 0)
 				}
+				if pb.Ids.DevEui != nil {
+					p.SAdd(ctx, r.devEUIKey(types.MustEUI64(pb.Ids.DevEui).OrZero()), uid)
+				}
 			} else {
 				if ttnpb.HasAnyField(sets, "ids.application_ids.application_id") && pb.Ids.ApplicationIds.ApplicationId != stored.Ids.ApplicationIds.ApplicationId {
 					return errReadOnlyField.WithAttributes("field", "ids.application_ids.application_id")
@@ -17,11 +17,13 @@ package networkserver
 import (
 	"bytes"
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -38,8 +40,12 @@ type UplinkMatch struct {
 }
 
 // DeviceRegistry is a registry, containing devices.
+// The callback passed to SetByID may be invoked more than once, if the transaction is marked as retryable using
+// withRetryableDeviceRegistryTransaction and conflicts with a concurrent modification. Callbacks of such transactions
+// must therefore derive their result from the stored device only, and must not accumulate state across invocations.
 type DeviceRegistry interface {
 	GetByEUI(ctx context.Context, joinEUI, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	GetByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	GetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
 	RangeByUplinkMatches(ctx context.Context, up *ttnpb.UplinkMessage, f func(context.Context, *UplinkMatch) (bool, error)) error
 	SetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
@@ -95,6 +101,18 @@ func (w replacedEndDeviceFieldRegistryWrapper) GetByEUI(ctx context.Context, joi
 	return dev, ctx, nil
 }
 
+func (w replacedEndDeviceFieldRegistryWrapper) GetByDevEUI(ctx context.Context, devEUI types.EUI64, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
+	dev, ctx, err := w.DeviceRegistry.GetByDevEUI(ctx, devEUI, paths)
+	if err != nil || dev == nil {
+		return dev, ctx, err
+	}
+	for _, d := range replaced {
+		d.GetTransform(dev)
+	}
+	return dev, ctx, nil
+}
+
 func (w replacedEndDeviceFieldRegistryWrapper) GetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
 	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
 	dev, ctx, err := w.DeviceRegistry.GetByID(ctx, appID, devID, paths)
@@ -155,6 +173,81 @@ func wrapEndDeviceRegistryWithReplacedFields(r DeviceRegistry, fields ...registr
 	}
 }
 
+type retryableDeviceRegistryTransactionKeyType struct{}
+
+var retryableDeviceRegistryTransactionKey retryableDeviceRegistryTransactionKeyType
+
+// withRetryableDeviceRegistryTransaction marks the device registry transactions performed with the returned context
+// as retryable on conflicts. The callback of such transactions must have no side effects other than the returned
+// device, since it is invoked again on every attempt.
+func withRetryableDeviceRegistryTransaction(ctx context.Context) context.Context {
+	return withConditionallyRetryableDeviceRegistryTransaction(ctx, func() bool { return true })
+}
+
+// withConditionallyRetryableDeviceRegistryTransaction is like withRetryableDeviceRegistryTransaction, but a conflicting
+// transaction is only retried if retryable returns true after the conflict. This allows callbacks that may have side
+// effects, such as the transmission of a downlink message, to be retried as long as these side effects did not occur.
+func withConditionallyRetryableDeviceRegistryTransaction(ctx context.Context, retryable func() bool) context.Context {
+	return context.WithValue(ctx, retryableDeviceRegistryTransactionKey, retryable)
+}
+
+func deviceRegistryTransactionRetryable(ctx context.Context) func() bool {
+	retryable, _ := ctx.Value(retryableDeviceRegistryTransactionKey).(func() bool)
+	return retryable
+}
+
+type retryingDeviceRegistryWrapper struct {
+	DeviceRegistry
+	maxAttempts int
+	backoff     time.Duration
+	jitter      float64
+}
+
+// SetByID calls SetByID on the wrapped registry. If ctx is marked using withRetryableDeviceRegistryTransaction or
+// withConditionallyRetryableDeviceRegistryTransaction, the transaction is retried if it failed due to a concurrent
+// modification of the device. f is invoked again on every attempt with the freshly stored device.
+func (w retryingDeviceRegistryWrapper) SetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	retryable := deviceRegistryTransactionRetryable(ctx)
+	if retryable == nil {
+		return w.DeviceRegistry.SetByID(ctx, appID, devID, paths, f)
+	}
+	for attempt := 1; ; attempt++ {
+		dev, storedCtx, err := w.DeviceRegistry.SetByID(ctx, appID, devID, paths, f)
+		if err == nil || !errors.IsAborted(err) || !retryable() {
+			return dev, storedCtx, err
+		}
+		if attempt >= w.maxAttempts {
+			registerDeviceRegistryConflict(ctx, deviceRegistryConflictExhausted)
+			return dev, storedCtx, err
+		}
+		registerDeviceRegistryConflict(ctx, deviceRegistryConflictRetried)
+		log.FromContext(ctx).WithError(err).WithField("attempt", attempt).Debug("Device registry transaction conflicted, retry")
+		d := time.Duration(attempt) * w.backoff
+		if float64(d)*w.jitter >= 1 {
+			d = random.Jitter(d, w.jitter)
+		}
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func wrapEndDeviceRegistryWithRetries(r DeviceRegistry, conf DeviceRegistryRetryConfig) DeviceRegistry {
+	if conf.MaxAttempts <= 1 {
+		return r
+	}
+	return retryingDeviceRegistryWrapper{
+		DeviceRegistry: r,
+		maxAttempts:    conf.MaxAttempts,
+		backoff:        conf.Backoff,
+		jitter:         conf.Jitter,
+	}
+}
+
 var replacedEndDeviceFields = []registry.ReplacedEndDeviceField{
 	{
 		Old:          "mac_state.current_parameters.adr_ack_delay",
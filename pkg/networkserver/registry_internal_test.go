@@ -0,0 +1,191 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var errTestConflict = errors.DefineAborted("test_conflict", "test conflict")
+
+func TestRetryingDeviceRegistry(t *testing.T) {
+	t.Parallel()
+
+	appID := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	conf := DeviceRegistryRetryConfig{
+		MaxAttempts: 3,
+		Backoff:     test.Delay,
+		Jitter:      0.5,
+	}
+
+	// conflictingRegistry fails the first conflicts transactions with a conflict and invokes f on every attempt.
+	conflictingRegistry := func(conflicts int, attempts *int) DeviceRegistry {
+		return MockDeviceRegistry{
+			SetByIDFunc: func(ctx context.Context, _ *ttnpb.ApplicationIdentifiers, _ string, _ []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+				*attempts++
+				dev, _, err := f(ctx, &ttnpb.EndDevice{})
+				if err != nil {
+					return nil, ctx, err
+				}
+				if *attempts <= conflicts {
+					return nil, ctx, errTestConflict.New()
+				}
+				return dev, ctx, nil
+			},
+		}
+	}
+	set := func(ctx context.Context, r DeviceRegistry, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+		dev, _, err := r.SetByID(withRetryableDeviceRegistryTransaction(ctx), appID, "test-dev", []string{"ids"}, f)
+		return dev, err
+	}
+
+	for _, tc := range []struct {
+		Name             string
+		Conflicts        int
+		Error            error
+		ExpectedAttempts int
+		ErrorAssertion   func(*assertions.Assertion, error) bool
+	}{
+		{
+			Name:             "No conflict",
+			ExpectedAttempts: 1,
+		},
+		{
+			Name:             "Conflict once",
+			Conflicts:        1,
+			ExpectedAttempts: 2,
+		},
+		{
+			Name:             "Attempts exhausted",
+			Conflicts:        3,
+			ExpectedAttempts: 3,
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.HaveSameErrorDefinitionAs, errTestConflict)
+			},
+		},
+		{
+			Name:             "Callback error",
+			Conflicts:        1,
+			Error:            errOutdatedData.New(),
+			ExpectedAttempts: 1,
+			ErrorAssertion: func(a *assertions.Assertion, err error) bool {
+				return a.So(err, should.HaveSameErrorDefinitionAs, errOutdatedData)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			var attempts, invocations int
+			dev, err := set(test.Context(), wrapEndDeviceRegistryWithRetries(conflictingRegistry(tc.Conflicts, &attempts), conf),
+				func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+					invocations++
+					if tc.Error != nil {
+						return nil, nil, tc.Error
+					}
+					stored.FrequencyPlanId = "EU_863_870"
+					return stored, []string{"frequency_plan_id"}, nil
+				},
+			)
+			a.So(attempts, should.Equal, tc.ExpectedAttempts)
+			a.So(invocations, should.Equal, tc.ExpectedAttempts)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(a, err), should.BeTrue)
+				a.So(dev, should.BeNil)
+				return
+			}
+			if a.So(err, should.BeNil) && a.So(dev, should.NotBeNil) {
+				a.So(dev.FrequencyPlanId, should.Equal, "EU_863_870")
+			}
+		})
+	}
+
+	t.Run("Context canceled", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		ctx, cancel := context.WithCancel(test.Context())
+		defer cancel()
+		var attempts int
+		_, err := set(ctx, wrapEndDeviceRegistryWithRetries(conflictingRegistry(3, &attempts), DeviceRegistryRetryConfig{
+			MaxAttempts: 3,
+			Backoff:     time.Hour,
+		}), func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			cancel()
+			return nil, nil, nil
+		})
+		a.So(errors.IsCanceled(err), should.BeTrue)
+		a.So(attempts, should.Equal, 1)
+	})
+
+	t.Run("Retries disabled", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		var attempts int
+		_, err := set(test.Context(), wrapEndDeviceRegistryWithRetries(conflictingRegistry(1, &attempts), DeviceRegistryRetryConfig{
+			MaxAttempts: 1,
+		}), func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			return stored, nil, nil
+		})
+		a.So(err, should.HaveSameErrorDefinitionAs, errTestConflict)
+		a.So(attempts, should.Equal, 1)
+	})
+
+	t.Run("Not retryable", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		var attempts, invocations int
+		_, _, err := wrapEndDeviceRegistryWithRetries(conflictingRegistry(1, &attempts), conf).SetByID(test.Context(), appID, "test-dev", []string{"ids"},
+			func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				invocations++
+				return stored, nil, nil
+			},
+		)
+		a.So(err, should.HaveSameErrorDefinitionAs, errTestConflict)
+		a.So(attempts, should.Equal, 1)
+		a.So(invocations, should.Equal, 1)
+	})
+
+	t.Run("Conditionally retryable", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		var attempts, invocations int
+		var transmitted bool
+		ctx := withConditionallyRetryableDeviceRegistryTransaction(test.Context(), func() bool { return !transmitted })
+		_, _, err := wrapEndDeviceRegistryWithRetries(conflictingRegistry(3, &attempts), conf).SetByID(ctx, appID, "test-dev", []string{"ids"},
+			func(_ context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				invocations++
+				transmitted = invocations == 2
+				return stored, nil, nil
+			},
+		)
+		a.So(err, should.HaveSameErrorDefinitionAs, errTestConflict)
+		a.So(attempts, should.Equal, 2)
+		a.So(invocations, should.Equal, 2)
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestRetryingDeviceRegistryConcurrency(t *testing.T) {
+	a, ctx := test.New(t)
+
+	appID := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	ns, ctx, env, stop := StartTest(ctx, TestConfig{
+		NetworkServer: func() Config {
+			conf := DefaultConfig
+			conf.DeviceRegistryRetry = DeviceRegistryRetryConfig{
+				MaxAttempts: 100,
+				Backoff:     time.Millisecond,
+				Jitter:      0.5,
+			}
+			return conf
+		}(),
+		TaskStarter: StartTaskExclude(
+			DownlinkProcessTaskName,
+			DownlinkDispatchTaskName,
+			ApplicationUplinkDispatchTaskName,
+		),
+		Component: component.Config{
+			ServiceBase: config.ServiceBase{
+				FrequencyPlans: config.FrequencyPlansConfig{
+					ConfigSource: "static",
+					Static:       test.StaticFrequencyPlans,
+				},
+			},
+		},
+	})
+	defer stop()
+
+	ns.AddContextFiller(func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, &rights.Rights{
+			ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+				unique.ID(ctx, appID): {
+					Rights: []ttnpb.Right{
+						ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+						ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+					},
+				},
+			}),
+		})
+	})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-env.Cluster.GetPeer:
+				req.Response <- test.ClusterGetPeerResponse{}
+			case req := <-env.Cluster.Auth:
+				req.Response <- &grpc.EmptyCallOption{}
+			case req := <-env.Events:
+				close(req.Response)
+			}
+		}
+	}()
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appID,
+		DeviceId:       "test-dev",
+		JoinEui:        types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+		DevEui:         types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+		DevAddr:        types.DevAddr{0x42, 0x42, 0x42, 0x42}.Bytes(),
+	}
+	created, _ := MustCreateDevice(ctx, env.Devices, &ttnpb.EndDevice{
+		Ids:               ids,
+		FrequencyPlanId:   test.EUFrequencyPlanID,
+		LorawanVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+		LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+		Session: &ttnpb.Session{
+			DevAddr: ids.DevAddr,
+			Keys:    MakeSessionKeys(ttnpb.MACVersion_MAC_V1_0_3, true, true),
+		},
+		MacState: MakeDefaultEU868MACState(ttnpb.Class_CLASS_A, ttnpb.MACVersion_MAC_V1_0_3, ttnpb.PHYVersion_RP001_V1_0_3_REV_A),
+	})
+
+	const (
+		workers    = 4
+		iterations = 16
+	)
+	var wg sync.WaitGroup
+	errCh := make(chan error, (workers+1)*iterations)
+	wg.Add(workers + 1)
+	// Uplink workload, which increments the uplink frame counter.
+	go func() {
+		defer wg.Done()
+		for i := 1; i <= iterations; i++ {
+			up := MakeDataUplink(WithDeviceDataUplinkConfig(created, false, ttnpb.DataRateIndex_DATA_RATE_0, 0, uint32(i))(DataUplinkConfig{
+				FPort:      1,
+				FRMPayload: []byte{byte(i)},
+				RxMetadata: DefaultRxMetadata[:],
+			}))
+			_, err := ttnpb.NewGsNsClient(env.ClientConn).HandleUplink(ctx, up)
+			errCh <- err
+		}
+	}()
+	// Set workload, which updates the MAC settings of the device.
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for j := 1; j <= iterations; j++ {
+				_, err := ttnpb.NewNsEndDeviceRegistryClient(env.ClientConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+					EndDevice: &ttnpb.EndDevice{
+						Ids: ids,
+						MacSettings: &ttnpb.MACSettings{
+							StatusCountPeriodicity: &pbtypes.UInt32Value{Value: uint32(j)},
+						},
+					},
+					FieldMask: ttnpb.FieldMask("mac_settings.status_count_periodicity"),
+				})
+				errCh <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+	for err := range errCh {
+		a.So(err, should.BeNil)
+	}
+
+	dev, _, err := env.Devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{"session", "mac_settings", "mac_state"})
+	if a.So(err, should.BeNil) {
+		a.So(dev.Session.LastFCntUp, should.Equal, iterations)
+		a.So(dev.MacSettings.GetStatusCountPeriodicity().GetValue(), should.Equal, iterations)
+		a.So(dev.MacState.RecentUplinks, should.NotBeEmpty)
+	}
+}
@@ -78,6 +78,15 @@ func WithServerOptions(serverOptions ...grpc.ServerOption) Option {
 	}
 }
 
+// WithTLSPeerCredentials exposes the TLS connection state of connections that are accepted by TLS listeners as peer
+// authentication information. This should only be used for servers that serve dedicated listeners, which request
+// TLS client certificates.
+func WithTLSPeerCredentials() Option {
+	return func(o *options) {
+		o.serverOptions = append(o.serverOptions, grpc.Creds(tlsConnCredentials{}))
+	}
+}
+
 // WithContextFiller sets a context filler
 func WithContextFiller(contextFillers ...fillcontext.Filler) Option {
 	return func(o *options) {
@@ -0,0 +1,142 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcserver_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func makeTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+		Leaf:        cert,
+	}, pool
+}
+
+func TestTLSPeerCredentials(t *testing.T) {
+	cert, pool := makeTestCertificate(t)
+
+	for _, tc := range []struct {
+		Name          string
+		Options       []rpcserver.Option
+		ExpectTLSInfo bool
+	}{
+		{
+			Name: "Default",
+		},
+		{
+			Name:          "WithTLSPeerCredentials",
+			Options:       []rpcserver.Option{rpcserver.WithTLSPeerCredentials()},
+			ExpectTLSInfo: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			ctx, cancel := context.WithCancel(test.Context())
+			defer cancel()
+
+			server := rpcserver.New(ctx, tc.Options...)
+			mock := &mockServer{}
+			ttnpb.RegisterAppAsServer(server.Server, mock)
+
+			lis, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+				Certificates: []tls.Certificate{cert},
+				ClientAuth:   tls.RequestClientCert,
+				NextProtos:   []string{"h2"},
+			})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			go server.Serve(lis)
+			defer server.Stop()
+
+			conn, err := grpc.DialContext(ctx, lis.Addr().String(),
+				grpc.WithBlock(),
+				grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+					Certificates: []tls.Certificate{cert},
+					RootCAs:      pool,
+				})),
+			)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			defer conn.Close()
+
+			_, err = ttnpb.NewAppAsClient(conn).DownlinkQueuePush(ctx, downlinkQueueReq)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			p, ok := peer.FromContext(mock.pushCtx)
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+			if !tc.ExpectTLSInfo {
+				a.So(ok, should.BeFalse)
+				return
+			}
+			if a.So(ok, should.BeTrue) && a.So(tlsInfo.State.PeerCertificates, should.HaveLength, 1) {
+				a.So(tlsInfo.State.PeerCertificates[0].Equal(cert.Leaf), should.BeTrue)
+			}
+		})
+	}
+}
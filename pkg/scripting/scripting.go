@@ -21,10 +21,13 @@ import "time"
 type Options struct {
 	StackDepthLimit int
 	Timeout         time.Duration
+	// MemoryLimit is the memory limit in bytes. It is only enforced by engines that support it.
+	MemoryLimit int
 }
 
 // DefaultOptions are the default Options.
 var DefaultOptions = Options{
 	StackDepthLimit: 32,
 	Timeout:         100 * time.Millisecond,
+	MemoryLimit:     16 << 20,
 }
@@ -0,0 +1,74 @@
+;; engine.wasm is compiled from this module with `wat2wasm engine.wat`.
+(module
+  (type (func (param i32) (result i32)))
+  (type (func (param i32 i32) (result i64)))
+  (memory (export "memory") 1)
+
+  ;; The input is always written at offset 16384.
+  (func (export "alloc") (type 0)
+    i32.const 16384)
+
+  ;; Returns {"x":42}.
+  (func (export "test") (type 1)
+    i64.const 0x100_0000_0008)
+
+  ;; Returns the input.
+  (func (export "echo") (type 1)
+    local.get 0
+    i64.extend_i32_u
+    i64.const 32
+    i64.shl
+    local.get 1
+    i64.extend_i32_u
+    i64.or)
+
+  ;; Returns null.
+  (func (export "null") (type 1)
+    i64.const 0x120_0000_0004)
+
+  (func (export "trap") (type 1)
+    unreachable)
+
+  (func (export "loop") (type 1)
+    loop
+      br 0
+    end
+    unreachable)
+
+  ;; Returns an output that is out of the bounds of the memory.
+  (func (export "outOfBounds") (type 1)
+    i64.const 0x7fff0000_0000_0010)
+
+  ;; Attempts to grow the memory by 64 MiB and returns {"grown":true} or {"grown":false}.
+  (func (export "grow") (type 1)
+    i64.const 0x140_0000_000e
+    i64.const 0x160_0000_000f
+    i32.const 1024
+    memory.grow
+    i32.const -1
+    i32.ne
+    select)
+
+  ;; Calls itself recursively the number of times given by the parameter.
+  (func $recurse (type 0)
+    local.get 0
+    if (result i32)
+      local.get 0
+      i32.const 1
+      i32.sub
+      call $recurse
+    else
+      i32.const 0
+    end)
+
+  ;; Calls $recurse with 100 and returns {"x":42}.
+  (func (export "deep") (type 1)
+    i32.const 100
+    call $recurse
+    drop
+    i64.const 0x100_0000_0008)
+
+  (data (i32.const 256) "{\"x\":42}")
+  (data (i32.const 288) "null")
+  (data (i32.const 320) "{\"grown\":true}")
+  (data (i32.const 352) "{\"grown\":false}"))
@@ -0,0 +1,11 @@
+;; noalloc.wasm is compiled from this module with `wat2wasm noalloc.wat`.
+(module
+  (type (func (param i32) (result i32)))
+  (type (func (param i32 i32) (result i64)))
+  (memory (export "memory") 1)
+
+  ;; Returns {"x":42}.
+  (func (export "test") (type 1)
+    i64.const 0x100_0000_0008)
+
+  (data (i32.const 256) "{\"x\":42}"))
@@ -0,0 +1,383 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm implements a WebAssembly scripting engine.
+//
+// Scripts are WebAssembly modules that are executed in a sandbox. Modules may import WASI (wasi_snapshot_preview1),
+// but they have no access to the file system, the environment, the network or the system clock.
+//
+// The engine exchanges JSON documents with the module through its linear memory. A module must export:
+//
+//   - memory: the linear memory of the module.
+//   - alloc(size i32) i32: allocates size bytes in the linear memory and returns the offset.
+//   - Entrypoints with the signature (offset i32, length i32) i64. The input is the JSON encoded parameter written at
+//     the given offset. The result packs the offset of the JSON encoded output in the upper 32 bits and its length in
+//     the lower 32 bits.
+//
+// If the module exports _initialize, it is called after instantiation. Each run uses a new instance of the module, so
+// the module does not need to free memory.
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"runtime/trace"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+)
+
+const (
+	// pageSize is the size of a WebAssembly memory page.
+	pageSize = 64 << 10
+	// cacheSize is the number of compiled modules that are cached.
+	cacheSize = 256
+
+	memoryExport = "memory"
+	allocExport  = "alloc"
+)
+
+var (
+	errScriptTimeout      = errors.DefineDeadlineExceeded("script_timeout", "script timeout")
+	errScriptInterrupt    = errors.DefineAborted("script_interrupt", "script interrupt")
+	errScript             = errors.DefineAborted("script", "{message}")
+	errNoScriptOutput     = errors.DefineAborted("no_script_output", "no script output")
+	errRuntime            = errors.DefineAborted("runtime", "{message}")
+	errEntrypointNotFound = errors.DefineNotFound("entrypoint_not_found", "entrypoint `{entrypoint}` not found")
+	errCompile            = errors.DefineInvalidArgument("compile", "compile module: {message}")
+	errExportNotFound     = errors.DefineInvalidArgument("export_not_found", "export `{export}` not found")
+	errInvalidSignature   = errors.DefineInvalidArgument("invalid_signature", "invalid signature of `{export}`")
+	errMemoryAccess       = errors.DefineAborted("memory_access", "out of bounds memory access")
+	errExit               = errors.DefineAborted("exit", "module exited with code `{code}`")
+	errStackDepthLimit    = errors.DefineResourceExhausted("stack_depth_limit", "stack depth limit of `{limit}` exceeded")
+)
+
+type wasm struct {
+	options scripting.Options
+	runtime wazero.Runtime
+
+	mu    sync.Mutex
+	cache gcache.Cache
+}
+
+// New returns a new WebAssembly scripting engine.
+// The memory of the modules is limited by Options.MemoryLimit, the execution time by Options.Timeout and the depth of
+// nested function calls by Options.StackDepthLimit.
+func New(options scripting.Options) scripting.AheadOfTimeEngine {
+	ctx := context.Background()
+	config := wazero.NewRuntimeConfig().
+		WithCloseOnContextDone(true).
+		WithCoreFeatures(api.CoreFeaturesV2)
+	if options.MemoryLimit > 0 {
+		config = config.WithMemoryLimitPages(uint32((options.MemoryLimit + pageSize - 1) / pageSize))
+	}
+	r := wazero.NewRuntimeWithConfig(ctx, config)
+	wasi_snapshot_preview1.MustInstantiate(ctx, r)
+	return &wasm{
+		options: options,
+		runtime: r,
+		cache: gcache.New(cacheSize).LRU().
+			EvictedFunc(func(_, value interface{}) {
+				value.(*compiledModule).close()
+			}).
+			Build(),
+	}
+}
+
+type stackDepthKeyType struct{}
+
+var stackDepthKey stackDepthKeyType
+
+// stackDepthListener limits the depth of nested function calls of a run. The depth is counted in the context of the
+// run, which holds a *int. As listeners cannot return errors, exceeding the limit panics, and the runtime returns the
+// panic as error of the call.
+type stackDepthListener struct {
+	limit int
+}
+
+// NewListener implements experimental.FunctionListenerFactory.
+func (l stackDepthListener) NewListener(api.FunctionDefinition) experimental.FunctionListener {
+	return l
+}
+
+// Before implements experimental.FunctionListener.
+func (l stackDepthListener) Before(ctx context.Context, _ api.Module, _ api.FunctionDefinition, _ []uint64) context.Context {
+	depth, ok := ctx.Value(stackDepthKey).(*int)
+	if !ok {
+		return ctx
+	}
+	if *depth++; *depth > l.limit {
+		panic(errStackDepthLimit.WithAttributes("limit", l.limit))
+	}
+	return ctx
+}
+
+// After implements experimental.FunctionListener.
+func (stackDepthListener) After(ctx context.Context, _ api.Module, _ api.FunctionDefinition, _ error, _ []uint64) {
+	if depth, ok := ctx.Value(stackDepthKey).(*int); ok {
+		*depth--
+	}
+}
+
+// compiledModule is a cached compiled module.
+// The module is closed when it is evicted from the cache. Instantiation holds the read lock, so that the module is
+// not closed while it is being instantiated. Instances remain valid after the module is closed.
+type compiledModule struct {
+	mu       sync.RWMutex
+	compiled wazero.CompiledModule
+	closed   bool
+}
+
+func (m *compiledModule) close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.closed = true
+	m.compiled.Close(context.Background()) //nolint:errcheck
+}
+
+// instantiate instantiates the module. It returns nil if the module is closed.
+func (m *compiledModule) instantiate(ctx context.Context, r wazero.Runtime) (api.Module, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.closed {
+		return nil, nil
+	}
+	return r.InstantiateModule(ctx, m.compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions("_initialize"),
+	)
+}
+
+// compile compiles the module, or returns the cached compiled module.
+func (w *wasm) compile(ctx context.Context, key [sha256.Size]byte, module []byte) (m *compiledModule, err error) {
+	if cached, err := w.cache.Get(key); err == nil {
+		return cached.(*compiledModule), nil
+	}
+
+	defer trace.StartRegion(ctx, "compile wasm").End()
+
+	start := time.Now()
+	defer func() {
+		compilationsLatency.Observe(time.Since(start).Seconds())
+		if err != nil {
+			compilations.WithLabelValues("error").Inc()
+		} else {
+			compilations.WithLabelValues("ok").Inc()
+		}
+	}()
+
+	compileCtx := ctx
+	if w.options.StackDepthLimit > 0 {
+		compileCtx = context.WithValue(ctx, experimental.FunctionListenerFactoryKey{}, stackDepthListener{
+			limit: w.options.StackDepthLimit,
+		})
+	}
+	compiled, err := w.runtime.CompileModule(compileCtx, module)
+	if err != nil {
+		return nil, errCompile.WithAttributes("message", err.Error()).WithCause(err)
+	}
+	if err := validateExports(compiled); err != nil {
+		compiled.Close(ctx) //nolint:errcheck
+		return nil, err
+	}
+	m = &compiledModule{compiled: compiled}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if cached, err := w.cache.Get(key); err == nil {
+		// Another goroutine compiled the same module concurrently.
+		compiled.Close(ctx) //nolint:errcheck
+		return cached.(*compiledModule), nil
+	}
+	if err := w.cache.Set(key, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func validateExports(compiled wazero.CompiledModule) error {
+	if _, ok := compiled.ExportedMemories()[memoryExport]; !ok {
+		return errExportNotFound.WithAttributes("export", memoryExport)
+	}
+	alloc, ok := compiled.ExportedFunctions()[allocExport]
+	if !ok {
+		return errExportNotFound.WithAttributes("export", allocExport)
+	}
+	if !hasSignature(alloc, []api.ValueType{api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}) {
+		return errInvalidSignature.WithAttributes("export", allocExport)
+	}
+	return nil
+}
+
+func hasSignature(def api.FunctionDefinition, params, results []api.ValueType) bool {
+	equal := func(a, b []api.ValueType) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+		return true
+	}
+	return equal(def.ParamTypes(), params) && equal(def.ResultTypes(), results)
+}
+
+// Run executes the WebAssembly module and returns the output.
+// The script is the binary WebAssembly module.
+func (w *wasm) Run(ctx context.Context, script, fn string, params ...interface{}) (func(target interface{}) error, error) {
+	module := []byte(script)
+	key := sha256.Sum256(module)
+	return w.run(ctx, key, module, fn, params...)
+}
+
+// Compile compiles the WebAssembly module and returns a function to run it.
+// The script is the binary WebAssembly module.
+func (w *wasm) Compile(ctx context.Context, script string) (run func(context.Context, string, ...interface{}) (func(interface{}) error, error), err error) {
+	module := []byte(script)
+	key := sha256.Sum256(module)
+	if _, err := w.compile(ctx, key, module); err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, fn string, params ...interface{}) (func(interface{}) error, error) {
+		return w.run(ctx, key, module, fn, params...)
+	}, nil
+}
+
+func convertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if exitErr, ok := err.(*sys.ExitError); ok {
+		switch exitErr.ExitCode() {
+		case sys.ExitCodeDeadlineExceeded:
+			return errScriptTimeout.WithCause(err)
+		case sys.ExitCodeContextCanceled:
+			return errScriptInterrupt.WithCause(err)
+		default:
+			return errExit.WithAttributes("code", exitErr.ExitCode()).WithCause(err)
+		}
+	}
+	if ttnErr := (*errors.Error)(nil); errors.As(err, &ttnErr) && errors.Is(ttnErr, errStackDepthLimit) {
+		return ttnErr
+	}
+	if errors.IsDeadlineExceeded(err) {
+		return errScriptTimeout.WithCause(err)
+	}
+	if errors.IsCanceled(err) {
+		return errScriptInterrupt.WithCause(err)
+	}
+	return errScript.WithAttributes("message", err.Error()).WithCause(err)
+}
+
+func (w *wasm) run(ctx context.Context, key [sha256.Size]byte, module []byte, fn string, params ...interface{}) (as func(target interface{}) error, err error) {
+	defer trace.StartRegion(ctx, "run wasm").End()
+
+	start := time.Now()
+	defer func() {
+		runLatency.Observe(time.Since(start).Seconds())
+		if err != nil {
+			runs.WithLabelValues("error").Inc()
+		} else {
+			runs.WithLabelValues("ok").Inc()
+		}
+	}()
+
+	var input interface{}
+	switch len(params) {
+	case 0:
+	case 1:
+		input = params[0]
+	default:
+		input = params
+	}
+	inputBytes, err := json.Marshal(input)
+	if err != nil {
+		return nil, errRuntime.WithAttributes("message", err.Error()).WithCause(err)
+	}
+
+	if w.options.StackDepthLimit > 0 {
+		ctx = context.WithValue(ctx, stackDepthKey, new(int))
+	}
+	if w.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.options.Timeout)
+		defer cancel()
+	}
+
+	var mod api.Module
+	for mod == nil {
+		m, err := w.compile(ctx, key, module)
+		if err != nil {
+			return nil, err
+		}
+		// If the compiled module is evicted from the cache concurrently, the module is compiled again.
+		mod, err = m.instantiate(ctx, w.runtime)
+		if err != nil {
+			return nil, convertError(err)
+		}
+	}
+	defer mod.Close(ctx) //nolint:errcheck
+
+	entrypoint := mod.ExportedFunction(fn)
+	if entrypoint == nil {
+		return nil, errEntrypointNotFound.WithAttributes("entrypoint", fn)
+	}
+	if !hasSignature(entrypoint.Definition(), []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI64}) {
+		return nil, errInvalidSignature.WithAttributes("export", fn)
+	}
+
+	res, err := mod.ExportedFunction(allocExport).Call(ctx, uint64(len(inputBytes)))
+	if err != nil {
+		return nil, convertError(err)
+	}
+	offset := uint32(res[0])
+	memory := mod.ExportedMemory(memoryExport)
+	if !memory.Write(offset, inputBytes) {
+		return nil, errMemoryAccess.New()
+	}
+
+	res, err = entrypoint.Call(ctx, uint64(offset), uint64(len(inputBytes)))
+	if err != nil {
+		return nil, convertError(err)
+	}
+	outputOffset, outputLength := uint32(res[0]>>32), uint32(res[0])
+	view, ok := memory.Read(outputOffset, outputLength)
+	if !ok {
+		return nil, errMemoryAccess.New()
+	}
+	// The memory is released when the module is closed, so the output is copied.
+	output := make([]byte, len(view))
+	copy(output, view)
+
+	return func(target interface{}) error {
+		if len(output) == 0 || string(output) == "null" {
+			return errNoScriptOutput.New()
+		}
+		if err := json.Unmarshal(output, target); err != nil {
+			return errRuntime.WithAttributes("message", err.Error()).WithCause(err)
+		}
+		return nil
+	}, nil
+}
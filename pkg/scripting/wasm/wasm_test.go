@@ -0,0 +1,202 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm_test
+
+import (
+	"os"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting/wasm"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func readModule(t *testing.T, name string) string {
+	t.Helper()
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatalf("Failed to read module: %v", err)
+	}
+	return string(b)
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	e := wasm.New(scripting.DefaultOptions)
+	as, err := e.Run(ctx, readModule(t, "testdata/engine.wasm"), "test")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var output struct {
+		X int `json:"x"`
+	}
+	a.So(as(&output), should.BeNil)
+	a.So(output.X, should.Equal, 42)
+}
+
+func TestRunInput(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	type value struct {
+		Bytes []int `json:"bytes"`
+		FPort int   `json:"fPort"`
+	}
+	e := wasm.New(scripting.DefaultOptions)
+	as, err := e.Run(ctx, readModule(t, "testdata/engine.wasm"), "echo", value{Bytes: []int{1, 2, 3}, FPort: 42})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var output value
+	a.So(as(&output), should.BeNil)
+	a.So(output, should.Resemble, value{Bytes: []int{1, 2, 3}, FPort: 42})
+}
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	e := wasm.New(scripting.DefaultOptions)
+	run, err := e.Compile(ctx, readModule(t, "testdata/engine.wasm"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for i := 0; i < 3; i++ {
+		as, err := run(ctx, "test")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		var output map[string]interface{}
+		a.So(as(&output), should.BeNil)
+		a.So(output, should.Resemble, map[string]interface{}{"x": 42.0})
+	}
+
+	_, err = e.Compile(ctx, "not a module")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = e.Compile(ctx, readModule(t, "testdata/noalloc.wasm"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestRunErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name        string
+		Entrypoint  string
+		RunAssert   func(error) bool
+		ValueAssert func(error) bool
+	}{
+		{
+			Name:       "EntrypointNotFound",
+			Entrypoint: "unknown",
+			RunAssert:  errors.IsNotFound,
+		},
+		{
+			Name:       "InvalidSignature",
+			Entrypoint: "alloc",
+			RunAssert:  errors.IsInvalidArgument,
+		},
+		{
+			Name:       "Trap",
+			Entrypoint: "trap",
+			RunAssert:  errors.IsAborted,
+		},
+		{
+			Name:       "Timeout",
+			Entrypoint: "loop",
+			RunAssert:  errors.IsDeadlineExceeded,
+		},
+		{
+			Name:       "OutOfBounds",
+			Entrypoint: "outOfBounds",
+			RunAssert:  errors.IsAborted,
+		},
+		{
+			Name:        "NoOutput",
+			Entrypoint:  "null",
+			ValueAssert: errors.IsAborted,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+
+			e := wasm.New(scripting.DefaultOptions)
+			as, err := e.Run(ctx, readModule(t, "testdata/engine.wasm"), tc.Entrypoint)
+			if tc.RunAssert != nil {
+				a.So(tc.RunAssert(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var output map[string]interface{}
+			a.So(tc.ValueAssert(as(&output)), should.BeTrue)
+		})
+	}
+}
+
+func TestMemoryLimit(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var output struct {
+		Grown bool `json:"grown"`
+	}
+
+	// The module attempts to grow its memory by 64 MiB, which exceeds the default memory limit.
+	e := wasm.New(scripting.DefaultOptions)
+	as, err := e.Run(ctx, readModule(t, "testdata/engine.wasm"), "grow")
+	if a.So(err, should.BeNil) && a.So(as(&output), should.BeNil) {
+		a.So(output.Grown, should.BeFalse)
+	}
+
+	options := scripting.DefaultOptions
+	options.MemoryLimit = 128 << 20
+	e = wasm.New(options)
+	as, err = e.Run(ctx, readModule(t, "testdata/engine.wasm"), "grow")
+	if a.So(err, should.BeNil) && a.So(as(&output), should.BeNil) {
+		a.So(output.Grown, should.BeTrue)
+	}
+}
+
+func TestStackDepthLimit(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	// The module calls a function recursively 100 times, which exceeds the default stack depth limit.
+	e := wasm.New(scripting.DefaultOptions)
+	_, err := e.Run(ctx, readModule(t, "testdata/engine.wasm"), "deep")
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	options := scripting.DefaultOptions
+	options.StackDepthLimit = 128
+	e = wasm.New(options)
+	as, err := e.Run(ctx, readModule(t, "testdata/engine.wasm"), "deep")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var output struct {
+		X int `json:"x"`
+	}
+	a.So(as(&output), should.BeNil)
+	a.So(output.X, should.Equal, 42)
+}
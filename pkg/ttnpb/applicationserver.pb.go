@@ -69,10 +69,13 @@ type ApplicationLink struct {
 	DefaultFormatters *MessagePayloadFormatters `protobuf:"bytes,3,opt,name=default_formatters,json=defaultFormatters,proto3" json:"default_formatters,omitempty"`
 	// Skip decryption of uplink payloads and encryption of downlink payloads.
 	// Leave empty for the using the Application Server's default setting.
-	SkipPayloadCrypto    *types.BoolValue `protobuf:"bytes,5,opt,name=skip_payload_crypto,json=skipPayloadCrypto,proto3" json:"skip_payload_crypto,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	SkipPayloadCrypto *types.BoolValue `protobuf:"bytes,5,opt,name=skip_payload_crypto,json=skipPayloadCrypto,proto3" json:"skip_payload_crypto,omitempty"`
+	// Policy for downlink messages that are held by the Application Server.
+	// Leave empty for downlink messages to be held without expiry, in the order of their not before time.
+	DownlinkPolicy       *ApplicationDownlinkPolicy `protobuf:"bytes,6,opt,name=downlink_policy,json=downlinkPolicy,proto3" json:"downlink_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *ApplicationLink) Reset()         { *m = ApplicationLink{} }
@@ -113,6 +116,13 @@ func (m *ApplicationLink) GetSkipPayloadCrypto() *types.BoolValue {
 	return nil
 }
 
+func (m *ApplicationLink) GetDownlinkPolicy() *ApplicationDownlinkPolicy {
+	if m != nil {
+		return m.DownlinkPolicy
+	}
+	return nil
+}
+
 type GetApplicationLinkRequest struct {
 	ApplicationIds       *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
 	FieldMask            *types.FieldMask        `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
@@ -160,12 +170,14 @@ func (m *GetApplicationLinkRequest) GetFieldMask() *types.FieldMask {
 }
 
 type SetApplicationLinkRequest struct {
-	ApplicationIds       *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
-	Link                 *ApplicationLink        `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
-	FieldMask            *types.FieldMask        `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	Link           *ApplicationLink        `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
+	FieldMask      *types.FieldMask        `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// Skip the payload formatter test vectors when the default formatters are updated.
+	SkipFormatterTestVectors bool     `protobuf:"varint,4,opt,name=skip_formatter_test_vectors,json=skipFormatterTestVectors,proto3" json:"skip_formatter_test_vectors,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *SetApplicationLinkRequest) Reset()         { *m = SetApplicationLinkRequest{} }
@@ -213,6 +225,13 @@ func (m *SetApplicationLinkRequest) GetFieldMask() *types.FieldMask {
 	return nil
 }
 
+func (m *SetApplicationLinkRequest) GetSkipFormatterTestVectors() bool {
+	if m != nil {
+		return m.SkipFormatterTestVectors
+	}
+	return false
+}
+
 // Link stats as monitored by the Application Server.
 type ApplicationLinkStats struct {
 	LinkedAt             *types.Timestamp `protobuf:"bytes,1,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
@@ -542,6 +561,236 @@ func (m *GetAsConfigurationResponse) GetConfiguration() *AsConfiguration {
 	return nil
 }
 
+type GetNormalizedPayloadSchemaRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNormalizedPayloadSchemaRequest) Reset()         { *m = GetNormalizedPayloadSchemaRequest{} }
+func (m *GetNormalizedPayloadSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNormalizedPayloadSchemaRequest) ProtoMessage()    {}
+func (*GetNormalizedPayloadSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{7}
+}
+func (m *GetNormalizedPayloadSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNormalizedPayloadSchemaRequest.Unmarshal(m, b)
+}
+func (m *GetNormalizedPayloadSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNormalizedPayloadSchemaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNormalizedPayloadSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNormalizedPayloadSchemaRequest.Merge(m, src)
+}
+func (m *GetNormalizedPayloadSchemaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNormalizedPayloadSchemaRequest.Size(m)
+}
+func (m *GetNormalizedPayloadSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNormalizedPayloadSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNormalizedPayloadSchemaRequest proto.InternalMessageInfo
+
+type GetNormalizedPayloadSchemaResponse struct {
+	// Version of the normalized payload schema.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// JSON Schema of a single normalized payload measurement.
+	Schema               *types.Struct `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetNormalizedPayloadSchemaResponse) Reset()         { *m = GetNormalizedPayloadSchemaResponse{} }
+func (m *GetNormalizedPayloadSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNormalizedPayloadSchemaResponse) ProtoMessage()    {}
+func (*GetNormalizedPayloadSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{8}
+}
+func (m *GetNormalizedPayloadSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNormalizedPayloadSchemaResponse.Unmarshal(m, b)
+}
+func (m *GetNormalizedPayloadSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNormalizedPayloadSchemaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetNormalizedPayloadSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNormalizedPayloadSchemaResponse.Merge(m, src)
+}
+func (m *GetNormalizedPayloadSchemaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetNormalizedPayloadSchemaResponse.Size(m)
+}
+func (m *GetNormalizedPayloadSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNormalizedPayloadSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNormalizedPayloadSchemaResponse proto.InternalMessageInfo
+
+func (m *GetNormalizedPayloadSchemaResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *GetNormalizedPayloadSchemaResponse) GetSchema() *types.Struct {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+type TestFormattersRequest struct {
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// ID of the end device. If set, the formatters of the end device are used, or the default formatters of the
+	// application if the end device has no formatters.
+	DeviceId string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Formatters with the test vectors to run. If not set, the stored formatters are used.
+	Formatters           *MessagePayloadFormatters `protobuf:"bytes,3,opt,name=formatters,proto3" json:"formatters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *TestFormattersRequest) Reset()         { *m = TestFormattersRequest{} }
+func (m *TestFormattersRequest) String() string { return proto.CompactTextString(m) }
+func (*TestFormattersRequest) ProtoMessage()    {}
+func (*TestFormattersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{9}
+}
+func (m *TestFormattersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestFormattersRequest.Unmarshal(m, b)
+}
+func (m *TestFormattersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestFormattersRequest.Marshal(b, m, deterministic)
+}
+func (m *TestFormattersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestFormattersRequest.Merge(m, src)
+}
+func (m *TestFormattersRequest) XXX_Size() int {
+	return xxx_messageInfo_TestFormattersRequest.Size(m)
+}
+func (m *TestFormattersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestFormattersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestFormattersRequest proto.InternalMessageInfo
+
+func (m *TestFormattersRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *TestFormattersRequest) GetDeviceId() string {
+	if m != nil {
+		return m.DeviceId
+	}
+	return ""
+}
+
+func (m *TestFormattersRequest) GetFormatters() *MessagePayloadFormatters {
+	if m != nil {
+		return m.Formatters
+	}
+	return nil
+}
+
+type TestFormattersResponse struct {
+	Results              []*TestFormattersResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *TestFormattersResponse) Reset()         { *m = TestFormattersResponse{} }
+func (m *TestFormattersResponse) String() string { return proto.CompactTextString(m) }
+func (*TestFormattersResponse) ProtoMessage()    {}
+func (*TestFormattersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{10}
+}
+func (m *TestFormattersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestFormattersResponse.Unmarshal(m, b)
+}
+func (m *TestFormattersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestFormattersResponse.Marshal(b, m, deterministic)
+}
+func (m *TestFormattersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestFormattersResponse.Merge(m, src)
+}
+func (m *TestFormattersResponse) XXX_Size() int {
+	return xxx_messageInfo_TestFormattersResponse.Size(m)
+}
+func (m *TestFormattersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestFormattersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestFormattersResponse proto.InternalMessageInfo
+
+func (m *TestFormattersResponse) GetResults() []*TestFormattersResponse_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type TestFormattersResponse_Result struct {
+	// Name of the test vector.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the output of the payload formatter matches the expected output of the test vector.
+	Passed bool `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	// Test vector with the actual output of the payload formatter.
+	Output               *MessagePayloadFormatterTestVector `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *TestFormattersResponse_Result) Reset()         { *m = TestFormattersResponse_Result{} }
+func (m *TestFormattersResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*TestFormattersResponse_Result) ProtoMessage()    {}
+func (*TestFormattersResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{10, 0}
+}
+func (m *TestFormattersResponse_Result) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TestFormattersResponse_Result.Unmarshal(m, b)
+}
+func (m *TestFormattersResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TestFormattersResponse_Result.Marshal(b, m, deterministic)
+}
+func (m *TestFormattersResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TestFormattersResponse_Result.Merge(m, src)
+}
+func (m *TestFormattersResponse_Result) XXX_Size() int {
+	return xxx_messageInfo_TestFormattersResponse_Result.Size(m)
+}
+func (m *TestFormattersResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_TestFormattersResponse_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TestFormattersResponse_Result proto.InternalMessageInfo
+
+func (m *TestFormattersResponse_Result) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TestFormattersResponse_Result) GetPassed() bool {
+	if m != nil {
+		return m.Passed
+	}
+	return false
+}
+
+func (m *TestFormattersResponse_Result) GetOutput() *MessagePayloadFormatterTestVector {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
 // Container for multiple Application uplink messages.
 type NsAsHandleUplinkRequest struct {
 	ApplicationUps       []*ApplicationUp `protobuf:"bytes,1,rep,name=application_ups,json=applicationUps,proto3" json:"application_ups,omitempty"`
@@ -554,7 +803,7 @@ func (m *NsAsHandleUplinkRequest) Reset()         { *m = NsAsHandleUplinkRequest
 func (m *NsAsHandleUplinkRequest) String() string { return proto.CompactTextString(m) }
 func (*NsAsHandleUplinkRequest) ProtoMessage()    {}
 func (*NsAsHandleUplinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df9d75a19dc066e1, []int{7}
+	return fileDescriptor_df9d75a19dc066e1, []int{11}
 }
 func (m *NsAsHandleUplinkRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NsAsHandleUplinkRequest.Unmarshal(m, b)
@@ -596,7 +845,7 @@ func (m *EncodeDownlinkRequest) Reset()         { *m = EncodeDownlinkRequest{} }
 func (m *EncodeDownlinkRequest) String() string { return proto.CompactTextString(m) }
 func (*EncodeDownlinkRequest) ProtoMessage()    {}
 func (*EncodeDownlinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df9d75a19dc066e1, []int{8}
+	return fileDescriptor_df9d75a19dc066e1, []int{12}
 }
 func (m *EncodeDownlinkRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EncodeDownlinkRequest.Unmarshal(m, b)
@@ -662,7 +911,7 @@ func (m *EncodeDownlinkResponse) Reset()         { *m = EncodeDownlinkResponse{}
 func (m *EncodeDownlinkResponse) String() string { return proto.CompactTextString(m) }
 func (*EncodeDownlinkResponse) ProtoMessage()    {}
 func (*EncodeDownlinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df9d75a19dc066e1, []int{9}
+	return fileDescriptor_df9d75a19dc066e1, []int{13}
 }
 func (m *EncodeDownlinkResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EncodeDownlinkResponse.Unmarshal(m, b)
@@ -704,7 +953,7 @@ func (m *DecodeUplinkRequest) Reset()         { *m = DecodeUplinkRequest{} }
 func (m *DecodeUplinkRequest) String() string { return proto.CompactTextString(m) }
 func (*DecodeUplinkRequest) ProtoMessage()    {}
 func (*DecodeUplinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df9d75a19dc066e1, []int{10}
+	return fileDescriptor_df9d75a19dc066e1, []int{14}
 }
 func (m *DecodeUplinkRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeUplinkRequest.Unmarshal(m, b)
@@ -770,7 +1019,7 @@ func (m *DecodeUplinkResponse) Reset()         { *m = DecodeUplinkResponse{} }
 func (m *DecodeUplinkResponse) String() string { return proto.CompactTextString(m) }
 func (*DecodeUplinkResponse) ProtoMessage()    {}
 func (*DecodeUplinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df9d75a19dc066e1, []int{11}
+	return fileDescriptor_df9d75a19dc066e1, []int{15}
 }
 func (m *DecodeUplinkResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeUplinkResponse.Unmarshal(m, b)
@@ -812,7 +1061,7 @@ func (m *DecodeDownlinkRequest) Reset()         { *m = DecodeDownlinkRequest{} }
 func (m *DecodeDownlinkRequest) String() string { return proto.CompactTextString(m) }
 func (*DecodeDownlinkRequest) ProtoMessage()    {}
 func (*DecodeDownlinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df9d75a19dc066e1, []int{12}
+	return fileDescriptor_df9d75a19dc066e1, []int{16}
 }
 func (m *DecodeDownlinkRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeDownlinkRequest.Unmarshal(m, b)
@@ -878,7 +1127,7 @@ func (m *DecodeDownlinkResponse) Reset()         { *m = DecodeDownlinkResponse{}
 func (m *DecodeDownlinkResponse) String() string { return proto.CompactTextString(m) }
 func (*DecodeDownlinkResponse) ProtoMessage()    {}
 func (*DecodeDownlinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df9d75a19dc066e1, []int{13}
+	return fileDescriptor_df9d75a19dc066e1, []int{17}
 }
 func (m *DecodeDownlinkResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DecodeDownlinkResponse.Unmarshal(m, b)
@@ -905,6 +1154,214 @@ func (m *DecodeDownlinkResponse) GetDownlink() *ApplicationDownlink {
 	return nil
 }
 
+type SubscribeDurableRequest struct {
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// Name of the durable consumer. Messages that are not acknowledged by the consumer are delivered again
+	// when the consumer subscribes again.
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// Identifier of the message after which delivery resumes.
+	// If empty, delivery resumes after the last message delivered to the consumer.
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeDurableRequest) Reset()         { *m = SubscribeDurableRequest{} }
+func (m *SubscribeDurableRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeDurableRequest) ProtoMessage()    {}
+func (*SubscribeDurableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{18}
+}
+func (m *SubscribeDurableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeDurableRequest.Unmarshal(m, b)
+}
+func (m *SubscribeDurableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeDurableRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeDurableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeDurableRequest.Merge(m, src)
+}
+func (m *SubscribeDurableRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeDurableRequest.Size(m)
+}
+func (m *SubscribeDurableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeDurableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeDurableRequest proto.InternalMessageInfo
+
+func (m *SubscribeDurableRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *SubscribeDurableRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *SubscribeDurableRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type DurableApplicationUp struct {
+	// Identifier of the message. It is used to acknowledge the message and as resume cursor.
+	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Up                   *ApplicationUp `protobuf:"bytes,2,opt,name=up,proto3" json:"up,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DurableApplicationUp) Reset()         { *m = DurableApplicationUp{} }
+func (m *DurableApplicationUp) String() string { return proto.CompactTextString(m) }
+func (*DurableApplicationUp) ProtoMessage()    {}
+func (*DurableApplicationUp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{19}
+}
+func (m *DurableApplicationUp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DurableApplicationUp.Unmarshal(m, b)
+}
+func (m *DurableApplicationUp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DurableApplicationUp.Marshal(b, m, deterministic)
+}
+func (m *DurableApplicationUp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DurableApplicationUp.Merge(m, src)
+}
+func (m *DurableApplicationUp) XXX_Size() int {
+	return xxx_messageInfo_DurableApplicationUp.Size(m)
+}
+func (m *DurableApplicationUp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DurableApplicationUp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DurableApplicationUp proto.InternalMessageInfo
+
+func (m *DurableApplicationUp) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DurableApplicationUp) GetUp() *ApplicationUp {
+	if m != nil {
+		return m.Up
+	}
+	return nil
+}
+
+type AcknowledgeDurableRequest struct {
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// Name of the durable consumer.
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// Identifiers of the messages to acknowledge.
+	Ids                  []string `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcknowledgeDurableRequest) Reset()         { *m = AcknowledgeDurableRequest{} }
+func (m *AcknowledgeDurableRequest) String() string { return proto.CompactTextString(m) }
+func (*AcknowledgeDurableRequest) ProtoMessage()    {}
+func (*AcknowledgeDurableRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{20}
+}
+func (m *AcknowledgeDurableRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcknowledgeDurableRequest.Unmarshal(m, b)
+}
+func (m *AcknowledgeDurableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcknowledgeDurableRequest.Marshal(b, m, deterministic)
+}
+func (m *AcknowledgeDurableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcknowledgeDurableRequest.Merge(m, src)
+}
+func (m *AcknowledgeDurableRequest) XXX_Size() int {
+	return xxx_messageInfo_AcknowledgeDurableRequest.Size(m)
+}
+func (m *AcknowledgeDurableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcknowledgeDurableRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcknowledgeDurableRequest proto.InternalMessageInfo
+
+func (m *AcknowledgeDurableRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *AcknowledgeDurableRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *AcknowledgeDurableRequest) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type DeleteDurableConsumerRequest struct {
+	ApplicationIds *ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	// Name of the durable consumer.
+	Consumer             string   `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDurableConsumerRequest) Reset()         { *m = DeleteDurableConsumerRequest{} }
+func (m *DeleteDurableConsumerRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDurableConsumerRequest) ProtoMessage()    {}
+func (*DeleteDurableConsumerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{21}
+}
+func (m *DeleteDurableConsumerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDurableConsumerRequest.Unmarshal(m, b)
+}
+func (m *DeleteDurableConsumerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDurableConsumerRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteDurableConsumerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDurableConsumerRequest.Merge(m, src)
+}
+func (m *DeleteDurableConsumerRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDurableConsumerRequest.Size(m)
+}
+func (m *DeleteDurableConsumerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDurableConsumerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDurableConsumerRequest proto.InternalMessageInfo
+
+func (m *DeleteDurableConsumerRequest) GetApplicationIds() *ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIds
+	}
+	return nil
+}
+
+func (m *DeleteDurableConsumerRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.AsConfiguration_PubSub_Providers_Status", AsConfiguration_PubSub_Providers_Status_name, AsConfiguration_PubSub_Providers_Status_value)
@@ -928,6 +1385,16 @@ func init() {
 	golang_proto.RegisterType((*GetAsConfigurationRequest)(nil), "ttn.lorawan.v3.GetAsConfigurationRequest")
 	proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
 	golang_proto.RegisterType((*GetAsConfigurationResponse)(nil), "ttn.lorawan.v3.GetAsConfigurationResponse")
+	proto.RegisterType((*GetNormalizedPayloadSchemaRequest)(nil), "ttn.lorawan.v3.GetNormalizedPayloadSchemaRequest")
+	golang_proto.RegisterType((*GetNormalizedPayloadSchemaRequest)(nil), "ttn.lorawan.v3.GetNormalizedPayloadSchemaRequest")
+	proto.RegisterType((*GetNormalizedPayloadSchemaResponse)(nil), "ttn.lorawan.v3.GetNormalizedPayloadSchemaResponse")
+	golang_proto.RegisterType((*GetNormalizedPayloadSchemaResponse)(nil), "ttn.lorawan.v3.GetNormalizedPayloadSchemaResponse")
+	proto.RegisterType((*TestFormattersRequest)(nil), "ttn.lorawan.v3.TestFormattersRequest")
+	golang_proto.RegisterType((*TestFormattersRequest)(nil), "ttn.lorawan.v3.TestFormattersRequest")
+	proto.RegisterType((*TestFormattersResponse)(nil), "ttn.lorawan.v3.TestFormattersResponse")
+	golang_proto.RegisterType((*TestFormattersResponse)(nil), "ttn.lorawan.v3.TestFormattersResponse")
+	proto.RegisterType((*TestFormattersResponse_Result)(nil), "ttn.lorawan.v3.TestFormattersResponse.Result")
+	golang_proto.RegisterType((*TestFormattersResponse_Result)(nil), "ttn.lorawan.v3.TestFormattersResponse.Result")
 	proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
 	golang_proto.RegisterType((*NsAsHandleUplinkRequest)(nil), "ttn.lorawan.v3.NsAsHandleUplinkRequest")
 	proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
@@ -942,6 +1409,14 @@ func init() {
 	golang_proto.RegisterType((*DecodeDownlinkRequest)(nil), "ttn.lorawan.v3.DecodeDownlinkRequest")
 	proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
 	golang_proto.RegisterType((*DecodeDownlinkResponse)(nil), "ttn.lorawan.v3.DecodeDownlinkResponse")
+	proto.RegisterType((*SubscribeDurableRequest)(nil), "ttn.lorawan.v3.SubscribeDurableRequest")
+	golang_proto.RegisterType((*SubscribeDurableRequest)(nil), "ttn.lorawan.v3.SubscribeDurableRequest")
+	proto.RegisterType((*DurableApplicationUp)(nil), "ttn.lorawan.v3.DurableApplicationUp")
+	golang_proto.RegisterType((*DurableApplicationUp)(nil), "ttn.lorawan.v3.DurableApplicationUp")
+	proto.RegisterType((*AcknowledgeDurableRequest)(nil), "ttn.lorawan.v3.AcknowledgeDurableRequest")
+	golang_proto.RegisterType((*AcknowledgeDurableRequest)(nil), "ttn.lorawan.v3.AcknowledgeDurableRequest")
+	proto.RegisterType((*DeleteDurableConsumerRequest)(nil), "ttn.lorawan.v3.DeleteDurableConsumerRequest")
+	golang_proto.RegisterType((*DeleteDurableConsumerRequest)(nil), "ttn.lorawan.v3.DeleteDurableConsumerRequest")
 }
 
 func init() {
@@ -952,129 +1427,167 @@ func init() {
 }
 
 var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1947 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0x19, 0xee, 0x90, 0x94, 0x4c, 0x8d, 0x65, 0x59, 0x1e, 0x39, 0x8e, 0xc4, 0xb8, 0xb1, 0xba, 0x71,
-	0x5c, 0x49, 0x0d, 0x77, 0x5d, 0xb9, 0x69, 0x62, 0x15, 0x8d, 0xba, 0x7a, 0x58, 0x96, 0x2a, 0xa9,
-	0xf2, 0x52, 0x8e, 0x10, 0xe7, 0x41, 0x0c, 0xb9, 0x43, 0x72, 0xcb, 0xe5, 0xee, 0x66, 0x67, 0x96,
-	0x8a, 0xfc, 0xb8, 0x04, 0x41, 0x0b, 0xe4, 0xd0, 0x43, 0x8a, 0x00, 0xbd, 0x16, 0xbd, 0xe5, 0xd6,
-	0xe6, 0xd0, 0x5b, 0x11, 0xa0, 0xe8, 0xa9, 0xa7, 0x34, 0xc7, 0x1e, 0xfb, 0x38, 0xb4, 0x40, 0x51,
-	0xf4, 0x18, 0xa0, 0x45, 0x31, 0xb3, 0xbb, 0xe4, 0x3e, 0x48, 0x91, 0x72, 0x0c, 0x05, 0x05, 0x7a,
-	0x11, 0x66, 0x39, 0xff, 0xff, 0xcd, 0xf7, 0x3f, 0xe7, 0xdf, 0x15, 0x9c, 0x37, 0x6d, 0x17, 0x1f,
-	0x62, 0xab, 0x48, 0x19, 0xae, 0x36, 0x15, 0xec, 0x18, 0x0a, 0x76, 0x1c, 0xd3, 0xa8, 0x62, 0x66,
-	0xd8, 0x16, 0x25, 0x6e, 0x9b, 0xb8, 0xb2, 0xe3, 0xda, 0xcc, 0x46, 0x13, 0x8c, 0x59, 0x72, 0x20,
-	0x2e, 0xb7, 0x6f, 0x14, 0xd4, 0xba, 0xc1, 0x1a, 0x5e, 0x45, 0xae, 0xda, 0x2d, 0x85, 0x58, 0x6d,
-	0xfb, 0xc8, 0x71, 0xed, 0x77, 0x8e, 0x14, 0x21, 0x5c, 0x2d, 0xd6, 0x89, 0x55, 0x6c, 0x63, 0xd3,
-	0xd0, 0x31, 0x23, 0x4a, 0x6a, 0xe1, 0x43, 0x16, 0x8a, 0x11, 0x88, 0xba, 0x5d, 0xb7, 0x7d, 0xe5,
-	0x8a, 0x57, 0x13, 0x4f, 0xe2, 0x41, 0xac, 0x02, 0xf1, 0xb5, 0x88, 0xf8, 0x7e, 0x83, 0xec, 0x37,
-	0x0c, 0xab, 0x4e, 0x37, 0x2d, 0xdd, 0xa3, 0xcc, 0x35, 0x08, 0x8d, 0x1e, 0x5d, 0xb7, 0x8b, 0x35,
-	0x13, 0xd7, 0xa9, 0x82, 0x2d, 0xcb, 0x66, 0xbe, 0x31, 0x01, 0xca, 0xea, 0x89, 0x50, 0x7e, 0x48,
-	0x6d, 0xab, 0x07, 0xc8, 0xe5, 0xba, 0x6d, 0xd7, 0x4d, 0xe2, 0x3b, 0x2c, 0xb5, 0xfb, 0x6c, 0xb0,
-	0xdb, 0x31, 0x47, 0xf7, 0x5c, 0x21, 0x10, 0xec, 0x3f, 0x93, 0xdc, 0x27, 0x2d, 0x87, 0x1d, 0x05,
-	0x9b, 0xb3, 0xc9, 0xcd, 0x9a, 0x41, 0x4c, 0xbd, 0xdc, 0xc2, 0xb4, 0x19, 0x48, 0x5c, 0x49, 0x4a,
-	0x30, 0xa3, 0x45, 0x28, 0xc3, 0x2d, 0xa7, 0xdf, 0xf9, 0x87, 0x2e, 0x76, 0x1c, 0xe2, 0x86, 0xfc,
-	0xa4, 0x74, 0xd4, 0x89, 0xa5, 0x97, 0x75, 0xd2, 0x36, 0xaa, 0x61, 0x6c, 0x9e, 0x4b, 0xcb, 0x18,
-	0x3a, 0xb1, 0x98, 0x51, 0x33, 0xba, 0x40, 0xb3, 0x69, 0xa1, 0x16, 0xa1, 0x14, 0xd7, 0x49, 0xc7,
-	0x51, 0x3d, 0x24, 0xde, 0x66, 0xcc, 0xdf, 0x95, 0xfe, 0x00, 0xe0, 0x79, 0xb5, 0x9b, 0x6f, 0xdb,
-	0x86, 0xd5, 0x44, 0x07, 0x10, 0xe9, 0xa4, 0x86, 0x3d, 0x93, 0x95, 0x6b, 0xb6, 0xdb, 0xc2, 0x8c,
-	0x11, 0x97, 0x4e, 0x67, 0x67, 0xc1, 0xdc, 0xd9, 0xc5, 0x39, 0x39, 0x9e, 0x84, 0xf2, 0x8e, 0x7f,
-	0xda, 0x1e, 0x3e, 0x32, 0x6d, 0xac, 0xdf, 0xea, 0xc8, 0x6b, 0x17, 0x02, 0x8c, 0xee, 0x4f, 0x68,
-	0x0b, 0x4e, 0xd1, 0xa6, 0xe1, 0x94, 0x1d, 0x5f, 0xb8, 0x5c, 0x75, 0x8f, 0x1c, 0x66, 0x4f, 0x8f,
-	0x08, 0xe4, 0x82, 0xec, 0xfb, 0x4c, 0x0e, 0x7d, 0x26, 0xaf, 0xd8, 0xb6, 0xf9, 0x2a, 0x36, 0x3d,
-	0xa2, 0x5d, 0xe0, 0x6a, 0xc1, 0x11, 0xab, 0x42, 0x69, 0x29, 0xff, 0xaf, 0x8f, 0x66, 0x72, 0x79,
-	0x30, 0x09, 0xb6, 0xf8, 0xdf, 0xcc, 0x56, 0x2e, 0x9f, 0x99, 0xcc, 0x6e, 0xe5, 0xf2, 0xb9, 0xc9,
-	0x11, 0xe9, 0x97, 0x00, 0xce, 0x6c, 0x10, 0x96, 0xb0, 0x4b, 0x23, 0x6f, 0x7b, 0x84, 0x32, 0xf4,
-	0x1a, 0x3c, 0x1f, 0xa9, 0xb0, 0xb2, 0xa1, 0xd3, 0x69, 0x20, 0x18, 0x5c, 0x4b, 0xda, 0x16, 0x01,
-	0xd8, 0xec, 0x7a, 0x7e, 0x25, 0xff, 0xf9, 0xca, 0xc8, 0xfb, 0x20, 0x33, 0x09, 0xb4, 0x09, 0x1c,
-	0x95, 0xa0, 0xe8, 0x26, 0x84, 0xdd, 0x5c, 0x99, 0xce, 0xf4, 0xb1, 0xeb, 0x16, 0x17, 0xd9, 0xc1,
-	0xb4, 0xa9, 0x8d, 0xd5, 0xc2, 0xa5, 0xf4, 0x4f, 0x00, 0x67, 0x4a, 0x5f, 0x06, 0xe7, 0xef, 0xc2,
-	0x9c, 0x69, 0x58, 0x21, 0xdb, 0x2b, 0xc7, 0xe0, 0x71, 0x42, 0x11, 0x20, 0xa1, 0x96, 0x30, 0x39,
-	0x7b, 0x12, 0x93, 0xff, 0x9d, 0x85, 0x17, 0x13, 0xf0, 0x25, 0x86, 0x19, 0x45, 0x2f, 0xc1, 0x31,
-	0x8e, 0x4d, 0xf4, 0x32, 0x66, 0x81, 0x9d, 0x69, 0xc8, 0xfd, 0xb0, 0xe4, 0xb4, 0xbc, 0x2f, 0xac,
-	0x32, 0xf4, 0x3b, 0x00, 0x2f, 0x59, 0x84, 0x1d, 0xda, 0x6e, 0xb3, 0xec, 0xb7, 0xce, 0x32, 0xd6,
-	0x75, 0x97, 0x50, 0x2a, 0xcc, 0x1b, 0x5b, 0xf9, 0x09, 0xf8, 0x7c, 0xe5, 0x7d, 0xe0, 0xfe, 0x18,
-	0x2c, 0xbe, 0x07, 0xde, 0x9a, 0x5b, 0x5e, 0x9a, 0x5b, 0x5e, 0x7a, 0x1d, 0x17, 0xef, 0xab, 0xc5,
-	0x7b, 0xd7, 0x8b, 0x37, 0xdf, 0x7c, 0x18, 0x59, 0x77, 0x97, 0x6f, 0x14, 0xdf, 0x5c, 0x88, 0x6c,
-	0xcc, 0xbf, 0x21, 0xcf, 0x2f, 0x70, 0x3d, 0xb5, 0x78, 0x0f, 0x17, 0xef, 0xfb, 0x7a, 0xdd, 0x75,
-	0x77, 0x29, 0xf4, 0xba, 0x1b, 0xf3, 0x73, 0xcb, 0x4b, 0x4b, 0xaf, 0xf3, 0xd5, 0x83, 0x6f, 0xbe,
-	0xf0, 0xe2, 0xa3, 0xf9, 0xe5, 0xab, 0x0f, 0xdf, 0xba, 0xaa, 0x5d, 0x0c, 0xe8, 0x96, 0x04, 0x5b,
-	0xd5, 0x27, 0x8b, 0x36, 0xe1, 0x94, 0x89, 0x29, 0x2b, 0x7b, 0x4e, 0xd9, 0x25, 0x55, 0x62, 0xb4,
-	0x7d, 0x57, 0x64, 0x07, 0xba, 0x62, 0x92, 0xab, 0xdd, 0x75, 0xb4, 0x40, 0x49, 0x65, 0x68, 0x06,
-	0xe6, 0x3d, 0xa7, 0x5c, 0xb5, 0x3d, 0x8b, 0x4d, 0xe7, 0x66, 0xc1, 0x5c, 0x4e, 0x3b, 0xe3, 0x39,
-	0xab, 0xfc, 0x11, 0x1d, 0xc0, 0x82, 0x38, 0x45, 0xb7, 0x0f, 0x2d, 0xee, 0x42, 0x5e, 0xed, 0x87,
-	0xd8, 0xd5, 0xfd, 0xc3, 0x46, 0x06, 0x1e, 0xf6, 0x34, 0xd7, 0x5e, 0x0b, 0x94, 0x6f, 0x85, 0xba,
-	0x2a, 0x43, 0xcf, 0xc3, 0x89, 0x0e, 0xa6, 0x7f, 0xf2, 0xa8, 0x38, 0xf9, 0x5c, 0xf8, 0xab, 0x38,
-	0x5f, 0xfa, 0x4f, 0x0e, 0x9e, 0x57, 0xe9, 0xaa, 0x6d, 0xd5, 0x8c, 0x7a, 0xd0, 0x9e, 0xd1, 0x2b,
-	0x70, 0xd4, 0xf1, 0x2a, 0xd4, 0xab, 0xf4, 0xcd, 0xef, 0xb8, 0x82, 0xbc, 0xe7, 0x55, 0x4a, 0x5e,
-	0x45, 0x0b, 0xb4, 0xd0, 0x1a, 0xcc, 0x1f, 0x92, 0x4a, 0xc3, 0xb6, 0x9b, 0x34, 0xc8, 0xe8, 0xb9,
-	0x41, 0x08, 0x07, 0x81, 0xbc, 0xd6, 0xd1, 0x2c, 0x7c, 0x92, 0x81, 0xa3, 0x3e, 0x30, 0xda, 0x85,
-	0x63, 0x8e, 0x6b, 0xb7, 0x0d, 0x9d, 0xf7, 0x40, 0x9f, 0xd3, 0xf5, 0xe1, 0x38, 0xc9, 0x7b, 0xa1,
-	0x9e, 0xd6, 0x85, 0x28, 0xfc, 0x05, 0xc0, 0xb1, 0xce, 0x06, 0xfa, 0x3e, 0xcc, 0xf1, 0x66, 0x2c,
-	0x80, 0x27, 0x16, 0x5f, 0x3a, 0x29, 0xb0, 0xcc, 0xeb, 0xc5, 0xa3, 0x9a, 0x00, 0xe1, 0x60, 0x16,
-	0x66, 0xbe, 0xdd, 0x5f, 0x04, 0x8c, 0x83, 0x48, 0x2f, 0xc3, 0x51, 0xff, 0x19, 0x9d, 0x85, 0x67,
-	0xd6, 0x77, 0xd5, 0x95, 0xed, 0xf5, 0xb5, 0xc9, 0xaf, 0xf0, 0x87, 0x03, 0x55, 0xdb, 0xdd, 0xdc,
-	0xdd, 0x98, 0x04, 0x68, 0x1c, 0xe6, 0xd7, 0x36, 0x4b, 0xfe, 0x56, 0xa6, 0x30, 0xfa, 0xb7, 0x8f,
-	0x66, 0x32, 0xd3, 0xbc, 0x1f, 0x67, 0x27, 0x73, 0x85, 0x9f, 0x03, 0x98, 0x0f, 0x3d, 0x8b, 0xbe,
-	0x07, 0x2f, 0x7b, 0x56, 0x83, 0x60, 0x93, 0x35, 0x8e, 0xca, 0xfc, 0x32, 0x68, 0x39, 0x8c, 0x96,
-	0x59, 0xc3, 0x25, 0xb4, 0x61, 0x9b, 0xba, 0x30, 0x3f, 0xab, 0x15, 0x3a, 0x32, 0x6a, 0x20, 0xb2,
-	0x1f, 0x4a, 0xa0, 0x12, 0x9c, 0xee, 0x22, 0xb8, 0x84, 0xb9, 0x47, 0x65, 0xc3, 0x62, 0xc4, 0x6d,
-	0x63, 0x33, 0x88, 0xf3, 0x4c, 0x2a, 0x53, 0xd7, 0x02, 0x4b, 0xb5, 0x4b, 0x1d, 0x55, 0x8d, 0x6b,
-	0x6e, 0x06, 0x8a, 0xd2, 0x33, 0xfe, 0x35, 0x11, 0xf7, 0x4b, 0xd0, 0x72, 0xa5, 0x2a, 0x2c, 0xf4,
-	0xda, 0xa4, 0x0e, 0x9f, 0xca, 0xd0, 0x3a, 0x3c, 0x57, 0x8d, 0x6e, 0x04, 0xa9, 0x71, 0x65, 0x80,
-	0xd3, 0xb5, 0xb8, 0x96, 0xd4, 0x84, 0x4f, 0xef, 0x52, 0x95, 0xde, 0xc6, 0x96, 0x6e, 0x92, 0xbb,
-	0x8e, 0x19, 0x69, 0xf9, 0x7b, 0xf1, 0x96, 0xef, 0x39, 0x3c, 0xfd, 0xb2, 0x73, 0x67, 0x17, 0xbf,
-	0x7a, 0x4c, 0x8b, 0xbe, 0xeb, 0x88, 0x06, 0xfd, 0x01, 0xc8, 0xe4, 0xe3, 0x9d, 0xfe, 0xae, 0x43,
-	0xa5, 0x7f, 0x64, 0xe0, 0x53, 0xeb, 0x56, 0xd5, 0xd6, 0x49, 0x58, 0xb4, 0xe1, 0x59, 0xfb, 0x70,
-	0xa2, 0x3b, 0x7e, 0x44, 0x6e, 0x97, 0xab, 0xc9, 0xa3, 0xd6, 0x2d, 0x7d, 0x4d, 0x08, 0xf5, 0xbe,
-	0x5b, 0xc6, 0x49, 0x77, 0x9f, 0xa2, 0x6d, 0x78, 0xb6, 0x4d, 0x5c, 0x1a, 0x5e, 0x58, 0x7e, 0x98,
-	0xbe, 0xd1, 0x17, 0xf2, 0x55, 0x5f, 0x36, 0x82, 0xac, 0xc1, 0x76, 0xf8, 0x1b, 0xef, 0x89, 0xf9,
-	0xb0, 0x7d, 0x04, 0x8d, 0xf0, 0xb9, 0x63, 0x1c, 0x11, 0x5a, 0x18, 0x21, 0xd7, 0x51, 0x47, 0xb7,
-	0xe1, 0x58, 0x67, 0xb0, 0x11, 0x4d, 0x71, 0x62, 0x71, 0x36, 0x89, 0x95, 0x1c, 0x68, 0x04, 0xd0,
-	0xbb, 0x02, 0xa8, 0xab, 0x8c, 0x2e, 0xc3, 0x31, 0x07, 0xbb, 0xb8, 0x45, 0x38, 0x12, 0xef, 0x98,
-	0x63, 0x5a, 0xf7, 0x07, 0xe9, 0x35, 0x78, 0x29, 0xe9, 0xef, 0x20, 0x7d, 0x96, 0x23, 0xc6, 0x80,
-	0xa1, 0x8d, 0xe9, 0x9a, 0x20, 0xfd, 0x35, 0x03, 0xa7, 0xd6, 0x08, 0xc7, 0x8e, 0x67, 0xcd, 0xff,
-	0x42, 0x24, 0x57, 0xe1, 0xa8, 0xe7, 0x44, 0xe2, 0xf8, 0xb5, 0x63, 0x13, 0x3a, 0x11, 0xc5, 0x40,
-	0xf5, 0xd4, 0x62, 0x78, 0x07, 0x5e, 0x8c, 0xfb, 0x39, 0x88, 0xe0, 0xcd, 0x8e, 0x11, 0x60, 0x48,
-	0x23, 0x42, 0xea, 0xa2, 0x0e, 0x7d, 0xcc, 0xff, 0xd7, 0xe1, 0x69, 0xd5, 0x61, 0xd2, 0xdf, 0x4f,
-	0xa8, 0x0e, 0x17, 0x3f, 0x1d, 0x81, 0x19, 0x95, 0xa2, 0x0f, 0x01, 0x3c, 0xb3, 0x41, 0x98, 0x78,
-	0x7d, 0x9a, 0x4f, 0x22, 0xf4, 0x7d, 0x15, 0x29, 0x0c, 0x9a, 0xb6, 0xa5, 0x57, 0xde, 0xfd, 0xec,
-	0xcf, 0x3f, 0xcd, 0xbc, 0x8c, 0xbe, 0xad, 0x60, 0x1a, 0xfb, 0x2e, 0xa0, 0x3c, 0x48, 0xbc, 0x0f,
-	0xc8, 0xf1, 0xe7, 0x47, 0x8a, 0xf0, 0xf0, 0xcf, 0x00, 0x3c, 0x53, 0xea, 0xc7, 0xab, 0xf4, 0xf8,
-	0xbc, 0x54, 0xc1, 0xeb, 0x3b, 0x85, 0xc7, 0xe4, 0xb5, 0x04, 0x16, 0xd0, 0x43, 0x08, 0xd7, 0x88,
-	0x49, 0x18, 0x11, 0xe4, 0x86, 0x7c, 0x8f, 0x29, 0x5c, 0x4a, 0xdd, 0xf2, 0xeb, 0xfc, 0xcd, 0x5d,
-	0x92, 0x05, 0xa1, 0xb9, 0x85, 0x6b, 0x83, 0x08, 0x05, 0x8e, 0xf9, 0x00, 0xc0, 0xf1, 0x20, 0x60,
-	0xfe, 0x3b, 0xc7, 0xb0, 0x04, 0xae, 0x0e, 0x70, 0x8d, 0x40, 0x93, 0xbe, 0x25, 0xe8, 0xc8, 0xe8,
-	0x85, 0xe1, 0xe8, 0x28, 0x54, 0x70, 0x78, 0x0f, 0xc0, 0xc9, 0x0d, 0xc2, 0xe2, 0x13, 0x71, 0xcf,
-	0x74, 0xea, 0x39, 0xb2, 0x14, 0x16, 0x86, 0x11, 0xf5, 0x33, 0x5f, 0x9a, 0x11, 0x0c, 0xa7, 0xd0,
-	0x05, 0xce, 0x30, 0x36, 0x94, 0x2c, 0x1e, 0xc0, 0x1c, 0x1f, 0x4a, 0xd0, 0x0f, 0xe0, 0x78, 0x74,
-	0x30, 0x41, 0x5f, 0x4f, 0xc2, 0xf7, 0x19, 0x5d, 0xfa, 0x05, 0x69, 0xf1, 0xe3, 0x73, 0x70, 0x44,
-	0x75, 0x1c, 0x95, 0xa2, 0x7d, 0x38, 0x56, 0xf2, 0x2a, 0xb4, 0xea, 0x1a, 0x15, 0x32, 0xb4, 0xeb,
-	0x8f, 0x1f, 0x7c, 0xae, 0x03, 0xf4, 0x7b, 0x00, 0x2f, 0x84, 0x35, 0x7a, 0xc7, 0x23, 0x1e, 0xd9,
-	0xf3, 0x68, 0x03, 0xa5, 0x22, 0x16, 0x13, 0x19, 0xc0, 0x59, 0x7a, 0x47, 0xf8, 0xc9, 0x95, 0x5a,
-	0xe9, 0x48, 0xc6, 0x5b, 0xb5, 0x3c, 0x28, 0xf1, 0x7d, 0xd1, 0xb4, 0x5e, 0x67, 0xf9, 0x48, 0xe1,
-	0x6d, 0x45, 0x71, 0x3c, 0xda, 0xe0, 0x05, 0xf2, 0x29, 0x80, 0x17, 0x13, 0x54, 0x1d, 0x13, 0x57,
-	0xc9, 0x17, 0x34, 0xe8, 0x81, 0x30, 0xc8, 0x93, 0x9c, 0x53, 0x33, 0xc8, 0xf5, 0x79, 0x73, 0x9b,
-	0x3e, 0x4e, 0x46, 0x68, 0xdb, 0xa0, 0x0c, 0x0d, 0x75, 0xbd, 0x1d, 0x5b, 0x79, 0x21, 0x26, 0x95,
-	0x34, 0x61, 0xde, 0x36, 0xda, 0x3a, 0x79, 0x67, 0xea, 0xd8, 0x93, 0x30, 0x00, 0xfd, 0x02, 0xc0,
-	0xa7, 0x36, 0x08, 0xdb, 0xb9, 0xb3, 0xbf, 0xbf, 0x6a, 0x5b, 0x16, 0xa9, 0x8a, 0xcc, 0xb4, 0x6a,
-	0xf6, 0xd0, 0xa9, 0x2b, 0xa5, 0x3e, 0x9b, 0xa5, 0xb0, 0x86, 0xef, 0xf5, 0x8f, 0xc4, 0x07, 0xbc,
-	0x62, 0xb5, 0xa3, 0x5e, 0x34, 0x38, 0x97, 0xdf, 0x02, 0x38, 0x51, 0x32, 0x5a, 0x9e, 0x89, 0x59,
-	0x58, 0xb1, 0xc7, 0x57, 0x4c, 0xdf, 0x14, 0xb9, 0x2f, 0x98, 0x30, 0xc9, 0x3e, 0x8d, 0x14, 0xf1,
-	0x1c, 0x85, 0x06, 0xac, 0x79, 0x86, 0xfc, 0x11, 0xc0, 0x89, 0xf8, 0xd0, 0x8c, 0x9e, 0x4f, 0xa7,
-	0x47, 0x8f, 0xe1, 0xa9, 0x70, 0x6d, 0x90, 0x58, 0xd0, 0xf9, 0x4e, 0xd5, 0x3a, 0x51, 0x00, 0x44,
-	0x10, 0xe1, 0xd6, 0x7d, 0x06, 0xe0, 0x78, 0x74, 0x9c, 0x44, 0xa9, 0x71, 0xa3, 0xc7, 0x50, 0x9f,
-	0xce, 0xfc, 0x5e, 0x13, 0xe9, 0xe9, 0x76, 0x2a, 0xcf, 0x51, 0x74, 0x12, 0x5a, 0xc5, 0x63, 0x16,
-	0x1f, 0xb0, 0xd2, 0x31, 0xeb, 0x39, 0xf0, 0xa6, 0x63, 0xd6, 0x7b, 0x4e, 0xfb, 0x12, 0x62, 0xd6,
-	0xb1, 0x6e, 0xf1, 0xef, 0x39, 0x38, 0xa5, 0xd2, 0x4e, 0x4b, 0xd2, 0x48, 0xdd, 0xa0, 0xcc, 0x3d,
-	0x42, 0xbf, 0x02, 0x30, 0xbb, 0x41, 0x58, 0x3a, 0x84, 0x1b, 0x84, 0x45, 0xa4, 0x7d, 0x43, 0x67,
-	0xfa, 0xb6, 0x38, 0xa9, 0x29, 0x6c, 0x23, 0xa8, 0x7a, 0x0a, 0xb6, 0xa1, 0x1f, 0x65, 0x60, 0xb6,
-	0xd4, 0x8b, 0x74, 0xe9, 0x64, 0xa4, 0x7f, 0x03, 0x04, 0xeb, 0x5f, 0x83, 0xc2, 0xb1, 0xb4, 0xe5,
-	0xc7, 0xa4, 0x2d, 0xc7, 0x69, 0x2f, 0x81, 0x85, 0x7b, 0x3b, 0xd2, 0xed, 0x27, 0x75, 0x12, 0xcf,
-	0xd9, 0x0f, 0x01, 0x1c, 0xf5, 0xe7, 0xcf, 0x21, 0xaf, 0x9f, 0x7e, 0xcd, 0x72, 0x47, 0x38, 0x62,
-	0x63, 0x61, 0xfd, 0x89, 0x5c, 0x38, 0x2b, 0x2f, 0x7e, 0xf2, 0xa7, 0x67, 0xc1, 0x3d, 0xa5, 0x6e,
-	0xcb, 0xac, 0x41, 0x98, 0xf8, 0xd7, 0x98, 0x1c, 0x7c, 0x23, 0x56, 0xe2, 0xff, 0xc9, 0x69, 0xdf,
-	0x50, 0x9c, 0x66, 0x5d, 0x61, 0xcc, 0x72, 0x2a, 0x95, 0x51, 0xc1, 0xea, 0xc6, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0x21, 0x02, 0x3f, 0x3c, 0x4f, 0x1c, 0x00, 0x00,
+	// 2545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x6c, 0x1b, 0xc7,
+	0xf9, 0xff, 0x2f, 0x49, 0x51, 0xe4, 0xd8, 0x91, 0xe4, 0x91, 0x2d, 0x53, 0xb4, 0x63, 0xcb, 0x6b,
+	0x39, 0x96, 0x14, 0x93, 0xb4, 0xe9, 0x7f, 0x9a, 0x58, 0x45, 0xa2, 0x92, 0x92, 0x2c, 0xcb, 0xb5,
+	0x55, 0x79, 0x29, 0x59, 0xb0, 0x1d, 0x9b, 0x58, 0xee, 0x8e, 0xc8, 0x2d, 0x97, 0xbb, 0x9b, 0x9d,
+	0x59, 0x2a, 0xf2, 0x03, 0x05, 0x82, 0xb4, 0x05, 0xd2, 0xa2, 0x07, 0x17, 0x01, 0x7a, 0x6d, 0x0b,
+	0x14, 0xa8, 0x2f, 0x45, 0x5b, 0xa0, 0xbd, 0x05, 0x01, 0x8a, 0x9e, 0x7a, 0x69, 0x91, 0x63, 0x6f,
+	0x7d, 0x1d, 0x5a, 0x20, 0x28, 0x7a, 0x14, 0xd0, 0xa2, 0x98, 0xd9, 0x59, 0x3e, 0x76, 0x49, 0x8a,
+	0xf2, 0x43, 0x81, 0x81, 0x5e, 0xec, 0xdd, 0x9d, 0xef, 0xfb, 0xe6, 0xf7, 0xbd, 0xbf, 0x19, 0x11,
+	0x4c, 0xeb, 0xa6, 0x2d, 0x6f, 0xc9, 0x46, 0x0a, 0x13, 0x59, 0xa9, 0x66, 0x64, 0x4b, 0xcb, 0xc8,
+	0x96, 0xa5, 0x6b, 0x8a, 0x4c, 0x34, 0xd3, 0xc0, 0xc8, 0xae, 0x23, 0x3b, 0x6d, 0xd9, 0x26, 0x31,
+	0xe1, 0x10, 0x21, 0x46, 0x9a, 0x93, 0xa7, 0xeb, 0x17, 0x93, 0xb9, 0xb2, 0x46, 0x2a, 0x4e, 0x29,
+	0xad, 0x98, 0xb5, 0x0c, 0x32, 0xea, 0xe6, 0xb6, 0x65, 0x9b, 0xef, 0x6f, 0x67, 0x18, 0xb1, 0x92,
+	0x2a, 0x23, 0x23, 0x55, 0x97, 0x75, 0x4d, 0x95, 0x09, 0xca, 0x04, 0x1e, 0x5c, 0x91, 0xc9, 0x54,
+	0x8b, 0x88, 0xb2, 0x59, 0x36, 0x5d, 0xe6, 0x92, 0xb3, 0xc9, 0xde, 0xd8, 0x0b, 0x7b, 0xe2, 0xe4,
+	0x0b, 0x2d, 0xe4, 0x6b, 0x15, 0xb4, 0x56, 0xd1, 0x8c, 0x32, 0x5e, 0x36, 0x54, 0x07, 0x13, 0x5b,
+	0x43, 0xb8, 0x75, 0xeb, 0xb2, 0x99, 0xda, 0xd4, 0xe5, 0x32, 0xce, 0xc8, 0x86, 0x61, 0x12, 0x57,
+	0x19, 0x2e, 0x65, 0x7e, 0x4f, 0x52, 0xbe, 0x8e, 0x4d, 0xa3, 0x83, 0x90, 0xe3, 0x65, 0xd3, 0x2c,
+	0xeb, 0xc8, 0x35, 0x58, 0x60, 0xf5, 0x04, 0x5f, 0x6d, 0xa8, 0xa3, 0x3a, 0x36, 0x23, 0xe0, 0xeb,
+	0xc7, 0xfc, 0xeb, 0xa8, 0x66, 0x91, 0x6d, 0xbe, 0x38, 0xe1, 0x5f, 0xdc, 0xd4, 0x90, 0xae, 0x16,
+	0x6b, 0x32, 0xae, 0xfa, 0x36, 0x6f, 0x50, 0x60, 0x62, 0x3b, 0x0a, 0xe1, 0xab, 0x27, 0xfd, 0xab,
+	0x44, 0xab, 0x21, 0x4c, 0xe4, 0x9a, 0xd5, 0x0d, 0xdd, 0x96, 0x2d, 0x5b, 0x16, 0xb2, 0x3d, 0xf4,
+	0x62, 0x30, 0x26, 0x90, 0xa1, 0x16, 0x55, 0x54, 0xd7, 0x14, 0xcf, 0x73, 0xa7, 0x83, 0x34, 0x9a,
+	0x8a, 0x0c, 0xa2, 0x6d, 0x6a, 0x4d, 0x41, 0x13, 0x41, 0xa2, 0x1a, 0xc2, 0x58, 0x2e, 0xa3, 0x86,
+	0x19, 0x3b, 0x50, 0xbc, 0x47, 0xb8, 0x26, 0xe2, 0x4f, 0x42, 0x60, 0x38, 0xd7, 0x8c, 0xc6, 0x6b,
+	0x9a, 0x51, 0x85, 0x1b, 0x00, 0xaa, 0x68, 0x53, 0x76, 0x74, 0x52, 0xdc, 0x34, 0xed, 0x9a, 0x4c,
+	0x08, 0xb2, 0x71, 0x22, 0x3c, 0x21, 0x4c, 0x1d, 0xc8, 0x4e, 0xa5, 0xdb, 0x43, 0x34, 0x7d, 0xdd,
+	0xdd, 0x6d, 0x55, 0xde, 0xd6, 0x4d, 0x59, 0xbd, 0xdc, 0xa0, 0x97, 0x0e, 0x71, 0x19, 0xcd, 0x4f,
+	0xf0, 0x2a, 0x18, 0xc5, 0x55, 0xcd, 0x2a, 0x5a, 0x2e, 0x71, 0x51, 0xb1, 0xb7, 0x2d, 0x62, 0x26,
+	0x06, 0x98, 0xe4, 0x64, 0xda, 0xb5, 0x59, 0xda, 0xb3, 0x59, 0x3a, 0x6f, 0x9a, 0xfa, 0x4d, 0x59,
+	0x77, 0x90, 0x74, 0x88, 0xb2, 0xf1, 0x2d, 0xe6, 0x19, 0x13, 0x94, 0xc0, 0xb0, 0x6a, 0x6e, 0x19,
+	0xba, 0x66, 0x54, 0x8b, 0x96, 0xa9, 0x6b, 0xca, 0x76, 0x22, 0xca, 0xe4, 0x4c, 0xfb, 0x11, 0xb6,
+	0xa8, 0xb7, 0xc0, 0x39, 0x56, 0x19, 0x83, 0x34, 0xa4, 0xb6, 0xbd, 0xcf, 0xc6, 0xfe, 0xf5, 0x64,
+	0x3c, 0x12, 0x13, 0x46, 0x84, 0xab, 0xf4, 0xdf, 0xd0, 0xd5, 0x48, 0x2c, 0x34, 0x12, 0xbe, 0x1a,
+	0x89, 0x45, 0x46, 0x06, 0xc4, 0x9f, 0x0b, 0x60, 0x7c, 0x09, 0x11, 0x9f, 0xad, 0x24, 0xf4, 0x9e,
+	0x83, 0x30, 0x81, 0xb7, 0xc0, 0x70, 0x4b, 0x4e, 0x17, 0x35, 0x15, 0x27, 0x04, 0x86, 0xe6, 0xb5,
+	0x1e, 0x68, 0x96, 0x9b, 0xde, 0xcc, 0xc7, 0x76, 0xf2, 0x03, 0x1f, 0x09, 0xa1, 0x11, 0x41, 0x1a,
+	0x92, 0x5b, 0x29, 0x30, 0xbc, 0x04, 0x40, 0x33, 0x3a, 0x13, 0xa1, 0x2e, 0xb6, 0xba, 0x4c, 0x49,
+	0xae, 0xcb, 0xb8, 0x2a, 0xc5, 0x37, 0xbd, 0x47, 0xf1, 0x67, 0x21, 0x30, 0x5e, 0xf8, 0x22, 0x30,
+	0xbf, 0x0d, 0x22, 0xd4, 0xac, 0x1c, 0xed, 0xc9, 0x1e, 0xf2, 0x28, 0xa0, 0x16, 0x41, 0x8c, 0xcd,
+	0xa7, 0x72, 0x78, 0x0f, 0x2a, 0xc3, 0xb7, 0xc1, 0x31, 0x16, 0x62, 0x8d, 0xc0, 0x2d, 0x12, 0x84,
+	0x49, 0xb1, 0x8e, 0x14, 0x62, 0xda, 0x38, 0x11, 0x99, 0x10, 0xa6, 0x62, 0x52, 0x82, 0x92, 0x34,
+	0xe2, 0x72, 0x0d, 0x61, 0x72, 0xd3, 0x5d, 0x17, 0xff, 0x1d, 0x06, 0x87, 0x7d, 0xe8, 0x0a, 0x44,
+	0x26, 0x18, 0xbe, 0x09, 0xe2, 0x14, 0x1a, 0x52, 0x8b, 0x32, 0xe1, 0x66, 0x0a, 0x22, 0x5a, 0xf3,
+	0xaa, 0x80, 0x14, 0x73, 0x89, 0x73, 0x04, 0xfe, 0x56, 0x00, 0x63, 0x06, 0x22, 0x5b, 0xa6, 0x5d,
+	0x2d, 0xba, 0xb5, 0xbe, 0x28, 0xab, 0xaa, 0x8d, 0x30, 0x66, 0xd6, 0x89, 0xe7, 0xbf, 0x27, 0xec,
+	0xe4, 0x3f, 0x12, 0xec, 0x6f, 0x0b, 0xd9, 0x0f, 0x85, 0x7b, 0x53, 0x73, 0xb3, 0x53, 0x73, 0xb3,
+	0x77, 0xe4, 0xd4, 0xfd, 0x5c, 0xea, 0xf6, 0xf9, 0xd4, 0xa5, 0xbb, 0x0f, 0x5b, 0x9e, 0x9b, 0x8f,
+	0xef, 0xa6, 0xee, 0xce, 0xb4, 0x2c, 0x4c, 0xbf, 0x9b, 0x9e, 0x9e, 0xa1, 0x7c, 0xb9, 0xd4, 0x6d,
+	0x39, 0x75, 0xdf, 0xe5, 0x6b, 0x3e, 0x37, 0x1f, 0x19, 0x5f, 0x73, 0x61, 0x7a, 0x6a, 0x6e, 0x76,
+	0xf6, 0x0e, 0x7d, 0x7a, 0x70, 0xe1, 0xdc, 0x1b, 0x8f, 0xa6, 0xe7, 0x26, 0x1f, 0xde, 0x9b, 0x94,
+	0x0e, 0x73, 0xb8, 0x05, 0x86, 0x36, 0xe7, 0x82, 0x85, 0xcb, 0x60, 0x54, 0x97, 0x31, 0x29, 0x3a,
+	0x56, 0xd1, 0x46, 0x0a, 0xd2, 0xea, 0xae, 0x29, 0xc2, 0xbb, 0x9a, 0x62, 0x84, 0xb2, 0xad, 0x5b,
+	0x12, 0x67, 0xca, 0x11, 0x38, 0x0e, 0x62, 0x8e, 0x55, 0x54, 0x4c, 0xc7, 0x20, 0xcc, 0x21, 0x11,
+	0x69, 0xd0, 0xb1, 0xe6, 0xe9, 0x2b, 0xdc, 0x00, 0x49, 0xb6, 0x4b, 0x23, 0xb5, 0x37, 0x4d, 0x7b,
+	0x4b, 0xb6, 0x55, 0x77, 0xb3, 0x81, 0x5d, 0x37, 0x3b, 0x4a, 0xb9, 0xbd, 0x2c, 0xbf, 0xec, 0xf1,
+	0xe6, 0x08, 0x3c, 0x03, 0x1a, 0xc9, 0xce, 0x77, 0x8e, 0xb2, 0x9d, 0x5f, 0xf1, 0xbe, 0xb2, 0xfd,
+	0xc5, 0xff, 0x44, 0xc0, 0x70, 0x0e, 0xcf, 0x9b, 0xc6, 0xa6, 0x56, 0xe6, 0xfd, 0x04, 0xbe, 0x03,
+	0xa2, 0x96, 0x53, 0xc2, 0x4e, 0xa9, 0x6b, 0x7a, 0xb4, 0x33, 0xa4, 0x57, 0x9d, 0x52, 0xc1, 0x29,
+	0x49, 0x9c, 0x0b, 0x2e, 0x80, 0xd8, 0x16, 0x2a, 0x55, 0x4c, 0xb3, 0x8a, 0x79, 0x42, 0x4c, 0xed,
+	0x26, 0x61, 0x83, 0xd3, 0x4b, 0x0d, 0xce, 0xe4, 0xa7, 0x21, 0x10, 0x75, 0x05, 0xc3, 0x15, 0x10,
+	0xb7, 0x6c, 0xb3, 0xae, 0xa9, 0xb4, 0x2c, 0xbb, 0x98, 0xce, 0xf7, 0x87, 0x29, 0xbd, 0xea, 0xf1,
+	0x49, 0x4d, 0x11, 0xc9, 0xbf, 0x0a, 0x20, 0xde, 0x58, 0x80, 0x5f, 0x05, 0x11, 0xda, 0x1f, 0x98,
+	0xe0, 0xa1, 0xec, 0x9b, 0x7b, 0x15, 0x9c, 0xa6, 0xf9, 0xe2, 0x60, 0x89, 0x09, 0xa1, 0xc2, 0x0c,
+	0x99, 0xb8, 0x7a, 0x3f, 0x8b, 0x30, 0x2a, 0x44, 0x7c, 0x0b, 0x44, 0xdd, 0x77, 0x78, 0x00, 0x0c,
+	0x2e, 0xae, 0xe4, 0xf2, 0xd7, 0x16, 0x17, 0x46, 0xfe, 0x8f, 0xbe, 0x6c, 0xe4, 0xa4, 0x95, 0xe5,
+	0x95, 0xa5, 0x11, 0x01, 0x1e, 0x04, 0xb1, 0x85, 0xe5, 0x82, 0xbb, 0x14, 0x4a, 0x46, 0xff, 0xfe,
+	0x64, 0x3c, 0x94, 0xa0, 0xe5, 0x3c, 0x3c, 0x12, 0x49, 0xfe, 0x50, 0x00, 0x31, 0xcf, 0xb2, 0xf0,
+	0x2b, 0xe0, 0xb8, 0x63, 0x54, 0x90, 0xac, 0x93, 0xca, 0x76, 0x91, 0xd6, 0x81, 0x9a, 0x45, 0x70,
+	0x91, 0x54, 0x6c, 0x84, 0x2b, 0xa6, 0xae, 0x32, 0xf5, 0xc3, 0x52, 0xb2, 0x41, 0x93, 0xe3, 0x24,
+	0x6b, 0x1e, 0x05, 0x2c, 0x80, 0x44, 0x53, 0x82, 0x8d, 0x88, 0xbd, 0x5d, 0xd4, 0x0c, 0x82, 0xec,
+	0xba, 0xac, 0x73, 0x3f, 0x8f, 0x07, 0x22, 0x75, 0x81, 0x6b, 0x2a, 0x8d, 0x35, 0x58, 0x25, 0xca,
+	0xb9, 0xcc, 0x19, 0xc5, 0x63, 0x6e, 0x97, 0x69, 0xb7, 0x0b, 0xaf, 0xd8, 0xa2, 0x02, 0x92, 0x9d,
+	0x16, 0xb1, 0x45, 0xc7, 0x48, 0xb8, 0x08, 0x5e, 0x51, 0x5a, 0x17, 0x78, 0x68, 0x9c, 0xdc, 0xc5,
+	0xe8, 0x52, 0x3b, 0x97, 0x78, 0x1a, 0x9c, 0x5a, 0x42, 0x64, 0x85, 0x56, 0x47, 0x5d, 0xbb, 0x8f,
+	0x54, 0xde, 0x76, 0x0b, 0x4a, 0x05, 0xd5, 0x64, 0x0f, 0x89, 0x09, 0xc4, 0x5e, 0x44, 0x1c, 0x51,
+	0x02, 0x0c, 0xd6, 0x91, 0x8d, 0x3d, 0x2c, 0x71, 0xc9, 0x7b, 0x85, 0x19, 0x10, 0xc5, 0x8c, 0x96,
+	0x5b, 0xea, 0x68, 0xc0, 0x52, 0x05, 0x36, 0x6f, 0x49, 0x9c, 0x4c, 0xfc, 0x66, 0x08, 0x1c, 0xa1,
+	0x85, 0xba, 0x65, 0xc0, 0x78, 0xf1, 0x6d, 0x6c, 0x09, 0xc4, 0xdd, 0x89, 0xac, 0xa8, 0xa9, 0xbc,
+	0x5a, 0xcf, 0xec, 0xe4, 0xcf, 0xda, 0x67, 0x12, 0x93, 0xd9, 0x53, 0xf7, 0xee, 0xf0, 0x2a, 0x4a,
+	0x0b, 0x6f, 0xea, 0xee, 0x9c, 0xf7, 0x3a, 0xfd, 0x20, 0x7b, 0xee, 0x11, 0x2b, 0xa4, 0x31, 0x97,
+	0x79, 0x59, 0x85, 0x57, 0x00, 0x78, 0x86, 0x49, 0xaa, 0x85, 0x57, 0xfc, 0x93, 0x00, 0xc6, 0xfc,
+	0x76, 0xe0, 0xd6, 0x5e, 0x02, 0x83, 0x36, 0xc2, 0x8e, 0x4e, 0xa8, 0x01, 0xc2, 0x53, 0x07, 0xb2,
+	0x29, 0xff, 0x0e, 0x9d, 0x19, 0xd3, 0x12, 0xe3, 0x92, 0x3c, 0xee, 0xe4, 0x37, 0x40, 0xd4, 0xfd,
+	0x04, 0x21, 0x4d, 0xdf, 0x1a, 0xe2, 0xde, 0x63, 0xcf, 0x70, 0x0c, 0x44, 0x2d, 0x19, 0x63, 0xe4,
+	0x5a, 0x24, 0x26, 0xf1, 0x37, 0xb8, 0x0c, 0xa2, 0xa6, 0x43, 0x2c, 0xc7, 0xeb, 0x09, 0x17, 0xfa,
+	0xd4, 0xaf, 0xd9, 0x7e, 0x25, 0x2e, 0x40, 0xac, 0x82, 0xa3, 0x2b, 0x38, 0x87, 0xaf, 0xc8, 0x86,
+	0xaa, 0xa3, 0x75, 0x4b, 0x6f, 0x19, 0x5a, 0x56, 0xdb, 0xbd, 0xed, 0x58, 0x9e, 0xb2, 0xaf, 0xf6,
+	0xf0, 0xf6, 0xba, 0xc5, 0x9c, 0xfc, 0x58, 0x08, 0xc5, 0xda, 0x9d, 0xbc, 0x6e, 0x61, 0xf1, 0xf3,
+	0x10, 0x38, 0xb2, 0x68, 0x28, 0xa6, 0x8a, 0xbc, 0xbe, 0xe1, 0xed, 0xb5, 0x06, 0x86, 0x9a, 0x43,
+	0x79, 0x4b, 0x60, 0x4d, 0xfa, 0xb7, 0x5a, 0x34, 0xd4, 0x05, 0xee, 0xea, 0x4e, 0x61, 0x75, 0x10,
+	0x35, 0xd7, 0x31, 0xbc, 0x06, 0x0e, 0xf0, 0x2c, 0x60, 0x22, 0xdd, 0xf8, 0x7f, 0xbd, 0xab, 0xc8,
+	0x9b, 0x2e, 0x6d, 0x8b, 0x64, 0x09, 0xd4, 0xbd, 0x6f, 0xb4, 0x2d, 0xc7, 0xbc, 0x0e, 0xc6, 0xed,
+	0x7e, 0xba, 0x8f, 0xf9, 0xb7, 0x05, 0x5c, 0x83, 0x1d, 0x5e, 0x01, 0xf1, 0x46, 0xa0, 0xb1, 0xbe,
+	0x3c, 0x94, 0x9d, 0xf0, 0xcb, 0xf2, 0x3b, 0x8f, 0x09, 0xfa, 0x80, 0x09, 0x6a, 0x32, 0xc3, 0xe3,
+	0x20, 0x6e, 0xc9, 0xb6, 0x5c, 0x43, 0x54, 0xd2, 0x00, 0x8b, 0x9d, 0xe6, 0x07, 0xf1, 0x16, 0x18,
+	0xf3, 0xdb, 0x9b, 0x47, 0xf0, 0x5c, 0x8b, 0x32, 0x42, 0xdf, 0xca, 0x34, 0x55, 0x10, 0xff, 0x16,
+	0x02, 0xa3, 0x0b, 0x88, 0xca, 0x6e, 0x8f, 0x9a, 0x97, 0xc1, 0x93, 0xf3, 0x20, 0xea, 0x58, 0x2d,
+	0x7e, 0x3c, 0xd5, 0x33, 0xa0, 0x7d, 0x5e, 0xe4, 0xac, 0xfb, 0xe6, 0xc3, 0x1b, 0xe0, 0x70, 0xbb,
+	0x9d, 0xb9, 0x07, 0x2f, 0x35, 0x94, 0x10, 0xfa, 0x54, 0xc2, 0x83, 0xce, 0xf2, 0xd0, 0x95, 0xf9,
+	0xbf, 0x3c, 0xdc, 0xaf, 0x3c, 0xf4, 0xdb, 0xfb, 0x79, 0xe5, 0xe1, 0xe7, 0x02, 0x38, 0x5a, 0x70,
+	0x4a, 0x58, 0xb1, 0xb5, 0x12, 0xa2, 0x33, 0x4f, 0x49, 0x47, 0xfb, 0xd0, 0xaf, 0xe7, 0x41, 0x4c,
+	0x31, 0x0d, 0xec, 0xd4, 0x90, 0xcd, 0xdb, 0xf5, 0xd9, 0x9d, 0xfc, 0xa4, 0x2d, 0x26, 0x26, 0xb3,
+	0x27, 0x7a, 0xb7, 0x6b, 0xa9, 0xc1, 0x08, 0x2f, 0x82, 0xa8, 0xe2, 0xd8, 0xd8, 0xb4, 0x99, 0x1f,
+	0xe3, 0xf9, 0x63, 0x3b, 0xf9, 0x84, 0x3d, 0x96, 0x98, 0xc9, 0xc2, 0x7b, 0x53, 0xec, 0xac, 0xf4,
+	0x7a, 0xca, 0xfd, 0x6f, 0x7a, 0x6e, 0x52, 0xe2, 0xa4, 0xe2, 0x3a, 0x38, 0xcc, 0xd5, 0x6c, 0x0b,
+	0x70, 0x38, 0x04, 0x42, 0x9a, 0xca, 0xdb, 0x67, 0x48, 0x53, 0x61, 0x0a, 0x84, 0x1c, 0x8b, 0xc7,
+	0x5a, 0xef, 0x8e, 0x25, 0x85, 0x1c, 0x4b, 0xfc, 0xa7, 0x00, 0xc6, 0x73, 0x4a, 0xd5, 0x30, 0xb7,
+	0x74, 0xa4, 0x96, 0x5f, 0x3e, 0x4b, 0x86, 0x29, 0xa6, 0xf0, 0x44, 0x78, 0x2a, 0x9e, 0x3f, 0xb5,
+	0x93, 0x3f, 0xf1, 0x58, 0x38, 0x16, 0x13, 0x46, 0x54, 0xf1, 0x88, 0x3d, 0x9a, 0x98, 0xc9, 0x0e,
+	0xdf, 0x6b, 0x33, 0xe7, 0xa4, 0x44, 0xa9, 0xc5, 0x4f, 0x04, 0x70, 0x7c, 0x01, 0xe9, 0x88, 0x78,
+	0xda, 0xce, 0x73, 0x71, 0x2f, 0x89, 0xd6, 0xd9, 0xef, 0xc6, 0x41, 0x28, 0x87, 0xe1, 0xc7, 0x02,
+	0x18, 0x5c, 0x42, 0x84, 0x5d, 0xa8, 0x05, 0xae, 0xa4, 0xba, 0x5e, 0x24, 0x25, 0x77, 0xbb, 0x2b,
+	0x11, 0xdf, 0xf9, 0xe0, 0xb3, 0xbf, 0x7c, 0x3f, 0xf4, 0x16, 0xfc, 0x52, 0x46, 0xc6, 0x6d, 0xf7,
+	0xc8, 0x99, 0x07, 0x3e, 0xb3, 0xa4, 0xdb, 0xdf, 0x1f, 0x65, 0x58, 0x75, 0xf9, 0x81, 0x00, 0x06,
+	0x0b, 0xdd, 0x70, 0x15, 0x9e, 0x1e, 0x57, 0x8e, 0xe1, 0xfa, 0x72, 0xf2, 0x29, 0x71, 0xcd, 0x0a,
+	0x33, 0xf0, 0x21, 0x00, 0xae, 0xe7, 0x19, 0xb8, 0x3e, 0xdd, 0x99, 0x1c, 0x0b, 0x1c, 0x1d, 0x16,
+	0x6b, 0x16, 0xd9, 0x16, 0xd3, 0x0c, 0xd0, 0xd4, 0xcc, 0x6b, 0xbb, 0x01, 0xe2, 0x86, 0x79, 0x2c,
+	0x80, 0x83, 0xdc, 0x61, 0xee, 0x95, 0x4f, 0xbf, 0x00, 0x26, 0x77, 0x31, 0x0d, 0x93, 0x26, 0xfe,
+	0x3f, 0x83, 0x93, 0x86, 0xe7, 0xfa, 0x83, 0x93, 0xc1, 0x0c, 0xc3, 0x87, 0x02, 0x18, 0x59, 0x42,
+	0xa4, 0xfd, 0x42, 0xa2, 0x63, 0x38, 0x75, 0x3c, 0x31, 0x26, 0x67, 0xfa, 0x21, 0x75, 0xab, 0xbe,
+	0x38, 0xce, 0x10, 0x8e, 0xc2, 0x43, 0x14, 0x61, 0xdb, 0x99, 0x10, 0x3e, 0x11, 0xd8, 0xc9, 0xb3,
+	0xcb, 0x79, 0x0f, 0x5e, 0xe8, 0xb0, 0x4b, 0xef, 0x03, 0x64, 0x32, 0xbb, 0x17, 0x16, 0x0e, 0xf0,
+	0x0c, 0x03, 0x78, 0x12, 0xbe, 0x4a, 0x01, 0x1a, 0x0d, 0xe2, 0x14, 0xbf, 0x4e, 0xce, 0xb8, 0x47,
+	0x45, 0xf8, 0x9d, 0x10, 0x18, 0x6a, 0x3f, 0xe9, 0xc0, 0x33, 0xbb, 0x9d, 0x84, 0x5c, 0x50, 0xaf,
+	0xf5, 0x77, 0x60, 0x12, 0x7f, 0x2a, 0x30, 0x24, 0x3f, 0x12, 0xc4, 0xdc, 0xde, 0xa3, 0xbd, 0x79,
+	0x98, 0xcb, 0x10, 0x84, 0xc9, 0xac, 0x30, 0x73, 0xfb, 0xb6, 0xb8, 0xbe, 0x77, 0x39, 0xee, 0x38,
+	0x84, 0x33, 0x0f, 0x1a, 0x73, 0x51, 0x27, 0xd9, 0xd9, 0x0d, 0x10, 0xa1, 0x67, 0x29, 0xf8, 0x35,
+	0x70, 0xb0, 0xf5, 0x3c, 0x05, 0xcf, 0xfa, 0x75, 0xed, 0x72, 0xe2, 0xea, 0x96, 0x5f, 0xd9, 0xdf,
+	0x0f, 0x83, 0x81, 0x9c, 0x65, 0xe5, 0x30, 0x5c, 0x03, 0xf1, 0x46, 0xb3, 0xef, 0x3b, 0x6b, 0x7a,
+	0x77, 0xbf, 0xf3, 0x02, 0x54, 0xc0, 0x88, 0x7f, 0x84, 0x08, 0x82, 0xee, 0x32, 0x64, 0x04, 0x73,
+	0xb2, 0x53, 0x77, 0x3e, 0x2f, 0xc0, 0x0d, 0x00, 0x83, 0xfd, 0x35, 0x98, 0x60, 0x5d, 0x7b, 0x70,
+	0x37, 0xeb, 0xc0, 0xbb, 0x74, 0x98, 0xed, 0xd0, 0xc5, 0xe0, 0xb9, 0x00, 0xb2, 0x1e, 0xcd, 0xae,
+	0xab, 0xf8, 0xdf, 0x09, 0xe0, 0x90, 0x37, 0x77, 0xdd, 0x70, 0x90, 0x83, 0x56, 0x1d, 0x5c, 0x81,
+	0x41, 0xad, 0x5b, 0x49, 0x76, 0x91, 0x29, 0xbe, 0xcf, 0x82, 0xda, 0x16, 0x6b, 0xc1, 0x58, 0x6c,
+	0x1f, 0xbf, 0xd3, 0x7d, 0x87, 0xa6, 0x8f, 0xaf, 0x25, 0x52, 0xe9, 0xa8, 0x98, 0xb1, 0x1c, 0x5c,
+	0xa1, 0x85, 0xff, 0x0f, 0x02, 0x38, 0xec, 0x83, 0x6a, 0xe9, 0xb2, 0x82, 0x9e, 0x51, 0xa1, 0x07,
+	0x4c, 0x21, 0x47, 0xb4, 0xf6, 0x4d, 0x21, 0xdb, 0xc5, 0x4d, 0x75, 0xfa, 0xa5, 0xdf, 0x43, 0xd7,
+	0x34, 0x4c, 0x60, 0x5f, 0x47, 0x96, 0x9e, 0x1d, 0xc5, 0x93, 0x89, 0x45, 0x89, 0xa9, 0x77, 0x0d,
+	0x5e, 0x7d, 0x3e, 0xb5, 0x83, 0x2a, 0x00, 0x7f, 0x2c, 0x80, 0x23, 0x4b, 0x88, 0x5c, 0xbf, 0xb1,
+	0xb6, 0x36, 0x6f, 0x1a, 0x06, 0x52, 0x58, 0xda, 0x1a, 0x9b, 0x66, 0xdf, 0x79, 0x2d, 0x06, 0xae,
+	0x7d, 0x02, 0xb2, 0xfa, 0x9f, 0x61, 0x1e, 0xb1, 0x3f, 0x55, 0xa6, 0x94, 0x06, 0x7b, 0x4a, 0xa3,
+	0x58, 0x7e, 0x23, 0x80, 0xa1, 0x82, 0x56, 0x73, 0x74, 0x99, 0x78, 0xe5, 0xac, 0x77, 0x39, 0xe9,
+	0x1a, 0x22, 0xf7, 0x19, 0x12, 0x22, 0x9a, 0xfb, 0x11, 0x22, 0x8e, 0x95, 0xc1, 0x1c, 0x35, 0x8d,
+	0x90, 0x3f, 0x0a, 0x60, 0xa8, 0xfd, 0x22, 0x24, 0xd8, 0xa7, 0x3a, 0x5e, 0x4c, 0x05, 0xfb, 0x54,
+	0xe7, 0xfb, 0x94, 0xfd, 0xd5, 0x8e, 0x25, 0x00, 0x62, 0x40, 0xa8, 0x76, 0x9f, 0x09, 0xe0, 0x60,
+	0xeb, 0x15, 0x01, 0x3c, 0x1d, 0x2c, 0x7c, 0x81, 0x8b, 0x9a, 0x0e, 0x75, 0xbb, 0xc3, 0x2d, 0xc3,
+	0xfe, 0x56, 0x2a, 0xc7, 0xca, 0xa8, 0xc8, 0xd3, 0x8a, 0xfa, 0xac, 0xfd, 0xd0, 0x1c, 0xf4, 0x59,
+	0xc7, 0x4b, 0x8c, 0xa0, 0xcf, 0x3a, 0x9f, 0xbd, 0xbf, 0x00, 0x9f, 0x35, 0xb4, 0xcb, 0xfe, 0x23,
+	0x02, 0x46, 0x73, 0xb8, 0x51, 0x92, 0x24, 0x54, 0xd6, 0x30, 0xb1, 0xb7, 0xe1, 0x2f, 0x04, 0x10,
+	0x5e, 0x42, 0x24, 0xe8, 0xc2, 0x25, 0x44, 0x5a, 0xa8, 0x5d, 0x45, 0xc7, 0xbb, 0x96, 0x38, 0xb1,
+	0xca, 0x74, 0x43, 0x50, 0xd9, 0x07, 0xdd, 0xe0, 0xb7, 0x42, 0x20, 0x5c, 0xe8, 0x04, 0xba, 0xb0,
+	0x37, 0xd0, 0x9f, 0xb8, 0xc3, 0xde, 0xaf, 0x85, 0x64, 0x4f, 0xd8, 0xe9, 0xa7, 0x84, 0x9d, 0x6e,
+	0x87, 0x4d, 0xc7, 0xc1, 0xeb, 0xe2, 0x95, 0xe7, 0xb5, 0x13, 0x8d, 0xd9, 0x8f, 0x05, 0x10, 0x75,
+	0x87, 0x8c, 0x3e, 0xdb, 0x4f, 0xb7, 0x62, 0x79, 0x9d, 0x19, 0x62, 0x69, 0x66, 0xf1, 0xb9, 0x34,
+	0x9c, 0xec, 0xaf, 0x42, 0xe0, 0xa8, 0xff, 0x1e, 0xab, 0x80, 0x6c, 0xba, 0x0e, 0x6f, 0xbd, 0xb0,
+	0xe2, 0x01, 0x8b, 0x2f, 0xb8, 0xea, 0xd2, 0x0d, 0x5e, 0x68, 0x89, 0xc8, 0xbf, 0xf1, 0xe9, 0x9f,
+	0x4f, 0x08, 0xb7, 0x33, 0x65, 0x33, 0x4d, 0x2a, 0x88, 0xb0, 0xdf, 0x56, 0xa5, 0xf9, 0xdf, 0xec,
+	0x33, 0xed, 0x3f, 0xf6, 0xa9, 0x5f, 0xcc, 0x58, 0xd5, 0x72, 0x86, 0x10, 0xc3, 0x2a, 0x95, 0xa2,
+	0xcc, 0x9d, 0x17, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x90, 0x04, 0x17, 0x44, 0x90, 0x26, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1104,6 +1617,10 @@ type AsClient interface {
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
 	GetConfiguration(ctx context.Context, in *GetAsConfigurationRequest, opts ...grpc.CallOption) (*GetAsConfigurationResponse, error)
+	// Get the JSON Schema of the normalized payload.
+	GetNormalizedPayloadSchema(ctx context.Context, in *GetNormalizedPayloadSchemaRequest, opts ...grpc.CallOption) (*GetNormalizedPayloadSchemaResponse, error)
+	// Run the test vectors of the payload formatters of the application or end device.
+	TestFormatters(ctx context.Context, in *TestFormattersRequest, opts ...grpc.CallOption) (*TestFormattersResponse, error)
 }
 
 type asClient struct {
@@ -1159,6 +1676,24 @@ func (c *asClient) GetConfiguration(ctx context.Context, in *GetAsConfigurationR
 	return out, nil
 }
 
+func (c *asClient) GetNormalizedPayloadSchema(ctx context.Context, in *GetNormalizedPayloadSchemaRequest, opts ...grpc.CallOption) (*GetNormalizedPayloadSchemaResponse, error) {
+	out := new(GetNormalizedPayloadSchemaResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/GetNormalizedPayloadSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) TestFormatters(ctx context.Context, in *TestFormattersRequest, opts ...grpc.CallOption) (*TestFormattersResponse, error) {
+	out := new(TestFormattersResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/TestFormatters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsServer is the server API for As service.
 type AsServer interface {
 	// Get a link configuration from the Application Server to Network Server.
@@ -1176,6 +1711,10 @@ type AsServer interface {
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(context.Context, *ApplicationIdentifiers) (*ApplicationLinkStats, error)
 	GetConfiguration(context.Context, *GetAsConfigurationRequest) (*GetAsConfigurationResponse, error)
+	// Get the JSON Schema of the normalized payload.
+	GetNormalizedPayloadSchema(context.Context, *GetNormalizedPayloadSchemaRequest) (*GetNormalizedPayloadSchemaResponse, error)
+	// Run the test vectors of the payload formatters of the application or end device.
+	TestFormatters(context.Context, *TestFormattersRequest) (*TestFormattersResponse, error)
 }
 
 // UnimplementedAsServer can be embedded to have forward compatible implementations.
@@ -1197,6 +1736,12 @@ func (*UnimplementedAsServer) GetLinkStats(ctx context.Context, req *Application
 func (*UnimplementedAsServer) GetConfiguration(ctx context.Context, req *GetAsConfigurationRequest) (*GetAsConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
+func (*UnimplementedAsServer) GetNormalizedPayloadSchema(ctx context.Context, req *GetNormalizedPayloadSchemaRequest) (*GetNormalizedPayloadSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNormalizedPayloadSchema not implemented")
+}
+func (*UnimplementedAsServer) TestFormatters(ctx context.Context, req *TestFormattersRequest) (*TestFormattersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestFormatters not implemented")
+}
 
 func RegisterAsServer(s *grpc.Server, srv AsServer) {
 	s.RegisterService(&_As_serviceDesc, srv)
@@ -1292,6 +1837,42 @@ func _As_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _As_GetNormalizedPayloadSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNormalizedPayloadSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).GetNormalizedPayloadSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/GetNormalizedPayloadSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).GetNormalizedPayloadSchema(ctx, req.(*GetNormalizedPayloadSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_TestFormatters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestFormattersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).TestFormatters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/TestFormatters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).TestFormatters(ctx, req.(*TestFormattersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _As_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.As",
 	HandlerType: (*AsServer)(nil),
@@ -1316,6 +1897,14 @@ var _As_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfiguration",
 			Handler:    _As_GetConfiguration_Handler,
 		},
+		{
+			MethodName: "GetNormalizedPayloadSchema",
+			Handler:    _As_GetNormalizedPayloadSchema_Handler,
+		},
+		{
+			MethodName: "TestFormatters",
+			Handler:    _As_TestFormatters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver.proto",
@@ -1401,6 +1990,13 @@ var _NsAs_serviceDesc = grpc.ServiceDesc{
 type AppAsClient interface {
 	// Subscribe to upstream messages.
 	Subscribe(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (AppAs_SubscribeClient, error)
+	// Subscribe to upstream messages as durable consumer.
+	// Messages are buffered while the consumer is not subscribed, and delivered again until they are acknowledged.
+	SubscribeDurable(ctx context.Context, in *SubscribeDurableRequest, opts ...grpc.CallOption) (AppAs_SubscribeDurableClient, error)
+	// Acknowledge upstream messages delivered to a durable consumer.
+	AcknowledgeDurable(ctx context.Context, in *AcknowledgeDurableRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Delete a durable consumer. Messages are no longer buffered for the consumer.
+	DeleteDurableConsumer(ctx context.Context, in *DeleteDurableConsumerRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// Push downlink messages to the end of the downlink queue.
 	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	// Replace the entire downlink queue with the specified messages.
@@ -1457,6 +2053,56 @@ func (x *appAsSubscribeClient) Recv() (*ApplicationUp, error) {
 	return m, nil
 }
 
+func (c *appAsClient) SubscribeDurable(ctx context.Context, in *SubscribeDurableRequest, opts ...grpc.CallOption) (AppAs_SubscribeDurableClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppAs_serviceDesc.Streams[1], "/ttn.lorawan.v3.AppAs/SubscribeDurable", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appAsSubscribeDurableClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppAs_SubscribeDurableClient interface {
+	Recv() (*DurableApplicationUp, error)
+	grpc.ClientStream
+}
+
+type appAsSubscribeDurableClient struct {
+	grpc.ClientStream
+}
+
+func (x *appAsSubscribeDurableClient) Recv() (*DurableApplicationUp, error) {
+	m := new(DurableApplicationUp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *appAsClient) AcknowledgeDurable(ctx context.Context, in *AcknowledgeDurableRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/AcknowledgeDurable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) DeleteDurableConsumer(ctx context.Context, in *DeleteDurableConsumerRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DeleteDurableConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appAsClient) DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	out := new(types.Empty)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkQueuePush", in, out, opts...)
@@ -1533,6 +2179,13 @@ func (c *appAsClient) DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequ
 type AppAsServer interface {
 	// Subscribe to upstream messages.
 	Subscribe(*ApplicationIdentifiers, AppAs_SubscribeServer) error
+	// Subscribe to upstream messages as durable consumer.
+	// Messages are buffered while the consumer is not subscribed, and delivered again until they are acknowledged.
+	SubscribeDurable(*SubscribeDurableRequest, AppAs_SubscribeDurableServer) error
+	// Acknowledge upstream messages delivered to a durable consumer.
+	AcknowledgeDurable(context.Context, *AcknowledgeDurableRequest) (*types.Empty, error)
+	// Delete a durable consumer. Messages are no longer buffered for the consumer.
+	DeleteDurableConsumer(context.Context, *DeleteDurableConsumerRequest) (*types.Empty, error)
 	// Push downlink messages to the end of the downlink queue.
 	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
 	// Replace the entire downlink queue with the specified messages.
@@ -1556,6 +2209,15 @@ type UnimplementedAppAsServer struct {
 func (*UnimplementedAppAsServer) Subscribe(req *ApplicationIdentifiers, srv AppAs_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (*UnimplementedAppAsServer) SubscribeDurable(req *SubscribeDurableRequest, srv AppAs_SubscribeDurableServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeDurable not implemented")
+}
+func (*UnimplementedAppAsServer) AcknowledgeDurable(ctx context.Context, req *AcknowledgeDurableRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeDurable not implemented")
+}
+func (*UnimplementedAppAsServer) DeleteDurableConsumer(ctx context.Context, req *DeleteDurableConsumerRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDurableConsumer not implemented")
+}
 func (*UnimplementedAppAsServer) DownlinkQueuePush(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueuePush not implemented")
 }
@@ -1606,6 +2268,63 @@ func (x *appAsSubscribeServer) Send(m *ApplicationUp) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _AppAs_SubscribeDurable_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeDurableRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppAsServer).SubscribeDurable(m, &appAsSubscribeDurableServer{stream})
+}
+
+type AppAs_SubscribeDurableServer interface {
+	Send(*DurableApplicationUp) error
+	grpc.ServerStream
+}
+
+type appAsSubscribeDurableServer struct {
+	grpc.ServerStream
+}
+
+func (x *appAsSubscribeDurableServer) Send(m *DurableApplicationUp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AppAs_AcknowledgeDurable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeDurableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).AcknowledgeDurable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/AcknowledgeDurable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).AcknowledgeDurable(ctx, req.(*AcknowledgeDurableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_DeleteDurableConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDurableConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).DeleteDurableConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/DeleteDurableConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).DeleteDurableConsumer(ctx, req.(*DeleteDurableConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AppAs_DownlinkQueuePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DownlinkQueueRequest)
 	if err := dec(in); err != nil {
@@ -1754,6 +2473,14 @@ var _AppAs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.AppAs",
 	HandlerType: (*AppAsServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AcknowledgeDurable",
+			Handler:    _AppAs_AcknowledgeDurable_Handler,
+		},
+		{
+			MethodName: "DeleteDurableConsumer",
+			Handler:    _AppAs_DeleteDurableConsumer_Handler,
+		},
 		{
 			MethodName: "DownlinkQueuePush",
 			Handler:    _AppAs_DownlinkQueuePush_Handler,
@@ -1793,6 +2520,11 @@ var _AppAs_serviceDesc = grpc.ServiceDesc{
 			Handler:       _AppAs_Subscribe_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeDurable",
+			Handler:       _AppAs_SubscribeDurable_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lorawan-stack/api/applicationserver.proto",
 }
@@ -1950,3 +2682,159 @@ var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver.proto",
 }
+
+// PayloadFormatterServiceClient is the client API for PayloadFormatterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PayloadFormatterServiceClient interface {
+	// Decode the binary payload of the uplink message.
+	// The response contains the uplink message with the decoded payload, and optionally the normalized payload.
+	DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error)
+	// Encode the decoded payload of the downlink message.
+	// The response contains the downlink message with the binary payload and the FPort.
+	EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error)
+	// Decode the binary payload of the downlink message.
+	// The response contains the downlink message with the decoded payload.
+	DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error)
+}
+
+type payloadFormatterServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPayloadFormatterServiceClient(cc *grpc.ClientConn) PayloadFormatterServiceClient {
+	return &payloadFormatterServiceClient{cc}
+}
+
+func (c *payloadFormatterServiceClient) DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error) {
+	out := new(DecodeUplinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PayloadFormatterService/DecodeUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payloadFormatterServiceClient) EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error) {
+	out := new(EncodeDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PayloadFormatterService/EncodeDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payloadFormatterServiceClient) DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error) {
+	out := new(DecodeDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.PayloadFormatterService/DecodeDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayloadFormatterServiceServer is the server API for PayloadFormatterService service.
+type PayloadFormatterServiceServer interface {
+	// Decode the binary payload of the uplink message.
+	// The response contains the uplink message with the decoded payload, and optionally the normalized payload.
+	DecodeUplink(context.Context, *DecodeUplinkRequest) (*DecodeUplinkResponse, error)
+	// Encode the decoded payload of the downlink message.
+	// The response contains the downlink message with the binary payload and the FPort.
+	EncodeDownlink(context.Context, *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error)
+	// Decode the binary payload of the downlink message.
+	// The response contains the downlink message with the decoded payload.
+	DecodeDownlink(context.Context, *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error)
+}
+
+// UnimplementedPayloadFormatterServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPayloadFormatterServiceServer struct {
+}
+
+func (*UnimplementedPayloadFormatterServiceServer) DecodeUplink(ctx context.Context, req *DecodeUplinkRequest) (*DecodeUplinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeUplink not implemented")
+}
+func (*UnimplementedPayloadFormatterServiceServer) EncodeDownlink(ctx context.Context, req *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeDownlink not implemented")
+}
+func (*UnimplementedPayloadFormatterServiceServer) DecodeDownlink(ctx context.Context, req *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeDownlink not implemented")
+}
+
+func RegisterPayloadFormatterServiceServer(s *grpc.Server, srv PayloadFormatterServiceServer) {
+	s.RegisterService(&_PayloadFormatterService_serviceDesc, srv)
+}
+
+func _PayloadFormatterService_DecodeUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadFormatterServiceServer).DecodeUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PayloadFormatterService/DecodeUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadFormatterServiceServer).DecodeUplink(ctx, req.(*DecodeUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayloadFormatterService_EncodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadFormatterServiceServer).EncodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PayloadFormatterService/EncodeDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadFormatterServiceServer).EncodeDownlink(ctx, req.(*EncodeDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayloadFormatterService_DecodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayloadFormatterServiceServer).DecodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.PayloadFormatterService/DecodeDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayloadFormatterServiceServer).DecodeDownlink(ctx, req.(*DecodeDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PayloadFormatterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.PayloadFormatterService",
+	HandlerType: (*PayloadFormatterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DecodeUplink",
+			Handler:    _PayloadFormatterService_DecodeUplink_Handler,
+		},
+		{
+			MethodName: "EncodeDownlink",
+			Handler:    _PayloadFormatterService_EncodeDownlink_Handler,
+		},
+		{
+			MethodName: "DecodeDownlink",
+			Handler:    _PayloadFormatterService_DecodeDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
@@ -6,13 +6,18 @@ var ApplicationLinkFieldPathsNested = []string{
 	"default_formatters",
 	"default_formatters.down_formatter",
 	"default_formatters.down_formatter_parameter",
+	"default_formatters.test_vectors",
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
+	"downlink_policy",
+	"downlink_policy.default_ttl",
+	"downlink_policy.order_by_priority",
 	"skip_payload_crypto",
 }
 
 var ApplicationLinkFieldPathsTopLevel = []string{
 	"default_formatters",
+	"downlink_policy",
 	"skip_payload_crypto",
 }
 var GetApplicationLinkRequestFieldPathsNested = []string{
@@ -33,15 +38,21 @@ var SetApplicationLinkRequestFieldPathsNested = []string{
 	"link.default_formatters",
 	"link.default_formatters.down_formatter",
 	"link.default_formatters.down_formatter_parameter",
+	"link.default_formatters.test_vectors",
 	"link.default_formatters.up_formatter",
 	"link.default_formatters.up_formatter_parameter",
+	"link.downlink_policy",
+	"link.downlink_policy.default_ttl",
+	"link.downlink_policy.order_by_priority",
 	"link.skip_payload_crypto",
+	"skip_formatter_test_vectors",
 }
 
 var SetApplicationLinkRequestFieldPathsTopLevel = []string{
 	"application_ids",
 	"field_mask",
 	"link",
+	"skip_formatter_test_vectors",
 }
 var ApplicationLinkStatsFieldPathsNested = []string{
 	"downlink_count",
@@ -90,6 +101,41 @@ var GetAsConfigurationResponseFieldPathsNested = []string{
 var GetAsConfigurationResponseFieldPathsTopLevel = []string{
 	"configuration",
 }
+var GetNormalizedPayloadSchemaRequestFieldPathsNested []string
+var GetNormalizedPayloadSchemaRequestFieldPathsTopLevel []string
+var GetNormalizedPayloadSchemaResponseFieldPathsNested = []string{
+	"schema",
+	"version",
+}
+
+var GetNormalizedPayloadSchemaResponseFieldPathsTopLevel = []string{
+	"schema",
+	"version",
+}
+var TestFormattersRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_id",
+	"formatters",
+	"formatters.down_formatter",
+	"formatters.down_formatter_parameter",
+	"formatters.test_vectors",
+	"formatters.up_formatter",
+	"formatters.up_formatter_parameter",
+}
+
+var TestFormattersRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_id",
+	"formatters",
+}
+var TestFormattersResponseFieldPathsNested = []string{
+	"results",
+}
+
+var TestFormattersResponseFieldPathsTopLevel = []string{
+	"results",
+}
 var NsAsHandleUplinkRequestFieldPathsNested = []string{
 	"application_ups",
 }
@@ -110,6 +156,10 @@ var EncodeDownlinkRequestFieldPathsNested = []string{
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
+	"downlink.scheduling",
+	"downlink.scheduling.deduplication_key",
+	"downlink.scheduling.expires_at",
+	"downlink.scheduling.not_before",
 	"downlink.session_key_id",
 	"end_device_ids",
 	"end_device_ids.application_ids",
@@ -151,6 +201,10 @@ var EncodeDownlinkResponseFieldPathsNested = []string{
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
+	"downlink.scheduling",
+	"downlink.scheduling.deduplication_key",
+	"downlink.scheduling.expires_at",
+	"downlink.scheduling.not_before",
 	"downlink.session_key_id",
 }
 
@@ -208,7 +262,13 @@ var DecodeUplinkRequestFieldPathsNested = []string{
 	"uplink.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"uplink.settings.downlink",
 	"uplink.settings.downlink.antenna_index",
+	"uplink.settings.downlink.implicit_header",
 	"uplink.settings.downlink.invert_polarization",
+	"uplink.settings.downlink.listen_before_talk",
+	"uplink.settings.downlink.listen_before_talk.rssi_offset",
+	"uplink.settings.downlink.listen_before_talk.rssi_target",
+	"uplink.settings.downlink.listen_before_talk.scan_time",
+	"uplink.settings.downlink.preamble_length",
 	"uplink.settings.downlink.tx_power",
 	"uplink.settings.enable_crc",
 	"uplink.settings.frequency",
@@ -283,7 +343,13 @@ var DecodeUplinkResponseFieldPathsNested = []string{
 	"uplink.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"uplink.settings.downlink",
 	"uplink.settings.downlink.antenna_index",
+	"uplink.settings.downlink.implicit_header",
 	"uplink.settings.downlink.invert_polarization",
+	"uplink.settings.downlink.listen_before_talk",
+	"uplink.settings.downlink.listen_before_talk.rssi_offset",
+	"uplink.settings.downlink.listen_before_talk.rssi_target",
+	"uplink.settings.downlink.listen_before_talk.scan_time",
+	"uplink.settings.downlink.preamble_length",
 	"uplink.settings.downlink.tx_power",
 	"uplink.settings.enable_crc",
 	"uplink.settings.frequency",
@@ -316,6 +382,10 @@ var DecodeDownlinkRequestFieldPathsNested = []string{
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
+	"downlink.scheduling",
+	"downlink.scheduling.deduplication_key",
+	"downlink.scheduling.expires_at",
+	"downlink.scheduling.not_before",
 	"downlink.session_key_id",
 	"end_device_ids",
 	"end_device_ids.application_ids",
@@ -357,12 +427,318 @@ var DecodeDownlinkResponseFieldPathsNested = []string{
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
+	"downlink.scheduling",
+	"downlink.scheduling.deduplication_key",
+	"downlink.scheduling.expires_at",
+	"downlink.scheduling.not_before",
 	"downlink.session_key_id",
 }
 
 var DecodeDownlinkResponseFieldPathsTopLevel = []string{
 	"downlink",
 }
+var SubscribeDurableRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"consumer",
+	"cursor",
+}
+
+var SubscribeDurableRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"consumer",
+	"cursor",
+}
+var DurableApplicationUpFieldPathsNested = []string{
+	"id",
+	"up",
+	"up.correlation_ids",
+	"up.end_device_ids",
+	"up.end_device_ids.application_ids",
+	"up.end_device_ids.application_ids.application_id",
+	"up.end_device_ids.dev_addr",
+	"up.end_device_ids.dev_eui",
+	"up.end_device_ids.device_id",
+	"up.end_device_ids.join_eui",
+	"up.received_at",
+	"up.simulated",
+	"up.up",
+	"up.up.downlink_ack",
+	"up.up.downlink_ack.class_b_c",
+	"up.up.downlink_ack.class_b_c.absolute_time",
+	"up.up.downlink_ack.class_b_c.gateways",
+	"up.up.downlink_ack.confirmed",
+	"up.up.downlink_ack.correlation_ids",
+	"up.up.downlink_ack.decoded_payload",
+	"up.up.downlink_ack.decoded_payload_warnings",
+	"up.up.downlink_ack.f_cnt",
+	"up.up.downlink_ack.f_port",
+	"up.up.downlink_ack.frm_payload",
+	"up.up.downlink_ack.priority",
+	"up.up.downlink_ack.scheduling",
+	"up.up.downlink_ack.scheduling.deduplication_key",
+	"up.up.downlink_ack.scheduling.expires_at",
+	"up.up.downlink_ack.scheduling.not_before",
+	"up.up.downlink_ack.session_key_id",
+	"up.up.downlink_failed",
+	"up.up.downlink_failed.downlink",
+	"up.up.downlink_failed.downlink.class_b_c",
+	"up.up.downlink_failed.downlink.class_b_c.absolute_time",
+	"up.up.downlink_failed.downlink.class_b_c.gateways",
+	"up.up.downlink_failed.downlink.confirmed",
+	"up.up.downlink_failed.downlink.correlation_ids",
+	"up.up.downlink_failed.downlink.decoded_payload",
+	"up.up.downlink_failed.downlink.decoded_payload_warnings",
+	"up.up.downlink_failed.downlink.f_cnt",
+	"up.up.downlink_failed.downlink.f_port",
+	"up.up.downlink_failed.downlink.frm_payload",
+	"up.up.downlink_failed.downlink.priority",
+	"up.up.downlink_failed.downlink.scheduling",
+	"up.up.downlink_failed.downlink.scheduling.deduplication_key",
+	"up.up.downlink_failed.downlink.scheduling.expires_at",
+	"up.up.downlink_failed.downlink.scheduling.not_before",
+	"up.up.downlink_failed.downlink.session_key_id",
+	"up.up.downlink_failed.error",
+	"up.up.downlink_failed.error.attributes",
+	"up.up.downlink_failed.error.cause",
+	"up.up.downlink_failed.error.cause.attributes",
+	"up.up.downlink_failed.error.cause.correlation_id",
+	"up.up.downlink_failed.error.cause.message_format",
+	"up.up.downlink_failed.error.cause.name",
+	"up.up.downlink_failed.error.cause.namespace",
+	"up.up.downlink_failed.error.code",
+	"up.up.downlink_failed.error.correlation_id",
+	"up.up.downlink_failed.error.details",
+	"up.up.downlink_failed.error.message_format",
+	"up.up.downlink_failed.error.name",
+	"up.up.downlink_failed.error.namespace",
+	"up.up.downlink_nack",
+	"up.up.downlink_nack.class_b_c",
+	"up.up.downlink_nack.class_b_c.absolute_time",
+	"up.up.downlink_nack.class_b_c.gateways",
+	"up.up.downlink_nack.confirmed",
+	"up.up.downlink_nack.correlation_ids",
+	"up.up.downlink_nack.decoded_payload",
+	"up.up.downlink_nack.decoded_payload_warnings",
+	"up.up.downlink_nack.f_cnt",
+	"up.up.downlink_nack.f_port",
+	"up.up.downlink_nack.frm_payload",
+	"up.up.downlink_nack.priority",
+	"up.up.downlink_nack.scheduling",
+	"up.up.downlink_nack.scheduling.deduplication_key",
+	"up.up.downlink_nack.scheduling.expires_at",
+	"up.up.downlink_nack.scheduling.not_before",
+	"up.up.downlink_nack.session_key_id",
+	"up.up.downlink_queue_invalidated",
+	"up.up.downlink_queue_invalidated.downlinks",
+	"up.up.downlink_queue_invalidated.last_f_cnt_down",
+	"up.up.downlink_queue_invalidated.session_key_id",
+	"up.up.downlink_queued",
+	"up.up.downlink_queued.class_b_c",
+	"up.up.downlink_queued.class_b_c.absolute_time",
+	"up.up.downlink_queued.class_b_c.gateways",
+	"up.up.downlink_queued.confirmed",
+	"up.up.downlink_queued.correlation_ids",
+	"up.up.downlink_queued.decoded_payload",
+	"up.up.downlink_queued.decoded_payload_warnings",
+	"up.up.downlink_queued.f_cnt",
+	"up.up.downlink_queued.f_port",
+	"up.up.downlink_queued.frm_payload",
+	"up.up.downlink_queued.priority",
+	"up.up.downlink_queued.scheduling",
+	"up.up.downlink_queued.scheduling.deduplication_key",
+	"up.up.downlink_queued.scheduling.expires_at",
+	"up.up.downlink_queued.scheduling.not_before",
+	"up.up.downlink_queued.session_key_id",
+	"up.up.downlink_sent",
+	"up.up.downlink_sent.class_b_c",
+	"up.up.downlink_sent.class_b_c.absolute_time",
+	"up.up.downlink_sent.class_b_c.gateways",
+	"up.up.downlink_sent.confirmed",
+	"up.up.downlink_sent.correlation_ids",
+	"up.up.downlink_sent.decoded_payload",
+	"up.up.downlink_sent.decoded_payload_warnings",
+	"up.up.downlink_sent.f_cnt",
+	"up.up.downlink_sent.f_port",
+	"up.up.downlink_sent.frm_payload",
+	"up.up.downlink_sent.priority",
+	"up.up.downlink_sent.scheduling",
+	"up.up.downlink_sent.scheduling.deduplication_key",
+	"up.up.downlink_sent.scheduling.expires_at",
+	"up.up.downlink_sent.scheduling.not_before",
+	"up.up.downlink_sent.session_key_id",
+	"up.up.join_accept",
+	"up.up.join_accept.app_s_key",
+	"up.up.join_accept.app_s_key.encrypted_key",
+	"up.up.join_accept.app_s_key.kek_label",
+	"up.up.join_accept.app_s_key.key",
+	"up.up.join_accept.invalidated_downlinks",
+	"up.up.join_accept.pending_session",
+	"up.up.join_accept.received_at",
+	"up.up.join_accept.session_key_id",
+	"up.up.location_solved",
+	"up.up.location_solved.attributes",
+	"up.up.location_solved.location",
+	"up.up.location_solved.location.accuracy",
+	"up.up.location_solved.location.altitude",
+	"up.up.location_solved.location.latitude",
+	"up.up.location_solved.location.longitude",
+	"up.up.location_solved.location.source",
+	"up.up.location_solved.service",
+	"up.up.service_data",
+	"up.up.service_data.data",
+	"up.up.service_data.service",
+	"up.up.session_recovered",
+	"up.up.session_recovered.discarded_session_key_id",
+	"up.up.session_recovered.f_cnt_reset",
+	"up.up.session_recovered.last_a_f_cnt_down",
+	"up.up.session_recovered.last_f_cnt_up",
+	"up.up.session_recovered.session_key_id",
+	"up.up.uplink_message",
+	"up.up.uplink_message.app_s_key",
+	"up.up.uplink_message.app_s_key.encrypted_key",
+	"up.up.uplink_message.app_s_key.kek_label",
+	"up.up.uplink_message.app_s_key.key",
+	"up.up.uplink_message.confirmed",
+	"up.up.uplink_message.consumed_airtime",
+	"up.up.uplink_message.decoded_payload",
+	"up.up.uplink_message.decoded_payload_warnings",
+	"up.up.uplink_message.f_cnt",
+	"up.up.uplink_message.f_port",
+	"up.up.uplink_message.frm_payload",
+	"up.up.uplink_message.last_a_f_cnt_down",
+	"up.up.uplink_message.locations",
+	"up.up.uplink_message.network_ids",
+	"up.up.uplink_message.network_ids.cluster_address",
+	"up.up.uplink_message.network_ids.cluster_id",
+	"up.up.uplink_message.network_ids.net_id",
+	"up.up.uplink_message.network_ids.tenant_address",
+	"up.up.uplink_message.network_ids.tenant_id",
+	"up.up.uplink_message.normalized_payload",
+	"up.up.uplink_message.normalized_payload_warnings",
+	"up.up.uplink_message.received_at",
+	"up.up.uplink_message.rx_metadata",
+	"up.up.uplink_message.session_key_id",
+	"up.up.uplink_message.settings",
+	"up.up.uplink_message.settings.concentrator_timestamp",
+	"up.up.uplink_message.settings.data_rate",
+	"up.up.uplink_message.settings.data_rate.modulation",
+	"up.up.uplink_message.settings.data_rate.modulation.fsk",
+	"up.up.uplink_message.settings.data_rate.modulation.fsk.bit_rate",
+	"up.up.uplink_message.settings.data_rate.modulation.lora",
+	"up.up.uplink_message.settings.data_rate.modulation.lora.bandwidth",
+	"up.up.uplink_message.settings.data_rate.modulation.lora.coding_rate",
+	"up.up.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"up.up.uplink_message.settings.data_rate.modulation.lrfhss",
+	"up.up.uplink_message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"up.up.uplink_message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"up.up.uplink_message.settings.data_rate.modulation.lrfhss.operating_channel_width",
+	"up.up.uplink_message.settings.downlink",
+	"up.up.uplink_message.settings.downlink.antenna_index",
+	"up.up.uplink_message.settings.downlink.implicit_header",
+	"up.up.uplink_message.settings.downlink.invert_polarization",
+	"up.up.uplink_message.settings.downlink.listen_before_talk",
+	"up.up.uplink_message.settings.downlink.listen_before_talk.rssi_offset",
+	"up.up.uplink_message.settings.downlink.listen_before_talk.rssi_target",
+	"up.up.uplink_message.settings.downlink.listen_before_talk.scan_time",
+	"up.up.uplink_message.settings.downlink.preamble_length",
+	"up.up.uplink_message.settings.downlink.tx_power",
+	"up.up.uplink_message.settings.enable_crc",
+	"up.up.uplink_message.settings.frequency",
+	"up.up.uplink_message.settings.time",
+	"up.up.uplink_message.settings.timestamp",
+	"up.up.uplink_message.version_ids",
+	"up.up.uplink_message.version_ids.band_id",
+	"up.up.uplink_message.version_ids.brand_id",
+	"up.up.uplink_message.version_ids.firmware_version",
+	"up.up.uplink_message.version_ids.hardware_version",
+	"up.up.uplink_message.version_ids.model_id",
+	"up.up.uplink_message.version_ids.serial_number",
+	"up.up.uplink_message.version_ids.vendor_id",
+	"up.up.uplink_message.version_ids.vendor_profile_id",
+	"up.up.uplink_normalized",
+	"up.up.uplink_normalized.confirmed",
+	"up.up.uplink_normalized.consumed_airtime",
+	"up.up.uplink_normalized.f_cnt",
+	"up.up.uplink_normalized.f_port",
+	"up.up.uplink_normalized.frm_payload",
+	"up.up.uplink_normalized.locations",
+	"up.up.uplink_normalized.network_ids",
+	"up.up.uplink_normalized.network_ids.cluster_address",
+	"up.up.uplink_normalized.network_ids.cluster_id",
+	"up.up.uplink_normalized.network_ids.net_id",
+	"up.up.uplink_normalized.network_ids.tenant_address",
+	"up.up.uplink_normalized.network_ids.tenant_id",
+	"up.up.uplink_normalized.normalized_payload",
+	"up.up.uplink_normalized.normalized_payload_warnings",
+	"up.up.uplink_normalized.received_at",
+	"up.up.uplink_normalized.rx_metadata",
+	"up.up.uplink_normalized.session_key_id",
+	"up.up.uplink_normalized.settings",
+	"up.up.uplink_normalized.settings.concentrator_timestamp",
+	"up.up.uplink_normalized.settings.data_rate",
+	"up.up.uplink_normalized.settings.data_rate.modulation",
+	"up.up.uplink_normalized.settings.data_rate.modulation.fsk",
+	"up.up.uplink_normalized.settings.data_rate.modulation.fsk.bit_rate",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lora",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lora.bandwidth",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lora.coding_rate",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lora.spreading_factor",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lrfhss",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lrfhss.coding_rate",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lrfhss.modulation_type",
+	"up.up.uplink_normalized.settings.data_rate.modulation.lrfhss.operating_channel_width",
+	"up.up.uplink_normalized.settings.downlink",
+	"up.up.uplink_normalized.settings.downlink.antenna_index",
+	"up.up.uplink_normalized.settings.downlink.implicit_header",
+	"up.up.uplink_normalized.settings.downlink.invert_polarization",
+	"up.up.uplink_normalized.settings.downlink.listen_before_talk",
+	"up.up.uplink_normalized.settings.downlink.listen_before_talk.rssi_offset",
+	"up.up.uplink_normalized.settings.downlink.listen_before_talk.rssi_target",
+	"up.up.uplink_normalized.settings.downlink.listen_before_talk.scan_time",
+	"up.up.uplink_normalized.settings.downlink.preamble_length",
+	"up.up.uplink_normalized.settings.downlink.tx_power",
+	"up.up.uplink_normalized.settings.enable_crc",
+	"up.up.uplink_normalized.settings.frequency",
+	"up.up.uplink_normalized.settings.time",
+	"up.up.uplink_normalized.settings.timestamp",
+	"up.up.uplink_normalized.version_ids",
+	"up.up.uplink_normalized.version_ids.band_id",
+	"up.up.uplink_normalized.version_ids.brand_id",
+	"up.up.uplink_normalized.version_ids.firmware_version",
+	"up.up.uplink_normalized.version_ids.hardware_version",
+	"up.up.uplink_normalized.version_ids.model_id",
+	"up.up.uplink_normalized.version_ids.serial_number",
+	"up.up.uplink_normalized.version_ids.vendor_id",
+	"up.up.uplink_normalized.version_ids.vendor_profile_id",
+}
+
+var DurableApplicationUpFieldPathsTopLevel = []string{
+	"id",
+	"up",
+}
+var AcknowledgeDurableRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"consumer",
+	"ids",
+}
+
+var AcknowledgeDurableRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"consumer",
+	"ids",
+}
+var DeleteDurableConsumerRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"consumer",
+}
+
+var DeleteDurableConsumerRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"consumer",
+}
 var AsConfiguration_PubSubFieldPathsNested = []string{
 	"providers",
 	"providers.mqtt",
@@ -390,3 +766,22 @@ var AsConfiguration_PubSub_ProvidersFieldPathsTopLevel = []string{
 	"mqtt",
 	"nats",
 }
+var TestFormattersResponse_ResultFieldPathsNested = []string{
+	"name",
+	"output",
+	"output.decoded_payload",
+	"output.description",
+	"output.errors",
+	"output.f_port",
+	"output.frm_payload",
+	"output.name",
+	"output.type",
+	"output.warnings",
+	"passed",
+}
+
+var TestFormattersResponse_ResultFieldPathsTopLevel = []string{
+	"name",
+	"output",
+	"passed",
+}
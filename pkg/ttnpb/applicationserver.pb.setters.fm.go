@@ -41,6 +41,31 @@ func (dst *ApplicationLink) SetFields(src *ApplicationLink, paths ...string) err
 			} else {
 				dst.SkipPayloadCrypto = nil
 			}
+		case "downlink_policy":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationDownlinkPolicy
+				if (src == nil || src.DownlinkPolicy == nil) && dst.DownlinkPolicy == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DownlinkPolicy
+				}
+				if dst.DownlinkPolicy != nil {
+					newDst = dst.DownlinkPolicy
+				} else {
+					newDst = &ApplicationDownlinkPolicy{}
+					dst.DownlinkPolicy = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DownlinkPolicy = src.DownlinkPolicy
+				} else {
+					dst.DownlinkPolicy = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -156,6 +181,16 @@ func (dst *SetApplicationLinkRequest) SetFields(src *SetApplicationLinkRequest,
 			} else {
 				dst.FieldMask = nil
 			}
+		case "skip_formatter_test_vectors":
+			if len(subs) > 0 {
+				return fmt.Errorf("'skip_formatter_test_vectors' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SkipFormatterTestVectors = src.SkipFormatterTestVectors
+			} else {
+				var zero bool
+				dst.SkipFormatterTestVectors = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -336,6 +371,134 @@ func (dst *GetAsConfigurationResponse) SetFields(src *GetAsConfigurationResponse
 	return nil
 }
 
+func (dst *GetNormalizedPayloadSchemaRequest) SetFields(src *GetNormalizedPayloadSchemaRequest, paths ...string) error {
+	if len(paths) != 0 {
+		return fmt.Errorf("message GetNormalizedPayloadSchemaRequest has no fields, but paths %s were specified", paths)
+	}
+	return nil
+}
+
+func (dst *GetNormalizedPayloadSchemaResponse) SetFields(src *GetNormalizedPayloadSchemaResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero string
+				dst.Version = zero
+			}
+		case "schema":
+			if len(subs) > 0 {
+				return fmt.Errorf("'schema' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Schema = src.Schema
+			} else {
+				dst.Schema = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TestFormattersRequest) SetFields(src *TestFormattersRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "formatters":
+			if len(subs) > 0 {
+				var newDst, newSrc *MessagePayloadFormatters
+				if (src == nil || src.Formatters == nil) && dst.Formatters == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Formatters
+				}
+				if dst.Formatters != nil {
+					newDst = dst.Formatters
+				} else {
+					newDst = &MessagePayloadFormatters{}
+					dst.Formatters = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Formatters = src.Formatters
+				} else {
+					dst.Formatters = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TestFormattersResponse) SetFields(src *TestFormattersResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *NsAsHandleUplinkRequest) SetFields(src *NsAsHandleUplinkRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -782,6 +945,209 @@ func (dst *DecodeDownlinkResponse) SetFields(src *DecodeDownlinkResponse, paths
 	return nil
 }
 
+func (dst *SubscribeDurableRequest) SetFields(src *SubscribeDurableRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "consumer":
+			if len(subs) > 0 {
+				return fmt.Errorf("'consumer' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Consumer = src.Consumer
+			} else {
+				var zero string
+				dst.Consumer = zero
+			}
+		case "cursor":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cursor' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Cursor = src.Cursor
+			} else {
+				var zero string
+				dst.Cursor = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DurableApplicationUp) SetFields(src *DurableApplicationUp, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+		case "up":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUp
+				if (src == nil || src.Up == nil) && dst.Up == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Up
+				}
+				if dst.Up != nil {
+					newDst = dst.Up
+				} else {
+					newDst = &ApplicationUp{}
+					dst.Up = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Up = src.Up
+				} else {
+					dst.Up = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *AcknowledgeDurableRequest) SetFields(src *AcknowledgeDurableRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "consumer":
+			if len(subs) > 0 {
+				return fmt.Errorf("'consumer' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Consumer = src.Consumer
+			} else {
+				var zero string
+				dst.Consumer = zero
+			}
+		case "ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Ids = src.Ids
+			} else {
+				dst.Ids = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeleteDurableConsumerRequest) SetFields(src *DeleteDurableConsumerRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "consumer":
+			if len(subs) > 0 {
+				return fmt.Errorf("'consumer' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Consumer = src.Consumer
+			} else {
+				var zero string
+				dst.Consumer = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *AsConfiguration_PubSub) SetFields(src *AsConfiguration_PubSub, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -878,3 +1244,59 @@ func (dst *AsConfiguration_PubSub_Providers) SetFields(src *AsConfiguration_PubS
 	}
 	return nil
 }
+
+func (dst *TestFormattersResponse_Result) SetFields(src *TestFormattersResponse_Result, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "passed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'passed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Passed = src.Passed
+			} else {
+				var zero bool
+				dst.Passed = zero
+			}
+		case "output":
+			if len(subs) > 0 {
+				var newDst, newSrc *MessagePayloadFormatterTestVector
+				if (src == nil || src.Output == nil) && dst.Output == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Output
+				}
+				if dst.Output != nil {
+					newDst = dst.Output
+				} else {
+					newDst = &MessagePayloadFormatterTestVector{}
+					dst.Output = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Output = src.Output
+				} else {
+					dst.Output = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
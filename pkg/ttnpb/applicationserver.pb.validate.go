@@ -74,6 +74,18 @@ func (m *ApplicationLink) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "downlink_policy":
+
+			if v, ok := interface{}(m.GetDownlinkPolicy()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationLinkValidationError{
+						field:  "downlink_policy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationLinkValidationError{
 				field:  name,
@@ -316,6 +328,8 @@ func (m *SetApplicationLinkRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "skip_formatter_test_vectors":
+			// no validation rules for SkipFormatterTestVectors
 		default:
 			return SetApplicationLinkRequestValidationError{
 				field:  name,
@@ -779,47 +793,104 @@ var _ interface {
 	ErrorName() string
 } = GetAsConfigurationResponseValidationError{}
 
-// ValidateFields checks the field values on NsAsHandleUplinkRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *NsAsHandleUplinkRequest) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on GetNormalizedPayloadSchemaRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GetNormalizedPayloadSchemaRequest) ValidateFields(paths ...string) error {
+	if len(paths) > 0 {
+		return fmt.Errorf("message GetNormalizedPayloadSchemaRequest has no fields, but paths %s were specified", paths)
+	}
+	return nil
+}
+
+// GetNormalizedPayloadSchemaRequestValidationError is the validation error
+// returned by GetNormalizedPayloadSchemaRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetNormalizedPayloadSchemaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNormalizedPayloadSchemaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNormalizedPayloadSchemaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNormalizedPayloadSchemaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNormalizedPayloadSchemaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNormalizedPayloadSchemaRequestValidationError) ErrorName() string {
+	return "GetNormalizedPayloadSchemaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNormalizedPayloadSchemaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNormalizedPayloadSchemaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNormalizedPayloadSchemaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNormalizedPayloadSchemaRequestValidationError{}
+
+// ValidateFields checks the field values on GetNormalizedPayloadSchemaResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GetNormalizedPayloadSchemaResponse) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = NsAsHandleUplinkRequestFieldPathsNested
+		paths = GetNormalizedPayloadSchemaResponseFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "application_ups":
-
-			if len(m.GetApplicationUps()) < 1 {
-				return NsAsHandleUplinkRequestValidationError{
-					field:  "application_ups",
-					reason: "value must contain at least 1 item(s)",
-				}
-			}
-
-			for idx, item := range m.GetApplicationUps() {
-				_, _ = idx, item
+		case "version":
+			// no validation rules for Version
+		case "schema":
 
-				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
-					if err := v.ValidateFields(subs...); err != nil {
-						return NsAsHandleUplinkRequestValidationError{
-							field:  fmt.Sprintf("application_ups[%v]", idx),
-							reason: "embedded message failed validation",
-							cause:  err,
-						}
+			if v, ok := interface{}(m.GetSchema()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetNormalizedPayloadSchemaResponseValidationError{
+						field:  "schema",
+						reason: "embedded message failed validation",
+						cause:  err,
 					}
 				}
-
 			}
 
 		default:
-			return NsAsHandleUplinkRequestValidationError{
+			return GetNormalizedPayloadSchemaResponseValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -828,9 +899,10 @@ func (m *NsAsHandleUplinkRequest) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// NsAsHandleUplinkRequestValidationError is the validation error returned by
-// NsAsHandleUplinkRequest.ValidateFields if the designated constraints aren't met.
-type NsAsHandleUplinkRequestValidationError struct {
+// GetNormalizedPayloadSchemaResponseValidationError is the validation error
+// returned by GetNormalizedPayloadSchemaResponse.ValidateFields if the
+// designated constraints aren't met.
+type GetNormalizedPayloadSchemaResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -838,24 +910,24 @@ type NsAsHandleUplinkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e NsAsHandleUplinkRequestValidationError) Field() string { return e.field }
+func (e GetNormalizedPayloadSchemaResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NsAsHandleUplinkRequestValidationError) Reason() string { return e.reason }
+func (e GetNormalizedPayloadSchemaResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NsAsHandleUplinkRequestValidationError) Cause() error { return e.cause }
+func (e GetNormalizedPayloadSchemaResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NsAsHandleUplinkRequestValidationError) Key() bool { return e.key }
+func (e GetNormalizedPayloadSchemaResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NsAsHandleUplinkRequestValidationError) ErrorName() string {
-	return "NsAsHandleUplinkRequestValidationError"
+func (e GetNormalizedPayloadSchemaResponseValidationError) ErrorName() string {
+	return "GetNormalizedPayloadSchemaResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e NsAsHandleUplinkRequestValidationError) Error() string {
+func (e GetNormalizedPayloadSchemaResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -867,14 +939,14 @@ func (e NsAsHandleUplinkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNsAsHandleUplinkRequest.%s: %s%s",
+		"invalid %sGetNormalizedPayloadSchemaResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NsAsHandleUplinkRequestValidationError{}
+var _ error = GetNormalizedPayloadSchemaResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -882,86 +954,72 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NsAsHandleUplinkRequestValidationError{}
+} = GetNormalizedPayloadSchemaResponseValidationError{}
 
-// ValidateFields checks the field values on EncodeDownlinkRequest with the
+// ValidateFields checks the field values on TestFormattersRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *EncodeDownlinkRequest) ValidateFields(paths ...string) error {
+func (m *TestFormattersRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = EncodeDownlinkRequestFieldPathsNested
+		paths = TestFormattersRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "end_device_ids":
+		case "application_ids":
 
-			if m.GetEndDeviceIds() == nil {
-				return EncodeDownlinkRequestValidationError{
-					field:  "end_device_ids",
+			if m.GetApplicationIds() == nil {
+				return TestFormattersRequestValidationError{
+					field:  "application_ids",
 					reason: "value is required",
 				}
 			}
 
-			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return EncodeDownlinkRequestValidationError{
-						field:  "end_device_ids",
+					return TestFormattersRequestValidationError{
+						field:  "application_ids",
 						reason: "embedded message failed validation",
 						cause:  err,
 					}
 				}
 			}
 
-		case "version_ids":
+		case "device_id":
 
-			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
-				if err := v.ValidateFields(subs...); err != nil {
-					return EncodeDownlinkRequestValidationError{
-						field:  "version_ids",
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
+			if utf8.RuneCountInString(m.GetDeviceId()) > 36 {
+				return TestFormattersRequestValidationError{
+					field:  "device_id",
+					reason: "value length must be at most 36 runes",
 				}
 			}
 
-		case "downlink":
-
-			if m.GetDownlink() == nil {
-				return EncodeDownlinkRequestValidationError{
-					field:  "downlink",
-					reason: "value is required",
+			if !_TestFormattersRequest_DeviceId_Pattern.MatchString(m.GetDeviceId()) {
+				return TestFormattersRequestValidationError{
+					field:  "device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
 				}
 			}
 
-			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+		case "formatters":
+
+			if v, ok := interface{}(m.GetFormatters()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return EncodeDownlinkRequestValidationError{
-						field:  "downlink",
+					return TestFormattersRequestValidationError{
+						field:  "formatters",
 						reason: "embedded message failed validation",
 						cause:  err,
 					}
 				}
 			}
 
-		case "formatter":
-
-			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
-				return EncodeDownlinkRequestValidationError{
-					field:  "formatter",
-					reason: "value must be one of the defined enum values",
-				}
-			}
-
-		case "parameter":
-			// no validation rules for Parameter
 		default:
-			return EncodeDownlinkRequestValidationError{
+			return TestFormattersRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -970,9 +1028,9 @@ func (m *EncodeDownlinkRequest) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// EncodeDownlinkRequestValidationError is the validation error returned by
-// EncodeDownlinkRequest.ValidateFields if the designated constraints aren't met.
-type EncodeDownlinkRequestValidationError struct {
+// TestFormattersRequestValidationError is the validation error returned by
+// TestFormattersRequest.ValidateFields if the designated constraints aren't met.
+type TestFormattersRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -980,24 +1038,24 @@ type EncodeDownlinkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e EncodeDownlinkRequestValidationError) Field() string { return e.field }
+func (e TestFormattersRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EncodeDownlinkRequestValidationError) Reason() string { return e.reason }
+func (e TestFormattersRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EncodeDownlinkRequestValidationError) Cause() error { return e.cause }
+func (e TestFormattersRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EncodeDownlinkRequestValidationError) Key() bool { return e.key }
+func (e TestFormattersRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EncodeDownlinkRequestValidationError) ErrorName() string {
-	return "EncodeDownlinkRequestValidationError"
+func (e TestFormattersRequestValidationError) ErrorName() string {
+	return "TestFormattersRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EncodeDownlinkRequestValidationError) Error() string {
+func (e TestFormattersRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1009,14 +1067,14 @@ func (e EncodeDownlinkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEncodeDownlinkRequest.%s: %s%s",
+		"invalid %sTestFormattersRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EncodeDownlinkRequestValidationError{}
+var _ error = TestFormattersRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1024,37 +1082,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EncodeDownlinkRequestValidationError{}
+} = TestFormattersRequestValidationError{}
 
-// ValidateFields checks the field values on EncodeDownlinkResponse with the
+var _TestFormattersRequest_DeviceId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
+
+// ValidateFields checks the field values on TestFormattersResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *EncodeDownlinkResponse) ValidateFields(paths ...string) error {
+func (m *TestFormattersResponse) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = EncodeDownlinkResponseFieldPathsNested
+		paths = TestFormattersResponseFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "downlink":
+		case "results":
 
-			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
-				if err := v.ValidateFields(subs...); err != nil {
-					return EncodeDownlinkResponseValidationError{
-						field:  "downlink",
-						reason: "embedded message failed validation",
-						cause:  err,
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return TestFormattersResponseValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
 					}
 				}
+
 			}
 
 		default:
-			return EncodeDownlinkResponseValidationError{
+			return TestFormattersResponseValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1063,9 +1128,9 @@ func (m *EncodeDownlinkResponse) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// EncodeDownlinkResponseValidationError is the validation error returned by
-// EncodeDownlinkResponse.ValidateFields if the designated constraints aren't met.
-type EncodeDownlinkResponseValidationError struct {
+// TestFormattersResponseValidationError is the validation error returned by
+// TestFormattersResponse.ValidateFields if the designated constraints aren't met.
+type TestFormattersResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1073,24 +1138,24 @@ type EncodeDownlinkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e EncodeDownlinkResponseValidationError) Field() string { return e.field }
+func (e TestFormattersResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EncodeDownlinkResponseValidationError) Reason() string { return e.reason }
+func (e TestFormattersResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EncodeDownlinkResponseValidationError) Cause() error { return e.cause }
+func (e TestFormattersResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EncodeDownlinkResponseValidationError) Key() bool { return e.key }
+func (e TestFormattersResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EncodeDownlinkResponseValidationError) ErrorName() string {
-	return "EncodeDownlinkResponseValidationError"
+func (e TestFormattersResponseValidationError) ErrorName() string {
+	return "TestFormattersResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e EncodeDownlinkResponseValidationError) Error() string {
+func (e TestFormattersResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1102,14 +1167,14 @@ func (e EncodeDownlinkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEncodeDownlinkResponse.%s: %s%s",
+		"invalid %sTestFormattersResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EncodeDownlinkResponseValidationError{}
+var _ error = TestFormattersResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1117,86 +1182,886 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EncodeDownlinkResponseValidationError{}
+} = TestFormattersResponseValidationError{}
 
-// ValidateFields checks the field values on DecodeUplinkRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateFields checks the field values on NsAsHandleUplinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DecodeUplinkRequest) ValidateFields(paths ...string) error {
+func (m *NsAsHandleUplinkRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DecodeUplinkRequestFieldPathsNested
+		paths = NsAsHandleUplinkRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "end_device_ids":
-
-			if m.GetEndDeviceIds() == nil {
-				return DecodeUplinkRequestValidationError{
-					field:  "end_device_ids",
-					reason: "value is required",
-				}
-			}
+		case "application_ups":
 
-			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
-				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeUplinkRequestValidationError{
-						field:  "end_device_ids",
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
+			if len(m.GetApplicationUps()) < 1 {
+				return NsAsHandleUplinkRequestValidationError{
+					field:  "application_ups",
+					reason: "value must contain at least 1 item(s)",
 				}
 			}
 
-		case "version_ids":
+			for idx, item := range m.GetApplicationUps() {
+				_, _ = idx, item
 
-			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
-				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeUplinkRequestValidationError{
-						field:  "version_ids",
-						reason: "embedded message failed validation",
-						cause:  err,
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return NsAsHandleUplinkRequestValidationError{
+							field:  fmt.Sprintf("application_ups[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
 					}
 				}
-			}
 
-		case "uplink":
+			}
 
-			if m.GetUplink() == nil {
-				return DecodeUplinkRequestValidationError{
-					field:  "uplink",
-					reason: "value is required",
-				}
+		default:
+			return NsAsHandleUplinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
 			}
+		}
+	}
+	return nil
+}
 
-			if v, ok := interface{}(m.GetUplink()).(interface{ ValidateFields(...string) error }); ok {
+// NsAsHandleUplinkRequestValidationError is the validation error returned by
+// NsAsHandleUplinkRequest.ValidateFields if the designated constraints aren't met.
+type NsAsHandleUplinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NsAsHandleUplinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NsAsHandleUplinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NsAsHandleUplinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NsAsHandleUplinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NsAsHandleUplinkRequestValidationError) ErrorName() string {
+	return "NsAsHandleUplinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NsAsHandleUplinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNsAsHandleUplinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NsAsHandleUplinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NsAsHandleUplinkRequestValidationError{}
+
+// ValidateFields checks the field values on EncodeDownlinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EncodeDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EncodeDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return EncodeDownlinkRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink":
+
+			if m.GetDownlink() == nil {
+				return EncodeDownlinkRequestValidationError{
+					field:  "downlink",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return EncodeDownlinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+			// no validation rules for Parameter
+		default:
+			return EncodeDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeDownlinkRequestValidationError is the validation error returned by
+// EncodeDownlinkRequest.ValidateFields if the designated constraints aren't met.
+type EncodeDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EncodeDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EncodeDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EncodeDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EncodeDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EncodeDownlinkRequestValidationError) ErrorName() string {
+	return "EncodeDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EncodeDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEncodeDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EncodeDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EncodeDownlinkRequestValidationError{}
+
+// ValidateFields checks the field values on EncodeDownlinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EncodeDownlinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EncodeDownlinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "downlink":
+
+			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkResponseValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EncodeDownlinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeDownlinkResponseValidationError is the validation error returned by
+// EncodeDownlinkResponse.ValidateFields if the designated constraints aren't met.
+type EncodeDownlinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EncodeDownlinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EncodeDownlinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EncodeDownlinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EncodeDownlinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EncodeDownlinkResponseValidationError) ErrorName() string {
+	return "EncodeDownlinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EncodeDownlinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEncodeDownlinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EncodeDownlinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EncodeDownlinkResponseValidationError{}
+
+// ValidateFields checks the field values on DecodeUplinkRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeUplinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeUplinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return DecodeUplinkRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink":
+
+			if m.GetUplink() == nil {
+				return DecodeUplinkRequestValidationError{
+					field:  "uplink",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetUplink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "uplink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return DecodeUplinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+			// no validation rules for Parameter
+		default:
+			return DecodeUplinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeUplinkRequestValidationError is the validation error returned by
+// DecodeUplinkRequest.ValidateFields if the designated constraints aren't met.
+type DecodeUplinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeUplinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeUplinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeUplinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeUplinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeUplinkRequestValidationError) ErrorName() string {
+	return "DecodeUplinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeUplinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeUplinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeUplinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeUplinkRequestValidationError{}
+
+// ValidateFields checks the field values on DecodeUplinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeUplinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeUplinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "uplink":
+
+			if v, ok := interface{}(m.GetUplink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkResponseValidationError{
+						field:  "uplink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DecodeUplinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeUplinkResponseValidationError is the validation error returned by
+// DecodeUplinkResponse.ValidateFields if the designated constraints aren't met.
+type DecodeUplinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeUplinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeUplinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeUplinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeUplinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeUplinkResponseValidationError) ErrorName() string {
+	return "DecodeUplinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeUplinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeUplinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeUplinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeUplinkResponseValidationError{}
+
+// ValidateFields checks the field values on DecodeDownlinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return DecodeDownlinkRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink":
+
+			if m.GetDownlink() == nil {
+				return DecodeDownlinkRequestValidationError{
+					field:  "downlink",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkRequestValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return DecodeDownlinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+			// no validation rules for Parameter
+		default:
+			return DecodeDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeDownlinkRequestValidationError is the validation error returned by
+// DecodeDownlinkRequest.ValidateFields if the designated constraints aren't met.
+type DecodeDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeDownlinkRequestValidationError) ErrorName() string {
+	return "DecodeDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeDownlinkRequestValidationError{}
+
+// ValidateFields checks the field values on DecodeDownlinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeDownlinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeDownlinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "downlink":
+
+			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeDownlinkResponseValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DecodeDownlinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeDownlinkResponseValidationError is the validation error returned by
+// DecodeDownlinkResponse.ValidateFields if the designated constraints aren't met.
+type DecodeDownlinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeDownlinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeDownlinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeDownlinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeDownlinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeDownlinkResponseValidationError) ErrorName() string {
+	return "DecodeDownlinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeDownlinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeDownlinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeDownlinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeDownlinkResponseValidationError{}
+
+// ValidateFields checks the field values on SubscribeDurableRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SubscribeDurableRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SubscribeDurableRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return SubscribeDurableRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeUplinkRequestValidationError{
-						field:  "uplink",
+					return SubscribeDurableRequestValidationError{
+						field:  "application_ids",
 						reason: "embedded message failed validation",
 						cause:  err,
 					}
 				}
 			}
 
-		case "formatter":
+		case "consumer":
 
-			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
-				return DecodeUplinkRequestValidationError{
-					field:  "formatter",
-					reason: "value must be one of the defined enum values",
+			if utf8.RuneCountInString(m.GetConsumer()) > 36 {
+				return SubscribeDurableRequestValidationError{
+					field:  "consumer",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_SubscribeDurableRequest_Consumer_Pattern.MatchString(m.GetConsumer()) {
+				return SubscribeDurableRequestValidationError{
+					field:  "consumer",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "cursor":
+
+			if utf8.RuneCountInString(m.GetCursor()) > 42 {
+				return SubscribeDurableRequestValidationError{
+					field:  "cursor",
+					reason: "value length must be at most 42 runes",
+				}
+			}
+
+			if !_SubscribeDurableRequest_Cursor_Pattern.MatchString(m.GetCursor()) {
+				return SubscribeDurableRequestValidationError{
+					field:  "cursor",
+					reason: "value does not match regex pattern \"^([0-9]+-[0-9]+)?$\"",
 				}
 			}
 
-		case "parameter":
-			// no validation rules for Parameter
 		default:
-			return DecodeUplinkRequestValidationError{
+			return SubscribeDurableRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1205,9 +2070,9 @@ func (m *DecodeUplinkRequest) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DecodeUplinkRequestValidationError is the validation error returned by
-// DecodeUplinkRequest.ValidateFields if the designated constraints aren't met.
-type DecodeUplinkRequestValidationError struct {
+// SubscribeDurableRequestValidationError is the validation error returned by
+// SubscribeDurableRequest.ValidateFields if the designated constraints aren't met.
+type SubscribeDurableRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1215,24 +2080,24 @@ type DecodeUplinkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DecodeUplinkRequestValidationError) Field() string { return e.field }
+func (e SubscribeDurableRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DecodeUplinkRequestValidationError) Reason() string { return e.reason }
+func (e SubscribeDurableRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DecodeUplinkRequestValidationError) Cause() error { return e.cause }
+func (e SubscribeDurableRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DecodeUplinkRequestValidationError) Key() bool { return e.key }
+func (e SubscribeDurableRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DecodeUplinkRequestValidationError) ErrorName() string {
-	return "DecodeUplinkRequestValidationError"
+func (e SubscribeDurableRequestValidationError) ErrorName() string {
+	return "SubscribeDurableRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DecodeUplinkRequestValidationError) Error() string {
+func (e SubscribeDurableRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1244,14 +2109,14 @@ func (e DecodeUplinkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDecodeUplinkRequest.%s: %s%s",
+		"invalid %sSubscribeDurableRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DecodeUplinkRequestValidationError{}
+var _ error = SubscribeDurableRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1259,29 +2124,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DecodeUplinkRequestValidationError{}
+} = SubscribeDurableRequestValidationError{}
 
-// ValidateFields checks the field values on DecodeUplinkResponse with the
+var _SubscribeDurableRequest_Consumer_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _SubscribeDurableRequest_Cursor_Pattern = regexp.MustCompile("^([0-9]+-[0-9]+)?$")
+
+// ValidateFields checks the field values on DurableApplicationUp with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DecodeUplinkResponse) ValidateFields(paths ...string) error {
+func (m *DurableApplicationUp) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DecodeUplinkResponseFieldPathsNested
+		paths = DurableApplicationUpFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "uplink":
+		case "id":
+			// no validation rules for Id
+		case "up":
 
-			if v, ok := interface{}(m.GetUplink()).(interface{ ValidateFields(...string) error }); ok {
+			if v, ok := interface{}(m.GetUp()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeUplinkResponseValidationError{
-						field:  "uplink",
+					return DurableApplicationUpValidationError{
+						field:  "up",
 						reason: "embedded message failed validation",
 						cause:  err,
 					}
@@ -1289,7 +2160,7 @@ func (m *DecodeUplinkResponse) ValidateFields(paths ...string) error {
 			}
 
 		default:
-			return DecodeUplinkResponseValidationError{
+			return DurableApplicationUpValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1298,9 +2169,9 @@ func (m *DecodeUplinkResponse) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DecodeUplinkResponseValidationError is the validation error returned by
-// DecodeUplinkResponse.ValidateFields if the designated constraints aren't met.
-type DecodeUplinkResponseValidationError struct {
+// DurableApplicationUpValidationError is the validation error returned by
+// DurableApplicationUp.ValidateFields if the designated constraints aren't met.
+type DurableApplicationUpValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1308,24 +2179,24 @@ type DecodeUplinkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DecodeUplinkResponseValidationError) Field() string { return e.field }
+func (e DurableApplicationUpValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DecodeUplinkResponseValidationError) Reason() string { return e.reason }
+func (e DurableApplicationUpValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DecodeUplinkResponseValidationError) Cause() error { return e.cause }
+func (e DurableApplicationUpValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DecodeUplinkResponseValidationError) Key() bool { return e.key }
+func (e DurableApplicationUpValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DecodeUplinkResponseValidationError) ErrorName() string {
-	return "DecodeUplinkResponseValidationError"
+func (e DurableApplicationUpValidationError) ErrorName() string {
+	return "DurableApplicationUpValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DecodeUplinkResponseValidationError) Error() string {
+func (e DurableApplicationUpValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1337,14 +2208,14 @@ func (e DecodeUplinkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDecodeUplinkResponse.%s: %s%s",
+		"invalid %sDurableApplicationUp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DecodeUplinkResponseValidationError{}
+var _ error = DurableApplicationUpValidationError{}
 
 var _ interface {
 	Field() string
@@ -1352,86 +2223,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DecodeUplinkResponseValidationError{}
+} = DurableApplicationUpValidationError{}
 
-// ValidateFields checks the field values on DecodeDownlinkRequest with the
+// ValidateFields checks the field values on AcknowledgeDurableRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *DecodeDownlinkRequest) ValidateFields(paths ...string) error {
+func (m *AcknowledgeDurableRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DecodeDownlinkRequestFieldPathsNested
+		paths = AcknowledgeDurableRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "end_device_ids":
+		case "application_ids":
 
-			if m.GetEndDeviceIds() == nil {
-				return DecodeDownlinkRequestValidationError{
-					field:  "end_device_ids",
+			if m.GetApplicationIds() == nil {
+				return AcknowledgeDurableRequestValidationError{
+					field:  "application_ids",
 					reason: "value is required",
 				}
 			}
 
-			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeDownlinkRequestValidationError{
-						field:  "end_device_ids",
+					return AcknowledgeDurableRequestValidationError{
+						field:  "application_ids",
 						reason: "embedded message failed validation",
 						cause:  err,
 					}
 				}
 			}
 
-		case "version_ids":
+		case "consumer":
 
-			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
-				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeDownlinkRequestValidationError{
-						field:  "version_ids",
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
+			if utf8.RuneCountInString(m.GetConsumer()) > 36 {
+				return AcknowledgeDurableRequestValidationError{
+					field:  "consumer",
+					reason: "value length must be at most 36 runes",
 				}
 			}
 
-		case "downlink":
-
-			if m.GetDownlink() == nil {
-				return DecodeDownlinkRequestValidationError{
-					field:  "downlink",
-					reason: "value is required",
+			if !_AcknowledgeDurableRequest_Consumer_Pattern.MatchString(m.GetConsumer()) {
+				return AcknowledgeDurableRequestValidationError{
+					field:  "consumer",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
 				}
 			}
 
-			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
-				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeDownlinkRequestValidationError{
-						field:  "downlink",
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
+		case "ids":
+
+			if l := len(m.GetIds()); l < 1 || l > 100 {
+				return AcknowledgeDurableRequestValidationError{
+					field:  "ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
 				}
 			}
 
-		case "formatter":
+			for idx, item := range m.GetIds() {
+				_, _ = idx, item
 
-			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
-				return DecodeDownlinkRequestValidationError{
-					field:  "formatter",
-					reason: "value must be one of the defined enum values",
+				if utf8.RuneCountInString(item) > 42 {
+					return AcknowledgeDurableRequestValidationError{
+						field:  fmt.Sprintf("ids[%v]", idx),
+						reason: "value length must be at most 42 runes",
+					}
+				}
+
+				if !_AcknowledgeDurableRequest_Ids_Pattern.MatchString(item) {
+					return AcknowledgeDurableRequestValidationError{
+						field:  fmt.Sprintf("ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[0-9]+-[0-9]+$\"",
+					}
 				}
+
 			}
 
-		case "parameter":
-			// no validation rules for Parameter
 		default:
-			return DecodeDownlinkRequestValidationError{
+			return AcknowledgeDurableRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1440,9 +2313,10 @@ func (m *DecodeDownlinkRequest) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DecodeDownlinkRequestValidationError is the validation error returned by
-// DecodeDownlinkRequest.ValidateFields if the designated constraints aren't met.
-type DecodeDownlinkRequestValidationError struct {
+// AcknowledgeDurableRequestValidationError is the validation error returned by
+// AcknowledgeDurableRequest.ValidateFields if the designated constraints
+// aren't met.
+type AcknowledgeDurableRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1450,24 +2324,24 @@ type DecodeDownlinkRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DecodeDownlinkRequestValidationError) Field() string { return e.field }
+func (e AcknowledgeDurableRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DecodeDownlinkRequestValidationError) Reason() string { return e.reason }
+func (e AcknowledgeDurableRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DecodeDownlinkRequestValidationError) Cause() error { return e.cause }
+func (e AcknowledgeDurableRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DecodeDownlinkRequestValidationError) Key() bool { return e.key }
+func (e AcknowledgeDurableRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DecodeDownlinkRequestValidationError) ErrorName() string {
-	return "DecodeDownlinkRequestValidationError"
+func (e AcknowledgeDurableRequestValidationError) ErrorName() string {
+	return "AcknowledgeDurableRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DecodeDownlinkRequestValidationError) Error() string {
+func (e AcknowledgeDurableRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1479,14 +2353,14 @@ func (e DecodeDownlinkRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDecodeDownlinkRequest.%s: %s%s",
+		"invalid %sAcknowledgeDurableRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DecodeDownlinkRequestValidationError{}
+var _ error = AcknowledgeDurableRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1494,37 +2368,64 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DecodeDownlinkRequestValidationError{}
+} = AcknowledgeDurableRequestValidationError{}
 
-// ValidateFields checks the field values on DecodeDownlinkResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DecodeDownlinkResponse) ValidateFields(paths ...string) error {
+var _AcknowledgeDurableRequest_Consumer_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _AcknowledgeDurableRequest_Ids_Pattern = regexp.MustCompile("^[0-9]+-[0-9]+$")
+
+// ValidateFields checks the field values on DeleteDurableConsumerRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DeleteDurableConsumerRequest) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = DecodeDownlinkResponseFieldPathsNested
+		paths = DeleteDurableConsumerRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "downlink":
+		case "application_ids":
 
-			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+			if m.GetApplicationIds() == nil {
+				return DeleteDurableConsumerRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return DecodeDownlinkResponseValidationError{
-						field:  "downlink",
+					return DeleteDurableConsumerRequestValidationError{
+						field:  "application_ids",
 						reason: "embedded message failed validation",
 						cause:  err,
 					}
 				}
 			}
 
+		case "consumer":
+
+			if utf8.RuneCountInString(m.GetConsumer()) > 36 {
+				return DeleteDurableConsumerRequestValidationError{
+					field:  "consumer",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_DeleteDurableConsumerRequest_Consumer_Pattern.MatchString(m.GetConsumer()) {
+				return DeleteDurableConsumerRequestValidationError{
+					field:  "consumer",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
 		default:
-			return DecodeDownlinkResponseValidationError{
+			return DeleteDurableConsumerRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1533,9 +2434,10 @@ func (m *DecodeDownlinkResponse) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// DecodeDownlinkResponseValidationError is the validation error returned by
-// DecodeDownlinkResponse.ValidateFields if the designated constraints aren't met.
-type DecodeDownlinkResponseValidationError struct {
+// DeleteDurableConsumerRequestValidationError is the validation error returned
+// by DeleteDurableConsumerRequest.ValidateFields if the designated
+// constraints aren't met.
+type DeleteDurableConsumerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1543,24 +2445,24 @@ type DecodeDownlinkResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DecodeDownlinkResponseValidationError) Field() string { return e.field }
+func (e DeleteDurableConsumerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DecodeDownlinkResponseValidationError) Reason() string { return e.reason }
+func (e DeleteDurableConsumerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DecodeDownlinkResponseValidationError) Cause() error { return e.cause }
+func (e DeleteDurableConsumerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DecodeDownlinkResponseValidationError) Key() bool { return e.key }
+func (e DeleteDurableConsumerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DecodeDownlinkResponseValidationError) ErrorName() string {
-	return "DecodeDownlinkResponseValidationError"
+func (e DeleteDurableConsumerRequestValidationError) ErrorName() string {
+	return "DeleteDurableConsumerRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DecodeDownlinkResponseValidationError) Error() string {
+func (e DeleteDurableConsumerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1572,14 +2474,14 @@ func (e DecodeDownlinkResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDecodeDownlinkResponse.%s: %s%s",
+		"invalid %sDeleteDurableConsumerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DecodeDownlinkResponseValidationError{}
+var _ error = DeleteDurableConsumerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1587,7 +2489,9 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DecodeDownlinkResponseValidationError{}
+} = DeleteDurableConsumerRequestValidationError{}
+
+var _DeleteDurableConsumerRequest_Consumer_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
 
 // ValidateFields checks the field values on AsConfiguration_PubSub with the
 // rules defined in the proto definition for this message. If any rules are
@@ -1863,3 +2767,101 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AsConfiguration_PubSub_ProvidersValidationError{}
+
+// ValidateFields checks the field values on TestFormattersResponse_Result with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TestFormattersResponse_Result) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestFormattersResponse_ResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "name":
+			// no validation rules for Name
+		case "passed":
+			// no validation rules for Passed
+		case "output":
+
+			if v, ok := interface{}(m.GetOutput()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestFormattersResponse_ResultValidationError{
+						field:  "output",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return TestFormattersResponse_ResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestFormattersResponse_ResultValidationError is the validation error
+// returned by TestFormattersResponse_Result.ValidateFields if the designated
+// constraints aren't met.
+type TestFormattersResponse_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestFormattersResponse_ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestFormattersResponse_ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestFormattersResponse_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestFormattersResponse_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestFormattersResponse_ResultValidationError) ErrorName() string {
+	return "TestFormattersResponse_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestFormattersResponse_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestFormattersResponse_Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestFormattersResponse_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestFormattersResponse_ResultValidationError{}
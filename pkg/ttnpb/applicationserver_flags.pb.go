@@ -17,6 +17,8 @@ func AddSelectFlagsForApplicationLink(flags *pflag.FlagSet, prefix string, hidde
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("default-formatters", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("default-formatters", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForMessagePayloadFormatters(flags, flagsplugin.Prefix("default-formatters", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("skip-payload-crypto", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("skip-payload-crypto", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("downlink-policy", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("downlink-policy", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationDownlinkPolicy(flags, flagsplugin.Prefix("downlink-policy", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationLink message from select flags.
@@ -36,6 +38,16 @@ func PathsFromSelectFlagsForApplicationLink(flags *pflag.FlagSet, prefix string)
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("skip_payload_crypto", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("downlink_policy", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("downlink_policy", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationDownlinkPolicy(flags, flagsplugin.Prefix("downlink_policy", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -43,6 +55,7 @@ func PathsFromSelectFlagsForApplicationLink(flags *pflag.FlagSet, prefix string)
 func AddSetFlagsForApplicationLink(flags *pflag.FlagSet, prefix string, hidden bool) {
 	AddSetFlagsForMessagePayloadFormatters(flags, flagsplugin.Prefix("default-formatters", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("skip-payload-crypto", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForApplicationDownlinkPolicy(flags, flagsplugin.Prefix("downlink-policy", prefix), hidden)
 }
 
 // SetFromFlags sets the ApplicationLink message from flags.
@@ -63,5 +76,13 @@ func (m *ApplicationLink) SetFromFlags(flags *pflag.FlagSet, prefix string) (pat
 		m.SkipPayloadCrypto = &types.BoolValue{Value: val}
 		paths = append(paths, flagsplugin.Prefix("skip_payload_crypto", prefix))
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("downlink_policy", prefix)); changed {
+		m.DownlinkPolicy = &ApplicationDownlinkPolicy{}
+		if setPaths, err := m.DownlinkPolicy.SetFromFlags(flags, flagsplugin.Prefix("downlink_policy", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	return paths, nil
 }
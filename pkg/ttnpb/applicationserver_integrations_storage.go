@@ -38,6 +38,7 @@ var StoredApplicationUpTypes = map[string]struct{}{
 	"downlink_queue_invalidated": {},
 	"location_solved":            {},
 	"service_data":               {},
+	"session_recovered":          {},
 }
 
 // WithEndDeviceIds returns the request with set EndDeviceIdentifiers.
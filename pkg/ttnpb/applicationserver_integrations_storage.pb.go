@@ -302,65 +302,66 @@ func init() {
 }
 
 var fileDescriptor_6ff0e9f52f73d254 = []byte{
-	// 919 bytes of a gzipped FileDescriptorProto
+	// 930 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xee, 0x3a, 0xb6, 0x4b, 0x26, 0x69, 0x02, 0xa3, 0x0a, 0x2d, 0xa6, 0x69, 0x2d, 0x17, 0xa1,
-	0x5c, 0xbc, 0x5b, 0xd9, 0x42, 0x0a, 0x1c, 0x2a, 0xd5, 0xb4, 0xa0, 0x80, 0x50, 0x61, 0xda, 0x70,
-	0xc8, 0x65, 0x35, 0xde, 0x7d, 0xde, 0x0c, 0x5e, 0xcf, 0x4c, 0x67, 0xc6, 0x0e, 0x26, 0xca, 0x01,
-	0x6e, 0x9c, 0xf9, 0x27, 0x90, 0x38, 0x70, 0xe2, 0x04, 0x02, 0x84, 0x38, 0x71, 0x03, 0x0e, 0xbd,
-	0x71, 0x01, 0x89, 0xff, 0x21, 0x27, 0xb4, 0xb3, 0xeb, 0xdf, 0x49, 0xea, 0x46, 0x48, 0xd0, 0xdb,
-	0xbc, 0xb7, 0xdf, 0xfb, 0xf6, 0xed, 0x7b, 0xdf, 0xa7, 0x1d, 0x74, 0x3b, 0x11, 0x8a, 0x1e, 0x52,
-	0x5e, 0xd7, 0x86, 0x86, 0x5d, 0x9f, 0x4a, 0xe6, 0x53, 0x29, 0x13, 0x16, 0x52, 0xc3, 0x04, 0xd7,
-	0xa0, 0x06, 0xa0, 0x02, 0xc6, 0x0d, 0xc4, 0x2a, 0xcb, 0x04, 0xda, 0x08, 0x45, 0x63, 0xf0, 0xa4,
-	0x12, 0x46, 0xe0, 0x0d, 0x63, 0xb8, 0x97, 0x73, 0x78, 0x83, 0x66, 0xe5, 0x4e, 0xcc, 0xcc, 0x41,
-	0xbf, 0xed, 0x85, 0xa2, 0xe7, 0x03, 0x1f, 0x88, 0xa1, 0x54, 0xe2, 0xe3, 0xa1, 0x6f, 0xc1, 0x61,
-	0x3d, 0x06, 0x5e, 0x1f, 0xd0, 0x84, 0x45, 0xd4, 0x80, 0xbf, 0x70, 0xc8, 0x28, 0x2b, 0xf5, 0x29,
-	0x8a, 0x58, 0xc4, 0x22, 0x2b, 0x6e, 0xf7, 0x3b, 0x36, 0xb2, 0x81, 0x3d, 0xe5, 0xf0, 0x6b, 0xb1,
-	0x10, 0x71, 0x02, 0x59, 0xeb, 0x9c, 0x0b, 0x93, 0xf5, 0x99, 0x3f, 0xad, 0xe6, 0x4f, 0xc7, 0x1c,
-	0x1d, 0x06, 0x49, 0x14, 0xf4, 0xa8, 0xee, 0xe6, 0x88, 0x1b, 0xf3, 0x08, 0xc3, 0x7a, 0xa0, 0x0d,
-	0xed, 0xc9, 0x1c, 0x70, 0x7d, 0x1e, 0x10, 0xf5, 0xb3, 0x59, 0x9c, 0xf5, 0xfc, 0x50, 0x51, 0x29,
-	0x41, 0x8d, 0x5a, 0xb8, 0xb9, 0x38, 0x62, 0x16, 0x01, 0x37, 0xac, 0xc3, 0x26, 0xa0, 0xea, 0x22,
-	0xa8, 0x07, 0x5a, 0xd3, 0x18, 0x72, 0x44, 0xed, 0xf3, 0x32, 0xda, 0x7a, 0x1b, 0xcc, 0x03, 0x23,
-	0x14, 0x44, 0x77, 0x26, 0x3b, 0xda, 0x93, 0x04, 0x1e, 0xf5, 0x41, 0x1b, 0x7c, 0x1f, 0x6d, 0x4e,
-	0xed, 0x2e, 0x60, 0x91, 0x76, 0x9d, 0xaa, 0xb3, 0xbd, 0xd6, 0x78, 0xd5, 0x9b, 0xdd, 0x92, 0x37,
-	0x55, 0xbe, 0x3b, 0x69, 0x85, 0x6c, 0xd0, 0xe9, 0xbc, 0xc6, 0xef, 0xa0, 0x0d, 0xe0, 0x51, 0x10,
-	0xc1, 0x80, 0x85, 0x60, 0xf9, 0x0a, 0x96, 0xef, 0x95, 0x79, 0xbe, 0x7b, 0x3c, 0xba, 0x6b, 0x41,
-	0xd3, 0x6c, 0xeb, 0x30, 0xc9, 0x6a, 0xfc, 0xd8, 0x41, 0x45, 0x33, 0x94, 0xe0, 0xae, 0x54, 0x9d,
-	0xed, 0xd5, 0xd6, 0x4f, 0xce, 0x49, 0xeb, 0x7b, 0x47, 0x7d, 0xeb, 0x90, 0x4b, 0x64, 0xa3, 0x2f,
-	0x13, 0xc6, 0xbb, 0x41, 0xfe, 0xc1, 0xe4, 0x85, 0x3c, 0xe6, 0x42, 0xf5, 0x68, 0xc2, 0x3e, 0x81,
-	0x88, 0xac, 0x7d, 0x24, 0x18, 0x0f, 0x68, 0x18, 0x82, 0x34, 0x64, 0x3d, 0x12, 0x87, 0xdc, 0x22,
-	0x68, 0xd8, 0x25, 0x57, 0xc6, 0x11, 0x9f, 0x0d, 0x35, 0x70, 0x43, 0x36, 0xc7, 0x61, 0x87, 0xb2,
-	0x04, 0xa2, 0xa9, 0xc4, 0xa3, 0x3e, 0xf4, 0x21, 0x22, 0x95, 0xd9, 0x44, 0xc0, 0xf8, 0x48, 0x8f,
-	0x11, 0xd9, 0x4c, 0x44, 0x3e, 0x4c, 0x2d, 0x92, 0x01, 0x44, 0x64, 0x3d, 0x75, 0x44, 0x3a, 0x8c,
-	0x88, 0x1a, 0x4a, 0xec, 0x07, 0xe1, 0x06, 0x2a, 0x25, 0xac, 0xc7, 0x8c, 0x5b, 0xb4, 0xc3, 0xb9,
-	0xe6, 0x65, 0x7a, 0xf0, 0x46, 0x7a, 0xf0, 0xf6, 0x76, 0xb9, 0x69, 0x36, 0x3e, 0xa4, 0x49, 0x1f,
-	0x48, 0x06, 0xc5, 0xb7, 0x50, 0x89, 0x76, 0x0c, 0x28, 0xb7, 0x64, 0x6b, 0x2a, 0x0b, 0x35, 0x0f,
-	0x47, 0x22, 0x24, 0x19, 0x10, 0x37, 0x50, 0xb9, 0x0d, 0x1d, 0xa1, 0xc0, 0x2d, 0x3f, 0xb1, 0x24,
-	0x47, 0xe2, 0x26, 0x2a, 0x77, 0x02, 0x29, 0x94, 0x71, 0x2f, 0x2f, 0xd3, 0x5a, 0xe7, 0x7d, 0xa1,
-	0x0c, 0xde, 0x41, 0x25, 0xa1, 0x22, 0x50, 0xee, 0x73, 0x76, 0x51, 0xb5, 0x93, 0xd6, 0x0d, 0xb5,
-	0x45, 0x2e, 0x91, 0xf5, 0xba, 0x82, 0x10, 0xd8, 0x00, 0xa2, 0x80, 0x1a, 0xb2, 0x36, 0x1d, 0x64,
-	0x05, 0xf8, 0x75, 0x84, 0x26, 0xee, 0x72, 0x57, 0xcf, 0x68, 0xf3, 0xad, 0x14, 0xf2, 0x1e, 0xd5,
-	0x5d, 0xb2, 0xda, 0x19, 0x1d, 0x71, 0x1d, 0x15, 0x13, 0xaa, 0x8d, 0x8b, 0x6c, 0xd1, 0x4b, 0x0b,
-	0x45, 0x77, 0x73, 0xcb, 0x11, 0x0b, 0xab, 0xfd, 0x56, 0x44, 0xb5, 0xd3, 0xbd, 0xf0, 0xa6, 0xe8,
-	0x73, 0xf3, 0x4c, 0x18, 0xe2, 0xe7, 0x59, 0x43, 0x7c, 0xed, 0x9c, 0xb4, 0xbe, 0x72, 0xd4, 0x97,
-	0xa7, 0x19, 0xe2, 0xff, 0xac, 0xfe, 0xb1, 0x92, 0x8b, 0x4f, 0xaf, 0xe4, 0xd2, 0x05, 0x94, 0x5c,
-	0x5e, 0x5e, 0xc9, 0x23, 0x51, 0x5d, 0x5e, 0x4e, 0x54, 0xdf, 0x38, 0xe8, 0xe6, 0xb9, 0xa2, 0xd2,
-	0x32, 0xfd, 0x23, 0xe2, 0x87, 0xa8, 0x14, 0xa6, 0x09, 0xd7, 0xa9, 0xae, 0x6c, 0xaf, 0x35, 0x6e,
-	0xcf, 0xef, 0x7e, 0x09, 0x0e, 0xcf, 0x46, 0xf7, 0xb8, 0x51, 0x43, 0x92, 0x91, 0x55, 0x76, 0x10,
-	0x9a, 0x24, 0xf1, 0xf3, 0x68, 0xa5, 0x0b, 0x43, 0xab, 0xd6, 0x55, 0x92, 0x1e, 0xf1, 0x55, 0x54,
-	0x1a, 0xa4, 0x1f, 0x67, 0x15, 0x77, 0x85, 0x64, 0xc1, 0x1b, 0x85, 0x1d, 0xa7, 0xf1, 0x43, 0x09,
-	0x5d, 0x9d, 0x79, 0xd5, 0x83, 0xec, 0x0f, 0x8d, 0xbf, 0x2b, 0xa0, 0x17, 0x4f, 0x6f, 0x06, 0xd7,
-	0x97, 0x6b, 0x3a, 0x37, 0x52, 0x65, 0xeb, 0x1c, 0xbf, 0xec, 0xc9, 0xda, 0xaf, 0xce, 0x67, 0xbf,
-	0xff, 0xf5, 0x45, 0xe1, 0x17, 0x07, 0x1f, 0xf9, 0x54, 0xcf, 0x5c, 0x20, 0xfc, 0xa3, 0x59, 0xc3,
-	0x78, 0x73, 0x86, 0x9c, 0x8b, 0x8f, 0xfd, 0x0c, 0xba, 0x58, 0x37, 0x3e, 0x1e, 0xfb, 0x92, 0x86,
-	0xdd, 0xf4, 0xc7, 0xe8, 0xe7, 0x57, 0x11, 0xff, 0x28, 0x55, 0xe2, 0xf1, 0xfe, 0xbb, 0x78, 0x77,
-	0xf1, 0xf5, 0x4f, 0x7a, 0xdf, 0x19, 0x64, 0xb7, 0x1c, 0xfc, 0x77, 0x01, 0xbd, 0x7c, 0xce, 0x2e,
-	0x71, 0xe3, 0xa9, 0x16, 0x9f, 0x0d, 0xb2, 0x79, 0x01, 0xb1, 0xd4, 0xfe, 0xc8, 0xc6, 0xfb, 0xd8,
-	0xc1, 0x9f, 0x3a, 0xff, 0xe1, 0x7c, 0x7d, 0x2b, 0xd4, 0xfd, 0x0f, 0xf0, 0xfd, 0x7f, 0x6d, 0xca,
-	0x19, 0x65, 0xeb, 0xb5, 0x1f, 0xff, 0xbc, 0xee, 0xec, 0xfb, 0xb1, 0xf0, 0xcc, 0x01, 0x98, 0x03,
-	0xc6, 0x63, 0xed, 0x71, 0x30, 0x87, 0x42, 0x75, 0xfd, 0xd9, 0x7b, 0xd1, 0xa0, 0xe9, 0xcb, 0x6e,
-	0xec, 0x1b, 0xc3, 0x65, 0xbb, 0x5d, 0xb6, 0x4e, 0x6e, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x5b,
-	0x07, 0x69, 0x0e, 0xc4, 0x0a, 0x00, 0x00,
+	0x14, 0xee, 0xa6, 0xb6, 0x4b, 0x26, 0x69, 0x42, 0x47, 0x15, 0x5a, 0x4c, 0xd3, 0x5a, 0x2e, 0x42,
+	0xb9, 0x78, 0xb7, 0xb2, 0x85, 0x14, 0x38, 0x54, 0xaa, 0x69, 0x41, 0x01, 0xa1, 0xc2, 0xb4, 0xe1,
+	0x90, 0xcb, 0x6a, 0xbc, 0xf3, 0xbc, 0x19, 0xbc, 0x9e, 0xd9, 0xce, 0x8c, 0x37, 0x98, 0x28, 0x07,
+	0xf8, 0x17, 0xb8, 0xf2, 0x6f, 0x70, 0x02, 0x01, 0xe2, 0xc8, 0x01, 0xa9, 0x42, 0xa8, 0x37, 0x2e,
+	0x80, 0x90, 0xf8, 0x13, 0x72, 0x42, 0x3b, 0xbb, 0xfe, 0x9d, 0xa4, 0x6e, 0x85, 0x84, 0x7a, 0x9b,
+	0xf7, 0xe6, 0x7b, 0xdf, 0xbe, 0x7d, 0xef, 0xfb, 0xb4, 0x8b, 0x6e, 0xc7, 0x52, 0xd1, 0x43, 0x2a,
+	0x1a, 0xda, 0xd0, 0xb0, 0xe7, 0xd3, 0x84, 0xfb, 0x34, 0x49, 0x62, 0x1e, 0x52, 0xc3, 0xa5, 0xd0,
+	0xa0, 0x52, 0x50, 0x01, 0x17, 0x06, 0x22, 0x95, 0x67, 0x02, 0x6d, 0xa4, 0xa2, 0x11, 0x78, 0x89,
+	0x92, 0x46, 0xe2, 0x0d, 0x63, 0x84, 0x57, 0x70, 0x78, 0x69, 0xab, 0x7a, 0x27, 0xe2, 0xe6, 0x60,
+	0xd0, 0xf1, 0x42, 0xd9, 0xf7, 0x41, 0xa4, 0x72, 0x98, 0x28, 0xf9, 0xd9, 0xd0, 0xb7, 0xe0, 0xb0,
+	0x11, 0x81, 0x68, 0xa4, 0x34, 0xe6, 0x8c, 0x1a, 0xf0, 0x17, 0x0e, 0x39, 0x65, 0xb5, 0x31, 0x45,
+	0x11, 0xc9, 0x48, 0xe6, 0xc5, 0x9d, 0x41, 0xd7, 0x46, 0x36, 0xb0, 0xa7, 0x02, 0x7e, 0x2d, 0x92,
+	0x32, 0x8a, 0x21, 0x6f, 0x5d, 0x08, 0x69, 0xf2, 0x3e, 0x8b, 0xdb, 0x5a, 0x71, 0x3b, 0xe6, 0xe8,
+	0x72, 0x88, 0x59, 0xd0, 0xa7, 0xba, 0x57, 0x20, 0x6e, 0xcc, 0x23, 0x0c, 0xef, 0x83, 0x36, 0xb4,
+	0x9f, 0x14, 0x80, 0xeb, 0xf3, 0x00, 0x36, 0xc8, 0x67, 0x71, 0xd6, 0xfd, 0xa1, 0xa2, 0x49, 0x02,
+	0x6a, 0xd4, 0xc2, 0xcd, 0xc5, 0x11, 0x73, 0x06, 0xc2, 0xf0, 0x2e, 0x9f, 0x80, 0x6a, 0x8b, 0xa0,
+	0x3e, 0x68, 0x4d, 0x23, 0x28, 0x10, 0xf5, 0xaf, 0x2b, 0x68, 0xeb, 0x3d, 0x30, 0x0f, 0x8c, 0x54,
+	0xc0, 0xee, 0x4c, 0x76, 0xb4, 0x97, 0x10, 0x78, 0x34, 0x00, 0x6d, 0xf0, 0x7d, 0xb4, 0x39, 0xb5,
+	0xbb, 0x80, 0x33, 0xed, 0x3a, 0x35, 0x67, 0x7b, 0xad, 0xf9, 0x86, 0x37, 0xbb, 0x25, 0x6f, 0xaa,
+	0x7c, 0x77, 0xd2, 0x0a, 0xd9, 0xa0, 0xd3, 0x79, 0x8d, 0xdf, 0x47, 0x1b, 0x20, 0x58, 0xc0, 0x20,
+	0xe5, 0x21, 0x58, 0xbe, 0x15, 0xcb, 0xf7, 0xfa, 0x3c, 0xdf, 0x3d, 0xc1, 0xee, 0x5a, 0xd0, 0x34,
+	0xdb, 0x3a, 0x4c, 0xb2, 0x1a, 0xff, 0xe3, 0xa0, 0x92, 0x19, 0x26, 0xe0, 0x5e, 0xac, 0x39, 0xdb,
+	0xab, 0xed, 0xdf, 0x9c, 0x93, 0xf6, 0x63, 0x47, 0xfd, 0xe2, 0x90, 0x0b, 0x64, 0x63, 0x90, 0xc4,
+	0x5c, 0xf4, 0x82, 0xe2, 0x85, 0xc9, 0x95, 0x22, 0x16, 0x52, 0xf5, 0x69, 0xcc, 0x3f, 0x07, 0x46,
+	0xd6, 0x3e, 0x95, 0x5c, 0x04, 0x34, 0x0c, 0x21, 0x31, 0x64, 0x9d, 0xc9, 0x43, 0x61, 0x11, 0x34,
+	0xec, 0x91, 0xcb, 0xe3, 0x48, 0xcc, 0x86, 0x1a, 0x84, 0x21, 0x9b, 0xe3, 0xb0, 0x4b, 0x79, 0x0c,
+	0x6c, 0x2a, 0xf1, 0x68, 0x00, 0x03, 0x60, 0xa4, 0x3a, 0x9b, 0x08, 0xb8, 0x18, 0xe9, 0x91, 0x91,
+	0xcd, 0x58, 0x16, 0xc3, 0xd4, 0x32, 0x4e, 0x81, 0x91, 0xf5, 0xcc, 0x11, 0xd9, 0x30, 0x18, 0x35,
+	0x94, 0x5c, 0xd1, 0xa0, 0x75, 0x76, 0xab, 0x20, 0x94, 0x29, 0x28, 0x60, 0xc4, 0xbe, 0x23, 0x6e,
+	0xa2, 0x72, 0xcc, 0xfb, 0xdc, 0xb8, 0x25, 0x3b, 0xaf, 0x6b, 0x5e, 0x2e, 0x11, 0x6f, 0x24, 0x11,
+	0x6f, 0x6f, 0x57, 0x98, 0x56, 0xf3, 0x13, 0x1a, 0x0f, 0x80, 0xe4, 0x50, 0x7c, 0x0b, 0x95, 0x69,
+	0xd7, 0x80, 0x72, 0xcb, 0xb6, 0xa6, 0xba, 0x50, 0xf3, 0x70, 0xa4, 0x4b, 0x92, 0x03, 0x71, 0x13,
+	0x55, 0x3a, 0xd0, 0x95, 0x0a, 0xdc, 0xca, 0x53, 0x4b, 0x0a, 0x24, 0x6e, 0xa1, 0x4a, 0x37, 0x48,
+	0xa4, 0x32, 0xee, 0xa5, 0x65, 0x5a, 0xeb, 0x7e, 0x24, 0x95, 0xc1, 0x3b, 0xa8, 0x2c, 0x15, 0x03,
+	0xe5, 0xbe, 0x64, 0x77, 0x57, 0x3f, 0x69, 0xdf, 0x50, 0x5b, 0xe4, 0x02, 0x59, 0x6f, 0x28, 0x08,
+	0x81, 0xa7, 0xc0, 0x02, 0x6a, 0xc8, 0xda, 0x74, 0x90, 0x17, 0xe0, 0xb7, 0x10, 0x9a, 0x18, 0xce,
+	0x5d, 0x3d, 0xa3, 0xcd, 0x77, 0x33, 0xc8, 0x87, 0x54, 0xf7, 0xc8, 0x6a, 0x77, 0x74, 0xc4, 0x0d,
+	0x54, 0x8a, 0xa9, 0x36, 0x2e, 0xb2, 0x45, 0xaf, 0x2e, 0x14, 0xdd, 0x2d, 0x5c, 0x48, 0x2c, 0xac,
+	0xfe, 0x57, 0x09, 0xd5, 0x4f, 0xb7, 0xc7, 0x3b, 0x72, 0x20, 0xcc, 0x0b, 0xe1, 0x91, 0x27, 0xb3,
+	0x1e, 0xf9, 0xc9, 0x39, 0x69, 0x7f, 0xef, 0xa8, 0x6f, 0x4f, 0xf3, 0xc8, 0x0b, 0x66, 0x88, 0xb1,
+	0xb8, 0x4b, 0xcf, 0x2e, 0xee, 0xf2, 0x73, 0x88, 0xbb, 0xb2, 0xbc, 0xb8, 0x47, 0x3a, 0xbb, 0xb4,
+	0x9c, 0xce, 0xbe, 0x71, 0xd0, 0xcd, 0x73, 0x75, 0xa6, 0x93, 0xec, 0xbb, 0x89, 0x1f, 0xa2, 0x72,
+	0x98, 0x25, 0x5c, 0xa7, 0x76, 0x71, 0x7b, 0xad, 0x79, 0x7b, 0x5e, 0x0e, 0x4b, 0x70, 0x78, 0x36,
+	0xba, 0x27, 0x8c, 0x1a, 0x92, 0x9c, 0xac, 0xba, 0x83, 0xd0, 0x24, 0x89, 0x5f, 0x46, 0x17, 0x7b,
+	0x30, 0xb4, 0x02, 0x5e, 0x25, 0xd9, 0x11, 0x5f, 0x45, 0xe5, 0x34, 0x7b, 0x39, 0x2b, 0xc2, 0xcb,
+	0x24, 0x0f, 0xde, 0x5e, 0xd9, 0x71, 0x9a, 0x3f, 0x94, 0xd1, 0xd5, 0x99, 0x47, 0x3d, 0xc8, 0xbf,
+	0xe3, 0xf8, 0xbb, 0x15, 0xf4, 0xca, 0xe9, 0xcd, 0xe0, 0xc6, 0x72, 0x4d, 0x17, 0xde, 0xaa, 0x6e,
+	0x9d, 0x63, 0xa1, 0xbd, 0xa4, 0xfe, 0xd8, 0xf9, 0xf2, 0xd7, 0x3f, 0xbf, 0x5a, 0xf9, 0xd9, 0xc1,
+	0x47, 0x3e, 0xd5, 0x33, 0xbf, 0x19, 0xfe, 0xd1, 0xac, 0x87, 0xbc, 0x39, 0x8f, 0xce, 0xc5, 0xc7,
+	0x7e, 0x0e, 0x5d, 0xac, 0x1b, 0x1f, 0x8f, 0xfd, 0x84, 0x86, 0xbd, 0xec, 0xf3, 0xe9, 0x17, 0x3f,
+	0x2c, 0xfe, 0x51, 0xa6, 0xc4, 0xe3, 0xfd, 0x0f, 0xf0, 0xee, 0xe2, 0xe3, 0x9f, 0xf6, 0xbc, 0x33,
+	0xc8, 0x6e, 0x39, 0xf8, 0xef, 0x15, 0xf4, 0xda, 0x39, 0xbb, 0xc4, 0xcd, 0x67, 0x5a, 0x7c, 0x3e,
+	0xc8, 0xd6, 0x73, 0x88, 0xa5, 0xfe, 0x7b, 0x3e, 0xde, 0x27, 0x0e, 0xfe, 0xc2, 0xf9, 0x1f, 0xe7,
+	0xeb, 0x5b, 0xa1, 0xee, 0x7f, 0x8c, 0xef, 0xff, 0x67, 0x53, 0xce, 0x29, 0xdb, 0x6f, 0xfe, 0xf8,
+	0xc7, 0x75, 0x67, 0xdf, 0x8f, 0xa4, 0x67, 0x0e, 0xc0, 0x1c, 0x70, 0x11, 0x69, 0x4f, 0x80, 0x39,
+	0x94, 0xaa, 0xe7, 0xcf, 0xfe, 0x3d, 0xa5, 0x2d, 0x3f, 0xe9, 0x45, 0xbe, 0x31, 0x22, 0xe9, 0x74,
+	0x2a, 0xd6, 0xc9, 0xad, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x15, 0x20, 0x78, 0xac, 0xea, 0x0a,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
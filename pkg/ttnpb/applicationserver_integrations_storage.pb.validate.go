@@ -79,7 +79,7 @@ func (m *GetStoredApplicationUpRequest) ValidateFields(paths ...string) error {
 			if _, ok := _GetStoredApplicationUpRequest_Type_InLookup[m.GetType()]; !ok {
 				return GetStoredApplicationUpRequestValidationError{
 					field:  "type",
-					reason: "value must be in list [ uplink_message uplink_normalized join_accept downlink_ack downlink_nack downlink_sent downlink_failed downlink_queued downlink_queue_invalidated location_solved service_data]",
+					reason: "value must be in list [ uplink_message uplink_normalized join_accept downlink_ack downlink_nack downlink_sent downlink_failed downlink_queued downlink_queue_invalidated location_solved service_data session_recovered]",
 				}
 			}
 
@@ -244,6 +244,7 @@ var _GetStoredApplicationUpRequest_Type_InLookup = map[string]struct{}{
 	"downlink_queue_invalidated": {},
 	"location_solved":            {},
 	"service_data":               {},
+	"session_recovered":          {},
 }
 
 var _GetStoredApplicationUpRequest_Order_InLookup = map[string]struct{}{
@@ -296,7 +297,7 @@ func (m *GetStoredApplicationUpCountRequest) ValidateFields(paths ...string) err
 			if _, ok := _GetStoredApplicationUpCountRequest_Type_InLookup[m.GetType()]; !ok {
 				return GetStoredApplicationUpCountRequestValidationError{
 					field:  "type",
-					reason: "value must be in list [ uplink_message join_accept downlink_ack downlink_nack downlink_sent downlink_failed downlink_queued downlink_queue_invalidated location_solved service_data]",
+					reason: "value must be in list [ uplink_message join_accept downlink_ack downlink_nack downlink_sent downlink_failed downlink_queued downlink_queue_invalidated location_solved service_data session_recovered]",
 				}
 			}
 
@@ -427,6 +428,7 @@ var _GetStoredApplicationUpCountRequest_Type_InLookup = map[string]struct{}{
 	"downlink_queue_invalidated": {},
 	"location_solved":            {},
 	"service_data":               {},
+	"session_recovered":          {},
 }
 
 // ValidateFields checks the field values on
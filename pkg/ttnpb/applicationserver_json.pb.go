@@ -34,6 +34,12 @@ func (x *ApplicationLink) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteBool(x.SkipPayloadCrypto.Value)
 		}
 	}
+	if x.DownlinkPolicy != nil || s.HasField("downlink_policy") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("downlink_policy")
+		// NOTE: ApplicationDownlinkPolicy does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.DownlinkPolicy)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -69,6 +75,16 @@ func (x *ApplicationLink) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.SkipPayloadCrypto = &types.BoolValue{Value: v}
+		case "downlink_policy", "downlinkPolicy":
+			s.AddField("downlink_policy")
+			if s.ReadNil() {
+				x.DownlinkPolicy = nil
+				return
+			}
+			// NOTE: ApplicationDownlinkPolicy does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationDownlinkPolicy
+			gogo.UnmarshalMessage(s, &v)
+			x.DownlinkPolicy = &v
 		}
 	})
 }
@@ -106,6 +122,11 @@ func (x *SetApplicationLinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState)
 			gogo.MarshalFieldMask(s, x.FieldMask)
 		}
 	}
+	if x.SkipFormatterTestVectors || s.HasField("skip_formatter_test_vectors") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("skip_formatter_test_vectors")
+		s.WriteBool(x.SkipFormatterTestVectors)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -151,6 +172,9 @@ func (x *SetApplicationLinkRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalSt
 				return
 			}
 			x.FieldMask = v
+		case "skip_formatter_test_vectors", "skipFormatterTestVectors":
+			s.AddField("skip_formatter_test_vectors")
+			x.SkipFormatterTestVectors = s.ReadBool()
 		}
 	})
 }
@@ -404,6 +428,201 @@ func (x *GetAsConfigurationResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the TestFormattersRequest message to JSON.
+func (x *TestFormattersRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.ApplicationIds)
+	}
+	if x.DeviceId != "" || s.HasField("device_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_id")
+		s.WriteString(x.DeviceId)
+	}
+	if x.Formatters != nil || s.HasField("formatters") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("formatters")
+		x.Formatters.MarshalProtoJSON(s.WithField("formatters"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the TestFormattersRequest to JSON.
+func (x *TestFormattersRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TestFormattersRequest message from JSON.
+func (x *TestFormattersRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			gogo.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "device_id", "deviceId":
+			s.AddField("device_id")
+			x.DeviceId = s.ReadString()
+		case "formatters":
+			if s.ReadNil() {
+				x.Formatters = nil
+				return
+			}
+			x.Formatters = &MessagePayloadFormatters{}
+			x.Formatters.UnmarshalProtoJSON(s.WithField("formatters", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the TestFormattersRequest from JSON.
+func (x *TestFormattersRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the TestFormattersResponse_Result message to JSON.
+func (x *TestFormattersResponse_Result) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Name != "" || s.HasField("name") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("name")
+		s.WriteString(x.Name)
+	}
+	if x.Passed || s.HasField("passed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("passed")
+		s.WriteBool(x.Passed)
+	}
+	if x.Output != nil || s.HasField("output") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("output")
+		x.Output.MarshalProtoJSON(s.WithField("output"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the TestFormattersResponse_Result to JSON.
+func (x *TestFormattersResponse_Result) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TestFormattersResponse_Result message from JSON.
+func (x *TestFormattersResponse_Result) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "name":
+			s.AddField("name")
+			x.Name = s.ReadString()
+		case "passed":
+			s.AddField("passed")
+			x.Passed = s.ReadBool()
+		case "output":
+			if s.ReadNil() {
+				x.Output = nil
+				return
+			}
+			x.Output = &MessagePayloadFormatterTestVector{}
+			x.Output.UnmarshalProtoJSON(s.WithField("output", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the TestFormattersResponse_Result from JSON.
+func (x *TestFormattersResponse_Result) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the TestFormattersResponse message to JSON.
+func (x *TestFormattersResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Results) > 0 || s.HasField("results") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("results")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Results {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("results"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the TestFormattersResponse to JSON.
+func (x *TestFormattersResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TestFormattersResponse message from JSON.
+func (x *TestFormattersResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "results":
+			s.AddField("results")
+			if s.ReadNil() {
+				x.Results = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Results = append(x.Results, nil)
+					return
+				}
+				v := &TestFormattersResponse_Result{}
+				v.UnmarshalProtoJSON(s.WithField("results", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Results = append(x.Results, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the TestFormattersResponse from JSON.
+func (x *TestFormattersResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the NsAsHandleUplinkRequest message to JSON.
 func (x *NsAsHandleUplinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -874,3 +1093,57 @@ func (x *DecodeDownlinkResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState
 func (x *DecodeDownlinkResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the DurableApplicationUp message to JSON.
+func (x *DurableApplicationUp) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Id != "" || s.HasField("id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("id")
+		s.WriteString(x.Id)
+	}
+	if x.Up != nil || s.HasField("up") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("up")
+		x.Up.MarshalProtoJSON(s.WithField("up"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the DurableApplicationUp to JSON.
+func (x *DurableApplicationUp) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the DurableApplicationUp message from JSON.
+func (x *DurableApplicationUp) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "id":
+			s.AddField("id")
+			x.Id = s.ReadString()
+		case "up":
+			if s.ReadNil() {
+				x.Up = nil
+				return
+			}
+			x.Up = &ApplicationUp{}
+			x.Up.UnmarshalProtoJSON(s.WithField("up", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the DurableApplicationUp from JSON.
+func (x *DurableApplicationUp) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -137,9 +137,14 @@ type ApplicationPubSub struct {
 	DownlinkQueueInvalidated *ApplicationPubSub_Message `protobuf:"bytes,19,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationPubSub_Message `protobuf:"bytes,16,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationPubSub_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                   `json:"-"`
-	XXX_unrecognized         []byte                     `json:"-"`
-	XXX_sizecache            int32                      `json:"-"`
+	SessionRecovered         *ApplicationPubSub_Message `protobuf:"bytes,21,opt,name=session_recovered,json=sessionRecovered,proto3" json:"session_recovered,omitempty"`
+	// Filter expression which the upstream messages must match in order to be published.
+	// Upstream message fields are referenced as `up.<path>` and end device attributes as `attributes.<key>`,
+	// for example `up.uplink_message.f_port == 2 && attributes.building == "a"`.
+	Filter               string   `protobuf:"bytes,22,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationPubSub) Reset()         { *m = ApplicationPubSub{} }
@@ -338,6 +343,20 @@ func (m *ApplicationPubSub) GetServiceData() *ApplicationPubSub_Message {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetSessionRecovered() *ApplicationPubSub_Message {
+	if m != nil {
+		return m.SessionRecovered
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1069,146 +1088,148 @@ func init() {
 }
 
 var fileDescriptor_1dce56ec18597200 = []byte{
-	// 2217 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0xce, 0x58, 0xb1, 0x6c, 0x3d, 0xc9, 0xb6, 0x32, 0x9b, 0x76, 0x69, 0x65, 0x93, 0xcd, 0x6a,
-	0x8d, 0x5d, 0xd9, 0x09, 0xa5, 0x44, 0xee, 0x6e, 0x37, 0x0a, 0x8a, 0x44, 0xb4, 0x9d, 0xd8, 0x4d,
-	0xe2, 0xc4, 0x94, 0xb6, 0xdb, 0x24, 0x4e, 0x88, 0x91, 0x38, 0x96, 0x19, 0x53, 0x24, 0xc3, 0x19,
-	0xda, 0x71, 0x7e, 0x00, 0xc1, 0x5e, 0x5a, 0xf4, 0x50, 0x2c, 0xd0, 0x43, 0x51, 0x14, 0x45, 0x81,
-	0x16, 0x68, 0x81, 0x1c, 0x8b, 0x9e, 0xbb, 0xed, 0xad, 0x28, 0x50, 0xf4, 0xd0, 0x3f, 0xa1, 0x45,
-	0x0f, 0xbd, 0x14, 0xd8, 0x53, 0xe1, 0x02, 0x45, 0xc1, 0x21, 0x29, 0xd2, 0x56, 0x36, 0xb6, 0x1c,
-	0xf4, 0xb0, 0x97, 0xe4, 0x0d, 0xdf, 0x7b, 0xdf, 0x7c, 0xef, 0xcd, 0xf3, 0xbc, 0x99, 0x11, 0x9c,
-	0x33, 0x6d, 0x97, 0x6c, 0x11, 0x4b, 0x66, 0x9c, 0xb4, 0x37, 0x2a, 0xc4, 0x31, 0x2a, 0xc4, 0x71,
-	0x4c, 0xa3, 0x4d, 0xb8, 0x61, 0x5b, 0x8c, 0xba, 0x9b, 0xd4, 0xd5, 0x1c, 0xaf, 0xc5, 0xbc, 0x56,
-	0xd9, 0x71, 0x6d, 0x6e, 0xe3, 0x71, 0xce, 0xad, 0x72, 0xe8, 0x55, 0xde, 0x9c, 0x2d, 0xcc, 0x77,
-	0x0c, 0xbe, 0xee, 0xb5, 0xca, 0x6d, 0xbb, 0x5b, 0x69, 0xae, 0xd3, 0xe6, 0xba, 0x61, 0x75, 0xd8,
-	0x92, 0xa5, 0x7b, 0x8c, 0xbb, 0x06, 0x65, 0x15, 0xe1, 0xd5, 0x96, 0x3b, 0xd4, 0x92, 0x3b, 0xb6,
-	0xbc, 0x66, 0x92, 0x0e, 0xab, 0x10, 0xcb, 0xb2, 0x79, 0x30, 0x43, 0x80, 0x5a, 0xa8, 0x27, 0x50,
-	0xa8, 0xb5, 0x69, 0x6f, 0x3b, 0xae, 0xfd, 0x68, 0x3b, 0xe9, 0xbc, 0x49, 0x4c, 0x43, 0x27, 0x9c,
-	0x56, 0xfa, 0x84, 0x10, 0x42, 0x4e, 0x40, 0x74, 0xec, 0x8e, 0x1d, 0x38, 0xb7, 0xbc, 0x35, 0x31,
-	0x12, 0x03, 0x21, 0x85, 0xe6, 0x73, 0x03, 0xf1, 0x7e, 0xc0, 0x6c, 0xeb, 0x25, 0xb4, 0xdf, 0xea,
-	0xd8, 0x76, 0xc7, 0xa4, 0x41, 0xde, 0xfa, 0xb4, 0xa7, 0x42, 0x6d, 0x8f, 0x88, 0xee, 0xb9, 0xc2,
-	0x20, 0xd4, 0x9f, 0xd8, 0xab, 0xa7, 0x5d, 0x87, 0x6f, 0x87, 0xca, 0xd3, 0x7b, 0x95, 0x6b, 0x06,
-	0x35, 0x75, 0xad, 0x4b, 0xd8, 0x46, 0x68, 0xf1, 0xf6, 0x5e, 0x0b, 0x6e, 0x74, 0x29, 0xe3, 0xa4,
-	0xeb, 0x84, 0x06, 0xef, 0xf6, 0x2f, 0xae, 0xa1, 0x53, 0x8b, 0x1b, 0x6b, 0x06, 0x75, 0x43, 0x92,
-	0xc5, 0x3f, 0x21, 0x78, 0xab, 0x1e, 0x2f, 0xf9, 0x2d, 0xaf, 0xd5, 0xf0, 0x5a, 0x4b, 0xb1, 0x19,
-	0xbe, 0x0d, 0x13, 0x89, 0x92, 0xd0, 0x0c, 0x9d, 0x49, 0xe8, 0x34, 0x2a, 0x65, 0xab, 0xef, 0x95,
-	0x77, 0x97, 0x42, 0x39, 0x01, 0x93, 0x00, 0x50, 0x46, 0x77, 0x94, 0xe1, 0x1f, 0xa0, 0xa1, 0x3c,
-	0x52, 0xc7, 0x49, 0xd2, 0x82, 0xe1, 0x05, 0x00, 0xc7, 0x6b, 0x69, 0xcc, 0x6b, 0x69, 0x86, 0x2e,
-	0x0d, 0x9d, 0x46, 0xa5, 0x8c, 0xf2, 0xfe, 0x8e, 0x32, 0xe5, 0x16, 0xa5, 0xa9, 0xea, 0xa9, 0xfb,
-	0x77, 0x89, 0xfc, 0xf8, 0x9c, 0x7c, 0xe1, 0x5e, 0xe9, 0x52, 0xed, 0xae, 0x7c, 0xef, 0x52, 0x34,
-	0x9c, 0x7e, 0x52, 0x3d, 0xfb, 0x6c, 0x4a, 0x1d, 0x75, 0x42, 0xaa, 0xb5, 0xd1, 0x2f, 0x5e, 0x4c,
-	0x1e, 0x1d, 0x3d, 0x92, 0x47, 0xc5, 0x3f, 0xbc, 0x03, 0xc7, 0xfa, 0x82, 0xc1, 0xb7, 0x20, 0x15,
-	0xb3, 0x3e, 0xfb, 0x0a, 0xd6, 0x7d, 0xc1, 0x2b, 0xf9, 0x88, 0x7b, 0x30, 0x45, 0x09, 0xa9, 0x3e,
-	0x14, 0x9e, 0x03, 0x68, 0xbb, 0x94, 0x70, 0xaa, 0x6b, 0x84, 0x0b, 0xe2, 0xd9, 0x6a, 0xa1, 0x1c,
-	0xac, 0x47, 0x39, 0x5a, 0x8f, 0x72, 0x33, 0x5a, 0x0f, 0x25, 0x62, 0x78, 0x44, 0xcd, 0x84, 0x7e,
-	0x75, 0xee, 0x83, 0x78, 0x8e, 0x1e, 0x81, 0xa4, 0x06, 0x01, 0x09, 0xfd, 0xea, 0x1c, 0x5f, 0x82,
-	0xf4, 0x9a, 0xed, 0x76, 0x09, 0x97, 0x8e, 0x26, 0xd3, 0x77, 0x7c, 0xdf, 0xf4, 0x85, 0x6e, 0x78,
-	0x1e, 0x8e, 0x5a, 0x84, 0x33, 0xe9, 0x98, 0x98, 0xbf, 0xbc, 0x6f, 0x76, 0xca, 0xcb, 0xf5, 0x66,
-	0xe3, 0x96, 0x6b, 0x6f, 0x1a, 0x3a, 0x75, 0x17, 0x8f, 0xa8, 0xc2, 0xdb, 0x47, 0xe9, 0x3e, 0xe4,
-	0x5c, 0x9a, 0x3c, 0x28, 0xca, 0x8d, 0x95, 0x66, 0x33, 0x89, 0xe2, 0x7b, 0xe3, 0x6b, 0x30, 0x42,
-	0xb6, 0x98, 0x66, 0xd8, 0x5c, 0xa2, 0x02, 0xe8, 0xdc, 0xfe, 0x40, 0xf5, 0x4f, 0x1a, 0x4b, 0x76,
-	0x12, 0x2a, 0x4d, 0xb6, 0xd8, 0x92, 0xcd, 0xf1, 0x7b, 0x00, 0x2d, 0xc2, 0xa8, 0xc6, 0x6d, 0xc7,
-	0x68, 0x4b, 0x69, 0x91, 0x9d, 0x91, 0x1d, 0xe5, 0xa8, 0x3b, 0x24, 0xe9, 0x6a, 0xc6, 0x57, 0x35,
-	0x7d, 0x0d, 0x5e, 0x86, 0x31, 0xdd, 0xde, 0xb2, 0x4c, 0xc3, 0xda, 0xd0, 0x1c, 0x8f, 0xad, 0x4b,
-	0x23, 0x62, 0xea, 0xe9, 0x03, 0xc4, 0x40, 0x19, 0x23, 0x1d, 0xaa, 0xe6, 0x22, 0xff, 0x5b, 0x1e,
-	0x5b, 0xc7, 0x4d, 0xc8, 0xf7, 0xf0, 0x5c, 0xea, 0x98, 0xa4, 0x4d, 0xa5, 0xd1, 0x41, 0x21, 0x27,
-	0x22, 0x08, 0x35, 0x40, 0xc0, 0xb7, 0x60, 0xdc, 0x73, 0x04, 0x66, 0x37, 0x30, 0x91, 0x32, 0x83,
-	0x62, 0x8e, 0x05, 0x00, 0xe1, 0x10, 0x7f, 0x07, 0x8e, 0x85, 0x88, 0x96, 0x5f, 0x09, 0xa6, 0xf1,
-	0x98, 0xea, 0xd2, 0xf1, 0x41, 0x41, 0xf3, 0x01, 0xc6, 0x72, 0x0f, 0x02, 0x7f, 0x1b, 0xb2, 0x0f,
-	0x6c, 0xc3, 0xd2, 0x48, 0xbb, 0x4d, 0x1d, 0x2e, 0xc1, 0xa0, 0x88, 0xe0, 0x7b, 0xd7, 0x85, 0x33,
-	0xbe, 0x0e, 0xbd, 0xdc, 0x6a, 0xa4, 0xbd, 0x21, 0x65, 0x07, 0x05, 0xcb, 0x46, 0xee, 0xf5, 0xf6,
-	0xc6, 0xae, 0x95, 0xb6, 0x7c, 0xb8, 0xdc, 0xa1, 0x57, 0x7a, 0x99, 0xec, 0xc1, 0x63, 0xd4, 0xe2,
-	0xd2, 0xd8, 0xa1, 0xf1, 0x1a, 0xd4, 0xe2, 0x58, 0x85, 0xde, 0xb2, 0x6b, 0x6b, 0xc4, 0x30, 0xa9,
-	0x2e, 0x8d, 0x0f, 0x8a, 0x38, 0x1e, 0x21, 0x5c, 0x11, 0x00, 0xbb, 0x30, 0x1f, 0x7a, 0xd4, 0xa3,
-	0xba, 0x34, 0x71, 0x68, 0xcc, 0x15, 0x01, 0x80, 0x3b, 0x50, 0xd8, 0x8d, 0xa9, 0x19, 0x56, 0xd4,
-	0x8c, 0x75, 0xe9, 0x8d, 0x41, 0xe1, 0xa5, 0x5d, 0xf0, 0x4b, 0x31, 0x94, 0x4f, 0xde, 0xb4, 0xc3,
-	0xbe, 0xc3, 0x6c, 0x73, 0x93, 0xea, 0x52, 0x7e, 0x60, 0xf2, 0x11, 0x42, 0x43, 0x00, 0xf8, 0x25,
-	0xe5, 0x1f, 0x6b, 0x8c, 0x36, 0xd5, 0x74, 0xc2, 0x89, 0x84, 0x07, 0x2e, 0xa9, 0xd0, 0x7d, 0x9e,
-	0x70, 0x52, 0xa8, 0x43, 0x2e, 0xb9, 0x1f, 0xe2, 0xf7, 0x01, 0xc2, 0x43, 0x93, 0xe7, 0x9a, 0xa2,
-	0xe3, 0x64, 0x44, 0xff, 0x73, 0x53, 0xdf, 0x47, 0x48, 0xcd, 0x04, 0xba, 0x8f, 0x5d, 0x33, 0xec,
-	0x59, 0x28, 0x8f, 0x0a, 0xbf, 0xcd, 0x40, 0x2e, 0xb9, 0x1b, 0x1e, 0x18, 0x03, 0x4f, 0x41, 0xa6,
-	0x6d, 0x1a, 0xd4, 0xe2, 0x71, 0xf7, 0x0c, 0x37, 0xb8, 0x37, 0xd5, 0xd1, 0x40, 0xb3, 0xa4, 0xe3,
-	0x77, 0x61, 0xd4, 0x63, 0xd4, 0xb5, 0x48, 0x97, 0x8a, 0x26, 0x93, 0xd8, 0x05, 0x7b, 0x0a, 0xdf,
-	0xc8, 0x21, 0x8c, 0x6d, 0xd9, 0xae, 0x1e, 0x36, 0x92, 0xd8, 0x28, 0x52, 0xe0, 0x4f, 0x60, 0x8c,
-	0x79, 0x2d, 0xd6, 0x76, 0x8d, 0x16, 0xd5, 0x1e, 0xda, 0x4c, 0x1a, 0x3e, 0x8d, 0x4a, 0xe3, 0xd5,
-	0xea, 0x60, 0xbb, 0x7d, 0x79, 0xc5, 0x6e, 0xa8, 0xb9, 0x1e, 0xd0, 0x8a, 0xcd, 0x70, 0x03, 0xb2,
-	0x8e, 0xd7, 0x32, 0x0d, 0xb6, 0x2e, 0x60, 0xd3, 0x87, 0x86, 0x85, 0x10, 0xc6, 0x07, 0x7d, 0x13,
-	0x46, 0x3c, 0x7f, 0xfb, 0x37, 0x99, 0xd8, 0xd1, 0x47, 0xd5, 0xb4, 0xc7, 0x68, 0xd3, 0x64, 0xf8,
-	0x77, 0x08, 0xd2, 0xdc, 0x64, 0x5a, 0x9b, 0x88, 0x7d, 0x39, 0xa7, 0xfc, 0x0a, 0xed, 0x28, 0xc3,
-	0x8f, 0x53, 0xd2, 0xf3, 0xcb, 0x5f, 0xbc, 0x98, 0xfc, 0x09, 0x2a, 0x2c, 0x1f, 0xe2, 0x48, 0x2b,
-	0xfe, 0x75, 0x4c, 0xaf, 0x63, 0x58, 0xe5, 0x65, 0xba, 0xb5, 0x48, 0x1f, 0x29, 0xdb, 0x9c, 0xb2,
-	0x2b, 0x26, 0xe9, 0x14, 0xaf, 0xbe, 0x26, 0xde, 0x55, 0xca, 0x05, 0x98, 0x3a, 0xcc, 0x4d, 0x36,
-	0x47, 0xf0, 0x5f, 0x10, 0x4c, 0x88, 0x00, 0x82, 0xc5, 0x6f, 0x53, 0x97, 0x8b, 0x6e, 0xf0, 0x15,
-	0x8a, 0x64, 0xcc, 0x8f, 0x44, 0xd0, 0x9f, 0xa3, 0x2e, 0xc7, 0x7f, 0x46, 0x30, 0x9e, 0x88, 0x68,
-	0x83, 0x6e, 0x8b, 0xbe, 0xf1, 0x15, 0x0a, 0x28, 0xd7, 0x0b, 0xe8, 0x1a, 0xdd, 0xc6, 0x1f, 0xc3,
-	0xc8, 0x3a, 0x25, 0x3a, 0x75, 0x99, 0x94, 0x3d, 0x9d, 0x2a, 0x65, 0xab, 0x17, 0x07, 0x2c, 0xe6,
-	0xc5, 0xc0, 0x7b, 0xc1, 0xe2, 0xee, 0xb6, 0x1a, 0x61, 0x15, 0x6a, 0x90, 0x4b, 0x2a, 0x70, 0x1e,
-	0x52, 0x7e, 0xaa, 0xc4, 0x16, 0xa1, 0xfa, 0x22, 0x3e, 0x0e, 0xc3, 0x9b, 0xc4, 0xf4, 0x68, 0xb0,
-	0x1d, 0xa8, 0xc1, 0xa0, 0x36, 0xf4, 0x11, 0x2a, 0xce, 0x43, 0x6a, 0xc5, 0x6e, 0xe0, 0x3c, 0xe4,
-	0xea, 0x4d, 0xed, 0xc6, 0xcd, 0x46, 0x53, 0xbb, 0xb9, 0x3c, 0xb7, 0x90, 0x3f, 0x82, 0x8f, 0xc1,
-	0x58, 0xbd, 0xa9, 0x5d, 0x5f, 0xa8, 0x47, 0x9f, 0x90, 0x6f, 0xb4, 0xf0, 0xdd, 0xfa, 0x5c, 0xf3,
-	0xfa, 0xed, 0xe0, 0xcb, 0x50, 0x21, 0xfd, 0xcf, 0x17, 0x93, 0x43, 0x12, 0x4a, 0x6c, 0x5b, 0xdf,
-	0x03, 0x18, 0xdf, 0x7d, 0xf6, 0xc2, 0x3f, 0x1d, 0x82, 0xb4, 0x4b, 0x3b, 0x86, 0x6d, 0x85, 0xbb,
-	0xd6, 0xa7, 0x43, 0x3b, 0xca, 0x7f, 0x91, 0xfb, 0x1f, 0xa4, 0x02, 0x59, 0x93, 0x99, 0xed, 0xf1,
-	0x75, 0xf9, 0xbc, 0x9a, 0x21, 0x8e, 0x4c, 0x09, 0xe3, 0xf2, 0x79, 0xff, 0x42, 0x20, 0x5b, 0xb6,
-	0xcb, 0xd7, 0x5f, 0x3a, 0xae, 0xaa, 0x40, 0x9c, 0x9e, 0xdb, 0x78, 0x24, 0x27, 0x6c, 0xe3, 0x71,
-	0x55, 0xcd, 0xb5, 0x89, 0xdc, 0xa6, 0x16, 0x77, 0x89, 0x29, 0x9f, 0x57, 0x73, 0xd4, 0x4b, 0x8c,
-	0x80, 0x7a, 0x01, 0x6e, 0x28, 0xf7, 0xa8, 0x50, 0x4f, 0xde, 0xa2, 0x02, 0xae, 0x27, 0x56, 0x63,
-	0x71, 0x56, 0x85, 0x2e, 0x8d, 0x8d, 0x19, 0x89, 0x78, 0x67, 0x3c, 0xd6, 0x27, 0x56, 0x85, 0x18,
-	0xa1, 0x45, 0x62, 0x55, 0x0d, 0x53, 0x82, 0x6f, 0x03, 0xf8, 0x47, 0x22, 0xc6, 0x44, 0x79, 0x07,
-	0x77, 0x86, 0xda, 0xa0, 0xe7, 0xdb, 0x72, 0x5d, 0x40, 0x5c, 0xa3, 0xdb, 0x6a, 0x86, 0x44, 0x22,
-	0x5e, 0x85, 0x2c, 0x61, 0xcc, 0xeb, 0x52, 0xcd, 0xb5, 0x4d, 0x1a, 0x5e, 0x25, 0x2e, 0x0e, 0x8e,
-	0x2d, 0x30, 0x54, 0xdb, 0xa4, 0x2a, 0x90, 0x9e, 0x8c, 0x7f, 0x89, 0x20, 0x4f, 0x2d, 0xdd, 0xb1,
-	0x0d, 0x8b, 0x6b, 0x44, 0xd7, 0x5d, 0xca, 0x58, 0xd8, 0x24, 0x1e, 0xed, 0x28, 0x9e, 0xcb, 0xa4,
-	0xe7, 0xa8, 0x6a, 0xdd, 0x2f, 0x95, 0x4a, 0xfe, 0x15, 0xa3, 0x2e, 0xdf, 0xf1, 0x6f, 0x19, 0x4f,
-	0x13, 0x72, 0x2c, 0xae, 0xca, 0xf7, 0x66, 0x12, 0x8a, 0xe9, 0xd5, 0xf2, 0xf4, 0x4c, 0xe9, 0x6e,
-	0x5d, 0xbe, 0x13, 0xde, 0x4d, 0x9e, 0x26, 0xe4, 0x58, 0x14, 0x5e, 0xb1, 0x62, 0xfa, 0xe9, 0xf4,
-	0x94, 0x3a, 0x11, 0x31, 0xaa, 0x07, 0x84, 0xb0, 0x06, 0x23, 0x3a, 0x5d, 0x23, 0x9e, 0xc9, 0x45,
-	0x5b, 0xca, 0x56, 0xe7, 0x06, 0x8e, 0x7f, 0x3e, 0xf0, 0x5f, 0xb2, 0x38, 0xed, 0x04, 0x17, 0xf5,
-	0xc5, 0x23, 0x6a, 0x84, 0x5a, 0xf8, 0x0d, 0x82, 0x4c, 0x2f, 0xfb, 0xf8, 0x9b, 0x30, 0x16, 0xaf,
-	0xa6, 0xdf, 0x7f, 0x83, 0x8a, 0x7f, 0x63, 0x47, 0xc9, 0xbb, 0xe3, 0xf9, 0xbc, 0x9f, 0x92, 0x91,
-	0xfb, 0x77, 0x57, 0xb7, 0xee, 0xcd, 0x4c, 0xa9, 0xd9, 0xde, 0x4a, 0x2d, 0xe9, 0x78, 0x16, 0x8e,
-	0x31, 0xda, 0x76, 0x29, 0xd7, 0xf6, 0x54, 0x43, 0xaf, 0xe5, 0x96, 0xd4, 0x89, 0xc0, 0x22, 0x9e,
-	0x4d, 0x86, 0x31, 0x46, 0x19, 0xf3, 0xcf, 0x41, 0xdc, 0xde, 0xa0, 0x56, 0xd8, 0xc8, 0x83, 0x53,
-	0x81, 0xf4, 0x7c, 0x48, 0xcd, 0x85, 0xea, 0xa6, 0xaf, 0x4d, 0xfc, 0x95, 0xfe, 0x03, 0x01, 0xc4,
-	0xcb, 0x8a, 0xaf, 0x41, 0x8a, 0xb8, 0xd1, 0x5f, 0xe7, 0x85, 0x1d, 0xe5, 0x43, 0xf7, 0x1b, 0xd5,
-	0xea, 0x7d, 0xe2, 0x5a, 0x35, 0xb2, 0xc5, 0x6a, 0x06, 0xe9, 0xd6, 0x6a, 0x77, 0xfd, 0x14, 0x3f,
-	0x39, 0x5f, 0x7d, 0x56, 0xf3, 0x6b, 0x69, 0xb5, 0x12, 0x27, 0x5e, 0x3b, 0xf3, 0xad, 0xb3, 0xe5,
-	0xcb, 0xf2, 0xbd, 0x33, 0x53, 0xaa, 0x8f, 0x82, 0x2f, 0x42, 0x96, 0x3e, 0xe2, 0xfe, 0x01, 0xc2,
-	0x8c, 0x0f, 0x20, 0x85, 0x1d, 0xe5, 0x4d, 0xf7, 0x6b, 0xd2, 0x1f, 0x33, 0xd5, 0xbc, 0x1f, 0xbe,
-	0x70, 0xa9, 0xad, 0x56, 0x64, 0x3f, 0x0f, 0x10, 0x99, 0x2f, 0xe9, 0x78, 0x1e, 0xf2, 0x51, 0x44,
-	0xd1, 0xab, 0x48, 0x58, 0xb7, 0x93, 0x7d, 0x57, 0xe0, 0xf9, 0xd0, 0xc0, 0xcf, 0x8b, 0x70, 0x89,
-	0x3e, 0x24, 0x02, 0x25, 0x80, 0xfb, 0x97, 0x0f, 0xd7, 0x01, 0xc4, 0xdb, 0x87, 0x26, 0x4e, 0x3f,
-	0x41, 0xd8, 0xc5, 0x1d, 0xe5, 0x6d, 0xf7, 0xa4, 0xbf, 0x40, 0xd2, 0xfd, 0x30, 0xb8, 0x3d, 0xb5,
-	0x36, 0xa5, 0x66, 0x84, 0xd7, 0x32, 0xe9, 0xd2, 0x78, 0x8a, 0x58, 0x52, 0x72, 0x00, 0x3a, 0x75,
-	0x4c, 0x7b, 0xbb, 0x4b, 0x2d, 0x5e, 0xa8, 0xc2, 0x48, 0x74, 0xa7, 0x3a, 0x09, 0xc3, 0xc1, 0x75,
-	0x13, 0xed, 0x3e, 0x43, 0x05, 0x5f, 0x5f, 0x8a, 0x35, 0x01, 0xa3, 0x4e, 0xb4, 0x81, 0xa6, 0xfe,
-	0xad, 0xa0, 0xe2, 0x0a, 0xe0, 0xbe, 0x4a, 0x65, 0xf8, 0x22, 0x8c, 0x04, 0xcf, 0x70, 0x4c, 0x42,
-	0xa2, 0xa3, 0xbc, 0xb3, 0x6f, 0x79, 0xab, 0x91, 0x47, 0xf1, 0xd7, 0x08, 0xa4, 0x3e, 0xf5, 0x15,
-	0x71, 0xff, 0x67, 0xf8, 0x26, 0x8c, 0x04, 0x4f, 0x01, 0x11, 0xf2, 0x07, 0xfb, 0x22, 0x87, 0xae,
-	0xe5, 0xf0, 0xff, 0xb0, 0x4b, 0x85, 0x28, 0x7e, 0x97, 0x4a, 0x2a, 0x06, 0xea, 0x52, 0xbf, 0x40,
-	0x70, 0xe2, 0x2a, 0xe5, 0xfd, 0xb1, 0xd0, 0x87, 0x1e, 0x65, 0x1c, 0x2f, 0x1e, 0xfe, 0x29, 0x27,
-	0x7e, 0x86, 0x12, 0x4f, 0x38, 0x17, 0x00, 0xe2, 0x17, 0xb5, 0x2f, 0x7d, 0xc2, 0xb9, 0xe2, 0x9b,
-	0xdc, 0x20, 0x6c, 0x43, 0xcd, 0xac, 0x45, 0x62, 0xf1, 0x73, 0x04, 0x27, 0xaf, 0x1b, 0xac, 0x9f,
-	0x25, 0x8b, 0x68, 0xfe, 0x1f, 0xdf, 0xcc, 0x0e, 0xcf, 0x3b, 0xf1, 0x4e, 0xf6, 0x33, 0x04, 0x27,
-	0x1a, 0xaf, 0x48, 0xf3, 0x1c, 0xa4, 0x83, 0xda, 0x09, 0x69, 0xef, 0x5f, 0x6c, 0x09, 0xc6, 0xa1,
-	0xeb, 0x6b, 0x30, 0xad, 0x7e, 0x9e, 0x86, 0xc9, 0x97, 0x90, 0xeb, 0x18, 0xcc, 0x2f, 0xa8, 0x07,
-	0x00, 0x57, 0x29, 0x8f, 0xea, 0xf7, 0xeb, 0x7d, 0x90, 0x0b, 0x5d, 0x87, 0x6f, 0x17, 0x4a, 0x07,
-	0x2d, 0xe3, 0x62, 0xe1, 0xd3, 0xbf, 0xfe, 0xfd, 0x47, 0x43, 0xc7, 0x31, 0xae, 0x10, 0x56, 0x09,
-	0xc8, 0xcb, 0x61, 0x31, 0xe3, 0x9f, 0x23, 0x48, 0x5d, 0xa5, 0x1c, 0x9f, 0xd9, 0x8b, 0xf6, 0x8a,
-	0x2a, 0x2d, 0xec, 0x9f, 0xae, 0xe2, 0xa2, 0x98, 0x53, 0xc1, 0x97, 0xe3, 0x39, 0x2b, 0x4f, 0x0c,
-	0x9d, 0x95, 0xf7, 0xd4, 0xcd, 0x9e, 0xf1, 0xb3, 0xc0, 0x28, 0x7e, 0x35, 0x7d, 0x86, 0x7f, 0x88,
-	0xe0, 0xa8, 0x5f, 0x8d, 0x58, 0xde, 0x3b, 0xeb, 0x2b, 0x6b, 0xb4, 0x50, 0xdc, 0x97, 0x24, 0x2b,
-	0xce, 0x0a, 0x96, 0x32, 0x3e, 0x93, 0x64, 0xb9, 0x0f, 0x43, 0xfc, 0x2f, 0x04, 0xa9, 0xc6, 0xcb,
-	0x52, 0xd6, 0x78, 0xbd, 0x94, 0xfd, 0x18, 0x09, 0x36, 0x9f, 0xa1, 0xc2, 0x72, 0x92, 0x4e, 0xf8,
-	0xeb, 0xc4, 0x81, 0x72, 0x97, 0xb0, 0x4d, 0xa4, 0xb0, 0x86, 0x66, 0xee, 0x5c, 0x2c, 0x7e, 0x78,
-	0x38, 0xd0, 0x1a, 0x9a, 0xc1, 0x9f, 0x21, 0x48, 0xcf, 0x53, 0x93, 0x72, 0x8a, 0x07, 0xda, 0x93,
-	0x0a, 0x5f, 0x52, 0xbb, 0xc5, 0xcb, 0x22, 0xd2, 0xda, 0xcc, 0x47, 0x03, 0xe4, 0x5d, 0x90, 0x8e,
-	0x42, 0x52, 0x3e, 0xf8, 0xfd, 0xdf, 0x4e, 0xa1, 0x3b, 0x95, 0x8e, 0x5d, 0xe6, 0xeb, 0x94, 0x8b,
-	0x6b, 0x4d, 0xd9, 0xa2, 0x7c, 0xcb, 0x76, 0x37, 0x2a, 0xbb, 0x7f, 0x16, 0xd8, 0x9c, 0xad, 0x38,
-	0x1b, 0x9d, 0x0a, 0xe7, 0x96, 0xd3, 0x6a, 0xa5, 0x05, 0x91, 0xd9, 0xff, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0x08, 0xc8, 0x2f, 0x15, 0x18, 0x1a, 0x00, 0x00,
+	// 2255 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x99, 0x4f, 0x6f, 0xdb, 0xc8,
+	0x15, 0xc0, 0x43, 0x2b, 0x96, 0xad, 0x27, 0xd9, 0x56, 0x66, 0xb3, 0x1b, 0x5a, 0xd9, 0x64, 0x5d,
+	0xad, 0xb1, 0x2b, 0x3b, 0xa1, 0x94, 0xc8, 0xdd, 0xed, 0x46, 0x41, 0x91, 0x48, 0xb6, 0x13, 0xbb,
+	0x49, 0x9c, 0x98, 0xd2, 0x76, 0x9b, 0xc4, 0x09, 0x31, 0x12, 0xc7, 0x32, 0x63, 0x8a, 0x64, 0x38,
+	0x43, 0x3b, 0xce, 0x1f, 0x20, 0xd8, 0x4b, 0x8b, 0x1e, 0x8a, 0x05, 0x7a, 0x28, 0x8a, 0xa2, 0x28,
+	0xd0, 0x02, 0x2d, 0x90, 0x63, 0xd1, 0x5b, 0x81, 0x6e, 0x8f, 0x45, 0x81, 0xa2, 0x87, 0x7e, 0x84,
+	0x16, 0x3d, 0xf4, 0x52, 0x60, 0x4f, 0x85, 0x0b, 0x14, 0x05, 0x87, 0x43, 0x91, 0x96, 0xb2, 0xb1,
+	0xe5, 0x60, 0x0f, 0x7b, 0x49, 0x1e, 0xf9, 0xde, 0xfb, 0xf1, 0xcd, 0x9b, 0xa7, 0x79, 0x33, 0x63,
+	0x38, 0x67, 0xda, 0x2e, 0xde, 0xc6, 0x96, 0x42, 0x19, 0x6e, 0x6d, 0x96, 0xb0, 0x63, 0x94, 0xb0,
+	0xe3, 0x98, 0x46, 0x0b, 0x33, 0xc3, 0xb6, 0x28, 0x71, 0xb7, 0x88, 0xab, 0x39, 0x5e, 0x93, 0x7a,
+	0xcd, 0xa2, 0xe3, 0xda, 0xcc, 0x46, 0xe3, 0x8c, 0x59, 0x45, 0xe1, 0x55, 0xdc, 0x9a, 0xcb, 0x2d,
+	0xb4, 0x0d, 0xb6, 0xe1, 0x35, 0x8b, 0x2d, 0xbb, 0x53, 0x6a, 0x6c, 0x90, 0xc6, 0x86, 0x61, 0xb5,
+	0xe9, 0xb2, 0xa5, 0x7b, 0x94, 0xb9, 0x06, 0xa1, 0x25, 0xee, 0xd5, 0x52, 0xda, 0xc4, 0x52, 0xda,
+	0xb6, 0xb2, 0x6e, 0xe2, 0x36, 0x2d, 0x61, 0xcb, 0xb2, 0x59, 0xf0, 0x85, 0x80, 0x9a, 0xab, 0xc6,
+	0x28, 0xc4, 0xda, 0xb2, 0x77, 0x1c, 0xd7, 0x7e, 0xb4, 0x13, 0x77, 0xde, 0xc2, 0xa6, 0xa1, 0x63,
+	0x46, 0x4a, 0x7d, 0x82, 0x40, 0x28, 0x31, 0x44, 0xdb, 0x6e, 0xdb, 0x81, 0x73, 0xd3, 0x5b, 0xe7,
+	0x4f, 0xfc, 0x81, 0x4b, 0xc2, 0x7c, 0x7e, 0xa0, 0xb8, 0x1f, 0x50, 0xdb, 0x7a, 0x49, 0xd8, 0x6f,
+	0xb7, 0x6d, 0xbb, 0x6d, 0x92, 0x20, 0x6f, 0x7d, 0xda, 0xd3, 0x42, 0xdb, 0x0d, 0x44, 0xf7, 0x5c,
+	0x6e, 0x20, 0xf4, 0x27, 0x7b, 0xf5, 0xa4, 0xe3, 0xb0, 0x1d, 0xa1, 0x9c, 0xea, 0x55, 0xae, 0x1b,
+	0xc4, 0xd4, 0xb5, 0x0e, 0xa6, 0x9b, 0xc2, 0xe2, 0x9d, 0x5e, 0x0b, 0x66, 0x74, 0x08, 0x65, 0xb8,
+	0xe3, 0x08, 0x83, 0x77, 0xfb, 0x27, 0xd7, 0xd0, 0x89, 0xc5, 0x8c, 0x75, 0x83, 0xb8, 0x22, 0xc8,
+	0xfc, 0x9f, 0x25, 0x78, 0xbb, 0x1a, 0x4d, 0xf9, 0x2d, 0xaf, 0x59, 0xf7, 0x9a, 0xcb, 0x91, 0x19,
+	0xba, 0x0d, 0x13, 0xb1, 0x92, 0xd0, 0x0c, 0x9d, 0xca, 0xd2, 0x94, 0x54, 0x48, 0x97, 0xdf, 0x2b,
+	0xee, 0x2d, 0x85, 0x62, 0x0c, 0x13, 0x03, 0xd4, 0x46, 0x77, 0x6b, 0xc3, 0x3f, 0x94, 0x86, 0xb2,
+	0x92, 0x3a, 0x8e, 0xe3, 0x16, 0x14, 0x2d, 0x02, 0x38, 0x5e, 0x53, 0xa3, 0x5e, 0x53, 0x33, 0x74,
+	0x79, 0x68, 0x4a, 0x2a, 0xa4, 0x6a, 0xef, 0xef, 0xd6, 0xa6, 0xdd, 0xbc, 0x3c, 0x5d, 0x3e, 0x7d,
+	0xff, 0x2e, 0x56, 0x1e, 0x9f, 0x53, 0x2e, 0xdc, 0x2b, 0x5c, 0xaa, 0xdc, 0x55, 0xee, 0x5d, 0x0a,
+	0x1f, 0x67, 0x9e, 0x94, 0xcf, 0x3e, 0x9b, 0x56, 0x47, 0x1d, 0x11, 0x6a, 0x65, 0xf4, 0x8b, 0x17,
+	0x93, 0x47, 0x47, 0x8f, 0x64, 0xa5, 0xfc, 0xef, 0xf3, 0x70, 0xac, 0x6f, 0x30, 0xe8, 0x16, 0x24,
+	0xa2, 0xa8, 0xcf, 0xbe, 0x22, 0xea, 0xbe, 0xc1, 0xd7, 0xb2, 0x61, 0xec, 0xc1, 0x27, 0x0a, 0x92,
+	0xea, 0xa3, 0xd0, 0x3c, 0x40, 0xcb, 0x25, 0x98, 0x11, 0x5d, 0xc3, 0x8c, 0x07, 0x9e, 0x2e, 0xe7,
+	0x8a, 0xc1, 0x7c, 0x14, 0xc3, 0xf9, 0x28, 0x36, 0xc2, 0xf9, 0xa8, 0x85, 0x11, 0x1e, 0x51, 0x53,
+	0xc2, 0xaf, 0xca, 0x7c, 0x88, 0xe7, 0xe8, 0x21, 0x24, 0x31, 0x08, 0x44, 0xf8, 0x55, 0x19, 0xba,
+	0x04, 0xc9, 0x75, 0xdb, 0xed, 0x60, 0x26, 0x1f, 0x8d, 0xa7, 0xef, 0xf8, 0xbe, 0xe9, 0x13, 0x6e,
+	0x68, 0x01, 0x8e, 0x5a, 0x98, 0x51, 0xf9, 0x18, 0xff, 0x7e, 0x71, 0xdf, 0xec, 0x14, 0x57, 0xaa,
+	0x8d, 0xfa, 0x2d, 0xd7, 0xde, 0x32, 0x74, 0xe2, 0x2e, 0x1d, 0x51, 0xb9, 0xb7, 0x4f, 0xe9, 0x3c,
+	0x64, 0x4c, 0x9e, 0x3c, 0x28, 0xe5, 0xc6, 0x6a, 0xa3, 0x11, 0xa7, 0xf8, 0xde, 0xe8, 0x1a, 0x8c,
+	0xe0, 0x6d, 0xaa, 0x19, 0x36, 0x93, 0x09, 0x07, 0x9d, 0xdb, 0x1f, 0x54, 0xfd, 0xa4, 0xbe, 0x6c,
+	0xc7, 0x51, 0x49, 0xbc, 0x4d, 0x97, 0x6d, 0x86, 0xde, 0x03, 0x68, 0x62, 0x4a, 0x34, 0x66, 0x3b,
+	0x46, 0x4b, 0x4e, 0xf2, 0xec, 0x8c, 0xec, 0xd6, 0x8e, 0xba, 0x43, 0xb2, 0xae, 0xa6, 0x7c, 0x55,
+	0xc3, 0xd7, 0xa0, 0x15, 0x18, 0xd3, 0xed, 0x6d, 0xcb, 0x34, 0xac, 0x4d, 0xcd, 0xf1, 0xe8, 0x86,
+	0x3c, 0xc2, 0x3f, 0x3d, 0x73, 0x80, 0x31, 0x10, 0x4a, 0x71, 0x9b, 0xa8, 0x99, 0xd0, 0xff, 0x96,
+	0x47, 0x37, 0x50, 0x03, 0xb2, 0x5d, 0x9e, 0x4b, 0x1c, 0x13, 0xb7, 0x88, 0x3c, 0x3a, 0x28, 0x72,
+	0x22, 0x44, 0xa8, 0x01, 0x01, 0xdd, 0x82, 0x71, 0xcf, 0xe1, 0xcc, 0x4e, 0x60, 0x22, 0xa7, 0x06,
+	0x65, 0x8e, 0x05, 0x00, 0xf1, 0x88, 0xbe, 0x0b, 0xc7, 0x04, 0xd1, 0xf2, 0x2b, 0xc1, 0x34, 0x1e,
+	0x13, 0x5d, 0x3e, 0x3e, 0x28, 0x34, 0x1b, 0x30, 0x56, 0xba, 0x08, 0xf4, 0x1d, 0x48, 0x3f, 0xb0,
+	0x0d, 0x4b, 0xc3, 0xad, 0x16, 0x71, 0x98, 0x0c, 0x83, 0x12, 0xc1, 0xf7, 0xae, 0x72, 0x67, 0x74,
+	0x1d, 0xba, 0xb9, 0xd5, 0x70, 0x6b, 0x53, 0x4e, 0x0f, 0x0a, 0x4b, 0x87, 0xee, 0xd5, 0xd6, 0xe6,
+	0x9e, 0x99, 0xb6, 0x7c, 0x5c, 0xe6, 0xd0, 0x33, 0xbd, 0x82, 0x7b, 0x78, 0x94, 0x58, 0x4c, 0x1e,
+	0x3b, 0x34, 0xaf, 0x4e, 0x2c, 0x86, 0x54, 0xe8, 0x4e, 0xbb, 0xb6, 0x8e, 0x0d, 0x93, 0xe8, 0xf2,
+	0xf8, 0xa0, 0xc4, 0xf1, 0x90, 0x70, 0x85, 0x03, 0xf6, 0x30, 0x1f, 0x7a, 0xc4, 0x23, 0xba, 0x3c,
+	0x71, 0x68, 0xe6, 0x2a, 0x07, 0xa0, 0x36, 0xe4, 0xf6, 0x32, 0x35, 0xc3, 0x0a, 0x9b, 0xb1, 0x2e,
+	0xbf, 0x31, 0x28, 0x5e, 0xde, 0x83, 0x5f, 0x8e, 0x50, 0x7e, 0xf0, 0xa6, 0x2d, 0xfa, 0x0e, 0xb5,
+	0xcd, 0x2d, 0xa2, 0xcb, 0xd9, 0x81, 0x83, 0x0f, 0x09, 0x75, 0x0e, 0xf0, 0x4b, 0xca, 0xdf, 0xd6,
+	0x18, 0x2d, 0xa2, 0xe9, 0x98, 0x61, 0x19, 0x0d, 0x5c, 0x52, 0xc2, 0x7d, 0x01, 0x33, 0xec, 0xff,
+	0x88, 0x28, 0xa1, 0xd4, 0x0f, 0xd0, 0x25, 0x2d, 0x7b, 0x8b, 0xb8, 0x44, 0x97, 0xdf, 0x1c, 0xf8,
+	0x47, 0x24, 0x18, 0x6a, 0x88, 0x40, 0x53, 0x90, 0x5c, 0x37, 0x4c, 0x46, 0x5c, 0xf9, 0x2d, 0xbe,
+	0x70, 0xf9, 0x3d, 0xd4, 0x4d, 0xc8, 0xcf, 0x47, 0x55, 0xf1, 0x3e, 0x57, 0x85, 0x4c, 0x7c, 0x25,
+	0x46, 0xef, 0x03, 0x88, 0xed, 0x9a, 0xe7, 0x9a, 0xbc, 0xd7, 0x85, 0x5e, 0x3f, 0x90, 0x24, 0x35,
+	0x15, 0xe8, 0x3e, 0x76, 0x4d, 0xd1, 0x2d, 0xa5, 0xac, 0x94, 0xfb, 0x5d, 0x0a, 0x32, 0xf1, 0x75,
+	0xf8, 0xc0, 0x0c, 0x34, 0x0d, 0xa9, 0x96, 0x69, 0x10, 0x8b, 0x45, 0x7d, 0x5b, 0x2c, 0xad, 0x27,
+	0xd4, 0xd1, 0x40, 0xb3, 0xac, 0xa3, 0x77, 0x61, 0xd4, 0xa3, 0xc4, 0xb5, 0x70, 0x87, 0xf0, 0xf6,
+	0x16, 0x5b, 0x7f, 0xbb, 0x0a, 0xdf, 0xc8, 0xc1, 0x94, 0x6e, 0xdb, 0xae, 0x2e, 0x5a, 0x58, 0x64,
+	0x14, 0x2a, 0xd0, 0x27, 0x30, 0x46, 0xbd, 0x26, 0x6d, 0xb9, 0x46, 0x93, 0x68, 0x0f, 0x6d, 0x2a,
+	0x0f, 0x4f, 0x49, 0x85, 0xf1, 0x72, 0x79, 0xb0, 0x3e, 0x53, 0x5c, 0xb5, 0xeb, 0x6a, 0xa6, 0x0b,
+	0x5a, 0xb5, 0x29, 0xaa, 0x43, 0xda, 0xf1, 0x9a, 0xa6, 0x41, 0x37, 0x38, 0x36, 0x79, 0x68, 0x2c,
+	0x08, 0x8c, 0x0f, 0x3d, 0x01, 0x23, 0x9e, 0xdf, 0x78, 0x4c, 0xca, 0x7b, 0xc9, 0xa8, 0x9a, 0xf4,
+	0x28, 0x69, 0x98, 0x14, 0xfd, 0x41, 0x82, 0x24, 0x33, 0xa9, 0xd6, 0xc2, 0xbc, 0x23, 0x64, 0x6a,
+	0xbf, 0x96, 0x76, 0x6b, 0xc3, 0x8f, 0x13, 0xf2, 0xf3, 0xcb, 0x5f, 0xbc, 0x98, 0xfc, 0xa9, 0x94,
+	0x5b, 0x39, 0xc4, 0x66, 0x9a, 0xff, 0xeb, 0x98, 0x5e, 0xdb, 0xb0, 0x8a, 0x2b, 0x64, 0x7b, 0x89,
+	0x3c, 0xaa, 0xed, 0x30, 0x42, 0xaf, 0x98, 0xb8, 0x9d, 0xbf, 0xfa, 0x9a, 0xbc, 0xab, 0x84, 0x71,
+	0x98, 0x3a, 0xcc, 0x4c, 0x3a, 0x8f, 0xd1, 0x5f, 0x25, 0x98, 0xe0, 0x03, 0x08, 0x26, 0xbf, 0x45,
+	0x5c, 0xc6, 0xfb, 0xd0, 0xd7, 0x68, 0x24, 0x63, 0xfe, 0x48, 0x78, 0xf8, 0xf3, 0xc4, 0x65, 0xe8,
+	0x2f, 0x12, 0x8c, 0xc7, 0x46, 0xb4, 0x49, 0x76, 0x78, 0xc7, 0xfa, 0x1a, 0x0d, 0x28, 0xd3, 0x1d,
+	0xd0, 0x35, 0xb2, 0x83, 0x3e, 0x86, 0x91, 0x0d, 0x82, 0x75, 0xe2, 0x52, 0x39, 0x3d, 0x95, 0x28,
+	0xa4, 0xcb, 0x17, 0x07, 0x2c, 0xe6, 0xa5, 0xc0, 0x7b, 0xd1, 0x62, 0xee, 0x8e, 0x1a, 0xb2, 0x72,
+	0x15, 0xc8, 0xc4, 0x15, 0x28, 0x0b, 0x09, 0x3f, 0x55, 0x7c, 0x89, 0x50, 0x7d, 0x11, 0x1d, 0x87,
+	0xe1, 0x2d, 0x6c, 0x7a, 0x24, 0x58, 0x0e, 0xd4, 0xe0, 0xa1, 0x32, 0xf4, 0x91, 0x94, 0x5f, 0x80,
+	0xc4, 0xaa, 0x5d, 0x47, 0x59, 0xc8, 0x54, 0x1b, 0xda, 0x8d, 0x9b, 0xf5, 0x86, 0x76, 0x73, 0x65,
+	0x7e, 0x31, 0x7b, 0x04, 0x1d, 0x83, 0xb1, 0x6a, 0x43, 0xbb, 0xbe, 0x58, 0x0d, 0x5f, 0x49, 0xbe,
+	0xd1, 0xe2, 0xf7, 0xaa, 0xf3, 0x8d, 0xeb, 0xb7, 0x83, 0x37, 0x43, 0xb9, 0xe4, 0xbf, 0x5e, 0x4c,
+	0x0e, 0xc9, 0x52, 0x6c, 0xd9, 0xfa, 0x3e, 0xc0, 0xf8, 0xde, 0x5d, 0x1f, 0xfa, 0xd9, 0x10, 0x24,
+	0x5d, 0xd2, 0x36, 0x6c, 0x4b, 0xac, 0x5a, 0x9f, 0x0e, 0xed, 0xd6, 0xfe, 0x27, 0xb9, 0xff, 0x95,
+	0x54, 0xc0, 0xeb, 0x0a, 0xb5, 0x3d, 0xb6, 0xa1, 0x9c, 0x57, 0x53, 0xd8, 0x51, 0x08, 0xa6, 0x4c,
+	0x39, 0xef, 0x1f, 0x45, 0x14, 0xcb, 0x76, 0xd9, 0xc6, 0x4b, 0x9f, 0xcb, 0x2a, 0x60, 0xa7, 0xeb,
+	0x36, 0x1e, 0xca, 0x31, 0xdb, 0xe8, 0xb9, 0xac, 0x66, 0x5a, 0x58, 0x69, 0x11, 0x8b, 0xb9, 0xd8,
+	0x54, 0xce, 0xab, 0x19, 0xe2, 0xc5, 0x9e, 0x80, 0x78, 0x01, 0x57, 0xc8, 0xdd, 0x50, 0x88, 0xa7,
+	0x6c, 0x13, 0x8e, 0xeb, 0x8a, 0xe5, 0x48, 0x9c, 0x53, 0xa1, 0x43, 0x22, 0x63, 0x8a, 0xc3, 0xb8,
+	0x53, 0x1e, 0xed, 0x13, 0xcb, 0x5c, 0x0c, 0x69, 0xa1, 0x58, 0x56, 0x45, 0x4a, 0xd0, 0x6d, 0x00,
+	0x7f, 0x33, 0x46, 0x29, 0x2f, 0xef, 0xe0, 0xb4, 0x52, 0x19, 0x74, 0x67, 0x5d, 0xac, 0x72, 0xc4,
+	0x35, 0xb2, 0xa3, 0xa6, 0x70, 0x28, 0xa2, 0x35, 0x48, 0x63, 0x4a, 0xbd, 0x0e, 0xd1, 0x5c, 0xdb,
+	0x24, 0xe2, 0x10, 0x73, 0x71, 0x70, 0x36, 0x67, 0xa8, 0xb6, 0x49, 0x54, 0xc0, 0x5d, 0x19, 0xfd,
+	0x4a, 0x82, 0x2c, 0xb1, 0x74, 0xc7, 0x36, 0x2c, 0xa6, 0x61, 0x5d, 0x77, 0x09, 0xa5, 0xa2, 0x49,
+	0x3c, 0xda, 0xad, 0x79, 0x2e, 0x95, 0x9f, 0x4b, 0x65, 0xeb, 0x7e, 0xa1, 0x50, 0xf0, 0x0f, 0x37,
+	0x55, 0xe5, 0x8e, 0x7f, 0xbe, 0x79, 0x1a, 0x93, 0x23, 0x71, 0x4d, 0xb9, 0x37, 0x1b, 0x53, 0xcc,
+	0xac, 0x15, 0x67, 0x66, 0x0b, 0x77, 0xab, 0xca, 0x1d, 0x71, 0x2a, 0x7a, 0x1a, 0x93, 0x23, 0x91,
+	0x7b, 0x45, 0x8a, 0x99, 0xa7, 0x33, 0xd3, 0xea, 0x44, 0x18, 0x51, 0x35, 0x08, 0x08, 0x69, 0x30,
+	0xa2, 0x93, 0x75, 0xec, 0x99, 0x8c, 0xb7, 0xa5, 0x74, 0x79, 0x7e, 0xe0, 0xf1, 0x2f, 0x04, 0xfe,
+	0xcb, 0x16, 0x23, 0xed, 0xe0, 0x8a, 0x60, 0xe9, 0x88, 0x1a, 0x52, 0x73, 0xbf, 0x95, 0x20, 0xd5,
+	0xcd, 0x3e, 0xfa, 0x16, 0x8c, 0x45, 0xb3, 0xe9, 0xf7, 0xdf, 0xa0, 0xe2, 0xdf, 0xd8, 0xad, 0x65,
+	0xdd, 0xf1, 0x6c, 0xd6, 0x4f, 0xc9, 0xc8, 0xfd, 0xbb, 0x6b, 0xdb, 0xf7, 0x66, 0xa7, 0xd5, 0x74,
+	0x77, 0xa6, 0x96, 0x75, 0x34, 0xe7, 0xef, 0x55, 0x5a, 0x2e, 0x61, 0x5a, 0x4f, 0x35, 0x74, 0x5b,
+	0x6e, 0x41, 0x9d, 0x08, 0x2c, 0xa2, 0xaf, 0x29, 0x30, 0x16, 0x6e, 0x70, 0x98, 0xbd, 0x49, 0x2c,
+	0xd1, 0xc8, 0xc3, 0xfd, 0xc8, 0x90, 0x9a, 0x11, 0xea, 0x86, 0xaf, 0x8d, 0xfd, 0x4a, 0xff, 0x29,
+	0x01, 0x44, 0xd3, 0x8a, 0xae, 0x41, 0x02, 0xbb, 0xe1, 0xaf, 0xf3, 0xc2, 0x6e, 0xed, 0x43, 0xf7,
+	0x9b, 0xe5, 0xf2, 0x7d, 0xec, 0x5a, 0x15, 0xbc, 0x4d, 0x2b, 0x06, 0xee, 0x54, 0x2a, 0x77, 0xfd,
+	0x14, 0x3f, 0x39, 0x5f, 0x7e, 0x56, 0xf1, 0x6b, 0x69, 0xad, 0x14, 0x25, 0x5e, 0x3b, 0xf3, 0xed,
+	0xb3, 0xc5, 0xcb, 0xca, 0xbd, 0x33, 0xd3, 0xaa, 0x4f, 0x41, 0x17, 0x21, 0x4d, 0x1e, 0x31, 0x7f,
+	0x03, 0x61, 0x46, 0x1b, 0x90, 0xdc, 0x6e, 0xed, 0x84, 0xfb, 0xa6, 0xfc, 0xa7, 0x54, 0x39, 0xeb,
+	0x0f, 0x9f, 0xbb, 0x54, 0xd6, 0x4a, 0x8a, 0x9f, 0x07, 0x08, 0xcd, 0x97, 0x75, 0xb4, 0x00, 0xe1,
+	0x76, 0x4b, 0x0b, 0xef, 0x63, 0x44, 0xdd, 0x4e, 0xf6, 0x1d, 0xbe, 0x17, 0x84, 0x81, 0x9f, 0x17,
+	0xee, 0x12, 0xbe, 0x88, 0x0d, 0x14, 0x03, 0xea, 0x9f, 0x3e, 0x54, 0x05, 0xe0, 0xb7, 0x2e, 0x1a,
+	0xdf, 0xfd, 0x04, 0xc3, 0xce, 0xef, 0xd6, 0xde, 0x71, 0x4f, 0xf9, 0x13, 0x24, 0xdf, 0x17, 0x83,
+	0xeb, 0xa9, 0xb5, 0x69, 0x35, 0xc5, 0xbd, 0x56, 0x70, 0x87, 0x44, 0x9f, 0x88, 0xa4, 0x5a, 0x06,
+	0x40, 0x27, 0x8e, 0x69, 0xef, 0x74, 0x88, 0xc5, 0x72, 0x65, 0x18, 0x09, 0x4f, 0x73, 0xa7, 0x60,
+	0x38, 0x38, 0xe8, 0x4a, 0x7b, 0xf7, 0x50, 0xc1, 0xdb, 0x97, 0xb2, 0x26, 0x60, 0xd4, 0x09, 0x17,
+	0xd0, 0xc4, 0x7f, 0x6a, 0x52, 0x7e, 0x15, 0x50, 0x5f, 0xa5, 0x52, 0x74, 0x11, 0x46, 0x82, 0x0b,
+	0x40, 0x2a, 0x4b, 0xbc, 0xa3, 0x7c, 0x63, 0xdf, 0xf2, 0x56, 0x43, 0x8f, 0xfc, 0x6f, 0x24, 0x90,
+	0xfb, 0xd4, 0x57, 0xf8, 0xcd, 0x03, 0x45, 0x37, 0x61, 0x24, 0xb8, 0x84, 0x08, 0xc9, 0x1f, 0xec,
+	0x4b, 0x16, 0xae, 0x45, 0xf1, 0xbf, 0xe8, 0x52, 0x82, 0xe2, 0x77, 0xa9, 0xb8, 0x62, 0xa0, 0x2e,
+	0xf5, 0x4b, 0x09, 0x4e, 0x5e, 0x25, 0xac, 0x7f, 0x2c, 0xe4, 0xa1, 0x47, 0x28, 0x43, 0x4b, 0x87,
+	0xbf, 0x44, 0x8a, 0x2e, 0xc0, 0xf8, 0xe5, 0xd1, 0x05, 0x80, 0xe8, 0x2e, 0xef, 0x4b, 0x2f, 0x8f,
+	0xae, 0xf8, 0x26, 0x37, 0x30, 0xdd, 0x54, 0x53, 0xeb, 0xa1, 0x98, 0xff, 0x5c, 0x82, 0x53, 0xd7,
+	0x0d, 0xda, 0x1f, 0x25, 0x0d, 0xc3, 0xfc, 0x0a, 0x6f, 0xeb, 0x0e, 0x1f, 0x77, 0xec, 0x86, 0xee,
+	0xe7, 0x12, 0x9c, 0xac, 0xbf, 0x22, 0xcd, 0xf3, 0x90, 0x0c, 0x6a, 0x47, 0x84, 0xbd, 0x7f, 0xb1,
+	0xc5, 0x22, 0x16, 0xae, 0xaf, 0x11, 0x69, 0xf9, 0xf3, 0x24, 0x4c, 0xbe, 0x24, 0xb8, 0xb6, 0x41,
+	0xfd, 0x82, 0x7a, 0x00, 0x70, 0x95, 0xb0, 0xb0, 0x7e, 0xdf, 0xea, 0x43, 0x2e, 0x76, 0x1c, 0xb6,
+	0x93, 0x2b, 0x1c, 0xb4, 0x8c, 0xf3, 0xb9, 0x4f, 0xff, 0xf6, 0x8f, 0x1f, 0x0f, 0x1d, 0x47, 0xa8,
+	0x84, 0x69, 0x29, 0x08, 0x5e, 0x11, 0xc5, 0x8c, 0x7e, 0x21, 0x41, 0xe2, 0x2a, 0x61, 0xe8, 0x4c,
+	0x2f, 0xed, 0x15, 0x55, 0x9a, 0xdb, 0x3f, 0x5d, 0xf9, 0x25, 0xfe, 0xcd, 0x1a, 0xba, 0x1c, 0x7d,
+	0xb3, 0xf4, 0xc4, 0xd0, 0x69, 0xb1, 0xa7, 0x6e, 0x7a, 0x9e, 0x9f, 0x05, 0x46, 0xd1, 0x7d, 0xed,
+	0x33, 0xf4, 0x23, 0x09, 0x8e, 0xfa, 0xd5, 0x88, 0x94, 0xde, 0xaf, 0xbe, 0xb2, 0x46, 0x73, 0xf9,
+	0x7d, 0x83, 0xa4, 0xf9, 0x39, 0x1e, 0xa5, 0x82, 0xce, 0xc4, 0xa3, 0xdc, 0x27, 0x42, 0xf4, 0x6f,
+	0x09, 0x12, 0xf5, 0x97, 0xa5, 0xac, 0xfe, 0x7a, 0x29, 0xfb, 0x89, 0xc4, 0xa3, 0xf9, 0x4c, 0xca,
+	0xad, 0xc4, 0xc3, 0x11, 0x7f, 0x17, 0x39, 0x50, 0xee, 0x62, 0xb6, 0xb1, 0x14, 0x56, 0xa4, 0xd9,
+	0x3b, 0x17, 0xf3, 0x1f, 0x1e, 0x0e, 0x5a, 0x91, 0x66, 0xd1, 0x67, 0x12, 0x24, 0x17, 0x88, 0x49,
+	0x18, 0x41, 0x03, 0xad, 0x49, 0xb9, 0x2f, 0xa9, 0xdd, 0xfc, 0x65, 0x3e, 0xd2, 0xca, 0xec, 0x47,
+	0x03, 0xe4, 0x9d, 0x07, 0x1d, 0x0e, 0xa9, 0xf6, 0xc1, 0x1f, 0xff, 0x7e, 0x5a, 0xba, 0x53, 0x6a,
+	0xdb, 0x45, 0xb6, 0x41, 0x18, 0x3f, 0xd6, 0x14, 0x2d, 0xc2, 0xb6, 0x6d, 0x77, 0xb3, 0xb4, 0xf7,
+	0x0f, 0x12, 0x5b, 0x73, 0x25, 0x67, 0xb3, 0x5d, 0x62, 0xcc, 0x72, 0x9a, 0xcd, 0x24, 0x0f, 0x64,
+	0xee, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x13, 0x46, 0xc9, 0x92, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
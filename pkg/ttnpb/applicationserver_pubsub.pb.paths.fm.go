@@ -31,6 +31,7 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"downlink_replace.topic",
 	"downlink_sent",
 	"downlink_sent.topic",
+	"filter",
 	"format",
 	"ids",
 	"ids.application_ids",
@@ -71,6 +72,8 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"provider.nats.server_url",
 	"service_data",
 	"service_data.topic",
+	"session_recovered",
+	"session_recovered.topic",
 	"updated_at",
 	"uplink_message",
 	"uplink_message.topic",
@@ -89,12 +92,14 @@ var ApplicationPubSubFieldPathsTopLevel = []string{
 	"downlink_queued",
 	"downlink_replace",
 	"downlink_sent",
+	"filter",
 	"format",
 	"ids",
 	"join_accept",
 	"location_solved",
 	"provider",
 	"service_data",
+	"session_recovered",
 	"updated_at",
 	"uplink_message",
 	"uplink_normalized",
@@ -156,6 +161,7 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.downlink_replace.topic",
 	"pubsub.downlink_sent",
 	"pubsub.downlink_sent.topic",
+	"pubsub.filter",
 	"pubsub.format",
 	"pubsub.ids",
 	"pubsub.ids.application_ids",
@@ -196,6 +202,8 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.provider.nats.server_url",
 	"pubsub.service_data",
 	"pubsub.service_data.topic",
+	"pubsub.session_recovered",
+	"pubsub.session_recovered.topic",
 	"pubsub.updated_at",
 	"pubsub.uplink_message",
 	"pubsub.uplink_message.topic",
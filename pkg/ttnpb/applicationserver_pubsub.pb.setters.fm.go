@@ -441,6 +441,41 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 					dst.ServiceData = nil
 				}
 			}
+		case "session_recovered":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationPubSub_Message
+				if (src == nil || src.SessionRecovered == nil) && dst.SessionRecovered == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SessionRecovered
+				}
+				if dst.SessionRecovered != nil {
+					newDst = dst.SessionRecovered
+				} else {
+					newDst = &ApplicationPubSub_Message{}
+					dst.SessionRecovered = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SessionRecovered = src.SessionRecovered
+				} else {
+					dst.SessionRecovered = nil
+				}
+			}
+		case "filter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Filter = src.Filter
+			} else {
+				var zero string
+				dst.Filter = zero
+			}
 
 		case "provider":
 			if len(subs) == 0 && src == nil {
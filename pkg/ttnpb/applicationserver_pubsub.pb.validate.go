@@ -393,6 +393,27 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "session_recovered":
+
+			if v, ok := interface{}(m.GetSessionRecovered()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubValidationError{
+						field:  "session_recovered",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "filter":
+
+			if utf8.RuneCountInString(m.GetFilter()) > 1024 {
+				return ApplicationPubSubValidationError{
+					field:  "filter",
+					reason: "value length must be at most 1024 runes",
+				}
+			}
+
 		case "provider":
 			if m.Provider == nil {
 				return ApplicationPubSubValidationError{
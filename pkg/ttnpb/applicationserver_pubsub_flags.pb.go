@@ -566,6 +566,9 @@ func AddSelectFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix string, hid
 	AddSelectFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("location-solved", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("service-data", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("service-data", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("service-data", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("session-recovered", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("session-recovered", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("session-recovered", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("filter", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("filter", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationPubSub message from select flags.
@@ -740,6 +743,21 @@ func PathsFromSelectFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix strin
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("session_recovered", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("session_recovered", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("session_recovered", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("filter", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("filter", prefix))
+	}
 	return paths, nil
 }
 
@@ -764,6 +782,8 @@ func AddSetFlagsForApplicationPubSub(flags *pflag.FlagSet, prefix string, hidden
 	AddSetFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("downlink-queue-invalidated", prefix), hidden)
 	AddSetFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("location-solved", prefix), hidden)
 	AddSetFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("service-data", prefix), hidden)
+	AddSetFlagsForApplicationPubSub_Message(flags, flagsplugin.Prefix("session-recovered", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("filter", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the ApplicationPubSub message from flags.
@@ -956,6 +976,20 @@ func (m *ApplicationPubSub) SetFromFlags(flags *pflag.FlagSet, prefix string) (p
 			paths = append(paths, setPaths...)
 		}
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("session_recovered", prefix)); changed {
+		m.SessionRecovered = &ApplicationPubSub_Message{}
+		if setPaths, err := m.SessionRecovered.SetFromFlags(flags, flagsplugin.Prefix("session_recovered", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("filter", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Filter = val
+		paths = append(paths, flagsplugin.Prefix("filter", prefix))
+	}
 	return paths, nil
 }
 
@@ -325,6 +325,17 @@ func (x *ApplicationPubSub) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: ApplicationPubSub_Message does not seem to implement MarshalProtoJSON.
 		gogo.MarshalMessage(s, x.ServiceData)
 	}
+	if x.SessionRecovered != nil || s.HasField("session_recovered") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("session_recovered")
+		// NOTE: ApplicationPubSub_Message does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.SessionRecovered)
+	}
+	if x.Filter != "" || s.HasField("filter") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("filter")
+		s.WriteString(x.Filter)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -543,6 +554,19 @@ func (x *ApplicationPubSub) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			var v ApplicationPubSub_Message
 			gogo.UnmarshalMessage(s, &v)
 			x.ServiceData = &v
+		case "session_recovered", "sessionRecovered":
+			s.AddField("session_recovered")
+			if s.ReadNil() {
+				x.SessionRecovered = nil
+				return
+			}
+			// NOTE: ApplicationPubSub_Message does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationPubSub_Message
+			gogo.UnmarshalMessage(s, &v)
+			x.SessionRecovered = &v
+		case "filter":
+			s.AddField("filter")
+			x.Filter = s.ReadString()
 		}
 	})
 }
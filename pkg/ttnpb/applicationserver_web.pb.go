@@ -78,7 +78,13 @@ func (m *ApplicationWebhookIdentifiers) GetWebhookId() string {
 }
 
 type ApplicationWebhookTemplateIdentifiers struct {
-	TemplateId           string   `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// The organization that owns the template.
+	// Templates of the public catalog are not owned by an organization.
+	OrganizationIds *OrganizationIdentifiers `protobuf:"bytes,2,opt,name=organization_ids,json=organizationIds,proto3" json:"organization_ids,omitempty"`
+	// The version of a template owned by an organization.
+	// Zero refers to the latest version of the template.
+	Version              uint32   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -115,6 +121,20 @@ func (m *ApplicationWebhookTemplateIdentifiers) GetTemplateId() string {
 	return ""
 }
 
+func (m *ApplicationWebhookTemplateIdentifiers) GetOrganizationIds() *OrganizationIdentifiers {
+	if m != nil {
+		return m.OrganizationIds
+	}
+	return nil
+}
+
+func (m *ApplicationWebhookTemplateIdentifiers) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // ApplicationWebhookTemplateField represents a custom field that needs to be filled by the user in order to use the template.
 // A field can be an API key, an username or password, or any custom platform specific field (such as region).
 // The fields are meant to be replaced inside the URLs and headers when the webhook is created.
@@ -223,10 +243,15 @@ type ApplicationWebhookTemplate struct {
 	DownlinkQueueInvalidated *ApplicationWebhookTemplate_Message `protobuf:"bytes,21,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationWebhookTemplate_Message `protobuf:"bytes,18,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationWebhookTemplate_Message `protobuf:"bytes,20,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
+	SessionRecovered         *ApplicationWebhookTemplate_Message `protobuf:"bytes,24,opt,name=session_recovered,json=sessionRecovered,proto3" json:"session_recovered,omitempty"`
 	FieldMask                *types.FieldMask                    `protobuf:"bytes,22,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                            `json:"-"`
-	XXX_unrecognized         []byte                              `json:"-"`
-	XXX_sizecache            int32                               `json:"-"`
+	// Deprecated templates are not listed by default, but they can still be retrieved.
+	Deprecated bool `protobuf:"varint,25,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// The time at which this version of the template has been published.
+	CreatedAt            *types.Timestamp `protobuf:"bytes,26,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *ApplicationWebhookTemplate) Reset()         { *m = ApplicationWebhookTemplate{} }
@@ -407,6 +432,13 @@ func (m *ApplicationWebhookTemplate) GetServiceData() *ApplicationWebhookTemplat
 	return nil
 }
 
+func (m *ApplicationWebhookTemplate) GetSessionRecovered() *ApplicationWebhookTemplate_Message {
+	if m != nil {
+		return m.SessionRecovered
+	}
+	return nil
+}
+
 func (m *ApplicationWebhookTemplate) GetFieldMask() *types.FieldMask {
 	if m != nil {
 		return m.FieldMask
@@ -414,6 +446,20 @@ func (m *ApplicationWebhookTemplate) GetFieldMask() *types.FieldMask {
 	return nil
 }
 
+func (m *ApplicationWebhookTemplate) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *ApplicationWebhookTemplate) GetCreatedAt() *types.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
 type ApplicationWebhookTemplate_Message struct {
 	// Path to append to the base URL. Can contain template fields, in RFC 6570 format.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -689,11 +735,16 @@ type ApplicationWebhook struct {
 	DownlinkQueueInvalidated *ApplicationWebhook_Message `protobuf:"bytes,19,opt,name=downlink_queue_invalidated,json=downlinkQueueInvalidated,proto3" json:"downlink_queue_invalidated,omitempty"`
 	LocationSolved           *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
 	ServiceData              *ApplicationWebhook_Message `protobuf:"bytes,18,opt,name=service_data,json=serviceData,proto3" json:"service_data,omitempty"`
+	SessionRecovered         *ApplicationWebhook_Message `protobuf:"bytes,23,opt,name=session_recovered,json=sessionRecovered,proto3" json:"session_recovered,omitempty"`
 	HealthStatus             *ApplicationWebhookHealth   `protobuf:"bytes,20,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
 	FieldMask                *types.FieldMask            `protobuf:"bytes,21,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{}                    `json:"-"`
-	XXX_unrecognized         []byte                      `json:"-"`
-	XXX_sizecache            int32                       `json:"-"`
+	// Filter expression which the upstream messages must match in order to be sent.
+	// Upstream message fields are referenced as `up.<path>` and end device attributes as `attributes.<key>`,
+	// for example `up.uplink_message.f_port == 2 && attributes.building == "a"`.
+	Filter               string   `protobuf:"bytes,24,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()         { *m = ApplicationWebhook{} }
@@ -860,6 +911,13 @@ func (m *ApplicationWebhook) GetServiceData() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetSessionRecovered() *ApplicationWebhook_Message {
+	if m != nil {
+		return m.SessionRecovered
+	}
+	return nil
+}
+
 func (m *ApplicationWebhook) GetHealthStatus() *ApplicationWebhookHealth {
 	if m != nil {
 		return m.HealthStatus
@@ -874,6 +932,13 @@ func (m *ApplicationWebhook) GetFieldMask() *types.FieldMask {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -1175,10 +1240,14 @@ func (m *GetApplicationWebhookTemplateRequest) GetFieldMask() *types.FieldMask {
 }
 
 type ListApplicationWebhookTemplatesRequest struct {
-	FieldMask            *types.FieldMask `protobuf:"bytes,1,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	FieldMask *types.FieldMask `protobuf:"bytes,1,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// List the templates of the organization in addition to the public templates.
+	OrganizationIds *OrganizationIdentifiers `protobuf:"bytes,2,opt,name=organization_ids,json=organizationIds,proto3" json:"organization_ids,omitempty"`
+	// Include the deprecated templates.
+	IncludeDeprecated    bool     `protobuf:"varint,3,opt,name=include_deprecated,json=includeDeprecated,proto3" json:"include_deprecated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ListApplicationWebhookTemplatesRequest) Reset() {
@@ -1214,6 +1283,62 @@ func (m *ListApplicationWebhookTemplatesRequest) GetFieldMask() *types.FieldMask
 	return nil
 }
 
+func (m *ListApplicationWebhookTemplatesRequest) GetOrganizationIds() *OrganizationIdentifiers {
+	if m != nil {
+		return m.OrganizationIds
+	}
+	return nil
+}
+
+func (m *ListApplicationWebhookTemplatesRequest) GetIncludeDeprecated() bool {
+	if m != nil {
+		return m.IncludeDeprecated
+	}
+	return false
+}
+
+type PublishApplicationWebhookTemplateRequest struct {
+	// The template to publish as a new version.
+	// The template identifiers must contain the organization identifiers.
+	Template             *ApplicationWebhookTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *PublishApplicationWebhookTemplateRequest) Reset() {
+	*m = PublishApplicationWebhookTemplateRequest{}
+}
+func (m *PublishApplicationWebhookTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishApplicationWebhookTemplateRequest) ProtoMessage()    {}
+func (*PublishApplicationWebhookTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{14}
+}
+func (m *PublishApplicationWebhookTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishApplicationWebhookTemplateRequest.Unmarshal(m, b)
+}
+func (m *PublishApplicationWebhookTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishApplicationWebhookTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishApplicationWebhookTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishApplicationWebhookTemplateRequest.Merge(m, src)
+}
+func (m *PublishApplicationWebhookTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishApplicationWebhookTemplateRequest.Size(m)
+}
+func (m *PublishApplicationWebhookTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishApplicationWebhookTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishApplicationWebhookTemplateRequest proto.InternalMessageInfo
+
+func (m *PublishApplicationWebhookTemplateRequest) GetTemplate() *ApplicationWebhookTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
 	golang_proto.RegisterType((*ApplicationWebhookIdentifiers)(nil), "ttn.lorawan.v3.ApplicationWebhookIdentifiers")
@@ -1259,6 +1384,8 @@ func init() {
 	golang_proto.RegisterType((*GetApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookTemplateRequest")
 	proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
 	golang_proto.RegisterType((*ListApplicationWebhookTemplatesRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhookTemplatesRequest")
+	proto.RegisterType((*PublishApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.PublishApplicationWebhookTemplateRequest")
+	golang_proto.RegisterType((*PublishApplicationWebhookTemplateRequest)(nil), "ttn.lorawan.v3.PublishApplicationWebhookTemplateRequest")
 }
 
 func init() {
@@ -1269,132 +1396,147 @@ func init() {
 }
 
 var fileDescriptor_2652f2d8eaceda0e = []byte{
-	// 1990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x99, 0x4d, 0x73, 0xdb, 0xc6,
-	0x19, 0xc7, 0x05, 0xea, 0x8d, 0x7c, 0xa8, 0x17, 0x7a, 0xf5, 0x62, 0x84, 0x92, 0x1d, 0x0d, 0xec,
-	0xda, 0xb2, 0x6a, 0x92, 0x19, 0xb9, 0x6a, 0x6a, 0x4d, 0x27, 0x0e, 0x19, 0x59, 0x92, 0x9b, 0x2a,
-	0xa9, 0x41, 0xbb, 0xae, 0xe3, 0x49, 0xd9, 0x15, 0xb1, 0x22, 0x51, 0x82, 0x00, 0x82, 0x5d, 0x4a,
-	0x55, 0x3c, 0x9e, 0xc9, 0x74, 0x7a, 0x68, 0x7b, 0xc9, 0x4c, 0x73, 0xe8, 0x4c, 0x0f, 0x9d, 0x66,
-	0xda, 0x4b, 0x73, 0xec, 0x74, 0x7a, 0xee, 0x07, 0xe8, 0xad, 0x1f, 0xa1, 0xfd, 0x04, 0x3d, 0x75,
-	0x7c, 0xea, 0x60, 0xb1, 0x20, 0xf1, 0x42, 0x59, 0x00, 0x55, 0xe7, 0x44, 0x2c, 0xf6, 0xd9, 0xdf,
-	0xf3, 0xec, 0xb3, 0x8b, 0x7d, 0xfe, 0x00, 0xa1, 0x64, 0x58, 0x0e, 0x3e, 0xc1, 0x66, 0x89, 0x32,
-	0xdc, 0xec, 0x54, 0xb0, 0xad, 0x57, 0xb0, 0x6d, 0x1b, 0x7a, 0x13, 0x33, 0xdd, 0x32, 0x29, 0x71,
-	0x8e, 0x89, 0xd3, 0x38, 0x21, 0x87, 0x65, 0xdb, 0xb1, 0x98, 0x85, 0xe6, 0x18, 0x33, 0xcb, 0x62,
-	0x48, 0xf9, 0xf8, 0x4e, 0x71, 0xa7, 0xa5, 0xb3, 0x76, 0xef, 0xb0, 0xdc, 0xb4, 0xba, 0x95, 0x47,
-	0x6d, 0xf2, 0xa8, 0xad, 0x9b, 0x2d, 0xfa, 0xc0, 0xd4, 0x7a, 0x94, 0x39, 0x3a, 0xa1, 0x15, 0x3e,
-	0xaa, 0x59, 0x6a, 0x11, 0xb3, 0xd4, 0xb2, 0x4a, 0x47, 0x06, 0x6e, 0xd1, 0x0a, 0x36, 0x4d, 0x8b,
-	0x79, 0x78, 0x8f, 0x5a, 0xac, 0x06, 0x28, 0xc4, 0x3c, 0xb6, 0x4e, 0x6d, 0xc7, 0xfa, 0xd9, 0x69,
-	0x70, 0xf0, 0x31, 0x36, 0x74, 0x0d, 0x33, 0x52, 0x89, 0x5d, 0x08, 0x44, 0x29, 0x80, 0x68, 0x59,
-	0x2d, 0xcb, 0x1b, 0x7c, 0xd8, 0x3b, 0xe2, 0x2d, 0xde, 0xe0, 0x57, 0xc2, 0x7c, 0xb5, 0x65, 0x59,
-	0x2d, 0x83, 0x78, 0xf3, 0x8d, 0xc5, 0xb3, 0x22, 0x7a, 0xfb, 0x0c, 0xd2, 0xb5, 0xd9, 0xa9, 0xe8,
-	0x5c, 0x8b, 0x76, 0x1e, 0xe9, 0xc4, 0xd0, 0x1a, 0x5d, 0x4c, 0x3b, 0xc2, 0xe2, 0xcd, 0xa8, 0x05,
-	0xd3, 0xbb, 0x84, 0x32, 0xdc, 0xb5, 0x85, 0xc1, 0x95, 0x78, 0xd2, 0x89, 0xe3, 0x58, 0x8e, 0xe8,
-	0xbe, 0x16, 0xef, 0xd6, 0x35, 0x62, 0x32, 0xfd, 0x48, 0x27, 0x8e, 0x88, 0x51, 0xf9, 0x87, 0x04,
-	0x57, 0xaa, 0x83, 0x95, 0x7a, 0x42, 0x0e, 0xdb, 0x96, 0xd5, 0x79, 0x30, 0xb0, 0x43, 0x4f, 0x61,
-	0x3e, 0xb0, 0x94, 0x0d, 0x5d, 0xa3, 0xb2, 0xb4, 0x26, 0xad, 0xe7, 0x37, 0x6f, 0x94, 0xc3, 0xab,
-	0x58, 0x0e, 0x70, 0x02, 0x80, 0x5a, 0xf6, 0x65, 0x6d, 0xf2, 0xd7, 0x52, 0xa6, 0x20, 0xa9, 0x73,
-	0x38, 0x68, 0x41, 0xd1, 0x2e, 0xc0, 0x89, 0xe7, 0xb0, 0xa1, 0x6b, 0x72, 0x66, 0x4d, 0x5a, 0xcf,
-	0xd5, 0x6e, 0xbe, 0xac, 0x5d, 0x77, 0x14, 0xf9, 0xfa, 0xe6, 0xd5, 0x1f, 0x3f, 0xc3, 0xa5, 0x4f,
-	0xdf, 0x2a, 0xdd, 0xfd, 0x78, 0xfd, 0xde, 0xf6, 0xb3, 0xd2, 0xc7, 0xf7, 0xfc, 0xe6, 0xad, 0xe7,
-	0x9b, 0xb7, 0x5f, 0x5c, 0x57, 0x73, 0x27, 0x7e, 0xac, 0xdb, 0xd9, 0xff, 0x7c, 0xf5, 0xc6, 0x44,
-	0x56, 0x2a, 0x48, 0xca, 0x73, 0xf8, 0x46, 0x7c, 0x36, 0x8f, 0x48, 0xd7, 0x36, 0x30, 0x23, 0xc1,
-	0x59, 0xed, 0x43, 0x9e, 0x89, 0xdb, 0xae, 0x6f, 0x29, 0x9d, 0x6f, 0x60, 0x7d, 0x64, 0xc0, 0xf9,
-	0x2f, 0x32, 0xf0, 0xe6, 0xd9, 0xde, 0x77, 0xdd, 0xf5, 0x45, 0x6f, 0x43, 0x26, 0xbd, 0xbb, 0x8c,
-	0xae, 0xa1, 0x15, 0x98, 0x30, 0x71, 0x97, 0x88, 0x2c, 0x4d, 0xbf, 0xac, 0x4d, 0x38, 0x19, 0x79,
-	0x51, 0xe5, 0x37, 0xd1, 0x2d, 0xc8, 0x6b, 0x84, 0x36, 0x1d, 0xdd, 0x76, 0x1d, 0xcb, 0xe3, 0x41,
-	0x1b, 0x4d, 0x0d, 0xf6, 0xa1, 0x65, 0x98, 0xa2, 0xa4, 0xe9, 0x10, 0x26, 0x4f, 0xac, 0x49, 0xeb,
-	0x59, 0x55, 0xb4, 0xd0, 0x6d, 0x98, 0xd5, 0xc8, 0x11, 0xee, 0x19, 0xac, 0x71, 0x8c, 0x8d, 0x1e,
-	0x91, 0x27, 0xc3, 0x90, 0x19, 0xd1, 0xfb, 0x43, 0xb7, 0x13, 0x15, 0x21, 0x6b, 0x71, 0x1e, 0x36,
-	0xe4, 0x29, 0xce, 0xe9, 0xb7, 0x95, 0x2f, 0xe7, 0xa0, 0x78, 0x76, 0x1a, 0xd0, 0x43, 0x18, 0x1f,
-	0xec, 0xa1, 0xad, 0x57, 0xec, 0xa1, 0xb3, 0x57, 0x2f, 0xb0, 0xa5, 0x5c, 0xd6, 0xff, 0x2d, 0x37,
-	0xd7, 0x20, 0x6b, 0x58, 0x2d, 0xab, 0xd1, 0x73, 0x0c, 0x9e, 0x9d, 0x1c, 0x77, 0xe4, 0x8c, 0xff,
-	0x52, 0x92, 0xd4, 0x69, 0xb7, 0xe7, 0xb1, 0x63, 0xb8, 0x46, 0xba, 0x79, 0xe4, 0x19, 0x4d, 0x46,
-	0x8d, 0xdc, 0x1e, 0xd7, 0x68, 0x0b, 0x2e, 0x69, 0x56, 0xb3, 0xd7, 0x25, 0xa6, 0x77, 0x24, 0x70,
-	0xeb, 0xa9, 0x88, 0x75, 0x21, 0x64, 0x22, 0xd8, 0x87, 0x98, 0x12, 0x6e, 0x3d, 0x1d, 0x65, 0xbb,
-	0x3d, 0xae, 0x51, 0x1b, 0xa6, 0xdb, 0x04, 0x6b, 0xc4, 0xa1, 0x72, 0x76, 0x6d, 0x7c, 0x3d, 0xbf,
-	0xf9, 0x76, 0xf2, 0x24, 0x96, 0xf7, 0xbd, 0x91, 0xf7, 0x4d, 0xe6, 0x9c, 0xd6, 0x96, 0x5e, 0xd6,
-	0xd0, 0xef, 0xa4, 0xf9, 0xc2, 0xa6, 0xe2, 0x26, 0xe3, 0xdd, 0x8d, 0x49, 0x67, 0x5c, 0xfe, 0x2c,
-	0xa3, 0xfa, 0x78, 0x74, 0x0f, 0xa6, 0x8e, 0x2c, 0xa7, 0x8b, 0x99, 0x9c, 0x0b, 0x6e, 0xd8, 0xc5,
-	0x73, 0x37, 0xac, 0x18, 0x86, 0xf6, 0x60, 0x8a, 0x1f, 0x6b, 0x54, 0x06, 0x1e, 0x69, 0x25, 0x79,
-	0xa4, 0xfc, 0x71, 0x51, 0xc5, 0x70, 0xb4, 0x05, 0x97, 0x9b, 0x0e, 0x71, 0x1f, 0x56, 0xcd, 0x3a,
-	0x31, 0x0d, 0xdd, 0xec, 0x34, 0xb0, 0xad, 0x37, 0x3a, 0xe4, 0x54, 0x5e, 0xe0, 0xdb, 0x6f, 0xd1,
-	0xeb, 0xde, 0x11, 0xbd, 0x55, 0x5b, 0x7f, 0x9f, 0x9c, 0xa2, 0xa7, 0x30, 0xd7, 0xb3, 0xb9, 0x75,
-	0x97, 0x50, 0x8a, 0x5b, 0x44, 0xce, 0xf3, 0x6d, 0xb7, 0x99, 0x22, 0x63, 0x07, 0xde, 0x48, 0x75,
-	0xd6, 0x23, 0x89, 0x26, 0x6a, 0xc0, 0x25, 0x81, 0x36, 0xdd, 0xb9, 0x1a, 0xfa, 0xa7, 0x44, 0x93,
-	0x2f, 0x8f, 0x4c, 0x2f, 0x78, 0xb0, 0x0f, 0xfa, 0x2c, 0x54, 0x87, 0xfc, 0x4f, 0x2d, 0xdd, 0x6c,
-	0xe0, 0x66, 0x93, 0xd8, 0x4c, 0x9e, 0x19, 0x19, 0x0d, 0x2e, 0xa6, 0xca, 0x29, 0xe8, 0x31, 0xcc,
-	0x0c, 0x12, 0xd8, 0xec, 0xc8, 0xb3, 0x23, 0x53, 0xf3, 0x3e, 0xa7, 0xda, 0xec, 0xa0, 0x27, 0x30,
-	0xdb, 0xc7, 0x9a, 0x2e, 0x77, 0x6e, 0x64, 0x6e, 0x3f, 0xbe, 0x0f, 0x70, 0x04, 0x4c, 0x89, 0xc9,
-	0xe4, 0xf9, 0x8b, 0x83, 0xeb, 0xc4, 0x64, 0xe8, 0x19, 0xcc, 0xf7, 0xc1, 0x47, 0x58, 0x37, 0x88,
-	0x26, 0x17, 0x46, 0x46, 0xcf, 0xf9, 0xa8, 0x5d, 0x4e, 0x0a, 0xc1, 0x3f, 0xe9, 0x91, 0x1e, 0xd1,
-	0xe4, 0x4b, 0x17, 0x87, 0x3f, 0xe4, 0x24, 0x64, 0x43, 0x31, 0x0c, 0x6f, 0xe8, 0xa6, 0xaf, 0x62,
-	0x34, 0x79, 0x69, 0x64, 0x3f, 0x72, 0xc8, 0xcf, 0x83, 0x01, 0xd3, 0x9d, 0x8e, 0x61, 0x89, 0xf2,
-	0x4f, 0x2d, 0xe3, 0x98, 0x68, 0x32, 0x1a, 0x7d, 0x3a, 0x3e, 0xaa, 0xce, 0x49, 0xee, 0x8e, 0x74,
-	0xe5, 0xa1, 0xde, 0x24, 0x0d, 0x0d, 0x33, 0x2c, 0x2f, 0x8e, 0xbe, 0x23, 0x05, 0x67, 0x07, 0x33,
-	0x8c, 0xee, 0x02, 0x0c, 0x04, 0x95, 0xbc, 0xcc, 0xa1, 0xc5, 0xb2, 0xa7, 0xa8, 0xca, 0xbe, 0xa2,
-	0x2a, 0xf3, 0x43, 0xe6, 0x00, 0xd3, 0x8e, 0x9a, 0x3b, 0xf2, 0x2f, 0x8b, 0xdb, 0x30, 0x13, 0x3c,
-	0x25, 0x51, 0x01, 0xc6, 0xdd, 0x73, 0x86, 0xd7, 0x6c, 0xd5, 0xbd, 0x44, 0x8b, 0x30, 0xe9, 0xd5,
-	0x48, 0x5e, 0x70, 0x54, 0xaf, 0xb1, 0x9d, 0xf9, 0x8e, 0x54, 0xbc, 0x01, 0xd3, 0xfe, 0x01, 0xb1,
-	0x02, 0x13, 0x36, 0x66, 0x6d, 0x51, 0xeb, 0x45, 0xc1, 0x79, 0x57, 0xe5, 0x37, 0x95, 0x16, 0xac,
-	0x9c, 0x3d, 0x23, 0x57, 0x9d, 0xe4, 0x7c, 0x85, 0xe1, 0x56, 0x4a, 0xf7, 0xe8, 0xdc, 0x48, 0x9e,
-	0x11, 0x75, 0x30, 0x58, 0xf9, 0x6a, 0x02, 0xe4, 0xb8, 0xe5, 0x3e, 0xc1, 0x06, 0x6b, 0xa3, 0x06,
-	0xaf, 0x24, 0x06, 0x6b, 0x9f, 0x8a, 0x72, 0xfc, 0xde, 0xf9, 0x4e, 0xbc, 0xa1, 0xe5, 0x50, 0xab,
-	0xce, 0x30, 0xeb, 0x51, 0xef, 0xfa, 0x74, 0x7f, 0x4c, 0xf5, 0xa9, 0x88, 0x40, 0xae, 0x67, 0xfa,
-	0x2e, 0x32, 0xdc, 0xc5, 0xfd, 0x8b, 0xb8, 0x78, 0xec, 0xc3, 0xf6, 0xc7, 0xd4, 0x01, 0xb9, 0x78,
-	0x03, 0x8a, 0x67, 0xc7, 0xd3, 0x17, 0x68, 0x63, 0xc5, 0x5f, 0x65, 0x60, 0xf5, 0x55, 0x54, 0x74,
-	0x13, 0xe6, 0xbd, 0xc3, 0xa0, 0x81, 0x99, 0x9b, 0x43, 0xe6, 0xe9, 0x94, 0x09, 0x75, 0xce, 0xbb,
-	0x5d, 0x15, 0x77, 0xd1, 0x53, 0x58, 0x36, 0x30, 0x65, 0x8d, 0xb0, 0x75, 0x03, 0x33, 0x31, 0xcb,
-	0xf8, 0x56, 0x7b, 0xe4, 0x8b, 0x77, 0x5e, 0xd2, 0xff, 0x22, 0x65, 0xb2, 0x92, 0xba, 0xe0, 0x32,
-	0x76, 0x83, 0xe4, 0xaa, 0x7b, 0x32, 0xad, 0x0c, 0x43, 0x6b, 0x84, 0x61, 0xdd, 0xa0, 0x5c, 0xbf,
-	0xe4, 0x37, 0x57, 0xa3, 0x59, 0xbc, 0xef, 0x0a, 0xff, 0x1d, 0xcf, 0x46, 0x95, 0x63, 0x5c, 0xd1,
-	0x33, 0xc8, 0xc5, 0xe0, 0xaa, 0x96, 0x85, 0x29, 0xca, 0xf3, 0xa0, 0x7c, 0x3e, 0x0f, 0x28, 0xbe,
-	0x1c, 0x61, 0xc5, 0x56, 0x3a, 0x7f, 0xfd, 0x82, 0x4a, 0xad, 0xe0, 0x2b, 0x35, 0xee, 0x6e, 0x6c,
-	0x5d, 0x28, 0xb6, 0xf7, 0x00, 0xbc, 0x82, 0xad, 0x25, 0xcc, 0x99, 0x37, 0xbc, 0x30, 0xa6, 0xe6,
-	0xc4, 0xb8, 0x2a, 0x73, 0x21, 0x3d, 0x5b, 0xf3, 0x21, 0xe3, 0x69, 0x20, 0x62, 0x5c, 0x95, 0x85,
-	0x24, 0xd7, 0xc4, 0x59, 0x92, 0xeb, 0x27, 0x03, 0xc9, 0x35, 0x99, 0x54, 0xc8, 0x24, 0x90, 0x5a,
-	0x6b, 0xc3, 0xa4, 0xd6, 0xd4, 0x68, 0x52, 0xeb, 0x47, 0x30, 0x13, 0x78, 0xa1, 0xa1, 0xa2, 0x50,
-	0x8e, 0xa6, 0xaf, 0xd5, 0xfc, 0xe0, 0xfd, 0x86, 0xa2, 0x06, 0xcc, 0xf7, 0xc9, 0x42, 0xcd, 0x15,
-	0x78, 0x12, 0xbe, 0x9d, 0x20, 0x09, 0x21, 0x39, 0xe7, 0xe5, 0x42, 0x9d, 0x63, 0xa1, 0x9b, 0x68,
-	0x13, 0x0a, 0x31, 0x55, 0x77, 0x29, 0xb0, 0x14, 0xf2, 0x67, 0xd2, 0xa0, 0x0a, 0x0a, 0x65, 0xf7,
-	0x30, 0xa6, 0xec, 0xa6, 0xf9, 0x84, 0x13, 0x1c, 0x93, 0x67, 0x29, 0xba, 0x27, 0xc3, 0x14, 0xdd,
-	0x72, 0x6a, 0x6a, 0x5c, 0xc9, 0xbd, 0x1f, 0x56, 0x72, 0xd9, 0xd4, 0xc8, 0xa0, 0x82, 0x3b, 0x88,
-	0x28, 0xb8, 0x5c, 0x6a, 0x5a, 0x48, 0xb9, 0x7d, 0x18, 0x55, 0x6e, 0x90, 0x9a, 0x17, 0x56, 0x6c,
-	0x1f, 0x46, 0x15, 0x5b, 0x7e, 0x74, 0x20, 0x57, 0x6a, 0xf5, 0xb8, 0x52, 0x9b, 0x49, 0x8d, 0x8c,
-	0x2a, 0xb4, 0x7a, 0x5c, 0xa1, 0xcd, 0x8e, 0x0e, 0x15, 0xca, 0xac, 0xfd, 0x4a, 0x65, 0xb6, 0x90,
-	0x9a, 0x7f, 0xb6, 0x22, 0xab, 0xc7, 0x15, 0xd9, 0x5c, 0xfa, 0xf0, 0x23, 0x4a, 0xec, 0x20, 0xa2,
-	0xc4, 0x50, 0xfa, 0x9d, 0x15, 0x54, 0x60, 0x07, 0x30, 0xeb, 0x95, 0xd5, 0x86, 0x57, 0x5d, 0x84,
-	0xb2, 0x5b, 0x4f, 0x5a, 0xff, 0xd5, 0x99, 0x76, 0xa0, 0x46, 0x47, 0x04, 0xdd, 0xd2, 0xd7, 0x25,
-	0xe8, 0xaa, 0xb0, 0x30, 0xe4, 0x08, 0x4b, 0x85, 0x78, 0x2b, 0x99, 0x26, 0x1c, 0x7c, 0x48, 0x0a,
-	0x7c, 0x52, 0x7a, 0x0c, 0x0b, 0xf1, 0xfc, 0x50, 0xf4, 0x0e, 0x64, 0xc5, 0xd7, 0x2f, 0x5f, 0x1e,
-	0x2a, 0xe7, 0xa7, 0x55, 0xed, 0x8f, 0x51, 0xfe, 0x2c, 0xc1, 0x1b, 0x71, 0x83, 0x5d, 0x5e, 0x49,
-	0x28, 0xfa, 0x01, 0x4c, 0x7b, 0x45, 0xc5, 0x87, 0x27, 0x38, 0xe8, 0xc5, 0xd8, 0xb2, 0xf8, 0xf5,
-	0x0e, 0x7a, 0x1f, 0xe3, 0xae, 0x40, 0xb0, 0x23, 0x4d, 0xfa, 0x94, 0x3f, 0x49, 0xb0, 0xba, 0x47,
-	0xd8, 0x90, 0xf9, 0x90, 0x4f, 0x7a, 0x84, 0x32, 0xf4, 0xe0, 0x02, 0xf2, 0x24, 0xf2, 0x21, 0x29,
-	0xbc, 0xc9, 0x32, 0x29, 0x36, 0x99, 0xf2, 0x37, 0x09, 0xae, 0x7e, 0x5f, 0xa7, 0x43, 0xe2, 0xa4,
-	0x7e, 0xa0, 0xaf, 0xf1, 0x4b, 0xea, 0x05, 0x02, 0xff, 0x52, 0x82, 0xd5, 0xfa, 0xab, 0xf2, 0xbb,
-	0x0b, 0xd3, 0x62, 0xe3, 0x88, 0x70, 0x13, 0xec, 0xb5, 0x40, 0xa8, 0xfe, 0xe0, 0x8b, 0xc4, 0xf8,
-	0x57, 0x09, 0xae, 0x0f, 0xdd, 0x03, 0xfd, 0x57, 0x1e, 0x11, 0xeb, 0x6b, 0xf8, 0xb8, 0x78, 0x81,
-	0xb0, 0x9b, 0x70, 0x63, 0xf8, 0x96, 0xe8, 0xbf, 0xe9, 0xf9, 0x71, 0x87, 0x9d, 0x48, 0x29, 0x9c,
-	0x6c, 0x7e, 0x9e, 0x1b, 0xf6, 0xb9, 0x55, 0x25, 0x2d, 0x9d, 0xba, 0x8f, 0x9a, 0x01, 0xb0, 0x47,
-	0x98, 0xff, 0x68, 0x2f, 0xc7, 0x98, 0xf7, 0xbb, 0x36, 0x3b, 0x2d, 0xde, 0x4a, 0xfc, 0x84, 0x2b,
-	0x2b, 0x3f, 0xff, 0xe7, 0xbf, 0xbf, 0xc8, 0x2c, 0xa1, 0x85, 0x0a, 0xa6, 0x15, 0xb1, 0xb6, 0x25,
-	0xf1, 0xa0, 0xa3, 0x3f, 0x48, 0x90, 0xdf, 0x23, 0xac, 0xff, 0xb1, 0xf7, 0x5b, 0x51, 0x6e, 0x92,
-	0x55, 0x2c, 0xa6, 0x78, 0xd7, 0x55, 0x2a, 0x3c, 0x9c, 0x5b, 0xe8, 0x66, 0x30, 0x9c, 0xfe, 0xfb,
-	0x6f, 0xe5, 0xb9, 0xae, 0xd1, 0x72, 0x40, 0x18, 0xbf, 0x40, 0x5f, 0x48, 0x30, 0xeb, 0xae, 0xca,
-	0xe0, 0x6d, 0x3b, 0x76, 0xbc, 0x25, 0x5b, 0xb4, 0xe2, 0x37, 0x93, 0x87, 0x49, 0x95, 0x2b, 0x3c,
-	0xce, 0xcb, 0x68, 0x69, 0x68, 0x9c, 0xe8, 0x8f, 0x12, 0x8c, 0xef, 0x11, 0x86, 0x6e, 0x27, 0x4a,
-	0x98, 0x1f, 0x41, 0x82, 0x27, 0x51, 0xf9, 0x1e, 0x77, 0xbc, 0x83, 0x6a, 0x01, 0xc7, 0x22, 0x2f,
-	0x91, 0xd3, 0x28, 0xd2, 0x7e, 0xe1, 0x19, 0x0d, 0xfe, 0xa1, 0x79, 0x81, 0x7e, 0x23, 0xc1, 0x84,
-	0x9b, 0x1c, 0x54, 0x4e, 0x96, 0xb2, 0x7e, 0xaa, 0xae, 0x9d, 0x1f, 0x28, 0x55, 0xb6, 0x78, 0xa4,
-	0x15, 0x54, 0x0a, 0x47, 0x7a, 0x4e, 0x94, 0xe8, 0xbf, 0x12, 0x8c, 0xd7, 0x87, 0xa5, 0xae, 0x7e,
-	0xd1, 0xd4, 0xfd, 0x5e, 0xe2, 0x11, 0xfd, 0x56, 0x2a, 0xaa, 0xe1, 0x90, 0xc4, 0x55, 0x39, 0x51,
-	0x12, 0x83, 0xc6, 0x81, 0x64, 0x6e, 0x4b, 0x1b, 0x1f, 0xbd, 0xa3, 0xdc, 0x1d, 0x19, 0xbc, 0x2d,
-	0x6d, 0xb8, 0x7b, 0x79, 0x6a, 0x87, 0x18, 0x84, 0x11, 0x94, 0xae, 0xf0, 0x15, 0xcf, 0x38, 0x08,
-	0x94, 0x1a, 0x9f, 0xf1, 0x77, 0x37, 0xb6, 0x53, 0xad, 0x41, 0x3f, 0x70, 0xb7, 0x51, 0xdb, 0xfa,
-	0xfb, 0xbf, 0xae, 0x4a, 0x1f, 0x55, 0x5a, 0x56, 0x99, 0xb5, 0x09, 0xe3, 0xff, 0xe5, 0x96, 0x4d,
-	0xc2, 0x4e, 0x2c, 0xa7, 0x53, 0x09, 0xff, 0x27, 0x79, 0x7c, 0xa7, 0x62, 0x77, 0x5a, 0x15, 0xc6,
-	0x4c, 0xfb, 0xf0, 0x70, 0x8a, 0x87, 0x72, 0xe7, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x13,
-	0xd7, 0xe3, 0x4c, 0x1e, 0x00, 0x00,
+	// 2240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x4d, 0x70, 0x1b, 0x49,
+	0x15, 0xce, 0xc8, 0x7f, 0xf2, 0x93, 0x7f, 0xe4, 0x76, 0x7e, 0x26, 0x72, 0x92, 0x75, 0x4d, 0x42,
+	0xe2, 0x84, 0x48, 0xda, 0x72, 0x08, 0xbb, 0x71, 0x51, 0x9b, 0xb5, 0xd6, 0x71, 0x12, 0x96, 0x6c,
+	0x92, 0x51, 0x42, 0x36, 0x9b, 0xda, 0x68, 0xdb, 0x9a, 0xb6, 0x34, 0x78, 0x34, 0x33, 0x3b, 0xdd,
+	0xb2, 0x71, 0x42, 0xaa, 0xb6, 0x28, 0x0e, 0xb0, 0x47, 0x72, 0xa0, 0x8a, 0x03, 0x05, 0x05, 0x17,
+	0xf6, 0x48, 0x51, 0x9c, 0x39, 0x53, 0xdc, 0x38, 0x70, 0x82, 0x0b, 0x14, 0x55, 0x5c, 0x39, 0x51,
+	0x39, 0x51, 0xdd, 0xd3, 0x33, 0x9a, 0x19, 0x49, 0xf1, 0x8c, 0x4c, 0x4e, 0x9e, 0xee, 0xf7, 0xfa,
+	0xeb, 0xf7, 0x5e, 0xbf, 0x7e, 0xfd, 0x75, 0xcb, 0x50, 0xb6, 0x1c, 0x0f, 0xef, 0x61, 0xbb, 0x4c,
+	0x19, 0x6e, 0xee, 0x54, 0xb1, 0x6b, 0x56, 0xb1, 0xeb, 0x5a, 0x66, 0x13, 0x33, 0xd3, 0xb1, 0x29,
+	0xf1, 0x76, 0x89, 0xd7, 0xd8, 0x23, 0x5b, 0x15, 0xd7, 0x73, 0x98, 0x83, 0xe6, 0x18, 0xb3, 0x2b,
+	0x72, 0x48, 0x65, 0xf7, 0x4a, 0x69, 0xa3, 0x65, 0xb2, 0x76, 0x77, 0xab, 0xd2, 0x74, 0x3a, 0xd5,
+	0x07, 0x6d, 0xf2, 0xa0, 0x6d, 0xda, 0x2d, 0x7a, 0xdb, 0x36, 0xba, 0x94, 0x79, 0x26, 0xa1, 0x55,
+	0x31, 0xaa, 0x59, 0x6e, 0x11, 0xbb, 0xdc, 0x72, 0xca, 0xdb, 0x16, 0x6e, 0xd1, 0x2a, 0xb6, 0x6d,
+	0x87, 0xf9, 0xf0, 0x3e, 0x6a, 0x69, 0x3d, 0x82, 0x42, 0xec, 0x5d, 0x67, 0xdf, 0xf5, 0x9c, 0xef,
+	0xef, 0x47, 0x07, 0xef, 0x62, 0xcb, 0x34, 0x30, 0x23, 0xd5, 0xbe, 0x0f, 0x09, 0x51, 0x8e, 0x40,
+	0xb4, 0x9c, 0x96, 0xe3, 0x0f, 0xde, 0xea, 0x6e, 0x8b, 0x96, 0x68, 0x88, 0x2f, 0xa9, 0x7e, 0xaa,
+	0xe5, 0x38, 0x2d, 0x8b, 0xf8, 0xfe, 0xf6, 0xd9, 0xb3, 0x24, 0xa5, 0x21, 0x06, 0xe9, 0xb8, 0x6c,
+	0x5f, 0x0a, 0x97, 0x93, 0xc2, 0x6d, 0x93, 0x58, 0x46, 0xa3, 0x83, 0xe9, 0x8e, 0xd4, 0x78, 0x2b,
+	0xa9, 0xc1, 0xcc, 0x0e, 0xa1, 0x0c, 0x77, 0x5c, 0xa9, 0x70, 0xba, 0x3f, 0xe8, 0xc4, 0xf3, 0x1c,
+	0x4f, 0x8a, 0xcf, 0xf6, 0x8b, 0x4d, 0x83, 0xd8, 0xcc, 0xdc, 0x36, 0x89, 0x27, 0x6d, 0xd4, 0xfe,
+	0xac, 0xc0, 0xe9, 0xf5, 0xde, 0x4a, 0x3d, 0x22, 0x5b, 0x6d, 0xc7, 0xd9, 0xb9, 0xdd, 0xd3, 0x43,
+	0x8f, 0x61, 0x3e, 0xb2, 0x94, 0x0d, 0xd3, 0xa0, 0xaa, 0xb2, 0xac, 0xac, 0x14, 0x56, 0xcf, 0x57,
+	0xe2, 0xab, 0x58, 0x89, 0xe0, 0x44, 0x00, 0x6a, 0xf9, 0x57, 0xb5, 0x89, 0x2f, 0x95, 0x5c, 0x51,
+	0xd1, 0xe7, 0x70, 0x54, 0x83, 0xa2, 0x4d, 0x80, 0x3d, 0x7f, 0xc2, 0x86, 0x69, 0xa8, 0xb9, 0x65,
+	0x65, 0x65, 0xba, 0x76, 0xe1, 0x55, 0xed, 0x9c, 0xa7, 0xa9, 0xe7, 0x56, 0xcf, 0x3c, 0x7d, 0x82,
+	0xcb, 0xcf, 0xde, 0x2e, 0x5f, 0xfb, 0x74, 0xe5, 0xfa, 0xda, 0x93, 0xf2, 0xa7, 0xd7, 0x83, 0xe6,
+	0xc5, 0xe7, 0xab, 0x97, 0x5f, 0x9c, 0xd3, 0xa7, 0xf7, 0x02, 0x5b, 0xd7, 0xf2, 0xff, 0xf9, 0xea,
+	0xe4, 0x78, 0x5e, 0x29, 0x2a, 0xda, 0xbf, 0x15, 0xf8, 0x5a, 0xbf, 0x3b, 0x0f, 0x48, 0xc7, 0xb5,
+	0x30, 0x23, 0x51, 0xb7, 0x6e, 0x41, 0x81, 0xc9, 0x6e, 0x3e, 0xb9, 0x92, 0x6d, 0x72, 0x60, 0x21,
+	0x24, 0xd2, 0xa1, 0xe8, 0x78, 0x2d, 0x6c, 0x9b, 0xcf, 0x7a, 0x11, 0xca, 0x89, 0x08, 0x5d, 0x48,
+	0x46, 0xe8, 0x6e, 0x44, 0x2f, 0x62, 0x8c, 0x3e, 0xef, 0xc4, 0x04, 0x14, 0xa9, 0x30, 0xb5, 0x4b,
+	0x3c, 0x6a, 0x3a, 0xb6, 0x3a, 0xb6, 0xac, 0xac, 0xcc, 0xea, 0x41, 0x33, 0xe2, 0xeb, 0x8f, 0x72,
+	0xf0, 0xd6, 0x70, 0x5f, 0x37, 0x79, 0x3a, 0xa1, 0x77, 0x20, 0x97, 0xdd, 0xb9, 0x9c, 0x69, 0xa0,
+	0x25, 0x18, 0xb7, 0x71, 0x87, 0xc8, 0x45, 0x99, 0x7a, 0x55, 0x1b, 0xf7, 0x72, 0xea, 0x51, 0x5d,
+	0x74, 0xa2, 0x8b, 0x50, 0x30, 0x08, 0x6d, 0x7a, 0xa6, 0xcb, 0x02, 0x0b, 0x43, 0x1d, 0x43, 0x8f,
+	0xca, 0xd0, 0x71, 0x98, 0xa4, 0xa4, 0xe9, 0x11, 0xa6, 0x8e, 0x2f, 0x2b, 0x2b, 0x79, 0x5d, 0xb6,
+	0xd0, 0x65, 0x98, 0x35, 0xc8, 0x36, 0xee, 0x5a, 0xac, 0xb1, 0x8b, 0xad, 0x2e, 0x51, 0x27, 0xe2,
+	0x20, 0x33, 0x52, 0xfa, 0x5d, 0x2e, 0x44, 0x25, 0xc8, 0x3b, 0x02, 0x0f, 0x5b, 0xea, 0xa4, 0xc0,
+	0x09, 0xdb, 0xda, 0xdf, 0xe6, 0xa1, 0x34, 0x3c, 0x0c, 0xe8, 0x3e, 0x8c, 0xf5, 0x52, 0xf6, 0xea,
+	0x6b, 0x52, 0x76, 0x78, 0xae, 0x44, 0x32, 0x98, 0x63, 0xfd, 0xdf, 0x62, 0x73, 0x16, 0xf2, 0x96,
+	0xd3, 0x72, 0x1a, 0x5d, 0xcf, 0x12, 0xd1, 0x99, 0x16, 0x13, 0x79, 0x63, 0x3f, 0x56, 0x14, 0x7d,
+	0x8a, 0x4b, 0x1e, 0x7a, 0x16, 0x57, 0x32, 0xed, 0x6d, 0x5f, 0x69, 0x22, 0xa9, 0xc4, 0x25, 0x5c,
+	0xe9, 0x2a, 0x2c, 0x18, 0x4e, 0xb3, 0xdb, 0x21, 0xb6, 0x5f, 0x81, 0x84, 0xf6, 0x64, 0x42, 0xbb,
+	0x18, 0x53, 0x91, 0xd8, 0x5b, 0x98, 0x12, 0xa1, 0x3d, 0x95, 0xc4, 0xe6, 0x12, 0xae, 0xd4, 0x86,
+	0xa9, 0x36, 0xc1, 0x06, 0xf1, 0xa8, 0x9a, 0x5f, 0x1e, 0x5b, 0x29, 0xac, 0xbe, 0x93, 0x3e, 0x88,
+	0x95, 0x5b, 0xfe, 0xc8, 0x1b, 0x36, 0xf3, 0xf6, 0x6b, 0xc7, 0x5e, 0xd5, 0xd0, 0xcf, 0x95, 0xf9,
+	0xe2, 0xaa, 0xc6, 0x83, 0xf1, 0xfe, 0xa5, 0x09, 0x6f, 0x4c, 0xfd, 0x22, 0xa7, 0x07, 0xf0, 0xe8,
+	0x3a, 0x4c, 0x6e, 0x3b, 0x5e, 0x07, 0x33, 0x75, 0x3a, 0x9a, 0xb0, 0x47, 0x0f, 0x4c, 0x58, 0x39,
+	0x0c, 0xdd, 0x84, 0x49, 0x51, 0x45, 0xa9, 0x0a, 0xc2, 0xd2, 0x6a, 0x7a, 0x4b, 0xc5, 0x76, 0xd1,
+	0xe5, 0x70, 0x74, 0x15, 0x4e, 0x34, 0x3d, 0xc2, 0x4b, 0x83, 0xe1, 0xec, 0xd9, 0x96, 0x69, 0xef,
+	0x34, 0xb0, 0x6b, 0x36, 0x76, 0xc8, 0xbe, 0xba, 0x28, 0xd2, 0xef, 0xa8, 0x2f, 0xde, 0x90, 0xd2,
+	0x75, 0xd7, 0xfc, 0x90, 0xec, 0xa3, 0xc7, 0x30, 0xd7, 0x75, 0x85, 0x76, 0x87, 0x50, 0x8a, 0x5b,
+	0x44, 0x2d, 0x88, 0xb4, 0x5b, 0xcd, 0x10, 0xb1, 0x3b, 0xfe, 0x48, 0x7d, 0xd6, 0x47, 0x92, 0x4d,
+	0xd4, 0x80, 0x05, 0x09, 0x6d, 0x73, 0x5f, 0x2d, 0xf3, 0x19, 0x31, 0xd4, 0x13, 0x23, 0xa3, 0x17,
+	0x7d, 0xb0, 0x8f, 0x42, 0x2c, 0x54, 0x87, 0xc2, 0xf7, 0x1c, 0xd3, 0x6e, 0xe0, 0x66, 0x93, 0xb8,
+	0x4c, 0x9d, 0x19, 0x19, 0x1a, 0x38, 0xcc, 0xba, 0x40, 0x41, 0x0f, 0x61, 0xa6, 0x17, 0xc0, 0xe6,
+	0x8e, 0x3a, 0x3b, 0x32, 0x6a, 0x21, 0xc0, 0x59, 0x6f, 0xee, 0xa0, 0x47, 0x30, 0x1b, 0xc2, 0xda,
+	0x1c, 0x77, 0x6e, 0x64, 0xdc, 0xd0, 0xbe, 0x8f, 0x70, 0x02, 0x98, 0x12, 0x9b, 0xa9, 0xf3, 0x87,
+	0x07, 0xae, 0x13, 0x9b, 0xa1, 0x27, 0x30, 0x1f, 0x02, 0x6f, 0x63, 0xd3, 0x22, 0x86, 0x5a, 0x1c,
+	0x19, 0x7a, 0x2e, 0x80, 0xda, 0x14, 0x48, 0x31, 0xf0, 0xcf, 0xbb, 0xa4, 0x4b, 0x0c, 0x75, 0xe1,
+	0xf0, 0xe0, 0xf7, 0x05, 0x12, 0x72, 0xa1, 0x14, 0x07, 0x6f, 0x98, 0x76, 0x40, 0x9a, 0x0c, 0xf5,
+	0xd8, 0xc8, 0xf3, 0xa8, 0xb1, 0x79, 0x6e, 0xf7, 0x30, 0xb9, 0x3b, 0x96, 0x23, 0xd9, 0x06, 0x75,
+	0xac, 0x5d, 0x62, 0xa8, 0x68, 0x74, 0x77, 0x02, 0xa8, 0xba, 0x40, 0xe2, 0x19, 0xc9, 0xd9, 0xa8,
+	0xd9, 0x24, 0x0d, 0x03, 0x33, 0xac, 0x1e, 0x1d, 0x3d, 0x23, 0x25, 0xce, 0x06, 0x66, 0x98, 0x6f,
+	0x4f, 0x4a, 0x28, 0x3f, 0xa0, 0x1b, 0x1e, 0x69, 0x3a, 0xbb, 0xc4, 0x23, 0x86, 0xaa, 0x8e, 0xbe,
+	0x3d, 0x25, 0x98, 0x1e, 0x60, 0xa1, 0x6b, 0x00, 0x3d, 0x82, 0xa8, 0x1e, 0x17, 0xc8, 0xa5, 0x8a,
+	0xcf, 0x10, 0x2b, 0x01, 0x43, 0xac, 0x88, 0x2a, 0x76, 0x07, 0xd3, 0x1d, 0x7d, 0x7a, 0x3b, 0xf8,
+	0x44, 0x67, 0x00, 0x0c, 0xe2, 0x7a, 0xa4, 0x29, 0x56, 0xec, 0xa4, 0xa8, 0x5f, 0x91, 0x1e, 0x0e,
+	0xed, 0x57, 0x33, 0xa3, 0x81, 0x99, 0x5a, 0x1a, 0x02, 0xfd, 0x20, 0x20, 0x9f, 0xfa, 0xb4, 0xd4,
+	0x5e, 0x67, 0xa5, 0x35, 0x98, 0x89, 0x56, 0x78, 0x54, 0x84, 0x31, 0x5e, 0x23, 0x05, 0xdf, 0xd0,
+	0xf9, 0x27, 0x3a, 0x0a, 0x13, 0xfe, 0xf9, 0x2e, 0x0e, 0x4b, 0xdd, 0x6f, 0xac, 0xe5, 0xde, 0x55,
+	0x4a, 0xe7, 0x61, 0x2a, 0x28, 0x6e, 0x4b, 0x30, 0xee, 0x62, 0xd6, 0x96, 0x3c, 0x45, 0x1e, 0x96,
+	0xef, 0xeb, 0xa2, 0x53, 0x6b, 0xc1, 0xd2, 0xf0, 0x88, 0x71, 0x1e, 0x37, 0x1d, 0x70, 0x31, 0x7e,
+	0xca, 0xf3, 0xb2, 0x7f, 0x29, 0x7d, 0xc4, 0xf5, 0xde, 0x60, 0xed, 0xab, 0x71, 0x50, 0xfb, 0x35,
+	0x6f, 0x11, 0x6c, 0xb1, 0x36, 0x6a, 0x88, 0x53, 0xd0, 0x62, 0xed, 0x7d, 0x49, 0x25, 0x3e, 0x38,
+	0x78, 0x12, 0x7f, 0x68, 0x25, 0xd6, 0xaa, 0x33, 0xcc, 0xba, 0xd4, 0xff, 0xde, 0xbf, 0x75, 0x44,
+	0x0f, 0x50, 0x11, 0x81, 0xe9, 0xae, 0x1d, 0x4c, 0xe1, 0xd3, 0xc7, 0x1b, 0x87, 0x99, 0xe2, 0x61,
+	0x00, 0x76, 0xeb, 0x88, 0xde, 0x43, 0x2e, 0x9d, 0x87, 0xd2, 0x70, 0x7b, 0x42, 0x72, 0x79, 0xa4,
+	0xf4, 0x93, 0x1c, 0x9c, 0x7a, 0x1d, 0x2a, 0xba, 0x00, 0xf3, 0x7e, 0x21, 0x6b, 0x60, 0xc6, 0x63,
+	0xc8, 0x7c, 0x8e, 0x35, 0xae, 0xcf, 0xf9, 0xdd, 0xeb, 0xb2, 0x17, 0x3d, 0x86, 0xe3, 0x16, 0xa6,
+	0xac, 0x11, 0xd7, 0xe6, 0xa9, 0x96, 0x3b, 0x28, 0xd5, 0x04, 0x1d, 0xf9, 0x9d, 0x92, 0xcb, 0x2b,
+	0xfa, 0x22, 0xc7, 0xd8, 0x8c, 0x22, 0xaf, 0xf3, 0xaa, 0xba, 0x34, 0x08, 0xda, 0x20, 0x0c, 0x9b,
+	0x16, 0x15, 0xdc, 0xab, 0xb0, 0x7a, 0x2a, 0x19, 0xc5, 0x1b, 0xfc, 0x8e, 0xb4, 0xe1, 0xeb, 0xe8,
+	0x6a, 0x1f, 0xae, 0x94, 0xf4, 0x62, 0xd1, 0xfb, 0xaa, 0xe5, 0x61, 0x92, 0x8a, 0x38, 0x68, 0x5f,
+	0x16, 0x01, 0xf5, 0x2f, 0x47, 0x9c, 0x6d, 0x96, 0x0f, 0x5e, 0xbf, 0x28, 0xcb, 0x2c, 0x06, 0x2c,
+	0x53, 0x4c, 0x77, 0x64, 0x45, 0xb2, 0xcd, 0x0f, 0x62, 0xdb, 0x33, 0x45, 0xcc, 0xfc, 0xe1, 0xc5,
+	0x23, 0x91, 0x8d, 0xca, 0x41, 0xba, 0xae, 0x11, 0x80, 0x8c, 0x65, 0x01, 0x91, 0xe3, 0xd6, 0x59,
+	0x8c, 0x2e, 0x8e, 0x0f, 0xa3, 0x8b, 0x9f, 0xf5, 0xe8, 0xe2, 0x44, 0x5a, 0x12, 0x96, 0x82, 0x26,
+	0x2e, 0x0f, 0xa2, 0x89, 0x93, 0xa3, 0xd1, 0xc4, 0x8f, 0x61, 0x26, 0x72, 0xf5, 0xa3, 0xf2, 0x90,
+	0x1f, 0xed, 0x6e, 0xa0, 0x17, 0x7a, 0x37, 0x41, 0x8a, 0x1a, 0x30, 0x1f, 0x22, 0x4b, 0x26, 0x5a,
+	0x14, 0x41, 0xf8, 0x66, 0x8a, 0x20, 0xc4, 0xa8, 0xa8, 0x1f, 0x0b, 0x7d, 0x8e, 0xc5, 0x3a, 0xd1,
+	0x2a, 0x14, 0xfb, 0x18, 0xe9, 0x42, 0x64, 0x29, 0xd4, 0x2f, 0x94, 0xde, 0x09, 0x2e, 0x59, 0xe9,
+	0xfd, 0x3e, 0x56, 0x3a, 0x25, 0x1c, 0x4e, 0x51, 0x26, 0x87, 0xb1, 0xd1, 0x47, 0x83, 0xd8, 0xe8,
+	0xf1, 0xcc, 0xa8, 0xfd, 0x2c, 0xf4, 0xc3, 0x38, 0x0b, 0xcd, 0x67, 0x86, 0x8c, 0xb2, 0xcf, 0x3b,
+	0x09, 0xf6, 0x39, 0x9d, 0x19, 0x2d, 0xc6, 0x3a, 0xef, 0x26, 0x59, 0x27, 0x64, 0xc6, 0x8b, 0xb3,
+	0xcd, 0xbb, 0x49, 0xb6, 0x59, 0x18, 0x1d, 0x50, 0xb0, 0xcc, 0x7a, 0x3f, 0xcb, 0x9c, 0xc9, 0x0c,
+	0x99, 0x64, 0x97, 0xf5, 0x7e, 0x76, 0x39, 0x3b, 0x3a, 0xa8, 0x64, 0x95, 0xed, 0xd7, 0xb2, 0xca,
+	0xc5, 0xcc, 0xf8, 0xc3, 0xd9, 0x64, 0xbd, 0x9f, 0x4d, 0xce, 0x65, 0x37, 0x3f, 0xc1, 0x22, 0xef,
+	0x24, 0x58, 0x24, 0xca, 0x9e, 0x59, 0x51, 0xf6, 0xf8, 0x68, 0x10, 0x7b, 0x3c, 0x91, 0x7d, 0x3b,
+	0xf5, 0xb1, 0xc6, 0x3b, 0x30, 0xeb, 0x9f, 0xd7, 0x0d, 0xff, 0xd8, 0x92, 0x74, 0x77, 0x25, 0x2d,
+	0xb1, 0xd0, 0x67, 0xda, 0x91, 0xc3, 0x3f, 0x41, 0x42, 0x8f, 0x65, 0x21, 0xa1, 0xcb, 0xfc, 0x6a,
+	0x6e, 0x31, 0xe2, 0x09, 0x56, 0xdc, 0x2b, 0x57, 0x79, 0x5d, 0xf6, 0x1f, 0x8a, 0x4b, 0xae, 0xc3,
+	0xe2, 0x80, 0xea, 0x99, 0x09, 0xe2, 0xed, 0x74, 0x74, 0xb4, 0xf7, 0xfe, 0x16, 0x79, 0x89, 0x7b,
+	0x08, 0x8b, 0xfd, 0x11, 0xa4, 0xe8, 0x3d, 0xc8, 0xcb, 0x37, 0xca, 0x80, 0x99, 0x6a, 0x07, 0x07,
+	0x5e, 0x0f, 0xc7, 0x68, 0xbf, 0x55, 0xe0, 0x64, 0xbf, 0xc2, 0xa6, 0x38, 0xc4, 0x28, 0xba, 0x07,
+	0x53, 0xfe, 0x79, 0x16, 0x80, 0xa7, 0x38, 0x63, 0xe4, 0xd8, 0x8a, 0xfc, 0xeb, 0x9f, 0x31, 0x01,
+	0x0c, 0x5f, 0x81, 0xa8, 0x20, 0x4b, 0xf8, 0xb4, 0xdf, 0x28, 0x70, 0xea, 0x26, 0x61, 0x03, 0xfc,
+	0x21, 0x9f, 0x77, 0x09, 0x65, 0xe8, 0xf6, 0x21, 0x98, 0x51, 0xe2, 0xfd, 0x2d, 0x9e, 0x86, 0xb9,
+	0x0c, 0x69, 0xa8, 0xfd, 0x41, 0x81, 0x33, 0xdf, 0x31, 0xe9, 0x00, 0x3b, 0x69, 0x60, 0xe8, 0x1b,
+	0x7c, 0xef, 0x3e, 0x84, 0xe1, 0xbf, 0x52, 0xe0, 0x54, 0xfd, 0x75, 0xf1, 0xdd, 0x84, 0x29, 0x99,
+	0x38, 0xd2, 0xdc, 0x14, 0xb9, 0x16, 0x31, 0x35, 0x18, 0x7c, 0x18, 0x1b, 0x7f, 0xaf, 0xc0, 0xb9,
+	0x81, 0x39, 0x10, 0xde, 0xb6, 0xa4, 0xad, 0x6f, 0xe0, 0x4d, 0xf6, 0x10, 0x66, 0xff, 0x4b, 0x81,
+	0xf3, 0x83, 0x73, 0x22, 0xbc, 0x65, 0x06, 0x86, 0xc7, 0x67, 0x51, 0xb2, 0x14, 0xc0, 0x37, 0xf1,
+	0x2b, 0x41, 0x19, 0x90, 0x69, 0x37, 0xad, 0xae, 0x41, 0x1a, 0x91, 0x1b, 0xfe, 0x98, 0xb8, 0xe1,
+	0x2f, 0x48, 0xc9, 0x46, 0x28, 0xd0, 0x7e, 0x00, 0x2b, 0xf7, 0xba, 0x5b, 0x96, 0x49, 0xdb, 0x07,
+	0x2f, 0xd1, 0x3d, 0xc8, 0x07, 0xd4, 0x53, 0xfa, 0x99, 0xe1, 0x56, 0x1d, 0x59, 0x9c, 0x10, 0x65,
+	0xf5, 0x4f, 0x33, 0x83, 0xde, 0xe9, 0x75, 0xd2, 0x32, 0x29, 0x2f, 0x36, 0x16, 0xc0, 0x4d, 0xc2,
+	0x82, 0xe2, 0x76, 0xbc, 0x2f, 0xa8, 0x37, 0x3a, 0x2e, 0xdb, 0x2f, 0x5d, 0x4c, 0x5d, 0xe3, 0xb4,
+	0xa5, 0x1f, 0xfe, 0xe5, 0x9f, 0x2f, 0x73, 0xc7, 0xd0, 0x62, 0x15, 0xd3, 0xaa, 0xcc, 0xee, 0xb2,
+	0x2c, 0x75, 0xe8, 0x97, 0x0a, 0x14, 0x6e, 0x12, 0x16, 0xfe, 0x4a, 0xf0, 0x8d, 0x24, 0x6e, 0x9a,
+	0x3c, 0x2e, 0x65, 0x08, 0x89, 0x56, 0x15, 0xe6, 0x5c, 0x44, 0x17, 0xa2, 0xe6, 0x84, 0x8f, 0x0f,
+	0xd5, 0xe7, 0xa6, 0x41, 0x2b, 0x91, 0x5b, 0xc9, 0x0b, 0xf4, 0x52, 0x81, 0x59, 0x9e, 0x96, 0xbd,
+	0xa7, 0x8e, 0xbe, 0x02, 0x9f, 0x2e, 0x6b, 0x4b, 0x5f, 0x4f, 0x6f, 0x26, 0xd5, 0x4e, 0x0b, 0x3b,
+	0x4f, 0xa0, 0x63, 0x03, 0xed, 0x44, 0x7f, 0x55, 0x60, 0x5e, 0x26, 0x51, 0x18, 0xbc, 0x77, 0x93,
+	0xf8, 0x69, 0xb3, 0x2c, 0x53, 0x00, 0x9f, 0x0a, 0xc3, 0x3e, 0xd6, 0xea, 0x83, 0x03, 0x18, 0xdd,
+	0x1b, 0xb4, 0xfa, 0x3c, 0x10, 0x54, 0x78, 0x5c, 0x93, 0x1b, 0x2f, 0xd9, 0xf1, 0x62, 0x4d, 0xb9,
+	0x84, 0xfe, 0xae, 0xc0, 0x42, 0xb8, 0x59, 0x42, 0xdf, 0x46, 0xab, 0x4e, 0x99, 0x1c, 0xdb, 0x16,
+	0x8e, 0x7d, 0xa6, 0x3d, 0x4d, 0xe5, 0xd8, 0x81, 0xbe, 0xf4, 0x7c, 0x17, 0xad, 0xb0, 0x30, 0xa0,
+	0x5f, 0x2b, 0x30, 0x76, 0x93, 0x30, 0x74, 0x39, 0x55, 0xae, 0x07, 0x4b, 0x94, 0xe2, 0x18, 0xd1,
+	0xbe, 0x2d, 0x3c, 0xd8, 0x40, 0xb5, 0x88, 0x07, 0x32, 0xa5, 0x13, 0x47, 0x69, 0xa2, 0xfd, 0xc2,
+	0x57, 0xea, 0xfd, 0x08, 0xfc, 0x02, 0xfd, 0x54, 0x81, 0x71, 0x9e, 0xd7, 0xa8, 0x92, 0x2e, 0xdb,
+	0xc3, 0x2c, 0x3f, 0x7b, 0xb0, 0xa1, 0x54, 0xbb, 0x2a, 0x2c, 0xad, 0xa2, 0x72, 0xdc, 0xd2, 0x03,
+	0xac, 0x44, 0xff, 0x55, 0x60, 0xac, 0x3e, 0x28, 0x74, 0xf5, 0xc3, 0x86, 0xee, 0x17, 0x8a, 0xb0,
+	0xe8, 0x67, 0x4a, 0x49, 0x8f, 0x9b, 0x24, 0xbf, 0x2a, 0xa9, 0x82, 0x18, 0x55, 0x8e, 0x04, 0x73,
+	0x4d, 0xb9, 0xf4, 0xc9, 0x7b, 0xda, 0xb5, 0x91, 0x81, 0xf9, 0xb6, 0x78, 0xa9, 0xc0, 0xe4, 0x06,
+	0xb1, 0x08, 0x23, 0x28, 0x1b, 0x6b, 0x2b, 0x0d, 0xa9, 0xe1, 0x5a, 0x4d, 0x78, 0xfc, 0xad, 0x4b,
+	0x6b, 0x99, 0xd6, 0x20, 0x34, 0x9c, 0x37, 0x6a, 0x57, 0xff, 0xf8, 0x8f, 0x33, 0xca, 0x27, 0xd5,
+	0x96, 0x53, 0x61, 0x6d, 0xc2, 0xc4, 0xbf, 0x8b, 0x54, 0x6c, 0xc2, 0xf6, 0x1c, 0x6f, 0xa7, 0x1a,
+	0xff, 0xb7, 0x87, 0xdd, 0x2b, 0x55, 0x77, 0xa7, 0x55, 0x65, 0xcc, 0x76, 0xb7, 0xb6, 0x26, 0x85,
+	0x29, 0x57, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xc5, 0xc5, 0xb1, 0x9c, 0xaf, 0x22, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1412,6 +1554,10 @@ type ApplicationWebhookRegistryClient interface {
 	GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationWebhookFormats, error)
 	GetTemplate(ctx context.Context, in *GetApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error)
 	ListTemplates(ctx context.Context, in *ListApplicationWebhookTemplatesRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplates, error)
+	// Publish a new version of a webhook template of an organization.
+	PublishTemplate(ctx context.Context, in *PublishApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error)
+	// Deprecate all versions of a webhook template of an organization.
+	DeprecateTemplate(ctx context.Context, in *ApplicationWebhookTemplateIdentifiers, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error)
 	Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
@@ -1453,6 +1599,24 @@ func (c *applicationWebhookRegistryClient) ListTemplates(ctx context.Context, in
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) PublishTemplate(ctx context.Context, in *PublishApplicationWebhookTemplateRequest, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error) {
+	out := new(ApplicationWebhookTemplate)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/PublishTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) DeprecateTemplate(ctx context.Context, in *ApplicationWebhookTemplateIdentifiers, opts ...grpc.CallOption) (*ApplicationWebhookTemplate, error) {
+	out := new(ApplicationWebhookTemplate)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/DeprecateTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationWebhookRegistryClient) Get(ctx context.Context, in *GetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error) {
 	out := new(ApplicationWebhook)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get", in, out, opts...)
@@ -1494,6 +1658,10 @@ type ApplicationWebhookRegistryServer interface {
 	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
 	GetTemplate(context.Context, *GetApplicationWebhookTemplateRequest) (*ApplicationWebhookTemplate, error)
 	ListTemplates(context.Context, *ListApplicationWebhookTemplatesRequest) (*ApplicationWebhookTemplates, error)
+	// Publish a new version of a webhook template of an organization.
+	PublishTemplate(context.Context, *PublishApplicationWebhookTemplateRequest) (*ApplicationWebhookTemplate, error)
+	// Deprecate all versions of a webhook template of an organization.
+	DeprecateTemplate(context.Context, *ApplicationWebhookTemplateIdentifiers) (*ApplicationWebhookTemplate, error)
 	Get(context.Context, *GetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
@@ -1513,6 +1681,12 @@ func (*UnimplementedApplicationWebhookRegistryServer) GetTemplate(ctx context.Co
 func (*UnimplementedApplicationWebhookRegistryServer) ListTemplates(ctx context.Context, req *ListApplicationWebhookTemplatesRequest) (*ApplicationWebhookTemplates, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
 }
+func (*UnimplementedApplicationWebhookRegistryServer) PublishTemplate(ctx context.Context, req *PublishApplicationWebhookTemplateRequest) (*ApplicationWebhookTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTemplate not implemented")
+}
+func (*UnimplementedApplicationWebhookRegistryServer) DeprecateTemplate(ctx context.Context, req *ApplicationWebhookTemplateIdentifiers) (*ApplicationWebhookTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateTemplate not implemented")
+}
 func (*UnimplementedApplicationWebhookRegistryServer) Get(ctx context.Context, req *GetApplicationWebhookRequest) (*ApplicationWebhook, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
@@ -1584,6 +1758,42 @@ func _ApplicationWebhookRegistry_ListTemplates_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_PublishTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishApplicationWebhookTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).PublishTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/PublishTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).PublishTemplate(ctx, req.(*PublishApplicationWebhookTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_DeprecateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookTemplateIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).DeprecateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/DeprecateTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).DeprecateTemplate(ctx, req.(*ApplicationWebhookTemplateIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationWebhookRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetApplicationWebhookRequest)
 	if err := dec(in); err != nil {
@@ -1672,6 +1882,14 @@ var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTemplates",
 			Handler:    _ApplicationWebhookRegistry_ListTemplates_Handler,
 		},
+		{
+			MethodName: "PublishTemplate",
+			Handler:    _ApplicationWebhookRegistry_PublishTemplate_Handler,
+		},
+		{
+			MethodName: "DeprecateTemplate",
+			Handler:    _ApplicationWebhookRegistry_DeprecateTemplate_Handler,
+		},
 		{
 			MethodName: "Get",
 			Handler:    _ApplicationWebhookRegistry_Get_Handler,
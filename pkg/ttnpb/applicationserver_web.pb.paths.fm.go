@@ -13,11 +13,16 @@ var ApplicationWebhookIdentifiersFieldPathsTopLevel = []string{
 	"webhook_id",
 }
 var ApplicationWebhookTemplateIdentifiersFieldPathsNested = []string{
+	"organization_ids",
+	"organization_ids.organization_id",
 	"template_id",
+	"version",
 }
 
 var ApplicationWebhookTemplateIdentifiersFieldPathsTopLevel = []string{
+	"organization_ids",
 	"template_id",
+	"version",
 }
 var ApplicationWebhookTemplateFieldFieldPathsNested = []string{
 	"default_value",
@@ -39,6 +44,8 @@ var ApplicationWebhookTemplateFieldFieldPathsTopLevel = []string{
 var ApplicationWebhookTemplateFieldPathsNested = []string{
 	"base_url",
 	"create_downlink_api_key",
+	"created_at",
+	"deprecated",
 	"description",
 	"documentation_url",
 	"downlink_ack",
@@ -58,7 +65,10 @@ var ApplicationWebhookTemplateFieldPathsNested = []string{
 	"format",
 	"headers",
 	"ids",
+	"ids.organization_ids",
+	"ids.organization_ids.organization_id",
 	"ids.template_id",
+	"ids.version",
 	"info_url",
 	"join_accept",
 	"join_accept.path",
@@ -68,6 +78,8 @@ var ApplicationWebhookTemplateFieldPathsNested = []string{
 	"name",
 	"service_data",
 	"service_data.path",
+	"session_recovered",
+	"session_recovered.path",
 	"uplink_message",
 	"uplink_message.path",
 	"uplink_normalized",
@@ -77,6 +89,8 @@ var ApplicationWebhookTemplateFieldPathsNested = []string{
 var ApplicationWebhookTemplateFieldPathsTopLevel = []string{
 	"base_url",
 	"create_downlink_api_key",
+	"created_at",
+	"deprecated",
 	"description",
 	"documentation_url",
 	"downlink_ack",
@@ -96,6 +110,7 @@ var ApplicationWebhookTemplateFieldPathsTopLevel = []string{
 	"logo_url",
 	"name",
 	"service_data",
+	"session_recovered",
 	"uplink_message",
 	"uplink_normalized",
 }
@@ -148,6 +163,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"downlink_sent",
 	"downlink_sent.path",
 	"field_mask",
+	"filter",
 	"format",
 	"headers",
 	"health_status",
@@ -180,9 +196,14 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"location_solved.path",
 	"service_data",
 	"service_data.path",
+	"session_recovered",
+	"session_recovered.path",
 	"template_fields",
 	"template_ids",
+	"template_ids.organization_ids",
+	"template_ids.organization_ids.organization_id",
 	"template_ids.template_id",
+	"template_ids.version",
 	"updated_at",
 	"uplink_message",
 	"uplink_message.path",
@@ -201,6 +222,7 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"downlink_queued",
 	"downlink_sent",
 	"field_mask",
+	"filter",
 	"format",
 	"headers",
 	"health_status",
@@ -208,6 +230,7 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"join_accept",
 	"location_solved",
 	"service_data",
+	"session_recovered",
 	"template_fields",
 	"template_ids",
 	"updated_at",
@@ -269,6 +292,7 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.downlink_sent",
 	"webhook.downlink_sent.path",
 	"webhook.field_mask",
+	"webhook.filter",
 	"webhook.format",
 	"webhook.headers",
 	"webhook.health_status",
@@ -301,9 +325,14 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.location_solved.path",
 	"webhook.service_data",
 	"webhook.service_data.path",
+	"webhook.session_recovered",
+	"webhook.session_recovered.path",
 	"webhook.template_fields",
 	"webhook.template_ids",
+	"webhook.template_ids.organization_ids",
+	"webhook.template_ids.organization_ids.organization_id",
 	"webhook.template_ids.template_id",
+	"webhook.template_ids.version",
 	"webhook.updated_at",
 	"webhook.uplink_message",
 	"webhook.uplink_message.path",
@@ -318,7 +347,10 @@ var SetApplicationWebhookRequestFieldPathsTopLevel = []string{
 var GetApplicationWebhookTemplateRequestFieldPathsNested = []string{
 	"field_mask",
 	"ids",
+	"ids.organization_ids",
+	"ids.organization_ids.organization_id",
 	"ids.template_id",
+	"ids.version",
 }
 
 var GetApplicationWebhookTemplateRequestFieldPathsTopLevel = []string{
@@ -327,10 +359,64 @@ var GetApplicationWebhookTemplateRequestFieldPathsTopLevel = []string{
 }
 var ListApplicationWebhookTemplatesRequestFieldPathsNested = []string{
 	"field_mask",
+	"include_deprecated",
+	"organization_ids",
+	"organization_ids.organization_id",
 }
 
 var ListApplicationWebhookTemplatesRequestFieldPathsTopLevel = []string{
 	"field_mask",
+	"include_deprecated",
+	"organization_ids",
+}
+var PublishApplicationWebhookTemplateRequestFieldPathsNested = []string{
+	"template",
+	"template.base_url",
+	"template.create_downlink_api_key",
+	"template.created_at",
+	"template.deprecated",
+	"template.description",
+	"template.documentation_url",
+	"template.downlink_ack",
+	"template.downlink_ack.path",
+	"template.downlink_failed",
+	"template.downlink_failed.path",
+	"template.downlink_nack",
+	"template.downlink_nack.path",
+	"template.downlink_queue_invalidated",
+	"template.downlink_queue_invalidated.path",
+	"template.downlink_queued",
+	"template.downlink_queued.path",
+	"template.downlink_sent",
+	"template.downlink_sent.path",
+	"template.field_mask",
+	"template.fields",
+	"template.format",
+	"template.headers",
+	"template.ids",
+	"template.ids.organization_ids",
+	"template.ids.organization_ids.organization_id",
+	"template.ids.template_id",
+	"template.ids.version",
+	"template.info_url",
+	"template.join_accept",
+	"template.join_accept.path",
+	"template.location_solved",
+	"template.location_solved.path",
+	"template.logo_url",
+	"template.name",
+	"template.service_data",
+	"template.service_data.path",
+	"template.session_recovered",
+	"template.session_recovered.path",
+	"template.uplink_message",
+	"template.uplink_message.path",
+	"template.uplink_normalized",
+	"template.uplink_normalized.path",
+}
+
+var PublishApplicationWebhookTemplateRequestFieldPathsTopLevel = []string{
+	"template",
 }
 var ApplicationWebhookTemplate_MessageFieldPathsNested = []string{
 	"path",
@@ -63,6 +63,41 @@ func (dst *ApplicationWebhookTemplateIdentifiers) SetFields(src *ApplicationWebh
 				var zero string
 				dst.TemplateId = zero
 			}
+		case "organization_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationIdentifiers
+				if (src == nil || src.OrganizationIds == nil) && dst.OrganizationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OrganizationIds
+				}
+				if dst.OrganizationIds != nil {
+					newDst = dst.OrganizationIds
+				} else {
+					newDst = &OrganizationIdentifiers{}
+					dst.OrganizationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OrganizationIds = src.OrganizationIds
+				} else {
+					dst.OrganizationIds = nil
+				}
+			}
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero uint32
+				dst.Version = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -543,6 +578,31 @@ func (dst *ApplicationWebhookTemplate) SetFields(src *ApplicationWebhookTemplate
 					dst.ServiceData = nil
 				}
 			}
+		case "session_recovered":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookTemplate_Message
+				if (src == nil || src.SessionRecovered == nil) && dst.SessionRecovered == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SessionRecovered
+				}
+				if dst.SessionRecovered != nil {
+					newDst = dst.SessionRecovered
+				} else {
+					newDst = &ApplicationWebhookTemplate_Message{}
+					dst.SessionRecovered = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SessionRecovered = src.SessionRecovered
+				} else {
+					dst.SessionRecovered = nil
+				}
+			}
 		case "field_mask":
 			if len(subs) > 0 {
 				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
@@ -552,6 +612,25 @@ func (dst *ApplicationWebhookTemplate) SetFields(src *ApplicationWebhookTemplate
 			} else {
 				dst.FieldMask = nil
 			}
+		case "deprecated":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deprecated' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deprecated = src.Deprecated
+			} else {
+				var zero bool
+				dst.Deprecated = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1078,6 +1157,31 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 					dst.ServiceData = nil
 				}
 			}
+		case "session_recovered":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_Message
+				if (src == nil || src.SessionRecovered == nil) && dst.SessionRecovered == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.SessionRecovered
+				}
+				if dst.SessionRecovered != nil {
+					newDst = dst.SessionRecovered
+				} else {
+					newDst = &ApplicationWebhook_Message{}
+					dst.SessionRecovered = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.SessionRecovered = src.SessionRecovered
+				} else {
+					dst.SessionRecovered = nil
+				}
+			}
 		case "health_status":
 			if len(subs) > 0 {
 				var newDst, newSrc *ApplicationWebhookHealth
@@ -1112,6 +1216,16 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 			} else {
 				dst.FieldMask = nil
 			}
+		case "filter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Filter = src.Filter
+			} else {
+				var zero string
+				dst.Filter = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1352,6 +1466,77 @@ func (dst *ListApplicationWebhookTemplatesRequest) SetFields(src *ListApplicatio
 			} else {
 				dst.FieldMask = nil
 			}
+		case "organization_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationIdentifiers
+				if (src == nil || src.OrganizationIds == nil) && dst.OrganizationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OrganizationIds
+				}
+				if dst.OrganizationIds != nil {
+					newDst = dst.OrganizationIds
+				} else {
+					newDst = &OrganizationIdentifiers{}
+					dst.OrganizationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OrganizationIds = src.OrganizationIds
+				} else {
+					dst.OrganizationIds = nil
+				}
+			}
+		case "include_deprecated":
+			if len(subs) > 0 {
+				return fmt.Errorf("'include_deprecated' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IncludeDeprecated = src.IncludeDeprecated
+			} else {
+				var zero bool
+				dst.IncludeDeprecated = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PublishApplicationWebhookTemplateRequest) SetFields(src *PublishApplicationWebhookTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "template":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookTemplate
+				if (src == nil || src.Template == nil) && dst.Template == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Template
+				}
+				if dst.Template != nil {
+					newDst = dst.Template
+				} else {
+					newDst = &ApplicationWebhookTemplate{}
+					dst.Template = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Template = src.Template
+				} else {
+					dst.Template = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
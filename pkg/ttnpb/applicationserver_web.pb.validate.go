@@ -185,6 +185,20 @@ func (m *ApplicationWebhookTemplateIdentifiers) ValidateFields(paths ...string)
 				}
 			}
 
+		case "organization_ids":
+
+			if v, ok := interface{}(m.GetOrganizationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookTemplateIdentifiersValidationError{
+						field:  "organization_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version":
+			// no validation rules for Version
 		default:
 			return ApplicationWebhookTemplateIdentifiersValidationError{
 				field:  name,
@@ -692,6 +706,18 @@ func (m *ApplicationWebhookTemplate) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "session_recovered":
+
+			if v, ok := interface{}(m.GetSessionRecovered()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookTemplateValidationError{
+						field:  "session_recovered",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "field_mask":
 
 			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
@@ -704,6 +730,20 @@ func (m *ApplicationWebhookTemplate) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "deprecated":
+			// no validation rules for Deprecated
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookTemplateValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationWebhookTemplateValidationError{
 				field:  name,
@@ -1269,6 +1309,18 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "session_recovered":
+
+			if v, ok := interface{}(m.GetSessionRecovered()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "session_recovered",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		case "health_status":
 
 			if v, ok := interface{}(m.GetHealthStatus()).(interface{ ValidateFields(...string) error }); ok {
@@ -1293,6 +1345,15 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "filter":
+
+			if utf8.RuneCountInString(m.GetFilter()) > 1024 {
+				return ApplicationWebhookValidationError{
+					field:  "filter",
+					reason: "value length must be at most 1024 runes",
+				}
+			}
+
 		default:
 			return ApplicationWebhookValidationError{
 				field:  name,
@@ -2022,6 +2083,20 @@ func (m *ListApplicationWebhookTemplatesRequest) ValidateFields(paths ...string)
 				}
 			}
 
+		case "organization_ids":
+
+			if v, ok := interface{}(m.GetOrganizationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationWebhookTemplatesRequestValidationError{
+						field:  "organization_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "include_deprecated":
+			// no validation rules for IncludeDeprecated
 		default:
 			return ListApplicationWebhookTemplatesRequestValidationError{
 				field:  name,
@@ -2089,6 +2164,107 @@ var _ interface {
 	ErrorName() string
 } = ListApplicationWebhookTemplatesRequestValidationError{}
 
+// ValidateFields checks the field values on
+// PublishApplicationWebhookTemplateRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *PublishApplicationWebhookTemplateRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PublishApplicationWebhookTemplateRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "template":
+
+			if m.GetTemplate() == nil {
+				return PublishApplicationWebhookTemplateRequestValidationError{
+					field:  "template",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetTemplate()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PublishApplicationWebhookTemplateRequestValidationError{
+						field:  "template",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return PublishApplicationWebhookTemplateRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PublishApplicationWebhookTemplateRequestValidationError is the validation
+// error returned by PublishApplicationWebhookTemplateRequest.ValidateFields
+// if the designated constraints aren't met.
+type PublishApplicationWebhookTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PublishApplicationWebhookTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PublishApplicationWebhookTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PublishApplicationWebhookTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PublishApplicationWebhookTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PublishApplicationWebhookTemplateRequestValidationError) ErrorName() string {
+	return "PublishApplicationWebhookTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PublishApplicationWebhookTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPublishApplicationWebhookTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PublishApplicationWebhookTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PublishApplicationWebhookTemplateRequestValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhookTemplate_Message
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
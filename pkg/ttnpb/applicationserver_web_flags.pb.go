@@ -69,6 +69,9 @@ func (m *ApplicationWebhookIdentifiers) SetFromFlags(flags *pflag.FlagSet, prefi
 // AddSelectFlagsForApplicationWebhookTemplateIdentifiers adds flags to select fields in ApplicationWebhookTemplateIdentifiers.
 func AddSelectFlagsForApplicationWebhookTemplateIdentifiers(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("template-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("template-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("organization-ids", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("organization-ids", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForOrganizationIdentifiers(flags, flagsplugin.Prefix("organization-ids", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("version", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("version", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationWebhookTemplateIdentifiers message from select flags.
@@ -78,12 +81,29 @@ func PathsFromSelectFlagsForApplicationWebhookTemplateIdentifiers(flags *pflag.F
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("template_id", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("organization_ids", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("organization_ids", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForOrganizationIdentifiers(flags, flagsplugin.Prefix("organization_ids", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("version", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("version", prefix))
+	}
 	return paths, nil
 }
 
 // AddSetFlagsForApplicationWebhookTemplateIdentifiers adds flags to select fields in ApplicationWebhookTemplateIdentifiers.
 func AddSetFlagsForApplicationWebhookTemplateIdentifiers(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("template-id", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForOrganizationIdentifiers(flags, flagsplugin.Prefix("organization-ids", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("version", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the ApplicationWebhookTemplateIdentifiers message from flags.
@@ -94,6 +114,20 @@ func (m *ApplicationWebhookTemplateIdentifiers) SetFromFlags(flags *pflag.FlagSe
 		m.TemplateId = val
 		paths = append(paths, flagsplugin.Prefix("template_id", prefix))
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("organization_ids", prefix)); changed {
+		m.OrganizationIds = &OrganizationIdentifiers{}
+		if setPaths, err := m.OrganizationIds.SetFromFlags(flags, flagsplugin.Prefix("organization_ids", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("version", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Version = val
+		paths = append(paths, flagsplugin.Prefix("version", prefix))
+	}
 	return paths, nil
 }
 
@@ -230,9 +264,12 @@ func AddSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix string, hi
 	AddSelectFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("location-solved", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("service-data", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("service-data", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("service-data", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("session-recovered", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("session-recovered", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("session-recovered", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("health-status", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("health-status", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationWebhookHealth(flags, flagsplugin.Prefix("health-status", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("field-mask", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("field-mask", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("filter", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("filter", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationWebhook message from select flags.
@@ -382,6 +419,16 @@ func PathsFromSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix stri
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("session_recovered", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("session_recovered", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("session_recovered", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("health_status", prefix)); err != nil {
 		return nil, err
 	} else if selected && val {
@@ -397,6 +444,11 @@ func PathsFromSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix stri
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("filter", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("filter", prefix))
+	}
 	return paths, nil
 }
 
@@ -420,8 +472,10 @@ func AddSetFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix string, hidde
 	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("downlink-queue-invalidated", prefix), hidden)
 	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("location-solved", prefix), hidden)
 	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("service-data", prefix), hidden)
+	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("session-recovered", prefix), hidden)
 	// FIXME: Skipping HealthStatus because it does not seem to implement AddSetFlags.
 	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("field-mask", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("filter", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the ApplicationWebhook message from flags.
@@ -586,6 +640,14 @@ func (m *ApplicationWebhook) SetFromFlags(flags *pflag.FlagSet, prefix string) (
 			paths = append(paths, setPaths...)
 		}
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("session_recovered", prefix)); changed {
+		m.SessionRecovered = &ApplicationWebhook_Message{}
+		if setPaths, err := m.SessionRecovered.SetFromFlags(flags, flagsplugin.Prefix("session_recovered", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	// FIXME: Skipping HealthStatus because it does not seem to implement AddSetFlags.
 	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("field_mask", prefix)); err != nil {
 		return nil, err
@@ -593,5 +655,11 @@ func (m *ApplicationWebhook) SetFromFlags(flags *pflag.FlagSet, prefix string) (
 		m.FieldMask = gogo.SetFieldMask(val)
 		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
 	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("filter", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Filter = val
+		paths = append(paths, flagsplugin.Prefix("filter", prefix))
+	}
 	return paths, nil
 }
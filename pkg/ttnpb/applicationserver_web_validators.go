@@ -45,3 +45,13 @@ func (m *SetApplicationWebhookRequest) ValidateContext(context.Context) error {
 func (req *ApplicationWebhookIdentifiers) ValidateContext(context.Context) error {
 	return req.ValidateFields()
 }
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+func (req *ApplicationWebhookTemplateIdentifiers) ValidateContext(context.Context) error {
+	return req.ValidateFields()
+}
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+func (req *PublishApplicationWebhookTemplateRequest) ValidateContext(context.Context) error {
+	return req.ValidateFields()
+}
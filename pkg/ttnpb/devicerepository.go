@@ -0,0 +1,63 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttnpb
+
+import (
+	"fmt"
+	"strings"
+)
+
+func testVectorName(t MessagePayloadFormatterTestVector_Type, i int) string {
+	return fmt.Sprintf("%s-%d", strings.ToLower(strings.ReplaceAll(t.String(), "_", "-")), i+1)
+}
+
+// TestVectors returns the examples of the decoder as payload formatter test vectors of the given type.
+// The test vectors are named after the type and the index of the example.
+func (m *MessagePayloadDecoder) TestVectors(t MessagePayloadFormatterTestVector_Type) []*MessagePayloadFormatterTestVector {
+	vectors := make([]*MessagePayloadFormatterTestVector, 0, len(m.GetExamples()))
+	for i, example := range m.GetExamples() {
+		vectors = append(vectors, &MessagePayloadFormatterTestVector{
+			Name:           testVectorName(t, i),
+			Description:    example.Description,
+			Type:           t,
+			FPort:          example.GetInput().GetFPort(),
+			FrmPayload:     example.GetInput().GetFrmPayload(),
+			DecodedPayload: example.GetOutput().GetData(),
+			Warnings:       example.GetOutput().GetWarnings(),
+			Errors:         example.GetOutput().GetErrors(),
+		})
+	}
+	return vectors
+}
+
+// TestVectors returns the examples of the encoder as payload formatter test vectors.
+// The test vectors are named after the type and the index of the example.
+func (m *MessagePayloadEncoder) TestVectors() []*MessagePayloadFormatterTestVector {
+	const t = MessagePayloadFormatterTestVector_DOWNLINK_ENCODE
+	vectors := make([]*MessagePayloadFormatterTestVector, 0, len(m.GetExamples()))
+	for i, example := range m.GetExamples() {
+		vectors = append(vectors, &MessagePayloadFormatterTestVector{
+			Name:           testVectorName(t, i),
+			Description:    example.Description,
+			Type:           t,
+			FPort:          example.GetOutput().GetFPort(),
+			FrmPayload:     example.GetOutput().GetFrmPayload(),
+			DecodedPayload: example.GetInput().GetData(),
+			Warnings:       example.GetOutput().GetWarnings(),
+			Errors:         example.GetOutput().GetErrors(),
+		})
+	}
+	return vectors
+}
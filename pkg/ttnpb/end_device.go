@@ -705,6 +705,14 @@ func (v *MACState) FieldIsZero(p string) bool {
 		return v.PendingApplicationDownlink.FieldIsZero("frm_payload")
 	case "pending_application_downlink.priority":
 		return v.PendingApplicationDownlink.FieldIsZero("priority")
+	case "pending_application_downlink.scheduling":
+		return v.PendingApplicationDownlink.FieldIsZero("scheduling")
+	case "pending_application_downlink.scheduling.deduplication_key":
+		return v.PendingApplicationDownlink.FieldIsZero("scheduling.deduplication_key")
+	case "pending_application_downlink.scheduling.expires_at":
+		return v.PendingApplicationDownlink.FieldIsZero("scheduling.expires_at")
+	case "pending_application_downlink.scheduling.not_before":
+		return v.PendingApplicationDownlink.FieldIsZero("scheduling.not_before")
 	case "pending_application_downlink.session_key_id":
 		return v.PendingApplicationDownlink.FieldIsZero("session_key_id")
 	case "pending_join_request":
@@ -1017,6 +1025,12 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 	switch p {
 	case "activated_at":
 		return v.ActivatedAt == nil
+	case "application_downlink_policy":
+		return v.ApplicationDownlinkPolicy == nil
+	case "application_downlink_policy.default_ttl":
+		return v.ApplicationDownlinkPolicy.FieldIsZero("default_ttl")
+	case "application_downlink_policy.order_by_priority":
+		return v.ApplicationDownlinkPolicy.FieldIsZero("order_by_priority")
 	case "application_server_address":
 		return v.ApplicationServerAddress == ""
 	case "application_server_id":
@@ -1047,6 +1061,8 @@ func (v *EndDevice) FieldIsZero(p string) bool {
 		return v.Formatters.FieldIsZero("down_formatter")
 	case "formatters.down_formatter_parameter":
 		return v.Formatters.FieldIsZero("down_formatter_parameter")
+	case "formatters.test_vectors":
+		return v.Formatters.FieldIsZero("test_vectors")
 	case "formatters.up_formatter":
 		return v.Formatters.FieldIsZero("up_formatter")
 	case "formatters.up_formatter_parameter":
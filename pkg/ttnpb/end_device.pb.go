@@ -2411,10 +2411,13 @@ type EndDevice struct {
 	ActivatedAt *types.Timestamp `protobuf:"bytes,53,opt,name=activated_at,json=activatedAt,proto3" json:"activated_at,omitempty"`
 	// Timestamp when a device uplink has been last observed.
 	// This field is set by the Application Server and stored in the Identity Server.
-	LastSeenAt           *types.Timestamp `protobuf:"bytes,54,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	LastSeenAt *types.Timestamp `protobuf:"bytes,54,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	// Policy for downlink messages that are held by the Application Server. Stored in Application Server.
+	// This field overrides the application-level setting.
+	ApplicationDownlinkPolicy *ApplicationDownlinkPolicy `protobuf:"bytes,55,opt,name=application_downlink_policy,json=applicationDownlinkPolicy,proto3" json:"application_downlink_policy,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{}                   `json:"-"`
+	XXX_unrecognized          []byte                     `json:"-"`
+	XXX_sizecache             int32                      `json:"-"`
 }
 
 func (m *EndDevice) Reset()         { *m = EndDevice{} }
@@ -2798,6 +2801,13 @@ func (m *EndDevice) GetLastSeenAt() *types.Timestamp {
 	return nil
 }
 
+func (m *EndDevice) GetApplicationDownlinkPolicy() *ApplicationDownlinkPolicy {
+	if m != nil {
+		return m.ApplicationDownlinkPolicy
+	}
+	return nil
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -3233,10 +3243,13 @@ type SetEndDeviceRequest struct {
 	EndDevice *EndDevice `protobuf:"bytes,1,opt,name=end_device,json=endDevice,proto3" json:"end_device,omitempty"`
 	// The names of the end device fields that should be updated.
 	// See the API reference for which fields can be set on the different services.
-	FieldMask            *types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	FieldMask *types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	// Skip the payload formatter test vectors when the formatters are updated.
+	// This is only used by the Application Server.
+	SkipFormatterTestVectors bool     `protobuf:"varint,3,opt,name=skip_formatter_test_vectors,json=skipFormatterTestVectors,proto3" json:"skip_formatter_test_vectors,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
 func (m *SetEndDeviceRequest) Reset()         { *m = SetEndDeviceRequest{} }
@@ -3277,6 +3290,13 @@ func (m *SetEndDeviceRequest) GetFieldMask() *types.FieldMask {
 	return nil
 }
 
+func (m *SetEndDeviceRequest) GetSkipFormatterTestVectors() bool {
+	if m != nil {
+		return m.SkipFormatterTestVectors
+	}
+	return false
+}
+
 type ResetAndGetEndDeviceRequest struct {
 	EndDeviceIds *EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
 	// The names of the end device fields that should be returned.
@@ -3629,381 +3649,384 @@ func init() {
 }
 
 var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 6005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x5d, 0x6c, 0x1b, 0xd9,
-	0x75, 0xbf, 0x86, 0xa2, 0x44, 0xf2, 0x90, 0x22, 0xa9, 0xab, 0xaf, 0x11, 0xe5, 0x0f, 0x99, 0xab,
-	0xf5, 0xd2, 0x8e, 0x45, 0xc9, 0xd4, 0x7a, 0x93, 0xf5, 0x26, 0xf1, 0x92, 0x92, 0x1c, 0x4b, 0xb6,
-	0xbc, 0xf2, 0x58, 0xb6, 0x93, 0xfd, 0x9a, 0x1d, 0xcd, 0x5c, 0x49, 0xb3, 0x22, 0x67, 0x98, 0x3b,
-	0x43, 0x99, 0xca, 0x66, 0x81, 0x20, 0xff, 0x7f, 0x51, 0x34, 0x40, 0xfa, 0x60, 0x14, 0x0d, 0x9a,
-	0xbe, 0x04, 0x7d, 0x28, 0x0a, 0xa3, 0x28, 0xfa, 0xd0, 0x87, 0x3e, 0x04, 0x68, 0x5f, 0x02, 0xa4,
-	0x08, 0xda, 0x26, 0xaf, 0x05, 0x0a, 0x14, 0xe8, 0x43, 0x8b, 0x00, 0xed, 0xc3, 0xbe, 0x55, 0x28,
-	0xda, 0xe2, 0xde, 0xb9, 0x77, 0x66, 0x48, 0x0e, 0xf5, 0x11, 0xef, 0x22, 0x1b, 0x20, 0x2f, 0xbb,
-	0xa3, 0x7b, 0xcf, 0xf9, 0xcd, 0x9d, 0x7b, 0xcf, 0x39, 0xf7, 0x7c, 0xd1, 0x50, 0xac, 0xdb, 0x44,
-	0x7b, 0xaa, 0x59, 0xf3, 0x8e, 0xab, 0xe9, 0xfb, 0x0b, 0x5a, 0xd3, 0x5c, 0xc0, 0x96, 0xa1, 0x1a,
-	0xf8, 0xc0, 0xd4, 0x71, 0xb9, 0x49, 0x6c, 0xd7, 0x46, 0x59, 0xd7, 0xb5, 0xca, 0x9c, 0xae, 0x7c,
-	0xb0, 0x54, 0xa8, 0xee, 0x9a, 0xee, 0x5e, 0x6b, 0xbb, 0xac, 0xdb, 0x8d, 0x05, 0x6c, 0x1d, 0xd8,
-	0x87, 0x4d, 0x62, 0xb7, 0x0f, 0x17, 0x18, 0xb1, 0x3e, 0xbf, 0x8b, 0xad, 0xf9, 0x03, 0xad, 0x6e,
-	0x1a, 0x9a, 0x8b, 0x17, 0x7a, 0x1e, 0x3c, 0xc8, 0xc2, 0x7c, 0x08, 0x62, 0xd7, 0xde, 0xb5, 0x3d,
-	0xe6, 0xed, 0xd6, 0x0e, 0xfb, 0x8b, 0xfd, 0xc1, 0x9e, 0x38, 0xf9, 0x4a, 0x88, 0x7c, 0x6b, 0x0f,
-	0x6f, 0xed, 0x99, 0xd6, 0xae, 0xb3, 0x66, 0x19, 0x2d, 0xc7, 0x25, 0x26, 0x76, 0xc2, 0xaf, 0xde,
-	0xb5, 0xe7, 0x77, 0xea, 0xda, 0xae, 0xb3, 0xa0, 0x59, 0x96, 0xed, 0x6a, 0xae, 0x69, 0x5b, 0x0e,
-	0x47, 0x59, 0x3e, 0x13, 0xca, 0x87, 0x8e, 0x6d, 0x45, 0x80, 0x5c, 0xd8, 0xb5, 0xed, 0xdd, 0x3a,
-	0x0e, 0x16, 0x6c, 0xb4, 0x08, 0x23, 0xe0, 0xf3, 0xb3, 0xdd, 0xf3, 0x3b, 0x26, 0xae, 0x1b, 0x6a,
-	0x43, 0x73, 0xf6, 0x39, 0xc5, 0xb9, 0x6e, 0x0a, 0xc7, 0x25, 0x2d, 0xdd, 0xe5, 0xb3, 0x17, 0xbb,
-	0x67, 0x5d, 0xb3, 0x81, 0x1d, 0x57, 0x6b, 0x34, 0xfb, 0x2d, 0xe0, 0x29, 0xd1, 0x9a, 0x4d, 0x4c,
-	0xc4, 0x02, 0xcf, 0x47, 0x9d, 0x68, 0xab, 0x21, 0xa6, 0x5f, 0xea, 0x9d, 0x36, 0x0d, 0x6c, 0xb9,
-	0xe6, 0x8e, 0x19, 0x60, 0x9c, 0xeb, 0x25, 0xda, 0xc7, 0x87, 0x62, 0xf6, 0x62, 0xef, 0xac, 0x90,
-	0x0e, 0xbe, 0x07, 0xbd, 0x04, 0x0d, 0xec, 0x38, 0xda, 0x2e, 0x76, 0x8e, 0xa3, 0x70, 0x35, 0x43,
-	0x73, 0xb5, 0xfe, 0x2f, 0x69, 0x9a, 0xba, 0xdb, 0x22, 0x42, 0x84, 0xca, 0xa1, 0xe3, 0xb2, 0x9b,
-	0xd8, 0xd2, 0x9a, 0xe6, 0x41, 0x65, 0xc1, 0x6e, 0xb2, 0xc3, 0xea, 0x3d, 0xb8, 0xe2, 0xf7, 0x87,
-	0x21, 0xf1, 0x10, 0x3b, 0x8e, 0x69, 0x5b, 0xe8, 0x3f, 0x62, 0x90, 0x34, 0xf0, 0x81, 0xaa, 0x19,
-	0x06, 0x91, 0x63, 0xb3, 0x52, 0x29, 0x53, 0xfb, 0x45, 0xec, 0x59, 0x75, 0x7a, 0x1d, 0x8a, 0x95,
-	0xd7, 0x16, 0x17, 0xab, 0xb5, 0xe5, 0x95, 0xe2, 0x0f, 0x63, 0x52, 0xe2, 0x4f, 0x62, 0xc3, 0x54,
-	0x36, 0xac, 0xdd, 0xa3, 0xda, 0xf0, 0xb7, 0xe2, 0x7b, 0xf1, 0xa6, 0xf4, 0xcb, 0xe7, 0xd3, 0xdf,
-	0x95, 0xe0, 0xd6, 0xae, 0x5d, 0x76, 0xf7, 0xb0, 0xcb, 0x24, 0xa8, 0x6c, 0x61, 0xf7, 0xa9, 0x4d,
-	0xf6, 0x17, 0x3a, 0x57, 0x7c, 0xb0, 0xb4, 0xd0, 0xdc, 0xdf, 0x5d, 0x70, 0x0f, 0x9b, 0xd8, 0x29,
-	0x6f, 0x68, 0xc4, 0xd9, 0xd3, 0xea, 0x77, 0x56, 0xbf, 0x5e, 0x3b, 0x74, 0xb1, 0x83, 0xce, 0x0c,
-	0xf0, 0xc8, 0x6a, 0x78, 0x10, 0xaf, 0x32, 0x80, 0x4f, 0x9e, 0x4f, 0xff, 0x48, 0x2a, 0xdc, 0x3f,
-	0x1d, 0x8a, 0xde, 0x30, 0x16, 0x5c, 0xd7, 0x9a, 0xaf, 0x3f, 0x9d, 0xd7, 0xeb, 0xe6, 0x82, 0xde,
-	0x72, 0x5c, 0xbb, 0xc1, 0x94, 0xa4, 0x7c, 0x1f, 0x3f, 0xf5, 0x00, 0x6f, 0xd7, 0xb5, 0xdd, 0xe2,
-	0x8b, 0xe3, 0x7d, 0x0d, 0xbb, 0xab, 0x6d, 0x4d, 0x77, 0x19, 0xa6, 0x92, 0x30, 0xf0, 0x41, 0xd5,
-	0x30, 0x08, 0x7a, 0x1d, 0xe2, 0x54, 0x7e, 0xe4, 0xc1, 0x59, 0xa9, 0x94, 0xae, 0xcc, 0x94, 0x3b,
-	0x0d, 0x4a, 0x99, 0x1f, 0xcb, 0x5d, 0x7c, 0xe8, 0xd4, 0x92, 0x47, 0xb5, 0xa1, 0xef, 0x49, 0xb1,
-	0xbc, 0xa4, 0x30, 0x16, 0x74, 0x09, 0x46, 0xea, 0x9a, 0xe3, 0xaa, 0x3b, 0xaa, 0x6e, 0xb9, 0x6a,
-	0xab, 0x29, 0xc7, 0x67, 0xa5, 0xd2, 0x88, 0x02, 0x74, 0xf0, 0xf6, 0xb2, 0xe5, 0x3e, 0x6a, 0xa2,
-	0x12, 0x8c, 0x32, 0x12, 0x8b, 0x13, 0x19, 0xf6, 0x53, 0x4b, 0x1e, 0x62, 0x64, 0x8c, 0xf7, 0x3e,
-	0xa5, 0x5b, 0xb1, 0x9f, 0x5a, 0x3e, 0xa5, 0x16, 0xa6, 0x1c, 0x0e, 0x28, 0xab, 0x3e, 0x65, 0x19,
-	0xc6, 0x19, 0xa5, 0x6e, 0x5b, 0x3b, 0x61, 0xe2, 0x04, 0x23, 0xce, 0xd3, 0xb9, 0x65, 0xdb, 0xda,
-	0xf1, 0xe9, 0x5f, 0x07, 0x70, 0x5c, 0x8d, 0xb8, 0xd8, 0x50, 0x35, 0x57, 0x4e, 0xb2, 0xef, 0x2c,
-	0x94, 0x3d, 0x55, 0x2d, 0x0b, 0x55, 0x2d, 0x6f, 0x09, 0x5d, 0x56, 0x52, 0x9c, 0xba, 0xea, 0x22,
-	0x0c, 0xe7, 0xbe, 0xd9, 0xc2, 0x2d, 0xca, 0xd9, 0x6c, 0xd6, 0x4d, 0x9d, 0x49, 0x2d, 0x7b, 0x5b,
-	0xdd, 0xb4, 0xf6, 0x1d, 0x39, 0x35, 0x3b, 0x58, 0x4a, 0x57, 0x5e, 0xea, 0xde, 0xb4, 0x6a, 0x40,
-	0xbc, 0xc2, 0x69, 0x95, 0x82, 0x07, 0x14, 0x31, 0xe5, 0xdc, 0x4c, 0x7e, 0xf2, 0x7c, 0x3a, 0x9e,
-	0x94, 0xf2, 0xd2, 0x3a, 0xfd, 0x6f, 0xac, 0xb8, 0x04, 0xa9, 0x9a, 0x6d, 0xd7, 0x1f, 0x6b, 0xf5,
-	0x16, 0x46, 0xe3, 0x30, 0x74, 0x40, 0x1f, 0x64, 0x69, 0x56, 0x2a, 0x25, 0x15, 0xef, 0x8f, 0x9b,
-	0xf9, 0x5f, 0x3e, 0x9f, 0x8e, 0xc9, 0xd2, 0x27, 0xcf, 0xa7, 0x87, 0x29, 0xa3, 0x2c, 0x15, 0x7f,
-	0x92, 0x83, 0x91, 0x8d, 0xea, 0xf2, 0xa6, 0x46, 0xb4, 0x06, 0x76, 0x31, 0x71, 0xd0, 0x34, 0x24,
-	0x1b, 0x5a, 0x5b, 0xc5, 0x26, 0x69, 0x32, 0xe6, 0x98, 0x92, 0x68, 0x68, 0xed, 0x55, 0x93, 0x34,
-	0xd1, 0x63, 0x18, 0xd3, 0x0c, 0xa2, 0x52, 0xa5, 0x56, 0x89, 0xe6, 0x62, 0xd5, 0xb4, 0x0c, 0xdc,
-	0x66, 0x07, 0x98, 0xad, 0x9c, 0xef, 0xfe, 0x9e, 0x15, 0xcd, 0xd5, 0x14, 0xcd, 0xc5, 0x6b, 0x94,
-	0x88, 0x89, 0xc1, 0x77, 0x99, 0x18, 0xe4, 0x35, 0x83, 0x74, 0xcc, 0xa1, 0x57, 0x01, 0x51, 0x5c,
-	0xb7, 0xad, 0x36, 0xed, 0xa7, 0x98, 0x70, 0x58, 0x76, 0xe0, 0xb5, 0xc4, 0x51, 0x2d, 0x7e, 0x35,
-	0x26, 0xe7, 0x94, 0x9c, 0x66, 0x90, 0xad, 0xf6, 0x26, 0x25, 0xf0, 0xb8, 0xae, 0x40, 0x86, 0x72,
-	0x59, 0xdb, 0xaa, 0x4b, 0x34, 0xcb, 0xf1, 0x8e, 0x3d, 0xa0, 0x07, 0xcd, 0x20, 0xf7, 0xb7, 0xb7,
-	0xe8, 0x14, 0xba, 0x0c, 0x23, 0x94, 0x54, 0xd3, 0xf7, 0xd5, 0xba, 0xd9, 0x30, 0x5d, 0xef, 0xd4,
-	0x6b, 0x31, 0x59, 0x52, 0xd2, 0x9a, 0x41, 0xaa, 0xfa, 0xfe, 0x3d, 0x3a, 0x1c, 0xa6, 0x33, 0x70,
-	0x5d, 0x3b, 0x64, 0xe7, 0xde, 0x41, 0xb7, 0x42, 0x87, 0xd1, 0x57, 0x21, 0x45, 0xda, 0xd7, 0x39,
-	0x4d, 0x8a, 0x7d, 0xfe, 0x54, 0xf7, 0xe7, 0x2b, 0x6d, 0x46, 0x1b, 0xfa, 0xf0, 0x24, 0x69, 0x5f,
-	0xf7, 0xf8, 0xbf, 0x01, 0xe3, 0x8c, 0xdf, 0xdf, 0x48, 0x7b, 0x67, 0xc7, 0xc1, 0xae, 0x0c, 0x0c,
-	0xea, 0x42, 0xbf, 0x9d, 0x7c, 0x8b, 0x51, 0x85, 0x10, 0x47, 0x29, 0x62, 0xc7, 0x24, 0x3d, 0x23,
-	0xd2, 0xae, 0xf4, 0x9c, 0x51, 0xfa, 0x8c, 0x67, 0x44, 0xda, 0x95, 0xce, 0x33, 0x2a, 0xc3, 0x08,
-	0xc5, 0xdd, 0x21, 0xf8, 0x9b, 0x2d, 0x6c, 0xe9, 0x87, 0x72, 0x66, 0x56, 0x2a, 0xc5, 0x6b, 0xa9,
-	0xa3, 0xda, 0x70, 0x25, 0x5e, 0xfa, 0xd1, 0xf7, 0x87, 0x95, 0x0c, 0x69, 0x57, 0x6e, 0x8b, 0x69,
-	0xf4, 0x10, 0xb2, 0x54, 0x8c, 0x8c, 0x96, 0x7b, 0xa8, 0xea, 0x87, 0x7a, 0x1d, 0xcb, 0x23, 0x6c,
-	0x09, 0xbd, 0x62, 0xbf, 0xbb, 0x4b, 0xf0, 0xae, 0xe6, 0x62, 0x63, 0xa5, 0xe5, 0x1e, 0x2e, 0x53,
-	0xd2, 0xd0, 0x42, 0x32, 0x0d, 0xad, 0xed, 0x8f, 0x23, 0x03, 0xa6, 0x08, 0xfe, 0xd0, 0x36, 0x2d,
-	0x95, 0x5e, 0xa2, 0x6a, 0x13, 0x13, 0xd3, 0x36, 0x4c, 0xdd, 0x74, 0x0f, 0xe5, 0x2c, 0x43, 0x2f,
-	0xf6, 0x9c, 0x02, 0x23, 0xa7, 0x6a, 0xba, 0xda, 0x6e, 0xda, 0x16, 0xb6, 0xc2, 0xdb, 0x37, 0x41,
-	0xfc, 0xd9, 0xcd, 0x00, 0x0a, 0xed, 0x82, 0xcc, 0xdf, 0xa2, 0xdb, 0x2d, 0xcb, 0xed, 0x78, 0x4d,
-	0x2e, 0xfa, 0x23, 0xbc, 0xd7, 0x2c, 0x53, 0xf2, 0x88, 0xf7, 0x4c, 0x92, 0x60, 0x3a, 0xfc, 0xa2,
-	0x37, 0x60, 0xac, 0x69, 0x5a, 0xbb, 0xaa, 0x53, 0xb7, 0xdd, 0xd0, 0xce, 0xe6, 0xd9, 0xce, 0xa6,
-	0x8f, 0x6a, 0xc9, 0xca, 0xb0, 0x3c, 0xc0, 0xf6, 0x76, 0x94, 0xd2, 0x3d, 0xac, 0xdb, 0x6e, 0xb0,
-	0xc1, 0xef, 0xc0, 0x74, 0xc0, 0xdc, 0x7d, 0xdc, 0xa3, 0xa7, 0x39, 0x6e, 0x2a, 0xd6, 0x13, 0x02,
-	0xb8, 0xf3, 0xb4, 0x5f, 0x83, 0xfc, 0x36, 0xd6, 0x74, 0xdb, 0x0a, 0x2d, 0x0b, 0xf5, 0x2e, 0x2b,
-	0xe7, 0x11, 0x05, 0x8b, 0xba, 0x0b, 0x49, 0x7d, 0x4f, 0xb3, 0x2c, 0x5c, 0x77, 0xe4, 0x31, 0x66,
-	0xe6, 0x5e, 0xee, 0x5e, 0x43, 0x87, 0xb5, 0x29, 0x2f, 0x7b, 0xd4, 0x6c, 0xb3, 0x9e, 0x49, 0xb1,
-	0xa4, 0xa4, 0xf8, 0x00, 0x68, 0x15, 0x46, 0x5b, 0x4d, 0x6a, 0xeb, 0x54, 0xe3, 0x29, 0xae, 0xd7,
-	0xd9, 0x99, 0xcb, 0xe3, 0xcc, 0x12, 0x4f, 0x77, 0xa3, 0xfa, 0x96, 0x4f, 0xc9, 0x79, 0x3c, 0x2b,
-	0x94, 0x85, 0x9e, 0x2c, 0x5a, 0x83, 0x31, 0x61, 0x7b, 0xc3, 0x40, 0x13, 0x27, 0x01, 0x8d, 0x0a,
-	0xae, 0x00, 0xea, 0x3d, 0x98, 0xec, 0xb0, 0x23, 0x2a, 0xe6, 0x87, 0x2d, 0x4f, 0x32, 0xb4, 0x52,
-	0x8f, 0x70, 0xaf, 0x28, 0xc2, 0xb8, 0x08, 0xb9, 0xf0, 0xc0, 0xc7, 0x42, 0x66, 0x47, 0xcc, 0x84,
-	0xe1, 0x99, 0x69, 0x09, 0xe0, 0xa7, 0x8e, 0x83, 0x67, 0x36, 0x25, 0x12, 0xbe, 0x63, 0x06, 0xed,
-	0xc2, 0xc5, 0xbe, 0x12, 0xa3, 0x7a, 0xb7, 0x85, 0xcc, 0xde, 0x53, 0x3c, 0x56, 0x6e, 0xbc, 0x37,
-	0x14, 0x22, 0x05, 0x87, 0xcd, 0x15, 0xfe, 0x29, 0x06, 0x09, 0x7e, 0xb0, 0x54, 0x92, 0xf8, 0x21,
-	0x06, 0x92, 0x24, 0x45, 0x48, 0x92, 0x47, 0x14, 0x48, 0xd2, 0x97, 0x00, 0xf9, 0xa7, 0x16, 0x70,
-	0xc6, 0xba, 0x8d, 0x8e, 0x7f, 0x48, 0x01, 0xe7, 0x63, 0x18, 0x6b, 0x98, 0x56, 0x8f, 0x4a, 0x0c,
-	0x9e, 0xd1, 0x02, 0x36, 0x4c, 0xab, 0x53, 0x27, 0x28, 0x2e, 0xb5, 0x68, 0x2f, 0x78, 0xfb, 0x51,
-	0x83, 0xd6, 0x81, 0xfb, 0x12, 0x8c, 0x60, 0x4b, 0xdb, 0xae, 0x63, 0xd5, 0xdb, 0x03, 0x76, 0xf1,
-	0x25, 0x95, 0x8c, 0x37, 0xf8, 0x88, 0x8d, 0x05, 0x97, 0x7d, 0xc7, 0xb5, 0x1f, 0xcb, 0x0f, 0xae,
-	0xc7, 0x93, 0x83, 0xf9, 0x78, 0xf1, 0x68, 0x08, 0xf2, 0xab, 0x96, 0xb1, 0xc2, 0xc2, 0xbc, 0xc7,
-	0x98, 0x30, 0xaf, 0xf8, 0x6b, 0x30, 0x68, 0x1a, 0x0e, 0xdb, 0xee, 0x74, 0xe5, 0x0b, 0xdd, 0x2b,
-	0xec, 0x26, 0x5f, 0x0b, 0xa2, 0x86, 0x90, 0xd3, 0x46, 0x11, 0xd0, 0x06, 0xe4, 0x38, 0xa3, 0x7a,
-	0xe0, 0x11, 0xb3, 0x93, 0xc8, 0x56, 0x0a, 0x11, 0xda, 0xcd, 0xe1, 0x42, 0xdf, 0x9c, 0xe5, 0x04,
-	0x62, 0x5d, 0x5b, 0x30, 0x26, 0xe0, 0x9a, 0x7b, 0x87, 0x3e, 0xe4, 0x60, 0x34, 0xe4, 0xe6, 0x9d,
-	0x6f, 0xf4, 0x42, 0x8e, 0x72, 0x82, 0xcd, 0xbd, 0x43, 0x81, 0xba, 0x04, 0xa3, 0xbe, 0xa0, 0xa8,
-	0xcd, 0xba, 0x66, 0xa9, 0xa6, 0xc1, 0x4e, 0x27, 0xc5, 0x9c, 0x02, 0x12, 0x93, 0xdf, 0x54, 0x72,
-	0x3e, 0xc5, 0x66, 0x5d, 0xb3, 0xd6, 0x0c, 0x34, 0x0b, 0xc3, 0xcd, 0x3d, 0xdb, 0xb5, 0x1d, 0x79,
-	0x68, 0x76, 0xb0, 0x94, 0x12, 0x76, 0x28, 0x0f, 0x0a, 0x1f, 0x47, 0x25, 0xc8, 0x3b, 0xad, 0x66,
-	0xd3, 0x26, 0xae, 0xa3, 0xea, 0x75, 0xcd, 0x71, 0xd4, 0x6d, 0xe6, 0x6a, 0x24, 0x95, 0xac, 0x18,
-	0x5f, 0xa6, 0xc3, 0xb5, 0x08, 0x4a, 0x9d, 0x39, 0x1a, 0xdd, 0x94, 0xcb, 0x68, 0x03, 0xc6, 0x0d,
-	0xbc, 0xa3, 0xb5, 0xea, 0xae, 0xda, 0xd0, 0x74, 0xd5, 0xc1, 0xae, 0x4b, 0x3d, 0x74, 0xee, 0x66,
-	0xce, 0x44, 0x6c, 0xea, 0x43, 0x4e, 0xa2, 0x20, 0xce, 0xb8, 0xa1, 0xe9, 0x62, 0x8c, 0x4a, 0x10,
-	0x95, 0xf8, 0x40, 0x4d, 0xa8, 0x4b, 0x12, 0x57, 0x32, 0x0d, 0x33, 0x64, 0x9a, 0x29, 0x91, 0xd6,
-	0x0e, 0x11, 0x01, 0x27, 0xd2, 0xda, 0x1d, 0x44, 0xfe, 0x27, 0xd0, 0x2b, 0x8b, 0xf9, 0x0d, 0x49,
-	0x25, 0x23, 0x06, 0xd7, 0x6d, 0xd3, 0x42, 0xd7, 0x00, 0x11, 0xec, 0x60, 0x4e, 0xa2, 0x5a, 0xb6,
-	0xa5, 0x63, 0x87, 0xf9, 0x03, 0x49, 0x25, 0xef, 0xcd, 0x50, 0xba, 0xfb, 0x6c, 0x1c, 0x69, 0x20,
-	0x96, 0xac, 0xee, 0xd8, 0xa4, 0xa1, 0xb9, 0xd4, 0xee, 0x33, 0x67, 0x20, 0xc2, 0xa0, 0x6d, 0x78,
-	0x51, 0xe5, 0xa6, 0x76, 0x58, 0xb7, 0x35, 0xe3, 0xb6, 0x4f, 0x1f, 0x12, 0xc8, 0x51, 0x8e, 0x16,
-	0x4c, 0x16, 0xff, 0x3b, 0x01, 0xe9, 0xea, 0x8a, 0xe2, 0xef, 0xc7, 0x9b, 0x30, 0xec, 0xd0, 0x58,
-	0x51, 0xe7, 0xa2, 0x7f, 0x39, 0xc2, 0x6e, 0x0a, 0xe2, 0xf2, 0x43, 0x46, 0xb9, 0x61, 0x1b, 0xf8,
-	0xce, 0x80, 0xc2, 0xf9, 0xd0, 0x32, 0x24, 0x8c, 0x43, 0x4b, 0x6b, 0x98, 0x3a, 0x13, 0xf4, 0x74,
-	0xe5, 0x95, 0xe3, 0x20, 0x56, 0x3c, 0x52, 0x8e, 0x21, 0x38, 0xd1, 0x6d, 0x48, 0x1a, 0xa6, 0x43,
-	0x95, 0xd8, 0xe0, 0x81, 0x52, 0xe9, 0x58, 0x14, 0x4e, 0xcb, 0x61, 0x7c, 0xde, 0xc2, 0x8f, 0x25,
-	0x80, 0x60, 0x95, 0xe8, 0x2d, 0xc8, 0x75, 0xdb, 0x20, 0xe9, 0x6c, 0x36, 0x68, 0xc4, 0xe8, 0x30,
-	0x40, 0xf3, 0x90, 0xed, 0x72, 0xbd, 0x63, 0x9d, 0xae, 0x74, 0xc6, 0x0d, 0xfb, 0xdd, 0x73, 0x90,
-	0xf4, 0x7d, 0xee, 0x41, 0x46, 0x48, 0xed, 0xf1, 0xd5, 0xb8, 0x9c, 0x2b, 0x49, 0x4a, 0xc2, 0xf2,
-	0x5c, 0xee, 0xc0, 0x4c, 0x15, 0xfe, 0x32, 0x0e, 0xe9, 0xd0, 0x0e, 0xa1, 0x25, 0x18, 0x6e, 0x68,
-	0x64, 0xd7, 0xb4, 0xf8, 0xe9, 0xcc, 0xf4, 0x44, 0x55, 0xb7, 0xeb, 0xb6, 0xc6, 0x2f, 0x32, 0x4e,
-	0x8a, 0x1e, 0x44, 0x1b, 0xf5, 0xd8, 0xa9, 0xef, 0xab, 0x5e, 0x7b, 0xfe, 0x20, 0xda, 0x9e, 0x0f,
-	0x9e, 0x01, 0xb2, 0xdb, 0x94, 0x2b, 0x80, 0xe8, 0x2a, 0xbb, 0x76, 0x33, 0xce, 0x10, 0xcf, 0xf5,
-	0x7c, 0xe6, 0xa3, 0x35, 0xcb, 0x5d, 0xaa, 0x30, 0xac, 0x50, 0x98, 0xd3, 0x30, 0xad, 0x8e, 0x30,
-	0x87, 0x62, 0x6a, 0xed, 0xa8, 0xe0, 0xe8, 0x0c, 0x98, 0x5a, 0xbb, 0x03, 0x73, 0x0d, 0xa8, 0x6d,
-	0xe8, 0x0c, 0x9d, 0x4e, 0x42, 0xe3, 0x87, 0x3c, 0x58, 0x92, 0x14, 0x68, 0x98, 0x96, 0x08, 0xad,
-	0x28, 0x94, 0xd6, 0x0e, 0xa0, 0x12, 0x67, 0x85, 0xd2, 0xda, 0xf7, 0x7b, 0x44, 0x46, 0x86, 0x4c,
-	0x58, 0x1b, 0x42, 0xb7, 0x1f, 0x88, 0xd8, 0x75, 0x56, 0xaa, 0x0d, 0x43, 0xbc, 0x61, 0x1b, 0xb8,
-	0xf8, 0xc9, 0x04, 0xa4, 0x43, 0x26, 0x12, 0x55, 0x21, 0xc7, 0x0d, 0x35, 0xf3, 0xf4, 0xec, 0x96,
-	0xcb, 0x25, 0x6d, 0xba, 0x67, 0x55, 0x2b, 0x3c, 0xd7, 0xa7, 0x8c, 0x30, 0x8e, 0xda, 0x96, 0x47,
-	0x8f, 0x9e, 0xc0, 0x44, 0xe0, 0x2a, 0x85, 0xfd, 0x7f, 0x4f, 0xe0, 0x7a, 0xfc, 0xff, 0x4d, 0xee,
-	0x0c, 0x79, 0xde, 0x3d, 0xf7, 0xc1, 0x9a, 0x1d, 0x83, 0x9e, 0xcb, 0xff, 0xee, 0x71, 0x5e, 0xfb,
-	0xe9, 0x45, 0xaf, 0x8f, 0xdb, 0xfe, 0x38, 0x3a, 0xa0, 0x88, 0x47, 0x5b, 0xc1, 0xb7, 0x31, 0xb1,
-	0xe9, 0x1e, 0xfb, 0xe6, 0x9f, 0xfb, 0xbd, 0xbd, 0xb1, 0xc6, 0x83, 0x88, 0x70, 0x60, 0xfa, 0x4c,
-	0xa0, 0x3d, 0x91, 0x82, 0x7f, 0x48, 0xba, 0x7f, 0x48, 0x43, 0xa7, 0x3b, 0xa4, 0x65, 0x71, 0x48,
-	0xaf, 0x87, 0xa3, 0x70, 0x21, 0xc2, 0xd1, 0x51, 0xb8, 0xb7, 0x88, 0x20, 0x00, 0xdf, 0xea, 0x13,
+	// 6062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x5b, 0x6c, 0x1b, 0xd9,
+	0x79, 0xbf, 0x86, 0xa2, 0x44, 0xf2, 0x23, 0x45, 0x52, 0x47, 0xb7, 0x11, 0xe5, 0x8b, 0xcc, 0xd5,
+	0x7a, 0x69, 0xc7, 0xa2, 0x64, 0x6a, 0xbd, 0xc9, 0x7a, 0xb3, 0xf1, 0x92, 0x92, 0x1c, 0x4b, 0xb6,
+	0xbc, 0xf2, 0x58, 0xb6, 0x93, 0xbd, 0xcd, 0x8e, 0x66, 0x8e, 0xa4, 0x59, 0x91, 0x33, 0xcc, 0xcc,
+	0x50, 0xa6, 0xb2, 0x59, 0x20, 0xc8, 0xff, 0x86, 0x7f, 0x80, 0xf4, 0xc1, 0x0f, 0x0d, 0x9a, 0xa7,
+	0xa0, 0x0f, 0x45, 0x61, 0x14, 0x45, 0x1f, 0xfa, 0xd0, 0x87, 0x00, 0xed, 0x4b, 0x80, 0x14, 0x41,
+	0xdb, 0x04, 0x7d, 0x2b, 0x50, 0xa0, 0x40, 0x1f, 0x5a, 0x04, 0x68, 0x1f, 0xf6, 0xad, 0x42, 0xd1,
+	0x16, 0xe7, 0x36, 0x33, 0x24, 0x87, 0xba, 0xc4, 0xbb, 0xc8, 0x06, 0xc8, 0xcb, 0xee, 0xe8, 0x9c,
+	0xef, 0xfb, 0xcd, 0x99, 0x73, 0xbe, 0xef, 0x3b, 0xdf, 0x8d, 0x86, 0x62, 0xdd, 0x76, 0xb4, 0xa7,
+	0x9a, 0x35, 0xef, 0x7a, 0x9a, 0xbe, 0xbf, 0xa0, 0x35, 0xcd, 0x05, 0x6c, 0x19, 0xaa, 0x81, 0x0f,
+	0x4c, 0x1d, 0x97, 0x9b, 0x8e, 0xed, 0xd9, 0x28, 0xeb, 0x79, 0x56, 0x99, 0xd3, 0x95, 0x0f, 0x96,
+	0x0a, 0xd5, 0x5d, 0xd3, 0xdb, 0x6b, 0x6d, 0x97, 0x75, 0xbb, 0xb1, 0x80, 0xad, 0x03, 0xfb, 0xb0,
+	0xe9, 0xd8, 0xed, 0xc3, 0x05, 0x4a, 0xac, 0xcf, 0xef, 0x62, 0x6b, 0xfe, 0x40, 0xab, 0x9b, 0x86,
+	0xe6, 0xe1, 0x85, 0x9e, 0x07, 0x06, 0x59, 0x98, 0x0f, 0x41, 0xec, 0xda, 0xbb, 0x36, 0x63, 0xde,
+	0x6e, 0xed, 0xd0, 0xbf, 0xe8, 0x1f, 0xf4, 0x89, 0x93, 0xaf, 0x84, 0xc8, 0xb7, 0xf6, 0xf0, 0xd6,
+	0x9e, 0x69, 0xed, 0xba, 0x6b, 0x96, 0xd1, 0x72, 0x3d, 0xc7, 0xc4, 0x6e, 0xf8, 0xd5, 0xbb, 0xf6,
+	0xfc, 0x4e, 0x5d, 0xdb, 0x75, 0x17, 0x34, 0xcb, 0xb2, 0x3d, 0xcd, 0x33, 0x6d, 0xcb, 0xe5, 0x28,
+	0xcb, 0x67, 0x42, 0xf9, 0xc8, 0xb5, 0xad, 0x08, 0x90, 0x0b, 0xbb, 0xb6, 0xbd, 0x5b, 0xc7, 0xc1,
+	0x82, 0x8d, 0x96, 0x43, 0x09, 0xf8, 0xfc, 0x6c, 0xf7, 0xfc, 0x8e, 0x89, 0xeb, 0x86, 0xda, 0xd0,
+	0xdc, 0x7d, 0x4e, 0x71, 0xae, 0x9b, 0xc2, 0xf5, 0x9c, 0x96, 0xee, 0xf1, 0xd9, 0x8b, 0xdd, 0xb3,
+	0x9e, 0xd9, 0xc0, 0xae, 0xa7, 0x35, 0x9a, 0xfd, 0x16, 0xf0, 0xd4, 0xd1, 0x9a, 0x4d, 0xec, 0x88,
+	0x05, 0x9e, 0x8f, 0x3a, 0xd1, 0x56, 0x43, 0x4c, 0xbf, 0xd4, 0x3b, 0x6d, 0x1a, 0xd8, 0xf2, 0xcc,
+	0x1d, 0x33, 0xc0, 0x38, 0xd7, 0x4b, 0xb4, 0x8f, 0x0f, 0xc5, 0xec, 0xc5, 0xde, 0x59, 0x21, 0x1d,
+	0x7c, 0x0f, 0x7a, 0x09, 0x1a, 0xd8, 0x75, 0xb5, 0x5d, 0xec, 0x1e, 0x47, 0xe1, 0x69, 0x86, 0xe6,
+	0x69, 0xfd, 0x5f, 0xd2, 0x34, 0x75, 0xaf, 0xe5, 0x08, 0x11, 0x2a, 0x87, 0x8e, 0xcb, 0x6e, 0x62,
+	0x4b, 0x6b, 0x9a, 0x07, 0x95, 0x05, 0xbb, 0x49, 0x0f, 0xab, 0xf7, 0xe0, 0x8a, 0x3f, 0x18, 0x86,
+	0xc4, 0x43, 0xec, 0xba, 0xa6, 0x6d, 0xa1, 0x7f, 0x8b, 0x41, 0xd2, 0xc0, 0x07, 0xaa, 0x66, 0x18,
+	0x8e, 0x1c, 0x9b, 0x95, 0x4a, 0x99, 0xda, 0x2f, 0x63, 0xcf, 0xaa, 0xd3, 0xeb, 0x50, 0xac, 0xbc,
+	0xb6, 0xb8, 0x58, 0xad, 0x2d, 0xaf, 0x14, 0x7f, 0x14, 0x93, 0x12, 0x7f, 0x18, 0x1b, 0x26, 0xb2,
+	0x61, 0xed, 0x1e, 0xd5, 0x86, 0xbf, 0x1d, 0xdf, 0x8b, 0x37, 0xa5, 0x5f, 0x3d, 0x9f, 0xfe, 0x9e,
+	0x04, 0xb7, 0x76, 0xed, 0xb2, 0xb7, 0x87, 0x3d, 0x2a, 0x41, 0x65, 0x0b, 0x7b, 0x4f, 0x6d, 0x67,
+	0x7f, 0xa1, 0x73, 0xc5, 0x07, 0x4b, 0x0b, 0xcd, 0xfd, 0xdd, 0x05, 0xef, 0xb0, 0x89, 0xdd, 0xf2,
+	0x86, 0xe6, 0xb8, 0x7b, 0x5a, 0xfd, 0xce, 0xea, 0x37, 0x6a, 0x87, 0x1e, 0x76, 0xd1, 0x99, 0x01,
+	0x1e, 0x59, 0x0d, 0x06, 0xf1, 0x2a, 0x05, 0xf8, 0xf4, 0xf9, 0xf4, 0x8f, 0xa5, 0xc2, 0xfd, 0xd3,
+	0xa1, 0xe8, 0x0d, 0x63, 0xc1, 0xf3, 0xac, 0xf9, 0xfa, 0xd3, 0x79, 0xbd, 0x6e, 0x2e, 0xe8, 0x2d,
+	0xd7, 0xb3, 0x1b, 0x54, 0x49, 0xca, 0xf7, 0xf1, 0x53, 0x06, 0x78, 0xbb, 0xae, 0xed, 0x16, 0x5f,
+	0x1c, 0xef, 0xeb, 0xd8, 0x5b, 0x6d, 0x6b, 0xba, 0x47, 0x31, 0x95, 0x84, 0x81, 0x0f, 0xaa, 0x86,
+	0xe1, 0xa0, 0xd7, 0x21, 0x4e, 0xe4, 0x47, 0x1e, 0x9c, 0x95, 0x4a, 0xe9, 0xca, 0x4c, 0xb9, 0xd3,
+	0xa0, 0x94, 0xf9, 0xb1, 0xdc, 0xc5, 0x87, 0x6e, 0x2d, 0x79, 0x54, 0x1b, 0xfa, 0xbe, 0x14, 0xcb,
+	0x4b, 0x0a, 0x65, 0x41, 0x97, 0x60, 0xa4, 0xae, 0xb9, 0x9e, 0xba, 0xa3, 0xea, 0x96, 0xa7, 0xb6,
+	0x9a, 0x72, 0x7c, 0x56, 0x2a, 0x8d, 0x28, 0x40, 0x06, 0x6f, 0x2f, 0x5b, 0xde, 0xa3, 0x26, 0x2a,
+	0xc1, 0x28, 0x25, 0xb1, 0x38, 0x91, 0x61, 0x3f, 0xb5, 0xe4, 0x21, 0x4a, 0x46, 0x79, 0xef, 0x13,
+	0xba, 0x15, 0xfb, 0xa9, 0xe5, 0x53, 0x6a, 0x61, 0xca, 0xe1, 0x80, 0xb2, 0xea, 0x53, 0x96, 0x61,
+	0x9c, 0x52, 0xea, 0xb6, 0xb5, 0x13, 0x26, 0x4e, 0x50, 0xe2, 0x3c, 0x99, 0x5b, 0xb6, 0xad, 0x1d,
+	0x9f, 0xfe, 0x75, 0x00, 0xd7, 0xd3, 0x1c, 0x0f, 0x1b, 0xaa, 0xe6, 0xc9, 0x49, 0xfa, 0x9d, 0x85,
+	0x32, 0x53, 0xd5, 0xb2, 0x50, 0xd5, 0xf2, 0x96, 0xd0, 0x65, 0x25, 0xc5, 0xa9, 0xab, 0x1e, 0xc2,
+	0x70, 0xee, 0x5b, 0x2d, 0xdc, 0x22, 0x9c, 0xcd, 0x66, 0xdd, 0xd4, 0xa9, 0xd4, 0xd2, 0xb7, 0xd5,
+	0x4d, 0x6b, 0xdf, 0x95, 0x53, 0xb3, 0x83, 0xa5, 0x74, 0xe5, 0xa5, 0xee, 0x4d, 0xab, 0x06, 0xc4,
+	0x2b, 0x9c, 0x56, 0x29, 0x30, 0xa0, 0x88, 0x29, 0xf7, 0x66, 0xf2, 0xd3, 0xe7, 0xd3, 0xf1, 0xa4,
+	0x94, 0x97, 0xd6, 0xc9, 0x7f, 0x63, 0xc5, 0x25, 0x48, 0xd5, 0x6c, 0xbb, 0xfe, 0x58, 0xab, 0xb7,
+	0x30, 0x1a, 0x87, 0xa1, 0x03, 0xf2, 0x20, 0x4b, 0xb3, 0x52, 0x29, 0xa9, 0xb0, 0x3f, 0x6e, 0xe6,
+	0x7f, 0xf5, 0x7c, 0x3a, 0x26, 0x4b, 0x9f, 0x3e, 0x9f, 0x1e, 0x26, 0x8c, 0xb2, 0x54, 0xfc, 0x69,
+	0x0e, 0x46, 0x36, 0xaa, 0xcb, 0x9b, 0x9a, 0xa3, 0x35, 0xb0, 0x87, 0x1d, 0x17, 0x4d, 0x43, 0xb2,
+	0xa1, 0xb5, 0x55, 0x6c, 0x3a, 0x4d, 0xca, 0x1c, 0x53, 0x12, 0x0d, 0xad, 0xbd, 0x6a, 0x3a, 0x4d,
+	0xf4, 0x18, 0xc6, 0x34, 0xc3, 0x51, 0x89, 0x52, 0xab, 0x8e, 0xe6, 0x61, 0xd5, 0xb4, 0x0c, 0xdc,
+	0xa6, 0x07, 0x98, 0xad, 0x9c, 0xef, 0xfe, 0x9e, 0x15, 0xcd, 0xd3, 0x14, 0xcd, 0xc3, 0x6b, 0x84,
+	0x88, 0x8a, 0xc1, 0xf7, 0xa8, 0x18, 0xe4, 0x35, 0xc3, 0xe9, 0x98, 0x43, 0xaf, 0x02, 0x22, 0xb8,
+	0x5e, 0x5b, 0x6d, 0xda, 0x4f, 0xb1, 0xc3, 0x61, 0xe9, 0x81, 0xd7, 0x12, 0x47, 0xb5, 0xf8, 0xd5,
+	0x98, 0x9c, 0x53, 0x72, 0x9a, 0xe1, 0x6c, 0xb5, 0x37, 0x09, 0x01, 0xe3, 0xba, 0x02, 0x19, 0xc2,
+	0x65, 0x6d, 0xab, 0x9e, 0xa3, 0x59, 0x2e, 0x3b, 0xf6, 0x80, 0x1e, 0x34, 0xc3, 0xb9, 0xbf, 0xbd,
+	0x45, 0xa6, 0xd0, 0x65, 0x18, 0x21, 0xa4, 0x9a, 0xbe, 0xaf, 0xd6, 0xcd, 0x86, 0xe9, 0xb1, 0x53,
+	0xaf, 0xc5, 0x64, 0x49, 0x49, 0x6b, 0x86, 0x53, 0xd5, 0xf7, 0xef, 0x91, 0xe1, 0x30, 0x9d, 0x81,
+	0xeb, 0xda, 0x21, 0x3d, 0xf7, 0x0e, 0xba, 0x15, 0x32, 0x8c, 0xbe, 0x06, 0x29, 0xa7, 0x7d, 0x9d,
+	0xd3, 0xa4, 0xe8, 0xe7, 0x4f, 0x75, 0x7f, 0xbe, 0xd2, 0xa6, 0xb4, 0xa1, 0x0f, 0x4f, 0x3a, 0xed,
+	0xeb, 0x8c, 0xff, 0x9b, 0x30, 0x4e, 0xf9, 0xfd, 0x8d, 0xb4, 0x77, 0x76, 0x5c, 0xec, 0xc9, 0x40,
+	0xa1, 0x2e, 0xf4, 0xdb, 0xc9, 0xb7, 0x29, 0x55, 0x08, 0x71, 0x94, 0x20, 0x76, 0x4c, 0x92, 0x33,
+	0x72, 0xda, 0x95, 0x9e, 0x33, 0x4a, 0x9f, 0xf1, 0x8c, 0x9c, 0x76, 0xa5, 0xf3, 0x8c, 0xca, 0x30,
+	0x42, 0x70, 0x77, 0x1c, 0xfc, 0xad, 0x16, 0xb6, 0xf4, 0x43, 0x39, 0x33, 0x2b, 0x95, 0xe2, 0xb5,
+	0xd4, 0x51, 0x6d, 0xb8, 0x12, 0x2f, 0xfd, 0xf8, 0x07, 0xc3, 0x4a, 0xc6, 0x69, 0x57, 0x6e, 0x8b,
+	0x69, 0xf4, 0x10, 0xb2, 0x44, 0x8c, 0x8c, 0x96, 0x77, 0xa8, 0xea, 0x87, 0x7a, 0x1d, 0xcb, 0x23,
+	0x74, 0x09, 0xbd, 0x62, 0xbf, 0xbb, 0xeb, 0xe0, 0x5d, 0xcd, 0xc3, 0xc6, 0x4a, 0xcb, 0x3b, 0x5c,
+	0x26, 0xa4, 0xa1, 0x85, 0x64, 0x1a, 0x5a, 0xdb, 0x1f, 0x47, 0x06, 0x4c, 0x39, 0xf8, 0x23, 0xdb,
+	0xb4, 0x54, 0x72, 0x89, 0xaa, 0x4d, 0xec, 0x98, 0xb6, 0x61, 0xea, 0xa6, 0x77, 0x28, 0x67, 0x29,
+	0x7a, 0xb1, 0xe7, 0x14, 0x28, 0x39, 0x51, 0xd3, 0xd5, 0x76, 0xd3, 0xb6, 0xb0, 0x15, 0xde, 0xbe,
+	0x09, 0xc7, 0x9f, 0xdd, 0x0c, 0xa0, 0xd0, 0x2e, 0xc8, 0xfc, 0x2d, 0xba, 0xdd, 0xb2, 0xbc, 0x8e,
+	0xd7, 0xe4, 0xa2, 0x3f, 0x82, 0xbd, 0x66, 0x99, 0x90, 0x47, 0xbc, 0x67, 0xd2, 0x09, 0xa6, 0xc3,
+	0x2f, 0x7a, 0x03, 0xc6, 0x9a, 0xa6, 0xb5, 0xab, 0xba, 0x75, 0xdb, 0x0b, 0xed, 0x6c, 0x9e, 0xee,
+	0x6c, 0xfa, 0xa8, 0x96, 0xac, 0x0c, 0xcb, 0x03, 0x74, 0x6f, 0x47, 0x09, 0xdd, 0xc3, 0xba, 0xed,
+	0x05, 0x1b, 0xfc, 0x2e, 0x4c, 0x07, 0xcc, 0xdd, 0xc7, 0x3d, 0x7a, 0x9a, 0xe3, 0x26, 0x62, 0x3d,
+	0x21, 0x80, 0x3b, 0x4f, 0xfb, 0x35, 0xc8, 0x6f, 0x63, 0x4d, 0xb7, 0xad, 0xd0, 0xb2, 0x50, 0xef,
+	0xb2, 0x72, 0x8c, 0x28, 0x58, 0xd4, 0x5d, 0x48, 0xea, 0x7b, 0x9a, 0x65, 0xe1, 0xba, 0x2b, 0x8f,
+	0x51, 0x33, 0xf7, 0x72, 0xf7, 0x1a, 0x3a, 0xac, 0x4d, 0x79, 0x99, 0x51, 0xd3, 0xcd, 0x7a, 0x26,
+	0xc5, 0x92, 0x92, 0xe2, 0x03, 0xa0, 0x55, 0x18, 0x6d, 0x35, 0x89, 0xad, 0x53, 0x8d, 0xa7, 0xb8,
+	0x5e, 0xa7, 0x67, 0x2e, 0x8f, 0x53, 0x4b, 0x3c, 0xdd, 0x8d, 0xea, 0x5b, 0x3e, 0x25, 0xc7, 0x78,
+	0x56, 0x08, 0x0b, 0x39, 0x59, 0xb4, 0x06, 0x63, 0xc2, 0xf6, 0x86, 0x81, 0x26, 0x4e, 0x02, 0x1a,
+	0x15, 0x5c, 0x01, 0xd4, 0xfb, 0x30, 0xd9, 0x61, 0x47, 0x54, 0xcc, 0x0f, 0x5b, 0x9e, 0xa4, 0x68,
+	0xa5, 0x1e, 0xe1, 0x5e, 0x51, 0x84, 0x71, 0x11, 0x72, 0xc1, 0xc0, 0xc7, 0x42, 0x66, 0x47, 0xcc,
+	0x84, 0xe1, 0xa9, 0x69, 0x09, 0xe0, 0xa7, 0x8e, 0x83, 0xa7, 0x36, 0x25, 0x12, 0xbe, 0x63, 0x06,
+	0xed, 0xc2, 0xc5, 0xbe, 0x12, 0xa3, 0xb2, 0xdb, 0x42, 0xa6, 0xef, 0x29, 0x1e, 0x2b, 0x37, 0xec,
+	0x0d, 0x85, 0x48, 0xc1, 0xa1, 0x73, 0x85, 0x7f, 0x88, 0x41, 0x82, 0x1f, 0x2c, 0x91, 0x24, 0x7e,
+	0x88, 0x81, 0x24, 0x49, 0x11, 0x92, 0xc4, 0x88, 0x02, 0x49, 0xfa, 0x0a, 0x20, 0xff, 0xd4, 0x02,
+	0xce, 0x58, 0xb7, 0xd1, 0xf1, 0x0f, 0x29, 0xe0, 0x7c, 0x0c, 0x63, 0x0d, 0xd3, 0xea, 0x51, 0x89,
+	0xc1, 0x33, 0x5a, 0xc0, 0x86, 0x69, 0x75, 0xea, 0x04, 0xc1, 0x25, 0x16, 0xed, 0x05, 0x6f, 0x3f,
+	0x62, 0xd0, 0x3a, 0x70, 0x5f, 0x82, 0x11, 0x6c, 0x69, 0xdb, 0x75, 0xac, 0xb2, 0x3d, 0xa0, 0x17,
+	0x5f, 0x52, 0xc9, 0xb0, 0xc1, 0x47, 0x74, 0x2c, 0xb8, 0xec, 0x3b, 0xae, 0xfd, 0x58, 0x7e, 0x70,
+	0x3d, 0x9e, 0x1c, 0xcc, 0xc7, 0x8b, 0x47, 0x43, 0x90, 0x5f, 0xb5, 0x8c, 0x15, 0x1a, 0xe6, 0x3d,
+	0xc6, 0x0e, 0xf5, 0x8a, 0xbf, 0x0e, 0x83, 0xa6, 0xe1, 0xd2, 0xed, 0x4e, 0x57, 0xbe, 0xd4, 0xbd,
+	0xc2, 0x6e, 0xf2, 0xb5, 0x20, 0x6a, 0x08, 0x39, 0x6d, 0x04, 0x01, 0x6d, 0x40, 0x8e, 0x33, 0xaa,
+	0x07, 0x8c, 0x98, 0x9e, 0x44, 0xb6, 0x52, 0x88, 0xd0, 0x6e, 0x0e, 0x17, 0xfa, 0xe6, 0x2c, 0x27,
+	0x10, 0xeb, 0xda, 0x82, 0x31, 0x01, 0xd7, 0xdc, 0x3b, 0xf4, 0x21, 0x07, 0xa3, 0x21, 0x37, 0xef,
+	0x7c, 0xb3, 0x17, 0x72, 0x94, 0x13, 0x6c, 0xee, 0x1d, 0x0a, 0xd4, 0x25, 0x18, 0xf5, 0x05, 0x45,
+	0x6d, 0xd6, 0x35, 0x4b, 0x35, 0x0d, 0x7a, 0x3a, 0x29, 0xea, 0x14, 0x38, 0x31, 0xf9, 0x2d, 0x25,
+	0xe7, 0x53, 0x6c, 0xd6, 0x35, 0x6b, 0xcd, 0x40, 0xb3, 0x30, 0xdc, 0xdc, 0xb3, 0x3d, 0xdb, 0x95,
+	0x87, 0x66, 0x07, 0x4b, 0x29, 0x61, 0x87, 0xf2, 0xa0, 0xf0, 0x71, 0x54, 0x82, 0xbc, 0xdb, 0x6a,
+	0x36, 0x6d, 0xc7, 0x73, 0x55, 0xbd, 0xae, 0xb9, 0xae, 0xba, 0x4d, 0x5d, 0x8d, 0xa4, 0x92, 0x15,
+	0xe3, 0xcb, 0x64, 0xb8, 0x16, 0x41, 0xa9, 0x53, 0x47, 0xa3, 0x9b, 0x72, 0x19, 0x6d, 0xc0, 0xb8,
+	0x81, 0x77, 0xb4, 0x56, 0xdd, 0x53, 0x1b, 0x9a, 0xae, 0xba, 0xd8, 0xf3, 0x88, 0x87, 0xce, 0xdd,
+	0xcc, 0x99, 0x88, 0x4d, 0x7d, 0xc8, 0x49, 0x14, 0xc4, 0x19, 0x37, 0x34, 0x5d, 0x8c, 0x11, 0x09,
+	0x22, 0x12, 0x1f, 0xa8, 0x09, 0x71, 0x49, 0xe2, 0x4a, 0xa6, 0x61, 0x86, 0x4c, 0x33, 0x21, 0xd2,
+	0xda, 0x21, 0x22, 0xe0, 0x44, 0x5a, 0xbb, 0x83, 0xc8, 0xff, 0x04, 0x72, 0x65, 0x51, 0xbf, 0x21,
+	0xa9, 0x64, 0xc4, 0xe0, 0xba, 0x6d, 0x5a, 0xe8, 0x1a, 0x20, 0x07, 0xbb, 0x98, 0x93, 0xa8, 0x96,
+	0x6d, 0xe9, 0xd8, 0xa5, 0xfe, 0x40, 0x52, 0xc9, 0xb3, 0x19, 0x42, 0x77, 0x9f, 0x8e, 0x23, 0x0d,
+	0xc4, 0x92, 0xd5, 0x1d, 0xdb, 0x69, 0x68, 0x1e, 0xb1, 0xfb, 0xd4, 0x19, 0x88, 0x30, 0x68, 0x1b,
+	0x2c, 0xaa, 0xdc, 0xd4, 0x0e, 0xeb, 0xb6, 0x66, 0xdc, 0xf6, 0xe9, 0x43, 0x02, 0x39, 0xca, 0xd1,
+	0x82, 0xc9, 0xe2, 0x7f, 0x26, 0x20, 0x5d, 0x5d, 0x51, 0xfc, 0xfd, 0x78, 0x0b, 0x86, 0x5d, 0x12,
+	0x2b, 0xea, 0x5c, 0xf4, 0x2f, 0x47, 0xd8, 0x4d, 0x41, 0x5c, 0x7e, 0x48, 0x29, 0x37, 0x6c, 0x03,
+	0xdf, 0x19, 0x50, 0x38, 0x1f, 0x5a, 0x86, 0x84, 0x71, 0x68, 0x69, 0x0d, 0x53, 0xa7, 0x82, 0x9e,
+	0xae, 0xbc, 0x72, 0x1c, 0xc4, 0x0a, 0x23, 0xe5, 0x18, 0x82, 0x13, 0xdd, 0x86, 0xa4, 0x61, 0xba,
+	0x44, 0x89, 0x0d, 0x1e, 0x28, 0x95, 0x8e, 0x45, 0xe1, 0xb4, 0x1c, 0xc6, 0xe7, 0x2d, 0xfc, 0x44,
+	0x02, 0x08, 0x56, 0x89, 0xde, 0x86, 0x5c, 0xb7, 0x0d, 0x92, 0xce, 0x66, 0x83, 0x46, 0x8c, 0x0e,
+	0x03, 0x34, 0x0f, 0xd9, 0x2e, 0xd7, 0x3b, 0xd6, 0xe9, 0x4a, 0x67, 0xbc, 0xb0, 0xdf, 0x3d, 0x07,
+	0x49, 0xdf, 0xe7, 0x1e, 0xa4, 0x84, 0xc4, 0x1e, 0x5f, 0x8d, 0xcb, 0xb9, 0x92, 0xa4, 0x24, 0x2c,
+	0xe6, 0x72, 0x07, 0x66, 0xaa, 0xf0, 0x67, 0x71, 0x48, 0x87, 0x76, 0x08, 0x2d, 0xc1, 0x70, 0x43,
+	0x73, 0x76, 0x4d, 0x8b, 0x9f, 0xce, 0x4c, 0x4f, 0x54, 0x75, 0xbb, 0x6e, 0x6b, 0xfc, 0x22, 0xe3,
+	0xa4, 0xe8, 0x41, 0xb4, 0x51, 0x8f, 0x9d, 0xfa, 0xbe, 0xea, 0xb5, 0xe7, 0x0f, 0xa2, 0xed, 0xf9,
+	0xe0, 0x19, 0x20, 0xbb, 0x4d, 0xb9, 0x02, 0x88, 0xac, 0xb2, 0x6b, 0x37, 0xe3, 0x14, 0xf1, 0x5c,
+	0xcf, 0x67, 0x3e, 0x5a, 0xb3, 0xbc, 0xa5, 0x0a, 0xc5, 0x0a, 0x85, 0x39, 0x0d, 0xd3, 0xea, 0x08,
+	0x73, 0x08, 0xa6, 0xd6, 0x8e, 0x0a, 0x8e, 0xce, 0x80, 0xa9, 0xb5, 0x3b, 0x30, 0xd7, 0x80, 0xd8,
+	0x86, 0xce, 0xd0, 0xe9, 0x24, 0x34, 0x7e, 0xc8, 0x83, 0x25, 0x49, 0x81, 0x86, 0x69, 0x89, 0xd0,
+	0x8a, 0x40, 0x69, 0xed, 0x00, 0x2a, 0x71, 0x56, 0x28, 0xad, 0x7d, 0xbf, 0x47, 0x64, 0x64, 0xc8,
+	0x84, 0xb5, 0x21, 0x74, 0xfb, 0x81, 0x88, 0x5d, 0x67, 0xa5, 0xda, 0x30, 0xc4, 0x1b, 0xb6, 0x81,
+	0x8b, 0x9f, 0x4e, 0x40, 0x3a, 0x64, 0x22, 0x51, 0x15, 0x72, 0xdc, 0x50, 0x53, 0x4f, 0xcf, 0x6e,
+	0x79, 0x5c, 0xd2, 0xa6, 0x7b, 0x56, 0xb5, 0xc2, 0x73, 0x7d, 0xca, 0x08, 0xe5, 0xa8, 0x6d, 0x31,
+	0x7a, 0xf4, 0x04, 0x26, 0x02, 0x57, 0x29, 0xec, 0xff, 0x33, 0x81, 0xeb, 0xf1, 0xff, 0x37, 0xb9,
+	0x33, 0xc4, 0xbc, 0x7b, 0xee, 0x83, 0x35, 0x3b, 0x06, 0x99, 0xcb, 0xff, 0xde, 0x71, 0x5e, 0xfb,
+	0xe9, 0x45, 0xaf, 0x8f, 0xdb, 0xfe, 0x38, 0x3a, 0xa0, 0x88, 0x47, 0x5b, 0xc1, 0x77, 0xb0, 0x63,
+	0x93, 0x3d, 0xf6, 0xcd, 0x3f, 0xf7, 0x7b, 0x7b, 0x63, 0x8d, 0x07, 0x11, 0xe1, 0xc0, 0xf4, 0x99,
+	0x40, 0x7b, 0x22, 0x05, 0xff, 0x90, 0x74, 0xff, 0x90, 0x86, 0x4e, 0x77, 0x48, 0xcb, 0xe2, 0x90,
+	0x5e, 0x0f, 0x47, 0xe1, 0x42, 0x84, 0xa3, 0xa3, 0x70, 0xb6, 0x88, 0x20, 0x00, 0xdf, 0xea, 0x13,
 	0x80, 0x27, 0xa2, 0x8f, 0xb7, 0x33, 0xc6, 0xe6, 0xdb, 0xd4, 0x1b, 0x7b, 0x3f, 0x88, 0x8e, 0xbd,
-	0x93, 0xa7, 0xb7, 0x28, 0x3d, 0x61, 0xf7, 0x72, 0x77, 0xd8, 0x9d, 0x62, 0x60, 0x3d, 0x29, 0x82,
-	0xae, 0xed, 0xee, 0x8c, 0xc5, 0x6f, 0x43, 0x61, 0x47, 0xd3, 0x5d, 0x9b, 0x1c, 0xaa, 0x4d, 0x76,
-	0x3f, 0xfb, 0x78, 0x26, 0x76, 0x64, 0x98, 0x1d, 0x2c, 0xc5, 0x7d, 0xc7, 0xe7, 0x03, 0x45, 0xe6,
-	0xb4, 0x9b, 0x8c, 0xf4, 0x76, 0x40, 0x89, 0xee, 0xf7, 0xc4, 0xf4, 0xe9, 0x3e, 0xd7, 0x5a, 0x6f,
-	0x4c, 0xcf, 0xd7, 0xd5, 0x11, 0xce, 0xdf, 0x85, 0x09, 0xdf, 0xdb, 0x58, 0xaa, 0xa8, 0xdb, 0x26,
-	0xcf, 0x0a, 0x32, 0x5f, 0xe2, 0xf8, 0xd8, 0x4c, 0xf0, 0x2d, 0x55, 0x6a, 0x26, 0x4b, 0x1b, 0xa2,
-	0xd7, 0x20, 0xd1, 0x72, 0xb0, 0xaa, 0x19, 0x84, 0x3b, 0x17, 0xfd, 0xd9, 0x59, 0xe4, 0x3b, 0xdc,
-	0x72, 0x70, 0xd5, 0x20, 0xe8, 0xab, 0x00, 0x34, 0xe8, 0xe2, 0x57, 0x52, 0xf6, 0xc4, 0x2b, 0x89,
-	0x31, 0xa7, 0x34, 0x83, 0x6c, 0x78, 0x37, 0xd3, 0x1b, 0x90, 0xe1, 0xde, 0x90, 0xb7, 0xf6, 0xdc,
-	0x49, 0x6b, 0x07, 0x8f, 0x9c, 0x2d, 0xfa, 0x01, 0x4c, 0x51, 0x8f, 0xa3, 0xe5, 0xf4, 0x26, 0x34,
-	0xf2, 0x27, 0x69, 0xc3, 0x84, 0xc7, 0xd9, 0x9d, 0xbd, 0x78, 0x0c, 0x32, 0x87, 0xec, 0xcd, 0x5e,
-	0x8c, 0x9e, 0x6c, 0x9c, 0x95, 0x49, 0x8f, 0xbb, 0x27, 0x59, 0x71, 0x07, 0x46, 0x0d, 0xec, 0x98,
-	0x04, 0x1b, 0x6a, 0xa0, 0x75, 0xe8, 0x14, 0x5a, 0x97, 0xe3, 0x6c, 0x8a, 0x50, 0x3e, 0x1d, 0xce,
-	0x75, 0x20, 0x75, 0x2b, 0xe1, 0xd8, 0xe9, 0x95, 0x50, 0x0e, 0x61, 0x77, 0xea, 0xe2, 0x07, 0x30,
+	0x93, 0xa7, 0xb7, 0x28, 0x3d, 0x61, 0xf7, 0x72, 0x77, 0xd8, 0x9d, 0xa2, 0x60, 0x3d, 0x29, 0x82,
+	0xae, 0xed, 0xee, 0x8c, 0xc5, 0x6f, 0x43, 0x61, 0x47, 0xd3, 0x3d, 0xdb, 0x39, 0x54, 0x9b, 0xf4,
+	0x7e, 0xf6, 0xf1, 0x4c, 0xec, 0xca, 0x30, 0x3b, 0x58, 0x8a, 0xfb, 0x8e, 0xcf, 0x87, 0x8a, 0xcc,
+	0x69, 0x37, 0x29, 0xe9, 0xed, 0x80, 0x12, 0xdd, 0xef, 0x89, 0xe9, 0xd3, 0x7d, 0xae, 0xb5, 0xde,
+	0x98, 0x9e, 0xaf, 0xab, 0x23, 0x9c, 0xbf, 0x0b, 0x13, 0xbe, 0xb7, 0xb1, 0x54, 0x51, 0xb7, 0x4d,
+	0x9e, 0x15, 0xa4, 0xbe, 0xc4, 0xf1, 0xb1, 0x99, 0xe0, 0x5b, 0xaa, 0xd4, 0x4c, 0x9a, 0x36, 0x44,
+	0xaf, 0x41, 0xa2, 0xe5, 0x62, 0x55, 0x33, 0x1c, 0xee, 0x5c, 0xf4, 0x67, 0xa7, 0x91, 0xef, 0x70,
+	0xcb, 0xc5, 0x55, 0xc3, 0x41, 0x5f, 0x03, 0x20, 0x41, 0x17, 0xbf, 0x92, 0xb2, 0x27, 0x5e, 0x49,
+	0x94, 0x39, 0xa5, 0x19, 0xce, 0x06, 0xbb, 0x99, 0xde, 0x80, 0x0c, 0xf7, 0x86, 0xd8, 0xda, 0x73,
+	0x27, 0xad, 0x1d, 0x18, 0x39, 0x5d, 0xf4, 0x03, 0x98, 0x22, 0x1e, 0x47, 0xcb, 0xed, 0x4d, 0x68,
+	0xe4, 0x4f, 0xd2, 0x86, 0x09, 0xc6, 0xd9, 0x9d, 0xbd, 0x78, 0x0c, 0x32, 0x87, 0xec, 0xcd, 0x5e,
+	0x8c, 0x9e, 0x6c, 0x9c, 0x95, 0x49, 0xc6, 0xdd, 0x93, 0xac, 0xb8, 0x03, 0xa3, 0x06, 0x76, 0x4d,
+	0x07, 0x1b, 0x6a, 0xa0, 0x75, 0xe8, 0x14, 0x5a, 0x97, 0xe3, 0x6c, 0x8a, 0x50, 0x3e, 0x1d, 0xce,
+	0x75, 0x20, 0x75, 0x2b, 0xe1, 0xd8, 0xe9, 0x95, 0x50, 0x0e, 0x61, 0x77, 0xea, 0xe2, 0x87, 0x30,
 	0x13, 0xbc, 0xa4, 0x57, 0x27, 0xc7, 0x4f, 0xad, 0x93, 0x53, 0xfe, 0x2b, 0x2a, 0xdd, 0x97, 0xfd,
-	0x44, 0xf8, 0x0d, 0x81, 0x8a, 0x4e, 0x9c, 0x4a, 0x45, 0xc7, 0x02, 0xdc, 0x40, 0x53, 0xdf, 0x83,
+	0x44, 0xf8, 0x0d, 0x81, 0x8a, 0x4e, 0x9c, 0x4a, 0x45, 0xc7, 0x02, 0xdc, 0x40, 0x53, 0xdf, 0x87,
 	0x49, 0x81, 0xd9, 0xa5, 0x69, 0x93, 0x67, 0xd4, 0x34, 0x01, 0xbf, 0x11, 0x56, 0xb8, 0x3a, 0x5c,
 	0x10, 0xf0, 0x7d, 0xf2, 0x18, 0x53, 0x67, 0xcc, 0x63, 0x14, 0x38, 0x5e, 0x35, 0x22, 0x9d, 0x11,
 	0xf1, 0xb6, 0xae, 0xb4, 0x86, 0x7c, 0xc6, 0xb4, 0x46, 0xe7, 0xdb, 0x3a, 0xb3, 0x1b, 0xfb, 0x70,
 	0x49, 0xbc, 0xad, 0xff, 0x0d, 0x3b, 0x73, 0xea, 0x63, 0x17, 0x22, 0xba, 0x19, 0x79, 0xd1, 0xe2,
-	0x40, 0xba, 0xa2, 0x2e, 0xdc, 0x73, 0x67, 0xba, 0x1b, 0xe5, 0xae, 0x57, 0x05, 0xe2, 0xf0, 0x01,
+	0x40, 0xba, 0xa2, 0x2e, 0xdc, 0x73, 0x67, 0xba, 0x1b, 0xe5, 0xae, 0x57, 0x05, 0xe2, 0xf0, 0x21,
 	0x88, 0x39, 0xb5, 0xe7, 0xfe, 0x3d, 0x7f, 0xa6, 0x77, 0x08, 0xb1, 0xaa, 0x75, 0x5d, 0xc3, 0x6b,
-	0x90, 0x0f, 0x0b, 0x1c, 0xcb, 0xfa, 0x5f, 0x60, 0xc8, 0x17, 0x7b, 0x36, 0x89, 0x25, 0x0b, 0x56,
-	0xd7, 0x94, 0x4d, 0x0f, 0x32, 0x1b, 0x48, 0x18, 0xab, 0x0e, 0x3c, 0x81, 0x19, 0xe1, 0x76, 0xe9,
-	0x7e, 0xb5, 0x43, 0x35, 0x2d, 0x17, 0x93, 0x03, 0xad, 0x2e, 0x5f, 0x3c, 0xc9, 0x9e, 0x4d, 0x79,
-	0x2e, 0xd8, 0xb2, 0xa8, 0x70, 0xac, 0x71, 0xce, 0xe8, 0x3c, 0xe0, 0xec, 0xa7, 0x95, 0x07, 0xbc,
-	0xf4, 0x2b, 0xe4, 0x01, 0xe7, 0x61, 0x90, 0xde, 0x33, 0xc5, 0xe8, 0x70, 0x3d, 0x14, 0xd4, 0x29,
-	0x94, 0x0e, 0xdd, 0x01, 0xe4, 0xe8, 0x7b, 0xd8, 0x68, 0xd5, 0x71, 0xa8, 0x0c, 0xf4, 0xd2, 0xc9,
-	0x97, 0x1c, 0x67, 0x8a, 0xa8, 0xf9, 0x14, 0xff, 0xb0, 0x04, 0x49, 0xea, 0xf4, 0xba, 0x9a, 0x8b,
-	0xd1, 0x63, 0x40, 0x7a, 0x8b, 0x10, 0x4c, 0xcd, 0xbd, 0x9f, 0x5b, 0xe5, 0x4e, 0xef, 0xf9, 0x63,
-	0x13, 0xb0, 0xe1, 0xc0, 0x9a, 0x43, 0x84, 0x6a, 0x41, 0x8f, 0x69, 0xec, 0xce, 0xc5, 0x3c, 0xc0,
-	0x8d, 0x9d, 0x11, 0x57, 0x88, 0x77, 0x80, 0x5b, 0x83, 0x8c, 0xd7, 0x90, 0xe0, 0xe5, 0x49, 0x78,
-	0xe6, 0x67, 0xa2, 0x1b, 0xd1, 0xcb, 0xab, 0x04, 0x71, 0x6b, 0xda, 0x63, 0x62, 0xc3, 0x51, 0x39,
-	0xa9, 0xf8, 0x0b, 0xe4, 0xa4, 0x9e, 0x40, 0xc1, 0xaf, 0x0f, 0x9a, 0xa4, 0x81, 0x8d, 0x40, 0x84,
-	0x35, 0xe1, 0x9a, 0x1e, 0x57, 0xff, 0x9b, 0x12, 0x15, 0x44, 0xc6, 0x2c, 0x4e, 0xac, 0xea, 0xa2,
-	0x1b, 0x20, 0x33, 0x60, 0x03, 0x1f, 0xa8, 0xfc, 0x62, 0xf6, 0x4b, 0x9f, 0x5e, 0xa5, 0x72, 0x8c,
-	0xce, 0xaf, 0xe0, 0x83, 0x87, 0x6c, 0x96, 0xd7, 0x40, 0xfb, 0x46, 0x20, 0x89, 0x17, 0x8c, 0x40,
-	0x30, 0x9c, 0x6b, 0x62, 0xcb, 0xa0, 0xd8, 0x51, 0xe5, 0x49, 0xee, 0xad, 0x9e, 0xae, 0x3a, 0xc9,
-	0x81, 0x22, 0xe6, 0xd0, 0x2a, 0xe4, 0x79, 0x11, 0x94, 0x60, 0xa7, 0x69, 0x5b, 0x0e, 0x16, 0x85,
-	0xcf, 0xa8, 0xf3, 0x59, 0xb6, 0x1b, 0x0d, 0xcd, 0x32, 0x94, 0x9c, 0xc7, 0xa3, 0x08, 0x16, 0x0a,
-	0x23, 0x56, 0xcb, 0x6c, 0x96, 0xe3, 0x7a, 0x0e, 0xeb, 0x09, 0x30, 0x9c, 0x47, 0xe1, 0x2c, 0xe8,
-	0x01, 0x20, 0xbe, 0x1a, 0x96, 0xb2, 0xd2, 0x74, 0x1d, 0x37, 0x5d, 0xee, 0xbd, 0xbe, 0x14, 0x95,
-	0x6e, 0xa3, 0x6a, 0x55, 0x5e, 0xb7, 0x4d, 0xab, 0xca, 0x48, 0x15, 0xfe, 0x31, 0xc1, 0x08, 0x7a,
-	0x0c, 0xe3, 0x62, 0x65, 0x0c, 0x93, 0x2f, 0x8f, 0xfb, 0xae, 0x73, 0xc7, 0x82, 0xf2, 0x75, 0x29,
-	0x88, 0x23, 0x84, 0xc6, 0xd0, 0x22, 0x0d, 0x4d, 0xd4, 0xa7, 0xa6, 0x65, 0xd8, 0x4f, 0x1d, 0x55,
-	0x3b, 0xd0, 0xcc, 0x3a, 0xb5, 0xe7, 0xcc, 0xa9, 0x4d, 0x2a, 0x88, 0xb4, 0x9f, 0x78, 0x53, 0x55,
-	0x31, 0x83, 0x36, 0x20, 0x4b, 0xb0, 0x8e, 0x99, 0x48, 0x79, 0xa6, 0x25, 0xcb, 0x76, 0xe8, 0x72,
-	0xdf, 0x35, 0x78, 0x49, 0x65, 0x9e, 0x6c, 0x53, 0x46, 0x3c, 0x6e, 0x6f, 0xd0, 0x41, 0x0f, 0x21,
-	0xcf, 0xe1, 0x02, 0x5b, 0x95, 0x63, 0x80, 0xa5, 0xbe, 0x80, 0xe2, 0xd8, 0x05, 0x64, 0xce, 0x43,
-	0xf0, 0x0d, 0x17, 0xda, 0x81, 0xa2, 0x57, 0xd2, 0xf7, 0x7a, 0x10, 0x54, 0xd3, 0x32, 0x5d, 0x93,
-	0xba, 0x2d, 0x1d, 0x6a, 0x96, 0x3f, 0x51, 0xcd, 0x2e, 0xb0, 0xfa, 0xbf, 0x07, 0xb2, 0x26, 0x30,
-	0x42, 0xda, 0x46, 0xe0, 0x02, 0xc1, 0x1f, 0x62, 0xdd, 0xe5, 0x4e, 0x47, 0x97, 0x03, 0x80, 0x1d,
-	0x79, 0x74, 0x76, 0xf0, 0xe4, 0x5c, 0x59, 0xee, 0xa8, 0x96, 0x79, 0x26, 0xa5, 0xf2, 0xb9, 0x22,
-	0x37, 0x17, 0x05, 0x81, 0x5a, 0xed, 0x2a, 0x5e, 0x63, 0x07, 0x6d, 0xc2, 0xf9, 0x8e, 0x77, 0x76,
-	0xa6, 0x6a, 0xb0, 0x23, 0xa3, 0xd9, 0xc1, 0xd2, 0x48, 0x2d, 0x7b, 0x54, 0x4b, 0x3f, 0x93, 0x92,
-	0xf9, 0x5c, 0xd1, 0xcb, 0xca, 0x4c, 0x87, 0x20, 0xc3, 0xd9, 0x19, 0xec, 0xa0, 0x2a, 0x8c, 0xfb,
-	0x88, 0xe1, 0x50, 0x6d, 0x8c, 0x85, 0x6a, 0x1c, 0xa8, 0x28, 0x6a, 0x20, 0x63, 0x82, 0x36, 0x1c,
-	0xab, 0xad, 0x40, 0xde, 0x33, 0x3b, 0xa1, 0xed, 0x1d, 0x3f, 0x71, 0x7b, 0xb3, 0xcc, 0x14, 0x05,
-	0xdb, 0x69, 0x83, 0xbf, 0xca, 0xd0, 0x56, 0x12, 0xcd, 0xda, 0xc5, 0x8e, 0x3c, 0xc1, 0x84, 0xe2,
-	0xd5, 0xbe, 0x42, 0xa1, 0x70, 0x4e, 0xb1, 0x5f, 0x0a, 0x63, 0x5b, 0xb5, 0x5c, 0x72, 0xc8, 0x4a,
-	0xa2, 0x11, 0x93, 0xbe, 0xb5, 0xa4, 0xfb, 0xa8, 0xef, 0xd1, 0xb1, 0xc0, 0x5a, 0x4e, 0x06, 0xd6,
-	0xb2, 0x6a, 0x90, 0x65, 0x36, 0xcb, 0xad, 0xa5, 0x49, 0x8f, 0x9d, 0xc9, 0x6c, 0x43, 0xd3, 0x55,
-	0xdd, 0x33, 0x03, 0x6a, 0xa8, 0x0f, 0x4a, 0x9e, 0x62, 0xc7, 0x3e, 0xd7, 0xdf, 0x68, 0x04, 0xe5,
-	0x0f, 0x65, 0xc6, 0xc3, 0xda, 0xd0, 0xf4, 0x9e, 0x39, 0xa7, 0xf0, 0xff, 0x62, 0x90, 0x0e, 0xeb,
-	0xeb, 0x03, 0xf0, 0x1d, 0x84, 0x20, 0x91, 0x3f, 0xcc, 0x77, 0xba, 0x5b, 0xc8, 0xee, 0x09, 0xc7,
-	0x20, 0x74, 0x3f, 0xe6, 0x05, 0xbb, 0x9f, 0xc0, 0xfa, 0x32, 0x24, 0x49, 0x9b, 0x47, 0x58, 0x89,
-	0xd3, 0x76, 0x17, 0x24, 0x88, 0x37, 0x84, 0x16, 0x20, 0xa1, 0xef, 0xa8, 0x75, 0xd3, 0x11, 0x6d,
-	0x2b, 0x93, 0x3d, 0xf7, 0xea, 0xed, 0x7b, 0xa6, 0xe3, 0x2a, 0xc3, 0xfa, 0x0e, 0xfd, 0x7f, 0x77,
-	0x23, 0x49, 0xb8, 0xae, 0xb4, 0x1e, 0x4f, 0xc6, 0xf3, 0x43, 0xeb, 0xf1, 0xe4, 0x50, 0x7e, 0x78,
-	0x3d, 0x9e, 0x4c, 0xe5, 0x61, 0x3d, 0x9e, 0x84, 0x7c, 0xba, 0xf0, 0x8b, 0x04, 0x40, 0xc8, 0x18,
-	0xbe, 0x04, 0x89, 0xa6, 0x97, 0xb3, 0x67, 0x5e, 0x47, 0x86, 0xa5, 0xf8, 0xbe, 0x15, 0xcf, 0x8f,
-	0xca, 0x97, 0x14, 0x31, 0x83, 0xee, 0x40, 0x42, 0x18, 0xc9, 0xd8, 0xe9, 0x8d, 0x64, 0x68, 0xa7,
-	0x04, 0xfb, 0x8b, 0xb4, 0x1f, 0xdd, 0x80, 0x9c, 0x6e, 0x13, 0x82, 0xeb, 0xde, 0xb5, 0x67, 0x1a,
-	0x8e, 0x1c, 0x67, 0x95, 0x9f, 0xcc, 0x51, 0x2d, 0xf5, 0x4c, 0x1a, 0x2e, 0xc6, 0x49, 0x4c, 0x36,
-	0x94, 0x6c, 0x88, 0x68, 0xcd, 0x70, 0x3a, 0x1b, 0xcc, 0x86, 0x7e, 0xdb, 0x60, 0xf6, 0x59, 0x37,
-	0x98, 0xfd, 0x5b, 0x0c, 0x86, 0x2d, 0xec, 0xaa, 0xa6, 0xc1, 0x74, 0x29, 0x53, 0xfb, 0xc7, 0xd8,
-	0xb3, 0xea, 0xd4, 0x7a, 0xb2, 0xb8, 0xb8, 0xb8, 0xb8, 0x78, 0x7d, 0x29, 0x6a, 0xaf, 0x07, 0x3f,
-	0x07, 0x7b, 0xbd, 0xf4, 0x69, 0xef, 0xf5, 0xd2, 0x67, 0xb7, 0xd7, 0x43, 0x16, 0x76, 0xd7, 0x8c,
-	0x50, 0xd6, 0xfd, 0xbf, 0x12, 0x30, 0xd2, 0xe1, 0x19, 0xa0, 0x37, 0x02, 0xb5, 0xf6, 0x34, 0x76,
-	0xaa, 0x4f, 0xc1, 0x2e, 0xac, 0xa4, 0x42, 0xdd, 0x9f, 0x40, 0xd2, 0xb7, 0x87, 0x5e, 0x06, 0xfa,
-	0xfa, 0xe9, 0x1c, 0x92, 0xf2, 0x56, 0x3b, 0xc2, 0x4c, 0xfa, 0x60, 0x48, 0x81, 0x34, 0x69, 0xab,
-	0xa2, 0xbd, 0x94, 0x15, 0x6e, 0x4f, 0x8f, 0xad, 0xb4, 0x37, 0x38, 0xa3, 0x02, 0xc4, 0x7f, 0x46,
-	0x6f, 0x40, 0x9a, 0x1a, 0x7d, 0xf3, 0xc0, 0xeb, 0xf7, 0x1b, 0x3e, 0xf1, 0xa6, 0x04, 0x41, 0xce,
-	0x5c, 0xfc, 0x1e, 0x9b, 0x92, 0x38, 0x85, 0x4d, 0xb9, 0x09, 0xe3, 0x22, 0x0a, 0xf2, 0x9a, 0x25,
-	0x78, 0x92, 0x22, 0xc5, 0x8a, 0x6a, 0xf4, 0xcb, 0xaf, 0x0e, 0xca, 0xff, 0x2b, 0x29, 0x88, 0x87,
-	0x3d, 0x1e, 0x11, 0xf3, 0x11, 0x0a, 0x0f, 0x01, 0x82, 0x5d, 0x42, 0xb7, 0x20, 0xe5, 0xdf, 0xce,
-	0x3c, 0xec, 0x93, 0xfb, 0x39, 0x38, 0xe1, 0x2d, 0x15, 0x75, 0x40, 0x66, 0xf2, 0xa1, 0xf0, 0x2f,
-	0x83, 0x00, 0xc1, 0xfe, 0xa0, 0x0d, 0x48, 0xef, 0x6a, 0x2e, 0x7e, 0xaa, 0x1d, 0xaa, 0x41, 0x1b,
-	0x41, 0x4f, 0xee, 0xe4, 0x6b, 0x1e, 0x49, 0x74, 0xf7, 0x00, 0xec, 0x8a, 0x59, 0x07, 0x5d, 0x82,
-	0x8c, 0xf8, 0x4e, 0xe2, 0x38, 0x26, 0xfb, 0xcc, 0x98, 0x92, 0xe6, 0x63, 0x8a, 0xe3, 0x98, 0x28,
-	0x0f, 0x83, 0x8e, 0x45, 0x98, 0x5f, 0x1e, 0x53, 0xe8, 0x23, 0xfa, 0x10, 0x64, 0xff, 0x76, 0x6d,
-	0x6a, 0xee, 0x1e, 0x8d, 0xcf, 0x1c, 0x97, 0x68, 0xa6, 0xe5, 0xf2, 0x96, 0xaf, 0x1e, 0x2f, 0x57,
-	0xb8, 0x2f, 0x9b, 0x9a, 0xbb, 0xb7, 0xec, 0x53, 0x87, 0xdb, 0xb1, 0x8c, 0x48, 0x0a, 0xba, 0x40,
-	0x9e, 0x67, 0x70, 0xed, 0x7d, 0x6c, 0xb1, 0x4c, 0x6e, 0x46, 0x49, 0x7b, 0x63, 0x5b, 0x74, 0x08,
-	0xed, 0xc3, 0x48, 0x53, 0xd3, 0xf7, 0xb1, 0xab, 0x6e, 0x13, 0x7b, 0x1f, 0x13, 0x9e, 0x00, 0xbd,
-	0x7d, 0x66, 0xe1, 0x2b, 0x6f, 0x32, 0x98, 0x1a, 0x43, 0xf1, 0x25, 0x32, 0xd3, 0x0c, 0x8d, 0x16,
-	0xce, 0xc1, 0x78, 0x14, 0x15, 0xbb, 0xa5, 0xd3, 0xec, 0xc8, 0x52, 0xde, 0x6d, 0xbc, 0x1e, 0x4f,
-	0x66, 0xf2, 0x23, 0xeb, 0xf1, 0xe4, 0x48, 0x3e, 0xbb, 0x1e, 0x4f, 0xe6, 0xf3, 0xa3, 0xeb, 0xf1,
-	0xe4, 0x68, 0x1e, 0xad, 0xc7, 0x93, 0x63, 0xf9, 0xf1, 0xf5, 0x78, 0x72, 0x3c, 0x3f, 0xb1, 0x1e,
-	0x4f, 0xea, 0x79, 0xc3, 0xbf, 0xe1, 0xbd, 0xbb, 0x3d, 0x29, 0x10, 0x0a, 0x3f, 0x88, 0x43, 0xae,
-	0xcb, 0x89, 0x47, 0xeb, 0xdd, 0xda, 0xbf, 0x78, 0x5a, 0xff, 0x5f, 0x98, 0x85, 0xc0, 0x18, 0x44,
-	0xa8, 0xc8, 0xf0, 0xc9, 0x2a, 0x52, 0xf8, 0xcf, 0x18, 0x24, 0xc4, 0x72, 0xb6, 0x60, 0xa8, 0xa1,
-	0xee, 0x19, 0x84, 0x0b, 0xe2, 0x8d, 0xb3, 0x2e, 0xa6, 0xbc, 0x71, 0x67, 0x45, 0x09, 0xfb, 0x03,
-	0x8d, 0x3b, 0x06, 0x41, 0xef, 0x42, 0x9a, 0xba, 0x8c, 0xe2, 0x43, 0x3d, 0x8f, 0xe2, 0x8d, 0xb3,
-	0x63, 0x57, 0x97, 0x79, 0xd3, 0x82, 0x02, 0x0d, 0x4d, 0xe7, 0xcf, 0x85, 0x15, 0x88, 0xd3, 0xb7,
-	0xa2, 0xd7, 0x60, 0xb8, 0xa1, 0xd2, 0xbb, 0x82, 0x97, 0xea, 0x7b, 0x52, 0x1d, 0x1b, 0x5b, 0x87,
-	0xcd, 0x70, 0xdf, 0xe3, 0x50, 0x83, 0x0e, 0x78, 0xae, 0x58, 0xe1, 0x3d, 0x80, 0x00, 0x1f, 0x5d,
-	0x84, 0xe1, 0x1d, 0xb5, 0x69, 0x13, 0x97, 0x97, 0xe9, 0x03, 0x43, 0x31, 0xb4, 0xb3, 0x69, 0x13,
-	0x17, 0x9d, 0x03, 0xd8, 0x69, 0xd5, 0xeb, 0xbc, 0x1e, 0xe1, 0xf5, 0x4d, 0x27, 0xe9, 0x08, 0x75,
-	0x96, 0xbb, 0x24, 0xc0, 0xf3, 0xee, 0x3c, 0x7f, 0x2f, 0x9e, 0x4f, 0x86, 0x66, 0xa9, 0xbf, 0x97,
-	0xc8, 0x27, 0x0b, 0x7f, 0x27, 0xc1, 0x48, 0x87, 0x93, 0xde, 0xaf, 0xc1, 0x4a, 0xfa, 0x8c, 0x1a,
-	0xac, 0x62, 0x2f, 0xd8, 0x60, 0x15, 0xba, 0xe1, 0x0c, 0xc8, 0x76, 0xc5, 0x1b, 0x77, 0x60, 0x98,
-	0x47, 0x33, 0xd2, 0x09, 0x31, 0x73, 0x07, 0x63, 0xa8, 0x5f, 0x91, 0xf3, 0x87, 0xde, 0x42, 0x60,
-	0xe6, 0x98, 0xd0, 0x87, 0x1a, 0xb9, 0x7d, 0xcc, 0x9b, 0xe0, 0x14, 0xfa, 0x88, 0xbe, 0x22, 0x9a,
-	0xb5, 0xfb, 0xf4, 0x9a, 0x44, 0xaf, 0xc1, 0x11, 0x5d, 0xdd, 0xb1, 0x2f, 0x85, 0xba, 0xc2, 0x8a,
-	0x3f, 0x93, 0x60, 0xc6, 0x6f, 0xed, 0xaa, 0xb6, 0xdc, 0x3d, 0x6a, 0x97, 0xbd, 0xe4, 0xcc, 0xb2,
-	0x6d, 0x60, 0xb4, 0x18, 0xee, 0x0c, 0x4f, 0xd5, 0x0a, 0x47, 0xb5, 0x29, 0x32, 0x51, 0x19, 0x7b,
-	0xff, 0x1d, 0x6d, 0xfe, 0x5b, 0xd5, 0xf9, 0xb7, 0x17, 0xe7, 0x5f, 0x7f, 0xef, 0xa3, 0xeb, 0xd7,
-	0x96, 0x2a, 0x1f, 0xcf, 0x71, 0x7c, 0xf4, 0x3a, 0x00, 0xfb, 0xb5, 0x8f, 0xba, 0x43, 0xec, 0x06,
-	0x5f, 0xe3, 0xb1, 0xad, 0xf0, 0x8c, 0xfa, 0x36, 0xb1, 0x1b, 0xe8, 0x06, 0x24, 0x3d, 0x56, 0xd7,
-	0xe6, 0xaa, 0x75, 0x1c, 0x63, 0x82, 0xd1, 0x6e, 0xd9, 0xa1, 0xaf, 0xf9, 0xe3, 0x59, 0x48, 0xf9,
-	0x5f, 0x83, 0xee, 0x84, 0x1b, 0xda, 0xe6, 0xfa, 0x36, 0xb4, 0x85, 0xef, 0xa2, 0xbc, 0xd0, 0x77,
-	0x06, 0x39, 0x50, 0xe2, 0x1d, 0x6d, 0xcb, 0x00, 0x3a, 0xc1, 0x1a, 0x6f, 0xef, 0x3f, 0xf1, 0x9b,
-	0x6a, 0xde, 0x8a, 0x06, 0xf2, 0x03, 0x4a, 0x8a, 0xf3, 0x55, 0x5d, 0x0a, 0xd2, 0x6a, 0x1a, 0x02,
-	0x64, 0xf0, 0x2c, 0x20, 0x9c, 0xaf, 0xea, 0xa2, 0x19, 0x88, 0x5b, 0x5a, 0x03, 0x77, 0x76, 0xaa,
-	0x55, 0x14, 0x36, 0x88, 0xae, 0x42, 0xda, 0xc0, 0x8e, 0x4e, 0x4c, 0xf6, 0x2b, 0x18, 0xa6, 0xcb,
-	0x5e, 0x8f, 0x1a, 0x19, 0x94, 0x7f, 0x9e, 0x53, 0xc2, 0x93, 0xe8, 0x3b, 0x12, 0x80, 0xe6, 0xba,
-	0xc4, 0xdc, 0x6e, 0xb9, 0xd8, 0x33, 0xaf, 0xe9, 0xca, 0x95, 0xbe, 0x9b, 0x54, 0xae, 0xfa, 0xb4,
-	0x4c, 0x2e, 0x6b, 0x37, 0x8e, 0x6a, 0x95, 0x1f, 0x4a, 0x0b, 0x79, 0x28, 0xce, 0x91, 0xa2, 0x3c,
-	0x57, 0xb9, 0xc0, 0x44, 0x82, 0xca, 0x43, 0xe9, 0xd6, 0xcd, 0x77, 0xe6, 0xdf, 0xbb, 0x25, 0xfe,
-	0xbc, 0xf2, 0x51, 0xe5, 0xda, 0xc7, 0x73, 0x57, 0xe9, 0x1a, 0x7e, 0x2a, 0x29, 0xa1, 0x77, 0xa2,
-	0x7b, 0x90, 0xe6, 0xb9, 0x58, 0xee, 0x04, 0x9d, 0xb5, 0xf1, 0x50, 0x81, 0x03, 0x31, 0x46, 0x43,
-	0x35, 0xe4, 0x60, 0xc2, 0x1c, 0xa4, 0x26, 0xb1, 0x77, 0xcc, 0x3a, 0xa6, 0xe1, 0x40, 0xb2, 0xb3,
-	0xa3, 0x2f, 0xcf, 0x49, 0x36, 0x3d, 0x8a, 0x35, 0x03, 0xfd, 0x44, 0x82, 0x49, 0x91, 0x66, 0xa2,
-	0x93, 0x98, 0xb0, 0xa8, 0x0d, 0x3b, 0x0e, 0x73, 0x39, 0x52, 0xb5, 0xdf, 0x97, 0x8e, 0x6a, 0xdf,
-	0x93, 0xc8, 0xef, 0x4a, 0x95, 0xff, 0x2f, 0xbd, 0x5f, 0xba, 0x75, 0x93, 0x7e, 0x5e, 0x20, 0xfc,
-	0xdf, 0x0e, 0x3d, 0x07, 0x8f, 0xef, 0xce, 0xbf, 0x77, 0x35, 0x34, 0x71, 0xe5, 0xdd, 0xf2, 0x95,
-	0xab, 0x94, 0xaf, 0x3a, 0xff, 0x36, 0xdf, 0x95, 0x6f, 0x87, 0x9e, 0x83, 0x47, 0xc6, 0x17, 0x4c,
-	0x5c, 0x29, 0xdd, 0xba, 0x79, 0xf3, 0x1d, 0xae, 0x63, 0x37, 0x3e, 0xbe, 0x72, 0x6b, 0xee, 0xdb,
-	0xef, 0xcf, 0x29, 0xe3, 0x7c, 0xb9, 0x0f, 0xd9, 0x6a, 0xab, 0xde, 0x62, 0x51, 0x15, 0xe4, 0xae,
-	0xcf, 0xd8, 0xc7, 0xfb, 0x6a, 0x5d, 0xdb, 0xc6, 0x75, 0x79, 0x21, 0x2c, 0x08, 0xdf, 0xc9, 0x2b,
-	0x13, 0x1d, 0x08, 0x77, 0xf1, 0xfe, 0x3d, 0x4a, 0x86, 0xfe, 0x5e, 0x82, 0x42, 0x38, 0xc9, 0xdb,
-	0xb5, 0x1d, 0xf0, 0xf9, 0xdc, 0x0e, 0x39, 0xb4, 0xe4, 0xce, 0x2d, 0x59, 0x83, 0x73, 0x11, 0x9f,
-	0x13, 0x6c, 0xcb, 0x62, 0xd7, 0xb6, 0x4c, 0xf7, 0x20, 0xf9, 0x5b, 0xf3, 0x06, 0x4c, 0x44, 0x40,
-	0x99, 0x86, 0x7c, 0x3d, 0x2c, 0x5f, 0x86, 0x32, 0xd6, 0x03, 0xb1, 0x66, 0xa0, 0xbf, 0x91, 0x60,
-	0x8c, 0x25, 0x7d, 0xbb, 0x36, 0x34, 0xfd, 0xf9, 0xdc, 0xd0, 0x51, 0xba, 0xd6, 0xce, 0x9d, 0x74,
-	0x21, 0x55, 0xb7, 0xbd, 0xaf, 0x72, 0xe4, 0x4c, 0x74, 0x76, 0x37, 0x30, 0x15, 0xf7, 0x04, 0xa9,
-	0x67, 0x29, 0xae, 0x1d, 0xd5, 0xae, 0xfc, 0x50, 0xba, 0x7c, 0x3a, 0x3b, 0xa1, 0x04, 0x2f, 0x42,
-	0xd7, 0x21, 0xc1, 0x7f, 0xf3, 0x27, 0x57, 0xa2, 0xe3, 0xc9, 0x4d, 0x6f, 0x5a, 0x11, 0x74, 0x91,
-	0xed, 0xb7, 0x23, 0xa7, 0x6e, 0xbf, 0xcd, 0xf6, 0x69, 0xbf, 0xed, 0x29, 0x1d, 0xe5, 0x3e, 0xfd,
-	0x76, 0xe6, 0xfc, 0x67, 0xd0, 0xce, 0x3c, 0x7a, 0x42, 0x3b, 0x73, 0x4f, 0x27, 0x30, 0x3a, 0x4d,
-	0x27, 0xf0, 0xd8, 0x69, 0x3a, 0x81, 0xc7, 0x4f, 0xdd, 0x09, 0x3c, 0xd1, 0xa7, 0x13, 0xf8, 0x06,
-	0xa4, 0x88, 0x6d, 0xbb, 0x2a, 0x4b, 0xdd, 0x4d, 0x46, 0x47, 0xa9, 0x8a, 0x6d, 0xbb, 0x77, 0xf1,
-	0xa1, 0xa3, 0x24, 0x09, 0x7f, 0x0a, 0xa7, 0x82, 0xa6, 0x7e, 0x9b, 0x0a, 0xfa, 0x0c, 0x53, 0x41,
-	0xe8, 0xab, 0x90, 0xe9, 0x68, 0x47, 0x97, 0x4f, 0x6e, 0x47, 0xa7, 0xc1, 0x93, 0x9f, 0x86, 0xb8,
-	0x01, 0x29, 0xc6, 0x4f, 0x7d, 0x55, 0xde, 0x1f, 0x28, 0xf7, 0xf3, 0x65, 0x95, 0x24, 0xe5, 0x64,
-	0xd5, 0xeb, 0x15, 0x18, 0x15, 0x95, 0xb4, 0x80, 0xfd, 0xda, 0x09, 0xec, 0xa2, 0xc4, 0xb7, 0x21,
-	0x50, 0xae, 0x43, 0xc2, 0xf1, 0xf2, 0xbe, 0x72, 0x21, 0xda, 0xb6, 0xf0, 0xb4, 0xb0, 0x22, 0xe8,
-	0xd0, 0x9b, 0x20, 0x50, 0x54, 0xc1, 0x3a, 0x73, 0x3c, 0x6b, 0x96, 0xd3, 0x8b, 0xdf, 0x1d, 0xcf,
-	0x41, 0xd6, 0x2f, 0xee, 0x32, 0xe9, 0x67, 0xad, 0x1f, 0x23, 0x4a, 0x86, 0x97, 0x74, 0x99, 0xe4,
-	0xa3, 0xcb, 0x90, 0x6b, 0x39, 0xd8, 0x08, 0xa8, 0x1c, 0xf9, 0xfc, 0xec, 0x60, 0x69, 0x44, 0x19,
-	0xa1, 0xc3, 0x82, 0xcc, 0xa1, 0x74, 0x0c, 0x2d, 0x50, 0x26, 0xd6, 0x8b, 0xc1, 0x7f, 0xcb, 0xea,
-	0x6b, 0x12, 0x7a, 0x99, 0xd3, 0x91, 0x0f, 0x79, 0x8f, 0xd7, 0x22, 0xeb, 0xae, 0xe0, 0xaf, 0x55,
-	0x3e, 0x64, 0xbd, 0x5b, 0x8b, 0xbd, 0x64, 0xd7, 0x59, 0xd7, 0x44, 0x27, 0xd9, 0x75, 0xf4, 0x75,
-	0x98, 0xe9, 0x2e, 0x50, 0x87, 0x53, 0x61, 0x97, 0x4e, 0x57, 0xfa, 0xf6, 0xeb, 0xd7, 0x4a, 0x90,
-	0x17, 0xbb, 0x0b, 0x69, 0xaf, 0x10, 0xe6, 0x1d, 0x69, 0xb1, 0x8f, 0x41, 0xa4, 0x24, 0xec, 0x0c,
-	0x6b, 0x59, 0x61, 0x10, 0x3f, 0x79, 0x3e, 0x1d, 0xcb, 0x0f, 0x28, 0xd0, 0xf4, 0xe7, 0xd0, 0x3b,
-	0x80, 0xb6, 0x59, 0xbf, 0xff, 0xa1, 0xda, 0xc4, 0x44, 0xc7, 0x96, 0xab, 0xed, 0x62, 0xde, 0x44,
-	0x71, 0x6c, 0xbf, 0x5e, 0xee, 0xa8, 0x96, 0x01, 0x38, 0x3f, 0x30, 0xf0, 0x9d, 0x5b, 0xf3, 0x03,
-	0x03, 0x03, 0x03, 0xca, 0x28, 0xc7, 0xd9, 0xf4, 0x61, 0xd0, 0x2b, 0x90, 0xf3, 0xd3, 0x4c, 0xbc,
-	0x13, 0x70, 0x6e, 0x56, 0x2a, 0x0d, 0x29, 0x59, 0x31, 0xcc, 0xbb, 0xfd, 0x4e, 0xfa, 0x6d, 0x6f,
-	0xe9, 0x53, 0xf9, 0x6d, 0x2f, 0xba, 0x03, 0x10, 0xfa, 0xb1, 0xc4, 0x95, 0xb3, 0xfd, 0x58, 0x42,
-	0x09, 0xf1, 0xa2, 0x07, 0x90, 0x6d, 0x12, 0xfb, 0xc0, 0xa4, 0xe2, 0xea, 0x39, 0x38, 0x57, 0xd9,
-	0x1d, 0x72, 0xf5, 0xa8, 0xf6, 0x0a, 0x79, 0x59, 0x9e, 0xab, 0x5c, 0x3a, 0xfe, 0xfa, 0xa6, 0xfe,
-	0xc3, 0x48, 0x08, 0x61, 0xcd, 0x60, 0xfa, 0x2a, 0x06, 0xa8, 0xee, 0xb0, 0x2c, 0xec, 0x17, 0xb8,
-	0xe2, 0x74, 0x1f, 0xc4, 0x43, 0xf6, 0x6f, 0x21, 0x28, 0xf9, 0x30, 0x07, 0x0d, 0x63, 0xd1, 0x39,
-	0x48, 0x35, 0x5a, 0x75, 0x1a, 0x9a, 0x3a, 0xae, 0x3c, 0xcf, 0xae, 0x8c, 0x60, 0x00, 0xed, 0xc2,
-	0xb4, 0x5e, 0xd7, 0xcc, 0x86, 0xaa, 0x75, 0x44, 0xb0, 0xaa, 0x6e, 0x1b, 0x58, 0x2e, 0x9f, 0x10,
-	0x57, 0xf4, 0x46, 0xbd, 0xac, 0xb9, 0xc8, 0x6c, 0x44, 0x84, 0xc3, 0x65, 0x18, 0x73, 0xf6, 0xcd,
-	0xa6, 0x48, 0x00, 0xa9, 0x3a, 0x39, 0x6c, 0xba, 0xb6, 0xbc, 0xc4, 0x16, 0x34, 0x4a, 0xa7, 0xf8,
-	0xfe, 0x2e, 0xb3, 0x09, 0xf4, 0x0e, 0x9c, 0x8b, 0xa0, 0x57, 0xed, 0x03, 0x4c, 0x88, 0x69, 0x60,
-	0xf9, 0xd5, 0x3e, 0xea, 0x12, 0xb4, 0xf5, 0x4c, 0xf7, 0x80, 0xbe, 0xc5, 0x99, 0xd1, 0x57, 0x20,
-	0xa3, 0xe9, 0xae, 0x79, 0x20, 0x42, 0xca, 0x1b, 0x27, 0xea, 0x5e, 0xda, 0xa7, 0xaf, 0xba, 0xe8,
-	0xcb, 0xc0, 0x34, 0x5b, 0x75, 0x30, 0xb6, 0x28, 0xfb, 0x6b, 0x27, 0x67, 0xb1, 0x29, 0xfd, 0x43,
-	0x8c, 0xad, 0xaa, 0x5b, 0xf8, 0x0a, 0xe4, 0xba, 0x42, 0xc2, 0x70, 0xaa, 0x22, 0xe5, 0xa5, 0x2a,
-	0xc6, 0xc3, 0xa9, 0x8a, 0x54, 0x28, 0x03, 0x51, 0x78, 0x0c, 0xd9, 0x4e, 0x37, 0x31, 0x82, 0xbb,
-	0xdc, 0x99, 0xe8, 0xe8, 0xb1, 0xee, 0x02, 0x20, 0x32, 0xb3, 0xb1, 0x1e, 0x4f, 0xbe, 0x9c, 0xbf,
-	0xbc, 0x1e, 0x4f, 0x5e, 0xce, 0xbf, 0xb2, 0x1e, 0x4f, 0xbe, 0x92, 0x2f, 0x15, 0xef, 0x00, 0xf8,
-	0x87, 0xee, 0xa0, 0x9b, 0x90, 0x0e, 0xfe, 0xa9, 0x13, 0x91, 0xd0, 0x99, 0xee, 0x2b, 0x25, 0x0a,
-	0x60, 0x9f, 0xb7, 0xf8, 0x89, 0x04, 0x23, 0x2b, 0x5e, 0xed, 0x69, 0x93, 0xe0, 0x1d, 0xb3, 0x8d,
-	0x7e, 0x2a, 0x85, 0x2a, 0x7e, 0x5e, 0x51, 0xf3, 0xcf, 0xa5, 0xdf, 0xa4, 0x8a, 0x5f, 0x50, 0x4c,
-	0x9b, 0x84, 0xe1, 0x3a, 0xb6, 0x76, 0xdd, 0x3d, 0x2f, 0x63, 0xa8, 0xf0, 0xbf, 0x8a, 0xef, 0xc2,
-	0xe4, 0x32, 0x4b, 0x66, 0x04, 0x7b, 0xc2, 0x0b, 0xac, 0x35, 0x80, 0x60, 0x2b, 0xfd, 0x5f, 0x4f,
-	0xf4, 0xdb, 0xc9, 0x50, 0x52, 0x35, 0xe5, 0xef, 0x69, 0xf1, 0x07, 0x12, 0x4c, 0x3e, 0x62, 0x69,
-	0x8e, 0xcf, 0x02, 0x1e, 0xbd, 0x0e, 0x10, 0xfc, 0x4b, 0x2c, 0x7d, 0x33, 0x38, 0xb7, 0x29, 0xc9,
-	0x86, 0xe6, 0xec, 0x2b, 0xa9, 0x1d, 0xf1, 0x58, 0xfc, 0xb3, 0x18, 0xbc, 0x54, 0xd3, 0x5c, 0x7d,
-	0xaf, 0x6b, 0x79, 0xf7, 0xb8, 0x32, 0x88, 0x65, 0x62, 0x48, 0x78, 0x79, 0x1a, 0x21, 0x4c, 0x77,
-	0x7b, 0x9a, 0xf5, 0x4e, 0x46, 0x29, 0xf7, 0x4c, 0x78, 0xf4, 0x8a, 0xc0, 0x2e, 0xfc, 0x91, 0x04,
-	0x53, 0x7d, 0x88, 0xd0, 0x9b, 0x67, 0xcf, 0x78, 0x75, 0xfd, 0x76, 0xb3, 0xdb, 0x28, 0xc4, 0xce,
-	0x62, 0x14, 0x8a, 0x7f, 0x2a, 0xc1, 0x18, 0xf5, 0x15, 0xbb, 0x4f, 0x70, 0x0b, 0xb2, 0xc1, 0x09,
-	0xaa, 0xbf, 0xfa, 0x12, 0x33, 0x38, 0x98, 0x77, 0x5e, 0xe4, 0x4c, 0x7f, 0x36, 0x08, 0x2f, 0x87,
-	0x17, 0x1a, 0x7a, 0xdd, 0x6d, 0x9b, 0xac, 0x3e, 0x5a, 0x73, 0xc4, 0xd2, 0x7f, 0x2e, 0x41, 0x92,
-	0x79, 0x58, 0xb8, 0x65, 0x72, 0xc5, 0xfe, 0x2b, 0xe9, 0x59, 0xf5, 0xd2, 0x3a, 0x2a, 0x7e, 0x71,
-	0xb1, 0xb6, 0xb4, 0x72, 0xe3, 0x8b, 0xab, 0x2b, 0x8b, 0xfd, 0x15, 0x3c, 0xf9, 0x39, 0x50, 0xf0,
-	0x2f, 0x71, 0x05, 0xa7, 0x9f, 0xb1, 0xda, 0x32, 0xd1, 0x3f, 0x48, 0x40, 0x95, 0x9d, 0x7d, 0x51,
-	0xec, 0x37, 0xf9, 0x8b, 0x86, 0x0d, 0x7c, 0xb0, 0xda, 0x32, 0x8b, 0x47, 0x31, 0x98, 0xb8, 0x67,
-	0x3a, 0xc1, 0x71, 0xfa, 0xa7, 0xf7, 0x16, 0xe4, 0xc2, 0x9e, 0x57, 0x20, 0x79, 0x97, 0x8f, 0xf1,
-	0xb9, 0xc2, 0x19, 0xc6, 0xac, 0x16, 0x1e, 0x7f, 0x11, 0x99, 0x43, 0x3f, 0x92, 0x60, 0xc8, 0x26,
-	0x06, 0x26, 0x2c, 0xf7, 0x9b, 0xaa, 0xfd, 0x9e, 0x74, 0x54, 0xfb, 0x1d, 0x89, 0x7c, 0x57, 0x52,
-	0x06, 0x94, 0x94, 0xaf, 0x1a, 0x0a, 0xcc, 0x07, 0xcf, 0xbe, 0xe4, 0x29, 0xa9, 0x79, 0xff, 0x51,
-	0x9c, 0x9d, 0x92, 0x9c, 0x17, 0x4f, 0x2c, 0xfd, 0xab, 0x0c, 0xcd, 0xb3, 0xff, 0x85, 0xd3, 0xbc,
-	0x4a, 0x66, 0x3e, 0xfc, 0x57, 0x28, 0x8b, 0xad, 0xa4, 0xe7, 0x43, 0x7f, 0x78, 0x0b, 0x43, 0x17,
-	0x60, 0xc8, 0xfb, 0x17, 0x4f, 0xe2, 0xe1, 0x5a, 0xd1, 0xbf, 0x27, 0x14, 0x6f, 0x18, 0x21, 0x88,
-	0x37, 0xa9, 0x1f, 0xed, 0x55, 0x89, 0xd8, 0x73, 0xf1, 0x0f, 0x24, 0x18, 0x7b, 0x18, 0xa1, 0xf3,
-	0xbf, 0x66, 0xab, 0xfd, 0x17, 0x12, 0xcc, 0x28, 0xd8, 0xc1, 0x6e, 0xd5, 0x32, 0x7e, 0x23, 0x4c,
-	0xd2, 0x8f, 0x25, 0x18, 0xf5, 0xdf, 0xb5, 0x85, 0x1b, 0xcd, 0x3a, 0xb5, 0xe8, 0xbf, 0xde, 0x5d,
-	0x44, 0x25, 0x48, 0x37, 0xb4, 0x26, 0x6b, 0x2d, 0xa6, 0xce, 0xd9, 0x60, 0x67, 0xb6, 0x13, 0xf8,
-	0xdc, 0x5d, 0x7c, 0x58, 0xfc, 0xeb, 0xf0, 0xb5, 0x24, 0x96, 0xef, 0x45, 0x19, 0x7e, 0xd1, 0x42,
-	0xea, 0x64, 0x8f, 0x2c, 0x5a, 0xc4, 0xc2, 0x49, 0xd9, 0x9f, 0x4a, 0x9d, 0x45, 0x8b, 0x2d, 0xc8,
-	0xb1, 0xc4, 0x3e, 0x6e, 0xbb, 0xd8, 0x72, 0x58, 0x36, 0x72, 0x90, 0xd5, 0x85, 0xbf, 0x70, 0x54,
-	0x2b, 0x3d, 0x93, 0x5e, 0xce, 0x1b, 0xb2, 0x54, 0xbc, 0x48, 0xce, 0x57, 0x66, 0xde, 0x2f, 0xdd,
-	0xba, 0xf9, 0x6e, 0x59, 0x04, 0x27, 0x1f, 0x5d, 0xbf, 0x76, 0xfd, 0xb5, 0x8f, 0xaf, 0x7c, 0x74,
-	0xfd, 0x5a, 0xe5, 0xe3, 0x39, 0x25, 0x4b, 0x31, 0x56, 0x7d, 0x88, 0xe2, 0xff, 0x48, 0x20, 0xf7,
-	0x59, 0xba, 0x83, 0x3e, 0x86, 0x84, 0x17, 0x1f, 0x89, 0x5b, 0xfd, 0x46, 0xdf, 0xdd, 0xef, 0x62,
-	0x2d, 0xf3, 0xff, 0xff, 0x2a, 0x59, 0x50, 0xf1, 0xce, 0x82, 0x0e, 0x99, 0x30, 0x4c, 0x84, 0x97,
-	0x7c, 0x52, 0x39, 0xb0, 0xcf, 0xf2, 0x42, 0x4e, 0x73, 0xf1, 0x9f, 0x25, 0xb8, 0xb8, 0x6c, 0x5b,
-	0x07, 0x98, 0xb8, 0x3d, 0xd4, 0x42, 0x5f, 0x56, 0x20, 0xe5, 0xad, 0x89, 0x06, 0x85, 0xde, 0x41,
-	0xbe, 0x72, 0x54, 0x3b, 0xdd, 0xd7, 0x24, 0x3d, 0xce, 0x35, 0x83, 0x1a, 0x10, 0x16, 0xff, 0xb1,
-	0x6b, 0x47, 0x61, 0xcf, 0xe8, 0x03, 0x98, 0x0c, 0x69, 0x62, 0xb8, 0x22, 0x34, 0x78, 0xf6, 0x8a,
-	0xd0, 0x18, 0xee, 0x99, 0x74, 0xae, 0x3e, 0x02, 0x08, 0xd2, 0x06, 0x68, 0x14, 0x46, 0x36, 0xdf,
-	0x7a, 0xb2, 0xaa, 0xa8, 0x8f, 0xee, 0xdf, 0xbd, 0xff, 0xd6, 0x93, 0xfb, 0xf9, 0x81, 0x60, 0xa8,
-	0x56, 0xdd, 0xda, 0x5a, 0x55, 0xbe, 0x91, 0x97, 0x10, 0x82, 0xac, 0x37, 0xb4, 0xfa, 0xf5, 0xad,
-	0x55, 0xe5, 0x7e, 0xf5, 0x5e, 0x3e, 0x56, 0x18, 0xf9, 0xe5, 0xf3, 0xe9, 0x94, 0x2c, 0x5d, 0x1d,
-	0x62, 0x33, 0xb5, 0x1b, 0x7f, 0xfb, 0xaf, 0x17, 0xa4, 0xb7, 0x17, 0xce, 0x70, 0x8b, 0xb9, 0x56,
-	0x73, 0x7b, 0x7b, 0x98, 0xa9, 0xdc, 0xd2, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x14, 0xe5, 0x99,
-	0x74, 0x7c, 0x51, 0x00, 0x00,
+	0x90, 0x0f, 0x0b, 0x1c, 0xcd, 0xfa, 0x5f, 0xa0, 0xc8, 0x17, 0x7b, 0x36, 0x89, 0x26, 0x0b, 0x56,
+	0xd7, 0x94, 0x4d, 0x06, 0x99, 0x0d, 0x24, 0x8c, 0x56, 0x07, 0x9e, 0xc0, 0x8c, 0x70, 0xbb, 0x74,
+	0xbf, 0xda, 0xa1, 0x9a, 0x96, 0x87, 0x9d, 0x03, 0xad, 0x2e, 0x5f, 0x3c, 0xc9, 0x9e, 0x4d, 0x31,
+	0x17, 0x6c, 0x59, 0x54, 0x38, 0xd6, 0x38, 0x67, 0x74, 0x1e, 0x70, 0xf6, 0xb3, 0xca, 0x03, 0x5e,
+	0xfa, 0x35, 0xf2, 0x80, 0xf3, 0x30, 0x48, 0xee, 0x99, 0x62, 0x74, 0xb8, 0x1e, 0x0a, 0xea, 0x14,
+	0x42, 0x87, 0xee, 0x00, 0x72, 0xf5, 0x3d, 0x6c, 0xb4, 0xea, 0x38, 0x54, 0x06, 0x7a, 0xe9, 0xe4,
+	0x4b, 0x8e, 0x33, 0x45, 0xd4, 0x7c, 0x8a, 0xbf, 0x5f, 0x82, 0x24, 0x71, 0x7a, 0x3d, 0xcd, 0xc3,
+	0xe8, 0x31, 0x20, 0xbd, 0xe5, 0x38, 0x98, 0x98, 0x7b, 0x3f, 0xb7, 0xca, 0x9d, 0xde, 0xf3, 0xc7,
+	0x26, 0x60, 0xc3, 0x81, 0x35, 0x87, 0x08, 0xd5, 0x82, 0x1e, 0x93, 0xd8, 0x9d, 0x8b, 0x79, 0x80,
+	0x1b, 0x3b, 0x23, 0xae, 0x10, 0xef, 0x00, 0xb7, 0x06, 0x19, 0xd6, 0x90, 0xc0, 0xf2, 0x24, 0x3c,
+	0xf3, 0x33, 0xd1, 0x8d, 0xc8, 0xf2, 0x2a, 0x41, 0xdc, 0x9a, 0x66, 0x4c, 0x74, 0x38, 0x2a, 0x27,
+	0x15, 0x7f, 0x81, 0x9c, 0xd4, 0x13, 0x28, 0xf8, 0xf5, 0x41, 0xd3, 0x69, 0x60, 0x23, 0x10, 0x61,
+	0x4d, 0xb8, 0xa6, 0xc7, 0xd5, 0xff, 0xa6, 0x44, 0x05, 0x91, 0x32, 0x8b, 0x13, 0xab, 0x7a, 0xe8,
+	0x06, 0xc8, 0x14, 0xd8, 0xc0, 0x07, 0x2a, 0xbf, 0x98, 0xfd, 0xd2, 0x27, 0xab, 0x54, 0x8e, 0x91,
+	0xf9, 0x15, 0x7c, 0xf0, 0x90, 0xce, 0xf2, 0x1a, 0x68, 0xdf, 0x08, 0x24, 0xf1, 0x82, 0x11, 0x08,
+	0x86, 0x73, 0x4d, 0x6c, 0x19, 0x04, 0x3b, 0xaa, 0x3c, 0xc9, 0xbd, 0xd5, 0xd3, 0x55, 0x27, 0x39,
+	0x50, 0xc4, 0x1c, 0x5a, 0x85, 0x3c, 0x2f, 0x82, 0x3a, 0xd8, 0x6d, 0xda, 0x96, 0x8b, 0x45, 0xe1,
+	0x33, 0xea, 0x7c, 0x96, 0xed, 0x46, 0x43, 0xb3, 0x0c, 0x25, 0xc7, 0x78, 0x14, 0xc1, 0x42, 0x60,
+	0xc4, 0x6a, 0xa9, 0xcd, 0x72, 0x3d, 0xe6, 0xb0, 0x9e, 0x00, 0xc3, 0x79, 0x14, 0xce, 0x82, 0x1e,
+	0x00, 0xe2, 0xab, 0xa1, 0x29, 0x2b, 0x4d, 0xd7, 0x71, 0xd3, 0xe3, 0xde, 0xeb, 0x4b, 0x51, 0xe9,
+	0x36, 0xa2, 0x56, 0xe5, 0x75, 0xdb, 0xb4, 0xaa, 0x94, 0x54, 0xe1, 0x1f, 0x13, 0x8c, 0xa0, 0xc7,
+	0x30, 0x2e, 0x56, 0x46, 0x31, 0xf9, 0xf2, 0xb8, 0xef, 0x3a, 0x77, 0x2c, 0x28, 0x5f, 0x97, 0x82,
+	0x38, 0x42, 0x68, 0x0c, 0x2d, 0x92, 0xd0, 0x44, 0x7d, 0x6a, 0x5a, 0x86, 0xfd, 0xd4, 0x55, 0xb5,
+	0x03, 0xcd, 0xac, 0x13, 0x7b, 0x4e, 0x9d, 0xda, 0xa4, 0x82, 0x9c, 0xf6, 0x13, 0x36, 0x55, 0x15,
+	0x33, 0x68, 0x03, 0xb2, 0x0e, 0xd6, 0x31, 0x15, 0x29, 0x66, 0x5a, 0xb2, 0x74, 0x87, 0x2e, 0xf7,
+	0x5d, 0x03, 0x4b, 0x2a, 0xf3, 0x64, 0x9b, 0x32, 0xc2, 0xb8, 0xd9, 0xa0, 0x8b, 0x1e, 0x42, 0x9e,
+	0xc3, 0x05, 0xb6, 0x2a, 0x47, 0x01, 0x4b, 0x7d, 0x01, 0xc5, 0xb1, 0x0b, 0xc8, 0x1c, 0x43, 0xf0,
+	0x0d, 0x17, 0xda, 0x81, 0x22, 0x2b, 0xe9, 0xb3, 0x1e, 0x04, 0xd5, 0xb4, 0x4c, 0xcf, 0x24, 0x6e,
+	0x4b, 0x87, 0x9a, 0xe5, 0x4f, 0x54, 0xb3, 0x0b, 0xb4, 0xfe, 0xcf, 0x40, 0xd6, 0x04, 0x46, 0x48,
+	0xdb, 0x1c, 0xb8, 0xe0, 0xe0, 0x8f, 0xb0, 0xee, 0x71, 0xa7, 0xa3, 0xcb, 0x01, 0xc0, 0xae, 0x3c,
+	0x3a, 0x3b, 0x78, 0x72, 0xae, 0x2c, 0x77, 0x54, 0xcb, 0x3c, 0x93, 0x52, 0xf9, 0x5c, 0x91, 0x9b,
+	0x8b, 0x82, 0x40, 0xad, 0x76, 0x15, 0xaf, 0xb1, 0x8b, 0x36, 0xe1, 0x7c, 0xc7, 0x3b, 0x3b, 0x53,
+	0x35, 0xd8, 0x95, 0xd1, 0xec, 0x60, 0x69, 0xa4, 0x96, 0x3d, 0xaa, 0xa5, 0x9f, 0x49, 0xc9, 0x7c,
+	0xae, 0xc8, 0xb2, 0x32, 0xd3, 0x21, 0xc8, 0x70, 0x76, 0x06, 0xbb, 0xa8, 0x0a, 0xe3, 0x3e, 0x62,
+	0x38, 0x54, 0x1b, 0xa3, 0xa1, 0x1a, 0x07, 0x2a, 0x8a, 0x1a, 0xc8, 0x98, 0xa0, 0x0d, 0xc7, 0x6a,
+	0x2b, 0x90, 0x67, 0x66, 0x27, 0xb4, 0xbd, 0xe3, 0x27, 0x6e, 0x6f, 0x96, 0x9a, 0xa2, 0x60, 0x3b,
+	0x6d, 0xf0, 0x57, 0x19, 0xda, 0x4a, 0x47, 0xb3, 0x76, 0xb1, 0x2b, 0x4f, 0x50, 0xa1, 0x78, 0xb5,
+	0xaf, 0x50, 0x28, 0x9c, 0x53, 0xec, 0x97, 0x42, 0xd9, 0x56, 0x2d, 0xcf, 0x39, 0xa4, 0x25, 0xd1,
+	0x88, 0x49, 0xdf, 0x5a, 0x92, 0x7d, 0xd4, 0xf7, 0xc8, 0x58, 0x60, 0x2d, 0x27, 0x03, 0x6b, 0x59,
+	0x35, 0x9c, 0x65, 0x3a, 0xcb, 0xad, 0xa5, 0x49, 0x8e, 0x9d, 0xca, 0x6c, 0x43, 0xd3, 0x55, 0x9d,
+	0x99, 0x01, 0x35, 0xd4, 0x07, 0x25, 0x4f, 0xd1, 0x63, 0x9f, 0xeb, 0x6f, 0x34, 0x82, 0xf2, 0x87,
+	0x32, 0xc3, 0xb0, 0x36, 0x34, 0xbd, 0x67, 0xce, 0x2d, 0xfc, 0xaf, 0x18, 0xa4, 0xc3, 0xfa, 0xfa,
+	0x00, 0x7c, 0x07, 0x21, 0x48, 0xe4, 0x0f, 0xf3, 0x9d, 0xee, 0x16, 0xb2, 0x7b, 0xc2, 0x31, 0x08,
+	0xdd, 0x8f, 0x79, 0xc1, 0xee, 0x27, 0xb0, 0xbe, 0x0a, 0x49, 0xa7, 0xcd, 0x23, 0xac, 0xc4, 0x69,
+	0xbb, 0x0b, 0x12, 0x0e, 0x1b, 0x42, 0x0b, 0x90, 0xd0, 0x77, 0xd4, 0xba, 0xe9, 0x8a, 0xb6, 0x95,
+	0xc9, 0x9e, 0x7b, 0xf5, 0xf6, 0x3d, 0xd3, 0xf5, 0x94, 0x61, 0x7d, 0x87, 0xfc, 0xbf, 0xbb, 0x91,
+	0x24, 0x5c, 0x57, 0x5a, 0x8f, 0x27, 0xe3, 0xf9, 0xa1, 0xf5, 0x78, 0x72, 0x28, 0x3f, 0xbc, 0x1e,
+	0x4f, 0xa6, 0xf2, 0xb0, 0x1e, 0x4f, 0x42, 0x3e, 0x5d, 0xf8, 0x65, 0x02, 0x20, 0x64, 0x0c, 0x5f,
+	0x82, 0x44, 0x93, 0xe5, 0xec, 0xa9, 0xd7, 0x91, 0xa1, 0x29, 0xbe, 0x6f, 0xc7, 0xf3, 0xa3, 0xf2,
+	0x25, 0x45, 0xcc, 0xa0, 0x3b, 0x90, 0x10, 0x46, 0x32, 0x76, 0x7a, 0x23, 0x19, 0xda, 0x29, 0xc1,
+	0xfe, 0x22, 0xed, 0x47, 0x37, 0x20, 0xa7, 0xdb, 0x8e, 0x83, 0xeb, 0xec, 0xda, 0x33, 0x0d, 0x57,
+	0x8e, 0xd3, 0xca, 0x4f, 0xe6, 0xa8, 0x96, 0x7a, 0x26, 0x0d, 0x17, 0xe3, 0x4e, 0x4c, 0x36, 0x94,
+	0x6c, 0x88, 0x68, 0xcd, 0x70, 0x3b, 0x1b, 0xcc, 0x86, 0x7e, 0xd7, 0x60, 0xf6, 0x79, 0x37, 0x98,
+	0xfd, 0x4b, 0x0c, 0x86, 0x2d, 0xec, 0xa9, 0xa6, 0x41, 0x75, 0x29, 0x53, 0xfb, 0xbb, 0xd8, 0xb3,
+	0xea, 0xd4, 0x7a, 0xb2, 0xb8, 0xb8, 0xb8, 0xb8, 0x78, 0x7d, 0x29, 0x6a, 0xaf, 0x07, 0xbf, 0x00,
+	0x7b, 0xbd, 0xf4, 0x59, 0xef, 0xf5, 0xd2, 0xe7, 0xb7, 0xd7, 0x43, 0x16, 0xf6, 0xd6, 0x8c, 0x50,
+	0xd6, 0xfd, 0x3f, 0x12, 0x30, 0xd2, 0xe1, 0x19, 0xa0, 0x37, 0x02, 0xb5, 0x66, 0x1a, 0x3b, 0xd5,
+	0xa7, 0x60, 0x17, 0x56, 0x52, 0xa1, 0xee, 0x4f, 0x20, 0xe9, 0xdb, 0x43, 0x96, 0x81, 0xbe, 0x7e,
+	0x3a, 0x87, 0xa4, 0xbc, 0xd5, 0x8e, 0x30, 0x93, 0x3e, 0x18, 0x52, 0x20, 0xed, 0xb4, 0x55, 0xd1,
+	0x5e, 0x4a, 0x0b, 0xb7, 0xa7, 0xc7, 0x56, 0xda, 0x1b, 0x9c, 0x51, 0x01, 0xc7, 0x7f, 0x46, 0x6f,
+	0x40, 0x9a, 0x18, 0x7d, 0xf3, 0x80, 0xf5, 0xfb, 0x0d, 0x9f, 0x78, 0x53, 0x82, 0x20, 0xa7, 0x2e,
+	0x7e, 0x8f, 0x4d, 0x49, 0x9c, 0xc2, 0xa6, 0xdc, 0x84, 0x71, 0x11, 0x05, 0xb1, 0x66, 0x09, 0x9e,
+	0xa4, 0x48, 0xd1, 0xa2, 0x1a, 0xf9, 0xf2, 0xab, 0x83, 0xf2, 0x7f, 0x4b, 0x0a, 0xe2, 0x61, 0x0f,
+	0x23, 0xa2, 0x3e, 0x42, 0xe1, 0x21, 0x40, 0xb0, 0x4b, 0xe8, 0x16, 0xa4, 0xfc, 0xdb, 0x99, 0x87,
+	0x7d, 0x72, 0x3f, 0x07, 0x27, 0xbc, 0xa5, 0xa2, 0x0e, 0x48, 0x4d, 0x3e, 0x14, 0xfe, 0x69, 0x10,
+	0x20, 0xd8, 0x1f, 0xb4, 0x01, 0xe9, 0x5d, 0xcd, 0xc3, 0x4f, 0xb5, 0x43, 0x35, 0x68, 0x23, 0xe8,
+	0xc9, 0x9d, 0x7c, 0x9d, 0x91, 0x44, 0x77, 0x0f, 0xc0, 0xae, 0x98, 0x75, 0xd1, 0x25, 0xc8, 0x88,
+	0xef, 0x74, 0x5c, 0xd7, 0xa4, 0x9f, 0x19, 0x53, 0xd2, 0x7c, 0x4c, 0x71, 0x5d, 0x13, 0xe5, 0x61,
+	0xd0, 0xb5, 0x1c, 0xea, 0x97, 0xc7, 0x14, 0xf2, 0x88, 0x3e, 0x02, 0xd9, 0xbf, 0x5d, 0x9b, 0x9a,
+	0xb7, 0x47, 0xe2, 0x33, 0xd7, 0x73, 0x34, 0xd3, 0xf2, 0x78, 0xcb, 0x57, 0x8f, 0x97, 0x2b, 0xdc,
+	0x97, 0x4d, 0xcd, 0xdb, 0x5b, 0xf6, 0xa9, 0xc3, 0xed, 0x58, 0x46, 0x24, 0x05, 0x59, 0x20, 0xcf,
+	0x33, 0x78, 0xf6, 0x3e, 0xb6, 0x68, 0x26, 0x37, 0xa3, 0xa4, 0xd9, 0xd8, 0x16, 0x19, 0x42, 0xfb,
+	0x30, 0xd2, 0xd4, 0xf4, 0x7d, 0xec, 0xa9, 0xdb, 0x8e, 0xbd, 0x8f, 0x1d, 0x9e, 0x00, 0xbd, 0x7d,
+	0x66, 0xe1, 0x2b, 0x6f, 0x52, 0x98, 0x1a, 0x45, 0xf1, 0x25, 0x32, 0xd3, 0x0c, 0x8d, 0x16, 0xce,
+	0xc1, 0x78, 0x14, 0x15, 0xbd, 0xa5, 0xd3, 0xf4, 0xc8, 0x52, 0xec, 0x36, 0x5e, 0x8f, 0x27, 0x33,
+	0xf9, 0x91, 0xf5, 0x78, 0x72, 0x24, 0x9f, 0x5d, 0x8f, 0x27, 0xf3, 0xf9, 0xd1, 0xf5, 0x78, 0x72,
+	0x34, 0x8f, 0xd6, 0xe3, 0xc9, 0xb1, 0xfc, 0xf8, 0x7a, 0x3c, 0x39, 0x9e, 0x9f, 0x58, 0x8f, 0x27,
+	0xf5, 0xbc, 0xe1, 0xdf, 0xf0, 0xec, 0x6e, 0x4f, 0x0a, 0x84, 0xc2, 0x0f, 0xe3, 0x90, 0xeb, 0x72,
+	0xe2, 0xd1, 0x7a, 0xb7, 0xf6, 0x2f, 0x9e, 0xd6, 0xff, 0x17, 0x66, 0x21, 0x30, 0x06, 0x11, 0x2a,
+	0x32, 0x7c, 0xb2, 0x8a, 0x14, 0xfe, 0x3d, 0x06, 0x09, 0xb1, 0x9c, 0x2d, 0x18, 0x6a, 0xa8, 0x7b,
+	0x86, 0xc3, 0x05, 0xf1, 0xc6, 0x59, 0x17, 0x53, 0xde, 0xb8, 0xb3, 0xa2, 0x84, 0xfd, 0x81, 0xc6,
+	0x1d, 0xc3, 0x41, 0xef, 0x41, 0x9a, 0xb8, 0x8c, 0xe2, 0x43, 0x99, 0x47, 0xf1, 0xc6, 0xd9, 0xb1,
+	0xab, 0xcb, 0xbc, 0x69, 0x41, 0x81, 0x86, 0xa6, 0xf3, 0xe7, 0xc2, 0x0a, 0xc4, 0xc9, 0x5b, 0xd1,
+	0x6b, 0x30, 0xdc, 0x50, 0xc9, 0x5d, 0xc1, 0x4b, 0xf5, 0x3d, 0xa9, 0x8e, 0x8d, 0xad, 0xc3, 0x66,
+	0xb8, 0xef, 0x71, 0xa8, 0x41, 0x06, 0x98, 0x2b, 0x56, 0x78, 0x1f, 0x20, 0xc0, 0x47, 0x17, 0x61,
+	0x78, 0x47, 0x6d, 0xda, 0x8e, 0xc7, 0xcb, 0xf4, 0x81, 0xa1, 0x18, 0xda, 0xd9, 0xb4, 0x1d, 0x0f,
+	0x9d, 0x03, 0xd8, 0x69, 0xd5, 0xeb, 0xbc, 0x1e, 0xc1, 0xfa, 0xa6, 0x93, 0x64, 0x84, 0x38, 0xcb,
+	0x5d, 0x12, 0xc0, 0xbc, 0x3b, 0xe6, 0xef, 0xc5, 0xf3, 0xc9, 0xd0, 0x2c, 0xf1, 0xf7, 0x12, 0xf9,
+	0x64, 0xe1, 0xaf, 0x25, 0x18, 0xe9, 0x70, 0xd2, 0xfb, 0x35, 0x58, 0x49, 0x9f, 0x53, 0x83, 0x55,
+	0xec, 0x05, 0x1b, 0xac, 0x42, 0x37, 0x9c, 0x01, 0xd9, 0xae, 0x78, 0xe3, 0x0e, 0x0c, 0xf3, 0x68,
+	0x46, 0x3a, 0x21, 0x66, 0xee, 0x60, 0x0c, 0xf5, 0x2b, 0x72, 0xfe, 0xd0, 0x5b, 0x1c, 0x98, 0x39,
+	0x26, 0xf4, 0x21, 0x46, 0x6e, 0x1f, 0xf3, 0x26, 0x38, 0x85, 0x3c, 0xa2, 0x37, 0x45, 0xb3, 0x76,
+	0x9f, 0x5e, 0x93, 0xe8, 0x35, 0xb8, 0xa2, 0xab, 0x3b, 0xf6, 0x95, 0x50, 0x57, 0x58, 0xf1, 0xe7,
+	0x12, 0xcc, 0xf8, 0xad, 0x5d, 0xd5, 0x96, 0xb7, 0x47, 0xec, 0x32, 0x4b, 0xce, 0x2c, 0xdb, 0x06,
+	0x46, 0x8b, 0xe1, 0xce, 0xf0, 0x54, 0xad, 0x70, 0x54, 0x9b, 0x72, 0x26, 0x2a, 0x63, 0x1f, 0xbc,
+	0xab, 0xcd, 0x7f, 0xbb, 0x3a, 0xff, 0xce, 0xe2, 0xfc, 0xeb, 0xef, 0x7f, 0x7c, 0xfd, 0xda, 0x52,
+	0xe5, 0x93, 0x39, 0x8e, 0x8f, 0x5e, 0x07, 0xa0, 0xbf, 0xf6, 0x51, 0x77, 0x1c, 0xbb, 0xc1, 0xd7,
+	0x78, 0x6c, 0x2b, 0x3c, 0xa5, 0xbe, 0xed, 0xd8, 0x0d, 0x74, 0x03, 0x92, 0x8c, 0xd5, 0xb3, 0xb9,
+	0x6a, 0x1d, 0xc7, 0x98, 0xa0, 0xb4, 0x5b, 0x76, 0xe8, 0x6b, 0xfe, 0xef, 0x25, 0x48, 0xf9, 0x5f,
+	0x83, 0xee, 0x84, 0x1b, 0xda, 0xe6, 0xfa, 0x36, 0xb4, 0x85, 0xef, 0xa2, 0xbc, 0xd0, 0x77, 0x0a,
+	0x39, 0x50, 0xe2, 0x1d, 0x6d, 0xcb, 0x00, 0xba, 0x83, 0x35, 0xde, 0xde, 0x7f, 0xe2, 0x37, 0xd5,
+	0xd8, 0x8a, 0x06, 0xf2, 0x03, 0x4a, 0x8a, 0xf3, 0x55, 0x3d, 0x02, 0xd2, 0x6a, 0x1a, 0x02, 0x64,
+	0xf0, 0x2c, 0x20, 0x9c, 0xaf, 0xea, 0xa1, 0x19, 0x88, 0x5b, 0x5a, 0x03, 0x77, 0x76, 0xaa, 0x55,
+	0x14, 0x3a, 0x88, 0xae, 0x42, 0xda, 0xc0, 0xae, 0xee, 0x98, 0xf4, 0x57, 0x30, 0x54, 0x97, 0x59,
+	0x8f, 0x9a, 0x33, 0x28, 0xff, 0x22, 0xa7, 0x84, 0x27, 0xd1, 0x77, 0x25, 0x00, 0xcd, 0xf3, 0x1c,
+	0x73, 0xbb, 0xe5, 0x61, 0x66, 0x5e, 0xd3, 0x95, 0x2b, 0x7d, 0x37, 0xa9, 0x5c, 0xf5, 0x69, 0xa9,
+	0x5c, 0xd6, 0x6e, 0x1c, 0xd5, 0x2a, 0x3f, 0x92, 0x16, 0xf2, 0x50, 0x9c, 0x73, 0x8a, 0xf2, 0x5c,
+	0xe5, 0x02, 0x15, 0x09, 0x22, 0x0f, 0xa5, 0x5b, 0x37, 0xdf, 0x9d, 0x7f, 0xff, 0x96, 0xf8, 0xf3,
+	0xca, 0xc7, 0x95, 0x6b, 0x9f, 0xcc, 0x5d, 0x25, 0x6b, 0xf8, 0x99, 0xa4, 0x84, 0xde, 0x89, 0xee,
+	0x41, 0x9a, 0xe7, 0x62, 0xb9, 0x13, 0x74, 0xd6, 0xc6, 0x43, 0x05, 0x0e, 0xc4, 0x18, 0x09, 0xd5,
+	0x90, 0x8b, 0x1d, 0xea, 0x20, 0x35, 0x1d, 0x7b, 0xc7, 0xac, 0x63, 0x12, 0x0e, 0x24, 0x3b, 0x3b,
+	0xfa, 0xf2, 0x9c, 0x64, 0x93, 0x51, 0xac, 0x19, 0xe8, 0xa7, 0x12, 0x4c, 0x8a, 0x34, 0x13, 0x99,
+	0xc4, 0x0e, 0x8d, 0xda, 0xb0, 0xeb, 0x52, 0x97, 0x23, 0x55, 0xfb, 0x3d, 0xe9, 0xa8, 0xf6, 0x7d,
+	0xc9, 0xf9, 0x7f, 0x52, 0xe5, 0x7f, 0x4b, 0x1f, 0x94, 0x6e, 0xdd, 0x24, 0x9f, 0x17, 0x08, 0xff,
+	0x77, 0x42, 0xcf, 0xc1, 0xe3, 0x7b, 0xf3, 0xef, 0x5f, 0x0d, 0x4d, 0x5c, 0x79, 0xaf, 0x7c, 0xe5,
+	0x2a, 0xe1, 0xab, 0xce, 0xbf, 0xc3, 0x77, 0xe5, 0x3b, 0xa1, 0xe7, 0xe0, 0x91, 0xf2, 0x05, 0x13,
+	0x57, 0x4a, 0xb7, 0x6e, 0xde, 0x7c, 0x97, 0xeb, 0xd8, 0x8d, 0x4f, 0xae, 0xdc, 0x9a, 0xfb, 0xce,
+	0x07, 0x73, 0xca, 0x38, 0x5f, 0xee, 0x43, 0xba, 0xda, 0x2a, 0x5b, 0x2c, 0xaa, 0x82, 0xdc, 0xf5,
+	0x19, 0xfb, 0x78, 0x5f, 0xad, 0x6b, 0xdb, 0xb8, 0x2e, 0x2f, 0x84, 0x05, 0xe1, 0xbb, 0x79, 0x65,
+	0xa2, 0x03, 0xe1, 0x2e, 0xde, 0xbf, 0x47, 0xc8, 0xd0, 0xdf, 0x48, 0x50, 0x08, 0x27, 0x79, 0xbb,
+	0xb6, 0x03, 0xbe, 0x98, 0xdb, 0x21, 0x87, 0x96, 0xdc, 0xb9, 0x25, 0x6b, 0x70, 0x2e, 0xe2, 0x73,
+	0x82, 0x6d, 0x59, 0xec, 0xda, 0x96, 0xe9, 0x1e, 0x24, 0x7f, 0x6b, 0xde, 0x80, 0x89, 0x08, 0x28,
+	0xd3, 0x90, 0xaf, 0x87, 0xe5, 0xcb, 0x50, 0xc6, 0x7a, 0x20, 0xd6, 0x0c, 0xf4, 0x97, 0x12, 0x8c,
+	0xd1, 0xa4, 0x6f, 0xd7, 0x86, 0xa6, 0xbf, 0x98, 0x1b, 0x3a, 0x4a, 0xd6, 0xda, 0xb9, 0x93, 0x1e,
+	0xa4, 0xea, 0x36, 0xfb, 0x2a, 0x57, 0xce, 0x44, 0x67, 0x77, 0x03, 0x53, 0x71, 0x4f, 0x90, 0x32,
+	0x4b, 0x71, 0xed, 0xa8, 0x76, 0xe5, 0x47, 0xd2, 0xe5, 0xd3, 0xd9, 0x09, 0x25, 0x78, 0x11, 0xba,
+	0x0e, 0x09, 0xfe, 0x9b, 0x3f, 0xb9, 0x12, 0x1d, 0x4f, 0x6e, 0xb2, 0x69, 0x45, 0xd0, 0x45, 0xb6,
+	0xdf, 0x8e, 0x9c, 0xba, 0xfd, 0x36, 0xdb, 0xa7, 0xfd, 0xb6, 0xa7, 0x74, 0x94, 0xfb, 0xec, 0xdb,
+	0x99, 0xf3, 0x9f, 0x43, 0x3b, 0xf3, 0xe8, 0x09, 0xed, 0xcc, 0x3d, 0x9d, 0xc0, 0xe8, 0x34, 0x9d,
+	0xc0, 0x63, 0xa7, 0xe9, 0x04, 0x1e, 0x3f, 0x75, 0x27, 0xf0, 0x44, 0x9f, 0x4e, 0xe0, 0x1b, 0x90,
+	0x72, 0x6c, 0xdb, 0x53, 0x69, 0xea, 0x6e, 0x32, 0x3a, 0x4a, 0x55, 0x6c, 0xdb, 0xbb, 0x8b, 0x0f,
+	0x5d, 0x25, 0xe9, 0xf0, 0xa7, 0x70, 0x2a, 0x68, 0xea, 0x77, 0xa9, 0xa0, 0xcf, 0x31, 0x15, 0x84,
+	0xbe, 0x06, 0x99, 0x8e, 0x76, 0x74, 0xf9, 0xe4, 0x76, 0x74, 0x12, 0x3c, 0xf9, 0x69, 0x88, 0x1b,
+	0x90, 0xa2, 0xfc, 0xc4, 0x57, 0xe5, 0xfd, 0x81, 0x72, 0x3f, 0x5f, 0x56, 0x49, 0x12, 0x4e, 0x5a,
+	0xbd, 0x5e, 0x81, 0x51, 0x51, 0x49, 0x0b, 0xd8, 0xaf, 0x9d, 0xc0, 0x2e, 0x4a, 0x7c, 0x1b, 0x02,
+	0xe5, 0x3a, 0x24, 0x5c, 0x96, 0xf7, 0x95, 0x0b, 0xd1, 0xb6, 0x85, 0xa7, 0x85, 0x15, 0x41, 0x87,
+	0xde, 0x02, 0x81, 0xa2, 0x0a, 0xd6, 0x99, 0xe3, 0x59, 0xb3, 0x9c, 0x5e, 0xfc, 0xee, 0x78, 0x0e,
+	0xb2, 0x7e, 0x71, 0x97, 0x4a, 0x3f, 0x6d, 0xfd, 0x18, 0x51, 0x32, 0xbc, 0xa4, 0x4b, 0x25, 0x1f,
+	0x5d, 0x86, 0x5c, 0xcb, 0xc5, 0x46, 0x40, 0xe5, 0xca, 0xe7, 0x67, 0x07, 0x4b, 0x23, 0xca, 0x08,
+	0x19, 0x16, 0x64, 0x2e, 0xa1, 0xa3, 0x68, 0x81, 0x32, 0xd1, 0x5e, 0x0c, 0xfe, 0x5b, 0x56, 0x5f,
+	0x93, 0xd0, 0xcb, 0x9c, 0xce, 0xf9, 0x88, 0xf7, 0x78, 0x2d, 0xd2, 0xee, 0x0a, 0xfe, 0x5a, 0xe5,
+	0x23, 0xda, 0xbb, 0xb5, 0xd8, 0x4b, 0x76, 0x9d, 0x76, 0x4d, 0x74, 0x92, 0x5d, 0x47, 0xdf, 0x80,
+	0x99, 0xee, 0x02, 0x75, 0x38, 0x15, 0x76, 0xe9, 0x74, 0xa5, 0x6f, 0xbf, 0x7e, 0xad, 0x04, 0x79,
+	0xb1, 0xbb, 0x90, 0x66, 0x85, 0x30, 0x76, 0xa4, 0xc5, 0x3e, 0x06, 0x91, 0x90, 0xd0, 0x33, 0xac,
+	0x65, 0x85, 0x41, 0xfc, 0xf4, 0xf9, 0x74, 0x2c, 0x3f, 0xa0, 0x40, 0xd3, 0x9f, 0x43, 0xef, 0x02,
+	0xda, 0xa6, 0xfd, 0xfe, 0x87, 0x6a, 0x13, 0x3b, 0x3a, 0xb6, 0x3c, 0x6d, 0x17, 0xf3, 0x26, 0x8a,
+	0x63, 0xfb, 0xf5, 0x72, 0x47, 0xb5, 0x0c, 0xc0, 0xf9, 0x81, 0x81, 0xef, 0xde, 0x9a, 0x1f, 0x18,
+	0x18, 0x18, 0x50, 0x46, 0x39, 0xce, 0xa6, 0x0f, 0x83, 0x5e, 0x81, 0x9c, 0x9f, 0x66, 0xe2, 0x9d,
+	0x80, 0x73, 0xb3, 0x52, 0x69, 0x48, 0xc9, 0x8a, 0x61, 0xde, 0xed, 0x77, 0xd2, 0x6f, 0x7b, 0x4b,
+	0x9f, 0xc9, 0x6f, 0x7b, 0xd1, 0x1d, 0x80, 0xd0, 0x8f, 0x25, 0xae, 0x9c, 0xed, 0xc7, 0x12, 0x4a,
+	0x88, 0x17, 0x3d, 0x80, 0x6c, 0xd3, 0xb1, 0x0f, 0x4c, 0x22, 0xae, 0xcc, 0xc1, 0xb9, 0x4a, 0xef,
+	0x90, 0xab, 0x47, 0xb5, 0x57, 0x9c, 0x97, 0xe5, 0xb9, 0xca, 0xa5, 0xe3, 0xaf, 0x6f, 0xe2, 0x3f,
+	0x8c, 0x84, 0x10, 0xd6, 0x0c, 0xaa, 0xaf, 0x62, 0x80, 0xe8, 0x0e, 0xcd, 0xc2, 0x7e, 0x89, 0x2b,
+	0x4e, 0xf7, 0x41, 0x3c, 0xa4, 0xff, 0x16, 0x82, 0x92, 0x0f, 0x73, 0x90, 0x30, 0x16, 0x9d, 0x83,
+	0x54, 0xa3, 0x55, 0x27, 0xa1, 0xa9, 0xeb, 0xc9, 0xf3, 0xf4, 0xca, 0x08, 0x06, 0xd0, 0x2e, 0x4c,
+	0xeb, 0x75, 0xcd, 0x6c, 0xa8, 0x5a, 0x47, 0x04, 0xab, 0xea, 0xb6, 0x81, 0xe5, 0xf2, 0x09, 0x71,
+	0x45, 0x6f, 0xd4, 0x4b, 0x9b, 0x8b, 0xcc, 0x46, 0x44, 0x38, 0x5c, 0x86, 0x31, 0x77, 0xdf, 0x6c,
+	0x8a, 0x04, 0x90, 0xaa, 0x3b, 0x87, 0x4d, 0xcf, 0x96, 0x97, 0xe8, 0x82, 0x46, 0xc9, 0x14, 0xdf,
+	0xdf, 0x65, 0x3a, 0x81, 0xde, 0x85, 0x73, 0x11, 0xf4, 0xaa, 0x7d, 0x80, 0x1d, 0xc7, 0x34, 0xb0,
+	0xfc, 0x6a, 0x1f, 0x75, 0x09, 0xda, 0x7a, 0xa6, 0x7b, 0x40, 0xdf, 0xe6, 0xcc, 0xe8, 0x4d, 0xc8,
+	0x68, 0xba, 0x67, 0x1e, 0x88, 0x90, 0xf2, 0xc6, 0x89, 0xba, 0x97, 0xf6, 0xe9, 0xab, 0x1e, 0xfa,
+	0x2a, 0x50, 0xcd, 0x56, 0x5d, 0x8c, 0x2d, 0xc2, 0xfe, 0xda, 0xc9, 0x59, 0x6c, 0x42, 0xff, 0x10,
+	0x63, 0xab, 0xea, 0x21, 0x13, 0x66, 0xa2, 0x64, 0x5a, 0x6d, 0xda, 0x75, 0x53, 0x3f, 0x94, 0xbf,
+	0x4c, 0xc1, 0xae, 0x9c, 0x42, 0xb2, 0x37, 0x29, 0x43, 0x87, 0xf3, 0xdd, 0x39, 0x55, 0x78, 0x13,
+	0x72, 0x5d, 0xd1, 0x67, 0x38, 0x2b, 0x92, 0x62, 0x59, 0x91, 0xf1, 0x70, 0x56, 0x24, 0x15, 0x4a,
+	0x76, 0x14, 0x1e, 0x43, 0xb6, 0xd3, 0x23, 0x8d, 0xe0, 0x2e, 0x77, 0xe6, 0x54, 0x7a, 0x2e, 0x12,
+	0x01, 0x10, 0x99, 0x44, 0x59, 0x8f, 0x27, 0x5f, 0xce, 0x5f, 0x5e, 0x8f, 0x27, 0x2f, 0xe7, 0x5f,
+	0x59, 0x8f, 0x27, 0x5f, 0xc9, 0x97, 0x8a, 0x77, 0x00, 0x7c, 0xf9, 0x72, 0xd1, 0x4d, 0x48, 0x07,
+	0xff, 0xaa, 0x8a, 0xc8, 0x1d, 0x4d, 0xf7, 0x15, 0x48, 0x05, 0xb0, 0xcf, 0x5b, 0xfc, 0x54, 0x82,
+	0x91, 0x15, 0x56, 0xe6, 0xda, 0x74, 0xf0, 0x8e, 0xd9, 0x46, 0x3f, 0x93, 0x42, 0xc5, 0x45, 0x56,
+	0x3f, 0xfd, 0x13, 0xe9, 0xb7, 0xa9, 0xb8, 0x18, 0xd4, 0xed, 0x26, 0x61, 0xb8, 0x8e, 0xad, 0x5d,
+	0x6f, 0x8f, 0x25, 0x27, 0x15, 0xfe, 0x57, 0xf1, 0x3d, 0x98, 0x5c, 0xa6, 0x79, 0x93, 0x60, 0x4f,
+	0x78, 0x2d, 0xb7, 0x06, 0x10, 0x6c, 0xa5, 0xff, 0x43, 0x8d, 0x7e, 0x3b, 0x19, 0xca, 0xdf, 0xa6,
+	0xfc, 0x3d, 0x2d, 0xfe, 0x50, 0x82, 0xc9, 0x47, 0x34, 0xa3, 0xf2, 0x79, 0xc0, 0xa3, 0xd7, 0x01,
+	0x82, 0x7f, 0xf4, 0xa5, 0x6f, 0xb2, 0xe8, 0x36, 0x21, 0xd9, 0xd0, 0xdc, 0x7d, 0x25, 0xb5, 0x23,
+	0x1e, 0x8b, 0x7f, 0x1c, 0x83, 0x97, 0x6a, 0x9a, 0xa7, 0xef, 0x75, 0x2d, 0xef, 0x1e, 0xd7, 0x3b,
+	0xb1, 0x4c, 0x0c, 0x09, 0x96, 0x12, 0x12, 0xc2, 0x74, 0xb7, 0xa7, 0x2f, 0xf0, 0x64, 0x94, 0x72,
+	0xcf, 0x04, 0xa3, 0x57, 0x04, 0x76, 0xe1, 0x0f, 0x24, 0x98, 0xea, 0x43, 0x84, 0xde, 0x3a, 0x7b,
+	0x72, 0xad, 0xeb, 0x67, 0xa2, 0xdd, 0xf6, 0x27, 0x76, 0x16, 0xfb, 0x53, 0xfc, 0x23, 0x09, 0xc6,
+	0x88, 0x5b, 0xda, 0x7d, 0x82, 0x5b, 0x90, 0x0d, 0x4e, 0x50, 0xfd, 0xf5, 0x97, 0x98, 0xc1, 0xc1,
+	0xbc, 0xfb, 0x22, 0x67, 0xfa, 0xf3, 0x41, 0x78, 0x39, 0xbc, 0xd0, 0xd0, 0xeb, 0x6e, 0xdb, 0xce,
+	0xea, 0xa3, 0x35, 0x57, 0x2c, 0xfd, 0x17, 0x12, 0x24, 0xa9, 0x33, 0x87, 0x5b, 0x26, 0x57, 0xec,
+	0x3f, 0x97, 0x9e, 0x55, 0x2f, 0xad, 0xa3, 0xe2, 0x97, 0x17, 0x6b, 0x4b, 0x2b, 0x37, 0xbe, 0xbc,
+	0xba, 0xb2, 0xd8, 0x5f, 0xc1, 0x93, 0x5f, 0x00, 0x05, 0xff, 0x0a, 0x57, 0x70, 0xf2, 0x19, 0xab,
+	0x2d, 0x13, 0xfd, 0xad, 0x04, 0x44, 0xd9, 0xe9, 0x17, 0xc5, 0x7e, 0x9b, 0xbf, 0x68, 0xd8, 0xc0,
+	0x07, 0xab, 0x2d, 0xb3, 0x78, 0x14, 0x83, 0x89, 0x7b, 0xa6, 0x1b, 0x1c, 0xa7, 0x7f, 0x7a, 0x6f,
+	0x43, 0x2e, 0x7c, 0x21, 0x06, 0x92, 0x77, 0xf9, 0x98, 0x4b, 0x30, 0x9c, 0xcc, 0xcc, 0x6a, 0xe1,
+	0xf1, 0x17, 0x91, 0x39, 0xf4, 0x63, 0x09, 0x86, 0x6c, 0xc7, 0xc0, 0x0e, 0x4d, 0x33, 0xa7, 0x6a,
+	0xff, 0x5f, 0x3a, 0xaa, 0xfd, 0x1f, 0xc9, 0xf9, 0x9e, 0xa4, 0x0c, 0x28, 0x29, 0x5f, 0x35, 0x14,
+	0x98, 0x0f, 0x9e, 0x7d, 0xc9, 0x53, 0x52, 0xf3, 0xfe, 0xa3, 0x38, 0x3b, 0x25, 0x39, 0x2f, 0x9e,
+	0x68, 0xa6, 0x59, 0x19, 0x9a, 0xa7, 0xff, 0x0b, 0x67, 0x94, 0x95, 0xcc, 0x7c, 0xf8, 0xaf, 0x50,
+	0xc2, 0x5c, 0x49, 0xcf, 0x87, 0xfe, 0x60, 0x0b, 0x43, 0x17, 0x60, 0x88, 0xfd, 0xe3, 0x2a, 0xf1,
+	0x70, 0x59, 0xea, 0x5f, 0x13, 0x0a, 0x1b, 0x46, 0x08, 0xe2, 0x4d, 0xe2, 0xb2, 0xb3, 0x82, 0x14,
+	0x7d, 0x2e, 0xfe, 0xbd, 0x04, 0x63, 0x0f, 0x23, 0x74, 0xfe, 0x37, 0x6b, 0xb5, 0xd1, 0x9b, 0x30,
+	0x43, 0x9d, 0x3c, 0xdf, 0x8f, 0x56, 0x3d, 0xec, 0x7a, 0xea, 0x01, 0xd6, 0x3d, 0xdb, 0x61, 0x6d,
+	0x47, 0x49, 0x45, 0x26, 0x24, 0xbe, 0xd7, 0xbd, 0x85, 0x5d, 0xef, 0x31, 0x9b, 0x2f, 0xfe, 0xa9,
+	0x04, 0x33, 0x0a, 0x76, 0xb1, 0x57, 0xb5, 0x8c, 0xdf, 0x0a, 0x8b, 0xf6, 0x13, 0x09, 0x46, 0xfd,
+	0x77, 0x6d, 0xe1, 0x46, 0xb3, 0x4e, 0x2e, 0x84, 0xdf, 0xf0, 0x21, 0x94, 0x20, 0xdd, 0xd0, 0x9a,
+	0xb4, 0x09, 0x9a, 0xf8, 0x76, 0x83, 0x9d, 0x79, 0x59, 0xe0, 0x73, 0x77, 0xf1, 0x61, 0xf1, 0x2f,
+	0xc2, 0xb7, 0x9a, 0x58, 0x3e, 0x3b, 0x19, 0xbf, 0xbc, 0x22, 0x75, 0xb2, 0x47, 0x96, 0x57, 0x62,
+	0xe1, 0xf4, 0xf1, 0xcf, 0xa4, 0xce, 0xf2, 0xca, 0x16, 0xe4, 0x68, 0x09, 0x02, 0xb7, 0x3d, 0x6c,
+	0xb9, 0x34, 0x6f, 0x3a, 0x48, 0x2b, 0xd8, 0x5f, 0x3a, 0xaa, 0x95, 0x9e, 0x49, 0x2f, 0xe7, 0x0d,
+	0x59, 0x2a, 0x5e, 0x74, 0xce, 0x57, 0x66, 0x3e, 0x28, 0xdd, 0xba, 0xf9, 0x5e, 0x59, 0x84, 0x51,
+	0x1f, 0x5f, 0xbf, 0x76, 0xfd, 0xb5, 0x4f, 0xae, 0x7c, 0x7c, 0xfd, 0x5a, 0xe5, 0x93, 0x39, 0x25,
+	0x4b, 0x30, 0x56, 0x7d, 0x88, 0xe2, 0x7f, 0x49, 0x20, 0xf7, 0x59, 0xba, 0x8b, 0x3e, 0x81, 0x04,
+	0x93, 0x40, 0xe1, 0x14, 0xdc, 0xe8, 0xbb, 0xfb, 0x5d, 0xac, 0x65, 0xfe, 0xff, 0x5f, 0x27, 0x5f,
+	0x2b, 0xde, 0x59, 0xd0, 0x21, 0x13, 0x86, 0x89, 0x70, 0xb2, 0x4f, 0x2a, 0x5c, 0xf6, 0x59, 0x5e,
+	0xc8, 0xe7, 0x2e, 0xfe, 0xa3, 0x04, 0x17, 0x97, 0x6d, 0xeb, 0x00, 0x3b, 0x5e, 0x0f, 0xb5, 0xd0,
+	0x97, 0x15, 0x48, 0xb1, 0x35, 0x91, 0xf0, 0x95, 0x1d, 0xe4, 0x2b, 0x47, 0xb5, 0xd3, 0x7d, 0x4d,
+	0x92, 0x71, 0xae, 0x19, 0xc4, 0xfe, 0xd0, 0x48, 0x95, 0xde, 0x5a, 0x0a, 0x7d, 0x46, 0x1f, 0xc2,
+	0x64, 0x48, 0x13, 0xc3, 0xb5, 0xab, 0xc1, 0xb3, 0xd7, 0xae, 0xc6, 0x70, 0xcf, 0xa4, 0x7b, 0xf5,
+	0x11, 0x40, 0x90, 0xe0, 0x40, 0xa3, 0x30, 0xb2, 0xf9, 0xf6, 0x93, 0x55, 0x45, 0x7d, 0x74, 0xff,
+	0xee, 0xfd, 0xb7, 0x9f, 0xdc, 0xcf, 0x0f, 0x04, 0x43, 0xb5, 0xea, 0xd6, 0xd6, 0xaa, 0xf2, 0xcd,
+	0xbc, 0x84, 0x10, 0x64, 0xd9, 0xd0, 0xea, 0x37, 0xb6, 0x56, 0x95, 0xfb, 0xd5, 0x7b, 0xf9, 0x58,
+	0x61, 0xe4, 0x57, 0xcf, 0xa7, 0x53, 0xb2, 0x74, 0x75, 0x88, 0xce, 0xd4, 0x6e, 0xfc, 0xd5, 0x3f,
+	0x5f, 0x90, 0xde, 0x59, 0x38, 0xc3, 0x25, 0xe8, 0x59, 0xcd, 0xed, 0xed, 0x61, 0xaa, 0x72, 0x4b,
+	0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xeb, 0xb2, 0x24, 0x26, 0x52, 0x00, 0x00,
 }
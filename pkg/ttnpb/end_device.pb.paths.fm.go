@@ -105,6 +105,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_formatters",
 	"default_formatters.down_formatter",
 	"default_formatters.down_formatter_parameter",
+	"default_formatters.test_vectors",
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"default_mac_settings",
@@ -433,6 +434,10 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.f_port",
 	"pending_application_downlink.frm_payload",
 	"pending_application_downlink.priority",
+	"pending_application_downlink.scheduling",
+	"pending_application_downlink.scheduling.deduplication_key",
+	"pending_application_downlink.scheduling.expires_at",
+	"pending_application_downlink.scheduling.not_before",
 	"pending_application_downlink.session_key_id",
 	"pending_join_request",
 	"pending_join_request.cf_list",
@@ -529,6 +534,9 @@ var EndDeviceAuthenticationCodeFieldPathsTopLevel = []string{
 }
 var EndDeviceFieldPathsNested = []string{
 	"activated_at",
+	"application_downlink_policy",
+	"application_downlink_policy.default_ttl",
+	"application_downlink_policy.order_by_priority",
 	"application_server_address",
 	"application_server_id",
 	"application_server_kek_label",
@@ -544,6 +552,7 @@ var EndDeviceFieldPathsNested = []string{
 	"formatters",
 	"formatters.down_formatter",
 	"formatters.down_formatter_parameter",
+	"formatters.test_vectors",
 	"formatters.up_formatter",
 	"formatters.up_formatter_parameter",
 	"frequency_plan_id",
@@ -717,6 +726,10 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
 	"mac_state.pending_application_downlink.priority",
+	"mac_state.pending_application_downlink.scheduling",
+	"mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"mac_state.pending_application_downlink.scheduling.expires_at",
+	"mac_state.pending_application_downlink.scheduling.not_before",
 	"mac_state.pending_application_downlink.session_key_id",
 	"mac_state.pending_join_request",
 	"mac_state.pending_join_request.cf_list",
@@ -856,6 +869,10 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_application_downlink.f_port",
 	"pending_mac_state.pending_application_downlink.frm_payload",
 	"pending_mac_state.pending_application_downlink.priority",
+	"pending_mac_state.pending_application_downlink.scheduling",
+	"pending_mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"pending_mac_state.pending_application_downlink.scheduling.expires_at",
+	"pending_mac_state.pending_application_downlink.scheduling.not_before",
 	"pending_mac_state.pending_application_downlink.session_key_id",
 	"pending_mac_state.pending_join_request",
 	"pending_mac_state.pending_join_request.cf_list",
@@ -1005,6 +1022,7 @@ var EndDeviceFieldPathsNested = []string{
 
 var EndDeviceFieldPathsTopLevel = []string{
 	"activated_at",
+	"application_downlink_policy",
 	"application_server_address",
 	"application_server_id",
 	"application_server_kek_label",
@@ -1075,6 +1093,9 @@ var DevAddrPrefixFieldPathsTopLevel = []string{
 var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
 	"end_device.activated_at",
+	"end_device.application_downlink_policy",
+	"end_device.application_downlink_policy.default_ttl",
+	"end_device.application_downlink_policy.order_by_priority",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -1090,6 +1111,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.test_vectors",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -1263,6 +1285,10 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.scheduling",
+	"end_device.mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -1402,6 +1428,10 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -1555,6 +1585,9 @@ var CreateEndDeviceRequestFieldPathsTopLevel = []string{
 var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
 	"end_device.activated_at",
+	"end_device.application_downlink_policy",
+	"end_device.application_downlink_policy.default_ttl",
+	"end_device.application_downlink_policy.order_by_priority",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -1570,6 +1603,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.test_vectors",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -1743,6 +1777,10 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.scheduling",
+	"end_device.mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -1882,6 +1920,10 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -2084,6 +2126,9 @@ var ListEndDevicesRequestFieldPathsTopLevel = []string{
 var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device",
 	"end_device.activated_at",
+	"end_device.application_downlink_policy",
+	"end_device.application_downlink_policy.default_ttl",
+	"end_device.application_downlink_policy.order_by_priority",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -2099,6 +2144,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.test_vectors",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -2272,6 +2318,10 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.scheduling",
+	"end_device.mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -2411,6 +2461,10 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -2557,11 +2611,13 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.version_ids.vendor_id",
 	"end_device.version_ids.vendor_profile_id",
 	"field_mask",
+	"skip_formatter_test_vectors",
 }
 
 var SetEndDeviceRequestFieldPathsTopLevel = []string{
 	"end_device",
 	"field_mask",
+	"skip_formatter_test_vectors",
 }
 var ResetAndGetEndDeviceRequestFieldPathsNested = []string{
 	"end_device_ids",
@@ -2581,6 +2637,9 @@ var ResetAndGetEndDeviceRequestFieldPathsTopLevel = []string{
 var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device",
 	"end_device.activated_at",
+	"end_device.application_downlink_policy",
+	"end_device.application_downlink_policy.default_ttl",
+	"end_device.application_downlink_policy.order_by_priority",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -2596,6 +2655,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.test_vectors",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -2769,6 +2829,10 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.scheduling",
+	"end_device.mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -2908,6 +2972,10 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
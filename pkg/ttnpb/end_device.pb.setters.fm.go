@@ -2530,6 +2530,31 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 			} else {
 				dst.LastSeenAt = nil
 			}
+		case "application_downlink_policy":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationDownlinkPolicy
+				if (src == nil || src.ApplicationDownlinkPolicy == nil) && dst.ApplicationDownlinkPolicy == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationDownlinkPolicy
+				}
+				if dst.ApplicationDownlinkPolicy != nil {
+					newDst = dst.ApplicationDownlinkPolicy
+				} else {
+					newDst = &ApplicationDownlinkPolicy{}
+					dst.ApplicationDownlinkPolicy = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationDownlinkPolicy = src.ApplicationDownlinkPolicy
+				} else {
+					dst.ApplicationDownlinkPolicy = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -2875,6 +2900,16 @@ func (dst *SetEndDeviceRequest) SetFields(src *SetEndDeviceRequest, paths ...str
 			} else {
 				dst.FieldMask = nil
 			}
+		case "skip_formatter_test_vectors":
+			if len(subs) > 0 {
+				return fmt.Errorf("'skip_formatter_test_vectors' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SkipFormatterTestVectors = src.SkipFormatterTestVectors
+			} else {
+				var zero bool
+				dst.SkipFormatterTestVectors = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
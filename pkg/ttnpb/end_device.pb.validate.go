@@ -2337,6 +2337,18 @@ func (m *EndDevice) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "application_downlink_policy":
+
+			if v, ok := interface{}(m.GetApplicationDownlinkPolicy()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceValidationError{
+						field:  "application_downlink_policy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return EndDeviceValidationError{
 				field:  name,
@@ -3321,6 +3333,8 @@ func (m *SetEndDeviceRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "skip_formatter_test_vectors":
+			// no validation rules for SkipFormatterTestVectors
 		default:
 			return SetEndDeviceRequestValidationError{
 				field:  name,
@@ -2484,6 +2484,8 @@ func AddSelectFlagsForEndDevice(flags *pflag.FlagSet, prefix string, hidden bool
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("skip-payload-crypto-override", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("skip-payload-crypto-override", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("activated-at", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("activated-at", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("last-seen-at", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("last-seen-at", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("application-downlink-policy", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("application-downlink-policy", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationDownlinkPolicy(flags, flagsplugin.Prefix("application-downlink-policy", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forEndDevice message from select flags.
@@ -2774,6 +2776,16 @@ func PathsFromSelectFlagsForEndDevice(flags *pflag.FlagSet, prefix string) (path
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("last_seen_at", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("application_downlink_policy", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("application_downlink_policy", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationDownlinkPolicy(flags, flagsplugin.Prefix("application_downlink_policy", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -2827,6 +2839,7 @@ func AddSetFlagsForEndDevice(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("skip-payload-crypto-override", prefix), "", flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewTimestampFlag(flagsplugin.Prefix("activated-at", prefix), "", flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewTimestampFlag(flagsplugin.Prefix("last-seen-at", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForApplicationDownlinkPolicy(flags, flagsplugin.Prefix("application-downlink-policy", prefix), hidden)
 }
 
 // SetFromFlags sets the EndDevice message from flags.
@@ -3147,5 +3160,13 @@ func (m *EndDevice) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []s
 		m.LastSeenAt = gogo.SetTimestamp(val)
 		paths = append(paths, flagsplugin.Prefix("last_seen_at", prefix))
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("application_downlink_policy", prefix)); changed {
+		m.ApplicationDownlinkPolicy = &ApplicationDownlinkPolicy{}
+		if setPaths, err := m.ApplicationDownlinkPolicy.SetFromFlags(flags, flagsplugin.Prefix("application_downlink_policy", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	return paths, nil
 }
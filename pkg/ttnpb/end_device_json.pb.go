@@ -2920,6 +2920,12 @@ func (x *EndDevice) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			gogo.MarshalTimestamp(s, x.LastSeenAt)
 		}
 	}
+	if x.ApplicationDownlinkPolicy != nil || s.HasField("application_downlink_policy") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_downlink_policy")
+		// NOTE: ApplicationDownlinkPolicy does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.ApplicationDownlinkPolicy)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -3242,6 +3248,16 @@ func (x *EndDevice) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.LastSeenAt = v
+		case "application_downlink_policy", "applicationDownlinkPolicy":
+			s.AddField("application_downlink_policy")
+			if s.ReadNil() {
+				x.ApplicationDownlinkPolicy = nil
+				return
+			}
+			// NOTE: ApplicationDownlinkPolicy does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationDownlinkPolicy
+			gogo.UnmarshalMessage(s, &v)
+			x.ApplicationDownlinkPolicy = &v
 		}
 	})
 }
@@ -3743,6 +3759,11 @@ func (x *SetEndDeviceRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			gogo.MarshalFieldMask(s, x.FieldMask)
 		}
 	}
+	if x.SkipFormatterTestVectors || s.HasField("skip_formatter_test_vectors") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("skip_formatter_test_vectors")
+		s.WriteBool(x.SkipFormatterTestVectors)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -3778,6 +3799,9 @@ func (x *SetEndDeviceRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.FieldMask = v
+		case "skip_formatter_test_vectors", "skipFormatterTestVectors":
+			s.AddField("skip_formatter_test_vectors")
+			x.SkipFormatterTestVectors = s.ReadBool()
 		}
 	})
 }
@@ -459,9 +459,14 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 	"/ttn.lorawan.v3.AsEndDeviceRegistry/Get": {
 		All: EndDeviceFieldPathsNested,
 		Allowed: []string{
+			"application_downlink_policy",
+			"application_downlink_policy.default_ttl",
+			"application_downlink_policy.order_by_priority",
+			"attributes",
 			"formatters",
 			"formatters.down_formatter",
 			"formatters.down_formatter_parameter",
+			"formatters.test_vectors",
 			"formatters.up_formatter",
 			"formatters.up_formatter_parameter",
 			"ids",
@@ -498,9 +503,14 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 	"/ttn.lorawan.v3.AsEndDeviceRegistry/Set": {
 		All: EndDeviceFieldPathsNested,
 		Allowed: []string{
+			"application_downlink_policy",
+			"application_downlink_policy.default_ttl",
+			"application_downlink_policy.order_by_priority",
+			"attributes",
 			"formatters",
 			"formatters.down_formatter",
 			"formatters.down_formatter_parameter",
+			"formatters.test_vectors",
 			"formatters.up_formatter",
 			"formatters.up_formatter_parameter",
 			"ids",
@@ -1046,5 +1056,6 @@ func applicationUpFieldMaskPaths() []string {
 	paths = append(paths, FieldsWithPrefix("up.downlink_queue_invalidated", ApplicationInvalidatedDownlinksFieldPathsNested...)...)
 	paths = append(paths, FieldsWithPrefix("up.location_solved", ApplicationLocationFieldPathsNested...)...)
 	paths = append(paths, FieldsWithPrefix("up.service_data", ApplicationServiceDataFieldPathsNested...)...)
+	paths = append(paths, FieldsWithPrefix("up.session_recovered", ApplicationSessionRecoveredFieldPathsNested...)...)
 	return paths
 }
@@ -56,6 +56,33 @@ func (GatewayAntennaPlacement) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1df6bae1ac946b39, []int{0}
 }
 
+type GatewayUplinkFilter_Action int32
+
+const (
+	// Forward the matching uplink messages.
+	GatewayUplinkFilter_ALLOW GatewayUplinkFilter_Action = 0
+	// Do not forward the matching uplink messages.
+	GatewayUplinkFilter_DENY GatewayUplinkFilter_Action = 1
+)
+
+var GatewayUplinkFilter_Action_name = map[int32]string{
+	0: "ALLOW",
+	1: "DENY",
+}
+
+var GatewayUplinkFilter_Action_value = map[string]int32{
+	"ALLOW": 0,
+	"DENY":  1,
+}
+
+func (x GatewayUplinkFilter_Action) String() string {
+	return proto.EnumName(GatewayUplinkFilter_Action_name, int32(x))
+}
+
+func (GatewayUplinkFilter_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{5, 0}
+}
+
 type GatewayBrand struct {
 	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -415,6 +442,179 @@ func (m *GatewayClaimAuthenticationCode) GetValidTo() *types.Timestamp {
 	return nil
 }
 
+// GatewayUplinkFilter is a filter of the uplink messages that the Gateway Server forwards from a gateway.
+// A filter matches an uplink message if the message matches all the conditions that are set.
+type GatewayUplinkFilter struct {
+	Action GatewayUplinkFilter_Action `protobuf:"varint,1,opt,name=action,proto3,enum=ttn.lorawan.v3.GatewayUplinkFilter_Action" json:"action,omitempty"`
+	// The upstreams to which the filter applies. If empty, the filter applies to all upstreams.
+	Upstreams []string `protobuf:"bytes,2,rep,name=upstreams,proto3" json:"upstreams,omitempty"`
+	// Match uplink messages of these message types.
+	MTypes []MType `protobuf:"varint,3,rep,packed,name=m_types,json=mTypes,proto3,enum=ttn.lorawan.v3.MType" json:"m_types,omitempty"`
+	// Match data uplink messages with a DevAddr that matches one of these prefixes.
+	DevAddrPrefixes [][]byte `protobuf:"bytes,4,rep,name=dev_addr_prefixes,json=devAddrPrefixes,proto3" json:"dev_addr_prefixes,omitempty"`
+	// Match data uplink messages with a DevAddr of one of these NetIDs,
+	// and rejoin-request messages of type 0 and 2 with one of these NetIDs.
+	NetIds [][]byte `protobuf:"bytes,5,rep,name=net_ids,json=netIds,proto3" json:"net_ids,omitempty"`
+	// Match join-request messages and rejoin-request messages of type 1 with a JoinEUI in one of these ranges.
+	JoinEuiRanges        []*GatewayUplinkFilter_JoinEUIRange `protobuf:"bytes,6,rep,name=join_eui_ranges,json=joinEuiRanges,proto3" json:"join_eui_ranges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *GatewayUplinkFilter) Reset()         { *m = GatewayUplinkFilter{} }
+func (m *GatewayUplinkFilter) String() string { return proto.CompactTextString(m) }
+func (*GatewayUplinkFilter) ProtoMessage()    {}
+func (*GatewayUplinkFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{5}
+}
+func (m *GatewayUplinkFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayUplinkFilter.Unmarshal(m, b)
+}
+func (m *GatewayUplinkFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayUplinkFilter.Marshal(b, m, deterministic)
+}
+func (m *GatewayUplinkFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUplinkFilter.Merge(m, src)
+}
+func (m *GatewayUplinkFilter) XXX_Size() int {
+	return xxx_messageInfo_GatewayUplinkFilter.Size(m)
+}
+func (m *GatewayUplinkFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUplinkFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUplinkFilter proto.InternalMessageInfo
+
+func (m *GatewayUplinkFilter) GetAction() GatewayUplinkFilter_Action {
+	if m != nil {
+		return m.Action
+	}
+	return GatewayUplinkFilter_ALLOW
+}
+
+func (m *GatewayUplinkFilter) GetUpstreams() []string {
+	if m != nil {
+		return m.Upstreams
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilter) GetMTypes() []MType {
+	if m != nil {
+		return m.MTypes
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilter) GetDevAddrPrefixes() [][]byte {
+	if m != nil {
+		return m.DevAddrPrefixes
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilter) GetNetIds() [][]byte {
+	if m != nil {
+		return m.NetIds
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilter) GetJoinEuiRanges() []*GatewayUplinkFilter_JoinEUIRange {
+	if m != nil {
+		return m.JoinEuiRanges
+	}
+	return nil
+}
+
+type GatewayUplinkFilter_JoinEUIRange struct {
+	// The first JoinEUI of the range.
+	Start []byte `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// The last JoinEUI of the range.
+	End                  []byte   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayUplinkFilter_JoinEUIRange) Reset()         { *m = GatewayUplinkFilter_JoinEUIRange{} }
+func (m *GatewayUplinkFilter_JoinEUIRange) String() string { return proto.CompactTextString(m) }
+func (*GatewayUplinkFilter_JoinEUIRange) ProtoMessage()    {}
+func (*GatewayUplinkFilter_JoinEUIRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{5, 0}
+}
+func (m *GatewayUplinkFilter_JoinEUIRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayUplinkFilter_JoinEUIRange.Unmarshal(m, b)
+}
+func (m *GatewayUplinkFilter_JoinEUIRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayUplinkFilter_JoinEUIRange.Marshal(b, m, deterministic)
+}
+func (m *GatewayUplinkFilter_JoinEUIRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUplinkFilter_JoinEUIRange.Merge(m, src)
+}
+func (m *GatewayUplinkFilter_JoinEUIRange) XXX_Size() int {
+	return xxx_messageInfo_GatewayUplinkFilter_JoinEUIRange.Size(m)
+}
+func (m *GatewayUplinkFilter_JoinEUIRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUplinkFilter_JoinEUIRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUplinkFilter_JoinEUIRange proto.InternalMessageInfo
+
+func (m *GatewayUplinkFilter_JoinEUIRange) GetStart() []byte {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *GatewayUplinkFilter_JoinEUIRange) GetEnd() []byte {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+// GatewayUplinkFilters is a list of uplink filters.
+type GatewayUplinkFilters struct {
+	Filters              []*GatewayUplinkFilter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GatewayUplinkFilters) Reset()         { *m = GatewayUplinkFilters{} }
+func (m *GatewayUplinkFilters) String() string { return proto.CompactTextString(m) }
+func (*GatewayUplinkFilters) ProtoMessage()    {}
+func (*GatewayUplinkFilters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1df6bae1ac946b39, []int{6}
+}
+func (m *GatewayUplinkFilters) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayUplinkFilters.Unmarshal(m, b)
+}
+func (m *GatewayUplinkFilters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayUplinkFilters.Marshal(b, m, deterministic)
+}
+func (m *GatewayUplinkFilters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayUplinkFilters.Merge(m, src)
+}
+func (m *GatewayUplinkFilters) XXX_Size() int {
+	return xxx_messageInfo_GatewayUplinkFilters.Size(m)
+}
+func (m *GatewayUplinkFilters) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayUplinkFilters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayUplinkFilters proto.InternalMessageInfo
+
+func (m *GatewayUplinkFilters) GetFilters() []*GatewayUplinkFilter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
 // Gateway is the message that defines a gateway on the network.
 type Gateway struct {
 	// The identifiers of the gateway. These are public and can be seen by any authenticated user in the network.
@@ -494,16 +694,23 @@ type Gateway struct {
 	RequireAuthenticatedConnection bool            `protobuf:"varint,27,opt,name=require_authenticated_connection,json=requireAuthenticatedConnection,proto3" json:"require_authenticated_connection,omitempty"`
 	Lrfhss                         *Gateway_LRFHSS `protobuf:"bytes,28,opt,name=lrfhss,proto3" json:"lrfhss,omitempty"`
 	DisablePacketBrokerForwarding  bool            `protobuf:"varint,29,opt,name=disable_packet_broker_forwarding,json=disablePacketBrokerForwarding,proto3" json:"disable_packet_broker_forwarding,omitempty"`
-	XXX_NoUnkeyedLiteral           struct{}        `json:"-"`
-	XXX_unrecognized               []byte          `json:"-"`
-	XXX_sizecache                  int32           `json:"-"`
+	// Transmit LoRaWAN Class B beacons. This requires a GPS-synchronized gateway.
+	// The location of the first antenna is included in the beacon frame.
+	TransmitBeacons bool `protobuf:"varint,32,opt,name=transmit_beacons,json=transmitBeacons,proto3" json:"transmit_beacons,omitempty"`
+	// Uplink filters of the gateway. For each uplink message and upstream, the Gateway Server evaluates the filters
+	// in order, and the action of the first filter that matches determines whether the message is forwarded.
+	// Uplink messages that do not match any filter are forwarded.
+	UplinkFilters        []*GatewayUplinkFilter `protobuf:"bytes,33,rep,name=uplink_filters,json=uplinkFilters,proto3" json:"uplink_filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *Gateway) Reset()         { *m = Gateway{} }
 func (m *Gateway) String() string { return proto.CompactTextString(m) }
 func (*Gateway) ProtoMessage()    {}
 func (*Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{5}
+	return fileDescriptor_1df6bae1ac946b39, []int{7}
 }
 func (m *Gateway) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Gateway.Unmarshal(m, b)
@@ -741,6 +948,20 @@ func (m *Gateway) GetDisablePacketBrokerForwarding() bool {
 	return false
 }
 
+func (m *Gateway) GetTransmitBeacons() bool {
+	if m != nil {
+		return m.TransmitBeacons
+	}
+	return false
+}
+
+func (m *Gateway) GetUplinkFilters() []*GatewayUplinkFilter {
+	if m != nil {
+		return m.UplinkFilters
+	}
+	return nil
+}
+
 // LR-FHSS gateway capabilities.
 type Gateway_LRFHSS struct {
 	// The gateway supports the LR-FHSS uplink channels.
@@ -754,7 +975,7 @@ func (m *Gateway_LRFHSS) Reset()         { *m = Gateway_LRFHSS{} }
 func (m *Gateway_LRFHSS) String() string { return proto.CompactTextString(m) }
 func (*Gateway_LRFHSS) ProtoMessage()    {}
 func (*Gateway_LRFHSS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{5, 1}
+	return fileDescriptor_1df6bae1ac946b39, []int{7, 1}
 }
 func (m *Gateway_LRFHSS) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Gateway_LRFHSS.Unmarshal(m, b)
@@ -792,7 +1013,7 @@ func (m *Gateways) Reset()         { *m = Gateways{} }
 func (m *Gateways) String() string { return proto.CompactTextString(m) }
 func (*Gateways) ProtoMessage()    {}
 func (*Gateways) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{6}
+	return fileDescriptor_1df6bae1ac946b39, []int{8}
 }
 func (m *Gateways) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Gateways.Unmarshal(m, b)
@@ -832,7 +1053,7 @@ func (m *GetGatewayRequest) Reset()         { *m = GetGatewayRequest{} }
 func (m *GetGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayRequest) ProtoMessage()    {}
 func (*GetGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{7}
+	return fileDescriptor_1df6bae1ac946b39, []int{9}
 }
 func (m *GetGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayRequest.Unmarshal(m, b)
@@ -877,7 +1098,7 @@ func (m *GetGatewayIdentifiersForEUIRequest) Reset()         { *m = GetGatewayId
 func (m *GetGatewayIdentifiersForEUIRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayIdentifiersForEUIRequest) ProtoMessage()    {}
 func (*GetGatewayIdentifiersForEUIRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{8}
+	return fileDescriptor_1df6bae1ac946b39, []int{10}
 }
 func (m *GetGatewayIdentifiersForEUIRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayIdentifiersForEUIRequest.Unmarshal(m, b)
@@ -929,7 +1150,7 @@ func (m *ListGatewaysRequest) Reset()         { *m = ListGatewaysRequest{} }
 func (m *ListGatewaysRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGatewaysRequest) ProtoMessage()    {}
 func (*ListGatewaysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{9}
+	return fileDescriptor_1df6bae1ac946b39, []int{11}
 }
 func (m *ListGatewaysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGatewaysRequest.Unmarshal(m, b)
@@ -1004,7 +1225,7 @@ func (m *CreateGatewayRequest) Reset()         { *m = CreateGatewayRequest{} }
 func (m *CreateGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateGatewayRequest) ProtoMessage()    {}
 func (*CreateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{10}
+	return fileDescriptor_1df6bae1ac946b39, []int{12}
 }
 func (m *CreateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateGatewayRequest.Unmarshal(m, b)
@@ -1051,7 +1272,7 @@ func (m *UpdateGatewayRequest) Reset()         { *m = UpdateGatewayRequest{} }
 func (m *UpdateGatewayRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGatewayRequest) ProtoMessage()    {}
 func (*UpdateGatewayRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{11}
+	return fileDescriptor_1df6bae1ac946b39, []int{13}
 }
 func (m *UpdateGatewayRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateGatewayRequest.Unmarshal(m, b)
@@ -1103,7 +1324,7 @@ func (m *ListGatewayAPIKeysRequest) Reset()         { *m = ListGatewayAPIKeysReq
 func (m *ListGatewayAPIKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGatewayAPIKeysRequest) ProtoMessage()    {}
 func (*ListGatewayAPIKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{12}
+	return fileDescriptor_1df6bae1ac946b39, []int{14}
 }
 func (m *ListGatewayAPIKeysRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGatewayAPIKeysRequest.Unmarshal(m, b)
@@ -1164,7 +1385,7 @@ func (m *GetGatewayAPIKeyRequest) Reset()         { *m = GetGatewayAPIKeyRequest
 func (m *GetGatewayAPIKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayAPIKeyRequest) ProtoMessage()    {}
 func (*GetGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{13}
+	return fileDescriptor_1df6bae1ac946b39, []int{15}
 }
 func (m *GetGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayAPIKeyRequest.Unmarshal(m, b)
@@ -1212,7 +1433,7 @@ func (m *CreateGatewayAPIKeyRequest) Reset()         { *m = CreateGatewayAPIKeyR
 func (m *CreateGatewayAPIKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateGatewayAPIKeyRequest) ProtoMessage()    {}
 func (*CreateGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{14}
+	return fileDescriptor_1df6bae1ac946b39, []int{16}
 }
 func (m *CreateGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateGatewayAPIKeyRequest.Unmarshal(m, b)
@@ -1274,7 +1495,7 @@ func (m *UpdateGatewayAPIKeyRequest) Reset()         { *m = UpdateGatewayAPIKeyR
 func (m *UpdateGatewayAPIKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGatewayAPIKeyRequest) ProtoMessage()    {}
 func (*UpdateGatewayAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{15}
+	return fileDescriptor_1df6bae1ac946b39, []int{17}
 }
 func (m *UpdateGatewayAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateGatewayAPIKeyRequest.Unmarshal(m, b)
@@ -1333,7 +1554,7 @@ func (m *ListGatewayCollaboratorsRequest) Reset()         { *m = ListGatewayColl
 func (m *ListGatewayCollaboratorsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGatewayCollaboratorsRequest) ProtoMessage()    {}
 func (*ListGatewayCollaboratorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{16}
+	return fileDescriptor_1df6bae1ac946b39, []int{18}
 }
 func (m *ListGatewayCollaboratorsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListGatewayCollaboratorsRequest.Unmarshal(m, b)
@@ -1393,7 +1614,7 @@ func (m *GetGatewayCollaboratorRequest) Reset()         { *m = GetGatewayCollabo
 func (m *GetGatewayCollaboratorRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayCollaboratorRequest) ProtoMessage()    {}
 func (*GetGatewayCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{17}
+	return fileDescriptor_1df6bae1ac946b39, []int{19}
 }
 func (m *GetGatewayCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayCollaboratorRequest.Unmarshal(m, b)
@@ -1439,7 +1660,7 @@ func (m *SetGatewayCollaboratorRequest) Reset()         { *m = SetGatewayCollabo
 func (m *SetGatewayCollaboratorRequest) String() string { return proto.CompactTextString(m) }
 func (*SetGatewayCollaboratorRequest) ProtoMessage()    {}
 func (*SetGatewayCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{18}
+	return fileDescriptor_1df6bae1ac946b39, []int{20}
 }
 func (m *SetGatewayCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetGatewayCollaboratorRequest.Unmarshal(m, b)
@@ -1490,7 +1711,7 @@ func (m *GatewayAntenna) Reset()         { *m = GatewayAntenna{} }
 func (m *GatewayAntenna) String() string { return proto.CompactTextString(m) }
 func (*GatewayAntenna) ProtoMessage()    {}
 func (*GatewayAntenna) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{19}
+	return fileDescriptor_1df6bae1ac946b39, []int{21}
 }
 func (m *GatewayAntenna) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayAntenna.Unmarshal(m, b)
@@ -1578,7 +1799,7 @@ func (m *GatewayStatus) Reset()         { *m = GatewayStatus{} }
 func (m *GatewayStatus) String() string { return proto.CompactTextString(m) }
 func (*GatewayStatus) ProtoMessage()    {}
 func (*GatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{20}
+	return fileDescriptor_1df6bae1ac946b39, []int{22}
 }
 func (m *GatewayStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayStatus.Unmarshal(m, b)
@@ -1659,7 +1880,7 @@ func (m *GatewayRemoteAddress) Reset()         { *m = GatewayRemoteAddress{} }
 func (m *GatewayRemoteAddress) String() string { return proto.CompactTextString(m) }
 func (*GatewayRemoteAddress) ProtoMessage()    {}
 func (*GatewayRemoteAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{21}
+	return fileDescriptor_1df6bae1ac946b39, []int{23}
 }
 func (m *GatewayRemoteAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayRemoteAddress.Unmarshal(m, b)
@@ -1702,16 +1923,18 @@ type GatewayConnectionStats struct {
 	SubBands []*GatewayConnectionStats_SubBand `protobuf:"bytes,10,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
 	// Gateway Remote Address.
 	GatewayRemoteAddress *GatewayRemoteAddress `protobuf:"bytes,12,opt,name=gateway_remote_address,json=gatewayRemoteAddress,proto3" json:"gateway_remote_address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	// Number of uplink messages that were not forwarded because of the uplink filters of the gateway, by upstream.
+	FilteredUplinkCounts map[string]uint64 `protobuf:"bytes,13,rep,name=filtered_uplink_counts,json=filteredUplinkCounts,proto3" json:"filtered_uplink_counts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *GatewayConnectionStats) Reset()         { *m = GatewayConnectionStats{} }
 func (m *GatewayConnectionStats) String() string { return proto.CompactTextString(m) }
 func (*GatewayConnectionStats) ProtoMessage()    {}
 func (*GatewayConnectionStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22}
+	return fileDescriptor_1df6bae1ac946b39, []int{24}
 }
 func (m *GatewayConnectionStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayConnectionStats.Unmarshal(m, b)
@@ -1815,6 +2038,13 @@ func (m *GatewayConnectionStats) GetGatewayRemoteAddress() *GatewayRemoteAddress
 	return nil
 }
 
+func (m *GatewayConnectionStats) GetFilteredUplinkCounts() map[string]uint64 {
+	if m != nil {
+		return m.FilteredUplinkCounts
+	}
+	return nil
+}
+
 type GatewayConnectionStats_RoundTripTimes struct {
 	Min                  *types.Duration `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
 	Max                  *types.Duration `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
@@ -1829,7 +2059,7 @@ func (m *GatewayConnectionStats_RoundTripTimes) Reset()         { *m = GatewayCo
 func (m *GatewayConnectionStats_RoundTripTimes) String() string { return proto.CompactTextString(m) }
 func (*GatewayConnectionStats_RoundTripTimes) ProtoMessage()    {}
 func (*GatewayConnectionStats_RoundTripTimes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22, 0}
+	return fileDescriptor_1df6bae1ac946b39, []int{24, 0}
 }
 func (m *GatewayConnectionStats_RoundTripTimes) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayConnectionStats_RoundTripTimes.Unmarshal(m, b)
@@ -1883,7 +2113,14 @@ type GatewayConnectionStats_SubBand struct {
 	// Duty-cycle limit of the sub-band as a fraction of time.
 	DownlinkUtilizationLimit float32 `protobuf:"fixed32,3,opt,name=downlink_utilization_limit,json=downlinkUtilizationLimit,proto3" json:"downlink_utilization_limit,omitempty"`
 	// Utilization rate of the available duty-cycle. This value should not exceed downlink_utilization_limit.
-	DownlinkUtilization  float32  `protobuf:"fixed32,4,opt,name=downlink_utilization,json=downlinkUtilization,proto3" json:"downlink_utilization,omitempty"`
+	DownlinkUtilization float32 `protobuf:"fixed32,4,opt,name=downlink_utilization,json=downlinkUtilization,proto3" json:"downlink_utilization,omitempty"`
+	// Whether the gateway listens before talk in the sub-band.
+	ListenBeforeTalk bool `protobuf:"varint,5,opt,name=listen_before_talk,json=listenBeforeTalk,proto3" json:"listen_before_talk,omitempty"`
+	// Number of downlink transmissions in the sub-band that failed because listen-before-talk detected channel activity.
+	LbtFailureCount uint64 `protobuf:"varint,6,opt,name=lbt_failure_count,json=lbtFailureCount,proto3" json:"lbt_failure_count,omitempty"`
+	// Fraction of the acknowledged downlink transmissions in the sub-band that failed because listen-before-talk
+	// detected channel activity.
+	LbtFailureRate       float32  `protobuf:"fixed32,7,opt,name=lbt_failure_rate,json=lbtFailureRate,proto3" json:"lbt_failure_rate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1893,7 +2130,7 @@ func (m *GatewayConnectionStats_SubBand) Reset()         { *m = GatewayConnectio
 func (m *GatewayConnectionStats_SubBand) String() string { return proto.CompactTextString(m) }
 func (*GatewayConnectionStats_SubBand) ProtoMessage()    {}
 func (*GatewayConnectionStats_SubBand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1df6bae1ac946b39, []int{22, 1}
+	return fileDescriptor_1df6bae1ac946b39, []int{24, 1}
 }
 func (m *GatewayConnectionStats_SubBand) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayConnectionStats_SubBand.Unmarshal(m, b)
@@ -1941,9 +2178,32 @@ func (m *GatewayConnectionStats_SubBand) GetDownlinkUtilization() float32 {
 	return 0
 }
 
+func (m *GatewayConnectionStats_SubBand) GetListenBeforeTalk() bool {
+	if m != nil {
+		return m.ListenBeforeTalk
+	}
+	return false
+}
+
+func (m *GatewayConnectionStats_SubBand) GetLbtFailureCount() uint64 {
+	if m != nil {
+		return m.LbtFailureCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStats_SubBand) GetLbtFailureRate() float32 {
+	if m != nil {
+		return m.LbtFailureRate
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.GatewayAntennaPlacement", GatewayAntennaPlacement_name, GatewayAntennaPlacement_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.GatewayAntennaPlacement", GatewayAntennaPlacement_name, GatewayAntennaPlacement_value)
+	proto.RegisterEnum("ttn.lorawan.v3.GatewayUplinkFilter_Action", GatewayUplinkFilter_Action_name, GatewayUplinkFilter_Action_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.GatewayUplinkFilter_Action", GatewayUplinkFilter_Action_name, GatewayUplinkFilter_Action_value)
 	proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
 	golang_proto.RegisterType((*GatewayBrand)(nil), "ttn.lorawan.v3.GatewayBrand")
 	proto.RegisterType((*GatewayModel)(nil), "ttn.lorawan.v3.GatewayModel")
@@ -1956,6 +2216,12 @@ func init() {
 	golang_proto.RegisterType((*GatewayRadio_TxConfiguration)(nil), "ttn.lorawan.v3.GatewayRadio.TxConfiguration")
 	proto.RegisterType((*GatewayClaimAuthenticationCode)(nil), "ttn.lorawan.v3.GatewayClaimAuthenticationCode")
 	golang_proto.RegisterType((*GatewayClaimAuthenticationCode)(nil), "ttn.lorawan.v3.GatewayClaimAuthenticationCode")
+	proto.RegisterType((*GatewayUplinkFilter)(nil), "ttn.lorawan.v3.GatewayUplinkFilter")
+	golang_proto.RegisterType((*GatewayUplinkFilter)(nil), "ttn.lorawan.v3.GatewayUplinkFilter")
+	proto.RegisterType((*GatewayUplinkFilter_JoinEUIRange)(nil), "ttn.lorawan.v3.GatewayUplinkFilter.JoinEUIRange")
+	golang_proto.RegisterType((*GatewayUplinkFilter_JoinEUIRange)(nil), "ttn.lorawan.v3.GatewayUplinkFilter.JoinEUIRange")
+	proto.RegisterType((*GatewayUplinkFilters)(nil), "ttn.lorawan.v3.GatewayUplinkFilters")
+	golang_proto.RegisterType((*GatewayUplinkFilters)(nil), "ttn.lorawan.v3.GatewayUplinkFilters")
 	proto.RegisterType((*Gateway)(nil), "ttn.lorawan.v3.Gateway")
 	golang_proto.RegisterType((*Gateway)(nil), "ttn.lorawan.v3.Gateway")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.Gateway.AttributesEntry")
@@ -2002,6 +2268,8 @@ func init() {
 	golang_proto.RegisterType((*GatewayRemoteAddress)(nil), "ttn.lorawan.v3.GatewayRemoteAddress")
 	proto.RegisterType((*GatewayConnectionStats)(nil), "ttn.lorawan.v3.GatewayConnectionStats")
 	golang_proto.RegisterType((*GatewayConnectionStats)(nil), "ttn.lorawan.v3.GatewayConnectionStats")
+	proto.RegisterMapType((map[string]uint64)(nil), "ttn.lorawan.v3.GatewayConnectionStats.FilteredUplinkCountsEntry")
+	golang_proto.RegisterMapType((map[string]uint64)(nil), "ttn.lorawan.v3.GatewayConnectionStats.FilteredUplinkCountsEntry")
 	proto.RegisterType((*GatewayConnectionStats_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayConnectionStats.RoundTripTimes")
 	golang_proto.RegisterType((*GatewayConnectionStats_RoundTripTimes)(nil), "ttn.lorawan.v3.GatewayConnectionStats.RoundTripTimes")
 	proto.RegisterType((*GatewayConnectionStats_SubBand)(nil), "ttn.lorawan.v3.GatewayConnectionStats.SubBand")
@@ -2014,207 +2282,241 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 3222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4d, 0x6c, 0x1b, 0x49,
-	0x76, 0x76, 0x93, 0x12, 0x49, 0x15, 0x25, 0x8a, 0xaa, 0x91, 0xe5, 0x96, 0x6c, 0xcb, 0x1a, 0x8e,
-	0x66, 0x47, 0x52, 0x4c, 0xd2, 0x4b, 0xad, 0xd6, 0x3b, 0x9a, 0x99, 0xd5, 0xb0, 0x29, 0x79, 0x46,
-	0xfe, 0x93, 0xd3, 0x92, 0xb2, 0x89, 0xff, 0x1a, 0xc5, 0xee, 0x22, 0xd9, 0xa3, 0x66, 0x77, 0xa7,
-	0xba, 0x5a, 0x16, 0x3d, 0x33, 0xd9, 0xc5, 0x22, 0x40, 0x82, 0x1c, 0xb2, 0x80, 0x11, 0x20, 0x80,
-	0x83, 0x24, 0x40, 0x80, 0x5c, 0x7c, 0x0a, 0x82, 0x5c, 0x92, 0x53, 0x0e, 0x01, 0x12, 0x20, 0x39,
-	0x6c, 0x0e, 0x39, 0xe6, 0x90, 0x9f, 0x43, 0x30, 0x87, 0x1c, 0x06, 0x39, 0x11, 0x39, 0x04, 0x55,
-	0x5d, 0xdd, 0x6c, 0x52, 0xa6, 0x64, 0x79, 0xec, 0x9c, 0xd8, 0x55, 0xf5, 0xbd, 0x57, 0xef, 0x55,
-	0xbd, 0xf7, 0xea, 0xbd, 0x47, 0x70, 0xc5, 0x72, 0x08, 0x7a, 0x82, 0xec, 0xa2, 0x47, 0x91, 0x7e,
-	0x50, 0x46, 0xae, 0x59, 0x6e, 0x22, 0x8a, 0x9f, 0xa0, 0x4e, 0xc9, 0x25, 0x0e, 0x75, 0x60, 0x8e,
-	0x52, 0xbb, 0x24, 0x40, 0xa5, 0xc3, 0xd5, 0xb9, 0xcd, 0xa6, 0x49, 0x5b, 0x7e, 0xbd, 0xa4, 0x3b,
-	0xed, 0xf2, 0x5e, 0x0b, 0xef, 0xb5, 0x4c, 0xbb, 0xe9, 0x6d, 0xdb, 0x86, 0xef, 0x51, 0x62, 0x62,
-	0xaf, 0xcc, 0xa9, 0xf4, 0x62, 0x13, 0xdb, 0xc5, 0xa6, 0x53, 0x6c, 0x58, 0xa8, 0xe9, 0x95, 0x91,
-	0x6d, 0x3b, 0x14, 0x51, 0xd3, 0xb1, 0xbd, 0x80, 0xeb, 0x5c, 0x35, 0xc6, 0x05, 0xdb, 0x87, 0x4e,
-	0xc7, 0x25, 0xce, 0x51, 0x27, 0x4e, 0x7c, 0x88, 0x2c, 0xd3, 0x40, 0x14, 0x97, 0x8f, 0x7d, 0x08,
-	0x16, 0xc5, 0x18, 0x8b, 0xa6, 0xd3, 0x74, 0x02, 0xe2, 0xba, 0xdf, 0xe0, 0x23, 0x3e, 0xe0, 0x5f,
-	0x02, 0x5e, 0x3b, 0x93, 0xdc, 0x5f, 0x78, 0x8e, 0xfd, 0x12, 0xb1, 0xe7, 0x9b, 0x8e, 0xd3, 0xb4,
-	0x70, 0x6f, 0x2b, 0xc3, 0x27, 0x1c, 0x20, 0xd6, 0x17, 0x06, 0xd7, 0x1b, 0x26, 0xb6, 0x0c, 0xad,
-	0x8d, 0xbc, 0x03, 0x81, 0xb8, 0x34, 0x88, 0xf0, 0x28, 0xf1, 0x75, 0x2a, 0x56, 0xaf, 0x0c, 0xae,
-	0x52, 0xb3, 0x8d, 0x3d, 0x8a, 0xda, 0xae, 0x00, 0x2c, 0x1e, 0xbf, 0x2e, 0xdd, 0xb1, 0x29, 0xd2,
-	0xa9, 0x66, 0xda, 0x8d, 0x50, 0xd7, 0xcb, 0xc7, 0x51, 0xd8, 0xf6, 0xdb, 0xa1, 0x16, 0xef, 0x1d,
-	0x5f, 0x36, 0x0d, 0x6c, 0x53, 0xb3, 0x61, 0x62, 0x12, 0x82, 0x16, 0x8e, 0x83, 0xda, 0x98, 0x22,
-	0x03, 0x51, 0x14, 0x1e, 0xc6, 0x71, 0x04, 0x31, 0x9b, 0x2d, 0x1a, 0x72, 0x78, 0x89, 0x69, 0x79,
-	0x58, 0x27, 0x38, 0x02, 0x94, 0x62, 0x67, 0xee, 0xb8, 0xd8, 0x46, 0xae, 0x79, 0x58, 0x29, 0x3b,
-	0x2e, 0x3f, 0xf1, 0xe3, 0xa7, 0x5f, 0xb8, 0x0f, 0xc6, 0x3f, 0x0b, 0x6c, 0x53, 0x21, 0xc8, 0x36,
-	0x60, 0x0e, 0x24, 0x4c, 0x43, 0x96, 0x16, 0xa4, 0xa5, 0x31, 0x35, 0x61, 0x1a, 0x10, 0x82, 0x11,
-	0x1b, 0xb5, 0xb1, 0x9c, 0xe0, 0x33, 0xfc, 0x1b, 0xe6, 0x41, 0xd2, 0x27, 0x96, 0x9c, 0xe4, 0x53,
-	0xec, 0x13, 0x4e, 0x83, 0x51, 0xcb, 0x69, 0x3a, 0x9e, 0x3c, 0xb2, 0x90, 0x5c, 0x1a, 0x53, 0x83,
-	0x41, 0xe1, 0xcf, 0xa5, 0x88, 0xf9, 0x1d, 0xc7, 0xc0, 0x16, 0xdc, 0x02, 0x99, 0x3a, 0xdb, 0x45,
-	0x0b, 0xb7, 0x50, 0x56, 0xba, 0xca, 0x07, 0xe4, 0x7d, 0x79, 0xb1, 0x32, 0xff, 0xf8, 0x01, 0x2a,
-	0x3e, 0xbd, 0x56, 0xfc, 0xf0, 0xd1, 0xd2, 0xc6, 0xfa, 0x83, 0xe2, 0xa3, 0x8d, 0x70, 0xb8, 0xfc,
-	0x65, 0xe5, 0xea, 0xd7, 0x8b, 0xbf, 0x94, 0x24, 0x35, 0xcd, 0x69, 0xb7, 0x0d, 0xb8, 0xce, 0x65,
-	0x4c, 0x9c, 0x99, 0x41, 0x5c, 0x9f, 0x64, 0x4f, 0x9f, 0xc2, 0x1f, 0x25, 0xc0, 0xac, 0x90, 0xf3,
-	0xd7, 0x30, 0xf1, 0x4c, 0xc7, 0xde, 0xee, 0x5d, 0xdd, 0x9b, 0x12, 0x7a, 0x0b, 0x64, 0xda, 0xec,
-	0x10, 0xb4, 0xd7, 0x12, 0x3d, 0xcd, 0x69, 0xb7, 0x0d, 0x58, 0x01, 0xf9, 0x16, 0x22, 0xc6, 0x13,
-	0x44, 0xb0, 0x76, 0x18, 0x08, 0x1b, 0xe8, 0xa2, 0xa4, 0xbb, 0xca, 0x08, 0x49, 0xc8, 0x0b, 0xea,
-	0x64, 0x08, 0x10, 0xca, 0x30, 0x9a, 0x86, 0x49, 0xda, 0x7d, 0x34, 0x23, 0x03, 0x34, 0x21, 0x40,
-	0xd0, 0xac, 0x67, 0xbe, 0x7d, 0x31, 0x3b, 0x92, 0x91, 0xf2, 0x52, 0xe1, 0xdf, 0x13, 0xd1, 0x2d,
-	0xaa, 0xc8, 0x30, 0x1d, 0x38, 0x03, 0x52, 0xd8, 0x46, 0x75, 0x0b, 0xf3, 0xe3, 0xc8, 0xa8, 0x62,
-	0x04, 0x2f, 0x82, 0x31, 0xbd, 0x65, 0xba, 0x1a, 0xed, 0xb8, 0xa1, 0xbd, 0x64, 0xd8, 0xc4, 0x5e,
-	0xc7, 0xc5, 0xf0, 0x12, 0x18, 0x6b, 0x10, 0xfc, 0x9b, 0x3e, 0xb6, 0xf5, 0x0e, 0x17, 0x78, 0x44,
-	0xed, 0x4d, 0xc0, 0x2b, 0x20, 0x4b, 0x3c, 0xcf, 0xd4, 0x9c, 0x46, 0xc3, 0xc3, 0x94, 0x0b, 0x97,
-	0x50, 0x01, 0x9b, 0xda, 0xe1, 0x33, 0xf0, 0x27, 0x20, 0x4f, 0x8f, 0x34, 0xdd, 0xb1, 0x1b, 0x66,
-	0x53, 0x84, 0x07, 0x79, 0x74, 0x41, 0x5a, 0xca, 0x56, 0xae, 0x96, 0xfa, 0x83, 0x69, 0x29, 0x2e,
-	0x6b, 0x69, 0xef, 0xa8, 0x16, 0xa7, 0x51, 0x27, 0x69, 0xff, 0xc4, 0xdc, 0x6f, 0x4b, 0x60, 0x72,
-	0x00, 0x04, 0xdf, 0x03, 0x13, 0x6d, 0xd3, 0xd6, 0x7a, 0xf2, 0x4a, 0x5c, 0xde, 0xf1, 0xb6, 0x69,
-	0xdf, 0x88, 0x44, 0x66, 0x20, 0x74, 0x14, 0x03, 0x25, 0x04, 0x08, 0x1d, 0xf5, 0x40, 0x1f, 0x80,
-	0x49, 0xdb, 0xa1, 0x7a, 0x4b, 0x1b, 0xd4, 0x3d, 0xc7, 0xa7, 0x23, 0x60, 0xe1, 0x9f, 0x24, 0x30,
-	0x2f, 0x04, 0xaf, 0x59, 0xc8, 0x6c, 0x57, 0x7d, 0xda, 0x62, 0x26, 0xa8, 0x73, 0x89, 0x6a, 0x8e,
-	0x81, 0x61, 0x09, 0xa4, 0x02, 0x57, 0xe7, 0xe2, 0x64, 0x2b, 0x33, 0x83, 0x8a, 0xef, 0xf2, 0x55,
-	0x55, 0xa0, 0xe0, 0x87, 0x00, 0xf0, 0xe8, 0xae, 0x35, 0x88, 0xd3, 0xe6, 0xd2, 0x65, 0x2b, 0x73,
-	0xa5, 0x20, 0x18, 0x96, 0xc2, 0x60, 0x58, 0xda, 0x0b, 0x83, 0xa1, 0x3a, 0xc6, 0xd1, 0x37, 0x88,
-	0xd3, 0x86, 0x6b, 0x20, 0x13, 0x90, 0x52, 0x87, 0xcb, 0x7b, 0x32, 0x61, 0x9a, 0x63, 0xf7, 0x9c,
-	0x98, 0xcd, 0xfc, 0x35, 0x04, 0x69, 0xa1, 0x0e, 0xbc, 0x01, 0x92, 0xa6, 0xe1, 0x09, 0xa1, 0x0b,
-	0x43, 0x6e, 0x2b, 0xe6, 0x70, 0x4a, 0xbe, 0xab, 0x8c, 0xfe, 0x9e, 0x94, 0xc8, 0x4b, 0x9c, 0xdd,
-	0xb9, 0x25, 0x49, 0x65, 0x0c, 0x60, 0x0d, 0x00, 0x9d, 0x60, 0x44, 0xb1, 0xa1, 0x21, 0x7a, 0xba,
-	0x3e, 0x4a, 0x20, 0xcd, 0xb9, 0xfc, 0x39, 0x75, 0x4c, 0xd0, 0x55, 0x29, 0x63, 0xe2, 0xbb, 0x46,
-	0xc8, 0x24, 0x79, 0x16, 0x26, 0x82, 0x2e, 0x60, 0x62, 0x60, 0x0b, 0x0b, 0x26, 0x73, 0xaf, 0xc8,
-	0x44, 0x62, 0x4c, 0x04, 0x5d, 0x95, 0xc2, 0x8b, 0x22, 0x10, 0xf5, 0x39, 0x62, 0x45, 0x44, 0xd8,
-	0x15, 0x90, 0x35, 0xb0, 0xa7, 0x13, 0xd3, 0x8d, 0x2c, 0x7d, 0x4c, 0xc9, 0x74, 0x95, 0x51, 0x92,
-	0x94, 0x7f, 0x39, 0xa9, 0xc6, 0x17, 0xe1, 0x6f, 0x01, 0x80, 0x28, 0x25, 0x66, 0xdd, 0xa7, 0xd8,
-	0x93, 0x53, 0x0b, 0xc9, 0xa5, 0x6c, 0xe5, 0x83, 0x21, 0xc7, 0x5c, 0xaa, 0x46, 0xc8, 0x2d, 0x9b,
-	0x92, 0x8e, 0xb2, 0xd6, 0x55, 0x2a, 0xcf, 0xa5, 0x72, 0x1e, 0x14, 0x16, 0x49, 0xe1, 0xf4, 0x50,
-	0xb4, 0xc2, 0x04, 0xf8, 0x07, 0x49, 0x8d, 0xed, 0x08, 0x6f, 0x82, 0xf1, 0xf8, 0x73, 0x29, 0xa7,
-	0xb9, 0x04, 0x17, 0x07, 0x25, 0xa8, 0x05, 0x98, 0x6d, 0xbb, 0xe1, 0x28, 0xa0, 0xab, 0x8c, 0x3e,
-	0x93, 0x12, 0x79, 0x20, 0x4b, 0x6a, 0x56, 0xef, 0x2d, 0x40, 0x03, 0xcc, 0x20, 0xa3, 0x6d, 0xda,
-	0xa6, 0x47, 0x99, 0x2f, 0x1e, 0x62, 0x4d, 0xac, 0xca, 0xf3, 0xfc, 0x94, 0x8b, 0x83, 0x5c, 0x77,
-	0x48, 0x13, 0xd9, 0xe6, 0x53, 0xee, 0x25, 0x3b, 0x64, 0xdf, 0xc3, 0x24, 0x66, 0x49, 0xea, 0xf9,
-	0x7e, 0x66, 0x42, 0x04, 0x78, 0x1f, 0x4c, 0x51, 0xac, 0xb7, 0x6c, 0x53, 0x47, 0x56, 0xb4, 0xc1,
-	0x95, 0xd7, 0xd9, 0x20, 0x1f, 0xf1, 0x09, 0x79, 0xdf, 0x04, 0x59, 0x11, 0x62, 0x35, 0x66, 0xf5,
-	0x19, 0xce, 0x75, 0x79, 0xc8, 0x75, 0x1c, 0x7f, 0x6d, 0x54, 0x70, 0x18, 0xce, 0x79, 0xf0, 0x5f,
-	0x24, 0x30, 0x23, 0x12, 0x47, 0xcd, 0xc3, 0xe4, 0x10, 0x13, 0x0d, 0x19, 0x06, 0xc1, 0x9e, 0x27,
-	0x8f, 0x71, 0x8b, 0xf8, 0x13, 0xa9, 0xab, 0x3c, 0x97, 0xc8, 0x1f, 0x4a, 0x95, 0x3f, 0x90, 0x1e,
-	0x2f, 0xb1, 0xab, 0x7a, 0xf4, 0x65, 0xe5, 0xea, 0xda, 0xd7, 0xeb, 0xe5, 0xf2, 0xf2, 0xc6, 0xd2,
-	0xc6, 0x3a, 0xbb, 0x43, 0x54, 0x7c, 0x5a, 0x2d, 0xde, 0x67, 0x57, 0xf8, 0x55, 0xec, 0xbb, 0xf7,
-	0xf9, 0xb0, 0xf8, 0x68, 0x25, 0xb6, 0xb0, 0xfc, 0xb0, 0xb4, 0xbc, 0xc2, 0xe8, 0xaa, 0xc5, 0xfb,
-	0xe2, 0xea, 0xbf, 0x8a, 0x7d, 0xf7, 0x3e, 0x39, 0x5d, 0x6f, 0x61, 0x79, 0x69, 0x63, 0x7d, 0xfd,
-	0x01, 0xfb, 0xfa, 0xf2, 0xfb, 0x57, 0xd7, 0xbe, 0x5e, 0xde, 0x58, 0xfc, 0xea, 0xf1, 0xa2, 0x3a,
-	0x2d, 0xc4, 0xdf, 0xe5, 0xd2, 0x57, 0x03, 0xe1, 0x59, 0xb4, 0x47, 0x3e, 0x75, 0xb4, 0xc0, 0xa3,
-	0x64, 0xc0, 0x5f, 0x11, 0xc0, 0xa6, 0xf6, 0xf9, 0x0c, 0x2c, 0x83, 0x5c, 0xb0, 0xa6, 0xe9, 0x2d,
-	0x64, 0xdb, 0xd8, 0x92, 0xb3, 0x71, 0x0f, 0xf8, 0x99, 0xa4, 0x4e, 0x04, 0xeb, 0xb5, 0x60, 0x19,
-	0xae, 0x82, 0xa9, 0x28, 0xc2, 0x6a, 0xae, 0x85, 0xd8, 0xe1, 0xcb, 0xe3, 0x71, 0xcf, 0xfa, 0x54,
-	0x9d, 0x8c, 0x10, 0xf7, 0x2c, 0x64, 0x6f, 0x1b, 0xf0, 0x63, 0x00, 0x8f, 0x11, 0x79, 0xf2, 0x34,
-	0xcb, 0x60, 0x94, 0x5c, 0x57, 0xc9, 0x3e, 0x93, 0x32, 0xf9, 0x4c, 0x21, 0x20, 0xce, 0x0f, 0x10,
-	0x7b, 0x70, 0x13, 0x64, 0x90, 0x4d, 0xb1, 0x6d, 0x23, 0x4f, 0x9e, 0xe0, 0x26, 0x3f, 0x3f, 0xe4,
-	0x96, 0xab, 0x01, 0x8c, 0x4b, 0xcf, 0xac, 0x3e, 0xa3, 0x46, 0x94, 0xec, 0x15, 0xf1, 0x28, 0xa2,
-	0xbe, 0xa7, 0xb9, 0x7e, 0xdd, 0x32, 0x75, 0x39, 0xc7, 0x0f, 0x63, 0x3c, 0x98, 0xbc, 0xc7, 0xe7,
-	0xd8, 0x2b, 0x62, 0x39, 0xc1, 0x4b, 0x10, 0xc2, 0x26, 0x39, 0x2c, 0x17, 0x4e, 0x0b, 0xe0, 0x0f,
-	0xc0, 0x8c, 0xa7, 0xb7, 0xb0, 0xe1, 0x5b, 0x58, 0x33, 0x9c, 0x27, 0xb6, 0x65, 0xda, 0x07, 0x9a,
-	0xc5, 0xce, 0x38, 0xcf, 0xf1, 0xd3, 0xe1, 0xea, 0xa6, 0x58, 0xbc, 0xcd, 0x4e, 0xfb, 0x2a, 0x80,
-	0xd8, 0x6e, 0x38, 0x44, 0xc7, 0x9a, 0xe1, 0xd3, 0x8e, 0xa6, 0x77, 0x74, 0x0b, 0xcb, 0x53, 0x9c,
-	0x22, 0x2f, 0x56, 0x36, 0x7d, 0xda, 0xa9, 0xb1, 0x79, 0xf8, 0x05, 0x90, 0x23, 0xd6, 0x2e, 0xa2,
-	0x2d, 0xe6, 0x40, 0xcc, 0xc1, 0x4c, 0x9b, 0xca, 0x70, 0x41, 0x5a, 0xca, 0x55, 0xbe, 0x37, 0x78,
-	0x0e, 0xe1, 0x6e, 0xf7, 0x10, 0x6d, 0xd5, 0x22, 0x34, 0x3f, 0x8f, 0x9f, 0xb3, 0x38, 0xaf, 0xce,
-	0x18, 0x2f, 0x45, 0xc0, 0x9d, 0x98, 0x3e, 0xc8, 0xee, 0xb0, 0xc4, 0x5d, 0x33, 0xb0, 0x85, 0x3a,
-	0xf2, 0x3b, 0xdc, 0xaf, 0x66, 0x8f, 0x05, 0xdd, 0xcd, 0xf0, 0xa1, 0x8f, 0x54, 0xad, 0x06, 0x74,
-	0x9b, 0x8c, 0x0c, 0x7e, 0x02, 0x2e, 0x0a, 0xc3, 0x8a, 0x0e, 0x94, 0xbd, 0x8e, 0x5a, 0x70, 0xdc,
-	0xf2, 0x79, 0xae, 0xb3, 0x1c, 0x40, 0x6e, 0x0b, 0x04, 0x7b, 0x11, 0x77, 0xf9, 0x3a, 0xfc, 0x18,
-	0xe4, 0xac, 0xba, 0xa7, 0x59, 0xb6, 0xa7, 0x89, 0xa7, 0x78, 0xe6, 0xc4, 0xa7, 0x78, 0xdc, 0xaa,
-	0x7b, 0xb7, 0x6d, 0x2f, 0x18, 0xc1, 0x2f, 0xc0, 0xac, 0xce, 0xde, 0x76, 0x0d, 0xf5, 0x3d, 0xee,
-	0x9a, 0xee, 0x18, 0x58, 0xbe, 0xc0, 0x19, 0x95, 0x86, 0x98, 0xd0, 0x90, 0x9c, 0x40, 0xbd, 0xa0,
-	0x0f, 0x49, 0x16, 0x56, 0xc1, 0x24, 0x45, 0xa4, 0x89, 0xa9, 0xa6, 0xfb, 0xae, 0xa7, 0xf9, 0xc4,
-	0x94, 0x65, 0xee, 0x0e, 0xd9, 0xae, 0x92, 0x21, 0xa9, 0xdf, 0x95, 0x24, 0x96, 0x55, 0x4e, 0x04,
-	0x98, 0x9a, 0xef, 0x7a, 0xfb, 0xc4, 0x84, 0x3f, 0xee, 0x27, 0x3a, 0xc0, 0x1d, 0x79, 0xf6, 0x44,
-	0xfd, 0x62, 0xf4, 0xb7, 0x70, 0x07, 0x7e, 0x0e, 0x16, 0x98, 0x97, 0x98, 0x04, 0xc7, 0x55, 0xc4,
-	0x06, 0x33, 0x11, 0x1b, 0xeb, 0xfc, 0x29, 0xbb, 0xc8, 0x8f, 0x78, 0x5e, 0xe0, 0xaa, 0x71, 0x58,
-	0x2d, 0x42, 0xc1, 0x1f, 0x82, 0x94, 0x45, 0x1a, 0x2d, 0xcf, 0x93, 0x2f, 0x71, 0x01, 0x86, 0xb9,
-	0x56, 0xe9, 0xb6, 0x7a, 0xe3, 0xf3, 0xdd, 0x5d, 0x55, 0xa0, 0xe1, 0x67, 0x60, 0xc1, 0x30, 0x3d,
-	0x96, 0x8d, 0x6a, 0x2e, 0xd2, 0x0f, 0x30, 0xd5, 0xea, 0xc4, 0x39, 0xc0, 0x44, 0x6b, 0x38, 0xe4,
-	0x09, 0x22, 0x86, 0x69, 0x37, 0xe5, 0xcb, 0x5c, 0x82, 0xcb, 0x02, 0x77, 0x8f, 0xc3, 0x14, 0x8e,
-	0xba, 0x11, 0x81, 0xe6, 0x3e, 0x01, 0x93, 0x03, 0x4f, 0x25, 0xab, 0x7a, 0xd8, 0x89, 0x04, 0xa5,
-	0x11, 0xfb, 0x64, 0x55, 0xcf, 0x21, 0xb2, 0xfc, 0x30, 0xd9, 0x0d, 0x06, 0xeb, 0x89, 0x1f, 0x49,
-	0x73, 0xd7, 0x40, 0x2a, 0x90, 0x8c, 0xe5, 0xbd, 0x9e, 0xef, 0xba, 0x0e, 0xa1, 0xd8, 0x10, 0xf9,
-	0x72, 0x6f, 0xa2, 0x97, 0x31, 0xc5, 0x72, 0xa7, 0x0d, 0x90, 0x11, 0xda, 0x79, 0x70, 0x15, 0x64,
-	0x44, 0x04, 0x65, 0x09, 0x14, 0x0b, 0x32, 0x17, 0x86, 0xa5, 0xbb, 0x11, 0xb0, 0xf0, 0xc7, 0x12,
-	0x98, 0xfa, 0x0c, 0xd3, 0x70, 0x81, 0xc5, 0x2d, 0x8f, 0xc2, 0x3b, 0x20, 0x1b, 0xbe, 0x25, 0x67,
-	0x4b, 0xc7, 0x32, 0x61, 0x3a, 0xa6, 0x82, 0x66, 0xb8, 0xea, 0xb1, 0xec, 0xb2, 0x57, 0x87, 0x0f,
-	0xcd, 0xc6, 0x6e, 0x30, 0xc8, 0x1d, 0xe4, 0x1d, 0xa8, 0x63, 0x8d, 0xf0, 0xb3, 0xf0, 0xdf, 0x12,
-	0x28, 0xf4, 0xe4, 0x8b, 0x6d, 0x75, 0xc3, 0x21, 0x5b, 0xfb, 0xdb, 0xa1, 0xc0, 0x7f, 0x2f, 0x81,
-	0x24, 0xf6, 0x4d, 0x2e, 0xe9, 0xb8, 0xf2, 0x57, 0xd2, 0xb3, 0xea, 0xbb, 0x37, 0x61, 0xe1, 0xfa,
-	0x35, 0x65, 0x75, 0x73, 0xed, 0xfa, 0xd6, 0xe6, 0xb5, 0x6b, 0xd7, 0xaa, 0x4a, 0x6d, 0xb3, 0xf0,
-	0x3c, 0x21, 0xa5, 0xff, 0x2c, 0x91, 0xf2, 0x28, 0x31, 0xed, 0x66, 0x57, 0x49, 0x3d, 0x1d, 0x69,
-	0x65, 0x5c, 0xe9, 0x9b, 0x17, 0xb3, 0x3f, 0x97, 0xc0, 0x46, 0xd3, 0x29, 0xd1, 0x16, 0xa6, 0xbc,
-	0x2f, 0x51, 0xb2, 0x31, 0x7d, 0xe2, 0x90, 0x83, 0x72, 0x7f, 0x09, 0x7d, 0xb8, 0x5a, 0x76, 0x0f,
-	0x9a, 0x65, 0x56, 0xb4, 0x78, 0xa5, 0x3b, 0x88, 0x78, 0x2d, 0x64, 0x7d, 0xbe, 0xf5, 0xeb, 0x4a,
-	0x87, 0xe5, 0x36, 0x67, 0x66, 0xb0, 0x6f, 0xb7, 0x03, 0x16, 0x3f, 0xe2, 0x0c, 0x54, 0xa6, 0x41,
-	0xe1, 0x7f, 0x13, 0xe0, 0x9d, 0xdb, 0xa6, 0x17, 0x6a, 0xec, 0x85, 0x1a, 0xfe, 0x06, 0xcb, 0x9c,
-	0x2c, 0x0b, 0xd5, 0x1d, 0x82, 0xa8, 0x43, 0xc4, 0x9d, 0x9c, 0x2d, 0x05, 0x51, 0x52, 0xdf, 0xbe,
-	0x98, 0x4d, 0x2c, 0x49, 0x6a, 0x1f, 0xab, 0xef, 0x70, 0x3d, 0xf0, 0x09, 0x18, 0x75, 0x88, 0x81,
-	0x89, 0x28, 0x2b, 0x51, 0x57, 0x79, 0x4c, 0x1e, 0xaa, 0xe7, 0xa2, 0xdb, 0xd7, 0x4c, 0x43, 0xcd,
-	0x16, 0xe3, 0x83, 0xf0, 0x1b, 0xfb, 0xa6, 0x3a, 0x5e, 0x8c, 0x8f, 0x78, 0x36, 0xab, 0x8e, 0x16,
-	0xf9, 0x4f, 0x2c, 0x7d, 0x57, 0xb3, 0xc5, 0xd8, 0x20, 0xd8, 0x0f, 0xce, 0x83, 0x51, 0xcb, 0x6c,
-	0x9b, 0x41, 0xf9, 0x37, 0xc1, 0xed, 0x6e, 0x25, 0x29, 0xff, 0x57, 0x5a, 0x0d, 0xa6, 0x59, 0xe9,
-	0xee, 0xa2, 0x26, 0xe6, 0xd9, 0xf0, 0x84, 0xca, 0xbf, 0xa1, 0x0c, 0xd2, 0x22, 0xa5, 0x96, 0x53,
-	0xdc, 0xb9, 0xc2, 0xe1, 0x7a, 0x98, 0xb9, 0x4b, 0x85, 0xbf, 0x90, 0xc0, 0x74, 0x8d, 0xef, 0x36,
-	0xe0, 0x12, 0x1f, 0x81, 0xb4, 0x10, 0x56, 0x1c, 0xfd, 0x30, 0xe7, 0x8a, 0xf9, 0x40, 0x48, 0x01,
-	0x1f, 0x0c, 0x5c, 0x5e, 0xe2, 0x75, 0x2e, 0xaf, 0xc7, 0xb7, 0x8f, 0x59, 0xe1, 0xf7, 0x25, 0x30,
-	0x1d, 0xe4, 0x42, 0x6f, 0x52, 0xe4, 0xef, 0xe0, 0xb3, 0x7f, 0x9a, 0x00, 0xb3, 0x31, 0x13, 0xae,
-	0xde, 0xdb, 0xbe, 0x85, 0x7b, 0x86, 0xfc, 0x86, 0x63, 0x8b, 0x1f, 0x5a, 0x60, 0x50, 0x1b, 0x69,
-	0x5d, 0xe5, 0x21, 0xb9, 0xcf, 0x2c, 0x10, 0xb9, 0x26, 0x7b, 0x95, 0x02, 0x0b, 0x8c, 0x0d, 0x5e,
-	0xcd, 0xcc, 0x00, 0x3e, 0x72, 0x4d, 0x82, 0xbd, 0x60, 0x21, 0x36, 0x18, 0xb4, 0xbf, 0xc4, 0xc9,
-	0xf6, 0x97, 0xec, 0xd9, 0x5f, 0xcc, 0xca, 0x7e, 0x0a, 0x2e, 0xf4, 0x82, 0x5a, 0x70, 0x3e, 0x6f,
-	0xe9, 0x78, 0xce, 0x83, 0x54, 0xa0, 0x77, 0xf8, 0xee, 0x1c, 0xe0, 0xce, 0xb6, 0x51, 0xf8, 0x45,
-	0x02, 0xcc, 0xf5, 0x99, 0xf9, 0x5b, 0x15, 0xe2, 0x62, 0xbc, 0x2f, 0x38, 0x58, 0xbe, 0x7e, 0x0a,
-	0x52, 0x41, 0xd7, 0x52, 0x4e, 0x2e, 0x24, 0x97, 0x72, 0x95, 0xf3, 0x83, 0xdb, 0xa8, 0x6c, 0x55,
-	0x99, 0xea, 0x2a, 0xb9, 0x67, 0x52, 0x36, 0x23, 0xc9, 0x52, 0x41, 0x64, 0x82, 0x82, 0x8e, 0x95,
-	0xd8, 0xbd, 0x0b, 0xe2, 0x76, 0x70, 0x4a, 0x89, 0xdd, 0x55, 0x46, 0xff, 0x52, 0x4a, 0x7c, 0x2a,
-	0xa9, 0x63, 0x82, 0xae, 0x4a, 0x0b, 0xff, 0x26, 0x81, 0xb9, 0x3e, 0x2f, 0x7a, 0xab, 0x27, 0xf2,
-	0x21, 0x48, 0x0b, 0x93, 0x14, 0xae, 0x75, 0x2c, 0x6b, 0x0a, 0xb6, 0x8f, 0x91, 0xa7, 0x90, 0x6b,
-	0xb2, 0xc4, 0xa9, 0xdf, 0x31, 0x93, 0x67, 0x71, 0xcc, 0x7f, 0x95, 0xc0, 0x95, 0x98, 0x63, 0xd6,
-	0x62, 0x51, 0xe4, 0x6d, 0xb9, 0xe7, 0x6b, 0xf8, 0x09, 0xbc, 0xde, 0xef, 0xd2, 0xef, 0x76, 0x95,
-	0x79, 0x72, 0x49, 0x3d, 0xa7, 0x26, 0x4c, 0x43, 0x4d, 0x16, 0x4d, 0x43, 0x4d, 0x17, 0x83, 0x9b,
-	0x0f, 0x2d, 0x40, 0x38, 0x65, 0xe1, 0x1f, 0x25, 0x70, 0xb9, 0xe7, 0x57, 0x71, 0xf5, 0xde, 0x92,
-	0x76, 0x6f, 0x35, 0xae, 0xff, 0x8d, 0x04, 0x2e, 0xef, 0xfe, 0x7f, 0x6a, 0x73, 0xf3, 0xa5, 0xda,
-	0x5c, 0x3a, 0xde, 0x9c, 0xe9, 0x61, 0x86, 0x0a, 0xff, 0x3f, 0x09, 0x90, 0xeb, 0x2f, 0x69, 0xd9,
-	0x55, 0x37, 0x91, 0x69, 0x73, 0x31, 0x13, 0x2a, 0xff, 0x86, 0x3f, 0x00, 0x99, 0xb0, 0xb8, 0x12,
-	0xdb, 0xc9, 0x83, 0xdb, 0x85, 0xa5, 0x95, 0x1a, 0x21, 0xe1, 0xef, 0x48, 0x7d, 0x6d, 0xac, 0x24,
-	0x4f, 0x76, 0x4b, 0x27, 0x57, 0xd4, 0xc7, 0xba, 0x59, 0xd7, 0x5f, 0xab, 0x9b, 0x25, 0xf7, 0xf7,
-	0xb3, 0xb6, 0xc0, 0x98, 0x6b, 0x21, 0x1d, 0xb7, 0xb1, 0x1d, 0x44, 0x9e, 0xdc, 0xd0, 0x76, 0x9a,
-	0x90, 0xe3, 0x5e, 0x08, 0x57, 0x7b, 0x94, 0xdf, 0xb1, 0x82, 0x88, 0xd5, 0x03, 0xbf, 0x18, 0x05,
-	0x13, 0x62, 0x3f, 0x51, 0x86, 0x96, 0xc0, 0x08, 0x2b, 0x69, 0x85, 0x71, 0x9c, 0xd4, 0x9a, 0xe5,
-	0x38, 0x78, 0x1d, 0x8c, 0xd5, 0x1d, 0x87, 0x6a, 0x9c, 0xe8, 0xf4, 0x46, 0x70, 0x86, 0x81, 0xd9,
-	0x10, 0xfe, 0x14, 0x64, 0x44, 0x3b, 0x2a, 0xbc, 0x91, 0x5f, 0x19, 0x72, 0x12, 0x81, 0x64, 0x25,
-	0xd1, 0xd0, 0x12, 0xd7, 0xf1, 0xc3, 0xae, 0xb2, 0xfa, 0x5c, 0xba, 0x96, 0x07, 0x85, 0xf7, 0xc9,
-	0x7b, 0xf2, 0x62, 0xe5, 0x4a, 0xdf, 0x75, 0x68, 0x2f, 0xbf, 0x8f, 0x9f, 0x49, 0x6a, 0xb4, 0x29,
-	0xdc, 0x01, 0x53, 0xa2, 0x55, 0x12, 0x15, 0xec, 0xc1, 0x7f, 0x4c, 0x27, 0x18, 0x55, 0xac, 0xcf,
-	0x92, 0x17, 0xc4, 0xe1, 0x12, 0x8b, 0x5d, 0x09, 0xd3, 0x95, 0x47, 0xfb, 0x7a, 0x3c, 0xa0, 0x30,
-	0x42, 0x12, 0xae, 0xa4, 0x26, 0x4c, 0x17, 0xfa, 0x20, 0xdd, 0xc6, 0x94, 0x98, 0x7a, 0xd8, 0x49,
-	0x5d, 0x39, 0x59, 0xe1, 0x3b, 0x01, 0x38, 0xd0, 0xb7, 0xdc, 0x55, 0xae, 0x3e, 0x97, 0x96, 0xf3,
-	0x0b, 0xaf, 0xa8, 0xaf, 0x1a, 0xee, 0xc5, 0xea, 0x3c, 0x64, 0x1c, 0x22, 0x5b, 0xc7, 0x86, 0xac,
-	0x8b, 0xbc, 0x6e, 0xf0, 0x82, 0x76, 0xf9, 0x9f, 0x9a, 0x6a, 0x04, 0x9c, 0xfb, 0x08, 0x4c, 0xf4,
-	0x9d, 0xf7, 0x99, 0x2a, 0xd4, 0x75, 0x30, 0x1e, 0x97, 0xfd, 0x34, 0xda, 0x44, 0x8c, 0xb6, 0xf0,
-	0x3d, 0x30, 0x1d, 0xa5, 0xa5, 0x6d, 0x87, 0xe2, 0xb0, 0xaf, 0x97, 0xe3, 0x87, 0x1b, 0xfe, 0x77,
-	0xe8, 0x16, 0xfe, 0x73, 0x0c, 0xcc, 0x44, 0xa1, 0x2e, 0xac, 0xed, 0xd9, 0xc1, 0x79, 0xf0, 0x13,
-	0xde, 0x34, 0x66, 0x53, 0x41, 0x13, 0xfd, 0x74, 0x53, 0xce, 0x46, 0x78, 0xde, 0x81, 0x9f, 0x34,
-	0x4c, 0xaf, 0x8f, 0x43, 0xf6, 0x54, 0x0e, 0xb9, 0x38, 0x49, 0x95, 0xc2, 0x39, 0x90, 0x09, 0xfe,
-	0x2c, 0x75, 0xac, 0xf0, 0xef, 0xaa, 0x70, 0x0c, 0x7f, 0x15, 0x5c, 0xb0, 0x90, 0x47, 0x45, 0x63,
-	0x48, 0x23, 0x58, 0xc7, 0xe6, 0xe1, 0x2b, 0xfe, 0x69, 0xa0, 0x4e, 0x33, 0xd2, 0xc0, 0x40, 0x54,
-	0x41, 0x58, 0xa5, 0xf0, 0xc7, 0x20, 0x1b, 0x63, 0x29, 0x72, 0x9a, 0xcb, 0x27, 0x9a, 0x97, 0x0a,
-	0x7a, 0x9c, 0x22, 0x91, 0x7c, 0x97, 0xf7, 0xde, 0xe2, 0x22, 0x8d, 0xbe, 0x9a, 0x48, 0xfb, 0x9c,
-	0x32, 0x26, 0xd2, 0xbb, 0x60, 0x5c, 0x70, 0xd3, 0x1d, 0xdf, 0xa6, 0xbc, 0x84, 0x1a, 0x51, 0xb3,
-	0xc1, 0x5c, 0x8d, 0x4d, 0xc1, 0x7d, 0x30, 0xcb, 0x77, 0x8d, 0x7a, 0x7e, 0xf1, 0x7d, 0xd3, 0xa7,
-	0xee, 0x3b, 0xc3, 0x88, 0xc3, 0xfe, 0x5f, 0x6c, 0xe7, 0xf7, 0x41, 0x2e, 0xe2, 0x18, 0xec, 0x9d,
-	0xe1, 0x7b, 0x4f, 0x84, 0xb3, 0xc1, 0xee, 0x1a, 0xc8, 0x13, 0xc7, 0xb7, 0x0d, 0x8d, 0x12, 0xd3,
-	0xe5, 0xf1, 0x2b, 0x68, 0x7d, 0x67, 0x2b, 0x6b, 0xc3, 0x3a, 0x65, 0xfd, 0x86, 0x56, 0x52, 0x19,
-	0xf9, 0x1e, 0x31, 0x5d, 0x2e, 0x93, 0x9a, 0x23, 0x7d, 0x63, 0x78, 0x0b, 0x8c, 0x79, 0x7e, 0x5d,
-	0xab, 0x23, 0xdb, 0xf0, 0x64, 0x70, 0xe2, 0xa3, 0x33, 0xc8, 0x79, 0xd7, 0xaf, 0x2b, 0xc8, 0x36,
-	0xd4, 0x8c, 0x17, 0x7c, 0x78, 0xf0, 0x7e, 0xaf, 0x5d, 0x4f, 0xb8, 0x63, 0x44, 0xed, 0xfa, 0x71,
-	0x2e, 0xf3, 0xe2, 0xb0, 0xde, 0x4d, 0xdc, 0x8b, 0xa2, 0x9e, 0x79, 0xdf, 0xec, 0xdc, 0x3f, 0x4b,
-	0x20, 0xd7, 0xaf, 0x0b, 0x5c, 0x03, 0xc9, 0xb6, 0x78, 0x7b, 0x4f, 0x6a, 0x85, 0xf2, 0x78, 0xf8,
-	0x42, 0x4a, 0x64, 0x24, 0x95, 0xe1, 0x39, 0x19, 0x3a, 0x12, 0xef, 0xc0, 0x2b, 0x92, 0xa1, 0x23,
-	0xf8, 0x11, 0x48, 0xb5, 0xb1, 0x61, 0x22, 0x5b, 0x38, 0xc0, 0x2b, 0x51, 0x0a, 0x12, 0x16, 0x4b,
-	0x82, 0x5b, 0xe6, 0xa5, 0xbd, 0x1a, 0x0c, 0xe6, 0xfe, 0x4e, 0x02, 0x69, 0x71, 0x8a, 0x6f, 0xf0,
-	0x3f, 0xd7, 0x8f, 0xc1, 0x5c, 0x64, 0x5a, 0x3e, 0x35, 0x2d, 0x91, 0xa4, 0x69, 0x41, 0xce, 0x9a,
-	0xe4, 0xc1, 0x2c, 0x6a, 0x61, 0xef, 0xf7, 0x00, 0xb7, 0x79, 0xf2, 0xfa, 0x7d, 0x30, 0xfd, 0x32,
-	0x6a, 0xf1, 0x97, 0xf4, 0x3b, 0x2f, 0xa1, 0x5b, 0x79, 0x08, 0x2e, 0x0c, 0xc9, 0x07, 0xe0, 0x79,
-	0x30, 0x75, 0xef, 0x76, 0xb5, 0xb6, 0x75, 0x67, 0xeb, 0xee, 0x9e, 0xb6, 0x7f, 0xf7, 0xd6, 0xdd,
-	0x9d, 0x9f, 0xdc, 0xcd, 0x9f, 0x83, 0x00, 0xa4, 0xb6, 0xef, 0x6e, 0xee, 0xec, 0xa8, 0x79, 0x09,
-	0x66, 0x41, 0x7a, 0x67, 0x7f, 0x8f, 0x0f, 0x12, 0x73, 0x53, 0xdf, 0xbc, 0x98, 0x9d, 0x90, 0xa5,
-	0x95, 0xb1, 0x88, 0x4a, 0x59, 0xfb, 0xdb, 0xff, 0x98, 0x97, 0xee, 0x97, 0xcf, 0xd0, 0x88, 0xa2,
-	0xb6, 0x5b, 0xaf, 0xa7, 0xf8, 0xb5, 0xac, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x63,
-	0x59, 0x2b, 0x8c, 0x24, 0x00, 0x00,
+	// 3772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0xea, 0x19, 0x72, 0x66, 0x58, 0x43, 0x0e, 0x87, 0x25, 0x8a, 0x6a, 0x52, 0x3f, 0x7a, 0x2c,
+	0xdb, 0x24, 0xa3, 0x19, 0xd2, 0x94, 0x65, 0xad, 0x69, 0x7b, 0xe5, 0x69, 0x7e, 0x64, 0x4a, 0x94,
+	0xa8, 0xb4, 0xc8, 0x38, 0xab, 0x8f, 0x1b, 0x35, 0xd3, 0x35, 0xc3, 0x32, 0x7b, 0xba, 0x3b, 0xd5,
+	0xd5, 0x94, 0x28, 0xdb, 0xd9, 0xc5, 0x22, 0x40, 0x16, 0x39, 0x64, 0x01, 0x21, 0x40, 0x02, 0x05,
+	0x49, 0x80, 0x05, 0x72, 0x88, 0x4e, 0xc1, 0x22, 0xa7, 0xbd, 0x24, 0x87, 0x04, 0xd9, 0x20, 0x39,
+	0x38, 0x87, 0x1c, 0x73, 0x48, 0x72, 0x09, 0x8c, 0x20, 0x87, 0x45, 0x4e, 0x44, 0x0e, 0x41, 0x7d,
+	0xba, 0xa7, 0x67, 0xc8, 0xa1, 0x28, 0xda, 0x0a, 0xcc, 0x0b, 0xeb, 0xf3, 0xde, 0xab, 0xf7, 0xea,
+	0x7d, 0xea, 0xbd, 0xd7, 0x03, 0x2e, 0x38, 0x1e, 0x45, 0x8f, 0x90, 0x5b, 0x0e, 0x18, 0xaa, 0x6f,
+	0xcf, 0x22, 0x9f, 0xcc, 0x36, 0x11, 0xc3, 0x8f, 0xd0, 0x6e, 0xc5, 0xa7, 0x1e, 0xf3, 0x60, 0x81,
+	0x31, 0xb7, 0xa2, 0x80, 0x2a, 0x3b, 0x97, 0x27, 0x96, 0x9a, 0x84, 0x6d, 0x85, 0xb5, 0x4a, 0xdd,
+	0x6b, 0xcd, 0x6e, 0x6c, 0xe1, 0x8d, 0x2d, 0xe2, 0x36, 0x83, 0x55, 0xd7, 0x0e, 0x03, 0x46, 0x09,
+	0x0e, 0x66, 0x05, 0x56, 0xbd, 0xdc, 0xc4, 0x6e, 0xb9, 0xe9, 0x95, 0x1b, 0x0e, 0x6a, 0x06, 0xb3,
+	0xc8, 0x75, 0x3d, 0x86, 0x18, 0xf1, 0xdc, 0x40, 0x52, 0x9d, 0xa8, 0x26, 0xa8, 0x60, 0x77, 0xc7,
+	0xdb, 0xf5, 0xa9, 0xf7, 0x78, 0x37, 0x89, 0xbc, 0x83, 0x1c, 0x62, 0x23, 0x86, 0x67, 0xf7, 0x0d,
+	0x14, 0x89, 0x72, 0x82, 0x44, 0xd3, 0x6b, 0x7a, 0x12, 0xb9, 0x16, 0x36, 0xc4, 0x4c, 0x4c, 0xc4,
+	0x48, 0x81, 0x2f, 0xbe, 0x14, 0xdf, 0x9f, 0x05, 0x9e, 0x7b, 0x00, 0xdb, 0xe7, 0x9b, 0x9e, 0xd7,
+	0x74, 0x70, 0xfb, 0x28, 0x3b, 0xa4, 0x02, 0x40, 0xed, 0x4f, 0x76, 0xef, 0x37, 0x08, 0x76, 0x6c,
+	0xab, 0x85, 0x82, 0x6d, 0x05, 0x71, 0xb6, 0x1b, 0x22, 0x60, 0x34, 0xac, 0x33, 0xb5, 0x7b, 0xa1,
+	0x7b, 0x97, 0x91, 0x16, 0x0e, 0x18, 0x6a, 0xf9, 0x0a, 0xe0, 0xe2, 0x7e, 0x75, 0xd5, 0x3d, 0x97,
+	0xa1, 0x3a, 0xb3, 0x88, 0xdb, 0x88, 0x64, 0x3d, 0xb7, 0x1f, 0x0a, 0xbb, 0x61, 0x2b, 0x92, 0xe2,
+	0xf5, 0xfd, 0xdb, 0xc4, 0xc6, 0x2e, 0x23, 0x0d, 0x82, 0x69, 0x04, 0x74, 0x80, 0x61, 0x44, 0x56,
+	0xa0, 0x64, 0xdd, 0x0f, 0xd0, 0xc2, 0x0c, 0xd9, 0x88, 0xa1, 0xe8, 0xb6, 0xf6, 0x43, 0x50, 0xd2,
+	0xdc, 0x62, 0x87, 0x1c, 0x11, 0xe0, 0x3a, 0xc5, 0x31, 0x40, 0x25, 0xa1, 0x14, 0xcf, 0xc7, 0x2e,
+	0xf2, 0xc9, 0xce, 0xfc, 0xac, 0xe7, 0x0b, 0x95, 0xec, 0x57, 0x4f, 0xe9, 0x1e, 0x18, 0xbc, 0x2e,
+	0x8d, 0xd7, 0xa0, 0xc8, 0xb5, 0x61, 0x01, 0xa4, 0x88, 0xad, 0x6b, 0x93, 0xda, 0xd4, 0x80, 0x99,
+	0x22, 0x36, 0x84, 0xa0, 0xcf, 0x45, 0x2d, 0xac, 0xa7, 0xc4, 0x8a, 0x18, 0xc3, 0x22, 0x48, 0x87,
+	0xd4, 0xd1, 0xd3, 0x62, 0x89, 0x0f, 0xe1, 0x28, 0xe8, 0x77, 0xbc, 0xa6, 0x17, 0xe8, 0x7d, 0x93,
+	0xe9, 0xa9, 0x01, 0x53, 0x4e, 0x4a, 0x7f, 0xae, 0xc5, 0xc4, 0x6f, 0x79, 0x36, 0x76, 0xe0, 0x32,
+	0xc8, 0xd5, 0xf8, 0x29, 0x56, 0x74, 0x84, 0x31, 0xb3, 0x67, 0xbc, 0x45, 0xdf, 0xd0, 0x2f, 0xce,
+	0x9f, 0xff, 0xf4, 0x3e, 0x2a, 0x3f, 0x99, 0x2b, 0xbf, 0xf7, 0x70, 0xea, 0xda, 0xc2, 0xfd, 0xf2,
+	0xc3, 0x6b, 0xd1, 0x74, 0xfa, 0xf3, 0xf9, 0x4b, 0x5f, 0x5e, 0xfc, 0x4a, 0xd3, 0xcc, 0xac, 0xc0,
+	0x5d, 0xb5, 0xe1, 0x82, 0xe0, 0x31, 0xf5, 0xd2, 0x04, 0x92, 0xf2, 0xa4, 0xdb, 0xf2, 0x94, 0xfe,
+	0x38, 0x05, 0xc6, 0x15, 0x9f, 0xbf, 0x81, 0x69, 0x40, 0x3c, 0x77, 0xb5, 0xad, 0xdb, 0x6f, 0x8b,
+	0xe9, 0x65, 0x90, 0x6b, 0xf1, 0x4b, 0xb0, 0x8e, 0xc5, 0x7a, 0x56, 0xe0, 0xae, 0xda, 0x70, 0x1e,
+	0x14, 0xb7, 0x10, 0xb5, 0x1f, 0x21, 0x8a, 0xad, 0x1d, 0xc9, 0xac, 0x94, 0xc5, 0xc8, 0xee, 0x19,
+	0x7d, 0x34, 0xa5, 0x4f, 0x9a, 0xc3, 0x11, 0x80, 0x12, 0x86, 0xe3, 0x34, 0x08, 0x6d, 0x75, 0xe0,
+	0xf4, 0x75, 0xe1, 0x44, 0x00, 0x0a, 0x67, 0x21, 0xf7, 0xab, 0xe7, 0xe3, 0x7d, 0x39, 0xad, 0xa8,
+	0x95, 0xfe, 0x3d, 0x15, 0x6b, 0xd1, 0x44, 0x36, 0xf1, 0xe0, 0x18, 0xc8, 0x60, 0x17, 0xd5, 0x1c,
+	0x2c, 0xae, 0x23, 0x67, 0xaa, 0x19, 0x3c, 0x03, 0x06, 0xea, 0x5b, 0xc4, 0xb7, 0xd8, 0xae, 0x1f,
+	0xd9, 0x4b, 0x8e, 0x2f, 0x6c, 0xec, 0xfa, 0x18, 0x9e, 0x05, 0x03, 0x0d, 0x8a, 0x7f, 0x2b, 0xc4,
+	0x6e, 0x7d, 0x57, 0x30, 0xdc, 0x67, 0xb6, 0x17, 0xe0, 0x05, 0x90, 0xa7, 0x41, 0x40, 0x2c, 0xaf,
+	0xd1, 0x08, 0x30, 0x13, 0xcc, 0xa5, 0x4c, 0xc0, 0x97, 0xd6, 0xc5, 0x0a, 0xfc, 0x04, 0x14, 0xd9,
+	0x63, 0xab, 0xee, 0xb9, 0x0d, 0xd2, 0x54, 0xf1, 0x43, 0xef, 0x9f, 0xd4, 0xa6, 0xf2, 0xf3, 0x97,
+	0x2a, 0x9d, 0xd1, 0xb6, 0x92, 0xe4, 0xb5, 0xb2, 0xf1, 0x78, 0x31, 0x89, 0x63, 0x0e, 0xb3, 0xce,
+	0x85, 0x89, 0xdf, 0xd1, 0xc0, 0x70, 0x17, 0x10, 0x7c, 0x1d, 0x0c, 0xb5, 0x88, 0x6b, 0xb5, 0xf9,
+	0xd5, 0x04, 0xbf, 0x83, 0x2d, 0xe2, 0xae, 0xc4, 0x2c, 0x73, 0x20, 0xf4, 0x38, 0x01, 0x94, 0x52,
+	0x40, 0xe8, 0x71, 0x1b, 0xe8, 0x2d, 0x30, 0xec, 0x7a, 0xac, 0xbe, 0x65, 0x75, 0xcb, 0x5e, 0x10,
+	0xcb, 0x31, 0x60, 0xe9, 0x9f, 0x34, 0x70, 0x5e, 0x31, 0xbe, 0xe8, 0x20, 0xd2, 0xaa, 0x86, 0x6c,
+	0x8b, 0x9b, 0x60, 0x5d, 0x70, 0xb4, 0xe8, 0xd9, 0x18, 0x56, 0x40, 0x46, 0xba, 0xba, 0x60, 0x27,
+	0x3f, 0x3f, 0xd6, 0x2d, 0xf8, 0x5d, 0xb1, 0x6b, 0x2a, 0x28, 0xf8, 0x1e, 0x00, 0x22, 0xfc, 0x5b,
+	0x0d, 0xea, 0xb5, 0x04, 0x77, 0xf9, 0xf9, 0x89, 0x8a, 0x8c, 0x96, 0x95, 0x28, 0x5a, 0x56, 0x36,
+	0xa2, 0x68, 0x69, 0x0e, 0x08, 0xe8, 0x15, 0xea, 0xb5, 0xe0, 0x15, 0x90, 0x93, 0xa8, 0xcc, 0x13,
+	0xfc, 0x1e, 0x8e, 0x98, 0x15, 0xb0, 0x1b, 0x5e, 0xc2, 0x66, 0xbe, 0x1a, 0x00, 0x27, 0x95, 0x38,
+	0x9b, 0xbe, 0x43, 0xdc, 0xed, 0x15, 0xe2, 0x30, 0x4c, 0xe1, 0x1a, 0xc8, 0xa0, 0xba, 0x50, 0x1e,
+	0x97, 0xa1, 0x30, 0x3f, 0xd3, 0x43, 0x79, 0x49, 0xa4, 0x4a, 0x55, 0x60, 0x18, 0xb9, 0x3d, 0xa3,
+	0xff, 0xc7, 0x5a, 0xaa, 0xa8, 0x99, 0x8a, 0x06, 0xbc, 0x01, 0x06, 0x42, 0x3f, 0x60, 0x14, 0xa3,
+	0x56, 0xa0, 0xa7, 0x78, 0xe4, 0x31, 0x2e, 0xed, 0x19, 0xd3, 0x4f, 0xb5, 0x37, 0x75, 0xad, 0x54,
+	0xa2, 0x93, 0x66, 0xb6, 0xee, 0x84, 0x01, 0xc3, 0xd4, 0x1c, 0xf4, 0x51, 0x7d, 0x1b, 0xb3, 0x1a,
+	0xf5, 0xb6, 0x31, 0x35, 0xb3, 0xc4, 0x65, 0x98, 0x7a, 0xbe, 0xd9, 0x46, 0x87, 0x55, 0x90, 0x6d,
+	0x09, 0xcb, 0x0d, 0xf4, 0xf4, 0x64, 0x7a, 0xaa, 0x30, 0x7f, 0xaa, 0x9b, 0xb5, 0x5b, 0xdc, 0x8e,
+	0x8d, 0x91, 0x3d, 0xa3, 0xf0, 0x54, 0xcb, 0x17, 0x73, 0xba, 0x56, 0x8a, 0xd8, 0x69, 0xf1, 0x9d,
+	0x00, 0xfe, 0x97, 0x06, 0x46, 0x6c, 0xbc, 0x63, 0x21, 0xdb, 0xa6, 0x96, 0x4f, 0x71, 0x83, 0x3c,
+	0xc6, 0x32, 0x22, 0x0e, 0x1a, 0x7f, 0xad, 0x3d, 0xad, 0x8e, 0xdc, 0x18, 0xbe, 0x5f, 0x9a, 0x7f,
+	0x77, 0x6e, 0xae, 0x6a, 0xcc, 0xcd, 0xcd, 0xce, 0xbf, 0x53, 0x7a, 0xb8, 0x67, 0xe4, 0x9f, 0x6a,
+	0xb9, 0x62, 0xb1, 0xd4, 0xf7, 0x24, 0xb5, 0xd5, 0xff, 0xf5, 0xf3, 0xf1, 0x3f, 0xd2, 0xc0, 0x6a,
+	0xd3, 0xab, 0xb0, 0x2d, 0xcc, 0xc4, 0x63, 0x5b, 0x71, 0x31, 0x7b, 0xe4, 0xd1, 0xed, 0xd9, 0xce,
+	0xb0, 0xbf, 0x73, 0x79, 0xd6, 0xdf, 0x6e, 0xce, 0x0a, 0x76, 0x2b, 0xb7, 0x10, 0x0d, 0xb6, 0x90,
+	0xb3, 0x84, 0x77, 0xaa, 0xb6, 0x4d, 0xef, 0x88, 0x73, 0xef, 0x3a, 0xa4, 0x8e, 0xe1, 0xcd, 0x97,
+	0x25, 0xb5, 0xe9, 0xb6, 0x7a, 0x11, 0x33, 0x87, 0xed, 0xe4, 0x1a, 0x0e, 0xe0, 0xdf, 0x6a, 0x20,
+	0xeb, 0x62, 0x66, 0x11, 0x3b, 0xd0, 0xfb, 0x85, 0x90, 0xcf, 0xb5, 0xa7, 0xd5, 0xc1, 0x1b, 0xe0,
+	0x7e, 0x69, 0x6e, 0x6e, 0x6e, 0xee, 0xed, 0xcb, 0x5d, 0xf2, 0xa5, 0xbf, 0x7e, 0x3e, 0xfe, 0x13,
+	0x0d, 0x54, 0x8f, 0x29, 0xdf, 0x6d, 0xcc, 0x56, 0x97, 0xa4, 0x5c, 0x8b, 0xc7, 0x96, 0xab, 0x4d,
+	0xc4, 0xcc, 0xb8, 0x98, 0xad, 0xda, 0x01, 0xac, 0x81, 0xe1, 0xcf, 0x3c, 0xe2, 0x5a, 0x38, 0x24,
+	0x16, 0x45, 0x6e, 0x13, 0x07, 0x7a, 0x66, 0x32, 0x3d, 0x95, 0x9f, 0x9f, 0x3b, 0x8a, 0x6d, 0xde,
+	0xf0, 0x88, 0xbb, 0xbc, 0xb9, 0x6a, 0x72, 0x44, 0x61, 0xa1, 0x4f, 0xb5, 0x54, 0xb1, 0x68, 0x0e,
+	0x71, 0x92, 0xcb, 0x21, 0x11, 0xeb, 0xc1, 0xc4, 0xcf, 0xd2, 0x60, 0x30, 0x09, 0x09, 0xff, 0x41,
+	0x03, 0xfd, 0x01, 0x43, 0x54, 0xfa, 0xf2, 0xa0, 0xf1, 0x73, 0xed, 0x69, 0xf5, 0xb5, 0x1b, 0xb0,
+	0x74, 0x75, 0xce, 0xb8, 0xbc, 0x74, 0xe5, 0xea, 0xf2, 0xd2, 0x9c, 0xfc, 0x2b, 0x3d, 0x4b, 0x69,
+	0xd9, 0x9f, 0xa5, 0x32, 0x3c, 0xe1, 0x72, 0x9b, 0x7b, 0x06, 0xbf, 0xc7, 0xdc, 0xd7, 0xcf, 0xc7,
+	0x7f, 0xac, 0x81, 0x6b, 0xc7, 0xbc, 0xc7, 0x8f, 0x97, 0x7f, 0xd3, 0xd8, 0x65, 0x38, 0x80, 0xd7,
+	0x8e, 0x7d, 0x8b, 0xdf, 0x13, 0x04, 0x4c, 0x29, 0x01, 0xfc, 0x3b, 0x0d, 0xa4, 0xb1, 0x2b, 0x1f,
+	0xb5, 0x83, 0x25, 0x59, 0x59, 0x59, 0x59, 0xf9, 0x4e, 0x4b, 0xc2, 0xf9, 0x2f, 0x9d, 0x03, 0x19,
+	0x19, 0x69, 0xe0, 0x00, 0xe8, 0xaf, 0xae, 0xad, 0xad, 0x7f, 0x52, 0x3c, 0x01, 0x73, 0xa0, 0x6f,
+	0x69, 0xf9, 0xf6, 0x0f, 0x8a, 0x5a, 0xc9, 0x02, 0xa3, 0x07, 0x18, 0x40, 0x00, 0xaf, 0x83, 0x6c,
+	0x43, 0x0e, 0x75, 0x4d, 0xd8, 0xcd, 0xeb, 0x47, 0xb0, 0x9b, 0x84, 0xa9, 0x44, 0xd8, 0xa5, 0x5f,
+	0x9c, 0x04, 0x59, 0x05, 0x0a, 0x57, 0x40, 0x9a, 0xbb, 0x95, 0x0c, 0xf4, 0xa5, 0x1e, 0x04, 0x13,
+	0x49, 0x8a, 0x51, 0xdc, 0x33, 0xfa, 0x7f, 0x8f, 0x47, 0x23, 0x11, 0x82, 0x4f, 0x4c, 0x69, 0x26,
+	0x27, 0x00, 0x17, 0x01, 0xa8, 0x53, 0x8c, 0x18, 0xb6, 0x2d, 0xc4, 0x5e, 0xfc, 0x06, 0x18, 0x32,
+	0x82, 0x9f, 0x28, 0x9e, 0x30, 0x07, 0x14, 0x5e, 0x95, 0x71, 0x22, 0xa1, 0x6f, 0x47, 0x44, 0xd2,
+	0x2f, 0x43, 0x44, 0xe1, 0x49, 0x22, 0x36, 0x76, 0xb0, 0x22, 0x32, 0x71, 0x44, 0x22, 0x1a, 0x27,
+	0xa2, 0xf0, 0xaa, 0x0c, 0x9e, 0x51, 0xc9, 0x5b, 0x47, 0xf2, 0x32, 0xaf, 0xb2, 0xd2, 0x19, 0x90,
+	0xb7, 0x71, 0x50, 0xa7, 0xc4, 0x8f, 0xb3, 0x83, 0x01, 0x71, 0xcf, 0x34, 0xad, 0x7f, 0x35, 0x6c,
+	0x26, 0x37, 0xe1, 0x6f, 0x03, 0x80, 0x18, 0xa3, 0xa4, 0x16, 0xb2, 0xd8, 0xdf, 0xdf, 0xea, 0x71,
+	0xcd, 0x95, 0x6a, 0x0c, 0xb9, 0xec, 0x32, 0xba, 0x6b, 0x5c, 0xd9, 0x33, 0xe6, 0x9f, 0x69, 0xb3,
+	0x45, 0x50, 0xba, 0x48, 0x4b, 0x2f, 0x4e, 0xdf, 0x66, 0x38, 0x03, 0xbf, 0xd4, 0xcc, 0xc4, 0x89,
+	0xf0, 0x06, 0x18, 0x4c, 0xd6, 0x20, 0x7a, 0x56, 0x70, 0x70, 0xa6, 0x9b, 0x83, 0x45, 0x09, 0xb3,
+	0xea, 0x36, 0x3c, 0x03, 0x28, 0x8b, 0x01, 0xba, 0x66, 0xe6, 0xeb, 0xed, 0x0d, 0x68, 0x83, 0x31,
+	0x64, 0xb7, 0x88, 0x4b, 0x02, 0xc6, 0xf3, 0x97, 0x1d, 0x6c, 0xa9, 0x5d, 0xfd, 0xbc, 0xb8, 0xe5,
+	0x72, 0x37, 0xd5, 0x75, 0xda, 0x44, 0x2e, 0x79, 0x22, 0x32, 0x8b, 0x75, 0xba, 0x19, 0x60, 0x9a,
+	0xb0, 0x24, 0xf3, 0x54, 0x27, 0x31, 0xc5, 0x02, 0xbc, 0x07, 0x46, 0x18, 0xae, 0x6f, 0xb9, 0xa4,
+	0x8e, 0x9c, 0xf8, 0x80, 0x0b, 0xc7, 0x39, 0xa0, 0x18, 0xd3, 0x89, 0x68, 0xdf, 0x00, 0x79, 0x95,
+	0x96, 0x8a, 0xc7, 0x24, 0x27, 0xa8, 0x4e, 0xf7, 0x50, 0xc7, 0xfe, 0x0c, 0xdd, 0x04, 0x3b, 0xd1,
+	0x5a, 0x00, 0xff, 0x45, 0x03, 0x63, 0xaa, 0x1a, 0xb7, 0x02, 0x4c, 0x77, 0x30, 0x15, 0xef, 0x31,
+	0x0e, 0x02, 0x7d, 0x40, 0x58, 0xc4, 0x9f, 0x6a, 0x7b, 0xc6, 0x33, 0x8d, 0xfe, 0xa1, 0x36, 0xff,
+	0x07, 0xda, 0xa7, 0x53, 0x5c, 0x55, 0x0f, 0x3f, 0x9f, 0xbf, 0x74, 0xe5, 0xcb, 0x85, 0xd9, 0xd9,
+	0xe9, 0x6b, 0x53, 0xd7, 0x16, 0xb8, 0x0e, 0x51, 0xf9, 0x49, 0xb5, 0x7c, 0x8f, 0xab, 0xf0, 0x8b,
+	0xc4, 0xb8, 0x3d, 0x7c, 0x50, 0x7e, 0x38, 0x93, 0xd8, 0x98, 0x7e, 0x50, 0x99, 0x9e, 0xe1, 0x78,
+	0xd5, 0xf2, 0x3d, 0xa5, 0xfa, 0x2f, 0x12, 0xe3, 0xf6, 0x50, 0xe0, 0xb5, 0x37, 0xa6, 0xa7, 0xae,
+	0x2d, 0x2c, 0xdc, 0xe7, 0xa3, 0xcf, 0xdf, 0xbe, 0x74, 0xe5, 0xcb, 0xe9, 0x6b, 0x17, 0xbf, 0xf8,
+	0xf4, 0xa2, 0x39, 0xaa, 0xd8, 0xbf, 0x2b, 0xb8, 0xaf, 0x4a, 0xe6, 0x79, 0x86, 0x8c, 0x42, 0xe6,
+	0x59, 0xd2, 0xa3, 0x74, 0x20, 0x32, 0x6f, 0xc0, 0x97, 0x36, 0xc5, 0x0a, 0x9c, 0x05, 0x05, 0xb9,
+	0x67, 0xd5, 0xb7, 0x90, 0xeb, 0x62, 0x47, 0xcf, 0x27, 0x3d, 0xe0, 0x47, 0x9a, 0x39, 0x24, 0xf7,
+	0x17, 0xe5, 0x36, 0xbc, 0x0c, 0x46, 0xe2, 0xac, 0xd4, 0xf2, 0x1d, 0xc4, 0x2f, 0x5f, 0x1f, 0x4c,
+	0x7a, 0xd6, 0x47, 0xe6, 0x70, 0x0c, 0x71, 0xc7, 0x41, 0xee, 0xaa, 0x0d, 0x3f, 0x00, 0x70, 0x1f,
+	0x52, 0xa0, 0x8f, 0x8a, 0xdc, 0xab, 0xa0, 0x9e, 0xfb, 0x5c, 0x49, 0x22, 0x17, 0xbb, 0x90, 0x03,
+	0xb8, 0x04, 0x72, 0xc8, 0x65, 0xd8, 0x75, 0x51, 0xa0, 0x0f, 0x09, 0x93, 0x3f, 0xdf, 0x43, 0xcb,
+	0x55, 0x09, 0x16, 0xc7, 0xc9, 0x9c, 0x19, 0x63, 0xf2, 0xcc, 0x3b, 0x60, 0x88, 0x85, 0x81, 0xe5,
+	0x87, 0x35, 0x87, 0xd4, 0xf5, 0x82, 0xb8, 0x8c, 0x41, 0xb9, 0x78, 0x47, 0xac, 0xf1, 0xcc, 0xdb,
+	0xf1, 0x64, 0xf6, 0x1c, 0x81, 0x0d, 0x0b, 0xb0, 0x42, 0xb4, 0xac, 0x00, 0xdf, 0x01, 0x63, 0x41,
+	0x7d, 0x0b, 0xdb, 0xa1, 0x83, 0x2d, 0xdb, 0x7b, 0xe4, 0xf2, 0x20, 0x6d, 0x39, 0xfc, 0x8e, 0x8b,
+	0x02, 0x7e, 0x34, 0xda, 0x5d, 0x52, 0x9b, 0x6b, 0xfc, 0xb6, 0x2f, 0x01, 0x88, 0xdd, 0x86, 0x47,
+	0xeb, 0xd8, 0xb2, 0x43, 0xb6, 0x6b, 0xd5, 0x77, 0xeb, 0x0e, 0xd6, 0x47, 0x04, 0x46, 0x51, 0xed,
+	0x2c, 0x85, 0x6c, 0x77, 0x91, 0xaf, 0xc3, 0xcf, 0x80, 0x1e, 0x93, 0xf6, 0x11, 0xdb, 0xe2, 0x0e,
+	0xc4, 0x1d, 0x8c, 0xb8, 0x4c, 0x87, 0x22, 0x11, 0x7e, 0xb3, 0xfb, 0x1e, 0xa2, 0xd3, 0xee, 0x20,
+	0xb6, 0xb5, 0x18, 0x43, 0x27, 0x92, 0xe0, 0x31, 0xfb, 0x40, 0x08, 0xb8, 0x9e, 0x90, 0x07, 0xb9,
+	0xbb, 0x8c, 0xb4, 0xb0, 0x65, 0x63, 0x07, 0xed, 0xea, 0x27, 0x85, 0x5f, 0x8d, 0xef, 0x0b, 0xba,
+	0x4b, 0x51, 0x71, 0x14, 0x8b, 0x5a, 0x95, 0x78, 0x4b, 0x1c, 0x0d, 0x7e, 0x08, 0xce, 0x28, 0xc3,
+	0x8a, 0x2f, 0x94, 0x57, 0x14, 0x96, 0xbc, 0x6e, 0xfd, 0x94, 0x90, 0x59, 0x97, 0x20, 0x6b, 0x0a,
+	0x82, 0x57, 0x11, 0x77, 0xc5, 0x3e, 0xfc, 0x00, 0x14, 0x9c, 0x5a, 0x60, 0x39, 0x6e, 0x60, 0xa9,
+	0xf2, 0x65, 0xec, 0xd0, 0xf2, 0x65, 0xd0, 0xa9, 0x05, 0x6b, 0x6e, 0x20, 0x67, 0xf0, 0x33, 0x30,
+	0x5e, 0xe7, 0xf5, 0x90, 0x85, 0x3a, 0x0a, 0x22, 0xab, 0xee, 0xd9, 0x58, 0x3f, 0x2d, 0x08, 0x55,
+	0x7a, 0x98, 0x50, 0x8f, 0x3a, 0xca, 0x3c, 0x5d, 0xef, 0x51, 0x60, 0x5d, 0x06, 0xc3, 0x0c, 0xd1,
+	0x26, 0x66, 0x56, 0x3d, 0xf4, 0x03, 0x2b, 0xa4, 0x44, 0xd7, 0x85, 0x3b, 0xe4, 0xf7, 0x8c, 0x1c,
+	0xcd, 0xfc, 0x44, 0xd3, 0x78, 0x25, 0x3e, 0x24, 0x61, 0x16, 0x43, 0x3f, 0xd8, 0xa4, 0x04, 0x7e,
+	0xbf, 0x13, 0x69, 0x1b, 0xef, 0xea, 0xe3, 0x87, 0xca, 0x97, 0xc0, 0xbf, 0x89, 0x77, 0xe1, 0xc7,
+	0x60, 0x92, 0x7b, 0x09, 0xa1, 0x38, 0x29, 0x22, 0xb6, 0xb9, 0x89, 0xb8, 0x58, 0xd6, 0x4a, 0x67,
+	0xc4, 0x15, 0x9f, 0x57, 0x70, 0xd5, 0x24, 0xd8, 0x62, 0x0c, 0x05, 0xdf, 0x05, 0x19, 0x87, 0x36,
+	0xb6, 0x82, 0x40, 0x3f, 0x2b, 0x18, 0xe8, 0xe5, 0x5a, 0x95, 0x35, 0x73, 0xe5, 0xe3, 0xbb, 0x77,
+	0x4d, 0x05, 0x0d, 0xaf, 0x83, 0x49, 0x9b, 0x04, 0xbc, 0x82, 0xb7, 0x64, 0x95, 0x64, 0xc9, 0x32,
+	0xc9, 0x6a, 0x78, 0xf4, 0x11, 0xa2, 0x36, 0x71, 0x9b, 0xfa, 0x39, 0xc1, 0xc1, 0x39, 0x05, 0x77,
+	0x47, 0x80, 0x19, 0x02, 0x6a, 0x25, 0x06, 0x82, 0xd3, 0xa0, 0xc8, 0x28, 0x72, 0x83, 0x16, 0x61,
+	0x56, 0x0d, 0x23, 0x6e, 0xe3, 0xfa, 0xa4, 0x40, 0x1c, 0x8e, 0xd6, 0x0d, 0xb9, 0x0c, 0x37, 0x78,
+	0xb0, 0x12, 0xee, 0x10, 0xe5, 0x4e, 0xaf, 0x1d, 0x27, 0x77, 0x1a, 0x0a, 0x93, 0xa9, 0xd8, 0xc4,
+	0x87, 0x60, 0xb8, 0xeb, 0xad, 0x86, 0x45, 0x90, 0xe6, 0x2a, 0x91, 0xfd, 0x2c, 0x3e, 0x84, 0xa3,
+	0xa0, 0x7f, 0x07, 0x39, 0x61, 0xd4, 0xa1, 0x90, 0x93, 0x85, 0xd4, 0xf7, 0xb4, 0x89, 0x39, 0x90,
+	0x91, 0x57, 0x03, 0xcf, 0x82, 0x81, 0x20, 0xf4, 0x7d, 0x8f, 0x32, 0x6c, 0xab, 0x26, 0x47, 0x7b,
+	0xa1, 0x5d, 0xe6, 0x26, 0x0a, 0xde, 0x6b, 0x20, 0xa7, 0x58, 0x0d, 0xe0, 0x65, 0x90, 0x53, 0x21,
+	0x3c, 0x4a, 0x09, 0x4f, 0xf7, 0xea, 0x51, 0xc4, 0x80, 0xa5, 0x3f, 0xd1, 0xc0, 0xc8, 0x75, 0xcc,
+	0xa2, 0x0d, 0x1e, 0x38, 0x03, 0x06, 0x6f, 0x81, 0x7c, 0xf4, 0x98, 0xbd, 0x5c, 0x3e, 0x98, 0x8b,
+	0xf2, 0x41, 0x13, 0x34, 0xa3, 0xdd, 0x00, 0xbe, 0x07, 0x40, 0xbb, 0xbb, 0xda, 0x33, 0x1d, 0x5c,
+	0xe1, 0x20, 0xb7, 0x50, 0xb0, 0x6d, 0x0e, 0x34, 0xa2, 0x61, 0xe9, 0xbf, 0x35, 0x50, 0x6a, 0xf3,
+	0x97, 0x38, 0x6a, 0xc5, 0xa3, 0xbc, 0xac, 0x51, 0x0c, 0xff, 0x3d, 0x2f, 0x06, 0x42, 0xa2, 0xca,
+	0x9a, 0xbf, 0x3a, 0xa0, 0x18, 0xa8, 0x1a, 0x8b, 0x4b, 0xdd, 0xc5, 0x40, 0xe6, 0x49, 0xdf, 0x56,
+	0xce, 0xd7, 0xbe, 0x3b, 0xe5, 0x40, 0x48, 0x4a, 0xff, 0x9b, 0x02, 0x27, 0xd7, 0x48, 0x10, 0x49,
+	0x1c, 0x44, 0x12, 0xfe, 0x80, 0xa7, 0x6e, 0x8e, 0x83, 0x6a, 0x1e, 0x45, 0xcc, 0xa3, 0x4a, 0x27,
+	0x2f, 0x97, 0x03, 0x19, 0x99, 0x5f, 0x3d, 0x1f, 0x4f, 0x4d, 0x69, 0x66, 0x07, 0xa9, 0x6f, 0xa0,
+	0x1e, 0xf8, 0x08, 0xf4, 0x7b, 0xd4, 0xc6, 0x54, 0xf5, 0x02, 0xd1, 0x9e, 0xf1, 0x29, 0x7d, 0x60,
+	0x9e, 0x88, 0xb5, 0x6f, 0x11, 0xdb, 0xcc, 0x97, 0x93, 0x93, 0x68, 0x8c, 0x43, 0x62, 0x0e, 0x96,
+	0x93, 0x33, 0x91, 0x4e, 0x9b, 0xfd, 0x65, 0xf1, 0x2f, 0x51, 0x3f, 0x98, 0xf9, 0x72, 0x62, 0x22,
+	0xcf, 0x83, 0xe7, 0x41, 0xbf, 0x43, 0x5a, 0x44, 0xf6, 0xec, 0x86, 0x84, 0xdd, 0xcd, 0xa4, 0xf5,
+	0xff, 0xcc, 0x9a, 0x72, 0x19, 0x42, 0xd0, 0xe7, 0xa3, 0x26, 0x16, 0xe9, 0xf8, 0x90, 0x29, 0xc6,
+	0x50, 0x07, 0x59, 0x95, 0xd3, 0xeb, 0x19, 0xe1, 0x5c, 0xd1, 0x74, 0x21, 0x2a, 0x1d, 0xb4, 0xd2,
+	0x5f, 0x6a, 0x60, 0x74, 0x51, 0x9c, 0xd6, 0xe5, 0x12, 0xef, 0x83, 0xac, 0x62, 0x56, 0x5d, 0x7d,
+	0x2f, 0xe7, 0x4a, 0xf8, 0x40, 0x84, 0x01, 0xef, 0x77, 0x29, 0x2f, 0x75, 0x1c, 0xe5, 0xb5, 0xe9,
+	0x76, 0x10, 0x2b, 0xfd, 0xbe, 0x06, 0x46, 0x65, 0x32, 0xf6, 0x6d, 0xb2, 0xfc, 0x0d, 0x7c, 0xf6,
+	0xcf, 0x52, 0x60, 0x3c, 0x61, 0xc2, 0xd5, 0x3b, 0xab, 0x37, 0x71, 0xdb, 0x90, 0xbf, 0xe5, 0xd8,
+	0x12, 0x46, 0x16, 0x28, 0x8b, 0x33, 0x6b, 0xcf, 0x78, 0x40, 0xef, 0x71, 0x0b, 0x44, 0x3e, 0xe1,
+	0xcf, 0xa2, 0xb4, 0xc0, 0xc4, 0xe4, 0x68, 0x66, 0x06, 0xf0, 0x63, 0x9f, 0x50, 0x1c, 0xc8, 0x8d,
+	0xc4, 0xa4, 0xdb, 0xfe, 0x52, 0x87, 0xdb, 0x5f, 0xba, 0x6d, 0x7f, 0x09, 0x2b, 0xfb, 0x21, 0x38,
+	0xdd, 0x0e, 0x6a, 0xf2, 0x7e, 0x5e, 0xd1, 0xf5, 0x9c, 0x02, 0x19, 0x29, 0x77, 0xf4, 0xee, 0x6c,
+	0xe3, 0xdd, 0x55, 0xbb, 0xf4, 0xd3, 0x14, 0x98, 0xe8, 0x30, 0xf3, 0x57, 0xca, 0xc4, 0x99, 0xe4,
+	0xc7, 0x9c, 0xee, 0xfa, 0xf9, 0x23, 0x90, 0x91, 0x9f, 0x9a, 0x7a, 0x35, 0x40, 0x4d, 0xbe, 0x1b,
+	0x35, 0x40, 0x73, 0x5a, 0xa2, 0x01, 0x2a, 0xf1, 0x78, 0x8d, 0xdf, 0x56, 0x90, 0xb0, 0x83, 0x17,
+	0xd4, 0xf8, 0x7b, 0x46, 0xff, 0xcf, 0xb5, 0xd4, 0x47, 0x9a, 0x39, 0xa0, 0xf0, 0xaa, 0xac, 0xf4,
+	0x6f, 0x1a, 0x98, 0xe8, 0xf0, 0xa2, 0x57, 0x7a, 0x23, 0xef, 0x81, 0xac, 0x32, 0x49, 0xe5, 0x5a,
+	0xfb, 0xd2, 0x36, 0x79, 0x7c, 0x02, 0x3d, 0x83, 0x7c, 0xc2, 0x33, 0xb7, 0x4e, 0xc7, 0x4c, 0xbf,
+	0x8c, 0x63, 0xfe, 0xab, 0x06, 0x2e, 0x24, 0x1c, 0x73, 0x31, 0x11, 0x45, 0x5e, 0x95, 0x7b, 0x1e,
+	0xc3, 0x4f, 0xe0, 0xd5, 0x4e, 0x97, 0x7e, 0x6d, 0xcf, 0x38, 0x4f, 0xcf, 0x9a, 0x27, 0xcc, 0x14,
+	0xb1, 0xcd, 0x74, 0x99, 0xd8, 0x66, 0xb6, 0x2c, 0x35, 0x1f, 0x59, 0x80, 0x72, 0xca, 0xd2, 0x3f,
+	0x6a, 0xe0, 0x5c, 0xdb, 0xaf, 0x92, 0xe2, 0xbd, 0x22, 0xe9, 0x5e, 0x69, 0x5c, 0xff, 0x85, 0x06,
+	0xce, 0xdd, 0xfd, 0xff, 0x94, 0xe6, 0xc6, 0x81, 0xd2, 0x9c, 0xdd, 0xdf, 0x1d, 0x6a, 0xc3, 0xf4,
+	0x64, 0xfe, 0x7f, 0x52, 0xa0, 0xd0, 0x59, 0x53, 0x73, 0x55, 0x37, 0x11, 0x91, 0x9f, 0x60, 0x52,
+	0xa6, 0x18, 0xc3, 0x77, 0x40, 0x2e, 0xaa, 0xee, 0xd4, 0x71, 0x7a, 0xf7, 0x71, 0x51, 0x6d, 0x67,
+	0xc6, 0x90, 0xf0, 0x77, 0xb5, 0x8e, 0x3e, 0x5a, 0x5a, 0x24, 0xbb, 0x95, 0xc3, 0x4b, 0xfa, 0x7d,
+	0xed, 0xb4, 0xab, 0xc7, 0x6a, 0xa7, 0xe9, 0x9d, 0x0d, 0xb5, 0x65, 0x30, 0xe0, 0x3b, 0xa8, 0x8e,
+	0x5b, 0xd8, 0x95, 0x91, 0xa7, 0xd0, 0xb3, 0x9f, 0xa7, 0xf8, 0xb8, 0x13, 0x81, 0x9b, 0x6d, 0xcc,
+	0x6f, 0x58, 0x41, 0x24, 0xea, 0x81, 0x9f, 0xf6, 0x83, 0x21, 0x75, 0x9e, 0xaa, 0x83, 0x2b, 0xa0,
+	0x8f, 0xd7, 0xd4, 0xca, 0x38, 0x0e, 0xfb, 0x9e, 0x26, 0xe0, 0xe0, 0x55, 0x30, 0x50, 0xf3, 0x3c,
+	0x66, 0x09, 0xa4, 0x17, 0x7f, 0xbd, 0xcb, 0x71, 0x60, 0x3e, 0x85, 0x3f, 0x04, 0x39, 0xd5, 0x0f,
+	0x8b, 0x34, 0xf2, 0x6b, 0x3d, 0x6e, 0x42, 0x72, 0x56, 0x51, 0x1d, 0x35, 0xa5, 0x8e, 0x77, 0xf7,
+	0x8c, 0xcb, 0xcf, 0xb4, 0xb9, 0x22, 0x28, 0xbd, 0x41, 0x5f, 0xd7, 0x2f, 0xce, 0x5f, 0xe8, 0x50,
+	0x87, 0x75, 0xb0, 0x3e, 0x7e, 0xa4, 0x99, 0xf1, 0xa1, 0x70, 0x1d, 0x8c, 0xa8, 0x5e, 0x4d, 0xdc,
+	0x31, 0x90, 0x9f, 0xc1, 0x0e, 0x31, 0xaa, 0x44, 0xa3, 0xa7, 0xa8, 0x90, 0xa3, 0x2d, 0x1e, 0xbb,
+	0x52, 0xc4, 0x17, 0xdf, 0x98, 0xda, 0x4d, 0x26, 0x50, 0xea, 0xa3, 0x29, 0x5f, 0x33, 0x53, 0xc4,
+	0x87, 0x21, 0xc8, 0xb6, 0x30, 0xa3, 0xa4, 0x1e, 0xb5, 0x72, 0x67, 0x0e, 0x17, 0xf8, 0x96, 0x04,
+	0x96, 0xf2, 0xce, 0xee, 0x19, 0x97, 0x9e, 0x69, 0xd3, 0xc5, 0xc9, 0x23, 0xca, 0x6b, 0x46, 0x67,
+	0xf1, 0x3a, 0x0f, 0xd9, 0x3b, 0xc8, 0xad, 0x63, 0x5b, 0xaf, 0xab, 0xbc, 0xae, 0x5b, 0x41, 0x77,
+	0xc5, 0x4f, 0x55, 0xcc, 0x18, 0x70, 0xe2, 0x7d, 0x30, 0xd4, 0x71, 0xdf, 0x2f, 0x55, 0xa1, 0x2e,
+	0x80, 0xc1, 0x24, 0xef, 0x2f, 0xc2, 0x4d, 0x25, 0x70, 0x4b, 0x6f, 0xc6, 0xdf, 0x2f, 0x4c, 0xdc,
+	0xf2, 0x18, 0x8e, 0x1a, 0x8b, 0x05, 0x71, 0xb9, 0xd1, 0x0f, 0x3e, 0xfc, 0xd2, 0x5f, 0x0c, 0x82,
+	0xb1, 0x38, 0xd4, 0x45, 0xcd, 0x05, 0x7e, 0x71, 0x01, 0xfc, 0x50, 0x74, 0xad, 0xf9, 0x92, 0xec,
+	0xe2, 0xbf, 0xd8, 0x94, 0xf3, 0x31, 0xbc, 0xf8, 0x04, 0x30, 0x6c, 0x93, 0xa0, 0x83, 0x42, 0xfe,
+	0x85, 0x14, 0x0a, 0x49, 0x94, 0x2a, 0x83, 0x13, 0x20, 0x27, 0x7f, 0xe1, 0xe2, 0x39, 0xd1, 0x6f,
+	0x0c, 0xa2, 0x39, 0xfc, 0x75, 0x70, 0xda, 0x41, 0x01, 0x53, 0x9d, 0x29, 0x8b, 0xe2, 0x3a, 0x26,
+	0x3b, 0x47, 0xfc, 0x6a, 0x61, 0x8e, 0x72, 0x54, 0x69, 0x20, 0xa6, 0x42, 0xac, 0x32, 0xf8, 0x7d,
+	0x90, 0x4f, 0x90, 0x54, 0x39, 0xcd, 0xb9, 0x43, 0xcd, 0xcb, 0x04, 0x6d, 0x4a, 0x31, 0x4b, 0xaa,
+	0xdb, 0x91, 0x64, 0xa9, 0xff, 0x68, 0x2c, 0xc9, 0xd6, 0x47, 0x82, 0xa5, 0xd7, 0xc0, 0xa0, 0xa2,
+	0x56, 0xf7, 0x42, 0x97, 0x89, 0x12, 0xaa, 0xcf, 0xcc, 0xcb, 0xb5, 0x45, 0xbe, 0x04, 0x37, 0xc1,
+	0xb8, 0x38, 0x35, 0x6e, 0x3a, 0x26, 0xcf, 0xcd, 0xbe, 0xf0, 0xdc, 0x31, 0x8e, 0x1c, 0x35, 0x20,
+	0x13, 0x27, 0xbf, 0x01, 0x0a, 0x31, 0x45, 0x79, 0x76, 0x4e, 0x9c, 0x3d, 0x14, 0xad, 0xca, 0xd3,
+	0x2d, 0x50, 0xa4, 0x5e, 0xe8, 0xda, 0x16, 0xa3, 0xc4, 0x17, 0xf1, 0x4b, 0xf6, 0xde, 0xf3, 0xf3,
+	0x57, 0x7a, 0xb5, 0xea, 0x3a, 0x0d, 0xad, 0x62, 0x72, 0xf4, 0x0d, 0x4a, 0x7c, 0xc1, 0x93, 0x59,
+	0xa0, 0x1d, 0x73, 0x78, 0x13, 0x0c, 0x04, 0x61, 0xcd, 0xaa, 0x21, 0xd7, 0x0e, 0x74, 0x70, 0xe8,
+	0xa3, 0xd3, 0x4d, 0xf9, 0x6e, 0x58, 0x33, 0x90, 0x6b, 0x9b, 0xb9, 0x40, 0x0e, 0x02, 0x78, 0xaf,
+	0xfd, 0xbd, 0x80, 0x0a, 0xc7, 0x88, 0xbf, 0x17, 0x0c, 0x0a, 0x9e, 0x2f, 0xf6, 0xea, 0xdd, 0x24,
+	0xbd, 0x28, 0x6e, 0xda, 0x77, 0xfa, 0xd6, 0x0e, 0x18, 0x93, 0xfd, 0x2d, 0x6c, 0x5b, 0x49, 0x9d,
+	0x45, 0xdd, 0xef, 0x8f, 0x8e, 0xc8, 0xf5, 0x8a, 0x22, 0xb2, 0xd9, 0xd6, 0xb1, 0x8c, 0x00, 0xe6,
+	0x68, 0xe3, 0x80, 0xad, 0x89, 0x7f, 0xd6, 0x40, 0xa1, 0xf3, 0x0e, 0xe1, 0x15, 0x90, 0x6e, 0xa9,
+	0x37, 0xff, 0xb0, 0x1e, 0xb0, 0x88, 0xc3, 0xcf, 0xb5, 0x54, 0x4e, 0x33, 0x39, 0xbc, 0x40, 0x43,
+	0x8f, 0xd5, 0xfb, 0x73, 0x44, 0x34, 0xf4, 0x18, 0xbe, 0x0f, 0x32, 0x2d, 0x6c, 0x13, 0xe4, 0x2a,
+	0xc7, 0x3b, 0x12, 0xa6, 0x42, 0xe1, 0x31, 0x4c, 0x5a, 0x97, 0x68, 0x29, 0x98, 0x72, 0x32, 0xf1,
+	0xcb, 0x14, 0xc8, 0x2a, 0xed, 0x7d, 0x8b, 0x3f, 0xd0, 0xf9, 0x00, 0x4c, 0xc4, 0x26, 0x1d, 0x32,
+	0xe2, 0xa8, 0xe4, 0xd0, 0x92, 0xb9, 0x72, 0x5a, 0x04, 0xd1, 0xb8, 0x77, 0xbf, 0xd9, 0x06, 0x58,
+	0x13, 0x49, 0xf3, 0xdb, 0x60, 0xf4, 0x20, 0x6c, 0xf5, 0xfb, 0xa5, 0x93, 0x07, 0xe0, 0xc1, 0x4b,
+	0x00, 0x3a, 0x24, 0x60, 0xd8, 0xb5, 0x6a, 0xb8, 0xe1, 0x51, 0x6c, 0x31, 0xe4, 0x6c, 0x8b, 0x58,
+	0x90, 0x33, 0x8b, 0x72, 0xc7, 0x10, 0x1b, 0x1b, 0xc8, 0xd9, 0x86, 0x33, 0x60, 0xc4, 0xa9, 0x31,
+	0xab, 0x81, 0x88, 0x13, 0x52, 0xdc, 0xe1, 0xf0, 0xc3, 0x4e, 0x8d, 0xad, 0xc8, 0x75, 0xe9, 0x76,
+	0x53, 0xa0, 0x98, 0x84, 0xa5, 0x88, 0x61, 0xe1, 0xeb, 0x29, 0xb3, 0xd0, 0x06, 0x35, 0x11, 0xc3,
+	0x13, 0xd7, 0xc1, 0x78, 0x4f, 0x8b, 0x7a, 0xd1, 0x9b, 0xd2, 0x97, 0x78, 0x53, 0x66, 0x1e, 0x80,
+	0xd3, 0x3d, 0x92, 0x2a, 0x78, 0x0a, 0x8c, 0xdc, 0x59, 0xab, 0x2e, 0x2e, 0xdf, 0x5a, 0xbe, 0xbd,
+	0x61, 0x6d, 0xde, 0xbe, 0x79, 0x7b, 0xfd, 0x93, 0xdb, 0xc5, 0x13, 0x10, 0x80, 0xcc, 0xea, 0xed,
+	0xa5, 0xf5, 0x75, 0xb3, 0xa8, 0xc1, 0x3c, 0xc8, 0xae, 0x6f, 0x6e, 0x88, 0x49, 0x6a, 0x62, 0xe4,
+	0xeb, 0xe7, 0xe3, 0x43, 0xba, 0x36, 0x33, 0x10, 0x63, 0x19, 0x57, 0xfe, 0xe6, 0x3f, 0xce, 0x6b,
+	0xf7, 0x66, 0x5f, 0xa2, 0x9b, 0xc7, 0x5c, 0xbf, 0x56, 0xcb, 0x08, 0x1b, 0xbb, 0xfc, 0x7f, 0x01,
+	0x00, 0x00, 0xff, 0xff, 0xfe, 0xd9, 0x4c, 0xdc, 0xa7, 0x2b, 0x00, 0x00,
 }
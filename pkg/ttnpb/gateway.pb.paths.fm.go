@@ -70,6 +70,30 @@ var GatewayClaimAuthenticationCodeFieldPathsTopLevel = []string{
 	"valid_from",
 	"valid_to",
 }
+var GatewayUplinkFilterFieldPathsNested = []string{
+	"action",
+	"dev_addr_prefixes",
+	"join_eui_ranges",
+	"m_types",
+	"net_ids",
+	"upstreams",
+}
+
+var GatewayUplinkFilterFieldPathsTopLevel = []string{
+	"action",
+	"dev_addr_prefixes",
+	"join_eui_ranges",
+	"m_types",
+	"net_ids",
+	"upstreams",
+}
+var GatewayUplinkFiltersFieldPathsNested = []string{
+	"filters",
+}
+
+var GatewayUplinkFiltersFieldPathsTopLevel = []string{
+	"filters",
+}
 var GatewayFieldPathsNested = []string{
 	"administrative_contact",
 	"administrative_contact.ids",
@@ -122,9 +146,11 @@ var GatewayFieldPathsNested = []string{
 	"technical_contact.ids.user_ids",
 	"technical_contact.ids.user_ids.email",
 	"technical_contact.ids.user_ids.user_id",
+	"transmit_beacons",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
+	"uplink_filters",
 	"version_ids",
 	"version_ids.brand_id",
 	"version_ids.firmware_version",
@@ -160,9 +186,11 @@ var GatewayFieldPathsTopLevel = []string{
 	"target_cups_key",
 	"target_cups_uri",
 	"technical_contact",
+	"transmit_beacons",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
+	"uplink_filters",
 	"version_ids",
 }
 var GatewaysFieldPathsNested = []string{
@@ -273,9 +301,11 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.technical_contact.ids.user_ids",
 	"gateway.technical_contact.ids.user_ids.email",
 	"gateway.technical_contact.ids.user_ids.user_id",
+	"gateway.transmit_beacons",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
+	"gateway.uplink_filters",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
 	"gateway.version_ids.firmware_version",
@@ -341,9 +371,11 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.technical_contact.ids.user_ids",
 	"gateway.technical_contact.ids.user_ids.email",
 	"gateway.technical_contact.ids.user_ids.user_id",
+	"gateway.transmit_beacons",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
+	"gateway.uplink_filters",
 	"gateway.version_ids",
 	"gateway.version_ids.brand_id",
 	"gateway.version_ids.firmware_version",
@@ -515,6 +547,7 @@ var GatewayConnectionStatsFieldPathsNested = []string{
 	"connected_at",
 	"disconnected_at",
 	"downlink_count",
+	"filtered_uplink_counts",
 	"gateway_remote_address",
 	"gateway_remote_address.ip",
 	"last_downlink_received_at",
@@ -542,6 +575,7 @@ var GatewayConnectionStatsFieldPathsTopLevel = []string{
 	"connected_at",
 	"disconnected_at",
 	"downlink_count",
+	"filtered_uplink_counts",
 	"gateway_remote_address",
 	"last_downlink_received_at",
 	"last_status",
@@ -563,6 +597,15 @@ var GatewayRadio_TxConfigurationFieldPathsTopLevel = []string{
 	"min_frequency",
 	"notch_frequency",
 }
+var GatewayUplinkFilter_JoinEUIRangeFieldPathsNested = []string{
+	"end",
+	"start",
+}
+
+var GatewayUplinkFilter_JoinEUIRangeFieldPathsTopLevel = []string{
+	"end",
+	"start",
+}
 var Gateway_LRFHSSFieldPathsNested = []string{
 	"supported",
 }
@@ -586,6 +629,9 @@ var GatewayConnectionStats_RoundTripTimesFieldPathsTopLevel = []string{
 var GatewayConnectionStats_SubBandFieldPathsNested = []string{
 	"downlink_utilization",
 	"downlink_utilization_limit",
+	"lbt_failure_count",
+	"lbt_failure_rate",
+	"listen_before_talk",
 	"max_frequency",
 	"min_frequency",
 }
@@ -593,6 +639,9 @@ var GatewayConnectionStats_SubBandFieldPathsNested = []string{
 var GatewayConnectionStats_SubBandFieldPathsTopLevel = []string{
 	"downlink_utilization",
 	"downlink_utilization_limit",
+	"lbt_failure_count",
+	"lbt_failure_rate",
+	"listen_before_talk",
 	"max_frequency",
 	"min_frequency",
 }
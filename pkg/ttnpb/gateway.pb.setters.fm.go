@@ -276,6 +276,92 @@ func (dst *GatewayClaimAuthenticationCode) SetFields(src *GatewayClaimAuthentica
 	return nil
 }
 
+func (dst *GatewayUplinkFilter) SetFields(src *GatewayUplinkFilter, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				var zero GatewayUplinkFilter_Action
+				dst.Action = zero
+			}
+		case "upstreams":
+			if len(subs) > 0 {
+				return fmt.Errorf("'upstreams' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Upstreams = src.Upstreams
+			} else {
+				dst.Upstreams = nil
+			}
+		case "m_types":
+			if len(subs) > 0 {
+				return fmt.Errorf("'m_types' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MTypes = src.MTypes
+			} else {
+				dst.MTypes = nil
+			}
+		case "dev_addr_prefixes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr_prefixes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddrPrefixes = src.DevAddrPrefixes
+			} else {
+				dst.DevAddrPrefixes = nil
+			}
+		case "net_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'net_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NetIds = src.NetIds
+			} else {
+				dst.NetIds = nil
+			}
+		case "join_eui_ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'join_eui_ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JoinEuiRanges = src.JoinEuiRanges
+			} else {
+				dst.JoinEuiRanges = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayUplinkFilters) SetFields(src *GatewayUplinkFilters, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "filters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Filters = src.Filters
+			} else {
+				dst.Filters = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -701,6 +787,25 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 				var zero bool
 				dst.DisablePacketBrokerForwarding = zero
 			}
+		case "transmit_beacons":
+			if len(subs) > 0 {
+				return fmt.Errorf("'transmit_beacons' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TransmitBeacons = src.TransmitBeacons
+			} else {
+				var zero bool
+				dst.TransmitBeacons = zero
+			}
+		case "uplink_filters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_filters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkFilters = src.UplinkFilters
+			} else {
+				dst.UplinkFilters = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1741,6 +1846,15 @@ func (dst *GatewayConnectionStats) SetFields(src *GatewayConnectionStats, paths
 					dst.GatewayRemoteAddress = nil
 				}
 			}
+		case "filtered_uplink_counts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filtered_uplink_counts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FilteredUplinkCounts = src.FilteredUplinkCounts
+			} else {
+				dst.FilteredUplinkCounts = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1790,6 +1904,35 @@ func (dst *GatewayRadio_TxConfiguration) SetFields(src *GatewayRadio_TxConfigura
 	return nil
 }
 
+func (dst *GatewayUplinkFilter_JoinEUIRange) SetFields(src *GatewayUplinkFilter_JoinEUIRange, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "start":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Start = src.Start
+			} else {
+				dst.Start = nil
+			}
+		case "end":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.End = src.End
+			} else {
+				dst.End = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *Gateway_LRFHSS) SetFields(src *Gateway_LRFHSS, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1902,6 +2045,36 @@ func (dst *GatewayConnectionStats_SubBand) SetFields(src *GatewayConnectionStats
 				var zero float32
 				dst.DownlinkUtilization = zero
 			}
+		case "listen_before_talk":
+			if len(subs) > 0 {
+				return fmt.Errorf("'listen_before_talk' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ListenBeforeTalk = src.ListenBeforeTalk
+			} else {
+				var zero bool
+				dst.ListenBeforeTalk = zero
+			}
+		case "lbt_failure_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lbt_failure_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LbtFailureCount = src.LbtFailureCount
+			} else {
+				var zero uint64
+				dst.LbtFailureCount = zero
+			}
+		case "lbt_failure_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lbt_failure_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LbtFailureRate = src.LbtFailureRate
+			} else {
+				var zero float32
+				dst.LbtFailureRate = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -608,6 +608,330 @@ var _ interface {
 	ErrorName() string
 } = GatewayClaimAuthenticationCodeValidationError{}
 
+// ValidateFields checks the field values on GatewayUplinkFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayUplinkFilter) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayUplinkFilterFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "action":
+
+			if _, ok := GatewayUplinkFilter_Action_name[int32(m.GetAction())]; !ok {
+				return GatewayUplinkFilterValidationError{
+					field:  "action",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "upstreams":
+
+			_GatewayUplinkFilter_Upstreams_Unique := make(map[string]struct{}, len(m.GetUpstreams()))
+
+			for idx, item := range m.GetUpstreams() {
+				_, _ = idx, item
+
+				if _, exists := _GatewayUplinkFilter_Upstreams_Unique[item]; exists {
+					return GatewayUplinkFilterValidationError{
+						field:  fmt.Sprintf("upstreams[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_GatewayUplinkFilter_Upstreams_Unique[item] = struct{}{}
+				}
+
+				if _, ok := _GatewayUplinkFilter_Upstreams_InLookup[item]; !ok {
+					return GatewayUplinkFilterValidationError{
+						field:  fmt.Sprintf("upstreams[%v]", idx),
+						reason: "value must be in list [cluster packetbroker interop]",
+					}
+				}
+
+			}
+
+		case "m_types":
+
+			if len(m.GetMTypes()) > 8 {
+				return GatewayUplinkFilterValidationError{
+					field:  "m_types",
+					reason: "value must contain no more than 8 item(s)",
+				}
+			}
+
+			_GatewayUplinkFilter_MTypes_Unique := make(map[MType]struct{}, len(m.GetMTypes()))
+
+			for idx, item := range m.GetMTypes() {
+				_, _ = idx, item
+
+				if _, exists := _GatewayUplinkFilter_MTypes_Unique[item]; exists {
+					return GatewayUplinkFilterValidationError{
+						field:  fmt.Sprintf("m_types[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_GatewayUplinkFilter_MTypes_Unique[item] = struct{}{}
+				}
+
+				if _, ok := MType_name[int32(item)]; !ok {
+					return GatewayUplinkFilterValidationError{
+						field:  fmt.Sprintf("m_types[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		case "dev_addr_prefixes":
+
+			if len(m.GetDevAddrPrefixes()) > 16 {
+				return GatewayUplinkFilterValidationError{
+					field:  "dev_addr_prefixes",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetDevAddrPrefixes() {
+				_, _ = idx, item
+
+				if len(item) != 5 {
+					return GatewayUplinkFilterValidationError{
+						field:  fmt.Sprintf("dev_addr_prefixes[%v]", idx),
+						reason: "value length must be 5 bytes",
+					}
+				}
+
+			}
+
+		case "net_ids":
+
+			if len(m.GetNetIds()) > 16 {
+				return GatewayUplinkFilterValidationError{
+					field:  "net_ids",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetNetIds() {
+				_, _ = idx, item
+
+				if len(item) != 3 {
+					return GatewayUplinkFilterValidationError{
+						field:  fmt.Sprintf("net_ids[%v]", idx),
+						reason: "value length must be 3 bytes",
+					}
+				}
+
+			}
+
+		case "join_eui_ranges":
+
+			if len(m.GetJoinEuiRanges()) > 16 {
+				return GatewayUplinkFilterValidationError{
+					field:  "join_eui_ranges",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetJoinEuiRanges() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayUplinkFilterValidationError{
+							field:  fmt.Sprintf("join_eui_ranges[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayUplinkFilterValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayUplinkFilterValidationError is the validation error returned by
+// GatewayUplinkFilter.ValidateFields if the designated constraints aren't met.
+type GatewayUplinkFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayUplinkFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayUplinkFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayUplinkFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayUplinkFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayUplinkFilterValidationError) ErrorName() string {
+	return "GatewayUplinkFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayUplinkFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayUplinkFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayUplinkFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayUplinkFilterValidationError{}
+
+var _GatewayUplinkFilter_Upstreams_InLookup = map[string]struct{}{
+	"cluster":      {},
+	"packetbroker": {},
+	"interop":      {},
+}
+
+// ValidateFields checks the field values on GatewayUplinkFilters with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayUplinkFilters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayUplinkFiltersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "filters":
+
+			if len(m.GetFilters()) > 16 {
+				return GatewayUplinkFiltersValidationError{
+					field:  "filters",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetFilters() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayUplinkFiltersValidationError{
+							field:  fmt.Sprintf("filters[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayUplinkFiltersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayUplinkFiltersValidationError is the validation error returned by
+// GatewayUplinkFilters.ValidateFields if the designated constraints aren't met.
+type GatewayUplinkFiltersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayUplinkFiltersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayUplinkFiltersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayUplinkFiltersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayUplinkFiltersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayUplinkFiltersValidationError) ErrorName() string {
+	return "GatewayUplinkFiltersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayUplinkFiltersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayUplinkFilters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayUplinkFiltersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayUplinkFiltersValidationError{}
+
 // ValidateFields checks the field values on Gateway with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
@@ -967,6 +1291,32 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 
 		case "disable_packet_broker_forwarding":
 			// no validation rules for DisablePacketBrokerForwarding
+		case "transmit_beacons":
+			// no validation rules for TransmitBeacons
+		case "uplink_filters":
+
+			if len(m.GetUplinkFilters()) > 16 {
+				return GatewayValidationError{
+					field:  "uplink_filters",
+					reason: "value must contain no more than 16 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetUplinkFilters() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayValidationError{
+							field:  fmt.Sprintf("uplink_filters[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return GatewayValidationError{
 				field:  name,
@@ -3186,6 +3536,8 @@ func (m *GatewayConnectionStats) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "filtered_uplink_counts":
+			// no validation rules for FilteredUplinkCounts
 		default:
 			return GatewayConnectionStatsValidationError{
 				field:  name,
@@ -3340,6 +3692,106 @@ var _ interface {
 	ErrorName() string
 } = GatewayRadio_TxConfigurationValidationError{}
 
+// ValidateFields checks the field values on GatewayUplinkFilter_JoinEUIRange
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayUplinkFilter_JoinEUIRange) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayUplinkFilter_JoinEUIRangeFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "start":
+
+			if len(m.GetStart()) != 8 {
+				return GatewayUplinkFilter_JoinEUIRangeValidationError{
+					field:  "start",
+					reason: "value length must be 8 bytes",
+				}
+			}
+
+		case "end":
+
+			if len(m.GetEnd()) != 8 {
+				return GatewayUplinkFilter_JoinEUIRangeValidationError{
+					field:  "end",
+					reason: "value length must be 8 bytes",
+				}
+			}
+
+		default:
+			return GatewayUplinkFilter_JoinEUIRangeValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayUplinkFilter_JoinEUIRangeValidationError is the validation error
+// returned by GatewayUplinkFilter_JoinEUIRange.ValidateFields if the
+// designated constraints aren't met.
+type GatewayUplinkFilter_JoinEUIRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayUplinkFilter_JoinEUIRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayUplinkFilter_JoinEUIRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayUplinkFilter_JoinEUIRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayUplinkFilter_JoinEUIRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayUplinkFilter_JoinEUIRangeValidationError) ErrorName() string {
+	return "GatewayUplinkFilter_JoinEUIRangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayUplinkFilter_JoinEUIRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayUplinkFilter_JoinEUIRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayUplinkFilter_JoinEUIRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayUplinkFilter_JoinEUIRangeValidationError{}
+
 // ValidateFields checks the field values on Gateway_LRFHSS with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -3555,6 +4007,12 @@ func (m *GatewayConnectionStats_SubBand) ValidateFields(paths ...string) error {
 			// no validation rules for DownlinkUtilizationLimit
 		case "downlink_utilization":
 			// no validation rules for DownlinkUtilization
+		case "listen_before_talk":
+			// no validation rules for ListenBeforeTalk
+		case "lbt_failure_count":
+			// no validation rules for LbtFailureCount
+		case "lbt_failure_rate":
+			// no validation rules for LbtFailureRate
 		default:
 			return GatewayConnectionStats_SubBandValidationError{
 				field:  name,
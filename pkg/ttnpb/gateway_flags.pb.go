@@ -217,6 +217,8 @@ func AddSelectFlagsForGateway(flags *pflag.FlagSet, prefix string, hidden bool)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("lrfhss", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("lrfhss", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForGateway_LRFHSS(flags, flagsplugin.Prefix("lrfhss", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("disable-packet-broker-forwarding", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("disable-packet-broker-forwarding", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("transmit-beacons", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("transmit-beacons", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("uplink-filters", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("uplink-filters", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forGateway message from select flags.
@@ -396,6 +398,16 @@ func PathsFromSelectFlagsForGateway(flags *pflag.FlagSet, prefix string) (paths
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("disable_packet_broker_forwarding", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("transmit_beacons", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("transmit_beacons", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("uplink_filters", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("uplink_filters", prefix))
+	}
 	return paths, nil
 }
 
@@ -429,6 +441,8 @@ func AddSetFlagsForGateway(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("require-authenticated-connection", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForGateway_LRFHSS(flags, flagsplugin.Prefix("lrfhss", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("disable-packet-broker-forwarding", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("transmit-beacons", prefix), "", flagsplugin.WithHidden(hidden)))
+	// FIXME: Skipping UplinkFilters because repeated messages are currently not supported.
 }
 
 // SetFromFlags sets the Gateway message from flags.
@@ -627,6 +641,13 @@ func (m *Gateway) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []str
 		m.DisablePacketBrokerForwarding = val
 		paths = append(paths, flagsplugin.Prefix("disable_packet_broker_forwarding", prefix))
 	}
+	if val, changed, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("transmit_beacons", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.TransmitBeacons = val
+		paths = append(paths, flagsplugin.Prefix("transmit_beacons", prefix))
+	}
+	// FIXME: Skipping UplinkFilters because it does not seem to implement AddSetFlags.
 	return paths, nil
 }
 
@@ -57,6 +57,248 @@ func (x *GatewayAntennaPlacement) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the GatewayUplinkFilter_JoinEUIRange message to JSON.
+func (x *GatewayUplinkFilter_JoinEUIRange) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Start) > 0 || s.HasField("start") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("start")
+		types.MarshalHEXBytes(s.WithField("start"), x.Start)
+	}
+	if len(x.End) > 0 || s.HasField("end") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end")
+		types.MarshalHEXBytes(s.WithField("end"), x.End)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayUplinkFilter_JoinEUIRange to JSON.
+func (x *GatewayUplinkFilter_JoinEUIRange) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayUplinkFilter_JoinEUIRange message from JSON.
+func (x *GatewayUplinkFilter_JoinEUIRange) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "start":
+			s.AddField("start")
+			x.Start = types.Unmarshal8Bytes(s.WithField("start", false))
+		case "end":
+			s.AddField("end")
+			x.End = types.Unmarshal8Bytes(s.WithField("end", false))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayUplinkFilter_JoinEUIRange from JSON.
+func (x *GatewayUplinkFilter_JoinEUIRange) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayUplinkFilter message to JSON.
+func (x *GatewayUplinkFilter) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Action != 0 || s.HasField("action") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("action")
+		s.WriteEnum(int32(x.Action), GatewayUplinkFilter_Action_name)
+	}
+	if len(x.Upstreams) > 0 || s.HasField("upstreams") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("upstreams")
+		s.WriteStringArray(x.Upstreams)
+	}
+	if len(x.MTypes) > 0 || s.HasField("m_types") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("m_types")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.MTypes {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s)
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.DevAddrPrefixes) > 0 || s.HasField("dev_addr_prefixes") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr_prefixes")
+		types.MarshalDevAddrPrefixSlice(s.WithField("dev_addr_prefixes"), x.DevAddrPrefixes)
+	}
+	if len(x.NetIds) > 0 || s.HasField("net_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("net_ids")
+		types.MarshalNetIDSlice(s.WithField("net_ids"), x.NetIds)
+	}
+	if len(x.JoinEuiRanges) > 0 || s.HasField("join_eui_ranges") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("join_eui_ranges")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.JoinEuiRanges {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("join_eui_ranges"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayUplinkFilter to JSON.
+func (x *GatewayUplinkFilter) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayUplinkFilter message from JSON.
+func (x *GatewayUplinkFilter) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "action":
+			s.AddField("action")
+			x.Action = GatewayUplinkFilter_Action(s.ReadEnum(GatewayUplinkFilter_Action_value))
+		case "upstreams":
+			s.AddField("upstreams")
+			if s.ReadNil() {
+				x.Upstreams = nil
+				return
+			}
+			x.Upstreams = s.ReadStringArray()
+		case "m_types", "mTypes":
+			s.AddField("m_types")
+			if s.ReadNil() {
+				x.MTypes = nil
+				return
+			}
+			s.ReadArray(func() {
+				var v MType
+				v.UnmarshalProtoJSON(s)
+				x.MTypes = append(x.MTypes, v)
+			})
+		case "dev_addr_prefixes", "devAddrPrefixes":
+			s.AddField("dev_addr_prefixes")
+			if s.ReadNil() {
+				x.DevAddrPrefixes = nil
+				return
+			}
+			x.DevAddrPrefixes = types.UnmarshalDevAddrPrefixSlice(s.WithField("dev_addr_prefixes", false))
+		case "net_ids", "netIds":
+			s.AddField("net_ids")
+			if s.ReadNil() {
+				x.NetIds = nil
+				return
+			}
+			x.NetIds = types.UnmarshalNetIDSlice(s.WithField("net_ids", false))
+		case "join_eui_ranges", "joinEuiRanges":
+			s.AddField("join_eui_ranges")
+			if s.ReadNil() {
+				x.JoinEuiRanges = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.JoinEuiRanges = append(x.JoinEuiRanges, nil)
+					return
+				}
+				v := &GatewayUplinkFilter_JoinEUIRange{}
+				v.UnmarshalProtoJSON(s.WithField("join_eui_ranges", false))
+				if s.Err() != nil {
+					return
+				}
+				x.JoinEuiRanges = append(x.JoinEuiRanges, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayUplinkFilter from JSON.
+func (x *GatewayUplinkFilter) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayUplinkFilters message to JSON.
+func (x *GatewayUplinkFilters) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Filters) > 0 || s.HasField("filters") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("filters")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Filters {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("filters"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayUplinkFilters to JSON.
+func (x *GatewayUplinkFilters) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayUplinkFilters message from JSON.
+func (x *GatewayUplinkFilters) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "filters":
+			s.AddField("filters")
+			if s.ReadNil() {
+				x.Filters = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Filters = append(x.Filters, nil)
+					return
+				}
+				v := &GatewayUplinkFilter{}
+				v.UnmarshalProtoJSON(s.WithField("filters", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Filters = append(x.Filters, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayUplinkFilters from JSON.
+func (x *GatewayUplinkFilters) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the Gateway message to JSON.
 func (x *Gateway) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -262,6 +504,22 @@ func (x *Gateway) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("disable_packet_broker_forwarding")
 		s.WriteBool(x.DisablePacketBrokerForwarding)
 	}
+	if x.TransmitBeacons || s.HasField("transmit_beacons") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("transmit_beacons")
+		s.WriteBool(x.TransmitBeacons)
+	}
+	if len(x.UplinkFilters) > 0 || s.HasField("uplink_filters") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uplink_filters")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.UplinkFilters {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("uplink_filters"))
+		}
+		s.WriteArrayEnd()
+	}
 	s.WriteObjectEnd()
 }
 
@@ -498,6 +756,27 @@ func (x *Gateway) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 		case "disable_packet_broker_forwarding", "disablePacketBrokerForwarding":
 			s.AddField("disable_packet_broker_forwarding")
 			x.DisablePacketBrokerForwarding = s.ReadBool()
+		case "transmit_beacons", "transmitBeacons":
+			s.AddField("transmit_beacons")
+			x.TransmitBeacons = s.ReadBool()
+		case "uplink_filters", "uplinkFilters":
+			s.AddField("uplink_filters")
+			if s.ReadNil() {
+				x.UplinkFilters = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.UplinkFilters = append(x.UplinkFilters, nil)
+					return
+				}
+				v := &GatewayUplinkFilter{}
+				v.UnmarshalProtoJSON(s.WithField("uplink_filters", false))
+				if s.Err() != nil {
+					return
+				}
+				x.UplinkFilters = append(x.UplinkFilters, v)
+			})
 		}
 	})
 }
@@ -1654,6 +1933,18 @@ func (x *GatewayConnectionStats) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		// NOTE: GatewayRemoteAddress does not seem to implement MarshalProtoJSON.
 		gogo.MarshalMessage(s, x.GatewayRemoteAddress)
 	}
+	if x.FilteredUplinkCounts != nil || s.HasField("filtered_uplink_counts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("filtered_uplink_counts")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.FilteredUplinkCounts {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteUint64(v)
+		}
+		s.WriteObjectEnd()
+	}
 	s.WriteObjectEnd()
 }
 
@@ -1774,6 +2065,16 @@ func (x *GatewayConnectionStats) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState
 			var v GatewayRemoteAddress
 			gogo.UnmarshalMessage(s, &v)
 			x.GatewayRemoteAddress = &v
+		case "filtered_uplink_counts", "filteredUplinkCounts":
+			s.AddField("filtered_uplink_counts")
+			if s.ReadNil() {
+				x.FilteredUplinkCounts = nil
+				return
+			}
+			x.FilteredUplinkCounts = make(map[string]uint64)
+			s.ReadStringMap(func(key string) {
+				x.FilteredUplinkCounts[key] = s.ReadUint64()
+			})
 		}
 	})
 }
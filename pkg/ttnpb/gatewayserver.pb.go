@@ -30,6 +30,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type GatewayTrafficCaptureFrame_Direction int32
+
+const (
+	GatewayTrafficCaptureFrame_UPLINK   GatewayTrafficCaptureFrame_Direction = 0
+	GatewayTrafficCaptureFrame_DOWNLINK GatewayTrafficCaptureFrame_Direction = 1
+)
+
+var GatewayTrafficCaptureFrame_Direction_name = map[int32]string{
+	0: "UPLINK",
+	1: "DOWNLINK",
+}
+
+var GatewayTrafficCaptureFrame_Direction_value = map[string]int32{
+	"UPLINK":   0,
+	"DOWNLINK": 1,
+}
+
+func (x GatewayTrafficCaptureFrame_Direction) String() string {
+	return proto.EnumName(GatewayTrafficCaptureFrame_Direction_name, int32(x))
+}
+
+func (GatewayTrafficCaptureFrame_Direction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{12, 0}
+}
+
 // GatewayUp may contain zero or more uplink messages and/or a status message for the gateway.
 type GatewayUp struct {
 	// Uplink messages received by the gateway.
@@ -324,7 +349,681 @@ func (m *BatchGetGatewayConnectionStatsResponse) GetEntries() map[string]*Gatewa
 	return nil
 }
 
+type GatewayRemoteCommandRequest struct {
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// The command to run on the gateway.
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// The arguments of the command.
+	Arguments            []string `protobuf:"bytes,3,rep,name=arguments,proto3" json:"arguments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayRemoteCommandRequest) Reset()         { *m = GatewayRemoteCommandRequest{} }
+func (m *GatewayRemoteCommandRequest) String() string { return proto.CompactTextString(m) }
+func (*GatewayRemoteCommandRequest) ProtoMessage()    {}
+func (*GatewayRemoteCommandRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{6}
+}
+func (m *GatewayRemoteCommandRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayRemoteCommandRequest.Unmarshal(m, b)
+}
+func (m *GatewayRemoteCommandRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayRemoteCommandRequest.Marshal(b, m, deterministic)
+}
+func (m *GatewayRemoteCommandRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteCommandRequest.Merge(m, src)
+}
+func (m *GatewayRemoteCommandRequest) XXX_Size() int {
+	return xxx_messageInfo_GatewayRemoteCommandRequest.Size(m)
+}
+func (m *GatewayRemoteCommandRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteCommandRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayRemoteCommandRequest proto.InternalMessageInfo
+
+func (m *GatewayRemoteCommandRequest) GetGatewayIds() *GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+func (m *GatewayRemoteCommandRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *GatewayRemoteCommandRequest) GetArguments() []string {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+// GatewayRemoteShellRequest is a message from the client in a remote shell session.
+// The first message of the session starts the session, and the subsequent messages contain the input of the shell.
+type GatewayRemoteShellRequest struct {
+	// Types that are valid to be assigned to Message:
+	//	*GatewayRemoteShellRequest_Start_
+	//	*GatewayRemoteShellRequest_Input
+	Message              isGatewayRemoteShellRequest_Message `protobuf_oneof:"message"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *GatewayRemoteShellRequest) Reset()         { *m = GatewayRemoteShellRequest{} }
+func (m *GatewayRemoteShellRequest) String() string { return proto.CompactTextString(m) }
+func (*GatewayRemoteShellRequest) ProtoMessage()    {}
+func (*GatewayRemoteShellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7}
+}
+func (m *GatewayRemoteShellRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayRemoteShellRequest.Unmarshal(m, b)
+}
+func (m *GatewayRemoteShellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayRemoteShellRequest.Marshal(b, m, deterministic)
+}
+func (m *GatewayRemoteShellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteShellRequest.Merge(m, src)
+}
+func (m *GatewayRemoteShellRequest) XXX_Size() int {
+	return xxx_messageInfo_GatewayRemoteShellRequest.Size(m)
+}
+func (m *GatewayRemoteShellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteShellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayRemoteShellRequest proto.InternalMessageInfo
+
+type isGatewayRemoteShellRequest_Message interface {
+	isGatewayRemoteShellRequest_Message()
+}
+
+type GatewayRemoteShellRequest_Start_ struct {
+	Start *GatewayRemoteShellRequest_Start `protobuf:"bytes,1,opt,name=start,proto3,oneof" json:"start,omitempty"`
+}
+type GatewayRemoteShellRequest_Input struct {
+	Input []byte `protobuf:"bytes,2,opt,name=input,proto3,oneof" json:"input,omitempty"`
+}
+
+func (*GatewayRemoteShellRequest_Start_) isGatewayRemoteShellRequest_Message() {}
+func (*GatewayRemoteShellRequest_Input) isGatewayRemoteShellRequest_Message()  {}
+
+func (m *GatewayRemoteShellRequest) GetMessage() isGatewayRemoteShellRequest_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *GatewayRemoteShellRequest) GetStart() *GatewayRemoteShellRequest_Start {
+	if x, ok := m.GetMessage().(*GatewayRemoteShellRequest_Start_); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (m *GatewayRemoteShellRequest) GetInput() []byte {
+	if x, ok := m.GetMessage().(*GatewayRemoteShellRequest_Input); ok {
+		return x.Input
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*GatewayRemoteShellRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*GatewayRemoteShellRequest_Start_)(nil),
+		(*GatewayRemoteShellRequest_Input)(nil),
+	}
+}
+
+type GatewayRemoteShellRequest_Start struct {
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// The user that opens the remote shell session. This is informational.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// The terminal type of the client, such as `xterm`.
+	Term                 string   `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayRemoteShellRequest_Start) Reset()         { *m = GatewayRemoteShellRequest_Start{} }
+func (m *GatewayRemoteShellRequest_Start) String() string { return proto.CompactTextString(m) }
+func (*GatewayRemoteShellRequest_Start) ProtoMessage()    {}
+func (*GatewayRemoteShellRequest_Start) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{7, 0}
+}
+func (m *GatewayRemoteShellRequest_Start) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayRemoteShellRequest_Start.Unmarshal(m, b)
+}
+func (m *GatewayRemoteShellRequest_Start) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayRemoteShellRequest_Start.Marshal(b, m, deterministic)
+}
+func (m *GatewayRemoteShellRequest_Start) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteShellRequest_Start.Merge(m, src)
+}
+func (m *GatewayRemoteShellRequest_Start) XXX_Size() int {
+	return xxx_messageInfo_GatewayRemoteShellRequest_Start.Size(m)
+}
+func (m *GatewayRemoteShellRequest_Start) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteShellRequest_Start.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayRemoteShellRequest_Start proto.InternalMessageInfo
+
+func (m *GatewayRemoteShellRequest_Start) GetGatewayIds() *GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+func (m *GatewayRemoteShellRequest_Start) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *GatewayRemoteShellRequest_Start) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+// GatewayRemoteShellResponse is a message to the client in a remote shell session.
+type GatewayRemoteShellResponse struct {
+	// Output of the shell.
+	Output               []byte   `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayRemoteShellResponse) Reset()         { *m = GatewayRemoteShellResponse{} }
+func (m *GatewayRemoteShellResponse) String() string { return proto.CompactTextString(m) }
+func (*GatewayRemoteShellResponse) ProtoMessage()    {}
+func (*GatewayRemoteShellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{8}
+}
+func (m *GatewayRemoteShellResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayRemoteShellResponse.Unmarshal(m, b)
+}
+func (m *GatewayRemoteShellResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayRemoteShellResponse.Marshal(b, m, deterministic)
+}
+func (m *GatewayRemoteShellResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayRemoteShellResponse.Merge(m, src)
+}
+func (m *GatewayRemoteShellResponse) XXX_Size() int {
+	return xxx_messageInfo_GatewayRemoteShellResponse.Size(m)
+}
+func (m *GatewayRemoteShellResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayRemoteShellResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayRemoteShellResponse proto.InternalMessageInfo
+
+func (m *GatewayRemoteShellResponse) GetOutput() []byte {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+// GatewayConnectionStatsSnapshot contains the statistics of a gateway connection in an interval.
+type GatewayConnectionStatsSnapshot struct {
+	// Start of the interval.
+	Time *types.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Duration of the interval.
+	Interval *types.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Number of uplink messages received in the interval.
+	UplinkCount uint64 `protobuf:"varint,3,opt,name=uplink_count,json=uplinkCount,proto3" json:"uplink_count,omitempty"`
+	// Number of downlink messages sent in the interval.
+	DownlinkCount uint64 `protobuf:"varint,4,opt,name=downlink_count,json=downlinkCount,proto3" json:"downlink_count,omitempty"`
+	// Round trip times at the end of the interval.
+	// When snapshots are aggregated, the minimum and maximum are those of the aggregated snapshots,
+	// and the median is the mean of the medians weighted by the count.
+	RoundTripTimes *GatewayConnectionStats_RoundTripTimes `protobuf:"bytes,5,opt,name=round_trip_times,json=roundTripTimes,proto3" json:"round_trip_times,omitempty"`
+	// Statistics for each sub band at the end of the interval.
+	// When snapshots are aggregated, the downlink utilization is the mean of the aggregated snapshots.
+	SubBands             []*GatewayConnectionStats_SubBand `protobuf:"bytes,6,rep,name=sub_bands,json=subBands,proto3" json:"sub_bands,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *GatewayConnectionStatsSnapshot) Reset()         { *m = GatewayConnectionStatsSnapshot{} }
+func (m *GatewayConnectionStatsSnapshot) String() string { return proto.CompactTextString(m) }
+func (*GatewayConnectionStatsSnapshot) ProtoMessage()    {}
+func (*GatewayConnectionStatsSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{9}
+}
+func (m *GatewayConnectionStatsSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayConnectionStatsSnapshot.Unmarshal(m, b)
+}
+func (m *GatewayConnectionStatsSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayConnectionStatsSnapshot.Marshal(b, m, deterministic)
+}
+func (m *GatewayConnectionStatsSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsSnapshot.Merge(m, src)
+}
+func (m *GatewayConnectionStatsSnapshot) XXX_Size() int {
+	return xxx_messageInfo_GatewayConnectionStatsSnapshot.Size(m)
+}
+func (m *GatewayConnectionStatsSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionStatsSnapshot proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsSnapshot) GetTime() *types.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *GatewayConnectionStatsSnapshot) GetInterval() *types.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *GatewayConnectionStatsSnapshot) GetUplinkCount() uint64 {
+	if m != nil {
+		return m.UplinkCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStatsSnapshot) GetDownlinkCount() uint64 {
+	if m != nil {
+		return m.DownlinkCount
+	}
+	return 0
+}
+
+func (m *GatewayConnectionStatsSnapshot) GetRoundTripTimes() *GatewayConnectionStats_RoundTripTimes {
+	if m != nil {
+		return m.RoundTripTimes
+	}
+	return nil
+}
+
+func (m *GatewayConnectionStatsSnapshot) GetSubBands() []*GatewayConnectionStats_SubBand {
+	if m != nil {
+		return m.SubBands
+	}
+	return nil
+}
+
+type GetGatewayConnectionStatsHistoryRequest struct {
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Query snapshots after this timestamp only. Cannot be used in conjunction with last.
+	After *types.Timestamp `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	// Query snapshots before this timestamp only. Cannot be used in conjunction with last.
+	Before *types.Timestamp `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	// Query snapshots in the last hours or minutes.
+	// If after, before and last are not set, the snapshots of the last 24 hours are returned.
+	Last *types.Duration `protobuf:"bytes,4,opt,name=last,proto3" json:"last,omitempty"`
+	// Aggregate the snapshots in intervals of this duration.
+	// If not set, the snapshots are returned as stored.
+	AggregationInterval  *types.Duration `protobuf:"bytes,5,opt,name=aggregation_interval,json=aggregationInterval,proto3" json:"aggregation_interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) Reset() {
+	*m = GetGatewayConnectionStatsHistoryRequest{}
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetGatewayConnectionStatsHistoryRequest) ProtoMessage()    {}
+func (*GetGatewayConnectionStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{10}
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Merge(m, src)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.Size(m)
+}
+func (m *GetGatewayConnectionStatsHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetGatewayConnectionStatsHistoryRequest proto.InternalMessageInfo
+
+func (m *GetGatewayConnectionStatsHistoryRequest) GetGatewayIds() *GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) GetAfter() *types.Timestamp {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) GetBefore() *types.Timestamp {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) GetLast() *types.Duration {
+	if m != nil {
+		return m.Last
+	}
+	return nil
+}
+
+func (m *GetGatewayConnectionStatsHistoryRequest) GetAggregationInterval() *types.Duration {
+	if m != nil {
+		return m.AggregationInterval
+	}
+	return nil
+}
+
+type GatewayConnectionStatsHistory struct {
+	// Snapshots in chronological order.
+	Snapshots            []*GatewayConnectionStatsSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *GatewayConnectionStatsHistory) Reset()         { *m = GatewayConnectionStatsHistory{} }
+func (m *GatewayConnectionStatsHistory) String() string { return proto.CompactTextString(m) }
+func (*GatewayConnectionStatsHistory) ProtoMessage()    {}
+func (*GatewayConnectionStatsHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{11}
+}
+func (m *GatewayConnectionStatsHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayConnectionStatsHistory.Unmarshal(m, b)
+}
+func (m *GatewayConnectionStatsHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayConnectionStatsHistory.Marshal(b, m, deterministic)
+}
+func (m *GatewayConnectionStatsHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayConnectionStatsHistory.Merge(m, src)
+}
+func (m *GatewayConnectionStatsHistory) XXX_Size() int {
+	return xxx_messageInfo_GatewayConnectionStatsHistory.Size(m)
+}
+func (m *GatewayConnectionStatsHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayConnectionStatsHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayConnectionStatsHistory proto.InternalMessageInfo
+
+func (m *GatewayConnectionStatsHistory) GetSnapshots() []*GatewayConnectionStatsSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureFrame is a frame exchanged between the gateway and the Gateway Server.
+type GatewayTrafficCaptureFrame struct {
+	// Time when the frame was received or sent by the Gateway Server.
+	Time      *types.Timestamp                     `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Direction GatewayTrafficCaptureFrame_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=ttn.lorawan.v3.GatewayTrafficCaptureFrame_Direction" json:"direction,omitempty"`
+	// The raw frame as exchanged with the gateway, in the encoding of the frontend.
+	// For the UDP frontend, this is the UDP datagram. For the LoRa Basics Station frontend, this is the
+	// JSON websocket message. For the MQTT frontend, this is the MQTT message payload.
+	Raw []byte `protobuf:"bytes,3,opt,name=raw,proto3" json:"raw,omitempty"`
+	// The decoded uplink frame. This is not set for frames that do not carry messages, like keep alives.
+	Up *GatewayUp `protobuf:"bytes,4,opt,name=up,proto3" json:"up,omitempty"`
+	// The decoded downlink frame.
+	Down                 *GatewayDown `protobuf:"bytes,5,opt,name=down,proto3" json:"down,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GatewayTrafficCaptureFrame) Reset()         { *m = GatewayTrafficCaptureFrame{} }
+func (m *GatewayTrafficCaptureFrame) String() string { return proto.CompactTextString(m) }
+func (*GatewayTrafficCaptureFrame) ProtoMessage()    {}
+func (*GatewayTrafficCaptureFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{12}
+}
+func (m *GatewayTrafficCaptureFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayTrafficCaptureFrame.Unmarshal(m, b)
+}
+func (m *GatewayTrafficCaptureFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayTrafficCaptureFrame.Marshal(b, m, deterministic)
+}
+func (m *GatewayTrafficCaptureFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficCaptureFrame.Merge(m, src)
+}
+func (m *GatewayTrafficCaptureFrame) XXX_Size() int {
+	return xxx_messageInfo_GatewayTrafficCaptureFrame.Size(m)
+}
+func (m *GatewayTrafficCaptureFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficCaptureFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayTrafficCaptureFrame proto.InternalMessageInfo
+
+func (m *GatewayTrafficCaptureFrame) GetTime() *types.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCaptureFrame) GetDirection() GatewayTrafficCaptureFrame_Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return GatewayTrafficCaptureFrame_UPLINK
+}
+
+func (m *GatewayTrafficCaptureFrame) GetRaw() []byte {
+	if m != nil {
+		return m.Raw
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCaptureFrame) GetUp() *GatewayUp {
+	if m != nil {
+		return m.Up
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCaptureFrame) GetDown() *GatewayDown {
+	if m != nil {
+		return m.Down
+	}
+	return nil
+}
+
+type StartGatewayTrafficCaptureRequest struct {
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// Stop the capture after this duration.
+	// If not set, the maximum duration configured in the Gateway Server is used.
+	Duration *types.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Stop the capture when the captured frames exceed this size in bytes.
+	// If not set, the maximum size configured in the Gateway Server is used.
+	MaxSize              uint64   `protobuf:"varint,3,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartGatewayTrafficCaptureRequest) Reset()         { *m = StartGatewayTrafficCaptureRequest{} }
+func (m *StartGatewayTrafficCaptureRequest) String() string { return proto.CompactTextString(m) }
+func (*StartGatewayTrafficCaptureRequest) ProtoMessage()    {}
+func (*StartGatewayTrafficCaptureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{13}
+}
+func (m *StartGatewayTrafficCaptureRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartGatewayTrafficCaptureRequest.Unmarshal(m, b)
+}
+func (m *StartGatewayTrafficCaptureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartGatewayTrafficCaptureRequest.Marshal(b, m, deterministic)
+}
+func (m *StartGatewayTrafficCaptureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartGatewayTrafficCaptureRequest.Merge(m, src)
+}
+func (m *StartGatewayTrafficCaptureRequest) XXX_Size() int {
+	return xxx_messageInfo_StartGatewayTrafficCaptureRequest.Size(m)
+}
+func (m *StartGatewayTrafficCaptureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartGatewayTrafficCaptureRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartGatewayTrafficCaptureRequest proto.InternalMessageInfo
+
+func (m *StartGatewayTrafficCaptureRequest) GetGatewayIds() *GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+func (m *StartGatewayTrafficCaptureRequest) GetDuration() *types.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *StartGatewayTrafficCaptureRequest) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+// GatewayTrafficCapture is a capture of the traffic of a gateway connection.
+type GatewayTrafficCapture struct {
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// The protocol of the frontend that the gateway is connected with, such as `udp`, `ws` or `mqtt`.
+	Protocol  string           `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	StartedAt *types.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	// Time when the capture stopped. This is not set while the capture is active.
+	StoppedAt *types.Timestamp `protobuf:"bytes,4,opt,name=stopped_at,json=stoppedAt,proto3" json:"stopped_at,omitempty"`
+	// The duration limit of the capture.
+	Duration *types.Duration `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The size limit of the capture in bytes.
+	MaxSize uint64 `protobuf:"varint,6,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// The size of the captured frames in bytes.
+	CapturedSize uint64 `protobuf:"varint,7,opt,name=captured_size,json=capturedSize,proto3" json:"captured_size,omitempty"`
+	// The captured frames in chronological order.
+	Frames               []*GatewayTrafficCaptureFrame `protobuf:"bytes,8,rep,name=frames,proto3" json:"frames,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *GatewayTrafficCapture) Reset()         { *m = GatewayTrafficCapture{} }
+func (m *GatewayTrafficCapture) String() string { return proto.CompactTextString(m) }
+func (*GatewayTrafficCapture) ProtoMessage()    {}
+func (*GatewayTrafficCapture) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b07a36420f2d6d, []int{14}
+}
+func (m *GatewayTrafficCapture) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayTrafficCapture.Unmarshal(m, b)
+}
+func (m *GatewayTrafficCapture) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayTrafficCapture.Marshal(b, m, deterministic)
+}
+func (m *GatewayTrafficCapture) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayTrafficCapture.Merge(m, src)
+}
+func (m *GatewayTrafficCapture) XXX_Size() int {
+	return xxx_messageInfo_GatewayTrafficCapture.Size(m)
+}
+func (m *GatewayTrafficCapture) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayTrafficCapture.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayTrafficCapture proto.InternalMessageInfo
+
+func (m *GatewayTrafficCapture) GetGatewayIds() *GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCapture) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *GatewayTrafficCapture) GetStartedAt() *types.Timestamp {
+	if m != nil {
+		return m.StartedAt
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCapture) GetStoppedAt() *types.Timestamp {
+	if m != nil {
+		return m.StoppedAt
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCapture) GetDuration() *types.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *GatewayTrafficCapture) GetMaxSize() uint64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *GatewayTrafficCapture) GetCapturedSize() uint64 {
+	if m != nil {
+		return m.CapturedSize
+	}
+	return 0
+}
+
+func (m *GatewayTrafficCapture) GetFrames() []*GatewayTrafficCaptureFrame {
+	if m != nil {
+		return m.Frames
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.GatewayTrafficCaptureFrame_Direction", GatewayTrafficCaptureFrame_Direction_name, GatewayTrafficCaptureFrame_Direction_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.GatewayTrafficCaptureFrame_Direction", GatewayTrafficCaptureFrame_Direction_name, GatewayTrafficCaptureFrame_Direction_value)
 	proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
 	golang_proto.RegisterType((*GatewayUp)(nil), "ttn.lorawan.v3.GatewayUp")
 	proto.RegisterType((*GatewayDown)(nil), "ttn.lorawan.v3.GatewayDown")
@@ -339,6 +1038,26 @@ func init() {
 	golang_proto.RegisterType((*BatchGetGatewayConnectionStatsResponse)(nil), "ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse")
 	proto.RegisterMapType((map[string]*GatewayConnectionStats)(nil), "ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry")
 	golang_proto.RegisterMapType((map[string]*GatewayConnectionStats)(nil), "ttn.lorawan.v3.BatchGetGatewayConnectionStatsResponse.EntriesEntry")
+	proto.RegisterType((*GatewayRemoteCommandRequest)(nil), "ttn.lorawan.v3.GatewayRemoteCommandRequest")
+	golang_proto.RegisterType((*GatewayRemoteCommandRequest)(nil), "ttn.lorawan.v3.GatewayRemoteCommandRequest")
+	proto.RegisterType((*GatewayRemoteShellRequest)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest")
+	golang_proto.RegisterType((*GatewayRemoteShellRequest)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest")
+	proto.RegisterType((*GatewayRemoteShellRequest_Start)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest.Start")
+	golang_proto.RegisterType((*GatewayRemoteShellRequest_Start)(nil), "ttn.lorawan.v3.GatewayRemoteShellRequest.Start")
+	proto.RegisterType((*GatewayRemoteShellResponse)(nil), "ttn.lorawan.v3.GatewayRemoteShellResponse")
+	golang_proto.RegisterType((*GatewayRemoteShellResponse)(nil), "ttn.lorawan.v3.GatewayRemoteShellResponse")
+	proto.RegisterType((*GatewayConnectionStatsSnapshot)(nil), "ttn.lorawan.v3.GatewayConnectionStatsSnapshot")
+	golang_proto.RegisterType((*GatewayConnectionStatsSnapshot)(nil), "ttn.lorawan.v3.GatewayConnectionStatsSnapshot")
+	proto.RegisterType((*GetGatewayConnectionStatsHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionStatsHistoryRequest")
+	golang_proto.RegisterType((*GetGatewayConnectionStatsHistoryRequest)(nil), "ttn.lorawan.v3.GetGatewayConnectionStatsHistoryRequest")
+	proto.RegisterType((*GatewayConnectionStatsHistory)(nil), "ttn.lorawan.v3.GatewayConnectionStatsHistory")
+	golang_proto.RegisterType((*GatewayConnectionStatsHistory)(nil), "ttn.lorawan.v3.GatewayConnectionStatsHistory")
+	proto.RegisterType((*GatewayTrafficCaptureFrame)(nil), "ttn.lorawan.v3.GatewayTrafficCaptureFrame")
+	golang_proto.RegisterType((*GatewayTrafficCaptureFrame)(nil), "ttn.lorawan.v3.GatewayTrafficCaptureFrame")
+	proto.RegisterType((*StartGatewayTrafficCaptureRequest)(nil), "ttn.lorawan.v3.StartGatewayTrafficCaptureRequest")
+	golang_proto.RegisterType((*StartGatewayTrafficCaptureRequest)(nil), "ttn.lorawan.v3.StartGatewayTrafficCaptureRequest")
+	proto.RegisterType((*GatewayTrafficCapture)(nil), "ttn.lorawan.v3.GatewayTrafficCapture")
+	golang_proto.RegisterType((*GatewayTrafficCapture)(nil), "ttn.lorawan.v3.GatewayTrafficCapture")
 }
 
 func init() {
@@ -349,67 +1068,123 @@ func init() {
 }
 
 var fileDescriptor_62b07a36420f2d6d = []byte{
-	// 959 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0xd7, 0x6c, 0x12, 0x9a, 0xcc, 0xb6, 0xe9, 0x32, 0x12, 0xb0, 0xd9, 0x26, 0x69, 0x64, 0xd4,
-	0x2a, 0xaa, 0x58, 0x3b, 0x38, 0x6a, 0xd5, 0x56, 0x70, 0xc8, 0x66, 0xd3, 0x55, 0x10, 0xe1, 0x8f,
-	0x93, 0x20, 0x81, 0x14, 0xad, 0x66, 0xed, 0x59, 0xaf, 0x65, 0xef, 0x8c, 0xeb, 0x19, 0xef, 0x66,
-	0x85, 0xb8, 0xf0, 0x11, 0xe0, 0xc0, 0x81, 0x6f, 0xc0, 0x95, 0x13, 0x12, 0x07, 0xbe, 0x03, 0x77,
-	0x38, 0xc0, 0x01, 0x89, 0x6f, 0xd0, 0x13, 0xf2, 0x78, 0xbc, 0x7f, 0xec, 0xb8, 0x0d, 0x48, 0x9c,
-	0x3c, 0x33, 0xef, 0xf7, 0x7e, 0xef, 0xf7, 0xde, 0xbc, 0x37, 0x86, 0xf7, 0x02, 0x16, 0xe1, 0x31,
-	0xa6, 0x4d, 0x2e, 0xb0, 0xed, 0x1b, 0x38, 0xf4, 0x0c, 0x17, 0x0b, 0x32, 0xc6, 0x13, 0x4e, 0xa2,
-	0x11, 0x89, 0xf4, 0x30, 0x62, 0x82, 0xa1, 0x75, 0x21, 0xa8, 0xae, 0xa0, 0xfa, 0x68, 0xbf, 0x71,
-	0xe0, 0x7a, 0x62, 0x10, 0xf7, 0x74, 0x9b, 0x0d, 0x0d, 0x42, 0x47, 0x6c, 0x12, 0x46, 0xec, 0x72,
-	0x62, 0x48, 0xb0, 0xdd, 0x74, 0x09, 0x6d, 0x8e, 0x70, 0xe0, 0x39, 0x58, 0x10, 0xa3, 0xb0, 0x48,
-	0x29, 0x1b, 0xcd, 0x39, 0x0a, 0x97, 0xb9, 0x2c, 0x75, 0xee, 0xc5, 0x7d, 0xb9, 0x93, 0x1b, 0xb9,
-	0x52, 0xf0, 0x4d, 0x97, 0x31, 0x37, 0x20, 0x52, 0x21, 0xa6, 0x94, 0x09, 0x2c, 0x3c, 0x46, 0xb9,
-	0xb2, 0x6e, 0x2b, 0xeb, 0x94, 0xc3, 0x89, 0x23, 0x09, 0x50, 0xf6, 0x3b, 0x79, 0x3b, 0x19, 0x86,
-	0x62, 0xa2, 0x8c, 0x5b, 0xc5, 0x1a, 0x90, 0x28, 0x62, 0x2a, 0xf7, 0xc6, 0x4e, 0xde, 0xb7, 0xef,
-	0x91, 0xc0, 0xe9, 0x0e, 0x31, 0xf7, 0x15, 0xe2, 0x6e, 0x69, 0x11, 0x15, 0xe0, 0xed, 0x22, 0xc0,
-	0x73, 0x08, 0x15, 0x5e, 0xdf, 0x23, 0x11, 0x2f, 0x67, 0xc9, 0x2a, 0xae, 0x84, 0x14, 0x01, 0x43,
-	0xc2, 0x39, 0x76, 0x49, 0x46, 0xb1, 0x79, 0x05, 0xe2, 0xb9, 0x10, 0xe5, 0xfe, 0x11, 0x71, 0x3d,
-	0x46, 0x71, 0x90, 0x22, 0xb4, 0xbf, 0x00, 0x5c, 0xeb, 0xa4, 0xca, 0xcf, 0x43, 0xf4, 0x0c, 0xde,
-	0x8e, 0xc3, 0xc0, 0xa3, 0x7e, 0x37, 0x0b, 0x53, 0x07, 0x3b, 0x4b, 0xbb, 0x55, 0x73, 0x4b, 0x5f,
-	0x6c, 0x07, 0xfd, 0x5c, 0xc2, 0x4e, 0x52, 0x94, 0xb5, 0x1e, 0xcf, 0x6f, 0x39, 0x6a, 0xc3, 0x75,
-	0x55, 0x8e, 0x2e, 0x17, 0x58, 0xc4, 0xbc, 0x5e, 0xd9, 0x01, 0x57, 0xd1, 0xa8, 0xd0, 0xa7, 0x12,
-	0x64, 0xdd, 0x72, 0xe7, 0xb7, 0xe8, 0x04, 0xbe, 0x2e, 0x2e, 0xbb, 0xd8, 0xf6, 0x29, 0x1b, 0x07,
-	0xc4, 0x71, 0x87, 0x84, 0x8a, 0xfa, 0x92, 0x24, 0xda, 0xc9, 0x13, 0x9d, 0x5d, 0x1e, 0x2c, 0xe0,
-	0xac, 0x9a, 0xc8, 0x9d, 0x68, 0x9f, 0xc3, 0xaa, 0x0a, 0xd7, 0x66, 0x63, 0x8a, 0x3e, 0x80, 0x35,
-	0x87, 0x8d, 0xe9, 0x7c, 0xb6, 0x75, 0x20, 0xc9, 0xef, 0xe6, 0xc9, 0xdb, 0x0a, 0x97, 0xa5, 0x7b,
-	0xdb, 0x59, 0x3c, 0xd0, 0x7e, 0x06, 0xb0, 0x7e, 0x6a, 0x0f, 0x88, 0x13, 0x07, 0x24, 0x03, 0x5b,
-	0x84, 0x87, 0x8c, 0x72, 0x82, 0x9e, 0xc0, 0x15, 0x87, 0x04, 0x78, 0xa2, 0xd8, 0x37, 0xf4, 0xb4,
-	0xbb, 0xf4, 0xac, 0xbb, 0xf4, 0xb6, 0xea, 0xdc, 0xd6, 0xea, 0x8b, 0xd6, 0xca, 0x0f, 0xa0, 0xb2,
-	0x0a, 0xac, 0xd4, 0x03, 0x1d, 0xc0, 0x5b, 0x53, 0x8d, 0x21, 0x16, 0x03, 0x55, 0xc6, 0xcd, 0x32,
-	0x81, 0x9f, 0x60, 0x31, 0xb0, 0x6e, 0x3a, 0x73, 0x3b, 0x54, 0x83, 0x4b, 0xd1, 0xe5, 0xbb, 0xb2,
-	0x6c, 0xab, 0x56, 0xb2, 0x4c, 0x4f, 0xcc, 0xfa, 0x72, 0x76, 0x62, 0x6a, 0x17, 0x70, 0x33, 0xaf,
-	0xfe, 0x28, 0x19, 0x87, 0x36, 0x11, 0xd8, 0x0b, 0x38, 0x7a, 0x1f, 0x56, 0x93, 0xe8, 0x5d, 0x39,
-	0x23, 0x59, 0x4b, 0x14, 0x44, 0xcc, 0xbb, 0x58, 0x30, 0x71, 0x90, 0x27, 0x5c, 0xfb, 0x11, 0xc0,
-	0x7b, 0x2d, 0x2c, 0xec, 0x41, 0x87, 0x08, 0x75, 0x03, 0x87, 0x8c, 0x52, 0x62, 0x27, 0x49, 0x27,
-	0x77, 0xcd, 0x2d, 0xf2, 0x3c, 0x26, 0x5c, 0xa0, 0x8f, 0x61, 0x35, 0xeb, 0x1b, 0xcf, 0xc9, 0x02,
-	0x69, 0x25, 0x4d, 0x73, 0x3c, 0x9b, 0xa7, 0x16, 0x7c, 0xd1, 0xba, 0xf1, 0x0d, 0x58, 0x5e, 0x05,
-	0x35, 0xc7, 0x82, 0x6e, 0x66, 0xe7, 0xe8, 0x09, 0x84, 0xb3, 0xd9, 0x55, 0xd5, 0x6b, 0x14, 0x2e,
-	0xe0, 0x59, 0x02, 0x39, 0xc1, 0xdc, 0xb7, 0xd6, 0xfa, 0xd9, 0x52, 0xfb, 0x1b, 0xc0, 0xfb, 0xaf,
-	0x52, 0xad, 0x6e, 0xf8, 0x02, 0xde, 0x20, 0x54, 0x44, 0xde, 0x74, 0x5c, 0x0e, 0xf3, 0x92, 0xaf,
-	0x47, 0xa4, 0x1f, 0xa5, 0x2c, 0xc9, 0x67, 0x62, 0x65, 0x9c, 0x8d, 0x1e, 0xbc, 0x39, 0x6f, 0x48,
-	0x2e, 0xd0, 0x27, 0x69, 0x3b, 0xad, 0x59, 0xc9, 0x12, 0xbd, 0x07, 0x57, 0x46, 0x38, 0x88, 0x89,
-	0xca, 0xf0, 0x7e, 0x49, 0xc5, 0xf2, 0x61, 0x53, 0xa7, 0xa7, 0x95, 0xc7, 0xc0, 0xfc, 0x7d, 0x09,
-	0xae, 0x74, 0xc4, 0xb8, 0xc3, 0xd1, 0x31, 0xac, 0x7e, 0xe8, 0x51, 0x5f, 0xb9, 0xa0, 0x8d, 0x12,
-	0xae, 0xf3, 0xb0, 0x71, 0xa7, 0xc4, 0x94, 0xf4, 0xd0, 0x2e, 0xd8, 0x03, 0xe8, 0x14, 0xbe, 0xd1,
-	0x21, 0xe2, 0x90, 0x51, 0x3b, 0x49, 0x05, 0x0b, 0x16, 0x1d, 0x32, 0xda, 0xf7, 0x5c, 0xf4, 0x66,
-	0xe1, 0x0a, 0x8e, 0x92, 0xd7, 0xb9, 0x51, 0xb8, 0xea, 0x2b, 0x7c, 0xbf, 0x03, 0x92, 0xf5, 0xe4,
-	0xd3, 0xb3, 0xb3, 0x59, 0x3e, 0xc7, 0xb4, 0xcf, 0xd0, 0x35, 0x1a, 0xa5, 0x18, 0xa1, 0xc8, 0xa3,
-	0x3d, 0xfa, 0xfa, 0xd7, 0x3f, 0xbf, 0xad, 0xec, 0x21, 0xdd, 0x70, 0xf9, 0xf4, 0xdf, 0x68, 0x7c,
-	0x39, 0xeb, 0xcc, 0xaf, 0xe4, 0x1b, 0xdb, 0xb4, 0xa7, 0x6e, 0x4d, 0x2f, 0x89, 0xff, 0x3d, 0x80,
-	0x6f, 0x29, 0x65, 0x9f, 0x99, 0xff, 0x93, 0xb6, 0xc7, 0x52, 0x9b, 0x89, 0xf6, 0x5e, 0xae, 0x6d,
-	0x64, 0xe6, 0xd5, 0x99, 0x04, 0x2e, 0x7f, 0xc4, 0x3b, 0x1c, 0x5d, 0xc0, 0x5a, 0x7e, 0xd8, 0xd1,
-	0xab, 0x5e, 0xbc, 0xc6, 0x6e, 0x1e, 0x50, 0xf6, 0xda, 0x99, 0xbf, 0x55, 0x60, 0xa5, 0xc3, 0x93,
-	0x5a, 0x6c, 0x94, 0xf6, 0xfb, 0xb5, 0xaa, 0x71, 0xcd, 0x26, 0xd6, 0x4c, 0x59, 0x91, 0x77, 0xd0,
-	0x83, 0xf2, 0x8a, 0xcc, 0x4a, 0x61, 0x70, 0x19, 0xff, 0x27, 0x00, 0xb7, 0x5f, 0x3e, 0x92, 0xe8,
-	0xe1, 0xbf, 0x1d, 0x61, 0xf9, 0x82, 0x35, 0x1e, 0xfd, 0xb7, 0xc9, 0xd7, 0x76, 0x65, 0x16, 0x9a,
-	0xb6, 0xb5, 0x90, 0x45, 0x5e, 0xf8, 0x53, 0xf0, 0xa0, 0xf5, 0xf0, 0x97, 0x3f, 0xb6, 0xc1, 0x17,
-	0x86, 0xcb, 0x74, 0x31, 0x20, 0x62, 0xe0, 0x51, 0x97, 0xeb, 0x94, 0x88, 0x31, 0x8b, 0x7c, 0x63,
-	0xf1, 0x77, 0x3f, 0xda, 0x37, 0x42, 0xdf, 0x35, 0x84, 0xa0, 0x61, 0xaf, 0xf7, 0x9a, 0x1c, 0xb5,
-	0xfd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x2e, 0x6e, 0xde, 0xff, 0x09, 0x00, 0x00,
+	// 1856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x93, 0x1b, 0x47,
+	0x19, 0xcf, 0xe8, 0xb1, 0x2b, 0x7d, 0xbb, 0x5e, 0x8b, 0x86, 0x24, 0x5a, 0xf9, 0x11, 0x65, 0xc2,
+	0x86, 0xf5, 0x06, 0x8d, 0x6c, 0xd9, 0x1b, 0x6c, 0x57, 0x00, 0xaf, 0x76, 0xed, 0xcd, 0x12, 0xaf,
+	0x13, 0x46, 0x6b, 0x5e, 0x85, 0x4b, 0xd5, 0xd2, 0xb4, 0x46, 0x53, 0x92, 0xa6, 0x27, 0xd3, 0x3d,
+	0xfb, 0x30, 0x45, 0x55, 0x2a, 0x37, 0x38, 0x51, 0xe1, 0x00, 0x14, 0x07, 0x2e, 0x9c, 0x72, 0x24,
+	0x97, 0x50, 0xc5, 0x01, 0x8e, 0xdc, 0x28, 0xf8, 0x03, 0x38, 0x40, 0x15, 0x54, 0xf1, 0x1f, 0xf8,
+	0x44, 0x75, 0x4f, 0x8f, 0xde, 0xa3, 0x1d, 0x48, 0x7c, 0xd2, 0x4c, 0xf7, 0xef, 0xfb, 0xfa, 0xf7,
+	0x3d, 0x7b, 0x3e, 0xc1, 0x46, 0x9f, 0xfa, 0xf8, 0x04, 0xbb, 0x15, 0xc6, 0x71, 0xbb, 0x57, 0xc5,
+	0x9e, 0x53, 0xb5, 0x31, 0x27, 0x27, 0xf8, 0x8c, 0x11, 0xff, 0x98, 0xf8, 0x86, 0xe7, 0x53, 0x4e,
+	0xd1, 0x1a, 0xe7, 0xae, 0xa1, 0xa0, 0xc6, 0xf1, 0xcd, 0xd2, 0x8e, 0xed, 0xf0, 0x6e, 0xd0, 0x32,
+	0xda, 0x74, 0x50, 0x25, 0xee, 0x31, 0x3d, 0xf3, 0x7c, 0x7a, 0x7a, 0x56, 0x95, 0xe0, 0x76, 0xc5,
+	0x26, 0x6e, 0xe5, 0x18, 0xf7, 0x1d, 0x0b, 0x73, 0x52, 0x9d, 0x79, 0x08, 0x55, 0x96, 0x2a, 0x63,
+	0x2a, 0x6c, 0x6a, 0xd3, 0x50, 0xb8, 0x15, 0x74, 0xe4, 0x9b, 0x7c, 0x91, 0x4f, 0x0a, 0x7e, 0xd9,
+	0xa6, 0xd4, 0xee, 0x13, 0xc9, 0x10, 0xbb, 0x2e, 0xe5, 0x98, 0x3b, 0xd4, 0x65, 0x6a, 0xf7, 0xaa,
+	0xda, 0x1d, 0xea, 0xb0, 0x02, 0x5f, 0x02, 0xd4, 0xfe, 0xa5, 0xe9, 0x7d, 0x32, 0xf0, 0xf8, 0x99,
+	0xda, 0xbc, 0x32, 0xeb, 0x03, 0xe2, 0xfb, 0x54, 0xd9, 0x5e, 0x2a, 0x4f, 0xcb, 0x76, 0x1c, 0xd2,
+	0xb7, 0x9a, 0x03, 0xcc, 0x7a, 0x0a, 0xf1, 0xca, 0x34, 0x82, 0x3b, 0x03, 0xc2, 0x38, 0x1e, 0x78,
+	0x11, 0x20, 0xd6, 0xcb, 0x0a, 0xf0, 0xda, 0x2c, 0xc0, 0xb1, 0x88, 0xcb, 0x9d, 0x8e, 0x43, 0x7c,
+	0x16, 0xaf, 0x25, 0x0a, 0x89, 0x62, 0x3a, 0x0b, 0x18, 0x10, 0xc6, 0xb0, 0x4d, 0x22, 0x15, 0x97,
+	0xe7, 0x20, 0xde, 0xe7, 0x3c, 0x5e, 0xde, 0x27, 0xb6, 0x43, 0x5d, 0xdc, 0x0f, 0x11, 0xfa, 0xbf,
+	0x35, 0xc8, 0xef, 0x87, 0xcc, 0x1f, 0x7b, 0xe8, 0x01, 0x5c, 0x0c, 0xbc, 0xbe, 0xe3, 0xf6, 0x9a,
+	0xd1, 0x31, 0x45, 0xad, 0x9c, 0xde, 0x5c, 0xa9, 0x5d, 0x31, 0x26, 0xf3, 0xc5, 0x78, 0x2c, 0x61,
+	0x87, 0x21, 0xca, 0x5c, 0x0b, 0xc6, 0x5f, 0x19, 0xda, 0x83, 0x35, 0xe5, 0x8e, 0x26, 0xe3, 0x98,
+	0x07, 0xac, 0x98, 0x2a, 0x6b, 0xf3, 0xd4, 0xa8, 0xa3, 0x1b, 0x12, 0x64, 0x5e, 0xb0, 0xc7, 0x5f,
+	0xd1, 0x21, 0x7c, 0x81, 0x9f, 0x36, 0x71, 0xbb, 0xe7, 0xd2, 0x93, 0x3e, 0xb1, 0xec, 0x01, 0x71,
+	0x79, 0x31, 0x2d, 0x15, 0x95, 0xa7, 0x15, 0x1d, 0x9d, 0xee, 0x4c, 0xe0, 0xcc, 0x02, 0x9f, 0x5a,
+	0xd1, 0xbf, 0x0f, 0x2b, 0xea, 0xb8, 0x3d, 0x7a, 0xe2, 0xa2, 0x6f, 0x41, 0xc1, 0xa2, 0x27, 0xee,
+	0xb8, 0xb5, 0x45, 0x4d, 0x2a, 0x7f, 0x65, 0x5a, 0xf9, 0x9e, 0xc2, 0x45, 0xe6, 0x5e, 0xb4, 0x26,
+	0x17, 0xf4, 0x3f, 0x68, 0x50, 0x6c, 0xb4, 0xbb, 0xc4, 0x0a, 0xfa, 0x24, 0x02, 0x9b, 0x84, 0x79,
+	0xd4, 0x65, 0x04, 0xdd, 0x81, 0xac, 0x45, 0xfa, 0xf8, 0x4c, 0x69, 0x5f, 0x37, 0xc2, 0xe4, 0x32,
+	0xa2, 0xe4, 0x32, 0xf6, 0x54, 0x6a, 0xd7, 0x73, 0xcf, 0xea, 0xd9, 0x8f, 0xb5, 0x54, 0x4e, 0x33,
+	0x43, 0x09, 0xb4, 0x03, 0x17, 0x86, 0x1c, 0x3d, 0xcc, 0xbb, 0xca, 0x8d, 0x97, 0xe3, 0x08, 0xbe,
+	0x87, 0x79, 0xd7, 0x5c, 0xb5, 0xc6, 0xde, 0x50, 0x01, 0xd2, 0xfe, 0xe9, 0x0d, 0xe9, 0xb6, 0x9c,
+	0x29, 0x1e, 0xc3, 0x95, 0x5a, 0x31, 0x13, 0xad, 0xd4, 0xf4, 0x27, 0x70, 0x79, 0x9a, 0xfd, 0x7d,
+	0x51, 0x2f, 0x7b, 0x84, 0x63, 0xa7, 0xcf, 0xd0, 0xd7, 0x61, 0x45, 0x9c, 0xde, 0x94, 0x45, 0x14,
+	0xa5, 0xc4, 0x0c, 0x89, 0x71, 0x11, 0x13, 0x84, 0x80, 0x5c, 0x61, 0xfa, 0x27, 0x1a, 0x6c, 0xd4,
+	0x31, 0x6f, 0x77, 0xf7, 0x09, 0x57, 0x11, 0xd8, 0xa5, 0xae, 0x4b, 0xda, 0xc2, 0x68, 0x11, 0x6b,
+	0x66, 0x92, 0xf7, 0x03, 0xc2, 0x38, 0x7a, 0x17, 0x56, 0xa2, 0xbc, 0x71, 0xac, 0xe8, 0x20, 0x3d,
+	0x26, 0x69, 0x0e, 0x46, 0xf5, 0x54, 0x87, 0x67, 0xf5, 0xe5, 0x8f, 0xb4, 0x4c, 0x4e, 0x2b, 0x58,
+	0x26, 0xd8, 0xd1, 0x3e, 0x43, 0x77, 0x00, 0x46, 0xc5, 0xad, 0xbc, 0x57, 0x9a, 0x09, 0xc0, 0x03,
+	0x01, 0x39, 0xc4, 0xac, 0x67, 0xe6, 0x3b, 0xd1, 0xa3, 0xfe, 0x1f, 0x0d, 0x5e, 0x3f, 0x8f, 0xb5,
+	0x8a, 0xf0, 0x13, 0x58, 0x26, 0x2e, 0xf7, 0x9d, 0x61, 0xb9, 0xec, 0x4e, 0x53, 0x4e, 0xa6, 0xc8,
+	0xb8, 0x1f, 0x6a, 0x11, 0x3f, 0x67, 0x66, 0xa4, 0xb3, 0xd4, 0x82, 0xd5, 0xf1, 0x0d, 0x11, 0xc0,
+	0x1e, 0x09, 0xd3, 0x29, 0x6f, 0x8a, 0x47, 0xf4, 0x16, 0x64, 0x8f, 0x71, 0x3f, 0x20, 0xca, 0xc2,
+	0xd7, 0x63, 0x3c, 0x36, 0x7d, 0x6c, 0x28, 0x74, 0x37, 0x75, 0x5b, 0xd3, 0xff, 0xa4, 0xc1, 0x25,
+	0x85, 0x32, 0xc9, 0x80, 0x72, 0xb2, 0x4b, 0x07, 0x03, 0xec, 0x5a, 0x51, 0x64, 0x0e, 0xa7, 0x23,
+	0xa3, 0x25, 0x8c, 0x8c, 0xc8, 0xe9, 0x9f, 0x6a, 0xa9, 0x82, 0x36, 0x11, 0x97, 0x2f, 0xc3, 0x72,
+	0x3b, 0x3c, 0x40, 0x52, 0xce, 0xcb, 0x00, 0xfa, 0xd9, 0x82, 0x56, 0xfc, 0x20, 0x65, 0x46, 0x5b,
+	0xa8, 0x02, 0x79, 0xec, 0xdb, 0x81, 0xa8, 0x5e, 0x56, 0x4c, 0x97, 0xd3, 0x9b, 0xf9, 0xfa, 0xc5,
+	0x67, 0xf5, 0xd5, 0x8f, 0xb4, 0x7c, 0xa1, 0xac, 0x67, 0xfd, 0xb4, 0x00, 0x8f, 0x10, 0xfa, 0x6f,
+	0x53, 0xb0, 0x3e, 0x61, 0x43, 0xa3, 0x4b, 0xfa, 0xfd, 0xc8, 0x82, 0x7d, 0xc8, 0x32, 0x8e, 0x7d,
+	0xae, 0xb8, 0x57, 0x63, 0xb8, 0xcf, 0x4a, 0x1a, 0x0d, 0x21, 0xf6, 0xf6, 0x0b, 0x66, 0x28, 0x8f,
+	0xca, 0x90, 0x75, 0x5c, 0x2f, 0xe0, 0x92, 0xf9, 0xaa, 0x34, 0xf0, 0x69, 0xba, 0xf8, 0x41, 0x59,
+	0x20, 0xe4, 0x46, 0xe9, 0x67, 0x1a, 0x64, 0xa5, 0xd0, 0xe7, 0xed, 0xb6, 0x4b, 0x90, 0x09, 0x18,
+	0xf1, 0x95, 0xcf, 0x96, 0x9f, 0xd5, 0x33, 0x7e, 0xaa, 0x78, 0xcf, 0x94, 0x8b, 0x62, 0x93, 0x13,
+	0x7f, 0x20, 0x4b, 0x7d, 0x7c, 0x53, 0x2c, 0xd6, 0xf3, 0xb0, 0xac, 0x9a, 0x9c, 0x7e, 0x0b, 0x4a,
+	0xf3, 0x6c, 0x55, 0xb9, 0xfc, 0x12, 0x2c, 0xd1, 0x80, 0x0b, 0xf3, 0x04, 0xd9, 0x55, 0x53, 0xbd,
+	0xe9, 0x3f, 0x49, 0xc3, 0xd5, 0xf9, 0x69, 0xd4, 0x70, 0xb1, 0xc7, 0xba, 0x94, 0xa3, 0xdb, 0x90,
+	0x11, 0xf7, 0xa4, 0xb2, 0x72, 0xb6, 0xcc, 0x8e, 0xa2, 0x4b, 0x54, 0x5a, 0xf7, 0x3b, 0xd9, 0xe8,
+	0xa4, 0x04, 0xda, 0x86, 0x9c, 0xe3, 0x72, 0xe2, 0x1f, 0xe3, 0xbe, 0x4a, 0xe1, 0xf8, 0x2e, 0x69,
+	0x0e, 0xa1, 0xe8, 0x55, 0x58, 0x55, 0xd7, 0x55, 0x9b, 0x06, 0xea, 0x6e, 0xc8, 0x98, 0x2b, 0xe1,
+	0xda, 0xae, 0x58, 0x42, 0x1b, 0xb0, 0x36, 0xec, 0xa0, 0x21, 0x28, 0x23, 0x41, 0xc3, 0xbe, 0x1a,
+	0xc2, 0x9a, 0x50, 0xf0, 0x69, 0xe0, 0x5a, 0x4d, 0xee, 0x3b, 0x5e, 0x53, 0xde, 0xf6, 0xc5, 0xac,
+	0x24, 0xb2, 0x9d, 0xac, 0x96, 0x0c, 0x53, 0x88, 0x1f, 0xf9, 0x8e, 0x27, 0xad, 0x34, 0xd7, 0xfc,
+	0x89, 0x77, 0xf4, 0x0e, 0xe4, 0x59, 0xd0, 0x6a, 0xb6, 0xb0, 0x6b, 0xb1, 0xe2, 0x92, 0x6c, 0x12,
+	0x46, 0x42, 0xcd, 0x8d, 0xa0, 0x55, 0x17, 0x95, 0x98, 0x63, 0xe1, 0x03, 0xd3, 0xff, 0x95, 0x82,
+	0xaf, 0xc4, 0x36, 0x93, 0xb7, 0x1d, 0xc6, 0xa9, 0x7f, 0xf6, 0x9c, 0x0a, 0xf7, 0x3a, 0x64, 0x71,
+	0x87, 0xab, 0x14, 0x5c, 0x18, 0x64, 0x33, 0x04, 0xa2, 0x1a, 0x2c, 0xb5, 0x48, 0x87, 0xfa, 0x44,
+	0x5d, 0xdd, 0x8b, 0x44, 0x14, 0x12, 0x55, 0x20, 0xd3, 0xc7, 0x2c, 0x8c, 0xd5, 0xc2, 0x5c, 0x90,
+	0x30, 0xf4, 0x3d, 0xf8, 0x12, 0xb6, 0x6d, 0x9f, 0xd8, 0x72, 0xb1, 0x39, 0x4c, 0xa5, 0xec, 0x79,
+	0x17, 0xae, 0xe8, 0x3a, 0x1f, 0x6b, 0x99, 0x5a, 0x2a, 0xf7, 0x96, 0xf9, 0xc5, 0x31, 0x15, 0x07,
+	0x4a, 0x83, 0x3e, 0x80, 0x2b, 0x0b, 0xbd, 0x8c, 0x1e, 0x42, 0x9e, 0xa9, 0xfc, 0x8f, 0x9a, 0x7f,
+	0xc2, 0xb8, 0x46, 0x65, 0x63, 0x8e, 0x14, 0xe8, 0x7f, 0x4e, 0x0d, 0x6b, 0xf3, 0xc8, 0xc7, 0x9d,
+	0x8e, 0xd3, 0xde, 0xc5, 0x1e, 0x0f, 0x7c, 0xf2, 0xc0, 0xc7, 0x03, 0xf2, 0x19, 0x0a, 0xec, 0x87,
+	0x90, 0xb7, 0x1c, 0x3f, 0x3c, 0x5d, 0x86, 0x6e, 0xad, 0x76, 0x2b, 0x86, 0xe6, 0x9c, 0x83, 0x8d,
+	0xbd, 0x48, 0x56, 0x2a, 0xfe, 0x50, 0x66, 0xc5, 0x48, 0xa1, 0xfc, 0xa2, 0xc0, 0x27, 0x32, 0xbe,
+	0xab, 0xa6, 0x78, 0x44, 0xd7, 0x20, 0x15, 0x78, 0xc3, 0xf0, 0xcd, 0x3f, 0xe8, 0xb1, 0x67, 0xa6,
+	0x02, 0x0f, 0x55, 0x21, 0x23, 0x6a, 0x51, 0x05, 0xeb, 0x52, 0x0c, 0x58, 0x7c, 0x97, 0x98, 0x12,
+	0xa8, 0x6f, 0x40, 0x7e, 0xc8, 0x07, 0x01, 0x2c, 0x3d, 0x7e, 0xef, 0xe1, 0xc1, 0xa3, 0x77, 0x0a,
+	0x2f, 0xa0, 0x55, 0xc8, 0xed, 0xbd, 0xfb, 0xdd, 0x47, 0xf2, 0x4d, 0xd3, 0xff, 0xa2, 0xc1, 0xab,
+	0xb2, 0x09, 0xcf, 0xb5, 0xeb, 0x39, 0x95, 0xc7, 0x0e, 0xe4, 0xa2, 0x41, 0xe5, 0xdc, 0x46, 0x36,
+	0x96, 0x7d, 0x9a, 0x39, 0x14, 0x43, 0xeb, 0x90, 0x1b, 0xe0, 0xd3, 0x26, 0x73, 0x9e, 0x12, 0xd5,
+	0xd0, 0x96, 0x07, 0xf8, 0xb4, 0xe1, 0x3c, 0x25, 0xfa, 0x27, 0x69, 0x78, 0x71, 0xae, 0x35, 0x9f,
+	0xb7, 0x19, 0x25, 0xc8, 0x85, 0x93, 0x1f, 0x0d, 0xfb, 0x71, 0xde, 0x1c, 0xbe, 0x8b, 0x4f, 0x2a,
+	0x79, 0x0f, 0x12, 0xab, 0x89, 0x79, 0x82, 0x9a, 0xce, 0x2b, 0xf4, 0x0e, 0x0f, 0x45, 0xa9, 0xe7,
+	0x85, 0xa2, 0x99, 0x24, 0xa2, 0x12, 0xbd, 0xc3, 0xc5, 0x0d, 0x31, 0x74, 0x6c, 0xf6, 0xdc, 0x1b,
+	0x62, 0xae, 0x33, 0x97, 0x26, 0x9c, 0x89, 0x5e, 0x83, 0x0b, 0xed, 0xd0, 0x7b, 0x56, 0xb8, 0xbf,
+	0x2c, 0xf7, 0x57, 0xa3, 0x45, 0x09, 0xaa, 0xc3, 0x52, 0x47, 0x54, 0x00, 0x2b, 0xe6, 0x64, 0x6d,
+	0x6f, 0x25, 0x2f, 0x1a, 0x53, 0x49, 0xd6, 0xfe, 0x9e, 0x86, 0xec, 0x3e, 0x3f, 0xd9, 0x67, 0xe8,
+	0x00, 0x56, 0x1e, 0x3a, 0x6e, 0x4f, 0xc9, 0xa0, 0xf8, 0xc2, 0x28, 0x2d, 0x2a, 0x83, 0x4d, 0xed,
+	0xba, 0x86, 0x1a, 0xf0, 0xe2, 0x3e, 0xe1, 0xbb, 0xd4, 0x6d, 0x8b, 0xaf, 0x44, 0xcc, 0xa9, 0xbf,
+	0x4b, 0xdd, 0x8e, 0x63, 0xa3, 0x97, 0x66, 0xdc, 0x72, 0x5f, 0x4c, 0xc6, 0xa5, 0x99, 0x64, 0x98,
+	0x23, 0xfb, 0x0b, 0x4d, 0x6a, 0x3d, 0xfc, 0xf6, 0xd1, 0xd1, 0xa8, 0x59, 0x1d, 0xb8, 0x1d, 0x8a,
+	0x12, 0xa4, 0xd2, 0xec, 0x09, 0xb3, 0x7a, 0xf4, 0x37, 0x3f, 0xfc, 0xdb, 0x3f, 0x7f, 0x9e, 0xba,
+	0x8e, 0x8c, 0xaa, 0xcd, 0x86, 0xff, 0x4b, 0x54, 0x7f, 0x34, 0xca, 0xdd, 0x1f, 0xcb, 0xf1, 0xb5,
+	0xd2, 0x1e, 0x8a, 0x55, 0x1c, 0x71, 0xfe, 0xaf, 0x35, 0x78, 0x59, 0x31, 0xfb, 0x4e, 0xed, 0x39,
+	0x71, 0xbb, 0x2d, 0xb9, 0xd5, 0xd0, 0xf5, 0xc5, 0xdc, 0x8e, 0x6b, 0xd3, 0xec, 0x6a, 0x04, 0x32,
+	0x8f, 0xd8, 0x3e, 0x43, 0x4f, 0xa0, 0x30, 0x3d, 0x47, 0xa1, 0xf3, 0x86, 0xc9, 0xd2, 0xe6, 0x34,
+	0x20, 0x6e, 0x90, 0xac, 0x7d, 0x0a, 0x90, 0xda, 0x67, 0xc2, 0x17, 0xeb, 0xb1, 0xb7, 0x7f, 0x22,
+	0x6f, 0x24, 0x9c, 0x0f, 0xf4, 0x9a, 0xf4, 0xc8, 0x57, 0xd1, 0x56, 0xbc, 0x47, 0x46, 0xae, 0xa8,
+	0x32, 0x79, 0xfe, 0xef, 0x35, 0xb8, 0xba, 0x78, 0xda, 0x41, 0xdb, 0xff, 0xeb, 0x74, 0x24, 0x5b,
+	0x75, 0xe9, 0xcd, 0xff, 0x6f, 0xa8, 0xd2, 0x37, 0xa5, 0x15, 0xba, 0x7e, 0x65, 0xc2, 0x8a, 0x69,
+	0xe2, 0x77, 0xb5, 0x2d, 0xf4, 0x57, 0x0d, 0xca, 0xe7, 0x7d, 0x57, 0xa1, 0xaf, 0xcd, 0x38, 0x2f,
+	0xd9, 0x97, 0x58, 0xa9, 0x92, 0xcc, 0xeb, 0x4a, 0x4a, 0x7f, 0x20, 0x69, 0xdf, 0x43, 0xdf, 0x88,
+	0x73, 0x3e, 0x33, 0x16, 0x05, 0xa2, 0xda, 0x55, 0x7c, 0x7f, 0xa3, 0xc1, 0xcb, 0x66, 0xe0, 0xce,
+	0x1b, 0xee, 0xd0, 0x1b, 0x0b, 0x87, 0xa0, 0xc9, 0x11, 0xb0, 0x14, 0xd3, 0x59, 0xf4, 0x6f, 0x4a,
+	0xa2, 0x77, 0xf4, 0x5b, 0xc9, 0x88, 0xfa, 0x52, 0x77, 0x55, 0xcd, 0x78, 0xc2, 0xed, 0x14, 0xd0,
+	0xec, 0x40, 0x82, 0xae, 0x25, 0x1e, 0xd0, 0x4a, 0x5b, 0x49, 0xa0, 0x61, 0x36, 0xc8, 0xe6, 0xf9,
+	0xa9, 0x06, 0xa5, 0xf8, 0x4f, 0x03, 0x74, 0x63, 0xa6, 0x22, 0xcf, 0xfb, 0x8c, 0x28, 0x6d, 0x24,
+	0xba, 0x17, 0xf4, 0x7b, 0xd2, 0x55, 0x77, 0xf5, 0xed, 0x64, 0xae, 0xe2, 0xa1, 0x74, 0x55, 0x5d,
+	0x49, 0xc2, 0x57, 0xbf, 0xd2, 0x60, 0xbd, 0xc1, 0xa9, 0x37, 0x9f, 0x79, 0x92, 0xe2, 0x4f, 0x48,
+	0xf5, 0x86, 0xa4, 0xfa, 0xc6, 0xd6, 0xb5, 0xf8, 0xda, 0x9f, 0xa2, 0x87, 0x7e, 0xa9, 0x41, 0x71,
+	0x54, 0x0c, 0xcf, 0x9d, 0x1a, 0x4a, 0x4e, 0xad, 0xbe, 0xfd, 0xc7, 0x7f, 0x5c, 0xd5, 0x7e, 0x50,
+	0xb5, 0xa9, 0xc1, 0xbb, 0x84, 0x77, 0x1d, 0xd7, 0x66, 0x86, 0x4b, 0xf8, 0x09, 0xf5, 0x7b, 0xd5,
+	0xc9, 0xff, 0x48, 0x8f, 0x6f, 0x56, 0xbd, 0x9e, 0x5d, 0xe5, 0xdc, 0xf5, 0x5a, 0xad, 0x25, 0x99,
+	0xea, 0x37, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x83, 0x7f, 0xe5, 0x67, 0x55, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -731,6 +1506,34 @@ type GsClient interface {
 	// Gateways that are not connected or are part of a different cluster are ignored.
 	// It is up to the client to make sure that the gateways are in the requested cluster.
 	BatchGetGatewayConnectionStats(ctx context.Context, in *BatchGetGatewayConnectionStatsRequest, opts ...grpc.CallOption) (*BatchGetGatewayConnectionStatsResponse, error)
+	// Get the history of the statistics of the gateway connections to the Gateway Server.
+	// The history is persisted between reconnects, but it is only available if the Gateway Server
+	// is configured with a connection stats history backend.
+	GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error)
+	// Run a remote command on the gateway.
+	// The command is run asynchronously; its output is not returned.
+	// This is only supported by LoRa Basics Station gateways that are connected to this Gateway Server.
+	RunGatewayRemoteCommand(ctx context.Context, in *GatewayRemoteCommandRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Open an interactive remote shell session on the gateway.
+	// The first message must start the session. The session ends when either side closes the stream.
+	// This is only supported by LoRa Basics Station gateways that advertise the remote shell feature,
+	// and that are connected to this Gateway Server.
+	GatewayRemoteShell(ctx context.Context, opts ...grpc.CallOption) (Gs_GatewayRemoteShellClient, error)
+	// Start capturing the traffic of a connected gateway.
+	// The capture stops when the duration or size limit is reached, or when it is stopped explicitly.
+	// A previous capture of the gateway is discarded.
+	// The capture continues when the gateway reconnects to this Gateway Server while the capture is active.
+	// Captures are kept in memory of the Gateway Server that the gateway is connected to. In deployments with
+	// multiple Gateway Server instances, the capture RPCs need to be routed to the same instance as the gateway
+	// connection; other instances return a not found error.
+	StartGatewayTrafficCapture(ctx context.Context, in *StartGatewayTrafficCaptureRequest, opts ...grpc.CallOption) (*GatewayTrafficCapture, error)
+	// Stop capturing the traffic of a gateway and return the capture.
+	// Only captures of the Gateway Server instance that handles the request are found.
+	StopGatewayTrafficCapture(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayTrafficCapture, error)
+	// Get the active or the last traffic capture of a gateway, including the captured frames.
+	// Stopped captures are retained for the duration configured in the Gateway Server.
+	// Only captures of the Gateway Server instance that handles the request are found.
+	GetGatewayTrafficCapture(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayTrafficCapture, error)
 }
 
 type gsClient struct {
@@ -759,6 +1562,82 @@ func (c *gsClient) BatchGetGatewayConnectionStats(ctx context.Context, in *Batch
 	return out, nil
 }
 
+func (c *gsClient) GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error) {
+	out := new(GatewayConnectionStatsHistory)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayConnectionStatsHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) RunGatewayRemoteCommand(ctx context.Context, in *GatewayRemoteCommandRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/RunGatewayRemoteCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GatewayRemoteShell(ctx context.Context, opts ...grpc.CallOption) (Gs_GatewayRemoteShellClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Gs_serviceDesc.Streams[0], "/ttn.lorawan.v3.Gs/GatewayRemoteShell", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsGatewayRemoteShellClient{stream}
+	return x, nil
+}
+
+type Gs_GatewayRemoteShellClient interface {
+	Send(*GatewayRemoteShellRequest) error
+	Recv() (*GatewayRemoteShellResponse, error)
+	grpc.ClientStream
+}
+
+type gsGatewayRemoteShellClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsGatewayRemoteShellClient) Send(m *GatewayRemoteShellRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gsGatewayRemoteShellClient) Recv() (*GatewayRemoteShellResponse, error) {
+	m := new(GatewayRemoteShellResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gsClient) StartGatewayTrafficCapture(ctx context.Context, in *StartGatewayTrafficCaptureRequest, opts ...grpc.CallOption) (*GatewayTrafficCapture, error) {
+	out := new(GatewayTrafficCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/StartGatewayTrafficCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) StopGatewayTrafficCapture(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayTrafficCapture, error) {
+	out := new(GatewayTrafficCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/StopGatewayTrafficCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GetGatewayTrafficCapture(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (*GatewayTrafficCapture, error) {
+	out := new(GatewayTrafficCapture)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Gs/GetGatewayTrafficCapture", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsServer is the server API for Gs service.
 type GsServer interface {
 	// Get statistics about the current gateway connection to the Gateway Server.
@@ -769,6 +1648,34 @@ type GsServer interface {
 	// Gateways that are not connected or are part of a different cluster are ignored.
 	// It is up to the client to make sure that the gateways are in the requested cluster.
 	BatchGetGatewayConnectionStats(context.Context, *BatchGetGatewayConnectionStatsRequest) (*BatchGetGatewayConnectionStatsResponse, error)
+	// Get the history of the statistics of the gateway connections to the Gateway Server.
+	// The history is persisted between reconnects, but it is only available if the Gateway Server
+	// is configured with a connection stats history backend.
+	GetGatewayConnectionStatsHistory(context.Context, *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error)
+	// Run a remote command on the gateway.
+	// The command is run asynchronously; its output is not returned.
+	// This is only supported by LoRa Basics Station gateways that are connected to this Gateway Server.
+	RunGatewayRemoteCommand(context.Context, *GatewayRemoteCommandRequest) (*types.Empty, error)
+	// Open an interactive remote shell session on the gateway.
+	// The first message must start the session. The session ends when either side closes the stream.
+	// This is only supported by LoRa Basics Station gateways that advertise the remote shell feature,
+	// and that are connected to this Gateway Server.
+	GatewayRemoteShell(Gs_GatewayRemoteShellServer) error
+	// Start capturing the traffic of a connected gateway.
+	// The capture stops when the duration or size limit is reached, or when it is stopped explicitly.
+	// A previous capture of the gateway is discarded.
+	// The capture continues when the gateway reconnects to this Gateway Server while the capture is active.
+	// Captures are kept in memory of the Gateway Server that the gateway is connected to. In deployments with
+	// multiple Gateway Server instances, the capture RPCs need to be routed to the same instance as the gateway
+	// connection; other instances return a not found error.
+	StartGatewayTrafficCapture(context.Context, *StartGatewayTrafficCaptureRequest) (*GatewayTrafficCapture, error)
+	// Stop capturing the traffic of a gateway and return the capture.
+	// Only captures of the Gateway Server instance that handles the request are found.
+	StopGatewayTrafficCapture(context.Context, *GatewayIdentifiers) (*GatewayTrafficCapture, error)
+	// Get the active or the last traffic capture of a gateway, including the captured frames.
+	// Stopped captures are retained for the duration configured in the Gateway Server.
+	// Only captures of the Gateway Server instance that handles the request are found.
+	GetGatewayTrafficCapture(context.Context, *GatewayIdentifiers) (*GatewayTrafficCapture, error)
 }
 
 // UnimplementedGsServer can be embedded to have forward compatible implementations.
@@ -781,6 +1688,24 @@ func (*UnimplementedGsServer) GetGatewayConnectionStats(ctx context.Context, req
 func (*UnimplementedGsServer) BatchGetGatewayConnectionStats(ctx context.Context, req *BatchGetGatewayConnectionStatsRequest) (*BatchGetGatewayConnectionStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGatewayConnectionStats not implemented")
 }
+func (*UnimplementedGsServer) GetGatewayConnectionStatsHistory(ctx context.Context, req *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStatsHistory not implemented")
+}
+func (*UnimplementedGsServer) RunGatewayRemoteCommand(ctx context.Context, req *GatewayRemoteCommandRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunGatewayRemoteCommand not implemented")
+}
+func (*UnimplementedGsServer) GatewayRemoteShell(srv Gs_GatewayRemoteShellServer) error {
+	return status.Errorf(codes.Unimplemented, "method GatewayRemoteShell not implemented")
+}
+func (*UnimplementedGsServer) StartGatewayTrafficCapture(ctx context.Context, req *StartGatewayTrafficCaptureRequest) (*GatewayTrafficCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGatewayTrafficCapture not implemented")
+}
+func (*UnimplementedGsServer) StopGatewayTrafficCapture(ctx context.Context, req *GatewayIdentifiers) (*GatewayTrafficCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopGatewayTrafficCapture not implemented")
+}
+func (*UnimplementedGsServer) GetGatewayTrafficCapture(ctx context.Context, req *GatewayIdentifiers) (*GatewayTrafficCapture, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayTrafficCapture not implemented")
+}
 
 func RegisterGsServer(s *grpc.Server, srv GsServer) {
 	s.RegisterService(&_Gs_serviceDesc, srv)
@@ -822,6 +1747,122 @@ func _Gs_BatchGetGatewayConnectionStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gs_GetGatewayConnectionStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConnectionStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayConnectionStatsHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, req.(*GetGatewayConnectionStatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_RunGatewayRemoteCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayRemoteCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).RunGatewayRemoteCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/RunGatewayRemoteCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).RunGatewayRemoteCommand(ctx, req.(*GatewayRemoteCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GatewayRemoteShell_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GsServer).GatewayRemoteShell(&gsGatewayRemoteShellServer{stream})
+}
+
+type Gs_GatewayRemoteShellServer interface {
+	Send(*GatewayRemoteShellResponse) error
+	Recv() (*GatewayRemoteShellRequest, error)
+	grpc.ServerStream
+}
+
+type gsGatewayRemoteShellServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsGatewayRemoteShellServer) Send(m *GatewayRemoteShellResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gsGatewayRemoteShellServer) Recv() (*GatewayRemoteShellRequest, error) {
+	m := new(GatewayRemoteShellRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Gs_StartGatewayTrafficCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGatewayTrafficCaptureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).StartGatewayTrafficCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/StartGatewayTrafficCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).StartGatewayTrafficCapture(ctx, req.(*StartGatewayTrafficCaptureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_StopGatewayTrafficCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).StopGatewayTrafficCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/StopGatewayTrafficCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).StopGatewayTrafficCapture(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GetGatewayTrafficCapture_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayTrafficCapture(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Gs/GetGatewayTrafficCapture",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayTrafficCapture(ctx, req.(*GatewayIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Gs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.Gs",
 	HandlerType: (*GsServer)(nil),
@@ -834,7 +1875,34 @@ var _Gs_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BatchGetGatewayConnectionStats",
 			Handler:    _Gs_BatchGetGatewayConnectionStats_Handler,
 		},
+		{
+			MethodName: "GetGatewayConnectionStatsHistory",
+			Handler:    _Gs_GetGatewayConnectionStatsHistory_Handler,
+		},
+		{
+			MethodName: "RunGatewayRemoteCommand",
+			Handler:    _Gs_RunGatewayRemoteCommand_Handler,
+		},
+		{
+			MethodName: "StartGatewayTrafficCapture",
+			Handler:    _Gs_StartGatewayTrafficCapture_Handler,
+		},
+		{
+			MethodName: "StopGatewayTrafficCapture",
+			Handler:    _Gs_StopGatewayTrafficCapture_Handler,
+		},
+		{
+			MethodName: "GetGatewayTrafficCapture",
+			Handler:    _Gs_GetGatewayTrafficCapture_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GatewayRemoteShell",
+			Handler:       _Gs_GatewayRemoteShell_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gatewayserver.proto",
 }
@@ -120,7 +120,13 @@ var GatewayUpFieldPathsNested = []string{
 	"tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"tx_acknowledgment.downlink_message.settings.scheduled.downlink",
 	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.antenna_index",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.implicit_header",
 	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_offset",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_target",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk.scan_time",
+	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.preamble_length",
 	"tx_acknowledgment.downlink_message.settings.scheduled.downlink.tx_power",
 	"tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
 	"tx_acknowledgment.downlink_message.settings.scheduled.frequency",
@@ -243,7 +249,13 @@ var GatewayDownFieldPathsNested = []string{
 	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"downlink_message.settings.scheduled.downlink",
 	"downlink_message.settings.scheduled.downlink.antenna_index",
+	"downlink_message.settings.scheduled.downlink.implicit_header",
 	"downlink_message.settings.scheduled.downlink.invert_polarization",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_offset",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_target",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk.scan_time",
+	"downlink_message.settings.scheduled.downlink.preamble_length",
 	"downlink_message.settings.scheduled.downlink.tx_power",
 	"downlink_message.settings.scheduled.enable_crc",
 	"downlink_message.settings.scheduled.frequency",
@@ -297,3 +309,396 @@ var BatchGetGatewayConnectionStatsResponseFieldPathsNested = []string{
 var BatchGetGatewayConnectionStatsResponseFieldPathsTopLevel = []string{
 	"entries",
 }
+var GatewayRemoteCommandRequestFieldPathsNested = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var GatewayRemoteCommandRequestFieldPathsTopLevel = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+}
+var GatewayRemoteShellRequestFieldPathsNested = []string{
+	"message",
+	"message.input",
+	"message.start",
+	"message.start.gateway_ids",
+	"message.start.gateway_ids.eui",
+	"message.start.gateway_ids.gateway_id",
+	"message.start.term",
+	"message.start.user",
+}
+
+var GatewayRemoteShellRequestFieldPathsTopLevel = []string{
+	"message",
+}
+var GatewayRemoteShellResponseFieldPathsNested = []string{
+	"output",
+}
+
+var GatewayRemoteShellResponseFieldPathsTopLevel = []string{
+	"output",
+}
+var GatewayConnectionStatsSnapshotFieldPathsNested = []string{
+	"downlink_count",
+	"interval",
+	"round_trip_times",
+	"round_trip_times.count",
+	"round_trip_times.max",
+	"round_trip_times.median",
+	"round_trip_times.min",
+	"sub_bands",
+	"time",
+	"uplink_count",
+}
+
+var GatewayConnectionStatsSnapshotFieldPathsTopLevel = []string{
+	"downlink_count",
+	"interval",
+	"round_trip_times",
+	"sub_bands",
+	"time",
+	"uplink_count",
+}
+var GetGatewayConnectionStatsHistoryRequestFieldPathsNested = []string{
+	"after",
+	"aggregation_interval",
+	"before",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"last",
+}
+
+var GetGatewayConnectionStatsHistoryRequestFieldPathsTopLevel = []string{
+	"after",
+	"aggregation_interval",
+	"before",
+	"gateway_ids",
+	"last",
+}
+var GatewayConnectionStatsHistoryFieldPathsNested = []string{
+	"snapshots",
+}
+
+var GatewayConnectionStatsHistoryFieldPathsTopLevel = []string{
+	"snapshots",
+}
+var GatewayTrafficCaptureFrameFieldPathsNested = []string{
+	"direction",
+	"down",
+	"down.downlink_message",
+	"down.downlink_message.correlation_ids",
+	"down.downlink_message.end_device_ids",
+	"down.downlink_message.end_device_ids.application_ids",
+	"down.downlink_message.end_device_ids.application_ids.application_id",
+	"down.downlink_message.end_device_ids.dev_addr",
+	"down.downlink_message.end_device_ids.dev_eui",
+	"down.downlink_message.end_device_ids.device_id",
+	"down.downlink_message.end_device_ids.join_eui",
+	"down.downlink_message.payload",
+	"down.downlink_message.payload.Payload",
+	"down.downlink_message.payload.Payload.join_accept_payload",
+	"down.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"down.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"down.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"down.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"down.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"down.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"down.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"down.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"down.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"down.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"down.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"down.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"down.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"down.downlink_message.payload.Payload.join_request_payload",
+	"down.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"down.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"down.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"down.downlink_message.payload.Payload.mac_payload",
+	"down.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"down.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"down.downlink_message.payload.Payload.mac_payload.f_port",
+	"down.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"down.downlink_message.payload.Payload.mac_payload.full_f_cnt",
+	"down.downlink_message.payload.Payload.rejoin_request_payload",
+	"down.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"down.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"down.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"down.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"down.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"down.downlink_message.payload.m_hdr",
+	"down.downlink_message.payload.m_hdr.m_type",
+	"down.downlink_message.payload.m_hdr.major",
+	"down.downlink_message.payload.mic",
+	"down.downlink_message.raw_payload",
+	"down.downlink_message.session_key_id",
+	"down.downlink_message.settings",
+	"down.downlink_message.settings.request",
+	"down.downlink_message.settings.request.absolute_time",
+	"down.downlink_message.settings.request.advanced",
+	"down.downlink_message.settings.request.class",
+	"down.downlink_message.settings.request.downlink_paths",
+	"down.downlink_message.settings.request.frequency_plan_id",
+	"down.downlink_message.settings.request.priority",
+	"down.downlink_message.settings.request.rx1_data_rate",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.fsk",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.fsk.bit_rate",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lora",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lora.bandwidth",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lora.coding_rate",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lora.spreading_factor",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.coding_rate",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.modulation_type",
+	"down.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.operating_channel_width",
+	"down.downlink_message.settings.request.rx1_delay",
+	"down.downlink_message.settings.request.rx1_frequency",
+	"down.downlink_message.settings.request.rx2_data_rate",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.fsk",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.fsk.bit_rate",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lora",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lora.bandwidth",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lora.coding_rate",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lora.spreading_factor",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.coding_rate",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.modulation_type",
+	"down.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.operating_channel_width",
+	"down.downlink_message.settings.request.rx2_frequency",
+	"down.downlink_message.settings.scheduled",
+	"down.downlink_message.settings.scheduled.concentrator_timestamp",
+	"down.downlink_message.settings.scheduled.data_rate",
+	"down.downlink_message.settings.scheduled.data_rate.modulation",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lora.coding_rate",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lrfhss",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"down.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
+	"down.downlink_message.settings.scheduled.downlink",
+	"down.downlink_message.settings.scheduled.downlink.antenna_index",
+	"down.downlink_message.settings.scheduled.downlink.implicit_header",
+	"down.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"down.downlink_message.settings.scheduled.downlink.listen_before_talk",
+	"down.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_offset",
+	"down.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_target",
+	"down.downlink_message.settings.scheduled.downlink.listen_before_talk.scan_time",
+	"down.downlink_message.settings.scheduled.downlink.preamble_length",
+	"down.downlink_message.settings.scheduled.downlink.tx_power",
+	"down.downlink_message.settings.scheduled.enable_crc",
+	"down.downlink_message.settings.scheduled.frequency",
+	"down.downlink_message.settings.scheduled.time",
+	"down.downlink_message.settings.scheduled.timestamp",
+	"raw",
+	"time",
+	"up",
+	"up.gateway_status",
+	"up.gateway_status.advanced",
+	"up.gateway_status.antenna_locations",
+	"up.gateway_status.boot_time",
+	"up.gateway_status.ip",
+	"up.gateway_status.metrics",
+	"up.gateway_status.time",
+	"up.gateway_status.versions",
+	"up.tx_acknowledgment",
+	"up.tx_acknowledgment.correlation_ids",
+	"up.tx_acknowledgment.downlink_message",
+	"up.tx_acknowledgment.downlink_message.correlation_ids",
+	"up.tx_acknowledgment.downlink_message.end_device_ids",
+	"up.tx_acknowledgment.downlink_message.end_device_ids.application_ids",
+	"up.tx_acknowledgment.downlink_message.end_device_ids.application_ids.application_id",
+	"up.tx_acknowledgment.downlink_message.end_device_ids.dev_addr",
+	"up.tx_acknowledgment.downlink_message.end_device_ids.dev_eui",
+	"up.tx_acknowledgment.downlink_message.end_device_ids.device_id",
+	"up.tx_acknowledgment.downlink_message.end_device_ids.join_eui",
+	"up.tx_acknowledgment.downlink_message.payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_port",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.full_f_cnt",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"up.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"up.tx_acknowledgment.downlink_message.payload.m_hdr",
+	"up.tx_acknowledgment.downlink_message.payload.m_hdr.m_type",
+	"up.tx_acknowledgment.downlink_message.payload.m_hdr.major",
+	"up.tx_acknowledgment.downlink_message.payload.mic",
+	"up.tx_acknowledgment.downlink_message.raw_payload",
+	"up.tx_acknowledgment.downlink_message.session_key_id",
+	"up.tx_acknowledgment.downlink_message.settings",
+	"up.tx_acknowledgment.downlink_message.settings.request",
+	"up.tx_acknowledgment.downlink_message.settings.request.absolute_time",
+	"up.tx_acknowledgment.downlink_message.settings.request.advanced",
+	"up.tx_acknowledgment.downlink_message.settings.request.class",
+	"up.tx_acknowledgment.downlink_message.settings.request.downlink_paths",
+	"up.tx_acknowledgment.downlink_message.settings.request.frequency_plan_id",
+	"up.tx_acknowledgment.downlink_message.settings.request.priority",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.fsk",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.fsk.bit_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora.bandwidth",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora.coding_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora.spreading_factor",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.coding_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.modulation_type",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.operating_channel_width",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_delay",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx1_frequency",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.fsk",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.fsk.bit_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora.bandwidth",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora.coding_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora.spreading_factor",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.coding_rate",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.modulation_type",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.operating_channel_width",
+	"up.tx_acknowledgment.downlink_message.settings.request.rx2_frequency",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.concentrator_timestamp",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.coding_rate",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.antenna_index",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.implicit_header",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_offset",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_target",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.listen_before_talk.scan_time",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.preamble_length",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.downlink.tx_power",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.frequency",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.time",
+	"up.tx_acknowledgment.downlink_message.settings.scheduled.timestamp",
+	"up.tx_acknowledgment.result",
+	"up.uplink_messages",
+}
+
+var GatewayTrafficCaptureFrameFieldPathsTopLevel = []string{
+	"direction",
+	"down",
+	"raw",
+	"time",
+	"up",
+}
+var StartGatewayTrafficCaptureRequestFieldPathsNested = []string{
+	"duration",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"max_size",
+}
+
+var StartGatewayTrafficCaptureRequestFieldPathsTopLevel = []string{
+	"duration",
+	"gateway_ids",
+	"max_size",
+}
+var GatewayTrafficCaptureFieldPathsNested = []string{
+	"captured_size",
+	"duration",
+	"frames",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"max_size",
+	"protocol",
+	"started_at",
+	"stopped_at",
+}
+
+var GatewayTrafficCaptureFieldPathsTopLevel = []string{
+	"captured_size",
+	"duration",
+	"frames",
+	"gateway_ids",
+	"max_size",
+	"protocol",
+	"started_at",
+	"stopped_at",
+}
+var GatewayRemoteShellRequest_StartFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"term",
+	"user",
+}
+
+var GatewayRemoteShellRequest_StartFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"term",
+	"user",
+}
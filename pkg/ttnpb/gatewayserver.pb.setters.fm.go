@@ -243,3 +243,643 @@ func (dst *BatchGetGatewayConnectionStatsResponse) SetFields(src *BatchGetGatewa
 	}
 	return nil
 }
+
+func (dst *GatewayRemoteCommandRequest) SetFields(src *GatewayRemoteCommandRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "command":
+			if len(subs) > 0 {
+				return fmt.Errorf("'command' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Command = src.Command
+			} else {
+				var zero string
+				dst.Command = zero
+			}
+		case "arguments":
+			if len(subs) > 0 {
+				return fmt.Errorf("'arguments' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Arguments = src.Arguments
+			} else {
+				dst.Arguments = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellRequest) SetFields(src *GatewayRemoteShellRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+
+		case "message":
+			if len(subs) == 0 && src == nil {
+				dst.Message = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Message = src.Message
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "start":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayRemoteShellRequest_Start_)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'start', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayRemoteShellRequest_Start_)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'start', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GatewayRemoteShellRequest_Start
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayRemoteShellRequest_Start_).Start
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayRemoteShellRequest_Start_).Start
+						} else if srcTypeOk {
+							newDst = &GatewayRemoteShellRequest_Start{}
+							dst.Message = &GatewayRemoteShellRequest_Start_{Start: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "input":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayRemoteShellRequest_Input)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'input', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayRemoteShellRequest_Input)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'input', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						return fmt.Errorf("'input' has no subfields, but %s were specified", oneofSubs)
+					}
+					if srcTypeOk {
+						dst.Message = src.Message
+					} else {
+						dst.Message = nil
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellResponse) SetFields(src *GatewayRemoteShellResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "output":
+			if len(subs) > 0 {
+				return fmt.Errorf("'output' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Output = src.Output
+			} else {
+				dst.Output = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsSnapshot) SetFields(src *GatewayConnectionStatsSnapshot, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				dst.Time = nil
+			}
+		case "interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Interval = src.Interval
+			} else {
+				dst.Interval = nil
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "round_trip_times":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStats_RoundTripTimes
+				if (src == nil || src.RoundTripTimes == nil) && dst.RoundTripTimes == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RoundTripTimes
+				}
+				if dst.RoundTripTimes != nil {
+					newDst = dst.RoundTripTimes
+				} else {
+					newDst = &GatewayConnectionStats_RoundTripTimes{}
+					dst.RoundTripTimes = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RoundTripTimes = src.RoundTripTimes
+				} else {
+					dst.RoundTripTimes = nil
+				}
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayConnectionStatsHistoryRequest) SetFields(src *GetGatewayConnectionStatsHistoryRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.After = src.After
+			} else {
+				dst.After = nil
+			}
+		case "before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Before = src.Before
+			} else {
+				dst.Before = nil
+			}
+		case "last":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Last = src.Last
+			} else {
+				dst.Last = nil
+			}
+		case "aggregation_interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'aggregation_interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AggregationInterval = src.AggregationInterval
+			} else {
+				dst.AggregationInterval = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsHistory) SetFields(src *GatewayConnectionStatsHistory, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "snapshots":
+			if len(subs) > 0 {
+				return fmt.Errorf("'snapshots' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Snapshots = src.Snapshots
+			} else {
+				dst.Snapshots = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficCaptureFrame) SetFields(src *GatewayTrafficCaptureFrame, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				dst.Time = nil
+			}
+		case "direction":
+			if len(subs) > 0 {
+				return fmt.Errorf("'direction' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Direction = src.Direction
+			} else {
+				var zero GatewayTrafficCaptureFrame_Direction
+				dst.Direction = zero
+			}
+		case "raw":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Raw = src.Raw
+			} else {
+				dst.Raw = nil
+			}
+		case "up":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayUp
+				if (src == nil || src.Up == nil) && dst.Up == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Up
+				}
+				if dst.Up != nil {
+					newDst = dst.Up
+				} else {
+					newDst = &GatewayUp{}
+					dst.Up = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Up = src.Up
+				} else {
+					dst.Up = nil
+				}
+			}
+		case "down":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayDown
+				if (src == nil || src.Down == nil) && dst.Down == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Down
+				}
+				if dst.Down != nil {
+					newDst = dst.Down
+				} else {
+					newDst = &GatewayDown{}
+					dst.Down = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Down = src.Down
+				} else {
+					dst.Down = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StartGatewayTrafficCaptureRequest) SetFields(src *StartGatewayTrafficCaptureRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+		case "max_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxSize = src.MaxSize
+			} else {
+				var zero uint64
+				dst.MaxSize = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficCapture) SetFields(src *GatewayTrafficCapture, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "protocol":
+			if len(subs) > 0 {
+				return fmt.Errorf("'protocol' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Protocol = src.Protocol
+			} else {
+				var zero string
+				dst.Protocol = zero
+			}
+		case "started_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedAt = src.StartedAt
+			} else {
+				dst.StartedAt = nil
+			}
+		case "stopped_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'stopped_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StoppedAt = src.StoppedAt
+			} else {
+				dst.StoppedAt = nil
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+		case "max_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxSize = src.MaxSize
+			} else {
+				var zero uint64
+				dst.MaxSize = zero
+			}
+		case "captured_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'captured_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CapturedSize = src.CapturedSize
+			} else {
+				var zero uint64
+				dst.CapturedSize = zero
+			}
+		case "frames":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frames' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Frames = src.Frames
+			} else {
+				dst.Frames = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellRequest_Start) SetFields(src *GatewayRemoteShellRequest_Start, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "user":
+			if len(subs) > 0 {
+				return fmt.Errorf("'user' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.User = src.User
+			} else {
+				var zero string
+				dst.User = zero
+			}
+		case "term":
+			if len(subs) > 0 {
+				return fmt.Errorf("'term' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Term = src.Term
+			} else {
+				var zero string
+				dst.Term = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
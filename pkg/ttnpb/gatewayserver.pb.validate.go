@@ -670,3 +670,1265 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BatchGetGatewayConnectionStatsResponseValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteCommandRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayRemoteCommandRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteCommandRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayRemoteCommandRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayRemoteCommandRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "command":
+
+			if l := utf8.RuneCountInString(m.GetCommand()); l < 1 || l > 256 {
+				return GatewayRemoteCommandRequestValidationError{
+					field:  "command",
+					reason: "value length must be between 1 and 256 runes, inclusive",
+				}
+			}
+
+		case "arguments":
+
+			if len(m.GetArguments()) > 32 {
+				return GatewayRemoteCommandRequestValidationError{
+					field:  "arguments",
+					reason: "value must contain no more than 32 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetArguments() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 256 {
+					return GatewayRemoteCommandRequestValidationError{
+						field:  fmt.Sprintf("arguments[%v]", idx),
+						reason: "value length must be at most 256 runes",
+					}
+				}
+
+			}
+
+		default:
+			return GatewayRemoteCommandRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteCommandRequestValidationError is the validation error returned
+// by GatewayRemoteCommandRequest.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteCommandRequestValidationError) ErrorName() string {
+	return "GatewayRemoteCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteCommandRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayRemoteShellRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "message":
+			if len(subs) == 0 {
+				subs = []string{
+					"start", "input",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "start":
+					w, ok := m.Message.(*GatewayRemoteShellRequest_Start_)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetStart()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayRemoteShellRequestValidationError{
+								field:  "start",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "input":
+					w, ok := m.Message.(*GatewayRemoteShellRequest_Input)
+					if !ok || w == nil {
+						continue
+					}
+
+					if len(m.GetInput()) > 4096 {
+						return GatewayRemoteShellRequestValidationError{
+							field:  "input",
+							reason: "value length must be at most 4096 bytes",
+						}
+					}
+
+				}
+			}
+		default:
+			return GatewayRemoteShellRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellRequestValidationError is the validation error returned by
+// GatewayRemoteShellRequest.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteShellRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellRequestValidationError) ErrorName() string {
+	return "GatewayRemoteShellRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayRemoteShellResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "output":
+			// no validation rules for Output
+		default:
+			return GatewayRemoteShellResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellResponseValidationError is the validation error returned
+// by GatewayRemoteShellResponse.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteShellResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellResponseValidationError) ErrorName() string {
+	return "GatewayRemoteShellResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellResponseValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsSnapshot
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayConnectionStatsSnapshot) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsSnapshotFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if m.GetTime() == nil {
+				return GatewayConnectionStatsSnapshotValidationError{
+					field:  "time",
+					reason: "value is required",
+				}
+			}
+
+		case "interval":
+
+			if v, ok := interface{}(m.GetInterval()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSnapshotValidationError{
+						field:  "interval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "round_trip_times":
+
+			if v, ok := interface{}(m.GetRoundTripTimes()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsSnapshotValidationError{
+						field:  "round_trip_times",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsSnapshotValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionStatsSnapshotValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsSnapshotValidationError is the validation error
+// returned by GatewayConnectionStatsSnapshot.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConnectionStatsSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsSnapshotValidationError) ErrorName() string {
+	return "GatewayConnectionStatsSnapshotValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsSnapshotValidationError{}
+
+// ValidateFields checks the field values on
+// GetGatewayConnectionStatsHistoryRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetGatewayConnectionStatsHistoryRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayConnectionStatsHistoryRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GetGatewayConnectionStatsHistoryRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "after":
+
+			if v, ok := interface{}(m.GetAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "before":
+
+			if v, ok := interface{}(m.GetBefore()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "before",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last":
+
+			if v, ok := interface{}(m.GetLast()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "last",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "aggregation_interval":
+
+			if d := m.GetAggregationInterval(); d != nil {
+				dur, err := types.DurationFromProto(d)
+				if err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "aggregation_interval",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				gte := time.Duration(60*time.Second + 0*time.Nanosecond)
+
+				if dur < gte {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "aggregation_interval",
+						reason: "value must be greater than or equal to 1m0s",
+					}
+				}
+
+			}
+
+		default:
+			return GetGatewayConnectionStatsHistoryRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayConnectionStatsHistoryRequestValidationError is the validation
+// error returned by GetGatewayConnectionStatsHistoryRequest.ValidateFields if
+// the designated constraints aren't met.
+type GetGatewayConnectionStatsHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) ErrorName() string {
+	return "GetGatewayConnectionStatsHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayConnectionStatsHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsHistory with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayConnectionStatsHistory) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsHistoryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "snapshots":
+
+			for idx, item := range m.GetSnapshots() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsHistoryValidationError{
+							field:  fmt.Sprintf("snapshots[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionStatsHistoryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsHistoryValidationError is the validation error
+// returned by GatewayConnectionStatsHistory.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConnectionStatsHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsHistoryValidationError) ErrorName() string {
+	return "GatewayConnectionStatsHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsHistoryValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficCaptureFrame with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayTrafficCaptureFrame) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficCaptureFrameFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if m.GetTime() == nil {
+				return GatewayTrafficCaptureFrameValidationError{
+					field:  "time",
+					reason: "value is required",
+				}
+			}
+
+		case "direction":
+
+			if _, ok := GatewayTrafficCaptureFrame_Direction_name[int32(m.GetDirection())]; !ok {
+				return GatewayTrafficCaptureFrameValidationError{
+					field:  "direction",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "raw":
+			// no validation rules for Raw
+		case "up":
+
+			if v, ok := interface{}(m.GetUp()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureFrameValidationError{
+						field:  "up",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "down":
+
+			if v, ok := interface{}(m.GetDown()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureFrameValidationError{
+						field:  "down",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayTrafficCaptureFrameValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureFrameValidationError is the validation error returned
+// by GatewayTrafficCaptureFrame.ValidateFields if the designated constraints
+// aren't met.
+type GatewayTrafficCaptureFrameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficCaptureFrameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficCaptureFrameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficCaptureFrameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficCaptureFrameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficCaptureFrameValidationError) ErrorName() string {
+	return "GatewayTrafficCaptureFrameValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficCaptureFrameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficCaptureFrame.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficCaptureFrameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficCaptureFrameValidationError{}
+
+// ValidateFields checks the field values on StartGatewayTrafficCaptureRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *StartGatewayTrafficCaptureRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StartGatewayTrafficCaptureRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return StartGatewayTrafficCaptureRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartGatewayTrafficCaptureRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+			if d := m.GetDuration(); d != nil {
+				dur, err := types.DurationFromProto(d)
+				if err != nil {
+					return StartGatewayTrafficCaptureRequestValidationError{
+						field:  "duration",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+				if dur < gte {
+					return StartGatewayTrafficCaptureRequestValidationError{
+						field:  "duration",
+						reason: "value must be greater than or equal to 1s",
+					}
+				}
+
+			}
+
+		case "max_size":
+			// no validation rules for MaxSize
+		default:
+			return StartGatewayTrafficCaptureRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StartGatewayTrafficCaptureRequestValidationError is the validation error
+// returned by StartGatewayTrafficCaptureRequest.ValidateFields if the
+// designated constraints aren't met.
+type StartGatewayTrafficCaptureRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartGatewayTrafficCaptureRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartGatewayTrafficCaptureRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartGatewayTrafficCaptureRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartGatewayTrafficCaptureRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartGatewayTrafficCaptureRequestValidationError) ErrorName() string {
+	return "StartGatewayTrafficCaptureRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartGatewayTrafficCaptureRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartGatewayTrafficCaptureRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartGatewayTrafficCaptureRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartGatewayTrafficCaptureRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficCapture with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayTrafficCapture) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficCaptureFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayTrafficCaptureValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "protocol":
+			// no validation rules for Protocol
+		case "started_at":
+
+			if v, ok := interface{}(m.GetStartedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureValidationError{
+						field:  "started_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "stopped_at":
+
+			if v, ok := interface{}(m.GetStoppedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureValidationError{
+						field:  "stopped_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+			if v, ok := interface{}(m.GetDuration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayTrafficCaptureValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max_size":
+			// no validation rules for MaxSize
+		case "captured_size":
+			// no validation rules for CapturedSize
+		case "frames":
+
+			for idx, item := range m.GetFrames() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayTrafficCaptureValidationError{
+							field:  fmt.Sprintf("frames[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayTrafficCaptureValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureValidationError is the validation error returned by
+// GatewayTrafficCapture.ValidateFields if the designated constraints aren't met.
+type GatewayTrafficCaptureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficCaptureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficCaptureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficCaptureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficCaptureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficCaptureValidationError) ErrorName() string {
+	return "GatewayTrafficCaptureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficCaptureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficCapture.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficCaptureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficCaptureValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellRequest_Start
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayRemoteShellRequest_Start) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellRequest_StartFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayRemoteShellRequest_StartValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayRemoteShellRequest_StartValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "user":
+
+			if utf8.RuneCountInString(m.GetUser()) > 64 {
+				return GatewayRemoteShellRequest_StartValidationError{
+					field:  "user",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "term":
+
+			if utf8.RuneCountInString(m.GetTerm()) > 64 {
+				return GatewayRemoteShellRequest_StartValidationError{
+					field:  "term",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		default:
+			return GatewayRemoteShellRequest_StartValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellRequest_StartValidationError is the validation error
+// returned by GatewayRemoteShellRequest_Start.ValidateFields if the
+// designated constraints aren't met.
+type GatewayRemoteShellRequest_StartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellRequest_StartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellRequest_StartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellRequest_StartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellRequest_StartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellRequest_StartValidationError) ErrorName() string {
+	return "GatewayRemoteShellRequest_StartValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellRequest_StartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellRequest_Start.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellRequest_StartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellRequest_StartValidationError{}
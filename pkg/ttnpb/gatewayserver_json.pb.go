@@ -308,3 +308,644 @@ func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalProtoJSON(s *jsonplugin
 func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the GatewayRemoteCommandRequest message to JSON.
+func (x *GatewayRemoteCommandRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Command != "" || s.HasField("command") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("command")
+		s.WriteString(x.Command)
+	}
+	if len(x.Arguments) > 0 || s.HasField("arguments") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("arguments")
+		s.WriteStringArray(x.Arguments)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayRemoteCommandRequest to JSON.
+func (x *GatewayRemoteCommandRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayRemoteCommandRequest message from JSON.
+func (x *GatewayRemoteCommandRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "command":
+			s.AddField("command")
+			x.Command = s.ReadString()
+		case "arguments":
+			s.AddField("arguments")
+			if s.ReadNil() {
+				x.Arguments = nil
+				return
+			}
+			x.Arguments = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayRemoteCommandRequest from JSON.
+func (x *GatewayRemoteCommandRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayRemoteShellRequest_Start message to JSON.
+func (x *GatewayRemoteShellRequest_Start) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.User != "" || s.HasField("user") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("user")
+		s.WriteString(x.User)
+	}
+	if x.Term != "" || s.HasField("term") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("term")
+		s.WriteString(x.Term)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayRemoteShellRequest_Start to JSON.
+func (x *GatewayRemoteShellRequest_Start) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayRemoteShellRequest_Start message from JSON.
+func (x *GatewayRemoteShellRequest_Start) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "user":
+			s.AddField("user")
+			x.User = s.ReadString()
+		case "term":
+			s.AddField("term")
+			x.Term = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayRemoteShellRequest_Start from JSON.
+func (x *GatewayRemoteShellRequest_Start) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayRemoteShellRequest message to JSON.
+func (x *GatewayRemoteShellRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Message != nil {
+		switch ov := x.Message.(type) {
+		case *GatewayRemoteShellRequest_Start_:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("start")
+			ov.Start.MarshalProtoJSON(s.WithField("start"))
+		case *GatewayRemoteShellRequest_Input:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("input")
+			s.WriteBytes(ov.Input)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayRemoteShellRequest to JSON.
+func (x *GatewayRemoteShellRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayRemoteShellRequest message from JSON.
+func (x *GatewayRemoteShellRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "start":
+			ov := &GatewayRemoteShellRequest_Start_{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.Start = nil
+				return
+			}
+			ov.Start = &GatewayRemoteShellRequest_Start{}
+			ov.Start.UnmarshalProtoJSON(s.WithField("start", true))
+		case "input":
+			s.AddField("input")
+			ov := &GatewayRemoteShellRequest_Input{}
+			x.Message = ov
+			ov.Input = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayRemoteShellRequest from JSON.
+func (x *GatewayRemoteShellRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetGatewayConnectionStatsHistoryRequest message to JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.After != nil || s.HasField("after") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("after")
+		if x.After == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalTimestamp(s, x.After)
+		}
+	}
+	if x.Before != nil || s.HasField("before") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("before")
+		if x.Before == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalTimestamp(s, x.Before)
+		}
+	}
+	if x.Last != nil || s.HasField("last") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last")
+		if x.Last == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalDuration(s, x.Last)
+		}
+	}
+	if x.AggregationInterval != nil || s.HasField("aggregation_interval") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("aggregation_interval")
+		if x.AggregationInterval == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalDuration(s, x.AggregationInterval)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetGatewayConnectionStatsHistoryRequest to JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetGatewayConnectionStatsHistoryRequest message from JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "after":
+			s.AddField("after")
+			if s.ReadNil() {
+				x.After = nil
+				return
+			}
+			v := gogo.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.After = v
+		case "before":
+			s.AddField("before")
+			if s.ReadNil() {
+				x.Before = nil
+				return
+			}
+			v := gogo.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Before = v
+		case "last":
+			s.AddField("last")
+			if s.ReadNil() {
+				x.Last = nil
+				return
+			}
+			v := gogo.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Last = v
+		case "aggregation_interval", "aggregationInterval":
+			s.AddField("aggregation_interval")
+			if s.ReadNil() {
+				x.AggregationInterval = nil
+				return
+			}
+			v := gogo.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.AggregationInterval = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetGatewayConnectionStatsHistoryRequest from JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayTrafficCaptureFrame message to JSON.
+func (x *GatewayTrafficCaptureFrame) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Time != nil || s.HasField("time") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("time")
+		if x.Time == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalTimestamp(s, x.Time)
+		}
+	}
+	if x.Direction != 0 || s.HasField("direction") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("direction")
+		s.WriteEnum(int32(x.Direction), GatewayTrafficCaptureFrame_Direction_name)
+	}
+	if len(x.Raw) > 0 || s.HasField("raw") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("raw")
+		s.WriteBytes(x.Raw)
+	}
+	if x.Up != nil || s.HasField("up") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("up")
+		x.Up.MarshalProtoJSON(s.WithField("up"))
+	}
+	if x.Down != nil || s.HasField("down") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("down")
+		x.Down.MarshalProtoJSON(s.WithField("down"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayTrafficCaptureFrame to JSON.
+func (x *GatewayTrafficCaptureFrame) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayTrafficCaptureFrame message from JSON.
+func (x *GatewayTrafficCaptureFrame) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "time":
+			s.AddField("time")
+			if s.ReadNil() {
+				x.Time = nil
+				return
+			}
+			v := gogo.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Time = v
+		case "direction":
+			s.AddField("direction")
+			x.Direction = GatewayTrafficCaptureFrame_Direction(s.ReadEnum(GatewayTrafficCaptureFrame_Direction_value))
+		case "raw":
+			s.AddField("raw")
+			x.Raw = s.ReadBytes()
+		case "up":
+			if s.ReadNil() {
+				x.Up = nil
+				return
+			}
+			x.Up = &GatewayUp{}
+			x.Up.UnmarshalProtoJSON(s.WithField("up", true))
+		case "down":
+			if s.ReadNil() {
+				x.Down = nil
+				return
+			}
+			x.Down = &GatewayDown{}
+			x.Down.UnmarshalProtoJSON(s.WithField("down", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayTrafficCaptureFrame from JSON.
+func (x *GatewayTrafficCaptureFrame) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the StartGatewayTrafficCaptureRequest message to JSON.
+func (x *StartGatewayTrafficCaptureRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Duration != nil || s.HasField("duration") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("duration")
+		if x.Duration == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalDuration(s, x.Duration)
+		}
+	}
+	if x.MaxSize != 0 || s.HasField("max_size") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("max_size")
+		s.WriteUint64(x.MaxSize)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the StartGatewayTrafficCaptureRequest to JSON.
+func (x *StartGatewayTrafficCaptureRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the StartGatewayTrafficCaptureRequest message from JSON.
+func (x *StartGatewayTrafficCaptureRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "duration":
+			s.AddField("duration")
+			if s.ReadNil() {
+				x.Duration = nil
+				return
+			}
+			v := gogo.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Duration = v
+		case "max_size", "maxSize":
+			s.AddField("max_size")
+			x.MaxSize = s.ReadUint64()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the StartGatewayTrafficCaptureRequest from JSON.
+func (x *StartGatewayTrafficCaptureRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayTrafficCapture message to JSON.
+func (x *GatewayTrafficCapture) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Protocol != "" || s.HasField("protocol") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("protocol")
+		s.WriteString(x.Protocol)
+	}
+	if x.StartedAt != nil || s.HasField("started_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("started_at")
+		if x.StartedAt == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalTimestamp(s, x.StartedAt)
+		}
+	}
+	if x.StoppedAt != nil || s.HasField("stopped_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("stopped_at")
+		if x.StoppedAt == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalTimestamp(s, x.StoppedAt)
+		}
+	}
+	if x.Duration != nil || s.HasField("duration") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("duration")
+		if x.Duration == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalDuration(s, x.Duration)
+		}
+	}
+	if x.MaxSize != 0 || s.HasField("max_size") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("max_size")
+		s.WriteUint64(x.MaxSize)
+	}
+	if x.CapturedSize != 0 || s.HasField("captured_size") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("captured_size")
+		s.WriteUint64(x.CapturedSize)
+	}
+	if len(x.Frames) > 0 || s.HasField("frames") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("frames")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Frames {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("frames"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayTrafficCapture to JSON.
+func (x *GatewayTrafficCapture) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayTrafficCapture message from JSON.
+func (x *GatewayTrafficCapture) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "protocol":
+			s.AddField("protocol")
+			x.Protocol = s.ReadString()
+		case "started_at", "startedAt":
+			s.AddField("started_at")
+			if s.ReadNil() {
+				x.StartedAt = nil
+				return
+			}
+			v := gogo.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.StartedAt = v
+		case "stopped_at", "stoppedAt":
+			s.AddField("stopped_at")
+			if s.ReadNil() {
+				x.StoppedAt = nil
+				return
+			}
+			v := gogo.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.StoppedAt = v
+		case "duration":
+			s.AddField("duration")
+			if s.ReadNil() {
+				x.Duration = nil
+				return
+			}
+			v := gogo.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Duration = v
+		case "max_size", "maxSize":
+			s.AddField("max_size")
+			x.MaxSize = s.ReadUint64()
+		case "captured_size", "capturedSize":
+			s.AddField("captured_size")
+			x.CapturedSize = s.ReadUint64()
+		case "frames":
+			s.AddField("frames")
+			if s.ReadNil() {
+				x.Frames = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Frames = append(x.Frames, nil)
+					return
+				}
+				v := &GatewayTrafficCaptureFrame{}
+				v.UnmarshalProtoJSON(s.WithField("frames", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Frames = append(x.Frames, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayTrafficCapture from JSON.
+func (x *GatewayTrafficCapture) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -2221,7 +2221,14 @@ type TxSettings_Downlink struct {
 	// Transmission power (dBm). Only on downlink.
 	TxPower float32 `protobuf:"fixed32,2,opt,name=tx_power,json=txPower,proto3" json:"tx_power,omitempty"`
 	// Invert LoRa polarization; false for LoRaWAN uplink, true for downlink.
-	InvertPolarization   bool     `protobuf:"varint,3,opt,name=invert_polarization,json=invertPolarization,proto3" json:"invert_polarization,omitempty"`
+	InvertPolarization bool `protobuf:"varint,3,opt,name=invert_polarization,json=invertPolarization,proto3" json:"invert_polarization,omitempty"`
+	// Listen-before-talk settings. Only set by the Gateway Server when the frequency plan requires
+	// listen-before-talk and the gateway frontend supports listen-before-talk settings in downlink messages.
+	ListenBeforeTalk *TxSettings_Downlink_ListenBeforeTalk `protobuf:"bytes,4,opt,name=listen_before_talk,json=listenBeforeTalk,proto3" json:"listen_before_talk,omitempty"`
+	// Use the implicit LoRa header mode; the packet has no PHY header. Used for Class B beacons.
+	ImplicitHeader bool `protobuf:"varint,5,opt,name=implicit_header,json=implicitHeader,proto3" json:"implicit_header,omitempty"`
+	// Length of the preamble (symbols). If zero, the gateway uses the default preamble length.
+	PreambleLength       uint32   `protobuf:"varint,6,opt,name=preamble_length,json=preambleLength,proto3" json:"preamble_length,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2272,6 +2279,85 @@ func (m *TxSettings_Downlink) GetInvertPolarization() bool {
 	return false
 }
 
+func (m *TxSettings_Downlink) GetListenBeforeTalk() *TxSettings_Downlink_ListenBeforeTalk {
+	if m != nil {
+		return m.ListenBeforeTalk
+	}
+	return nil
+}
+
+func (m *TxSettings_Downlink) GetImplicitHeader() bool {
+	if m != nil {
+		return m.ImplicitHeader
+	}
+	return false
+}
+
+func (m *TxSettings_Downlink) GetPreambleLength() uint32 {
+	if m != nil {
+		return m.PreambleLength
+	}
+	return 0
+}
+
+// Listen-before-talk settings for a downlink transmission.
+type TxSettings_Downlink_ListenBeforeTalk struct {
+	// Received signal strength target (dBm). The channel is considered clear below this value.
+	RssiTarget float32 `protobuf:"fixed32,1,opt,name=rssi_target,json=rssiTarget,proto3" json:"rssi_target,omitempty"`
+	// Received signal strength offset (dBm).
+	RssiOffset float32 `protobuf:"fixed32,2,opt,name=rssi_offset,json=rssiOffset,proto3" json:"rssi_offset,omitempty"`
+	// Duration to listen for channel activity before transmitting.
+	ScanTime             *types.Duration `protobuf:"bytes,3,opt,name=scan_time,json=scanTime,proto3" json:"scan_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *TxSettings_Downlink_ListenBeforeTalk) Reset()         { *m = TxSettings_Downlink_ListenBeforeTalk{} }
+func (m *TxSettings_Downlink_ListenBeforeTalk) String() string { return proto.CompactTextString(m) }
+func (*TxSettings_Downlink_ListenBeforeTalk) ProtoMessage()    {}
+func (*TxSettings_Downlink_ListenBeforeTalk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{14, 0, 0}
+}
+func (m *TxSettings_Downlink_ListenBeforeTalk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxSettings_Downlink_ListenBeforeTalk.Unmarshal(m, b)
+}
+func (m *TxSettings_Downlink_ListenBeforeTalk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxSettings_Downlink_ListenBeforeTalk.Marshal(b, m, deterministic)
+}
+func (m *TxSettings_Downlink_ListenBeforeTalk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxSettings_Downlink_ListenBeforeTalk.Merge(m, src)
+}
+func (m *TxSettings_Downlink_ListenBeforeTalk) XXX_Size() int {
+	return xxx_messageInfo_TxSettings_Downlink_ListenBeforeTalk.Size(m)
+}
+func (m *TxSettings_Downlink_ListenBeforeTalk) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxSettings_Downlink_ListenBeforeTalk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxSettings_Downlink_ListenBeforeTalk proto.InternalMessageInfo
+
+func (m *TxSettings_Downlink_ListenBeforeTalk) GetRssiTarget() float32 {
+	if m != nil {
+		return m.RssiTarget
+	}
+	return 0
+}
+
+func (m *TxSettings_Downlink_ListenBeforeTalk) GetRssiOffset() float32 {
+	if m != nil {
+		return m.RssiOffset
+	}
+	return 0
+}
+
+func (m *TxSettings_Downlink_ListenBeforeTalk) GetScanTime() *types.Duration {
+	if m != nil {
+		return m.ScanTime
+	}
+	return nil
+}
+
 type GatewayAntennaIdentifiers struct {
 	GatewayIds           *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
 	AntennaIndex         uint32              `protobuf:"varint,2,opt,name=antenna_index,json=antennaIndex,proto3" json:"antenna_index,omitempty"`
@@ -5000,6 +5086,8 @@ func init() {
 	golang_proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
 	proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
 	golang_proto.RegisterType((*TxSettings_Downlink)(nil), "ttn.lorawan.v3.TxSettings.Downlink")
+	proto.RegisterType((*TxSettings_Downlink_ListenBeforeTalk)(nil), "ttn.lorawan.v3.TxSettings.Downlink.ListenBeforeTalk")
+	golang_proto.RegisterType((*TxSettings_Downlink_ListenBeforeTalk)(nil), "ttn.lorawan.v3.TxSettings.Downlink.ListenBeforeTalk")
 	proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
 	golang_proto.RegisterType((*GatewayAntennaIdentifiers)(nil), "ttn.lorawan.v3.GatewayAntennaIdentifiers")
 	proto.RegisterType((*ClassBCGatewayIdentifiers)(nil), "ttn.lorawan.v3.ClassBCGatewayIdentifiers")
@@ -5102,397 +5190,404 @@ func init() {
 }
 
 var fileDescriptor_2084d1d5a227b67e = []byte{
-	// 6266 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x24, 0x57,
-	0x56, 0x76, 0xf5, 0xbf, 0x4f, 0x77, 0xbb, 0x6b, 0xae, 0xe7, 0xa7, 0xbb, 0x93, 0xcd, 0x4c, 0x3c,
-	0x81, 0x9d, 0xed, 0x68, 0x6c, 0x77, 0xdb, 0xe3, 0x71, 0xc2, 0x66, 0x93, 0xee, 0x76, 0x3b, 0xf6,
-	0x8c, 0xff, 0xb6, 0xda, 0x33, 0x93, 0x59, 0x16, 0x8a, 0x72, 0x57, 0xb5, 0xdd, 0x71, 0x77, 0x55,
-	0xa7, 0xba, 0xec, 0xb1, 0x97, 0x27, 0x82, 0x10, 0xd2, 0x4a, 0x08, 0x94, 0xb7, 0x65, 0x11, 0x5a,
-	0xf1, 0x02, 0x32, 0x2f, 0x68, 0x79, 0x5a, 0x10, 0x12, 0xf0, 0xc0, 0x13, 0x08, 0xf1, 0xc0, 0x03,
-	0xf0, 0xc0, 0x4a, 0x8b, 0x40, 0x32, 0x62, 0x41, 0x01, 0x24, 0x94, 0x07, 0x82, 0xce, 0xbd, 0xb7,
-	0xba, 0x6e, 0x55, 0xb5, 0xc7, 0x76, 0x36, 0x61, 0xf7, 0x21, 0x79, 0x99, 0xae, 0xef, 0xde, 0xfb,
-	0x9d, 0x73, 0xcf, 0x39, 0xf7, 0xdc, 0x73, 0x6f, 0x95, 0x03, 0x37, 0xbb, 0x96, 0xad, 0x3d, 0xd3,
-	0xcc, 0xbb, 0x03, 0x47, 0x6b, 0xed, 0xcf, 0x68, 0xfd, 0xce, 0x0c, 0x47, 0xa6, 0xfb, 0xb6, 0xe5,
-	0x58, 0x64, 0xc2, 0x71, 0xcc, 0x69, 0x17, 0x3a, 0x9c, 0x2b, 0x2e, 0xed, 0x76, 0x9c, 0xbd, 0x83,
-	0x9d, 0xe9, 0x96, 0xd5, 0x9b, 0xd9, 0xde, 0x33, 0xb6, 0xf7, 0x3a, 0xe6, 0xee, 0x60, 0xd5, 0xd4,
-	0x0f, 0x06, 0x8e, 0xdd, 0x31, 0x06, 0x33, 0x74, 0x54, 0xeb, 0xee, 0xae, 0x61, 0xde, 0xdd, 0xb5,
-	0xee, 0xb6, 0xbb, 0xda, 0xee, 0x60, 0x46, 0x33, 0x4d, 0xcb, 0xd1, 0x9c, 0x8e, 0x65, 0x0e, 0x18,
-	0x6b, 0xb1, 0x2a, 0xb0, 0x18, 0xe6, 0xa1, 0x75, 0xdc, 0xb7, 0xad, 0xa3, 0x63, 0x71, 0xf0, 0xa1,
-	0xd6, 0xed, 0xe8, 0x9a, 0x63, 0xcc, 0x84, 0x7e, 0x70, 0x8a, 0xbb, 0x02, 0xc5, 0xae, 0xb5, 0x6b,
-	0xb1, 0xc1, 0x3b, 0x07, 0x6d, 0xfa, 0x44, 0x1f, 0xe8, 0x2f, 0xde, 0xbd, 0x7e, 0x29, 0xbd, 0xdf,
-	0x1d, 0x58, 0xe6, 0x08, 0xb5, 0x5f, 0xdc, 0xb5, 0xac, 0xdd, 0xae, 0xe1, 0x89, 0x1a, 0x38, 0xf6,
-	0x41, 0xcb, 0xe1, 0xad, 0x37, 0x83, 0xad, 0x4e, 0xa7, 0x67, 0x0c, 0x1c, 0xad, 0xd7, 0xe7, 0x1d,
-	0x6e, 0x87, 0x8d, 0xdd, 0xd1, 0x0d, 0xd3, 0xe9, 0xb4, 0x3b, 0x86, 0xed, 0xca, 0x98, 0x16, 0x34,
-	0xb1, 0xfa, 0x86, 0xa9, 0xf5, 0x3b, 0x87, 0x95, 0x19, 0xab, 0x4f, 0xf5, 0x08, 0xeb, 0x34, 0xf5,
-	0xc7, 0x51, 0x48, 0xae, 0x1b, 0x83, 0x81, 0xb6, 0x6b, 0x90, 0x39, 0x88, 0xf7, 0xd4, 0x3d, 0xdd,
-	0xce, 0x4b, 0xb7, 0xa4, 0x3b, 0xe9, 0xca, 0xd5, 0x69, 0xbf, 0xf3, 0xa6, 0xd7, 0x57, 0x96, 0x94,
-	0x5a, 0xea, 0xa3, 0x5a, 0xfc, 0x9b, 0x52, 0x44, 0x96, 0x94, 0x58, 0x6f, 0x45, 0xb7, 0xc9, 0x0b,
-	0x10, 0xed, 0x75, 0x5a, 0xf9, 0xc8, 0x2d, 0xe9, 0x4e, 0xa6, 0x36, 0xfe, 0x51, 0x2d, 0xf1, 0x8d,
-	0x98, 0x3c, 0x96, 0x8f, 0x29, 0x88, 0x92, 0x37, 0x20, 0xdd, 0xd3, 0x5a, 0x6a, 0x5f, 0x3b, 0xee,
-	0x5a, 0x9a, 0x9e, 0x8f, 0x52, 0xde, 0x62, 0x88, 0xb7, 0x5a, 0xdf, 0x62, 0x3d, 0x56, 0xc6, 0x14,
-	0xe8, 0x69, 0x2d, 0xfe, 0x44, 0x1e, 0xc3, 0xd5, 0x77, 0xad, 0x8e, 0xa9, 0xda, 0xc6, 0x7b, 0x07,
-	0xc6, 0xc0, 0x19, 0xf2, 0xc4, 0x28, 0xcf, 0x54, 0x90, 0xe7, 0x81, 0xd5, 0x31, 0x15, 0xd6, 0xd5,
-	0xe3, 0x23, 0xef, 0x86, 0x50, 0xd2, 0x84, 0x49, 0xca, 0xab, 0xb5, 0x5a, 0x46, 0xdf, 0xa3, 0x8d,
-	0x53, 0xda, 0x97, 0x47, 0xd1, 0x56, 0x69, 0x4f, 0x8f, 0xf5, 0xca, 0xbb, 0x41, 0x90, 0x7c, 0x1d,
-	0xae, 0xdb, 0xc6, 0x48, 0x75, 0x13, 0x94, 0xf7, 0x95, 0x20, 0xaf, 0x62, 0xbc, 0x3b, 0x4a, 0xe1,
-	0xab, 0xf6, 0x08, 0xbc, 0x36, 0x01, 0x49, 0x57, 0x50, 0xf4, 0x7f, 0x6a, 0xd2, 0x83, 0x58, 0x2a,
-	0x29, 0xa7, 0xa6, 0x0e, 0x20, 0x86, 0x4e, 0x21, 0x0b, 0x90, 0xe8, 0xa9, 0xce, 0x71, 0xdf, 0xa0,
-	0xae, 0x9b, 0xa8, 0x5c, 0x0b, 0x99, 0x78, 0xfb, 0xb8, 0x6f, 0x50, 0xdf, 0xbd, 0x4f, 0x7d, 0x17,
-	0xef, 0x21, 0x40, 0xee, 0x41, 0xbc, 0xa7, 0xbd, 0x6b, 0xd9, 0xd4, 0x7d, 0xa3, 0x86, 0x61, 0xa3,
-	0x6f, 0x18, 0x02, 0x53, 0x3f, 0x90, 0x00, 0x3c, 0xa7, 0x61, 0xdc, 0xb4, 0x9f, 0x17, 0x37, 0xcb,
-	0x81, 0xb8, 0x69, 0x63, 0xdc, 0xdc, 0x84, 0x44, 0x5b, 0xed, 0x5b, 0xb6, 0x43, 0x65, 0x67, 0x69,
-	0x7b, 0x29, 0x9a, 0xff, 0x58, 0x52, 0xe2, 0xed, 0x2d, 0xcb, 0x76, 0xc8, 0x4d, 0x48, 0xb7, 0xed,
-	0x9e, 0x2f, 0x76, 0x32, 0x0a, 0xb4, 0xed, 0x9e, 0x2b, 0xf6, 0x2d, 0xc8, 0xe9, 0x46, 0xcb, 0xd2,
-	0x0d, 0x3d, 0x10, 0x18, 0x37, 0xa6, 0xd9, 0x52, 0x9a, 0x76, 0x97, 0xd2, 0x74, 0x93, 0x2e, 0x34,
-	0x65, 0x82, 0xf7, 0x77, 0x19, 0x5e, 0x04, 0x68, 0x1f, 0x74, 0xbb, 0x6a, 0x5b, 0x6d, 0x99, 0x0e,
-	0x75, 0x7f, 0x56, 0x49, 0x21, 0xb2, 0x5c, 0x37, 0x9d, 0xa9, 0x1f, 0x46, 0x21, 0x86, 0xaa, 0x93,
-	0x7f, 0x8b, 0x40, 0x4a, 0x37, 0x0e, 0x55, 0x4d, 0xe7, 0x73, 0xcc, 0xd4, 0xfe, 0x26, 0xf2, 0x41,
-	0xb5, 0xf0, 0x00, 0xa6, 0x2a, 0x0b, 0xb3, 0xb3, 0xd5, 0x5a, 0x7d, 0x69, 0xea, 0x37, 0x23, 0x52,
-	0xf2, 0x77, 0x22, 0x09, 0x4c, 0x07, 0xe6, 0x2e, 0x5d, 0x05, 0x7b, 0xb1, 0xbe, 0x74, 0x7a, 0x52,
-	0x78, 0x5f, 0x82, 0x37, 0x77, 0xad, 0x69, 0x67, 0xcf, 0x70, 0x68, 0xd2, 0x98, 0x36, 0x0d, 0xe7,
-	0x99, 0x65, 0xef, 0xcf, 0xf8, 0x57, 0xf3, 0xe1, 0xdc, 0x4c, 0x7f, 0x7f, 0x77, 0x06, 0x7d, 0x38,
-	0x98, 0x5e, 0xd7, 0xec, 0xc1, 0x9e, 0xd6, 0x5d, 0x69, 0xbc, 0x53, 0x3b, 0x76, 0x8c, 0x01, 0xb9,
-	0x34, 0xc1, 0x23, 0xb3, 0xc7, 0x28, 0xe6, 0x29, 0xc1, 0x87, 0x27, 0x85, 0xef, 0x48, 0xc5, 0x8d,
-	0x8b, 0xb1, 0xb4, 0x7a, 0xfa, 0x8c, 0xe3, 0x98, 0x77, 0xbb, 0xcf, 0xee, 0xb6, 0xba, 0x9d, 0x99,
-	0xd6, 0xc1, 0xc0, 0xb1, 0x7a, 0x34, 0x13, 0x4f, 0x6f, 0x18, 0xcf, 0x18, 0xe1, 0x72, 0x57, 0xdb,
-	0x9d, 0xfa, 0xd1, 0xf9, 0xde, 0x36, 0x9c, 0xc6, 0x91, 0xd6, 0x72, 0x28, 0xa7, 0x92, 0xd4, 0x8d,
-	0xc3, 0xaa, 0xae, 0xdb, 0x18, 0xcd, 0x6d, 0xb5, 0xe5, 0xd8, 0x5d, 0x1a, 0x1a, 0xe9, 0x70, 0x58,
-	0x2e, 0xd7, 0x1d, 0xbb, 0x2b, 0x44, 0x54, 0xbc, 0x8d, 0x00, 0x79, 0x09, 0xe3, 0x10, 0x3d, 0x19,
-	0xa5, 0x11, 0x85, 0xc9, 0xa8, 0x14, 0xcb, 0x7f, 0xfc, 0x71, 0x54, 0x89, 0xb5, 0xeb, 0xa6, 0x43,
-	0x5e, 0x42, 0x5e, 0xab, 0xef, 0x0c, 0x68, 0x9c, 0x64, 0x6a, 0xc9, 0x8f, 0x6a, 0xb1, 0x6f, 0x44,
-	0xf2, 0x39, 0x25, 0xde, 0xde, 0xec, 0x3b, 0x83, 0xa9, 0x5f, 0x92, 0x20, 0x4e, 0xa9, 0x89, 0x0c,
-	0x51, 0x8d, 0xfb, 0x3a, 0xa5, 0xe0, 0x4f, 0xf2, 0x12, 0xa4, 0x35, 0xdd, 0x56, 0xb5, 0xd6, 0x3e,
-	0x2e, 0x6f, 0xaa, 0x58, 0x4a, 0x19, 0xd7, 0x74, 0xbb, 0xda, 0xda, 0x57, 0x8c, 0xf7, 0xe8, 0x88,
-	0xd6, 0x3e, 0x95, 0x8c, 0x23, 0x5a, 0xfb, 0xe4, 0x05, 0x18, 0x6f, 0xab, 0x7d, 0xc3, 0xd4, 0x3b,
-	0xe6, 0x2e, 0x15, 0x98, 0x52, 0x52, 0xed, 0x2d, 0xf6, 0x4c, 0x6e, 0x40, 0xb2, 0xd5, 0xd5, 0x06,
-	0x03, 0x75, 0x87, 0x86, 0x5d, 0x4a, 0x49, 0xd0, 0xc7, 0xda, 0xd4, 0x7f, 0xa4, 0x80, 0x84, 0xf3,
-	0x18, 0xf9, 0xef, 0x08, 0xa4, 0x68, 0x6e, 0x31, 0x0e, 0x3a, 0x3c, 0x04, 0xff, 0x2e, 0xf2, 0x41,
-	0xf5, 0xe5, 0x07, 0x64, 0xea, 0xfe, 0x6c, 0x6d, 0x6e, 0xe9, 0xde, 0xfd, 0xc6, 0xd2, 0xec, 0xd9,
-	0xa1, 0x98, 0xfa, 0x09, 0x08, 0xc5, 0xc5, 0x4f, 0x3b, 0x14, 0x17, 0x3f, 0xc3, 0x50, 0x44, 0x5b,
-	0x37, 0x0e, 0x3a, 0xe4, 0x3f, 0x23, 0x80, 0x61, 0x49, 0xcd, 0x1e, 0xf9, 0xdc, 0xec, 0x9f, 0xb1,
-	0xd9, 0x13, 0xba, 0x71, 0x88, 0x56, 0xff, 0xd7, 0x08, 0x8c, 0xa3, 0xd5, 0x4d, 0xcb, 0x6c, 0x19,
-	0x2c, 0xf3, 0xd7, 0xfe, 0x2a, 0xf2, 0x41, 0xf5, 0xda, 0x83, 0xc4, 0xd4, 0x59, 0xb6, 0x8e, 0xfc,
-	0x04, 0xd8, 0xba, 0xf2, 0x69, 0xdb, 0xba, 0xf2, 0xd9, 0xd9, 0x1a, 0x77, 0xb4, 0x0d, 0xb4, 0xef,
-	0xd4, 0x3f, 0x8c, 0xc3, 0xd5, 0x51, 0xb5, 0x08, 0x59, 0x83, 0x34, 0xaf, 0x68, 0x84, 0xd2, 0xe2,
-	0xe5, 0xe7, 0x96, 0x31, 0x81, 0x32, 0x03, 0xd8, 0x78, 0x5a, 0x6b, 0xfc, 0x4b, 0x04, 0x12, 0xa6,
-	0xe1, 0xa8, 0x1d, 0x9d, 0xaf, 0xa4, 0xbf, 0x8e, 0x7c, 0x50, 0xbd, 0xf1, 0x20, 0x35, 0x35, 0x3b,
-	0x3b, 0x3b, 0x5b, 0x9e, 0x1b, 0xe5, 0xd3, 0xe8, 0x4f, 0x80, 0x4f, 0xe7, 0x3e, 0x6d, 0x9f, 0xce,
-	0x7d, 0x76, 0x3e, 0x8d, 0x9b, 0x86, 0xb3, 0x1a, 0xd8, 0x2c, 0xa2, 0x9f, 0x67, 0xad, 0x1f, 0xc7,
-	0x66, 0x11, 0xfb, 0xdc, 0xec, 0xff, 0x4f, 0x9b, 0xc5, 0x17, 0x80, 0x67, 0x19, 0xa1, 0x88, 0x1f,
-	0x67, 0x08, 0x56, 0xf1, 0x7f, 0x08, 0x70, 0x25, 0x74, 0x82, 0x23, 0x2f, 0xc2, 0xb8, 0x61, 0xb6,
-	0xec, 0xe3, 0xbe, 0x63, 0xe8, 0xac, 0x9e, 0x52, 0x3c, 0x80, 0xfc, 0x7b, 0x04, 0x80, 0x32, 0xb2,
-	0x0d, 0xc8, 0x9f, 0xae, 0xd0, 0x7f, 0x8d, 0xe5, 0xcf, 0xd3, 0xd5, 0x8f, 0xee, 0xc1, 0x71, 0xb4,
-	0x31, 0xdd, 0x83, 0xc4, 0xcd, 0x21, 0xfa, 0xf9, 0xe6, 0xf0, 0x59, 0x6e, 0x0e, 0xbe, 0xc3, 0x6c,
-	0xec, 0xf3, 0xc3, 0xec, 0x67, 0x7d, 0x98, 0x6d, 0x40, 0x5a, 0xef, 0xaa, 0x03, 0xc3, 0x71, 0x90,
-	0x99, 0xdf, 0x31, 0x85, 0xae, 0xc0, 0x96, 0xd6, 0x9a, 0xbc, 0x87, 0x70, 0xac, 0x05, 0xbd, 0xeb,
-	0xa2, 0xe4, 0xcb, 0x90, 0xb2, 0x8f, 0x54, 0xdd, 0xe8, 0x6a, 0xc7, 0xf4, 0x3e, 0x69, 0xa2, 0x72,
-	0x23, 0x54, 0x88, 0x1d, 0x2d, 0x61, 0xb3, 0x50, 0x7e, 0x25, 0x6d, 0x06, 0x91, 0x19, 0x48, 0xb6,
-	0xda, 0x6a, 0xb7, 0x33, 0x70, 0xf2, 0x49, 0xaa, 0xc0, 0xf5, 0xe0, 0xe0, 0xfa, 0xf2, 0x5a, 0x67,
-	0xe0, 0x28, 0x89, 0x56, 0x1b, 0xff, 0x9d, 0xfa, 0x13, 0x09, 0xc0, 0xd3, 0x89, 0xac, 0x41, 0xd6,
-	0x3e, 0x2a, 0xab, 0xba, 0xad, 0x5a, 0xed, 0xf6, 0xc0, 0x70, 0x78, 0x2d, 0xf8, 0x52, 0x68, 0x1a,
-	0x9a, 0xa3, 0x29, 0x9a, 0x63, 0x6c, 0xd2, 0x5e, 0x82, 0x26, 0x69, 0xfb, 0xa8, 0xbc, 0x64, 0x33,
-	0x98, 0x7c, 0x05, 0x12, 0xf6, 0x51, 0x45, 0xd5, 0xdd, 0x6b, 0xa7, 0x2f, 0x9c, 0x45, 0xb3, 0x6a,
-	0xea, 0xc6, 0x91, 0x78, 0xfd, 0x64, 0x1f, 0x55, 0x96, 0x6c, 0x3c, 0x3c, 0x5b, 0x7d, 0x47, 0x35,
-	0x8d, 0x5d, 0x7e, 0xde, 0x4e, 0x58, 0x7d, 0x67, 0xc3, 0xd8, 0x7d, 0x3d, 0xf5, 0xe1, 0x49, 0x21,
-	0x96, 0x92, 0x64, 0x69, 0xea, 0x17, 0x21, 0xc1, 0x66, 0x44, 0x16, 0x21, 0x26, 0x54, 0xaf, 0xc5,
-	0xd1, 0xf3, 0x0e, 0x94, 0xad, 0x74, 0x04, 0x21, 0x10, 0x6b, 0xb3, 0xb3, 0x7e, 0xf4, 0x4e, 0x56,
-	0xa1, 0xbf, 0x49, 0x01, 0x52, 0xad, 0x3d, 0xb5, 0xa7, 0x0d, 0xf6, 0x07, 0xf9, 0xe8, 0xad, 0xe8,
-	0x9d, 0x94, 0x92, 0x6c, 0xed, 0xad, 0xe3, 0xa3, 0x20, 0xfc, 0x7d, 0x09, 0x32, 0x6b, 0x96, 0xa2,
-	0xb9, 0xd3, 0xc0, 0xdd, 0x66, 0x47, 0x33, 0xf5, 0x67, 0x1d, 0xdd, 0xd9, 0xa3, 0x8a, 0x64, 0x15,
-	0x0f, 0x20, 0x5f, 0x02, 0x79, 0xd0, 0xb7, 0x0d, 0x4d, 0xef, 0x98, 0xbb, 0x6a, 0x5b, 0x6b, 0x39,
-	0xfc, 0x3e, 0x2e, 0xab, 0xe4, 0x86, 0xf8, 0x32, 0x85, 0xc9, 0x4d, 0x48, 0xb7, 0x2c, 0xda, 0xcf,
-	0xd6, 0x1c, 0x76, 0x32, 0x1a, 0x57, 0x80, 0x41, 0x28, 0x69, 0xa8, 0xc4, 0xd8, 0x54, 0x05, 0xd2,
-	0xcb, 0xcd, 0x87, 0x43, 0x15, 0x0a, 0x90, 0xda, 0xe9, 0x38, 0x6c, 0x18, 0xd3, 0x20, 0xb9, 0xd3,
-	0x71, 0x02, 0x63, 0x7e, 0x4b, 0x82, 0x89, 0x35, 0x65, 0x79, 0xa5, 0xd9, 0x1c, 0x8e, 0xfb, 0x22,
-	0xe4, 0x7a, 0x96, 0x7e, 0xd0, 0xa5, 0x97, 0xc6, 0xde, 0x39, 0x20, 0xab, 0x4c, 0x78, 0x30, 0x2d,
-	0xef, 0x17, 0xe0, 0x86, 0xd5, 0x37, 0x6c, 0x0d, 0x03, 0x46, 0x6d, 0xed, 0x69, 0xa6, 0x69, 0x74,
-	0x55, 0x36, 0x63, 0x36, 0x99, 0x6b, 0xc3, 0xe6, 0x3a, 0x6b, 0x7d, 0x42, 0x67, 0x7f, 0x89, 0x29,
-	0xfd, 0x85, 0x04, 0xa9, 0xa1, 0x62, 0x15, 0x88, 0xa1, 0x1b, 0xf9, 0x9d, 0xe3, 0x8b, 0x41, 0xbf,
-	0x8a, 0xf6, 0x5f, 0x19, 0x53, 0x68, 0x5f, 0x32, 0x03, 0xd1, 0xf6, 0x60, 0x9f, 0xdf, 0x2a, 0xbd,
-	0x10, 0xba, 0x55, 0xf2, 0xcc, 0xb5, 0x32, 0xa6, 0x60, 0x4f, 0xb2, 0x08, 0x89, 0xae, 0xdd, 0xde,
-	0x1b, 0x0c, 0xf8, 0xd5, 0x75, 0x28, 0xe0, 0xfd, 0xd6, 0x5a, 0x19, 0x53, 0x78, 0x7f, 0x4f, 0xeb,
-	0xda, 0x15, 0x00, 0xcf, 0x54, 0xf4, 0xf2, 0x76, 0xea, 0x87, 0x51, 0x80, 0xed, 0xa3, 0xe1, 0xea,
-	0x7a, 0x13, 0xc6, 0x75, 0xcd, 0xd1, 0x3c, 0xe7, 0xa4, 0x2b, 0xf9, 0xb3, 0x96, 0x84, 0x90, 0x1e,
-	0x52, 0xba, 0x10, 0x5f, 0x6d, 0x7a, 0xe9, 0x6c, 0xb6, 0x8e, 0x69, 0x4e, 0x8f, 0x29, 0x1e, 0x80,
-	0x05, 0x92, 0x61, 0x6a, 0x3b, 0x5d, 0x43, 0x6d, 0xd9, 0x2d, 0x7e, 0xdd, 0x34, 0xce, 0x90, 0xba,
-	0xdd, 0xc2, 0xc1, 0xc3, 0x37, 0x0d, 0x34, 0xb5, 0x64, 0x15, 0x0f, 0x20, 0xd3, 0x10, 0xc3, 0x07,
-	0x9e, 0x36, 0x8a, 0xa1, 0x9b, 0xd5, 0x6d, 0xb7, 0xa7, 0x42, 0xfb, 0x91, 0x37, 0x21, 0xa5, 0x5b,
-	0xcf, 0xcc, 0x6e, 0xc7, 0xdc, 0xcf, 0xa7, 0xe8, 0x98, 0xdb, 0xc1, 0xa9, 0x78, 0x33, 0x9f, 0x5e,
-	0xe2, 0x5d, 0x95, 0xe1, 0x20, 0x72, 0x0f, 0xae, 0xb7, 0xb0, 0x24, 0x30, 0x1d, 0x5b, 0x73, 0x2c,
-	0x5b, 0xf5, 0x74, 0x1b, 0xbf, 0x25, 0xdd, 0x89, 0x2a, 0xd7, 0xc4, 0xd6, 0xa1, 0xf4, 0xe2, 0xaf,
-	0x60, 0x6c, 0xb8, 0x1c, 0xb7, 0x21, 0xab, 0x99, 0x8e, 0x61, 0x9a, 0x9a, 0xda, 0xc1, 0x14, 0xc2,
-	0x43, 0x36, 0xc3, 0x41, 0x9a, 0x56, 0x70, 0x45, 0x38, 0x47, 0x6a, 0xdf, 0x7a, 0x66, 0xb0, 0xe5,
-	0x16, 0x51, 0x92, 0xce, 0xd1, 0x16, 0x3e, 0x92, 0x19, 0x98, 0xec, 0x98, 0x87, 0x86, 0xed, 0xa8,
-	0x7d, 0xab, 0xab, 0xd9, 0x9d, 0x6f, 0x50, 0xdf, 0xf1, 0x64, 0x43, 0x58, 0xd3, 0x96, 0xd0, 0xe2,
-	0x79, 0xdb, 0xfb, 0xf5, 0x20, 0x96, 0x8a, 0xc8, 0xd1, 0x07, 0xb1, 0x54, 0x54, 0x8e, 0x4d, 0xfd,
-	0xba, 0x04, 0x85, 0xb7, 0x35, 0xc7, 0x78, 0xa6, 0x1d, 0x57, 0xb9, 0x0e, 0xde, 0x9b, 0x1b, 0xb2,
-	0x0e, 0xe9, 0x5d, 0xd6, 0xa8, 0x76, 0xf4, 0x01, 0x8f, 0x80, 0xd0, 0xdb, 0x0d, 0x3e, 0x5e, 0x18,
-	0x28, 0x6e, 0x15, 0xbb, 0x6e, 0xeb, 0x20, 0x3c, 0xfb, 0x48, 0x78, 0xf6, 0x53, 0x7f, 0x20, 0x41,
-	0xa1, 0x4e, 0xaf, 0x1c, 0xeb, 0x61, 0xe2, 0x1f, 0x87, 0x46, 0x98, 0x08, 0x76, 0x6d, 0xeb, 0xa0,
-	0xcf, 0xbb, 0xd0, 0x3b, 0x5c, 0x05, 0x28, 0xc4, 0x54, 0xfe, 0x76, 0x04, 0xd2, 0x8f, 0xfa, 0xe8,
-	0xe0, 0x6d, 0x6b, 0xdf, 0x30, 0x49, 0x03, 0xa2, 0x9e, 0x72, 0x5f, 0x3a, 0x43, 0xb9, 0xb0, 0xb9,
-	0x05, 0x1d, 0x71, 0xbc, 0x3f, 0xfe, 0x23, 0xc1, 0xf8, 0xff, 0x19, 0x48, 0x0f, 0x0c, 0xfb, 0xd0,
-	0x60, 0x81, 0x38, 0x7c, 0x83, 0x75, 0xf6, 0x32, 0x00, 0xd6, 0x1d, 0x01, 0xf2, 0x2a, 0x5c, 0x09,
-	0xc5, 0x32, 0x5d, 0x9f, 0x51, 0x45, 0x0e, 0x86, 0x31, 0x79, 0x03, 0x32, 0xae, 0xcd, 0x69, 0xbf,
-	0xf8, 0xb9, 0xa2, 0x5c, 0x1f, 0x21, 0x32, 0xf5, 0xcb, 0x12, 0x64, 0xdc, 0x05, 0xb0, 0xa5, 0x39,
-	0x7b, 0xe4, 0x36, 0x64, 0x0e, 0xa8, 0xb5, 0x54, 0x07, 0xcd, 0xc5, 0x4e, 0x39, 0x2b, 0x63, 0x4a,
-	0xfa, 0x40, 0xb0, 0x61, 0x15, 0xe2, 0xed, 0xce, 0x91, 0xa1, 0xf3, 0x9c, 0x78, 0x71, 0x2b, 0xae,
-	0x8c, 0x29, 0x6c, 0x64, 0x2d, 0x0d, 0xb1, 0x3e, 0xca, 0xa3, 0x99, 0xed, 0x9b, 0x71, 0x18, 0xdf,
-	0x3e, 0xe2, 0x97, 0x41, 0xe4, 0x55, 0x88, 0xd3, 0x6b, 0xed, 0xb3, 0xde, 0x4a, 0xd1, 0x00, 0x54,
-	0x58, 0x1f, 0x52, 0x87, 0x09, 0x37, 0x09, 0xa8, 0x48, 0x38, 0xa0, 0x1b, 0xef, 0x88, 0xd4, 0x2e,
-	0xce, 0x52, 0xc9, 0xea, 0xc2, 0xd3, 0x80, 0x7c, 0x05, 0xc6, 0x69, 0xa1, 0x42, 0xeb, 0xa4, 0xe8,
-	0x45, 0xeb, 0xa4, 0x14, 0x56, 0x27, 0xb4, 0x50, 0xfa, 0x32, 0x2f, 0x74, 0x86, 0xe9, 0x38, 0xf3,
-	0xfc, 0x74, 0xcc, 0x0a, 0x1b, 0x37, 0x0f, 0xdf, 0x66, 0xa3, 0xbd, 0x5c, 0x1c, 0xa7, 0xb9, 0x38,
-	0x63, 0x1f, 0x95, 0x97, 0x87, 0xe9, 0x98, 0x8a, 0xa8, 0x08, 0x22, 0xb2, 0xe7, 0x8b, 0xa8, 0xf8,
-	0x45, 0x54, 0x04, 0x11, 0x49, 0x57, 0x44, 0xc5, 0x13, 0xb1, 0x02, 0xa9, 0xbe, 0xdd, 0xb1, 0xec,
-	0x8e, 0x73, 0x4c, 0x93, 0xf0, 0x44, 0x78, 0xed, 0x6e, 0x1f, 0x35, 0x5b, 0x7b, 0x86, 0x7e, 0xd0,
-	0x35, 0xb6, 0x78, 0x4f, 0xd1, 0x1e, 0xee, 0x68, 0xf2, 0x26, 0x64, 0xb5, 0x9d, 0x81, 0xd5, 0x3d,
-	0x70, 0x0c, 0x16, 0x95, 0xe3, 0xe7, 0x46, 0x65, 0xc6, 0x1d, 0x40, 0xa3, 0x7a, 0x0e, 0xae, 0x0c,
-	0x75, 0x55, 0xfb, 0x5d, 0xcd, 0xc4, 0x23, 0x1e, 0xe0, 0x26, 0x4f, 0x5f, 0xbf, 0xd8, 0x91, 0xfc,
-	0x5b, 0x4a, 0x6e, 0xd8, 0x63, 0xab, 0xab, 0x99, 0xab, 0x3a, 0x99, 0x83, 0x94, 0xa6, 0x1f, 0x6a,
-	0x66, 0xcb, 0xd0, 0xf3, 0xad, 0xe7, 0xbf, 0xd2, 0x1b, 0x76, 0x7c, 0x10, 0x4b, 0xc5, 0xe4, 0xf8,
-	0x83, 0x58, 0x2a, 0x21, 0x27, 0x1f, 0xc4, 0x52, 0x69, 0x39, 0x33, 0xf5, 0xe7, 0x73, 0xf4, 0x35,
-	0x65, 0xdd, 0xea, 0xf5, 0x34, 0x53, 0x27, 0x35, 0x88, 0xb6, 0x3a, 0x3a, 0x8f, 0xc5, 0x57, 0x46,
-	0xbc, 0x84, 0xe6, 0x1d, 0xbd, 0x28, 0xaf, 0xc1, 0x47, 0xb5, 0xe4, 0xfb, 0x52, 0x4c, 0x96, 0x6e,
-	0x8d, 0x29, 0x38, 0x98, 0xbc, 0x0c, 0x69, 0x5b, 0x7b, 0x36, 0x7c, 0xdf, 0x18, 0xe1, 0x6b, 0x0a,
-	0x6c, 0xed, 0x99, 0x7b, 0xb5, 0x50, 0x83, 0x71, 0xdb, 0x18, 0xe0, 0x59, 0xd6, 0x74, 0xdf, 0x78,
-	0xdf, 0x3e, 0x5b, 0xd8, 0xb4, 0x82, 0x7d, 0x57, 0x4d, 0x7d, 0x65, 0x4c, 0x49, 0xd9, 0xfc, 0x37,
-	0x69, 0x00, 0x30, 0x8e, 0x96, 0x65, 0xb6, 0xf9, 0x5b, 0xcd, 0x57, 0xce, 0x23, 0xa9, 0x5b, 0x66,
-	0x7b, 0x65, 0x4c, 0x61, 0xd2, 0xf1, 0x81, 0x6c, 0xc2, 0x04, 0x5d, 0x4e, 0xad, 0x3d, 0xa3, 0xb5,
-	0xaf, 0x6a, 0xa6, 0x7b, 0xfc, 0xf8, 0xe2, 0x73, 0xa8, 0xd6, 0x3a, 0xe6, 0x7e, 0x1d, 0xfb, 0x57,
-	0x4d, 0x5c, 0xe4, 0x99, 0xae, 0xf0, 0x4c, 0x56, 0x81, 0x3e, 0xab, 0x9a, 0x6e, 0xd3, 0xd7, 0x60,
-	0xec, 0xcd, 0xf6, 0x4f, 0x9d, 0x43, 0x57, 0x5d, 0x52, 0x14, 0xe3, 0x3d, 0x34, 0x13, 0x0e, 0xae,
-	0xea, 0xb6, 0x62, 0xbc, 0xe7, 0xa3, 0x42, 0xcd, 0x92, 0x17, 0xa5, 0x62, 0x7a, 0xb9, 0x54, 0xa8,
-	0xd5, 0x26, 0x4c, 0xe8, 0x07, 0xce, 0xb1, 0xda, 0x3a, 0x6e, 0x75, 0x0d, 0xaa, 0x57, 0xea, 0xdc,
-	0x69, 0x2e, 0x1d, 0x38, 0xc7, 0x75, 0xec, 0xcf, 0x34, 0xcb, 0xe8, 0xc2, 0x33, 0x79, 0x0a, 0xc4,
-	0x3e, 0x52, 0xfb, 0x9a, 0xad, 0xf5, 0xf0, 0xe4, 0x76, 0xd0, 0xa7, 0xa4, 0x2c, 0xf4, 0x4b, 0xcf,
-	0x73, 0xc3, 0xd1, 0x16, 0x8e, 0x69, 0xe2, 0x10, 0xc6, 0x9b, 0xb3, 0xfd, 0xd0, 0x08, 0x6a, 0x9c,
-	0x3c, 0x5c, 0x8a, 0x9a, 0x59, 0xc0, 0x47, 0xed, 0x9a, 0xc1, 0x38, 0x54, 0x07, 0x8e, 0xe6, 0x1c,
-	0x0c, 0x28, 0x6d, 0xfa, 0x7c, 0x33, 0x18, 0x87, 0x4d, 0xda, 0x9f, 0x7b, 0x5b, 0x17, 0x9e, 0x89,
-	0x02, 0x39, 0xd3, 0x78, 0x36, 0x2c, 0xe6, 0xd1, 0x06, 0x2c, 0x1d, 0xde, 0x79, 0x0e, 0xe3, 0x86,
-	0xf1, 0x8c, 0xd7, 0xf7, 0xcc, 0x02, 0x59, 0x53, 0x04, 0x82, 0x9c, 0xa8, 0x65, 0xf6, 0x12, 0x9c,
-	0x4c, 0x4d, 0x81, 0xd3, 0x9d, 0x78, 0xd7, 0xa7, 0xe6, 0xc4, 0xf9, 0x13, 0x5f, 0xf3, 0x69, 0x99,
-	0xd1, 0xbb, 0x82, 0x92, 0x7e, 0x42, 0xd4, 0x31, 0x77, 0x71, 0x42, 0xd7, 0x92, 0x5d, 0x41, 0xc3,
-	0xaf, 0xc3, 0xa4, 0x7d, 0x84, 0x09, 0x14, 0xcf, 0x39, 0x5e, 0x44, 0xc9, 0x94, 0xf5, 0xd5, 0xe7,
-	0xba, 0x7d, 0x9b, 0x0e, 0x12, 0x42, 0x4a, 0xb6, 0x03, 0x18, 0xc6, 0x94, 0x13, 0x0e, 0xd7, 0x2b,
-	0xe7, 0xc6, 0xd4, 0x76, 0x38, 0x5c, 0x9d, 0x40, 0xb8, 0xd2, 0x64, 0xb6, 0x6f, 0x1c, 0xd3, 0x64,
-	0x46, 0x2e, 0x90, 0xcc, 0xf6, 0x8d, 0xe3, 0x61, 0x32, 0x63, 0xbf, 0x59, 0x32, 0x43, 0x0e, 0x9a,
-	0xcc, 0x26, 0x2f, 0x90, 0xcc, 0xf6, 0x8d, 0x63, 0x2f, 0x99, 0xf1, 0x07, 0xb4, 0x21, 0xe6, 0x8a,
-	0xe0, 0x34, 0xaf, 0x9e, 0x6b, 0xc3, 0xea, 0x92, 0x12, 0x9c, 0xa7, 0xac, 0xe9, 0xb6, 0x7f, 0xa2,
-	0x0a, 0xe4, 0x74, 0xe3, 0xb0, 0xd3, 0x62, 0xdb, 0x1c, 0xf5, 0xf9, 0xb5, 0x73, 0xe3, 0x72, 0x89,
-	0x8e, 0xc0, 0x7d, 0x8e, 0xc7, 0xa5, 0x2e, 0x02, 0xe4, 0x11, 0xc8, 0x6d, 0xcb, 0x6e, 0x61, 0x4a,
-	0x72, 0xbf, 0x0b, 0xca, 0x5f, 0x1f, 0x5d, 0x67, 0x09, 0xa4, 0xcb, 0x38, 0x64, 0xf8, 0x52, 0x6d,
-	0x65, 0x4c, 0x99, 0x68, 0xfb, 0x10, 0x62, 0x0c, 0x3f, 0x34, 0x0a, 0xda, 0xe2, 0x06, 0x25, 0x9f,
-	0x7e, 0xae, 0x6d, 0x71, 0x60, 0xd0, 0x1c, 0x93, 0x76, 0x18, 0x3e, 0x43, 0x0c, 0x1a, 0x26, 0x7f,
-	0x69, 0x31, 0xcc, 0x3c, 0x21, 0x31, 0x68, 0xa4, 0xa7, 0x40, 0xfa, 0x74, 0x55, 0x74, 0x2d, 0xdc,
-	0x32, 0xdb, 0x16, 0x9d, 0x49, 0xe1, 0xdc, 0xe0, 0xdd, 0xc2, 0x15, 0xd0, 0xb5, 0x9c, 0x55, 0xb3,
-	0x6d, 0xf1, 0xe0, 0xed, 0xfb, 0x21, 0xb2, 0x03, 0xd7, 0x3c, 0x6a, 0x31, 0x3d, 0x14, 0x29, 0xfb,
-	0xdd, 0x0b, 0xb0, 0xfb, 0x92, 0x04, 0xe9, 0x87, 0xd0, 0xd1, 0x32, 0xd0, 0x48, 0x2f, 0x5c, 0x56,
-	0x06, 0xb3, 0x51, 0x50, 0x06, 0x9a, 0xe8, 0x1d, 0xb8, 0xb2, 0x63, 0x68, 0x2d, 0xcb, 0x74, 0x33,
-	0x08, 0xf2, 0xbf, 0x78, 0xae, 0x85, 0x6a, 0x74, 0x0c, 0xcb, 0x15, 0x7c, 0xcb, 0xd8, 0xf1, 0x43,
-	0x18, 0xf5, 0x9c, 0x19, 0x4b, 0x30, 0x6a, 0x9b, 0x2f, 0x9c, 0x1b, 0xf5, 0x8c, 0x17, 0xab, 0x4d,
-	0x9e, 0xe1, 0x77, 0x44, 0x20, 0xc8, 0x89, 0xba, 0xbe, 0x74, 0x09, 0x4e, 0xbe, 0x92, 0x76, 0x44,
-	0x40, 0x58, 0x9d, 0x3d, 0x4b, 0x37, 0x68, 0x32, 0xba, 0x79, 0xc1, 0xd5, 0xb9, 0x6e, 0xe9, 0x06,
-	0xcb, 0x48, 0x7c, 0x75, 0x72, 0x00, 0x57, 0xa7, 0xc8, 0x49, 0x93, 0xd3, 0xad, 0x73, 0x57, 0xa7,
-	0x47, 0xca, 0x33, 0xd4, 0x84, 0xee, 0x43, 0x8a, 0x0a, 0xa4, 0xdc, 0x92, 0x8e, 0x2c, 0x43, 0xb6,
-	0xd7, 0x31, 0x2d, 0x5b, 0x3d, 0x34, 0xec, 0x41, 0xc7, 0x32, 0xcf, 0xfc, 0x3a, 0x0f, 0x3b, 0x79,
-	0xc5, 0x66, 0x5e, 0x52, 0x32, 0x74, 0xdc, 0x63, 0x36, 0xac, 0xd8, 0x84, 0xf1, 0x61, 0x85, 0xf7,
-	0xa9, 0x91, 0xaa, 0x90, 0x11, 0x6b, 0x3d, 0x72, 0x0b, 0x12, 0x3d, 0xcd, 0xde, 0xed, 0x30, 0xc2,
-	0xe1, 0x07, 0x79, 0xff, 0x2b, 0x29, 0x1c, 0x27, 0x77, 0x21, 0xeb, 0x9e, 0x50, 0x5b, 0xd6, 0x81,
-	0x19, 0xfe, 0x72, 0xcf, 0x3d, 0xc0, 0xd6, 0xb1, 0xb5, 0xf8, 0xdb, 0x11, 0x00, 0xaf, 0xfc, 0x23,
-	0x9b, 0x90, 0x1b, 0x9e, 0x79, 0x84, 0x6b, 0x99, 0x4b, 0x5c, 0xff, 0x66, 0x75, 0xb1, 0x81, 0xdc,
-	0x85, 0x09, 0xf7, 0x02, 0x47, 0xbc, 0x56, 0xa0, 0xe7, 0x8a, 0x52, 0x24, 0x9f, 0x53, 0x32, 0xfc,
-	0x3e, 0x87, 0x75, 0x7f, 0x15, 0x32, 0xee, 0xfa, 0xec, 0x69, 0x83, 0x7d, 0x76, 0x7d, 0x4b, 0xd9,
-	0x3f, 0x90, 0x22, 0xb2, 0xac, 0xa4, 0x79, 0xeb, 0xba, 0x36, 0xd8, 0x27, 0xaf, 0xc1, 0x55, 0xb1,
-	0x33, 0x46, 0x87, 0x63, 0x5b, 0x5d, 0xf6, 0x7a, 0xd1, 0x95, 0x90, 0x54, 0x88, 0x30, 0xa6, 0xce,
-	0xba, 0x90, 0x29, 0x48, 0x99, 0x3b, 0xaa, 0x63, 0x63, 0xe0, 0x27, 0xfc, 0x0a, 0x25, 0xcd, 0x9d,
-	0x6d, 0xc4, 0xd9, 0x59, 0xa5, 0xf8, 0x81, 0x34, 0x34, 0x10, 0x3a, 0xe0, 0x0e, 0xc8, 0x3e, 0x99,
-	0x5a, 0x6b, 0x9f, 0x7f, 0x81, 0x36, 0x21, 0x88, 0xa9, 0xb6, 0xf6, 0xc9, 0x5d, 0x98, 0x0c, 0x98,
-	0x92, 0x76, 0x66, 0x1f, 0xa5, 0xc9, 0x3e, 0x2b, 0x61, 0xf7, 0x57, 0x59, 0x7d, 0xe0, 0x19, 0x4a,
-	0xf5, 0x3e, 0x55, 0xcb, 0x89, 0x36, 0xaa, 0xb6, 0xf6, 0x8b, 0x2d, 0xc8, 0x88, 0xb5, 0x31, 0x69,
-	0xc2, 0x44, 0x4f, 0x3b, 0x52, 0xbd, 0x02, 0x9b, 0x7b, 0x2d, 0x54, 0x06, 0x54, 0x77, 0x77, 0x6d,
-	0x03, 0x03, 0x40, 0x1f, 0x8e, 0x17, 0x7c, 0x97, 0xe9, 0x69, 0x47, 0x43, 0xbc, 0xf8, 0x5f, 0x12,
-	0xe4, 0x02, 0xc5, 0x32, 0x79, 0x8c, 0x35, 0x92, 0x70, 0x2e, 0xfe, 0x64, 0x31, 0x22, 0x0b, 0x87,
-	0x65, 0xe6, 0xf7, 0xa7, 0x70, 0xd5, 0x77, 0xa4, 0x77, 0x5f, 0x61, 0x44, 0x2e, 0xf9, 0x0a, 0xe3,
-	0x8a, 0x70, 0xd2, 0xe7, 0x2f, 0x32, 0xa6, 0x83, 0x87, 0x71, 0xb4, 0x69, 0x8c, 0x7e, 0x78, 0x58,
-	0x89, 0xdd, 0xf9, 0xce, 0xaf, 0x25, 0xfc, 0xe7, 0xf2, 0xe2, 0xef, 0x07, 0xa6, 0x8d, 0x5e, 0x9f,
-	0x87, 0x1b, 0x23, 0xa6, 0x2d, 0x38, 0x7f, 0x32, 0x38, 0x23, 0x74, 0xe9, 0x02, 0xe4, 0x47, 0x4d,
-	0x4a, 0x08, 0x83, 0xab, 0x21, 0x75, 0x71, 0x5c, 0x09, 0xae, 0xf8, 0x34, 0x16, 0x23, 0x41, 0x54,
-	0x15, 0x23, 0xe1, 0xe7, 0x21, 0x23, 0x1e, 0x0f, 0xc8, 0x14, 0x24, 0x77, 0x34, 0xc7, 0x31, 0xec,
-	0x63, 0x7f, 0x86, 0xf8, 0x58, 0x52, 0xdc, 0x06, 0x52, 0x1a, 0x26, 0x11, 0xd4, 0x22, 0x5e, 0x23,
-	0x1f, 0xd5, 0x72, 0xc5, 0x6c, 0xfe, 0xe6, 0x9d, 0xef, 0x7f, 0xcc, 0xff, 0x1b, 0xa6, 0x93, 0xe2,
-	0xb7, 0x22, 0x90, 0xf5, 0x9d, 0x16, 0x30, 0xc1, 0xb8, 0x2b, 0x40, 0xb8, 0xb7, 0x15, 0x13, 0x0c,
-	0x6f, 0x66, 0x9e, 0xfd, 0x92, 0x78, 0xed, 0x1d, 0xa1, 0xa6, 0x4f, 0x7f, 0x54, 0x4b, 0x55, 0x12,
-	0xf9, 0x31, 0x6a, 0x7c, 0xe1, 0x0e, 0xfc, 0x31, 0x4c, 0xf6, 0x3a, 0x66, 0x28, 0xb8, 0xa2, 0x97,
-	0x0c, 0xae, 0x5e, 0xc7, 0xf4, 0x07, 0x17, 0xf2, 0xe2, 0xea, 0x08, 0xf0, 0xc6, 0x2e, 0xcb, 0xab,
-	0x1d, 0xf9, 0xda, 0x8a, 0xef, 0x88, 0xa6, 0x41, 0xe3, 0xdf, 0x86, 0xac, 0xdf, 0x69, 0x2c, 0x38,
-	0x32, 0x6d, 0xc1, 0x63, 0x64, 0x0a, 0xb2, 0x9e, 0x26, 0x5e, 0x28, 0xa4, 0xdd, 0x8c, 0x80, 0x5e,
-	0x6d, 0x43, 0x46, 0x3c, 0xfb, 0x5c, 0xd6, 0xe6, 0x5f, 0x0c, 0xdb, 0x5c, 0x08, 0x77, 0xaf, 0x0d,
-	0xb7, 0x17, 0xf1, 0x48, 0x84, 0x91, 0xe7, 0x93, 0x23, 0x4c, 0x22, 0x27, 0x4a, 0xc0, 0x79, 0x84,
-	0x26, 0x1b, 0x09, 0x4f, 0xb6, 0xf8, 0x10, 0xe4, 0xe0, 0xe9, 0x88, 0xdc, 0x87, 0x38, 0xbb, 0xfa,
-	0x93, 0x2e, 0x7a, 0xf5, 0xc7, 0xfa, 0x17, 0xbf, 0x27, 0x41, 0x2e, 0x70, 0x1c, 0x22, 0x0f, 0x58,
-	0xe6, 0x33, 0x3a, 0x76, 0xdf, 0x97, 0x8b, 0xc2, 0x2f, 0x6f, 0x69, 0x05, 0xd0, 0x58, 0x55, 0xb6,
-	0x02, 0x09, 0xaf, 0xd1, 0xb1, 0xd9, 0xdd, 0x35, 0xce, 0x9e, 0x5f, 0xc6, 0xea, 0xcf, 0x8c, 0x6e,
-	0x97, 0xdd, 0xa5, 0xb1, 0x59, 0xe5, 0x58, 0xc3, 0x12, 0xe2, 0xf4, 0xca, 0x6c, 0x1a, 0x26, 0x87,
-	0x17, 0xa1, 0x42, 0x6f, 0xb6, 0x4a, 0xaf, 0xb8, 0x4d, 0xc3, 0xfe, 0xc5, 0x2d, 0xac, 0x38, 0xf8,
-	0x59, 0x6b, 0xe9, 0x52, 0xc5, 0x81, 0xa8, 0xad, 0x58, 0x1a, 0x7c, 0x15, 0xeb, 0x0d, 0xf7, 0xdc,
-	0xf5, 0xe9, 0x50, 0xfe, 0xbd, 0x04, 0x72, 0xf0, 0x20, 0x46, 0x76, 0xe0, 0xba, 0xfb, 0x51, 0x75,
-	0xb7, 0xd3, 0xeb, 0x38, 0xaa, 0x71, 0xd4, 0xb7, 0x4c, 0xc3, 0x74, 0xce, 0xdc, 0x63, 0x96, 0x94,
-	0x6a, 0x6b, 0x7f, 0x0d, 0xfb, 0x36, 0x78, 0x57, 0x41, 0xe2, 0x24, 0xfb, 0x1c, 0xdb, 0xd7, 0x2c,
-	0xca, 0xa0, 0xae, 0xf6, 0x64, 0x44, 0x9e, 0x27, 0x83, 0xc6, 0xc9, 0xd9, 0x32, 0x7c, 0xcd, 0xc5,
-	0x55, 0xc8, 0xfa, 0x8e, 0x82, 0xf4, 0xa5, 0xf3, 0x85, 0xde, 0x9a, 0x51, 0xe6, 0xef, 0x4a, 0x91,
-	0x94, 0xc4, 0xde, 0x9f, 0x15, 0xbf, 0x1b, 0x81, 0x09, 0xff, 0x09, 0xf0, 0x53, 0xfe, 0x0c, 0x73,
-	0x44, 0x19, 0x16, 0xf9, 0x91, 0xca, 0xb0, 0x3b, 0x90, 0xc6, 0x65, 0x62, 0x1b, 0xf4, 0x2f, 0xa1,
-	0xf8, 0xb7, 0xf7, 0xc3, 0x0a, 0x09, 0x7a, 0xda, 0x91, 0xc2, 0x9a, 0xc8, 0x13, 0xc8, 0xf5, 0x0d,
-	0xbb, 0x63, 0xe9, 0x9e, 0x0f, 0x62, 0xa3, 0x2f, 0x63, 0xf9, 0xf9, 0x91, 0x76, 0x1e, 0xe1, 0x84,
-	0x89, 0xbe, 0xaf, 0xa5, 0xf8, 0x97, 0x12, 0x4c, 0x8e, 0x38, 0xd9, 0x92, 0x9f, 0x05, 0x82, 0xaa,
-	0xd1, 0x62, 0xf5, 0xdc, 0xd8, 0x62, 0x04, 0xb4, 0x74, 0x1d, 0x21, 0x12, 0x53, 0xb4, 0xaf, 0x0d,
-	0x4f, 0x65, 0x48, 0x4e, 0xaf, 0x0b, 0x02, 0x31, 0x35, 0x35, 0x9a, 0x1b, 0xbd, 0x3e, 0x82, 0x3a,
-	0xd7, 0xd3, 0x8e, 0xc4, 0xa6, 0xe2, 0x4a, 0x78, 0x36, 0x18, 0x54, 0x65, 0xb8, 0x16, 0x12, 0x28,
-	0x64, 0x51, 0x12, 0xa0, 0xc1, 0x1c, 0xd9, 0x84, 0x5c, 0xe0, 0x9c, 0x4c, 0xde, 0x82, 0x04, 0xb3,
-	0xde, 0x59, 0xdf, 0x70, 0xb8, 0x03, 0x98, 0xf5, 0x05, 0x3d, 0xf9, 0xb8, 0xe2, 0x6f, 0x48, 0x40,
-	0xc2, 0xe7, 0x63, 0xff, 0x6e, 0x2c, 0x3d, 0x77, 0x37, 0xfe, 0xb4, 0x63, 0xb0, 0xb8, 0x17, 0xd2,
-	0xe8, 0xc2, 0x7b, 0xe6, 0xe5, 0x6a, 0xe9, 0xa2, 0x06, 0xb9, 0xc0, 0xb9, 0x9a, 0xdc, 0x14, 0x37,
-	0x1d, 0xdf, 0x9f, 0x9d, 0x30, 0x3c, 0xbc, 0xc5, 0x46, 0x9e, 0xb7, 0xc5, 0x16, 0x5f, 0x87, 0xac,
-	0xef, 0x88, 0x7d, 0x09, 0xcb, 0x16, 0xe7, 0xc5, 0xb1, 0x17, 0xb5, 0x41, 0x71, 0xd9, 0xcd, 0x5f,
-	0xee, 0xd9, 0xf8, 0xde, 0x45, 0x5e, 0xdc, 0x89, 0xbb, 0x28, 0xed, 0x5d, 0x7c, 0x1b, 0x26, 0xfc,
-	0xe7, 0xe3, 0x4f, 0x48, 0x54, 0x1b, 0x87, 0x24, 0x7f, 0xc5, 0x32, 0xd5, 0x80, 0xb4, 0x77, 0xfc,
-	0x1e, 0x90, 0x05, 0x48, 0xb5, 0xf8, 0xef, 0xbc, 0x44, 0xdf, 0x0f, 0x16, 0xcf, 0x3e, 0xad, 0x2b,
-	0xc3, 0xbe, 0x53, 0x75, 0x98, 0x18, 0x16, 0xb7, 0x8f, 0xb5, 0xee, 0x81, 0x81, 0x6e, 0x3b, 0xc4,
-	0x1f, 0xdc, 0xa2, 0x42, 0x15, 0xc3, 0xf0, 0xd7, 0xe5, 0xd3, 0x93, 0x42, 0x24, 0x2f, 0x7d, 0x78,
-	0x52, 0x48, 0xa4, 0x24, 0x3c, 0x40, 0x4f, 0xad, 0xc3, 0xf5, 0xaf, 0x19, 0xb6, 0xa5, 0xed, 0x74,
-	0x8d, 0x00, 0xd9, 0xcb, 0x7e, 0x32, 0x9f, 0x7b, 0xce, 0xa4, 0xdb, 0x85, 0x49, 0x7f, 0x85, 0xce,
-	0xb8, 0xbe, 0x22, 0x72, 0x5d, 0xe6, 0x84, 0x72, 0xa6, 0x20, 0x03, 0x88, 0x6f, 0x21, 0x31, 0x39,
-	0x6f, 0xf8, 0xe5, 0x5c, 0xfc, 0x2b, 0xac, 0xe7, 0xcc, 0xc7, 0x9f, 0x3c, 0x2e, 0x36, 0x9f, 0x33,
-	0x13, 0xce, 0x99, 0x82, 0xde, 0x83, 0xfc, 0x88, 0xd3, 0x26, 0x93, 0x56, 0xf7, 0x4b, 0xbb, 0xe4,
-	0x31, 0xf5, 0x4c, 0x91, 0x4f, 0x21, 0xc3, 0x8b, 0x47, 0x26, 0xe6, 0xbe, 0x5f, 0xcc, 0x45, 0x2a,
-	0xcd, 0xe7, 0xcd, 0x26, 0x5c, 0xd7, 0x5c, 0x70, 0x36, 0xcf, 0x2d, 0x88, 0xce, 0x17, 0xe9, 0xab,
-	0x63, 0x2e, 0x23, 0xf2, 0xac, 0xfa, 0xe8, 0x4c, 0x91, 0x2a, 0xe4, 0xbc, 0x3a, 0x99, 0x49, 0x7a,
-	0xdd, 0x2f, 0xe9, 0x62, 0x75, 0xf5, 0x59, 0x02, 0x4a, 0xbf, 0x2b, 0x41, 0x9c, 0xfe, 0x99, 0x2b,
-	0x91, 0x21, 0xf3, 0x60, 0x73, 0x75, 0x43, 0x55, 0x1a, 0x5f, 0x7d, 0xd4, 0x68, 0x6e, 0xcb, 0x63,
-	0x24, 0x07, 0x69, 0x8a, 0x54, 0xeb, 0xf5, 0xc6, 0xd6, 0xb6, 0x2c, 0x11, 0x02, 0x13, 0x8f, 0x36,
-	0xea, 0x9b, 0x1b, 0xcb, 0xab, 0xca, 0x7a, 0x63, 0x49, 0x7d, 0xb4, 0x25, 0x47, 0xc8, 0x55, 0x90,
-	0x45, 0x6c, 0x69, 0xf3, 0xc9, 0x86, 0x1c, 0x45, 0x32, 0x5f, 0xbf, 0x18, 0x8e, 0x0d, 0xf4, 0x8a,
-	0x23, 0xa6, 0x34, 0x7c, 0x42, 0x13, 0x28, 0x74, 0x4b, 0xd9, 0xdc, 0x52, 0x56, 0x1b, 0xdb, 0x55,
-	0xe5, 0xa9, 0x9c, 0x2c, 0x26, 0x98, 0xce, 0xa5, 0x3b, 0x10, 0xa7, 0x7f, 0x58, 0x4b, 0x26, 0x00,
-	0xd6, 0x36, 0x95, 0xea, 0x93, 0xea, 0x86, 0xaa, 0x94, 0xe5, 0xb1, 0x62, 0xee, 0xf4, 0xa4, 0x90,
-	0xce, 0x4b, 0xa5, 0x24, 0x47, 0x4b, 0xdf, 0x8b, 0xd0, 0x37, 0xd8, 0xbc, 0x88, 0x26, 0x2f, 0xd1,
-	0x5c, 0xa8, 0x3e, 0xda, 0x78, 0xb8, 0x81, 0x62, 0xc7, 0x8a, 0xd9, 0xd3, 0x93, 0xc2, 0x38, 0x49,
-	0x1e, 0x98, 0xfb, 0xa6, 0xf5, 0x0c, 0xdb, 0x53, 0xd8, 0xfe, 0xb8, 0xac, 0xce, 0xca, 0x52, 0x11,
-	0x4d, 0x94, 0x21, 0xd1, 0xf2, 0xf4, 0x2c, 0x89, 0x97, 0xa7, 0x67, 0xa7, 0x67, 0xc9, 0x0b, 0x94,
-	0x8d, 0xb6, 0xab, 0x65, 0x39, 0x52, 0x4c, 0x9f, 0x9e, 0x14, 0x92, 0xac, 0xb1, 0xec, 0x6b, 0xac,
-	0xc8, 0x51, 0xb1, 0xb1, 0x22, 0x30, 0x97, 0xe5, 0x98, 0xc7, 0x5c, 0xc6, 0xf6, 0x72, 0x80, 0x79,
-	0x4e, 0x8e, 0x8b, 0x83, 0xe7, 0x7c, 0x8d, 0xf3, 0x72, 0x42, 0x6c, 0x9c, 0x2f, 0x3e, 0xc1, 0x87,
-	0xbc, 0x54, 0x8a, 0xae, 0x57, 0xeb, 0x1f, 0x9e, 0x14, 0x56, 0x60, 0xf9, 0x12, 0xdf, 0x07, 0x3b,
-	0x66, 0x7f, 0x67, 0xda, 0x33, 0x90, 0xca, 0xbe, 0xc7, 0xa5, 0x11, 0x52, 0xfa, 0x5e, 0x1c, 0x60,
-	0x6b, 0xe5, 0xa9, 0x60, 0xbb, 0xad, 0x95, 0xa7, 0x67, 0xdb, 0xee, 0x15, 0x48, 0x61, 0x3b, 0xb7,
-	0xdd, 0xf5, 0xd3, 0x93, 0x02, 0xf1, 0xd9, 0x2e, 0x86, 0x2d, 0xe8, 0xb1, 0xed, 0xe6, 0xec, 0x6c,
-	0x99, 0xf7, 0x23, 0xb7, 0xa9, 0x0c, 0xcf, 0xa2, 0x93, 0xa7, 0x27, 0x85, 0x9c, 0x6b, 0xd1, 0x04,
-	0x83, 0x31, 0x84, 0xbc, 0x41, 0xd8, 0x8d, 0xbc, 0x05, 0xf2, 0x70, 0x58, 0x45, 0x55, 0x1a, 0x8f,
-	0xd5, 0xaa, 0x1c, 0x2d, 0x96, 0x4e, 0x4f, 0x0a, 0x3f, 0xed, 0x5a, 0x3c, 0x49, 0xff, 0xb9, 0xab,
-	0x71, 0x96, 0x0a, 0xc9, 0x88, 0xbd, 0x91, 0x53, 0xd9, 0x1a, 0x72, 0x56, 0xe4, 0x28, 0x29, 0x87,
-	0x38, 0x6b, 0x72, 0xac, 0xf8, 0xc2, 0xe9, 0x49, 0xe1, 0x86, 0x4b, 0xb6, 0xe3, 0x23, 0xa9, 0x91,
-	0xeb, 0x40, 0x44, 0x12, 0x77, 0x10, 0x99, 0x87, 0x09, 0x4e, 0x55, 0xe6, 0xca, 0xc5, 0x8b, 0xb7,
-	0x4e, 0x4f, 0x0a, 0x2f, 0x52, 0x77, 0xdf, 0xd5, 0x90, 0xaf, 0x3c, 0x3d, 0x7b, 0x57, 0x23, 0xe0,
-	0xf5, 0xc1, 0xf5, 0x33, 0x64, 0x1b, 0x8e, 0x0b, 0x71, 0xd5, 0xe4, 0x84, 0xc8, 0xb5, 0xe3, 0x72,
-	0xed, 0x08, 0x5c, 0xb5, 0x11, 0x5c, 0x35, 0x39, 0xe1, 0x9b, 0xe2, 0x1c, 0x97, 0x90, 0x14, 0xa7,
-	0x38, 0x77, 0x57, 0xe3, 0x53, 0xe4, 0xcd, 0x81, 0x29, 0x0e, 0x07, 0xb9, 0xf6, 0xab, 0x30, 0x7c,
-	0x56, 0x4e, 0x05, 0x90, 0xb2, 0x3c, 0x1e, 0x40, 0x2a, 0x32, 0x04, 0x90, 0x39, 0x39, 0x5d, 0xdc,
-	0x1e, 0xe6, 0xa1, 0x4f, 0x10, 0xb9, 0x5e, 0x78, 0x8a, 0x91, 0x2b, 0x4b, 0xa5, 0x5f, 0x8d, 0x42,
-	0xd6, 0x7f, 0xd1, 0x94, 0x83, 0xf4, 0x52, 0x75, 0xbb, 0xaa, 0x2a, 0xd5, 0xed, 0x86, 0x3a, 0xcb,
-	0x52, 0x9a, 0x07, 0x94, 0x65, 0xc9, 0x0f, 0x54, 0xe4, 0x88, 0x1f, 0x98, 0x93, 0xa3, 0x7e, 0x60,
-	0x5e, 0x8e, 0xf9, 0x81, 0x7b, 0x72, 0xdc, 0x0f, 0x2c, 0xb0, 0x1c, 0xe6, 0x01, 0xf7, 0xe5, 0xa4,
-	0x1f, 0x58, 0x94, 0x53, 0x7e, 0xe0, 0x35, 0x66, 0x35, 0x41, 0xb1, 0x59, 0x66, 0x35, 0x01, 0x29,
-	0xcb, 0xe9, 0x00, 0x52, 0x91, 0x33, 0x01, 0x64, 0x4e, 0xce, 0x06, 0x90, 0x79, 0x79, 0x22, 0x80,
-	0xdc, 0x93, 0x73, 0xc5, 0x5f, 0x38, 0x3d, 0x29, 0x64, 0x65, 0xa9, 0x34, 0x3e, 0xc4, 0x3f, 0x3c,
-	0x29, 0x3c, 0x84, 0xd5, 0xcb, 0x3a, 0xc2, 0x67, 0x6b, 0x5f, 0x16, 0xf9, 0xa3, 0x08, 0x4c, 0x04,
-	0x6e, 0x7d, 0xaf, 0x03, 0xf1, 0xd4, 0xd8, 0x5c, 0x5e, 0x6e, 0x36, 0xb6, 0xa9, 0x47, 0x46, 0xe1,
-	0xe8, 0x98, 0x51, 0x38, 0xfa, 0x67, 0x14, 0x8e, 0x6e, 0x1a, 0x85, 0xa3, 0xb7, 0x46, 0xe1, 0xe8,
-	0xb4, 0x51, 0x38, 0xfa, 0x6e, 0x14, 0x7e, 0x5f, 0x4e, 0x16, 0xf7, 0x4e, 0x4f, 0x0a, 0x57, 0x65,
-	0xa9, 0x24, 0x07, 0x5b, 0x3f, 0x3c, 0x29, 0xac, 0xc1, 0x83, 0x4f, 0x6a, 0x3b, 0x66, 0x1d, 0x9f,
-	0xf1, 0x7e, 0x0e, 0x72, 0x0f, 0xfc, 0x97, 0x12, 0xc2, 0x46, 0x59, 0xdf, 0xdc, 0xd8, 0x6e, 0xbc,
-	0x83, 0xbb, 0xb3, 0x87, 0x35, 0x1b, 0xcd, 0xe6, 0xea, 0xe6, 0x06, 0x8b, 0x66, 0x8e, 0x3d, 0x6c,
-	0x3c, 0x6d, 0xca, 0x11, 0x32, 0x0e, 0x31, 0x7c, 0x94, 0x3f, 0x96, 0x86, 0xfb, 0xe8, 0x9b, 0x70,
-	0x25, 0x74, 0xeb, 0x41, 0xd2, 0x90, 0xf4, 0x98, 0xd3, 0x90, 0xf4, 0x28, 0x53, 0x10, 0x63, 0x5c,
-	0x43, 0x82, 0x45, 0x00, 0xef, 0xfb, 0x7f, 0x14, 0xb9, 0x4c, 0x77, 0xef, 0x8d, 0xfa, 0x6a, 0xa3,
-	0x29, 0x8f, 0x91, 0x2b, 0x90, 0xad, 0xaf, 0x54, 0x37, 0x36, 0x1a, 0x6b, 0xea, 0x7a, 0xb5, 0xf9,
-	0xb0, 0x29, 0x7b, 0xa2, 0xdf, 0x80, 0x38, 0x3d, 0xba, 0x50, 0x71, 0x6b, 0xd5, 0x66, 0x53, 0xad,
-	0x32, 0x71, 0xec, 0xa1, 0x26, 0x4b, 0xde, 0x43, 0x5d, 0x8e, 0xb0, 0xcd, 0x26, 0x2f, 0x95, 0xe2,
-	0x14, 0x2a, 0x1d, 0x01, 0x09, 0x7f, 0x80, 0x47, 0x00, 0x12, 0x6b, 0x9b, 0x4f, 0x58, 0xc5, 0x92,
-	0x84, 0xe8, 0xda, 0xe6, 0x13, 0x59, 0xc2, 0xa0, 0xaf, 0x35, 0xd6, 0x36, 0x9f, 0xa8, 0x1b, 0x9b,
-	0xca, 0x7a, 0x75, 0x4d, 0x8e, 0x60, 0x37, 0xfe, 0x9b, 0x56, 0x27, 0xd5, 0xda, 0xe6, 0xe3, 0x86,
-	0xdb, 0x1a, 0xc3, 0x59, 0xae, 0xac, 0xbe, 0xbd, 0x22, 0xc7, 0x51, 0x01, 0xfc, 0x45, 0x8b, 0x91,
-	0xa1, 0xe2, 0xff, 0x14, 0x85, 0xab, 0xa3, 0x3e, 0x75, 0x23, 0x59, 0x18, 0xaf, 0xaf, 0x2e, 0xa9,
-	0xca, 0xf2, 0x23, 0x1a, 0xcc, 0xee, 0x63, 0xa3, 0xd9, 0xe0, 0xf5, 0x12, 0x3e, 0xae, 0xad, 0x6e,
-	0x3c, 0x54, 0xeb, 0x2b, 0x8d, 0xfa, 0x43, 0x39, 0x42, 0x2b, 0x23, 0x17, 0xab, 0x2e, 0x29, 0x72,
-	0xd4, 0xed, 0xb5, 0xf4, 0x68, 0xfb, 0xa9, 0x5a, 0x7f, 0x5a, 0x5f, 0x6b, 0xb0, 0xa8, 0xa5, 0x44,
-	0xef, 0xa8, 0x5b, 0x55, 0xa5, 0xba, 0xae, 0x36, 0x1b, 0xdb, 0x8f, 0xb6, 0x58, 0xc5, 0x44, 0xfb,
-	0x36, 0x1e, 0xab, 0xcd, 0xed, 0xea, 0xf6, 0xa3, 0xa6, 0x9c, 0x20, 0x93, 0x90, 0x43, 0x6c, 0xa3,
-	0xf1, 0x44, 0xe5, 0x86, 0x97, 0x93, 0xe4, 0x06, 0x4c, 0x72, 0x82, 0xed, 0xd5, 0xf5, 0xd5, 0x8d,
-	0xb7, 0x39, 0x43, 0xca, 0x65, 0xde, 0xf6, 0x33, 0x8f, 0x0f, 0x99, 0xd7, 0x86, 0x24, 0xe0, 0x4d,
-	0xe7, 0x61, 0xe3, 0xa9, 0x9c, 0x76, 0x39, 0xab, 0x4b, 0x8a, 0x6f, 0x6c, 0xc6, 0xd5, 0x60, 0xa9,
-	0xf1, 0x78, 0xb5, 0xde, 0x40, 0x81, 0x0d, 0x39, 0x8b, 0x9b, 0x11, 0x82, 0xcb, 0x9b, 0x4a, 0xbd,
-	0xa1, 0xb2, 0xa8, 0x94, 0x27, 0x48, 0x11, 0xae, 0x33, 0x4a, 0x1a, 0xa5, 0x22, 0x4d, 0xce, 0x55,
-	0x6d, 0x8b, 0xaa, 0xbb, 0xb6, 0xb9, 0xad, 0xae, 0x6e, 0x2c, 0x6f, 0xca, 0x32, 0x29, 0xc0, 0x35,
-	0x3f, 0xee, 0x6a, 0x78, 0x85, 0x5c, 0x83, 0x2b, 0xd8, 0x54, 0x6b, 0x54, 0xeb, 0x9b, 0x1b, 0x7c,
-	0xaa, 0x32, 0x71, 0x15, 0xe2, 0x30, 0xc6, 0xa7, 0x3c, 0x19, 0xd0, 0x72, 0x7d, 0x73, 0xa9, 0x21,
-	0xdf, 0x62, 0x55, 0x15, 0x16, 0x52, 0xf5, 0xd5, 0xa5, 0xd2, 0x3f, 0x47, 0x60, 0x72, 0xc4, 0x49,
-	0x87, 0xa6, 0xd0, 0xa1, 0x77, 0xd4, 0xb2, 0x3c, 0x16, 0x40, 0x2a, 0x2c, 0xe2, 0x04, 0x64, 0x9e,
-	0x79, 0x5a, 0x40, 0x16, 0xe5, 0x28, 0x2e, 0x0d, 0x91, 0x67, 0x41, 0x8e, 0x05, 0xa0, 0xb9, 0x8a,
-	0x1c, 0x0f, 0x40, 0x0b, 0xf3, 0x72, 0x02, 0x9d, 0x23, 0x0e, 0xac, 0x2c, 0xca, 0xc9, 0x00, 0x56,
-	0xb9, 0xb7, 0x20, 0xa7, 0x02, 0xd8, 0xbd, 0x72, 0x45, 0x1e, 0xc7, 0x69, 0x8b, 0x63, 0x67, 0x2b,
-	0xf3, 0x32, 0x04, 0xc0, 0xca, 0xec, 0xfc, 0xa2, 0x9c, 0x0e, 0x80, 0xf3, 0xb3, 0xaf, 0x2d, 0x30,
-	0xdf, 0x8a, 0xb3, 0x28, 0xbf, 0x56, 0x61, 0xbe, 0xf5, 0x4d, 0x64, 0x6e, 0x11, 0x77, 0x1a, 0x3f,
-	0x3a, 0x57, 0xb9, 0xbf, 0xb0, 0x28, 0xe7, 0x8a, 0xe4, 0xf4, 0xa4, 0x30, 0x91, 0x97, 0x4a, 0xe0,
-	0xb5, 0x95, 0xfe, 0x4c, 0x82, 0x09, 0xff, 0x01, 0x16, 0x67, 0x4d, 0x1d, 0xdc, 0x78, 0xdc, 0x50,
-	0x9e, 0xaa, 0x65, 0x9e, 0x49, 0x04, 0xa8, 0xd2, 0x94, 0xa5, 0x00, 0x34, 0x8f, 0x29, 0xce, 0x0f,
-	0x2d, 0x36, 0xd9, 0x8a, 0x12, 0xb9, 0x16, 0x9a, 0xec, 0xfc, 0x21, 0x60, 0x73, 0x95, 0x26, 0x5b,
-	0x4d, 0x02, 0xb6, 0x30, 0xcf, 0x57, 0x93, 0x38, 0xb6, 0xb2, 0xd8, 0x94, 0x93, 0xde, 0x1c, 0xbc,
-	0xa6, 0xd2, 0xb7, 0xa3, 0xee, 0x85, 0xa3, 0xff, 0x86, 0x73, 0x12, 0x72, 0xc3, 0x5c, 0xfd, 0x68,
-	0x63, 0x1b, 0xdd, 0x3c, 0x16, 0x02, 0xe7, 0x30, 0x64, 0x82, 0xe0, 0xc2, 0x3c, 0x3b, 0x4f, 0xf9,
-	0x87, 0x57, 0x30, 0x72, 0x82, 0x28, 0xba, 0x3b, 0x16, 0x42, 0xd1, 0xe1, 0x71, 0x5c, 0x13, 0x7e,
-	0x06, 0x74, 0x79, 0x22, 0x04, 0x53, 0xa7, 0x27, 0x43, 0x30, 0x75, 0x7b, 0x2a, 0x04, 0x53, 0xc7,
-	0x8f, 0xd3, 0xc2, 0xd0, 0x3f, 0x39, 0x74, 0x3d, 0x84, 0x70, 0xe6, 0xfc, 0x74, 0x08, 0x5f, 0xb8,
-	0x77, 0x6f, 0x0e, 0xa3, 0xea, 0x06, 0x4c, 0xfa, 0x79, 0xe6, 0xca, 0xb3, 0xf7, 0x31, 0xb2, 0x82,
-	0x0d, 0x95, 0x85, 0x4a, 0x79, 0x1e, 0x83, 0x2b, 0xd8, 0x70, 0xaf, 0x32, 0x5f, 0x59, 0xc4, 0xf8,
-	0xba, 0x7a, 0x7a, 0x52, 0x90, 0xf3, 0x52, 0x29, 0x23, 0x36, 0x97, 0xfe, 0x31, 0x02, 0x24, 0x7c,
-	0x7f, 0x8c, 0xc1, 0xc2, 0xbb, 0x61, 0x96, 0xa2, 0x39, 0x3b, 0x00, 0x95, 0x59, 0x94, 0x89, 0x50,
-	0x85, 0x45, 0x99, 0x08, 0xcd, 0xb1, 0xd5, 0x2c, 0x42, 0xf3, 0x6c, 0x35, 0x8b, 0xd0, 0x3d, 0xb6,
-	0x9a, 0x45, 0x08, 0x4b, 0x8c, 0x00, 0x84, 0x05, 0x62, 0x00, 0xc2, 0x12, 0x31, 0x00, 0xbd, 0xc6,
-	0x72, 0xb4, 0x4f, 0x55, 0x2c, 0x13, 0x83, 0x18, 0x16, 0x8a, 0x41, 0x0c, 0x4b, 0xc5, 0x20, 0x86,
-	0xc5, 0x62, 0x10, 0x43, 0x3b, 0x07, 0x31, 0x2c, 0x18, 0xe9, 0xf9, 0x2c, 0x2f, 0x95, 0xd2, 0x42,
-	0x4b, 0xe9, 0x6f, 0x25, 0xf7, 0x7f, 0x80, 0xe1, 0x7f, 0xe3, 0x20, 0x84, 0xf5, 0x56, 0x43, 0x59,
-	0xdd, 0x5c, 0xa2, 0x56, 0x0e, 0x81, 0x65, 0xdf, 0x02, 0xe0, 0x20, 0x5a, 0x3a, 0x04, 0xa2, 0xad,
-	0x43, 0x20, 0x5a, 0x3b, 0x04, 0xa2, 0xbd, 0x43, 0xe0, 0x02, 0x5b, 0xd4, 0x7e, 0x10, 0x2b, 0xba,
-	0x6b, 0xa7, 0x27, 0x85, 0x2b, 0x79, 0xa9, 0x94, 0xf5, 0x35, 0x95, 0xbe, 0x1f, 0x01, 0xf0, 0xee,
-	0x50, 0x68, 0x36, 0x66, 0x3b, 0x06, 0x3e, 0xaa, 0x8b, 0xac, 0xf2, 0x12, 0xa1, 0xf2, 0x2c, 0xdb,
-	0xea, 0x7d, 0x18, 0xce, 0x24, 0x88, 0xcd, 0xb1, 0xd4, 0xe4, 0xc3, 0xe6, 0x59, 0x6a, 0xf2, 0x61,
-	0x0b, 0x2c, 0x35, 0xf9, 0xb0, 0x45, 0xbe, 0x0b, 0x08, 0x58, 0x65, 0x96, 0xef, 0x02, 0x22, 0x56,
-	0xe6, 0xbb, 0x80, 0x88, 0xcd, 0xb3, 0xd0, 0xf1, 0x61, 0x0b, 0x2c, 0x74, 0x7c, 0xd8, 0x7d, 0x16,
-	0x3a, 0x3e, 0xec, 0x35, 0x16, 0x3a, 0x22, 0x36, 0x37, 0xcb, 0x42, 0xc7, 0x87, 0xcd, 0xb1, 0xd0,
-	0xf1, 0x61, 0x0b, 0x62, 0xe8, 0x08, 0x2d, 0xa5, 0x6f, 0x45, 0x61, 0x72, 0xc4, 0x1d, 0x1c, 0xba,
-	0x09, 0x8b, 0x8b, 0x6a, 0xfd, 0xa1, 0xba, 0xb6, 0xba, 0xbe, 0xba, 0x4d, 0xb7, 0xda, 0x10, 0xc8,
-	0x53, 0xa7, 0x1f, 0x9c, 0x67, 0x91, 0xe3, 0x07, 0x79, 0xe6, 0x0c, 0x70, 0xf2, 0xcc, 0xe9, 0x47,
-	0xe9, 0xce, 0x1b, 0x42, 0x17, 0x78, 0xe2, 0x0c, 0x30, 0x54, 0x78, 0xe2, 0x0c, 0xe8, 0x75, 0x8f,
-	0x27, 0x4e, 0x3f, 0xcc, 0x76, 0xe1, 0xeb, 0x40, 0x02, 0x24, 0x6c, 0x23, 0x0e, 0xe1, 0x7c, 0x2f,
-	0x0e, 0xe1, 0x7c, 0x3b, 0x0e, 0xe1, 0x7c, 0x47, 0xbe, 0x41, 0x2d, 0xea, 0x9b, 0x26, 0xdb, 0x94,
-	0x43, 0x0d, 0xee, 0xbe, 0x3c, 0x0c, 0x7f, 0x5f, 0xb3, 0xe0, 0x1b, 0xdf, 0x65, 0xa5, 0x68, 0xdc,
-	0xa5, 0xc6, 0x5a, 0xf5, 0x69, 0xd0, 0x37, 0x0c, 0x0c, 0xf8, 0x86, 0x81, 0x01, 0xdf, 0x30, 0x30,
-	0xe0, 0x1b, 0xce, 0x19, 0xf0, 0x0d, 0x43, 0x83, 0xbe, 0x61, 0x68, 0xd0, 0x37, 0x9c, 0x21, 0xe8,
-	0x1b, 0xae, 0x57, 0xd0, 0x37, 0x0c, 0x0e, 0xf9, 0x86, 0x93, 0x84, 0x7c, 0xc3, 0x59, 0x42, 0xbe,
-	0xe1, 0x13, 0x0c, 0xf9, 0x86, 0xcf, 0x31, 0xe4, 0x1b, 0x77, 0x9a, 0x21, 0xdf, 0xb8, 0x33, 0x3d,
-	0xc3, 0x37, 0xb4, 0xb9, 0x74, 0x1a, 0x81, 0x24, 0xbf, 0x22, 0x27, 0x13, 0x00, 0xca, 0x3b, 0x7c,
-	0x18, 0x26, 0x58, 0xf1, 0x19, 0x73, 0xab, 0xf8, 0x8c, 0xc9, 0x48, 0x7c, 0xc6, 0x44, 0x24, 0x3e,
-	0x63, 0x12, 0x12, 0x9f, 0x31, 0x8f, 0x8a, 0xcf, 0x98, 0x42, 0xc5, 0x67, 0xdc, 0xb1, 0xc4, 0x67,
-	0xdc, 0xae, 0xc4, 0x67, 0xdc, 0xab, 0xf0, 0x28, 0x3a, 0xd4, 0x07, 0x37, 0x2a, 0x1f, 0x80, 0xbb,
-	0x94, 0x0f, 0xc0, 0x2d, 0xca, 0x07, 0xe0, 0xfe, 0xe4, 0x03, 0xd0, 0x60, 0x3e, 0x00, 0x77, 0xa6,
-	0xa7, 0xa7, 0x27, 0x85, 0x8c, 0x2c, 0x95, 0x52, 0x2e, 0xfc, 0xe1, 0x49, 0xa1, 0x01, 0xf5, 0xcb,
-	0x9e, 0xc6, 0xb9, 0x51, 0x7d, 0xc7, 0xf0, 0xdf, 0x8b, 0x40, 0x9c, 0x7e, 0x9d, 0x81, 0x52, 0xd7,
-	0x57, 0x37, 0x36, 0x95, 0xe1, 0x31, 0x2f, 0x0d, 0x49, 0x06, 0xf0, 0x1b, 0x24, 0xaf, 0x95, 0xdf,
-	0x20, 0x79, 0x00, 0xbf, 0x41, 0xf2, 0x00, 0x7e, 0x83, 0xe4, 0x01, 0xfc, 0x06, 0xc9, 0x03, 0xf8,
-	0x0d, 0x92, 0x07, 0xf0, 0x1b, 0x24, 0x0f, 0xe0, 0x37, 0x48, 0x1e, 0xc0, 0x6f, 0x90, 0x3c, 0xc0,
-	0xbd, 0x41, 0x12, 0x10, 0x7e, 0x83, 0x24, 0x20, 0xfc, 0x06, 0x49, 0x40, 0xf8, 0x0d, 0x92, 0x80,
-	0xf0, 0x1b, 0x24, 0x01, 0x41, 0xb3, 0x0f, 0x0f, 0xe6, 0x14, 0xaf, 0xdd, 0xfb, 0xd3, 0x1f, 0xbc,
-	0x24, 0x7d, 0x6d, 0xe6, 0x92, 0x56, 0xdf, 0x49, 0xd0, 0x2f, 0x3b, 0xe6, 0xfe, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0xb1, 0x3c, 0x8e, 0x7e, 0x10, 0x57, 0x00, 0x00,
+	// 6376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7c, 0x4d, 0x6c, 0x24, 0x47,
+	0x76, 0x26, 0xb3, 0xfe, 0xf9, 0xea, 0x87, 0xc1, 0x60, 0xff, 0x14, 0x4b, 0x1a, 0xa9, 0xc5, 0xd6,
+	0xee, 0xf4, 0x94, 0xd0, 0x24, 0xab, 0xc8, 0x66, 0x53, 0xda, 0xd1, 0x48, 0xf5, 0x47, 0x91, 0x6c,
+	0xfe, 0x4d, 0x56, 0x75, 0xb7, 0x7a, 0x76, 0x76, 0x73, 0x93, 0x55, 0x59, 0x64, 0x89, 0x55, 0x99,
+	0xa5, 0xac, 0x24, 0x9b, 0x9c, 0x3d, 0xad, 0xf6, 0x60, 0x60, 0x00, 0xc3, 0x86, 0x0e, 0x06, 0xc6,
+	0x63, 0x18, 0x03, 0x5f, 0x6c, 0xd0, 0x17, 0x63, 0x7c, 0x1a, 0x1b, 0x03, 0xd8, 0x3e, 0xf8, 0x64,
+	0xc3, 0x30, 0x60, 0x1f, 0x6c, 0x1f, 0x3c, 0xc0, 0x18, 0x36, 0x40, 0xc3, 0x3f, 0x90, 0x6d, 0xc0,
+	0xd0, 0xc1, 0x32, 0x5e, 0x64, 0x64, 0x65, 0x64, 0x66, 0xf1, 0x4f, 0x23, 0x79, 0xe6, 0x20, 0x5d,
+	0xba, 0xf2, 0x8b, 0x88, 0x2f, 0x5e, 0xbc, 0xf7, 0xe2, 0xc5, 0x8b, 0x97, 0x49, 0xc1, 0xcb, 0x5d,
+	0xc3, 0x54, 0x9f, 0xab, 0xfa, 0xfd, 0x81, 0xa5, 0x36, 0x0f, 0xe6, 0xd4, 0x7e, 0x67, 0x8e, 0x23,
+	0xb3, 0x7d, 0xd3, 0xb0, 0x0c, 0x9a, 0xb1, 0x2c, 0x7d, 0xd6, 0x81, 0x8e, 0x16, 0x72, 0xd5, 0xbd,
+	0x8e, 0xb5, 0x7f, 0xb8, 0x3b, 0xdb, 0x34, 0x7a, 0x73, 0x8d, 0x7d, 0xad, 0xb1, 0xdf, 0xd1, 0xf7,
+	0x06, 0x6b, 0x7a, 0xeb, 0x70, 0x60, 0x99, 0x1d, 0x6d, 0x30, 0xc7, 0x46, 0x35, 0xef, 0xef, 0x69,
+	0xfa, 0xfd, 0x3d, 0xe3, 0x7e, 0xbb, 0xab, 0xee, 0x0d, 0xe6, 0x54, 0x5d, 0x37, 0x2c, 0xd5, 0xea,
+	0x18, 0xfa, 0xc0, 0x66, 0xcd, 0x95, 0x04, 0x16, 0x4d, 0x3f, 0x32, 0x4e, 0xfa, 0xa6, 0x71, 0x7c,
+	0x22, 0x0e, 0x3e, 0x52, 0xbb, 0x9d, 0x96, 0x6a, 0x69, 0x73, 0x81, 0x1f, 0x9c, 0xe2, 0xbe, 0x40,
+	0xb1, 0x67, 0xec, 0x19, 0xf6, 0xe0, 0xdd, 0xc3, 0x36, 0x7b, 0x62, 0x0f, 0xec, 0x17, 0xef, 0x5e,
+	0xb9, 0x96, 0xdc, 0xef, 0x0d, 0x0c, 0x7d, 0x84, 0xd8, 0x2f, 0xed, 0x19, 0xc6, 0x5e, 0x57, 0x73,
+	0xa7, 0x6a, 0x1d, 0x9a, 0xac, 0x03, 0x6f, 0x7f, 0xd1, 0xdf, 0x3e, 0xb0, 0xcc, 0xc3, 0xa6, 0xc5,
+	0x5b, 0x5f, 0xf6, 0xb7, 0x5a, 0x9d, 0x9e, 0x36, 0xb0, 0xd4, 0x5e, 0x9f, 0x77, 0xb8, 0x1b, 0x34,
+	0x46, 0xa7, 0xa5, 0xe9, 0x56, 0xa7, 0xdd, 0xd1, 0x4c, 0x47, 0x86, 0x59, 0x41, 0x52, 0xa3, 0xaf,
+	0xe9, 0x6a, 0xbf, 0x73, 0x54, 0x9c, 0x33, 0xfa, 0x4c, 0xce, 0xa0, 0xcc, 0x33, 0xbf, 0x1b, 0x86,
+	0xf8, 0xa6, 0x36, 0x18, 0xa8, 0x7b, 0x1a, 0x5d, 0x80, 0x68, 0x4f, 0xd9, 0x6f, 0x99, 0x59, 0xe9,
+	0x8e, 0x74, 0x2f, 0x59, 0xbc, 0x31, 0xeb, 0x35, 0xee, 0xec, 0xe6, 0x6a, 0x55, 0x2e, 0x27, 0x3e,
+	0x2e, 0x47, 0xbf, 0x2d, 0x85, 0x88, 0x24, 0x47, 0x7a, 0xab, 0x2d, 0x93, 0xbe, 0x00, 0xe1, 0x5e,
+	0xa7, 0x99, 0x0d, 0xdd, 0x91, 0xee, 0xa5, 0xca, 0xe3, 0x1f, 0x97, 0x63, 0xdf, 0x8a, 0x90, 0xb1,
+	0x6c, 0x44, 0x46, 0x94, 0xbe, 0x09, 0xc9, 0x9e, 0xda, 0x54, 0xfa, 0xea, 0x49, 0xd7, 0x50, 0x5b,
+	0xd9, 0x30, 0xe3, 0xcd, 0x05, 0x78, 0x4b, 0x95, 0x1d, 0xbb, 0xc7, 0xea, 0x98, 0x0c, 0x3d, 0xb5,
+	0xc9, 0x9f, 0xe8, 0x13, 0xb8, 0xf1, 0x9e, 0xd1, 0xd1, 0x15, 0x53, 0x7b, 0xff, 0x50, 0x1b, 0x58,
+	0x43, 0x9e, 0x08, 0xe3, 0x99, 0xf1, 0xf3, 0xac, 0x1b, 0x1d, 0x5d, 0xb6, 0xbb, 0xba, 0x7c, 0xf4,
+	0xbd, 0x00, 0x4a, 0xeb, 0x30, 0xc5, 0x78, 0xd5, 0x66, 0x53, 0xeb, 0xbb, 0xb4, 0x51, 0x46, 0xfb,
+	0xca, 0x28, 0xda, 0x12, 0xeb, 0xe9, 0xb2, 0x4e, 0xbe, 0xe7, 0x07, 0xe9, 0x37, 0xe1, 0x96, 0xa9,
+	0x8d, 0x14, 0x37, 0xc6, 0x78, 0x5f, 0xf5, 0xf3, 0xca, 0xda, 0x7b, 0xa3, 0x04, 0xbe, 0x61, 0x8e,
+	0xc0, 0xcb, 0x19, 0x88, 0x3b, 0x13, 0x85, 0xff, 0xbd, 0x2c, 0xad, 0x47, 0x12, 0x71, 0x92, 0x98,
+	0x39, 0x84, 0x08, 0x1a, 0x85, 0x2e, 0x41, 0xac, 0xa7, 0x58, 0x27, 0x7d, 0x8d, 0x99, 0x2e, 0x53,
+	0xbc, 0x19, 0x50, 0x71, 0xe3, 0xa4, 0xaf, 0x31, 0xdb, 0x7d, 0xc0, 0x6c, 0x17, 0xed, 0x21, 0x40,
+	0x1f, 0x40, 0xb4, 0xa7, 0xbe, 0x67, 0x98, 0xd9, 0xd0, 0x39, 0xc3, 0xb0, 0xd1, 0x33, 0x0c, 0x81,
+	0x99, 0x1f, 0x4b, 0x00, 0xae, 0xd1, 0xd0, 0x6f, 0xda, 0x17, 0xf9, 0xcd, 0x8a, 0xcf, 0x6f, 0xda,
+	0xe8, 0x37, 0x2f, 0x43, 0xac, 0xad, 0xf4, 0x0d, 0xd3, 0x62, 0x73, 0xa7, 0x59, 0x7b, 0x3e, 0x9c,
+	0xfd, 0x44, 0x92, 0xa3, 0xed, 0x1d, 0xc3, 0xb4, 0xe8, 0xcb, 0x90, 0x6c, 0x9b, 0x3d, 0x8f, 0xef,
+	0xa4, 0x64, 0x68, 0x9b, 0x3d, 0x67, 0xda, 0xb7, 0x61, 0xa2, 0xa5, 0x35, 0x8d, 0x96, 0xd6, 0xf2,
+	0x39, 0xc6, 0xed, 0x59, 0x7b, 0x2b, 0xcd, 0x3a, 0x5b, 0x69, 0xb6, 0xce, 0x36, 0x9a, 0x9c, 0xe1,
+	0xfd, 0x1d, 0x86, 0x17, 0x01, 0xda, 0x87, 0xdd, 0xae, 0xd2, 0x56, 0x9a, 0xba, 0xc5, 0xcc, 0x9f,
+	0x96, 0x13, 0x88, 0xac, 0x54, 0x74, 0x6b, 0xe6, 0x1f, 0xc3, 0x10, 0x41, 0xd1, 0xe9, 0x3f, 0x84,
+	0x20, 0xd1, 0xd2, 0x8e, 0x14, 0xb5, 0xc5, 0xd7, 0x98, 0x2a, 0xff, 0x69, 0xe8, 0xc3, 0xd2, 0xf4,
+	0x3a, 0xcc, 0x14, 0x97, 0xe6, 0xe7, 0x4b, 0xe5, 0x4a, 0x75, 0xe6, 0x97, 0x43, 0x52, 0xfc, 0xd7,
+	0x42, 0x31, 0x0c, 0x17, 0xfa, 0x1e, 0xdb, 0x05, 0xfb, 0x91, 0xbe, 0x74, 0x76, 0x3a, 0xfd, 0x81,
+	0x04, 0x6f, 0xed, 0x19, 0xb3, 0xd6, 0xbe, 0x66, 0xb1, 0xa0, 0x32, 0xab, 0x6b, 0xd6, 0x73, 0xc3,
+	0x3c, 0x98, 0xf3, 0xee, 0xe6, 0xa3, 0x85, 0xb9, 0xfe, 0xc1, 0xde, 0x1c, 0xda, 0x70, 0x30, 0xbb,
+	0xa9, 0x9a, 0x83, 0x7d, 0xb5, 0xbb, 0x5a, 0x7b, 0xb7, 0x7c, 0x62, 0x69, 0x03, 0x7a, 0x6d, 0x82,
+	0xc7, 0x7a, 0xcf, 0xa6, 0x58, 0x64, 0x04, 0x1f, 0x9d, 0x4e, 0x7f, 0x4f, 0xca, 0x6d, 0x5d, 0x8d,
+	0xa5, 0xd9, 0x6b, 0xcd, 0x59, 0x96, 0x7e, 0xbf, 0xfb, 0xfc, 0x7e, 0xb3, 0xdb, 0x99, 0x6b, 0x1e,
+	0x0e, 0x2c, 0xa3, 0xc7, 0x22, 0xf5, 0xec, 0x96, 0xf6, 0xdc, 0x26, 0x5c, 0xe9, 0xaa, 0x7b, 0x33,
+	0x3f, 0x39, 0xdf, 0x3b, 0x9a, 0x55, 0x3b, 0x56, 0x9b, 0x16, 0xe3, 0x94, 0xe3, 0x2d, 0xed, 0xa8,
+	0xd4, 0x6a, 0x99, 0xe8, 0xcd, 0x6d, 0xa5, 0x69, 0x99, 0x5d, 0xe6, 0x1a, 0xc9, 0xa0, 0x5b, 0xae,
+	0x54, 0x2c, 0xb3, 0x2b, 0x78, 0x54, 0xb4, 0x8d, 0x00, 0x7d, 0x09, 0xa2, 0xb6, 0x25, 0xc3, 0xcc,
+	0xa3, 0x30, 0x18, 0xe5, 0x23, 0xd9, 0x4f, 0x3e, 0x09, 0xcb, 0x91, 0x76, 0x45, 0xb7, 0xe8, 0x4b,
+	0xc8, 0x6b, 0xf4, 0xad, 0x01, 0xf3, 0x93, 0x54, 0x39, 0xfe, 0x71, 0x39, 0xf2, 0xad, 0x50, 0x76,
+	0x42, 0x8e, 0xb6, 0xb7, 0xfb, 0xd6, 0x60, 0xe6, 0xff, 0x49, 0x10, 0x65, 0xd4, 0x94, 0x40, 0x58,
+	0xe5, 0xb6, 0x4e, 0xc8, 0xf8, 0x93, 0xbe, 0x04, 0x49, 0xb5, 0x65, 0x2a, 0x6a, 0xf3, 0x00, 0xb7,
+	0x37, 0x13, 0x2c, 0x21, 0x8f, 0xab, 0x2d, 0xb3, 0xd4, 0x3c, 0x90, 0xb5, 0xf7, 0xd9, 0x88, 0xe6,
+	0x41, 0x36, 0xcc, 0x47, 0x34, 0x0f, 0xe8, 0x0b, 0x30, 0xde, 0x56, 0xfa, 0x9a, 0xde, 0xea, 0xe8,
+	0x7b, 0x6c, 0xc2, 0x84, 0x9c, 0x68, 0xef, 0xd8, 0xcf, 0xf4, 0x36, 0xc4, 0x9b, 0x5d, 0x75, 0x30,
+	0x50, 0x76, 0x99, 0xdb, 0x25, 0xe4, 0x18, 0x7b, 0x2c, 0xcf, 0xfc, 0x73, 0x02, 0x68, 0x30, 0x8e,
+	0xd1, 0x7f, 0x0b, 0x41, 0x82, 0xc5, 0x16, 0xed, 0xb0, 0xc3, 0x5d, 0xf0, 0x2f, 0x42, 0x1f, 0x96,
+	0x5e, 0x59, 0xa7, 0x33, 0x0f, 0xe7, 0xcb, 0x0b, 0xd5, 0x07, 0x0f, 0x6b, 0xd5, 0xf9, 0xf3, 0x5d,
+	0x31, 0xf1, 0x33, 0xe0, 0x8a, 0xcb, 0x9f, 0xb5, 0x2b, 0x2e, 0x7f, 0x8e, 0xae, 0x88, 0xba, 0xae,
+	0x1d, 0x76, 0xe8, 0xbf, 0x84, 0x00, 0xdd, 0x92, 0xa9, 0x3d, 0xf4, 0x85, 0xda, 0x3f, 0x67, 0xb5,
+	0xc7, 0x5a, 0xda, 0x11, 0x6a, 0xfd, 0xef, 0x43, 0x30, 0x8e, 0x5a, 0xd7, 0x0d, 0xbd, 0xa9, 0xd9,
+	0x91, 0xbf, 0xfc, 0xc7, 0xa1, 0x0f, 0x4b, 0x37, 0xd7, 0x63, 0x33, 0xe7, 0xe9, 0x3a, 0xf4, 0x33,
+	0xa0, 0xeb, 0xe2, 0x67, 0xad, 0xeb, 0xe2, 0xe7, 0xa7, 0x6b, 0x3c, 0xd1, 0xb6, 0x50, 0xbf, 0x33,
+	0x7f, 0x35, 0x0e, 0x37, 0x46, 0xe5, 0x22, 0x74, 0x03, 0x92, 0x3c, 0xa3, 0x11, 0x52, 0x8b, 0x57,
+	0x2e, 0x4c, 0x63, 0x7c, 0x69, 0x06, 0xd8, 0xe3, 0x11, 0xa5, 0x7f, 0x17, 0x82, 0x98, 0xae, 0x59,
+	0x4a, 0xa7, 0xc5, 0x77, 0xd2, 0x9f, 0x84, 0x3e, 0x2c, 0xdd, 0x5e, 0x4f, 0xcc, 0xcc, 0xcf, 0xcf,
+	0xcf, 0x17, 0x16, 0x46, 0xd9, 0x34, 0xfc, 0x33, 0x60, 0xd3, 0x85, 0xcf, 0xda, 0xa6, 0x0b, 0x9f,
+	0x9f, 0x4d, 0xa3, 0xba, 0x66, 0xad, 0xf9, 0x0e, 0x8b, 0xf0, 0x17, 0x51, 0xeb, 0xa7, 0x71, 0x58,
+	0x44, 0xbe, 0x50, 0xfb, 0x7f, 0xd1, 0x61, 0xf1, 0x25, 0xe0, 0x51, 0x46, 0x48, 0xe2, 0xc7, 0x6d,
+	0x04, 0xb3, 0xf8, 0xdf, 0x06, 0x98, 0x0c, 0xdc, 0xe0, 0xe8, 0x8b, 0x30, 0xae, 0xe9, 0x4d, 0xf3,
+	0xa4, 0x6f, 0x69, 0x2d, 0x3b, 0x9f, 0x92, 0x5d, 0x80, 0xfe, 0x53, 0x08, 0x80, 0x31, 0xda, 0x07,
+	0x90, 0x37, 0x5c, 0xa1, 0xfd, 0x6a, 0x2b, 0x5f, 0x84, 0xab, 0x9f, 0xdc, 0x82, 0xe3, 0xa8, 0x63,
+	0x76, 0x06, 0x89, 0x87, 0x43, 0xf8, 0x8b, 0xc3, 0xe1, 0xf3, 0x3c, 0x1c, 0x3c, 0x97, 0xd9, 0xc8,
+	0x17, 0x97, 0xd9, 0xcf, 0xfb, 0x32, 0x5b, 0x83, 0x64, 0xab, 0xab, 0x0c, 0x34, 0xcb, 0x42, 0xe6,
+	0x6c, 0x74, 0x74, 0x09, 0xac, 0xba, 0x51, 0xe7, 0x3d, 0x84, 0x6b, 0x2d, 0xb4, 0xba, 0x0e, 0x4a,
+	0xbf, 0x0a, 0x09, 0xf3, 0x58, 0x69, 0x69, 0x5d, 0xf5, 0x84, 0xd5, 0x93, 0x32, 0xc5, 0xdb, 0x7e,
+	0x0e, 0xf9, 0xb8, 0x8a, 0xcd, 0x42, 0xfa, 0x15, 0x37, 0x6d, 0x88, 0xce, 0x41, 0xbc, 0xd9, 0x56,
+	0xba, 0x9d, 0x81, 0x95, 0x8d, 0x33, 0x01, 0x6e, 0xf9, 0x07, 0x57, 0x56, 0x36, 0x3a, 0x03, 0x4b,
+	0x8e, 0x35, 0xdb, 0xf8, 0xef, 0xcc, 0x0f, 0x25, 0x00, 0x57, 0x26, 0xba, 0x01, 0x69, 0xf3, 0xb8,
+	0xa0, 0xb4, 0x4c, 0xc5, 0x68, 0xb7, 0x07, 0x9a, 0xc5, 0x73, 0xc1, 0x97, 0x02, 0xcb, 0x50, 0x2d,
+	0x55, 0x56, 0x2d, 0x6d, 0x9b, 0xf5, 0x12, 0x24, 0x49, 0x9a, 0xc7, 0x85, 0xaa, 0x69, 0xc3, 0xf4,
+	0x6b, 0x10, 0x33, 0x8f, 0x8b, 0x4a, 0xcb, 0x29, 0x3b, 0x7d, 0xe9, 0x3c, 0x9a, 0x35, 0xbd, 0xa5,
+	0x1d, 0x8b, 0xe5, 0x27, 0xf3, 0xb8, 0x58, 0x35, 0xf1, 0xf2, 0x6c, 0xf4, 0x2d, 0x45, 0xd7, 0xf6,
+	0xf8, 0x7d, 0x3b, 0x66, 0xf4, 0xad, 0x2d, 0x6d, 0xef, 0x8d, 0xc4, 0x47, 0xa7, 0xd3, 0x91, 0x84,
+	0x44, 0xa4, 0x99, 0xff, 0x0b, 0x31, 0x7b, 0x45, 0x74, 0x19, 0x22, 0x42, 0xf6, 0x9a, 0x1b, 0xbd,
+	0x6e, 0x5f, 0xda, 0xca, 0x46, 0x50, 0x0a, 0x91, 0xb6, 0x7d, 0xd7, 0x0f, 0xdf, 0x4b, 0xcb, 0xec,
+	0x37, 0x9d, 0x86, 0x44, 0x73, 0x5f, 0xe9, 0xa9, 0x83, 0x83, 0x41, 0x36, 0x7c, 0x27, 0x7c, 0x2f,
+	0x21, 0xc7, 0x9b, 0xfb, 0x9b, 0xf8, 0x28, 0x4c, 0xfe, 0x81, 0x04, 0xa9, 0x0d, 0x43, 0x56, 0x9d,
+	0x65, 0xe0, 0x69, 0xb3, 0xab, 0xea, 0xad, 0xe7, 0x9d, 0x96, 0xb5, 0xcf, 0x04, 0x49, 0xcb, 0x2e,
+	0x40, 0xbf, 0x02, 0x64, 0xd0, 0x37, 0x35, 0x15, 0x0b, 0x03, 0x4a, 0x5b, 0x6d, 0x5a, 0xbc, 0x1e,
+	0x97, 0x96, 0x27, 0x86, 0xf8, 0x0a, 0x83, 0xb1, 0x26, 0xd6, 0x34, 0x58, 0x3f, 0x53, 0xb5, 0xec,
+	0x9b, 0xd1, 0xb8, 0x0c, 0x36, 0x84, 0x33, 0x0d, 0x85, 0x18, 0x9b, 0x29, 0x42, 0x72, 0xa5, 0xfe,
+	0x68, 0x28, 0xc2, 0x34, 0x24, 0x76, 0x3b, 0x96, 0x3d, 0xcc, 0x96, 0x20, 0xbe, 0xdb, 0xb1, 0x7c,
+	0x63, 0x7e, 0x45, 0x82, 0xcc, 0x86, 0xbc, 0xb2, 0x5a, 0xaf, 0x0f, 0xc7, 0x7d, 0x19, 0x26, 0x7a,
+	0x46, 0xeb, 0xb0, 0xcb, 0x8a, 0xc6, 0xee, 0x3d, 0x20, 0x2d, 0x67, 0x5c, 0x98, 0xa5, 0xf7, 0x4b,
+	0x70, 0xdb, 0xe8, 0x6b, 0x58, 0xef, 0xd6, 0xf7, 0x94, 0xe6, 0xbe, 0xaa, 0xeb, 0x5a, 0x57, 0xb1,
+	0x57, 0x6c, 0x2f, 0xe6, 0xe6, 0xb0, 0xb9, 0x62, 0xb7, 0x3e, 0x65, 0xab, 0xbf, 0xc6, 0x92, 0xfe,
+	0x50, 0x82, 0xc4, 0x50, 0xb0, 0x22, 0x44, 0xd0, 0x8c, 0xbc, 0xe6, 0xf8, 0xa2, 0xdf, 0xae, 0xa2,
+	0xfe, 0x57, 0xc7, 0x64, 0xd6, 0x97, 0xce, 0x41, 0xb8, 0x3d, 0x38, 0xe0, 0x55, 0xa5, 0x17, 0x02,
+	0x55, 0x25, 0x57, 0x5d, 0xab, 0x63, 0x32, 0xf6, 0xa4, 0xcb, 0x10, 0xeb, 0x9a, 0xed, 0xfd, 0xc1,
+	0x80, 0x97, 0xae, 0x03, 0x0e, 0xef, 0xd5, 0xd6, 0xea, 0x98, 0xcc, 0xfb, 0xbb, 0x52, 0x97, 0x27,
+	0x01, 0x5c, 0x55, 0xb1, 0xe2, 0xed, 0xcc, 0x0f, 0x63, 0x00, 0x8d, 0xe3, 0xe1, 0xee, 0x7a, 0x0b,
+	0xc6, 0x5b, 0xaa, 0xa5, 0xba, 0xc6, 0x49, 0x16, 0xb3, 0xe7, 0x6d, 0x09, 0x21, 0x3c, 0x24, 0x5a,
+	0x82, 0x7f, 0xb5, 0x59, 0xd1, 0x59, 0x6f, 0x9e, 0xb0, 0x98, 0x1e, 0x91, 0x5d, 0x00, 0x13, 0x24,
+	0x4d, 0x57, 0x77, 0xbb, 0x9a, 0xd2, 0x34, 0x9b, 0xbc, 0xdc, 0x34, 0x6e, 0x23, 0x15, 0xb3, 0x89,
+	0x83, 0x87, 0x6f, 0x1a, 0x58, 0x68, 0x49, 0xcb, 0x2e, 0x40, 0x67, 0x21, 0x82, 0x0f, 0x3c, 0x6c,
+	0xe4, 0x02, 0x95, 0xd5, 0x86, 0xd3, 0x53, 0x66, 0xfd, 0xe8, 0x5b, 0x90, 0x68, 0x19, 0xcf, 0xf5,
+	0x6e, 0x47, 0x3f, 0xc8, 0x26, 0xd8, 0x98, 0xbb, 0xfe, 0xa5, 0xb8, 0x2b, 0x9f, 0xad, 0xf2, 0xae,
+	0xf2, 0x70, 0x10, 0x7d, 0x00, 0xb7, 0x9a, 0x98, 0x12, 0xe8, 0x96, 0xa9, 0x5a, 0x86, 0xa9, 0xb8,
+	0xb2, 0x8d, 0xdf, 0x91, 0xee, 0x85, 0xe5, 0x9b, 0x62, 0xeb, 0x70, 0xf6, 0xdc, 0x9f, 0x85, 0x21,
+	0xe1, 0xb0, 0xd1, 0xbb, 0x90, 0x56, 0x75, 0x4b, 0xd3, 0x75, 0x55, 0xe9, 0x60, 0x08, 0xe1, 0x2e,
+	0x9b, 0xe2, 0x20, 0x0b, 0x2b, 0xb8, 0x23, 0xac, 0x63, 0xa5, 0x6f, 0x3c, 0xd7, 0xec, 0xed, 0x16,
+	0x92, 0xe3, 0xd6, 0xf1, 0x0e, 0x3e, 0xd2, 0x39, 0x98, 0xea, 0xe8, 0x47, 0x9a, 0x69, 0x29, 0x7d,
+	0xa3, 0xab, 0x9a, 0x9d, 0x6f, 0x31, 0xdb, 0xf1, 0x60, 0x43, 0xed, 0xa6, 0x1d, 0xa1, 0x85, 0xee,
+	0x02, 0xc5, 0xe0, 0xaa, 0xe9, 0xca, 0xae, 0xd6, 0x36, 0x4c, 0x4d, 0xb1, 0xd4, 0xee, 0x01, 0xaf,
+	0x46, 0x2f, 0x5e, 0x61, 0xfd, 0xb3, 0x1b, 0x6c, 0x74, 0x99, 0x0d, 0x6e, 0xa8, 0xdd, 0x03, 0x99,
+	0x74, 0x7d, 0x08, 0xee, 0xc4, 0x4e, 0xaf, 0xdf, 0xed, 0x34, 0x3b, 0x96, 0xb2, 0xaf, 0xa9, 0x2d,
+	0xcd, 0xe4, 0xb6, 0xcc, 0x38, 0xf0, 0x2a, 0x43, 0xb1, 0x23, 0x86, 0x8d, 0x1e, 0x5a, 0xbc, 0xab,
+	0xe9, 0x7b, 0xd6, 0x3e, 0x37, 0x6b, 0xc6, 0x81, 0x37, 0x18, 0x9a, 0xfb, 0x25, 0x09, 0x88, 0x7f,
+	0x62, 0xdc, 0x8f, 0xe6, 0x60, 0xd0, 0x51, 0x2c, 0xd5, 0xdc, 0xe3, 0x81, 0x3e, 0x24, 0x03, 0x42,
+	0x0d, 0x86, 0x0c, 0x3b, 0xf0, 0x93, 0x20, 0xe4, 0x76, 0xe0, 0xe1, 0x7d, 0x09, 0xc6, 0x07, 0x4d,
+	0x55, 0x67, 0x96, 0xe3, 0xfb, 0x66, 0x3a, 0xe0, 0x37, 0x55, 0xfe, 0x6a, 0x4c, 0x4e, 0x60, 0x5f,
+	0xb4, 0xa3, 0xbb, 0x65, 0x46, 0xfd, 0x5a, 0x8f, 0x24, 0x42, 0x24, 0xbc, 0x1e, 0x49, 0x84, 0x49,
+	0x64, 0xe6, 0x17, 0x24, 0x98, 0x7e, 0x47, 0xb5, 0xb4, 0xe7, 0xea, 0x49, 0x89, 0x9b, 0xd4, 0x7d,
+	0x11, 0x46, 0x37, 0x21, 0xb9, 0x67, 0x37, 0x2a, 0x9d, 0xd6, 0x20, 0x2b, 0x8d, 0x7e, 0x59, 0xc4,
+	0xc7, 0x0b, 0x03, 0xc5, 0x93, 0x77, 0xcf, 0x69, 0x1d, 0x04, 0x9d, 0x29, 0x14, 0x74, 0xa6, 0x99,
+	0xdf, 0x92, 0x60, 0xba, 0xc2, 0x2a, 0xb8, 0x95, 0x20, 0xf1, 0x4f, 0x43, 0x22, 0x34, 0xd3, 0x9e,
+	0x69, 0x1c, 0xf6, 0x79, 0x17, 0x56, 0x12, 0x97, 0x81, 0x41, 0xb6, 0xc8, 0xdf, 0x0d, 0x41, 0xf2,
+	0x71, 0x1f, 0xbd, 0xaf, 0x61, 0x1c, 0x68, 0x3a, 0xad, 0x41, 0xd8, 0x15, 0xee, 0x2b, 0xe7, 0x08,
+	0x17, 0x54, 0xb7, 0x20, 0x23, 0x8e, 0xf7, 0x86, 0x93, 0x90, 0x3f, 0x9c, 0xfc, 0x0f, 0x48, 0x0e,
+	0x34, 0xf3, 0x48, 0x33, 0x45, 0xef, 0xb8, 0x28, 0xaa, 0x80, 0xdd, 0x1d, 0x01, 0xfa, 0x1a, 0x4c,
+	0x06, 0x42, 0x03, 0xdb, 0x64, 0x61, 0x99, 0xf8, 0xa3, 0x02, 0x7d, 0x13, 0x52, 0x8e, 0xce, 0x59,
+	0xbf, 0xe8, 0xa5, 0x53, 0x39, 0x36, 0x42, 0x64, 0xe6, 0xff, 0x4b, 0x90, 0x72, 0x76, 0xe7, 0x8e,
+	0x6a, 0xed, 0xd3, 0xbb, 0x90, 0x3a, 0x64, 0xda, 0x52, 0x2c, 0x54, 0x97, 0x7d, 0x69, 0x5c, 0x1d,
+	0x93, 0x93, 0x87, 0x82, 0x0e, 0x4b, 0x10, 0x6d, 0x77, 0x8e, 0xb5, 0x56, 0x36, 0x74, 0x4d, 0x2d,
+	0xae, 0x8e, 0xc9, 0xf6, 0xc8, 0x72, 0x12, 0x22, 0x7d, 0x9c, 0x8f, 0x1d, 0x14, 0xdf, 0x8e, 0xc2,
+	0x78, 0xe3, 0x98, 0xd7, 0xd6, 0xe8, 0x6b, 0x10, 0x65, 0x6f, 0x09, 0xce, 0x7b, 0xc9, 0xc7, 0x1c,
+	0x50, 0xb6, 0xfb, 0xd0, 0x0a, 0x64, 0x9c, 0x98, 0xaa, 0x20, 0xe1, 0x80, 0xe5, 0x31, 0x23, 0x4e,
+	0x4a, 0x71, 0x95, 0x72, 0xba, 0x25, 0x3c, 0x0d, 0xe8, 0xd7, 0x60, 0x9c, 0xe5, 0x7d, 0x2c, 0xed,
+	0x0c, 0x5f, 0x35, 0xed, 0x4c, 0x60, 0xb2, 0x87, 0x18, 0xfd, 0x2a, 0xcf, 0x1b, 0x87, 0xa7, 0x5b,
+	0xea, 0xe2, 0xd3, 0xcd, 0xce, 0x13, 0xf9, 0x03, 0xbd, 0x6b, 0x8f, 0x76, 0x8f, 0xb6, 0x28, 0x3b,
+	0xda, 0x52, 0xe6, 0x71, 0x61, 0xc5, 0xc1, 0xec, 0x29, 0x8a, 0xc2, 0x14, 0xe9, 0xcb, 0xa7, 0x28,
+	0x7a, 0xa7, 0x28, 0x0a, 0x53, 0xc4, 0x9d, 0x29, 0x8a, 0xee, 0x14, 0xab, 0x90, 0xe8, 0x9b, 0x1d,
+	0xc3, 0xec, 0x58, 0x27, 0xec, 0x4c, 0xcb, 0x04, 0xf7, 0x6e, 0xe3, 0xb8, 0xde, 0xdc, 0xd7, 0x5a,
+	0x87, 0x5d, 0x6d, 0x87, 0xf7, 0x14, 0xf5, 0xe1, 0x8c, 0xa6, 0x6f, 0x41, 0x5a, 0xdd, 0x1d, 0x18,
+	0xdd, 0x43, 0x4b, 0xb3, 0xbd, 0x72, 0xfc, 0x52, 0xaf, 0x4c, 0x39, 0x03, 0x10, 0xa2, 0x0b, 0x30,
+	0x39, 0x94, 0x55, 0xe9, 0x77, 0x55, 0x1d, 0x6f, 0xcc, 0x80, 0x39, 0x13, 0x7b, 0x9b, 0x65, 0x86,
+	0xb2, 0x6f, 0xcb, 0x13, 0xc3, 0x1e, 0x3b, 0x5d, 0x55, 0x5f, 0xc3, 0xf7, 0xb3, 0x09, 0xb5, 0x75,
+	0xa4, 0xea, 0x4d, 0xad, 0x95, 0x6d, 0x5e, 0xfc, 0x86, 0x74, 0xd8, 0x71, 0x3d, 0x92, 0x88, 0x90,
+	0xe8, 0x7a, 0x24, 0x11, 0x23, 0xf1, 0xf5, 0x48, 0x22, 0x49, 0x52, 0x33, 0x7f, 0xb0, 0xc0, 0xde,
+	0xfa, 0x56, 0x8c, 0x5e, 0x4f, 0xd5, 0x5b, 0xb4, 0x0c, 0xe1, 0x66, 0xa7, 0xc5, 0x7d, 0xf1, 0xd5,
+	0x11, 0xef, 0xf4, 0x79, 0x47, 0xd7, 0xcb, 0xcb, 0xf0, 0x71, 0x39, 0xfe, 0x81, 0x14, 0x21, 0xd2,
+	0x9d, 0x31, 0x19, 0x07, 0xd3, 0x57, 0x20, 0x69, 0xaa, 0xcf, 0x87, 0xaf, 0x6f, 0x43, 0x7c, 0x4f,
+	0x81, 0xa9, 0x3e, 0x77, 0x2a, 0x35, 0x65, 0x18, 0x37, 0xb5, 0x01, 0x96, 0x06, 0x74, 0xe7, 0x03,
+	0x82, 0xbb, 0xe7, 0x4f, 0x36, 0x2b, 0x63, 0xdf, 0x35, 0x1d, 0x5f, 0xa4, 0x27, 0x4c, 0xfe, 0x9b,
+	0xd6, 0x00, 0x6c, 0x8e, 0xa6, 0xa1, 0xb7, 0xf9, 0xb1, 0xfc, 0xea, 0x65, 0x24, 0x15, 0x43, 0x6f,
+	0xaf, 0x8e, 0xc9, 0xe3, 0xa6, 0xf3, 0x40, 0xb7, 0x21, 0xc3, 0xb6, 0x53, 0x73, 0x5f, 0x6b, 0x1e,
+	0x28, 0xaa, 0xee, 0xdc, 0xe6, 0xbe, 0x7c, 0x01, 0xd5, 0x46, 0x47, 0x3f, 0xa8, 0x60, 0xff, 0x92,
+	0x8e, 0x9b, 0x3c, 0xd5, 0x15, 0x9e, 0xe9, 0x1a, 0xb0, 0x67, 0x05, 0xdf, 0x2c, 0xe2, 0x4d, 0xc3,
+	0xfe, 0x50, 0xe0, 0xbf, 0x5d, 0x42, 0x57, 0xaa, 0xca, 0xb2, 0xf6, 0x3e, 0xaa, 0x09, 0x07, 0x97,
+	0x5a, 0x26, 0xbe, 0x7f, 0x14, 0xa9, 0x50, 0xb2, 0xf8, 0x55, 0xa9, 0x6c, 0xb9, 0x1c, 0x2a, 0x94,
+	0x6a, 0x1b, 0x32, 0xad, 0x43, 0xeb, 0x44, 0x69, 0x9e, 0x34, 0xbb, 0x1a, 0x93, 0x2b, 0x71, 0xe9,
+	0x32, 0xab, 0x87, 0xd6, 0x49, 0x05, 0xfb, 0xdb, 0x92, 0xa5, 0x5a, 0xc2, 0x33, 0x7d, 0x06, 0xd4,
+	0x3c, 0x56, 0xfa, 0xaa, 0xa9, 0xf6, 0xf0, 0x22, 0x7c, 0xd8, 0x67, 0xa4, 0xb6, 0xeb, 0xe7, 0x2f,
+	0x32, 0xc3, 0xf1, 0x0e, 0x8e, 0xa9, 0xe3, 0x10, 0x9b, 0x77, 0xc2, 0xf4, 0x42, 0x23, 0xa8, 0x71,
+	0xf1, 0x70, 0x2d, 0x6a, 0x5b, 0x03, 0x1e, 0x6a, 0x47, 0x0d, 0xda, 0x91, 0x32, 0xb0, 0x54, 0xeb,
+	0x70, 0xc0, 0x68, 0x93, 0x97, 0xab, 0x41, 0x3b, 0xaa, 0xb3, 0xfe, 0xdc, 0xda, 0x2d, 0xe1, 0x99,
+	0xca, 0x30, 0xa1, 0x6b, 0xcf, 0x87, 0x77, 0x23, 0xd4, 0x81, 0x1d, 0x0e, 0xef, 0x5d, 0xc0, 0xb8,
+	0xa5, 0x3d, 0xe7, 0xd7, 0x25, 0x5b, 0x03, 0x69, 0x5d, 0x04, 0xfc, 0x9c, 0x28, 0x65, 0xfa, 0x1a,
+	0x9c, 0xb6, 0x98, 0x02, 0xa7, 0xb3, 0xf0, 0xae, 0x47, 0xcc, 0xcc, 0xe5, 0x0b, 0xdf, 0xf0, 0x48,
+	0x99, 0x6a, 0x75, 0x05, 0x21, 0xbd, 0x84, 0x28, 0xe3, 0xc4, 0xd5, 0x09, 0x1d, 0x4d, 0x76, 0x05,
+	0x09, 0xbf, 0x09, 0x53, 0xe6, 0x31, 0x06, 0x50, 0xbc, 0x36, 0xba, 0x1e, 0x45, 0x18, 0xeb, 0x6b,
+	0x17, 0x9a, 0xbd, 0xc1, 0x06, 0x09, 0x2e, 0x45, 0x4c, 0x1f, 0x86, 0x3e, 0x65, 0x05, 0xdd, 0x75,
+	0xf2, 0x52, 0x9f, 0x6a, 0x04, 0xdd, 0xd5, 0xf2, 0x42, 0x76, 0x30, 0x3b, 0xd0, 0x4e, 0x58, 0x30,
+	0xa3, 0x57, 0x08, 0x66, 0x07, 0xda, 0xc9, 0x30, 0x98, 0xd9, 0xbf, 0xed, 0x60, 0x86, 0x1c, 0x2c,
+	0x98, 0x4d, 0x5d, 0x21, 0x98, 0x1d, 0x68, 0x27, 0x6e, 0x30, 0xe3, 0x0f, 0xa8, 0x43, 0x8c, 0x15,
+	0xfe, 0x65, 0xde, 0xb8, 0x54, 0x87, 0xa5, 0xaa, 0xec, 0x5f, 0x27, 0x51, 0x5b, 0xa6, 0x77, 0xa1,
+	0x32, 0x7e, 0x9b, 0x73, 0xd4, 0x69, 0xda, 0xc7, 0x1c, 0xb3, 0xf9, 0xcd, 0x4b, 0xfd, 0xb2, 0xca,
+	0x46, 0xe0, 0x39, 0xc7, 0xfd, 0xb2, 0x25, 0x02, 0xf4, 0x31, 0x90, 0xb6, 0x61, 0x36, 0x31, 0x24,
+	0x39, 0x9f, 0x59, 0x65, 0x6f, 0x8d, 0xce, 0xb3, 0x04, 0xd2, 0x15, 0x1c, 0x32, 0x7c, 0x47, 0xb9,
+	0x3a, 0x26, 0x67, 0xda, 0x1e, 0x84, 0x6a, 0xc3, 0xef, 0xb6, 0xfc, 0xba, 0xb8, 0xcd, 0xc8, 0x67,
+	0x2f, 0xd4, 0x2d, 0x0e, 0xf4, 0xab, 0x63, 0xca, 0x0c, 0xc2, 0xe7, 0x4c, 0x83, 0x8a, 0xc9, 0x5e,
+	0x7b, 0x1a, 0x5b, 0x3d, 0x81, 0x69, 0x50, 0x49, 0xcf, 0x80, 0xf6, 0xd9, 0xae, 0xe8, 0x1a, 0x78,
+	0x64, 0xb6, 0x0d, 0xb6, 0x92, 0xe9, 0x4b, 0x9d, 0x77, 0x07, 0x77, 0x40, 0xd7, 0xb0, 0xd6, 0xf4,
+	0xb6, 0xc1, 0x9d, 0xb7, 0xef, 0x85, 0xe8, 0x2e, 0xdc, 0x74, 0xa9, 0xc5, 0xf0, 0x90, 0x63, 0xec,
+	0xf7, 0xaf, 0xc0, 0xee, 0x09, 0x12, 0xb4, 0x1f, 0x40, 0x47, 0xcf, 0x81, 0x4a, 0x7a, 0xe1, 0xba,
+	0x73, 0xd8, 0x3a, 0xf2, 0xcf, 0x81, 0x2a, 0x7a, 0x17, 0x26, 0x77, 0x35, 0xb5, 0x69, 0xe8, 0x4e,
+	0x04, 0x41, 0xfe, 0x17, 0x2f, 0xd5, 0x50, 0x99, 0x8d, 0xb1, 0x63, 0x05, 0x3f, 0x32, 0x76, 0xbd,
+	0x10, 0x7a, 0x3d, 0x67, 0xc6, 0x14, 0x8c, 0xe9, 0xe6, 0x4b, 0x97, 0x7a, 0xbd, 0xcd, 0x8b, 0xd9,
+	0x26, 0x8f, 0xf0, 0xbb, 0x22, 0xe0, 0xe7, 0x44, 0x59, 0x5f, 0xba, 0x06, 0x27, 0xdf, 0x49, 0xbb,
+	0x22, 0x20, 0xec, 0xce, 0x9e, 0xd1, 0xd2, 0x58, 0x30, 0x7a, 0xf9, 0x8a, 0xbb, 0x73, 0xd3, 0x68,
+	0x69, 0x76, 0x44, 0x4a, 0xb7, 0x44, 0x00, 0x77, 0xa7, 0xc8, 0xc9, 0x82, 0xd3, 0x9d, 0x4b, 0x77,
+	0xa7, 0x4b, 0xca, 0x23, 0x54, 0xa6, 0xe5, 0x41, 0x72, 0x32, 0x24, 0x9c, 0x94, 0x8e, 0xae, 0x40,
+	0xba, 0xd7, 0xd1, 0x0d, 0x53, 0x39, 0xd2, 0xcc, 0x01, 0x16, 0x64, 0xce, 0xfb, 0xd8, 0x11, 0x3b,
+	0xb9, 0xc9, 0x66, 0x56, 0x92, 0x53, 0x6c, 0xdc, 0x13, 0x7b, 0x58, 0xae, 0x0e, 0xe3, 0xc3, 0x0c,
+	0xef, 0x33, 0x23, 0x55, 0x20, 0x25, 0xe6, 0x7a, 0xf4, 0x0e, 0xc4, 0x7a, 0xaa, 0xb9, 0xd7, 0xb1,
+	0x09, 0x87, 0xdf, 0x37, 0xfe, 0x87, 0x24, 0x73, 0x9c, 0xde, 0x87, 0xb4, 0x73, 0x43, 0x6d, 0x1a,
+	0x87, 0x7a, 0xf0, 0x43, 0x48, 0xe7, 0x02, 0x5b, 0xc1, 0xd6, 0xdc, 0xaf, 0x86, 0x00, 0xdc, 0xf4,
+	0x8f, 0x6e, 0xc3, 0xc4, 0xf0, 0xce, 0x23, 0x54, 0xb9, 0xae, 0x51, 0x4d, 0x4f, 0xb7, 0xc4, 0x06,
+	0x7a, 0x1f, 0x32, 0x4e, 0x3d, 0x4c, 0x2c, 0x2b, 0xb0, 0x7b, 0x45, 0x1e, 0xbf, 0x92, 0x4b, 0xf1,
+	0xf2, 0x98, 0xdd, 0xfd, 0x35, 0x48, 0x39, 0xfb, 0x13, 0xcb, 0xe1, 0x76, 0x35, 0x9c, 0xb1, 0x7f,
+	0x28, 0x85, 0x08, 0x91, 0x93, 0xbc, 0x15, 0x8b, 0xe3, 0xf4, 0x75, 0xb8, 0x21, 0x76, 0x46, 0xef,
+	0xb0, 0x4c, 0xa3, 0x9b, 0x8d, 0x8a, 0x33, 0xc4, 0x65, 0x2a, 0x8c, 0xa9, 0xd8, 0x5d, 0xe8, 0x0c,
+	0x24, 0xf4, 0x5d, 0xc5, 0x32, 0xd1, 0xf1, 0x63, 0x5e, 0x81, 0xe2, 0xfa, 0x6e, 0x03, 0x71, 0xfb,
+	0xae, 0x92, 0xfb, 0x50, 0x1a, 0x2a, 0x08, 0x0d, 0x70, 0x0f, 0x88, 0x67, 0x4e, 0xfc, 0x3c, 0xcf,
+	0xfe, 0xa0, 0x2f, 0x23, 0x4c, 0x53, 0x6a, 0x1e, 0xd0, 0xfb, 0x30, 0xe5, 0x53, 0x25, 0xeb, 0x6c,
+	0x7f, 0xe3, 0x47, 0x3c, 0x5a, 0xc2, 0xee, 0xaf, 0xd9, 0xf9, 0x81, 0xab, 0x28, 0xc5, 0xfd, 0xf2,
+	0x6f, 0x42, 0xd4, 0x51, 0xa9, 0x79, 0x90, 0x6b, 0x42, 0x4a, 0xcc, 0x8d, 0x69, 0x1d, 0x32, 0x3d,
+	0xf5, 0x58, 0x71, 0x13, 0x6c, 0x6e, 0xb5, 0x40, 0x1a, 0x50, 0xda, 0xdb, 0x33, 0x35, 0x74, 0x80,
+	0xd6, 0x70, 0xbc, 0x60, 0xbb, 0x54, 0x4f, 0x3d, 0x1e, 0xe2, 0xb9, 0x7f, 0x95, 0x60, 0xc2, 0x97,
+	0x2c, 0xd3, 0x27, 0x30, 0xe5, 0xb9, 0x17, 0x7f, 0x3a, 0x1f, 0x21, 0xc2, 0x65, 0xd9, 0xb6, 0xfb,
+	0x33, 0xb8, 0xe1, 0xb9, 0xd2, 0x8b, 0x75, 0xc0, 0xeb, 0xbc, 0x11, 0x9a, 0x14, 0x6e, 0xfa, 0x76,
+	0x23, 0x9d, 0xf5, 0x5f, 0xc6, 0x51, 0xa7, 0x11, 0xf6, 0x1d, 0x67, 0x31, 0x72, 0xef, 0x7b, 0x3f,
+	0x1f, 0xf3, 0xde, 0xcb, 0x73, 0xbf, 0xe9, 0x5b, 0x36, 0x5a, 0x7d, 0x11, 0x6e, 0x8f, 0x58, 0xb6,
+	0x60, 0xfc, 0x29, 0xff, 0x8a, 0xd0, 0xa4, 0x4b, 0x90, 0x1d, 0xb5, 0x28, 0xc1, 0x0d, 0x6e, 0x04,
+	0xc4, 0xc5, 0x71, 0x79, 0x98, 0xf4, 0x48, 0x2c, 0x7a, 0x82, 0x28, 0x2a, 0x7a, 0xc2, 0xff, 0x86,
+	0x94, 0x78, 0x3d, 0xa0, 0x33, 0x10, 0xdf, 0x55, 0x2d, 0x4b, 0x33, 0x4f, 0xbc, 0x11, 0xe2, 0x13,
+	0x49, 0x76, 0x1a, 0x68, 0x7e, 0x18, 0x44, 0x50, 0x8a, 0x68, 0x99, 0x7e, 0x5c, 0x9e, 0xc8, 0xa5,
+	0xb3, 0x2f, 0xdf, 0xfb, 0xd1, 0x27, 0xfc, 0xbf, 0x61, 0x38, 0xc9, 0x7d, 0x27, 0x04, 0x69, 0xcf,
+	0x6d, 0x01, 0x03, 0x8c, 0xb3, 0x03, 0x84, 0x32, 0xb8, 0x18, 0x60, 0x78, 0xb3, 0x6d, 0xd9, 0xaf,
+	0x88, 0x6f, 0x11, 0x42, 0x4c, 0xf5, 0xc9, 0x8f, 0xcb, 0x89, 0x62, 0x2c, 0x3b, 0xc6, 0x94, 0xef,
+	0xb6, 0xa2, 0x73, 0xf5, 0x3a, 0x7a, 0xc0, 0xb9, 0xc2, 0xd7, 0x74, 0xae, 0x5e, 0x47, 0xf7, 0xb4,
+	0x31, 0x5e, 0xf5, 0x38, 0xc0, 0x1b, 0xb9, 0x2e, 0xaf, 0x7a, 0xec, 0x69, 0xcb, 0xbd, 0x2b, 0xaa,
+	0x06, 0x95, 0x7f, 0x17, 0xd2, 0x5e, 0xa3, 0xd9, 0xce, 0x91, 0x6a, 0x0b, 0x16, 0xa3, 0x33, 0x90,
+	0x76, 0x25, 0x71, 0x5d, 0x21, 0xe9, 0x44, 0x04, 0xb4, 0x6a, 0x1b, 0x52, 0xd5, 0x8d, 0x4f, 0xaf,
+	0xf3, 0x2f, 0x07, 0x75, 0x2e, 0xb8, 0xbb, 0xdb, 0x96, 0x53, 0x84, 0x79, 0x70, 0x01, 0x79, 0x98,
+	0xf4, 0xcc, 0x23, 0x2c, 0x62, 0x42, 0x9c, 0x01, 0xd7, 0x11, 0x58, 0x6c, 0x28, 0xb8, 0xd8, 0xdc,
+	0x23, 0x20, 0xfe, 0xdb, 0x11, 0x7d, 0x08, 0x51, 0xbb, 0xf4, 0x27, 0x5d, 0xb5, 0xf4, 0x67, 0xf7,
+	0xcf, 0xfd, 0x40, 0x82, 0x09, 0xdf, 0x75, 0x88, 0xae, 0xdb, 0x91, 0x4f, 0xeb, 0x98, 0x7d, 0x4f,
+	0x2c, 0x0a, 0xbe, 0x0b, 0x67, 0x19, 0x40, 0x6d, 0x4d, 0xde, 0xf1, 0x05, 0xbc, 0x5a, 0xc7, 0xb4,
+	0x6b, 0xd7, 0xb8, 0x7a, 0x5e, 0x8c, 0x6d, 0x3d, 0xd7, 0xba, 0x5d, 0xbb, 0x96, 0x66, 0xaf, 0x6a,
+	0xc2, 0x6e, 0xa8, 0x22, 0xce, 0x4a, 0x66, 0xb3, 0x30, 0x35, 0x2c, 0x84, 0x0a, 0xbd, 0xed, 0x5d,
+	0x3a, 0xe9, 0x34, 0x0d, 0xfb, 0xe7, 0x76, 0x30, 0xe3, 0xe0, 0x77, 0xad, 0xea, 0xb5, 0x92, 0x03,
+	0x51, 0x5a, 0x31, 0x35, 0xf8, 0x3a, 0xe6, 0x1b, 0xce, 0xbd, 0xeb, 0xb3, 0xa1, 0xfc, 0x4b, 0x09,
+	0x88, 0xff, 0x22, 0x46, 0x77, 0xe1, 0x96, 0xf3, 0x8d, 0x7a, 0xb7, 0xd3, 0xeb, 0x58, 0x8a, 0x76,
+	0xdc, 0x37, 0x74, 0x4d, 0xb7, 0xce, 0x3d, 0x63, 0xaa, 0x72, 0xa9, 0x79, 0xb0, 0x81, 0x7d, 0x6b,
+	0xbc, 0xab, 0x30, 0xe3, 0x94, 0xfd, 0x75, 0xbb, 0xa7, 0x59, 0x9c, 0x83, 0x99, 0xda, 0x9d, 0x23,
+	0x74, 0xd1, 0x1c, 0xcc, 0x4f, 0xce, 0x9f, 0xc3, 0xd3, 0x9c, 0x5b, 0x83, 0xb4, 0xe7, 0x2a, 0xc8,
+	0xde, 0xe1, 0x5f, 0xe9, 0x25, 0x24, 0x63, 0xfe, 0xbe, 0x14, 0x4a, 0x48, 0xf6, 0xeb, 0xc8, 0xdc,
+	0xf7, 0x43, 0x90, 0xf1, 0xde, 0x00, 0x3f, 0xe3, 0xaf, 0x5a, 0x47, 0xa4, 0x61, 0xa1, 0x9f, 0x28,
+	0x0d, 0xbb, 0x87, 0x7f, 0x32, 0x75, 0xac, 0x98, 0x1a, 0xfb, 0xc3, 0xb3, 0x6c, 0x58, 0x4c, 0x79,
+	0xe2, 0xf8, 0xd7, 0x51, 0xc7, 0xb2, 0xdd, 0x44, 0x9f, 0xc2, 0x44, 0x5f, 0x33, 0x3b, 0x46, 0xcb,
+	0xb5, 0x41, 0x64, 0x74, 0x31, 0x96, 0xdf, 0x1f, 0x59, 0xe7, 0x11, 0x46, 0xc8, 0xf4, 0x3d, 0x2d,
+	0xb9, 0x3f, 0x92, 0x60, 0x6a, 0xc4, 0xcd, 0x96, 0xfe, 0x4f, 0xa0, 0x28, 0x1a, 0x4b, 0x56, 0x2f,
+	0xf5, 0x2d, 0x9b, 0x80, 0xa5, 0xae, 0x23, 0xa6, 0xc4, 0x10, 0xed, 0x69, 0xc3, 0x5b, 0x19, 0x92,
+	0xb3, 0x72, 0x81, 0xcf, 0xa7, 0x66, 0x46, 0x73, 0xa3, 0xd5, 0x47, 0x50, 0x4f, 0xf4, 0xd4, 0x63,
+	0xb1, 0x29, 0xb7, 0x1a, 0x5c, 0x0d, 0x3a, 0x55, 0x01, 0x6e, 0x06, 0x26, 0x14, 0xa2, 0x28, 0xf5,
+	0xd1, 0x60, 0x8c, 0xac, 0xc3, 0x84, 0xef, 0x9e, 0x4c, 0xdf, 0x86, 0x98, 0xad, 0xbd, 0xf3, 0x3e,
+	0x89, 0x71, 0x06, 0xd8, 0xda, 0x17, 0xe4, 0xe4, 0xe3, 0x72, 0xbf, 0x28, 0x01, 0x0d, 0xde, 0x8f,
+	0xbd, 0xa7, 0xb1, 0x74, 0xe1, 0x69, 0xfc, 0x59, 0xfb, 0x60, 0x6e, 0x3f, 0x20, 0xd1, 0x95, 0xcf,
+	0xcc, 0xeb, 0xe5, 0xd2, 0x39, 0x15, 0x26, 0x7c, 0xf7, 0x6a, 0xfa, 0xb2, 0x78, 0xe8, 0x78, 0xfe,
+	0x8a, 0xc7, 0xc6, 0x83, 0x47, 0x6c, 0xe8, 0xa2, 0x23, 0x36, 0xf7, 0x06, 0xa4, 0x3d, 0x57, 0xec,
+	0x6b, 0x68, 0x36, 0xb7, 0x28, 0x8e, 0xbd, 0xaa, 0x0e, 0x72, 0x2b, 0x4e, 0xfc, 0x72, 0xee, 0xc6,
+	0x0f, 0xae, 0xf2, 0xe2, 0x4e, 0x3c, 0x45, 0x59, 0xef, 0xdc, 0x3b, 0x90, 0xf1, 0xde, 0x8f, 0x3f,
+	0x25, 0x51, 0x79, 0x1c, 0xe2, 0xfc, 0x15, 0xcb, 0x4c, 0x0d, 0x92, 0xee, 0xf5, 0x7b, 0x40, 0x97,
+	0x20, 0xd1, 0xe4, 0xbf, 0xb3, 0x12, 0x7b, 0x3f, 0x98, 0x3b, 0xff, 0xb6, 0x2e, 0x0f, 0xfb, 0xce,
+	0x54, 0x20, 0x33, 0x4c, 0x6e, 0x9f, 0xa8, 0xdd, 0x43, 0x0d, 0xcd, 0x76, 0x84, 0x3f, 0xb8, 0x46,
+	0x85, 0x2c, 0xc6, 0xc6, 0xdf, 0x20, 0x67, 0xa7, 0xd3, 0xa1, 0xac, 0xf4, 0xd1, 0xe9, 0x74, 0x0c,
+	0xbf, 0x92, 0xca, 0x4a, 0x33, 0x9b, 0x70, 0xeb, 0x1b, 0x9a, 0x69, 0xe0, 0x87, 0x28, 0x3e, 0xb2,
+	0x57, 0xbc, 0x64, 0x1e, 0xf3, 0x9c, 0x4b, 0xb7, 0x07, 0x53, 0xde, 0x0c, 0xdd, 0xe6, 0xfa, 0x9a,
+	0xc8, 0x75, 0x9d, 0x1b, 0xca, 0xb9, 0x13, 0x69, 0x40, 0x3d, 0x1b, 0xc9, 0x9e, 0xe7, 0x4d, 0xef,
+	0x3c, 0x57, 0xff, 0xa8, 0xed, 0x82, 0xf5, 0x78, 0x83, 0xc7, 0xd5, 0xd6, 0x73, 0x6e, 0xc0, 0x39,
+	0x77, 0xa2, 0xf7, 0x21, 0x3b, 0xe2, 0xb6, 0x69, 0xcf, 0x56, 0xf1, 0xce, 0x76, 0xcd, 0x6b, 0xea,
+	0xb9, 0x53, 0x3e, 0x83, 0x14, 0x4f, 0x1e, 0xed, 0x69, 0x1e, 0x7a, 0xa7, 0xb9, 0x4a, 0xa6, 0x79,
+	0xd1, 0x6a, 0x82, 0x79, 0xcd, 0x15, 0x57, 0x73, 0x61, 0x42, 0x74, 0xf9, 0x94, 0x9e, 0x3c, 0xe6,
+	0x3a, 0x53, 0x9e, 0x97, 0x1f, 0x9d, 0x3b, 0xa5, 0x02, 0x13, 0x6e, 0x9e, 0x6c, 0xcf, 0xf4, 0x86,
+	0x77, 0xa6, 0xab, 0xe5, 0xd5, 0xe7, 0x4d, 0x90, 0xff, 0x75, 0x09, 0xa2, 0xec, 0xaf, 0x86, 0x29,
+	0x81, 0xd4, 0xfa, 0xf6, 0xda, 0x96, 0x22, 0xd7, 0xbe, 0xfe, 0xb8, 0x56, 0x6f, 0x90, 0x31, 0x3a,
+	0x01, 0x49, 0x86, 0x94, 0x2a, 0x95, 0xda, 0x4e, 0x83, 0x48, 0x94, 0x42, 0xe6, 0xf1, 0x56, 0x65,
+	0x7b, 0x6b, 0x65, 0x4d, 0xde, 0xac, 0x55, 0x95, 0xc7, 0x3b, 0x24, 0x44, 0x6f, 0x00, 0x11, 0xb1,
+	0xea, 0xf6, 0xd3, 0x2d, 0x12, 0x46, 0x32, 0x4f, 0xbf, 0x08, 0x8e, 0xf5, 0xf5, 0x8a, 0x22, 0x26,
+	0xd7, 0x3c, 0x93, 0xc6, 0x70, 0xd2, 0x1d, 0x79, 0x7b, 0x47, 0x5e, 0xab, 0x35, 0x4a, 0xf2, 0x33,
+	0x12, 0xcf, 0xc5, 0x6c, 0x99, 0xf3, 0xf7, 0x20, 0xca, 0xfe, 0x4e, 0x99, 0x66, 0x00, 0x36, 0xb6,
+	0xe5, 0xd2, 0xd3, 0xd2, 0x96, 0x22, 0x17, 0xc8, 0x58, 0x6e, 0xe2, 0xec, 0x74, 0x3a, 0x99, 0x95,
+	0xf2, 0x71, 0x8e, 0xe6, 0x7f, 0x10, 0x62, 0x6f, 0xb0, 0x79, 0x12, 0x8d, 0x7f, 0xd3, 0xb9, 0x59,
+	0xaa, 0x28, 0x8f, 0xb7, 0x1e, 0x6d, 0xe1, 0xb4, 0x63, 0xb9, 0xf4, 0xd9, 0xe9, 0xf4, 0x38, 0x8d,
+	0x1f, 0xea, 0x07, 0xba, 0xf1, 0x1c, 0xdb, 0x13, 0xd8, 0xfe, 0xa4, 0xa0, 0xcc, 0x13, 0x29, 0x87,
+	0x2a, 0x4a, 0xd1, 0x70, 0x61, 0x76, 0x9e, 0x46, 0x0b, 0xb3, 0xf3, 0xb3, 0xf3, 0xf4, 0x05, 0x00,
+	0xa7, 0x5d, 0x29, 0x90, 0x50, 0x2e, 0x79, 0x76, 0x3a, 0x1d, 0xb7, 0x1b, 0x0b, 0x9e, 0xc6, 0x22,
+	0x09, 0x8b, 0x8d, 0x45, 0x81, 0xb9, 0x40, 0x22, 0x2e, 0x73, 0x01, 0xdb, 0x0b, 0x3e, 0xe6, 0x05,
+	0x12, 0x15, 0x07, 0x2f, 0x78, 0x1a, 0x17, 0x49, 0x4c, 0x6c, 0x5c, 0xcc, 0x3d, 0xc5, 0x87, 0xac,
+	0x94, 0x0f, 0x6f, 0x96, 0x2a, 0x1f, 0x9d, 0x4e, 0xaf, 0xc2, 0xca, 0x35, 0x3e, 0xb7, 0xb6, 0xf4,
+	0xfe, 0xee, 0xac, 0xab, 0x20, 0xc5, 0xfe, 0xbc, 0x99, 0x79, 0x48, 0xfe, 0x07, 0x51, 0x80, 0x9d,
+	0xd5, 0x67, 0x82, 0xee, 0x76, 0x56, 0x9f, 0x9d, 0xaf, 0xbb, 0x57, 0x21, 0x81, 0xed, 0x5c, 0x77,
+	0xb7, 0xce, 0x4e, 0xa7, 0xa9, 0x47, 0x77, 0x11, 0x6c, 0x41, 0x8b, 0x35, 0xea, 0xf3, 0xf3, 0x05,
+	0xde, 0x8f, 0xde, 0x65, 0x73, 0xb8, 0x1a, 0x9d, 0x3a, 0x3b, 0x9d, 0x9e, 0x70, 0x34, 0x1a, 0xb3,
+	0x61, 0x74, 0x21, 0x77, 0x10, 0x76, 0xa3, 0x6f, 0x03, 0x19, 0x0e, 0x2b, 0x2a, 0x72, 0xed, 0x89,
+	0x52, 0x22, 0xe1, 0x5c, 0xfe, 0xec, 0x74, 0xfa, 0xbf, 0x3b, 0x1a, 0x8f, 0xb3, 0x7f, 0xee, 0xab,
+	0x9c, 0xa5, 0x48, 0x53, 0x62, 0x6f, 0xe4, 0x94, 0x77, 0x86, 0x9c, 0x45, 0x12, 0xa6, 0x85, 0x00,
+	0x67, 0x99, 0x44, 0x72, 0x2f, 0x9c, 0x9d, 0x4e, 0xdf, 0x76, 0xc8, 0x76, 0x3d, 0x24, 0x65, 0x7a,
+	0x0b, 0xa8, 0x48, 0xe2, 0x0c, 0xa2, 0x8b, 0x90, 0xe1, 0x54, 0x05, 0x2e, 0x5c, 0x34, 0x77, 0xe7,
+	0xec, 0x74, 0xfa, 0x45, 0x66, 0xee, 0xfb, 0x2a, 0xf2, 0x15, 0x66, 0xe7, 0xef, 0xab, 0x14, 0xdc,
+	0x3e, 0xb8, 0x7f, 0x86, 0x6c, 0xc3, 0x71, 0x01, 0xae, 0x32, 0x89, 0x89, 0x5c, 0xbb, 0x0e, 0xd7,
+	0xae, 0xc0, 0x55, 0x1e, 0xc1, 0x55, 0x26, 0x31, 0xcf, 0x12, 0x17, 0xf8, 0x0c, 0x71, 0x71, 0x89,
+	0x0b, 0xf7, 0x55, 0x9a, 0x12, 0x9b, 0x7d, 0x4b, 0x1c, 0x0e, 0x72, 0xf4, 0x57, 0xb4, 0xf1, 0x79,
+	0x92, 0xf0, 0x21, 0x05, 0x32, 0xee, 0x43, 0x8a, 0x04, 0x7c, 0xc8, 0x02, 0x49, 0xe6, 0x1a, 0xc3,
+	0x38, 0xf4, 0x29, 0x3c, 0xd7, 0x75, 0x4f, 0xd1, 0x73, 0x89, 0x94, 0xff, 0xb9, 0x30, 0xa4, 0xbd,
+	0x85, 0xa6, 0x09, 0x48, 0x56, 0x4b, 0x8d, 0x92, 0x22, 0x97, 0x1a, 0x35, 0x65, 0x9e, 0x8c, 0x79,
+	0x81, 0x02, 0x91, 0xbc, 0x40, 0x91, 0x84, 0xbc, 0xc0, 0x02, 0x09, 0x7b, 0x81, 0x45, 0x12, 0xf1,
+	0x02, 0x0f, 0x48, 0xd4, 0x0b, 0x2c, 0x91, 0x98, 0x17, 0x78, 0x48, 0xe2, 0x5e, 0x60, 0x99, 0x24,
+	0xbc, 0xc0, 0xeb, 0xb6, 0xd6, 0x04, 0xc1, 0xe6, 0x09, 0xf8, 0x90, 0x02, 0x49, 0xfa, 0x90, 0x22,
+	0x49, 0xf9, 0x90, 0x05, 0x92, 0xf6, 0x21, 0x8b, 0x24, 0xe3, 0x43, 0x1e, 0x90, 0x89, 0xdc, 0xff,
+	0x39, 0x3b, 0x9d, 0x4e, 0x13, 0x29, 0x3f, 0x3e, 0xc4, 0x3f, 0x3a, 0x9d, 0x7e, 0x04, 0x6b, 0xd7,
+	0x35, 0x84, 0x47, 0xd7, 0x9e, 0x28, 0xf2, 0x3b, 0x21, 0xc8, 0xf8, 0xaa, 0xbe, 0xb7, 0x80, 0xba,
+	0x62, 0x6c, 0xaf, 0xac, 0xd4, 0x6b, 0x0d, 0x66, 0x91, 0x51, 0x38, 0x1a, 0x66, 0x14, 0x8e, 0xf6,
+	0x19, 0x85, 0xa3, 0x99, 0x46, 0xe1, 0x68, 0xad, 0x51, 0x38, 0x1a, 0x6d, 0x14, 0x8e, 0xb6, 0x1b,
+	0x85, 0x3f, 0x24, 0xf1, 0xdc, 0xfe, 0xd9, 0xe9, 0xf4, 0x0d, 0x22, 0xe5, 0x89, 0xbf, 0xf5, 0xa3,
+	0xd3, 0xe9, 0x0d, 0x58, 0xff, 0xb4, 0xba, 0xb3, 0xb5, 0xe3, 0x51, 0xde, 0xff, 0x82, 0x89, 0x75,
+	0x6f, 0x51, 0x42, 0x38, 0x28, 0x2b, 0xdb, 0x5b, 0x8d, 0xda, 0xbb, 0x78, 0x3a, 0xbb, 0x58, 0xbd,
+	0x56, 0xaf, 0xaf, 0x6d, 0x6f, 0xd9, 0xde, 0xcc, 0xb1, 0x47, 0xb5, 0x67, 0x75, 0x12, 0xa2, 0xe3,
+	0x10, 0xc1, 0x47, 0xf2, 0x89, 0x34, 0x3c, 0x47, 0xdf, 0x82, 0xc9, 0x40, 0xd5, 0x83, 0x26, 0x21,
+	0xee, 0x32, 0x27, 0x21, 0xee, 0x52, 0x26, 0x20, 0x62, 0x73, 0x0d, 0x09, 0x96, 0x01, 0xdc, 0x3f,
+	0xa7, 0xc0, 0x29, 0x57, 0xd8, 0xe9, 0xbd, 0x55, 0x59, 0xab, 0xd5, 0xc9, 0x18, 0x9d, 0x84, 0x74,
+	0x65, 0xb5, 0xb4, 0xb5, 0x55, 0xdb, 0x50, 0x36, 0x4b, 0xf5, 0x47, 0x75, 0xe2, 0x4e, 0xfd, 0x26,
+	0x44, 0xd9, 0xd5, 0x85, 0x4d, 0xb7, 0x51, 0xaa, 0xd7, 0x95, 0x12, 0x19, 0x73, 0x1f, 0xca, 0x44,
+	0x72, 0x1f, 0x2a, 0x24, 0x64, 0x1f, 0x36, 0x59, 0x29, 0x1f, 0x65, 0x50, 0xfe, 0x18, 0x68, 0xf0,
+	0x03, 0x3c, 0x0a, 0x10, 0xdb, 0xd8, 0x7e, 0x6a, 0x67, 0x2c, 0x71, 0x08, 0x6f, 0x6c, 0x3f, 0x25,
+	0x12, 0x3a, 0x7d, 0xb9, 0xb6, 0xb1, 0xfd, 0x54, 0xd9, 0xda, 0x96, 0x37, 0x4b, 0x1b, 0x24, 0x84,
+	0xdd, 0xf8, 0x6f, 0x96, 0x9d, 0x94, 0xca, 0xdb, 0x4f, 0x6a, 0x4e, 0x6b, 0x04, 0x57, 0xb9, 0xba,
+	0xf6, 0xce, 0x2a, 0x89, 0xa2, 0x00, 0xf8, 0x8b, 0x25, 0x23, 0x43, 0xc1, 0xff, 0x26, 0x0c, 0x37,
+	0x46, 0x7d, 0xea, 0x46, 0xd3, 0x30, 0x5e, 0x59, 0xab, 0x2a, 0xf2, 0xca, 0x63, 0xe6, 0xcc, 0xce,
+	0x63, 0xad, 0x5e, 0xe3, 0xf9, 0x12, 0x3e, 0x6e, 0xac, 0x6d, 0x3d, 0x52, 0x2a, 0xab, 0xb5, 0xca,
+	0x23, 0x12, 0x62, 0x99, 0x91, 0x83, 0x95, 0xaa, 0x32, 0x09, 0x3b, 0xbd, 0xaa, 0x8f, 0x1b, 0xcf,
+	0x94, 0xca, 0xb3, 0xca, 0x46, 0xcd, 0xf6, 0x5a, 0x46, 0xf4, 0xae, 0xb2, 0x53, 0x92, 0x4b, 0x9b,
+	0x4a, 0xbd, 0xd6, 0x78, 0xbc, 0x63, 0x67, 0x4c, 0xac, 0x6f, 0xed, 0x89, 0x52, 0x6f, 0x94, 0x1a,
+	0x8f, 0xeb, 0x24, 0x46, 0xa7, 0x60, 0x02, 0xb1, 0xad, 0xda, 0x53, 0x85, 0x2b, 0x9e, 0xc4, 0xe9,
+	0x6d, 0x98, 0xe2, 0x04, 0x8d, 0xb5, 0xcd, 0xb5, 0xad, 0x77, 0x38, 0x43, 0xc2, 0x61, 0x6e, 0x78,
+	0x99, 0xc7, 0x87, 0xcc, 0x1b, 0x43, 0x12, 0x70, 0x97, 0xf3, 0xa8, 0xf6, 0x8c, 0x24, 0x1d, 0xce,
+	0x52, 0x55, 0xf6, 0x8c, 0x4d, 0x39, 0x12, 0x54, 0x6b, 0x4f, 0xd6, 0x2a, 0x35, 0x9c, 0xb0, 0x46,
+	0xd2, 0x78, 0x18, 0x21, 0xb8, 0xb2, 0x2d, 0x57, 0x6a, 0x8a, 0xed, 0x95, 0x24, 0x43, 0x73, 0x70,
+	0xcb, 0xa6, 0xc4, 0x67, 0x0f, 0xcd, 0x84, 0x23, 0xda, 0x0e, 0x13, 0x77, 0x63, 0xbb, 0xa1, 0xac,
+	0x6d, 0xad, 0x6c, 0x13, 0x42, 0xa7, 0xe1, 0xa6, 0x17, 0x77, 0x24, 0x9c, 0xa4, 0x37, 0x61, 0x12,
+	0x9b, 0xca, 0xb5, 0x52, 0x65, 0x7b, 0x8b, 0x2f, 0x95, 0x50, 0x47, 0x20, 0x0e, 0xa3, 0x7f, 0x92,
+	0x29, 0x9f, 0x94, 0x9b, 0xdb, 0xd5, 0x1a, 0xb9, 0x93, 0x4b, 0x3a, 0x89, 0x54, 0x65, 0xad, 0x9a,
+	0xff, 0xdb, 0x10, 0x4c, 0x8d, 0xb8, 0xe9, 0xb0, 0x10, 0x3a, 0xb4, 0x8e, 0x52, 0x20, 0x63, 0x3e,
+	0xa4, 0x48, 0x24, 0x1f, 0xb2, 0x48, 0x42, 0x3e, 0x64, 0x99, 0x84, 0x71, 0x6b, 0x88, 0x3c, 0x4b,
+	0x24, 0xe2, 0x83, 0x16, 0x8a, 0x24, 0xea, 0x83, 0x96, 0x16, 0x49, 0x0c, 0x8d, 0x23, 0x0e, 0x2c,
+	0x2e, 0x93, 0xb8, 0x0f, 0x2b, 0x3e, 0x58, 0x22, 0x09, 0x1f, 0xf6, 0xa0, 0x50, 0x24, 0xe3, 0xb8,
+	0x6c, 0x71, 0xec, 0x7c, 0x71, 0x91, 0x80, 0x0f, 0x2c, 0xce, 0x2f, 0x2e, 0x93, 0xa4, 0x0f, 0x5c,
+	0x9c, 0x7f, 0x7d, 0x89, 0xa4, 0x7c, 0xe0, 0x72, 0xe1, 0xf5, 0xa2, 0x6d, 0x5b, 0xcf, 0x42, 0x16,
+	0x96, 0xf1, 0xa4, 0xf1, 0xa2, 0x0b, 0xc5, 0x87, 0x4b, 0xcb, 0x64, 0x22, 0x47, 0xcf, 0x4e, 0xa7,
+	0x33, 0x59, 0x29, 0x0f, 0x6e, 0x5b, 0xfe, 0xf7, 0x25, 0xc8, 0x78, 0x2f, 0xb0, 0xb8, 0x6a, 0x66,
+	0xe0, 0xda, 0x93, 0x9a, 0xfc, 0x4c, 0x29, 0xf0, 0x48, 0x22, 0x40, 0xc5, 0x3a, 0x91, 0x7c, 0xd0,
+	0x22, 0x86, 0x38, 0x2f, 0xb4, 0x5c, 0xb7, 0x77, 0x94, 0xc8, 0xb5, 0x54, 0x27, 0x11, 0x1f, 0xb6,
+	0x50, 0xac, 0x93, 0xa8, 0x0f, 0x5b, 0x5a, 0xe4, 0xbb, 0x49, 0x1c, 0x5b, 0x5c, 0xae, 0x93, 0xb8,
+	0xbb, 0x06, 0xb7, 0x29, 0xff, 0xdd, 0xb0, 0x53, 0x70, 0xf4, 0x56, 0x38, 0xa7, 0x60, 0x62, 0x18,
+	0xab, 0x1f, 0x6f, 0x35, 0xd0, 0xcc, 0x63, 0x01, 0x70, 0x01, 0x5d, 0xc6, 0x0f, 0x2e, 0x2d, 0xda,
+	0xf7, 0x29, 0xef, 0xf0, 0x22, 0x7a, 0x8e, 0x1f, 0x45, 0x73, 0x47, 0x02, 0x28, 0x1a, 0x3c, 0x8a,
+	0x7b, 0xc2, 0xcb, 0x80, 0x26, 0x8f, 0x05, 0x60, 0x66, 0xf4, 0x78, 0x00, 0x66, 0x66, 0x4f, 0x04,
+	0x60, 0x66, 0xf8, 0x71, 0x96, 0x18, 0x7a, 0x17, 0x87, 0xa6, 0x87, 0x00, 0x6e, 0x1b, 0x3f, 0x19,
+	0xc0, 0x97, 0x1e, 0x3c, 0x58, 0x40, 0xaf, 0xba, 0x0d, 0x53, 0x5e, 0x9e, 0x85, 0xc2, 0xfc, 0x43,
+	0xf4, 0x2c, 0x7f, 0x43, 0x71, 0xa9, 0x58, 0x58, 0x44, 0xe7, 0xf2, 0x37, 0x3c, 0x28, 0x2e, 0x16,
+	0x97, 0xd1, 0xbf, 0x6e, 0x9c, 0x9d, 0x4e, 0x93, 0xac, 0x94, 0x4f, 0x89, 0xcd, 0xf9, 0xbf, 0x0e,
+	0x01, 0x0d, 0xd6, 0x8f, 0xd1, 0x59, 0x78, 0x37, 0x8c, 0x52, 0x2c, 0x66, 0xfb, 0xa0, 0x02, 0x91,
+	0xfc, 0x50, 0x91, 0x84, 0xfc, 0xd0, 0x02, 0x09, 0xfb, 0xa1, 0x45, 0x12, 0xf1, 0x43, 0x0f, 0x48,
+	0xd4, 0x0f, 0x61, 0x8a, 0xe1, 0x83, 0x30, 0x41, 0xf4, 0x41, 0x98, 0x22, 0xfa, 0xa0, 0xd7, 0xed,
+	0x18, 0xed, 0x11, 0x15, 0xd3, 0x44, 0x3f, 0x86, 0x89, 0xa2, 0x1f, 0xc3, 0x54, 0xd1, 0x8f, 0x61,
+	0xb2, 0xe8, 0xc7, 0x50, 0xcf, 0x7e, 0x0c, 0x13, 0x46, 0x76, 0x3f, 0xcb, 0x4a, 0xf9, 0xa4, 0xd0,
+	0x92, 0xff, 0x73, 0xc9, 0xf9, 0xff, 0x89, 0x78, 0xdf, 0x38, 0x08, 0x6e, 0xbd, 0x53, 0x93, 0xd7,
+	0xb6, 0xab, 0x4c, 0xcb, 0x01, 0xb0, 0x40, 0xa4, 0x20, 0x88, 0x9a, 0x0e, 0x80, 0xa8, 0xeb, 0x00,
+	0x88, 0xda, 0x0e, 0x80, 0xa8, 0xef, 0x00, 0xb8, 0x44, 0x62, 0x41, 0x10, 0x33, 0xba, 0x9b, 0x67,
+	0xa7, 0xd3, 0x93, 0x59, 0x29, 0x9f, 0xf6, 0x34, 0xe5, 0x7f, 0x14, 0x02, 0x70, 0x6b, 0x28, 0x2c,
+	0x1a, 0xdb, 0x27, 0x06, 0x3e, 0x2a, 0xcb, 0x76, 0xe6, 0x25, 0x42, 0x85, 0x79, 0x22, 0x05, 0x30,
+	0x5c, 0x89, 0x1f, 0x5b, 0x20, 0xe1, 0x00, 0xb6, 0x48, 0x22, 0x01, 0x6c, 0x89, 0x44, 0x03, 0xd8,
+	0x32, 0x89, 0xf9, 0xb1, 0xe2, 0x3c, 0x89, 0x07, 0xb0, 0x02, 0x49, 0x04, 0xb0, 0x45, 0x32, 0x1e,
+	0xc0, 0x96, 0x08, 0x04, 0xb0, 0x87, 0x24, 0x19, 0xc0, 0x5e, 0x27, 0x29, 0x3f, 0xb6, 0x30, 0x4f,
+	0xd2, 0x01, 0x6c, 0x81, 0x64, 0x02, 0xd8, 0x92, 0xe8, 0x3a, 0x42, 0x4b, 0xfe, 0x3b, 0x61, 0x98,
+	0x1a, 0x51, 0x83, 0x43, 0x33, 0x61, 0x72, 0x51, 0xaa, 0x3c, 0x52, 0x36, 0xd6, 0x36, 0xd7, 0x1a,
+	0xec, 0xa8, 0x0d, 0x80, 0x3c, 0x74, 0x7a, 0xc1, 0x45, 0x12, 0x0a, 0x82, 0x3c, 0x72, 0xfa, 0x38,
+	0x79, 0xe4, 0xf4, 0xa2, 0xec, 0xe4, 0x0d, 0xa0, 0x4b, 0x3c, 0x70, 0xfa, 0x18, 0x8a, 0x3c, 0x70,
+	0xfa, 0xe4, 0x7a, 0xc0, 0x03, 0xa7, 0x17, 0xb6, 0x4f, 0xe1, 0x5b, 0x40, 0x7d, 0x24, 0xf6, 0x41,
+	0x1c, 0xc0, 0xf9, 0x59, 0x1c, 0xc0, 0xf9, 0x71, 0x1c, 0xc0, 0xf9, 0x89, 0x7c, 0x1b, 0xa6, 0xbc,
+	0xb8, 0x73, 0x28, 0x07, 0x1a, 0x9c, 0x73, 0x79, 0xe8, 0xfe, 0x9e, 0x66, 0xc1, 0x36, 0x9e, 0x62,
+	0xa5, 0xa8, 0xdc, 0x6a, 0x6d, 0xa3, 0xf4, 0xcc, 0x6f, 0x1b, 0x1b, 0xf4, 0xd9, 0xc6, 0x06, 0x7d,
+	0xb6, 0xb1, 0x41, 0x9f, 0x6d, 0x38, 0xa7, 0xcf, 0x36, 0x36, 0xea, 0xb7, 0x8d, 0x8d, 0xfa, 0x6d,
+	0xc3, 0x19, 0xfc, 0xb6, 0xe1, 0x72, 0xf9, 0x6d, 0x63, 0xc3, 0x01, 0xdb, 0x70, 0x92, 0x80, 0x6d,
+	0x38, 0x4b, 0xc0, 0x36, 0x7c, 0x81, 0x01, 0xdb, 0xf0, 0x35, 0x06, 0x6c, 0xe3, 0x2c, 0x33, 0x60,
+	0x1b, 0x67, 0xa5, 0xe7, 0xd8, 0x86, 0x35, 0xe7, 0xcf, 0x42, 0x10, 0xe7, 0x25, 0x72, 0xac, 0xa9,
+	0xc9, 0xef, 0xf2, 0x61, 0x18, 0x60, 0xc5, 0x67, 0x8c, 0xad, 0xe2, 0x33, 0x06, 0x23, 0xf1, 0x19,
+	0x03, 0x91, 0xf8, 0x8c, 0x41, 0x48, 0x7c, 0xc6, 0x38, 0x2a, 0x3e, 0x63, 0x08, 0x15, 0x9f, 0xf1,
+	0xc4, 0x12, 0x9f, 0xf1, 0xb8, 0x12, 0x9f, 0xf1, 0xac, 0xc2, 0xab, 0xe8, 0x50, 0x1e, 0x3c, 0xa8,
+	0x3c, 0x00, 0x9e, 0x52, 0x1e, 0x00, 0x8f, 0x28, 0x0f, 0x80, 0xe7, 0x93, 0x07, 0x40, 0x85, 0x79,
+	0x00, 0x3c, 0x99, 0x9e, 0x61, 0x4d, 0x95, 0x48, 0xf9, 0x84, 0x03, 0x7f, 0x74, 0x3a, 0x5d, 0x83,
+	0xca, 0x75, 0x6f, 0xe3, 0x5c, 0xa9, 0x9e, 0x6b, 0xf8, 0x6f, 0x84, 0x20, 0xca, 0xbe, 0xce, 0xc0,
+	0x59, 0x37, 0xd7, 0xb6, 0xb6, 0xe5, 0xe1, 0x35, 0x2f, 0x09, 0x71, 0x1b, 0xe0, 0x15, 0x24, 0xb7,
+	0x95, 0x57, 0x90, 0x5c, 0x80, 0x57, 0x90, 0x5c, 0x80, 0x57, 0x90, 0x5c, 0x80, 0x57, 0x90, 0x5c,
+	0x80, 0x57, 0x90, 0x5c, 0x80, 0x57, 0x90, 0x5c, 0x80, 0x57, 0x90, 0x5c, 0x80, 0x57, 0x90, 0x5c,
+	0xc0, 0xa9, 0x20, 0x09, 0x08, 0xaf, 0x20, 0x09, 0x08, 0xaf, 0x20, 0x09, 0x08, 0xaf, 0x20, 0x09,
+	0x08, 0xaf, 0x20, 0x09, 0x08, 0xaa, 0x7d, 0x78, 0x31, 0x67, 0x78, 0xf9, 0xc1, 0xef, 0xfd, 0xf8,
+	0x25, 0xe9, 0x1b, 0x73, 0xd7, 0xd4, 0xfa, 0x6e, 0x8c, 0x7d, 0xd9, 0xb1, 0xf0, 0x9f, 0x03, 0x00,
+	0xdf, 0x28, 0x0d, 0x49, 0x7f, 0x58, 0x00, 0x00,
 }
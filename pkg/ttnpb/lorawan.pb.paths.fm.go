@@ -255,7 +255,13 @@ var TxSettingsFieldPathsNested = []string{
 	"data_rate.modulation.lrfhss.operating_channel_width",
 	"downlink",
 	"downlink.antenna_index",
+	"downlink.implicit_header",
 	"downlink.invert_polarization",
+	"downlink.listen_before_talk",
+	"downlink.listen_before_talk.rssi_offset",
+	"downlink.listen_before_talk.rssi_target",
+	"downlink.listen_before_talk.scan_time",
+	"downlink.preamble_length",
 	"downlink.tx_power",
 	"enable_crc",
 	"frequency",
@@ -554,15 +560,35 @@ var DeviceEIRPValueFieldPathsTopLevel = []string{
 }
 var TxSettings_DownlinkFieldPathsNested = []string{
 	"antenna_index",
+	"implicit_header",
 	"invert_polarization",
+	"listen_before_talk",
+	"listen_before_talk.rssi_offset",
+	"listen_before_talk.rssi_target",
+	"listen_before_talk.scan_time",
+	"preamble_length",
 	"tx_power",
 }
 
 var TxSettings_DownlinkFieldPathsTopLevel = []string{
 	"antenna_index",
+	"implicit_header",
 	"invert_polarization",
+	"listen_before_talk",
+	"preamble_length",
 	"tx_power",
 }
+var TxSettings_Downlink_ListenBeforeTalkFieldPathsNested = []string{
+	"rssi_offset",
+	"rssi_target",
+	"scan_time",
+}
+
+var TxSettings_Downlink_ListenBeforeTalkFieldPathsTopLevel = []string{
+	"rssi_offset",
+	"rssi_target",
+	"scan_time",
+}
 var MACCommand_ResetIndFieldPathsNested = []string{
 	"minor_version",
 }
@@ -2897,6 +2897,91 @@ func (dst *TxSettings_Downlink) SetFields(src *TxSettings_Downlink, paths ...str
 				var zero bool
 				dst.InvertPolarization = zero
 			}
+		case "listen_before_talk":
+			if len(subs) > 0 {
+				var newDst, newSrc *TxSettings_Downlink_ListenBeforeTalk
+				if (src == nil || src.ListenBeforeTalk == nil) && dst.ListenBeforeTalk == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ListenBeforeTalk
+				}
+				if dst.ListenBeforeTalk != nil {
+					newDst = dst.ListenBeforeTalk
+				} else {
+					newDst = &TxSettings_Downlink_ListenBeforeTalk{}
+					dst.ListenBeforeTalk = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ListenBeforeTalk = src.ListenBeforeTalk
+				} else {
+					dst.ListenBeforeTalk = nil
+				}
+			}
+		case "implicit_header":
+			if len(subs) > 0 {
+				return fmt.Errorf("'implicit_header' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ImplicitHeader = src.ImplicitHeader
+			} else {
+				var zero bool
+				dst.ImplicitHeader = zero
+			}
+		case "preamble_length":
+			if len(subs) > 0 {
+				return fmt.Errorf("'preamble_length' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PreambleLength = src.PreambleLength
+			} else {
+				var zero uint32
+				dst.PreambleLength = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TxSettings_Downlink_ListenBeforeTalk) SetFields(src *TxSettings_Downlink_ListenBeforeTalk, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "rssi_target":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi_target' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RssiTarget = src.RssiTarget
+			} else {
+				var zero float32
+				dst.RssiTarget = zero
+			}
+		case "rssi_offset":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rssi_offset' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RssiOffset = src.RssiOffset
+			} else {
+				var zero float32
+				dst.RssiOffset = zero
+			}
+		case "scan_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scan_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ScanTime = src.ScanTime
+			} else {
+				dst.ScanTime = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -4027,6 +4027,22 @@ func (m *TxSettings_Downlink) ValidateFields(paths ...string) error {
 			// no validation rules for TxPower
 		case "invert_polarization":
 			// no validation rules for InvertPolarization
+		case "listen_before_talk":
+
+			if v, ok := interface{}(m.GetListenBeforeTalk()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TxSettings_DownlinkValidationError{
+						field:  "listen_before_talk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "implicit_header":
+			// no validation rules for ImplicitHeader
+		case "preamble_length":
+			// no validation rules for PreambleLength
 		default:
 			return TxSettings_DownlinkValidationError{
 				field:  name,
@@ -4093,6 +4109,104 @@ var _ interface {
 	ErrorName() string
 } = TxSettings_DownlinkValidationError{}
 
+// ValidateFields checks the field values on
+// TxSettings_Downlink_ListenBeforeTalk with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *TxSettings_Downlink_ListenBeforeTalk) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TxSettings_Downlink_ListenBeforeTalkFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "rssi_target":
+			// no validation rules for RssiTarget
+		case "rssi_offset":
+			// no validation rules for RssiOffset
+		case "scan_time":
+
+			if v, ok := interface{}(m.GetScanTime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TxSettings_Downlink_ListenBeforeTalkValidationError{
+						field:  "scan_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return TxSettings_Downlink_ListenBeforeTalkValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TxSettings_Downlink_ListenBeforeTalkValidationError is the validation error
+// returned by TxSettings_Downlink_ListenBeforeTalk.ValidateFields if the
+// designated constraints aren't met.
+type TxSettings_Downlink_ListenBeforeTalkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxSettings_Downlink_ListenBeforeTalkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxSettings_Downlink_ListenBeforeTalkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxSettings_Downlink_ListenBeforeTalkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxSettings_Downlink_ListenBeforeTalkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxSettings_Downlink_ListenBeforeTalkValidationError) ErrorName() string {
+	return "TxSettings_Downlink_ListenBeforeTalkValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TxSettings_Downlink_ListenBeforeTalkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxSettings_Downlink_ListenBeforeTalk.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxSettings_Downlink_ListenBeforeTalkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxSettings_Downlink_ListenBeforeTalkValidationError{}
+
 // ValidateFields checks the field values on MACCommand_ResetInd with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
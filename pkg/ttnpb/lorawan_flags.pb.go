@@ -596,11 +596,42 @@ func PathsFromSelectFlagsForDataRate(flags *pflag.FlagSet, prefix string) (paths
 	return paths, nil
 }
 
+// AddSelectFlagsForTxSettings_Downlink_ListenBeforeTalk adds flags to select fields in TxSettings_Downlink_ListenBeforeTalk.
+func AddSelectFlagsForTxSettings_Downlink_ListenBeforeTalk(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("rssi-target", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("rssi-target", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("rssi-offset", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("rssi-offset", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("scan-time", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("scan-time", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forTxSettings_Downlink_ListenBeforeTalk message from select flags.
+func PathsFromSelectFlagsForTxSettings_Downlink_ListenBeforeTalk(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("rssi_target", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("rssi_target", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("rssi_offset", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("rssi_offset", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("scan_time", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("scan_time", prefix))
+	}
+	return paths, nil
+}
+
 // AddSelectFlagsForTxSettings_Downlink adds flags to select fields in TxSettings_Downlink.
 func AddSelectFlagsForTxSettings_Downlink(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("antenna-index", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("antenna-index", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("tx-power", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("tx-power", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("invert-polarization", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("invert-polarization", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("listen-before-talk", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("listen-before-talk", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForTxSettings_Downlink_ListenBeforeTalk(flags, flagsplugin.Prefix("listen-before-talk", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("implicit-header", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("implicit-header", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("preamble-length", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("preamble-length", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forTxSettings_Downlink message from select flags.
@@ -620,6 +651,26 @@ func PathsFromSelectFlagsForTxSettings_Downlink(flags *pflag.FlagSet, prefix str
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("invert_polarization", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("listen_before_talk", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("listen_before_talk", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForTxSettings_Downlink_ListenBeforeTalk(flags, flagsplugin.Prefix("listen_before_talk", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("implicit_header", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("implicit_header", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("preamble_length", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("preamble_length", prefix))
+	}
 	return paths, nil
 }
 
@@ -31,6 +31,8 @@ func (v *MessagePayloadFormatters) FieldIsZero(p string) bool {
 		return v.DownFormatterParameter == ""
 	case "up_formatter":
 		return v.UpFormatter == 0
+	case "test_vectors":
+		return len(v.TestVectors) == 0
 	case "up_formatter_parameter":
 		return v.UpFormatterParameter == ""
 	}
@@ -51,6 +53,22 @@ func (v *ApplicationDownlink_ClassBC) FieldIsZero(p string) bool {
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
+// FieldIsZero returns whether path p is zero.
+func (v *ApplicationDownlink_Scheduling) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "deduplication_key":
+		return v.DeduplicationKey == ""
+	case "expires_at":
+		return v.ExpiresAt == nil
+	case "not_before":
+		return v.NotBefore == nil
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
 // FieldIsZero returns whether path p is zero.
 func (v *ApplicationDownlink) FieldIsZero(p string) bool {
 	if v == nil {
@@ -79,12 +97,34 @@ func (v *ApplicationDownlink) FieldIsZero(p string) bool {
 		return v.FrmPayload == nil
 	case "priority":
 		return v.Priority == 0
+	case "scheduling":
+		return v.Scheduling == nil
+	case "scheduling.deduplication_key":
+		return v.Scheduling.FieldIsZero("deduplication_key")
+	case "scheduling.expires_at":
+		return v.Scheduling.FieldIsZero("expires_at")
+	case "scheduling.not_before":
+		return v.Scheduling.FieldIsZero("not_before")
 	case "session_key_id":
 		return v.SessionKeyId == nil
 	}
 	panic(fmt.Sprintf("unknown path '%s'", p))
 }
 
+// FieldIsZero returns whether path p is zero.
+func (v *ApplicationDownlinkPolicy) FieldIsZero(p string) bool {
+	if v == nil {
+		return true
+	}
+	switch p {
+	case "default_ttl":
+		return v.DefaultTtl == nil
+	case "order_by_priority":
+		return !v.OrderByPriority
+	}
+	panic(fmt.Sprintf("unknown path '%s'", p))
+}
+
 // PartitionDownlinks partitions downlinks based on the general predicate p.
 func PartitionDownlinks(p func(down *ApplicationDownlink) bool, downs ...*ApplicationDownlink) (t, f []*ApplicationDownlink) {
 	t, f = downs[:0:0], downs[:0:0]
@@ -41,6 +41,8 @@ const (
 	PayloadFormatter_FORMATTER_JAVASCRIPT PayloadFormatter = 3
 	// CayenneLPP payload formatter.
 	PayloadFormatter_FORMATTER_CAYENNELPP PayloadFormatter = 4
+	// Custom payload formatter that executes a WebAssembly module. The parameter is the base64 encoded WebAssembly module.
+	PayloadFormatter_FORMATTER_WASM PayloadFormatter = 5
 )
 
 var PayloadFormatter_name = map[int32]string{
@@ -49,6 +51,7 @@ var PayloadFormatter_name = map[int32]string{
 	2: "FORMATTER_GRPC_SERVICE",
 	3: "FORMATTER_JAVASCRIPT",
 	4: "FORMATTER_CAYENNELPP",
+	5: "FORMATTER_WASM",
 }
 
 var PayloadFormatter_value = map[string]int32{
@@ -57,6 +60,7 @@ var PayloadFormatter_value = map[string]int32{
 	"FORMATTER_GRPC_SERVICE": 2,
 	"FORMATTER_JAVASCRIPT":   3,
 	"FORMATTER_CAYENNELPP":   4,
+	"FORMATTER_WASM":         5,
 }
 
 func (x PayloadFormatter) String() string {
@@ -79,6 +83,9 @@ const (
 	TxAcknowledgment_TX_FREQ          TxAcknowledgment_Result = 6
 	TxAcknowledgment_TX_POWER         TxAcknowledgment_Result = 7
 	TxAcknowledgment_GPS_UNLOCKED     TxAcknowledgment_Result = 8
+	// The gateway did not transmit because listen-before-talk detected channel activity.
+	// This result is only reported by gateways that connect with gRPC or MQTT with Protocol Buffers.
+	TxAcknowledgment_LBT_FAILED TxAcknowledgment_Result = 9
 )
 
 var TxAcknowledgment_Result_name = map[int32]string{
@@ -91,6 +98,7 @@ var TxAcknowledgment_Result_name = map[int32]string{
 	6: "TX_FREQ",
 	7: "TX_POWER",
 	8: "GPS_UNLOCKED",
+	9: "LBT_FAILED",
 }
 
 var TxAcknowledgment_Result_value = map[string]int32{
@@ -103,6 +111,7 @@ var TxAcknowledgment_Result_value = map[string]int32{
 	"TX_FREQ":          6,
 	"TX_POWER":         7,
 	"GPS_UNLOCKED":     8,
+	"LBT_FAILED":       9,
 }
 
 func (x TxAcknowledgment_Result) String() string {
@@ -113,6 +122,37 @@ func (TxAcknowledgment_Result) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_bbc6bff5780bdc9d, []int{2, 0}
 }
 
+type MessagePayloadFormatterTestVector_Type int32
+
+const (
+	// Decode the binary payload of an uplink message with the uplink payload formatter.
+	MessagePayloadFormatterTestVector_UPLINK_DECODE MessagePayloadFormatterTestVector_Type = 0
+	// Encode the decoded payload of a downlink message with the downlink payload formatter.
+	MessagePayloadFormatterTestVector_DOWNLINK_ENCODE MessagePayloadFormatterTestVector_Type = 1
+	// Decode the binary payload of a downlink message with the downlink payload formatter.
+	MessagePayloadFormatterTestVector_DOWNLINK_DECODE MessagePayloadFormatterTestVector_Type = 2
+)
+
+var MessagePayloadFormatterTestVector_Type_name = map[int32]string{
+	0: "UPLINK_DECODE",
+	1: "DOWNLINK_ENCODE",
+	2: "DOWNLINK_DECODE",
+}
+
+var MessagePayloadFormatterTestVector_Type_value = map[string]int32{
+	"UPLINK_DECODE":   0,
+	"DOWNLINK_ENCODE": 1,
+	"DOWNLINK_DECODE": 2,
+}
+
+func (x MessagePayloadFormatterTestVector_Type) String() string {
+	return proto.EnumName(MessagePayloadFormatterTestVector_Type_name, int32(x))
+}
+
+func (MessagePayloadFormatterTestVector_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{19, 0}
+}
+
 // Uplink message from the end device to the network
 type UplinkMessage struct {
 	RawPayload []byte        `protobuf:"bytes,1,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
@@ -996,11 +1036,15 @@ type ApplicationDownlink struct {
 	// If not set, this downlink message may be transmitted in class A, B and C.
 	ClassBC *ApplicationDownlink_ClassBC `protobuf:"bytes,7,opt,name=class_b_c,json=classBC,proto3" json:"class_b_c,omitempty"`
 	// Priority for scheduling the downlink message.
-	Priority             TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
-	CorrelationIds       []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Priority       TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
+	CorrelationIds []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// Optional scheduling policy for this downlink message.
+	// If set, the downlink message is held by the Application Server until it is due, and is then queued in the Network Server.
+	// Held downlink messages that expire or that are superseded are reported as failed downlink messages.
+	Scheduling           *ApplicationDownlink_Scheduling `protobuf:"bytes,11,opt,name=scheduling,proto3" json:"scheduling,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
 func (m *ApplicationDownlink) Reset()         { *m = ApplicationDownlink{} }
@@ -1097,6 +1141,13 @@ func (m *ApplicationDownlink) GetCorrelationIds() []string {
 	return nil
 }
 
+func (m *ApplicationDownlink) GetScheduling() *ApplicationDownlink_Scheduling {
+	if m != nil {
+		return m.Scheduling
+	}
+	return nil
+}
+
 type ApplicationDownlink_ClassBC struct {
 	// Possible gateway identifiers, antenna index, and group index to use for this downlink message.
 	// The Network Server selects one of these gateways for downlink, based on connectivity, signal quality, channel utilization and an available slot.
@@ -1152,6 +1203,65 @@ func (m *ApplicationDownlink_ClassBC) GetAbsoluteTime() *types.Timestamp {
 	return nil
 }
 
+type ApplicationDownlink_Scheduling struct {
+	// The downlink message is held by the Application Server and is not queued in the Network Server before this time.
+	// If null, the downlink message is queued in the Network Server as soon as possible.
+	NotBefore *types.Timestamp `protobuf:"bytes,1,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// The downlink message is discarded by the Application Server if it is not queued in the Network Server before this time.
+	// If null, the default time to live of the downlink policy of the end device or application applies.
+	ExpiresAt *types.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Downlink messages held by the Application Server with the same deduplication key are superseded by this downlink message.
+	DeduplicationKey     string   `protobuf:"bytes,3,opt,name=deduplication_key,json=deduplicationKey,proto3" json:"deduplication_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationDownlink_Scheduling) Reset()         { *m = ApplicationDownlink_Scheduling{} }
+func (m *ApplicationDownlink_Scheduling) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDownlink_Scheduling) ProtoMessage()    {}
+func (*ApplicationDownlink_Scheduling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{9, 1}
+}
+func (m *ApplicationDownlink_Scheduling) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationDownlink_Scheduling.Unmarshal(m, b)
+}
+func (m *ApplicationDownlink_Scheduling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationDownlink_Scheduling.Marshal(b, m, deterministic)
+}
+func (m *ApplicationDownlink_Scheduling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlink_Scheduling.Merge(m, src)
+}
+func (m *ApplicationDownlink_Scheduling) XXX_Size() int {
+	return xxx_messageInfo_ApplicationDownlink_Scheduling.Size(m)
+}
+func (m *ApplicationDownlink_Scheduling) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlink_Scheduling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDownlink_Scheduling proto.InternalMessageInfo
+
+func (m *ApplicationDownlink_Scheduling) GetNotBefore() *types.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *ApplicationDownlink_Scheduling) GetExpiresAt() *types.Timestamp {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
+func (m *ApplicationDownlink_Scheduling) GetDeduplicationKey() string {
+	if m != nil {
+		return m.DeduplicationKey
+	}
+	return ""
+}
+
 type ApplicationDownlinks struct {
 	Downlinks            []*ApplicationDownlink `protobuf:"bytes,1,rep,name=downlinks,proto3" json:"downlinks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -1190,6 +1300,58 @@ func (m *ApplicationDownlinks) GetDownlinks() []*ApplicationDownlink {
 	return nil
 }
 
+// Policy for downlink messages that are held by the Application Server.
+type ApplicationDownlinkPolicy struct {
+	// Time to live of held downlink messages that do not specify an expiry time.
+	// The time to live starts at the not before time of the downlink message, or when it is held if it has no not before time.
+	// If null or zero, held downlink messages do not expire.
+	DefaultTtl *types.Duration `protobuf:"bytes,1,opt,name=default_ttl,json=defaultTtl,proto3" json:"default_ttl,omitempty"`
+	// Queue held downlink messages that are due at the same time in the order of their priority.
+	// If false, held downlink messages are queued in the order of their not before time.
+	OrderByPriority      bool     `protobuf:"varint,2,opt,name=order_by_priority,json=orderByPriority,proto3" json:"order_by_priority,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationDownlinkPolicy) Reset()         { *m = ApplicationDownlinkPolicy{} }
+func (m *ApplicationDownlinkPolicy) String() string { return proto.CompactTextString(m) }
+func (*ApplicationDownlinkPolicy) ProtoMessage()    {}
+func (*ApplicationDownlinkPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
+}
+func (m *ApplicationDownlinkPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationDownlinkPolicy.Unmarshal(m, b)
+}
+func (m *ApplicationDownlinkPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationDownlinkPolicy.Marshal(b, m, deterministic)
+}
+func (m *ApplicationDownlinkPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkPolicy.Merge(m, src)
+}
+func (m *ApplicationDownlinkPolicy) XXX_Size() int {
+	return xxx_messageInfo_ApplicationDownlinkPolicy.Size(m)
+}
+func (m *ApplicationDownlinkPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDownlinkPolicy proto.InternalMessageInfo
+
+func (m *ApplicationDownlinkPolicy) GetDefaultTtl() *types.Duration {
+	if m != nil {
+		return m.DefaultTtl
+	}
+	return nil
+}
+
+func (m *ApplicationDownlinkPolicy) GetOrderByPriority() bool {
+	if m != nil {
+		return m.OrderByPriority
+	}
+	return false
+}
+
 type ApplicationDownlinkFailed struct {
 	Downlink             *ApplicationDownlink `protobuf:"bytes,1,opt,name=downlink,proto3" json:"downlink,omitempty"`
 	Error                *ErrorDetails        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -1202,7 +1364,7 @@ func (m *ApplicationDownlinkFailed) Reset()         { *m = ApplicationDownlinkFa
 func (m *ApplicationDownlinkFailed) String() string { return proto.CompactTextString(m) }
 func (*ApplicationDownlinkFailed) ProtoMessage()    {}
 func (*ApplicationDownlinkFailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
 }
 func (m *ApplicationDownlinkFailed) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplicationDownlinkFailed.Unmarshal(m, b)
@@ -1249,7 +1411,7 @@ func (m *ApplicationInvalidatedDownlinks) Reset()         { *m = ApplicationInva
 func (m *ApplicationInvalidatedDownlinks) String() string { return proto.CompactTextString(m) }
 func (*ApplicationInvalidatedDownlinks) ProtoMessage()    {}
 func (*ApplicationInvalidatedDownlinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{13}
 }
 func (m *ApplicationInvalidatedDownlinks) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplicationInvalidatedDownlinks.Unmarshal(m, b)
@@ -1306,7 +1468,7 @@ func (m *DownlinkQueueOperationErrorDetails) Reset()         { *m = DownlinkQueu
 func (m *DownlinkQueueOperationErrorDetails) String() string { return proto.CompactTextString(m) }
 func (*DownlinkQueueOperationErrorDetails) ProtoMessage()    {}
 func (*DownlinkQueueOperationErrorDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{13}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{14}
 }
 func (m *DownlinkQueueOperationErrorDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownlinkQueueOperationErrorDetails.Unmarshal(m, b)
@@ -1368,6 +1530,84 @@ func (m *DownlinkQueueOperationErrorDetails) GetPendingMinFCntDown() uint32 {
 	return 0
 }
 
+// ApplicationSessionRecovered is sent by the Network Server when an uplink message of an end device matches a session
+// that differs from the session that the Application Server expects. This happens when the end device keeps using its
+// current session after a new session has been established, or when the end device resets its frame counters.
+type ApplicationSessionRecovered struct {
+	// Join Server issued identifier for the session keys of the recovered session.
+	SessionKeyId []byte `protobuf:"bytes,1,opt,name=session_key_id,json=sessionKeyId,proto3" json:"session_key_id,omitempty"`
+	// Join Server issued identifier for the session keys of the pending session that has been discarded, if any.
+	DiscardedSessionKeyId []byte `protobuf:"bytes,2,opt,name=discarded_session_key_id,json=discardedSessionKeyId,proto3" json:"discarded_session_key_id,omitempty"`
+	// The end device reset its frame counters.
+	FCntReset bool `protobuf:"varint,3,opt,name=f_cnt_reset,json=fCntReset,proto3" json:"f_cnt_reset,omitempty"`
+	// The uplink frame counter of the uplink message that recovered the session.
+	LastFCntUp uint32 `protobuf:"varint,4,opt,name=last_f_cnt_up,json=lastFCntUp,proto3" json:"last_f_cnt_up,omitempty"`
+	// The last application downlink frame counter of the recovered session.
+	LastAFCntDown        uint32   `protobuf:"varint,5,opt,name=last_a_f_cnt_down,json=lastAFCntDown,proto3" json:"last_a_f_cnt_down,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSessionRecovered) Reset()         { *m = ApplicationSessionRecovered{} }
+func (m *ApplicationSessionRecovered) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSessionRecovered) ProtoMessage()    {}
+func (*ApplicationSessionRecovered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{15}
+}
+func (m *ApplicationSessionRecovered) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationSessionRecovered.Unmarshal(m, b)
+}
+func (m *ApplicationSessionRecovered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationSessionRecovered.Marshal(b, m, deterministic)
+}
+func (m *ApplicationSessionRecovered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSessionRecovered.Merge(m, src)
+}
+func (m *ApplicationSessionRecovered) XXX_Size() int {
+	return xxx_messageInfo_ApplicationSessionRecovered.Size(m)
+}
+func (m *ApplicationSessionRecovered) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSessionRecovered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSessionRecovered proto.InternalMessageInfo
+
+func (m *ApplicationSessionRecovered) GetSessionKeyId() []byte {
+	if m != nil {
+		return m.SessionKeyId
+	}
+	return nil
+}
+
+func (m *ApplicationSessionRecovered) GetDiscardedSessionKeyId() []byte {
+	if m != nil {
+		return m.DiscardedSessionKeyId
+	}
+	return nil
+}
+
+func (m *ApplicationSessionRecovered) GetFCntReset() bool {
+	if m != nil {
+		return m.FCntReset
+	}
+	return false
+}
+
+func (m *ApplicationSessionRecovered) GetLastFCntUp() uint32 {
+	if m != nil {
+		return m.LastFCntUp
+	}
+	return 0
+}
+
+func (m *ApplicationSessionRecovered) GetLastAFCntDown() uint32 {
+	if m != nil {
+		return m.LastAFCntDown
+	}
+	return 0
+}
+
 type ApplicationServiceData struct {
 	Service              string        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 	Data                 *types.Struct `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -1380,7 +1620,7 @@ func (m *ApplicationServiceData) Reset()         { *m = ApplicationServiceData{}
 func (m *ApplicationServiceData) String() string { return proto.CompactTextString(m) }
 func (*ApplicationServiceData) ProtoMessage()    {}
 func (*ApplicationServiceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{14}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{16}
 }
 func (m *ApplicationServiceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplicationServiceData.Unmarshal(m, b)
@@ -1432,6 +1672,7 @@ type ApplicationUp struct {
 	//	*ApplicationUp_DownlinkQueueInvalidated
 	//	*ApplicationUp_LocationSolved
 	//	*ApplicationUp_ServiceData
+	//	*ApplicationUp_SessionRecovered
 	Up isApplicationUp_Up `protobuf_oneof:"up"`
 	// Signals if the message is coming from the Network Server or is simulated.
 	Simulated            bool     `protobuf:"varint,14,opt,name=simulated,proto3" json:"simulated,omitempty"`
@@ -1444,7 +1685,7 @@ func (m *ApplicationUp) Reset()         { *m = ApplicationUp{} }
 func (m *ApplicationUp) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUp) ProtoMessage()    {}
 func (*ApplicationUp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{15}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{17}
 }
 func (m *ApplicationUp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ApplicationUp.Unmarshal(m, b)
@@ -1501,6 +1742,9 @@ type ApplicationUp_LocationSolved struct {
 type ApplicationUp_ServiceData struct {
 	ServiceData *ApplicationServiceData `protobuf:"bytes,13,opt,name=service_data,json=serviceData,proto3,oneof" json:"service_data,omitempty"`
 }
+type ApplicationUp_SessionRecovered struct {
+	SessionRecovered *ApplicationSessionRecovered `protobuf:"bytes,17,opt,name=session_recovered,json=sessionRecovered,proto3,oneof" json:"session_recovered,omitempty"`
+}
 
 func (*ApplicationUp_UplinkMessage) isApplicationUp_Up()            {}
 func (*ApplicationUp_UplinkNormalized) isApplicationUp_Up()         {}
@@ -1513,6 +1757,7 @@ func (*ApplicationUp_DownlinkQueued) isApplicationUp_Up()           {}
 func (*ApplicationUp_DownlinkQueueInvalidated) isApplicationUp_Up() {}
 func (*ApplicationUp_LocationSolved) isApplicationUp_Up()           {}
 func (*ApplicationUp_ServiceData) isApplicationUp_Up()              {}
+func (*ApplicationUp_SessionRecovered) isApplicationUp_Up()         {}
 
 func (m *ApplicationUp) GetUp() isApplicationUp_Up {
 	if m != nil {
@@ -1619,6 +1864,13 @@ func (m *ApplicationUp) GetServiceData() *ApplicationServiceData {
 	return nil
 }
 
+func (m *ApplicationUp) GetSessionRecovered() *ApplicationSessionRecovered {
+	if x, ok := m.GetUp().(*ApplicationUp_SessionRecovered); ok {
+		return x.SessionRecovered
+	}
+	return nil
+}
+
 func (m *ApplicationUp) GetSimulated() bool {
 	if m != nil {
 		return m.Simulated
@@ -1640,28 +1892,32 @@ func (*ApplicationUp) XXX_OneofWrappers() []interface{} {
 		(*ApplicationUp_DownlinkQueueInvalidated)(nil),
 		(*ApplicationUp_LocationSolved)(nil),
 		(*ApplicationUp_ServiceData)(nil),
+		(*ApplicationUp_SessionRecovered)(nil),
 	}
 }
 
 type MessagePayloadFormatters struct {
 	// Payload formatter for uplink messages, must be set together with its parameter.
 	UpFormatter PayloadFormatter `protobuf:"varint,1,opt,name=up_formatter,json=upFormatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"up_formatter,omitempty"`
-	// Parameter for the up_formatter, must be set together. The API enforces a maximum length of 16KB, but the size may be restricted further by deployment configuration.
+	// Parameter for the up_formatter, must be set together. The API enforces a maximum length of 1MB, but the size may be restricted further by deployment configuration.
 	UpFormatterParameter string `protobuf:"bytes,2,opt,name=up_formatter_parameter,json=upFormatterParameter,proto3" json:"up_formatter_parameter,omitempty"`
 	// Payload formatter for downlink messages, must be set together with its parameter.
 	DownFormatter PayloadFormatter `protobuf:"varint,3,opt,name=down_formatter,json=downFormatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"down_formatter,omitempty"`
-	// Parameter for the down_formatter, must be set together. The API enforces a maximum length of 16KB, but the size may be restricted further by deployment configuration.
-	DownFormatterParameter string   `protobuf:"bytes,4,opt,name=down_formatter_parameter,json=downFormatterParameter,proto3" json:"down_formatter_parameter,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	// Parameter for the down_formatter, must be set together. The API enforces a maximum length of 1MB, but the size may be restricted further by deployment configuration.
+	DownFormatterParameter string `protobuf:"bytes,4,opt,name=down_formatter_parameter,json=downFormatterParameter,proto3" json:"down_formatter_parameter,omitempty"`
+	// Test vectors of the payload formatters.
+	// The Application Server rejects updates of the payload formatters that break the test vectors.
+	TestVectors          []*MessagePayloadFormatterTestVector `protobuf:"bytes,5,rep,name=test_vectors,json=testVectors,proto3" json:"test_vectors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
 }
 
 func (m *MessagePayloadFormatters) Reset()         { *m = MessagePayloadFormatters{} }
 func (m *MessagePayloadFormatters) String() string { return proto.CompactTextString(m) }
 func (*MessagePayloadFormatters) ProtoMessage()    {}
 func (*MessagePayloadFormatters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{16}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{18}
 }
 func (m *MessagePayloadFormatters) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MessagePayloadFormatters.Unmarshal(m, b)
@@ -1709,6 +1965,113 @@ func (m *MessagePayloadFormatters) GetDownFormatterParameter() string {
 	return ""
 }
 
+func (m *MessagePayloadFormatters) GetTestVectors() []*MessagePayloadFormatterTestVector {
+	if m != nil {
+		return m.TestVectors
+	}
+	return nil
+}
+
+// Test vector of a payload formatter, with an example payload and the expected output of the payload formatter.
+type MessagePayloadFormatterTestVector struct {
+	// Name of the test vector, unique within the payload formatters.
+	Name        string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Type        MessagePayloadFormatterTestVector_Type `protobuf:"varint,3,opt,name=type,proto3,enum=ttn.lorawan.v3.MessagePayloadFormatterTestVector_Type" json:"type,omitempty"`
+	// FPort and binary payload. This is the input of decoders and the expected output of encoders.
+	FPort      uint32 `protobuf:"varint,4,opt,name=f_port,json=fPort,proto3" json:"f_port,omitempty"`
+	FrmPayload []byte `protobuf:"bytes,5,opt,name=frm_payload,json=frmPayload,proto3" json:"frm_payload,omitempty"`
+	// Decoded payload. This is the input of encoders and the expected output of decoders.
+	DecodedPayload *types.Struct `protobuf:"bytes,6,opt,name=decoded_payload,json=decodedPayload,proto3" json:"decoded_payload,omitempty"`
+	// Expected warnings of the payload formatter.
+	Warnings []string `protobuf:"bytes,7,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// Expected errors of the payload formatter. If set, the payload formatter is expected to fail.
+	Errors               []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MessagePayloadFormatterTestVector) Reset()         { *m = MessagePayloadFormatterTestVector{} }
+func (m *MessagePayloadFormatterTestVector) String() string { return proto.CompactTextString(m) }
+func (*MessagePayloadFormatterTestVector) ProtoMessage()    {}
+func (*MessagePayloadFormatterTestVector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{19}
+}
+func (m *MessagePayloadFormatterTestVector) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MessagePayloadFormatterTestVector.Unmarshal(m, b)
+}
+func (m *MessagePayloadFormatterTestVector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MessagePayloadFormatterTestVector.Marshal(b, m, deterministic)
+}
+func (m *MessagePayloadFormatterTestVector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessagePayloadFormatterTestVector.Merge(m, src)
+}
+func (m *MessagePayloadFormatterTestVector) XXX_Size() int {
+	return xxx_messageInfo_MessagePayloadFormatterTestVector.Size(m)
+}
+func (m *MessagePayloadFormatterTestVector) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessagePayloadFormatterTestVector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessagePayloadFormatterTestVector proto.InternalMessageInfo
+
+func (m *MessagePayloadFormatterTestVector) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MessagePayloadFormatterTestVector) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MessagePayloadFormatterTestVector) GetType() MessagePayloadFormatterTestVector_Type {
+	if m != nil {
+		return m.Type
+	}
+	return MessagePayloadFormatterTestVector_UPLINK_DECODE
+}
+
+func (m *MessagePayloadFormatterTestVector) GetFPort() uint32 {
+	if m != nil {
+		return m.FPort
+	}
+	return 0
+}
+
+func (m *MessagePayloadFormatterTestVector) GetFrmPayload() []byte {
+	if m != nil {
+		return m.FrmPayload
+	}
+	return nil
+}
+
+func (m *MessagePayloadFormatterTestVector) GetDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.DecodedPayload
+	}
+	return nil
+}
+
+func (m *MessagePayloadFormatterTestVector) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
+func (m *MessagePayloadFormatterTestVector) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
 type DownlinkQueueRequest struct {
 	EndDeviceIds         *EndDeviceIdentifiers  `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids,omitempty"`
 	Downlinks            []*ApplicationDownlink `protobuf:"bytes,2,rep,name=downlinks,proto3" json:"downlinks,omitempty"`
@@ -1721,7 +2084,7 @@ func (m *DownlinkQueueRequest) Reset()         { *m = DownlinkQueueRequest{} }
 func (m *DownlinkQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*DownlinkQueueRequest) ProtoMessage()    {}
 func (*DownlinkQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{17}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{20}
 }
 func (m *DownlinkQueueRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DownlinkQueueRequest.Unmarshal(m, b)
@@ -1760,6 +2123,8 @@ func init() {
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PayloadFormatter", PayloadFormatter_name, PayloadFormatter_value)
 	proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
+	proto.RegisterEnum("ttn.lorawan.v3.MessagePayloadFormatterTestVector_Type", MessagePayloadFormatterTestVector_Type_name, MessagePayloadFormatterTestVector_Type_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MessagePayloadFormatterTestVector_Type", MessagePayloadFormatterTestVector_Type_name, MessagePayloadFormatterTestVector_Type_value)
 	proto.RegisterType((*UplinkMessage)(nil), "ttn.lorawan.v3.UplinkMessage")
 	golang_proto.RegisterType((*UplinkMessage)(nil), "ttn.lorawan.v3.UplinkMessage")
 	proto.RegisterType((*DownlinkMessage)(nil), "ttn.lorawan.v3.DownlinkMessage")
@@ -1788,20 +2153,28 @@ func init() {
 	golang_proto.RegisterType((*ApplicationDownlink)(nil), "ttn.lorawan.v3.ApplicationDownlink")
 	proto.RegisterType((*ApplicationDownlink_ClassBC)(nil), "ttn.lorawan.v3.ApplicationDownlink.ClassBC")
 	golang_proto.RegisterType((*ApplicationDownlink_ClassBC)(nil), "ttn.lorawan.v3.ApplicationDownlink.ClassBC")
+	proto.RegisterType((*ApplicationDownlink_Scheduling)(nil), "ttn.lorawan.v3.ApplicationDownlink.Scheduling")
+	golang_proto.RegisterType((*ApplicationDownlink_Scheduling)(nil), "ttn.lorawan.v3.ApplicationDownlink.Scheduling")
 	proto.RegisterType((*ApplicationDownlinks)(nil), "ttn.lorawan.v3.ApplicationDownlinks")
 	golang_proto.RegisterType((*ApplicationDownlinks)(nil), "ttn.lorawan.v3.ApplicationDownlinks")
+	proto.RegisterType((*ApplicationDownlinkPolicy)(nil), "ttn.lorawan.v3.ApplicationDownlinkPolicy")
+	golang_proto.RegisterType((*ApplicationDownlinkPolicy)(nil), "ttn.lorawan.v3.ApplicationDownlinkPolicy")
 	proto.RegisterType((*ApplicationDownlinkFailed)(nil), "ttn.lorawan.v3.ApplicationDownlinkFailed")
 	golang_proto.RegisterType((*ApplicationDownlinkFailed)(nil), "ttn.lorawan.v3.ApplicationDownlinkFailed")
 	proto.RegisterType((*ApplicationInvalidatedDownlinks)(nil), "ttn.lorawan.v3.ApplicationInvalidatedDownlinks")
 	golang_proto.RegisterType((*ApplicationInvalidatedDownlinks)(nil), "ttn.lorawan.v3.ApplicationInvalidatedDownlinks")
 	proto.RegisterType((*DownlinkQueueOperationErrorDetails)(nil), "ttn.lorawan.v3.DownlinkQueueOperationErrorDetails")
 	golang_proto.RegisterType((*DownlinkQueueOperationErrorDetails)(nil), "ttn.lorawan.v3.DownlinkQueueOperationErrorDetails")
+	proto.RegisterType((*ApplicationSessionRecovered)(nil), "ttn.lorawan.v3.ApplicationSessionRecovered")
+	golang_proto.RegisterType((*ApplicationSessionRecovered)(nil), "ttn.lorawan.v3.ApplicationSessionRecovered")
 	proto.RegisterType((*ApplicationServiceData)(nil), "ttn.lorawan.v3.ApplicationServiceData")
 	golang_proto.RegisterType((*ApplicationServiceData)(nil), "ttn.lorawan.v3.ApplicationServiceData")
 	proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
 	golang_proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
 	proto.RegisterType((*MessagePayloadFormatters)(nil), "ttn.lorawan.v3.MessagePayloadFormatters")
 	golang_proto.RegisterType((*MessagePayloadFormatters)(nil), "ttn.lorawan.v3.MessagePayloadFormatters")
+	proto.RegisterType((*MessagePayloadFormatterTestVector)(nil), "ttn.lorawan.v3.MessagePayloadFormatterTestVector")
+	golang_proto.RegisterType((*MessagePayloadFormatterTestVector)(nil), "ttn.lorawan.v3.MessagePayloadFormatterTestVector")
 	proto.RegisterType((*DownlinkQueueRequest)(nil), "ttn.lorawan.v3.DownlinkQueueRequest")
 	golang_proto.RegisterType((*DownlinkQueueRequest)(nil), "ttn.lorawan.v3.DownlinkQueueRequest")
 }
@@ -1812,179 +2185,207 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2772 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6c, 0xe3, 0xc6,
-	0xd5, 0x37, 0x25, 0x59, 0x7f, 0x9e, 0xfe, 0x98, 0x9e, 0x38, 0x1b, 0xda, 0xd9, 0x64, 0xfd, 0x29,
-	0x9b, 0x2f, 0xce, 0xe6, 0xb3, 0xbc, 0x9f, 0xb7, 0xdb, 0xa4, 0xdb, 0x26, 0x5b, 0x49, 0x96, 0xd7,
-	0xf2, 0x1f, 0x49, 0x19, 0x69, 0x37, 0xbb, 0x09, 0x52, 0x82, 0x16, 0xc7, 0x32, 0x63, 0x8a, 0x64,
-	0x48, 0x4a, 0xb6, 0xb7, 0x28, 0x10, 0xe4, 0xd6, 0x00, 0x3d, 0x34, 0x28, 0x50, 0x34, 0x2d, 0x8a,
-	0xa2, 0x05, 0x72, 0x58, 0x14, 0x3d, 0x14, 0xbd, 0xf5, 0x92, 0x4b, 0x81, 0x1c, 0xda, 0x5b, 0x81,
-	0x5e, 0x0b, 0xb4, 0x97, 0x06, 0xe8, 0x25, 0xa7, 0xc2, 0x97, 0x14, 0x1c, 0x0e, 0x25, 0x92, 0xd2,
-	0xda, 0xd6, 0x26, 0x29, 0xd2, 0x8b, 0x61, 0xce, 0xbc, 0xf7, 0x9b, 0x37, 0x6f, 0xde, 0xfb, 0xbd,
-	0x37, 0x23, 0x58, 0x54, 0x75, 0x53, 0x3a, 0x94, 0xb4, 0x65, 0xcb, 0x96, 0xda, 0x07, 0x2b, 0x92,
-	0xa1, 0xac, 0x74, 0x89, 0x65, 0x49, 0x1d, 0x62, 0x15, 0x0c, 0x53, 0xb7, 0x75, 0x94, 0xb3, 0x6d,
-	0xad, 0xc0, 0xa4, 0x0a, 0xfd, 0x6b, 0x0b, 0xc5, 0x8e, 0x62, 0xef, 0xf7, 0x76, 0x0b, 0x6d, 0xbd,
-	0xbb, 0x42, 0xb4, 0xbe, 0x7e, 0x6c, 0x98, 0xfa, 0xd1, 0xf1, 0x0a, 0x15, 0x6e, 0x2f, 0x77, 0x88,
-	0xb6, 0xdc, 0x97, 0x54, 0x45, 0x96, 0x6c, 0xb2, 0x32, 0xf2, 0x8f, 0x0b, 0xb9, 0xb0, 0xec, 0x83,
-	0xe8, 0xe8, 0x1d, 0xdd, 0x55, 0xde, 0xed, 0xed, 0xd1, 0x2f, 0xfa, 0x41, 0xff, 0x63, 0xe2, 0x65,
-	0x9f, 0x78, 0x6b, 0x9f, 0xb4, 0xf6, 0x15, 0xad, 0x63, 0x55, 0x35, 0xb9, 0x67, 0xd9, 0xa6, 0x42,
-	0x2c, 0xff, 0xd2, 0x1d, 0x7d, 0xf9, 0x2d, 0x4b, 0xd7, 0x56, 0x24, 0x4d, 0xd3, 0x6d, 0xc9, 0x56,
-	0x74, 0x8d, 0x6d, 0x63, 0x61, 0x6d, 0x22, 0x90, 0x3d, 0x55, 0xea, 0x58, 0x63, 0x50, 0x2e, 0x76,
-	0x74, 0xbd, 0xa3, 0x92, 0xa1, 0xc1, 0x96, 0x6d, 0xf6, 0xda, 0x36, 0x9b, 0xbd, 0x14, 0x9e, 0xb5,
-	0x95, 0x2e, 0xb1, 0x6c, 0xa9, 0x6b, 0x30, 0x81, 0xa7, 0xc3, 0x02, 0x72, 0xcf, 0xa4, 0xf8, 0x6c,
-	0xfe, 0xa9, 0xd1, 0xd3, 0x20, 0xa6, 0xa9, 0x9b, 0x6c, 0xfa, 0x99, 0xd1, 0x69, 0x45, 0x26, 0x9a,
-	0xad, 0xec, 0x29, 0xc4, 0x1c, 0x98, 0x38, 0x2a, 0x74, 0x40, 0x8e, 0xbd, 0xd9, 0x4b, 0xa3, 0xb3,
-	0xde, 0xd9, 0xba, 0x02, 0x63, 0x03, 0xc2, 0x96, 0x64, 0xc9, 0x96, 0x98, 0x44, 0xc1, 0xe7, 0x2a,
-	0xdd, 0x20, 0x9a, 0x64, 0x28, 0xfd, 0xd5, 0x15, 0xdd, 0xa0, 0x7e, 0x1a, 0xf5, 0x59, 0xfe, 0xef,
-	0x51, 0xc8, 0xde, 0x36, 0x54, 0x45, 0x3b, 0xd8, 0x71, 0x23, 0x0b, 0x5d, 0x82, 0xb4, 0x29, 0x1d,
-	0x8a, 0x86, 0x74, 0xac, 0xea, 0x92, 0x2c, 0x70, 0x8b, 0xdc, 0x52, 0x06, 0x83, 0x29, 0x1d, 0x36,
-	0xdc, 0x11, 0xf4, 0xff, 0x90, 0xf0, 0x26, 0x23, 0x8b, 0xdc, 0x52, 0x7a, 0xf5, 0x89, 0x42, 0x30,
-	0x0a, 0x0b, 0x0c, 0x0a, 0x7b, 0x72, 0xe8, 0xdb, 0x90, 0xb4, 0x88, 0x6d, 0x3b, 0xa7, 0x2a, 0xc4,
-	0xa8, 0xce, 0x42, 0x58, 0xa7, 0x75, 0xd4, 0x64, 0x12, 0xa5, 0xe4, 0x49, 0x69, 0xfa, 0x3d, 0x2e,
-	0xc2, 0x73, 0x78, 0xa0, 0x85, 0xbe, 0x09, 0x69, 0xf3, 0x48, 0xf4, 0x36, 0x2b, 0x4c, 0x2f, 0x46,
-	0xc7, 0x81, 0xe0, 0xa3, 0x1d, 0x26, 0x81, 0xc1, 0x1c, 0xfc, 0x4f, 0x95, 0x49, 0x9b, 0x28, 0x7d,
-	0x22, 0x8b, 0x92, 0x2d, 0xc4, 0x99, 0x05, 0xee, 0x79, 0x17, 0xbc, 0xf3, 0x2e, 0xb4, 0xbc, 0x80,
-	0xc0, 0xe0, 0x89, 0x17, 0x6d, 0x74, 0x1d, 0x66, 0xda, 0xba, 0x69, 0x12, 0x95, 0xfa, 0x4d, 0x54,
-	0x64, 0x4b, 0x48, 0x2c, 0x46, 0x97, 0x52, 0xa5, 0xcc, 0x49, 0x29, 0xf5, 0x3e, 0x17, 0xcf, 0xc7,
-	0xcc, 0x88, 0x20, 0xe3, 0x9c, 0x4f, 0xa8, 0x2a, 0x5b, 0xe8, 0x06, 0xcc, 0xc9, 0xa4, 0xaf, 0xb4,
-	0x89, 0xd8, 0xde, 0x97, 0x34, 0x8d, 0xa8, 0xa2, 0xa2, 0xc9, 0xe4, 0x48, 0x48, 0x2d, 0x72, 0x4b,
-	0x59, 0xba, 0xc5, 0x2b, 0x51, 0xe1, 0x33, 0x0e, 0x23, 0x57, 0xaa, 0xec, 0x0a, 0x55, 0x1d, 0x19,
-	0xb4, 0x06, 0x7c, 0x5b, 0xd7, 0xac, 0x5e, 0xd7, 0xb1, 0x57, 0x31, 0x9d, 0x40, 0x15, 0x80, 0x1a,
-	0x3d, 0x3f, 0x62, 0xf4, 0x1a, 0x0b, 0x52, 0x3c, 0xe3, 0xa9, 0x14, 0x5d, 0x8d, 0xcd, 0x58, 0x32,
-	0xca, 0xc7, 0x36, 0x63, 0xc9, 0x24, 0x9f, 0xca, 0xff, 0x2c, 0x0a, 0x33, 0x6b, 0xfa, 0xa1, 0xf6,
-	0x65, 0x1f, 0xf4, 0x26, 0xe4, 0x88, 0x26, 0x8b, 0x6c, 0xe7, 0x8e, 0xaf, 0xa2, 0x54, 0xf3, 0x72,
-	0x58, 0xb3, 0xa2, 0xc9, 0x6b, 0x54, 0xa8, 0x3a, 0xcc, 0x11, 0x9c, 0x21, 0xc3, 0x51, 0x0b, 0x5d,
-	0x87, 0x84, 0x49, 0xde, 0xee, 0x11, 0xcb, 0x66, 0x31, 0x33, 0x3f, 0x1a, 0x33, 0xd8, 0x15, 0xd8,
-	0x98, 0xc2, 0x9e, 0x2c, 0xba, 0x01, 0x29, 0xab, 0xbd, 0x4f, 0xe4, 0x9e, 0x4a, 0x64, 0x61, 0xfa,
-	0xac, 0x60, 0xdb, 0x98, 0xc2, 0x43, 0xf1, 0x71, 0x67, 0x1d, 0x3f, 0xc7, 0x59, 0x17, 0x20, 0x67,
-	0x11, 0xcb, 0x72, 0x54, 0x0e, 0xc8, 0xb1, 0xa8, 0xc8, 0x42, 0xc2, 0x71, 0x26, 0x3d, 0xe5, 0xfb,
-	0x51, 0xe1, 0x1d, 0x1e, 0x67, 0xd8, 0xfc, 0x16, 0x39, 0xae, 0xca, 0xa5, 0x99, 0x61, 0x3a, 0xa0,
-	0xe8, 0xbf, 0x4a, 0x5c, 0xfe, 0xfb, 0x51, 0xe0, 0x5b, 0x47, 0xc5, 0xf6, 0x81, 0xa6, 0x1f, 0xaa,
-	0x44, 0xee, 0x74, 0x89, 0x36, 0x36, 0xf0, 0xb8, 0x73, 0x18, 0x53, 0x85, 0xb8, 0x49, 0xac, 0x9e,
-	0x6a, 0xd3, 0x43, 0xcb, 0xad, 0x3e, 0x37, 0xba, 0xf9, 0xe0, 0x42, 0x05, 0x4c, 0xc5, 0xa9, 0xb5,
-	0xef, 0xd2, 0xb4, 0x63, 0x00, 0x68, 0x13, 0x78, 0x99, 0x05, 0x8d, 0xc8, 0x0a, 0x0f, 0x3b, 0xcf,
-	0x4b, 0x61, 0xd0, 0x50, 0x70, 0xe1, 0x19, 0x39, 0x38, 0x90, 0xff, 0x90, 0x83, 0xb8, 0xbb, 0x10,
-	0x4a, 0x43, 0xa2, 0x79, 0xbb, 0x5c, 0xae, 0x34, 0x9b, 0xfc, 0x14, 0x9a, 0x85, 0xec, 0xed, 0xda,
-	0x56, 0xad, 0xfe, 0x5a, 0x4d, 0xac, 0x60, 0x5c, 0xc7, 0x3c, 0x87, 0x32, 0x90, 0x6c, 0xd5, 0xeb,
-	0xe2, 0x76, 0xb1, 0x55, 0xe1, 0x23, 0x28, 0x0b, 0x29, 0xe7, 0xab, 0x52, 0xc4, 0xdb, 0xf7, 0xf8,
-	0x28, 0x9a, 0x03, 0xbe, 0x5c, 0xdf, 0xde, 0xae, 0x36, 0xab, 0xf5, 0x9a, 0xd8, 0x28, 0x96, 0xb7,
-	0x2a, 0x2d, 0x3e, 0x16, 0x1c, 0x2d, 0x55, 0x8a, 0xe5, 0x7a, 0x8d, 0x9f, 0x76, 0x16, 0x6a, 0xdd,
-	0x15, 0xd7, 0x71, 0xe5, 0x55, 0x3e, 0x4e, 0x51, 0xef, 0x8a, 0x8d, 0xfa, 0x6b, 0x15, 0xcc, 0x27,
-	0x10, 0x0f, 0x99, 0x5b, 0x8d, 0xa6, 0x78, 0xbb, 0xb6, 0x5d, 0x2f, 0x6f, 0x55, 0xd6, 0xf8, 0xe4,
-	0x42, 0xfc, 0x93, 0x07, 0xf3, 0x11, 0x81, 0xcb, 0xff, 0x98, 0x83, 0x27, 0x6e, 0x49, 0x36, 0x39,
-	0x94, 0x8e, 0x47, 0x8e, 0xa4, 0x0c, 0xe9, 0x8e, 0x3b, 0xc5, 0x8e, 0xc3, 0xf1, 0x45, 0x3e, 0xec,
-	0x0b, 0xa6, 0xed, 0x8f, 0x6c, 0xe8, 0x78, 0x63, 0x16, 0x7a, 0x11, 0xe2, 0xf6, 0x91, 0x28, 0xb5,
-	0x0f, 0x58, 0x56, 0x2d, 0x9e, 0x75, 0x40, 0x78, 0xda, 0x76, 0x46, 0xf2, 0x26, 0xcc, 0x31, 0xe8,
-	0x20, 0x63, 0x17, 0x21, 0xe1, 0x9d, 0x8e, 0x6b, 0xd1, 0x53, 0x61, 0xc4, 0x80, 0xbc, 0x8f, 0x5f,
-	0x3d, 0x3d, 0xf4, 0x04, 0x24, 0x76, 0x25, 0x4d, 0x76, 0x42, 0xd7, 0x31, 0x2a, 0x85, 0xe3, 0xce,
-	0x67, 0x55, 0xce, 0xff, 0x23, 0x09, 0xb3, 0x45, 0xc3, 0x50, 0x95, 0x36, 0x0d, 0x30, 0x17, 0x68,
-	0x4c, 0xc0, 0x73, 0xa7, 0x05, 0x3c, 0xca, 0x43, 0x7c, 0x4f, 0x34, 0x74, 0xd3, 0x8d, 0xc9, 0x6c,
-	0x29, 0x7d, 0x52, 0x4a, 0x5e, 0x89, 0x0b, 0x9f, 0x71, 0x2f, 0xfd, 0x95, 0xc3, 0xd3, 0x7b, 0x0d,
-	0xdd, 0xb4, 0xd1, 0x63, 0x30, 0xbd, 0x27, 0xb6, 0x35, 0x9b, 0x46, 0x58, 0x16, 0xc7, 0xf6, 0xca,
-	0x9a, 0xed, 0x70, 0xd4, 0x9e, 0xd9, 0x1d, 0x70, 0x54, 0xcc, 0xe5, 0xa8, 0x3d, 0xb3, 0xdb, 0x18,
-	0x54, 0x96, 0x19, 0x99, 0xb4, 0x75, 0x99, 0xc8, 0x03, 0xa1, 0x69, 0xc6, 0x55, 0x61, 0xa6, 0x6c,
-	0xd2, 0x6e, 0x00, 0xe7, 0x98, 0xbc, 0x87, 0xf0, 0x12, 0x08, 0x21, 0x04, 0xf1, 0x50, 0x32, 0x35,
-	0x5a, 0xab, 0x32, 0x4e, 0xbe, 0xe1, 0x0b, 0x41, 0x8d, 0xd7, 0xd8, 0x2c, 0x5a, 0x07, 0xa4, 0xe9,
-	0x66, 0x57, 0x52, 0x95, 0xfb, 0xbe, 0xe5, 0x67, 0x69, 0x69, 0x7a, 0xe8, 0xf2, 0xb3, 0x43, 0x15,
-	0xcf, 0x82, 0x57, 0xe0, 0xc9, 0x51, 0x9c, 0xa1, 0x11, 0x88, 0x1a, 0x31, 0x3f, 0xa2, 0x37, 0xb0,
-	0x23, 0x54, 0x1b, 0xe3, 0x13, 0xd5, 0x46, 0x7f, 0x69, 0x4e, 0x3c, 0x72, 0x69, 0xf6, 0x55, 0xd7,
-	0xe4, 0x44, 0xd5, 0xf5, 0x45, 0x48, 0x49, 0x86, 0x21, 0x5a, 0x4e, 0x1c, 0xd1, 0xda, 0x98, 0x5e,
-	0x7d, 0x32, 0xbc, 0xfe, 0x16, 0x39, 0xae, 0x68, 0x7d, 0xa2, 0xea, 0x06, 0xc1, 0x09, 0xc9, 0x30,
-	0x9a, 0x5b, 0xe4, 0x18, 0x2d, 0xc1, 0xac, 0x2a, 0x59, 0xb6, 0x28, 0x89, 0x34, 0x6a, 0x44, 0x87,
-	0x6f, 0x68, 0x91, 0xcc, 0xe2, 0xac, 0x33, 0x51, 0x5c, 0x2f, 0x6b, 0xb6, 0xc3, 0x4a, 0xe8, 0x22,
-	0xa4, 0xda, 0xba, 0xb6, 0xa7, 0x98, 0x5d, 0x22, 0x0b, 0xe9, 0x45, 0x6e, 0x29, 0x89, 0x87, 0x03,
-	0x63, 0x6b, 0x6d, 0x76, 0xd2, 0x5a, 0x8b, 0x6a, 0x90, 0x52, 0x75, 0x37, 0x45, 0x2c, 0x21, 0x47,
-	0x0f, 0xe0, 0x6a, 0x78, 0x1b, 0x23, 0x69, 0x54, 0xd8, 0xf6, 0x54, 0x2a, 0x9a, 0x6d, 0x1e, 0xe3,
-	0x21, 0x04, 0xda, 0x86, 0x74, 0x9f, 0x98, 0x96, 0xc7, 0xfb, 0x33, 0xd4, 0xa0, 0x17, 0x1e, 0x5a,
-	0x44, 0xef, 0xb8, 0xb2, 0x01, 0xc6, 0xe9, 0x7b, 0x63, 0x96, 0x43, 0x5b, 0x1a, 0xb1, 0x0f, 0x75,
-	0xf3, 0x80, 0xa2, 0xf1, 0xe3, 0x69, 0xab, 0xe6, 0x8a, 0x04, 0x40, 0x34, 0x6f, 0xcc, 0x5a, 0xb8,
-	0x03, 0xb9, 0xa0, 0xbd, 0x88, 0x87, 0xa8, 0x73, 0x6a, 0x1c, 0x25, 0x0c, 0xe7, 0x5f, 0x54, 0x80,
-	0xe9, 0xbe, 0xa4, 0xf6, 0x08, 0x63, 0x36, 0x21, 0xbc, 0x84, 0x07, 0x80, 0x5d, 0xb1, 0x1b, 0x91,
-	0x97, 0xb8, 0x1b, 0xc9, 0x4f, 0x1f, 0xcc, 0xc7, 0x92, 0x1c, 0x3f, 0x95, 0xff, 0x5d, 0x02, 0x9e,
-	0x1c, 0x71, 0x52, 0x6d, 0x10, 0xf6, 0x13, 0xb3, 0xce, 0xe5, 0x10, 0xeb, 0x64, 0x4f, 0x4a, 0x70,
-	0x25, 0x29, 0x7c, 0xc6, 0x2d, 0x7d, 0x7e, 0xde, 0x69, 0x8c, 0xcd, 0xfd, 0xd3, 0xa9, 0xc7, 0x97,
-	0x3d, 0x93, 0xb3, 0x40, 0x7c, 0x42, 0x16, 0x48, 0x3c, 0x32, 0x0b, 0x24, 0x1f, 0x89, 0x05, 0x2a,
-	0x41, 0x16, 0x48, 0x9d, 0xc5, 0x02, 0x14, 0xe4, 0xb7, 0x5c, 0x24, 0xc9, 0x05, 0xf8, 0x20, 0x90,
-	0xac, 0x70, 0x9e, 0x64, 0x4d, 0x4f, 0x9c, 0xac, 0x77, 0xfd, 0xc9, 0x9a, 0xa1, 0x7e, 0xba, 0x71,
-	0x66, 0xb2, 0x0e, 0xe3, 0xf0, 0xfc, 0x69, 0x9b, 0xfd, 0x42, 0xd3, 0x36, 0xf7, 0x15, 0x4d, 0xdb,
-	0x3f, 0x44, 0xe0, 0x31, 0x9f, 0xbb, 0x3c, 0x61, 0x24, 0x40, 0xc2, 0x22, 0xa6, 0xb3, 0x4f, 0xb6,
-	0x96, 0xf7, 0x89, 0x5e, 0x81, 0xa4, 0xe7, 0xb3, 0xb3, 0x96, 0xf4, 0xc7, 0x9a, 0xa7, 0x83, 0xde,
-	0xe3, 0x00, 0x24, 0xdb, 0x36, 0x95, 0xdd, 0x9e, 0x4d, 0x9c, 0x2b, 0x86, 0x73, 0x84, 0xd7, 0x4e,
-	0x39, 0x42, 0x0f, 0xad, 0x50, 0x1c, 0x68, 0x51, 0x5f, 0x94, 0xae, 0x9f, 0x94, 0x56, 0x3f, 0xe0,
-	0x56, 0x78, 0xc8, 0x5f, 0x36, 0xf3, 0xc2, 0xe5, 0xd5, 0xa7, 0xbf, 0xf3, 0x86, 0xb4, 0x7c, 0xff,
-	0xea, 0xf2, 0x37, 0xde, 0x5c, 0xba, 0x79, 0xe3, 0x8d, 0xe5, 0x37, 0x6f, 0x7a, 0x9f, 0xcf, 0x7f,
-	0x77, 0xf5, 0xff, 0xbe, 0x77, 0xf9, 0xca, 0xb4, 0x19, 0x15, 0x3e, 0xe6, 0xb0, 0x6f, 0xf5, 0x85,
-	0x97, 0x61, 0x26, 0x84, 0x3a, 0xc6, 0xc3, 0x73, 0x7e, 0x0f, 0xa7, 0xc6, 0xfb, 0xf1, 0xcf, 0x11,
-	0x78, 0xdc, 0x67, 0xf3, 0xa6, 0xae, 0x68, 0xc5, 0x76, 0x9b, 0x18, 0xf6, 0xc4, 0xc4, 0x17, 0x28,
-	0xaa, 0x91, 0x09, 0x8a, 0xea, 0x5d, 0x78, 0x5c, 0xd1, 0xbc, 0xf7, 0x20, 0x59, 0xf4, 0x7a, 0x78,
-	0xcf, 0xc5, 0xcf, 0x9c, 0xe2, 0x62, 0xef, 0x02, 0x80, 0xe7, 0x7c, 0x08, 0xde, 0xa0, 0x85, 0x9e,
-	0x83, 0x19, 0x83, 0x68, 0xb2, 0xa2, 0x75, 0x44, 0x66, 0x2a, 0x25, 0xd5, 0x24, 0xce, 0xb1, 0xe1,
-	0xa6, 0x3b, 0x1a, 0xe6, 0x91, 0xe4, 0xa3, 0xf1, 0x88, 0xcf, 0xad, 0xff, 0x4c, 0x04, 0xc2, 0xd3,
-	0x33, 0x09, 0xfd, 0x89, 0x7b, 0x88, 0x57, 0x3f, 0xe4, 0x3c, 0xb7, 0x7e, 0xfa, 0x60, 0xfe, 0x27,
-	0xdc, 0x42, 0xed, 0x11, 0x1e, 0xa7, 0xe8, 0x5f, 0x43, 0xed, 0x75, 0x14, 0xad, 0x50, 0x23, 0x87,
-	0x1b, 0xe4, 0xa8, 0x74, 0x6c, 0x13, 0x6b, 0x5d, 0x95, 0x3a, 0xf9, 0x5b, 0x9f, 0x13, 0xef, 0x16,
-	0xb1, 0x29, 0xd8, 0x17, 0xd5, 0x63, 0x7f, 0xc4, 0x8d, 0x29, 0x76, 0xa5, 0x9f, 0x73, 0x5f, 0xf1,
-	0xdd, 0xff, 0xe7, 0x6e, 0x01, 0x70, 0xea, 0x2d, 0x20, 0x50, 0xb1, 0xe2, 0xe1, 0x8a, 0x75, 0x0b,
-	0x52, 0x6d, 0x55, 0xb2, 0x2c, 0x71, 0x57, 0x6c, 0xb3, 0xfe, 0xfa, 0x85, 0x73, 0x64, 0x51, 0xa1,
-	0xec, 0x28, 0x95, 0xca, 0x38, 0xd1, 0x76, 0xff, 0x41, 0x1b, 0x90, 0x34, 0x4c, 0x45, 0x37, 0x15,
-	0xfb, 0x98, 0x26, 0x45, 0x6e, 0xb4, 0x12, 0xb4, 0x8e, 0x9a, 0xec, 0x25, 0xa3, 0xc1, 0x24, 0x7d,
-	0x77, 0xfa, 0x81, 0xf6, 0xb8, 0x77, 0x85, 0xd4, 0xd9, 0xef, 0x0a, 0x0b, 0x3f, 0xe5, 0x20, 0xc1,
-	0xac, 0x42, 0x15, 0x48, 0xb2, 0x0b, 0xad, 0xc5, 0x1a, 0x8d, 0xe7, 0xc3, 0xc6, 0x30, 0xd1, 0x31,
-	0x77, 0xe1, 0x81, 0x2a, 0xba, 0x09, 0x59, 0x69, 0xd7, 0xd2, 0xd5, 0x9e, 0x4d, 0x44, 0x5a, 0xcb,
-	0xcf, 0xbe, 0x3b, 0x64, 0x3c, 0x05, 0x67, 0x68, 0x90, 0xe5, 0x43, 0x1a, 0xe5, 0xf2, 0xf7, 0x60,
-	0x6e, 0x8c, 0x43, 0x2d, 0x54, 0x84, 0xd4, 0x90, 0xcf, 0xb8, 0xf3, 0xf3, 0xd9, 0x50, 0x2b, 0xff,
-	0x1b, 0x0e, 0xe6, 0xc7, 0x88, 0xac, 0x4b, 0x8a, 0x4a, 0x64, 0x54, 0x85, 0xa4, 0x27, 0xca, 0xee,
-	0xe1, 0xe7, 0xc1, 0xf7, 0x17, 0x38, 0x4f, 0x1d, 0x7d, 0x0b, 0xa6, 0xe9, 0xd3, 0x32, 0x23, 0xef,
-	0x8b, 0x23, 0x1d, 0x84, 0x33, 0xb9, 0x46, 0x6c, 0x49, 0x51, 0xfd, 0xdd, 0x98, 0xab, 0xe4, 0x2f,
-	0xcd, 0x1c, 0x5c, 0xf2, 0xad, 0x59, 0x1d, 0xc7, 0xcc, 0x9f, 0xdf, 0x2f, 0xe8, 0x59, 0x98, 0xa1,
-	0x77, 0x31, 0xdf, 0x4d, 0x8c, 0x72, 0x10, 0xce, 0x38, 0xc3, 0x83, 0x8b, 0xd8, 0x68, 0x19, 0x8b,
-	0x9e, 0x56, 0xc6, 0x7c, 0xfb, 0xf8, 0xe1, 0x34, 0xe4, 0xbd, 0x85, 0x5f, 0xed, 0x91, 0x1e, 0xa9,
-	0x1b, 0xc4, 0xed, 0xee, 0xfc, 0x9e, 0x40, 0x1f, 0x73, 0x90, 0x94, 0x49, 0x5f, 0x94, 0x64, 0xd9,
-	0x64, 0x64, 0xfe, 0x6b, 0xee, 0xfd, 0xe2, 0xfc, 0x26, 0xe4, 0x57, 0xbf, 0x7e, 0xf5, 0x6a, 0xb1,
-	0x54, 0x5e, 0xcb, 0x7f, 0x10, 0xe1, 0x12, 0xbf, 0x8c, 0xc4, 0x1d, 0xb6, 0xd1, 0x3a, 0x27, 0xa5,
-	0xf8, 0xfd, 0xd8, 0x7e, 0xcc, 0xe0, 0x3e, 0x79, 0x30, 0xff, 0x2e, 0x07, 0x37, 0x3b, 0x7a, 0xc1,
-	0xde, 0x27, 0x36, 0xe5, 0xa4, 0x02, 0x6b, 0xa2, 0x56, 0x82, 0x6f, 0xee, 0xfd, 0x6b, 0x2b, 0xc6,
-	0x41, 0x67, 0xc5, 0x3e, 0x36, 0x88, 0x55, 0xd8, 0x91, 0x4c, 0x6b, 0x5f, 0x52, 0x37, 0x2a, 0x77,
-	0x29, 0x27, 0xa1, 0x89, 0x01, 0x6e, 0x6b, 0x5d, 0x17, 0xe2, 0x6b, 0x2e, 0xa9, 0x25, 0x64, 0xd2,
-	0x2f, 0xca, 0xb2, 0x39, 0xc6, 0x57, 0x91, 0x53, 0x4b, 0xfe, 0x33, 0x90, 0xeb, 0x2a, 0x9a, 0xff,
-	0x04, 0x5c, 0x8a, 0x4f, 0x77, 0x15, 0x6d, 0x70, 0x00, 0x7f, 0xe1, 0x80, 0xf7, 0xaa, 0xf0, 0xc0,
-	0x4f, 0xb1, 0xff, 0x46, 0x3f, 0x79, 0x5d, 0xc3, 0x1a, 0x73, 0xd7, 0xcb, 0x70, 0x21, 0xd4, 0x5e,
-	0x78, 0x6e, 0x9b, 0x0e, 0xb9, 0xed, 0xb1, 0x60, 0xbf, 0xe1, 0x7a, 0x6f, 0x75, 0xa8, 0x1e, 0xf2,
-	0x62, 0x9c, 0x7a, 0x11, 0xb1, 0xd9, 0x9d, 0xa1, 0x33, 0xf3, 0x0a, 0x5c, 0xf0, 0xa5, 0x45, 0xd3,
-	0x6d, 0x6d, 0xd7, 0x9c, 0xcb, 0xd4, 0xc3, 0x1b, 0xdf, 0x17, 0x20, 0x46, 0x2f, 0x67, 0x91, 0xd3,
-	0x8b, 0x13, 0x15, 0xf2, 0x85, 0xff, 0x8f, 0x52, 0x90, 0x0d, 0x5c, 0x48, 0x50, 0x6b, 0xe4, 0x9d,
-	0x9d, 0x3b, 0xff, 0x3b, 0xbb, 0x8f, 0x31, 0xc2, 0x2f, 0xee, 0x23, 0x95, 0x21, 0x72, 0x8e, 0x17,
-	0xe7, 0xd0, 0x03, 0x50, 0x66, 0xa2, 0x07, 0xa0, 0x4d, 0xc8, 0xf5, 0x8c, 0x31, 0x2f, 0xcc, 0xff,
-	0x73, 0xe6, 0x8d, 0x6c, 0x63, 0x0a, 0x67, 0x7b, 0x81, 0x87, 0xd0, 0xd7, 0x61, 0x96, 0x61, 0x0d,
-	0xaf, 0xc9, 0x0f, 0x7b, 0x3b, 0x39, 0xe5, 0x82, 0xb7, 0x31, 0x85, 0xf9, 0x5e, 0xf8, 0xf1, 0x61,
-	0x03, 0xd2, 0x6f, 0xe9, 0x8a, 0x26, 0x4a, 0xb4, 0x25, 0x67, 0xbf, 0x48, 0x3c, 0x7b, 0x0a, 0xea,
-	0xb0, 0x7f, 0xdf, 0x98, 0xc2, 0xf0, 0xd6, 0xb0, 0x9b, 0xdf, 0x80, 0xcc, 0xe0, 0x55, 0x5d, 0x6a,
-	0x1f, 0xb0, 0x4e, 0xe5, 0x3c, 0x9c, 0xbb, 0x31, 0x85, 0xd3, 0x9e, 0x6a, 0xb1, 0x7d, 0x80, 0x36,
-	0x21, 0x3b, 0x40, 0xd2, 0x1c, 0xa8, 0xf8, 0x24, 0x50, 0x03, 0x2b, 0x6a, 0x52, 0x08, 0xcb, 0x22,
-	0x9a, 0xcd, 0x9a, 0x95, 0x49, 0xb1, 0x9a, 0x44, 0xb3, 0x51, 0x0b, 0x06, 0xcf, 0xff, 0xe2, 0x1e,
-	0x2d, 0x8e, 0xac, 0xb2, 0x3f, 0x7f, 0x0e, 0x34, 0xb7, 0x9a, 0x6e, 0x4c, 0xe1, 0x9c, 0x1c, 0xac,
-	0xaf, 0x35, 0x1f, 0xea, 0xdb, 0x4e, 0x11, 0x90, 0xd9, 0x2b, 0xc3, 0x39, 0x6d, 0x1c, 0xe0, 0xd1,
-	0x0a, 0x22, 0x23, 0x1d, 0x16, 0x82, 0x78, 0xa2, 0xef, 0xe6, 0xc2, 0x7e, 0x6f, 0x5b, 0x39, 0x05,
-	0x7a, 0x5c, 0x35, 0xdd, 0x98, 0xc2, 0x42, 0x60, 0x19, 0x9f, 0x90, 0xb3, 0x01, 0xef, 0x0a, 0x2b,
-	0x5a, 0xba, 0xda, 0x67, 0xcf, 0x91, 0xa7, 0x6f, 0xc0, 0xbb, 0xba, 0x3a, 0x1b, 0xf0, 0xb4, 0x9b,
-	0x54, 0x19, 0x6d, 0x41, 0x86, 0x11, 0x8b, 0x48, 0x59, 0xc5, 0x7d, 0x6e, 0xf8, 0xdf, 0x53, 0xc0,
-	0x7c, 0x2c, 0xe5, 0xc4, 0x92, 0xe5, 0x23, 0xad, 0x8b, 0x90, 0xb2, 0x94, 0x6e, 0x4f, 0xa5, 0x9b,
-	0xcf, 0xb9, 0x6d, 0xec, 0x60, 0x60, 0xc8, 0x45, 0xa5, 0x14, 0x44, 0x7a, 0x86, 0xfb, 0xab, 0xd5,
-	0x1f, 0x23, 0x20, 0xb0, 0xd4, 0x63, 0x4d, 0xf1, 0xba, 0x93, 0x2f, 0xb6, 0x4d, 0x4c, 0x0b, 0xed,
-	0x40, 0xa6, 0x67, 0x88, 0x7b, 0xde, 0x00, 0xe5, 0xa7, 0xdc, 0xe8, 0x6f, 0x1d, 0x61, 0x45, 0x5f,
-	0xc7, 0x9a, 0xee, 0x19, 0x83, 0x61, 0x74, 0x13, 0x2e, 0xf8, 0xe1, 0x44, 0x43, 0x32, 0xa5, 0x2e,
-	0x71, 0x80, 0xe9, 0x8d, 0xba, 0x94, 0x3a, 0x29, 0xc5, 0xcd, 0x98, 0xf0, 0xce, 0x47, 0x11, 0x3c,
-	0xe7, 0xd3, 0x6b, 0x78, 0x62, 0xe8, 0x55, 0xa0, 0xe7, 0xef, 0xb3, 0x28, 0x3a, 0xb1, 0x45, 0x34,
-	0x43, 0x86, 0x36, 0x95, 0x41, 0x08, 0x42, 0xfa, 0xac, 0x8a, 0x85, 0xad, 0xba, 0x10, 0xd0, 0x1d,
-	0xd8, 0xe5, 0x6b, 0x5c, 0x7f, 0xcf, 0xc1, 0x5c, 0xa0, 0xc9, 0x61, 0x3f, 0x6e, 0x7e, 0x49, 0x64,
-	0xbf, 0xe3, 0xef, 0xfb, 0x22, 0xe7, 0xee, 0xfb, 0x4a, 0x70, 0x52, 0x4a, 0xbc, 0xcf, 0xc5, 0xf8,
-	0x5f, 0xfc, 0x20, 0xee, 0xeb, 0x01, 0xaf, 0xfc, 0x8a, 0x03, 0x3e, 0xec, 0x3a, 0x84, 0x20, 0xb7,
-	0x5e, 0xc7, 0x3b, 0xc5, 0x56, 0xab, 0x82, 0xc5, 0x5a, 0xbd, 0x56, 0xe1, 0xa7, 0x90, 0x00, 0x73,
-	0xc3, 0x31, 0x5c, 0x69, 0xd4, 0x9b, 0xd5, 0x56, 0x1d, 0xdf, 0xe3, 0x39, 0xb4, 0x00, 0x17, 0x86,
-	0x33, 0xb7, 0x70, 0xa3, 0x2c, 0x36, 0x2b, 0xf8, 0x4e, 0xb5, 0x5c, 0xe1, 0x23, 0x41, 0xad, 0xcd,
-	0xe2, 0x9d, 0x62, 0xb3, 0x8c, 0xab, 0x8d, 0x16, 0x1f, 0x0d, 0xce, 0x94, 0x8b, 0xf7, 0x2a, 0xb5,
-	0x5a, 0x65, 0xbb, 0xd1, 0xe0, 0x63, 0x0b, 0xb3, 0x9f, 0x3c, 0x98, 0xcf, 0x0a, 0xdc, 0x95, 0xd4,
-	0x60, 0xbe, 0x74, 0xfd, 0xa3, 0xbf, 0x3d, 0xcd, 0xbd, 0xbe, 0x32, 0x41, 0xfb, 0x61, 0x6b, 0xc6,
-	0xee, 0x6e, 0x9c, 0x16, 0xb1, 0x6b, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x35, 0x53, 0xb3, 0xcc,
-	0x91, 0x23, 0x00, 0x00,
+	// 3231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0x4b, 0x6c, 0xe3, 0x46,
+	0x96, 0xa6, 0xfe, 0x7a, 0xb2, 0x64, 0xba, 0xda, 0xe9, 0xc8, 0x4e, 0xa7, 0xdb, 0x51, 0x3a, 0x89,
+	0xe3, 0xac, 0xe5, 0x8e, 0x3b, 0x9d, 0x4f, 0x67, 0x93, 0x8e, 0x28, 0xcb, 0x6d, 0xf9, 0x23, 0x29,
+	0x94, 0xfa, 0x97, 0x20, 0x4b, 0xd0, 0x62, 0x59, 0x66, 0x2c, 0x91, 0x0c, 0x49, 0xc9, 0x56, 0x2f,
+	0x16, 0x08, 0x72, 0x09, 0x10, 0x20, 0x87, 0xed, 0xcb, 0x22, 0xd9, 0xc5, 0x62, 0xb1, 0x87, 0x3d,
+	0x34, 0x16, 0x7b, 0x58, 0x04, 0xbb, 0x87, 0xb9, 0x04, 0x03, 0x0c, 0x90, 0xcb, 0xdc, 0x06, 0x98,
+	0xeb, 0x00, 0x33, 0x97, 0xc9, 0x69, 0x90, 0xd3, 0xc0, 0x97, 0x0c, 0x58, 0x2c, 0x52, 0x24, 0xa5,
+	0xb6, 0xa5, 0x4e, 0x32, 0xc8, 0x5c, 0x04, 0xa9, 0xea, 0xbd, 0x57, 0xaf, 0xde, 0xff, 0xbd, 0x12,
+	0x2c, 0xb6, 0x55, 0x5d, 0x3c, 0x12, 0x95, 0x15, 0xc3, 0x14, 0x9b, 0x87, 0xab, 0xa2, 0x26, 0xaf,
+	0x76, 0xb0, 0x61, 0x88, 0x2d, 0x6c, 0xe4, 0x35, 0x5d, 0x35, 0x55, 0x94, 0x31, 0x4d, 0x25, 0x4f,
+	0xa1, 0xf2, 0xbd, 0xab, 0x0b, 0x85, 0x96, 0x6c, 0x1e, 0x74, 0xf7, 0xf2, 0x4d, 0xb5, 0xb3, 0x8a,
+	0x95, 0x9e, 0xda, 0xd7, 0x74, 0xf5, 0xb8, 0xbf, 0x4a, 0x80, 0x9b, 0x2b, 0x2d, 0xac, 0xac, 0xf4,
+	0xc4, 0xb6, 0x2c, 0x89, 0x26, 0x5e, 0x1d, 0xfa, 0x62, 0x93, 0x5c, 0x58, 0xf1, 0x90, 0x68, 0xa9,
+	0x2d, 0xd5, 0x46, 0xde, 0xeb, 0xee, 0x93, 0x5f, 0xe4, 0x07, 0xf9, 0x46, 0xc1, 0x8b, 0x1e, 0xf0,
+	0xc6, 0x01, 0x6e, 0x1c, 0xc8, 0x4a, 0xcb, 0x28, 0x2b, 0x52, 0xd7, 0x30, 0x75, 0x19, 0x1b, 0xde,
+	0xa3, 0x5b, 0xea, 0xca, 0x87, 0x86, 0xaa, 0xac, 0x8a, 0x8a, 0xa2, 0x9a, 0xa2, 0x29, 0xab, 0x0a,
+	0xbd, 0xc6, 0xc2, 0xfa, 0x44, 0x44, 0xf6, 0xdb, 0x62, 0xcb, 0x18, 0x41, 0xe5, 0x42, 0x4b, 0x55,
+	0x5b, 0x6d, 0x3c, 0x60, 0xd8, 0x30, 0xf5, 0x6e, 0xd3, 0xa4, 0xbb, 0x97, 0x82, 0xbb, 0xa6, 0xdc,
+	0xc1, 0x86, 0x29, 0x76, 0x34, 0x0a, 0x70, 0x31, 0x08, 0x20, 0x75, 0x75, 0x42, 0x9f, 0xee, 0x3f,
+	0x3d, 0xac, 0x0d, 0xac, 0xeb, 0xaa, 0x4e, 0xb7, 0x9f, 0x1d, 0xde, 0x96, 0x25, 0xac, 0x98, 0xf2,
+	0xbe, 0x8c, 0x75, 0x97, 0xc5, 0x61, 0xa0, 0x43, 0xdc, 0x77, 0x76, 0x2f, 0x0d, 0xef, 0x3a, 0xba,
+	0xb5, 0x01, 0x46, 0x1a, 0x84, 0x29, 0x4a, 0xa2, 0x29, 0x52, 0x88, 0xbc, 0x47, 0x54, 0xaa, 0x86,
+	0x15, 0x51, 0x93, 0x7b, 0x6b, 0xab, 0xaa, 0x46, 0xe4, 0x34, 0x2c, 0xb3, 0xdc, 0x1f, 0xc2, 0x90,
+	0xbe, 0xa5, 0xb5, 0x65, 0xe5, 0x70, 0xd7, 0xb6, 0x2c, 0x74, 0x09, 0x52, 0xba, 0x78, 0x24, 0x68,
+	0x62, 0xbf, 0xad, 0x8a, 0x52, 0x96, 0x59, 0x64, 0x96, 0xa6, 0x79, 0xd0, 0xc5, 0xa3, 0x9a, 0xbd,
+	0x82, 0x5e, 0x86, 0xb8, 0xb3, 0x19, 0x5a, 0x64, 0x96, 0x52, 0x6b, 0x4f, 0xe6, 0xfd, 0x56, 0x98,
+	0xa7, 0xa4, 0x78, 0x07, 0x0e, 0xbd, 0x03, 0x09, 0x03, 0x9b, 0xa6, 0xa5, 0xd5, 0x6c, 0x84, 0xe0,
+	0x2c, 0x04, 0x71, 0x1a, 0xc7, 0x75, 0x0a, 0xc1, 0x25, 0x4e, 0xb8, 0xe8, 0x67, 0x4c, 0x88, 0x65,
+	0x78, 0x17, 0x0b, 0xbd, 0x09, 0x29, 0xfd, 0x58, 0x70, 0x2e, 0x9b, 0x8d, 0x2e, 0x86, 0x47, 0x11,
+	0xe1, 0x8f, 0x77, 0x29, 0x04, 0x0f, 0xba, 0xfb, 0x9d, 0x20, 0xe3, 0x26, 0x96, 0x7b, 0x58, 0x12,
+	0x44, 0x33, 0x1b, 0xa3, 0x1c, 0xd8, 0xfa, 0xce, 0x3b, 0xfa, 0xce, 0x37, 0x1c, 0x83, 0xe0, 0xc1,
+	0x01, 0x2f, 0x98, 0xe8, 0x1a, 0xcc, 0x34, 0x55, 0x5d, 0xc7, 0x6d, 0x22, 0x37, 0x41, 0x96, 0x8c,
+	0x6c, 0x7c, 0x31, 0xbc, 0x94, 0xe4, 0xa6, 0x4f, 0xb8, 0xe4, 0x03, 0x26, 0x96, 0x8b, 0xe8, 0xa1,
+	0xac, 0xc4, 0x67, 0x3c, 0x40, 0x65, 0xc9, 0x40, 0xd7, 0x61, 0x4e, 0xc2, 0x3d, 0xb9, 0x89, 0x85,
+	0xe6, 0x81, 0xa8, 0x28, 0xb8, 0x2d, 0xc8, 0x8a, 0x84, 0x8f, 0xb3, 0xc9, 0x45, 0x66, 0x29, 0x4d,
+	0xae, 0xb8, 0x1c, 0xce, 0x7e, 0xcf, 0xf0, 0xc8, 0x86, 0x2a, 0xda, 0x40, 0x65, 0x0b, 0x06, 0xad,
+	0x03, 0xdb, 0x54, 0x15, 0xa3, 0xdb, 0xb1, 0xf8, 0x95, 0x75, 0xcb, 0x50, 0xb3, 0x40, 0x98, 0x9e,
+	0x1f, 0x62, 0x7a, 0x9d, 0x1a, 0x29, 0x3f, 0xe3, 0xa0, 0x14, 0x6c, 0x8c, 0xad, 0x48, 0x22, 0xcc,
+	0x46, 0xb6, 0x22, 0x89, 0x04, 0x9b, 0xcc, 0xfd, 0x5b, 0x18, 0x66, 0xd6, 0xd5, 0x23, 0xe5, 0xa7,
+	0x56, 0xf4, 0x16, 0x64, 0xb0, 0x22, 0x09, 0xf4, 0xe6, 0x96, 0xac, 0xc2, 0x04, 0xf3, 0x72, 0x10,
+	0xb3, 0xa4, 0x48, 0xeb, 0x04, 0xa8, 0x3c, 0xf0, 0x11, 0x7e, 0x1a, 0x0f, 0x56, 0x0d, 0x74, 0x0d,
+	0xe2, 0x3a, 0xfe, 0xa8, 0x8b, 0x0d, 0x93, 0xda, 0xcc, 0xfc, 0xb0, 0xcd, 0xf0, 0x36, 0xc0, 0xe6,
+	0x14, 0xef, 0xc0, 0xa2, 0xeb, 0x90, 0x34, 0x9a, 0x07, 0x58, 0xea, 0xb6, 0xb1, 0x94, 0x8d, 0x9e,
+	0x65, 0x6c, 0x9b, 0x53, 0xfc, 0x00, 0x7c, 0x94, 0xae, 0x63, 0x63, 0xe8, 0x3a, 0x0f, 0x19, 0x03,
+	0x1b, 0x86, 0x85, 0x72, 0x88, 0xfb, 0x82, 0x2c, 0x65, 0xe3, 0x96, 0x30, 0x89, 0x96, 0xef, 0x87,
+	0xb3, 0x1f, 0xb3, 0xfc, 0x34, 0xdd, 0xdf, 0xc6, 0xfd, 0xb2, 0xc4, 0xcd, 0x0c, 0xdc, 0x01, 0x85,
+	0xff, 0xcc, 0x31, 0xb9, 0x2f, 0xc2, 0xc0, 0x36, 0x8e, 0x0b, 0xcd, 0x43, 0x45, 0x3d, 0x6a, 0x63,
+	0xa9, 0xd5, 0xc1, 0xca, 0x48, 0xc3, 0x63, 0xc6, 0x60, 0xa6, 0x0c, 0x31, 0x1d, 0x1b, 0xdd, 0xb6,
+	0x49, 0x94, 0x96, 0x59, 0x7b, 0x61, 0xf8, 0xf2, 0xfe, 0x83, 0xf2, 0x3c, 0x01, 0x27, 0xdc, 0x7e,
+	0x42, 0xdc, 0x8e, 0x12, 0x40, 0x5b, 0xc0, 0x4a, 0xd4, 0x68, 0x04, 0x9a, 0x78, 0xa8, 0x3e, 0x2f,
+	0x05, 0x89, 0x06, 0x8c, 0x8b, 0x9f, 0x91, 0xfc, 0x0b, 0xb9, 0xff, 0x63, 0x20, 0x66, 0x1f, 0x84,
+	0x52, 0x10, 0xaf, 0xdf, 0x2a, 0x16, 0x4b, 0xf5, 0x3a, 0x3b, 0x85, 0x66, 0x21, 0x7d, 0xab, 0xb2,
+	0x5d, 0xa9, 0xde, 0xa9, 0x08, 0x25, 0x9e, 0xaf, 0xf2, 0x2c, 0x83, 0xa6, 0x21, 0xd1, 0xa8, 0x56,
+	0x85, 0x9d, 0x42, 0xa3, 0xc4, 0x86, 0x50, 0x1a, 0x92, 0xd6, 0xaf, 0x52, 0x81, 0xdf, 0xb9, 0xc7,
+	0x86, 0xd1, 0x1c, 0xb0, 0xc5, 0xea, 0xce, 0x4e, 0xb9, 0x5e, 0xae, 0x56, 0x84, 0x5a, 0xa1, 0xb8,
+	0x5d, 0x6a, 0xb0, 0x11, 0xff, 0x2a, 0x57, 0x2a, 0x14, 0xab, 0x15, 0x36, 0x6a, 0x1d, 0xd4, 0xb8,
+	0x2b, 0x6c, 0xf0, 0xa5, 0x77, 0xd9, 0x18, 0xa1, 0x7a, 0x57, 0xa8, 0x55, 0xef, 0x94, 0x78, 0x36,
+	0x8e, 0x58, 0x98, 0xbe, 0x59, 0xab, 0x0b, 0xb7, 0x2a, 0x3b, 0xd5, 0xe2, 0x76, 0x69, 0x9d, 0x4d,
+	0xa0, 0x0c, 0xc0, 0x0e, 0xd7, 0x10, 0x36, 0x0a, 0xe5, 0x9d, 0xd2, 0x3a, 0x9b, 0x5c, 0x88, 0x7d,
+	0xfb, 0x70, 0x3e, 0x94, 0x65, 0x72, 0xff, 0xc2, 0xc0, 0x93, 0x37, 0x45, 0x13, 0x1f, 0x89, 0xfd,
+	0x21, 0x15, 0x15, 0x21, 0xd5, 0xb2, 0xb7, 0xa8, 0x7a, 0x2c, 0xd9, 0xe4, 0x82, 0xb2, 0xa1, 0xd8,
+	0x5e, 0x4b, 0x87, 0x96, 0xb3, 0x66, 0xa0, 0xd7, 0x20, 0x66, 0x1e, 0x0b, 0x62, 0xf3, 0x90, 0x7a,
+	0xd9, 0xe2, 0x59, 0x0a, 0xe3, 0xa3, 0xa6, 0xb5, 0x92, 0xd3, 0x61, 0x8e, 0x92, 0xf6, 0x47, 0xf0,
+	0x02, 0xc4, 0x1d, 0x6d, 0xd9, 0x1c, 0x3d, 0x1d, 0xa4, 0xe8, 0x83, 0xf7, 0xc4, 0x5b, 0x07, 0x0f,
+	0x3d, 0x09, 0xf1, 0x3d, 0x51, 0x91, 0x2c, 0x53, 0xb6, 0x98, 0x4a, 0xf2, 0x31, 0xeb, 0x67, 0x59,
+	0xca, 0xfd, 0x31, 0x01, 0xb3, 0x05, 0x4d, 0x6b, 0xcb, 0x4d, 0x62, 0x70, 0x36, 0xa1, 0x11, 0x0e,
+	0xc0, 0x9c, 0xe6, 0x00, 0x28, 0x07, 0xb1, 0x7d, 0x41, 0x53, 0x75, 0xdb, 0x46, 0xd3, 0x5c, 0xea,
+	0x84, 0x4b, 0x2c, 0xc7, 0xb2, 0xdf, 0x33, 0xaf, 0xff, 0x8e, 0xe1, 0xa3, 0xfb, 0x35, 0x55, 0x37,
+	0xd1, 0x39, 0x88, 0xee, 0x0b, 0x4d, 0xc5, 0x24, 0x16, 0x97, 0xe6, 0x23, 0xfb, 0x45, 0xc5, 0xb4,
+	0x62, 0xd6, 0xbe, 0xde, 0x71, 0x63, 0x56, 0xc4, 0x8e, 0x59, 0xfb, 0x7a, 0xa7, 0xe6, 0x66, 0x9a,
+	0x19, 0x09, 0x37, 0x55, 0x09, 0x4b, 0x2e, 0x50, 0x94, 0xc6, 0xae, 0x60, 0xe4, 0xac, 0x93, 0xea,
+	0x80, 0xcf, 0x50, 0x78, 0x87, 0xc2, 0xeb, 0x90, 0x0d, 0x50, 0x10, 0x8e, 0x44, 0x5d, 0x21, 0xb9,
+	0x6b, 0xda, 0xf2, 0x3f, 0xfe, 0xbc, 0x1f, 0xe3, 0x0e, 0xdd, 0x45, 0x1b, 0x80, 0x14, 0x55, 0xef,
+	0x88, 0x6d, 0xf9, 0xbe, 0xe7, 0xf8, 0x59, 0x92, 0xaa, 0x1e, 0x79, 0xfc, 0xec, 0x00, 0xc5, 0xe1,
+	0xe0, 0x6d, 0x78, 0x6a, 0x98, 0xce, 0x80, 0x09, 0x44, 0x98, 0x98, 0x1f, 0xc2, 0x73, 0xf9, 0x08,
+	0xe4, 0xca, 0xd8, 0x44, 0xb9, 0xd2, 0x9b, 0xaa, 0xe3, 0x8f, 0x9d, 0xaa, 0x3d, 0xd9, 0x36, 0x31,
+	0x51, 0xb6, 0x7d, 0x0d, 0x92, 0xa2, 0xa6, 0x09, 0x86, 0x65, 0x47, 0x24, 0x57, 0xa6, 0xd6, 0x9e,
+	0x0a, 0x9e, 0xbf, 0x8d, 0xfb, 0x25, 0xa5, 0x87, 0xdb, 0xaa, 0x86, 0xf9, 0xb8, 0xa8, 0x69, 0xf5,
+	0x6d, 0xdc, 0x47, 0x4b, 0x30, 0xdb, 0x16, 0x0d, 0x53, 0x10, 0x05, 0x62, 0x35, 0x82, 0x15, 0x7f,
+	0x48, 0xd2, 0x4c, 0xf3, 0x69, 0x6b, 0xa3, 0xb0, 0x51, 0x54, 0x4c, 0x2b, 0x4a, 0xa1, 0x0b, 0x90,
+	0x6c, 0xaa, 0xca, 0xbe, 0xac, 0x77, 0xb0, 0x94, 0x4d, 0x2d, 0x32, 0x4b, 0x09, 0x7e, 0xb0, 0x30,
+	0x32, 0xf7, 0xa6, 0x27, 0xcd, 0xbd, 0xa8, 0x02, 0xc9, 0xb6, 0x6a, 0xbb, 0x88, 0x91, 0xcd, 0x10,
+	0x05, 0x5c, 0x09, 0x5e, 0x63, 0xc8, 0x8d, 0xf2, 0x3b, 0x0e, 0x4a, 0x49, 0x31, 0xf5, 0x3e, 0x3f,
+	0x20, 0x81, 0x76, 0x20, 0xd5, 0xc3, 0xba, 0xe1, 0xe4, 0x81, 0x19, 0xc2, 0xd0, 0x4b, 0x8f, 0x4c,
+	0xaa, 0xb7, 0x6d, 0x58, 0x5f, 0xc4, 0xe9, 0x39, 0x6b, 0x86, 0x15, 0xb6, 0x14, 0x6c, 0x1e, 0xa9,
+	0xfa, 0x21, 0xa1, 0xc6, 0x8e, 0x0e, 0x5b, 0x15, 0x1b, 0xc4, 0x47, 0x44, 0x71, 0xd6, 0x8c, 0x85,
+	0xdb, 0x90, 0xf1, 0xf3, 0x8b, 0x58, 0x08, 0x5b, 0x5a, 0x63, 0x48, 0xc0, 0xb0, 0xbe, 0xa2, 0x3c,
+	0x44, 0x7b, 0x62, 0xbb, 0x8b, 0x69, 0x64, 0xcb, 0x06, 0x8f, 0x70, 0x08, 0xf0, 0x36, 0xd8, 0xf5,
+	0xd0, 0xeb, 0xcc, 0xf5, 0xc4, 0x77, 0x0f, 0xe7, 0x23, 0x09, 0x86, 0x9d, 0xca, 0x7d, 0x15, 0x87,
+	0xa7, 0x86, 0x84, 0x54, 0x71, 0xcd, 0x7e, 0xe2, 0xa8, 0x73, 0x39, 0x10, 0x75, 0xd2, 0x27, 0x1c,
+	0x2c, 0x27, 0xb2, 0xdf, 0x33, 0x4b, 0x3f, 0x3c, 0xee, 0xd4, 0x46, 0xfa, 0xfe, 0xe9, 0xa1, 0xc7,
+	0xe3, 0x3d, 0x93, 0x47, 0x81, 0xd8, 0x84, 0x51, 0x20, 0xfe, 0xd8, 0x51, 0x20, 0xf1, 0x58, 0x51,
+	0xa0, 0xe4, 0x8f, 0x02, 0xc9, 0xb3, 0xa2, 0x00, 0x21, 0xf2, 0xbf, 0x4c, 0x28, 0xc1, 0xf8, 0xe2,
+	0x81, 0xcf, 0x59, 0x61, 0x1c, 0x67, 0x4d, 0x4d, 0xec, 0xac, 0x77, 0xbd, 0xce, 0x3a, 0x4d, 0xe4,
+	0x74, 0xfd, 0x4c, 0x67, 0x1d, 0xd8, 0xe1, 0xf8, 0x6e, 0x9b, 0xfe, 0x51, 0xdd, 0x36, 0xf3, 0x33,
+	0x75, 0xdb, 0x5f, 0x85, 0xe0, 0x9c, 0x47, 0x5c, 0x0e, 0x30, 0xca, 0x42, 0xdc, 0xc0, 0xba, 0x75,
+	0x4f, 0x7a, 0x96, 0xf3, 0x13, 0xbd, 0x0d, 0x09, 0x47, 0x66, 0x67, 0x1d, 0xe9, 0xb5, 0x35, 0x07,
+	0x07, 0x7d, 0xc6, 0x00, 0x88, 0xa6, 0xa9, 0xcb, 0x7b, 0x5d, 0x13, 0x5b, 0x2d, 0x87, 0xa5, 0xc2,
+	0xab, 0xa7, 0xa8, 0xd0, 0xa1, 0x96, 0x2f, 0xb8, 0x58, 0x44, 0x16, 0xdc, 0xb5, 0x13, 0x6e, 0xed,
+	0x4b, 0x66, 0x95, 0x85, 0xdc, 0x65, 0x3d, 0x97, 0xbd, 0xbc, 0x76, 0xf1, 0x1f, 0xde, 0x17, 0x57,
+	0xee, 0x5f, 0x59, 0x79, 0xe3, 0x83, 0xa5, 0x1b, 0xd7, 0xdf, 0x5f, 0xf9, 0xe0, 0x86, 0xf3, 0xf3,
+	0xc5, 0x7f, 0x5c, 0xfb, 0xbb, 0x7f, 0xba, 0xbc, 0x1c, 0xd5, 0xc3, 0xd9, 0x6f, 0x18, 0xde, 0x73,
+	0xfa, 0xc2, 0x5b, 0x30, 0x13, 0xa0, 0x3a, 0x42, 0xc2, 0x73, 0x5e, 0x09, 0x27, 0x47, 0xcb, 0xf1,
+	0x37, 0x21, 0x78, 0xc2, 0xc3, 0xf3, 0x96, 0x2a, 0x2b, 0x85, 0x66, 0x13, 0x6b, 0xe6, 0xc4, 0x81,
+	0xcf, 0x97, 0x54, 0x43, 0x13, 0x24, 0xd5, 0xbb, 0xf0, 0x84, 0xac, 0x38, 0xf3, 0x21, 0x49, 0x70,
+	0x6a, 0x7a, 0x47, 0xc4, 0xcf, 0x9e, 0x22, 0x62, 0xa7, 0x21, 0xe0, 0xe7, 0x3c, 0x14, 0x9c, 0x45,
+	0x03, 0xbd, 0x00, 0x33, 0x1a, 0x56, 0x24, 0x59, 0x69, 0x09, 0x94, 0x55, 0x12, 0x54, 0x13, 0x7c,
+	0x86, 0x2e, 0xd7, 0xed, 0xd5, 0x60, 0x1c, 0x49, 0x3c, 0x5e, 0x1c, 0xf1, 0x88, 0xf5, 0x13, 0xf0,
+	0x99, 0xa7, 0xc3, 0x12, 0xfa, 0x35, 0xf3, 0x08, 0xa9, 0xfe, 0x17, 0xe3, 0x88, 0xf5, 0xbb, 0x87,
+	0xf3, 0x5f, 0x30, 0x0b, 0x95, 0xc7, 0x18, 0x56, 0x91, 0x4f, 0xad, 0xdd, 0x6d, 0xc9, 0x4a, 0xbe,
+	0x82, 0x8f, 0x36, 0xf1, 0x31, 0xd7, 0x37, 0xb1, 0xb1, 0xd1, 0x16, 0x5b, 0xb9, 0x9b, 0x3f, 0x90,
+	0xde, 0x4d, 0x6c, 0x12, 0x62, 0x3f, 0x56, 0x8d, 0xfd, 0x35, 0x33, 0x22, 0xd9, 0x71, 0xff, 0xce,
+	0xfc, 0xcc, 0x6f, 0xff, 0xd7, 0xeb, 0x02, 0xe0, 0xd4, 0x2e, 0xc0, 0x97, 0xb1, 0x62, 0xc1, 0x8c,
+	0x75, 0x13, 0x92, 0xcd, 0xb6, 0x68, 0x18, 0xc2, 0x9e, 0xd0, 0xa4, 0xf5, 0xf5, 0x4b, 0x63, 0x78,
+	0x51, 0xbe, 0x68, 0x21, 0x71, 0x45, 0x3e, 0xde, 0xb4, 0xbf, 0xa0, 0x4d, 0x48, 0x68, 0xba, 0xac,
+	0xea, 0xb2, 0xd9, 0x27, 0x4e, 0x91, 0x19, 0xce, 0x04, 0x8d, 0xe3, 0x3a, 0x9d, 0x6c, 0xd4, 0x28,
+	0xa4, 0xa7, 0xc7, 0x77, 0xb1, 0x47, 0xcd, 0x19, 0x92, 0x63, 0xcc, 0x19, 0x2a, 0x00, 0x74, 0x70,
+	0x22, 0x2b, 0x2d, 0x9a, 0x75, 0xf3, 0xe3, 0x5c, 0xa5, 0xee, 0x62, 0xf1, 0x1e, 0x0a, 0x0b, 0xff,
+	0xca, 0x40, 0x9c, 0xde, 0x12, 0x95, 0x20, 0x41, 0x1b, 0x64, 0x83, 0x16, 0x2e, 0x2f, 0x06, 0x29,
+	0x53, 0xd0, 0x11, 0xbd, 0xb5, 0x8b, 0x8a, 0x6e, 0x40, 0x5a, 0xdc, 0x33, 0xd4, 0x76, 0xd7, 0xc4,
+	0x02, 0xa9, 0x0d, 0xce, 0xee, 0x45, 0xa6, 0x1d, 0x04, 0x6b, 0xc9, 0x8d, 0x1a, 0xcc, 0xc2, 0x2f,
+	0x19, 0x80, 0x01, 0xe3, 0xe8, 0x0d, 0x00, 0x45, 0x35, 0x85, 0x3d, 0xbc, 0xaf, 0xea, 0x4e, 0x97,
+	0x7d, 0x1a, 0xd9, 0xa4, 0xa2, 0x9a, 0x1c, 0x01, 0xb6, 0x50, 0xf1, 0xb1, 0x26, 0xeb, 0xd8, 0xb0,
+	0xe2, 0x59, 0xe8, 0x6c, 0x54, 0x0a, 0x5d, 0x30, 0xd1, 0x2b, 0x30, 0x2b, 0x61, 0xa9, 0xeb, 0x8a,
+	0x94, 0xc4, 0x73, 0xcb, 0x75, 0x93, 0x5c, 0xfc, 0x84, 0xb3, 0xd5, 0xc4, 0xfa, 0x20, 0xb6, 0x71,
+	0x7f, 0x70, 0x89, 0xc1, 0xb7, 0xdc, 0x3d, 0x98, 0x1b, 0xa1, 0x1a, 0x03, 0x15, 0x20, 0x39, 0x08,
+	0xf2, 0xcc, 0xf8, 0x41, 0x7e, 0x80, 0x95, 0xfb, 0x9c, 0x81, 0xf9, 0x11, 0x20, 0x35, 0xb5, 0x2d,
+	0x37, 0xfb, 0xe8, 0x3a, 0xa4, 0x24, 0xbc, 0x2f, 0x76, 0xdb, 0xa6, 0x60, 0x9a, 0x6d, 0x2a, 0xb9,
+	0x53, 0x8a, 0x35, 0xa0, 0xd0, 0x0d, 0xb3, 0x8d, 0x96, 0x61, 0x56, 0xd5, 0x25, 0xac, 0x0b, 0x7b,
+	0x7d, 0xc1, 0xb5, 0xfd, 0x10, 0xf1, 0xb0, 0x19, 0xb2, 0xc1, 0xf5, 0x1d, 0x43, 0xf7, 0x5c, 0xf5,
+	0x7f, 0x46, 0xf3, 0xb3, 0x21, 0xca, 0x6d, 0x2c, 0xa1, 0x32, 0x24, 0x1c, 0xd6, 0x29, 0x33, 0xe3,
+	0xdc, 0xd7, 0x5b, 0x85, 0x38, 0xe8, 0xe8, 0xef, 0x21, 0x4a, 0xde, 0x03, 0xa8, 0x4e, 0x2f, 0x0c,
+	0x95, 0x79, 0xd6, 0xe6, 0x3a, 0x36, 0x45, 0xb9, 0xed, 0x2d, 0x99, 0x6d, 0x24, 0x6f, 0xfd, 0xc4,
+	0xc0, 0x25, 0xcf, 0x99, 0xe5, 0x51, 0xe9, 0xf3, 0x87, 0xeb, 0x09, 0x3d, 0x07, 0x33, 0xa4, 0x61,
+	0xf6, 0xb4, 0xcb, 0x24, 0x51, 0xf0, 0xd3, 0xd6, 0xb2, 0xdb, 0x2d, 0x0f, 0xd7, 0x1a, 0xe1, 0xd3,
+	0x6a, 0x0d, 0xcf, 0x3d, 0xfe, 0x39, 0x0a, 0x39, 0xe7, 0xe0, 0x77, 0xbb, 0xb8, 0x8b, 0xab, 0x1a,
+	0xb6, 0xb5, 0xea, 0x95, 0x04, 0xfa, 0x86, 0x81, 0x84, 0x84, 0x7b, 0x82, 0x28, 0x49, 0x3a, 0xcd,
+	0xb8, 0xff, 0xcd, 0x3c, 0x28, 0xcc, 0x6f, 0x41, 0x6e, 0xed, 0xd5, 0x2b, 0x57, 0x0a, 0x5c, 0x71,
+	0x3d, 0xf7, 0x65, 0x88, 0x89, 0xff, 0x67, 0x28, 0x66, 0xa5, 0x04, 0xa5, 0x75, 0xc2, 0xc5, 0xee,
+	0x47, 0x0e, 0x22, 0x1a, 0xf3, 0xed, 0xc3, 0xf9, 0x4f, 0x18, 0xb8, 0xd1, 0x52, 0xf3, 0xe6, 0x01,
+	0x36, 0x49, 0xe2, 0xc8, 0xd3, 0x4a, 0x77, 0xd5, 0xff, 0x50, 0xd2, 0xbb, 0xba, 0xaa, 0x1d, 0xb6,
+	0x56, 0xcd, 0xbe, 0x86, 0x8d, 0xfc, 0xae, 0xa8, 0x1b, 0x07, 0x62, 0x7b, 0xb3, 0x74, 0x97, 0x24,
+	0x0e, 0x34, 0x31, 0x81, 0x5b, 0x4a, 0xc7, 0x26, 0xf1, 0x8a, 0x9d, 0x79, 0xe2, 0x12, 0xee, 0x15,
+	0x24, 0x49, 0x1f, 0x21, 0xab, 0xd0, 0xa9, 0x75, 0xd9, 0xb3, 0x90, 0xe9, 0xc8, 0x8a, 0x57, 0x03,
+	0x76, 0x1e, 0x4e, 0x75, 0x64, 0xc5, 0x55, 0xc0, 0x6f, 0x19, 0x60, 0x9d, 0x52, 0xc9, 0x95, 0x53,
+	0xe4, 0x6f, 0x51, 0x4e, 0x4e, 0x69, 0xb7, 0x4e, 0xc5, 0xf5, 0x16, 0x9c, 0x0f, 0xd4, 0x80, 0x8e,
+	0xd8, 0xa2, 0x01, 0xb1, 0x9d, 0xf3, 0x17, 0x85, 0xb6, 0xf4, 0xd6, 0x06, 0xe8, 0x01, 0x29, 0xc6,
+	0x88, 0x14, 0x11, 0xdd, 0xdd, 0x1d, 0x08, 0x33, 0xf7, 0x69, 0xc8, 0x37, 0x52, 0xa0, 0xf4, 0x78,
+	0xdc, 0x54, 0x7b, 0x58, 0x7f, 0x8c, 0x91, 0x42, 0x01, 0xb2, 0x92, 0x6c, 0x34, 0x45, 0xdd, 0x2a,
+	0x14, 0xce, 0xd0, 0xfd, 0x13, 0x2e, 0xa4, 0xef, 0x1a, 0x17, 0x21, 0x65, 0xb3, 0xae, 0x63, 0x03,
+	0xdb, 0x95, 0x58, 0x82, 0x4f, 0x5a, 0x95, 0x18, 0x6f, 0x2d, 0xa0, 0x67, 0x20, 0xed, 0xf1, 0xd3,
+	0xae, 0x46, 0x74, 0x9f, 0xe6, 0xc1, 0xf1, 0xd2, 0x5b, 0xda, 0xe8, 0xd9, 0x57, 0x74, 0xc4, 0xec,
+	0xcb, 0xe3, 0x9d, 0x32, 0x9c, 0xf7, 0x09, 0x82, 0x74, 0x62, 0xeb, 0x56, 0xef, 0xff, 0xe8, 0x3e,
+	0xed, 0x25, 0x88, 0x90, 0x59, 0x42, 0xe8, 0xf4, 0x5a, 0x8a, 0x00, 0x79, 0x8e, 0xfa, 0x53, 0x12,
+	0xd2, 0xbe, 0xfe, 0x19, 0x35, 0x86, 0x9e, 0x89, 0x98, 0xf1, 0x9f, 0x89, 0x3c, 0xb1, 0x33, 0xf8,
+	0x60, 0x34, 0x54, 0xc8, 0x84, 0xc6, 0x28, 0x64, 0x02, 0xf3, 0xca, 0xe9, 0x89, 0xe6, 0x95, 0x5b,
+	0x90, 0xe9, 0x6a, 0x23, 0x1e, 0x48, 0x9e, 0x39, 0x73, 0x80, 0xb0, 0x39, 0xc5, 0xa7, 0xbb, 0xbe,
+	0xb9, 0xfd, 0x7b, 0x30, 0x4b, 0x69, 0x0d, 0xa6, 0x3a, 0x8f, 0x1a, 0xf5, 0x9d, 0x32, 0x8f, 0xd8,
+	0x9c, 0xe2, 0xd9, 0x6e, 0x70, 0x56, 0xb6, 0x09, 0xa9, 0x0f, 0x55, 0x59, 0x11, 0x44, 0xd2, 0x41,
+	0xd2, 0x07, 0xb5, 0xe7, 0x4e, 0xa1, 0x3a, 0x68, 0x37, 0x37, 0xa7, 0x78, 0xf8, 0x70, 0xd0, 0x7c,
+	0x6e, 0xc2, 0xb4, 0xfb, 0x28, 0x24, 0x36, 0x0f, 0x69, 0x61, 0x3d, 0x4e, 0xf6, 0xd9, 0x9c, 0xe2,
+	0x53, 0x0e, 0x6a, 0xa1, 0x79, 0x88, 0xb6, 0x20, 0xed, 0x52, 0x52, 0x2c, 0x52, 0xb1, 0x49, 0x48,
+	0xb9, 0x5c, 0x54, 0xc4, 0x00, 0x2d, 0x03, 0x2b, 0x26, 0xad, 0xad, 0x27, 0xa5, 0x55, 0xc7, 0x8a,
+	0x89, 0x1a, 0xe0, 0xbe, 0x5e, 0x09, 0xfb, 0xa4, 0x4c, 0xa0, 0x85, 0xe3, 0x8b, 0x63, 0x50, 0xb3,
+	0xeb, 0x8a, 0xcd, 0x29, 0x3e, 0x23, 0xf9, 0x2b, 0x8d, 0x8a, 0x87, 0xea, 0x47, 0x56, 0x3a, 0x94,
+	0xe8, 0x50, 0x6c, 0x4c, 0x1e, 0x5d, 0x7a, 0x24, 0x97, 0x4a, 0x48, 0x85, 0x05, 0x3f, 0x3d, 0xc1,
+	0xd3, 0x68, 0xd3, 0xe7, 0xe2, 0xd5, 0x53, 0x48, 0x8f, 0xaa, 0x2b, 0x36, 0xa7, 0xf8, 0xac, 0xef,
+	0x18, 0x0f, 0x90, 0x75, 0x01, 0x67, 0xe2, 0x22, 0x18, 0x6a, 0xbb, 0x47, 0xa7, 0xe7, 0xa7, 0x5f,
+	0xc0, 0x99, 0xb4, 0x58, 0x17, 0x70, 0xb0, 0xeb, 0x04, 0x19, 0x6d, 0xc3, 0x34, 0x0d, 0x2c, 0x02,
+	0x89, 0x2a, 0xf6, 0x74, 0xec, 0xf9, 0x53, 0x88, 0x79, 0xa2, 0x94, 0x65, 0x4b, 0x86, 0x27, 0x68,
+	0xbd, 0x07, 0xb3, 0x4e, 0xf8, 0xd5, 0x9d, 0x68, 0x9e, 0x9d, 0x3d, 0xd3, 0x77, 0x82, 0x09, 0xc0,
+	0xf2, 0x1d, 0x23, 0x98, 0x14, 0x2e, 0x40, 0xd2, 0x90, 0x3b, 0xdd, 0x36, 0x11, 0x6c, 0xc6, 0x8e,
+	0xcf, 0xee, 0xc2, 0x20, 0xce, 0x71, 0x49, 0x08, 0x75, 0x35, 0xfb, 0x41, 0xf7, 0xab, 0x30, 0x64,
+	0xa9, 0x5b, 0xd3, 0xfe, 0x70, 0xc3, 0xf2, 0x45, 0xd3, 0xc4, 0xba, 0x81, 0x76, 0x61, 0xba, 0xab,
+	0x09, 0xfb, 0xce, 0x02, 0x89, 0x7d, 0x99, 0xe1, 0x67, 0xbf, 0x20, 0xa2, 0xa7, 0x79, 0x4b, 0x75,
+	0x35, 0x77, 0x19, 0xdd, 0x80, 0xf3, 0x5e, 0x72, 0x82, 0x26, 0xea, 0x62, 0x07, 0x5b, 0x84, 0xc9,
+	0x70, 0x89, 0x4b, 0x9e, 0x70, 0x31, 0x3d, 0x92, 0xfd, 0xf8, 0xe3, 0x77, 0xf8, 0x39, 0x0f, 0x5e,
+	0xcd, 0x01, 0x43, 0xef, 0x02, 0xb1, 0x2d, 0x0f, 0x47, 0xe1, 0x89, 0x39, 0x22, 0xde, 0x37, 0xe0,
+	0xa9, 0x08, 0x59, 0x3f, 0x49, 0x0f, 0x57, 0x91, 0x20, 0x57, 0xe7, 0x7d, 0xb8, 0x03, 0xbe, 0xf6,
+	0x61, 0xda, 0xc4, 0x86, 0x29, 0xf4, 0x70, 0xd3, 0x54, 0x75, 0x83, 0xfe, 0xe9, 0xe3, 0xe5, 0x47,
+	0xfc, 0x09, 0x21, 0xc8, 0x5c, 0x03, 0x1b, 0xe6, 0x6d, 0x82, 0xc9, 0xb1, 0x27, 0x5c, 0xf4, 0x01,
+	0x13, 0x62, 0xe7, 0x1c, 0x55, 0xf1, 0x29, 0xd3, 0xdd, 0x35, 0x3c, 0xbd, 0xc2, 0xa7, 0x11, 0x78,
+	0xe6, 0x4c, 0x72, 0xe8, 0x4d, 0x88, 0x28, 0x62, 0x87, 0x66, 0x47, 0xee, 0x85, 0x13, 0x6e, 0xac,
+	0x59, 0x21, 0x4f, 0x90, 0xd0, 0xb2, 0xd5, 0x00, 0x19, 0x4d, 0x5d, 0xd6, 0xdc, 0x71, 0x67, 0x92,
+	0xc8, 0xd1, 0x9e, 0x24, 0x7a, 0x37, 0x51, 0x03, 0x22, 0x56, 0x25, 0x45, 0xd5, 0xf1, 0xea, 0xc4,
+	0x17, 0xcf, 0x37, 0xfa, 0x1a, 0xf6, 0x28, 0x89, 0x50, 0x43, 0x97, 0xdc, 0xc1, 0x50, 0x24, 0xf0,
+	0x5f, 0x14, 0x3a, 0x15, 0x0a, 0x3c, 0x76, 0x44, 0xc7, 0x79, 0x64, 0x8d, 0x4d, 0x36, 0x5e, 0x59,
+	0x86, 0x84, 0x3b, 0x4e, 0xb1, 0xff, 0x4d, 0x93, 0x39, 0xe1, 0x52, 0x0f, 0x98, 0x04, 0x0b, 0x34,
+	0x4b, 0xbb, 0xfb, 0xe8, 0x79, 0x88, 0x91, 0x16, 0xc9, 0xc8, 0x26, 0x46, 0x42, 0xd2, 0xdd, 0xdc,
+	0x36, 0x44, 0xac, 0xfb, 0x92, 0x7f, 0x14, 0xd4, 0x76, 0xca, 0x95, 0x6d, 0x61, 0xbd, 0x54, 0xac,
+	0xae, 0x97, 0xd8, 0x29, 0x74, 0x0e, 0x66, 0xd6, 0xab, 0x77, 0x2a, 0x64, 0xb1, 0x54, 0x21, 0x8b,
+	0x8c, 0x6f, 0x91, 0x42, 0x86, 0xdc, 0x57, 0xff, 0x5f, 0x30, 0x30, 0xe7, 0x6b, 0x5e, 0xe8, 0x3f,
+	0x4d, 0x7e, 0xa2, 0xd2, 0x65, 0xd7, 0xdb, 0xcf, 0x85, 0xc6, 0xee, 0xe7, 0x38, 0x38, 0xe1, 0xe2,
+	0x0f, 0x98, 0x08, 0xfb, 0x1f, 0x9f, 0xc7, 0x3c, 0xbd, 0xdd, 0xf2, 0xff, 0x33, 0xc0, 0x06, 0xcd,
+	0x02, 0x21, 0xc8, 0x6c, 0x54, 0xf9, 0xdd, 0x42, 0xa3, 0x51, 0xe2, 0x85, 0x4a, 0xb5, 0x62, 0x09,
+	0x26, 0x0b, 0x73, 0x83, 0x35, 0xbe, 0x54, 0xab, 0xd6, 0xcb, 0x8d, 0x2a, 0x7f, 0x8f, 0x65, 0xd0,
+	0x02, 0x9c, 0x1f, 0xec, 0xdc, 0xe4, 0x6b, 0x45, 0xa1, 0x5e, 0xe2, 0x6f, 0x97, 0x8b, 0x25, 0x36,
+	0xe4, 0xc7, 0xda, 0x2a, 0xdc, 0x2e, 0xd4, 0x8b, 0x7c, 0xb9, 0xd6, 0x60, 0xc3, 0xfe, 0x9d, 0x62,
+	0xe1, 0x5e, 0xa9, 0x52, 0x29, 0xed, 0xd4, 0x6a, 0x6c, 0xc4, 0x7f, 0xfa, 0x9d, 0x42, 0x7d, 0x97,
+	0x8d, 0x2e, 0xcc, 0x7e, 0xfb, 0x70, 0x3e, 0x9d, 0x65, 0x96, 0x93, 0xee, 0x0e, 0x77, 0xed, 0xeb,
+	0xdf, 0x5f, 0x64, 0xde, 0x5b, 0x9d, 0xa0, 0xd5, 0x30, 0x15, 0x6d, 0x6f, 0x2f, 0x46, 0x0c, 0xee,
+	0xea, 0x5f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xec, 0xac, 0x72, 0x32, 0x29, 0x00, 0x00,
 }
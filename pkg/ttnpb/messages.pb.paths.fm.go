@@ -70,7 +70,13 @@ var UplinkMessageFieldPathsNested = []string{
 	"settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.downlink",
 	"settings.downlink.antenna_index",
+	"settings.downlink.implicit_header",
 	"settings.downlink.invert_polarization",
+	"settings.downlink.listen_before_talk",
+	"settings.downlink.listen_before_talk.rssi_offset",
+	"settings.downlink.listen_before_talk.rssi_target",
+	"settings.downlink.listen_before_talk.scan_time",
+	"settings.downlink.preamble_length",
 	"settings.downlink.tx_power",
 	"settings.enable_crc",
 	"settings.frequency",
@@ -195,7 +201,13 @@ var DownlinkMessageFieldPathsNested = []string{
 	"settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.scheduled.downlink",
 	"settings.scheduled.downlink.antenna_index",
+	"settings.scheduled.downlink.implicit_header",
 	"settings.scheduled.downlink.invert_polarization",
+	"settings.scheduled.downlink.listen_before_talk",
+	"settings.scheduled.downlink.listen_before_talk.rssi_offset",
+	"settings.scheduled.downlink.listen_before_talk.rssi_target",
+	"settings.scheduled.downlink.listen_before_talk.scan_time",
+	"settings.scheduled.downlink.preamble_length",
 	"settings.scheduled.downlink.tx_power",
 	"settings.scheduled.enable_crc",
 	"settings.scheduled.frequency",
@@ -320,7 +332,13 @@ var TxAcknowledgmentFieldPathsNested = []string{
 	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"downlink_message.settings.scheduled.downlink",
 	"downlink_message.settings.scheduled.downlink.antenna_index",
+	"downlink_message.settings.scheduled.downlink.implicit_header",
 	"downlink_message.settings.scheduled.downlink.invert_polarization",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_offset",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_target",
+	"downlink_message.settings.scheduled.downlink.listen_before_talk.scan_time",
+	"downlink_message.settings.scheduled.downlink.preamble_length",
 	"downlink_message.settings.scheduled.downlink.tx_power",
 	"downlink_message.settings.scheduled.enable_crc",
 	"downlink_message.settings.scheduled.frequency",
@@ -447,7 +465,13 @@ var GatewayTxAcknowledgmentFieldPathsNested = []string{
 	"tx_ack.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"tx_ack.downlink_message.settings.scheduled.downlink",
 	"tx_ack.downlink_message.settings.scheduled.downlink.antenna_index",
+	"tx_ack.downlink_message.settings.scheduled.downlink.implicit_header",
 	"tx_ack.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"tx_ack.downlink_message.settings.scheduled.downlink.listen_before_talk",
+	"tx_ack.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_offset",
+	"tx_ack.downlink_message.settings.scheduled.downlink.listen_before_talk.rssi_target",
+	"tx_ack.downlink_message.settings.scheduled.downlink.listen_before_talk.scan_time",
+	"tx_ack.downlink_message.settings.scheduled.downlink.preamble_length",
 	"tx_ack.downlink_message.settings.scheduled.downlink.tx_power",
 	"tx_ack.downlink_message.settings.scheduled.enable_crc",
 	"tx_ack.downlink_message.settings.scheduled.frequency",
@@ -530,7 +554,13 @@ var GatewayUplinkMessageFieldPathsNested = []string{
 	"message.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"message.settings.downlink",
 	"message.settings.downlink.antenna_index",
+	"message.settings.downlink.implicit_header",
 	"message.settings.downlink.invert_polarization",
+	"message.settings.downlink.listen_before_talk",
+	"message.settings.downlink.listen_before_talk.rssi_offset",
+	"message.settings.downlink.listen_before_talk.rssi_target",
+	"message.settings.downlink.listen_before_talk.scan_time",
+	"message.settings.downlink.preamble_length",
 	"message.settings.downlink.tx_power",
 	"message.settings.enable_crc",
 	"message.settings.frequency",
@@ -583,7 +613,13 @@ var ApplicationUplinkFieldPathsNested = []string{
 	"settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.downlink",
 	"settings.downlink.antenna_index",
+	"settings.downlink.implicit_header",
 	"settings.downlink.invert_polarization",
+	"settings.downlink.listen_before_talk",
+	"settings.downlink.listen_before_talk.rssi_offset",
+	"settings.downlink.listen_before_talk.rssi_target",
+	"settings.downlink.listen_before_talk.scan_time",
+	"settings.downlink.preamble_length",
 	"settings.downlink.tx_power",
 	"settings.enable_crc",
 	"settings.frequency",
@@ -654,7 +690,13 @@ var ApplicationUplinkNormalizedFieldPathsNested = []string{
 	"settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.downlink",
 	"settings.downlink.antenna_index",
+	"settings.downlink.implicit_header",
 	"settings.downlink.invert_polarization",
+	"settings.downlink.listen_before_talk",
+	"settings.downlink.listen_before_talk.rssi_offset",
+	"settings.downlink.listen_before_talk.rssi_target",
+	"settings.downlink.listen_before_talk.scan_time",
+	"settings.downlink.preamble_length",
 	"settings.downlink.tx_power",
 	"settings.enable_crc",
 	"settings.frequency",
@@ -733,6 +775,10 @@ var ApplicationDownlinkFieldPathsNested = []string{
 	"f_port",
 	"frm_payload",
 	"priority",
+	"scheduling",
+	"scheduling.deduplication_key",
+	"scheduling.expires_at",
+	"scheduling.not_before",
 	"session_key_id",
 }
 
@@ -746,6 +792,7 @@ var ApplicationDownlinkFieldPathsTopLevel = []string{
 	"f_port",
 	"frm_payload",
 	"priority",
+	"scheduling",
 	"session_key_id",
 }
 var ApplicationDownlinksFieldPathsNested = []string{
@@ -755,6 +802,15 @@ var ApplicationDownlinksFieldPathsNested = []string{
 var ApplicationDownlinksFieldPathsTopLevel = []string{
 	"downlinks",
 }
+var ApplicationDownlinkPolicyFieldPathsNested = []string{
+	"default_ttl",
+	"order_by_priority",
+}
+
+var ApplicationDownlinkPolicyFieldPathsTopLevel = []string{
+	"default_ttl",
+	"order_by_priority",
+}
 var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink",
 	"downlink.class_b_c",
@@ -768,6 +824,10 @@ var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink.f_port",
 	"downlink.frm_payload",
 	"downlink.priority",
+	"downlink.scheduling",
+	"downlink.scheduling.deduplication_key",
+	"downlink.scheduling.expires_at",
+	"downlink.scheduling.not_before",
 	"downlink.session_key_id",
 	"error",
 	"error.attributes",
@@ -817,6 +877,21 @@ var DownlinkQueueOperationErrorDetailsFieldPathsTopLevel = []string{
 	"pending_session_key_id",
 	"session_key_id",
 }
+var ApplicationSessionRecoveredFieldPathsNested = []string{
+	"discarded_session_key_id",
+	"f_cnt_reset",
+	"last_a_f_cnt_down",
+	"last_f_cnt_up",
+	"session_key_id",
+}
+
+var ApplicationSessionRecoveredFieldPathsTopLevel = []string{
+	"discarded_session_key_id",
+	"f_cnt_reset",
+	"last_a_f_cnt_down",
+	"last_f_cnt_up",
+	"session_key_id",
+}
 var ApplicationServiceDataFieldPathsNested = []string{
 	"data",
 	"service",
@@ -850,6 +925,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_ack.f_port",
 	"up.downlink_ack.frm_payload",
 	"up.downlink_ack.priority",
+	"up.downlink_ack.scheduling",
+	"up.downlink_ack.scheduling.deduplication_key",
+	"up.downlink_ack.scheduling.expires_at",
+	"up.downlink_ack.scheduling.not_before",
 	"up.downlink_ack.session_key_id",
 	"up.downlink_failed",
 	"up.downlink_failed.downlink",
@@ -864,6 +943,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_failed.downlink.f_port",
 	"up.downlink_failed.downlink.frm_payload",
 	"up.downlink_failed.downlink.priority",
+	"up.downlink_failed.downlink.scheduling",
+	"up.downlink_failed.downlink.scheduling.deduplication_key",
+	"up.downlink_failed.downlink.scheduling.expires_at",
+	"up.downlink_failed.downlink.scheduling.not_before",
 	"up.downlink_failed.downlink.session_key_id",
 	"up.downlink_failed.error",
 	"up.downlink_failed.error.attributes",
@@ -891,6 +974,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_nack.f_port",
 	"up.downlink_nack.frm_payload",
 	"up.downlink_nack.priority",
+	"up.downlink_nack.scheduling",
+	"up.downlink_nack.scheduling.deduplication_key",
+	"up.downlink_nack.scheduling.expires_at",
+	"up.downlink_nack.scheduling.not_before",
 	"up.downlink_nack.session_key_id",
 	"up.downlink_queue_invalidated",
 	"up.downlink_queue_invalidated.downlinks",
@@ -908,6 +995,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_queued.f_port",
 	"up.downlink_queued.frm_payload",
 	"up.downlink_queued.priority",
+	"up.downlink_queued.scheduling",
+	"up.downlink_queued.scheduling.deduplication_key",
+	"up.downlink_queued.scheduling.expires_at",
+	"up.downlink_queued.scheduling.not_before",
 	"up.downlink_queued.session_key_id",
 	"up.downlink_sent",
 	"up.downlink_sent.class_b_c",
@@ -921,6 +1012,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_sent.f_port",
 	"up.downlink_sent.frm_payload",
 	"up.downlink_sent.priority",
+	"up.downlink_sent.scheduling",
+	"up.downlink_sent.scheduling.deduplication_key",
+	"up.downlink_sent.scheduling.expires_at",
+	"up.downlink_sent.scheduling.not_before",
 	"up.downlink_sent.session_key_id",
 	"up.join_accept",
 	"up.join_accept.app_s_key",
@@ -943,6 +1038,12 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.service_data",
 	"up.service_data.data",
 	"up.service_data.service",
+	"up.session_recovered",
+	"up.session_recovered.discarded_session_key_id",
+	"up.session_recovered.f_cnt_reset",
+	"up.session_recovered.last_a_f_cnt_down",
+	"up.session_recovered.last_f_cnt_up",
+	"up.session_recovered.session_key_id",
 	"up.uplink_message",
 	"up.uplink_message.app_s_key",
 	"up.uplink_message.app_s_key.encrypted_key",
@@ -984,7 +1085,13 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.uplink_message.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"up.uplink_message.settings.downlink",
 	"up.uplink_message.settings.downlink.antenna_index",
+	"up.uplink_message.settings.downlink.implicit_header",
 	"up.uplink_message.settings.downlink.invert_polarization",
+	"up.uplink_message.settings.downlink.listen_before_talk",
+	"up.uplink_message.settings.downlink.listen_before_talk.rssi_offset",
+	"up.uplink_message.settings.downlink.listen_before_talk.rssi_target",
+	"up.uplink_message.settings.downlink.listen_before_talk.scan_time",
+	"up.uplink_message.settings.downlink.preamble_length",
 	"up.uplink_message.settings.downlink.tx_power",
 	"up.uplink_message.settings.enable_crc",
 	"up.uplink_message.settings.frequency",
@@ -1033,7 +1140,13 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.uplink_normalized.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"up.uplink_normalized.settings.downlink",
 	"up.uplink_normalized.settings.downlink.antenna_index",
+	"up.uplink_normalized.settings.downlink.implicit_header",
 	"up.uplink_normalized.settings.downlink.invert_polarization",
+	"up.uplink_normalized.settings.downlink.listen_before_talk",
+	"up.uplink_normalized.settings.downlink.listen_before_talk.rssi_offset",
+	"up.uplink_normalized.settings.downlink.listen_before_talk.rssi_target",
+	"up.uplink_normalized.settings.downlink.listen_before_talk.scan_time",
+	"up.uplink_normalized.settings.downlink.preamble_length",
 	"up.uplink_normalized.settings.downlink.tx_power",
 	"up.uplink_normalized.settings.enable_crc",
 	"up.uplink_normalized.settings.frequency",
@@ -1060,6 +1173,7 @@ var ApplicationUpFieldPathsTopLevel = []string{
 var MessagePayloadFormattersFieldPathsNested = []string{
 	"down_formatter",
 	"down_formatter_parameter",
+	"test_vectors",
 	"up_formatter",
 	"up_formatter_parameter",
 }
@@ -1067,9 +1181,31 @@ var MessagePayloadFormattersFieldPathsNested = []string{
 var MessagePayloadFormattersFieldPathsTopLevel = []string{
 	"down_formatter",
 	"down_formatter_parameter",
+	"test_vectors",
 	"up_formatter",
 	"up_formatter_parameter",
 }
+var MessagePayloadFormatterTestVectorFieldPathsNested = []string{
+	"decoded_payload",
+	"description",
+	"errors",
+	"f_port",
+	"frm_payload",
+	"name",
+	"type",
+	"warnings",
+}
+
+var MessagePayloadFormatterTestVectorFieldPathsTopLevel = []string{
+	"decoded_payload",
+	"description",
+	"errors",
+	"f_port",
+	"frm_payload",
+	"name",
+	"type",
+	"warnings",
+}
 var DownlinkQueueRequestFieldPathsNested = []string{
 	"downlinks",
 	"end_device_ids",
@@ -1094,3 +1230,14 @@ var ApplicationDownlink_ClassBCFieldPathsTopLevel = []string{
 	"absolute_time",
 	"gateways",
 }
+var ApplicationDownlink_SchedulingFieldPathsNested = []string{
+	"deduplication_key",
+	"expires_at",
+	"not_before",
+}
+
+var ApplicationDownlink_SchedulingFieldPathsTopLevel = []string{
+	"deduplication_key",
+	"expires_at",
+	"not_before",
+}
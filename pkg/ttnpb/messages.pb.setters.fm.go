@@ -1133,6 +1133,31 @@ func (dst *ApplicationDownlink) SetFields(src *ApplicationDownlink, paths ...str
 			} else {
 				dst.CorrelationIds = nil
 			}
+		case "scheduling":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationDownlink_Scheduling
+				if (src == nil || src.Scheduling == nil) && dst.Scheduling == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Scheduling
+				}
+				if dst.Scheduling != nil {
+					newDst = dst.Scheduling
+				} else {
+					newDst = &ApplicationDownlink_Scheduling{}
+					dst.Scheduling = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Scheduling = src.Scheduling
+				} else {
+					dst.Scheduling = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1161,6 +1186,36 @@ func (dst *ApplicationDownlinks) SetFields(src *ApplicationDownlinks, paths ...s
 	return nil
 }
 
+func (dst *ApplicationDownlinkPolicy) SetFields(src *ApplicationDownlinkPolicy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "default_ttl":
+			if len(subs) > 0 {
+				return fmt.Errorf("'default_ttl' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DefaultTtl = src.DefaultTtl
+			} else {
+				dst.DefaultTtl = nil
+			}
+		case "order_by_priority":
+			if len(subs) > 0 {
+				return fmt.Errorf("'order_by_priority' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OrderByPriority = src.OrderByPriority
+			} else {
+				var zero bool
+				dst.OrderByPriority = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationDownlinkFailed) SetFields(src *ApplicationDownlinkFailed, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1328,6 +1383,65 @@ func (dst *DownlinkQueueOperationErrorDetails) SetFields(src *DownlinkQueueOpera
 	return nil
 }
 
+func (dst *ApplicationSessionRecovered) SetFields(src *ApplicationSessionRecovered, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionKeyId = src.SessionKeyId
+			} else {
+				dst.SessionKeyId = nil
+			}
+		case "discarded_session_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'discarded_session_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DiscardedSessionKeyId = src.DiscardedSessionKeyId
+			} else {
+				dst.DiscardedSessionKeyId = nil
+			}
+		case "f_cnt_reset":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt_reset' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCntReset = src.FCntReset
+			} else {
+				var zero bool
+				dst.FCntReset = zero
+			}
+		case "last_f_cnt_up":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_f_cnt_up' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastFCntUp = src.LastFCntUp
+			} else {
+				var zero uint32
+				dst.LastFCntUp = zero
+			}
+		case "last_a_f_cnt_down":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_a_f_cnt_down' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastAFCntDown = src.LastAFCntDown
+			} else {
+				var zero uint32
+				dst.LastAFCntDown = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationServiceData) SetFields(src *ApplicationServiceData, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1826,6 +1940,42 @@ func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 							dst.Up = nil
 						}
 					}
+				case "session_recovered":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Up.(*ApplicationUp_SessionRecovered)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Up == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'session_recovered', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Up.(*ApplicationUp_SessionRecovered)
+					if dstValid := dstTypeOk || dst.Up == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'session_recovered', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *ApplicationSessionRecovered
+						if srcTypeOk {
+							newSrc = src.Up.(*ApplicationUp_SessionRecovered).SessionRecovered
+						}
+						if dstTypeOk {
+							newDst = dst.Up.(*ApplicationUp_SessionRecovered).SessionRecovered
+						} else if srcTypeOk {
+							newDst = &ApplicationSessionRecovered{}
+							dst.Up = &ApplicationUp_SessionRecovered{SessionRecovered: newDst}
+						} else {
+							dst.Up = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Up = src.Up
+						} else {
+							dst.Up = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
@@ -1882,6 +2032,102 @@ func (dst *MessagePayloadFormatters) SetFields(src *MessagePayloadFormatters, pa
 				var zero string
 				dst.DownFormatterParameter = zero
 			}
+		case "test_vectors":
+			if len(subs) > 0 {
+				return fmt.Errorf("'test_vectors' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TestVectors = src.TestVectors
+			} else {
+				dst.TestVectors = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MessagePayloadFormatterTestVector) SetFields(src *MessagePayloadFormatterTestVector, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Type = src.Type
+			} else {
+				var zero MessagePayloadFormatterTestVector_Type
+				dst.Type = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrmPayload = src.FrmPayload
+			} else {
+				dst.FrmPayload = nil
+			}
+		case "decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayload = src.DecodedPayload
+			} else {
+				dst.DecodedPayload = nil
+			}
+		case "warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Warnings = src.Warnings
+			} else {
+				dst.Warnings = nil
+			}
+		case "errors":
+			if len(subs) > 0 {
+				return fmt.Errorf("'errors' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Errors = src.Errors
+			} else {
+				dst.Errors = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1963,3 +2209,42 @@ func (dst *ApplicationDownlink_ClassBC) SetFields(src *ApplicationDownlink_Class
 	}
 	return nil
 }
+
+func (dst *ApplicationDownlink_Scheduling) SetFields(src *ApplicationDownlink_Scheduling, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "not_before":
+			if len(subs) > 0 {
+				return fmt.Errorf("'not_before' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NotBefore = src.NotBefore
+			} else {
+				dst.NotBefore = nil
+			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+		case "deduplication_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deduplication_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeduplicationKey = src.DeduplicationKey
+			} else {
+				var zero string
+				dst.DeduplicationKey = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
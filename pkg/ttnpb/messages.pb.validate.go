@@ -1577,6 +1577,18 @@ func (m *ApplicationDownlink) ValidateFields(paths ...string) error {
 
 			}
 
+		case "scheduling":
+
+			if v, ok := interface{}(m.GetScheduling()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkValidationError{
+						field:  "scheduling",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationDownlinkValidationError{
 				field:  name,
@@ -1745,6 +1757,102 @@ var _ interface {
 	ErrorName() string
 } = ApplicationDownlinksValidationError{}
 
+// ValidateFields checks the field values on ApplicationDownlinkPolicy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationDownlinkPolicy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDownlinkPolicyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "default_ttl":
+
+			if v, ok := interface{}(m.GetDefaultTtl()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkPolicyValidationError{
+						field:  "default_ttl",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "order_by_priority":
+			// no validation rules for OrderByPriority
+		default:
+			return ApplicationDownlinkPolicyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDownlinkPolicyValidationError is the validation error returned by
+// ApplicationDownlinkPolicy.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationDownlinkPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDownlinkPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDownlinkPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDownlinkPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDownlinkPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDownlinkPolicyValidationError) ErrorName() string {
+	return "ApplicationDownlinkPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDownlinkPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDownlinkPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDownlinkPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDownlinkPolicyValidationError{}
+
 // ValidateFields checks the field values on ApplicationDownlinkFailed with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -2105,6 +2213,112 @@ var _ interface {
 	ErrorName() string
 } = DownlinkQueueOperationErrorDetailsValidationError{}
 
+// ValidateFields checks the field values on ApplicationSessionRecovered with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationSessionRecovered) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationSessionRecoveredFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "session_key_id":
+
+			if len(m.GetSessionKeyId()) > 2048 {
+				return ApplicationSessionRecoveredValidationError{
+					field:  "session_key_id",
+					reason: "value length must be at most 2048 bytes",
+				}
+			}
+
+		case "discarded_session_key_id":
+
+			if len(m.GetDiscardedSessionKeyId()) > 2048 {
+				return ApplicationSessionRecoveredValidationError{
+					field:  "discarded_session_key_id",
+					reason: "value length must be at most 2048 bytes",
+				}
+			}
+
+		case "f_cnt_reset":
+			// no validation rules for FCntReset
+		case "last_f_cnt_up":
+			// no validation rules for LastFCntUp
+		case "last_a_f_cnt_down":
+			// no validation rules for LastAFCntDown
+		default:
+			return ApplicationSessionRecoveredValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationSessionRecoveredValidationError is the validation error returned
+// by ApplicationSessionRecovered.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationSessionRecoveredValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationSessionRecoveredValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationSessionRecoveredValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationSessionRecoveredValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationSessionRecoveredValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationSessionRecoveredValidationError) ErrorName() string {
+	return "ApplicationSessionRecoveredValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationSessionRecoveredValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationSessionRecovered.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationSessionRecoveredValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationSessionRecoveredValidationError{}
+
 // ValidateFields checks the field values on ApplicationServiceData with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -2271,7 +2485,7 @@ func (m *ApplicationUp) ValidateFields(paths ...string) error {
 			}
 			if len(subs) == 0 {
 				subs = []string{
-					"uplink_message", "uplink_normalized", "join_accept", "downlink_ack", "downlink_nack", "downlink_sent", "downlink_failed", "downlink_queued", "downlink_queue_invalidated", "location_solved", "service_data",
+					"uplink_message", "uplink_normalized", "join_accept", "downlink_ack", "downlink_nack", "downlink_sent", "downlink_failed", "downlink_queued", "downlink_queue_invalidated", "location_solved", "service_data", "session_recovered",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -2453,6 +2667,22 @@ func (m *ApplicationUp) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "session_recovered":
+					w, ok := m.Up.(*ApplicationUp_SessionRecovered)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetSessionRecovered()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationUpValidationError{
+								field:  "session_recovered",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
 				}
 			}
 		default:
@@ -2545,10 +2775,10 @@ func (m *MessagePayloadFormatters) ValidateFields(paths ...string) error {
 
 		case "up_formatter_parameter":
 
-			if utf8.RuneCountInString(m.GetUpFormatterParameter()) > 40960 {
+			if utf8.RuneCountInString(m.GetUpFormatterParameter()) > 1048576 {
 				return MessagePayloadFormattersValidationError{
 					field:  "up_formatter_parameter",
-					reason: "value length must be at most 40960 runes",
+					reason: "value length must be at most 1048576 runes",
 				}
 			}
 
@@ -2563,13 +2793,37 @@ func (m *MessagePayloadFormatters) ValidateFields(paths ...string) error {
 
 		case "down_formatter_parameter":
 
-			if utf8.RuneCountInString(m.GetDownFormatterParameter()) > 40960 {
+			if utf8.RuneCountInString(m.GetDownFormatterParameter()) > 1048576 {
 				return MessagePayloadFormattersValidationError{
 					field:  "down_formatter_parameter",
-					reason: "value length must be at most 40960 runes",
+					reason: "value length must be at most 1048576 runes",
+				}
+			}
+
+		case "test_vectors":
+
+			if len(m.GetTestVectors()) > 20 {
+				return MessagePayloadFormattersValidationError{
+					field:  "test_vectors",
+					reason: "value must contain no more than 20 item(s)",
 				}
 			}
 
+			for idx, item := range m.GetTestVectors() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MessagePayloadFormattersValidationError{
+							field:  fmt.Sprintf("test_vectors[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return MessagePayloadFormattersValidationError{
 				field:  name,
@@ -2637,6 +2891,189 @@ var _ interface {
 	ErrorName() string
 } = MessagePayloadFormattersValidationError{}
 
+// ValidateFields checks the field values on MessagePayloadFormatterTestVector
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *MessagePayloadFormatterTestVector) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MessagePayloadFormatterTestVectorFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 36 {
+				return MessagePayloadFormatterTestVectorValidationError{
+					field:  "name",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_MessagePayloadFormatterTestVector_Name_Pattern.MatchString(m.GetName()) {
+				return MessagePayloadFormatterTestVectorValidationError{
+					field:  "name",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 200 {
+				return MessagePayloadFormatterTestVectorValidationError{
+					field:  "description",
+					reason: "value length must be at most 200 runes",
+				}
+			}
+
+		case "type":
+
+			if _, ok := MessagePayloadFormatterTestVector_Type_name[int32(m.GetType())]; !ok {
+				return MessagePayloadFormatterTestVectorValidationError{
+					field:  "type",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "f_port":
+
+			if m.GetFPort() > 255 {
+				return MessagePayloadFormatterTestVectorValidationError{
+					field:  "f_port",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "frm_payload":
+			// no validation rules for FrmPayload
+		case "decoded_payload":
+
+			if v, ok := interface{}(m.GetDecodedPayload()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MessagePayloadFormatterTestVectorValidationError{
+						field:  "decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "warnings":
+
+			if len(m.GetWarnings()) > 10 {
+				return MessagePayloadFormatterTestVectorValidationError{
+					field:  "warnings",
+					reason: "value must contain no more than 10 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetWarnings() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 100 {
+					return MessagePayloadFormatterTestVectorValidationError{
+						field:  fmt.Sprintf("warnings[%v]", idx),
+						reason: "value length must be at most 100 runes",
+					}
+				}
+
+			}
+
+		case "errors":
+
+			if len(m.GetErrors()) > 10 {
+				return MessagePayloadFormatterTestVectorValidationError{
+					field:  "errors",
+					reason: "value must contain no more than 10 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetErrors() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 100 {
+					return MessagePayloadFormatterTestVectorValidationError{
+						field:  fmt.Sprintf("errors[%v]", idx),
+						reason: "value length must be at most 100 runes",
+					}
+				}
+
+			}
+
+		default:
+			return MessagePayloadFormatterTestVectorValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MessagePayloadFormatterTestVectorValidationError is the validation error
+// returned by MessagePayloadFormatterTestVector.ValidateFields if the
+// designated constraints aren't met.
+type MessagePayloadFormatterTestVectorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessagePayloadFormatterTestVectorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessagePayloadFormatterTestVectorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessagePayloadFormatterTestVectorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessagePayloadFormatterTestVectorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessagePayloadFormatterTestVectorValidationError) ErrorName() string {
+	return "MessagePayloadFormatterTestVectorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MessagePayloadFormatterTestVectorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessagePayloadFormatterTestVector.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessagePayloadFormatterTestVectorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessagePayloadFormatterTestVectorValidationError{}
+
+var _MessagePayloadFormatterTestVector_Name_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
 // ValidateFields checks the field values on DownlinkQueueRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -2871,3 +3308,118 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ApplicationDownlink_ClassBCValidationError{}
+
+// ValidateFields checks the field values on ApplicationDownlink_Scheduling
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationDownlink_Scheduling) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDownlink_SchedulingFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "not_before":
+
+			if v, ok := interface{}(m.GetNotBefore()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlink_SchedulingValidationError{
+						field:  "not_before",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlink_SchedulingValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "deduplication_key":
+
+			if utf8.RuneCountInString(m.GetDeduplicationKey()) > 100 {
+				return ApplicationDownlink_SchedulingValidationError{
+					field:  "deduplication_key",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ApplicationDownlink_SchedulingValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDownlink_SchedulingValidationError is the validation error
+// returned by ApplicationDownlink_Scheduling.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationDownlink_SchedulingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDownlink_SchedulingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDownlink_SchedulingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDownlink_SchedulingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDownlink_SchedulingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDownlink_SchedulingValidationError) ErrorName() string {
+	return "ApplicationDownlink_SchedulingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDownlink_SchedulingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDownlink_Scheduling.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDownlink_SchedulingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDownlink_SchedulingValidationError{}
@@ -381,6 +381,63 @@ func (m *ApplicationDownlink_ClassBC) SetFromFlags(flags *pflag.FlagSet, prefix
 	return paths, nil
 }
 
+// AddSelectFlagsForApplicationDownlink_Scheduling adds flags to select fields in ApplicationDownlink_Scheduling.
+func AddSelectFlagsForApplicationDownlink_Scheduling(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("not-before", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("not-before", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("expires-at", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("expires-at", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("deduplication-key", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("deduplication-key", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationDownlink_Scheduling message from select flags.
+func PathsFromSelectFlagsForApplicationDownlink_Scheduling(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("not_before", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("not_before", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("expires_at", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("expires_at", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("deduplication_key", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("deduplication_key", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationDownlink_Scheduling adds flags to select fields in ApplicationDownlink_Scheduling.
+func AddSetFlagsForApplicationDownlink_Scheduling(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewTimestampFlag(flagsplugin.Prefix("not-before", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewTimestampFlag(flagsplugin.Prefix("expires-at", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("deduplication-key", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationDownlink_Scheduling message from flags.
+func (m *ApplicationDownlink_Scheduling) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetTimestamp(flags, flagsplugin.Prefix("not_before", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.NotBefore = gogo.SetTimestamp(val)
+		paths = append(paths, flagsplugin.Prefix("not_before", prefix))
+	}
+	if val, changed, err := flagsplugin.GetTimestamp(flags, flagsplugin.Prefix("expires_at", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.ExpiresAt = gogo.SetTimestamp(val)
+		paths = append(paths, flagsplugin.Prefix("expires_at", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("deduplication_key", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.DeduplicationKey = val
+		paths = append(paths, flagsplugin.Prefix("deduplication_key", prefix))
+	}
+	return paths, nil
+}
+
 // AddSelectFlagsForApplicationDownlink adds flags to select fields in ApplicationDownlink.
 func AddSelectFlagsForApplicationDownlink(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("session-key-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("session-key-id", prefix), false), flagsplugin.WithHidden(hidden)))
@@ -394,6 +451,8 @@ func AddSelectFlagsForApplicationDownlink(flags *pflag.FlagSet, prefix string, h
 	AddSelectFlagsForApplicationDownlink_ClassBC(flags, flagsplugin.Prefix("class-b-c", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("priority", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("priority", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("correlation-ids", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("correlation-ids", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("scheduling", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("scheduling", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationDownlink_Scheduling(flags, flagsplugin.Prefix("scheduling", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationDownlink message from select flags.
@@ -453,6 +512,16 @@ func PathsFromSelectFlagsForApplicationDownlink(flags *pflag.FlagSet, prefix str
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("correlation_ids", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("scheduling", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("scheduling", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationDownlink_Scheduling(flags, flagsplugin.Prefix("scheduling", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -468,6 +537,7 @@ func AddSetFlagsForApplicationDownlink(flags *pflag.FlagSet, prefix string, hidd
 	AddSetFlagsForApplicationDownlink_ClassBC(flags, flagsplugin.Prefix("class-b-c", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("priority", prefix), flagsplugin.EnumValueDesc(TxSchedulePriority_value), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("correlation-ids", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForApplicationDownlink_Scheduling(flags, flagsplugin.Prefix("scheduling", prefix), hidden)
 }
 
 // SetFromFlags sets the ApplicationDownlink message from flags.
@@ -535,6 +605,58 @@ func (m *ApplicationDownlink) SetFromFlags(flags *pflag.FlagSet, prefix string)
 		m.CorrelationIds = val
 		paths = append(paths, flagsplugin.Prefix("correlation_ids", prefix))
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("scheduling", prefix)); changed {
+		m.Scheduling = &ApplicationDownlink_Scheduling{}
+		if setPaths, err := m.Scheduling.SetFromFlags(flags, flagsplugin.Prefix("scheduling", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationDownlinkPolicy adds flags to select fields in ApplicationDownlinkPolicy.
+func AddSelectFlagsForApplicationDownlinkPolicy(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("default-ttl", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("default-ttl", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("order-by-priority", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("order-by-priority", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationDownlinkPolicy message from select flags.
+func PathsFromSelectFlagsForApplicationDownlinkPolicy(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("default_ttl", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("default_ttl", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("order_by_priority", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("order_by_priority", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationDownlinkPolicy adds flags to select fields in ApplicationDownlinkPolicy.
+func AddSetFlagsForApplicationDownlinkPolicy(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewDurationFlag(flagsplugin.Prefix("default-ttl", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("order-by-priority", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationDownlinkPolicy message from flags.
+func (m *ApplicationDownlinkPolicy) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetDuration(flags, flagsplugin.Prefix("default_ttl", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.DefaultTtl = gogo.SetDuration(val)
+		paths = append(paths, flagsplugin.Prefix("default_ttl", prefix))
+	}
+	if val, changed, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("order_by_priority", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.OrderByPriority = val
+		paths = append(paths, flagsplugin.Prefix("order_by_priority", prefix))
+	}
 	return paths, nil
 }
 
@@ -594,6 +716,45 @@ func PathsFromSelectFlagsForApplicationInvalidatedDownlinks(flags *pflag.FlagSet
 	return paths, nil
 }
 
+// AddSelectFlagsForApplicationSessionRecovered adds flags to select fields in ApplicationSessionRecovered.
+func AddSelectFlagsForApplicationSessionRecovered(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("session-key-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("session-key-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("discarded-session-key-id", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("discarded-session-key-id", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("f-cnt-reset", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("f-cnt-reset", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("last-f-cnt-up", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("last-f-cnt-up", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("last-a-f-cnt-down", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("last-a-f-cnt-down", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationSessionRecovered message from select flags.
+func PathsFromSelectFlagsForApplicationSessionRecovered(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("session_key_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("session_key_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("discarded_session_key_id", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("discarded_session_key_id", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("f_cnt_reset", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("f_cnt_reset", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("last_f_cnt_up", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("last_f_cnt_up", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("last_a_f_cnt_down", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("last_a_f_cnt_down", prefix))
+	}
+	return paths, nil
+}
+
 // AddSelectFlagsForApplicationServiceData adds flags to select fields in ApplicationServiceData.
 func AddSelectFlagsForApplicationServiceData(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("service", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("service", prefix), false), flagsplugin.WithHidden(hidden)))
@@ -643,6 +804,8 @@ func AddSelectFlagsForApplicationUp(flags *pflag.FlagSet, prefix string, hidden
 	AddSelectFlagsForApplicationLocation(flags, flagsplugin.Prefix("up.location-solved", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("up.service-data", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("up.service-data", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationServiceData(flags, flagsplugin.Prefix("up.service-data", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("up.session-recovered", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("up.session-recovered", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationSessionRecovered(flags, flagsplugin.Prefix("up.session-recovered", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("simulated", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("simulated", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
@@ -778,6 +941,16 @@ func PathsFromSelectFlagsForApplicationUp(flags *pflag.FlagSet, prefix string) (
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("up.session_recovered", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("up.session_recovered", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationSessionRecovered(flags, flagsplugin.Prefix("up.session_recovered", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("simulated", prefix)); err != nil {
 		return nil, err
 	} else if selected && val {
@@ -792,6 +965,7 @@ func AddSelectFlagsForMessagePayloadFormatters(flags *pflag.FlagSet, prefix stri
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("up-formatter-parameter", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("up-formatter-parameter", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("down-formatter", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("down-formatter", prefix), false), flagsplugin.WithHidden(hidden)))
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("down-formatter-parameter", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("down-formatter-parameter", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("test-vectors", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("test-vectors", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forMessagePayloadFormatters message from select flags.
@@ -816,6 +990,11 @@ func PathsFromSelectFlagsForMessagePayloadFormatters(flags *pflag.FlagSet, prefi
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("down_formatter_parameter", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("test_vectors", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("test_vectors", prefix))
+	}
 	return paths, nil
 }
 
@@ -34,6 +34,7 @@ var PayloadFormatter_customvalue = map[string]int32{
 	"GRPC_SERVICE": 2,
 	"JAVASCRIPT":   3,
 	"CAYENNELPP":   4,
+	"WASM":         5,
 }
 
 // UnmarshalProtoJSON unmarshals the PayloadFormatter from JSON.
@@ -1436,6 +1437,12 @@ func (x *ApplicationDownlink) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("correlation_ids")
 		s.WriteStringArray(x.CorrelationIds)
 	}
+	if x.Scheduling != nil || s.HasField("scheduling") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("scheduling")
+		// NOTE: ApplicationDownlink_Scheduling does not seem to implement MarshalProtoJSON.
+		gogo.MarshalMessage(s, x.Scheduling)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -1503,6 +1510,16 @@ func (x *ApplicationDownlink) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.CorrelationIds = s.ReadStringArray()
+		case "scheduling":
+			s.AddField("scheduling")
+			if s.ReadNil() {
+				x.Scheduling = nil
+				return
+			}
+			// NOTE: ApplicationDownlink_Scheduling does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationDownlink_Scheduling
+			gogo.UnmarshalMessage(s, &v)
+			x.Scheduling = &v
 		}
 	})
 }
@@ -1872,6 +1889,11 @@ func (x *ApplicationUp) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteObjectField("service_data")
 			// NOTE: ApplicationServiceData does not seem to implement MarshalProtoJSON.
 			gogo.MarshalMessage(s, ov.ServiceData)
+		case *ApplicationUp_SessionRecovered:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("session_recovered")
+			// NOTE: ApplicationSessionRecovered does not seem to implement MarshalProtoJSON.
+			gogo.MarshalMessage(s, ov.SessionRecovered)
 		}
 	}
 	if x.Simulated || s.HasField("simulated") {
@@ -2023,6 +2045,18 @@ func (x *ApplicationUp) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			var v ApplicationServiceData
 			gogo.UnmarshalMessage(s, &v)
 			ov.ServiceData = &v
+		case "session_recovered", "sessionRecovered":
+			s.AddField("session_recovered")
+			ov := &ApplicationUp_SessionRecovered{}
+			x.Up = ov
+			if s.ReadNil() {
+				ov.SessionRecovered = nil
+				return
+			}
+			// NOTE: ApplicationSessionRecovered does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationSessionRecovered
+			gogo.UnmarshalMessage(s, &v)
+			ov.SessionRecovered = &v
 		case "simulated":
 			s.AddField("simulated")
 			x.Simulated = s.ReadBool()
@@ -2063,6 +2097,17 @@ func (x *MessagePayloadFormatters) MarshalProtoJSON(s *jsonplugin.MarshalState)
 		s.WriteObjectField("down_formatter_parameter")
 		s.WriteString(x.DownFormatterParameter)
 	}
+	if len(x.TestVectors) > 0 || s.HasField("test_vectors") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("test_vectors")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.TestVectors {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("test_vectors"))
+		}
+		s.WriteArrayEnd()
+	}
 	s.WriteObjectEnd()
 }
 
@@ -2092,6 +2137,24 @@ func (x *MessagePayloadFormatters) UnmarshalProtoJSON(s *jsonplugin.UnmarshalSta
 		case "down_formatter_parameter", "downFormatterParameter":
 			s.AddField("down_formatter_parameter")
 			x.DownFormatterParameter = s.ReadString()
+		case "test_vectors", "testVectors":
+			s.AddField("test_vectors")
+			if s.ReadNil() {
+				x.TestVectors = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.TestVectors = append(x.TestVectors, nil)
+					return
+				}
+				v := &MessagePayloadFormatterTestVector{}
+				v.UnmarshalProtoJSON(s.WithField("test_vectors", false))
+				if s.Err() != nil {
+					return
+				}
+				x.TestVectors = append(x.TestVectors, v)
+			})
 		}
 	})
 }
@@ -2101,6 +2164,164 @@ func (x *MessagePayloadFormatters) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the MessagePayloadFormatterTestVector_Type to JSON.
+func (x MessagePayloadFormatterTestVector_Type) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), MessagePayloadFormatterTestVector_Type_name)
+}
+
+// MarshalText marshals the MessagePayloadFormatterTestVector_Type to text.
+func (x MessagePayloadFormatterTestVector_Type) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), MessagePayloadFormatterTestVector_Type_name)), nil
+}
+
+// MarshalJSON marshals the MessagePayloadFormatterTestVector_Type to JSON.
+func (x MessagePayloadFormatterTestVector_Type) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MessagePayloadFormatterTestVector_Type from JSON.
+func (x *MessagePayloadFormatterTestVector_Type) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(MessagePayloadFormatterTestVector_Type_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Type enum: %v", err)
+		return
+	}
+	*x = MessagePayloadFormatterTestVector_Type(v)
+}
+
+// UnmarshalText unmarshals the MessagePayloadFormatterTestVector_Type from text.
+func (x *MessagePayloadFormatterTestVector_Type) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), MessagePayloadFormatterTestVector_Type_value)
+	if err != nil {
+		return err
+	}
+	*x = MessagePayloadFormatterTestVector_Type(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the MessagePayloadFormatterTestVector_Type from JSON.
+func (x *MessagePayloadFormatterTestVector_Type) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the MessagePayloadFormatterTestVector message to JSON.
+func (x *MessagePayloadFormatterTestVector) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Name != "" || s.HasField("name") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("name")
+		s.WriteString(x.Name)
+	}
+	if x.Description != "" || s.HasField("description") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("description")
+		s.WriteString(x.Description)
+	}
+	if x.Type != 0 || s.HasField("type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("type")
+		x.Type.MarshalProtoJSON(s)
+	}
+	if x.FPort != 0 || s.HasField("f_port") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_port")
+		s.WriteUint32(x.FPort)
+	}
+	if len(x.FrmPayload) > 0 || s.HasField("frm_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("frm_payload")
+		s.WriteBytes(x.FrmPayload)
+	}
+	if x.DecodedPayload != nil || s.HasField("decoded_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("decoded_payload")
+		if x.DecodedPayload == nil {
+			s.WriteNil()
+		} else {
+			gogo.MarshalStruct(s, x.DecodedPayload)
+		}
+	}
+	if len(x.Warnings) > 0 || s.HasField("warnings") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("warnings")
+		s.WriteStringArray(x.Warnings)
+	}
+	if len(x.Errors) > 0 || s.HasField("errors") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("errors")
+		s.WriteStringArray(x.Errors)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the MessagePayloadFormatterTestVector to JSON.
+func (x *MessagePayloadFormatterTestVector) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the MessagePayloadFormatterTestVector message from JSON.
+func (x *MessagePayloadFormatterTestVector) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "name":
+			s.AddField("name")
+			x.Name = s.ReadString()
+		case "description":
+			s.AddField("description")
+			x.Description = s.ReadString()
+		case "type":
+			s.AddField("type")
+			x.Type.UnmarshalProtoJSON(s)
+		case "f_port", "fPort":
+			s.AddField("f_port")
+			x.FPort = s.ReadUint32()
+		case "frm_payload", "frmPayload":
+			s.AddField("frm_payload")
+			x.FrmPayload = s.ReadBytes()
+		case "decoded_payload", "decodedPayload":
+			s.AddField("decoded_payload")
+			if s.ReadNil() {
+				x.DecodedPayload = nil
+				return
+			}
+			v := gogo.UnmarshalStruct(s)
+			if s.Err() != nil {
+				return
+			}
+			x.DecodedPayload = v
+		case "warnings":
+			s.AddField("warnings")
+			if s.ReadNil() {
+				x.Warnings = nil
+				return
+			}
+			x.Warnings = s.ReadStringArray()
+		case "errors":
+			s.AddField("errors")
+			if s.ReadNil() {
+				x.Errors = nil
+				return
+			}
+			x.Errors = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the MessagePayloadFormatterTestVector from JSON.
+func (x *MessagePayloadFormatterTestVector) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the DownlinkQueueRequest message to JSON.
 func (x *DownlinkQueueRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -30,6 +30,9 @@ var GetQRCodeFormatRequestFieldPathsTopLevel = []string{
 var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device",
 	"end_device.activated_at",
+	"end_device.application_downlink_policy",
+	"end_device.application_downlink_policy.default_ttl",
+	"end_device.application_downlink_policy.order_by_priority",
 	"end_device.application_server_address",
 	"end_device.application_server_id",
 	"end_device.application_server_kek_label",
@@ -45,6 +48,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.test_vectors",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -218,6 +222,10 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.scheduling",
+	"end_device.mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device.mac_state.pending_join_request",
 	"end_device.mac_state.pending_join_request.cf_list",
@@ -357,6 +365,10 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device.pending_mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device.pending_mac_state.pending_join_request",
 	"end_device.pending_mac_state.pending_join_request.cf_list",
@@ -538,6 +550,9 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template",
 	"end_device_template.end_device",
 	"end_device_template.end_device.activated_at",
+	"end_device_template.end_device.application_downlink_policy",
+	"end_device_template.end_device.application_downlink_policy.default_ttl",
+	"end_device_template.end_device.application_downlink_policy.order_by_priority",
 	"end_device_template.end_device.application_server_address",
 	"end_device_template.end_device.application_server_id",
 	"end_device_template.end_device.application_server_kek_label",
@@ -553,6 +568,7 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.formatters",
 	"end_device_template.end_device.formatters.down_formatter",
 	"end_device_template.end_device.formatters.down_formatter_parameter",
+	"end_device_template.end_device.formatters.test_vectors",
 	"end_device_template.end_device.formatters.up_formatter",
 	"end_device_template.end_device.formatters.up_formatter_parameter",
 	"end_device_template.end_device.frequency_plan_id",
@@ -726,6 +742,10 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.mac_state.pending_application_downlink.f_port",
 	"end_device_template.end_device.mac_state.pending_application_downlink.frm_payload",
 	"end_device_template.end_device.mac_state.pending_application_downlink.priority",
+	"end_device_template.end_device.mac_state.pending_application_downlink.scheduling",
+	"end_device_template.end_device.mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device_template.end_device.mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device_template.end_device.mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device_template.end_device.mac_state.pending_application_downlink.session_key_id",
 	"end_device_template.end_device.mac_state.pending_join_request",
 	"end_device_template.end_device.mac_state.pending_join_request.cf_list",
@@ -865,6 +885,10 @@ var ParseEndDeviceQRCodeResponseFieldPathsNested = []string{
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.frm_payload",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device_template.end_device.pending_mac_state.pending_application_downlink.scheduling",
+	"end_device_template.end_device.pending_mac_state.pending_application_downlink.scheduling.deduplication_key",
+	"end_device_template.end_device.pending_mac_state.pending_application_downlink.scheduling.expires_at",
+	"end_device_template.end_device.pending_mac_state.pending_application_downlink.scheduling.not_before",
 	"end_device_template.end_device.pending_mac_state.pending_application_downlink.session_key_id",
 	"end_device_template.end_device.pending_mac_state.pending_join_request",
 	"end_device_template.end_device.pending_mac_state.pending_join_request.cf_list",
@@ -30,6 +30,14 @@ var (
 	AllClusterRights      = &Rights{}
 	AllAdminRights        = &Rights{}
 	AllRights             = &Rights{}
+
+	// explicitGatewayRights are the gateway rights that are not implied by RIGHT_GATEWAY_ALL.
+	// These rights need to be granted explicitly.
+	explicitGatewayRights = RightsFrom(
+		Right_RIGHT_GATEWAY_REMOTE_SHELL,
+	)
+
+	impliedGatewayRights = &Rights{}
 )
 
 func init() {
@@ -63,6 +71,7 @@ func init() {
 	AllUserRights = AllUserRights.Sorted()
 	AllApplicationRights = AllApplicationRights.Sorted()
 	AllGatewayRights = AllGatewayRights.Sorted()
+	impliedGatewayRights = AllGatewayRights.Sub(explicitGatewayRights).Sorted()
 	AllOrganizationRights = AllOrganizationRights.Sorted()
 	AllRights = AllRights.Sorted()
 }
@@ -82,7 +91,7 @@ func (r Right) Implied() *Rights {
 			Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
 		)
 	case Right_RIGHT_GATEWAY_ALL:
-		return AllGatewayRights
+		return impliedGatewayRights
 	case Right_RIGHT_GATEWAY_LINK:
 		return RightsFrom(
 			Right_RIGHT_GATEWAY_INFO,
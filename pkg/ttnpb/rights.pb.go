@@ -134,7 +134,11 @@ const (
 	Right_RIGHT_GATEWAY_WRITE_SECRETS Right = 57
 	// The right to retrieve secrets associated with this gateway.
 	Right_RIGHT_GATEWAY_READ_SECRETS Right = 58
-	// The pseudo-right for all (current and future) gateway rights.
+	// The right to run remote commands and open remote shell sessions on this gateway.
+	// This right is not implied by RIGHT_GATEWAY_ALL and needs to be granted explicitly.
+	Right_RIGHT_GATEWAY_REMOTE_SHELL Right = 64
+	// The pseudo-right for all (current and future) gateway rights,
+	// except RIGHT_GATEWAY_REMOTE_SHELL.
 	Right_RIGHT_GATEWAY_ALL Right = 40
 	// The right to view organization information.
 	Right_RIGHT_ORGANIZATION_INFO Right = 41
@@ -218,6 +222,7 @@ var Right_name = map[int32]string{
 	39: "RIGHT_GATEWAY_LOCATION_READ",
 	57: "RIGHT_GATEWAY_WRITE_SECRETS",
 	58: "RIGHT_GATEWAY_READ_SECRETS",
+	64: "RIGHT_GATEWAY_REMOTE_SHELL",
 	40: "RIGHT_GATEWAY_ALL",
 	41: "RIGHT_ORGANIZATION_INFO",
 	42: "RIGHT_ORGANIZATION_SETTINGS_BASIC",
@@ -285,6 +290,7 @@ var Right_value = map[string]int32{
 	"RIGHT_GATEWAY_LOCATION_READ":              39,
 	"RIGHT_GATEWAY_WRITE_SECRETS":              57,
 	"RIGHT_GATEWAY_READ_SECRETS":               58,
+	"RIGHT_GATEWAY_REMOTE_SHELL":               64,
 	"RIGHT_GATEWAY_ALL":                        40,
 	"RIGHT_ORGANIZATION_INFO":                  41,
 	"RIGHT_ORGANIZATION_SETTINGS_BASIC":        42,
@@ -632,86 +638,86 @@ func init() {
 }
 
 var fileDescriptor_9bb69af2cf8904c5 = []byte{
-	// 1283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x57, 0xdb, 0xc6,
-	0x17, 0xfd, 0xc9, 0xf8, 0x1f, 0x8f, 0x98, 0x4c, 0x26, 0x01, 0x8c, 0x21, 0x40, 0x4c, 0xfe, 0xf0,
-	0xa3, 0xb1, 0xdd, 0x40, 0xd3, 0x36, 0x69, 0xda, 0x54, 0x96, 0x05, 0x11, 0x28, 0x16, 0x47, 0x12,
-	0xc9, 0x49, 0x36, 0x3e, 0x02, 0x2b, 0x42, 0x05, 0x24, 0x1f, 0x69, 0x20, 0xa1, 0xcb, 0x2e, 0xbb,
-	0xec, 0x37, 0xe8, 0x36, 0x9b, 0x9e, 0xd3, 0x4f, 0xd0, 0x75, 0xbf, 0x44, 0x17, 0xdd, 0x75, 0xd9,
-	0x65, 0x56, 0x3d, 0x96, 0xc6, 0x8c, 0x24, 0x8b, 0xb8, 0x74, 0x27, 0xde, 0xbb, 0xef, 0xcd, 0x9d,
-	0x7b, 0x9f, 0x9e, 0x30, 0x2c, 0x1c, 0xb9, 0x9e, 0xf1, 0xd6, 0x70, 0x6a, 0x3e, 0x31, 0xf6, 0x0f,
-	0x1b, 0x46, 0xcf, 0x6e, 0x78, 0xb6, 0x75, 0x40, 0xfc, 0x7a, 0xcf, 0x73, 0x89, 0x8b, 0x27, 0x09,
-	0x71, 0xea, 0x14, 0x53, 0x3f, 0x5d, 0xaf, 0xb4, 0x2c, 0x9b, 0x1c, 0x9c, 0xec, 0xd5, 0xf7, 0xdd,
-	0xe3, 0x86, 0x7e, 0x60, 0xea, 0x07, 0xb6, 0x63, 0xf9, 0x92, 0xd3, 0x3d, 0xf1, 0x89, 0x67, 0x9b,
-	0x7e, 0x23, 0xa8, 0xda, 0xaf, 0x59, 0xa6, 0x53, 0xb3, 0xdc, 0xda, 0x9b, 0x23, 0xc3, 0xf2, 0x1b,
-	0x86, 0xe3, 0xb8, 0xc4, 0x20, 0xb6, 0xeb, 0xd0, 0xae, 0x15, 0x3e, 0xd2, 0xc5, 0x74, 0x4e, 0xdd,
-	0xb3, 0x9e, 0xe7, 0xbe, 0x3b, 0x8b, 0x16, 0x9f, 0x1a, 0x47, 0x76, 0xd7, 0x20, 0x66, 0x63, 0xe8,
-	0x81, 0xb6, 0xa8, 0x45, 0x5a, 0x58, 0xae, 0xe5, 0x86, 0xc5, 0x7b, 0x27, 0x6f, 0x82, 0xbf, 0x82,
-	0x3f, 0x82, 0x27, 0x0a, 0x17, 0x2e, 0xc5, 0xfb, 0x3b, 0xdf, 0x75, 0x52, 0x68, 0x2f, 0x5a, 0xae,
-	0x6b, 0x1d, 0x99, 0xec, 0x28, 0x62, 0x1f, 0x9b, 0x3e, 0x31, 0x8e, 0x7b, 0x14, 0xb0, 0x3c, 0xac,
-	0xa6, 0xdd, 0x35, 0x1d, 0x62, 0xbf, 0xb1, 0x4d, 0x8f, 0x76, 0xa9, 0x6e, 0x40, 0x5e, 0x0d, 0x24,
-	0xc6, 0x4f, 0x20, 0x1f, 0x8a, 0x5d, 0xe6, 0x96, 0xc6, 0x56, 0x26, 0xd7, 0xa6, 0xea, 0x71, 0xb5,
-	0xeb, 0x01, 0xae, 0x59, 0xfa, 0xd0, 0x84, 0x9f, 0xb8, 0x42, 0x35, 0xf7, 0x03, 0x97, 0x41, 0x9c,
-	0x4a, 0x6b, 0xaa, 0xbf, 0x67, 0x20, 0xcf, 0xef, 0x48, 0xdb, 0xe6, 0x19, 0x9e, 0x84, 0x8c, 0xdd,
-	0x2d, 0x73, 0x4b, 0xdc, 0xca, 0xb8, 0x9a, 0xb1, 0xbb, 0x18, 0xc1, 0xd8, 0xa1, 0x79, 0x56, 0xce,
-	0x04, 0x81, 0xfe, 0x23, 0x9e, 0x83, 0xac, 0x63, 0x1c, 0x9b, 0xe5, 0xb1, 0x7e, 0xa8, 0x59, 0xf8,
-	0xd0, 0xcc, 0x7a, 0x99, 0xf2, 0x9a, 0x1a, 0x04, 0x23, 0x3c, 0xb2, 0x97, 0xe7, 0x81, 0x1f, 0x01,
-	0xec, 0x7b, 0xa6, 0x41, 0xcc, 0x6e, 0xc7, 0x20, 0xe5, 0xdc, 0x12, 0xb7, 0x32, 0xb1, 0x56, 0xa9,
-	0x87, 0x52, 0xd5, 0x07, 0x52, 0xd5, 0xf5, 0x81, 0x54, 0xea, 0x38, 0x45, 0xf3, 0xa4, 0x5f, 0x7a,
-	0xd2, 0xeb, 0x0e, 0x4a, 0xf3, 0xa3, 0x4b, 0x29, 0x9a, 0x27, 0x58, 0x00, 0x30, 0xdf, 0xf5, 0x6c,
-	0xcf, 0xf4, 0xfb, 0xa5, 0x85, 0x51, 0xa5, 0xcd, 0xe2, 0x87, 0x66, 0xee, 0x57, 0x2e, 0xf3, 0x2d,
-	0xa7, 0x8e, 0xd3, 0x3a, 0x9e, 0x3c, 0x2e, 0xfe, 0xfd, 0x7e, 0x36, 0x5b, 0xe4, 0x10, 0x57, 0x7d,
-	0x02, 0x85, 0x50, 0x4b, 0x1f, 0x3f, 0x80, 0xa2, 0xd1, 0xb3, 0x3b, 0x87, 0xe6, 0x59, 0xe8, 0xcb,
-	0xc4, 0xda, 0x74, 0x52, 0x8f, 0x10, 0xaa, 0x16, 0x8c, 0x9e, 0xdd, 0x2f, 0xa9, 0xfe, 0xc2, 0xc1,
-	0x15, 0xc1, 0x3d, 0x3a, 0x32, 0xf6, 0x5c, 0xcf, 0x20, 0xae, 0x87, 0x25, 0x18, 0xb3, 0xbb, 0x7e,
-	0xe0, 0xc8, 0xc4, 0x5a, 0x2d, 0x59, 0xae, 0x78, 0x96, 0xe1, 0xd8, 0xdf, 0x07, 0xb3, 0xa5, 0x78,
-	0xbb, 0xbe, 0xe9, 0x49, 0x6c, 0x4a, 0x02, 0xa6, 0x3f, 0x06, 0x0a, 0xf7, 0x7b, 0x44, 0xcc, 0xc9,
-	0x5c, 0xde, 0x9c, 0xad, 0x6c, 0x71, 0x0c, 0x65, 0xb7, 0xb2, 0xc5, 0x2c, 0xca, 0x6d, 0x65, 0x8b,
-	0x39, 0x94, 0xdf, 0xca, 0x16, 0xf3, 0xa8, 0x50, 0xfd, 0x99, 0x83, 0x99, 0x4d, 0x93, 0x44, 0x49,
-	0xab, 0xa6, 0xdf, 0x73, 0x1d, 0xdf, 0xc4, 0x4f, 0xff, 0x3b, 0xf9, 0x90, 0x72, 0xed, 0x5f, 0x51,
-	0x1e, 0xc9, 0x51, 0x83, 0x52, 0x94, 0x9f, 0x8f, 0x9b, 0x50, 0xda, 0x8f, 0x06, 0xa8, 0x3d, 0xf3,
-	0xc9, 0xf6, 0xb1, 0x5b, 0xc5, 0x4b, 0x56, 0xff, 0x40, 0x90, 0x0b, 0x8e, 0xc7, 0xd7, 0xa0, 0x14,
-	0x10, 0xe8, 0xd8, 0x4e, 0xb0, 0x5b, 0xd0, 0xff, 0xf0, 0x75, 0xb8, 0xaa, 0x4a, 0x9b, 0xcf, 0xf4,
-	0xce, 0xae, 0x26, 0xaa, 0x1d, 0xa9, 0xbd, 0xa1, 0x20, 0x0e, 0xdf, 0x84, 0xd9, 0x48, 0x50, 0x13,
-	0x75, 0x5d, 0x6a, 0x6f, 0x6a, 0x9d, 0x26, 0xaf, 0x49, 0x02, 0xca, 0xe0, 0x25, 0x98, 0x4f, 0x4b,
-	0xf3, 0x3b, 0x52, 0x67, 0x5b, 0x7c, 0xa5, 0xa1, 0x31, 0x3c, 0x05, 0xd7, 0x22, 0x88, 0x96, 0x28,
-	0x8b, 0xba, 0x88, 0xb2, 0xf8, 0x16, 0xdc, 0x8c, 0x84, 0xf9, 0x5d, 0xfd, 0x99, 0xa2, 0x4a, 0xaf,
-	0xc5, 0x56, 0x47, 0x90, 0x25, 0xb1, 0xad, 0x6b, 0x28, 0x97, 0xe8, 0xcd, 0xef, 0xec, 0xc8, 0x92,
-	0xc0, 0xeb, 0x92, 0xd2, 0xd6, 0x3a, 0xb2, 0xa4, 0xe9, 0x28, 0x8f, 0xab, 0xb0, 0x70, 0x11, 0x42,
-	0x50, 0x45, 0x5e, 0x17, 0x51, 0x01, 0xcf, 0x43, 0x39, 0x82, 0xd9, 0xe4, 0x75, 0xf1, 0x25, 0xff,
-	0x8a, 0x76, 0x28, 0xe2, 0x05, 0xa8, 0xa4, 0x65, 0x69, 0xf5, 0x38, 0x9e, 0x83, 0x99, 0x48, 0x9e,
-	0x72, 0x0b, 0x8b, 0x21, 0xa1, 0xcd, 0x20, 0x49, 0x6b, 0x27, 0x12, 0x57, 0x54, 0xd4, 0x4d, 0xbe,
-	0x2d, 0xbd, 0x8e, 0x5e, 0xe0, 0x0a, 0x5e, 0x86, 0xc5, 0x0b, 0x21, 0xb4, 0x4f, 0x29, 0xd1, 0xa7,
-	0xad, 0xe8, 0xd2, 0xc6, 0xf9, 0x35, 0x55, 0x91, 0x6f, 0xa1, 0xaf, 0x30, 0x86, 0xc9, 0xa8, 0x10,
-	0xb2, 0x8c, 0x26, 0x71, 0x05, 0xa6, 0xc3, 0x58, 0x44, 0x97, 0xd0, 0xd5, 0xab, 0xf8, 0x36, 0x2c,
-	0x0d, 0xe7, 0x12, 0xe6, 0x22, 0x7c, 0x0f, 0x96, 0x3f, 0x82, 0x3a, 0xf7, 0xf8, 0x1a, 0xbe, 0x0f,
-	0x2b, 0x1f, 0x01, 0x0a, 0x8a, 0x2c, 0xf3, 0x4d, 0x45, 0xe5, 0x75, 0x45, 0xd5, 0x10, 0x1e, 0xd1,
-	0x76, 0x87, 0x17, 0xb6, 0xf9, 0x4d, 0x51, 0x43, 0x5f, 0x32, 0xeb, 0xa2, 0x40, 0x3a, 0x41, 0xd7,
-	0x99, 0xf9, 0xf1, 0xec, 0x0b, 0x49, 0x10, 0xa9, 0x2e, 0x37, 0x98, 0xbe, 0x69, 0x98, 0x97, 0xaa,
-	0xa4, 0x8b, 0x68, 0x2a, 0x9d, 0x4f, 0xb4, 0x51, 0x78, 0xcd, 0x69, 0xbc, 0x02, 0xb7, 0x47, 0x74,
-	0x0b, 0x91, 0x33, 0xe9, 0xdc, 0x74, 0x95, 0xdf, 0xd8, 0x90, 0x84, 0x90, 0x5b, 0x19, 0xdf, 0x85,
-	0xea, 0xc5, 0x98, 0xdd, 0x1d, 0x4a, 0x6f, 0x36, 0xfd, 0xd4, 0x01, 0xae, 0xa5, 0xbc, 0x6c, 0x53,
-	0x64, 0x25, 0xdd, 0x71, 0x59, 0x6a, 0x6f, 0xa3, 0x39, 0x3c, 0x0b, 0x53, 0xc3, 0xb9, 0xfe, 0xa0,
-	0xcc, 0xe3, 0x1b, 0x80, 0xc2, 0x54, 0x38, 0xc1, 0x41, 0xf4, 0x26, 0x7b, 0x6f, 0x69, 0x34, 0x98,
-	0x9c, 0x27, 0x78, 0x11, 0xe6, 0x62, 0xe1, 0xc4, 0xd0, 0x7c, 0xcd, 0xd4, 0x4c, 0x02, 0xe2, 0x63,
-	0xf0, 0x0d, 0x9e, 0x81, 0xeb, 0x31, 0x20, 0x35, 0xf6, 0x29, 0x9e, 0x06, 0x1c, 0x26, 0xe8, 0xeb,
-	0x18, 0x1e, 0xbd, 0xc0, 0xf6, 0xc1, 0x20, 0x9e, 0x38, 0x7b, 0x91, 0xd9, 0x3d, 0x84, 0x38, 0x1f,
-	0xd6, 0x25, 0xa6, 0xe7, 0x10, 0x28, 0xce, 0xf0, 0x16, 0x2e, 0xc3, 0x8d, 0x38, 0x92, 0x52, 0xac,
-	0xb2, 0xb5, 0x31, 0xc8, 0xc4, 0xbc, 0x5d, 0x66, 0xef, 0x57, 0x32, 0x1f, 0xf1, 0xeb, 0xf6, 0xf0,
-	0x45, 0x03, 0xaf, 0xee, 0xb0, 0xbd, 0x72, 0xce, 0x50, 0xe7, 0xf5, 0x5d, 0x3a, 0xd4, 0x77, 0x99,
-	0x05, 0xe7, 0x65, 0x0a, 0xf5, 0x33, 0x00, 0xdc, 0x1b, 0x06, 0x84, 0xb3, 0xa9, 0x89, 0x82, 0x2a,
-	0xea, 0x1a, 0x7a, 0x34, 0x4c, 0x3f, 0x98, 0xf2, 0x41, 0xfe, 0x31, 0xf3, 0x7e, 0x90, 0xef, 0x8f,
-	0xc4, 0x0a, 0x5b, 0x86, 0xd1, 0x45, 0x15, 0xba, 0xf3, 0x7f, 0x7c, 0x07, 0x6e, 0xa5, 0x24, 0x13,
-	0x16, 0xad, 0x32, 0xf5, 0xd3, 0x61, 0xe7, 0x3e, 0x7d, 0xc2, 0x06, 0x29, 0x1d, 0xf9, 0x5c, 0x7c,
-	0xde, 0x14, 0x55, 0x0d, 0xdd, 0x67, 0x72, 0xc5, 0x80, 0xd4, 0xab, 0xda, 0x05, 0x27, 0x0e, 0x7f,
-	0x4e, 0xea, 0x78, 0x15, 0xee, 0x8e, 0x42, 0xd2, 0xa5, 0xdc, 0x60, 0x0e, 0xc7, 0xb0, 0xf1, 0xcf,
-	0xcb, 0xa7, 0xec, 0x1d, 0x4f, 0x47, 0xd1, 0x6e, 0x0f, 0xd8, 0xe0, 0xc6, 0x70, 0xb1, 0xcf, 0xcd,
-	0xda, 0x05, 0x0a, 0x27, 0x3e, 0x3b, 0xeb, 0x17, 0xdd, 0xa2, 0xd5, 0xea, 0xf0, 0xf1, 0x11, 0x47,
-	0x9f, 0xb1, 0x8d, 0x11, 0xc7, 0xca, 0x32, 0x7a, 0xc8, 0xa6, 0x53, 0x13, 0xdb, 0xad, 0x8e, 0xd4,
-	0x7e, 0x21, 0xe9, 0xa2, 0x86, 0x3e, 0xc7, 0x25, 0x18, 0xa7, 0x9b, 0x44, 0x96, 0xd1, 0x17, 0x95,
-	0xd2, 0x5f, 0xef, 0x67, 0xc7, 0xcb, 0xdc, 0x6a, 0x2e, 0x08, 0x36, 0x1f, 0xfe, 0xf6, 0xe7, 0x02,
-	0xf7, 0xba, 0x61, 0xb9, 0x75, 0x72, 0x60, 0x92, 0xe0, 0x87, 0x46, 0xdd, 0x31, 0xc9, 0x5b, 0xd7,
-	0x3b, 0x6c, 0xc4, 0x7f, 0x1f, 0x9c, 0xae, 0x37, 0x7a, 0x87, 0x56, 0x83, 0x10, 0xa7, 0xb7, 0xb7,
-	0x97, 0x0f, 0xfe, 0x69, 0x5d, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0x51, 0x4e, 0x29, 0xf4, 0x92,
-	0x0d, 0x00, 0x00,
+	// 1293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x57, 0xdb, 0x46,
+	0x14, 0xad, 0x8c, 0xbf, 0x78, 0xc4, 0x64, 0x32, 0x09, 0x60, 0x0c, 0x01, 0x62, 0xf2, 0x41, 0x69,
+	0x6c, 0x37, 0xd0, 0xb4, 0x4d, 0x9a, 0x36, 0x91, 0x65, 0x41, 0x04, 0x8a, 0xc5, 0x91, 0x44, 0x72,
+	0x92, 0x8d, 0x8f, 0xc0, 0x8a, 0x50, 0x01, 0xc9, 0x47, 0x1a, 0x48, 0xe8, 0xb2, 0xcb, 0x2e, 0xfb,
+	0x0f, 0xba, 0xcd, 0xa6, 0xe7, 0xf4, 0x17, 0x74, 0xdd, 0xbf, 0xd1, 0x5d, 0x97, 0x5d, 0x66, 0xd3,
+	0x1e, 0x4b, 0x63, 0x46, 0x92, 0x45, 0xdc, 0x74, 0x27, 0xde, 0xbb, 0xef, 0xcd, 0x9d, 0x7b, 0x9f,
+	0x9e, 0x30, 0x2c, 0x1c, 0xb9, 0x9e, 0xf1, 0xc6, 0x70, 0x6a, 0x3e, 0x31, 0xf6, 0x0f, 0x1b, 0x46,
+	0xcf, 0x6e, 0x78, 0xb6, 0x75, 0x40, 0xfc, 0x7a, 0xcf, 0x73, 0x89, 0x8b, 0x27, 0x09, 0x71, 0xea,
+	0x14, 0x53, 0x3f, 0x5d, 0xaf, 0xb4, 0x2c, 0x9b, 0x1c, 0x9c, 0xec, 0xd5, 0xf7, 0xdd, 0xe3, 0x86,
+	0x7e, 0x60, 0xea, 0x07, 0xb6, 0x63, 0xf9, 0x92, 0xd3, 0x3d, 0xf1, 0x89, 0x67, 0x9b, 0x7e, 0x23,
+	0xa8, 0xda, 0xaf, 0x59, 0xa6, 0x53, 0xb3, 0xdc, 0xda, 0xeb, 0x23, 0xc3, 0xf2, 0x1b, 0x86, 0xe3,
+	0xb8, 0xc4, 0x20, 0xb6, 0xeb, 0xd0, 0xae, 0x15, 0x3e, 0xd2, 0xc5, 0x74, 0x4e, 0xdd, 0xb3, 0x9e,
+	0xe7, 0xbe, 0x3d, 0x8b, 0x16, 0x9f, 0x1a, 0x47, 0x76, 0xd7, 0x20, 0x66, 0x63, 0xe8, 0x81, 0xb6,
+	0xa8, 0x45, 0x5a, 0x58, 0xae, 0xe5, 0x86, 0xc5, 0x7b, 0x27, 0xaf, 0x83, 0xbf, 0x82, 0x3f, 0x82,
+	0x27, 0x0a, 0x17, 0x3e, 0x8a, 0xf7, 0xf7, 0xbe, 0xeb, 0xa4, 0xd0, 0x5e, 0xb4, 0x5c, 0xd7, 0x3a,
+	0x32, 0xd9, 0x51, 0xc4, 0x3e, 0x36, 0x7d, 0x62, 0x1c, 0xf7, 0x28, 0x60, 0x79, 0x58, 0x4d, 0xbb,
+	0x6b, 0x3a, 0xc4, 0x7e, 0x6d, 0x9b, 0x1e, 0xed, 0x52, 0xdd, 0x80, 0xbc, 0x1a, 0x48, 0x8c, 0x1f,
+	0x41, 0x3e, 0x14, 0xbb, 0xcc, 0x2d, 0x8d, 0xad, 0x4c, 0xae, 0x4d, 0xd5, 0xe3, 0x6a, 0xd7, 0x03,
+	0x5c, 0xb3, 0xf4, 0xbe, 0x09, 0x3f, 0x73, 0x85, 0x6a, 0xee, 0x47, 0x2e, 0x83, 0x38, 0x95, 0xd6,
+	0x54, 0xff, 0xc8, 0x40, 0x9e, 0xdf, 0x91, 0xb6, 0xcd, 0x33, 0x3c, 0x09, 0x19, 0xbb, 0x5b, 0xe6,
+	0x96, 0xb8, 0x95, 0x71, 0x35, 0x63, 0x77, 0x31, 0x82, 0xb1, 0x43, 0xf3, 0xac, 0x9c, 0x09, 0x02,
+	0xfd, 0x47, 0x3c, 0x07, 0x59, 0xc7, 0x38, 0x36, 0xcb, 0x63, 0xfd, 0x50, 0xb3, 0xf0, 0xbe, 0x99,
+	0xf5, 0x32, 0xe5, 0x35, 0x35, 0x08, 0x46, 0x78, 0x64, 0x3f, 0x9e, 0x07, 0x7e, 0x00, 0xb0, 0xef,
+	0x99, 0x06, 0x31, 0xbb, 0x1d, 0x83, 0x94, 0x73, 0x4b, 0xdc, 0xca, 0xc4, 0x5a, 0xa5, 0x1e, 0x4a,
+	0x55, 0x1f, 0x48, 0x55, 0xd7, 0x07, 0x52, 0xa9, 0xe3, 0x14, 0xcd, 0x93, 0x7e, 0xe9, 0x49, 0xaf,
+	0x3b, 0x28, 0xcd, 0x8f, 0x2e, 0xa5, 0x68, 0x9e, 0x60, 0x01, 0xc0, 0x7c, 0xdb, 0xb3, 0x3d, 0xd3,
+	0xef, 0x97, 0x16, 0x46, 0x95, 0x36, 0x8b, 0xef, 0x9b, 0xb9, 0xdf, 0xb8, 0xcc, 0x13, 0x4e, 0x1d,
+	0xa7, 0x75, 0x3c, 0x79, 0x58, 0xfc, 0xfb, 0xdd, 0x6c, 0xb6, 0xc8, 0x21, 0xae, 0xfa, 0x08, 0x0a,
+	0xa1, 0x96, 0x3e, 0xbe, 0x07, 0x45, 0xa3, 0x67, 0x77, 0x0e, 0xcd, 0xb3, 0xd0, 0x97, 0x89, 0xb5,
+	0xe9, 0xa4, 0x1e, 0x21, 0x54, 0x2d, 0x18, 0x3d, 0xbb, 0x5f, 0x52, 0xfd, 0x95, 0x83, 0x4b, 0x82,
+	0x7b, 0x74, 0x64, 0xec, 0xb9, 0x9e, 0x41, 0x5c, 0x0f, 0x4b, 0x30, 0x66, 0x77, 0xfd, 0xc0, 0x91,
+	0x89, 0xb5, 0x5a, 0xb2, 0x5c, 0xf1, 0x2c, 0xc3, 0xb1, 0x7f, 0x08, 0x66, 0x4b, 0xf1, 0x76, 0x7d,
+	0xd3, 0x93, 0xd8, 0x94, 0x04, 0x4c, 0x7f, 0x0a, 0x14, 0xee, 0xf7, 0x88, 0x98, 0x93, 0xf9, 0x78,
+	0x73, 0xb6, 0xb2, 0xc5, 0x31, 0x94, 0xdd, 0xca, 0x16, 0xb3, 0x28, 0xb7, 0x95, 0x2d, 0xe6, 0x50,
+	0x7e, 0x2b, 0x5b, 0xcc, 0xa3, 0x42, 0xf5, 0x17, 0x0e, 0x66, 0x36, 0x4d, 0x12, 0x25, 0xad, 0x9a,
+	0x7e, 0xcf, 0x75, 0x7c, 0x13, 0x3f, 0xfe, 0xff, 0xe4, 0x43, 0xca, 0xb5, 0xff, 0x44, 0x79, 0x24,
+	0x47, 0x0d, 0x4a, 0x51, 0x7e, 0x3e, 0x6e, 0x42, 0x69, 0x3f, 0x1a, 0xa0, 0xf6, 0xcc, 0x27, 0xdb,
+	0xc7, 0x6e, 0x15, 0x2f, 0x59, 0xfd, 0x07, 0x41, 0x2e, 0x38, 0x1e, 0x5f, 0x81, 0x52, 0x40, 0xa0,
+	0x63, 0x3b, 0xc1, 0x6e, 0x41, 0x9f, 0xe0, 0xab, 0x70, 0x59, 0x95, 0x36, 0x9f, 0xea, 0x9d, 0x5d,
+	0x4d, 0x54, 0x3b, 0x52, 0x7b, 0x43, 0x41, 0x1c, 0xbe, 0x0e, 0xb3, 0x91, 0xa0, 0x26, 0xea, 0xba,
+	0xd4, 0xde, 0xd4, 0x3a, 0x4d, 0x5e, 0x93, 0x04, 0x94, 0xc1, 0x4b, 0x30, 0x9f, 0x96, 0xe6, 0x77,
+	0xa4, 0xce, 0xb6, 0xf8, 0x52, 0x43, 0x63, 0x78, 0x0a, 0xae, 0x44, 0x10, 0x2d, 0x51, 0x16, 0x75,
+	0x11, 0x65, 0xf1, 0x0d, 0xb8, 0x1e, 0x09, 0xf3, 0xbb, 0xfa, 0x53, 0x45, 0x95, 0x5e, 0x89, 0xad,
+	0x8e, 0x20, 0x4b, 0x62, 0x5b, 0xd7, 0x50, 0x2e, 0xd1, 0x9b, 0xdf, 0xd9, 0x91, 0x25, 0x81, 0xd7,
+	0x25, 0xa5, 0xad, 0x75, 0x64, 0x49, 0xd3, 0x51, 0x1e, 0x57, 0x61, 0xe1, 0x22, 0x84, 0xa0, 0x8a,
+	0xbc, 0x2e, 0xa2, 0x02, 0x9e, 0x87, 0x72, 0x04, 0xb3, 0xc9, 0xeb, 0xe2, 0x0b, 0xfe, 0x25, 0xed,
+	0x50, 0xc4, 0x0b, 0x50, 0x49, 0xcb, 0xd2, 0xea, 0x71, 0x3c, 0x07, 0x33, 0x91, 0x3c, 0xe5, 0x16,
+	0x16, 0x43, 0x42, 0x9b, 0x41, 0x92, 0xd6, 0x4e, 0x24, 0xae, 0xa8, 0xa8, 0x9b, 0x7c, 0x5b, 0x7a,
+	0x15, 0xbd, 0xc0, 0x25, 0xbc, 0x0c, 0x8b, 0x17, 0x42, 0x68, 0x9f, 0x52, 0xa2, 0x4f, 0x5b, 0xd1,
+	0xa5, 0x8d, 0xf3, 0x6b, 0xaa, 0x22, 0xdf, 0x42, 0xdf, 0x60, 0x0c, 0x93, 0x51, 0x21, 0x64, 0x19,
+	0x4d, 0xe2, 0x0a, 0x4c, 0x87, 0xb1, 0x88, 0x2e, 0xa1, 0xab, 0x97, 0xf1, 0x4d, 0x58, 0x1a, 0xce,
+	0x25, 0xcc, 0x45, 0xf8, 0x0e, 0x2c, 0x7f, 0x00, 0x75, 0xee, 0xf1, 0x15, 0x7c, 0x17, 0x56, 0x3e,
+	0x00, 0x14, 0x14, 0x59, 0xe6, 0x9b, 0x8a, 0xca, 0xeb, 0x8a, 0xaa, 0x21, 0x3c, 0xa2, 0xed, 0x0e,
+	0x2f, 0x6c, 0xf3, 0x9b, 0xa2, 0x86, 0xbe, 0x66, 0xd6, 0x45, 0x81, 0x74, 0x82, 0xae, 0x32, 0xf3,
+	0xe3, 0xd9, 0xe7, 0x92, 0x20, 0x52, 0x5d, 0xae, 0x31, 0x7d, 0xd3, 0x30, 0x2f, 0x54, 0x49, 0x17,
+	0xd1, 0x54, 0x3a, 0x9f, 0x68, 0xa3, 0xf0, 0x9a, 0xd3, 0x78, 0x05, 0x6e, 0x8e, 0xe8, 0x16, 0x22,
+	0x67, 0xd2, 0xb9, 0xe9, 0x2a, 0xbf, 0xb1, 0x21, 0x09, 0x21, 0xb7, 0x32, 0xbe, 0x0d, 0xd5, 0x8b,
+	0x31, 0xbb, 0x3b, 0x94, 0xde, 0x6c, 0xfa, 0xa9, 0x03, 0x5c, 0x4b, 0x79, 0xd1, 0xa6, 0xc8, 0x4a,
+	0xba, 0xe3, 0xb2, 0xd4, 0xde, 0x46, 0x73, 0x78, 0x16, 0xa6, 0x86, 0x73, 0xfd, 0x41, 0x99, 0xc7,
+	0xd7, 0x00, 0x85, 0xa9, 0x70, 0x82, 0x83, 0xe8, 0x75, 0xf6, 0xde, 0xd2, 0x68, 0x30, 0x39, 0x8f,
+	0xf0, 0x22, 0xcc, 0xc5, 0xc2, 0x89, 0xa1, 0xf9, 0x96, 0xa9, 0x99, 0x04, 0xc4, 0xc7, 0xe0, 0x3b,
+	0x3c, 0x03, 0x57, 0x63, 0x40, 0x6a, 0xec, 0x63, 0x3c, 0x0d, 0x38, 0x4c, 0xd0, 0xd7, 0x31, 0x3c,
+	0x7a, 0x81, 0xed, 0x83, 0x41, 0x3c, 0x71, 0xf6, 0x22, 0xb3, 0x7b, 0x08, 0x71, 0x3e, 0xac, 0x4b,
+	0x4c, 0xcf, 0x21, 0x50, 0x9c, 0xe1, 0x0d, 0x5c, 0x86, 0x6b, 0x71, 0x24, 0xa5, 0x58, 0x65, 0x6b,
+	0x63, 0x90, 0x89, 0x79, 0xbb, 0xcc, 0xde, 0xaf, 0x64, 0x3e, 0xe2, 0xd7, 0xcd, 0xe1, 0x8b, 0x06,
+	0x5e, 0xdd, 0x62, 0x7b, 0xe5, 0x9c, 0xa1, 0xce, 0xeb, 0xbb, 0x74, 0xa8, 0x6f, 0x33, 0x0b, 0xce,
+	0xcb, 0x14, 0xea, 0x67, 0x00, 0xb8, 0x33, 0x0c, 0x08, 0x67, 0x53, 0x13, 0x05, 0x55, 0xd4, 0x35,
+	0xf4, 0x60, 0x98, 0x7e, 0x30, 0xe5, 0x83, 0xfc, 0xc3, 0xb4, 0xfc, 0x33, 0xa5, 0xdf, 0xe1, 0xa9,
+	0x28, 0xcb, 0xe8, 0x09, 0x9b, 0x8d, 0x41, 0xbe, 0x3f, 0x32, 0x2b, 0x6c, 0x59, 0x46, 0x17, 0x59,
+	0xe8, 0xde, 0xa7, 0xf8, 0x16, 0xdc, 0x48, 0x49, 0x26, 0x2c, 0x5c, 0x65, 0xee, 0xa4, 0xc3, 0xce,
+	0x7d, 0xfc, 0x8c, 0x0d, 0x5a, 0x3a, 0xf2, 0x99, 0xf8, 0xac, 0x29, 0xaa, 0x1a, 0xba, 0xcb, 0xe4,
+	0x8c, 0x01, 0xa9, 0x97, 0xb5, 0x0b, 0x4e, 0x1c, 0xfe, 0xdc, 0xd4, 0xf1, 0x2a, 0xdc, 0x1e, 0x85,
+	0xa4, 0x4b, 0xbb, 0xc1, 0x26, 0x20, 0x86, 0x8d, 0x7f, 0x7e, 0x3e, 0x67, 0x3b, 0x20, 0x1d, 0x45,
+	0xbb, 0xdd, 0x63, 0x83, 0x1d, 0xc3, 0xc5, 0x3e, 0x47, 0x6b, 0x17, 0x28, 0x9c, 0xf8, 0x2c, 0xad,
+	0x5f, 0x74, 0x8b, 0x56, 0xab, 0xc3, 0xc7, 0x5f, 0x01, 0xf4, 0x05, 0xdb, 0x28, 0x71, 0xac, 0x2c,
+	0xa3, 0xfb, 0x6c, 0x7a, 0x35, 0xb1, 0xdd, 0xea, 0x48, 0xed, 0xe7, 0x92, 0x2e, 0x6a, 0xe8, 0x4b,
+	0x5c, 0x82, 0x71, 0xba, 0x69, 0x64, 0x19, 0x7d, 0x55, 0x29, 0xfd, 0xf5, 0x6e, 0x76, 0xbc, 0xcc,
+	0xad, 0xe6, 0x82, 0x60, 0xf3, 0xfe, 0xef, 0x7f, 0x2e, 0x70, 0xaf, 0x1a, 0x96, 0x5b, 0x27, 0x07,
+	0x26, 0x09, 0x7e, 0x88, 0xd4, 0x1d, 0x93, 0xbc, 0x71, 0xbd, 0xc3, 0x46, 0xfc, 0xf7, 0xc3, 0xe9,
+	0x7a, 0xa3, 0x77, 0x68, 0x35, 0x08, 0x71, 0x7a, 0x7b, 0x7b, 0xf9, 0xe0, 0x9f, 0xda, 0xf5, 0x7f,
+	0x03, 0x00, 0x00, 0xff, 0xff, 0xf7, 0x66, 0x66, 0xb9, 0xb2, 0x0d, 0x00, 0x00,
 }
@@ -75,6 +75,7 @@ var Right_customvalue = map[string]int32{
 	"GATEWAY_LOCATION_READ":              39,
 	"GATEWAY_WRITE_SECRETS":              57,
 	"GATEWAY_READ_SECRETS":               58,
+	"GATEWAY_REMOTE_SHELL":               64,
 	"GATEWAY_ALL":                        40,
 	"ORGANIZATION_INFO":                  41,
 	"ORGANIZATION_SETTINGS_BASIC":        42,
@@ -75,6 +75,19 @@ func TestRights(t *testing.T) {
 		a.So(ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_ALL).Implied().GetRights(), should.Contain, ttnpb.Right_RIGHT_ORGANIZATION_DELETE)
 		a.So(ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_ALL).Implied().GetRights(), should.Contain, ttnpb.Right_RIGHT_USER_DELETE)
 	})
+	t.Run("ImpliedExplicitGatewayRights", func(t *testing.T) {
+		a := assertions.New(t)
+		a.So(ttnpb.AllGatewayRights.GetRights(), should.Contain, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL)
+		a.So(ttnpb.Right_RIGHT_GATEWAY_ALL.Implied().GetRights(), should.NotContain, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL)
+		a.So(ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_ALL).Implied().IncludesAll(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL), should.BeFalse)
+		a.So(ttnpb.RightsFrom(
+			ttnpb.Right_RIGHT_GATEWAY_ALL,
+			ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL,
+		).Implied().IncludesAll(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL), should.BeTrue)
+		a.So(ttnpb.AllGatewayRights.Sub(ttnpb.Right_RIGHT_GATEWAY_ALL.Implied()).GetRights(), should.Resemble, []ttnpb.Right{
+			ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL,
+		})
+	})
 	t.Run("IncludesAll", func(t *testing.T) {
 		a := assertions.New(t)
 		a.So(nilRights.IncludesAll(), should.BeTrue)
@@ -44,6 +44,7 @@ type RxPacket struct {
 	CodR  string       `json:"codr"`            // LoRa or LR-FHSS ECC coding rate identifier
 	Hpw   uint32       `json:"hpw,omitempty"`   // Hopping width; a number describing the number of steps of the LR-FHSS grid
 	RSSI  int16        `json:"rssi"`            // RSSI in dBm (signed integer, 1 dB precision)
+	RSSIS *int16       `json:"rssis,omitempty"` // RSSI in dBm of the signal (signed integer, 1 dB precision) (Optional)
 	LSNR  float64      `json:"lsnr"`            // Lora SNR ratio in dB (signed float, 0.1 dB precision)
 	FOff  *int32       `json:"foff,omitempty"`  // Frequency offset in Hz [-125kHz..+125Khz] (Optional)
 	Size  uint16       `json:"size"`            // RF packet payload size in bytes (unsigned integer)
@@ -53,6 +54,13 @@ type RxPacket struct {
 	Aesk  uint         `json:"aesk"`            // AES key index used for encrypting fine timestamps (unsigned integer)
 }
 
+// CRC statuses of received packets.
+const (
+	CRCStatusFail int8 = -1
+	CRCStatusNone int8 = 0
+	CRCStatusOK   int8 = 1
+)
+
 // reduceLRFHSSCodingRate reduces the coding rate fraction returned by the packet forwarder.
 // The packet forwarder will render the coding rates used by LR-FHSS in their `4/x` form, even
 // though the real coding rates are irreducible fractions.
@@ -99,6 +107,7 @@ type RSig struct {
 	LSNR   float64 `json:"lsnr"`   // Lora SNR ratio in dB (signed float, 0.1 dB precision), TBD for LR-FHSS
 	ETime  string  `json:"etime"`  // Encrypted fine timestamp, ns precision [0..999999999] (Optional)
 	FTime  *uint32 `json:"ftime"`  // Fine timestamp, ns precision [0..999999999] (Optional)
+	FTStat *int8   `json:"ftstat"` // Fine timestamp status: 0 = valid (Optional)
 	FOff   int32   `json:"foff"`   // Frequency offset in Hz [-125kHz..+125Khz] (Optional)
 	Fdri   int32   `json:"fdri"`   // Frequency drift in Hz between start and end of a LR-FHSS packet (signed)
 }
@@ -122,6 +131,7 @@ type TxPacket struct {
 	Prea uint16       `json:"prea,omitempty"` // RF preamble size (unsigned integer)
 	Size uint16       `json:"size"`           // RF packet payload size in bytes (unsigned integer)
 	NCRC bool         `json:"ncrc,omitempty"` // If true, disable the CRC of the physical layer (optional)
+	NHdr bool         `json:"nhdr,omitempty"` // If true, disable the header of the physical layer (optional)
 	Data string       `json:"data"`           // Base64 encoded RF packet payload, padding optional
 }
 
@@ -165,6 +175,7 @@ type Stat struct {
 	FPGA *uint32       `json:"fpga,omitempty"` // Version of Gateway FPGA (unsigned integer)
 	DSP  *uint32       `json:"dsp,omitempty"`  // Version of Gateway DSP software (unsigned integer)
 	HAL  *string       `json:"hal,omitempty"`  // Version of Gateway driver (format X.X.X)
+	Pfrm *string       `json:"pfrm,omitempty"` // Gateway platform (e.g. "IMST + Rpi")
 	HVer *struct {
 		FPGA *uint32 `json:"fpga,omitempty"` // Version of FPGA (unsigned integer)
 		DSP0 *uint32 `json:"dsp0,omitempty"` // Version of DSP 0 software (unsigned integer)
@@ -197,4 +208,6 @@ var (
 // TxPacketAck contains a Tx acknowledgment packet
 type TxPacketAck struct {
 	Error TxError `json:"error"`
+	Warn  TxError `json:"warn,omitempty"`  // Warning for a packet that has been programmed for downlink with adjusted parameters
+	Value *int32  `json:"value,omitempty"` // Adjusted value of the parameter that caused the warning (Optional)
 }
@@ -68,7 +68,8 @@ func ToGatewayUp(data Data, md UpstreamMetadata) (*ttnpb.GatewayUp, error) {
 	up := &ttnpb.GatewayUp{}
 	up.UplinkMessages = make([]*ttnpb.UplinkMessage, 0)
 	for _, rx := range data.RxPacket {
-		if rx == nil {
+		// Packet forwarders may be configured to forward packets with a failed CRC. These are never valid uplinks.
+		if rx == nil || rx.Stat == CRCStatusFail {
 			continue
 		}
 		convertedRx, err := convertUplink(*rx, md)
@@ -81,7 +82,13 @@ func ToGatewayUp(data Data, md UpstreamMetadata) (*ttnpb.GatewayUp, error) {
 		up.GatewayStatus = convertStatus(*data.Stat, md)
 	}
 	if data.TxPacketAck != nil {
-		result, ok := ttnAckError[data.TxPacketAck.Error]
+		txErr := data.TxPacketAck.Error
+		if txErr == "" {
+			// Packet forwarders that report a warning, like an adjusted Tx power, leave out the error
+			// since the packet has been programmed for downlink.
+			txErr = TxErrNone
+		}
+		result, ok := ttnAckError[txErr]
 		if !ok {
 			result = ttnpb.TxAcknowledgment_UNKNOWN_ERROR
 		}
@@ -126,6 +133,11 @@ func v1Metadata(rx RxPacket, gatewayID *ttnpb.GatewayIdentifiers) []*ttnpb.RxMet
 		Snr:          float32(rx.LSNR),
 		HoppingWidth: rx.Hpw,
 	}
+	if rx.RSSIS != nil {
+		md.SignalRssi = &pbtypes.FloatValue{
+			Value: float32(*rx.RSSIS),
+		}
+	}
 	if rx.FTime != nil {
 		md.FineTimestamp = uint64(*rx.FTime)
 	}
@@ -158,14 +170,24 @@ func v2Metadata(rx RxPacket, gatewayID *ttnpb.GatewayIdentifiers) []*ttnpb.RxMet
 		if signal.RSSISD != nil {
 			signalMetadata.RssiStandardDeviation = float32(*signal.RSSISD)
 		}
-		if signal.ETime != "" {
-			if etime, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(signal.ETime, "=")); err == nil {
-				signalMetadata.EncryptedFineTimestampKeyId = strconv.Itoa(int(rx.Aesk))
-				signalMetadata.EncryptedFineTimestamp = etime
-			}
+		if signal.FOff == 0 && rx.FOff != nil {
+			signalMetadata.FrequencyOffset = int64(*rx.FOff)
 		}
-		if signal.FTime != nil {
-			signalMetadata.FineTimestamp = uint64(*signal.FTime)
+		// The fine timestamp status is only reported by some packet forwarders. If reported, the fine timestamps are
+		// only valid if the status is zero.
+		if signal.FTStat == nil || *signal.FTStat == 0 {
+			if signal.ETime != "" {
+				if etime, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(signal.ETime, "=")); err == nil {
+					signalMetadata.EncryptedFineTimestampKeyId = strconv.Itoa(int(rx.Aesk))
+					signalMetadata.EncryptedFineTimestamp = etime
+				}
+			}
+			switch {
+			case signal.FTime != nil:
+				signalMetadata.FineTimestamp = uint64(*signal.FTime)
+			case rx.FTime != nil:
+				signalMetadata.FineTimestamp = uint64(*rx.FTime)
+			}
 		}
 		md = append(md, signalMetadata)
 	}
@@ -231,6 +253,9 @@ func addVersions(status *ttnpb.GatewayStatus, stat Stat) {
 	if stat.HAL != nil {
 		status.Versions["hal"] = *stat.HAL
 	}
+	if stat.Pfrm != nil {
+		status.Versions["platform"] = *stat.Pfrm
+	}
 	if hver := stat.HVer; hver != nil {
 		if fpga := hver.FPGA; fpga != nil {
 			status.Versions["fpga"] = strconv.Itoa(int(*fpga))
@@ -321,6 +346,11 @@ func FromGatewayUp(up *ttnpb.GatewayUp) (rxs []*RxPacket, stat *Stat, ack *TxPac
 		if i := int32(msg.RxMetadata[0].FrequencyOffset); i != 0 {
 			foff = &i
 		}
+		var rssis *int16
+		if signalRSSI := msg.RxMetadata[0].SignalRssi; signalRSSI != nil {
+			i := int16(signalRSSI.Value)
+			rssis = &i
+		}
 		rxs = append(rxs, &RxPacket{
 			Freq:  float64(msg.Settings.Frequency) / 1000000,
 			Chan:  uint8(msg.RxMetadata[0].ChannelIndex),
@@ -331,6 +361,7 @@ func FromGatewayUp(up *ttnpb.GatewayUp) (rxs []*RxPacket, stat *Stat, ack *TxPac
 			Data:  base64.StdEncoding.EncodeToString(msg.RawPayload),
 			Tmst:  msg.RxMetadata[0].Timestamp,
 			RSSI:  int16(msg.RxMetadata[0].Rssi),
+			RSSIS: rssis,
 			LSNR:  float64(msg.RxMetadata[0].Snr),
 			FTime: ftime,
 			FOff:  foff,
@@ -371,9 +402,19 @@ func ToDownlinkMessage(tx *TxPacket) (*ttnpb.DownlinkMessage, error) {
 		},
 		Timestamp: tx.Tmst,
 	}
-	if tx.Time != nil {
+	switch {
+	case tx.Imme:
+		scheduled.Timestamp = 0
+	case tx.Tmms != nil:
 		t := gpstime.Parse(time.Duration(*tx.Tmms) * time.Millisecond)
 		scheduled.Time = ttnpb.ProtoTimePtr(t)
+	case tx.Time != nil:
+		scheduled.Time = ttnpb.ProtoTimePtr(time.Time(*tx.Time))
+	}
+	if _, ok := tx.DatR.DataRate.GetModulation().(*ttnpb.DataRate_Lora); ok {
+		scheduled.EnableCrc = !tx.NCRC
+		scheduled.Downlink.ImplicitHeader = tx.NHdr
+		scheduled.Downlink.PreambleLength = uint32(tx.Prea)
 	}
 	buf, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(tx.Data, "="))
 	if err != nil {
@@ -414,6 +455,8 @@ func FromDownlinkMessage(msg *ttnpb.DownlinkMessage) (*TxPacket, error) {
 	case *ttnpb.DataRate_Lora:
 		tx.CodR = mod.Lora.CodingRate
 		tx.NCRC = !scheduled.EnableCrc
+		tx.NHdr = scheduled.Downlink.ImplicitHeader
+		tx.Prea = uint16(scheduled.Downlink.PreambleLength)
 		tx.Modu = lora
 	case *ttnpb.DataRate_Fsk:
 		tx.Modu = fsk
@@ -17,6 +17,7 @@ package udp_test
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -456,6 +457,154 @@ func TestToGatewayUpRawMultiAntenna(t *testing.T) {
 	})
 }
 
+func TestToGatewayUpVendorCaptures(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name   string
+		Raw    string
+		Assert func(*assertions.Assertion, *ttnpb.GatewayUp)
+	}{
+		{
+			Name: "SX1302/Uplink",
+			Raw: `{"rxpk":[
+				{"jver":1,"tmst":1793287028,"chan":2,"rfch":1,"freq":868.500000,"mid":8,"stat":1,"modu":"LORA","datr":"SF7BW125","codr":"4/5","rssis":-53,"lsnr":13.5,"foff":-164,"rssi":-52,"size":12,"data":"QCkuASaAAAAByFaF"},
+				{"jver":1,"tmst":1793287530,"chan":0,"rfch":1,"freq":868.100000,"mid":8,"stat":-1,"modu":"LORA","datr":"SF12BW125","codr":"4/5","rssis":-121,"lsnr":-15.2,"foff":-98,"rssi":-111,"size":12,"data":"QCkuASaAAAAByFaF"},
+				{"jver":1,"tmst":1793288012,"chan":1,"rfch":1,"freq":868.300000,"mid":8,"stat":0,"modu":"LORA","datr":"SF9BW125","codr":"4/5","rssis":-99,"lsnr":4.2,"foff":27,"rssi":-98,"size":12,"data":"QCkuASaAAAAByFaF"}
+			]}`, //nolint:lll
+			Assert: func(a *assertions.Assertion, up *ttnpb.GatewayUp) {
+				// The packet with the failed CRC is dropped.
+				if !a.So(up.UplinkMessages, should.HaveLength, 2) {
+					return
+				}
+				md := up.UplinkMessages[0].RxMetadata
+				if a.So(md, should.HaveLength, 1) {
+					a.So(md[0].Timestamp, should.Equal, 1793287028)
+					a.So(md[0].ChannelIndex, should.Equal, 2)
+					a.So(md[0].Rssi, should.Equal, -52)
+					a.So(md[0].ChannelRssi, should.Equal, -52)
+					a.So(md[0].SignalRssi, should.Resemble, &pbtypes.FloatValue{Value: -53})
+					a.So(md[0].Snr, should.Equal, 13.5)
+					a.So(md[0].FrequencyOffset, should.Equal, -164)
+				}
+				a.So(up.UplinkMessages[1].Settings.Frequency, should.Equal, 868300000)
+				a.So(up.UplinkMessages[1].RxMetadata[0].Timestamp, should.Equal, 1793288012)
+			},
+		},
+		{
+			Name: "SX1302/Status",
+			Raw:  `{"stat":{"time":"2023-03-14 10:21:36 UTC","rxnb":3,"rxok":2,"rxfw":2,"ackr":100.0,"dwnb":1,"txnb":1,"temp":37.8}}`, //nolint:lll
+			Assert: func(a *assertions.Assertion, up *ttnpb.GatewayUp) {
+				status := up.GatewayStatus
+				if !a.So(status, should.NotBeNil) {
+					return
+				}
+				a.So(*ttnpb.StdTime(status.Time), should.Equal, time.Date(2023, 3, 14, 10, 21, 36, 0, time.UTC))
+				a.So(status.BootTime, should.BeNil)
+				a.So(status.Metrics["temp"], should.AlmostEqual, 37.8, 0.001)
+				a.So(status.Metrics["rxin"], should.Equal, 3)
+				a.So(status.Metrics["rxok"], should.Equal, 2)
+				a.So(status.Metrics["txok"], should.Equal, 1)
+			},
+		},
+		{
+			Name: "SX1302/TxAcknowledgmentWarning",
+			Raw:  `{"txpk_ack":{"warn":"TX_POWER","value":14}}`,
+			Assert: func(a *assertions.Assertion, up *ttnpb.GatewayUp) {
+				if a.So(up.TxAcknowledgment, should.NotBeNil) {
+					a.So(up.TxAcknowledgment.Result, should.Equal, ttnpb.TxAcknowledgment_SUCCESS)
+				}
+			},
+		},
+		{
+			Name: "SX1302/TxAcknowledgmentError",
+			Raw:  `{"txpk_ack":{"error":"TOO_LATE"}}`,
+			Assert: func(a *assertions.Assertion, up *ttnpb.GatewayUp) {
+				if a.So(up.TxAcknowledgment, should.NotBeNil) {
+					a.So(up.TxAcknowledgment.Result, should.Equal, ttnpb.TxAcknowledgment_TOO_LATE)
+				}
+			},
+		},
+		{
+			Name: "Kerlink/MultiAntenna",
+			Raw: `{"rxpk":[{
+				"tmst":2211342084,"time":"2023-03-14T10:21:36.271455Z","tmms":1362910914271,"ftime":508183093,
+				"chan":3,"rfch":0,"freq":867.100000,"stat":1,"modu":"LORA","datr":"SF8BW125","codr":"4/5",
+				"rssi":-97,"lsnr":7.5,"foff":-1204,"size":12,"data":"QCkuASaAAAAByFaF","brd":1,"aesk":3,
+				"rsig":[
+					{"ant":2,"chan":3,"rssic":-97,"rssis":-98,"rssisd":1,"lsnr":7.5,"etime":"42QMzOlYSSPMMeqVPrY0fQ==","ftstat":0,"ftver":1,"ftdelta":-2},
+					{"ant":3,"chan":3,"rssic":-103,"rssis":-105,"rssisd":2,"lsnr":1.2,"etime":"djGiSzOC+gCT7vRPv7+Asw==","ftstat":3,"ftver":1,"ftdelta":0}
+				]
+			}]}`, //nolint:lll
+			Assert: func(a *assertions.Assertion, up *ttnpb.GatewayUp) {
+				if !a.So(up.UplinkMessages, should.HaveLength, 1) {
+					return
+				}
+				msg := up.UplinkMessages[0]
+				a.So(msg.Settings.Timestamp, should.Equal, 2211342084)
+				md := msg.RxMetadata
+				if !a.So(md, should.HaveLength, 2) {
+					return
+				}
+				gpsTime := gpstime.Parse(1362910914271 * time.Millisecond)
+
+				a.So(md[0].AntennaIndex, should.Equal, 2)
+				a.So(md[0].Rssi, should.Equal, -97)
+				a.So(md[0].SignalRssi, should.Resemble, &pbtypes.FloatValue{Value: -98})
+				a.So(md[0].RssiStandardDeviation, should.Equal, 1)
+				a.So(md[0].Snr, should.Equal, 7.5)
+				a.So(md[0].FrequencyOffset, should.Equal, -1204)
+				a.So(md[0].FineTimestamp, should.Equal, 508183093)
+				a.So(md[0].EncryptedFineTimestampKeyId, should.Equal, "3")
+				a.So(md[0].EncryptedFineTimestamp, should.Resemble, []byte{0xe3, 0x64, 0x0c, 0xcc, 0xe9, 0x58, 0x49, 0x23, 0xcc, 0x31, 0xea, 0x95, 0x3e, 0xb6, 0x34, 0x7d}) //nolint:lll
+				a.So(*ttnpb.StdTime(md[0].GpsTime), should.Equal, gpsTime)
+
+				// The fine timestamp status of the second antenna is invalid.
+				a.So(md[1].AntennaIndex, should.Equal, 3)
+				a.So(md[1].Rssi, should.Equal, -103)
+				a.So(md[1].SignalRssi, should.Resemble, &pbtypes.FloatValue{Value: -105})
+				a.So(md[1].Snr, should.AlmostEqual, 1.2, 0.001)
+				a.So(md[1].FineTimestamp, should.BeZeroValue)
+				a.So(md[1].EncryptedFineTimestamp, should.BeEmpty)
+				a.So(md[1].EncryptedFineTimestampKeyId, should.BeEmpty)
+			},
+		},
+		{
+			Name: "TTNPacketForwarder/Status",
+			Raw:  `{"stat":{"time":"2023-03-14 10:21:36 GMT","boot":"2023-03-01 08:00:00 GMT","lati":52.37403,"long":4.88969,"alti":12,"rxnb":12,"rxok":10,"rxfw":10,"ackr":100.0,"dwnb":2,"txnb":2,"pfrm":"IMST + Rpi","mail":"gateway@example.com","desc":"Rooftop gateway"}}`, //nolint:lll
+			Assert: func(a *assertions.Assertion, up *ttnpb.GatewayUp) {
+				status := up.GatewayStatus
+				if !a.So(status, should.NotBeNil) {
+					return
+				}
+				a.So(status.Versions["platform"], should.Equal, "IMST + Rpi")
+				a.So(*ttnpb.StdTime(status.BootTime), should.Equal, time.Date(2023, 3, 1, 8, 0, 0, 0, time.UTC))
+				if a.So(status.AntennaLocations, should.HaveLength, 1) {
+					a.So(status.AntennaLocations[0].Latitude, should.AlmostEqual, 52.37403, 0.0001)
+					a.So(status.AntennaLocations[0].Longitude, should.AlmostEqual, 4.88969, 0.0001)
+					a.So(status.AntennaLocations[0].Altitude, should.Equal, 12)
+				}
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			var data udp.Data
+			if !a.So(json.Unmarshal([]byte(tc.Raw), &data), should.BeNil) {
+				t.FailNow()
+			}
+			up, err := udp.ToGatewayUp(data, udp.UpstreamMetadata{ID: ids, IP: "127.0.0.1"})
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			tc.Assert(a, up)
+		})
+	}
+}
+
 func TestFromDownlinkMessageLoRa(t *testing.T) {
 	t.Parallel()
 	a := assertions.New(t)
@@ -569,3 +718,98 @@ func TestDownlinkRoundtrip(t *testing.T) {
 
 	a.So(actual, should.HaveEmptyDiff, expected)
 }
+
+func TestToDownlinkMessage(t *testing.T) {
+	t.Parallel()
+
+	absoluteTime := time.Date(2023, 3, 14, 10, 21, 36, 0, time.UTC)
+	gpsTime := uint64(gpstime.ToGPS(absoluteTime) / time.Millisecond)
+
+	for _, tc := range []struct {
+		Name     string
+		Raw      string
+		Expected *ttnpb.TxSettings
+	}{
+		{
+			Name: "Timestamp",
+			Raw:  `{"txpk":{"imme":false,"tmst":1886440700,"freq":869.525,"rfch":0,"powe":14,"modu":"LORA","datr":"SF9BW125","codr":"4/5","ipol":true,"size":3,"data":"ffOO"}}`, //nolint:lll
+			Expected: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 9,
+							Bandwidth:       125000,
+							CodingRate:      band.Cr4_5,
+						},
+					},
+				},
+				Frequency: 869525000,
+				EnableCrc: true,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					InvertPolarization: true,
+					TxPower:            16.15,
+				},
+				Timestamp: 1886440700,
+			},
+		},
+		{
+			Name: "Immediate/NoCRC",
+			Raw:  `{"txpk":{"imme":true,"tmst":1886440700,"freq":869.525,"rfch":0,"powe":14,"modu":"LORA","datr":"SF9BW125","codr":"4/5","ipol":true,"ncrc":true,"size":3,"data":"ffOO"}}`, //nolint:lll
+			Expected: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 9,
+							Bandwidth:       125000,
+							CodingRate:      band.Cr4_5,
+						},
+					},
+				},
+				Frequency: 869525000,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					InvertPolarization: true,
+					TxPower:            16.15,
+				},
+			},
+		},
+		{
+			Name: "GPSTime",
+			Raw:  fmt.Sprintf(`{"txpk":{"tmms":%d,"freq":869.525,"rfch":0,"powe":14,"modu":"LORA","datr":"SF9BW125","codr":"4/5","ipol":true,"size":3,"data":"ffOO"}}`, gpsTime), //nolint:lll
+			Expected: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 9,
+							Bandwidth:       125000,
+							CodingRate:      band.Cr4_5,
+						},
+					},
+				},
+				Frequency: 869525000,
+				EnableCrc: true,
+				Downlink: &ttnpb.TxSettings_Downlink{
+					InvertPolarization: true,
+					TxPower:            16.15,
+				},
+				Time: ttnpb.ProtoTimePtr(absoluteTime),
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			var data udp.Data
+			if !a.So(json.Unmarshal([]byte(tc.Raw), &data), should.BeNil) {
+				t.FailNow()
+			}
+			msg, err := udp.ToDownlinkMessage(data.TxPacket)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(msg.GetScheduled(), should.Resemble, tc.Expected)
+			a.So(msg.RawPayload, should.Resemble, []byte{0x7d, 0xf3, 0x8e})
+		})
+	}
+}
@@ -128,6 +128,33 @@ func (id *NetID) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 	copy(id[:], b)
 }
 
+// MarshalNetIDSlice marshals a slice of NetIDs to JSON.
+func MarshalNetIDSlice(s *jsonplugin.MarshalState, bs [][]byte) {
+	vs := make([]string, len(bs))
+	for i, b := range bs {
+		vs[i] = fmt.Sprintf("%X", b)
+	}
+	s.WriteStringArray(vs)
+}
+
+// UnmarshalNetIDSlice unmarshals a slice of NetIDs from JSON.
+func UnmarshalNetIDSlice(s *jsonplugin.UnmarshalState) [][]byte {
+	vs := s.ReadStringArray()
+	if s.Err() != nil {
+		return nil
+	}
+	bs := make([][]byte, len(vs))
+	for i, v := range vs {
+		var id NetID
+		if err := id.UnmarshalText([]byte(v)); err != nil {
+			s.SetError(err)
+			return nil
+		}
+		bs[i] = id.Bytes()
+	}
+	return bs
+}
+
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
 func (id NetID) MarshalBinary() ([]byte, error) { return marshalBinaryBytes(id[:]) }
 
@@ -211,6 +211,8 @@ PropTypes.entityLocations = PropTypes.shape({
   'lora-cloud-geolocation-v3-rssi': PropTypes.entityLocation,
   'lora-cloud-geolocation-v3-tdoa': PropTypes.entityLocation,
   'lora-cloud-geolocation-v3-rssitdoacombined': PropTypes.entityLocation,
+  'local-geolocation-v1-tdoa': PropTypes.entityLocation,
+  'local-geolocation-v1-rssi': PropTypes.entityLocation,
 })
 
 PropTypes.device = PropTypes.shape({
@@ -28,6 +28,12 @@ class As {
     return Marshaler.payloadSingleResponse(result)
   }
 
+  async getNormalizedPayloadSchema() {
+    const result = await this._api.As.GetNormalizedPayloadSchema()
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+
   async encodeDownlink(appId, deviceId, data) {
     const result = await this._api.AppAs.EncodeDownlink(
       {